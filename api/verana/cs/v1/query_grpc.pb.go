@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName                = "/verana.cs.v1.Query/Params"
-	Query_ListCredentialSchemas_FullMethodName = "/verana.cs.v1.Query/ListCredentialSchemas"
-	Query_GetCredentialSchema_FullMethodName   = "/verana.cs.v1.Query/GetCredentialSchema"
-	Query_RenderJsonSchema_FullMethodName      = "/verana.cs.v1.Query/RenderJsonSchema"
+	Query_Params_FullMethodName                    = "/verana.cs.v1.Query/Params"
+	Query_ListCredentialSchemas_FullMethodName     = "/verana.cs.v1.Query/ListCredentialSchemas"
+	Query_GetCredentialSchema_FullMethodName       = "/verana.cs.v1.Query/GetCredentialSchema"
+	Query_RenderJsonSchema_FullMethodName          = "/verana.cs.v1.Query/RenderJsonSchema"
+	Query_RenderCredentialSchema_FullMethodName    = "/verana.cs.v1.Query/RenderCredentialSchema"
+	Query_ValidateCredentialSubject_FullMethodName = "/verana.cs.v1.Query/ValidateCredentialSubject"
 )
 
 // QueryClient is the client API for Query service.
@@ -39,6 +41,11 @@ type QueryClient interface {
 	GetCredentialSchema(ctx context.Context, in *QueryGetCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryGetCredentialSchemaResponse, error)
 	// RenderJsonSchema returns the JSON schema definition
 	RenderJsonSchema(ctx context.Context, in *QueryRenderJsonSchemaRequest, opts ...grpc.CallOption) (*QueryRenderJsonSchemaResponse, error)
+	// RenderCredentialSchema renders a credential schema in the requested format
+	RenderCredentialSchema(ctx context.Context, in *QueryRenderCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryRenderCredentialSchemaResponse, error)
+	// ValidateCredentialSubject validates a JSON credential subject against the
+	// canonicalized JSON schema of a credential schema
+	ValidateCredentialSubject(ctx context.Context, in *QueryValidateCredentialSubjectRequest, opts ...grpc.CallOption) (*QueryValidateCredentialSubjectResponse, error)
 }
 
 type queryClient struct {
@@ -89,6 +96,26 @@ func (c *queryClient) RenderJsonSchema(ctx context.Context, in *QueryRenderJsonS
 	return out, nil
 }
 
+func (c *queryClient) RenderCredentialSchema(ctx context.Context, in *QueryRenderCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryRenderCredentialSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRenderCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_RenderCredentialSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateCredentialSubject(ctx context.Context, in *QueryValidateCredentialSubjectRequest, opts ...grpc.CallOption) (*QueryValidateCredentialSubjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidateCredentialSubjectResponse)
+	err := c.cc.Invoke(ctx, Query_ValidateCredentialSubject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -103,6 +130,11 @@ type QueryServer interface {
 	GetCredentialSchema(context.Context, *QueryGetCredentialSchemaRequest) (*QueryGetCredentialSchemaResponse, error)
 	// RenderJsonSchema returns the JSON schema definition
 	RenderJsonSchema(context.Context, *QueryRenderJsonSchemaRequest) (*QueryRenderJsonSchemaResponse, error)
+	// RenderCredentialSchema renders a credential schema in the requested format
+	RenderCredentialSchema(context.Context, *QueryRenderCredentialSchemaRequest) (*QueryRenderCredentialSchemaResponse, error)
+	// ValidateCredentialSubject validates a JSON credential subject against the
+	// canonicalized JSON schema of a credential schema
+	ValidateCredentialSubject(context.Context, *QueryValidateCredentialSubjectRequest) (*QueryValidateCredentialSubjectResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -125,6 +157,12 @@ func (UnimplementedQueryServer) GetCredentialSchema(context.Context, *QueryGetCr
 func (UnimplementedQueryServer) RenderJsonSchema(context.Context, *QueryRenderJsonSchemaRequest) (*QueryRenderJsonSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenderJsonSchema not implemented")
 }
+func (UnimplementedQueryServer) RenderCredentialSchema(context.Context, *QueryRenderCredentialSchemaRequest) (*QueryRenderCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderCredentialSchema not implemented")
+}
+func (UnimplementedQueryServer) ValidateCredentialSubject(context.Context, *QueryValidateCredentialSubjectRequest) (*QueryValidateCredentialSubjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCredentialSubject not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -218,6 +256,42 @@ func _Query_RenderJsonSchema_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RenderCredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRenderCredentialSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RenderCredentialSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RenderCredentialSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RenderCredentialSchema(ctx, req.(*QueryRenderCredentialSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateCredentialSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateCredentialSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateCredentialSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidateCredentialSubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateCredentialSubject(ctx, req.(*QueryValidateCredentialSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +315,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenderJsonSchema",
 			Handler:    _Query_RenderJsonSchema_Handler,
 		},
+		{
+			MethodName: "RenderCredentialSchema",
+			Handler:    _Query_RenderCredentialSchema_Handler,
+		},
+		{
+			MethodName: "ValidateCredentialSubject",
+			Handler:    _Query_ValidateCredentialSubject_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/cs/v1/query.proto",
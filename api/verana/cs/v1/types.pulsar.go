@@ -2538,6 +2538,68 @@ func (PricingAssetType) EnumDescriptor() ([]byte, []int) {
 	return file_verana_cs_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
+// CredentialSchemaRenderFormat defines the documents a credential schema can be
+// rendered as by the RenderCredentialSchema query.
+type CredentialSchemaRenderFormat int32
+
+const (
+	// Defaults to the JSON schema rendering
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED CredentialSchemaRenderFormat = 0
+	// JCS-canonicalized JSON Schema with the canonical $id
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA CredentialSchemaRenderFormat = 1
+	// W3C VC JSON-LD @context document
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT CredentialSchemaRenderFormat = 2
+	// SD-JWT VC type metadata document (vct)
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA CredentialSchemaRenderFormat = 3
+	// Overlays Capture Architecture bundle
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE CredentialSchemaRenderFormat = 4
+)
+
+// Enum value maps for CredentialSchemaRenderFormat.
+var (
+	CredentialSchemaRenderFormat_name = map[int32]string{
+		0: "CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED",
+		1: "CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA",
+		2: "CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT",
+		3: "CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA",
+		4: "CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE",
+	}
+	CredentialSchemaRenderFormat_value = map[string]int32{
+		"CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED":             0,
+		"CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA":             1,
+		"CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT":      2,
+		"CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA": 3,
+		"CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE":              4,
+	}
+)
+
+func (x CredentialSchemaRenderFormat) Enum() *CredentialSchemaRenderFormat {
+	p := new(CredentialSchemaRenderFormat)
+	*p = x
+	return p
+}
+
+func (x CredentialSchemaRenderFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CredentialSchemaRenderFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_cs_v1_types_proto_enumTypes[4].Descriptor()
+}
+
+func (CredentialSchemaRenderFormat) Type() protoreflect.EnumType {
+	return &file_verana_cs_v1_types_proto_enumTypes[4]
+}
+
+func (x CredentialSchemaRenderFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CredentialSchemaRenderFormat.Descriptor instead.
+func (CredentialSchemaRenderFormat) EnumDescriptor() ([]byte, []int) {
+	return file_verana_cs_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
 // SchemaAuthorizationPolicyRole defines the role for a schema authorization policy.
 type SchemaAuthorizationPolicyRole int32
 
@@ -2572,11 +2634,11 @@ func (x SchemaAuthorizationPolicyRole) String() string {
 }
 
 func (SchemaAuthorizationPolicyRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_verana_cs_v1_types_proto_enumTypes[4].Descriptor()
+	return file_verana_cs_v1_types_proto_enumTypes[5].Descriptor()
 }
 
 func (SchemaAuthorizationPolicyRole) Type() protoreflect.EnumType {
-	return &file_verana_cs_v1_types_proto_enumTypes[4]
+	return &file_verana_cs_v1_types_proto_enumTypes[5]
 }
 
 func (x SchemaAuthorizationPolicyRole) Number() protoreflect.EnumNumber {
@@ -2585,7 +2647,7 @@ func (x SchemaAuthorizationPolicyRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SchemaAuthorizationPolicyRole.Descriptor instead.
 func (SchemaAuthorizationPolicyRole) EnumDescriptor() ([]byte, []int) {
-	return file_verana_cs_v1_types_proto_rawDescGZIP(), []int{4}
+	return file_verana_cs_v1_types_proto_rawDescGZIP(), []int{5}
 }
 
 // SchemaAuthorizationPolicy defines a versioned policy document attached to a credential schema role.
@@ -3018,28 +3080,47 @@ var file_verana_cs_v1_types_proto_rawDesc = []byte{
 	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
 	0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x54, 0x55, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
 	0x43, 0x4f, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x41, 0x54, 0x10, 0x03,
-	0x2a, 0xad, 0x01, 0x0a, 0x1d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x6f,
-	0x6c, 0x65, 0x12, 0x30, 0x0a, 0x2c, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x41, 0x55, 0x54,
-	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
-	0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x2b, 0x0a, 0x27, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x41,
-	0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c,
-	0x49, 0x43, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x10,
-	0x01, 0x12, 0x2d, 0x0a, 0x29, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x41, 0x55, 0x54, 0x48,
-	0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
-	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10, 0x02,
-	0x42, 0xa5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
-	0x63, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x63, 0x73,
-	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x43, 0x58, 0xaa, 0x02,
-	0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x43, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c,
-	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x43, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x43, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x3a, 0x3a, 0x43, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2a, 0xa5, 0x02, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x2f, 0x0a, 0x2b, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x2f, 0x0a, 0x2b, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d,
+	0x41, 0x10, 0x01, 0x12, 0x36, 0x0a, 0x32, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41,
+	0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x56, 0x43, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x4c,
+	0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x3b, 0x0a, 0x37, 0x43,
+	0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41,
+	0x5f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53,
+	0x44, 0x5f, 0x4a, 0x57, 0x54, 0x5f, 0x56, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45,
+	0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x03, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x52, 0x45, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x52, 0x45,
+	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x43, 0x41, 0x5f,
+	0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x10, 0x04, 0x2a, 0xad, 0x01, 0x0a, 0x1d, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x2c, 0x53, 0x43,
+	0x48, 0x45, 0x4d, 0x41, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2b, 0x0a, 0x27,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x53, 0x43, 0x48,
+	0x45, 0x4d, 0x41, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x56, 0x45,
+	0x52, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10, 0x02, 0x42, 0xa5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x63, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x63, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x73, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x56, 0x43, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x43, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x43,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x43, 0x73,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x43, 0x73, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3054,26 +3135,27 @@ func file_verana_cs_v1_types_proto_rawDescGZIP() []byte {
 	return file_verana_cs_v1_types_proto_rawDescData
 }
 
-var file_verana_cs_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_verana_cs_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
 var file_verana_cs_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_verana_cs_v1_types_proto_goTypes = []interface{}{
 	(IssuerOnboardingMode)(0),          // 0: verana.cs.v1.IssuerOnboardingMode
 	(VerifierOnboardingMode)(0),        // 1: verana.cs.v1.VerifierOnboardingMode
 	(HolderOnboardingMode)(0),          // 2: verana.cs.v1.HolderOnboardingMode
 	(PricingAssetType)(0),              // 3: verana.cs.v1.PricingAssetType
-	(SchemaAuthorizationPolicyRole)(0), // 4: verana.cs.v1.SchemaAuthorizationPolicyRole
-	(*SchemaAuthorizationPolicy)(nil),  // 5: verana.cs.v1.SchemaAuthorizationPolicy
-	(*CredentialSchema)(nil),           // 6: verana.cs.v1.CredentialSchema
-	(*timestamppb.Timestamp)(nil),      // 7: google.protobuf.Timestamp
+	(CredentialSchemaRenderFormat)(0),  // 4: verana.cs.v1.CredentialSchemaRenderFormat
+	(SchemaAuthorizationPolicyRole)(0), // 5: verana.cs.v1.SchemaAuthorizationPolicyRole
+	(*SchemaAuthorizationPolicy)(nil),  // 6: verana.cs.v1.SchemaAuthorizationPolicy
+	(*CredentialSchema)(nil),           // 7: verana.cs.v1.CredentialSchema
+	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
 }
 var file_verana_cs_v1_types_proto_depIdxs = []int32{
-	4,  // 0: verana.cs.v1.SchemaAuthorizationPolicy.role:type_name -> verana.cs.v1.SchemaAuthorizationPolicyRole
-	7,  // 1: verana.cs.v1.SchemaAuthorizationPolicy.effective_from:type_name -> google.protobuf.Timestamp
-	7,  // 2: verana.cs.v1.SchemaAuthorizationPolicy.effective_until:type_name -> google.protobuf.Timestamp
-	7,  // 3: verana.cs.v1.SchemaAuthorizationPolicy.created:type_name -> google.protobuf.Timestamp
-	7,  // 4: verana.cs.v1.CredentialSchema.created:type_name -> google.protobuf.Timestamp
-	7,  // 5: verana.cs.v1.CredentialSchema.modified:type_name -> google.protobuf.Timestamp
-	7,  // 6: verana.cs.v1.CredentialSchema.archived:type_name -> google.protobuf.Timestamp
+	5,  // 0: verana.cs.v1.SchemaAuthorizationPolicy.role:type_name -> verana.cs.v1.SchemaAuthorizationPolicyRole
+	8,  // 1: verana.cs.v1.SchemaAuthorizationPolicy.effective_from:type_name -> google.protobuf.Timestamp
+	8,  // 2: verana.cs.v1.SchemaAuthorizationPolicy.effective_until:type_name -> google.protobuf.Timestamp
+	8,  // 3: verana.cs.v1.SchemaAuthorizationPolicy.created:type_name -> google.protobuf.Timestamp
+	8,  // 4: verana.cs.v1.CredentialSchema.created:type_name -> google.protobuf.Timestamp
+	8,  // 5: verana.cs.v1.CredentialSchema.modified:type_name -> google.protobuf.Timestamp
+	8,  // 6: verana.cs.v1.CredentialSchema.archived:type_name -> google.protobuf.Timestamp
 	0,  // 7: verana.cs.v1.CredentialSchema.issuer_onboarding_mode:type_name -> verana.cs.v1.IssuerOnboardingMode
 	1,  // 8: verana.cs.v1.CredentialSchema.verifier_onboarding_mode:type_name -> verana.cs.v1.VerifierOnboardingMode
 	3,  // 9: verana.cs.v1.CredentialSchema.pricing_asset_type:type_name -> verana.cs.v1.PricingAssetType
@@ -3121,7 +3203,7 @@ func file_verana_cs_v1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_cs_v1_types_proto_rawDesc,
-			NumEnums:      5,
+			NumEnums:      6,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
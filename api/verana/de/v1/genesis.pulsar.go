@@ -218,6 +218,108 @@ func (x *_GenesisState_5_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*OperatorRole
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorRole)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorRole)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(OperatorRole)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(OperatorRole)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*CorporationFreeze
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CorporationFreeze)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CorporationFreeze)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(CorporationFreeze)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(CorporationFreeze)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                               protoreflect.MessageDescriptor
 	fd_GenesisState_params                        protoreflect.FieldDescriptor
@@ -225,6 +327,8 @@ var (
 	fd_GenesisState_fee_grants                    protoreflect.FieldDescriptor
 	fd_GenesisState_vs_operator_authorizations    protoreflect.FieldDescriptor
 	fd_GenesisState_operator_authorization_usages protoreflect.FieldDescriptor
+	fd_GenesisState_operator_roles                protoreflect.FieldDescriptor
+	fd_GenesisState_corporation_freezes           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -235,6 +339,8 @@ func init() {
 	fd_GenesisState_fee_grants = md_GenesisState.Fields().ByName("fee_grants")
 	fd_GenesisState_vs_operator_authorizations = md_GenesisState.Fields().ByName("vs_operator_authorizations")
 	fd_GenesisState_operator_authorization_usages = md_GenesisState.Fields().ByName("operator_authorization_usages")
+	fd_GenesisState_operator_roles = md_GenesisState.Fields().ByName("operator_roles")
+	fd_GenesisState_corporation_freezes = md_GenesisState.Fields().ByName("corporation_freezes")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -332,6 +438,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.OperatorRoles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.OperatorRoles})
+		if !f(fd_GenesisState_operator_roles, value) {
+			return
+		}
+	}
+	if len(x.CorporationFreezes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.CorporationFreezes})
+		if !f(fd_GenesisState_corporation_freezes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -357,6 +475,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.VsOperatorAuthorizations) != 0
 	case "verana.de.v1.GenesisState.operator_authorization_usages":
 		return len(x.OperatorAuthorizationUsages) != 0
+	case "verana.de.v1.GenesisState.operator_roles":
+		return len(x.OperatorRoles) != 0
+	case "verana.de.v1.GenesisState.corporation_freezes":
+		return len(x.CorporationFreezes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.GenesisState"))
@@ -383,6 +505,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.VsOperatorAuthorizations = nil
 	case "verana.de.v1.GenesisState.operator_authorization_usages":
 		x.OperatorAuthorizationUsages = nil
+	case "verana.de.v1.GenesisState.operator_roles":
+		x.OperatorRoles = nil
+	case "verana.de.v1.GenesisState.corporation_freezes":
+		x.CorporationFreezes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.GenesisState"))
@@ -426,6 +552,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_5_list{list: &x.OperatorAuthorizationUsages}
 		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.GenesisState.operator_roles":
+		if len(x.OperatorRoles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.OperatorRoles}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.GenesisState.corporation_freezes":
+		if len(x.CorporationFreezes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.CorporationFreezes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.GenesisState"))
@@ -464,6 +602,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_5_list)
 		x.OperatorAuthorizationUsages = *clv.list
+	case "verana.de.v1.GenesisState.operator_roles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.OperatorRoles = *clv.list
+	case "verana.de.v1.GenesisState.corporation_freezes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.CorporationFreezes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.GenesisState"))
@@ -513,6 +659,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_5_list{list: &x.OperatorAuthorizationUsages}
 		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.GenesisState.operator_roles":
+		if x.OperatorRoles == nil {
+			x.OperatorRoles = []*OperatorRole{}
+		}
+		value := &_GenesisState_6_list{list: &x.OperatorRoles}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.GenesisState.corporation_freezes":
+		if x.CorporationFreezes == nil {
+			x.CorporationFreezes = []*CorporationFreeze{}
+		}
+		value := &_GenesisState_7_list{list: &x.CorporationFreezes}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.GenesisState"))
@@ -541,6 +699,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "verana.de.v1.GenesisState.operator_authorization_usages":
 		list := []*OperatorAuthorizationUsage{}
 		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "verana.de.v1.GenesisState.operator_roles":
+		list := []*OperatorRole{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "verana.de.v1.GenesisState.corporation_freezes":
+		list := []*CorporationFreeze{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.GenesisState"))
@@ -638,6 +802,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.OperatorRoles) > 0 {
+			for _, e := range x.OperatorRoles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CorporationFreezes) > 0 {
+			for _, e := range x.CorporationFreezes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -667,6 +843,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CorporationFreezes) > 0 {
+			for iNdEx := len(x.CorporationFreezes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CorporationFreezes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.OperatorRoles) > 0 {
+			for iNdEx := len(x.OperatorRoles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OperatorRoles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.OperatorAuthorizationUsages) > 0 {
 			for iNdEx := len(x.OperatorAuthorizationUsages) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.OperatorAuthorizationUsages[iNdEx])
@@ -966,6 +1174,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorRoles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorRoles = append(x.OperatorRoles, &OperatorRole{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OperatorRoles[len(x.OperatorRoles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationFreezes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CorporationFreezes = append(x.CorporationFreezes, &CorporationFreeze{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CorporationFreezes[len(x.CorporationFreezes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1030,6 +1306,10 @@ type GenesisState struct {
 	VsOperatorAuthorizations []*VSOperatorAuthorization `protobuf:"bytes,4,rep,name=vs_operator_authorizations,json=vsOperatorAuthorizations,proto3" json:"vs_operator_authorizations,omitempty"`
 	// operator_authorization_usages is a list of all OperatorAuthorizationUsage objects
 	OperatorAuthorizationUsages []*OperatorAuthorizationUsage `protobuf:"bytes,5,rep,name=operator_authorization_usages,json=operatorAuthorizationUsages,proto3" json:"operator_authorization_usages,omitempty"`
+	// operator_roles is a list of all OperatorRole objects
+	OperatorRoles []*OperatorRole `protobuf:"bytes,6,rep,name=operator_roles,json=operatorRoles,proto3" json:"operator_roles,omitempty"`
+	// corporation_freezes is a list of all CorporationFreeze objects
+	CorporationFreezes []*CorporationFreeze `protobuf:"bytes,7,rep,name=corporation_freezes,json=corporationFreezes,proto3" json:"corporation_freezes,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1087,6 +1367,20 @@ func (x *GenesisState) GetOperatorAuthorizationUsages() []*OperatorAuthorization
 	return nil
 }
 
+func (x *GenesisState) GetOperatorRoles() []*OperatorRole {
+	if x != nil {
+		return x.OperatorRoles
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCorporationFreezes() []*CorporationFreeze {
+	if x != nil {
+		return x.CorporationFreezes
+	}
+	return nil
+}
+
 var File_verana_de_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_verana_de_v1_genesis_proto_rawDesc = []byte{
@@ -1098,7 +1392,7 @@ var file_verana_de_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x64, 0x65, 0x2f, 0x76,
 	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
 	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x72, 0x61,
 	0x6e, 0x61, 0x2e, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
@@ -1127,18 +1421,28 @@ var file_verana_de_v1_genesis_proto_rawDesc = []byte{
 	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42,
 	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x1b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41,
 	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x42, 0xa7, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2e, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56,
-	0x44, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x44, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x44, 0x65, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x44, 0x65, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x44, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x13, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x12, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x73, 0x42, 0xa7, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x56, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x44, 0x65, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x44, 0x65, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x44, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x44, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1161,6 +1465,8 @@ var file_verana_de_v1_genesis_proto_goTypes = []interface{}{
 	(*FeeGrant)(nil),                   // 3: verana.de.v1.FeeGrant
 	(*VSOperatorAuthorization)(nil),    // 4: verana.de.v1.VSOperatorAuthorization
 	(*OperatorAuthorizationUsage)(nil), // 5: verana.de.v1.OperatorAuthorizationUsage
+	(*OperatorRole)(nil),               // 6: verana.de.v1.OperatorRole
+	(*CorporationFreeze)(nil),          // 7: verana.de.v1.CorporationFreeze
 }
 var file_verana_de_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: verana.de.v1.GenesisState.params:type_name -> verana.de.v1.Params
@@ -1168,11 +1474,13 @@ var file_verana_de_v1_genesis_proto_depIdxs = []int32{
 	3, // 2: verana.de.v1.GenesisState.fee_grants:type_name -> verana.de.v1.FeeGrant
 	4, // 3: verana.de.v1.GenesisState.vs_operator_authorizations:type_name -> verana.de.v1.VSOperatorAuthorization
 	5, // 4: verana.de.v1.GenesisState.operator_authorization_usages:type_name -> verana.de.v1.OperatorAuthorizationUsage
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 5: verana.de.v1.GenesisState.operator_roles:type_name -> verana.de.v1.OperatorRole
+	7, // 6: verana.de.v1.GenesisState.corporation_freezes:type_name -> verana.de.v1.CorporationFreeze
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_verana_de_v1_genesis_proto_init() }
@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName                       = "/verana.de.v1.Query/Params"
-	Query_ListOperatorAuthorizations_FullMethodName   = "/verana.de.v1.Query/ListOperatorAuthorizations"
-	Query_ListVSOperatorAuthorizations_FullMethodName = "/verana.de.v1.Query/ListVSOperatorAuthorizations"
-	Query_GetOperatorAuthorization_FullMethodName     = "/verana.de.v1.Query/GetOperatorAuthorization"
-	Query_GetVSOperatorAuthorization_FullMethodName   = "/verana.de.v1.Query/GetVSOperatorAuthorization"
+	Query_Params_FullMethodName                            = "/verana.de.v1.Query/Params"
+	Query_ListOperatorAuthorizations_FullMethodName        = "/verana.de.v1.Query/ListOperatorAuthorizations"
+	Query_ListVSOperatorAuthorizations_FullMethodName      = "/verana.de.v1.Query/ListVSOperatorAuthorizations"
+	Query_GetOperatorAuthorization_FullMethodName          = "/verana.de.v1.Query/GetOperatorAuthorization"
+	Query_GetVSOperatorAuthorization_FullMethodName        = "/verana.de.v1.Query/GetVSOperatorAuthorization"
+	Query_ListOperatorRoles_FullMethodName                 = "/verana.de.v1.Query/ListOperatorRoles"
+	Query_ListRoleOperators_FullMethodName                 = "/verana.de.v1.Query/ListRoleOperators"
+	Query_ListAuthorizationsByGrantee_FullMethodName       = "/verana.de.v1.Query/ListAuthorizationsByGrantee"
+	Query_GetCorporationFreeze_FullMethodName              = "/verana.de.v1.Query/GetCorporationFreeze"
+	Query_ListPendingOperatorAuthorizations_FullMethodName = "/verana.de.v1.Query/ListPendingOperatorAuthorizations"
 )
 
 // QueryClient is the client API for Query service.
@@ -42,6 +47,18 @@ type QueryClient interface {
 	GetOperatorAuthorization(ctx context.Context, in *QueryGetOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetOperatorAuthorizationResponse, error)
 	// [MOD-DE-QRY-4] GetVSOperatorAuthorization returns a single VSOperatorAuthorization by id.
 	GetVSOperatorAuthorization(ctx context.Context, in *QueryGetVSOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetVSOperatorAuthorizationResponse, error)
+	// ListOperatorRoles returns the operator roles of a corporation.
+	ListOperatorRoles(ctx context.Context, in *QueryListOperatorRolesRequest, opts ...grpc.CallOption) (*QueryListOperatorRolesResponse, error)
+	// ListRoleOperators returns the operator authorizations granted from an
+	// operator role.
+	ListRoleOperators(ctx context.Context, in *QueryListRoleOperatorsRequest, opts ...grpc.CallOption) (*QueryListRoleOperatorsResponse, error)
+	// ListAuthorizationsByGrantee returns the operator and VS operator
+	// authorizations held by a grantee across all corporations.
+	ListAuthorizationsByGrantee(ctx context.Context, in *QueryListAuthorizationsByGranteeRequest, opts ...grpc.CallOption) (*QueryListAuthorizationsByGranteeResponse, error)
+	// GetCorporationFreeze returns whether the operators of a corporation are frozen.
+	GetCorporationFreeze(ctx context.Context, in *QueryGetCorporationFreezeRequest, opts ...grpc.CallOption) (*QueryGetCorporationFreezeResponse, error)
+	// ListPendingOperatorAuthorizations lists the operator authorizations not active yet.
+	ListPendingOperatorAuthorizations(ctx context.Context, in *QueryListPendingOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListPendingOperatorAuthorizationsResponse, error)
 }
 
 type queryClient struct {
@@ -102,6 +119,56 @@ func (c *queryClient) GetVSOperatorAuthorization(ctx context.Context, in *QueryG
 	return out, nil
 }
 
+func (c *queryClient) ListOperatorRoles(ctx context.Context, in *QueryListOperatorRolesRequest, opts ...grpc.CallOption) (*QueryListOperatorRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListOperatorRolesResponse)
+	err := c.cc.Invoke(ctx, Query_ListOperatorRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRoleOperators(ctx context.Context, in *QueryListRoleOperatorsRequest, opts ...grpc.CallOption) (*QueryListRoleOperatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListRoleOperatorsResponse)
+	err := c.cc.Invoke(ctx, Query_ListRoleOperators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAuthorizationsByGrantee(ctx context.Context, in *QueryListAuthorizationsByGranteeRequest, opts ...grpc.CallOption) (*QueryListAuthorizationsByGranteeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListAuthorizationsByGranteeResponse)
+	err := c.cc.Invoke(ctx, Query_ListAuthorizationsByGrantee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCorporationFreeze(ctx context.Context, in *QueryGetCorporationFreezeRequest, opts ...grpc.CallOption) (*QueryGetCorporationFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetCorporationFreezeResponse)
+	err := c.cc.Invoke(ctx, Query_GetCorporationFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPendingOperatorAuthorizations(ctx context.Context, in *QueryListPendingOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListPendingOperatorAuthorizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListPendingOperatorAuthorizationsResponse)
+	err := c.cc.Invoke(ctx, Query_ListPendingOperatorAuthorizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -118,6 +185,18 @@ type QueryServer interface {
 	GetOperatorAuthorization(context.Context, *QueryGetOperatorAuthorizationRequest) (*QueryGetOperatorAuthorizationResponse, error)
 	// [MOD-DE-QRY-4] GetVSOperatorAuthorization returns a single VSOperatorAuthorization by id.
 	GetVSOperatorAuthorization(context.Context, *QueryGetVSOperatorAuthorizationRequest) (*QueryGetVSOperatorAuthorizationResponse, error)
+	// ListOperatorRoles returns the operator roles of a corporation.
+	ListOperatorRoles(context.Context, *QueryListOperatorRolesRequest) (*QueryListOperatorRolesResponse, error)
+	// ListRoleOperators returns the operator authorizations granted from an
+	// operator role.
+	ListRoleOperators(context.Context, *QueryListRoleOperatorsRequest) (*QueryListRoleOperatorsResponse, error)
+	// ListAuthorizationsByGrantee returns the operator and VS operator
+	// authorizations held by a grantee across all corporations.
+	ListAuthorizationsByGrantee(context.Context, *QueryListAuthorizationsByGranteeRequest) (*QueryListAuthorizationsByGranteeResponse, error)
+	// GetCorporationFreeze returns whether the operators of a corporation are frozen.
+	GetCorporationFreeze(context.Context, *QueryGetCorporationFreezeRequest) (*QueryGetCorporationFreezeResponse, error)
+	// ListPendingOperatorAuthorizations lists the operator authorizations not active yet.
+	ListPendingOperatorAuthorizations(context.Context, *QueryListPendingOperatorAuthorizationsRequest) (*QueryListPendingOperatorAuthorizationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -143,6 +222,21 @@ func (UnimplementedQueryServer) GetOperatorAuthorization(context.Context, *Query
 func (UnimplementedQueryServer) GetVSOperatorAuthorization(context.Context, *QueryGetVSOperatorAuthorizationRequest) (*QueryGetVSOperatorAuthorizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVSOperatorAuthorization not implemented")
 }
+func (UnimplementedQueryServer) ListOperatorRoles(context.Context, *QueryListOperatorRolesRequest) (*QueryListOperatorRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperatorRoles not implemented")
+}
+func (UnimplementedQueryServer) ListRoleOperators(context.Context, *QueryListRoleOperatorsRequest) (*QueryListRoleOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleOperators not implemented")
+}
+func (UnimplementedQueryServer) ListAuthorizationsByGrantee(context.Context, *QueryListAuthorizationsByGranteeRequest) (*QueryListAuthorizationsByGranteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorizationsByGrantee not implemented")
+}
+func (UnimplementedQueryServer) GetCorporationFreeze(context.Context, *QueryGetCorporationFreezeRequest) (*QueryGetCorporationFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorporationFreeze not implemented")
+}
+func (UnimplementedQueryServer) ListPendingOperatorAuthorizations(context.Context, *QueryListPendingOperatorAuthorizationsRequest) (*QueryListPendingOperatorAuthorizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingOperatorAuthorizations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -254,6 +348,96 @@ func _Query_GetVSOperatorAuthorization_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListOperatorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListOperatorRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListOperatorRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListOperatorRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListOperatorRoles(ctx, req.(*QueryListOperatorRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRoleOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRoleOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRoleOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListRoleOperators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRoleOperators(ctx, req.(*QueryListRoleOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAuthorizationsByGrantee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListAuthorizationsByGranteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAuthorizationsByGrantee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListAuthorizationsByGrantee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAuthorizationsByGrantee(ctx, req.(*QueryListAuthorizationsByGranteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCorporationFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCorporationFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCorporationFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCorporationFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCorporationFreeze(ctx, req.(*QueryGetCorporationFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPendingOperatorAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPendingOperatorAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPendingOperatorAuthorizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPendingOperatorAuthorizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPendingOperatorAuthorizations(ctx, req.(*QueryListPendingOperatorAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +465,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVSOperatorAuthorization",
 			Handler:    _Query_GetVSOperatorAuthorization_Handler,
 		},
+		{
+			MethodName: "ListOperatorRoles",
+			Handler:    _Query_ListOperatorRoles_Handler,
+		},
+		{
+			MethodName: "ListRoleOperators",
+			Handler:    _Query_ListRoleOperators_Handler,
+		},
+		{
+			MethodName: "ListAuthorizationsByGrantee",
+			Handler:    _Query_ListAuthorizationsByGrantee_Handler,
+		},
+		{
+			MethodName: "GetCorporationFreeze",
+			Handler:    _Query_GetCorporationFreeze_Handler,
+		},
+		{
+			MethodName: "ListPendingOperatorAuthorizations",
+			Handler:    _Query_ListPendingOperatorAuthorizations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/de/v1/query.proto",
@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName                = "/verana.de.v1.Msg/UpdateParams"
-	Msg_GrantOperatorAuthorization_FullMethodName  = "/verana.de.v1.Msg/GrantOperatorAuthorization"
-	Msg_RevokeOperatorAuthorization_FullMethodName = "/verana.de.v1.Msg/RevokeOperatorAuthorization"
+	Msg_UpdateParams_FullMethodName                 = "/verana.de.v1.Msg/UpdateParams"
+	Msg_GrantOperatorAuthorization_FullMethodName   = "/verana.de.v1.Msg/GrantOperatorAuthorization"
+	Msg_RevokeOperatorAuthorization_FullMethodName  = "/verana.de.v1.Msg/RevokeOperatorAuthorization"
+	Msg_SetOperatorRole_FullMethodName              = "/verana.de.v1.Msg/SetOperatorRole"
+	Msg_DeleteOperatorRole_FullMethodName           = "/verana.de.v1.Msg/DeleteOperatorRole"
+	Msg_FreezeCorporationOperators_FullMethodName   = "/verana.de.v1.Msg/FreezeCorporationOperators"
+	Msg_UnfreezeCorporationOperators_FullMethodName = "/verana.de.v1.Msg/UnfreezeCorporationOperators"
+	Msg_ExecAsCorporation_FullMethodName            = "/verana.de.v1.Msg/ExecAsCorporation"
+	Msg_AcceptOperatorAuthorization_FullMethodName  = "/verana.de.v1.Msg/AcceptOperatorAuthorization"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +43,18 @@ type MsgClient interface {
 	GrantOperatorAuthorization(ctx context.Context, in *MsgGrantOperatorAuthorization, opts ...grpc.CallOption) (*MsgGrantOperatorAuthorizationResponse, error)
 	// [MOD-DE-MSG-4] Revoke Operator Authorization
 	RevokeOperatorAuthorization(ctx context.Context, in *MsgRevokeOperatorAuthorization, opts ...grpc.CallOption) (*MsgRevokeOperatorAuthorizationResponse, error)
+	// SetOperatorRole creates or updates a named operator role of a corporation
+	SetOperatorRole(ctx context.Context, in *MsgSetOperatorRole, opts ...grpc.CallOption) (*MsgSetOperatorRoleResponse, error)
+	// DeleteOperatorRole deletes an operator role no authorization is granted from
+	DeleteOperatorRole(ctx context.Context, in *MsgDeleteOperatorRole, opts ...grpc.CallOption) (*MsgDeleteOperatorRoleResponse, error)
+	// FreezeCorporationOperators suspends every operator authorization of a corporation
+	FreezeCorporationOperators(ctx context.Context, in *MsgFreezeCorporationOperators, opts ...grpc.CallOption) (*MsgFreezeCorporationOperatorsResponse, error)
+	// UnfreezeCorporationOperators lifts a freeze, through a group proposal only
+	UnfreezeCorporationOperators(ctx context.Context, in *MsgUnfreezeCorporationOperators, opts ...grpc.CallOption) (*MsgUnfreezeCorporationOperatorsResponse, error)
+	// ExecAsCorporation atomically executes messages of a corporation run by one operator
+	ExecAsCorporation(ctx context.Context, in *MsgExecAsCorporation, opts ...grpc.CallOption) (*MsgExecAsCorporationResponse, error)
+	// AcceptOperatorAuthorization lets a grantee accept a pending operator authorization
+	AcceptOperatorAuthorization(ctx context.Context, in *MsgAcceptOperatorAuthorization, opts ...grpc.CallOption) (*MsgAcceptOperatorAuthorizationResponse, error)
 }
 
 type msgClient struct {
@@ -77,6 +95,66 @@ func (c *msgClient) RevokeOperatorAuthorization(ctx context.Context, in *MsgRevo
 	return out, nil
 }
 
+func (c *msgClient) SetOperatorRole(ctx context.Context, in *MsgSetOperatorRole, opts ...grpc.CallOption) (*MsgSetOperatorRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_SetOperatorRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteOperatorRole(ctx context.Context, in *MsgDeleteOperatorRole, opts ...grpc.CallOption) (*MsgDeleteOperatorRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDeleteOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteOperatorRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeCorporationOperators(ctx context.Context, in *MsgFreezeCorporationOperators, opts ...grpc.CallOption) (*MsgFreezeCorporationOperatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFreezeCorporationOperatorsResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeCorporationOperators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeCorporationOperators(ctx context.Context, in *MsgUnfreezeCorporationOperators, opts ...grpc.CallOption) (*MsgUnfreezeCorporationOperatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnfreezeCorporationOperatorsResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeCorporationOperators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecAsCorporation(ctx context.Context, in *MsgExecAsCorporation, opts ...grpc.CallOption) (*MsgExecAsCorporationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgExecAsCorporationResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecAsCorporation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOperatorAuthorization(ctx context.Context, in *MsgAcceptOperatorAuthorization, opts ...grpc.CallOption) (*MsgAcceptOperatorAuthorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptOperatorAuthorizationResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptOperatorAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -90,6 +168,18 @@ type MsgServer interface {
 	GrantOperatorAuthorization(context.Context, *MsgGrantOperatorAuthorization) (*MsgGrantOperatorAuthorizationResponse, error)
 	// [MOD-DE-MSG-4] Revoke Operator Authorization
 	RevokeOperatorAuthorization(context.Context, *MsgRevokeOperatorAuthorization) (*MsgRevokeOperatorAuthorizationResponse, error)
+	// SetOperatorRole creates or updates a named operator role of a corporation
+	SetOperatorRole(context.Context, *MsgSetOperatorRole) (*MsgSetOperatorRoleResponse, error)
+	// DeleteOperatorRole deletes an operator role no authorization is granted from
+	DeleteOperatorRole(context.Context, *MsgDeleteOperatorRole) (*MsgDeleteOperatorRoleResponse, error)
+	// FreezeCorporationOperators suspends every operator authorization of a corporation
+	FreezeCorporationOperators(context.Context, *MsgFreezeCorporationOperators) (*MsgFreezeCorporationOperatorsResponse, error)
+	// UnfreezeCorporationOperators lifts a freeze, through a group proposal only
+	UnfreezeCorporationOperators(context.Context, *MsgUnfreezeCorporationOperators) (*MsgUnfreezeCorporationOperatorsResponse, error)
+	// ExecAsCorporation atomically executes messages of a corporation run by one operator
+	ExecAsCorporation(context.Context, *MsgExecAsCorporation) (*MsgExecAsCorporationResponse, error)
+	// AcceptOperatorAuthorization lets a grantee accept a pending operator authorization
+	AcceptOperatorAuthorization(context.Context, *MsgAcceptOperatorAuthorization) (*MsgAcceptOperatorAuthorizationResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -109,6 +199,24 @@ func (UnimplementedMsgServer) GrantOperatorAuthorization(context.Context, *MsgGr
 func (UnimplementedMsgServer) RevokeOperatorAuthorization(context.Context, *MsgRevokeOperatorAuthorization) (*MsgRevokeOperatorAuthorizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperatorAuthorization not implemented")
 }
+func (UnimplementedMsgServer) SetOperatorRole(context.Context, *MsgSetOperatorRole) (*MsgSetOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorRole not implemented")
+}
+func (UnimplementedMsgServer) DeleteOperatorRole(context.Context, *MsgDeleteOperatorRole) (*MsgDeleteOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOperatorRole not implemented")
+}
+func (UnimplementedMsgServer) FreezeCorporationOperators(context.Context, *MsgFreezeCorporationOperators) (*MsgFreezeCorporationOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeCorporationOperators not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeCorporationOperators(context.Context, *MsgUnfreezeCorporationOperators) (*MsgUnfreezeCorporationOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeCorporationOperators not implemented")
+}
+func (UnimplementedMsgServer) ExecAsCorporation(context.Context, *MsgExecAsCorporation) (*MsgExecAsCorporationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecAsCorporation not implemented")
+}
+func (UnimplementedMsgServer) AcceptOperatorAuthorization(context.Context, *MsgAcceptOperatorAuthorization) (*MsgAcceptOperatorAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOperatorAuthorization not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -184,6 +292,114 @@ func _Msg_RevokeOperatorAuthorization_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetOperatorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperatorRole(ctx, req.(*MsgSetOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteOperatorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOperatorRole(ctx, req.(*MsgDeleteOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeCorporationOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeCorporationOperators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeCorporationOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeCorporationOperators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeCorporationOperators(ctx, req.(*MsgFreezeCorporationOperators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeCorporationOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeCorporationOperators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeCorporationOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeCorporationOperators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeCorporationOperators(ctx, req.(*MsgUnfreezeCorporationOperators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecAsCorporation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecAsCorporation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecAsCorporation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecAsCorporation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecAsCorporation(ctx, req.(*MsgExecAsCorporation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOperatorAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOperatorAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptOperatorAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOperatorAuthorization(ctx, req.(*MsgAcceptOperatorAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +419,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeOperatorAuthorization",
 			Handler:    _Msg_RevokeOperatorAuthorization_Handler,
 		},
+		{
+			MethodName: "SetOperatorRole",
+			Handler:    _Msg_SetOperatorRole_Handler,
+		},
+		{
+			MethodName: "DeleteOperatorRole",
+			Handler:    _Msg_DeleteOperatorRole_Handler,
+		},
+		{
+			MethodName: "FreezeCorporationOperators",
+			Handler:    _Msg_FreezeCorporationOperators_Handler,
+		},
+		{
+			MethodName: "UnfreezeCorporationOperators",
+			Handler:    _Msg_UnfreezeCorporationOperators_Handler,
+		},
+		{
+			MethodName: "ExecAsCorporation",
+			Handler:    _Msg_ExecAsCorporation_Handler,
+		},
+		{
+			MethodName: "AcceptOperatorAuthorization",
+			Handler:    _Msg_AcceptOperatorAuthorization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/de/v1/tx.proto",
@@ -279,6 +279,9 @@ var (
 	fd_OperatorAuthorization_remaining_fee_spend protoreflect.FieldDescriptor
 	fd_OperatorAuthorization_expiration          protoreflect.FieldDescriptor
 	fd_OperatorAuthorization_period              protoreflect.FieldDescriptor
+	fd_OperatorAuthorization_role                protoreflect.FieldDescriptor
+	fd_OperatorAuthorization_pending             protoreflect.FieldDescriptor
+	fd_OperatorAuthorization_not_before          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -294,6 +297,9 @@ func init() {
 	fd_OperatorAuthorization_remaining_fee_spend = md_OperatorAuthorization.Fields().ByName("remaining_fee_spend")
 	fd_OperatorAuthorization_expiration = md_OperatorAuthorization.Fields().ByName("expiration")
 	fd_OperatorAuthorization_period = md_OperatorAuthorization.Fields().ByName("period")
+	fd_OperatorAuthorization_role = md_OperatorAuthorization.Fields().ByName("role")
+	fd_OperatorAuthorization_pending = md_OperatorAuthorization.Fields().ByName("pending")
+	fd_OperatorAuthorization_not_before = md_OperatorAuthorization.Fields().ByName("not_before")
 }
 
 var _ protoreflect.Message = (*fastReflection_OperatorAuthorization)(nil)
@@ -421,6 +427,24 @@ func (x *fastReflection_OperatorAuthorization) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if x.Role != "" {
+		value := protoreflect.ValueOfString(x.Role)
+		if !f(fd_OperatorAuthorization_role, value) {
+			return
+		}
+	}
+	if x.Pending != false {
+		value := protoreflect.ValueOfBool(x.Pending)
+		if !f(fd_OperatorAuthorization_pending, value) {
+			return
+		}
+	}
+	if x.NotBefore != nil {
+		value := protoreflect.ValueOfMessage(x.NotBefore.ProtoReflect())
+		if !f(fd_OperatorAuthorization_not_before, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -456,6 +480,12 @@ func (x *fastReflection_OperatorAuthorization) Has(fd protoreflect.FieldDescript
 		return x.Expiration != nil
 	case "verana.de.v1.OperatorAuthorization.period":
 		return x.Period != nil
+	case "verana.de.v1.OperatorAuthorization.role":
+		return x.Role != ""
+	case "verana.de.v1.OperatorAuthorization.pending":
+		return x.Pending != false
+	case "verana.de.v1.OperatorAuthorization.not_before":
+		return x.NotBefore != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorization"))
@@ -492,6 +522,12 @@ func (x *fastReflection_OperatorAuthorization) Clear(fd protoreflect.FieldDescri
 		x.Expiration = nil
 	case "verana.de.v1.OperatorAuthorization.period":
 		x.Period = nil
+	case "verana.de.v1.OperatorAuthorization.role":
+		x.Role = ""
+	case "verana.de.v1.OperatorAuthorization.pending":
+		x.Pending = false
+	case "verana.de.v1.OperatorAuthorization.not_before":
+		x.NotBefore = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorization"))
@@ -553,6 +589,15 @@ func (x *fastReflection_OperatorAuthorization) Get(descriptor protoreflect.Field
 	case "verana.de.v1.OperatorAuthorization.period":
 		value := x.Period
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.de.v1.OperatorAuthorization.role":
+		value := x.Role
+		return protoreflect.ValueOfString(value)
+	case "verana.de.v1.OperatorAuthorization.pending":
+		value := x.Pending
+		return protoreflect.ValueOfBool(value)
+	case "verana.de.v1.OperatorAuthorization.not_before":
+		value := x.NotBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorization"))
@@ -603,6 +648,12 @@ func (x *fastReflection_OperatorAuthorization) Set(fd protoreflect.FieldDescript
 		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.de.v1.OperatorAuthorization.period":
 		x.Period = value.Message().Interface().(*durationpb.Duration)
+	case "verana.de.v1.OperatorAuthorization.role":
+		x.Role = value.Interface().(string)
+	case "verana.de.v1.OperatorAuthorization.pending":
+		x.Pending = value.Bool()
+	case "verana.de.v1.OperatorAuthorization.not_before":
+		x.NotBefore = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorization"))
@@ -663,12 +714,21 @@ func (x *fastReflection_OperatorAuthorization) Mutable(fd protoreflect.FieldDesc
 			x.Period = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+	case "verana.de.v1.OperatorAuthorization.not_before":
+		if x.NotBefore == nil {
+			x.NotBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.NotBefore.ProtoReflect())
 	case "verana.de.v1.OperatorAuthorization.id":
 		panic(fmt.Errorf("field id of message verana.de.v1.OperatorAuthorization is not mutable"))
 	case "verana.de.v1.OperatorAuthorization.corporation_id":
 		panic(fmt.Errorf("field corporation_id of message verana.de.v1.OperatorAuthorization is not mutable"))
 	case "verana.de.v1.OperatorAuthorization.operator":
 		panic(fmt.Errorf("field operator of message verana.de.v1.OperatorAuthorization is not mutable"))
+	case "verana.de.v1.OperatorAuthorization.role":
+		panic(fmt.Errorf("field role of message verana.de.v1.OperatorAuthorization is not mutable"))
+	case "verana.de.v1.OperatorAuthorization.pending":
+		panic(fmt.Errorf("field pending of message verana.de.v1.OperatorAuthorization is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorization"))
@@ -709,6 +769,13 @@ func (x *fastReflection_OperatorAuthorization) NewField(fd protoreflect.FieldDes
 	case "verana.de.v1.OperatorAuthorization.period":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.de.v1.OperatorAuthorization.role":
+		return protoreflect.ValueOfString("")
+	case "verana.de.v1.OperatorAuthorization.pending":
+		return protoreflect.ValueOfBool(false)
+	case "verana.de.v1.OperatorAuthorization.not_before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorization"))
@@ -826,6 +893,17 @@ func (x *fastReflection_OperatorAuthorization) ProtoMethods() *protoiface.Method
 			l = options.Size(x.Period)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Role)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pending {
+			n += 2
+		}
+		if x.NotBefore != nil {
+			l = options.Size(x.NotBefore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -855,6 +933,37 @@ func (x *fastReflection_OperatorAuthorization) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NotBefore != nil {
+			encoded, err := options.Marshal(x.NotBefore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.Pending {
+			i--
+			if x.Pending {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Role) > 0 {
+			i -= len(x.Role)
+			copy(dAtA[i:], x.Role)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Role)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if x.Period != nil {
 			encoded, err := options.Marshal(x.Period)
 			if err != nil {
@@ -1332,6 +1441,94 @@ func (x *fastReflection_OperatorAuthorization) ProtoMethods() *protoiface.Method
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Role = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Pending = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NotBefore == nil {
+					x.NotBefore = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NotBefore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1367,81 +1564,184 @@ func (x *fastReflection_OperatorAuthorization) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_OperatorAuthorizationUsage_2_list)(nil)
+var _ protoreflect.List = (*_OperatorRole_3_list)(nil)
 
-type _OperatorAuthorizationUsage_2_list struct {
+type _OperatorRole_3_list struct {
+	list *[]string
+}
+
+func (x *_OperatorRole_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OperatorRole_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OperatorRole_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OperatorRole_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OperatorRole_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OperatorRole at list field MsgTypes as it is not of Message kind"))
+}
+
+func (x *_OperatorRole_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OperatorRole_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OperatorRole_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OperatorRole_4_list)(nil)
+
+type _OperatorRole_4_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) Len() int {
+func (x *_OperatorRole_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) Get(i int) protoreflect.Value {
+func (x *_OperatorRole_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) Set(i int, value protoreflect.Value) {
+func (x *_OperatorRole_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) Append(value protoreflect.Value) {
+func (x *_OperatorRole_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) AppendMutable() protoreflect.Value {
+func (x *_OperatorRole_4_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) Truncate(n int) {
+func (x *_OperatorRole_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) NewElement() protoreflect.Value {
+func (x *_OperatorRole_4_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_OperatorAuthorizationUsage_2_list) IsValid() bool {
+func (x *_OperatorRole_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OperatorRole_5_list)(nil)
+
+type _OperatorRole_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_OperatorRole_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OperatorRole_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OperatorRole_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OperatorRole_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OperatorRole_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OperatorRole_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OperatorRole_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OperatorRole_5_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_OperatorAuthorizationUsage                           protoreflect.MessageDescriptor
-	fd_OperatorAuthorizationUsage_operator_authorization_id protoreflect.FieldDescriptor
-	fd_OperatorAuthorizationUsage_remaining                 protoreflect.FieldDescriptor
-	fd_OperatorAuthorizationUsage_last_reset                protoreflect.FieldDescriptor
+	md_OperatorRole                 protoreflect.MessageDescriptor
+	fd_OperatorRole_corporation_id  protoreflect.FieldDescriptor
+	fd_OperatorRole_name            protoreflect.FieldDescriptor
+	fd_OperatorRole_msg_types       protoreflect.FieldDescriptor
+	fd_OperatorRole_spend_limit     protoreflect.FieldDescriptor
+	fd_OperatorRole_fee_spend_limit protoreflect.FieldDescriptor
+	fd_OperatorRole_period          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_de_v1_types_proto_init()
-	md_OperatorAuthorizationUsage = File_verana_de_v1_types_proto.Messages().ByName("OperatorAuthorizationUsage")
-	fd_OperatorAuthorizationUsage_operator_authorization_id = md_OperatorAuthorizationUsage.Fields().ByName("operator_authorization_id")
-	fd_OperatorAuthorizationUsage_remaining = md_OperatorAuthorizationUsage.Fields().ByName("remaining")
-	fd_OperatorAuthorizationUsage_last_reset = md_OperatorAuthorizationUsage.Fields().ByName("last_reset")
+	md_OperatorRole = File_verana_de_v1_types_proto.Messages().ByName("OperatorRole")
+	fd_OperatorRole_corporation_id = md_OperatorRole.Fields().ByName("corporation_id")
+	fd_OperatorRole_name = md_OperatorRole.Fields().ByName("name")
+	fd_OperatorRole_msg_types = md_OperatorRole.Fields().ByName("msg_types")
+	fd_OperatorRole_spend_limit = md_OperatorRole.Fields().ByName("spend_limit")
+	fd_OperatorRole_fee_spend_limit = md_OperatorRole.Fields().ByName("fee_spend_limit")
+	fd_OperatorRole_period = md_OperatorRole.Fields().ByName("period")
 }
 
-var _ protoreflect.Message = (*fastReflection_OperatorAuthorizationUsage)(nil)
+var _ protoreflect.Message = (*fastReflection_OperatorRole)(nil)
 
-type fastReflection_OperatorAuthorizationUsage OperatorAuthorizationUsage
+type fastReflection_OperatorRole OperatorRole
 
-func (x *OperatorAuthorizationUsage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OperatorAuthorizationUsage)(x)
+func (x *OperatorRole) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OperatorRole)(x)
 }
 
-func (x *OperatorAuthorizationUsage) slowProtoReflect() protoreflect.Message {
+func (x *OperatorRole) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_de_v1_types_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1453,43 +1753,43 @@ func (x *OperatorAuthorizationUsage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OperatorAuthorizationUsage_messageType fastReflection_OperatorAuthorizationUsage_messageType
-var _ protoreflect.MessageType = fastReflection_OperatorAuthorizationUsage_messageType{}
+var _fastReflection_OperatorRole_messageType fastReflection_OperatorRole_messageType
+var _ protoreflect.MessageType = fastReflection_OperatorRole_messageType{}
 
-type fastReflection_OperatorAuthorizationUsage_messageType struct{}
+type fastReflection_OperatorRole_messageType struct{}
 
-func (x fastReflection_OperatorAuthorizationUsage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OperatorAuthorizationUsage)(nil)
+func (x fastReflection_OperatorRole_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OperatorRole)(nil)
 }
-func (x fastReflection_OperatorAuthorizationUsage_messageType) New() protoreflect.Message {
-	return new(fastReflection_OperatorAuthorizationUsage)
+func (x fastReflection_OperatorRole_messageType) New() protoreflect.Message {
+	return new(fastReflection_OperatorRole)
 }
-func (x fastReflection_OperatorAuthorizationUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OperatorAuthorizationUsage
+func (x fastReflection_OperatorRole_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorRole
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OperatorAuthorizationUsage) Descriptor() protoreflect.MessageDescriptor {
-	return md_OperatorAuthorizationUsage
+func (x *fastReflection_OperatorRole) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorRole
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OperatorAuthorizationUsage) Type() protoreflect.MessageType {
-	return _fastReflection_OperatorAuthorizationUsage_messageType
+func (x *fastReflection_OperatorRole) Type() protoreflect.MessageType {
+	return _fastReflection_OperatorRole_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OperatorAuthorizationUsage) New() protoreflect.Message {
-	return new(fastReflection_OperatorAuthorizationUsage)
+func (x *fastReflection_OperatorRole) New() protoreflect.Message {
+	return new(fastReflection_OperatorRole)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OperatorAuthorizationUsage) Interface() protoreflect.ProtoMessage {
-	return (*OperatorAuthorizationUsage)(x)
+func (x *fastReflection_OperatorRole) Interface() protoreflect.ProtoMessage {
+	return (*OperatorRole)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1497,31 +1797,49 @@ func (x *fastReflection_OperatorAuthorizationUsage) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OperatorAuthorizationUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OperatorAuthorizationId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OperatorAuthorizationId)
-		if !f(fd_OperatorAuthorizationUsage_operator_authorization_id, value) {
+func (x *fastReflection_OperatorRole) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_OperatorRole_corporation_id, value) {
 			return
 		}
 	}
-	if len(x.Remaining) != 0 {
-		value := protoreflect.ValueOfList(&_OperatorAuthorizationUsage_2_list{list: &x.Remaining})
-		if !f(fd_OperatorAuthorizationUsage_remaining, value) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_OperatorRole_name, value) {
 			return
 		}
 	}
-	if x.LastReset != nil {
-		value := protoreflect.ValueOfMessage(x.LastReset.ProtoReflect())
-		if !f(fd_OperatorAuthorizationUsage_last_reset, value) {
+	if len(x.MsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_OperatorRole_3_list{list: &x.MsgTypes})
+		if !f(fd_OperatorRole_msg_types, value) {
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
+	if len(x.SpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_OperatorRole_4_list{list: &x.SpendLimit})
+		if !f(fd_OperatorRole_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.FeeSpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_OperatorRole_5_list{list: &x.FeeSpendLimit})
+		if !f(fd_OperatorRole_fee_spend_limit, value) {
+			return
+		}
+	}
+	if x.Period != nil {
+		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+		if !f(fd_OperatorRole_period, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
 // was explicitly populated with the default value. Singular message fields,
 // member fields of a oneof, and proto2 scalar fields are nullable. Such
 // fields are populated only if explicitly set.
@@ -1529,19 +1847,25 @@ func (x *fastReflection_OperatorAuthorizationUsage) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OperatorAuthorizationUsage) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OperatorRole) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
-		return x.OperatorAuthorizationId != uint64(0)
-	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
-		return len(x.Remaining) != 0
-	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
-		return x.LastReset != nil
+	case "verana.de.v1.OperatorRole.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.de.v1.OperatorRole.name":
+		return x.Name != ""
+	case "verana.de.v1.OperatorRole.msg_types":
+		return len(x.MsgTypes) != 0
+	case "verana.de.v1.OperatorRole.spend_limit":
+		return len(x.SpendLimit) != 0
+	case "verana.de.v1.OperatorRole.fee_spend_limit":
+		return len(x.FeeSpendLimit) != 0
+	case "verana.de.v1.OperatorRole.period":
+		return x.Period != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorRole"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorRole does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1551,19 +1875,25 @@ func (x *fastReflection_OperatorAuthorizationUsage) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OperatorAuthorizationUsage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OperatorRole) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
-		x.OperatorAuthorizationId = uint64(0)
-	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
-		x.Remaining = nil
-	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
-		x.LastReset = nil
+	case "verana.de.v1.OperatorRole.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.de.v1.OperatorRole.name":
+		x.Name = ""
+	case "verana.de.v1.OperatorRole.msg_types":
+		x.MsgTypes = nil
+	case "verana.de.v1.OperatorRole.spend_limit":
+		x.SpendLimit = nil
+	case "verana.de.v1.OperatorRole.fee_spend_limit":
+		x.FeeSpendLimit = nil
+	case "verana.de.v1.OperatorRole.period":
+		x.Period = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorRole"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorRole does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1573,25 +1903,40 @@ func (x *fastReflection_OperatorAuthorizationUsage) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OperatorAuthorizationUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OperatorRole) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
-		value := x.OperatorAuthorizationId
+	case "verana.de.v1.OperatorRole.corporation_id":
+		value := x.CorporationId
 		return protoreflect.ValueOfUint64(value)
-	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
-		if len(x.Remaining) == 0 {
-			return protoreflect.ValueOfList(&_OperatorAuthorizationUsage_2_list{})
+	case "verana.de.v1.OperatorRole.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "verana.de.v1.OperatorRole.msg_types":
+		if len(x.MsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_OperatorRole_3_list{})
 		}
-		listValue := &_OperatorAuthorizationUsage_2_list{list: &x.Remaining}
+		listValue := &_OperatorRole_3_list{list: &x.MsgTypes}
 		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
-		value := x.LastReset
+	case "verana.de.v1.OperatorRole.spend_limit":
+		if len(x.SpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_OperatorRole_4_list{})
+		}
+		listValue := &_OperatorRole_4_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.OperatorRole.fee_spend_limit":
+		if len(x.FeeSpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_OperatorRole_5_list{})
+		}
+		listValue := &_OperatorRole_5_list{list: &x.FeeSpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.OperatorRole.period":
+		value := x.Period
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorRole"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorRole does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1605,21 +1950,31 @@ func (x *fastReflection_OperatorAuthorizationUsage) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OperatorAuthorizationUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OperatorRole) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
-		x.OperatorAuthorizationId = value.Uint()
-	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
+	case "verana.de.v1.OperatorRole.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.de.v1.OperatorRole.name":
+		x.Name = value.Interface().(string)
+	case "verana.de.v1.OperatorRole.msg_types":
 		lv := value.List()
-		clv := lv.(*_OperatorAuthorizationUsage_2_list)
-		x.Remaining = *clv.list
-	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
-		x.LastReset = value.Message().Interface().(*timestamppb.Timestamp)
+		clv := lv.(*_OperatorRole_3_list)
+		x.MsgTypes = *clv.list
+	case "verana.de.v1.OperatorRole.spend_limit":
+		lv := value.List()
+		clv := lv.(*_OperatorRole_4_list)
+		x.SpendLimit = *clv.list
+	case "verana.de.v1.OperatorRole.fee_spend_limit":
+		lv := value.List()
+		clv := lv.(*_OperatorRole_5_list)
+		x.FeeSpendLimit = *clv.list
+	case "verana.de.v1.OperatorRole.period":
+		x.Period = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorRole"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorRole does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1633,57 +1988,79 @@ func (x *fastReflection_OperatorAuthorizationUsage) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OperatorAuthorizationUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OperatorRole) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
-		if x.Remaining == nil {
-			x.Remaining = []*v1beta1.Coin{}
+	case "verana.de.v1.OperatorRole.msg_types":
+		if x.MsgTypes == nil {
+			x.MsgTypes = []string{}
 		}
-		value := &_OperatorAuthorizationUsage_2_list{list: &x.Remaining}
+		value := &_OperatorRole_3_list{list: &x.MsgTypes}
 		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
-		if x.LastReset == nil {
-			x.LastReset = new(timestamppb.Timestamp)
+	case "verana.de.v1.OperatorRole.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.LastReset.ProtoReflect())
-	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
-		panic(fmt.Errorf("field operator_authorization_id of message verana.de.v1.OperatorAuthorizationUsage is not mutable"))
+		value := &_OperatorRole_4_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.OperatorRole.fee_spend_limit":
+		if x.FeeSpendLimit == nil {
+			x.FeeSpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_OperatorRole_5_list{list: &x.FeeSpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.OperatorRole.period":
+		if x.Period == nil {
+			x.Period = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+	case "verana.de.v1.OperatorRole.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.de.v1.OperatorRole is not mutable"))
+	case "verana.de.v1.OperatorRole.name":
+		panic(fmt.Errorf("field name of message verana.de.v1.OperatorRole is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorRole"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorRole does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OperatorAuthorizationUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OperatorRole) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
+	case "verana.de.v1.OperatorRole.corporation_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
+	case "verana.de.v1.OperatorRole.name":
+		return protoreflect.ValueOfString("")
+	case "verana.de.v1.OperatorRole.msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OperatorRole_3_list{list: &list})
+	case "verana.de.v1.OperatorRole.spend_limit":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_OperatorAuthorizationUsage_2_list{list: &list})
-	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
-		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfList(&_OperatorRole_4_list{list: &list})
+	case "verana.de.v1.OperatorRole.fee_spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_OperatorRole_5_list{list: &list})
+	case "verana.de.v1.OperatorRole.period":
+		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorRole"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorRole does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OperatorAuthorizationUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OperatorRole) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.OperatorAuthorizationUsage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.OperatorRole", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1691,7 +2068,7 @@ func (x *fastReflection_OperatorAuthorizationUsage) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OperatorAuthorizationUsage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OperatorRole) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1702,7 +2079,7 @@ func (x *fastReflection_OperatorAuthorizationUsage) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OperatorAuthorizationUsage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OperatorRole) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1714,7 +2091,7 @@ func (x *fastReflection_OperatorAuthorizationUsage) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OperatorAuthorizationUsage) IsValid() bool {
+func (x *fastReflection_OperatorRole) IsValid() bool {
 	return x != nil
 }
 
@@ -1724,9 +2101,9 @@ func (x *fastReflection_OperatorAuthorizationUsage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OperatorRole) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OperatorAuthorizationUsage)
+		x := input.Message.Interface().(*OperatorRole)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1738,17 +2115,33 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.OperatorAuthorizationId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OperatorAuthorizationId))
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
 		}
-		if len(x.Remaining) > 0 {
-			for _, e := range x.Remaining {
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgTypes) > 0 {
+			for _, s := range x.MsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for _, e := range x.SpendLimit {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.LastReset != nil {
-			l = options.Size(x.LastReset)
+		if len(x.FeeSpendLimit) > 0 {
+			for _, e := range x.FeeSpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Period != nil {
+			l = options.Size(x.Period)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1761,7 +2154,7 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OperatorAuthorizationUsage)
+		x := input.Message.Interface().(*OperatorRole)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1780,8 +2173,8 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastReset != nil {
-			encoded, err := options.Marshal(x.LastReset)
+		if x.Period != nil {
+			encoded, err := options.Marshal(x.Period)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1792,11 +2185,11 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x32
 		}
-		if len(x.Remaining) > 0 {
-			for iNdEx := len(x.Remaining) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Remaining[iNdEx])
+		if len(x.FeeSpendLimit) > 0 {
+			for iNdEx := len(x.FeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSpendLimit[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1807,11 +2200,43 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x2a
 			}
 		}
-		if x.OperatorAuthorizationId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorAuthorizationId))
+		if len(x.SpendLimit) > 0 {
+			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.MsgTypes) > 0 {
+			for iNdEx := len(x.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypes[iNdEx])
+				copy(dAtA[i:], x.MsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -1826,7 +2251,7 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OperatorAuthorizationUsage)
+		x := input.Message.Interface().(*OperatorRole)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1858,17 +2283,17 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorAuthorizationUsage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorRole: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorAuthorizationUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorRole: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorizationId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 				}
-				x.OperatorAuthorizationId = 0
+				x.CorporationId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1878,16 +2303,16 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OperatorAuthorizationId |= uint64(b&0x7F) << shift
+					x.CorporationId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1897,31 +2322,29 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Remaining = append(x.Remaining, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Remaining[len(x.Remaining)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastReset", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1931,36 +2354,136 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.LastReset == nil {
-					x.LastReset = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastReset); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.MsgTypes = append(x.MsgTypes, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSpendLimit = append(x.FeeSpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSpendLimit[len(x.FeeSpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Period == nil {
+					x.Period = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -1988,234 +2511,129 @@ func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_FeeGrant_3_list)(nil)
-
-type _FeeGrant_3_list struct {
-	list *[]string
-}
-
-func (x *_FeeGrant_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_FeeGrant_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_FeeGrant_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_FeeGrant_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_FeeGrant_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message FeeGrant at list field MsgTypes as it is not of Message kind"))
-}
-
-func (x *_FeeGrant_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_FeeGrant_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_FeeGrant_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_FeeGrant_4_list)(nil)
+var _ protoreflect.List = (*_OperatorAuthorizationUsage_2_list)(nil)
 
-type _FeeGrant_4_list struct {
+type _OperatorAuthorizationUsage_2_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_FeeGrant_4_list) Len() int {
+func (x *_OperatorAuthorizationUsage_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_FeeGrant_4_list) Get(i int) protoreflect.Value {
+func (x *_OperatorAuthorizationUsage_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_FeeGrant_4_list) Set(i int, value protoreflect.Value) {
+func (x *_OperatorAuthorizationUsage_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_FeeGrant_4_list) Append(value protoreflect.Value) {
+func (x *_OperatorAuthorizationUsage_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_FeeGrant_4_list) AppendMutable() protoreflect.Value {
+func (x *_OperatorAuthorizationUsage_2_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_FeeGrant_4_list) Truncate(n int) {
+func (x *_OperatorAuthorizationUsage_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_FeeGrant_4_list) NewElement() protoreflect.Value {
+func (x *_OperatorAuthorizationUsage_2_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_FeeGrant_4_list) IsValid() bool {
+func (x *_OperatorAuthorizationUsage_2_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_FeeGrant_5_list)(nil)
+var (
+	md_OperatorAuthorizationUsage                           protoreflect.MessageDescriptor
+	fd_OperatorAuthorizationUsage_operator_authorization_id protoreflect.FieldDescriptor
+	fd_OperatorAuthorizationUsage_remaining                 protoreflect.FieldDescriptor
+	fd_OperatorAuthorizationUsage_last_reset                protoreflect.FieldDescriptor
+)
 
-type _FeeGrant_5_list struct {
-	list *[]*v1beta1.Coin
+func init() {
+	file_verana_de_v1_types_proto_init()
+	md_OperatorAuthorizationUsage = File_verana_de_v1_types_proto.Messages().ByName("OperatorAuthorizationUsage")
+	fd_OperatorAuthorizationUsage_operator_authorization_id = md_OperatorAuthorizationUsage.Fields().ByName("operator_authorization_id")
+	fd_OperatorAuthorizationUsage_remaining = md_OperatorAuthorizationUsage.Fields().ByName("remaining")
+	fd_OperatorAuthorizationUsage_last_reset = md_OperatorAuthorizationUsage.Fields().ByName("last_reset")
 }
 
-func (x *_FeeGrant_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_OperatorAuthorizationUsage)(nil)
 
-func (x *_FeeGrant_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_OperatorAuthorizationUsage OperatorAuthorizationUsage
 
-func (x *_FeeGrant_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
+func (x *OperatorAuthorizationUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OperatorAuthorizationUsage)(x)
 }
 
-func (x *_FeeGrant_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
+func (x *OperatorAuthorizationUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_de_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_FeeGrant_5_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+var _fastReflection_OperatorAuthorizationUsage_messageType fastReflection_OperatorAuthorizationUsage_messageType
+var _ protoreflect.MessageType = fastReflection_OperatorAuthorizationUsage_messageType{}
 
-func (x *_FeeGrant_5_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_OperatorAuthorizationUsage_messageType struct{}
 
-func (x *_FeeGrant_5_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x fastReflection_OperatorAuthorizationUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OperatorAuthorizationUsage)(nil)
+}
+func (x fastReflection_OperatorAuthorizationUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_OperatorAuthorizationUsage)
+}
+func (x fastReflection_OperatorAuthorizationUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorAuthorizationUsage
 }
 
-func (x *_FeeGrant_5_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_FeeGrant                        protoreflect.MessageDescriptor
-	fd_FeeGrant_grantor_corporation_id protoreflect.FieldDescriptor
-	fd_FeeGrant_grantee                protoreflect.FieldDescriptor
-	fd_FeeGrant_msg_types              protoreflect.FieldDescriptor
-	fd_FeeGrant_spend_limit            protoreflect.FieldDescriptor
-	fd_FeeGrant_remaining_spend        protoreflect.FieldDescriptor
-	fd_FeeGrant_expiration             protoreflect.FieldDescriptor
-	fd_FeeGrant_period                 protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_verana_de_v1_types_proto_init()
-	md_FeeGrant = File_verana_de_v1_types_proto.Messages().ByName("FeeGrant")
-	fd_FeeGrant_grantor_corporation_id = md_FeeGrant.Fields().ByName("grantor_corporation_id")
-	fd_FeeGrant_grantee = md_FeeGrant.Fields().ByName("grantee")
-	fd_FeeGrant_msg_types = md_FeeGrant.Fields().ByName("msg_types")
-	fd_FeeGrant_spend_limit = md_FeeGrant.Fields().ByName("spend_limit")
-	fd_FeeGrant_remaining_spend = md_FeeGrant.Fields().ByName("remaining_spend")
-	fd_FeeGrant_expiration = md_FeeGrant.Fields().ByName("expiration")
-	fd_FeeGrant_period = md_FeeGrant.Fields().ByName("period")
-}
-
-var _ protoreflect.Message = (*fastReflection_FeeGrant)(nil)
-
-type fastReflection_FeeGrant FeeGrant
-
-func (x *FeeGrant) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_FeeGrant)(x)
-}
-
-func (x *FeeGrant) slowProtoReflect() protoreflect.Message {
-	mi := &file_verana_de_v1_types_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_FeeGrant_messageType fastReflection_FeeGrant_messageType
-var _ protoreflect.MessageType = fastReflection_FeeGrant_messageType{}
-
-type fastReflection_FeeGrant_messageType struct{}
-
-func (x fastReflection_FeeGrant_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_FeeGrant)(nil)
-}
-func (x fastReflection_FeeGrant_messageType) New() protoreflect.Message {
-	return new(fastReflection_FeeGrant)
-}
-func (x fastReflection_FeeGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeeGrant
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_FeeGrant) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeeGrant
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OperatorAuthorizationUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorAuthorizationUsage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_FeeGrant) Type() protoreflect.MessageType {
-	return _fastReflection_FeeGrant_messageType
+func (x *fastReflection_OperatorAuthorizationUsage) Type() protoreflect.MessageType {
+	return _fastReflection_OperatorAuthorizationUsage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_FeeGrant) New() protoreflect.Message {
-	return new(fastReflection_FeeGrant)
+func (x *fastReflection_OperatorAuthorizationUsage) New() protoreflect.Message {
+	return new(fastReflection_OperatorAuthorizationUsage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_FeeGrant) Interface() protoreflect.ProtoMessage {
-	return (*FeeGrant)(x)
+func (x *fastReflection_OperatorAuthorizationUsage) Interface() protoreflect.ProtoMessage {
+	return (*OperatorAuthorizationUsage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2223,46 +2641,22 @@ func (x *fastReflection_FeeGrant) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_FeeGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GrantorCorporationId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GrantorCorporationId)
-		if !f(fd_FeeGrant_grantor_corporation_id, value) {
-			return
-		}
-	}
-	if x.Grantee != "" {
-		value := protoreflect.ValueOfString(x.Grantee)
-		if !f(fd_FeeGrant_grantee, value) {
-			return
-		}
-	}
-	if len(x.MsgTypes) != 0 {
-		value := protoreflect.ValueOfList(&_FeeGrant_3_list{list: &x.MsgTypes})
-		if !f(fd_FeeGrant_msg_types, value) {
-			return
-		}
-	}
-	if len(x.SpendLimit) != 0 {
-		value := protoreflect.ValueOfList(&_FeeGrant_4_list{list: &x.SpendLimit})
-		if !f(fd_FeeGrant_spend_limit, value) {
-			return
-		}
-	}
-	if len(x.RemainingSpend) != 0 {
-		value := protoreflect.ValueOfList(&_FeeGrant_5_list{list: &x.RemainingSpend})
-		if !f(fd_FeeGrant_remaining_spend, value) {
+func (x *fastReflection_OperatorAuthorizationUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAuthorizationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OperatorAuthorizationId)
+		if !f(fd_OperatorAuthorizationUsage_operator_authorization_id, value) {
 			return
 		}
 	}
-	if x.Expiration != nil {
-		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-		if !f(fd_FeeGrant_expiration, value) {
+	if len(x.Remaining) != 0 {
+		value := protoreflect.ValueOfList(&_OperatorAuthorizationUsage_2_list{list: &x.Remaining})
+		if !f(fd_OperatorAuthorizationUsage_remaining, value) {
 			return
 		}
 	}
-	if x.Period != nil {
-		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-		if !f(fd_FeeGrant_period, value) {
+	if x.LastReset != nil {
+		value := protoreflect.ValueOfMessage(x.LastReset.ProtoReflect())
+		if !f(fd_OperatorAuthorizationUsage_last_reset, value) {
 			return
 		}
 	}
@@ -2279,27 +2673,19 @@ func (x *fastReflection_FeeGrant) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_FeeGrant) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OperatorAuthorizationUsage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.de.v1.FeeGrant.grantor_corporation_id":
-		return x.GrantorCorporationId != uint64(0)
-	case "verana.de.v1.FeeGrant.grantee":
-		return x.Grantee != ""
-	case "verana.de.v1.FeeGrant.msg_types":
-		return len(x.MsgTypes) != 0
-	case "verana.de.v1.FeeGrant.spend_limit":
-		return len(x.SpendLimit) != 0
-	case "verana.de.v1.FeeGrant.remaining_spend":
-		return len(x.RemainingSpend) != 0
-	case "verana.de.v1.FeeGrant.expiration":
-		return x.Expiration != nil
-	case "verana.de.v1.FeeGrant.period":
-		return x.Period != nil
+	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
+		return x.OperatorAuthorizationId != uint64(0)
+	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
+		return len(x.Remaining) != 0
+	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
+		return x.LastReset != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2309,27 +2695,19 @@ func (x *fastReflection_FeeGrant) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeGrant) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OperatorAuthorizationUsage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.de.v1.FeeGrant.grantor_corporation_id":
-		x.GrantorCorporationId = uint64(0)
-	case "verana.de.v1.FeeGrant.grantee":
-		x.Grantee = ""
-	case "verana.de.v1.FeeGrant.msg_types":
-		x.MsgTypes = nil
-	case "verana.de.v1.FeeGrant.spend_limit":
-		x.SpendLimit = nil
-	case "verana.de.v1.FeeGrant.remaining_spend":
-		x.RemainingSpend = nil
-	case "verana.de.v1.FeeGrant.expiration":
-		x.Expiration = nil
-	case "verana.de.v1.FeeGrant.period":
-		x.Period = nil
+	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
+		x.OperatorAuthorizationId = uint64(0)
+	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
+		x.Remaining = nil
+	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
+		x.LastReset = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2339,43 +2717,25 @@ func (x *fastReflection_FeeGrant) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FeeGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OperatorAuthorizationUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.de.v1.FeeGrant.grantor_corporation_id":
-		value := x.GrantorCorporationId
+	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
+		value := x.OperatorAuthorizationId
 		return protoreflect.ValueOfUint64(value)
-	case "verana.de.v1.FeeGrant.grantee":
-		value := x.Grantee
-		return protoreflect.ValueOfString(value)
-	case "verana.de.v1.FeeGrant.msg_types":
-		if len(x.MsgTypes) == 0 {
-			return protoreflect.ValueOfList(&_FeeGrant_3_list{})
-		}
-		listValue := &_FeeGrant_3_list{list: &x.MsgTypes}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.FeeGrant.spend_limit":
-		if len(x.SpendLimit) == 0 {
-			return protoreflect.ValueOfList(&_FeeGrant_4_list{})
-		}
-		listValue := &_FeeGrant_4_list{list: &x.SpendLimit}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.FeeGrant.remaining_spend":
-		if len(x.RemainingSpend) == 0 {
-			return protoreflect.ValueOfList(&_FeeGrant_5_list{})
+	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
+		if len(x.Remaining) == 0 {
+			return protoreflect.ValueOfList(&_OperatorAuthorizationUsage_2_list{})
 		}
-		listValue := &_FeeGrant_5_list{list: &x.RemainingSpend}
+		listValue := &_OperatorAuthorizationUsage_2_list{list: &x.Remaining}
 		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.FeeGrant.expiration":
-		value := x.Expiration
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "verana.de.v1.FeeGrant.period":
-		value := x.Period
+	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
+		value := x.LastReset
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2389,33 +2749,21 @@ func (x *fastReflection_FeeGrant) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OperatorAuthorizationUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.de.v1.FeeGrant.grantor_corporation_id":
-		x.GrantorCorporationId = value.Uint()
-	case "verana.de.v1.FeeGrant.grantee":
-		x.Grantee = value.Interface().(string)
-	case "verana.de.v1.FeeGrant.msg_types":
-		lv := value.List()
-		clv := lv.(*_FeeGrant_3_list)
-		x.MsgTypes = *clv.list
-	case "verana.de.v1.FeeGrant.spend_limit":
-		lv := value.List()
-		clv := lv.(*_FeeGrant_4_list)
-		x.SpendLimit = *clv.list
-	case "verana.de.v1.FeeGrant.remaining_spend":
+	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
+		x.OperatorAuthorizationId = value.Uint()
+	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
 		lv := value.List()
-		clv := lv.(*_FeeGrant_5_list)
-		x.RemainingSpend = *clv.list
-	case "verana.de.v1.FeeGrant.expiration":
-		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
-	case "verana.de.v1.FeeGrant.period":
-		x.Period = value.Message().Interface().(*durationpb.Duration)
+		clv := lv.(*_OperatorAuthorizationUsage_2_list)
+		x.Remaining = *clv.list
+	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
+		x.LastReset = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2429,87 +2777,57 @@ func (x *fastReflection_FeeGrant) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OperatorAuthorizationUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.FeeGrant.msg_types":
-		if x.MsgTypes == nil {
-			x.MsgTypes = []string{}
+	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
+		if x.Remaining == nil {
+			x.Remaining = []*v1beta1.Coin{}
 		}
-		value := &_FeeGrant_3_list{list: &x.MsgTypes}
+		value := &_OperatorAuthorizationUsage_2_list{list: &x.Remaining}
 		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.FeeGrant.spend_limit":
-		if x.SpendLimit == nil {
-			x.SpendLimit = []*v1beta1.Coin{}
+	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
+		if x.LastReset == nil {
+			x.LastReset = new(timestamppb.Timestamp)
 		}
-		value := &_FeeGrant_4_list{list: &x.SpendLimit}
-		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.FeeGrant.remaining_spend":
-		if x.RemainingSpend == nil {
-			x.RemainingSpend = []*v1beta1.Coin{}
-		}
-		value := &_FeeGrant_5_list{list: &x.RemainingSpend}
-		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.FeeGrant.expiration":
-		if x.Expiration == nil {
-			x.Expiration = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-	case "verana.de.v1.FeeGrant.period":
-		if x.Period == nil {
-			x.Period = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-	case "verana.de.v1.FeeGrant.grantor_corporation_id":
-		panic(fmt.Errorf("field grantor_corporation_id of message verana.de.v1.FeeGrant is not mutable"))
-	case "verana.de.v1.FeeGrant.grantee":
-		panic(fmt.Errorf("field grantee of message verana.de.v1.FeeGrant is not mutable"))
+		return protoreflect.ValueOfMessage(x.LastReset.ProtoReflect())
+	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
+		panic(fmt.Errorf("field operator_authorization_id of message verana.de.v1.OperatorAuthorizationUsage is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FeeGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OperatorAuthorizationUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.FeeGrant.grantor_corporation_id":
+	case "verana.de.v1.OperatorAuthorizationUsage.operator_authorization_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.de.v1.FeeGrant.grantee":
-		return protoreflect.ValueOfString("")
-	case "verana.de.v1.FeeGrant.msg_types":
-		list := []string{}
-		return protoreflect.ValueOfList(&_FeeGrant_3_list{list: &list})
-	case "verana.de.v1.FeeGrant.spend_limit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_FeeGrant_4_list{list: &list})
-	case "verana.de.v1.FeeGrant.remaining_spend":
+	case "verana.de.v1.OperatorAuthorizationUsage.remaining":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_FeeGrant_5_list{list: &list})
-	case "verana.de.v1.FeeGrant.expiration":
+		return protoreflect.ValueOfList(&_OperatorAuthorizationUsage_2_list{list: &list})
+	case "verana.de.v1.OperatorAuthorizationUsage.last_reset":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "verana.de.v1.FeeGrant.period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.OperatorAuthorizationUsage"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.OperatorAuthorizationUsage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FeeGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OperatorAuthorizationUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.FeeGrant", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.OperatorAuthorizationUsage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2517,7 +2835,7 @@ func (x *fastReflection_FeeGrant) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FeeGrant) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OperatorAuthorizationUsage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2528,7 +2846,7 @@ func (x *fastReflection_FeeGrant) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeeGrant) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OperatorAuthorizationUsage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2540,7 +2858,7 @@ func (x *fastReflection_FeeGrant) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FeeGrant) IsValid() bool {
+func (x *fastReflection_OperatorAuthorizationUsage) IsValid() bool {
 	return x != nil
 }
 
@@ -2550,9 +2868,9 @@ func (x *fastReflection_FeeGrant) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OperatorAuthorizationUsage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FeeGrant)
+		x := input.Message.Interface().(*OperatorAuthorizationUsage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2564,37 +2882,17 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.GrantorCorporationId != 0 {
-			n += 1 + runtime.Sov(uint64(x.GrantorCorporationId))
-		}
-		l = len(x.Grantee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.MsgTypes) > 0 {
-			for _, s := range x.MsgTypes {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.SpendLimit) > 0 {
-			for _, e := range x.SpendLimit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.OperatorAuthorizationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorAuthorizationId))
 		}
-		if len(x.RemainingSpend) > 0 {
-			for _, e := range x.RemainingSpend {
+		if len(x.Remaining) > 0 {
+			for _, e := range x.Remaining {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Expiration != nil {
-			l = options.Size(x.Expiration)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Period != nil {
-			l = options.Size(x.Period)
+		if x.LastReset != nil {
+			l = options.Size(x.LastReset)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2607,7 +2905,7 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FeeGrant)
+		x := input.Message.Interface().(*OperatorAuthorizationUsage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2626,22 +2924,8 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Period != nil {
-			encoded, err := options.Marshal(x.Period)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.Expiration != nil {
-			encoded, err := options.Marshal(x.Expiration)
+		if x.LastReset != nil {
+			encoded, err := options.Marshal(x.LastReset)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2652,27 +2936,11 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.RemainingSpend) > 0 {
-			for iNdEx := len(x.RemainingSpend) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RemainingSpend[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
+			dAtA[i] = 0x1a
 		}
-		if len(x.SpendLimit) > 0 {
-			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+		if len(x.Remaining) > 0 {
+			for iNdEx := len(x.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Remaining[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2683,27 +2951,11 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.MsgTypes) > 0 {
-			for iNdEx := len(x.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.MsgTypes[iNdEx])
-				copy(dAtA[i:], x.MsgTypes[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypes[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0x12
 			}
 		}
-		if len(x.Grantee) > 0 {
-			i -= len(x.Grantee)
-			copy(dAtA[i:], x.Grantee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.GrantorCorporationId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GrantorCorporationId))
+		if x.OperatorAuthorizationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorAuthorizationId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -2718,7 +2970,7 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeeGrant)
+		x := input.Message.Interface().(*OperatorAuthorizationUsage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2750,17 +3002,17 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeGrant: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorAuthorizationUsage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorAuthorizationUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantorCorporationId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorizationId", wireType)
 				}
-				x.GrantorCorporationId = 0
+				x.OperatorAuthorizationId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2770,78 +3022,14 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GrantorCorporationId |= uint64(b&0x7F) << shift
+					x.OperatorAuthorizationId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Grantee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MsgTypes = append(x.MsgTypes, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2868,14 +3056,14 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
+				x.Remaining = append(x.Remaining, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Remaining[len(x.Remaining)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingSpend", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastReset", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2902,80 +3090,10 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RemainingSpend = append(x.RemainingSpend, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingSpend[len(x.RemainingSpend)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Expiration == nil {
-					x.Expiration = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Period == nil {
-					x.Period = &durationpb.Duration{}
+				if x.LastReset == nil {
+					x.LastReset = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastReset); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3014,238 +3132,1397 @@ func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ParticipantAuthorizationRecord_2_list)(nil)
+var _ protoreflect.List = (*_FeeGrant_3_list)(nil)
 
-type _ParticipantAuthorizationRecord_2_list struct {
+type _FeeGrant_3_list struct {
 	list *[]string
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) Len() int {
+func (x *_FeeGrant_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) Get(i int) protoreflect.Value {
+func (x *_FeeGrant_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) Set(i int, value protoreflect.Value) {
+func (x *_FeeGrant_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) Append(value protoreflect.Value) {
+func (x *_FeeGrant_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message ParticipantAuthorizationRecord at list field MsgTypes as it is not of Message kind"))
+func (x *_FeeGrant_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeeGrant at list field MsgTypes as it is not of Message kind"))
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) Truncate(n int) {
+func (x *_FeeGrant_3_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) NewElement() protoreflect.Value {
+func (x *_FeeGrant_3_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_ParticipantAuthorizationRecord_2_list) IsValid() bool {
+func (x *_FeeGrant_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_ParticipantAuthorizationRecord_3_list)(nil)
+var _ protoreflect.List = (*_FeeGrant_4_list)(nil)
 
-type _ParticipantAuthorizationRecord_3_list struct {
+type _FeeGrant_4_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) Len() int {
+func (x *_FeeGrant_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) Get(i int) protoreflect.Value {
+func (x *_FeeGrant_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) Set(i int, value protoreflect.Value) {
+func (x *_FeeGrant_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) Append(value protoreflect.Value) {
+func (x *_FeeGrant_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) AppendMutable() protoreflect.Value {
+func (x *_FeeGrant_4_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) Truncate(n int) {
+func (x *_FeeGrant_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) NewElement() protoreflect.Value {
+func (x *_FeeGrant_4_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ParticipantAuthorizationRecord_3_list) IsValid() bool {
+func (x *_FeeGrant_4_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_ParticipantAuthorizationRecord_4_list)(nil)
+var _ protoreflect.List = (*_FeeGrant_5_list)(nil)
 
-type _ParticipantAuthorizationRecord_4_list struct {
+type _FeeGrant_5_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) Len() int {
+func (x *_FeeGrant_5_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) Get(i int) protoreflect.Value {
+func (x *_FeeGrant_5_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) Set(i int, value protoreflect.Value) {
+func (x *_FeeGrant_5_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) Append(value protoreflect.Value) {
+func (x *_FeeGrant_5_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) AppendMutable() protoreflect.Value {
+func (x *_FeeGrant_5_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) Truncate(n int) {
+func (x *_FeeGrant_5_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) NewElement() protoreflect.Value {
+func (x *_FeeGrant_5_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ParticipantAuthorizationRecord_4_list) IsValid() bool {
+func (x *_FeeGrant_5_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_ParticipantAuthorizationRecord_5_list)(nil)
-
-type _ParticipantAuthorizationRecord_5_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_ParticipantAuthorizationRecord_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var (
+	md_FeeGrant                        protoreflect.MessageDescriptor
+	fd_FeeGrant_grantor_corporation_id protoreflect.FieldDescriptor
+	fd_FeeGrant_grantee                protoreflect.FieldDescriptor
+	fd_FeeGrant_msg_types              protoreflect.FieldDescriptor
+	fd_FeeGrant_spend_limit            protoreflect.FieldDescriptor
+	fd_FeeGrant_remaining_spend        protoreflect.FieldDescriptor
+	fd_FeeGrant_expiration             protoreflect.FieldDescriptor
+	fd_FeeGrant_period                 protoreflect.FieldDescriptor
+	fd_FeeGrant_pending                protoreflect.FieldDescriptor
+	fd_FeeGrant_not_before             protoreflect.FieldDescriptor
+)
 
-func (x *_ParticipantAuthorizationRecord_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func init() {
+	file_verana_de_v1_types_proto_init()
+	md_FeeGrant = File_verana_de_v1_types_proto.Messages().ByName("FeeGrant")
+	fd_FeeGrant_grantor_corporation_id = md_FeeGrant.Fields().ByName("grantor_corporation_id")
+	fd_FeeGrant_grantee = md_FeeGrant.Fields().ByName("grantee")
+	fd_FeeGrant_msg_types = md_FeeGrant.Fields().ByName("msg_types")
+	fd_FeeGrant_spend_limit = md_FeeGrant.Fields().ByName("spend_limit")
+	fd_FeeGrant_remaining_spend = md_FeeGrant.Fields().ByName("remaining_spend")
+	fd_FeeGrant_expiration = md_FeeGrant.Fields().ByName("expiration")
+	fd_FeeGrant_period = md_FeeGrant.Fields().ByName("period")
+	fd_FeeGrant_pending = md_FeeGrant.Fields().ByName("pending")
+	fd_FeeGrant_not_before = md_FeeGrant.Fields().ByName("not_before")
 }
 
-func (x *_ParticipantAuthorizationRecord_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
+var _ protoreflect.Message = (*fastReflection_FeeGrant)(nil)
 
-func (x *_ParticipantAuthorizationRecord_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
+type fastReflection_FeeGrant FeeGrant
 
-func (x *_ParticipantAuthorizationRecord_5_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *FeeGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeGrant)(x)
 }
 
-func (x *_ParticipantAuthorizationRecord_5_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+func (x *FeeGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_de_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ParticipantAuthorizationRecord_5_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+	return mi.MessageOf(x)
 }
 
-func (x *_ParticipantAuthorizationRecord_5_list) IsValid() bool {
-	return x.list != nil
-}
+var _fastReflection_FeeGrant_messageType fastReflection_FeeGrant_messageType
+var _ protoreflect.MessageType = fastReflection_FeeGrant_messageType{}
 
-var _ protoreflect.List = (*_ParticipantAuthorizationRecord_6_list)(nil)
+type fastReflection_FeeGrant_messageType struct{}
 
-type _ParticipantAuthorizationRecord_6_list struct {
-	list *[]*v1beta1.Coin
+func (x fastReflection_FeeGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeGrant)(nil)
 }
-
-func (x *_ParticipantAuthorizationRecord_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+func (x fastReflection_FeeGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeGrant)
+}
+func (x fastReflection_FeeGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeGrant
 }
 
-func (x *_ParticipantAuthorizationRecord_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeGrant
 }
 
-func (x *_ParticipantAuthorizationRecord_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeGrant) Type() protoreflect.MessageType {
+	return _fastReflection_FeeGrant_messageType
 }
 
-func (x *_ParticipantAuthorizationRecord_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeGrant) New() protoreflect.Message {
+	return new(fastReflection_FeeGrant)
 }
 
-func (x *_ParticipantAuthorizationRecord_6_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeGrant) Interface() protoreflect.ProtoMessage {
+	return (*FeeGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GrantorCorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GrantorCorporationId)
+		if !f(fd_FeeGrant_grantor_corporation_id, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_FeeGrant_grantee, value) {
+			return
+		}
+	}
+	if len(x.MsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_FeeGrant_3_list{list: &x.MsgTypes})
+		if !f(fd_FeeGrant_msg_types, value) {
+			return
+		}
+	}
+	if len(x.SpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_FeeGrant_4_list{list: &x.SpendLimit})
+		if !f(fd_FeeGrant_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.RemainingSpend) != 0 {
+		value := protoreflect.ValueOfList(&_FeeGrant_5_list{list: &x.RemainingSpend})
+		if !f(fd_FeeGrant_remaining_spend, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_FeeGrant_expiration, value) {
+			return
+		}
+	}
+	if x.Period != nil {
+		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+		if !f(fd_FeeGrant_period, value) {
+			return
+		}
+	}
+	if x.Pending != false {
+		value := protoreflect.ValueOfBool(x.Pending)
+		if !f(fd_FeeGrant_pending, value) {
+			return
+		}
+	}
+	if x.NotBefore != nil {
+		value := protoreflect.ValueOfMessage(x.NotBefore.ProtoReflect())
+		if !f(fd_FeeGrant_not_before, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.de.v1.FeeGrant.grantor_corporation_id":
+		return x.GrantorCorporationId != uint64(0)
+	case "verana.de.v1.FeeGrant.grantee":
+		return x.Grantee != ""
+	case "verana.de.v1.FeeGrant.msg_types":
+		return len(x.MsgTypes) != 0
+	case "verana.de.v1.FeeGrant.spend_limit":
+		return len(x.SpendLimit) != 0
+	case "verana.de.v1.FeeGrant.remaining_spend":
+		return len(x.RemainingSpend) != 0
+	case "verana.de.v1.FeeGrant.expiration":
+		return x.Expiration != nil
+	case "verana.de.v1.FeeGrant.period":
+		return x.Period != nil
+	case "verana.de.v1.FeeGrant.pending":
+		return x.Pending != false
+	case "verana.de.v1.FeeGrant.not_before":
+		return x.NotBefore != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.de.v1.FeeGrant.grantor_corporation_id":
+		x.GrantorCorporationId = uint64(0)
+	case "verana.de.v1.FeeGrant.grantee":
+		x.Grantee = ""
+	case "verana.de.v1.FeeGrant.msg_types":
+		x.MsgTypes = nil
+	case "verana.de.v1.FeeGrant.spend_limit":
+		x.SpendLimit = nil
+	case "verana.de.v1.FeeGrant.remaining_spend":
+		x.RemainingSpend = nil
+	case "verana.de.v1.FeeGrant.expiration":
+		x.Expiration = nil
+	case "verana.de.v1.FeeGrant.period":
+		x.Period = nil
+	case "verana.de.v1.FeeGrant.pending":
+		x.Pending = false
+	case "verana.de.v1.FeeGrant.not_before":
+		x.NotBefore = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.de.v1.FeeGrant.grantor_corporation_id":
+		value := x.GrantorCorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.de.v1.FeeGrant.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "verana.de.v1.FeeGrant.msg_types":
+		if len(x.MsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_FeeGrant_3_list{})
+		}
+		listValue := &_FeeGrant_3_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.FeeGrant.spend_limit":
+		if len(x.SpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_FeeGrant_4_list{})
+		}
+		listValue := &_FeeGrant_4_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.FeeGrant.remaining_spend":
+		if len(x.RemainingSpend) == 0 {
+			return protoreflect.ValueOfList(&_FeeGrant_5_list{})
+		}
+		listValue := &_FeeGrant_5_list{list: &x.RemainingSpend}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.FeeGrant.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.de.v1.FeeGrant.period":
+		value := x.Period
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.de.v1.FeeGrant.pending":
+		value := x.Pending
+		return protoreflect.ValueOfBool(value)
+	case "verana.de.v1.FeeGrant.not_before":
+		value := x.NotBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.de.v1.FeeGrant.grantor_corporation_id":
+		x.GrantorCorporationId = value.Uint()
+	case "verana.de.v1.FeeGrant.grantee":
+		x.Grantee = value.Interface().(string)
+	case "verana.de.v1.FeeGrant.msg_types":
+		lv := value.List()
+		clv := lv.(*_FeeGrant_3_list)
+		x.MsgTypes = *clv.list
+	case "verana.de.v1.FeeGrant.spend_limit":
+		lv := value.List()
+		clv := lv.(*_FeeGrant_4_list)
+		x.SpendLimit = *clv.list
+	case "verana.de.v1.FeeGrant.remaining_spend":
+		lv := value.List()
+		clv := lv.(*_FeeGrant_5_list)
+		x.RemainingSpend = *clv.list
+	case "verana.de.v1.FeeGrant.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.de.v1.FeeGrant.period":
+		x.Period = value.Message().Interface().(*durationpb.Duration)
+	case "verana.de.v1.FeeGrant.pending":
+		x.Pending = value.Bool()
+	case "verana.de.v1.FeeGrant.not_before":
+		x.NotBefore = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.de.v1.FeeGrant.msg_types":
+		if x.MsgTypes == nil {
+			x.MsgTypes = []string{}
+		}
+		value := &_FeeGrant_3_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.FeeGrant.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_FeeGrant_4_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.FeeGrant.remaining_spend":
+		if x.RemainingSpend == nil {
+			x.RemainingSpend = []*v1beta1.Coin{}
+		}
+		value := &_FeeGrant_5_list{list: &x.RemainingSpend}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.FeeGrant.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "verana.de.v1.FeeGrant.period":
+		if x.Period == nil {
+			x.Period = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+	case "verana.de.v1.FeeGrant.not_before":
+		if x.NotBefore == nil {
+			x.NotBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.NotBefore.ProtoReflect())
+	case "verana.de.v1.FeeGrant.grantor_corporation_id":
+		panic(fmt.Errorf("field grantor_corporation_id of message verana.de.v1.FeeGrant is not mutable"))
+	case "verana.de.v1.FeeGrant.grantee":
+		panic(fmt.Errorf("field grantee of message verana.de.v1.FeeGrant is not mutable"))
+	case "verana.de.v1.FeeGrant.pending":
+		panic(fmt.Errorf("field pending of message verana.de.v1.FeeGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.de.v1.FeeGrant.grantor_corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.de.v1.FeeGrant.grantee":
+		return protoreflect.ValueOfString("")
+	case "verana.de.v1.FeeGrant.msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FeeGrant_3_list{list: &list})
+	case "verana.de.v1.FeeGrant.spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeGrant_4_list{list: &list})
+	case "verana.de.v1.FeeGrant.remaining_spend":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeGrant_5_list{list: &list})
+	case "verana.de.v1.FeeGrant.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.de.v1.FeeGrant.period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.de.v1.FeeGrant.pending":
+		return protoreflect.ValueOfBool(false)
+	case "verana.de.v1.FeeGrant.not_before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.FeeGrant"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.FeeGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.FeeGrant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GrantorCorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GrantorCorporationId))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgTypes) > 0 {
+			for _, s := range x.MsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for _, e := range x.SpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemainingSpend) > 0 {
+			for _, e := range x.RemainingSpend {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Period != nil {
+			l = options.Size(x.Period)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pending {
+			n += 2
+		}
+		if x.NotBefore != nil {
+			l = options.Size(x.NotBefore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NotBefore != nil {
+			encoded, err := options.Marshal(x.NotBefore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Pending {
+			i--
+			if x.Pending {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Period != nil {
+			encoded, err := options.Marshal(x.Period)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.RemainingSpend) > 0 {
+			for iNdEx := len(x.RemainingSpend) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RemainingSpend[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.MsgTypes) > 0 {
+			for iNdEx := len(x.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypes[iNdEx])
+				copy(dAtA[i:], x.MsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.GrantorCorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GrantorCorporationId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantorCorporationId", wireType)
+				}
+				x.GrantorCorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GrantorCorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypes = append(x.MsgTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingSpend", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemainingSpend = append(x.RemainingSpend, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingSpend[len(x.RemainingSpend)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Period == nil {
+					x.Period = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Pending = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NotBefore == nil {
+					x.NotBefore = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NotBefore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParticipantAuthorizationRecord_2_list)(nil)
+
+type _ParticipantAuthorizationRecord_2_list struct {
+	list *[]string
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ParticipantAuthorizationRecord at list field MsgTypes as it is not of Message kind"))
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ParticipantAuthorizationRecord_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ParticipantAuthorizationRecord_3_list)(nil)
+
+type _ParticipantAuthorizationRecord_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ParticipantAuthorizationRecord_4_list)(nil)
+
+type _ParticipantAuthorizationRecord_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ParticipantAuthorizationRecord_5_list)(nil)
+
+type _ParticipantAuthorizationRecord_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ParticipantAuthorizationRecord_6_list)(nil)
+
+type _ParticipantAuthorizationRecord_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ParticipantAuthorizationRecord_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantAuthorizationRecord_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantAuthorizationRecord_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantAuthorizationRecord_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
 func (x *_ParticipantAuthorizationRecord_6_list) Truncate(n int) {
@@ -3255,52 +4532,1135 @@ func (x *_ParticipantAuthorizationRecord_6_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ParticipantAuthorizationRecord_6_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_ParticipantAuthorizationRecord_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantAuthorizationRecord_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParticipantAuthorizationRecord                     protoreflect.MessageDescriptor
+	fd_ParticipantAuthorizationRecord_participant_id      protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_msg_types           protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_spend_limit         protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_remaining_spend     protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_fee_spend_limit     protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_remaining_fee_spend protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_with_feegrant       protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_expiration          protoreflect.FieldDescriptor
+	fd_ParticipantAuthorizationRecord_period              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_de_v1_types_proto_init()
+	md_ParticipantAuthorizationRecord = File_verana_de_v1_types_proto.Messages().ByName("ParticipantAuthorizationRecord")
+	fd_ParticipantAuthorizationRecord_participant_id = md_ParticipantAuthorizationRecord.Fields().ByName("participant_id")
+	fd_ParticipantAuthorizationRecord_msg_types = md_ParticipantAuthorizationRecord.Fields().ByName("msg_types")
+	fd_ParticipantAuthorizationRecord_spend_limit = md_ParticipantAuthorizationRecord.Fields().ByName("spend_limit")
+	fd_ParticipantAuthorizationRecord_remaining_spend = md_ParticipantAuthorizationRecord.Fields().ByName("remaining_spend")
+	fd_ParticipantAuthorizationRecord_fee_spend_limit = md_ParticipantAuthorizationRecord.Fields().ByName("fee_spend_limit")
+	fd_ParticipantAuthorizationRecord_remaining_fee_spend = md_ParticipantAuthorizationRecord.Fields().ByName("remaining_fee_spend")
+	fd_ParticipantAuthorizationRecord_with_feegrant = md_ParticipantAuthorizationRecord.Fields().ByName("with_feegrant")
+	fd_ParticipantAuthorizationRecord_expiration = md_ParticipantAuthorizationRecord.Fields().ByName("expiration")
+	fd_ParticipantAuthorizationRecord_period = md_ParticipantAuthorizationRecord.Fields().ByName("period")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantAuthorizationRecord)(nil)
+
+type fastReflection_ParticipantAuthorizationRecord ParticipantAuthorizationRecord
+
+func (x *ParticipantAuthorizationRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantAuthorizationRecord)(x)
+}
+
+func (x *ParticipantAuthorizationRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_de_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantAuthorizationRecord_messageType fastReflection_ParticipantAuthorizationRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantAuthorizationRecord_messageType{}
+
+type fastReflection_ParticipantAuthorizationRecord_messageType struct{}
+
+func (x fastReflection_ParticipantAuthorizationRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantAuthorizationRecord)(nil)
+}
+func (x fastReflection_ParticipantAuthorizationRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantAuthorizationRecord)
+}
+func (x fastReflection_ParticipantAuthorizationRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantAuthorizationRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantAuthorizationRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantAuthorizationRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantAuthorizationRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantAuthorizationRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantAuthorizationRecord) New() protoreflect.Message {
+	return new(fastReflection_ParticipantAuthorizationRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantAuthorizationRecord) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantAuthorizationRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantAuthorizationRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipantId)
+		if !f(fd_ParticipantAuthorizationRecord_participant_id, value) {
+			return
+		}
+	}
+	if len(x.MsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_2_list{list: &x.MsgTypes})
+		if !f(fd_ParticipantAuthorizationRecord_msg_types, value) {
+			return
+		}
+	}
+	if len(x.SpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_3_list{list: &x.SpendLimit})
+		if !f(fd_ParticipantAuthorizationRecord_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.RemainingSpend) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_4_list{list: &x.RemainingSpend})
+		if !f(fd_ParticipantAuthorizationRecord_remaining_spend, value) {
+			return
+		}
+	}
+	if len(x.FeeSpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_5_list{list: &x.FeeSpendLimit})
+		if !f(fd_ParticipantAuthorizationRecord_fee_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.RemainingFeeSpend) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_6_list{list: &x.RemainingFeeSpend})
+		if !f(fd_ParticipantAuthorizationRecord_remaining_fee_spend, value) {
+			return
+		}
+	}
+	if x.WithFeegrant != false {
+		value := protoreflect.ValueOfBool(x.WithFeegrant)
+		if !f(fd_ParticipantAuthorizationRecord_with_feegrant, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_ParticipantAuthorizationRecord_expiration, value) {
+			return
+		}
+	}
+	if x.Period != nil {
+		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+		if !f(fd_ParticipantAuthorizationRecord_period, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantAuthorizationRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
+		return x.ParticipantId != uint64(0)
+	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
+		return len(x.MsgTypes) != 0
+	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
+		return len(x.SpendLimit) != 0
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
+		return len(x.RemainingSpend) != 0
+	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
+		return len(x.FeeSpendLimit) != 0
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
+		return len(x.RemainingFeeSpend) != 0
+	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
+		return x.WithFeegrant != false
+	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
+		return x.Expiration != nil
+	case "verana.de.v1.ParticipantAuthorizationRecord.period":
+		return x.Period != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantAuthorizationRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
+		x.ParticipantId = uint64(0)
+	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
+		x.MsgTypes = nil
+	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
+		x.SpendLimit = nil
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
+		x.RemainingSpend = nil
+	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
+		x.FeeSpendLimit = nil
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
+		x.RemainingFeeSpend = nil
+	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
+		x.WithFeegrant = false
+	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
+		x.Expiration = nil
+	case "verana.de.v1.ParticipantAuthorizationRecord.period":
+		x.Period = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantAuthorizationRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
+		value := x.ParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
+		if len(x.MsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_2_list{})
+		}
+		listValue := &_ParticipantAuthorizationRecord_2_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
+		if len(x.SpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_3_list{})
+		}
+		listValue := &_ParticipantAuthorizationRecord_3_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
+		if len(x.RemainingSpend) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_4_list{})
+		}
+		listValue := &_ParticipantAuthorizationRecord_4_list{list: &x.RemainingSpend}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
+		if len(x.FeeSpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_5_list{})
+		}
+		listValue := &_ParticipantAuthorizationRecord_5_list{list: &x.FeeSpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
+		if len(x.RemainingFeeSpend) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_6_list{})
+		}
+		listValue := &_ParticipantAuthorizationRecord_6_list{list: &x.RemainingFeeSpend}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
+		value := x.WithFeegrant
+		return protoreflect.ValueOfBool(value)
+	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.de.v1.ParticipantAuthorizationRecord.period":
+		value := x.Period
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantAuthorizationRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
+		x.ParticipantId = value.Uint()
+	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
+		lv := value.List()
+		clv := lv.(*_ParticipantAuthorizationRecord_2_list)
+		x.MsgTypes = *clv.list
+	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
+		lv := value.List()
+		clv := lv.(*_ParticipantAuthorizationRecord_3_list)
+		x.SpendLimit = *clv.list
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
+		lv := value.List()
+		clv := lv.(*_ParticipantAuthorizationRecord_4_list)
+		x.RemainingSpend = *clv.list
+	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
+		lv := value.List()
+		clv := lv.(*_ParticipantAuthorizationRecord_5_list)
+		x.FeeSpendLimit = *clv.list
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
+		lv := value.List()
+		clv := lv.(*_ParticipantAuthorizationRecord_6_list)
+		x.RemainingFeeSpend = *clv.list
+	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
+		x.WithFeegrant = value.Bool()
+	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.de.v1.ParticipantAuthorizationRecord.period":
+		x.Period = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantAuthorizationRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
+		if x.MsgTypes == nil {
+			x.MsgTypes = []string{}
+		}
+		value := &_ParticipantAuthorizationRecord_2_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_ParticipantAuthorizationRecord_3_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
+		if x.RemainingSpend == nil {
+			x.RemainingSpend = []*v1beta1.Coin{}
+		}
+		value := &_ParticipantAuthorizationRecord_4_list{list: &x.RemainingSpend}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
+		if x.FeeSpendLimit == nil {
+			x.FeeSpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_ParticipantAuthorizationRecord_5_list{list: &x.FeeSpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
+		if x.RemainingFeeSpend == nil {
+			x.RemainingFeeSpend = []*v1beta1.Coin{}
+		}
+		value := &_ParticipantAuthorizationRecord_6_list{list: &x.RemainingFeeSpend}
+		return protoreflect.ValueOfList(value)
+	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "verana.de.v1.ParticipantAuthorizationRecord.period":
+		if x.Period == nil {
+			x.Period = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
+		panic(fmt.Errorf("field participant_id of message verana.de.v1.ParticipantAuthorizationRecord is not mutable"))
+	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
+		panic(fmt.Errorf("field with_feegrant of message verana.de.v1.ParticipantAuthorizationRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantAuthorizationRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_2_list{list: &list})
+	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_3_list{list: &list})
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_4_list{list: &list})
+	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_5_list{list: &list})
+	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_6_list{list: &list})
+	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
+		return protoreflect.ValueOfBool(false)
+	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.de.v1.ParticipantAuthorizationRecord.period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+		}
+		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantAuthorizationRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.ParticipantAuthorizationRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantAuthorizationRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantAuthorizationRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantAuthorizationRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantAuthorizationRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipantId))
+		}
+		if len(x.MsgTypes) > 0 {
+			for _, s := range x.MsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for _, e := range x.SpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemainingSpend) > 0 {
+			for _, e := range x.RemainingSpend {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSpendLimit) > 0 {
+			for _, e := range x.FeeSpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemainingFeeSpend) > 0 {
+			for _, e := range x.RemainingFeeSpend {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.WithFeegrant {
+			n += 2
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Period != nil {
+			l = options.Size(x.Period)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantAuthorizationRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Period != nil {
+			encoded, err := options.Marshal(x.Period)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.WithFeegrant {
+			i--
+			if x.WithFeegrant {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.RemainingFeeSpend) > 0 {
+			for iNdEx := len(x.RemainingFeeSpend) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RemainingFeeSpend[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.FeeSpendLimit) > 0 {
+			for iNdEx := len(x.FeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.RemainingSpend) > 0 {
+			for iNdEx := len(x.RemainingSpend) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RemainingSpend[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.MsgTypes) > 0 {
+			for iNdEx := len(x.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypes[iNdEx])
+				copy(dAtA[i:], x.MsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.ParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipantId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantAuthorizationRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantAuthorizationRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantAuthorizationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+				}
+				x.ParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypes = append(x.MsgTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingSpend", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemainingSpend = append(x.RemainingSpend, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingSpend[len(x.RemainingSpend)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSpendLimit = append(x.FeeSpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSpendLimit[len(x.FeeSpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingFeeSpend", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemainingFeeSpend = append(x.RemainingFeeSpend, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingFeeSpend[len(x.RemainingFeeSpend)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithFeegrant", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.WithFeegrant = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Period == nil {
+					x.Period = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VSOperatorAuthorization_4_list)(nil)
+
+type _VSOperatorAuthorization_4_list struct {
+	list *[]*ParticipantAuthorizationRecord
+}
+
+func (x *_VSOperatorAuthorization_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VSOperatorAuthorization_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VSOperatorAuthorization_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantAuthorizationRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VSOperatorAuthorization_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantAuthorizationRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VSOperatorAuthorization_4_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantAuthorizationRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VSOperatorAuthorization_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VSOperatorAuthorization_4_list) NewElement() protoreflect.Value {
+	v := new(ParticipantAuthorizationRecord)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ParticipantAuthorizationRecord_6_list) IsValid() bool {
+func (x *_VSOperatorAuthorization_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ParticipantAuthorizationRecord                     protoreflect.MessageDescriptor
-	fd_ParticipantAuthorizationRecord_participant_id      protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_msg_types           protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_spend_limit         protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_remaining_spend     protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_fee_spend_limit     protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_remaining_fee_spend protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_with_feegrant       protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_expiration          protoreflect.FieldDescriptor
-	fd_ParticipantAuthorizationRecord_period              protoreflect.FieldDescriptor
+	md_VSOperatorAuthorization                protoreflect.MessageDescriptor
+	fd_VSOperatorAuthorization_id             protoreflect.FieldDescriptor
+	fd_VSOperatorAuthorization_corporation_id protoreflect.FieldDescriptor
+	fd_VSOperatorAuthorization_vs_operator    protoreflect.FieldDescriptor
+	fd_VSOperatorAuthorization_records        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_de_v1_types_proto_init()
-	md_ParticipantAuthorizationRecord = File_verana_de_v1_types_proto.Messages().ByName("ParticipantAuthorizationRecord")
-	fd_ParticipantAuthorizationRecord_participant_id = md_ParticipantAuthorizationRecord.Fields().ByName("participant_id")
-	fd_ParticipantAuthorizationRecord_msg_types = md_ParticipantAuthorizationRecord.Fields().ByName("msg_types")
-	fd_ParticipantAuthorizationRecord_spend_limit = md_ParticipantAuthorizationRecord.Fields().ByName("spend_limit")
-	fd_ParticipantAuthorizationRecord_remaining_spend = md_ParticipantAuthorizationRecord.Fields().ByName("remaining_spend")
-	fd_ParticipantAuthorizationRecord_fee_spend_limit = md_ParticipantAuthorizationRecord.Fields().ByName("fee_spend_limit")
-	fd_ParticipantAuthorizationRecord_remaining_fee_spend = md_ParticipantAuthorizationRecord.Fields().ByName("remaining_fee_spend")
-	fd_ParticipantAuthorizationRecord_with_feegrant = md_ParticipantAuthorizationRecord.Fields().ByName("with_feegrant")
-	fd_ParticipantAuthorizationRecord_expiration = md_ParticipantAuthorizationRecord.Fields().ByName("expiration")
-	fd_ParticipantAuthorizationRecord_period = md_ParticipantAuthorizationRecord.Fields().ByName("period")
+	md_VSOperatorAuthorization = File_verana_de_v1_types_proto.Messages().ByName("VSOperatorAuthorization")
+	fd_VSOperatorAuthorization_id = md_VSOperatorAuthorization.Fields().ByName("id")
+	fd_VSOperatorAuthorization_corporation_id = md_VSOperatorAuthorization.Fields().ByName("corporation_id")
+	fd_VSOperatorAuthorization_vs_operator = md_VSOperatorAuthorization.Fields().ByName("vs_operator")
+	fd_VSOperatorAuthorization_records = md_VSOperatorAuthorization.Fields().ByName("records")
 }
 
-var _ protoreflect.Message = (*fastReflection_ParticipantAuthorizationRecord)(nil)
+var _ protoreflect.Message = (*fastReflection_VSOperatorAuthorization)(nil)
 
-type fastReflection_ParticipantAuthorizationRecord ParticipantAuthorizationRecord
+type fastReflection_VSOperatorAuthorization VSOperatorAuthorization
 
-func (x *ParticipantAuthorizationRecord) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ParticipantAuthorizationRecord)(x)
+func (x *VSOperatorAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VSOperatorAuthorization)(x)
 }
 
-func (x *ParticipantAuthorizationRecord) slowProtoReflect() protoreflect.Message {
-	mi := &file_verana_de_v1_types_proto_msgTypes[3]
+func (x *VSOperatorAuthorization) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_de_v1_types_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3311,43 +5671,43 @@ func (x *ParticipantAuthorizationRecord) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ParticipantAuthorizationRecord_messageType fastReflection_ParticipantAuthorizationRecord_messageType
-var _ protoreflect.MessageType = fastReflection_ParticipantAuthorizationRecord_messageType{}
+var _fastReflection_VSOperatorAuthorization_messageType fastReflection_VSOperatorAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_VSOperatorAuthorization_messageType{}
 
-type fastReflection_ParticipantAuthorizationRecord_messageType struct{}
+type fastReflection_VSOperatorAuthorization_messageType struct{}
 
-func (x fastReflection_ParticipantAuthorizationRecord_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ParticipantAuthorizationRecord)(nil)
+func (x fastReflection_VSOperatorAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VSOperatorAuthorization)(nil)
 }
-func (x fastReflection_ParticipantAuthorizationRecord_messageType) New() protoreflect.Message {
-	return new(fastReflection_ParticipantAuthorizationRecord)
+func (x fastReflection_VSOperatorAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_VSOperatorAuthorization)
 }
-func (x fastReflection_ParticipantAuthorizationRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParticipantAuthorizationRecord
+func (x fastReflection_VSOperatorAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VSOperatorAuthorization
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ParticipantAuthorizationRecord) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParticipantAuthorizationRecord
+func (x *fastReflection_VSOperatorAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_VSOperatorAuthorization
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ParticipantAuthorizationRecord) Type() protoreflect.MessageType {
-	return _fastReflection_ParticipantAuthorizationRecord_messageType
+func (x *fastReflection_VSOperatorAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_VSOperatorAuthorization_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ParticipantAuthorizationRecord) New() protoreflect.Message {
-	return new(fastReflection_ParticipantAuthorizationRecord)
+func (x *fastReflection_VSOperatorAuthorization) New() protoreflect.Message {
+	return new(fastReflection_VSOperatorAuthorization)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ParticipantAuthorizationRecord) Interface() protoreflect.ProtoMessage {
-	return (*ParticipantAuthorizationRecord)(x)
+func (x *fastReflection_VSOperatorAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*VSOperatorAuthorization)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3355,58 +5715,28 @@ func (x *fastReflection_ParticipantAuthorizationRecord) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ParticipantAuthorizationRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ParticipantId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ParticipantId)
-		if !f(fd_ParticipantAuthorizationRecord_participant_id, value) {
-			return
-		}
-	}
-	if len(x.MsgTypes) != 0 {
-		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_2_list{list: &x.MsgTypes})
-		if !f(fd_ParticipantAuthorizationRecord_msg_types, value) {
-			return
-		}
-	}
-	if len(x.SpendLimit) != 0 {
-		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_3_list{list: &x.SpendLimit})
-		if !f(fd_ParticipantAuthorizationRecord_spend_limit, value) {
-			return
-		}
-	}
-	if len(x.RemainingSpend) != 0 {
-		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_4_list{list: &x.RemainingSpend})
-		if !f(fd_ParticipantAuthorizationRecord_remaining_spend, value) {
-			return
-		}
-	}
-	if len(x.FeeSpendLimit) != 0 {
-		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_5_list{list: &x.FeeSpendLimit})
-		if !f(fd_ParticipantAuthorizationRecord_fee_spend_limit, value) {
-			return
-		}
-	}
-	if len(x.RemainingFeeSpend) != 0 {
-		value := protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_6_list{list: &x.RemainingFeeSpend})
-		if !f(fd_ParticipantAuthorizationRecord_remaining_fee_spend, value) {
+func (x *fastReflection_VSOperatorAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_VSOperatorAuthorization_id, value) {
 			return
 		}
 	}
-	if x.WithFeegrant != false {
-		value := protoreflect.ValueOfBool(x.WithFeegrant)
-		if !f(fd_ParticipantAuthorizationRecord_with_feegrant, value) {
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_VSOperatorAuthorization_corporation_id, value) {
 			return
 		}
 	}
-	if x.Expiration != nil {
-		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-		if !f(fd_ParticipantAuthorizationRecord_expiration, value) {
+	if x.VsOperator != "" {
+		value := protoreflect.ValueOfString(x.VsOperator)
+		if !f(fd_VSOperatorAuthorization_vs_operator, value) {
 			return
 		}
 	}
-	if x.Period != nil {
-		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-		if !f(fd_ParticipantAuthorizationRecord_period, value) {
+	if len(x.Records) != 0 {
+		value := protoreflect.ValueOfList(&_VSOperatorAuthorization_4_list{list: &x.Records})
+		if !f(fd_VSOperatorAuthorization_records, value) {
 			return
 		}
 	}
@@ -3423,31 +5753,21 @@ func (x *fastReflection_ParticipantAuthorizationRecord) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ParticipantAuthorizationRecord) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VSOperatorAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
-		return x.ParticipantId != uint64(0)
-	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
-		return len(x.MsgTypes) != 0
-	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
-		return len(x.SpendLimit) != 0
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
-		return len(x.RemainingSpend) != 0
-	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
-		return len(x.FeeSpendLimit) != 0
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
-		return len(x.RemainingFeeSpend) != 0
-	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
-		return x.WithFeegrant != false
-	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
-		return x.Expiration != nil
-	case "verana.de.v1.ParticipantAuthorizationRecord.period":
-		return x.Period != nil
+	case "verana.de.v1.VSOperatorAuthorization.id":
+		return x.Id != uint64(0)
+	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
+		return x.VsOperator != ""
+	case "verana.de.v1.VSOperatorAuthorization.records":
+		return len(x.Records) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3457,31 +5777,21 @@ func (x *fastReflection_ParticipantAuthorizationRecord) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantAuthorizationRecord) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VSOperatorAuthorization) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
-		x.ParticipantId = uint64(0)
-	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
-		x.MsgTypes = nil
-	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
-		x.SpendLimit = nil
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
-		x.RemainingSpend = nil
-	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
-		x.FeeSpendLimit = nil
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
-		x.RemainingFeeSpend = nil
-	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
-		x.WithFeegrant = false
-	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
-		x.Expiration = nil
-	case "verana.de.v1.ParticipantAuthorizationRecord.period":
-		x.Period = nil
+	case "verana.de.v1.VSOperatorAuthorization.id":
+		x.Id = uint64(0)
+	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
+		x.VsOperator = ""
+	case "verana.de.v1.VSOperatorAuthorization.records":
+		x.Records = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3491,55 +5801,28 @@ func (x *fastReflection_ParticipantAuthorizationRecord) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ParticipantAuthorizationRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VSOperatorAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
-		value := x.ParticipantId
+	case "verana.de.v1.VSOperatorAuthorization.id":
+		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
-		if len(x.MsgTypes) == 0 {
-			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_2_list{})
-		}
-		listValue := &_ParticipantAuthorizationRecord_2_list{list: &x.MsgTypes}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
-		if len(x.SpendLimit) == 0 {
-			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_3_list{})
-		}
-		listValue := &_ParticipantAuthorizationRecord_3_list{list: &x.SpendLimit}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
-		if len(x.RemainingSpend) == 0 {
-			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_4_list{})
-		}
-		listValue := &_ParticipantAuthorizationRecord_4_list{list: &x.RemainingSpend}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
-		if len(x.FeeSpendLimit) == 0 {
-			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_5_list{})
-		}
-		listValue := &_ParticipantAuthorizationRecord_5_list{list: &x.FeeSpendLimit}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
-		if len(x.RemainingFeeSpend) == 0 {
-			return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_6_list{})
+	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
+		value := x.VsOperator
+		return protoreflect.ValueOfString(value)
+	case "verana.de.v1.VSOperatorAuthorization.records":
+		if len(x.Records) == 0 {
+			return protoreflect.ValueOfList(&_VSOperatorAuthorization_4_list{})
 		}
-		listValue := &_ParticipantAuthorizationRecord_6_list{list: &x.RemainingFeeSpend}
+		listValue := &_VSOperatorAuthorization_4_list{list: &x.Records}
 		return protoreflect.ValueOfList(listValue)
-	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
-		value := x.WithFeegrant
-		return protoreflect.ValueOfBool(value)
-	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
-		value := x.Expiration
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "verana.de.v1.ParticipantAuthorizationRecord.period":
-		value := x.Period
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3553,41 +5836,23 @@ func (x *fastReflection_ParticipantAuthorizationRecord) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantAuthorizationRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VSOperatorAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
-		x.ParticipantId = value.Uint()
-	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
-		lv := value.List()
-		clv := lv.(*_ParticipantAuthorizationRecord_2_list)
-		x.MsgTypes = *clv.list
-	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
-		lv := value.List()
-		clv := lv.(*_ParticipantAuthorizationRecord_3_list)
-		x.SpendLimit = *clv.list
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
-		lv := value.List()
-		clv := lv.(*_ParticipantAuthorizationRecord_4_list)
-		x.RemainingSpend = *clv.list
-	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
-		lv := value.List()
-		clv := lv.(*_ParticipantAuthorizationRecord_5_list)
-		x.FeeSpendLimit = *clv.list
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
+	case "verana.de.v1.VSOperatorAuthorization.id":
+		x.Id = value.Uint()
+	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
+		x.VsOperator = value.Interface().(string)
+	case "verana.de.v1.VSOperatorAuthorization.records":
 		lv := value.List()
-		clv := lv.(*_ParticipantAuthorizationRecord_6_list)
-		x.RemainingFeeSpend = *clv.list
-	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
-		x.WithFeegrant = value.Bool()
-	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
-		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
-	case "verana.de.v1.ParticipantAuthorizationRecord.period":
-		x.Period = value.Message().Interface().(*durationpb.Duration)
+		clv := lv.(*_VSOperatorAuthorization_4_list)
+		x.Records = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3601,105 +5866,57 @@ func (x *fastReflection_ParticipantAuthorizationRecord) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantAuthorizationRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VSOperatorAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
-		if x.MsgTypes == nil {
-			x.MsgTypes = []string{}
-		}
-		value := &_ParticipantAuthorizationRecord_2_list{list: &x.MsgTypes}
-		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
-		if x.SpendLimit == nil {
-			x.SpendLimit = []*v1beta1.Coin{}
-		}
-		value := &_ParticipantAuthorizationRecord_3_list{list: &x.SpendLimit}
-		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
-		if x.RemainingSpend == nil {
-			x.RemainingSpend = []*v1beta1.Coin{}
-		}
-		value := &_ParticipantAuthorizationRecord_4_list{list: &x.RemainingSpend}
-		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
-		if x.FeeSpendLimit == nil {
-			x.FeeSpendLimit = []*v1beta1.Coin{}
-		}
-		value := &_ParticipantAuthorizationRecord_5_list{list: &x.FeeSpendLimit}
-		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
-		if x.RemainingFeeSpend == nil {
-			x.RemainingFeeSpend = []*v1beta1.Coin{}
+	case "verana.de.v1.VSOperatorAuthorization.records":
+		if x.Records == nil {
+			x.Records = []*ParticipantAuthorizationRecord{}
 		}
-		value := &_ParticipantAuthorizationRecord_6_list{list: &x.RemainingFeeSpend}
+		value := &_VSOperatorAuthorization_4_list{list: &x.Records}
 		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
-		if x.Expiration == nil {
-			x.Expiration = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
-	case "verana.de.v1.ParticipantAuthorizationRecord.period":
-		if x.Period == nil {
-			x.Period = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
-	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
-		panic(fmt.Errorf("field participant_id of message verana.de.v1.ParticipantAuthorizationRecord is not mutable"))
-	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
-		panic(fmt.Errorf("field with_feegrant of message verana.de.v1.ParticipantAuthorizationRecord is not mutable"))
+	case "verana.de.v1.VSOperatorAuthorization.id":
+		panic(fmt.Errorf("field id of message verana.de.v1.VSOperatorAuthorization is not mutable"))
+	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.de.v1.VSOperatorAuthorization is not mutable"))
+	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
+		panic(fmt.Errorf("field vs_operator of message verana.de.v1.VSOperatorAuthorization is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ParticipantAuthorizationRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VSOperatorAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.ParticipantAuthorizationRecord.participant_id":
+	case "verana.de.v1.VSOperatorAuthorization.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.de.v1.ParticipantAuthorizationRecord.msg_types":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_2_list{list: &list})
-	case "verana.de.v1.ParticipantAuthorizationRecord.spend_limit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_3_list{list: &list})
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_spend":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_4_list{list: &list})
-	case "verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_5_list{list: &list})
-	case "verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_ParticipantAuthorizationRecord_6_list{list: &list})
-	case "verana.de.v1.ParticipantAuthorizationRecord.with_feegrant":
-		return protoreflect.ValueOfBool(false)
-	case "verana.de.v1.ParticipantAuthorizationRecord.expiration":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "verana.de.v1.ParticipantAuthorizationRecord.period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
+		return protoreflect.ValueOfString("")
+	case "verana.de.v1.VSOperatorAuthorization.records":
+		list := []*ParticipantAuthorizationRecord{}
+		return protoreflect.ValueOfList(&_VSOperatorAuthorization_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.ParticipantAuthorizationRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.ParticipantAuthorizationRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ParticipantAuthorizationRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VSOperatorAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.ParticipantAuthorizationRecord", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.VSOperatorAuthorization", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3707,7 +5924,7 @@ func (x *fastReflection_ParticipantAuthorizationRecord) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ParticipantAuthorizationRecord) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VSOperatorAuthorization) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3718,7 +5935,7 @@ func (x *fastReflection_ParticipantAuthorizationRecord) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantAuthorizationRecord) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VSOperatorAuthorization) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3730,7 +5947,7 @@ func (x *fastReflection_ParticipantAuthorizationRecord) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ParticipantAuthorizationRecord) IsValid() bool {
+func (x *fastReflection_VSOperatorAuthorization) IsValid() bool {
 	return x != nil
 }
 
@@ -3740,9 +5957,9 @@ func (x *fastReflection_ParticipantAuthorizationRecord) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ParticipantAuthorizationRecord)
+		x := input.Message.Interface().(*VSOperatorAuthorization)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3754,152 +5971,54 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		if x.ParticipantId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ParticipantId))
-		}
-		if len(x.MsgTypes) > 0 {
-			for _, s := range x.MsgTypes {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.SpendLimit) > 0 {
-			for _, e := range x.SpendLimit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.RemainingSpend) > 0 {
-			for _, e := range x.RemainingSpend {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.FeeSpendLimit) > 0 {
-			for _, e := range x.FeeSpendLimit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.RemainingFeeSpend) > 0 {
-			for _, e := range x.RemainingFeeSpend {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.WithFeegrant {
-			n += 2
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		if x.Expiration != nil {
-			l = options.Size(x.Expiration)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
 		}
-		if x.Period != nil {
-			l = options.Size(x.Period)
+		l = len(x.VsOperator)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ParticipantAuthorizationRecord)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Period != nil {
-			encoded, err := options.Marshal(x.Period)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if x.Expiration != nil {
-			encoded, err := options.Marshal(x.Expiration)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.WithFeegrant {
-			i--
-			if x.WithFeegrant {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x38
-		}
-		if len(x.RemainingFeeSpend) > 0 {
-			for iNdEx := len(x.RemainingFeeSpend) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RemainingFeeSpend[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if len(x.FeeSpendLimit) > 0 {
-			for iNdEx := len(x.FeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FeeSpendLimit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
+		if len(x.Records) > 0 {
+			for _, e := range x.Records {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.RemainingSpend) > 0 {
-			for iNdEx := len(x.RemainingSpend) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RemainingSpend[iNdEx])
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VSOperatorAuthorization)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Records) > 0 {
+			for iNdEx := len(x.Records) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Records[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3913,33 +6032,20 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 				dAtA[i] = 0x22
 			}
 		}
-		if len(x.SpendLimit) > 0 {
-			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
+		if len(x.VsOperator) > 0 {
+			i -= len(x.VsOperator)
+			copy(dAtA[i:], x.VsOperator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VsOperator)))
+			i--
+			dAtA[i] = 0x1a
 		}
-		if len(x.MsgTypes) > 0 {
-			for iNdEx := len(x.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.MsgTypes[iNdEx])
-				copy(dAtA[i:], x.MsgTypes[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypes[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x10
 		}
-		if x.ParticipantId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipantId))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3954,7 +6060,7 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ParticipantAuthorizationRecord)
+		x := input.Message.Interface().(*VSOperatorAuthorization)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3986,17 +6092,17 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantAuthorizationRecord: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VSOperatorAuthorization: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantAuthorizationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VSOperatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.ParticipantId = 0
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4006,184 +6112,16 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ParticipantId |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MsgTypes = append(x.MsgTypes, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingSpend", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RemainingSpend = append(x.RemainingSpend, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingSpend[len(x.RemainingSpend)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.FeeSpendLimit = append(x.FeeSpendLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSpendLimit[len(x.FeeSpendLimit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingFeeSpend", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RemainingFeeSpend = append(x.RemainingFeeSpend, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingFeeSpend[len(x.RemainingFeeSpend)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithFeegrant", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 				}
-				var v int
+				x.CorporationId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4193,17 +6131,16 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.CorporationId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.WithFeegrant = bool(v != 0)
-			case 8:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4213,31 +6150,27 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Expiration == nil {
-					x.Expiration = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.VsOperator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 9:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4264,10 +6197,8 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Period == nil {
-					x.Period = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+				x.Records = append(x.Records, &ParticipantAuthorizationRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Records[len(x.Records)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4306,84 +6237,33 @@ func (x *fastReflection_ParticipantAuthorizationRecord) ProtoMethods() *protoifa
 	}
 }
 
-var _ protoreflect.List = (*_VSOperatorAuthorization_4_list)(nil)
-
-type _VSOperatorAuthorization_4_list struct {
-	list *[]*ParticipantAuthorizationRecord
-}
-
-func (x *_VSOperatorAuthorization_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_VSOperatorAuthorization_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_VSOperatorAuthorization_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ParticipantAuthorizationRecord)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_VSOperatorAuthorization_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ParticipantAuthorizationRecord)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_VSOperatorAuthorization_4_list) AppendMutable() protoreflect.Value {
-	v := new(ParticipantAuthorizationRecord)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_VSOperatorAuthorization_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_VSOperatorAuthorization_4_list) NewElement() protoreflect.Value {
-	v := new(ParticipantAuthorizationRecord)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_VSOperatorAuthorization_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_VSOperatorAuthorization                protoreflect.MessageDescriptor
-	fd_VSOperatorAuthorization_id             protoreflect.FieldDescriptor
-	fd_VSOperatorAuthorization_corporation_id protoreflect.FieldDescriptor
-	fd_VSOperatorAuthorization_vs_operator    protoreflect.FieldDescriptor
-	fd_VSOperatorAuthorization_records        protoreflect.FieldDescriptor
+	md_CorporationFreeze                protoreflect.MessageDescriptor
+	fd_CorporationFreeze_corporation_id protoreflect.FieldDescriptor
+	fd_CorporationFreeze_frozen_by      protoreflect.FieldDescriptor
+	fd_CorporationFreeze_reason         protoreflect.FieldDescriptor
+	fd_CorporationFreeze_frozen_at      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_de_v1_types_proto_init()
-	md_VSOperatorAuthorization = File_verana_de_v1_types_proto.Messages().ByName("VSOperatorAuthorization")
-	fd_VSOperatorAuthorization_id = md_VSOperatorAuthorization.Fields().ByName("id")
-	fd_VSOperatorAuthorization_corporation_id = md_VSOperatorAuthorization.Fields().ByName("corporation_id")
-	fd_VSOperatorAuthorization_vs_operator = md_VSOperatorAuthorization.Fields().ByName("vs_operator")
-	fd_VSOperatorAuthorization_records = md_VSOperatorAuthorization.Fields().ByName("records")
+	md_CorporationFreeze = File_verana_de_v1_types_proto.Messages().ByName("CorporationFreeze")
+	fd_CorporationFreeze_corporation_id = md_CorporationFreeze.Fields().ByName("corporation_id")
+	fd_CorporationFreeze_frozen_by = md_CorporationFreeze.Fields().ByName("frozen_by")
+	fd_CorporationFreeze_reason = md_CorporationFreeze.Fields().ByName("reason")
+	fd_CorporationFreeze_frozen_at = md_CorporationFreeze.Fields().ByName("frozen_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_VSOperatorAuthorization)(nil)
+var _ protoreflect.Message = (*fastReflection_CorporationFreeze)(nil)
 
-type fastReflection_VSOperatorAuthorization VSOperatorAuthorization
+type fastReflection_CorporationFreeze CorporationFreeze
 
-func (x *VSOperatorAuthorization) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VSOperatorAuthorization)(x)
+func (x *CorporationFreeze) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CorporationFreeze)(x)
 }
 
-func (x *VSOperatorAuthorization) slowProtoReflect() protoreflect.Message {
-	mi := &file_verana_de_v1_types_proto_msgTypes[4]
+func (x *CorporationFreeze) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_de_v1_types_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4394,43 +6274,43 @@ func (x *VSOperatorAuthorization) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VSOperatorAuthorization_messageType fastReflection_VSOperatorAuthorization_messageType
-var _ protoreflect.MessageType = fastReflection_VSOperatorAuthorization_messageType{}
+var _fastReflection_CorporationFreeze_messageType fastReflection_CorporationFreeze_messageType
+var _ protoreflect.MessageType = fastReflection_CorporationFreeze_messageType{}
 
-type fastReflection_VSOperatorAuthorization_messageType struct{}
+type fastReflection_CorporationFreeze_messageType struct{}
 
-func (x fastReflection_VSOperatorAuthorization_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VSOperatorAuthorization)(nil)
+func (x fastReflection_CorporationFreeze_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CorporationFreeze)(nil)
 }
-func (x fastReflection_VSOperatorAuthorization_messageType) New() protoreflect.Message {
-	return new(fastReflection_VSOperatorAuthorization)
+func (x fastReflection_CorporationFreeze_messageType) New() protoreflect.Message {
+	return new(fastReflection_CorporationFreeze)
 }
-func (x fastReflection_VSOperatorAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VSOperatorAuthorization
+func (x fastReflection_CorporationFreeze_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CorporationFreeze
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VSOperatorAuthorization) Descriptor() protoreflect.MessageDescriptor {
-	return md_VSOperatorAuthorization
+func (x *fastReflection_CorporationFreeze) Descriptor() protoreflect.MessageDescriptor {
+	return md_CorporationFreeze
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VSOperatorAuthorization) Type() protoreflect.MessageType {
-	return _fastReflection_VSOperatorAuthorization_messageType
+func (x *fastReflection_CorporationFreeze) Type() protoreflect.MessageType {
+	return _fastReflection_CorporationFreeze_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VSOperatorAuthorization) New() protoreflect.Message {
-	return new(fastReflection_VSOperatorAuthorization)
+func (x *fastReflection_CorporationFreeze) New() protoreflect.Message {
+	return new(fastReflection_CorporationFreeze)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VSOperatorAuthorization) Interface() protoreflect.ProtoMessage {
-	return (*VSOperatorAuthorization)(x)
+func (x *fastReflection_CorporationFreeze) Interface() protoreflect.ProtoMessage {
+	return (*CorporationFreeze)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4438,28 +6318,28 @@ func (x *fastReflection_VSOperatorAuthorization) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VSOperatorAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_VSOperatorAuthorization_id, value) {
+func (x *fastReflection_CorporationFreeze) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_CorporationFreeze_corporation_id, value) {
 			return
 		}
 	}
-	if x.CorporationId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.CorporationId)
-		if !f(fd_VSOperatorAuthorization_corporation_id, value) {
+	if x.FrozenBy != "" {
+		value := protoreflect.ValueOfString(x.FrozenBy)
+		if !f(fd_CorporationFreeze_frozen_by, value) {
 			return
 		}
 	}
-	if x.VsOperator != "" {
-		value := protoreflect.ValueOfString(x.VsOperator)
-		if !f(fd_VSOperatorAuthorization_vs_operator, value) {
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_CorporationFreeze_reason, value) {
 			return
 		}
 	}
-	if len(x.Records) != 0 {
-		value := protoreflect.ValueOfList(&_VSOperatorAuthorization_4_list{list: &x.Records})
-		if !f(fd_VSOperatorAuthorization_records, value) {
+	if x.FrozenAt != nil {
+		value := protoreflect.ValueOfMessage(x.FrozenAt.ProtoReflect())
+		if !f(fd_CorporationFreeze_frozen_at, value) {
 			return
 		}
 	}
@@ -4476,21 +6356,21 @@ func (x *fastReflection_VSOperatorAuthorization) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VSOperatorAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CorporationFreeze) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.de.v1.VSOperatorAuthorization.id":
-		return x.Id != uint64(0)
-	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+	case "verana.de.v1.CorporationFreeze.corporation_id":
 		return x.CorporationId != uint64(0)
-	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
-		return x.VsOperator != ""
-	case "verana.de.v1.VSOperatorAuthorization.records":
-		return len(x.Records) != 0
+	case "verana.de.v1.CorporationFreeze.frozen_by":
+		return x.FrozenBy != ""
+	case "verana.de.v1.CorporationFreeze.reason":
+		return x.Reason != ""
+	case "verana.de.v1.CorporationFreeze.frozen_at":
+		return x.FrozenAt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.CorporationFreeze"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.CorporationFreeze does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4500,21 +6380,21 @@ func (x *fastReflection_VSOperatorAuthorization) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VSOperatorAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CorporationFreeze) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.de.v1.VSOperatorAuthorization.id":
-		x.Id = uint64(0)
-	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+	case "verana.de.v1.CorporationFreeze.corporation_id":
 		x.CorporationId = uint64(0)
-	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
-		x.VsOperator = ""
-	case "verana.de.v1.VSOperatorAuthorization.records":
-		x.Records = nil
+	case "verana.de.v1.CorporationFreeze.frozen_by":
+		x.FrozenBy = ""
+	case "verana.de.v1.CorporationFreeze.reason":
+		x.Reason = ""
+	case "verana.de.v1.CorporationFreeze.frozen_at":
+		x.FrozenAt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.CorporationFreeze"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.CorporationFreeze does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4524,28 +6404,25 @@ func (x *fastReflection_VSOperatorAuthorization) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VSOperatorAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CorporationFreeze) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.de.v1.VSOperatorAuthorization.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+	case "verana.de.v1.CorporationFreeze.corporation_id":
 		value := x.CorporationId
 		return protoreflect.ValueOfUint64(value)
-	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
-		value := x.VsOperator
+	case "verana.de.v1.CorporationFreeze.frozen_by":
+		value := x.FrozenBy
 		return protoreflect.ValueOfString(value)
-	case "verana.de.v1.VSOperatorAuthorization.records":
-		if len(x.Records) == 0 {
-			return protoreflect.ValueOfList(&_VSOperatorAuthorization_4_list{})
-		}
-		listValue := &_VSOperatorAuthorization_4_list{list: &x.Records}
-		return protoreflect.ValueOfList(listValue)
+	case "verana.de.v1.CorporationFreeze.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "verana.de.v1.CorporationFreeze.frozen_at":
+		value := x.FrozenAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.CorporationFreeze"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.CorporationFreeze does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4559,23 +6436,21 @@ func (x *fastReflection_VSOperatorAuthorization) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VSOperatorAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CorporationFreeze) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.de.v1.VSOperatorAuthorization.id":
-		x.Id = value.Uint()
-	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
+	case "verana.de.v1.CorporationFreeze.corporation_id":
 		x.CorporationId = value.Uint()
-	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
-		x.VsOperator = value.Interface().(string)
-	case "verana.de.v1.VSOperatorAuthorization.records":
-		lv := value.List()
-		clv := lv.(*_VSOperatorAuthorization_4_list)
-		x.Records = *clv.list
+	case "verana.de.v1.CorporationFreeze.frozen_by":
+		x.FrozenBy = value.Interface().(string)
+	case "verana.de.v1.CorporationFreeze.reason":
+		x.Reason = value.Interface().(string)
+	case "verana.de.v1.CorporationFreeze.frozen_at":
+		x.FrozenAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.CorporationFreeze"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.CorporationFreeze does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4589,57 +6464,56 @@ func (x *fastReflection_VSOperatorAuthorization) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VSOperatorAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CorporationFreeze) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.VSOperatorAuthorization.records":
-		if x.Records == nil {
-			x.Records = []*ParticipantAuthorizationRecord{}
-		}
-		value := &_VSOperatorAuthorization_4_list{list: &x.Records}
-		return protoreflect.ValueOfList(value)
-	case "verana.de.v1.VSOperatorAuthorization.id":
-		panic(fmt.Errorf("field id of message verana.de.v1.VSOperatorAuthorization is not mutable"))
-	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
-		panic(fmt.Errorf("field corporation_id of message verana.de.v1.VSOperatorAuthorization is not mutable"))
-	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
-		panic(fmt.Errorf("field vs_operator of message verana.de.v1.VSOperatorAuthorization is not mutable"))
+	case "verana.de.v1.CorporationFreeze.frozen_at":
+		if x.FrozenAt == nil {
+			x.FrozenAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.FrozenAt.ProtoReflect())
+	case "verana.de.v1.CorporationFreeze.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.de.v1.CorporationFreeze is not mutable"))
+	case "verana.de.v1.CorporationFreeze.frozen_by":
+		panic(fmt.Errorf("field frozen_by of message verana.de.v1.CorporationFreeze is not mutable"))
+	case "verana.de.v1.CorporationFreeze.reason":
+		panic(fmt.Errorf("field reason of message verana.de.v1.CorporationFreeze is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.CorporationFreeze"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.CorporationFreeze does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VSOperatorAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CorporationFreeze) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.de.v1.VSOperatorAuthorization.id":
+	case "verana.de.v1.CorporationFreeze.corporation_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.de.v1.VSOperatorAuthorization.corporation_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.de.v1.VSOperatorAuthorization.vs_operator":
+	case "verana.de.v1.CorporationFreeze.frozen_by":
 		return protoreflect.ValueOfString("")
-	case "verana.de.v1.VSOperatorAuthorization.records":
-		list := []*ParticipantAuthorizationRecord{}
-		return protoreflect.ValueOfList(&_VSOperatorAuthorization_4_list{list: &list})
+	case "verana.de.v1.CorporationFreeze.reason":
+		return protoreflect.ValueOfString("")
+	case "verana.de.v1.CorporationFreeze.frozen_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.VSOperatorAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.de.v1.CorporationFreeze"))
 		}
-		panic(fmt.Errorf("message verana.de.v1.VSOperatorAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.de.v1.CorporationFreeze does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VSOperatorAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CorporationFreeze) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.VSOperatorAuthorization", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.de.v1.CorporationFreeze", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4647,7 +6521,7 @@ func (x *fastReflection_VSOperatorAuthorization) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VSOperatorAuthorization) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CorporationFreeze) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4658,7 +6532,7 @@ func (x *fastReflection_VSOperatorAuthorization) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VSOperatorAuthorization) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CorporationFreeze) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4670,7 +6544,7 @@ func (x *fastReflection_VSOperatorAuthorization) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VSOperatorAuthorization) IsValid() bool {
+func (x *fastReflection_CorporationFreeze) IsValid() bool {
 	return x != nil
 }
 
@@ -4680,9 +6554,9 @@ func (x *fastReflection_VSOperatorAuthorization) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CorporationFreeze) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VSOperatorAuthorization)
+		x := input.Message.Interface().(*CorporationFreeze)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4694,21 +6568,20 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
 		if x.CorporationId != 0 {
 			n += 1 + runtime.Sov(uint64(x.CorporationId))
 		}
-		l = len(x.VsOperator)
+		l = len(x.FrozenBy)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Records) > 0 {
-			for _, e := range x.Records {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FrozenAt != nil {
+			l = options.Size(x.FrozenAt)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4720,7 +6593,7 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VSOperatorAuthorization)
+		x := input.Message.Interface().(*CorporationFreeze)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4729,46 +6602,46 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 		}
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Records) > 0 {
-			for iNdEx := len(x.Records) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Records[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FrozenAt != nil {
+			encoded, err := options.Marshal(x.FrozenAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
 		}
-		if len(x.VsOperator) > 0 {
-			i -= len(x.VsOperator)
-			copy(dAtA[i:], x.VsOperator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VsOperator)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.CorporationId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+		if len(x.FrozenBy) > 0 {
+			i -= len(x.FrozenBy)
+			copy(dAtA[i:], x.FrozenBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FrozenBy)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4783,7 +6656,7 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VSOperatorAuthorization)
+		x := input.Message.Interface().(*CorporationFreeze)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4815,17 +6688,17 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VSOperatorAuthorization: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CorporationFreeze: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VSOperatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CorporationFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 				}
-				x.Id = 0
+				x.CorporationId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4835,16 +6708,16 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.CorporationId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
 				}
-				x.CorporationId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4854,14 +6727,27 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.CorporationId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FrozenBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4889,11 +6775,11 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VsOperator = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenAt", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4920,8 +6806,10 @@ func (x *fastReflection_VSOperatorAuthorization) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Records = append(x.Records, &ParticipantAuthorizationRecord{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Records[len(x.Records)-1]); err != nil {
+				if x.FrozenAt == nil {
+					x.FrozenAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FrozenAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5007,6 +6895,16 @@ type OperatorAuthorization struct {
 	// period is the reset period for spend_limit and fee_spend_limit. If set,
 	// expiration MUST also be set.
 	Period *durationpb.Duration `protobuf:"bytes,10,opt,name=period,proto3" json:"period,omitempty"`
+	// role is the name of the OperatorRole of corporation_id this authorization
+	// was granted from, empty if granted without role. msg_types follow the
+	// role when it is edited.
+	Role string `protobuf:"bytes,11,opt,name=role,proto3" json:"role,omitempty"`
+	// pending is true while the authorization awaits the
+	// MsgAcceptOperatorAuthorization of its operator.
+	Pending bool `protobuf:"varint,12,opt,name=pending,proto3" json:"pending,omitempty"`
+	// not_before is the optional time before which the authorization is not
+	// active, leaving the members of the corporation time to revoke it.
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
 }
 
 func (x *OperatorAuthorization) Reset() {
@@ -5099,6 +6997,114 @@ func (x *OperatorAuthorization) GetPeriod() *durationpb.Duration {
 	return nil
 }
 
+func (x *OperatorAuthorization) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OperatorAuthorization) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *OperatorAuthorization) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+// OperatorRole is a corporation-scoped named template of msg_types and
+// default limits that operator authorizations can be granted from.
+type OperatorRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation_id is the id of the corporation owning the role. The
+	// (corporation_id, name) tuple MUST be unique.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// name identifies the role within the corporation (e.g. "schema-admin").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_types is the list of VPR delegable message types granted by the role.
+	MsgTypes []string `protobuf:"bytes,3,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// spend_limit is the default spend_limit of the authorizations granted from
+	// the role.
+	SpendLimit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// fee_spend_limit is the default fee_spend_limit of the authorizations
+	// granted from the role.
+	FeeSpendLimit []*v1beta1.Coin `protobuf:"bytes,5,rep,name=fee_spend_limit,json=feeSpendLimit,proto3" json:"fee_spend_limit,omitempty"`
+	// period is the default reset period of the authorizations granted from the
+	// role.
+	Period *durationpb.Duration `protobuf:"bytes,6,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *OperatorRole) Reset() {
+	*x = OperatorRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_de_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatorRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatorRole) ProtoMessage() {}
+
+// Deprecated: Use OperatorRole.ProtoReflect.Descriptor instead.
+func (*OperatorRole) Descriptor() ([]byte, []int) {
+	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OperatorRole) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *OperatorRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperatorRole) GetMsgTypes() []string {
+	if x != nil {
+		return x.MsgTypes
+	}
+	return nil
+}
+
+func (x *OperatorRole) GetSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return nil
+}
+
+func (x *OperatorRole) GetFeeSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeSpendLimit
+	}
+	return nil
+}
+
+func (x *OperatorRole) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
 // OperatorAuthorizationUsage tracks per-authorization spend consumption so spec
 // [AUTHZ-CHECK-1] can enforce the spend_limit / period-reset invariant. Keyed by
 // the parent OperatorAuthorization id.
@@ -5125,7 +7131,7 @@ type OperatorAuthorizationUsage struct {
 func (x *OperatorAuthorizationUsage) Reset() {
 	*x = OperatorAuthorizationUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_de_v1_types_proto_msgTypes[1]
+		mi := &file_verana_de_v1_types_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5139,7 +7145,7 @@ func (*OperatorAuthorizationUsage) ProtoMessage() {}
 
 // Deprecated: Use OperatorAuthorizationUsage.ProtoReflect.Descriptor instead.
 func (*OperatorAuthorizationUsage) Descriptor() ([]byte, []int) {
-	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{1}
+	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OperatorAuthorizationUsage) GetOperatorAuthorizationId() uint64 {
@@ -5188,12 +7194,18 @@ type FeeGrant struct {
 	// period is the reset period for spend_limit. If set, expiration MUST also be
 	// set.
 	Period *durationpb.Duration `protobuf:"bytes,7,opt,name=period,proto3" json:"period,omitempty"`
+	// pending mirrors the pending field of the OperatorAuthorization the fee
+	// grant was granted with; the fee grant is not usable while true.
+	Pending bool `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	// not_before mirrors the not_before field of the OperatorAuthorization the
+	// fee grant was granted with; the fee grant is not usable before it.
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
 }
 
 func (x *FeeGrant) Reset() {
 	*x = FeeGrant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_de_v1_types_proto_msgTypes[2]
+		mi := &file_verana_de_v1_types_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5207,7 +7219,7 @@ func (*FeeGrant) ProtoMessage() {}
 
 // Deprecated: Use FeeGrant.ProtoReflect.Descriptor instead.
 func (*FeeGrant) Descriptor() ([]byte, []int) {
-	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *FeeGrant) GetGrantorCorporationId() uint64 {
@@ -5259,6 +7271,20 @@ func (x *FeeGrant) GetPeriod() *durationpb.Duration {
 	return nil
 }
 
+func (x *FeeGrant) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *FeeGrant) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
 // ParticipantAuthorizationRecord is a per-participant VS-operator authorization
 // nested inside a VSOperatorAuthorization. Keyed by participant_id, which is
 // globally unique across all records of all VSOperatorAuthorizations.
@@ -5297,7 +7323,7 @@ type ParticipantAuthorizationRecord struct {
 func (x *ParticipantAuthorizationRecord) Reset() {
 	*x = ParticipantAuthorizationRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_de_v1_types_proto_msgTypes[3]
+		mi := &file_verana_de_v1_types_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5311,7 +7337,7 @@ func (*ParticipantAuthorizationRecord) ProtoMessage() {}
 
 // Deprecated: Use ParticipantAuthorizationRecord.ProtoReflect.Descriptor instead.
 func (*ParticipantAuthorizationRecord) Descriptor() ([]byte, []int) {
-	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{3}
+	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ParticipantAuthorizationRecord) GetParticipantId() uint64 {
@@ -5400,7 +7426,7 @@ type VSOperatorAuthorization struct {
 func (x *VSOperatorAuthorization) Reset() {
 	*x = VSOperatorAuthorization{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_de_v1_types_proto_msgTypes[4]
+		mi := &file_verana_de_v1_types_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5414,7 +7440,7 @@ func (*VSOperatorAuthorization) ProtoMessage() {}
 
 // Deprecated: Use VSOperatorAuthorization.ProtoReflect.Descriptor instead.
 func (*VSOperatorAuthorization) Descriptor() ([]byte, []int) {
-	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{4}
+	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *VSOperatorAuthorization) GetId() uint64 {
@@ -5445,6 +7471,73 @@ func (x *VSOperatorAuthorization) GetRecords() []*ParticipantAuthorizationRecord
 	return nil
 }
 
+// CorporationFreeze records that every operator and VS operator authorization
+// of a corporation is suspended until the corporation unfreezes them through a
+// group proposal.
+type CorporationFreeze struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation_id is the id of the frozen corporation.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// frozen_by is the operator that froze the corporation, empty when it was
+	// frozen through a group proposal.
+	FrozenBy string `protobuf:"bytes,2,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty"`
+	// reason is the optional reason given for the freeze.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// frozen_at is the time the corporation was frozen.
+	FrozenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+}
+
+func (x *CorporationFreeze) Reset() {
+	*x = CorporationFreeze{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_de_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorporationFreeze) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorporationFreeze) ProtoMessage() {}
+
+// Deprecated: Use CorporationFreeze.ProtoReflect.Descriptor instead.
+func (*CorporationFreeze) Descriptor() ([]byte, []int) {
+	return file_verana_de_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CorporationFreeze) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *CorporationFreeze) GetFrozenBy() string {
+	if x != nil {
+		return x.FrozenBy
+	}
+	return ""
+}
+
+func (x *CorporationFreeze) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CorporationFreeze) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
 var File_verana_de_v1_types_proto protoreflect.FileDescriptor
 
 var file_verana_de_v1_types_proto_rawDesc = []byte{
@@ -5459,7 +7552,7 @@ var file_verana_de_v1_types_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x05, 0x0a, 0x15, 0x4f,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x06, 0x0a, 0x15, 0x4f,
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
@@ -5506,130 +7599,180 @@ var file_verana_de_v1_types_proto_rawDesc = []byte{
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
 	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
-	0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x02, 0x0a,
-	0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x69, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
-	0x6e, 0x67, 0x12, 0x43, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x6c, 0x61,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xf9,
-	0x03, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x67,
-	0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x74, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70,
-	0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a,
+	0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x0b,
+	0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x03, 0x0a, 0x0c,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
 	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x22, 0xda, 0x05, 0x0a, 0x1e, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x74, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x65,
-	0x6e, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x73, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65,
-	0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x73, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x22, 0x9f, 0x02, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x3a, 0x0a, 0x19, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x69, 0x0a, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
 	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x66, 0x65, 0x65,
-	0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x7b, 0x0a, 0x13, 0x72, 0x65,
-	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e,
-	0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x72,
+	0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0xd4, 0x04, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x16, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x70, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x73,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x74, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x3f, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x22, 0xda, 0x05, 0x0a, 0x1e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x6c, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x74,
+	0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
 	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x46,
-	0x65, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x5f,
-	0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x77, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0a,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
-	0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37,
-	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52,
-	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x17, 0x56, 0x53, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72,
-	0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x76, 0x73,
-	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x76, 0x73, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
-	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0xa5, 0x01,
-	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x64, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x64, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x64, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x44, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x5c, 0x44, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x5c, 0x44, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x44,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x73, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x7b, 0x0a, 0x13, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66,
+	0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77,
+	0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x17, 0x56, 0x53, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x76, 0x73, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc, 0x01, 0x0a,
+	0x11, 0x43, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x74, 0x42, 0xa5, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64,
+	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x44, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x5c, 0x44, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x5c, 0x44, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x44, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5644,42 +7787,50 @@ func file_verana_de_v1_types_proto_rawDescGZIP() []byte {
 	return file_verana_de_v1_types_proto_rawDescData
 }
 
-var file_verana_de_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_verana_de_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_verana_de_v1_types_proto_goTypes = []interface{}{
 	(*OperatorAuthorization)(nil),          // 0: verana.de.v1.OperatorAuthorization
-	(*OperatorAuthorizationUsage)(nil),     // 1: verana.de.v1.OperatorAuthorizationUsage
-	(*FeeGrant)(nil),                       // 2: verana.de.v1.FeeGrant
-	(*ParticipantAuthorizationRecord)(nil), // 3: verana.de.v1.ParticipantAuthorizationRecord
-	(*VSOperatorAuthorization)(nil),        // 4: verana.de.v1.VSOperatorAuthorization
-	(*v1beta1.Coin)(nil),                   // 5: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),          // 6: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),            // 7: google.protobuf.Duration
+	(*OperatorRole)(nil),                   // 1: verana.de.v1.OperatorRole
+	(*OperatorAuthorizationUsage)(nil),     // 2: verana.de.v1.OperatorAuthorizationUsage
+	(*FeeGrant)(nil),                       // 3: verana.de.v1.FeeGrant
+	(*ParticipantAuthorizationRecord)(nil), // 4: verana.de.v1.ParticipantAuthorizationRecord
+	(*VSOperatorAuthorization)(nil),        // 5: verana.de.v1.VSOperatorAuthorization
+	(*CorporationFreeze)(nil),              // 6: verana.de.v1.CorporationFreeze
+	(*v1beta1.Coin)(nil),                   // 7: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),          // 8: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 9: google.protobuf.Duration
 }
 var file_verana_de_v1_types_proto_depIdxs = []int32{
-	5,  // 0: verana.de.v1.OperatorAuthorization.spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 1: verana.de.v1.OperatorAuthorization.remaining_spend:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 2: verana.de.v1.OperatorAuthorization.fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 3: verana.de.v1.OperatorAuthorization.remaining_fee_spend:type_name -> cosmos.base.v1beta1.Coin
-	6,  // 4: verana.de.v1.OperatorAuthorization.expiration:type_name -> google.protobuf.Timestamp
-	7,  // 5: verana.de.v1.OperatorAuthorization.period:type_name -> google.protobuf.Duration
-	5,  // 6: verana.de.v1.OperatorAuthorizationUsage.remaining:type_name -> cosmos.base.v1beta1.Coin
-	6,  // 7: verana.de.v1.OperatorAuthorizationUsage.last_reset:type_name -> google.protobuf.Timestamp
-	5,  // 8: verana.de.v1.FeeGrant.spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 9: verana.de.v1.FeeGrant.remaining_spend:type_name -> cosmos.base.v1beta1.Coin
-	6,  // 10: verana.de.v1.FeeGrant.expiration:type_name -> google.protobuf.Timestamp
-	7,  // 11: verana.de.v1.FeeGrant.period:type_name -> google.protobuf.Duration
-	5,  // 12: verana.de.v1.ParticipantAuthorizationRecord.spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 13: verana.de.v1.ParticipantAuthorizationRecord.remaining_spend:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 14: verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 15: verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend:type_name -> cosmos.base.v1beta1.Coin
-	6,  // 16: verana.de.v1.ParticipantAuthorizationRecord.expiration:type_name -> google.protobuf.Timestamp
-	7,  // 17: verana.de.v1.ParticipantAuthorizationRecord.period:type_name -> google.protobuf.Duration
-	3,  // 18: verana.de.v1.VSOperatorAuthorization.records:type_name -> verana.de.v1.ParticipantAuthorizationRecord
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	7,  // 0: verana.de.v1.OperatorAuthorization.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 1: verana.de.v1.OperatorAuthorization.remaining_spend:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 2: verana.de.v1.OperatorAuthorization.fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 3: verana.de.v1.OperatorAuthorization.remaining_fee_spend:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 4: verana.de.v1.OperatorAuthorization.expiration:type_name -> google.protobuf.Timestamp
+	9,  // 5: verana.de.v1.OperatorAuthorization.period:type_name -> google.protobuf.Duration
+	8,  // 6: verana.de.v1.OperatorAuthorization.not_before:type_name -> google.protobuf.Timestamp
+	7,  // 7: verana.de.v1.OperatorRole.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 8: verana.de.v1.OperatorRole.fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 9: verana.de.v1.OperatorRole.period:type_name -> google.protobuf.Duration
+	7,  // 10: verana.de.v1.OperatorAuthorizationUsage.remaining:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 11: verana.de.v1.OperatorAuthorizationUsage.last_reset:type_name -> google.protobuf.Timestamp
+	7,  // 12: verana.de.v1.FeeGrant.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 13: verana.de.v1.FeeGrant.remaining_spend:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 14: verana.de.v1.FeeGrant.expiration:type_name -> google.protobuf.Timestamp
+	9,  // 15: verana.de.v1.FeeGrant.period:type_name -> google.protobuf.Duration
+	8,  // 16: verana.de.v1.FeeGrant.not_before:type_name -> google.protobuf.Timestamp
+	7,  // 17: verana.de.v1.ParticipantAuthorizationRecord.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 18: verana.de.v1.ParticipantAuthorizationRecord.remaining_spend:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 19: verana.de.v1.ParticipantAuthorizationRecord.fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 20: verana.de.v1.ParticipantAuthorizationRecord.remaining_fee_spend:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 21: verana.de.v1.ParticipantAuthorizationRecord.expiration:type_name -> google.protobuf.Timestamp
+	9,  // 22: verana.de.v1.ParticipantAuthorizationRecord.period:type_name -> google.protobuf.Duration
+	4,  // 23: verana.de.v1.VSOperatorAuthorization.records:type_name -> verana.de.v1.ParticipantAuthorizationRecord
+	8,  // 24: verana.de.v1.CorporationFreeze.frozen_at:type_name -> google.protobuf.Timestamp
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_verana_de_v1_types_proto_init() }
@@ -5701,7 +7852,7 @@ func file_verana_de_v1_types_proto_init() {
 			}
 		}
 		file_verana_de_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperatorAuthorizationUsage); i {
+			switch v := v.(*OperatorRole); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5713,7 +7864,7 @@ func file_verana_de_v1_types_proto_init() {
 			}
 		}
 		file_verana_de_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeGrant); i {
+			switch v := v.(*OperatorAuthorizationUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5725,7 +7876,7 @@ func file_verana_de_v1_types_proto_init() {
 			}
 		}
 		file_verana_de_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParticipantAuthorizationRecord); i {
+			switch v := v.(*FeeGrant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5737,6 +7888,18 @@ func file_verana_de_v1_types_proto_init() {
 			}
 		}
 		file_verana_de_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantAuthorizationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_de_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VSOperatorAuthorization); i {
 			case 0:
 				return &v.state
@@ -5748,6 +7911,18 @@ func file_verana_de_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_verana_de_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorporationFreeze); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5755,7 +7930,7 @@ func file_verana_de_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_de_v1_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -116,12 +116,64 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*ParticipantCascade
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantCascade)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantCascade)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantCascade)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(ParticipantCascade)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                      protoreflect.MessageDescriptor
 	fd_GenesisState_params               protoreflect.FieldDescriptor
 	fd_GenesisState_participants         protoreflect.FieldDescriptor
 	fd_GenesisState_participant_sessions protoreflect.FieldDescriptor
 	fd_GenesisState_next_participant_id  protoreflect.FieldDescriptor
+	fd_GenesisState_participant_cascades protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -131,6 +183,7 @@ func init() {
 	fd_GenesisState_participants = md_GenesisState.Fields().ByName("participants")
 	fd_GenesisState_participant_sessions = md_GenesisState.Fields().ByName("participant_sessions")
 	fd_GenesisState_next_participant_id = md_GenesisState.Fields().ByName("next_participant_id")
+	fd_GenesisState_participant_cascades = md_GenesisState.Fields().ByName("participant_cascades")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -222,6 +275,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ParticipantCascades) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ParticipantCascades})
+		if !f(fd_GenesisState_participant_cascades, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -245,6 +304,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.ParticipantSessions) != 0
 	case "verana.pp.v1.GenesisState.next_participant_id":
 		return x.NextParticipantId != uint64(0)
+	case "verana.pp.v1.GenesisState.participant_cascades":
+		return len(x.ParticipantCascades) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.GenesisState"))
@@ -269,6 +330,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.ParticipantSessions = nil
 	case "verana.pp.v1.GenesisState.next_participant_id":
 		x.NextParticipantId = uint64(0)
+	case "verana.pp.v1.GenesisState.participant_cascades":
+		x.ParticipantCascades = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.GenesisState"))
@@ -303,6 +366,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "verana.pp.v1.GenesisState.next_participant_id":
 		value := x.NextParticipantId
 		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.GenesisState.participant_cascades":
+		if len(x.ParticipantCascades) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ParticipantCascades}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.GenesisState"))
@@ -335,6 +404,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.ParticipantSessions = *clv.list
 	case "verana.pp.v1.GenesisState.next_participant_id":
 		x.NextParticipantId = value.Uint()
+	case "verana.pp.v1.GenesisState.participant_cascades":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ParticipantCascades = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.GenesisState"))
@@ -372,6 +445,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.ParticipantSessions}
 		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.GenesisState.participant_cascades":
+		if x.ParticipantCascades == nil {
+			x.ParticipantCascades = []*ParticipantCascade{}
+		}
+		value := &_GenesisState_5_list{list: &x.ParticipantCascades}
+		return protoreflect.ValueOfList(value)
 	case "verana.pp.v1.GenesisState.next_participant_id":
 		panic(fmt.Errorf("field next_participant_id of message verana.pp.v1.GenesisState is not mutable"))
 	default:
@@ -398,6 +477,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
 	case "verana.pp.v1.GenesisState.next_participant_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.GenesisState.participant_cascades":
+		list := []*ParticipantCascade{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.GenesisState"))
@@ -486,6 +568,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.NextParticipantId != 0 {
 			n += 1 + runtime.Sov(uint64(x.NextParticipantId))
 		}
+		if len(x.ParticipantCascades) > 0 {
+			for _, e := range x.ParticipantCascades {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -515,6 +603,22 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ParticipantCascades) > 0 {
+			for iNdEx := len(x.ParticipantCascades) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParticipantCascades[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if x.NextParticipantId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextParticipantId))
 			i--
@@ -738,6 +842,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantCascades", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipantCascades = append(x.ParticipantCascades, &ParticipantCascade{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantCascades[len(x.ParticipantCascades)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -800,6 +938,9 @@ type GenesisState struct {
 	ParticipantSessions []*ParticipantSession `protobuf:"bytes,3,rep,name=participant_sessions,json=participantSessions,proto3" json:"participant_sessions,omitempty"`
 	// next_participant_id is the next auto-increment ID to be assigned to a new participant
 	NextParticipantId uint64 `protobuf:"varint,4,opt,name=next_participant_id,json=nextParticipantId,proto3" json:"next_participant_id,omitempty"`
+	// participant_cascades is a list of all ParticipantCascade objects,
+	// including the ones still in progress
+	ParticipantCascades []*ParticipantCascade `protobuf:"bytes,5,rep,name=participant_cascades,json=participantCascades,proto3" json:"participant_cascades,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -850,6 +991,13 @@ func (x *GenesisState) GetNextParticipantId() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetParticipantCascades() []*ParticipantCascade {
+	if x != nil {
+		return x.ParticipantCascades
+	}
+	return nil
+}
+
 var File_verana_pp_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_verana_pp_v1_genesis_proto_rawDesc = []byte{
@@ -861,7 +1009,7 @@ var file_verana_pp_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76,
 	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
 	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x72, 0x61,
 	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
@@ -879,18 +1027,23 @@ var file_verana_pp_v1_genesis_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
 	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x11, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x42, 0xa7, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56,
-	0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x64, 0x12, 0x59, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61,
+	0x64, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x73, 0x42, 0xa7, 0x01,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f,
+	0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0c,
+	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a,
+	0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -911,16 +1064,18 @@ var file_verana_pp_v1_genesis_proto_goTypes = []interface{}{
 	(*Params)(nil),             // 1: verana.pp.v1.Params
 	(*Participant)(nil),        // 2: verana.pp.v1.Participant
 	(*ParticipantSession)(nil), // 3: verana.pp.v1.ParticipantSession
+	(*ParticipantCascade)(nil), // 4: verana.pp.v1.ParticipantCascade
 }
 var file_verana_pp_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: verana.pp.v1.GenesisState.params:type_name -> verana.pp.v1.Params
 	2, // 1: verana.pp.v1.GenesisState.participants:type_name -> verana.pp.v1.Participant
 	3, // 2: verana.pp.v1.GenesisState.participant_sessions:type_name -> verana.pp.v1.ParticipantSession
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: verana.pp.v1.GenesisState.participant_cascades:type_name -> verana.pp.v1.ParticipantCascade
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_verana_pp_v1_genesis_proto_init() }
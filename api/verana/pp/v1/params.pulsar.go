@@ -14,15 +14,71 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]uint64
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field ExpiryNoticeLeadDays as it is not of Message kind"))
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                                        protoreflect.MessageDescriptor
 	fd_Params_validation_term_requested_timeout_days protoreflect.FieldDescriptor
+	fd_Params_participant_session_retention_days     protoreflect.FieldDescriptor
+	fd_Params_fee_change_notice_period_days          protoreflect.FieldDescriptor
+	fd_Params_slash_dispute_window_days              protoreflect.FieldDescriptor
+	fd_Params_expiry_notice_lead_days                protoreflect.FieldDescriptor
+	fd_Params_max_batch_size                         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_params_proto_init()
 	md_Params = File_verana_pp_v1_params_proto.Messages().ByName("Params")
 	fd_Params_validation_term_requested_timeout_days = md_Params.Fields().ByName("validation_term_requested_timeout_days")
+	fd_Params_participant_session_retention_days = md_Params.Fields().ByName("participant_session_retention_days")
+	fd_Params_fee_change_notice_period_days = md_Params.Fields().ByName("fee_change_notice_period_days")
+	fd_Params_slash_dispute_window_days = md_Params.Fields().ByName("slash_dispute_window_days")
+	fd_Params_expiry_notice_lead_days = md_Params.Fields().ByName("expiry_notice_lead_days")
+	fd_Params_max_batch_size = md_Params.Fields().ByName("max_batch_size")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -96,6 +152,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ParticipantSessionRetentionDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipantSessionRetentionDays)
+		if !f(fd_Params_participant_session_retention_days, value) {
+			return
+		}
+	}
+	if x.FeeChangeNoticePeriodDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FeeChangeNoticePeriodDays)
+		if !f(fd_Params_fee_change_notice_period_days, value) {
+			return
+		}
+	}
+	if x.SlashDisputeWindowDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashDisputeWindowDays)
+		if !f(fd_Params_slash_dispute_window_days, value) {
+			return
+		}
+	}
+	if len(x.ExpiryNoticeLeadDays) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.ExpiryNoticeLeadDays})
+		if !f(fd_Params_expiry_notice_lead_days, value) {
+			return
+		}
+	}
+	if x.MaxBatchSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBatchSize)
+		if !f(fd_Params_max_batch_size, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +199,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "verana.pp.v1.Params.validation_term_requested_timeout_days":
 		return x.ValidationTermRequestedTimeoutDays != uint64(0)
+	case "verana.pp.v1.Params.participant_session_retention_days":
+		return x.ParticipantSessionRetentionDays != uint64(0)
+	case "verana.pp.v1.Params.fee_change_notice_period_days":
+		return x.FeeChangeNoticePeriodDays != uint64(0)
+	case "verana.pp.v1.Params.slash_dispute_window_days":
+		return x.SlashDisputeWindowDays != uint64(0)
+	case "verana.pp.v1.Params.expiry_notice_lead_days":
+		return len(x.ExpiryNoticeLeadDays) != 0
+	case "verana.pp.v1.Params.max_batch_size":
+		return x.MaxBatchSize != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Params"))
@@ -131,6 +227,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "verana.pp.v1.Params.validation_term_requested_timeout_days":
 		x.ValidationTermRequestedTimeoutDays = uint64(0)
+	case "verana.pp.v1.Params.participant_session_retention_days":
+		x.ParticipantSessionRetentionDays = uint64(0)
+	case "verana.pp.v1.Params.fee_change_notice_period_days":
+		x.FeeChangeNoticePeriodDays = uint64(0)
+	case "verana.pp.v1.Params.slash_dispute_window_days":
+		x.SlashDisputeWindowDays = uint64(0)
+	case "verana.pp.v1.Params.expiry_notice_lead_days":
+		x.ExpiryNoticeLeadDays = nil
+	case "verana.pp.v1.Params.max_batch_size":
+		x.MaxBatchSize = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Params"))
@@ -150,6 +256,24 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "verana.pp.v1.Params.validation_term_requested_timeout_days":
 		value := x.ValidationTermRequestedTimeoutDays
 		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.Params.participant_session_retention_days":
+		value := x.ParticipantSessionRetentionDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.Params.fee_change_notice_period_days":
+		value := x.FeeChangeNoticePeriodDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.Params.slash_dispute_window_days":
+		value := x.SlashDisputeWindowDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.Params.expiry_notice_lead_days":
+		if len(x.ExpiryNoticeLeadDays) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.ExpiryNoticeLeadDays}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.Params.max_batch_size":
+		value := x.MaxBatchSize
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Params"))
@@ -172,6 +296,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "verana.pp.v1.Params.validation_term_requested_timeout_days":
 		x.ValidationTermRequestedTimeoutDays = value.Uint()
+	case "verana.pp.v1.Params.participant_session_retention_days":
+		x.ParticipantSessionRetentionDays = value.Uint()
+	case "verana.pp.v1.Params.fee_change_notice_period_days":
+		x.FeeChangeNoticePeriodDays = value.Uint()
+	case "verana.pp.v1.Params.slash_dispute_window_days":
+		x.SlashDisputeWindowDays = value.Uint()
+	case "verana.pp.v1.Params.expiry_notice_lead_days":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.ExpiryNoticeLeadDays = *clv.list
+	case "verana.pp.v1.Params.max_batch_size":
+		x.MaxBatchSize = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Params"))
@@ -192,8 +328,22 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.pp.v1.Params.expiry_notice_lead_days":
+		if x.ExpiryNoticeLeadDays == nil {
+			x.ExpiryNoticeLeadDays = []uint64{}
+		}
+		value := &_Params_5_list{list: &x.ExpiryNoticeLeadDays}
+		return protoreflect.ValueOfList(value)
 	case "verana.pp.v1.Params.validation_term_requested_timeout_days":
 		panic(fmt.Errorf("field validation_term_requested_timeout_days of message verana.pp.v1.Params is not mutable"))
+	case "verana.pp.v1.Params.participant_session_retention_days":
+		panic(fmt.Errorf("field participant_session_retention_days of message verana.pp.v1.Params is not mutable"))
+	case "verana.pp.v1.Params.fee_change_notice_period_days":
+		panic(fmt.Errorf("field fee_change_notice_period_days of message verana.pp.v1.Params is not mutable"))
+	case "verana.pp.v1.Params.slash_dispute_window_days":
+		panic(fmt.Errorf("field slash_dispute_window_days of message verana.pp.v1.Params is not mutable"))
+	case "verana.pp.v1.Params.max_batch_size":
+		panic(fmt.Errorf("field max_batch_size of message verana.pp.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Params"))
@@ -209,6 +359,17 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "verana.pp.v1.Params.validation_term_requested_timeout_days":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.Params.participant_session_retention_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.Params.fee_change_notice_period_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.Params.slash_dispute_window_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.Params.expiry_notice_lead_days":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "verana.pp.v1.Params.max_batch_size":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Params"))
@@ -281,6 +442,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ValidationTermRequestedTimeoutDays != 0 {
 			n += 1 + runtime.Sov(uint64(x.ValidationTermRequestedTimeoutDays))
 		}
+		if x.ParticipantSessionRetentionDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipantSessionRetentionDays))
+		}
+		if x.FeeChangeNoticePeriodDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeChangeNoticePeriodDays))
+		}
+		if x.SlashDisputeWindowDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashDisputeWindowDays))
+		}
+		if len(x.ExpiryNoticeLeadDays) > 0 {
+			l = 0
+			for _, e := range x.ExpiryNoticeLeadDays {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.MaxBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBatchSize))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +490,46 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBatchSize))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ExpiryNoticeLeadDays) > 0 {
+			var pksize2 int
+			for _, num := range x.ExpiryNoticeLeadDays {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ExpiryNoticeLeadDays {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SlashDisputeWindowDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashDisputeWindowDays))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.FeeChangeNoticePeriodDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeChangeNoticePeriodDays))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ParticipantSessionRetentionDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipantSessionRetentionDays))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.ValidationTermRequestedTimeoutDays != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationTermRequestedTimeoutDays))
 			i--
@@ -383,6 +603,158 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantSessionRetentionDays", wireType)
+				}
+				x.ParticipantSessionRetentionDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipantSessionRetentionDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeChangeNoticePeriodDays", wireType)
+				}
+				x.FeeChangeNoticePeriodDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeChangeNoticePeriodDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashDisputeWindowDays", wireType)
+				}
+				x.SlashDisputeWindowDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashDisputeWindowDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ExpiryNoticeLeadDays = append(x.ExpiryNoticeLeadDays, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ExpiryNoticeLeadDays) == 0 {
+						x.ExpiryNoticeLeadDays = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ExpiryNoticeLeadDays = append(x.ExpiryNoticeLeadDays, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryNoticeLeadDays", wireType)
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+				}
+				x.MaxBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBatchSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -438,6 +810,24 @@ type Params struct {
 	unknownFields protoimpl.UnknownFields
 
 	ValidationTermRequestedTimeoutDays uint64 `protobuf:"varint,1,opt,name=validation_term_requested_timeout_days,json=validationTermRequestedTimeoutDays,proto3" json:"validation_term_requested_timeout_days,omitempty"`
+	// participant_session_retention_days is the number of days a participant
+	// session is kept after its last modification before it is pruned.
+	// 0 keeps sessions forever.
+	ParticipantSessionRetentionDays uint64 `protobuf:"varint,2,opt,name=participant_session_retention_days,json=participantSessionRetentionDays,proto3" json:"participant_session_retention_days,omitempty"`
+	// fee_change_notice_period_days is the number of days between the
+	// acceptance of a participant fee change and the time it takes effect.
+	FeeChangeNoticePeriodDays uint64 `protobuf:"varint,3,opt,name=fee_change_notice_period_days,json=feeChangeNoticePeriodDays,proto3" json:"fee_change_notice_period_days,omitempty"`
+	// slash_dispute_window_days is the number of days during which a slash can
+	// be appealed before it becomes final. 0 makes slashes final immediately.
+	SlashDisputeWindowDays uint64 `protobuf:"varint,4,opt,name=slash_dispute_window_days,json=slashDisputeWindowDays,proto3" json:"slash_dispute_window_days,omitempty"`
+	// expiry_notice_lead_days are the number of days before the
+	// effective_until or op_exp of a participant at which a
+	// participant_expiring event is emitted. participant_expired is always
+	// emitted at expiry.
+	ExpiryNoticeLeadDays []uint64 `protobuf:"varint,5,rep,packed,name=expiry_notice_lead_days,json=expiryNoticeLeadDays,proto3" json:"expiry_notice_lead_days,omitempty"`
+	// max_batch_size is the maximum number of items of a batch participant
+	// operation.
+	MaxBatchSize uint64 `protobuf:"varint,6,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -467,6 +857,41 @@ func (x *Params) GetValidationTermRequestedTimeoutDays() uint64 {
 	return 0
 }
 
+func (x *Params) GetParticipantSessionRetentionDays() uint64 {
+	if x != nil {
+		return x.ParticipantSessionRetentionDays
+	}
+	return 0
+}
+
+func (x *Params) GetFeeChangeNoticePeriodDays() uint64 {
+	if x != nil {
+		return x.FeeChangeNoticePeriodDays
+	}
+	return 0
+}
+
+func (x *Params) GetSlashDisputeWindowDays() uint64 {
+	if x != nil {
+		return x.SlashDisputeWindowDays
+	}
+	return 0
+}
+
+func (x *Params) GetExpiryNoticeLeadDays() []uint64 {
+	if x != nil {
+		return x.ExpiryNoticeLeadDays
+	}
+	return nil
+}
+
+func (x *Params) GetMaxBatchSize() uint64 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
 var File_verana_pp_v1_params_proto protoreflect.FileDescriptor
 
 var file_verana_pp_v1_params_proto_rawDesc = []byte{
@@ -475,25 +900,44 @@ var file_verana_pp_v1_params_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
 	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x79, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x26,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x22, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x79, 0x73,
-	0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa6, 0x01,
-	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
-	0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76,
-	0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a,
-	0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x22, 0xa0, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x52, 0x0a,
+	0x26, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x22, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x4b, 0x0a, 0x22, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x40,
+	0x0a, 0x1d, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x6f, 0x74,
+	0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x66, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x39, 0x0a, 0x19, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x61,
+	0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x12, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa6, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
+	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5,6 +5,7 @@ import (
 	_ "cosmossdk.io/api/amino"
 	_ "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -812,6 +813,7 @@ var (
 	md_QueryListParticipantsRequest                   protoreflect.MessageDescriptor
 	fd_QueryListParticipantsRequest_modified_after    protoreflect.FieldDescriptor
 	fd_QueryListParticipantsRequest_response_max_size protoreflect.FieldDescriptor
+	fd_QueryListParticipantsRequest_suspended         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -819,6 +821,7 @@ func init() {
 	md_QueryListParticipantsRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryListParticipantsRequest")
 	fd_QueryListParticipantsRequest_modified_after = md_QueryListParticipantsRequest.Fields().ByName("modified_after")
 	fd_QueryListParticipantsRequest_response_max_size = md_QueryListParticipantsRequest.Fields().ByName("response_max_size")
+	fd_QueryListParticipantsRequest_suspended = md_QueryListParticipantsRequest.Fields().ByName("suspended")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryListParticipantsRequest)(nil)
@@ -898,6 +901,12 @@ func (x *fastReflection_QueryListParticipantsRequest) Range(f func(protoreflect.
 			return
 		}
 	}
+	if x.Suspended != false {
+		value := protoreflect.ValueOfBool(x.Suspended)
+		if !f(fd_QueryListParticipantsRequest_suspended, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -917,6 +926,8 @@ func (x *fastReflection_QueryListParticipantsRequest) Has(fd protoreflect.FieldD
 		return x.ModifiedAfter != nil
 	case "verana.pp.v1.QueryListParticipantsRequest.response_max_size":
 		return x.ResponseMaxSize != uint32(0)
+	case "verana.pp.v1.QueryListParticipantsRequest.suspended":
+		return x.Suspended != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantsRequest"))
@@ -937,6 +948,8 @@ func (x *fastReflection_QueryListParticipantsRequest) Clear(fd protoreflect.Fiel
 		x.ModifiedAfter = nil
 	case "verana.pp.v1.QueryListParticipantsRequest.response_max_size":
 		x.ResponseMaxSize = uint32(0)
+	case "verana.pp.v1.QueryListParticipantsRequest.suspended":
+		x.Suspended = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantsRequest"))
@@ -959,6 +972,9 @@ func (x *fastReflection_QueryListParticipantsRequest) Get(descriptor protoreflec
 	case "verana.pp.v1.QueryListParticipantsRequest.response_max_size":
 		value := x.ResponseMaxSize
 		return protoreflect.ValueOfUint32(value)
+	case "verana.pp.v1.QueryListParticipantsRequest.suspended":
+		value := x.Suspended
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantsRequest"))
@@ -983,6 +999,8 @@ func (x *fastReflection_QueryListParticipantsRequest) Set(fd protoreflect.FieldD
 		x.ModifiedAfter = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.pp.v1.QueryListParticipantsRequest.response_max_size":
 		x.ResponseMaxSize = uint32(value.Uint())
+	case "verana.pp.v1.QueryListParticipantsRequest.suspended":
+		x.Suspended = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantsRequest"))
@@ -1010,6 +1028,8 @@ func (x *fastReflection_QueryListParticipantsRequest) Mutable(fd protoreflect.Fi
 		return protoreflect.ValueOfMessage(x.ModifiedAfter.ProtoReflect())
 	case "verana.pp.v1.QueryListParticipantsRequest.response_max_size":
 		panic(fmt.Errorf("field response_max_size of message verana.pp.v1.QueryListParticipantsRequest is not mutable"))
+	case "verana.pp.v1.QueryListParticipantsRequest.suspended":
+		panic(fmt.Errorf("field suspended of message verana.pp.v1.QueryListParticipantsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantsRequest"))
@@ -1028,6 +1048,8 @@ func (x *fastReflection_QueryListParticipantsRequest) NewField(fd protoreflect.F
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "verana.pp.v1.QueryListParticipantsRequest.response_max_size":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.pp.v1.QueryListParticipantsRequest.suspended":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantsRequest"))
@@ -1104,6 +1126,9 @@ func (x *fastReflection_QueryListParticipantsRequest) ProtoMethods() *protoiface
 		if x.ResponseMaxSize != 0 {
 			n += 1 + runtime.Sov(uint64(x.ResponseMaxSize))
 		}
+		if x.Suspended {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1133,6 +1158,16 @@ func (x *fastReflection_QueryListParticipantsRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Suspended {
+			i--
+			if x.Suspended {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.ResponseMaxSize != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResponseMaxSize))
 			i--
@@ -1256,6 +1291,26 @@ func (x *fastReflection_QueryListParticipantsRequest) ProtoMethods() *protoiface
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Suspended = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3483,6 +3538,9 @@ var (
 	md_QueryListParticipantSessionsRequest                   protoreflect.MessageDescriptor
 	fd_QueryListParticipantSessionsRequest_modified_after    protoreflect.FieldDescriptor
 	fd_QueryListParticipantSessionsRequest_response_max_size protoreflect.FieldDescriptor
+	fd_QueryListParticipantSessionsRequest_corporation_id    protoreflect.FieldDescriptor
+	fd_QueryListParticipantSessionsRequest_vs_operator       protoreflect.FieldDescriptor
+	fd_QueryListParticipantSessionsRequest_participant_id    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3490,6 +3548,9 @@ func init() {
 	md_QueryListParticipantSessionsRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryListParticipantSessionsRequest")
 	fd_QueryListParticipantSessionsRequest_modified_after = md_QueryListParticipantSessionsRequest.Fields().ByName("modified_after")
 	fd_QueryListParticipantSessionsRequest_response_max_size = md_QueryListParticipantSessionsRequest.Fields().ByName("response_max_size")
+	fd_QueryListParticipantSessionsRequest_corporation_id = md_QueryListParticipantSessionsRequest.Fields().ByName("corporation_id")
+	fd_QueryListParticipantSessionsRequest_vs_operator = md_QueryListParticipantSessionsRequest.Fields().ByName("vs_operator")
+	fd_QueryListParticipantSessionsRequest_participant_id = md_QueryListParticipantSessionsRequest.Fields().ByName("participant_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryListParticipantSessionsRequest)(nil)
@@ -3569,6 +3630,24 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) Range(f func(protor
 			return
 		}
 	}
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_QueryListParticipantSessionsRequest_corporation_id, value) {
+			return
+		}
+	}
+	if x.VsOperator != "" {
+		value := protoreflect.ValueOfString(x.VsOperator)
+		if !f(fd_QueryListParticipantSessionsRequest_vs_operator, value) {
+			return
+		}
+	}
+	if x.ParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipantId)
+		if !f(fd_QueryListParticipantSessionsRequest_participant_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3588,6 +3667,12 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) Has(fd protoreflect
 		return x.ModifiedAfter != nil
 	case "verana.pp.v1.QueryListParticipantSessionsRequest.response_max_size":
 		return x.ResponseMaxSize != uint32(0)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.vs_operator":
+		return x.VsOperator != ""
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.participant_id":
+		return x.ParticipantId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantSessionsRequest"))
@@ -3608,6 +3693,12 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) Clear(fd protorefle
 		x.ModifiedAfter = nil
 	case "verana.pp.v1.QueryListParticipantSessionsRequest.response_max_size":
 		x.ResponseMaxSize = uint32(0)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.vs_operator":
+		x.VsOperator = ""
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.participant_id":
+		x.ParticipantId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantSessionsRequest"))
@@ -3630,6 +3721,15 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) Get(descriptor prot
 	case "verana.pp.v1.QueryListParticipantSessionsRequest.response_max_size":
 		value := x.ResponseMaxSize
 		return protoreflect.ValueOfUint32(value)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.vs_operator":
+		value := x.VsOperator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.participant_id":
+		value := x.ParticipantId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantSessionsRequest"))
@@ -3654,6 +3754,12 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) Set(fd protoreflect
 		x.ModifiedAfter = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.pp.v1.QueryListParticipantSessionsRequest.response_max_size":
 		x.ResponseMaxSize = uint32(value.Uint())
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.vs_operator":
+		x.VsOperator = value.Interface().(string)
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.participant_id":
+		x.ParticipantId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantSessionsRequest"))
@@ -3681,6 +3787,12 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) Mutable(fd protoref
 		return protoreflect.ValueOfMessage(x.ModifiedAfter.ProtoReflect())
 	case "verana.pp.v1.QueryListParticipantSessionsRequest.response_max_size":
 		panic(fmt.Errorf("field response_max_size of message verana.pp.v1.QueryListParticipantSessionsRequest is not mutable"))
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.pp.v1.QueryListParticipantSessionsRequest is not mutable"))
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.vs_operator":
+		panic(fmt.Errorf("field vs_operator of message verana.pp.v1.QueryListParticipantSessionsRequest is not mutable"))
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.participant_id":
+		panic(fmt.Errorf("field participant_id of message verana.pp.v1.QueryListParticipantSessionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantSessionsRequest"))
@@ -3699,6 +3811,12 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) NewField(fd protore
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "verana.pp.v1.QueryListParticipantSessionsRequest.response_max_size":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.vs_operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.QueryListParticipantSessionsRequest.participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListParticipantSessionsRequest"))
@@ -3775,6 +3893,16 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) ProtoMethods() *pro
 		if x.ResponseMaxSize != 0 {
 			n += 1 + runtime.Sov(uint64(x.ResponseMaxSize))
 		}
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
+		}
+		l = len(x.VsOperator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipantId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3804,6 +3932,23 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipantId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.VsOperator) > 0 {
+			i -= len(x.VsOperator)
+			copy(dAtA[i:], x.VsOperator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VsOperator)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.ResponseMaxSize != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResponseMaxSize))
 			i--
@@ -3927,6 +4072,76 @@ func (x *fastReflection_QueryListParticipantSessionsRequest) ProtoMethods() *pro
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				}
+				x.CorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VsOperator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+				}
+				x.ParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4457,31 +4672,35 @@ func (x *fastReflection_QueryListParticipantSessionsResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryFindParticipantsWithDIDRequest           protoreflect.MessageDescriptor
-	fd_QueryFindParticipantsWithDIDRequest_did       protoreflect.FieldDescriptor
-	fd_QueryFindParticipantsWithDIDRequest_role      protoreflect.FieldDescriptor
-	fd_QueryFindParticipantsWithDIDRequest_schema_id protoreflect.FieldDescriptor
-	fd_QueryFindParticipantsWithDIDRequest_when      protoreflect.FieldDescriptor
+	md_QueryListRevokedParticipantsRequest                   protoreflect.MessageDescriptor
+	fd_QueryListRevokedParticipantsRequest_schema_id         protoreflect.FieldDescriptor
+	fd_QueryListRevokedParticipantsRequest_ecosystem_id      protoreflect.FieldDescriptor
+	fd_QueryListRevokedParticipantsRequest_revoked_after     protoreflect.FieldDescriptor
+	fd_QueryListRevokedParticipantsRequest_after_id          protoreflect.FieldDescriptor
+	fd_QueryListRevokedParticipantsRequest_revoked_before    protoreflect.FieldDescriptor
+	fd_QueryListRevokedParticipantsRequest_response_max_size protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_query_proto_init()
-	md_QueryFindParticipantsWithDIDRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindParticipantsWithDIDRequest")
-	fd_QueryFindParticipantsWithDIDRequest_did = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("did")
-	fd_QueryFindParticipantsWithDIDRequest_role = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("role")
-	fd_QueryFindParticipantsWithDIDRequest_schema_id = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("schema_id")
-	fd_QueryFindParticipantsWithDIDRequest_when = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("when")
+	md_QueryListRevokedParticipantsRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryListRevokedParticipantsRequest")
+	fd_QueryListRevokedParticipantsRequest_schema_id = md_QueryListRevokedParticipantsRequest.Fields().ByName("schema_id")
+	fd_QueryListRevokedParticipantsRequest_ecosystem_id = md_QueryListRevokedParticipantsRequest.Fields().ByName("ecosystem_id")
+	fd_QueryListRevokedParticipantsRequest_revoked_after = md_QueryListRevokedParticipantsRequest.Fields().ByName("revoked_after")
+	fd_QueryListRevokedParticipantsRequest_after_id = md_QueryListRevokedParticipantsRequest.Fields().ByName("after_id")
+	fd_QueryListRevokedParticipantsRequest_revoked_before = md_QueryListRevokedParticipantsRequest.Fields().ByName("revoked_before")
+	fd_QueryListRevokedParticipantsRequest_response_max_size = md_QueryListRevokedParticipantsRequest.Fields().ByName("response_max_size")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFindParticipantsWithDIDRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryListRevokedParticipantsRequest)(nil)
 
-type fastReflection_QueryFindParticipantsWithDIDRequest QueryFindParticipantsWithDIDRequest
+type fastReflection_QueryListRevokedParticipantsRequest QueryListRevokedParticipantsRequest
 
-func (x *QueryFindParticipantsWithDIDRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFindParticipantsWithDIDRequest)(x)
+func (x *QueryListRevokedParticipantsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryListRevokedParticipantsRequest)(x)
 }
 
-func (x *QueryFindParticipantsWithDIDRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryListRevokedParticipantsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_pp_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4493,43 +4712,43 @@ func (x *QueryFindParticipantsWithDIDRequest) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFindParticipantsWithDIDRequest_messageType fastReflection_QueryFindParticipantsWithDIDRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFindParticipantsWithDIDRequest_messageType{}
+var _fastReflection_QueryListRevokedParticipantsRequest_messageType fastReflection_QueryListRevokedParticipantsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryListRevokedParticipantsRequest_messageType{}
 
-type fastReflection_QueryFindParticipantsWithDIDRequest_messageType struct{}
+type fastReflection_QueryListRevokedParticipantsRequest_messageType struct{}
 
-func (x fastReflection_QueryFindParticipantsWithDIDRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFindParticipantsWithDIDRequest)(nil)
+func (x fastReflection_QueryListRevokedParticipantsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryListRevokedParticipantsRequest)(nil)
 }
-func (x fastReflection_QueryFindParticipantsWithDIDRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFindParticipantsWithDIDRequest)
+func (x fastReflection_QueryListRevokedParticipantsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryListRevokedParticipantsRequest)
 }
-func (x fastReflection_QueryFindParticipantsWithDIDRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindParticipantsWithDIDRequest
+func (x fastReflection_QueryListRevokedParticipantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListRevokedParticipantsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindParticipantsWithDIDRequest
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListRevokedParticipantsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFindParticipantsWithDIDRequest_messageType
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryListRevokedParticipantsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryFindParticipantsWithDIDRequest)
+func (x *fastReflection_QueryListRevokedParticipantsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryListRevokedParticipantsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryFindParticipantsWithDIDRequest)(x)
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryListRevokedParticipantsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4537,28 +4756,40 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Did != "" {
-		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_QueryFindParticipantsWithDIDRequest_did, value) {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SchemaId)
+		if !f(fd_QueryListRevokedParticipantsRequest_schema_id, value) {
 			return
 		}
 	}
-	if x.Role != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Role)
-		if !f(fd_QueryFindParticipantsWithDIDRequest_role, value) {
+	if x.EcosystemId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EcosystemId)
+		if !f(fd_QueryListRevokedParticipantsRequest_ecosystem_id, value) {
 			return
 		}
 	}
-	if x.SchemaId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SchemaId)
-		if !f(fd_QueryFindParticipantsWithDIDRequest_schema_id, value) {
+	if x.RevokedAfter != nil {
+		value := protoreflect.ValueOfMessage(x.RevokedAfter.ProtoReflect())
+		if !f(fd_QueryListRevokedParticipantsRequest_revoked_after, value) {
 			return
 		}
 	}
-	if x.When != nil {
-		value := protoreflect.ValueOfMessage(x.When.ProtoReflect())
-		if !f(fd_QueryFindParticipantsWithDIDRequest_when, value) {
+	if x.AfterId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AfterId)
+		if !f(fd_QueryListRevokedParticipantsRequest_after_id, value) {
+			return
+		}
+	}
+	if x.RevokedBefore != nil {
+		value := protoreflect.ValueOfMessage(x.RevokedBefore.ProtoReflect())
+		if !f(fd_QueryListRevokedParticipantsRequest_revoked_before, value) {
+			return
+		}
+	}
+	if x.ResponseMaxSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ResponseMaxSize)
+		if !f(fd_QueryListRevokedParticipantsRequest_response_max_size, value) {
 			return
 		}
 	}
@@ -4575,21 +4806,25 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
-		return x.Did != ""
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
-		return x.Role != uint32(0)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.schema_id":
 		return x.SchemaId != uint64(0)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
-		return x.When != nil
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.ecosystem_id":
+		return x.EcosystemId != uint64(0)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_after":
+		return x.RevokedAfter != nil
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.after_id":
+		return x.AfterId != uint64(0)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_before":
+		return x.RevokedBefore != nil
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.response_max_size":
+		return x.ResponseMaxSize != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4599,21 +4834,25 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
-		x.Did = ""
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
-		x.Role = uint32(0)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.schema_id":
 		x.SchemaId = uint64(0)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
-		x.When = nil
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.ecosystem_id":
+		x.EcosystemId = uint64(0)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_after":
+		x.RevokedAfter = nil
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.after_id":
+		x.AfterId = uint64(0)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_before":
+		x.RevokedBefore = nil
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.response_max_size":
+		x.ResponseMaxSize = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4623,25 +4862,31 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
-		value := x.Did
-		return protoreflect.ValueOfString(value)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
-		value := x.Role
-		return protoreflect.ValueOfUint32(value)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.schema_id":
 		value := x.SchemaId
 		return protoreflect.ValueOfUint64(value)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
-		value := x.When
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.ecosystem_id":
+		value := x.EcosystemId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_after":
+		value := x.RevokedAfter
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.after_id":
+		value := x.AfterId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_before":
+		value := x.RevokedBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.response_max_size":
+		value := x.ResponseMaxSize
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4655,21 +4900,25 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
-		x.Did = value.Interface().(string)
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
-		x.Role = uint32(value.Uint())
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.schema_id":
 		x.SchemaId = value.Uint()
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
-		x.When = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.ecosystem_id":
+		x.EcosystemId = value.Uint()
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_after":
+		x.RevokedAfter = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.after_id":
+		x.AfterId = value.Uint()
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_before":
+		x.RevokedBefore = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.response_max_size":
+		x.ResponseMaxSize = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4683,56 +4932,68 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
-		if x.When == nil {
-			x.When = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.When.ProtoReflect())
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
-		panic(fmt.Errorf("field did of message verana.pp.v1.QueryFindParticipantsWithDIDRequest is not mutable"))
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
-		panic(fmt.Errorf("field role of message verana.pp.v1.QueryFindParticipantsWithDIDRequest is not mutable"))
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
-		panic(fmt.Errorf("field schema_id of message verana.pp.v1.QueryFindParticipantsWithDIDRequest is not mutable"))
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_after":
+		if x.RevokedAfter == nil {
+			x.RevokedAfter = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RevokedAfter.ProtoReflect())
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_before":
+		if x.RevokedBefore == nil {
+			x.RevokedBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RevokedBefore.ProtoReflect())
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.schema_id":
+		panic(fmt.Errorf("field schema_id of message verana.pp.v1.QueryListRevokedParticipantsRequest is not mutable"))
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.ecosystem_id":
+		panic(fmt.Errorf("field ecosystem_id of message verana.pp.v1.QueryListRevokedParticipantsRequest is not mutable"))
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.after_id":
+		panic(fmt.Errorf("field after_id of message verana.pp.v1.QueryListRevokedParticipantsRequest is not mutable"))
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.response_max_size":
+		panic(fmt.Errorf("field response_max_size of message verana.pp.v1.QueryListRevokedParticipantsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
-		return protoreflect.ValueOfString("")
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.schema_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.ecosystem_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_after":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.after_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_before":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.QueryListRevokedParticipantsRequest.response_max_size":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindParticipantsWithDIDRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryListRevokedParticipantsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4740,7 +5001,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4751,7 +5012,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4763,7 +5024,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) IsValid() bool {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4773,9 +5034,9 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryListRevokedParticipantsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFindParticipantsWithDIDRequest)
+		x := input.Message.Interface().(*QueryListRevokedParticipantsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4787,20 +5048,26 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		l = len(x.Did)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Role != 0 {
-			n += 1 + runtime.Sov(uint64(x.Role))
-		}
 		if x.SchemaId != 0 {
 			n += 1 + runtime.Sov(uint64(x.SchemaId))
 		}
-		if x.When != nil {
-			l = options.Size(x.When)
+		if x.EcosystemId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EcosystemId))
+		}
+		if x.RevokedAfter != nil {
+			l = options.Size(x.RevokedAfter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AfterId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AfterId))
+		}
+		if x.RevokedBefore != nil {
+			l = options.Size(x.RevokedBefore)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ResponseMaxSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResponseMaxSize))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4811,7 +5078,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindParticipantsWithDIDRequest)
+		x := input.Message.Interface().(*QueryListRevokedParticipantsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4830,8 +5097,13 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.When != nil {
-			encoded, err := options.Marshal(x.When)
+		if x.ResponseMaxSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResponseMaxSize))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RevokedBefore != nil {
+			encoded, err := options.Marshal(x.RevokedBefore)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4844,22 +5116,34 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 			i--
 			dAtA[i] = 0x2a
 		}
-		if x.SchemaId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SchemaId))
+		if x.AfterId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AfterId))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x20
 		}
-		if x.Role != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
+		if x.RevokedAfter != nil {
+			encoded, err := options.Marshal(x.RevokedAfter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EcosystemId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EcosystemId))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Did) > 0 {
-			i -= len(x.Did)
-			copy(dAtA[i:], x.Did)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+		if x.SchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SchemaId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4872,7 +5156,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindParticipantsWithDIDRequest)
+		x := input.Message.Interface().(*QueryListRevokedParticipantsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4904,17 +5188,17 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListRevokedParticipantsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListRevokedParticipantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
 				}
-				var stringLen uint64
+				x.SchemaId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4924,29 +5208,16 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.SchemaId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Did = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
 				}
-				x.Role = 0
+				x.EcosystemId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4956,16 +5227,52 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Role |= uint32(b&0x7F) << shift
+					x.EcosystemId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokedAfter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RevokedAfter == nil {
+					x.RevokedAfter = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevokedAfter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
 				}
-				x.SchemaId = 0
+				x.AfterId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4975,14 +5282,14 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SchemaId |= uint64(b&0x7F) << shift
+					x.AfterId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokedBefore", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5009,13 +5316,32 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.When == nil {
-					x.When = &timestamppb.Timestamp{}
+				if x.RevokedBefore == nil {
+					x.RevokedBefore = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.When); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevokedBefore); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+				}
+				x.ResponseMaxSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResponseMaxSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5051,77 +5377,77 @@ func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *pro
 	}
 }
 
-var _ protoreflect.List = (*_QueryFindParticipantsWithDIDResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryListRevokedParticipantsResponse_1_list)(nil)
 
-type _QueryFindParticipantsWithDIDResponse_1_list struct {
+type _QueryListRevokedParticipantsResponse_1_list struct {
 	list *[]*Participant
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) Len() int {
+func (x *_QueryListRevokedParticipantsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryListRevokedParticipantsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryListRevokedParticipantsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Participant)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryListRevokedParticipantsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Participant)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryListRevokedParticipantsResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(Participant)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) Truncate(n int) {
+func (x *_QueryListRevokedParticipantsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryListRevokedParticipantsResponse_1_list) NewElement() protoreflect.Value {
 	v := new(Participant)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryFindParticipantsWithDIDResponse_1_list) IsValid() bool {
+func (x *_QueryListRevokedParticipantsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryFindParticipantsWithDIDResponse              protoreflect.MessageDescriptor
-	fd_QueryFindParticipantsWithDIDResponse_participants protoreflect.FieldDescriptor
+	md_QueryListRevokedParticipantsResponse              protoreflect.MessageDescriptor
+	fd_QueryListRevokedParticipantsResponse_participants protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_query_proto_init()
-	md_QueryFindParticipantsWithDIDResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindParticipantsWithDIDResponse")
-	fd_QueryFindParticipantsWithDIDResponse_participants = md_QueryFindParticipantsWithDIDResponse.Fields().ByName("participants")
+	md_QueryListRevokedParticipantsResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryListRevokedParticipantsResponse")
+	fd_QueryListRevokedParticipantsResponse_participants = md_QueryListRevokedParticipantsResponse.Fields().ByName("participants")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFindParticipantsWithDIDResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryListRevokedParticipantsResponse)(nil)
 
-type fastReflection_QueryFindParticipantsWithDIDResponse QueryFindParticipantsWithDIDResponse
+type fastReflection_QueryListRevokedParticipantsResponse QueryListRevokedParticipantsResponse
 
-func (x *QueryFindParticipantsWithDIDResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFindParticipantsWithDIDResponse)(x)
+func (x *QueryListRevokedParticipantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryListRevokedParticipantsResponse)(x)
 }
 
-func (x *QueryFindParticipantsWithDIDResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryListRevokedParticipantsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_pp_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5133,43 +5459,43 @@ func (x *QueryFindParticipantsWithDIDResponse) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFindParticipantsWithDIDResponse_messageType fastReflection_QueryFindParticipantsWithDIDResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFindParticipantsWithDIDResponse_messageType{}
+var _fastReflection_QueryListRevokedParticipantsResponse_messageType fastReflection_QueryListRevokedParticipantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryListRevokedParticipantsResponse_messageType{}
 
-type fastReflection_QueryFindParticipantsWithDIDResponse_messageType struct{}
+type fastReflection_QueryListRevokedParticipantsResponse_messageType struct{}
 
-func (x fastReflection_QueryFindParticipantsWithDIDResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFindParticipantsWithDIDResponse)(nil)
+func (x fastReflection_QueryListRevokedParticipantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryListRevokedParticipantsResponse)(nil)
 }
-func (x fastReflection_QueryFindParticipantsWithDIDResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFindParticipantsWithDIDResponse)
+func (x fastReflection_QueryListRevokedParticipantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryListRevokedParticipantsResponse)
 }
-func (x fastReflection_QueryFindParticipantsWithDIDResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindParticipantsWithDIDResponse
+func (x fastReflection_QueryListRevokedParticipantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListRevokedParticipantsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindParticipantsWithDIDResponse
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListRevokedParticipantsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFindParticipantsWithDIDResponse_messageType
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryListRevokedParticipantsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryFindParticipantsWithDIDResponse)
+func (x *fastReflection_QueryListRevokedParticipantsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryListRevokedParticipantsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryFindParticipantsWithDIDResponse)(x)
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryListRevokedParticipantsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5177,10 +5503,10 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Participants) != 0 {
-		value := protoreflect.ValueOfList(&_QueryFindParticipantsWithDIDResponse_1_list{list: &x.Participants})
-		if !f(fd_QueryFindParticipantsWithDIDResponse_participants, value) {
+		value := protoreflect.ValueOfList(&_QueryListRevokedParticipantsResponse_1_list{list: &x.Participants})
+		if !f(fd_QueryListRevokedParticipantsResponse_participants, value) {
 			return
 		}
 	}
@@ -5197,15 +5523,15 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
+	case "verana.pp.v1.QueryListRevokedParticipantsResponse.participants":
 		return len(x.Participants) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5215,15 +5541,15 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
+	case "verana.pp.v1.QueryListRevokedParticipantsResponse.participants":
 		x.Participants = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5233,19 +5559,19 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
+	case "verana.pp.v1.QueryListRevokedParticipantsResponse.participants":
 		if len(x.Participants) == 0 {
-			return protoreflect.ValueOfList(&_QueryFindParticipantsWithDIDResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryListRevokedParticipantsResponse_1_list{})
 		}
-		listValue := &_QueryFindParticipantsWithDIDResponse_1_list{list: &x.Participants}
+		listValue := &_QueryListRevokedParticipantsResponse_1_list{list: &x.Participants}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5259,17 +5585,17 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
+	case "verana.pp.v1.QueryListRevokedParticipantsResponse.participants":
 		lv := value.List()
-		clv := lv.(*_QueryFindParticipantsWithDIDResponse_1_list)
+		clv := lv.(*_QueryListRevokedParticipantsResponse_1_list)
 		x.Participants = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5283,45 +5609,45 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
+	case "verana.pp.v1.QueryListRevokedParticipantsResponse.participants":
 		if x.Participants == nil {
 			x.Participants = []*Participant{}
 		}
-		value := &_QueryFindParticipantsWithDIDResponse_1_list{list: &x.Participants}
+		value := &_QueryListRevokedParticipantsResponse_1_list{list: &x.Participants}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
+	case "verana.pp.v1.QueryListRevokedParticipantsResponse.participants":
 		list := []*Participant{}
-		return protoreflect.ValueOfList(&_QueryFindParticipantsWithDIDResponse_1_list{list: &list})
+		return protoreflect.ValueOfList(&_QueryListRevokedParticipantsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryListRevokedParticipantsResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryListRevokedParticipantsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindParticipantsWithDIDResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryListRevokedParticipantsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5329,7 +5655,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5340,7 +5666,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5352,7 +5678,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) IsValid() bool {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5362,9 +5688,9 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFindParticipantsWithDIDResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryListRevokedParticipantsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFindParticipantsWithDIDResponse)
+		x := input.Message.Interface().(*QueryListRevokedParticipantsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5392,7 +5718,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindParticipantsWithDIDResponse)
+		x := input.Message.Interface().(*QueryListRevokedParticipantsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5438,7 +5764,7 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindParticipantsWithDIDResponse)
+		x := input.Message.Interface().(*QueryListRevokedParticipantsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5470,10 +5796,10 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListRevokedParticipantsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListRevokedParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5546,27 +5872,33 @@ func (x *fastReflection_QueryFindParticipantsWithDIDResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryFindBeneficiariesRequest                         protoreflect.MessageDescriptor
-	fd_QueryFindBeneficiariesRequest_issuer_participant_id   protoreflect.FieldDescriptor
-	fd_QueryFindBeneficiariesRequest_verifier_participant_id protoreflect.FieldDescriptor
+	md_QueryFindParticipantsWithDIDRequest                     protoreflect.MessageDescriptor
+	fd_QueryFindParticipantsWithDIDRequest_did                 protoreflect.FieldDescriptor
+	fd_QueryFindParticipantsWithDIDRequest_role                protoreflect.FieldDescriptor
+	fd_QueryFindParticipantsWithDIDRequest_schema_id           protoreflect.FieldDescriptor
+	fd_QueryFindParticipantsWithDIDRequest_when                protoreflect.FieldDescriptor
+	fd_QueryFindParticipantsWithDIDRequest_include_did_history protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_query_proto_init()
-	md_QueryFindBeneficiariesRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindBeneficiariesRequest")
-	fd_QueryFindBeneficiariesRequest_issuer_participant_id = md_QueryFindBeneficiariesRequest.Fields().ByName("issuer_participant_id")
-	fd_QueryFindBeneficiariesRequest_verifier_participant_id = md_QueryFindBeneficiariesRequest.Fields().ByName("verifier_participant_id")
+	md_QueryFindParticipantsWithDIDRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindParticipantsWithDIDRequest")
+	fd_QueryFindParticipantsWithDIDRequest_did = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("did")
+	fd_QueryFindParticipantsWithDIDRequest_role = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("role")
+	fd_QueryFindParticipantsWithDIDRequest_schema_id = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("schema_id")
+	fd_QueryFindParticipantsWithDIDRequest_when = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("when")
+	fd_QueryFindParticipantsWithDIDRequest_include_did_history = md_QueryFindParticipantsWithDIDRequest.Fields().ByName("include_did_history")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFindBeneficiariesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFindParticipantsWithDIDRequest)(nil)
 
-type fastReflection_QueryFindBeneficiariesRequest QueryFindBeneficiariesRequest
+type fastReflection_QueryFindParticipantsWithDIDRequest QueryFindParticipantsWithDIDRequest
 
-func (x *QueryFindBeneficiariesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFindBeneficiariesRequest)(x)
+func (x *QueryFindParticipantsWithDIDRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFindParticipantsWithDIDRequest)(x)
 }
 
-func (x *QueryFindBeneficiariesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryFindParticipantsWithDIDRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_pp_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5578,43 +5910,43 @@ func (x *QueryFindBeneficiariesRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFindBeneficiariesRequest_messageType fastReflection_QueryFindBeneficiariesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFindBeneficiariesRequest_messageType{}
+var _fastReflection_QueryFindParticipantsWithDIDRequest_messageType fastReflection_QueryFindParticipantsWithDIDRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFindParticipantsWithDIDRequest_messageType{}
 
-type fastReflection_QueryFindBeneficiariesRequest_messageType struct{}
+type fastReflection_QueryFindParticipantsWithDIDRequest_messageType struct{}
 
-func (x fastReflection_QueryFindBeneficiariesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFindBeneficiariesRequest)(nil)
+func (x fastReflection_QueryFindParticipantsWithDIDRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFindParticipantsWithDIDRequest)(nil)
 }
-func (x fastReflection_QueryFindBeneficiariesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFindBeneficiariesRequest)
+func (x fastReflection_QueryFindParticipantsWithDIDRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFindParticipantsWithDIDRequest)
 }
-func (x fastReflection_QueryFindBeneficiariesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindBeneficiariesRequest
+func (x fastReflection_QueryFindParticipantsWithDIDRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindParticipantsWithDIDRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindBeneficiariesRequest
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindParticipantsWithDIDRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFindBeneficiariesRequest_messageType
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFindParticipantsWithDIDRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFindBeneficiariesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryFindBeneficiariesRequest)
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFindParticipantsWithDIDRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryFindBeneficiariesRequest)(x)
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFindParticipantsWithDIDRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5622,16 +5954,34 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.IssuerParticipantId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.IssuerParticipantId)
-		if !f(fd_QueryFindBeneficiariesRequest_issuer_participant_id, value) {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_QueryFindParticipantsWithDIDRequest_did, value) {
 			return
 		}
 	}
-	if x.VerifierParticipantId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.VerifierParticipantId)
-		if !f(fd_QueryFindBeneficiariesRequest_verifier_participant_id, value) {
+	if x.Role != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Role)
+		if !f(fd_QueryFindParticipantsWithDIDRequest_role, value) {
+			return
+		}
+	}
+	if x.SchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SchemaId)
+		if !f(fd_QueryFindParticipantsWithDIDRequest_schema_id, value) {
+			return
+		}
+	}
+	if x.When != nil {
+		value := protoreflect.ValueOfMessage(x.When.ProtoReflect())
+		if !f(fd_QueryFindParticipantsWithDIDRequest_when, value) {
+			return
+		}
+	}
+	if x.IncludeDidHistory != false {
+		value := protoreflect.ValueOfBool(x.IncludeDidHistory)
+		if !f(fd_QueryFindParticipantsWithDIDRequest_include_did_history, value) {
 			return
 		}
 	}
@@ -5648,17 +5998,23 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
-		return x.IssuerParticipantId != uint64(0)
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
-		return x.VerifierParticipantId != uint64(0)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
+		return x.Did != ""
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
+		return x.Role != uint32(0)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+		return x.SchemaId != uint64(0)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
+		return x.When != nil
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.include_did_history":
+		return x.IncludeDidHistory != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5668,17 +6024,23 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
-		x.IssuerParticipantId = uint64(0)
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
-		x.VerifierParticipantId = uint64(0)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
+		x.Did = ""
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
+		x.Role = uint32(0)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+		x.SchemaId = uint64(0)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
+		x.When = nil
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.include_did_history":
+		x.IncludeDidHistory = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5688,19 +6050,28 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
-		value := x.IssuerParticipantId
-		return protoreflect.ValueOfUint64(value)
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
-		value := x.VerifierParticipantId
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
+		value := x.Role
+		return protoreflect.ValueOfUint32(value)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+		value := x.SchemaId
 		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
+		value := x.When
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.include_did_history":
+		value := x.IncludeDidHistory
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5714,17 +6085,23 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
-		x.IssuerParticipantId = value.Uint()
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
-		x.VerifierParticipantId = value.Uint()
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
+		x.Did = value.Interface().(string)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
+		x.Role = uint32(value.Uint())
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+		x.SchemaId = value.Uint()
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
+		x.When = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.include_did_history":
+		x.IncludeDidHistory = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5738,44 +6115,60 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
-		panic(fmt.Errorf("field issuer_participant_id of message verana.pp.v1.QueryFindBeneficiariesRequest is not mutable"))
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
-		panic(fmt.Errorf("field verifier_participant_id of message verana.pp.v1.QueryFindBeneficiariesRequest is not mutable"))
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
+		if x.When == nil {
+			x.When = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.When.ProtoReflect())
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
+		panic(fmt.Errorf("field did of message verana.pp.v1.QueryFindParticipantsWithDIDRequest is not mutable"))
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
+		panic(fmt.Errorf("field role of message verana.pp.v1.QueryFindParticipantsWithDIDRequest is not mutable"))
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
+		panic(fmt.Errorf("field schema_id of message verana.pp.v1.QueryFindParticipantsWithDIDRequest is not mutable"))
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.include_did_history":
+		panic(fmt.Errorf("field include_did_history of message verana.pp.v1.QueryFindParticipantsWithDIDRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFindBeneficiariesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.did":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.role":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.schema_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.when":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.QueryFindParticipantsWithDIDRequest.include_did_history":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDRequest"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFindBeneficiariesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindBeneficiariesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindParticipantsWithDIDRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5783,7 +6176,7 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFindBeneficiariesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5794,7 +6187,7 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5806,7 +6199,7 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFindBeneficiariesRequest) IsValid() bool {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5816,9 +6209,9 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFindParticipantsWithDIDRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFindBeneficiariesRequest)
+		x := input.Message.Interface().(*QueryFindParticipantsWithDIDRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5830,11 +6223,22 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if x.IssuerParticipantId != 0 {
-			n += 1 + runtime.Sov(uint64(x.IssuerParticipantId))
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.VerifierParticipantId != 0 {
-			n += 1 + runtime.Sov(uint64(x.VerifierParticipantId))
+		if x.Role != 0 {
+			n += 1 + runtime.Sov(uint64(x.Role))
+		}
+		if x.SchemaId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SchemaId))
+		}
+		if x.When != nil {
+			l = options.Size(x.When)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncludeDidHistory {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5846,7 +6250,7 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindBeneficiariesRequest)
+		x := input.Message.Interface().(*QueryFindParticipantsWithDIDRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5865,15 +6269,46 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VerifierParticipantId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierParticipantId))
+		if x.IncludeDidHistory {
+			i--
+			if x.IncludeDidHistory {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.When != nil {
+			encoded, err := options.Marshal(x.When)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SchemaId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Role != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.IssuerParticipantId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerParticipantId))
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5886,7 +6321,7 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindBeneficiariesRequest)
+		x := input.Message.Interface().(*QueryFindParticipantsWithDIDRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5918,17 +6353,17 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
-				x.IssuerParticipantId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5938,16 +6373,29 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.IssuerParticipantId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 				}
-				x.VerifierParticipantId = 0
+				x.Role = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5957,21 +6405,96 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.VerifierParticipantId |= uint64(b&0x7F) << shift
+					x.Role |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
 				}
-				if (iNdEx + skippy) > l {
+				x.SchemaId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SchemaId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.When == nil {
+					x.When = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.When); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeDidHistory", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludeDidHistory = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -5997,77 +6520,77 @@ func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoifac
 	}
 }
 
-var _ protoreflect.List = (*_QueryFindBeneficiariesResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryFindParticipantsWithDIDResponse_1_list)(nil)
 
-type _QueryFindBeneficiariesResponse_1_list struct {
+type _QueryFindParticipantsWithDIDResponse_1_list struct {
 	list *[]*Participant
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) Len() int {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Participant)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Participant)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(Participant)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) Truncate(n int) {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) NewElement() protoreflect.Value {
 	v := new(Participant)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryFindBeneficiariesResponse_1_list) IsValid() bool {
+func (x *_QueryFindParticipantsWithDIDResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryFindBeneficiariesResponse              protoreflect.MessageDescriptor
-	fd_QueryFindBeneficiariesResponse_participants protoreflect.FieldDescriptor
+	md_QueryFindParticipantsWithDIDResponse              protoreflect.MessageDescriptor
+	fd_QueryFindParticipantsWithDIDResponse_participants protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_query_proto_init()
-	md_QueryFindBeneficiariesResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindBeneficiariesResponse")
-	fd_QueryFindBeneficiariesResponse_participants = md_QueryFindBeneficiariesResponse.Fields().ByName("participants")
+	md_QueryFindParticipantsWithDIDResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindParticipantsWithDIDResponse")
+	fd_QueryFindParticipantsWithDIDResponse_participants = md_QueryFindParticipantsWithDIDResponse.Fields().ByName("participants")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFindBeneficiariesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFindParticipantsWithDIDResponse)(nil)
 
-type fastReflection_QueryFindBeneficiariesResponse QueryFindBeneficiariesResponse
+type fastReflection_QueryFindParticipantsWithDIDResponse QueryFindParticipantsWithDIDResponse
 
-func (x *QueryFindBeneficiariesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFindBeneficiariesResponse)(x)
+func (x *QueryFindParticipantsWithDIDResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFindParticipantsWithDIDResponse)(x)
 }
 
-func (x *QueryFindBeneficiariesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryFindParticipantsWithDIDResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_pp_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6079,43 +6602,43 @@ func (x *QueryFindBeneficiariesResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFindBeneficiariesResponse_messageType fastReflection_QueryFindBeneficiariesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFindBeneficiariesResponse_messageType{}
+var _fastReflection_QueryFindParticipantsWithDIDResponse_messageType fastReflection_QueryFindParticipantsWithDIDResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFindParticipantsWithDIDResponse_messageType{}
 
-type fastReflection_QueryFindBeneficiariesResponse_messageType struct{}
+type fastReflection_QueryFindParticipantsWithDIDResponse_messageType struct{}
 
-func (x fastReflection_QueryFindBeneficiariesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFindBeneficiariesResponse)(nil)
+func (x fastReflection_QueryFindParticipantsWithDIDResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFindParticipantsWithDIDResponse)(nil)
 }
-func (x fastReflection_QueryFindBeneficiariesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFindBeneficiariesResponse)
+func (x fastReflection_QueryFindParticipantsWithDIDResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFindParticipantsWithDIDResponse)
 }
-func (x fastReflection_QueryFindBeneficiariesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindBeneficiariesResponse
+func (x fastReflection_QueryFindParticipantsWithDIDResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindParticipantsWithDIDResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFindBeneficiariesResponse
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindParticipantsWithDIDResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFindBeneficiariesResponse_messageType
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFindParticipantsWithDIDResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFindBeneficiariesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryFindBeneficiariesResponse)
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFindParticipantsWithDIDResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryFindBeneficiariesResponse)(x)
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFindParticipantsWithDIDResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6123,10 +6646,10 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Participants) != 0 {
-		value := protoreflect.ValueOfList(&_QueryFindBeneficiariesResponse_1_list{list: &x.Participants})
-		if !f(fd_QueryFindBeneficiariesResponse_participants, value) {
+		value := protoreflect.ValueOfList(&_QueryFindParticipantsWithDIDResponse_1_list{list: &x.Participants})
+		if !f(fd_QueryFindParticipantsWithDIDResponse_participants, value) {
 			return
 		}
 	}
@@ -6143,15 +6666,15 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
 		return len(x.Participants) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6161,15 +6684,15 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
 		x.Participants = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6179,19 +6702,19 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
 		if len(x.Participants) == 0 {
-			return protoreflect.ValueOfList(&_QueryFindBeneficiariesResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryFindParticipantsWithDIDResponse_1_list{})
 		}
-		listValue := &_QueryFindBeneficiariesResponse_1_list{list: &x.Participants}
+		listValue := &_QueryFindParticipantsWithDIDResponse_1_list{list: &x.Participants}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6205,17 +6728,17 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
 		lv := value.List()
-		clv := lv.(*_QueryFindBeneficiariesResponse_1_list)
+		clv := lv.(*_QueryFindParticipantsWithDIDResponse_1_list)
 		x.Participants = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6229,45 +6752,45 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
 		if x.Participants == nil {
 			x.Participants = []*Participant{}
 		}
-		value := &_QueryFindBeneficiariesResponse_1_list{list: &x.Participants}
+		value := &_QueryFindParticipantsWithDIDResponse_1_list{list: &x.Participants}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFindBeneficiariesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+	case "verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants":
 		list := []*Participant{}
-		return protoreflect.ValueOfList(&_QueryFindBeneficiariesResponse_1_list{list: &list})
+		return protoreflect.ValueOfList(&_QueryFindParticipantsWithDIDResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindParticipantsWithDIDResponse"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindParticipantsWithDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFindBeneficiariesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindBeneficiariesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindParticipantsWithDIDResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6275,7 +6798,7 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFindBeneficiariesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6286,7 +6809,7 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFindBeneficiariesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6298,7 +6821,7 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFindBeneficiariesResponse) IsValid() bool {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6308,9 +6831,9 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFindBeneficiariesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFindParticipantsWithDIDResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFindBeneficiariesResponse)
+		x := input.Message.Interface().(*QueryFindParticipantsWithDIDResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6338,7 +6861,7 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindBeneficiariesResponse)
+		x := input.Message.Interface().(*QueryFindParticipantsWithDIDResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6384,7 +6907,7 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFindBeneficiariesResponse)
+		x := input.Message.Interface().(*QueryFindParticipantsWithDIDResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6416,10 +6939,10 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6491,127 +7014,6812 @@ func (x *fastReflection_QueryFindBeneficiariesResponse) ProtoMethods() *protoifa
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: verana/pp/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryFindBeneficiariesRequest                         protoreflect.MessageDescriptor
+	fd_QueryFindBeneficiariesRequest_issuer_participant_id   protoreflect.FieldDescriptor
+	fd_QueryFindBeneficiariesRequest_verifier_participant_id protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryFindBeneficiariesRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindBeneficiariesRequest")
+	fd_QueryFindBeneficiariesRequest_issuer_participant_id = md_QueryFindBeneficiariesRequest.Fields().ByName("issuer_participant_id")
+	fd_QueryFindBeneficiariesRequest_verifier_participant_id = md_QueryFindBeneficiariesRequest.Fields().ByName("verifier_participant_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFindBeneficiariesRequest)(nil)
+
+type fastReflection_QueryFindBeneficiariesRequest QueryFindBeneficiariesRequest
+
+func (x *QueryFindBeneficiariesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFindBeneficiariesRequest)(x)
+}
+
+func (x *QueryFindBeneficiariesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFindBeneficiariesRequest_messageType fastReflection_QueryFindBeneficiariesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFindBeneficiariesRequest_messageType{}
+
+type fastReflection_QueryFindBeneficiariesRequest_messageType struct{}
+
+func (x fastReflection_QueryFindBeneficiariesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFindBeneficiariesRequest)(nil)
+}
+func (x fastReflection_QueryFindBeneficiariesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFindBeneficiariesRequest)
+}
+func (x fastReflection_QueryFindBeneficiariesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindBeneficiariesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindBeneficiariesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFindBeneficiariesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFindBeneficiariesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFindBeneficiariesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFindBeneficiariesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IssuerParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuerParticipantId)
+		if !f(fd_QueryFindBeneficiariesRequest_issuer_participant_id, value) {
+			return
+		}
+	}
+	if x.VerifierParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerifierParticipantId)
+		if !f(fd_QueryFindBeneficiariesRequest_verifier_participant_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
+		return x.IssuerParticipantId != uint64(0)
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
+		return x.VerifierParticipantId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
+		x.IssuerParticipantId = uint64(0)
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
+		x.VerifierParticipantId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
+		value := x.IssuerParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
+		value := x.VerifierParticipantId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
+		x.IssuerParticipantId = value.Uint()
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
+		x.VerifierParticipantId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
+		panic(fmt.Errorf("field issuer_participant_id of message verana.pp.v1.QueryFindBeneficiariesRequest is not mutable"))
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
+		panic(fmt.Errorf("field verifier_participant_id of message verana.pp.v1.QueryFindBeneficiariesRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFindBeneficiariesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.issuer_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryFindBeneficiariesRequest.verifier_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFindBeneficiariesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindBeneficiariesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFindBeneficiariesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFindBeneficiariesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFindBeneficiariesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFindBeneficiariesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IssuerParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerParticipantId))
+		}
+		if x.VerifierParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierParticipantId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFindBeneficiariesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VerifierParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierParticipantId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.IssuerParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerParticipantId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFindBeneficiariesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
+				}
+				x.IssuerParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+				}
+				x.VerifierParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryFindBeneficiariesResponse_1_list)(nil)
+
+type _QueryFindBeneficiariesResponse_1_list struct {
+	list *[]*Participant
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Participant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Participant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Participant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Participant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryFindBeneficiariesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryFindBeneficiariesResponse              protoreflect.MessageDescriptor
+	fd_QueryFindBeneficiariesResponse_participants protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryFindBeneficiariesResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryFindBeneficiariesResponse")
+	fd_QueryFindBeneficiariesResponse_participants = md_QueryFindBeneficiariesResponse.Fields().ByName("participants")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFindBeneficiariesResponse)(nil)
+
+type fastReflection_QueryFindBeneficiariesResponse QueryFindBeneficiariesResponse
+
+func (x *QueryFindBeneficiariesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFindBeneficiariesResponse)(x)
+}
+
+func (x *QueryFindBeneficiariesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFindBeneficiariesResponse_messageType fastReflection_QueryFindBeneficiariesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFindBeneficiariesResponse_messageType{}
+
+type fastReflection_QueryFindBeneficiariesResponse_messageType struct{}
+
+func (x fastReflection_QueryFindBeneficiariesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFindBeneficiariesResponse)(nil)
+}
+func (x fastReflection_QueryFindBeneficiariesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFindBeneficiariesResponse)
+}
+func (x fastReflection_QueryFindBeneficiariesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindBeneficiariesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFindBeneficiariesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFindBeneficiariesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFindBeneficiariesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFindBeneficiariesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFindBeneficiariesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_QueryFindBeneficiariesResponse_1_list{list: &x.Participants})
+		if !f(fd_QueryFindBeneficiariesResponse_participants, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+		return len(x.Participants) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+		x.Participants = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_QueryFindBeneficiariesResponse_1_list{})
+		}
+		listValue := &_QueryFindBeneficiariesResponse_1_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+		lv := value.List()
+		clv := lv.(*_QueryFindBeneficiariesResponse_1_list)
+		x.Participants = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+		if x.Participants == nil {
+			x.Participants = []*Participant{}
+		}
+		value := &_QueryFindBeneficiariesResponse_1_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFindBeneficiariesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryFindBeneficiariesResponse.participants":
+		list := []*Participant{}
+		return protoreflect.ValueOfList(&_QueryFindBeneficiariesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryFindBeneficiariesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryFindBeneficiariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFindBeneficiariesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryFindBeneficiariesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFindBeneficiariesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFindBeneficiariesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFindBeneficiariesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFindBeneficiariesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFindBeneficiariesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Participants) > 0 {
+			for _, e := range x.Participants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFindBeneficiariesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Participants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFindBeneficiariesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFindBeneficiariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, &Participant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeEstimateItem                protoreflect.MessageDescriptor
+	fd_FeeEstimateItem_kind           protoreflect.FieldDescriptor
+	fd_FeeEstimateItem_destination    protoreflect.FieldDescriptor
+	fd_FeeEstimateItem_participant_id protoreflect.FieldDescriptor
+	fd_FeeEstimateItem_corporation_id protoreflect.FieldDescriptor
+	fd_FeeEstimateItem_account        protoreflect.FieldDescriptor
+	fd_FeeEstimateItem_amount         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_FeeEstimateItem = File_verana_pp_v1_query_proto.Messages().ByName("FeeEstimateItem")
+	fd_FeeEstimateItem_kind = md_FeeEstimateItem.Fields().ByName("kind")
+	fd_FeeEstimateItem_destination = md_FeeEstimateItem.Fields().ByName("destination")
+	fd_FeeEstimateItem_participant_id = md_FeeEstimateItem.Fields().ByName("participant_id")
+	fd_FeeEstimateItem_corporation_id = md_FeeEstimateItem.Fields().ByName("corporation_id")
+	fd_FeeEstimateItem_account = md_FeeEstimateItem.Fields().ByName("account")
+	fd_FeeEstimateItem_amount = md_FeeEstimateItem.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeEstimateItem)(nil)
+
+type fastReflection_FeeEstimateItem FeeEstimateItem
+
+func (x *FeeEstimateItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeEstimateItem)(x)
+}
+
+func (x *FeeEstimateItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeEstimateItem_messageType fastReflection_FeeEstimateItem_messageType
+var _ protoreflect.MessageType = fastReflection_FeeEstimateItem_messageType{}
+
+type fastReflection_FeeEstimateItem_messageType struct{}
+
+func (x fastReflection_FeeEstimateItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeEstimateItem)(nil)
+}
+func (x fastReflection_FeeEstimateItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeEstimateItem)
+}
+func (x fastReflection_FeeEstimateItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeEstimateItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeEstimateItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeEstimateItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeEstimateItem) Type() protoreflect.MessageType {
+	return _fastReflection_FeeEstimateItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeEstimateItem) New() protoreflect.Message {
+	return new(fastReflection_FeeEstimateItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeEstimateItem) Interface() protoreflect.ProtoMessage {
+	return (*FeeEstimateItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeEstimateItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_FeeEstimateItem_kind, value) {
+			return
+		}
+	}
+	if x.Destination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Destination))
+		if !f(fd_FeeEstimateItem_destination, value) {
+			return
+		}
+	}
+	if x.ParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipantId)
+		if !f(fd_FeeEstimateItem_participant_id, value) {
+			return
+		}
+	}
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_FeeEstimateItem_corporation_id, value) {
+			return
+		}
+	}
+	if x.Account != "" {
+		value := protoreflect.ValueOfString(x.Account)
+		if !f(fd_FeeEstimateItem_account, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_FeeEstimateItem_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeEstimateItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.FeeEstimateItem.kind":
+		return x.Kind != 0
+	case "verana.pp.v1.FeeEstimateItem.destination":
+		return x.Destination != 0
+	case "verana.pp.v1.FeeEstimateItem.participant_id":
+		return x.ParticipantId != uint64(0)
+	case "verana.pp.v1.FeeEstimateItem.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.pp.v1.FeeEstimateItem.account":
+		return x.Account != ""
+	case "verana.pp.v1.FeeEstimateItem.amount":
+		return x.Amount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.FeeEstimateItem"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.FeeEstimateItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEstimateItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.FeeEstimateItem.kind":
+		x.Kind = 0
+	case "verana.pp.v1.FeeEstimateItem.destination":
+		x.Destination = 0
+	case "verana.pp.v1.FeeEstimateItem.participant_id":
+		x.ParticipantId = uint64(0)
+	case "verana.pp.v1.FeeEstimateItem.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.pp.v1.FeeEstimateItem.account":
+		x.Account = ""
+	case "verana.pp.v1.FeeEstimateItem.amount":
+		x.Amount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.FeeEstimateItem"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.FeeEstimateItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeEstimateItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.FeeEstimateItem.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.FeeEstimateItem.destination":
+		value := x.Destination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.FeeEstimateItem.participant_id":
+		value := x.ParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.FeeEstimateItem.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.FeeEstimateItem.account":
+		value := x.Account
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.FeeEstimateItem.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.FeeEstimateItem"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.FeeEstimateItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEstimateItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.FeeEstimateItem.kind":
+		x.Kind = (FeeItemKind)(value.Enum())
+	case "verana.pp.v1.FeeEstimateItem.destination":
+		x.Destination = (FeeDestination)(value.Enum())
+	case "verana.pp.v1.FeeEstimateItem.participant_id":
+		x.ParticipantId = value.Uint()
+	case "verana.pp.v1.FeeEstimateItem.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.pp.v1.FeeEstimateItem.account":
+		x.Account = value.Interface().(string)
+	case "verana.pp.v1.FeeEstimateItem.amount":
+		x.Amount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.FeeEstimateItem"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.FeeEstimateItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEstimateItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.FeeEstimateItem.kind":
+		panic(fmt.Errorf("field kind of message verana.pp.v1.FeeEstimateItem is not mutable"))
+	case "verana.pp.v1.FeeEstimateItem.destination":
+		panic(fmt.Errorf("field destination of message verana.pp.v1.FeeEstimateItem is not mutable"))
+	case "verana.pp.v1.FeeEstimateItem.participant_id":
+		panic(fmt.Errorf("field participant_id of message verana.pp.v1.FeeEstimateItem is not mutable"))
+	case "verana.pp.v1.FeeEstimateItem.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.pp.v1.FeeEstimateItem is not mutable"))
+	case "verana.pp.v1.FeeEstimateItem.account":
+		panic(fmt.Errorf("field account of message verana.pp.v1.FeeEstimateItem is not mutable"))
+	case "verana.pp.v1.FeeEstimateItem.amount":
+		panic(fmt.Errorf("field amount of message verana.pp.v1.FeeEstimateItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.FeeEstimateItem"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.FeeEstimateItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeEstimateItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.FeeEstimateItem.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.FeeEstimateItem.destination":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.FeeEstimateItem.participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.FeeEstimateItem.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.FeeEstimateItem.account":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.FeeEstimateItem.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.FeeEstimateItem"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.FeeEstimateItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeEstimateItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.FeeEstimateItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeEstimateItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEstimateItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeEstimateItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeEstimateItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeEstimateItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Kind != 0 {
+			n += 1 + runtime.Sov(uint64(x.Kind))
+		}
+		if x.Destination != 0 {
+			n += 1 + runtime.Sov(uint64(x.Destination))
+		}
+		if x.ParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipantId))
+		}
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
+		}
+		l = len(x.Account)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeEstimateItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Account) > 0 {
+			i -= len(x.Account)
+			copy(dAtA[i:], x.Account)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Account)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipantId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Destination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Destination))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeEstimateItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeEstimateItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeEstimateItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= FeeItemKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				x.Destination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Destination |= FeeDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+				}
+				x.ParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				}
+				x.CorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Account = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BeneficiaryFeeEstimate                protoreflect.MessageDescriptor
+	fd_BeneficiaryFeeEstimate_participant_id protoreflect.FieldDescriptor
+	fd_BeneficiaryFeeEstimate_role           protoreflect.FieldDescriptor
+	fd_BeneficiaryFeeEstimate_corporation_id protoreflect.FieldDescriptor
+	fd_BeneficiaryFeeEstimate_fees           protoreflect.FieldDescriptor
+	fd_BeneficiaryFeeEstimate_fees_in_denom  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_BeneficiaryFeeEstimate = File_verana_pp_v1_query_proto.Messages().ByName("BeneficiaryFeeEstimate")
+	fd_BeneficiaryFeeEstimate_participant_id = md_BeneficiaryFeeEstimate.Fields().ByName("participant_id")
+	fd_BeneficiaryFeeEstimate_role = md_BeneficiaryFeeEstimate.Fields().ByName("role")
+	fd_BeneficiaryFeeEstimate_corporation_id = md_BeneficiaryFeeEstimate.Fields().ByName("corporation_id")
+	fd_BeneficiaryFeeEstimate_fees = md_BeneficiaryFeeEstimate.Fields().ByName("fees")
+	fd_BeneficiaryFeeEstimate_fees_in_denom = md_BeneficiaryFeeEstimate.Fields().ByName("fees_in_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_BeneficiaryFeeEstimate)(nil)
+
+type fastReflection_BeneficiaryFeeEstimate BeneficiaryFeeEstimate
+
+func (x *BeneficiaryFeeEstimate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BeneficiaryFeeEstimate)(x)
+}
+
+func (x *BeneficiaryFeeEstimate) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BeneficiaryFeeEstimate_messageType fastReflection_BeneficiaryFeeEstimate_messageType
+var _ protoreflect.MessageType = fastReflection_BeneficiaryFeeEstimate_messageType{}
+
+type fastReflection_BeneficiaryFeeEstimate_messageType struct{}
+
+func (x fastReflection_BeneficiaryFeeEstimate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BeneficiaryFeeEstimate)(nil)
+}
+func (x fastReflection_BeneficiaryFeeEstimate_messageType) New() protoreflect.Message {
+	return new(fastReflection_BeneficiaryFeeEstimate)
+}
+func (x fastReflection_BeneficiaryFeeEstimate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BeneficiaryFeeEstimate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BeneficiaryFeeEstimate) Descriptor() protoreflect.MessageDescriptor {
+	return md_BeneficiaryFeeEstimate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BeneficiaryFeeEstimate) Type() protoreflect.MessageType {
+	return _fastReflection_BeneficiaryFeeEstimate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BeneficiaryFeeEstimate) New() protoreflect.Message {
+	return new(fastReflection_BeneficiaryFeeEstimate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BeneficiaryFeeEstimate) Interface() protoreflect.ProtoMessage {
+	return (*BeneficiaryFeeEstimate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BeneficiaryFeeEstimate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipantId)
+		if !f(fd_BeneficiaryFeeEstimate_participant_id, value) {
+			return
+		}
+	}
+	if x.Role != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Role))
+		if !f(fd_BeneficiaryFeeEstimate_role, value) {
+			return
+		}
+	}
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_BeneficiaryFeeEstimate_corporation_id, value) {
+			return
+		}
+	}
+	if x.Fees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Fees)
+		if !f(fd_BeneficiaryFeeEstimate_fees, value) {
+			return
+		}
+	}
+	if x.FeesInDenom != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FeesInDenom)
+		if !f(fd_BeneficiaryFeeEstimate_fees_in_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BeneficiaryFeeEstimate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.BeneficiaryFeeEstimate.participant_id":
+		return x.ParticipantId != uint64(0)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.role":
+		return x.Role != 0
+	case "verana.pp.v1.BeneficiaryFeeEstimate.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees":
+		return x.Fees != uint64(0)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees_in_denom":
+		return x.FeesInDenom != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.BeneficiaryFeeEstimate"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.BeneficiaryFeeEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeneficiaryFeeEstimate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.BeneficiaryFeeEstimate.participant_id":
+		x.ParticipantId = uint64(0)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.role":
+		x.Role = 0
+	case "verana.pp.v1.BeneficiaryFeeEstimate.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees":
+		x.Fees = uint64(0)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees_in_denom":
+		x.FeesInDenom = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.BeneficiaryFeeEstimate"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.BeneficiaryFeeEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BeneficiaryFeeEstimate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.BeneficiaryFeeEstimate.participant_id":
+		value := x.ParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.role":
+		value := x.Role
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees":
+		value := x.Fees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees_in_denom":
+		value := x.FeesInDenom
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.BeneficiaryFeeEstimate"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.BeneficiaryFeeEstimate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeneficiaryFeeEstimate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.BeneficiaryFeeEstimate.participant_id":
+		x.ParticipantId = value.Uint()
+	case "verana.pp.v1.BeneficiaryFeeEstimate.role":
+		x.Role = (ParticipantRole)(value.Enum())
+	case "verana.pp.v1.BeneficiaryFeeEstimate.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees":
+		x.Fees = value.Uint()
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees_in_denom":
+		x.FeesInDenom = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.BeneficiaryFeeEstimate"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.BeneficiaryFeeEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeneficiaryFeeEstimate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.BeneficiaryFeeEstimate.participant_id":
+		panic(fmt.Errorf("field participant_id of message verana.pp.v1.BeneficiaryFeeEstimate is not mutable"))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.role":
+		panic(fmt.Errorf("field role of message verana.pp.v1.BeneficiaryFeeEstimate is not mutable"))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.pp.v1.BeneficiaryFeeEstimate is not mutable"))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees":
+		panic(fmt.Errorf("field fees of message verana.pp.v1.BeneficiaryFeeEstimate is not mutable"))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees_in_denom":
+		panic(fmt.Errorf("field fees_in_denom of message verana.pp.v1.BeneficiaryFeeEstimate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.BeneficiaryFeeEstimate"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.BeneficiaryFeeEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BeneficiaryFeeEstimate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.BeneficiaryFeeEstimate.participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.role":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.BeneficiaryFeeEstimate.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.BeneficiaryFeeEstimate.fees_in_denom":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.BeneficiaryFeeEstimate"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.BeneficiaryFeeEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BeneficiaryFeeEstimate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.BeneficiaryFeeEstimate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BeneficiaryFeeEstimate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeneficiaryFeeEstimate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BeneficiaryFeeEstimate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BeneficiaryFeeEstimate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BeneficiaryFeeEstimate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipantId))
+		}
+		if x.Role != 0 {
+			n += 1 + runtime.Sov(uint64(x.Role))
+		}
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
+		}
+		if x.Fees != 0 {
+			n += 1 + runtime.Sov(uint64(x.Fees))
+		}
+		if x.FeesInDenom != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeesInDenom))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BeneficiaryFeeEstimate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FeesInDenom != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeesInDenom))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Fees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Fees))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Role != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipantId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BeneficiaryFeeEstimate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BeneficiaryFeeEstimate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BeneficiaryFeeEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+				}
+				x.ParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				x.Role = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Role |= ParticipantRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				}
+				x.CorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				x.Fees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Fees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeesInDenom", wireType)
+				}
+				x.FeesInDenom = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeesInDenom |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEstimateSessionFeesRequest                             protoreflect.MessageDescriptor
+	fd_QueryEstimateSessionFeesRequest_issuer_participant_id       protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesRequest_verifier_participant_id     protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesRequest_agent_participant_id        protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesRequest_wallet_agent_participant_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryEstimateSessionFeesRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryEstimateSessionFeesRequest")
+	fd_QueryEstimateSessionFeesRequest_issuer_participant_id = md_QueryEstimateSessionFeesRequest.Fields().ByName("issuer_participant_id")
+	fd_QueryEstimateSessionFeesRequest_verifier_participant_id = md_QueryEstimateSessionFeesRequest.Fields().ByName("verifier_participant_id")
+	fd_QueryEstimateSessionFeesRequest_agent_participant_id = md_QueryEstimateSessionFeesRequest.Fields().ByName("agent_participant_id")
+	fd_QueryEstimateSessionFeesRequest_wallet_agent_participant_id = md_QueryEstimateSessionFeesRequest.Fields().ByName("wallet_agent_participant_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEstimateSessionFeesRequest)(nil)
+
+type fastReflection_QueryEstimateSessionFeesRequest QueryEstimateSessionFeesRequest
+
+func (x *QueryEstimateSessionFeesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEstimateSessionFeesRequest)(x)
+}
+
+func (x *QueryEstimateSessionFeesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEstimateSessionFeesRequest_messageType fastReflection_QueryEstimateSessionFeesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEstimateSessionFeesRequest_messageType{}
+
+type fastReflection_QueryEstimateSessionFeesRequest_messageType struct{}
+
+func (x fastReflection_QueryEstimateSessionFeesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEstimateSessionFeesRequest)(nil)
+}
+func (x fastReflection_QueryEstimateSessionFeesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateSessionFeesRequest)
+}
+func (x fastReflection_QueryEstimateSessionFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateSessionFeesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateSessionFeesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEstimateSessionFeesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateSessionFeesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEstimateSessionFeesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IssuerParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuerParticipantId)
+		if !f(fd_QueryEstimateSessionFeesRequest_issuer_participant_id, value) {
+			return
+		}
+	}
+	if x.VerifierParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerifierParticipantId)
+		if !f(fd_QueryEstimateSessionFeesRequest_verifier_participant_id, value) {
+			return
+		}
+	}
+	if x.AgentParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgentParticipantId)
+		if !f(fd_QueryEstimateSessionFeesRequest_agent_participant_id, value) {
+			return
+		}
+	}
+	if x.WalletAgentParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WalletAgentParticipantId)
+		if !f(fd_QueryEstimateSessionFeesRequest_wallet_agent_participant_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.issuer_participant_id":
+		return x.IssuerParticipantId != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.verifier_participant_id":
+		return x.VerifierParticipantId != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.agent_participant_id":
+		return x.AgentParticipantId != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.wallet_agent_participant_id":
+		return x.WalletAgentParticipantId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.issuer_participant_id":
+		x.IssuerParticipantId = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.verifier_participant_id":
+		x.VerifierParticipantId = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.agent_participant_id":
+		x.AgentParticipantId = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.wallet_agent_participant_id":
+		x.WalletAgentParticipantId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.issuer_participant_id":
+		value := x.IssuerParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.verifier_participant_id":
+		value := x.VerifierParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.agent_participant_id":
+		value := x.AgentParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.wallet_agent_participant_id":
+		value := x.WalletAgentParticipantId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.issuer_participant_id":
+		x.IssuerParticipantId = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.verifier_participant_id":
+		x.VerifierParticipantId = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.agent_participant_id":
+		x.AgentParticipantId = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.wallet_agent_participant_id":
+		x.WalletAgentParticipantId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.issuer_participant_id":
+		panic(fmt.Errorf("field issuer_participant_id of message verana.pp.v1.QueryEstimateSessionFeesRequest is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.verifier_participant_id":
+		panic(fmt.Errorf("field verifier_participant_id of message verana.pp.v1.QueryEstimateSessionFeesRequest is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.agent_participant_id":
+		panic(fmt.Errorf("field agent_participant_id of message verana.pp.v1.QueryEstimateSessionFeesRequest is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.wallet_agent_participant_id":
+		panic(fmt.Errorf("field wallet_agent_participant_id of message verana.pp.v1.QueryEstimateSessionFeesRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.issuer_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.verifier_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.agent_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesRequest.wallet_agent_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryEstimateSessionFeesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEstimateSessionFeesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEstimateSessionFeesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IssuerParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerParticipantId))
+		}
+		if x.VerifierParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierParticipantId))
+		}
+		if x.AgentParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgentParticipantId))
+		}
+		if x.WalletAgentParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.WalletAgentParticipantId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateSessionFeesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.WalletAgentParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletAgentParticipantId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AgentParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgentParticipantId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.VerifierParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierParticipantId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.IssuerParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerParticipantId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateSessionFeesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateSessionFeesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateSessionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
+				}
+				x.IssuerParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+				}
+				x.VerifierParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgentParticipantId", wireType)
+				}
+				x.AgentParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgentParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAgentParticipantId", wireType)
+				}
+				x.WalletAgentParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WalletAgentParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryEstimateSessionFeesResponse_1_list)(nil)
+
+type _QueryEstimateSessionFeesResponse_1_list struct {
+	list *[]*BeneficiaryFeeEstimate
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BeneficiaryFeeEstimate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BeneficiaryFeeEstimate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(BeneficiaryFeeEstimate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(BeneficiaryFeeEstimate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateSessionFeesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryEstimateSessionFeesResponse_2_list)(nil)
+
+type _QueryEstimateSessionFeesResponse_2_list struct {
+	list *[]*FeeEstimateItem
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeEstimateItem)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeEstimateItem)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(FeeEstimateItem)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) NewElement() protoreflect.Value {
+	v := new(FeeEstimateItem)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateSessionFeesResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEstimateSessionFeesResponse                        protoreflect.MessageDescriptor
+	fd_QueryEstimateSessionFeesResponse_beneficiaries          protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_items                  protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_beneficiary_fees       protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_discount               protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_trust_unit_price       protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_denom                  protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_payer                  protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_required_payer_balance protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_payer_balance          protoreflect.FieldDescriptor
+	fd_QueryEstimateSessionFeesResponse_sufficient_funds       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryEstimateSessionFeesResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryEstimateSessionFeesResponse")
+	fd_QueryEstimateSessionFeesResponse_beneficiaries = md_QueryEstimateSessionFeesResponse.Fields().ByName("beneficiaries")
+	fd_QueryEstimateSessionFeesResponse_items = md_QueryEstimateSessionFeesResponse.Fields().ByName("items")
+	fd_QueryEstimateSessionFeesResponse_beneficiary_fees = md_QueryEstimateSessionFeesResponse.Fields().ByName("beneficiary_fees")
+	fd_QueryEstimateSessionFeesResponse_discount = md_QueryEstimateSessionFeesResponse.Fields().ByName("discount")
+	fd_QueryEstimateSessionFeesResponse_trust_unit_price = md_QueryEstimateSessionFeesResponse.Fields().ByName("trust_unit_price")
+	fd_QueryEstimateSessionFeesResponse_denom = md_QueryEstimateSessionFeesResponse.Fields().ByName("denom")
+	fd_QueryEstimateSessionFeesResponse_payer = md_QueryEstimateSessionFeesResponse.Fields().ByName("payer")
+	fd_QueryEstimateSessionFeesResponse_required_payer_balance = md_QueryEstimateSessionFeesResponse.Fields().ByName("required_payer_balance")
+	fd_QueryEstimateSessionFeesResponse_payer_balance = md_QueryEstimateSessionFeesResponse.Fields().ByName("payer_balance")
+	fd_QueryEstimateSessionFeesResponse_sufficient_funds = md_QueryEstimateSessionFeesResponse.Fields().ByName("sufficient_funds")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEstimateSessionFeesResponse)(nil)
+
+type fastReflection_QueryEstimateSessionFeesResponse QueryEstimateSessionFeesResponse
+
+func (x *QueryEstimateSessionFeesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEstimateSessionFeesResponse)(x)
+}
+
+func (x *QueryEstimateSessionFeesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEstimateSessionFeesResponse_messageType fastReflection_QueryEstimateSessionFeesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEstimateSessionFeesResponse_messageType{}
+
+type fastReflection_QueryEstimateSessionFeesResponse_messageType struct{}
+
+func (x fastReflection_QueryEstimateSessionFeesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEstimateSessionFeesResponse)(nil)
+}
+func (x fastReflection_QueryEstimateSessionFeesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateSessionFeesResponse)
+}
+func (x fastReflection_QueryEstimateSessionFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateSessionFeesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateSessionFeesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEstimateSessionFeesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateSessionFeesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEstimateSessionFeesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Beneficiaries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateSessionFeesResponse_1_list{list: &x.Beneficiaries})
+		if !f(fd_QueryEstimateSessionFeesResponse_beneficiaries, value) {
+			return
+		}
+	}
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateSessionFeesResponse_2_list{list: &x.Items})
+		if !f(fd_QueryEstimateSessionFeesResponse_items, value) {
+			return
+		}
+	}
+	if x.BeneficiaryFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BeneficiaryFees)
+		if !f(fd_QueryEstimateSessionFeesResponse_beneficiary_fees, value) {
+			return
+		}
+	}
+	if x.Discount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Discount)
+		if !f(fd_QueryEstimateSessionFeesResponse_discount, value) {
+			return
+		}
+	}
+	if x.TrustUnitPrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrustUnitPrice)
+		if !f(fd_QueryEstimateSessionFeesResponse_trust_unit_price, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryEstimateSessionFeesResponse_denom, value) {
+			return
+		}
+	}
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_QueryEstimateSessionFeesResponse_payer, value) {
+			return
+		}
+	}
+	if x.RequiredPayerBalance != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequiredPayerBalance)
+		if !f(fd_QueryEstimateSessionFeesResponse_required_payer_balance, value) {
+			return
+		}
+	}
+	if x.PayerBalance != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PayerBalance)
+		if !f(fd_QueryEstimateSessionFeesResponse_payer_balance, value) {
+			return
+		}
+	}
+	if x.SufficientFunds != false {
+		value := protoreflect.ValueOfBool(x.SufficientFunds)
+		if !f(fd_QueryEstimateSessionFeesResponse_sufficient_funds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiaries":
+		return len(x.Beneficiaries) != 0
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.items":
+		return len(x.Items) != 0
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiary_fees":
+		return x.BeneficiaryFees != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.discount":
+		return x.Discount != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.trust_unit_price":
+		return x.TrustUnitPrice != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.denom":
+		return x.Denom != ""
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer":
+		return x.Payer != ""
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.required_payer_balance":
+		return x.RequiredPayerBalance != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer_balance":
+		return x.PayerBalance != uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.sufficient_funds":
+		return x.SufficientFunds != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiaries":
+		x.Beneficiaries = nil
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.items":
+		x.Items = nil
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiary_fees":
+		x.BeneficiaryFees = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.discount":
+		x.Discount = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.trust_unit_price":
+		x.TrustUnitPrice = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.denom":
+		x.Denom = ""
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer":
+		x.Payer = ""
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.required_payer_balance":
+		x.RequiredPayerBalance = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer_balance":
+		x.PayerBalance = uint64(0)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.sufficient_funds":
+		x.SufficientFunds = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiaries":
+		if len(x.Beneficiaries) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateSessionFeesResponse_1_list{})
+		}
+		listValue := &_QueryEstimateSessionFeesResponse_1_list{list: &x.Beneficiaries}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateSessionFeesResponse_2_list{})
+		}
+		listValue := &_QueryEstimateSessionFeesResponse_2_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiary_fees":
+		value := x.BeneficiaryFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.discount":
+		value := x.Discount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.trust_unit_price":
+		value := x.TrustUnitPrice
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.required_payer_balance":
+		value := x.RequiredPayerBalance
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer_balance":
+		value := x.PayerBalance
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.sufficient_funds":
+		value := x.SufficientFunds
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiaries":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateSessionFeesResponse_1_list)
+		x.Beneficiaries = *clv.list
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.items":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateSessionFeesResponse_2_list)
+		x.Items = *clv.list
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiary_fees":
+		x.BeneficiaryFees = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.discount":
+		x.Discount = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.trust_unit_price":
+		x.TrustUnitPrice = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.denom":
+		x.Denom = value.Interface().(string)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer":
+		x.Payer = value.Interface().(string)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.required_payer_balance":
+		x.RequiredPayerBalance = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer_balance":
+		x.PayerBalance = value.Uint()
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.sufficient_funds":
+		x.SufficientFunds = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiaries":
+		if x.Beneficiaries == nil {
+			x.Beneficiaries = []*BeneficiaryFeeEstimate{}
+		}
+		value := &_QueryEstimateSessionFeesResponse_1_list{list: &x.Beneficiaries}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.items":
+		if x.Items == nil {
+			x.Items = []*FeeEstimateItem{}
+		}
+		value := &_QueryEstimateSessionFeesResponse_2_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiary_fees":
+		panic(fmt.Errorf("field beneficiary_fees of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.discount":
+		panic(fmt.Errorf("field discount of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.trust_unit_price":
+		panic(fmt.Errorf("field trust_unit_price of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.denom":
+		panic(fmt.Errorf("field denom of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer":
+		panic(fmt.Errorf("field payer of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.required_payer_balance":
+		panic(fmt.Errorf("field required_payer_balance of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer_balance":
+		panic(fmt.Errorf("field payer_balance of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.sufficient_funds":
+		panic(fmt.Errorf("field sufficient_funds of message verana.pp.v1.QueryEstimateSessionFeesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiaries":
+		list := []*BeneficiaryFeeEstimate{}
+		return protoreflect.ValueOfList(&_QueryEstimateSessionFeesResponse_1_list{list: &list})
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.items":
+		list := []*FeeEstimateItem{}
+		return protoreflect.ValueOfList(&_QueryEstimateSessionFeesResponse_2_list{list: &list})
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiary_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.discount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.trust_unit_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.denom":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.required_payer_balance":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.payer_balance":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateSessionFeesResponse.sufficient_funds":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateSessionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateSessionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryEstimateSessionFeesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEstimateSessionFeesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEstimateSessionFeesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Beneficiaries) > 0 {
+			for _, e := range x.Beneficiaries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BeneficiaryFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.BeneficiaryFees))
+		}
+		if x.Discount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Discount))
+		}
+		if x.TrustUnitPrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrustUnitPrice))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Payer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequiredPayerBalance != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequiredPayerBalance))
+		}
+		if x.PayerBalance != 0 {
+			n += 1 + runtime.Sov(uint64(x.PayerBalance))
+		}
+		if x.SufficientFunds {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateSessionFeesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SufficientFunds {
+			i--
+			if x.SufficientFunds {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.PayerBalance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PayerBalance))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RequiredPayerBalance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequiredPayerBalance))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.TrustUnitPrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrustUnitPrice))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Discount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Discount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BeneficiaryFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeneficiaryFees))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Beneficiaries) > 0 {
+			for iNdEx := len(x.Beneficiaries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Beneficiaries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateSessionFeesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateSessionFeesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateSessionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beneficiaries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beneficiaries = append(x.Beneficiaries, &BeneficiaryFeeEstimate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Beneficiaries[len(x.Beneficiaries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Items = append(x.Items, &FeeEstimateItem{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryFees", wireType)
+				}
+				x.BeneficiaryFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BeneficiaryFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+				}
+				x.Discount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Discount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustUnitPrice", wireType)
+				}
+				x.TrustUnitPrice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrustUnitPrice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredPayerBalance", wireType)
+				}
+				x.RequiredPayerBalance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequiredPayerBalance |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayerBalance", wireType)
+				}
+				x.PayerBalance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PayerBalance |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SufficientFunds", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SufficientFunds = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEstimateOnboardingFeesRequest                          protoreflect.MessageDescriptor
+	fd_QueryEstimateOnboardingFeesRequest_validator_participant_id protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesRequest_role                     protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesRequest_corporation              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryEstimateOnboardingFeesRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryEstimateOnboardingFeesRequest")
+	fd_QueryEstimateOnboardingFeesRequest_validator_participant_id = md_QueryEstimateOnboardingFeesRequest.Fields().ByName("validator_participant_id")
+	fd_QueryEstimateOnboardingFeesRequest_role = md_QueryEstimateOnboardingFeesRequest.Fields().ByName("role")
+	fd_QueryEstimateOnboardingFeesRequest_corporation = md_QueryEstimateOnboardingFeesRequest.Fields().ByName("corporation")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEstimateOnboardingFeesRequest)(nil)
+
+type fastReflection_QueryEstimateOnboardingFeesRequest QueryEstimateOnboardingFeesRequest
+
+func (x *QueryEstimateOnboardingFeesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEstimateOnboardingFeesRequest)(x)
+}
+
+func (x *QueryEstimateOnboardingFeesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEstimateOnboardingFeesRequest_messageType fastReflection_QueryEstimateOnboardingFeesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEstimateOnboardingFeesRequest_messageType{}
+
+type fastReflection_QueryEstimateOnboardingFeesRequest_messageType struct{}
+
+func (x fastReflection_QueryEstimateOnboardingFeesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEstimateOnboardingFeesRequest)(nil)
+}
+func (x fastReflection_QueryEstimateOnboardingFeesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateOnboardingFeesRequest)
+}
+func (x fastReflection_QueryEstimateOnboardingFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateOnboardingFeesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateOnboardingFeesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEstimateOnboardingFeesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateOnboardingFeesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEstimateOnboardingFeesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorParticipantId)
+		if !f(fd_QueryEstimateOnboardingFeesRequest_validator_participant_id, value) {
+			return
+		}
+	}
+	if x.Role != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Role))
+		if !f(fd_QueryEstimateOnboardingFeesRequest_role, value) {
+			return
+		}
+	}
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_QueryEstimateOnboardingFeesRequest_corporation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.validator_participant_id":
+		return x.ValidatorParticipantId != uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.role":
+		return x.Role != 0
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.corporation":
+		return x.Corporation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.validator_participant_id":
+		x.ValidatorParticipantId = uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.role":
+		x.Role = 0
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.corporation":
+		x.Corporation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.validator_participant_id":
+		value := x.ValidatorParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.role":
+		value := x.Role
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.validator_participant_id":
+		x.ValidatorParticipantId = value.Uint()
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.role":
+		x.Role = (ParticipantRole)(value.Enum())
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.corporation":
+		x.Corporation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.validator_participant_id":
+		panic(fmt.Errorf("field validator_participant_id of message verana.pp.v1.QueryEstimateOnboardingFeesRequest is not mutable"))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.role":
+		panic(fmt.Errorf("field role of message verana.pp.v1.QueryEstimateOnboardingFeesRequest is not mutable"))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.QueryEstimateOnboardingFeesRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.validator_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.role":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesRequest.corporation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryEstimateOnboardingFeesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEstimateOnboardingFeesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEstimateOnboardingFeesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorParticipantId))
+		}
+		if x.Role != 0 {
+			n += 1 + runtime.Sov(uint64(x.Role))
+		}
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateOnboardingFeesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Role != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValidatorParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorParticipantId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateOnboardingFeesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateOnboardingFeesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateOnboardingFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
+				}
+				x.ValidatorParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				x.Role = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Role |= ParticipantRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryEstimateOnboardingFeesResponse_1_list)(nil)
+
+type _QueryEstimateOnboardingFeesResponse_1_list struct {
+	list *[]*FeeEstimateItem
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeEstimateItem)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeEstimateItem)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(FeeEstimateItem)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(FeeEstimateItem)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateOnboardingFeesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEstimateOnboardingFeesResponse                        protoreflect.MessageDescriptor
+	fd_QueryEstimateOnboardingFeesResponse_items                  protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesResponse_validation_fees        protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesResponse_trust_unit_price       protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesResponse_denom                  protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesResponse_required_payer_balance protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesResponse_payer_balance          protoreflect.FieldDescriptor
+	fd_QueryEstimateOnboardingFeesResponse_sufficient_funds       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryEstimateOnboardingFeesResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryEstimateOnboardingFeesResponse")
+	fd_QueryEstimateOnboardingFeesResponse_items = md_QueryEstimateOnboardingFeesResponse.Fields().ByName("items")
+	fd_QueryEstimateOnboardingFeesResponse_validation_fees = md_QueryEstimateOnboardingFeesResponse.Fields().ByName("validation_fees")
+	fd_QueryEstimateOnboardingFeesResponse_trust_unit_price = md_QueryEstimateOnboardingFeesResponse.Fields().ByName("trust_unit_price")
+	fd_QueryEstimateOnboardingFeesResponse_denom = md_QueryEstimateOnboardingFeesResponse.Fields().ByName("denom")
+	fd_QueryEstimateOnboardingFeesResponse_required_payer_balance = md_QueryEstimateOnboardingFeesResponse.Fields().ByName("required_payer_balance")
+	fd_QueryEstimateOnboardingFeesResponse_payer_balance = md_QueryEstimateOnboardingFeesResponse.Fields().ByName("payer_balance")
+	fd_QueryEstimateOnboardingFeesResponse_sufficient_funds = md_QueryEstimateOnboardingFeesResponse.Fields().ByName("sufficient_funds")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEstimateOnboardingFeesResponse)(nil)
+
+type fastReflection_QueryEstimateOnboardingFeesResponse QueryEstimateOnboardingFeesResponse
+
+func (x *QueryEstimateOnboardingFeesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEstimateOnboardingFeesResponse)(x)
+}
+
+func (x *QueryEstimateOnboardingFeesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEstimateOnboardingFeesResponse_messageType fastReflection_QueryEstimateOnboardingFeesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEstimateOnboardingFeesResponse_messageType{}
+
+type fastReflection_QueryEstimateOnboardingFeesResponse_messageType struct{}
+
+func (x fastReflection_QueryEstimateOnboardingFeesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEstimateOnboardingFeesResponse)(nil)
+}
+func (x fastReflection_QueryEstimateOnboardingFeesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateOnboardingFeesResponse)
+}
+func (x fastReflection_QueryEstimateOnboardingFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateOnboardingFeesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateOnboardingFeesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEstimateOnboardingFeesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateOnboardingFeesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEstimateOnboardingFeesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateOnboardingFeesResponse_1_list{list: &x.Items})
+		if !f(fd_QueryEstimateOnboardingFeesResponse_items, value) {
+			return
+		}
+	}
+	if x.ValidationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidationFees)
+		if !f(fd_QueryEstimateOnboardingFeesResponse_validation_fees, value) {
+			return
+		}
+	}
+	if x.TrustUnitPrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrustUnitPrice)
+		if !f(fd_QueryEstimateOnboardingFeesResponse_trust_unit_price, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryEstimateOnboardingFeesResponse_denom, value) {
+			return
+		}
+	}
+	if x.RequiredPayerBalance != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequiredPayerBalance)
+		if !f(fd_QueryEstimateOnboardingFeesResponse_required_payer_balance, value) {
+			return
+		}
+	}
+	if x.PayerBalance != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PayerBalance)
+		if !f(fd_QueryEstimateOnboardingFeesResponse_payer_balance, value) {
+			return
+		}
+	}
+	if x.SufficientFunds != false {
+		value := protoreflect.ValueOfBool(x.SufficientFunds)
+		if !f(fd_QueryEstimateOnboardingFeesResponse_sufficient_funds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.items":
+		return len(x.Items) != 0
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.validation_fees":
+		return x.ValidationFees != uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.trust_unit_price":
+		return x.TrustUnitPrice != uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.denom":
+		return x.Denom != ""
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.required_payer_balance":
+		return x.RequiredPayerBalance != uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.payer_balance":
+		return x.PayerBalance != uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.sufficient_funds":
+		return x.SufficientFunds != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.items":
+		x.Items = nil
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.validation_fees":
+		x.ValidationFees = uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.trust_unit_price":
+		x.TrustUnitPrice = uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.denom":
+		x.Denom = ""
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.required_payer_balance":
+		x.RequiredPayerBalance = uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.payer_balance":
+		x.PayerBalance = uint64(0)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.sufficient_funds":
+		x.SufficientFunds = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateOnboardingFeesResponse_1_list{})
+		}
+		listValue := &_QueryEstimateOnboardingFeesResponse_1_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.validation_fees":
+		value := x.ValidationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.trust_unit_price":
+		value := x.TrustUnitPrice
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.required_payer_balance":
+		value := x.RequiredPayerBalance
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.payer_balance":
+		value := x.PayerBalance
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.sufficient_funds":
+		value := x.SufficientFunds
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.items":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateOnboardingFeesResponse_1_list)
+		x.Items = *clv.list
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.validation_fees":
+		x.ValidationFees = value.Uint()
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.trust_unit_price":
+		x.TrustUnitPrice = value.Uint()
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.denom":
+		x.Denom = value.Interface().(string)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.required_payer_balance":
+		x.RequiredPayerBalance = value.Uint()
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.payer_balance":
+		x.PayerBalance = value.Uint()
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.sufficient_funds":
+		x.SufficientFunds = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.items":
+		if x.Items == nil {
+			x.Items = []*FeeEstimateItem{}
+		}
+		value := &_QueryEstimateOnboardingFeesResponse_1_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.validation_fees":
+		panic(fmt.Errorf("field validation_fees of message verana.pp.v1.QueryEstimateOnboardingFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.trust_unit_price":
+		panic(fmt.Errorf("field trust_unit_price of message verana.pp.v1.QueryEstimateOnboardingFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.denom":
+		panic(fmt.Errorf("field denom of message verana.pp.v1.QueryEstimateOnboardingFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.required_payer_balance":
+		panic(fmt.Errorf("field required_payer_balance of message verana.pp.v1.QueryEstimateOnboardingFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.payer_balance":
+		panic(fmt.Errorf("field payer_balance of message verana.pp.v1.QueryEstimateOnboardingFeesResponse is not mutable"))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.sufficient_funds":
+		panic(fmt.Errorf("field sufficient_funds of message verana.pp.v1.QueryEstimateOnboardingFeesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.items":
+		list := []*FeeEstimateItem{}
+		return protoreflect.ValueOfList(&_QueryEstimateOnboardingFeesResponse_1_list{list: &list})
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.validation_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.trust_unit_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.denom":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.required_payer_balance":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.payer_balance":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.QueryEstimateOnboardingFeesResponse.sufficient_funds":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryEstimateOnboardingFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryEstimateOnboardingFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryEstimateOnboardingFeesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEstimateOnboardingFeesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEstimateOnboardingFeesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ValidationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationFees))
+		}
+		if x.TrustUnitPrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrustUnitPrice))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequiredPayerBalance != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequiredPayerBalance))
+		}
+		if x.PayerBalance != 0 {
+			n += 1 + runtime.Sov(uint64(x.PayerBalance))
+		}
+		if x.SufficientFunds {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateOnboardingFeesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SufficientFunds {
+			i--
+			if x.SufficientFunds {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.PayerBalance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PayerBalance))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RequiredPayerBalance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequiredPayerBalance))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TrustUnitPrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrustUnitPrice))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ValidationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationFees))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateOnboardingFeesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateOnboardingFeesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateOnboardingFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Items = append(x.Items, &FeeEstimateItem{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+				}
+				x.ValidationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustUnitPrice", wireType)
+				}
+				x.TrustUnitPrice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrustUnitPrice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredPayerBalance", wireType)
+				}
+				x.RequiredPayerBalance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequiredPayerBalance |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayerBalance", wireType)
+				}
+				x.PayerBalance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PayerBalance |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SufficientFunds", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SufficientFunds = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetParticipantCascadeRequest    protoreflect.MessageDescriptor
+	fd_QueryGetParticipantCascadeRequest_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryGetParticipantCascadeRequest = File_verana_pp_v1_query_proto.Messages().ByName("QueryGetParticipantCascadeRequest")
+	fd_QueryGetParticipantCascadeRequest_id = md_QueryGetParticipantCascadeRequest.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetParticipantCascadeRequest)(nil)
+
+type fastReflection_QueryGetParticipantCascadeRequest QueryGetParticipantCascadeRequest
+
+func (x *QueryGetParticipantCascadeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetParticipantCascadeRequest)(x)
+}
+
+func (x *QueryGetParticipantCascadeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetParticipantCascadeRequest_messageType fastReflection_QueryGetParticipantCascadeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetParticipantCascadeRequest_messageType{}
+
+type fastReflection_QueryGetParticipantCascadeRequest_messageType struct{}
+
+func (x fastReflection_QueryGetParticipantCascadeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetParticipantCascadeRequest)(nil)
+}
+func (x fastReflection_QueryGetParticipantCascadeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParticipantCascadeRequest)
+}
+func (x fastReflection_QueryGetParticipantCascadeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParticipantCascadeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParticipantCascadeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetParticipantCascadeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParticipantCascadeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetParticipantCascadeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryGetParticipantCascadeRequest_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeRequest.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeRequest.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeRequest.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeRequest.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.QueryGetParticipantCascadeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeRequest"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryGetParticipantCascadeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetParticipantCascadeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetParticipantCascadeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetParticipantCascadeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetParticipantCascadeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParticipantCascadeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParticipantCascadeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetParticipantCascadeResponse               protoreflect.MessageDescriptor
+	fd_QueryGetParticipantCascadeResponse_cascade       protoreflect.FieldDescriptor
+	fd_QueryGetParticipantCascadeResponse_pending_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_query_proto_init()
+	md_QueryGetParticipantCascadeResponse = File_verana_pp_v1_query_proto.Messages().ByName("QueryGetParticipantCascadeResponse")
+	fd_QueryGetParticipantCascadeResponse_cascade = md_QueryGetParticipantCascadeResponse.Fields().ByName("cascade")
+	fd_QueryGetParticipantCascadeResponse_pending_count = md_QueryGetParticipantCascadeResponse.Fields().ByName("pending_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetParticipantCascadeResponse)(nil)
+
+type fastReflection_QueryGetParticipantCascadeResponse QueryGetParticipantCascadeResponse
+
+func (x *QueryGetParticipantCascadeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetParticipantCascadeResponse)(x)
+}
+
+func (x *QueryGetParticipantCascadeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetParticipantCascadeResponse_messageType fastReflection_QueryGetParticipantCascadeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetParticipantCascadeResponse_messageType{}
+
+type fastReflection_QueryGetParticipantCascadeResponse_messageType struct{}
+
+func (x fastReflection_QueryGetParticipantCascadeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetParticipantCascadeResponse)(nil)
+}
+func (x fastReflection_QueryGetParticipantCascadeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParticipantCascadeResponse)
+}
+func (x fastReflection_QueryGetParticipantCascadeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParticipantCascadeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetParticipantCascadeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetParticipantCascadeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetParticipantCascadeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetParticipantCascadeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Cascade != nil {
+		value := protoreflect.ValueOfMessage(x.Cascade.ProtoReflect())
+		if !f(fd_QueryGetParticipantCascadeResponse_cascade, value) {
+			return
+		}
+	}
+	if x.PendingCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingCount)
+		if !f(fd_QueryGetParticipantCascadeResponse_pending_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.cascade":
+		return x.Cascade != nil
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.pending_count":
+		return x.PendingCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.cascade":
+		x.Cascade = nil
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.pending_count":
+		x.PendingCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.cascade":
+		value := x.Cascade
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.pending_count":
+		value := x.PendingCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.cascade":
+		x.Cascade = value.Message().Interface().(*ParticipantCascade)
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.pending_count":
+		x.PendingCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.cascade":
+		if x.Cascade == nil {
+			x.Cascade = new(ParticipantCascade)
+		}
+		return protoreflect.ValueOfMessage(x.Cascade.ProtoReflect())
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.pending_count":
+		panic(fmt.Errorf("field pending_count of message verana.pp.v1.QueryGetParticipantCascadeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.cascade":
+		m := new(ParticipantCascade)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.QueryGetParticipantCascadeResponse.pending_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.QueryGetParticipantCascadeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.QueryGetParticipantCascadeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.QueryGetParticipantCascadeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetParticipantCascadeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetParticipantCascadeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Cascade != nil {
+			l = options.Size(x.Cascade)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetParticipantCascadeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PendingCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Cascade != nil {
+			encoded, err := options.Marshal(x.Cascade)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetParticipantCascadeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParticipantCascadeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetParticipantCascadeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Cascade == nil {
+					x.Cascade = &ParticipantCascade{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Cascade); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingCount", wireType)
+				}
+				x.PendingCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: verana/pp/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FeeItemKind identifies what a fee estimate item pays for.
+type FeeItemKind int32
+
+const (
+	FeeItemKind_FEE_ITEM_KIND_UNSPECIFIED FeeItemKind = 0
+	// Fee owed to a beneficiary participant of the session.
+	FeeItemKind_FEE_ITEM_KIND_BENEFICIARY_FEE FeeItemKind = 1
+	// Reward owed to the user agent participant.
+	FeeItemKind_FEE_ITEM_KIND_USER_AGENT_REWARD FeeItemKind = 2
+	// Reward owed to the wallet user agent participant.
+	FeeItemKind_FEE_ITEM_KIND_WALLET_USER_AGENT_REWARD FeeItemKind = 3
+	// Validation fees owed to the validator participant.
+	FeeItemKind_FEE_ITEM_KIND_VALIDATION_FEE FeeItemKind = 4
+	// Trust deposit required from the applicant when starting validation.
+	FeeItemKind_FEE_ITEM_KIND_VALIDATION_DEPOSIT FeeItemKind = 5
+)
+
+// Enum value maps for FeeItemKind.
+var (
+	FeeItemKind_name = map[int32]string{
+		0: "FEE_ITEM_KIND_UNSPECIFIED",
+		1: "FEE_ITEM_KIND_BENEFICIARY_FEE",
+		2: "FEE_ITEM_KIND_USER_AGENT_REWARD",
+		3: "FEE_ITEM_KIND_WALLET_USER_AGENT_REWARD",
+		4: "FEE_ITEM_KIND_VALIDATION_FEE",
+		5: "FEE_ITEM_KIND_VALIDATION_DEPOSIT",
+	}
+	FeeItemKind_value = map[string]int32{
+		"FEE_ITEM_KIND_UNSPECIFIED":              0,
+		"FEE_ITEM_KIND_BENEFICIARY_FEE":          1,
+		"FEE_ITEM_KIND_USER_AGENT_REWARD":        2,
+		"FEE_ITEM_KIND_WALLET_USER_AGENT_REWARD": 3,
+		"FEE_ITEM_KIND_VALIDATION_FEE":           4,
+		"FEE_ITEM_KIND_VALIDATION_DEPOSIT":       5,
+	}
+)
+
+func (x FeeItemKind) Enum() *FeeItemKind {
+	p := new(FeeItemKind)
+	*p = x
+	return p
+}
+
+func (x FeeItemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeItemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_pp_v1_query_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeItemKind) Type() protoreflect.EnumType {
+	return &file_verana_pp_v1_query_proto_enumTypes[0]
+}
+
+func (x FeeItemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeItemKind.Descriptor instead.
+func (FeeItemKind) EnumDescriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// FeeDestination identifies where the amount of a fee estimate item is sent.
+type FeeDestination int32
+
+const (
+	FeeDestination_FEE_DESTINATION_UNSPECIFIED FeeDestination = 0
+	// Transferred to the recipient corporation account.
+	FeeDestination_FEE_DESTINATION_ACCOUNT FeeDestination = 1
+	// Added to the recipient corporation trust deposit, funded by the payer.
+	FeeDestination_FEE_DESTINATION_TRUST_DEPOSIT FeeDestination = 2
+	// Added to the payer corporation own trust deposit.
+	FeeDestination_FEE_DESTINATION_PAYER_TRUST_DEPOSIT FeeDestination = 3
+	// Held in the module escrow account until the validation completes.
+	FeeDestination_FEE_DESTINATION_ESCROW FeeDestination = 4
+)
+
+// Enum value maps for FeeDestination.
+var (
+	FeeDestination_name = map[int32]string{
+		0: "FEE_DESTINATION_UNSPECIFIED",
+		1: "FEE_DESTINATION_ACCOUNT",
+		2: "FEE_DESTINATION_TRUST_DEPOSIT",
+		3: "FEE_DESTINATION_PAYER_TRUST_DEPOSIT",
+		4: "FEE_DESTINATION_ESCROW",
+	}
+	FeeDestination_value = map[string]int32{
+		"FEE_DESTINATION_UNSPECIFIED":         0,
+		"FEE_DESTINATION_ACCOUNT":             1,
+		"FEE_DESTINATION_TRUST_DEPOSIT":       2,
+		"FEE_DESTINATION_PAYER_TRUST_DEPOSIT": 3,
+		"FEE_DESTINATION_ESCROW":              4,
+	}
+)
+
+func (x FeeDestination) Enum() *FeeDestination {
+	p := new(FeeDestination)
+	*p = x
+	return p
+}
+
+func (x FeeDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_pp_v1_query_proto_enumTypes[1].Descriptor()
+}
+
+func (FeeDestination) Type() protoreflect.EnumType {
+	return &file_verana_pp_v1_query_proto_enumTypes[1]
+}
+
+func (x FeeDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeDestination.Descriptor instead.
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[0]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type QueryListParticipantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModifiedAfter   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=modified_after,json=modifiedAfter,proto3" json:"modified_after,omitempty"`
+	ResponseMaxSize uint32                 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"` // Default 64, min 1, max 1024
+	// suspended only returns the participants currently suspended
+	Suspended bool `protobuf:"varint,3,opt,name=suspended,proto3" json:"suspended,omitempty"`
+}
+
+func (x *QueryListParticipantsRequest) Reset() {
+	*x = QueryListParticipantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryListParticipantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListParticipantsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryListParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*QueryListParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryListParticipantsRequest) GetModifiedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedAfter
+	}
+	return nil
+}
+
+func (x *QueryListParticipantsRequest) GetResponseMaxSize() uint32 {
+	if x != nil {
+		return x.ResponseMaxSize
+	}
+	return 0
+}
+
+func (x *QueryListParticipantsRequest) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+type QueryListParticipantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (x *QueryListParticipantsResponse) Reset() {
+	*x = QueryListParticipantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryListParticipantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListParticipantsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryListParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*QueryListParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryListParticipantsResponse) GetParticipants() []*Participant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type QueryGetParticipantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryGetParticipantRequest) Reset() {
+	*x = QueryGetParticipantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetParticipantRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetParticipantRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryGetParticipantRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type QueryGetParticipantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Participant *Participant `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (x *QueryGetParticipantResponse) Reset() {
+	*x = QueryGetParticipantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetParticipantResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetParticipantResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryGetParticipantResponse) GetParticipant() *Participant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type QueryGetParticipantSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID
+}
+
+func (x *QueryGetParticipantSessionRequest) Reset() {
+	*x = QueryGetParticipantSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetParticipantSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetParticipantSessionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetParticipantSessionRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetParticipantSessionRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryGetParticipantSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type QueryGetParticipantSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *ParticipantSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *QueryGetParticipantSessionResponse) Reset() {
+	*x = QueryGetParticipantSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetParticipantSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetParticipantSessionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetParticipantSessionResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetParticipantSessionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryGetParticipantSessionResponse) GetSession() *ParticipantSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type QueryListParticipantSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModifiedAfter   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=modified_after,json=modifiedAfter,proto3" json:"modified_after,omitempty"`
+	ResponseMaxSize uint32                 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+	// optional filters, served from the session indexes when set
+	CorporationId uint64 `protobuf:"varint,3,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	VsOperator    string `protobuf:"bytes,4,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
+	ParticipantId uint64 `protobuf:"varint,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+}
+
+func (x *QueryListParticipantSessionsRequest) Reset() {
+	*x = QueryListParticipantSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryListParticipantSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListParticipantSessionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryListParticipantSessionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryListParticipantSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryListParticipantSessionsRequest) GetModifiedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedAfter
+	}
+	return nil
+}
+
+func (x *QueryListParticipantSessionsRequest) GetResponseMaxSize() uint32 {
+	if x != nil {
+		return x.ResponseMaxSize
+	}
+	return 0
+}
+
+func (x *QueryListParticipantSessionsRequest) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *QueryListParticipantSessionsRequest) GetVsOperator() string {
+	if x != nil {
+		return x.VsOperator
+	}
+	return ""
+}
+
+func (x *QueryListParticipantSessionsRequest) GetParticipantId() uint64 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+type QueryListParticipantSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*ParticipantSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *QueryListParticipantSessionsResponse) Reset() {
+	*x = QueryListParticipantSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryListParticipantSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListParticipantSessionsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryListParticipantSessionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryListParticipantSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryListParticipantSessionsResponse) GetSessions() []*ParticipantSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type QueryListRevokedParticipantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaId    uint64 `protobuf:"varint,1,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`          // optional
+	EcosystemId uint64 `protobuf:"varint,2,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"` // optional
+	// revoked_after is the exclusive lower bound of the revocation time.
+	// Together with after_id it forms the cursor of an incremental sync:
+	// participants revoked exactly at revoked_after are returned only if
+	// their id is greater than after_id. When after_id is 0 none of them are
+	// returned.
+	RevokedAfter *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=revoked_after,json=revokedAfter,proto3" json:"revoked_after,omitempty"`
+	AfterId      uint64                 `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// revoked_before is the exclusive upper bound of the revocation time.
+	RevokedBefore   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revoked_before,json=revokedBefore,proto3" json:"revoked_before,omitempty"`
+	ResponseMaxSize uint32                 `protobuf:"varint,6,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"` // Default 64, min 1, max 1024
+}
+
+func (x *QueryListRevokedParticipantsRequest) Reset() {
+	*x = QueryListRevokedParticipantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryListRevokedParticipantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListRevokedParticipantsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryListRevokedParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*QueryListRevokedParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryListRevokedParticipantsRequest) GetSchemaId() uint64 {
+	if x != nil {
+		return x.SchemaId
+	}
+	return 0
+}
+
+func (x *QueryListRevokedParticipantsRequest) GetEcosystemId() uint64 {
+	if x != nil {
+		return x.EcosystemId
+	}
+	return 0
+}
+
+func (x *QueryListRevokedParticipantsRequest) GetRevokedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAfter
+	}
+	return nil
+}
+
+func (x *QueryListRevokedParticipantsRequest) GetAfterId() uint64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *QueryListRevokedParticipantsRequest) GetRevokedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedBefore
+	}
+	return nil
+}
+
+func (x *QueryListRevokedParticipantsRequest) GetResponseMaxSize() uint32 {
+	if x != nil {
+		return x.ResponseMaxSize
+	}
+	return 0
+}
+
+type QueryListRevokedParticipantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (x *QueryListRevokedParticipantsResponse) Reset() {
+	*x = QueryListRevokedParticipantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryListRevokedParticipantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryListRevokedParticipantsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryListRevokedParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*QueryListRevokedParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryListRevokedParticipantsResponse) GetParticipants() []*Participant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type QueryFindParticipantsWithDIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Did      string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Role     uint32                 `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	SchemaId uint64                 `protobuf:"varint,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	When     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=when,proto3" json:"when,omitempty"`
+	// include_did_history also matches participants that had did before a
+	// rotation; with when, only if did was their did at that time.
+	IncludeDidHistory bool `protobuf:"varint,6,opt,name=include_did_history,json=includeDidHistory,proto3" json:"include_did_history,omitempty"`
+}
+
+func (x *QueryFindParticipantsWithDIDRequest) Reset() {
+	*x = QueryFindParticipantsWithDIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryFindParticipantsWithDIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFindParticipantsWithDIDRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryFindParticipantsWithDIDRequest.ProtoReflect.Descriptor instead.
+func (*QueryFindParticipantsWithDIDRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryFindParticipantsWithDIDRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *QueryFindParticipantsWithDIDRequest) GetRole() uint32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (x *QueryFindParticipantsWithDIDRequest) GetSchemaId() uint64 {
+	if x != nil {
+		return x.SchemaId
+	}
+	return 0
+}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{0}
+func (x *QueryFindParticipantsWithDIDRequest) GetWhen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.When
+	}
+	return nil
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryFindParticipantsWithDIDRequest) GetIncludeDidHistory() bool {
+	if x != nil {
+		return x.IncludeDidHistory
+	}
+	return false
+}
+
+type QueryFindParticipantsWithDIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryFindParticipantsWithDIDResponse) Reset() {
+	*x = QueryFindParticipantsWithDIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[1]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryFindParticipantsWithDIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryFindParticipantsWithDIDResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryFindParticipantsWithDIDResponse.ProtoReflect.Descriptor instead.
+func (*QueryFindParticipantsWithDIDResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryFindParticipantsWithDIDResponse) GetParticipants() []*Participant {
 	if x != nil {
-		return x.Params
+		return x.Participants
 	}
 	return nil
 }
 
-type QueryListParticipantsRequest struct {
+type QueryFindBeneficiariesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModifiedAfter   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=modified_after,json=modifiedAfter,proto3" json:"modified_after,omitempty"`
-	ResponseMaxSize uint32                 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"` // Default 64, min 1, max 1024
+	IssuerParticipantId   uint64 `protobuf:"varint,1,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`
+	VerifierParticipantId uint64 `protobuf:"varint,2,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`
 }
 
-func (x *QueryListParticipantsRequest) Reset() {
-	*x = QueryListParticipantsRequest{}
+func (x *QueryFindBeneficiariesRequest) Reset() {
+	*x = QueryFindBeneficiariesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[2]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryListParticipantsRequest) String() string {
+func (x *QueryFindBeneficiariesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryListParticipantsRequest) ProtoMessage() {}
+func (*QueryFindBeneficiariesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryListParticipantsRequest.ProtoReflect.Descriptor instead.
-func (*QueryListParticipantsRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryFindBeneficiariesRequest.ProtoReflect.Descriptor instead.
+func (*QueryFindBeneficiariesRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryListParticipantsRequest) GetModifiedAfter() *timestamppb.Timestamp {
+func (x *QueryFindBeneficiariesRequest) GetIssuerParticipantId() uint64 {
 	if x != nil {
-		return x.ModifiedAfter
+		return x.IssuerParticipantId
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryListParticipantsRequest) GetResponseMaxSize() uint32 {
+func (x *QueryFindBeneficiariesRequest) GetVerifierParticipantId() uint64 {
 	if x != nil {
-		return x.ResponseMaxSize
+		return x.VerifierParticipantId
 	}
 	return 0
 }
 
-type QueryListParticipantsResponse struct {
+type QueryFindBeneficiariesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -6619,423 +13827,570 @@ type QueryListParticipantsResponse struct {
 	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
 }
 
-func (x *QueryListParticipantsResponse) Reset() {
-	*x = QueryListParticipantsResponse{}
+func (x *QueryFindBeneficiariesResponse) Reset() {
+	*x = QueryFindBeneficiariesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[3]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryListParticipantsResponse) String() string {
+func (x *QueryFindBeneficiariesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryListParticipantsResponse) ProtoMessage() {}
+func (*QueryFindBeneficiariesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryListParticipantsResponse.ProtoReflect.Descriptor instead.
-func (*QueryListParticipantsResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryFindBeneficiariesResponse.ProtoReflect.Descriptor instead.
+func (*QueryFindBeneficiariesResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryListParticipantsResponse) GetParticipants() []*Participant {
+func (x *QueryFindBeneficiariesResponse) GetParticipants() []*Participant {
 	if x != nil {
 		return x.Participants
 	}
 	return nil
 }
 
-type QueryGetParticipantRequest struct {
+// FeeEstimateItem is a single amount the payer is charged, in the bond denom.
+type FeeEstimateItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind        FeeItemKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=verana.pp.v1.FeeItemKind" json:"kind,omitempty"`
+	Destination FeeDestination `protobuf:"varint,2,opt,name=destination,proto3,enum=verana.pp.v1.FeeDestination" json:"destination,omitempty"`
+	// participant credited by this item, 0 when unknown (agent not provided)
+	ParticipantId uint64 `protobuf:"varint,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	CorporationId uint64 `protobuf:"varint,4,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// corporation account credited by this item, empty for escrow or when unknown
+	Account string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Amount  uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *QueryGetParticipantRequest) Reset() {
-	*x = QueryGetParticipantRequest{}
+func (x *FeeEstimateItem) Reset() {
+	*x = FeeEstimateItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[4]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetParticipantRequest) String() string {
+func (x *FeeEstimateItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetParticipantRequest) ProtoMessage() {}
+func (*FeeEstimateItem) ProtoMessage() {}
 
-// Deprecated: Use QueryGetParticipantRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetParticipantRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use FeeEstimateItem.ProtoReflect.Descriptor instead.
+func (*FeeEstimateItem) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryGetParticipantRequest) GetId() uint64 {
+func (x *FeeEstimateItem) GetKind() FeeItemKind {
 	if x != nil {
-		return x.Id
+		return x.Kind
+	}
+	return FeeItemKind_FEE_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *FeeEstimateItem) GetDestination() FeeDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return FeeDestination_FEE_DESTINATION_UNSPECIFIED
+}
+
+func (x *FeeEstimateItem) GetParticipantId() uint64 {
+	if x != nil {
+		return x.ParticipantId
 	}
 	return 0
 }
 
-type QueryGetParticipantResponse struct {
+func (x *FeeEstimateItem) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *FeeEstimateItem) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *FeeEstimateItem) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// BeneficiaryFeeEstimate is the fee owed to one beneficiary participant.
+type BeneficiaryFeeEstimate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Participant *Participant `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	ParticipantId uint64          `protobuf:"varint,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Role          ParticipantRole `protobuf:"varint,2,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
+	CorporationId uint64          `protobuf:"varint,3,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// fees in trust units, after the executor discount
+	Fees uint64 `protobuf:"varint,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	// fees converted to the bond denom with the trust unit price
+	FeesInDenom uint64 `protobuf:"varint,5,opt,name=fees_in_denom,json=feesInDenom,proto3" json:"fees_in_denom,omitempty"`
 }
 
-func (x *QueryGetParticipantResponse) Reset() {
-	*x = QueryGetParticipantResponse{}
+func (x *BeneficiaryFeeEstimate) Reset() {
+	*x = BeneficiaryFeeEstimate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[5]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetParticipantResponse) String() string {
+func (x *BeneficiaryFeeEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetParticipantResponse) ProtoMessage() {}
+func (*BeneficiaryFeeEstimate) ProtoMessage() {}
 
-// Deprecated: Use QueryGetParticipantResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BeneficiaryFeeEstimate.ProtoReflect.Descriptor instead.
+func (*BeneficiaryFeeEstimate) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryGetParticipantResponse) GetParticipant() *Participant {
+func (x *BeneficiaryFeeEstimate) GetParticipantId() uint64 {
 	if x != nil {
-		return x.Participant
+		return x.ParticipantId
 	}
-	return nil
+	return 0
 }
 
-type QueryGetParticipantSessionRequest struct {
+func (x *BeneficiaryFeeEstimate) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_UNSPECIFIED
+}
+
+func (x *BeneficiaryFeeEstimate) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *BeneficiaryFeeEstimate) GetFees() uint64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *BeneficiaryFeeEstimate) GetFeesInDenom() uint64 {
+	if x != nil {
+		return x.FeesInDenom
+	}
+	return 0
+}
+
+type QueryEstimateSessionFeesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID
+	IssuerParticipantId   uint64 `protobuf:"varint,1,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`
+	VerifierParticipantId uint64 `protobuf:"varint,2,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`
+	// optional, when set the agent is validated and credited in the items
+	AgentParticipantId uint64 `protobuf:"varint,3,opt,name=agent_participant_id,json=agentParticipantId,proto3" json:"agent_participant_id,omitempty"`
+	// optional, when set the wallet agent is validated and credited in the items
+	WalletAgentParticipantId uint64 `protobuf:"varint,4,opt,name=wallet_agent_participant_id,json=walletAgentParticipantId,proto3" json:"wallet_agent_participant_id,omitempty"`
 }
 
-func (x *QueryGetParticipantSessionRequest) Reset() {
-	*x = QueryGetParticipantSessionRequest{}
+func (x *QueryEstimateSessionFeesRequest) Reset() {
+	*x = QueryEstimateSessionFeesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[6]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetParticipantSessionRequest) String() string {
+func (x *QueryEstimateSessionFeesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetParticipantSessionRequest) ProtoMessage() {}
+func (*QueryEstimateSessionFeesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetParticipantSessionRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetParticipantSessionRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryEstimateSessionFeesRequest.ProtoReflect.Descriptor instead.
+func (*QueryEstimateSessionFeesRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryGetParticipantSessionRequest) GetId() string {
+func (x *QueryEstimateSessionFeesRequest) GetIssuerParticipantId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.IssuerParticipantId
 	}
-	return ""
+	return 0
 }
 
-type QueryGetParticipantSessionResponse struct {
+func (x *QueryEstimateSessionFeesRequest) GetVerifierParticipantId() uint64 {
+	if x != nil {
+		return x.VerifierParticipantId
+	}
+	return 0
+}
+
+func (x *QueryEstimateSessionFeesRequest) GetAgentParticipantId() uint64 {
+	if x != nil {
+		return x.AgentParticipantId
+	}
+	return 0
+}
+
+func (x *QueryEstimateSessionFeesRequest) GetWalletAgentParticipantId() uint64 {
+	if x != nil {
+		return x.WalletAgentParticipantId
+	}
+	return 0
+}
+
+type QueryEstimateSessionFeesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Session *ParticipantSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
-}
-
-func (x *QueryGetParticipantSessionResponse) Reset() {
-	*x = QueryGetParticipantSessionResponse{}
+	Beneficiaries []*BeneficiaryFeeEstimate `protobuf:"bytes,1,rep,name=beneficiaries,proto3" json:"beneficiaries,omitempty"`
+	Items         []*FeeEstimateItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// sum of the beneficiary fees in trust units, after the executor discount
+	BeneficiaryFees uint64 `protobuf:"varint,3,opt,name=beneficiary_fees,json=beneficiaryFees,proto3" json:"beneficiary_fees,omitempty"`
+	// executor discount applied, scaled 0..10000
+	Discount       uint64 `protobuf:"varint,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	TrustUnitPrice uint64 `protobuf:"varint,5,opt,name=trust_unit_price,json=trustUnitPrice,proto3" json:"trust_unit_price,omitempty"`
+	Denom          string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// payer corporation account (the issuer, or the verifier for verifications)
+	Payer string `protobuf:"bytes,7,opt,name=payer,proto3" json:"payer,omitempty"`
+	// balance the payer must hold for the session to be accepted
+	RequiredPayerBalance uint64 `protobuf:"varint,8,opt,name=required_payer_balance,json=requiredPayerBalance,proto3" json:"required_payer_balance,omitempty"`
+	PayerBalance         uint64 `protobuf:"varint,9,opt,name=payer_balance,json=payerBalance,proto3" json:"payer_balance,omitempty"`
+	SufficientFunds      bool   `protobuf:"varint,10,opt,name=sufficient_funds,json=sufficientFunds,proto3" json:"sufficient_funds,omitempty"`
+}
+
+func (x *QueryEstimateSessionFeesResponse) Reset() {
+	*x = QueryEstimateSessionFeesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[7]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetParticipantSessionResponse) String() string {
+func (x *QueryEstimateSessionFeesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetParticipantSessionResponse) ProtoMessage() {}
+func (*QueryEstimateSessionFeesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetParticipantSessionResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetParticipantSessionResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryEstimateSessionFeesResponse.ProtoReflect.Descriptor instead.
+func (*QueryEstimateSessionFeesResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryGetParticipantSessionResponse) GetSession() *ParticipantSession {
+func (x *QueryEstimateSessionFeesResponse) GetBeneficiaries() []*BeneficiaryFeeEstimate {
 	if x != nil {
-		return x.Session
+		return x.Beneficiaries
 	}
 	return nil
 }
 
-type QueryListParticipantSessionsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QueryEstimateSessionFeesResponse) GetItems() []*FeeEstimateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
 
-	ModifiedAfter   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=modified_after,json=modifiedAfter,proto3" json:"modified_after,omitempty"`
-	ResponseMaxSize uint32                 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+func (x *QueryEstimateSessionFeesResponse) GetBeneficiaryFees() uint64 {
+	if x != nil {
+		return x.BeneficiaryFees
+	}
+	return 0
 }
 
-func (x *QueryListParticipantSessionsRequest) Reset() {
-	*x = QueryListParticipantSessionsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryEstimateSessionFeesResponse) GetDiscount() uint64 {
+	if x != nil {
+		return x.Discount
 	}
+	return 0
 }
 
-func (x *QueryListParticipantSessionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryEstimateSessionFeesResponse) GetTrustUnitPrice() uint64 {
+	if x != nil {
+		return x.TrustUnitPrice
+	}
+	return 0
 }
 
-func (*QueryListParticipantSessionsRequest) ProtoMessage() {}
+func (x *QueryEstimateSessionFeesResponse) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
 
-// Deprecated: Use QueryListParticipantSessionsRequest.ProtoReflect.Descriptor instead.
-func (*QueryListParticipantSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{8}
+func (x *QueryEstimateSessionFeesResponse) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
 }
 
-func (x *QueryListParticipantSessionsRequest) GetModifiedAfter() *timestamppb.Timestamp {
+func (x *QueryEstimateSessionFeesResponse) GetRequiredPayerBalance() uint64 {
 	if x != nil {
-		return x.ModifiedAfter
+		return x.RequiredPayerBalance
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryListParticipantSessionsRequest) GetResponseMaxSize() uint32 {
+func (x *QueryEstimateSessionFeesResponse) GetPayerBalance() uint64 {
 	if x != nil {
-		return x.ResponseMaxSize
+		return x.PayerBalance
 	}
 	return 0
 }
 
-type QueryListParticipantSessionsResponse struct {
+func (x *QueryEstimateSessionFeesResponse) GetSufficientFunds() bool {
+	if x != nil {
+		return x.SufficientFunds
+	}
+	return false
+}
+
+type QueryEstimateOnboardingFeesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sessions []*ParticipantSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	ValidatorParticipantId uint64 `protobuf:"varint,1,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
+	// role requested by the applicant, checked against the validator role
+	Role ParticipantRole `protobuf:"varint,2,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
+	// optional applicant corporation account, used to report its balance
+	Corporation string `protobuf:"bytes,3,opt,name=corporation,proto3" json:"corporation,omitempty"`
 }
 
-func (x *QueryListParticipantSessionsResponse) Reset() {
-	*x = QueryListParticipantSessionsResponse{}
+func (x *QueryEstimateOnboardingFeesRequest) Reset() {
+	*x = QueryEstimateOnboardingFeesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[9]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryListParticipantSessionsResponse) String() string {
+func (x *QueryEstimateOnboardingFeesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryListParticipantSessionsResponse) ProtoMessage() {}
+func (*QueryEstimateOnboardingFeesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryListParticipantSessionsResponse.ProtoReflect.Descriptor instead.
-func (*QueryListParticipantSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryEstimateOnboardingFeesRequest.ProtoReflect.Descriptor instead.
+func (*QueryEstimateOnboardingFeesRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryListParticipantSessionsResponse) GetSessions() []*ParticipantSession {
+func (x *QueryEstimateOnboardingFeesRequest) GetValidatorParticipantId() uint64 {
 	if x != nil {
-		return x.Sessions
+		return x.ValidatorParticipantId
 	}
-	return nil
+	return 0
 }
 
-type QueryFindParticipantsWithDIDRequest struct {
+func (x *QueryEstimateOnboardingFeesRequest) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_UNSPECIFIED
+}
+
+func (x *QueryEstimateOnboardingFeesRequest) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+type QueryEstimateOnboardingFeesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Did      string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	Role     uint32                 `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
-	SchemaId uint64                 `protobuf:"varint,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
-	When     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=when,proto3" json:"when,omitempty"`
+	Items []*FeeEstimateItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// validator participant validation fees in trust units
+	ValidationFees uint64 `protobuf:"varint,2,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	TrustUnitPrice uint64 `protobuf:"varint,3,opt,name=trust_unit_price,json=trustUnitPrice,proto3" json:"trust_unit_price,omitempty"`
+	Denom          string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// balance the applicant must hold for the request to be accepted
+	RequiredPayerBalance uint64 `protobuf:"varint,5,opt,name=required_payer_balance,json=requiredPayerBalance,proto3" json:"required_payer_balance,omitempty"`
+	PayerBalance         uint64 `protobuf:"varint,6,opt,name=payer_balance,json=payerBalance,proto3" json:"payer_balance,omitempty"`
+	SufficientFunds      bool   `protobuf:"varint,7,opt,name=sufficient_funds,json=sufficientFunds,proto3" json:"sufficient_funds,omitempty"`
 }
 
-func (x *QueryFindParticipantsWithDIDRequest) Reset() {
-	*x = QueryFindParticipantsWithDIDRequest{}
+func (x *QueryEstimateOnboardingFeesResponse) Reset() {
+	*x = QueryEstimateOnboardingFeesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[10]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFindParticipantsWithDIDRequest) String() string {
+func (x *QueryEstimateOnboardingFeesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFindParticipantsWithDIDRequest) ProtoMessage() {}
+func (*QueryEstimateOnboardingFeesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryFindParticipantsWithDIDRequest.ProtoReflect.Descriptor instead.
-func (*QueryFindParticipantsWithDIDRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryEstimateOnboardingFeesResponse.ProtoReflect.Descriptor instead.
+func (*QueryEstimateOnboardingFeesResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryFindParticipantsWithDIDRequest) GetDid() string {
+func (x *QueryEstimateOnboardingFeesResponse) GetItems() []*FeeEstimateItem {
 	if x != nil {
-		return x.Did
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryFindParticipantsWithDIDRequest) GetRole() uint32 {
+func (x *QueryEstimateOnboardingFeesResponse) GetValidationFees() uint64 {
 	if x != nil {
-		return x.Role
+		return x.ValidationFees
 	}
 	return 0
 }
 
-func (x *QueryFindParticipantsWithDIDRequest) GetSchemaId() uint64 {
+func (x *QueryEstimateOnboardingFeesResponse) GetTrustUnitPrice() uint64 {
 	if x != nil {
-		return x.SchemaId
+		return x.TrustUnitPrice
 	}
 	return 0
 }
 
-func (x *QueryFindParticipantsWithDIDRequest) GetWhen() *timestamppb.Timestamp {
+func (x *QueryEstimateOnboardingFeesResponse) GetDenom() string {
 	if x != nil {
-		return x.When
+		return x.Denom
 	}
-	return nil
-}
-
-type QueryFindParticipantsWithDIDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	return ""
 }
 
-func (x *QueryFindParticipantsWithDIDResponse) Reset() {
-	*x = QueryFindParticipantsWithDIDResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryEstimateOnboardingFeesResponse) GetRequiredPayerBalance() uint64 {
+	if x != nil {
+		return x.RequiredPayerBalance
 	}
+	return 0
 }
 
-func (x *QueryFindParticipantsWithDIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryFindParticipantsWithDIDResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryFindParticipantsWithDIDResponse.ProtoReflect.Descriptor instead.
-func (*QueryFindParticipantsWithDIDResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{11}
+func (x *QueryEstimateOnboardingFeesResponse) GetPayerBalance() uint64 {
+	if x != nil {
+		return x.PayerBalance
+	}
+	return 0
 }
 
-func (x *QueryFindParticipantsWithDIDResponse) GetParticipants() []*Participant {
+func (x *QueryEstimateOnboardingFeesResponse) GetSufficientFunds() bool {
 	if x != nil {
-		return x.Participants
+		return x.SufficientFunds
 	}
-	return nil
+	return false
 }
 
-type QueryFindBeneficiariesRequest struct {
+type QueryGetParticipantCascadeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IssuerParticipantId   uint64 `protobuf:"varint,1,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`
-	VerifierParticipantId uint64 `protobuf:"varint,2,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *QueryFindBeneficiariesRequest) Reset() {
-	*x = QueryFindBeneficiariesRequest{}
+func (x *QueryGetParticipantCascadeRequest) Reset() {
+	*x = QueryGetParticipantCascadeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[12]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFindBeneficiariesRequest) String() string {
+func (x *QueryGetParticipantCascadeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFindBeneficiariesRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryFindBeneficiariesRequest.ProtoReflect.Descriptor instead.
-func (*QueryFindBeneficiariesRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{12}
-}
+func (*QueryGetParticipantCascadeRequest) ProtoMessage() {}
 
-func (x *QueryFindBeneficiariesRequest) GetIssuerParticipantId() uint64 {
-	if x != nil {
-		return x.IssuerParticipantId
-	}
-	return 0
+// Deprecated: Use QueryGetParticipantCascadeRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetParticipantCascadeRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryFindBeneficiariesRequest) GetVerifierParticipantId() uint64 {
+func (x *QueryGetParticipantCascadeRequest) GetId() uint64 {
 	if x != nil {
-		return x.VerifierParticipantId
+		return x.Id
 	}
 	return 0
 }
 
-type QueryFindBeneficiariesResponse struct {
+type QueryGetParticipantCascadeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	Cascade *ParticipantCascade `protobuf:"bytes,1,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	// pending_count is the number of descendants still to be visited
+	PendingCount uint64 `protobuf:"varint,2,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
 }
 
-func (x *QueryFindBeneficiariesResponse) Reset() {
-	*x = QueryFindBeneficiariesResponse{}
+func (x *QueryGetParticipantCascadeResponse) Reset() {
+	*x = QueryGetParticipantCascadeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_query_proto_msgTypes[13]
+		mi := &file_verana_pp_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFindBeneficiariesResponse) String() string {
+func (x *QueryGetParticipantCascadeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFindBeneficiariesResponse) ProtoMessage() {}
+func (*QueryGetParticipantCascadeResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryFindBeneficiariesResponse.ProtoReflect.Descriptor instead.
-func (*QueryFindBeneficiariesResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryGetParticipantCascadeResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetParticipantCascadeResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryFindBeneficiariesResponse) GetParticipants() []*Participant {
+func (x *QueryGetParticipantCascadeResponse) GetCascade() *ParticipantCascade {
 	if x != nil {
-		return x.Participants
+		return x.Cascade
 	}
 	return nil
 }
 
+func (x *QueryGetParticipantCascadeResponse) GetPendingCount() uint64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
 var File_verana_pp_v1_query_proto protoreflect.FileDescriptor
 
 var file_verana_pp_v1_query_proto_rawDesc = []byte{
@@ -7045,183 +14400,414 @@ var file_verana_pp_v1_query_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f,
-	0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f,
+	0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x1d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x22, 0x2c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60,
+	0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x22, 0x33, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x02, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
-	0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x64, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4b, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x0b, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a,
+	0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x6a, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc4, 0x02,
+	0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x61, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0e, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x61, 0x78,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x6b, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x22, 0xe1, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x64, 0x69, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x64, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x6b, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69,
+	0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64,
+	0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x65, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
 	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
 	0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
 	0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x22,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2d, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x16, 0x42,
+	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x65,
+	0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xfe,
+	0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x3d, 0x0a, 0x1b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0xd2, 0x03, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x6e, 0x65, 0x66,
+	0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x34,
+	0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x50, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x79,
+	0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x46,
+	0x75, 0x6e, 0x64, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcf, 0x02, 0x0a, 0x23, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x75, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x21,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9e,
-	0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01,
-	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x66,
-	0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
-	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0x6a, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x8b, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63,
+	0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61,
 	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x23,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e,
-	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22,
-	0x6b, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x49, 0x44, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a,
-	0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69,
-	0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
-	0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x73, 0x32, 0x90, 0x08, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6b, 0x0a, 0x06, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a,
+	0xe8, 0x01, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x1d, 0x0a, 0x19, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x42, 0x45, 0x4e, 0x45, 0x46, 0x49, 0x43, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x46, 0x45, 0x45, 0x10,
+	0x01, 0x12, 0x23, 0x0a, 0x1f, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x57, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44,
+	0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46,
+	0x45, 0x45, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x05, 0x2a, 0xb6, 0x01, 0x0a, 0x0e, 0x46,
+	0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x1b, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x46,
+	0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x52, 0x55, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x02, 0x12, 0x27,
+	0x0a, 0x23, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x55, 0x53, 0x54, 0x5f, 0x44, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x45, 0x45, 0x5f, 0x44,
+	0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x53, 0x43, 0x52, 0x4f,
+	0x57, 0x10, 0x04, 0x32, 0xc2, 0x0d, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6b, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
 	0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x2a, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa2, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0xa4, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e,
 	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
-	0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76,
-	0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa2, 0x01, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
-	0x1e, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67,
-	0x65, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
-	0xa4, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
-	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44,
-	0x49, 0x44, 0x12, 0x31, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x49, 0x44, 0x52, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e,
+	0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x44, 0x49, 0x44, 0x12, 0x31, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f,
+	0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x64,
+	0x12, 0x93, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x42,
+	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e, 0x65,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x31, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x44, 0x49,
-	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x69, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x64, 0x12, 0x93,
-	0x01, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e,
-	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69,
-	0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x42, 0xa5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56,
-	0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0xa1, 0x01, 0x0a, 0x13, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x2d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x12, 0xc8, 0x01, 0x0a,
+	0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x12, 0x2f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x63,
+	0x61, 0x64, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0xa5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x50, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50,
+	0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7236,57 +14822,91 @@ func file_verana_pp_v1_query_proto_rawDescGZIP() []byte {
 	return file_verana_pp_v1_query_proto_rawDescData
 }
 
-var file_verana_pp_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_verana_pp_v1_query_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_verana_pp_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_verana_pp_v1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),                   // 0: verana.pp.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),                  // 1: verana.pp.v1.QueryParamsResponse
-	(*QueryListParticipantsRequest)(nil),         // 2: verana.pp.v1.QueryListParticipantsRequest
-	(*QueryListParticipantsResponse)(nil),        // 3: verana.pp.v1.QueryListParticipantsResponse
-	(*QueryGetParticipantRequest)(nil),           // 4: verana.pp.v1.QueryGetParticipantRequest
-	(*QueryGetParticipantResponse)(nil),          // 5: verana.pp.v1.QueryGetParticipantResponse
-	(*QueryGetParticipantSessionRequest)(nil),    // 6: verana.pp.v1.QueryGetParticipantSessionRequest
-	(*QueryGetParticipantSessionResponse)(nil),   // 7: verana.pp.v1.QueryGetParticipantSessionResponse
-	(*QueryListParticipantSessionsRequest)(nil),  // 8: verana.pp.v1.QueryListParticipantSessionsRequest
-	(*QueryListParticipantSessionsResponse)(nil), // 9: verana.pp.v1.QueryListParticipantSessionsResponse
-	(*QueryFindParticipantsWithDIDRequest)(nil),  // 10: verana.pp.v1.QueryFindParticipantsWithDIDRequest
-	(*QueryFindParticipantsWithDIDResponse)(nil), // 11: verana.pp.v1.QueryFindParticipantsWithDIDResponse
-	(*QueryFindBeneficiariesRequest)(nil),        // 12: verana.pp.v1.QueryFindBeneficiariesRequest
-	(*QueryFindBeneficiariesResponse)(nil),       // 13: verana.pp.v1.QueryFindBeneficiariesResponse
-	(*Params)(nil),                               // 14: verana.pp.v1.Params
-	(*timestamppb.Timestamp)(nil),                // 15: google.protobuf.Timestamp
-	(*Participant)(nil),                          // 16: verana.pp.v1.Participant
-	(*ParticipantSession)(nil),                   // 17: verana.pp.v1.ParticipantSession
+	(FeeItemKind)(0),                             // 0: verana.pp.v1.FeeItemKind
+	(FeeDestination)(0),                          // 1: verana.pp.v1.FeeDestination
+	(*QueryParamsRequest)(nil),                   // 2: verana.pp.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                  // 3: verana.pp.v1.QueryParamsResponse
+	(*QueryListParticipantsRequest)(nil),         // 4: verana.pp.v1.QueryListParticipantsRequest
+	(*QueryListParticipantsResponse)(nil),        // 5: verana.pp.v1.QueryListParticipantsResponse
+	(*QueryGetParticipantRequest)(nil),           // 6: verana.pp.v1.QueryGetParticipantRequest
+	(*QueryGetParticipantResponse)(nil),          // 7: verana.pp.v1.QueryGetParticipantResponse
+	(*QueryGetParticipantSessionRequest)(nil),    // 8: verana.pp.v1.QueryGetParticipantSessionRequest
+	(*QueryGetParticipantSessionResponse)(nil),   // 9: verana.pp.v1.QueryGetParticipantSessionResponse
+	(*QueryListParticipantSessionsRequest)(nil),  // 10: verana.pp.v1.QueryListParticipantSessionsRequest
+	(*QueryListParticipantSessionsResponse)(nil), // 11: verana.pp.v1.QueryListParticipantSessionsResponse
+	(*QueryListRevokedParticipantsRequest)(nil),  // 12: verana.pp.v1.QueryListRevokedParticipantsRequest
+	(*QueryListRevokedParticipantsResponse)(nil), // 13: verana.pp.v1.QueryListRevokedParticipantsResponse
+	(*QueryFindParticipantsWithDIDRequest)(nil),  // 14: verana.pp.v1.QueryFindParticipantsWithDIDRequest
+	(*QueryFindParticipantsWithDIDResponse)(nil), // 15: verana.pp.v1.QueryFindParticipantsWithDIDResponse
+	(*QueryFindBeneficiariesRequest)(nil),        // 16: verana.pp.v1.QueryFindBeneficiariesRequest
+	(*QueryFindBeneficiariesResponse)(nil),       // 17: verana.pp.v1.QueryFindBeneficiariesResponse
+	(*FeeEstimateItem)(nil),                      // 18: verana.pp.v1.FeeEstimateItem
+	(*BeneficiaryFeeEstimate)(nil),               // 19: verana.pp.v1.BeneficiaryFeeEstimate
+	(*QueryEstimateSessionFeesRequest)(nil),      // 20: verana.pp.v1.QueryEstimateSessionFeesRequest
+	(*QueryEstimateSessionFeesResponse)(nil),     // 21: verana.pp.v1.QueryEstimateSessionFeesResponse
+	(*QueryEstimateOnboardingFeesRequest)(nil),   // 22: verana.pp.v1.QueryEstimateOnboardingFeesRequest
+	(*QueryEstimateOnboardingFeesResponse)(nil),  // 23: verana.pp.v1.QueryEstimateOnboardingFeesResponse
+	(*QueryGetParticipantCascadeRequest)(nil),    // 24: verana.pp.v1.QueryGetParticipantCascadeRequest
+	(*QueryGetParticipantCascadeResponse)(nil),   // 25: verana.pp.v1.QueryGetParticipantCascadeResponse
+	(*Params)(nil),                               // 26: verana.pp.v1.Params
+	(*timestamppb.Timestamp)(nil),                // 27: google.protobuf.Timestamp
+	(*Participant)(nil),                          // 28: verana.pp.v1.Participant
+	(*ParticipantSession)(nil),                   // 29: verana.pp.v1.ParticipantSession
+	(ParticipantRole)(0),                         // 30: verana.pp.v1.ParticipantRole
+	(*ParticipantCascade)(nil),                   // 31: verana.pp.v1.ParticipantCascade
 }
 var file_verana_pp_v1_query_proto_depIdxs = []int32{
-	14, // 0: verana.pp.v1.QueryParamsResponse.params:type_name -> verana.pp.v1.Params
-	15, // 1: verana.pp.v1.QueryListParticipantsRequest.modified_after:type_name -> google.protobuf.Timestamp
-	16, // 2: verana.pp.v1.QueryListParticipantsResponse.participants:type_name -> verana.pp.v1.Participant
-	16, // 3: verana.pp.v1.QueryGetParticipantResponse.participant:type_name -> verana.pp.v1.Participant
-	17, // 4: verana.pp.v1.QueryGetParticipantSessionResponse.session:type_name -> verana.pp.v1.ParticipantSession
-	15, // 5: verana.pp.v1.QueryListParticipantSessionsRequest.modified_after:type_name -> google.protobuf.Timestamp
-	17, // 6: verana.pp.v1.QueryListParticipantSessionsResponse.sessions:type_name -> verana.pp.v1.ParticipantSession
-	15, // 7: verana.pp.v1.QueryFindParticipantsWithDIDRequest.when:type_name -> google.protobuf.Timestamp
-	16, // 8: verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants:type_name -> verana.pp.v1.Participant
-	16, // 9: verana.pp.v1.QueryFindBeneficiariesResponse.participants:type_name -> verana.pp.v1.Participant
-	0,  // 10: verana.pp.v1.Query.Params:input_type -> verana.pp.v1.QueryParamsRequest
-	2,  // 11: verana.pp.v1.Query.ListParticipants:input_type -> verana.pp.v1.QueryListParticipantsRequest
-	4,  // 12: verana.pp.v1.Query.GetParticipant:input_type -> verana.pp.v1.QueryGetParticipantRequest
-	6,  // 13: verana.pp.v1.Query.GetParticipantSession:input_type -> verana.pp.v1.QueryGetParticipantSessionRequest
-	8,  // 14: verana.pp.v1.Query.ListParticipantSessions:input_type -> verana.pp.v1.QueryListParticipantSessionsRequest
-	10, // 15: verana.pp.v1.Query.FindParticipantsWithDID:input_type -> verana.pp.v1.QueryFindParticipantsWithDIDRequest
-	12, // 16: verana.pp.v1.Query.FindBeneficiaries:input_type -> verana.pp.v1.QueryFindBeneficiariesRequest
-	1,  // 17: verana.pp.v1.Query.Params:output_type -> verana.pp.v1.QueryParamsResponse
-	3,  // 18: verana.pp.v1.Query.ListParticipants:output_type -> verana.pp.v1.QueryListParticipantsResponse
-	5,  // 19: verana.pp.v1.Query.GetParticipant:output_type -> verana.pp.v1.QueryGetParticipantResponse
-	7,  // 20: verana.pp.v1.Query.GetParticipantSession:output_type -> verana.pp.v1.QueryGetParticipantSessionResponse
-	9,  // 21: verana.pp.v1.Query.ListParticipantSessions:output_type -> verana.pp.v1.QueryListParticipantSessionsResponse
-	11, // 22: verana.pp.v1.Query.FindParticipantsWithDID:output_type -> verana.pp.v1.QueryFindParticipantsWithDIDResponse
-	13, // 23: verana.pp.v1.Query.FindBeneficiaries:output_type -> verana.pp.v1.QueryFindBeneficiariesResponse
-	17, // [17:24] is the sub-list for method output_type
-	10, // [10:17] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	26, // 0: verana.pp.v1.QueryParamsResponse.params:type_name -> verana.pp.v1.Params
+	27, // 1: verana.pp.v1.QueryListParticipantsRequest.modified_after:type_name -> google.protobuf.Timestamp
+	28, // 2: verana.pp.v1.QueryListParticipantsResponse.participants:type_name -> verana.pp.v1.Participant
+	28, // 3: verana.pp.v1.QueryGetParticipantResponse.participant:type_name -> verana.pp.v1.Participant
+	29, // 4: verana.pp.v1.QueryGetParticipantSessionResponse.session:type_name -> verana.pp.v1.ParticipantSession
+	27, // 5: verana.pp.v1.QueryListParticipantSessionsRequest.modified_after:type_name -> google.protobuf.Timestamp
+	29, // 6: verana.pp.v1.QueryListParticipantSessionsResponse.sessions:type_name -> verana.pp.v1.ParticipantSession
+	27, // 7: verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_after:type_name -> google.protobuf.Timestamp
+	27, // 8: verana.pp.v1.QueryListRevokedParticipantsRequest.revoked_before:type_name -> google.protobuf.Timestamp
+	28, // 9: verana.pp.v1.QueryListRevokedParticipantsResponse.participants:type_name -> verana.pp.v1.Participant
+	27, // 10: verana.pp.v1.QueryFindParticipantsWithDIDRequest.when:type_name -> google.protobuf.Timestamp
+	28, // 11: verana.pp.v1.QueryFindParticipantsWithDIDResponse.participants:type_name -> verana.pp.v1.Participant
+	28, // 12: verana.pp.v1.QueryFindBeneficiariesResponse.participants:type_name -> verana.pp.v1.Participant
+	0,  // 13: verana.pp.v1.FeeEstimateItem.kind:type_name -> verana.pp.v1.FeeItemKind
+	1,  // 14: verana.pp.v1.FeeEstimateItem.destination:type_name -> verana.pp.v1.FeeDestination
+	30, // 15: verana.pp.v1.BeneficiaryFeeEstimate.role:type_name -> verana.pp.v1.ParticipantRole
+	19, // 16: verana.pp.v1.QueryEstimateSessionFeesResponse.beneficiaries:type_name -> verana.pp.v1.BeneficiaryFeeEstimate
+	18, // 17: verana.pp.v1.QueryEstimateSessionFeesResponse.items:type_name -> verana.pp.v1.FeeEstimateItem
+	30, // 18: verana.pp.v1.QueryEstimateOnboardingFeesRequest.role:type_name -> verana.pp.v1.ParticipantRole
+	18, // 19: verana.pp.v1.QueryEstimateOnboardingFeesResponse.items:type_name -> verana.pp.v1.FeeEstimateItem
+	31, // 20: verana.pp.v1.QueryGetParticipantCascadeResponse.cascade:type_name -> verana.pp.v1.ParticipantCascade
+	2,  // 21: verana.pp.v1.Query.Params:input_type -> verana.pp.v1.QueryParamsRequest
+	4,  // 22: verana.pp.v1.Query.ListParticipants:input_type -> verana.pp.v1.QueryListParticipantsRequest
+	6,  // 23: verana.pp.v1.Query.GetParticipant:input_type -> verana.pp.v1.QueryGetParticipantRequest
+	8,  // 24: verana.pp.v1.Query.GetParticipantSession:input_type -> verana.pp.v1.QueryGetParticipantSessionRequest
+	10, // 25: verana.pp.v1.Query.ListParticipantSessions:input_type -> verana.pp.v1.QueryListParticipantSessionsRequest
+	14, // 26: verana.pp.v1.Query.FindParticipantsWithDID:input_type -> verana.pp.v1.QueryFindParticipantsWithDIDRequest
+	16, // 27: verana.pp.v1.Query.FindBeneficiaries:input_type -> verana.pp.v1.QueryFindBeneficiariesRequest
+	12, // 28: verana.pp.v1.Query.ListRevokedParticipants:input_type -> verana.pp.v1.QueryListRevokedParticipantsRequest
+	20, // 29: verana.pp.v1.Query.EstimateSessionFees:input_type -> verana.pp.v1.QueryEstimateSessionFeesRequest
+	22, // 30: verana.pp.v1.Query.EstimateOnboardingFees:input_type -> verana.pp.v1.QueryEstimateOnboardingFeesRequest
+	24, // 31: verana.pp.v1.Query.GetParticipantCascade:input_type -> verana.pp.v1.QueryGetParticipantCascadeRequest
+	3,  // 32: verana.pp.v1.Query.Params:output_type -> verana.pp.v1.QueryParamsResponse
+	5,  // 33: verana.pp.v1.Query.ListParticipants:output_type -> verana.pp.v1.QueryListParticipantsResponse
+	7,  // 34: verana.pp.v1.Query.GetParticipant:output_type -> verana.pp.v1.QueryGetParticipantResponse
+	9,  // 35: verana.pp.v1.Query.GetParticipantSession:output_type -> verana.pp.v1.QueryGetParticipantSessionResponse
+	11, // 36: verana.pp.v1.Query.ListParticipantSessions:output_type -> verana.pp.v1.QueryListParticipantSessionsResponse
+	15, // 37: verana.pp.v1.Query.FindParticipantsWithDID:output_type -> verana.pp.v1.QueryFindParticipantsWithDIDResponse
+	17, // 38: verana.pp.v1.Query.FindBeneficiaries:output_type -> verana.pp.v1.QueryFindBeneficiariesResponse
+	13, // 39: verana.pp.v1.Query.ListRevokedParticipants:output_type -> verana.pp.v1.QueryListRevokedParticipantsResponse
+	21, // 40: verana.pp.v1.Query.EstimateSessionFees:output_type -> verana.pp.v1.QueryEstimateSessionFeesResponse
+	23, // 41: verana.pp.v1.Query.EstimateOnboardingFees:output_type -> verana.pp.v1.QueryEstimateOnboardingFeesResponse
+	25, // 42: verana.pp.v1.Query.GetParticipantCascade:output_type -> verana.pp.v1.QueryGetParticipantCascadeResponse
+	32, // [32:43] is the sub-list for method output_type
+	21, // [21:32] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_verana_pp_v1_query_proto_init() }
@@ -7418,7 +15038,7 @@ func file_verana_pp_v1_query_proto_init() {
 			}
 		}
 		file_verana_pp_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFindParticipantsWithDIDRequest); i {
+			switch v := v.(*QueryListRevokedParticipantsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7430,7 +15050,7 @@ func file_verana_pp_v1_query_proto_init() {
 			}
 		}
 		file_verana_pp_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFindParticipantsWithDIDResponse); i {
+			switch v := v.(*QueryListRevokedParticipantsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7442,7 +15062,7 @@ func file_verana_pp_v1_query_proto_init() {
 			}
 		}
 		file_verana_pp_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFindBeneficiariesRequest); i {
+			switch v := v.(*QueryFindParticipantsWithDIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7454,6 +15074,30 @@ func file_verana_pp_v1_query_proto_init() {
 			}
 		}
 		file_verana_pp_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFindParticipantsWithDIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFindBeneficiariesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryFindBeneficiariesResponse); i {
 			case 0:
 				return &v.state
@@ -7465,19 +15109,116 @@ func file_verana_pp_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_verana_pp_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeEstimateItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeneficiaryFeeEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEstimateSessionFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEstimateSessionFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEstimateOnboardingFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEstimateOnboardingFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetParticipantCascadeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetParticipantCascadeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_pp_v1_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_verana_pp_v1_query_proto_goTypes,
 		DependencyIndexes: file_verana_pp_v1_query_proto_depIdxs,
+		EnumInfos:         file_verana_pp_v1_query_proto_enumTypes,
 		MessageInfos:      file_verana_pp_v1_query_proto_msgTypes,
 	}.Build()
 	File_verana_pp_v1_query_proto = out.File
@@ -26,6 +26,10 @@ const (
 	Query_ListParticipantSessions_FullMethodName = "/verana.pp.v1.Query/ListParticipantSessions"
 	Query_FindParticipantsWithDID_FullMethodName = "/verana.pp.v1.Query/FindParticipantsWithDID"
 	Query_FindBeneficiaries_FullMethodName       = "/verana.pp.v1.Query/FindBeneficiaries"
+	Query_ListRevokedParticipants_FullMethodName = "/verana.pp.v1.Query/ListRevokedParticipants"
+	Query_EstimateSessionFees_FullMethodName     = "/verana.pp.v1.Query/EstimateSessionFees"
+	Query_EstimateOnboardingFees_FullMethodName  = "/verana.pp.v1.Query/EstimateOnboardingFees"
+	Query_GetParticipantCascade_FullMethodName   = "/verana.pp.v1.Query/GetParticipantCascade"
 )
 
 // QueryClient is the client API for Query service.
@@ -42,6 +46,19 @@ type QueryClient interface {
 	ListParticipantSessions(ctx context.Context, in *QueryListParticipantSessionsRequest, opts ...grpc.CallOption) (*QueryListParticipantSessionsResponse, error)
 	FindParticipantsWithDID(ctx context.Context, in *QueryFindParticipantsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindParticipantsWithDIDResponse, error)
 	FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error)
+	// ListRevokedParticipants returns revoked participants ordered by
+	// revocation time, so that verifiers can sync a revocation feed.
+	ListRevokedParticipants(ctx context.Context, in *QueryListRevokedParticipantsRequest, opts ...grpc.CallOption) (*QueryListRevokedParticipantsResponse, error)
+	// EstimateSessionFees returns the fees a CreateOrUpdateParticipantSession
+	// would charge for the given participants, itemized per beneficiary and
+	// destination, without changing any state.
+	EstimateSessionFees(ctx context.Context, in *QueryEstimateSessionFeesRequest, opts ...grpc.CallOption) (*QueryEstimateSessionFeesResponse, error)
+	// EstimateOnboardingFees returns the fees and trust deposit a
+	// StartParticipantOP (or RenewParticipantOP) would charge to be validated
+	// by the given validator participant, without changing any state.
+	EstimateOnboardingFees(ctx context.Context, in *QueryEstimateOnboardingFeesRequest, opts ...grpc.CallOption) (*QueryEstimateOnboardingFeesResponse, error)
+	// GetParticipantCascade returns a revocation cascade and its progress.
+	GetParticipantCascade(ctx context.Context, in *QueryGetParticipantCascadeRequest, opts ...grpc.CallOption) (*QueryGetParticipantCascadeResponse, error)
 }
 
 type queryClient struct {
@@ -122,6 +139,46 @@ func (c *queryClient) FindBeneficiaries(ctx context.Context, in *QueryFindBenefi
 	return out, nil
 }
 
+func (c *queryClient) ListRevokedParticipants(ctx context.Context, in *QueryListRevokedParticipantsRequest, opts ...grpc.CallOption) (*QueryListRevokedParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListRevokedParticipantsResponse)
+	err := c.cc.Invoke(ctx, Query_ListRevokedParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSessionFees(ctx context.Context, in *QueryEstimateSessionFeesRequest, opts ...grpc.CallOption) (*QueryEstimateSessionFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEstimateSessionFeesResponse)
+	err := c.cc.Invoke(ctx, Query_EstimateSessionFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateOnboardingFees(ctx context.Context, in *QueryEstimateOnboardingFeesRequest, opts ...grpc.CallOption) (*QueryEstimateOnboardingFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEstimateOnboardingFeesResponse)
+	err := c.cc.Invoke(ctx, Query_EstimateOnboardingFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetParticipantCascade(ctx context.Context, in *QueryGetParticipantCascadeRequest, opts ...grpc.CallOption) (*QueryGetParticipantCascadeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetParticipantCascadeResponse)
+	err := c.cc.Invoke(ctx, Query_GetParticipantCascade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -136,6 +193,19 @@ type QueryServer interface {
 	ListParticipantSessions(context.Context, *QueryListParticipantSessionsRequest) (*QueryListParticipantSessionsResponse, error)
 	FindParticipantsWithDID(context.Context, *QueryFindParticipantsWithDIDRequest) (*QueryFindParticipantsWithDIDResponse, error)
 	FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error)
+	// ListRevokedParticipants returns revoked participants ordered by
+	// revocation time, so that verifiers can sync a revocation feed.
+	ListRevokedParticipants(context.Context, *QueryListRevokedParticipantsRequest) (*QueryListRevokedParticipantsResponse, error)
+	// EstimateSessionFees returns the fees a CreateOrUpdateParticipantSession
+	// would charge for the given participants, itemized per beneficiary and
+	// destination, without changing any state.
+	EstimateSessionFees(context.Context, *QueryEstimateSessionFeesRequest) (*QueryEstimateSessionFeesResponse, error)
+	// EstimateOnboardingFees returns the fees and trust deposit a
+	// StartParticipantOP (or RenewParticipantOP) would charge to be validated
+	// by the given validator participant, without changing any state.
+	EstimateOnboardingFees(context.Context, *QueryEstimateOnboardingFeesRequest) (*QueryEstimateOnboardingFeesResponse, error)
+	// GetParticipantCascade returns a revocation cascade and its progress.
+	GetParticipantCascade(context.Context, *QueryGetParticipantCascadeRequest) (*QueryGetParticipantCascadeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -167,6 +237,18 @@ func (UnimplementedQueryServer) FindParticipantsWithDID(context.Context, *QueryF
 func (UnimplementedQueryServer) FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindBeneficiaries not implemented")
 }
+func (UnimplementedQueryServer) ListRevokedParticipants(context.Context, *QueryListRevokedParticipantsRequest) (*QueryListRevokedParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevokedParticipants not implemented")
+}
+func (UnimplementedQueryServer) EstimateSessionFees(context.Context, *QueryEstimateSessionFeesRequest) (*QueryEstimateSessionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSessionFees not implemented")
+}
+func (UnimplementedQueryServer) EstimateOnboardingFees(context.Context, *QueryEstimateOnboardingFeesRequest) (*QueryEstimateOnboardingFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateOnboardingFees not implemented")
+}
+func (UnimplementedQueryServer) GetParticipantCascade(context.Context, *QueryGetParticipantCascadeRequest) (*QueryGetParticipantCascadeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParticipantCascade not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -314,6 +396,78 @@ func _Query_FindBeneficiaries_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListRevokedParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRevokedParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRevokedParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListRevokedParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRevokedParticipants(ctx, req.(*QueryListRevokedParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSessionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSessionFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSessionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimateSessionFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSessionFees(ctx, req.(*QueryEstimateSessionFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateOnboardingFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateOnboardingFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateOnboardingFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimateOnboardingFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateOnboardingFees(ctx, req.(*QueryEstimateOnboardingFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetParticipantCascade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetParticipantCascadeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParticipantCascade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetParticipantCascade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParticipantCascade(ctx, req.(*QueryGetParticipantCascadeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +503,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindBeneficiaries",
 			Handler:    _Query_FindBeneficiaries_Handler,
 		},
+		{
+			MethodName: "ListRevokedParticipants",
+			Handler:    _Query_ListRevokedParticipants_Handler,
+		},
+		{
+			MethodName: "EstimateSessionFees",
+			Handler:    _Query_EstimateSessionFees_Handler,
+		},
+		{
+			MethodName: "EstimateOnboardingFees",
+			Handler:    _Query_EstimateOnboardingFees_Handler,
+		},
+		{
+			MethodName: "GetParticipantCascade",
+			Handler:    _Query_GetParticipantCascade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/pp/v1/query.proto",
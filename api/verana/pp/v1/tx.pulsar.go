@@ -8670,10 +8670,14 @@ func (x *fastReflection_MsgSetParticipantEffectiveUntilResponse) ProtoMethods()
 }
 
 var (
-	md_MsgRevokeParticipant             protoreflect.MessageDescriptor
-	fd_MsgRevokeParticipant_corporation protoreflect.FieldDescriptor
-	fd_MsgRevokeParticipant_operator    protoreflect.FieldDescriptor
-	fd_MsgRevokeParticipant_id          protoreflect.FieldDescriptor
+	md_MsgRevokeParticipant                 protoreflect.MessageDescriptor
+	fd_MsgRevokeParticipant_corporation     protoreflect.FieldDescriptor
+	fd_MsgRevokeParticipant_operator        protoreflect.FieldDescriptor
+	fd_MsgRevokeParticipant_id              protoreflect.FieldDescriptor
+	fd_MsgRevokeParticipant_reason          protoreflect.FieldDescriptor
+	fd_MsgRevokeParticipant_evidence        protoreflect.FieldDescriptor
+	fd_MsgRevokeParticipant_evidence_digest protoreflect.FieldDescriptor
+	fd_MsgRevokeParticipant_cascade         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -8682,6 +8686,10 @@ func init() {
 	fd_MsgRevokeParticipant_corporation = md_MsgRevokeParticipant.Fields().ByName("corporation")
 	fd_MsgRevokeParticipant_operator = md_MsgRevokeParticipant.Fields().ByName("operator")
 	fd_MsgRevokeParticipant_id = md_MsgRevokeParticipant.Fields().ByName("id")
+	fd_MsgRevokeParticipant_reason = md_MsgRevokeParticipant.Fields().ByName("reason")
+	fd_MsgRevokeParticipant_evidence = md_MsgRevokeParticipant.Fields().ByName("evidence")
+	fd_MsgRevokeParticipant_evidence_digest = md_MsgRevokeParticipant.Fields().ByName("evidence_digest")
+	fd_MsgRevokeParticipant_cascade = md_MsgRevokeParticipant.Fields().ByName("cascade")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRevokeParticipant)(nil)
@@ -8767,6 +8775,30 @@ func (x *fastReflection_MsgRevokeParticipant) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_MsgRevokeParticipant_reason, value) {
+			return
+		}
+	}
+	if x.Evidence != "" {
+		value := protoreflect.ValueOfString(x.Evidence)
+		if !f(fd_MsgRevokeParticipant_evidence, value) {
+			return
+		}
+	}
+	if x.EvidenceDigest != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigest)
+		if !f(fd_MsgRevokeParticipant_evidence_digest, value) {
+			return
+		}
+	}
+	if x.Cascade != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Cascade))
+		if !f(fd_MsgRevokeParticipant_cascade, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -8788,6 +8820,14 @@ func (x *fastReflection_MsgRevokeParticipant) Has(fd protoreflect.FieldDescripto
 		return x.Operator != ""
 	case "verana.pp.v1.MsgRevokeParticipant.id":
 		return x.Id != uint64(0)
+	case "verana.pp.v1.MsgRevokeParticipant.reason":
+		return x.Reason != 0
+	case "verana.pp.v1.MsgRevokeParticipant.evidence":
+		return x.Evidence != ""
+	case "verana.pp.v1.MsgRevokeParticipant.evidence_digest":
+		return x.EvidenceDigest != ""
+	case "verana.pp.v1.MsgRevokeParticipant.cascade":
+		return x.Cascade != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipant"))
@@ -8810,6 +8850,14 @@ func (x *fastReflection_MsgRevokeParticipant) Clear(fd protoreflect.FieldDescrip
 		x.Operator = ""
 	case "verana.pp.v1.MsgRevokeParticipant.id":
 		x.Id = uint64(0)
+	case "verana.pp.v1.MsgRevokeParticipant.reason":
+		x.Reason = 0
+	case "verana.pp.v1.MsgRevokeParticipant.evidence":
+		x.Evidence = ""
+	case "verana.pp.v1.MsgRevokeParticipant.evidence_digest":
+		x.EvidenceDigest = ""
+	case "verana.pp.v1.MsgRevokeParticipant.cascade":
+		x.Cascade = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipant"))
@@ -8835,6 +8883,18 @@ func (x *fastReflection_MsgRevokeParticipant) Get(descriptor protoreflect.FieldD
 	case "verana.pp.v1.MsgRevokeParticipant.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgRevokeParticipant.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.MsgRevokeParticipant.evidence":
+		value := x.Evidence
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgRevokeParticipant.evidence_digest":
+		value := x.EvidenceDigest
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgRevokeParticipant.cascade":
+		value := x.Cascade
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipant"))
@@ -8861,6 +8921,14 @@ func (x *fastReflection_MsgRevokeParticipant) Set(fd protoreflect.FieldDescripto
 		x.Operator = value.Interface().(string)
 	case "verana.pp.v1.MsgRevokeParticipant.id":
 		x.Id = value.Uint()
+	case "verana.pp.v1.MsgRevokeParticipant.reason":
+		x.Reason = (RevocationReason)(value.Enum())
+	case "verana.pp.v1.MsgRevokeParticipant.evidence":
+		x.Evidence = value.Interface().(string)
+	case "verana.pp.v1.MsgRevokeParticipant.evidence_digest":
+		x.EvidenceDigest = value.Interface().(string)
+	case "verana.pp.v1.MsgRevokeParticipant.cascade":
+		x.Cascade = (CascadeMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipant"))
@@ -8887,6 +8955,14 @@ func (x *fastReflection_MsgRevokeParticipant) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgRevokeParticipant is not mutable"))
 	case "verana.pp.v1.MsgRevokeParticipant.id":
 		panic(fmt.Errorf("field id of message verana.pp.v1.MsgRevokeParticipant is not mutable"))
+	case "verana.pp.v1.MsgRevokeParticipant.reason":
+		panic(fmt.Errorf("field reason of message verana.pp.v1.MsgRevokeParticipant is not mutable"))
+	case "verana.pp.v1.MsgRevokeParticipant.evidence":
+		panic(fmt.Errorf("field evidence of message verana.pp.v1.MsgRevokeParticipant is not mutable"))
+	case "verana.pp.v1.MsgRevokeParticipant.evidence_digest":
+		panic(fmt.Errorf("field evidence_digest of message verana.pp.v1.MsgRevokeParticipant is not mutable"))
+	case "verana.pp.v1.MsgRevokeParticipant.cascade":
+		panic(fmt.Errorf("field cascade of message verana.pp.v1.MsgRevokeParticipant is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipant"))
@@ -8906,6 +8982,14 @@ func (x *fastReflection_MsgRevokeParticipant) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfString("")
 	case "verana.pp.v1.MsgRevokeParticipant.id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgRevokeParticipant.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.MsgRevokeParticipant.evidence":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgRevokeParticipant.evidence_digest":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgRevokeParticipant.cascade":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipant"))
@@ -8986,6 +9070,20 @@ func (x *fastReflection_MsgRevokeParticipant) ProtoMethods() *protoiface.Methods
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		l = len(x.Evidence)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Cascade != 0 {
+			n += 1 + runtime.Sov(uint64(x.Cascade))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9015,6 +9113,30 @@ func (x *fastReflection_MsgRevokeParticipant) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Cascade != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Cascade))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EvidenceDigest) > 0 {
+			i -= len(x.EvidenceDigest)
+			copy(dAtA[i:], x.EvidenceDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigest)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Evidence) > 0 {
+			i -= len(x.Evidence)
+			copy(dAtA[i:], x.Evidence)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Evidence)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
@@ -9166,6 +9288,108 @@ func (x *fastReflection_MsgRevokeParticipant) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= RevocationReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Evidence = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+				}
+				x.Cascade = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Cascade |= CascadeMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9202,12 +9426,14 @@ func (x *fastReflection_MsgRevokeParticipant) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgRevokeParticipantResponse protoreflect.MessageDescriptor
+	md_MsgRevokeParticipantResponse            protoreflect.MessageDescriptor
+	fd_MsgRevokeParticipantResponse_cascade_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_tx_proto_init()
 	md_MsgRevokeParticipantResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgRevokeParticipantResponse")
+	fd_MsgRevokeParticipantResponse_cascade_id = md_MsgRevokeParticipantResponse.Fields().ByName("cascade_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRevokeParticipantResponse)(nil)
@@ -9275,6 +9501,12 @@ func (x *fastReflection_MsgRevokeParticipantResponse) Interface() protoreflect.P
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_MsgRevokeParticipantResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CascadeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CascadeId)
+		if !f(fd_MsgRevokeParticipantResponse_cascade_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9290,6 +9522,8 @@ func (x *fastReflection_MsgRevokeParticipantResponse) Range(f func(protoreflect.
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MsgRevokeParticipantResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgRevokeParticipantResponse.cascade_id":
+		return x.CascadeId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipantResponse"))
@@ -9306,6 +9540,8 @@ func (x *fastReflection_MsgRevokeParticipantResponse) Has(fd protoreflect.FieldD
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgRevokeParticipantResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgRevokeParticipantResponse.cascade_id":
+		x.CascadeId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipantResponse"))
@@ -9322,6 +9558,9 @@ func (x *fastReflection_MsgRevokeParticipantResponse) Clear(fd protoreflect.Fiel
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MsgRevokeParticipantResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgRevokeParticipantResponse.cascade_id":
+		value := x.CascadeId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipantResponse"))
@@ -9342,6 +9581,8 @@ func (x *fastReflection_MsgRevokeParticipantResponse) Get(descriptor protoreflec
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgRevokeParticipantResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgRevokeParticipantResponse.cascade_id":
+		x.CascadeId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipantResponse"))
@@ -9362,6 +9603,8 @@ func (x *fastReflection_MsgRevokeParticipantResponse) Set(fd protoreflect.FieldD
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgRevokeParticipantResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgRevokeParticipantResponse.cascade_id":
+		panic(fmt.Errorf("field cascade_id of message verana.pp.v1.MsgRevokeParticipantResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipantResponse"))
@@ -9375,6 +9618,8 @@ func (x *fastReflection_MsgRevokeParticipantResponse) Mutable(fd protoreflect.Fi
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MsgRevokeParticipantResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgRevokeParticipantResponse.cascade_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRevokeParticipantResponse"))
@@ -9444,6 +9689,9 @@ func (x *fastReflection_MsgRevokeParticipantResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
+		if x.CascadeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CascadeId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9473,6 +9721,11 @@ func (x *fastReflection_MsgRevokeParticipantResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CascadeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CascadeId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9522,6 +9775,25 @@ func (x *fastReflection_MsgRevokeParticipantResponse) ProtoMethods() *protoiface
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CascadeId", wireType)
+				}
+				x.CascadeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CascadeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10782,12 +11054,13 @@ func (x *fastReflection_MsgCreateOrUpdateParticipantSessionResponse) ProtoMethod
 }
 
 var (
-	md_MsgSlashParticipantTrustDeposit             protoreflect.MessageDescriptor
-	fd_MsgSlashParticipantTrustDeposit_corporation protoreflect.FieldDescriptor
-	fd_MsgSlashParticipantTrustDeposit_operator    protoreflect.FieldDescriptor
-	fd_MsgSlashParticipantTrustDeposit_id          protoreflect.FieldDescriptor
-	fd_MsgSlashParticipantTrustDeposit_amount      protoreflect.FieldDescriptor
-	fd_MsgSlashParticipantTrustDeposit_reason      protoreflect.FieldDescriptor
+	md_MsgSlashParticipantTrustDeposit                 protoreflect.MessageDescriptor
+	fd_MsgSlashParticipantTrustDeposit_corporation     protoreflect.FieldDescriptor
+	fd_MsgSlashParticipantTrustDeposit_operator        protoreflect.FieldDescriptor
+	fd_MsgSlashParticipantTrustDeposit_id              protoreflect.FieldDescriptor
+	fd_MsgSlashParticipantTrustDeposit_amount          protoreflect.FieldDescriptor
+	fd_MsgSlashParticipantTrustDeposit_reason          protoreflect.FieldDescriptor
+	fd_MsgSlashParticipantTrustDeposit_evidence_digest protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -10798,6 +11071,7 @@ func init() {
 	fd_MsgSlashParticipantTrustDeposit_id = md_MsgSlashParticipantTrustDeposit.Fields().ByName("id")
 	fd_MsgSlashParticipantTrustDeposit_amount = md_MsgSlashParticipantTrustDeposit.Fields().ByName("amount")
 	fd_MsgSlashParticipantTrustDeposit_reason = md_MsgSlashParticipantTrustDeposit.Fields().ByName("reason")
+	fd_MsgSlashParticipantTrustDeposit_evidence_digest = md_MsgSlashParticipantTrustDeposit.Fields().ByName("evidence_digest")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgSlashParticipantTrustDeposit)(nil)
@@ -10895,6 +11169,12 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) Range(f func(protorefle
 			return
 		}
 	}
+	if x.EvidenceDigest != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigest)
+		if !f(fd_MsgSlashParticipantTrustDeposit_evidence_digest, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -10920,6 +11200,8 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) Has(fd protoreflect.Fie
 		return x.Amount != uint64(0)
 	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.reason":
 		return x.Reason != ""
+	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.evidence_digest":
+		return x.EvidenceDigest != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDeposit"))
@@ -10946,6 +11228,8 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) Clear(fd protoreflect.F
 		x.Amount = uint64(0)
 	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.reason":
 		x.Reason = ""
+	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.evidence_digest":
+		x.EvidenceDigest = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDeposit"))
@@ -10977,6 +11261,9 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) Get(descriptor protoref
 	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.reason":
 		value := x.Reason
 		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.evidence_digest":
+		value := x.EvidenceDigest
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDeposit"))
@@ -11007,6 +11294,8 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) Set(fd protoreflect.Fie
 		x.Amount = value.Uint()
 	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.reason":
 		x.Reason = value.Interface().(string)
+	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.evidence_digest":
+		x.EvidenceDigest = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDeposit"))
@@ -11037,6 +11326,8 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) Mutable(fd protoreflect
 		panic(fmt.Errorf("field amount of message verana.pp.v1.MsgSlashParticipantTrustDeposit is not mutable"))
 	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.reason":
 		panic(fmt.Errorf("field reason of message verana.pp.v1.MsgSlashParticipantTrustDeposit is not mutable"))
+	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.evidence_digest":
+		panic(fmt.Errorf("field evidence_digest of message verana.pp.v1.MsgSlashParticipantTrustDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDeposit"))
@@ -11060,6 +11351,8 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) NewField(fd protoreflec
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.reason":
 		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgSlashParticipantTrustDeposit.evidence_digest":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDeposit"))
@@ -11147,6 +11440,10 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) ProtoMethods() *protoif
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.EvidenceDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11176,6 +11473,13 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EvidenceDigest) > 0 {
+			i -= len(x.EvidenceDigest)
+			copy(dAtA[i:], x.EvidenceDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigest)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.Reason) > 0 {
 			i -= len(x.Reason)
 			copy(dAtA[i:], x.Reason)
@@ -11390,6 +11694,38 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) ProtoMethods() *protoif
 				}
 				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11426,12 +11762,16 @@ func (x *fastReflection_MsgSlashParticipantTrustDeposit) ProtoMethods() *protoif
 }
 
 var (
-	md_MsgSlashParticipantTrustDepositResponse protoreflect.MessageDescriptor
+	md_MsgSlashParticipantTrustDepositResponse                  protoreflect.MessageDescriptor
+	fd_MsgSlashParticipantTrustDepositResponse_slash_id         protoreflect.FieldDescriptor
+	fd_MsgSlashParticipantTrustDepositResponse_dispute_deadline protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_tx_proto_init()
 	md_MsgSlashParticipantTrustDepositResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgSlashParticipantTrustDepositResponse")
+	fd_MsgSlashParticipantTrustDepositResponse_slash_id = md_MsgSlashParticipantTrustDepositResponse.Fields().ByName("slash_id")
+	fd_MsgSlashParticipantTrustDepositResponse_dispute_deadline = md_MsgSlashParticipantTrustDepositResponse.Fields().ByName("dispute_deadline")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgSlashParticipantTrustDepositResponse)(nil)
@@ -11499,6 +11839,18 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Interface() pro
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SlashId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashId)
+		if !f(fd_MsgSlashParticipantTrustDepositResponse_slash_id, value) {
+			return
+		}
+	}
+	if x.DisputeDeadline != nil {
+		value := protoreflect.ValueOfMessage(x.DisputeDeadline.ProtoReflect())
+		if !f(fd_MsgSlashParticipantTrustDepositResponse_dispute_deadline, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -11514,6 +11866,10 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Range(f func(pr
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.slash_id":
+		return x.SlashId != uint64(0)
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.dispute_deadline":
+		return x.DisputeDeadline != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDepositResponse"))
@@ -11530,6 +11886,10 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Has(fd protoref
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.slash_id":
+		x.SlashId = uint64(0)
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.dispute_deadline":
+		x.DisputeDeadline = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDepositResponse"))
@@ -11546,6 +11906,12 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Clear(fd protor
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.slash_id":
+		value := x.SlashId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.dispute_deadline":
+		value := x.DisputeDeadline
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDepositResponse"))
@@ -11566,6 +11932,10 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Get(descriptor
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.slash_id":
+		x.SlashId = value.Uint()
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.dispute_deadline":
+		x.DisputeDeadline = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDepositResponse"))
@@ -11586,6 +11956,13 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Set(fd protoref
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.dispute_deadline":
+		if x.DisputeDeadline == nil {
+			x.DisputeDeadline = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DisputeDeadline.ProtoReflect())
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.slash_id":
+		panic(fmt.Errorf("field slash_id of message verana.pp.v1.MsgSlashParticipantTrustDepositResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDepositResponse"))
@@ -11599,6 +11976,11 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) Mutable(fd prot
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.slash_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgSlashParticipantTrustDepositResponse.dispute_deadline":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSlashParticipantTrustDepositResponse"))
@@ -11668,6 +12050,13 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) ProtoMethods()
 		var n int
 		var l int
 		_ = l
+		if x.SlashId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashId))
+		}
+		if x.DisputeDeadline != nil {
+			l = options.Size(x.DisputeDeadline)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11697,6 +12086,25 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) ProtoMethods()
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DisputeDeadline != nil {
+			encoded, err := options.Marshal(x.DisputeDeadline)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SlashId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -11746,19 +12154,74 @@ func (x *fastReflection_MsgSlashParticipantTrustDepositResponse) ProtoMethods()
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashParticipantTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.SlashId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadline", wireType)
 				}
-				if !options.DiscardUnknown {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DisputeDeadline == nil {
+					x.DisputeDeadline = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DisputeDeadline); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
@@ -14618,1411 +15081,12768 @@ func (x *fastReflection_MsgSelfCreateParticipantResponse) ProtoMethods() *protoi
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: verana/pp/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgProposeParticipantFeeChange                           protoreflect.MessageDescriptor
+	fd_MsgProposeParticipantFeeChange_corporation               protoreflect.FieldDescriptor
+	fd_MsgProposeParticipantFeeChange_operator                  protoreflect.FieldDescriptor
+	fd_MsgProposeParticipantFeeChange_id                        protoreflect.FieldDescriptor
+	fd_MsgProposeParticipantFeeChange_validation_fees           protoreflect.FieldDescriptor
+	fd_MsgProposeParticipantFeeChange_issuance_fees             protoreflect.FieldDescriptor
+	fd_MsgProposeParticipantFeeChange_verification_fees         protoreflect.FieldDescriptor
+	fd_MsgProposeParticipantFeeChange_issuance_fee_discount     protoreflect.FieldDescriptor
+	fd_MsgProposeParticipantFeeChange_verification_fee_discount protoreflect.FieldDescriptor
 )
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the module parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
-}
-
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgProposeParticipantFeeChange = File_verana_pp_v1_tx_proto.Messages().ByName("MsgProposeParticipantFeeChange")
+	fd_MsgProposeParticipantFeeChange_corporation = md_MsgProposeParticipantFeeChange.Fields().ByName("corporation")
+	fd_MsgProposeParticipantFeeChange_operator = md_MsgProposeParticipantFeeChange.Fields().ByName("operator")
+	fd_MsgProposeParticipantFeeChange_id = md_MsgProposeParticipantFeeChange.Fields().ByName("id")
+	fd_MsgProposeParticipantFeeChange_validation_fees = md_MsgProposeParticipantFeeChange.Fields().ByName("validation_fees")
+	fd_MsgProposeParticipantFeeChange_issuance_fees = md_MsgProposeParticipantFeeChange.Fields().ByName("issuance_fees")
+	fd_MsgProposeParticipantFeeChange_verification_fees = md_MsgProposeParticipantFeeChange.Fields().ByName("verification_fees")
+	fd_MsgProposeParticipantFeeChange_issuance_fee_discount = md_MsgProposeParticipantFeeChange.Fields().ByName("issuance_fee_discount")
+	fd_MsgProposeParticipantFeeChange_verification_fee_discount = md_MsgProposeParticipantFeeChange.Fields().ByName("verification_fee_discount")
 }
 
-func (x *MsgUpdateParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _ protoreflect.Message = (*fastReflection_MsgProposeParticipantFeeChange)(nil)
 
-func (*MsgUpdateParams) ProtoMessage() {}
+type fastReflection_MsgProposeParticipantFeeChange MsgProposeParticipantFeeChange
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{0}
+func (x *MsgProposeParticipantFeeChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProposeParticipantFeeChange)(x)
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
-	if x != nil {
-		return x.Authority
+func (x *MsgProposeParticipantFeeChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
-}
+var _fastReflection_MsgProposeParticipantFeeChange_messageType fastReflection_MsgProposeParticipantFeeChange_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProposeParticipantFeeChange_messageType{}
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+type fastReflection_MsgProposeParticipantFeeChange_messageType struct{}
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_MsgProposeParticipantFeeChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProposeParticipantFeeChange)(nil)
 }
-
-func (x *MsgUpdateParamsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x fastReflection_MsgProposeParticipantFeeChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeParticipantFeeChange)
 }
-
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{1}
+func (x fastReflection_MsgProposeParticipantFeeChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeParticipantFeeChange
 }
 
-// MsgStartParticipantOP represents a message to start a participant onboarding process
-type MsgStartParticipantOP struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// corporation is the group account on whose behalf this message is executed
-	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	// operator is the account authorized by the corporation to run this Msg
-	Operator               string          `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Role                   ParticipantRole `protobuf:"varint,3,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
-	ValidatorParticipantId uint64          `protobuf:"varint,4,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
-	Did                    string          `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"` // mandatory: MUST conform to DID Syntax
-	ValidationFees         *OptionalUInt64 `protobuf:"bytes,6,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	IssuanceFees           *OptionalUInt64 `protobuf:"bytes,7,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
-	VerificationFees       *OptionalUInt64 `protobuf:"bytes,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	// vs_operator: the account of the Verifiable Service (optional)
-	VsOperator                   string               `protobuf:"bytes,9,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
-	VsOperatorAuthzMsgTypes      []string             `protobuf:"bytes,15,rep,name=vs_operator_authz_msg_types,json=vsOperatorAuthzMsgTypes,proto3" json:"vs_operator_authz_msg_types,omitempty"`
-	VsOperatorAuthzSpendLimit    []*v1beta1.Coin      `protobuf:"bytes,11,rep,name=vs_operator_authz_spend_limit,json=vsOperatorAuthzSpendLimit,proto3" json:"vs_operator_authz_spend_limit,omitempty"`
-	VsOperatorAuthzWithFeegrant  bool                 `protobuf:"varint,12,opt,name=vs_operator_authz_with_feegrant,json=vsOperatorAuthzWithFeegrant,proto3" json:"vs_operator_authz_with_feegrant,omitempty"`
-	VsOperatorAuthzFeeSpendLimit []*v1beta1.Coin      `protobuf:"bytes,13,rep,name=vs_operator_authz_fee_spend_limit,json=vsOperatorAuthzFeeSpendLimit,proto3" json:"vs_operator_authz_fee_spend_limit,omitempty"`
-	VsOperatorAuthzPeriod        *durationpb.Duration `protobuf:"bytes,14,opt,name=vs_operator_authz_period,json=vsOperatorAuthzPeriod,proto3" json:"vs_operator_authz_period,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeParticipantFeeChange
 }
 
-func (x *MsgStartParticipantOP) Reset() {
-	*x = MsgStartParticipantOP{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProposeParticipantFeeChange_messageType
 }
 
-func (x *MsgStartParticipantOP) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProposeParticipantFeeChange) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeParticipantFeeChange)
 }
 
-func (*MsgStartParticipantOP) ProtoMessage() {}
-
-// Deprecated: Use MsgStartParticipantOP.ProtoReflect.Descriptor instead.
-func (*MsgStartParticipantOP) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{2}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Interface() protoreflect.ProtoMessage {
+	return (*MsgProposeParticipantFeeChange)(x)
 }
 
-func (x *MsgStartParticipantOP) GetCorporation() string {
-	if x != nil {
-		return x.Corporation
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgProposeParticipantFeeChange_corporation, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *MsgStartParticipantOP) GetOperator() string {
-	if x != nil {
-		return x.Operator
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgProposeParticipantFeeChange_operator, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *MsgStartParticipantOP) GetRole() ParticipantRole {
-	if x != nil {
-		return x.Role
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgProposeParticipantFeeChange_id, value) {
+			return
+		}
 	}
-	return ParticipantRole_UNSPECIFIED
-}
-
-func (x *MsgStartParticipantOP) GetValidatorParticipantId() uint64 {
-	if x != nil {
-		return x.ValidatorParticipantId
+	if x.ValidationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidationFees)
+		if !f(fd_MsgProposeParticipantFeeChange_validation_fees, value) {
+			return
+		}
 	}
-	return 0
-}
-
-func (x *MsgStartParticipantOP) GetDid() string {
-	if x != nil {
-		return x.Did
+	if x.IssuanceFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuanceFees)
+		if !f(fd_MsgProposeParticipantFeeChange_issuance_fees, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *MsgStartParticipantOP) GetValidationFees() *OptionalUInt64 {
-	if x != nil {
-		return x.ValidationFees
+	if x.VerificationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerificationFees)
+		if !f(fd_MsgProposeParticipantFeeChange_verification_fees, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *MsgStartParticipantOP) GetIssuanceFees() *OptionalUInt64 {
-	if x != nil {
-		return x.IssuanceFees
+	if x.IssuanceFeeDiscount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuanceFeeDiscount)
+		if !f(fd_MsgProposeParticipantFeeChange_issuance_fee_discount, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *MsgStartParticipantOP) GetVerificationFees() *OptionalUInt64 {
-	if x != nil {
-		return x.VerificationFees
+	if x.VerificationFeeDiscount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerificationFeeDiscount)
+		if !f(fd_MsgProposeParticipantFeeChange_verification_fee_discount, value) {
+			return
+		}
 	}
-	return nil
 }
 
-func (x *MsgStartParticipantOP) GetVsOperator() string {
-	if x != nil {
-		return x.VsOperator
-	}
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.validation_fees":
+		return x.ValidationFees != uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fees":
+		return x.IssuanceFees != uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fees":
+		return x.VerificationFees != uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fee_discount":
+		return x.IssuanceFeeDiscount != uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fee_discount":
+		return x.VerificationFeeDiscount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.validation_fees":
+		x.ValidationFees = uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fees":
+		x.IssuanceFees = uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fees":
+		x.VerificationFees = uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fee_discount":
+		x.IssuanceFeeDiscount = uint64(0)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fee_discount":
+		x.VerificationFeeDiscount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.validation_fees":
+		value := x.ValidationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fees":
+		value := x.IssuanceFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fees":
+		value := x.VerificationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fee_discount":
+		value := x.IssuanceFeeDiscount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fee_discount":
+		value := x.VerificationFeeDiscount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.validation_fees":
+		x.ValidationFees = value.Uint()
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fees":
+		x.IssuanceFees = value.Uint()
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fees":
+		x.VerificationFees = value.Uint()
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fee_discount":
+		x.IssuanceFeeDiscount = value.Uint()
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fee_discount":
+		x.VerificationFeeDiscount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.validation_fees":
+		panic(fmt.Errorf("field validation_fees of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fees":
+		panic(fmt.Errorf("field issuance_fees of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fees":
+		panic(fmt.Errorf("field verification_fees of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fee_discount":
+		panic(fmt.Errorf("field issuance_fee_discount of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fee_discount":
+		panic(fmt.Errorf("field verification_fee_discount of message verana.pp.v1.MsgProposeParticipantFeeChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProposeParticipantFeeChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.validation_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.issuance_fee_discount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgProposeParticipantFeeChange.verification_fee_discount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProposeParticipantFeeChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgProposeParticipantFeeChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProposeParticipantFeeChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProposeParticipantFeeChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProposeParticipantFeeChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProposeParticipantFeeChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.ValidationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationFees))
+		}
+		if x.IssuanceFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuanceFees))
+		}
+		if x.VerificationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerificationFees))
+		}
+		if x.IssuanceFeeDiscount != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuanceFeeDiscount))
+		}
+		if x.VerificationFeeDiscount != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerificationFeeDiscount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeParticipantFeeChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VerificationFeeDiscount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFeeDiscount))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.IssuanceFeeDiscount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFeeDiscount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.VerificationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFees))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.IssuanceFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFees))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ValidationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationFees))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeParticipantFeeChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeParticipantFeeChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeParticipantFeeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+				}
+				x.ValidationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+				}
+				x.IssuanceFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuanceFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				}
+				x.VerificationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerificationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
+				}
+				x.IssuanceFeeDiscount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
+				}
+				x.VerificationFeeDiscount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerificationFeeDiscount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgProposeParticipantFeeChangeResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgProposeParticipantFeeChangeResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgProposeParticipantFeeChangeResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProposeParticipantFeeChangeResponse)(nil)
+
+type fastReflection_MsgProposeParticipantFeeChangeResponse MsgProposeParticipantFeeChangeResponse
+
+func (x *MsgProposeParticipantFeeChangeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProposeParticipantFeeChangeResponse)(x)
+}
+
+func (x *MsgProposeParticipantFeeChangeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProposeParticipantFeeChangeResponse_messageType fastReflection_MsgProposeParticipantFeeChangeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProposeParticipantFeeChangeResponse_messageType{}
+
+type fastReflection_MsgProposeParticipantFeeChangeResponse_messageType struct{}
+
+func (x fastReflection_MsgProposeParticipantFeeChangeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProposeParticipantFeeChangeResponse)(nil)
+}
+func (x fastReflection_MsgProposeParticipantFeeChangeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeParticipantFeeChangeResponse)
+}
+func (x fastReflection_MsgProposeParticipantFeeChangeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeParticipantFeeChangeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeParticipantFeeChangeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProposeParticipantFeeChangeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeParticipantFeeChangeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgProposeParticipantFeeChangeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChangeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgProposeParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgProposeParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgProposeParticipantFeeChangeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProposeParticipantFeeChangeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProposeParticipantFeeChangeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeParticipantFeeChangeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeParticipantFeeChangeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeParticipantFeeChangeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeParticipantFeeChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptParticipantFeeChange             protoreflect.MessageDescriptor
+	fd_MsgAcceptParticipantFeeChange_corporation protoreflect.FieldDescriptor
+	fd_MsgAcceptParticipantFeeChange_operator    protoreflect.FieldDescriptor
+	fd_MsgAcceptParticipantFeeChange_id          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgAcceptParticipantFeeChange = File_verana_pp_v1_tx_proto.Messages().ByName("MsgAcceptParticipantFeeChange")
+	fd_MsgAcceptParticipantFeeChange_corporation = md_MsgAcceptParticipantFeeChange.Fields().ByName("corporation")
+	fd_MsgAcceptParticipantFeeChange_operator = md_MsgAcceptParticipantFeeChange.Fields().ByName("operator")
+	fd_MsgAcceptParticipantFeeChange_id = md_MsgAcceptParticipantFeeChange.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptParticipantFeeChange)(nil)
+
+type fastReflection_MsgAcceptParticipantFeeChange MsgAcceptParticipantFeeChange
+
+func (x *MsgAcceptParticipantFeeChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptParticipantFeeChange)(x)
+}
+
+func (x *MsgAcceptParticipantFeeChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptParticipantFeeChange_messageType fastReflection_MsgAcceptParticipantFeeChange_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptParticipantFeeChange_messageType{}
+
+type fastReflection_MsgAcceptParticipantFeeChange_messageType struct{}
+
+func (x fastReflection_MsgAcceptParticipantFeeChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptParticipantFeeChange)(nil)
+}
+func (x fastReflection_MsgAcceptParticipantFeeChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptParticipantFeeChange)
+}
+func (x fastReflection_MsgAcceptParticipantFeeChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptParticipantFeeChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptParticipantFeeChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptParticipantFeeChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptParticipantFeeChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptParticipantFeeChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgAcceptParticipantFeeChange_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgAcceptParticipantFeeChange_operator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgAcceptParticipantFeeChange_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgAcceptParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgAcceptParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.MsgAcceptParticipantFeeChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgAcceptParticipantFeeChange.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChange"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgAcceptParticipantFeeChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptParticipantFeeChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptParticipantFeeChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptParticipantFeeChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptParticipantFeeChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptParticipantFeeChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptParticipantFeeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptParticipantFeeChangeResponse           protoreflect.MessageDescriptor
+	fd_MsgAcceptParticipantFeeChangeResponse_effective protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgAcceptParticipantFeeChangeResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgAcceptParticipantFeeChangeResponse")
+	fd_MsgAcceptParticipantFeeChangeResponse_effective = md_MsgAcceptParticipantFeeChangeResponse.Fields().ByName("effective")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptParticipantFeeChangeResponse)(nil)
+
+type fastReflection_MsgAcceptParticipantFeeChangeResponse MsgAcceptParticipantFeeChangeResponse
+
+func (x *MsgAcceptParticipantFeeChangeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptParticipantFeeChangeResponse)(x)
+}
+
+func (x *MsgAcceptParticipantFeeChangeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType{}
+
+type fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType struct{}
+
+func (x fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptParticipantFeeChangeResponse)(nil)
+}
+func (x fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptParticipantFeeChangeResponse)
+}
+func (x fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptParticipantFeeChangeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptParticipantFeeChangeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptParticipantFeeChangeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptParticipantFeeChangeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptParticipantFeeChangeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Effective != nil {
+		value := protoreflect.ValueOfMessage(x.Effective.ProtoReflect())
+		if !f(fd_MsgAcceptParticipantFeeChangeResponse_effective, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChangeResponse.effective":
+		return x.Effective != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChangeResponse.effective":
+		x.Effective = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChangeResponse.effective":
+		value := x.Effective
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChangeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChangeResponse.effective":
+		x.Effective = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChangeResponse.effective":
+		if x.Effective == nil {
+			x.Effective = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Effective.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAcceptParticipantFeeChangeResponse.effective":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAcceptParticipantFeeChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgAcceptParticipantFeeChangeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptParticipantFeeChangeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptParticipantFeeChangeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Effective != nil {
+			l = options.Size(x.Effective)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptParticipantFeeChangeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Effective != nil {
+			encoded, err := options.Marshal(x.Effective)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptParticipantFeeChangeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptParticipantFeeChangeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptParticipantFeeChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Effective == nil {
+					x.Effective = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Effective); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAppealSlash                 protoreflect.MessageDescriptor
+	fd_MsgAppealSlash_corporation     protoreflect.FieldDescriptor
+	fd_MsgAppealSlash_operator        protoreflect.FieldDescriptor
+	fd_MsgAppealSlash_id              protoreflect.FieldDescriptor
+	fd_MsgAppealSlash_slash_id        protoreflect.FieldDescriptor
+	fd_MsgAppealSlash_reason          protoreflect.FieldDescriptor
+	fd_MsgAppealSlash_evidence_digest protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgAppealSlash = File_verana_pp_v1_tx_proto.Messages().ByName("MsgAppealSlash")
+	fd_MsgAppealSlash_corporation = md_MsgAppealSlash.Fields().ByName("corporation")
+	fd_MsgAppealSlash_operator = md_MsgAppealSlash.Fields().ByName("operator")
+	fd_MsgAppealSlash_id = md_MsgAppealSlash.Fields().ByName("id")
+	fd_MsgAppealSlash_slash_id = md_MsgAppealSlash.Fields().ByName("slash_id")
+	fd_MsgAppealSlash_reason = md_MsgAppealSlash.Fields().ByName("reason")
+	fd_MsgAppealSlash_evidence_digest = md_MsgAppealSlash.Fields().ByName("evidence_digest")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAppealSlash)(nil)
+
+type fastReflection_MsgAppealSlash MsgAppealSlash
+
+func (x *MsgAppealSlash) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlash)(x)
+}
+
+func (x *MsgAppealSlash) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAppealSlash_messageType fastReflection_MsgAppealSlash_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAppealSlash_messageType{}
+
+type fastReflection_MsgAppealSlash_messageType struct{}
+
+func (x fastReflection_MsgAppealSlash_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlash)(nil)
+}
+func (x fastReflection_MsgAppealSlash_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlash)
+}
+func (x fastReflection_MsgAppealSlash_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlash
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAppealSlash) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlash
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAppealSlash) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAppealSlash_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAppealSlash) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlash)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAppealSlash) Interface() protoreflect.ProtoMessage {
+	return (*MsgAppealSlash)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAppealSlash) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgAppealSlash_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgAppealSlash_operator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgAppealSlash_id, value) {
+			return
+		}
+	}
+	if x.SlashId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashId)
+		if !f(fd_MsgAppealSlash_slash_id, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgAppealSlash_reason, value) {
+			return
+		}
+	}
+	if x.EvidenceDigest != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigest)
+		if !f(fd_MsgAppealSlash_evidence_digest, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAppealSlash) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlash.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgAppealSlash.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgAppealSlash.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.MsgAppealSlash.slash_id":
+		return x.SlashId != uint64(0)
+	case "verana.pp.v1.MsgAppealSlash.reason":
+		return x.Reason != ""
+	case "verana.pp.v1.MsgAppealSlash.evidence_digest":
+		return x.EvidenceDigest != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlash.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgAppealSlash.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgAppealSlash.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.MsgAppealSlash.slash_id":
+		x.SlashId = uint64(0)
+	case "verana.pp.v1.MsgAppealSlash.reason":
+		x.Reason = ""
+	case "verana.pp.v1.MsgAppealSlash.evidence_digest":
+		x.EvidenceDigest = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAppealSlash) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgAppealSlash.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgAppealSlash.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgAppealSlash.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgAppealSlash.slash_id":
+		value := x.SlashId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgAppealSlash.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgAppealSlash.evidence_digest":
+		value := x.EvidenceDigest
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlash does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlash.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgAppealSlash.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgAppealSlash.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.MsgAppealSlash.slash_id":
+		x.SlashId = value.Uint()
+	case "verana.pp.v1.MsgAppealSlash.reason":
+		x.Reason = value.Interface().(string)
+	case "verana.pp.v1.MsgAppealSlash.evidence_digest":
+		x.EvidenceDigest = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlash.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgAppealSlash is not mutable"))
+	case "verana.pp.v1.MsgAppealSlash.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgAppealSlash is not mutable"))
+	case "verana.pp.v1.MsgAppealSlash.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.MsgAppealSlash is not mutable"))
+	case "verana.pp.v1.MsgAppealSlash.slash_id":
+		panic(fmt.Errorf("field slash_id of message verana.pp.v1.MsgAppealSlash is not mutable"))
+	case "verana.pp.v1.MsgAppealSlash.reason":
+		panic(fmt.Errorf("field reason of message verana.pp.v1.MsgAppealSlash is not mutable"))
+	case "verana.pp.v1.MsgAppealSlash.evidence_digest":
+		panic(fmt.Errorf("field evidence_digest of message verana.pp.v1.MsgAppealSlash is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAppealSlash) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlash.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgAppealSlash.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgAppealSlash.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgAppealSlash.slash_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgAppealSlash.reason":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgAppealSlash.evidence_digest":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAppealSlash) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgAppealSlash", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAppealSlash) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAppealSlash) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAppealSlash) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAppealSlash)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.SlashId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashId))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlash)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EvidenceDigest) > 0 {
+			i -= len(x.EvidenceDigest)
+			copy(dAtA[i:], x.EvidenceDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigest)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SlashId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlash)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlash: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
+				}
+				x.SlashId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAppealSlashResponse         protoreflect.MessageDescriptor
+	fd_MsgAppealSlashResponse_arbiter protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgAppealSlashResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgAppealSlashResponse")
+	fd_MsgAppealSlashResponse_arbiter = md_MsgAppealSlashResponse.Fields().ByName("arbiter")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAppealSlashResponse)(nil)
+
+type fastReflection_MsgAppealSlashResponse MsgAppealSlashResponse
+
+func (x *MsgAppealSlashResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlashResponse)(x)
+}
+
+func (x *MsgAppealSlashResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAppealSlashResponse_messageType fastReflection_MsgAppealSlashResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAppealSlashResponse_messageType{}
+
+type fastReflection_MsgAppealSlashResponse_messageType struct{}
+
+func (x fastReflection_MsgAppealSlashResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlashResponse)(nil)
+}
+func (x fastReflection_MsgAppealSlashResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlashResponse)
+}
+func (x fastReflection_MsgAppealSlashResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlashResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAppealSlashResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlashResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAppealSlashResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAppealSlashResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAppealSlashResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlashResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAppealSlashResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAppealSlashResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAppealSlashResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Arbiter != "" {
+		value := protoreflect.ValueOfString(x.Arbiter)
+		if !f(fd_MsgAppealSlashResponse_arbiter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAppealSlashResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlashResponse.arbiter":
+		return x.Arbiter != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlashResponse.arbiter":
+		x.Arbiter = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAppealSlashResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgAppealSlashResponse.arbiter":
+		value := x.Arbiter
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlashResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlashResponse.arbiter":
+		x.Arbiter = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlashResponse.arbiter":
+		panic(fmt.Errorf("field arbiter of message verana.pp.v1.MsgAppealSlashResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAppealSlashResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgAppealSlashResponse.arbiter":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAppealSlashResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgAppealSlashResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAppealSlashResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAppealSlashResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAppealSlashResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAppealSlashResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Arbiter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlashResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Arbiter) > 0 {
+			i -= len(x.Arbiter)
+			copy(dAtA[i:], x.Arbiter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Arbiter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlashResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlashResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Arbiter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgResolveSlashAppeal           protoreflect.MessageDescriptor
+	fd_MsgResolveSlashAppeal_authority protoreflect.FieldDescriptor
+	fd_MsgResolveSlashAppeal_id        protoreflect.FieldDescriptor
+	fd_MsgResolveSlashAppeal_slash_id  protoreflect.FieldDescriptor
+	fd_MsgResolveSlashAppeal_reverse   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgResolveSlashAppeal = File_verana_pp_v1_tx_proto.Messages().ByName("MsgResolveSlashAppeal")
+	fd_MsgResolveSlashAppeal_authority = md_MsgResolveSlashAppeal.Fields().ByName("authority")
+	fd_MsgResolveSlashAppeal_id = md_MsgResolveSlashAppeal.Fields().ByName("id")
+	fd_MsgResolveSlashAppeal_slash_id = md_MsgResolveSlashAppeal.Fields().ByName("slash_id")
+	fd_MsgResolveSlashAppeal_reverse = md_MsgResolveSlashAppeal.Fields().ByName("reverse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgResolveSlashAppeal)(nil)
+
+type fastReflection_MsgResolveSlashAppeal MsgResolveSlashAppeal
+
+func (x *MsgResolveSlashAppeal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppeal)(x)
+}
+
+func (x *MsgResolveSlashAppeal) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgResolveSlashAppeal_messageType fastReflection_MsgResolveSlashAppeal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveSlashAppeal_messageType{}
+
+type fastReflection_MsgResolveSlashAppeal_messageType struct{}
+
+func (x fastReflection_MsgResolveSlashAppeal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppeal)(nil)
+}
+func (x fastReflection_MsgResolveSlashAppeal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppeal)
+}
+func (x fastReflection_MsgResolveSlashAppeal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppeal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgResolveSlashAppeal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppeal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgResolveSlashAppeal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveSlashAppeal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgResolveSlashAppeal) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppeal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgResolveSlashAppeal) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveSlashAppeal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgResolveSlashAppeal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgResolveSlashAppeal_authority, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgResolveSlashAppeal_id, value) {
+			return
+		}
+	}
+	if x.SlashId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashId)
+		if !f(fd_MsgResolveSlashAppeal_slash_id, value) {
+			return
+		}
+	}
+	if x.Reverse != false {
+		value := protoreflect.ValueOfBool(x.Reverse)
+		if !f(fd_MsgResolveSlashAppeal_reverse, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgResolveSlashAppeal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgResolveSlashAppeal.authority":
+		return x.Authority != ""
+	case "verana.pp.v1.MsgResolveSlashAppeal.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.MsgResolveSlashAppeal.slash_id":
+		return x.SlashId != uint64(0)
+	case "verana.pp.v1.MsgResolveSlashAppeal.reverse":
+		return x.Reverse != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgResolveSlashAppeal.authority":
+		x.Authority = ""
+	case "verana.pp.v1.MsgResolveSlashAppeal.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.MsgResolveSlashAppeal.slash_id":
+		x.SlashId = uint64(0)
+	case "verana.pp.v1.MsgResolveSlashAppeal.reverse":
+		x.Reverse = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgResolveSlashAppeal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgResolveSlashAppeal.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgResolveSlashAppeal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgResolveSlashAppeal.slash_id":
+		value := x.SlashId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgResolveSlashAppeal.reverse":
+		value := x.Reverse
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppeal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgResolveSlashAppeal.authority":
+		x.Authority = value.Interface().(string)
+	case "verana.pp.v1.MsgResolveSlashAppeal.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.MsgResolveSlashAppeal.slash_id":
+		x.SlashId = value.Uint()
+	case "verana.pp.v1.MsgResolveSlashAppeal.reverse":
+		x.Reverse = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgResolveSlashAppeal.authority":
+		panic(fmt.Errorf("field authority of message verana.pp.v1.MsgResolveSlashAppeal is not mutable"))
+	case "verana.pp.v1.MsgResolveSlashAppeal.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.MsgResolveSlashAppeal is not mutable"))
+	case "verana.pp.v1.MsgResolveSlashAppeal.slash_id":
+		panic(fmt.Errorf("field slash_id of message verana.pp.v1.MsgResolveSlashAppeal is not mutable"))
+	case "verana.pp.v1.MsgResolveSlashAppeal.reverse":
+		panic(fmt.Errorf("field reverse of message verana.pp.v1.MsgResolveSlashAppeal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgResolveSlashAppeal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgResolveSlashAppeal.authority":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgResolveSlashAppeal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgResolveSlashAppeal.slash_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgResolveSlashAppeal.reverse":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgResolveSlashAppeal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgResolveSlashAppeal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgResolveSlashAppeal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgResolveSlashAppeal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgResolveSlashAppeal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgResolveSlashAppeal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.SlashId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashId))
+		}
+		if x.Reverse {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppeal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Reverse {
+			i--
+			if x.Reverse {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SlashId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppeal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppeal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
+				}
+				x.SlashId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Reverse = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgResolveSlashAppealResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgResolveSlashAppealResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgResolveSlashAppealResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgResolveSlashAppealResponse)(nil)
+
+type fastReflection_MsgResolveSlashAppealResponse MsgResolveSlashAppealResponse
+
+func (x *MsgResolveSlashAppealResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppealResponse)(x)
+}
+
+func (x *MsgResolveSlashAppealResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgResolveSlashAppealResponse_messageType fastReflection_MsgResolveSlashAppealResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveSlashAppealResponse_messageType{}
+
+type fastReflection_MsgResolveSlashAppealResponse_messageType struct{}
+
+func (x fastReflection_MsgResolveSlashAppealResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppealResponse)(nil)
+}
+func (x fastReflection_MsgResolveSlashAppealResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppealResponse)
+}
+func (x fastReflection_MsgResolveSlashAppealResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppealResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppealResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveSlashAppealResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgResolveSlashAppealResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppealResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveSlashAppealResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppealResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgResolveSlashAppealResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgResolveSlashAppealResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgResolveSlashAppealResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgResolveSlashAppealResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgResolveSlashAppealResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgResolveSlashAppealResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgResolveSlashAppealResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppealResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppealResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppealResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSuspendParticipant             protoreflect.MessageDescriptor
+	fd_MsgSuspendParticipant_corporation protoreflect.FieldDescriptor
+	fd_MsgSuspendParticipant_operator    protoreflect.FieldDescriptor
+	fd_MsgSuspendParticipant_id          protoreflect.FieldDescriptor
+	fd_MsgSuspendParticipant_reason      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgSuspendParticipant = File_verana_pp_v1_tx_proto.Messages().ByName("MsgSuspendParticipant")
+	fd_MsgSuspendParticipant_corporation = md_MsgSuspendParticipant.Fields().ByName("corporation")
+	fd_MsgSuspendParticipant_operator = md_MsgSuspendParticipant.Fields().ByName("operator")
+	fd_MsgSuspendParticipant_id = md_MsgSuspendParticipant.Fields().ByName("id")
+	fd_MsgSuspendParticipant_reason = md_MsgSuspendParticipant.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSuspendParticipant)(nil)
+
+type fastReflection_MsgSuspendParticipant MsgSuspendParticipant
+
+func (x *MsgSuspendParticipant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSuspendParticipant)(x)
+}
+
+func (x *MsgSuspendParticipant) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSuspendParticipant_messageType fastReflection_MsgSuspendParticipant_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSuspendParticipant_messageType{}
+
+type fastReflection_MsgSuspendParticipant_messageType struct{}
+
+func (x fastReflection_MsgSuspendParticipant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSuspendParticipant)(nil)
+}
+func (x fastReflection_MsgSuspendParticipant_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendParticipant)
+}
+func (x fastReflection_MsgSuspendParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendParticipant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSuspendParticipant) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendParticipant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSuspendParticipant) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSuspendParticipant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSuspendParticipant) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendParticipant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSuspendParticipant) Interface() protoreflect.ProtoMessage {
+	return (*MsgSuspendParticipant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSuspendParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgSuspendParticipant_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgSuspendParticipant_operator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgSuspendParticipant_id, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgSuspendParticipant_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSuspendParticipant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgSuspendParticipant.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgSuspendParticipant.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgSuspendParticipant.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.MsgSuspendParticipant.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgSuspendParticipant.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgSuspendParticipant.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgSuspendParticipant.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.MsgSuspendParticipant.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSuspendParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgSuspendParticipant.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgSuspendParticipant.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgSuspendParticipant.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgSuspendParticipant.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgSuspendParticipant.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgSuspendParticipant.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgSuspendParticipant.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.MsgSuspendParticipant.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgSuspendParticipant.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgSuspendParticipant is not mutable"))
+	case "verana.pp.v1.MsgSuspendParticipant.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgSuspendParticipant is not mutable"))
+	case "verana.pp.v1.MsgSuspendParticipant.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.MsgSuspendParticipant is not mutable"))
+	case "verana.pp.v1.MsgSuspendParticipant.reason":
+		panic(fmt.Errorf("field reason of message verana.pp.v1.MsgSuspendParticipant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSuspendParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgSuspendParticipant.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgSuspendParticipant.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgSuspendParticipant.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgSuspendParticipant.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSuspendParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgSuspendParticipant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSuspendParticipant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSuspendParticipant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSuspendParticipant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSuspendParticipant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendParticipant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendParticipant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendParticipant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSuspendParticipantResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgSuspendParticipantResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgSuspendParticipantResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSuspendParticipantResponse)(nil)
+
+type fastReflection_MsgSuspendParticipantResponse MsgSuspendParticipantResponse
+
+func (x *MsgSuspendParticipantResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSuspendParticipantResponse)(x)
+}
+
+func (x *MsgSuspendParticipantResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSuspendParticipantResponse_messageType fastReflection_MsgSuspendParticipantResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSuspendParticipantResponse_messageType{}
+
+type fastReflection_MsgSuspendParticipantResponse_messageType struct{}
+
+func (x fastReflection_MsgSuspendParticipantResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSuspendParticipantResponse)(nil)
+}
+func (x fastReflection_MsgSuspendParticipantResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendParticipantResponse)
+}
+func (x fastReflection_MsgSuspendParticipantResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendParticipantResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSuspendParticipantResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendParticipantResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSuspendParticipantResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSuspendParticipantResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSuspendParticipantResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendParticipantResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSuspendParticipantResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSuspendParticipantResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSuspendParticipantResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSuspendParticipantResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipantResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSuspendParticipantResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipantResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipantResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipantResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSuspendParticipantResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgSuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgSuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSuspendParticipantResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgSuspendParticipantResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSuspendParticipantResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendParticipantResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSuspendParticipantResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSuspendParticipantResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSuspendParticipantResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendParticipantResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendParticipantResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendParticipantResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUnsuspendParticipant             protoreflect.MessageDescriptor
+	fd_MsgUnsuspendParticipant_corporation protoreflect.FieldDescriptor
+	fd_MsgUnsuspendParticipant_operator    protoreflect.FieldDescriptor
+	fd_MsgUnsuspendParticipant_id          protoreflect.FieldDescriptor
+	fd_MsgUnsuspendParticipant_reason      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgUnsuspendParticipant = File_verana_pp_v1_tx_proto.Messages().ByName("MsgUnsuspendParticipant")
+	fd_MsgUnsuspendParticipant_corporation = md_MsgUnsuspendParticipant.Fields().ByName("corporation")
+	fd_MsgUnsuspendParticipant_operator = md_MsgUnsuspendParticipant.Fields().ByName("operator")
+	fd_MsgUnsuspendParticipant_id = md_MsgUnsuspendParticipant.Fields().ByName("id")
+	fd_MsgUnsuspendParticipant_reason = md_MsgUnsuspendParticipant.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUnsuspendParticipant)(nil)
+
+type fastReflection_MsgUnsuspendParticipant MsgUnsuspendParticipant
+
+func (x *MsgUnsuspendParticipant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendParticipant)(x)
+}
+
+func (x *MsgUnsuspendParticipant) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUnsuspendParticipant_messageType fastReflection_MsgUnsuspendParticipant_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnsuspendParticipant_messageType{}
+
+type fastReflection_MsgUnsuspendParticipant_messageType struct{}
+
+func (x fastReflection_MsgUnsuspendParticipant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendParticipant)(nil)
+}
+func (x fastReflection_MsgUnsuspendParticipant_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendParticipant)
+}
+func (x fastReflection_MsgUnsuspendParticipant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendParticipant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUnsuspendParticipant) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendParticipant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUnsuspendParticipant) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnsuspendParticipant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUnsuspendParticipant) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendParticipant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUnsuspendParticipant) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnsuspendParticipant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUnsuspendParticipant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgUnsuspendParticipant_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgUnsuspendParticipant_operator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgUnsuspendParticipant_id, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgUnsuspendParticipant_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUnsuspendParticipant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgUnsuspendParticipant.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgUnsuspendParticipant.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgUnsuspendParticipant.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.MsgUnsuspendParticipant.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgUnsuspendParticipant.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgUnsuspendParticipant.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgUnsuspendParticipant.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.MsgUnsuspendParticipant.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUnsuspendParticipant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgUnsuspendParticipant.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgUnsuspendParticipant.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgUnsuspendParticipant.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgUnsuspendParticipant.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgUnsuspendParticipant.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgUnsuspendParticipant.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgUnsuspendParticipant.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.MsgUnsuspendParticipant.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgUnsuspendParticipant.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgUnsuspendParticipant is not mutable"))
+	case "verana.pp.v1.MsgUnsuspendParticipant.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgUnsuspendParticipant is not mutable"))
+	case "verana.pp.v1.MsgUnsuspendParticipant.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.MsgUnsuspendParticipant is not mutable"))
+	case "verana.pp.v1.MsgUnsuspendParticipant.reason":
+		panic(fmt.Errorf("field reason of message verana.pp.v1.MsgUnsuspendParticipant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUnsuspendParticipant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgUnsuspendParticipant.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgUnsuspendParticipant.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgUnsuspendParticipant.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgUnsuspendParticipant.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipant"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUnsuspendParticipant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgUnsuspendParticipant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUnsuspendParticipant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUnsuspendParticipant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUnsuspendParticipant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUnsuspendParticipant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendParticipant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendParticipant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendParticipant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUnsuspendParticipantResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgUnsuspendParticipantResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgUnsuspendParticipantResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUnsuspendParticipantResponse)(nil)
+
+type fastReflection_MsgUnsuspendParticipantResponse MsgUnsuspendParticipantResponse
+
+func (x *MsgUnsuspendParticipantResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendParticipantResponse)(x)
+}
+
+func (x *MsgUnsuspendParticipantResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUnsuspendParticipantResponse_messageType fastReflection_MsgUnsuspendParticipantResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnsuspendParticipantResponse_messageType{}
+
+type fastReflection_MsgUnsuspendParticipantResponse_messageType struct{}
+
+func (x fastReflection_MsgUnsuspendParticipantResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendParticipantResponse)(nil)
+}
+func (x fastReflection_MsgUnsuspendParticipantResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendParticipantResponse)
+}
+func (x fastReflection_MsgUnsuspendParticipantResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendParticipantResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendParticipantResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnsuspendParticipantResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendParticipantResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnsuspendParticipantResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipantResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgUnsuspendParticipantResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgUnsuspendParticipantResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgUnsuspendParticipantResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUnsuspendParticipantResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUnsuspendParticipantResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendParticipantResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendParticipantResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendParticipantResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRotateParticipantDID             protoreflect.MessageDescriptor
+	fd_MsgRotateParticipantDID_corporation protoreflect.FieldDescriptor
+	fd_MsgRotateParticipantDID_operator    protoreflect.FieldDescriptor
+	fd_MsgRotateParticipantDID_id          protoreflect.FieldDescriptor
+	fd_MsgRotateParticipantDID_new_did     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgRotateParticipantDID = File_verana_pp_v1_tx_proto.Messages().ByName("MsgRotateParticipantDID")
+	fd_MsgRotateParticipantDID_corporation = md_MsgRotateParticipantDID.Fields().ByName("corporation")
+	fd_MsgRotateParticipantDID_operator = md_MsgRotateParticipantDID.Fields().ByName("operator")
+	fd_MsgRotateParticipantDID_id = md_MsgRotateParticipantDID.Fields().ByName("id")
+	fd_MsgRotateParticipantDID_new_did = md_MsgRotateParticipantDID.Fields().ByName("new_did")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRotateParticipantDID)(nil)
+
+type fastReflection_MsgRotateParticipantDID MsgRotateParticipantDID
+
+func (x *MsgRotateParticipantDID) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRotateParticipantDID)(x)
+}
+
+func (x *MsgRotateParticipantDID) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRotateParticipantDID_messageType fastReflection_MsgRotateParticipantDID_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRotateParticipantDID_messageType{}
+
+type fastReflection_MsgRotateParticipantDID_messageType struct{}
+
+func (x fastReflection_MsgRotateParticipantDID_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRotateParticipantDID)(nil)
+}
+func (x fastReflection_MsgRotateParticipantDID_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateParticipantDID)
+}
+func (x fastReflection_MsgRotateParticipantDID_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateParticipantDID
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRotateParticipantDID) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateParticipantDID
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRotateParticipantDID) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRotateParticipantDID_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRotateParticipantDID) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateParticipantDID)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRotateParticipantDID) Interface() protoreflect.ProtoMessage {
+	return (*MsgRotateParticipantDID)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRotateParticipantDID) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgRotateParticipantDID_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgRotateParticipantDID_operator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgRotateParticipantDID_id, value) {
+			return
+		}
+	}
+	if x.NewDid != "" {
+		value := protoreflect.ValueOfString(x.NewDid)
+		if !f(fd_MsgRotateParticipantDID_new_did, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRotateParticipantDID) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgRotateParticipantDID.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgRotateParticipantDID.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgRotateParticipantDID.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.MsgRotateParticipantDID.new_did":
+		return x.NewDid != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDID"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDID does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDID) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgRotateParticipantDID.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgRotateParticipantDID.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgRotateParticipantDID.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.MsgRotateParticipantDID.new_did":
+		x.NewDid = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDID"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDID does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRotateParticipantDID) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgRotateParticipantDID.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgRotateParticipantDID.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgRotateParticipantDID.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.MsgRotateParticipantDID.new_did":
+		value := x.NewDid
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDID"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDID does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDID) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgRotateParticipantDID.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgRotateParticipantDID.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgRotateParticipantDID.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.MsgRotateParticipantDID.new_did":
+		x.NewDid = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDID"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDID does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDID) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgRotateParticipantDID.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgRotateParticipantDID is not mutable"))
+	case "verana.pp.v1.MsgRotateParticipantDID.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgRotateParticipantDID is not mutable"))
+	case "verana.pp.v1.MsgRotateParticipantDID.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.MsgRotateParticipantDID is not mutable"))
+	case "verana.pp.v1.MsgRotateParticipantDID.new_did":
+		panic(fmt.Errorf("field new_did of message verana.pp.v1.MsgRotateParticipantDID is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDID"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDID does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRotateParticipantDID) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgRotateParticipantDID.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgRotateParticipantDID.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgRotateParticipantDID.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.MsgRotateParticipantDID.new_did":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDID"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDID does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRotateParticipantDID) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgRotateParticipantDID", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRotateParticipantDID) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDID) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRotateParticipantDID) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRotateParticipantDID) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRotateParticipantDID)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.NewDid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateParticipantDID)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewDid) > 0 {
+			i -= len(x.NewDid)
+			copy(dAtA[i:], x.NewDid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewDid)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateParticipantDID)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateParticipantDID: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateParticipantDID: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewDid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewDid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRotateParticipantDIDResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgRotateParticipantDIDResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgRotateParticipantDIDResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRotateParticipantDIDResponse)(nil)
+
+type fastReflection_MsgRotateParticipantDIDResponse MsgRotateParticipantDIDResponse
+
+func (x *MsgRotateParticipantDIDResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRotateParticipantDIDResponse)(x)
+}
+
+func (x *MsgRotateParticipantDIDResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRotateParticipantDIDResponse_messageType fastReflection_MsgRotateParticipantDIDResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRotateParticipantDIDResponse_messageType{}
+
+type fastReflection_MsgRotateParticipantDIDResponse_messageType struct{}
+
+func (x fastReflection_MsgRotateParticipantDIDResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRotateParticipantDIDResponse)(nil)
+}
+func (x fastReflection_MsgRotateParticipantDIDResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateParticipantDIDResponse)
+}
+func (x fastReflection_MsgRotateParticipantDIDResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateParticipantDIDResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateParticipantDIDResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRotateParticipantDIDResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateParticipantDIDResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRotateParticipantDIDResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDIDResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDIDResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDIDResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDIDResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDIDResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDIDResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgRotateParticipantDIDResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgRotateParticipantDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgRotateParticipantDIDResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRotateParticipantDIDResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRotateParticipantDIDResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateParticipantDIDResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateParticipantDIDResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateParticipantDIDResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateParticipantDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchRevokeParticipants_3_list)(nil)
+
+type _MsgBatchRevokeParticipants_3_list struct {
+	list *[]*MsgRevokeParticipant
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgRevokeParticipant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgRevokeParticipant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) AppendMutable() protoreflect.Value {
+	v := new(MsgRevokeParticipant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) NewElement() protoreflect.Value {
+	v := new(MsgRevokeParticipant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchRevokeParticipants_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchRevokeParticipants             protoreflect.MessageDescriptor
+	fd_MsgBatchRevokeParticipants_corporation protoreflect.FieldDescriptor
+	fd_MsgBatchRevokeParticipants_operator    protoreflect.FieldDescriptor
+	fd_MsgBatchRevokeParticipants_items       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgBatchRevokeParticipants = File_verana_pp_v1_tx_proto.Messages().ByName("MsgBatchRevokeParticipants")
+	fd_MsgBatchRevokeParticipants_corporation = md_MsgBatchRevokeParticipants.Fields().ByName("corporation")
+	fd_MsgBatchRevokeParticipants_operator = md_MsgBatchRevokeParticipants.Fields().ByName("operator")
+	fd_MsgBatchRevokeParticipants_items = md_MsgBatchRevokeParticipants.Fields().ByName("items")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchRevokeParticipants)(nil)
+
+type fastReflection_MsgBatchRevokeParticipants MsgBatchRevokeParticipants
+
+func (x *MsgBatchRevokeParticipants) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchRevokeParticipants)(x)
+}
+
+func (x *MsgBatchRevokeParticipants) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchRevokeParticipants_messageType fastReflection_MsgBatchRevokeParticipants_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchRevokeParticipants_messageType{}
+
+type fastReflection_MsgBatchRevokeParticipants_messageType struct{}
+
+func (x fastReflection_MsgBatchRevokeParticipants_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchRevokeParticipants)(nil)
+}
+func (x fastReflection_MsgBatchRevokeParticipants_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchRevokeParticipants)
+}
+func (x fastReflection_MsgBatchRevokeParticipants_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchRevokeParticipants
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchRevokeParticipants) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchRevokeParticipants
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchRevokeParticipants) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchRevokeParticipants_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchRevokeParticipants) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchRevokeParticipants)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchRevokeParticipants) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchRevokeParticipants)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchRevokeParticipants) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgBatchRevokeParticipants_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgBatchRevokeParticipants_operator, value) {
+			return
+		}
+	}
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchRevokeParticipants_3_list{list: &x.Items})
+		if !f(fd_MsgBatchRevokeParticipants_items, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchRevokeParticipants) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipants.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgBatchRevokeParticipants.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgBatchRevokeParticipants.items":
+		return len(x.Items) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipants) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipants.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgBatchRevokeParticipants.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgBatchRevokeParticipants.items":
+		x.Items = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchRevokeParticipants) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipants.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgBatchRevokeParticipants.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgBatchRevokeParticipants.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchRevokeParticipants_3_list{})
+		}
+		listValue := &_MsgBatchRevokeParticipants_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipants does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipants) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipants.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgBatchRevokeParticipants.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgBatchRevokeParticipants.items":
+		lv := value.List()
+		clv := lv.(*_MsgBatchRevokeParticipants_3_list)
+		x.Items = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipants) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipants.items":
+		if x.Items == nil {
+			x.Items = []*MsgRevokeParticipant{}
+		}
+		value := &_MsgBatchRevokeParticipants_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.MsgBatchRevokeParticipants.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgBatchRevokeParticipants is not mutable"))
+	case "verana.pp.v1.MsgBatchRevokeParticipants.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgBatchRevokeParticipants is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchRevokeParticipants) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipants.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgBatchRevokeParticipants.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgBatchRevokeParticipants.items":
+		list := []*MsgRevokeParticipant{}
+		return protoreflect.ValueOfList(&_MsgBatchRevokeParticipants_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchRevokeParticipants) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgBatchRevokeParticipants", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchRevokeParticipants) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipants) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchRevokeParticipants) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchRevokeParticipants) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchRevokeParticipants)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchRevokeParticipants)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchRevokeParticipants)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchRevokeParticipants: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchRevokeParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Items = append(x.Items, &MsgRevokeParticipant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchRevokeParticipantsResponse_1_list)(nil)
+
+type _MsgBatchRevokeParticipantsResponse_1_list struct {
+	list *[]*MsgRevokeParticipantResponse
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgRevokeParticipantResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgRevokeParticipantResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgRevokeParticipantResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MsgRevokeParticipantResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchRevokeParticipantsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchRevokeParticipantsResponse         protoreflect.MessageDescriptor
+	fd_MsgBatchRevokeParticipantsResponse_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgBatchRevokeParticipantsResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgBatchRevokeParticipantsResponse")
+	fd_MsgBatchRevokeParticipantsResponse_results = md_MsgBatchRevokeParticipantsResponse.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchRevokeParticipantsResponse)(nil)
+
+type fastReflection_MsgBatchRevokeParticipantsResponse MsgBatchRevokeParticipantsResponse
+
+func (x *MsgBatchRevokeParticipantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchRevokeParticipantsResponse)(x)
+}
+
+func (x *MsgBatchRevokeParticipantsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchRevokeParticipantsResponse_messageType fastReflection_MsgBatchRevokeParticipantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchRevokeParticipantsResponse_messageType{}
+
+type fastReflection_MsgBatchRevokeParticipantsResponse_messageType struct{}
+
+func (x fastReflection_MsgBatchRevokeParticipantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchRevokeParticipantsResponse)(nil)
+}
+func (x fastReflection_MsgBatchRevokeParticipantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchRevokeParticipantsResponse)
+}
+func (x fastReflection_MsgBatchRevokeParticipantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchRevokeParticipantsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchRevokeParticipantsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchRevokeParticipantsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchRevokeParticipantsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchRevokeParticipantsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchRevokeParticipantsResponse_1_list{list: &x.Results})
+		if !f(fd_MsgBatchRevokeParticipantsResponse_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipantsResponse.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipantsResponse.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipantsResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchRevokeParticipantsResponse_1_list{})
+		}
+		listValue := &_MsgBatchRevokeParticipantsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipantsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipantsResponse.results":
+		lv := value.List()
+		clv := lv.(*_MsgBatchRevokeParticipantsResponse_1_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipantsResponse.results":
+		if x.Results == nil {
+			x.Results = []*MsgRevokeParticipantResponse{}
+		}
+		value := &_MsgBatchRevokeParticipantsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchRevokeParticipantsResponse.results":
+		list := []*MsgRevokeParticipantResponse{}
+		return protoreflect.ValueOfList(&_MsgBatchRevokeParticipantsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchRevokeParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchRevokeParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgBatchRevokeParticipantsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchRevokeParticipantsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchRevokeParticipantsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchRevokeParticipantsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchRevokeParticipantsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchRevokeParticipantsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchRevokeParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &MsgRevokeParticipantResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchSetParticipantEffectiveUntil_3_list)(nil)
+
+type _MsgBatchSetParticipantEffectiveUntil_3_list struct {
+	list *[]*MsgSetParticipantEffectiveUntil
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSetParticipantEffectiveUntil)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSetParticipantEffectiveUntil)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) AppendMutable() protoreflect.Value {
+	v := new(MsgSetParticipantEffectiveUntil)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) NewElement() protoreflect.Value {
+	v := new(MsgSetParticipantEffectiveUntil)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntil_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchSetParticipantEffectiveUntil             protoreflect.MessageDescriptor
+	fd_MsgBatchSetParticipantEffectiveUntil_corporation protoreflect.FieldDescriptor
+	fd_MsgBatchSetParticipantEffectiveUntil_operator    protoreflect.FieldDescriptor
+	fd_MsgBatchSetParticipantEffectiveUntil_items       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgBatchSetParticipantEffectiveUntil = File_verana_pp_v1_tx_proto.Messages().ByName("MsgBatchSetParticipantEffectiveUntil")
+	fd_MsgBatchSetParticipantEffectiveUntil_corporation = md_MsgBatchSetParticipantEffectiveUntil.Fields().ByName("corporation")
+	fd_MsgBatchSetParticipantEffectiveUntil_operator = md_MsgBatchSetParticipantEffectiveUntil.Fields().ByName("operator")
+	fd_MsgBatchSetParticipantEffectiveUntil_items = md_MsgBatchSetParticipantEffectiveUntil.Fields().ByName("items")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchSetParticipantEffectiveUntil)(nil)
+
+type fastReflection_MsgBatchSetParticipantEffectiveUntil MsgBatchSetParticipantEffectiveUntil
+
+func (x *MsgBatchSetParticipantEffectiveUntil) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchSetParticipantEffectiveUntil)(x)
+}
+
+func (x *MsgBatchSetParticipantEffectiveUntil) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType{}
+
+type fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType struct{}
+
+func (x fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchSetParticipantEffectiveUntil)(nil)
+}
+func (x fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSetParticipantEffectiveUntil)
+}
+func (x fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSetParticipantEffectiveUntil
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSetParticipantEffectiveUntil
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchSetParticipantEffectiveUntil_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSetParticipantEffectiveUntil)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchSetParticipantEffectiveUntil)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgBatchSetParticipantEffectiveUntil_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgBatchSetParticipantEffectiveUntil_operator, value) {
+			return
+		}
+	}
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchSetParticipantEffectiveUntil_3_list{list: &x.Items})
+		if !f(fd_MsgBatchSetParticipantEffectiveUntil_items, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.items":
+		return len(x.Items) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.items":
+		x.Items = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchSetParticipantEffectiveUntil_3_list{})
+		}
+		listValue := &_MsgBatchSetParticipantEffectiveUntil_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.items":
+		lv := value.List()
+		clv := lv.(*_MsgBatchSetParticipantEffectiveUntil_3_list)
+		x.Items = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.items":
+		if x.Items == nil {
+			x.Items = []*MsgSetParticipantEffectiveUntil{}
+		}
+		value := &_MsgBatchSetParticipantEffectiveUntil_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil is not mutable"))
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.items":
+		list := []*MsgSetParticipantEffectiveUntil{}
+		return protoreflect.ValueOfList(&_MsgBatchSetParticipantEffectiveUntil_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntil does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgBatchSetParticipantEffectiveUntil", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntil) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchSetParticipantEffectiveUntil)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSetParticipantEffectiveUntil)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSetParticipantEffectiveUntil)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntil: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntil: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Items = append(x.Items, &MsgSetParticipantEffectiveUntil{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchSetParticipantEffectiveUntilResponse_1_list)(nil)
+
+type _MsgBatchSetParticipantEffectiveUntilResponse_1_list struct {
+	list *[]*MsgSetParticipantEffectiveUntilResponse
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSetParticipantEffectiveUntilResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSetParticipantEffectiveUntilResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgSetParticipantEffectiveUntilResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MsgSetParticipantEffectiveUntilResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSetParticipantEffectiveUntilResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchSetParticipantEffectiveUntilResponse         protoreflect.MessageDescriptor
+	fd_MsgBatchSetParticipantEffectiveUntilResponse_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgBatchSetParticipantEffectiveUntilResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgBatchSetParticipantEffectiveUntilResponse")
+	fd_MsgBatchSetParticipantEffectiveUntilResponse_results = md_MsgBatchSetParticipantEffectiveUntilResponse.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchSetParticipantEffectiveUntilResponse)(nil)
+
+type fastReflection_MsgBatchSetParticipantEffectiveUntilResponse MsgBatchSetParticipantEffectiveUntilResponse
+
+func (x *MsgBatchSetParticipantEffectiveUntilResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchSetParticipantEffectiveUntilResponse)(x)
+}
+
+func (x *MsgBatchSetParticipantEffectiveUntilResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType{}
+
+type fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType struct{}
+
+func (x fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchSetParticipantEffectiveUntilResponse)(nil)
+}
+func (x fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSetParticipantEffectiveUntilResponse)
+}
+func (x fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSetParticipantEffectiveUntilResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSetParticipantEffectiveUntilResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchSetParticipantEffectiveUntilResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSetParticipantEffectiveUntilResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchSetParticipantEffectiveUntilResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchSetParticipantEffectiveUntilResponse_1_list{list: &x.Results})
+		if !f(fd_MsgBatchSetParticipantEffectiveUntilResponse_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchSetParticipantEffectiveUntilResponse_1_list{})
+		}
+		listValue := &_MsgBatchSetParticipantEffectiveUntilResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse.results":
+		lv := value.List()
+		clv := lv.(*_MsgBatchSetParticipantEffectiveUntilResponse_1_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse.results":
+		if x.Results == nil {
+			x.Results = []*MsgSetParticipantEffectiveUntilResponse{}
+		}
+		value := &_MsgBatchSetParticipantEffectiveUntilResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse.results":
+		list := []*MsgSetParticipantEffectiveUntilResponse{}
+		return protoreflect.ValueOfList(&_MsgBatchSetParticipantEffectiveUntilResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchSetParticipantEffectiveUntilResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchSetParticipantEffectiveUntilResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSetParticipantEffectiveUntilResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSetParticipantEffectiveUntilResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntilResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntilResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &MsgSetParticipantEffectiveUntilResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchSelfCreateParticipants_3_list)(nil)
+
+type _MsgBatchSelfCreateParticipants_3_list struct {
+	list *[]*MsgSelfCreateParticipant
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSelfCreateParticipant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSelfCreateParticipant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) AppendMutable() protoreflect.Value {
+	v := new(MsgSelfCreateParticipant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) NewElement() protoreflect.Value {
+	v := new(MsgSelfCreateParticipant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSelfCreateParticipants_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchSelfCreateParticipants             protoreflect.MessageDescriptor
+	fd_MsgBatchSelfCreateParticipants_corporation protoreflect.FieldDescriptor
+	fd_MsgBatchSelfCreateParticipants_operator    protoreflect.FieldDescriptor
+	fd_MsgBatchSelfCreateParticipants_items       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgBatchSelfCreateParticipants = File_verana_pp_v1_tx_proto.Messages().ByName("MsgBatchSelfCreateParticipants")
+	fd_MsgBatchSelfCreateParticipants_corporation = md_MsgBatchSelfCreateParticipants.Fields().ByName("corporation")
+	fd_MsgBatchSelfCreateParticipants_operator = md_MsgBatchSelfCreateParticipants.Fields().ByName("operator")
+	fd_MsgBatchSelfCreateParticipants_items = md_MsgBatchSelfCreateParticipants.Fields().ByName("items")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchSelfCreateParticipants)(nil)
+
+type fastReflection_MsgBatchSelfCreateParticipants MsgBatchSelfCreateParticipants
+
+func (x *MsgBatchSelfCreateParticipants) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchSelfCreateParticipants)(x)
+}
+
+func (x *MsgBatchSelfCreateParticipants) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchSelfCreateParticipants_messageType fastReflection_MsgBatchSelfCreateParticipants_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchSelfCreateParticipants_messageType{}
+
+type fastReflection_MsgBatchSelfCreateParticipants_messageType struct{}
+
+func (x fastReflection_MsgBatchSelfCreateParticipants_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchSelfCreateParticipants)(nil)
+}
+func (x fastReflection_MsgBatchSelfCreateParticipants_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSelfCreateParticipants)
+}
+func (x fastReflection_MsgBatchSelfCreateParticipants_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSelfCreateParticipants
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSelfCreateParticipants
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchSelfCreateParticipants_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSelfCreateParticipants)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchSelfCreateParticipants)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_MsgBatchSelfCreateParticipants_corporation, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgBatchSelfCreateParticipants_operator, value) {
+			return
+		}
+	}
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchSelfCreateParticipants_3_list{list: &x.Items})
+		if !f(fd_MsgBatchSelfCreateParticipants_items, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.corporation":
+		return x.Corporation != ""
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.operator":
+		return x.Operator != ""
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.items":
+		return len(x.Items) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.corporation":
+		x.Corporation = ""
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.operator":
+		x.Operator = ""
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.items":
+		x.Items = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchSelfCreateParticipants_3_list{})
+		}
+		listValue := &_MsgBatchSelfCreateParticipants_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipants does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.operator":
+		x.Operator = value.Interface().(string)
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.items":
+		lv := value.List()
+		clv := lv.(*_MsgBatchSelfCreateParticipants_3_list)
+		x.Items = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.items":
+		if x.Items == nil {
+			x.Items = []*MsgSelfCreateParticipant{}
+		}
+		value := &_MsgBatchSelfCreateParticipants_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.corporation":
+		panic(fmt.Errorf("field corporation of message verana.pp.v1.MsgBatchSelfCreateParticipants is not mutable"))
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.operator":
+		panic(fmt.Errorf("field operator of message verana.pp.v1.MsgBatchSelfCreateParticipants is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.MsgBatchSelfCreateParticipants.items":
+		list := []*MsgSelfCreateParticipant{}
+		return protoreflect.ValueOfList(&_MsgBatchSelfCreateParticipants_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipants"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipants does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgBatchSelfCreateParticipants", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchSelfCreateParticipants) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchSelfCreateParticipants)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSelfCreateParticipants)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSelfCreateParticipants)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSelfCreateParticipants: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSelfCreateParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Items = append(x.Items, &MsgSelfCreateParticipant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgBatchSelfCreateParticipantsResponse_1_list)(nil)
+
+type _MsgBatchSelfCreateParticipantsResponse_1_list struct {
+	list *[]*MsgSelfCreateParticipantResponse
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSelfCreateParticipantResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgSelfCreateParticipantResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgSelfCreateParticipantResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MsgSelfCreateParticipantResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchSelfCreateParticipantsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgBatchSelfCreateParticipantsResponse         protoreflect.MessageDescriptor
+	fd_MsgBatchSelfCreateParticipantsResponse_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_tx_proto_init()
+	md_MsgBatchSelfCreateParticipantsResponse = File_verana_pp_v1_tx_proto.Messages().ByName("MsgBatchSelfCreateParticipantsResponse")
+	fd_MsgBatchSelfCreateParticipantsResponse_results = md_MsgBatchSelfCreateParticipantsResponse.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBatchSelfCreateParticipantsResponse)(nil)
+
+type fastReflection_MsgBatchSelfCreateParticipantsResponse MsgBatchSelfCreateParticipantsResponse
+
+func (x *MsgBatchSelfCreateParticipantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchSelfCreateParticipantsResponse)(x)
+}
+
+func (x *MsgBatchSelfCreateParticipantsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_tx_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType{}
+
+type fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType struct{}
+
+func (x fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchSelfCreateParticipantsResponse)(nil)
+}
+func (x fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSelfCreateParticipantsResponse)
+}
+func (x fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSelfCreateParticipantsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchSelfCreateParticipantsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchSelfCreateParticipantsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchSelfCreateParticipantsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchSelfCreateParticipantsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchSelfCreateParticipantsResponse_1_list{list: &x.Results})
+		if !f(fd_MsgBatchSelfCreateParticipantsResponse_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipantsResponse.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipantsResponse.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipantsResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchSelfCreateParticipantsResponse_1_list{})
+		}
+		listValue := &_MsgBatchSelfCreateParticipantsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipantsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipantsResponse.results":
+		lv := value.List()
+		clv := lv.(*_MsgBatchSelfCreateParticipantsResponse_1_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipantsResponse.results":
+		if x.Results == nil {
+			x.Results = []*MsgSelfCreateParticipantResponse{}
+		}
+		value := &_MsgBatchSelfCreateParticipantsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.MsgBatchSelfCreateParticipantsResponse.results":
+		list := []*MsgSelfCreateParticipantResponse{}
+		return protoreflect.ValueOfList(&_MsgBatchSelfCreateParticipantsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.MsgBatchSelfCreateParticipantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.MsgBatchSelfCreateParticipantsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBatchSelfCreateParticipantsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBatchSelfCreateParticipantsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSelfCreateParticipantsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBatchSelfCreateParticipantsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSelfCreateParticipantsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchSelfCreateParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &MsgSelfCreateParticipantResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: verana/pp/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// MsgStartParticipantOP represents a message to start a participant onboarding process
+type MsgStartParticipantOP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation is the group account on whose behalf this message is executed
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run this Msg
+	Operator               string          `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Role                   ParticipantRole `protobuf:"varint,3,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
+	ValidatorParticipantId uint64          `protobuf:"varint,4,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
+	Did                    string          `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"` // mandatory: MUST conform to DID Syntax
+	ValidationFees         *OptionalUInt64 `protobuf:"bytes,6,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees           *OptionalUInt64 `protobuf:"bytes,7,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees       *OptionalUInt64 `protobuf:"bytes,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	// vs_operator: the account of the Verifiable Service (optional)
+	VsOperator                   string               `protobuf:"bytes,9,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
+	VsOperatorAuthzMsgTypes      []string             `protobuf:"bytes,15,rep,name=vs_operator_authz_msg_types,json=vsOperatorAuthzMsgTypes,proto3" json:"vs_operator_authz_msg_types,omitempty"`
+	VsOperatorAuthzSpendLimit    []*v1beta1.Coin      `protobuf:"bytes,11,rep,name=vs_operator_authz_spend_limit,json=vsOperatorAuthzSpendLimit,proto3" json:"vs_operator_authz_spend_limit,omitempty"`
+	VsOperatorAuthzWithFeegrant  bool                 `protobuf:"varint,12,opt,name=vs_operator_authz_with_feegrant,json=vsOperatorAuthzWithFeegrant,proto3" json:"vs_operator_authz_with_feegrant,omitempty"`
+	VsOperatorAuthzFeeSpendLimit []*v1beta1.Coin      `protobuf:"bytes,13,rep,name=vs_operator_authz_fee_spend_limit,json=vsOperatorAuthzFeeSpendLimit,proto3" json:"vs_operator_authz_fee_spend_limit,omitempty"`
+	VsOperatorAuthzPeriod        *durationpb.Duration `protobuf:"bytes,14,opt,name=vs_operator_authz_period,json=vsOperatorAuthzPeriod,proto3" json:"vs_operator_authz_period,omitempty"`
+}
+
+func (x *MsgStartParticipantOP) Reset() {
+	*x = MsgStartParticipantOP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgStartParticipantOP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgStartParticipantOP) ProtoMessage() {}
+
+// Deprecated: Use MsgStartParticipantOP.ProtoReflect.Descriptor instead.
+func (*MsgStartParticipantOP) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgStartParticipantOP) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgStartParticipantOP) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgStartParticipantOP) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_UNSPECIFIED
+}
+
+func (x *MsgStartParticipantOP) GetValidatorParticipantId() uint64 {
+	if x != nil {
+		return x.ValidatorParticipantId
+	}
+	return 0
+}
+
+func (x *MsgStartParticipantOP) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgStartParticipantOP) GetValidationFees() *OptionalUInt64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return nil
+}
+
+func (x *MsgStartParticipantOP) GetIssuanceFees() *OptionalUInt64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return nil
+}
+
+func (x *MsgStartParticipantOP) GetVerificationFees() *OptionalUInt64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return nil
+}
+
+func (x *MsgStartParticipantOP) GetVsOperator() string {
+	if x != nil {
+		return x.VsOperator
+	}
+	return ""
+}
+
+func (x *MsgStartParticipantOP) GetVsOperatorAuthzMsgTypes() []string {
+	if x != nil {
+		return x.VsOperatorAuthzMsgTypes
+	}
+	return nil
+}
+
+func (x *MsgStartParticipantOP) GetVsOperatorAuthzSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.VsOperatorAuthzSpendLimit
+	}
+	return nil
+}
+
+func (x *MsgStartParticipantOP) GetVsOperatorAuthzWithFeegrant() bool {
+	if x != nil {
+		return x.VsOperatorAuthzWithFeegrant
+	}
+	return false
+}
+
+func (x *MsgStartParticipantOP) GetVsOperatorAuthzFeeSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.VsOperatorAuthzFeeSpendLimit
+	}
+	return nil
+}
+
+func (x *MsgStartParticipantOP) GetVsOperatorAuthzPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.VsOperatorAuthzPeriod
+	}
+	return nil
+}
+
+// MsgStartParticipantOPResponse defines the Msg/StartParticipantOP response type
+type MsgStartParticipantOPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParticipantId uint64 `protobuf:"varint,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+}
+
+func (x *MsgStartParticipantOPResponse) Reset() {
+	*x = MsgStartParticipantOPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgStartParticipantOPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgStartParticipantOPResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgStartParticipantOPResponse.ProtoReflect.Descriptor instead.
+func (*MsgStartParticipantOPResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgStartParticipantOPResponse) GetParticipantId() uint64 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+// MsgRenewParticipantOP represents a message to renew a participant onboarding process
+type MsgRenewParticipantOP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// [MOD-PP-MSG-2-1] Spec v4 parameters: corporation, operator, id.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // ID of the participant to renew
+}
+
+func (x *MsgRenewParticipantOP) Reset() {
+	*x = MsgRenewParticipantOP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRenewParticipantOP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRenewParticipantOP) ProtoMessage() {}
+
+// Deprecated: Use MsgRenewParticipantOP.ProtoReflect.Descriptor instead.
+func (*MsgRenewParticipantOP) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgRenewParticipantOP) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgRenewParticipantOP) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgRenewParticipantOP) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// MsgRenewParticipantOPResponse defines the Msg/RenewParticipantOP response type
+type MsgRenewParticipantOPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRenewParticipantOPResponse) Reset() {
+	*x = MsgRenewParticipantOPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRenewParticipantOPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRenewParticipantOPResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRenewParticipantOPResponse.ProtoReflect.Descriptor instead.
+func (*MsgRenewParticipantOPResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgSetParticipantOPToValidated represents a message to set a participant onboarding process to validated state
+type MsgSetParticipantOPToValidated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation is the group account on whose behalf this message is executed
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run this Msg
+	Operator         string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id               uint64                 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	ValidationFees   uint64                 `protobuf:"varint,5,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64                 `protobuf:"varint,6,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64                 `protobuf:"varint,7,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	OpSummaryDigest  string                 `protobuf:"bytes,8,opt,name=op_summary_digest,json=opSummaryDigest,proto3" json:"op_summary_digest,omitempty"`
+	// Fee discount fields (scaled: 0 = 0.0, 10000 = 1.0, range 0-10000)
+	// Default to 0 (no discount), maximum 10000 (100% discount)
+	IssuanceFeeDiscount     uint64 `protobuf:"varint,9,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`              // Issuance fee discount (mandatory, default 0)
+	VerificationFeeDiscount uint64 `protobuf:"varint,10,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"` // Verification fee discount (mandatory, default 0)
+}
+
+func (x *MsgSetParticipantOPToValidated) Reset() {
+	*x = MsgSetParticipantOPToValidated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetParticipantOPToValidated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetParticipantOPToValidated) ProtoMessage() {}
+
+// Deprecated: Use MsgSetParticipantOPToValidated.ProtoReflect.Descriptor instead.
+func (*MsgSetParticipantOPToValidated) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgSetParticipantOPToValidated) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgSetParticipantOPToValidated) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgSetParticipantOPToValidated) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSetParticipantOPToValidated) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *MsgSetParticipantOPToValidated) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *MsgSetParticipantOPToValidated) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *MsgSetParticipantOPToValidated) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+func (x *MsgSetParticipantOPToValidated) GetOpSummaryDigest() string {
+	if x != nil {
+		return x.OpSummaryDigest
+	}
+	return ""
+}
+
+func (x *MsgSetParticipantOPToValidated) GetIssuanceFeeDiscount() uint64 {
+	if x != nil {
+		return x.IssuanceFeeDiscount
+	}
+	return 0
+}
+
+func (x *MsgSetParticipantOPToValidated) GetVerificationFeeDiscount() uint64 {
+	if x != nil {
+		return x.VerificationFeeDiscount
+	}
+	return 0
+}
+
+// MsgSetParticipantOPToValidatedResponse defines the Msg/SetParticipantOPToValidated response type
+type MsgSetParticipantOPToValidatedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetParticipantOPToValidatedResponse) Reset() {
+	*x = MsgSetParticipantOPToValidatedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetParticipantOPToValidatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetParticipantOPToValidatedResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetParticipantOPToValidatedResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetParticipantOPToValidatedResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+type MsgCancelParticipantOPLastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation is the group account on whose behalf this message is executed
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run this Msg
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id       uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+}
+
+func (x *MsgCancelParticipantOPLastRequest) Reset() {
+	*x = MsgCancelParticipantOPLastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelParticipantOPLastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelParticipantOPLastRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelParticipantOPLastRequest.ProtoReflect.Descriptor instead.
+func (*MsgCancelParticipantOPLastRequest) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgCancelParticipantOPLastRequest) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgCancelParticipantOPLastRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgCancelParticipantOPLastRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgCancelParticipantOPLastRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCancelParticipantOPLastRequestResponse) Reset() {
+	*x = MsgCancelParticipantOPLastRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelParticipantOPLastRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelParticipantOPLastRequestResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelParticipantOPLastRequestResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelParticipantOPLastRequestResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+type MsgCreateRootParticipant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// [MOD-PP-MSG-7-1] Spec v4-rc2 parameters.
+	// participant.role is hardcoded to ECOSYSTEM by the handler per [MOD-PP-MSG-7-3].
+	Corporation      string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator         string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	SchemaId         uint64                 `protobuf:"varint,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Did              string                 `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	ValidationFees   uint64                 `protobuf:"varint,7,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64                 `protobuf:"varint,8,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64                 `protobuf:"varint,9,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	// vs_operator: the account of the Verifiable Service (optional, spec v4-rc2).
+	VsOperator string `protobuf:"bytes,10,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
+	// vs_operator_authz_msg_types: presence triggers VSOA record creation; MUST be a
+	// subset of [SetParticipantOPToValidated] for root participants.
+	VsOperatorAuthzMsgTypes      []string             `protobuf:"bytes,11,rep,name=vs_operator_authz_msg_types,json=vsOperatorAuthzMsgTypes,proto3" json:"vs_operator_authz_msg_types,omitempty"`
+	VsOperatorAuthzSpendLimit    []*v1beta1.Coin      `protobuf:"bytes,12,rep,name=vs_operator_authz_spend_limit,json=vsOperatorAuthzSpendLimit,proto3" json:"vs_operator_authz_spend_limit,omitempty"`
+	VsOperatorAuthzWithFeegrant  bool                 `protobuf:"varint,13,opt,name=vs_operator_authz_with_feegrant,json=vsOperatorAuthzWithFeegrant,proto3" json:"vs_operator_authz_with_feegrant,omitempty"`
+	VsOperatorAuthzFeeSpendLimit []*v1beta1.Coin      `protobuf:"bytes,14,rep,name=vs_operator_authz_fee_spend_limit,json=vsOperatorAuthzFeeSpendLimit,proto3" json:"vs_operator_authz_fee_spend_limit,omitempty"`
+	VsOperatorAuthzPeriod        *durationpb.Duration `protobuf:"bytes,15,opt,name=vs_operator_authz_period,json=vsOperatorAuthzPeriod,proto3" json:"vs_operator_authz_period,omitempty"`
+}
+
+func (x *MsgCreateRootParticipant) Reset() {
+	*x = MsgCreateRootParticipant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateRootParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateRootParticipant) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateRootParticipant.ProtoReflect.Descriptor instead.
+func (*MsgCreateRootParticipant) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgCreateRootParticipant) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgCreateRootParticipant) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgCreateRootParticipant) GetSchemaId() uint64 {
+	if x != nil {
+		return x.SchemaId
+	}
+	return 0
+}
+
+func (x *MsgCreateRootParticipant) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgCreateRootParticipant) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *MsgCreateRootParticipant) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *MsgCreateRootParticipant) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *MsgCreateRootParticipant) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *MsgCreateRootParticipant) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+func (x *MsgCreateRootParticipant) GetVsOperator() string {
+	if x != nil {
+		return x.VsOperator
+	}
+	return ""
+}
+
+func (x *MsgCreateRootParticipant) GetVsOperatorAuthzMsgTypes() []string {
+	if x != nil {
+		return x.VsOperatorAuthzMsgTypes
+	}
+	return nil
+}
+
+func (x *MsgCreateRootParticipant) GetVsOperatorAuthzSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.VsOperatorAuthzSpendLimit
+	}
+	return nil
+}
+
+func (x *MsgCreateRootParticipant) GetVsOperatorAuthzWithFeegrant() bool {
+	if x != nil {
+		return x.VsOperatorAuthzWithFeegrant
+	}
+	return false
+}
+
+func (x *MsgCreateRootParticipant) GetVsOperatorAuthzFeeSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.VsOperatorAuthzFeeSpendLimit
+	}
+	return nil
+}
+
+func (x *MsgCreateRootParticipant) GetVsOperatorAuthzPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.VsOperatorAuthzPeriod
+	}
+	return nil
+}
+
+type MsgCreateRootParticipantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created participant
+}
+
+func (x *MsgCreateRootParticipantResponse) Reset() {
+	*x = MsgCreateRootParticipantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateRootParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateRootParticipantResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateRootParticipantResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateRootParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MsgCreateRootParticipantResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgSetParticipantEffectiveUntil struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Corporation    string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator       string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id             uint64                 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	EffectiveUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+}
+
+func (x *MsgSetParticipantEffectiveUntil) Reset() {
+	*x = MsgSetParticipantEffectiveUntil{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetParticipantEffectiveUntil) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetParticipantEffectiveUntil) ProtoMessage() {}
+
+// Deprecated: Use MsgSetParticipantEffectiveUntil.ProtoReflect.Descriptor instead.
+func (*MsgSetParticipantEffectiveUntil) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgSetParticipantEffectiveUntil) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgSetParticipantEffectiveUntil) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgSetParticipantEffectiveUntil) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSetParticipantEffectiveUntil) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+type MsgSetParticipantEffectiveUntilResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetParticipantEffectiveUntilResponse) Reset() {
+	*x = MsgSetParticipantEffectiveUntilResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetParticipantEffectiveUntilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetParticipantEffectiveUntilResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetParticipantEffectiveUntilResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetParticipantEffectiveUntilResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+type MsgRevokeParticipant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Corporation string           `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string           `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64           `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	Reason      RevocationReason `protobuf:"varint,4,opt,name=reason,proto3,enum=verana.pp.v1.RevocationReason" json:"reason,omitempty"`
+	// evidence is an optional free-text justification
+	Evidence string `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// evidence_digest is an optional SRI digest of off-chain evidence
+	EvidenceDigest string `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+	// cascade optionally revokes or suspends the descendants of the
+	// participant, in batches over the following blocks
+	Cascade CascadeMode `protobuf:"varint,7,opt,name=cascade,proto3,enum=verana.pp.v1.CascadeMode" json:"cascade,omitempty"`
+}
+
+func (x *MsgRevokeParticipant) Reset() {
+	*x = MsgRevokeParticipant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevokeParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevokeParticipant) ProtoMessage() {}
+
+// Deprecated: Use MsgRevokeParticipant.ProtoReflect.Descriptor instead.
+func (*MsgRevokeParticipant) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgRevokeParticipant) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgRevokeParticipant) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgRevokeParticipant) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgRevokeParticipant) GetReason() RevocationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
+
+func (x *MsgRevokeParticipant) GetEvidence() string {
+	if x != nil {
+		return x.Evidence
+	}
+	return ""
+}
+
+func (x *MsgRevokeParticipant) GetEvidenceDigest() string {
+	if x != nil {
+		return x.EvidenceDigest
+	}
+	return ""
+}
+
+func (x *MsgRevokeParticipant) GetCascade() CascadeMode {
+	if x != nil {
+		return x.Cascade
+	}
+	return CascadeMode_CASCADE_MODE_UNSPECIFIED
+}
+
+type MsgRevokeParticipantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cascade_id is the id of the started cascade, 0 if none
+	CascadeId uint64 `protobuf:"varint,1,opt,name=cascade_id,json=cascadeId,proto3" json:"cascade_id,omitempty"`
+}
+
+func (x *MsgRevokeParticipantResponse) Reset() {
+	*x = MsgRevokeParticipantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevokeParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevokeParticipantResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRevokeParticipantResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevokeParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgRevokeParticipantResponse) GetCascadeId() uint64 {
+	if x != nil {
+		return x.CascadeId
+	}
+	return 0
+}
+
+type MsgCreateOrUpdateParticipantSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation is the group account on whose behalf this message is executed
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run this Msg (vs_operator)
+	Operator                 string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id                       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`                                                                                  // UUID (mandatory)
+	IssuerParticipantId      uint64 `protobuf:"varint,4,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`                  // optional: issuer participant id
+	VerifierParticipantId    uint64 `protobuf:"varint,5,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`            // optional: verifier participant id
+	AgentParticipantId       uint64 `protobuf:"varint,6,opt,name=agent_participant_id,json=agentParticipantId,proto3" json:"agent_participant_id,omitempty"`                     // mandatory: agent credential issuer participant id
+	WalletAgentParticipantId uint64 `protobuf:"varint,7,opt,name=wallet_agent_participant_id,json=walletAgentParticipantId,proto3" json:"wallet_agent_participant_id,omitempty"` // mandatory: wallet credential issuer participant id
+	Digest                   string `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`                                                                          // optional: digest derived from an issued or verified credential
+}
+
+func (x *MsgCreateOrUpdateParticipantSession) Reset() {
+	*x = MsgCreateOrUpdateParticipantSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateOrUpdateParticipantSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateOrUpdateParticipantSession) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateOrUpdateParticipantSession.ProtoReflect.Descriptor instead.
+func (*MsgCreateOrUpdateParticipantSession) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgCreateOrUpdateParticipantSession) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
 	return ""
 }
 
-func (x *MsgStartParticipantOP) GetVsOperatorAuthzMsgTypes() []string {
+func (x *MsgCreateOrUpdateParticipantSession) GetOperator() string {
 	if x != nil {
-		return x.VsOperatorAuthzMsgTypes
+		return x.Operator
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgStartParticipantOP) GetVsOperatorAuthzSpendLimit() []*v1beta1.Coin {
+func (x *MsgCreateOrUpdateParticipantSession) GetId() string {
 	if x != nil {
-		return x.VsOperatorAuthzSpendLimit
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgStartParticipantOP) GetVsOperatorAuthzWithFeegrant() bool {
+func (x *MsgCreateOrUpdateParticipantSession) GetIssuerParticipantId() uint64 {
 	if x != nil {
-		return x.VsOperatorAuthzWithFeegrant
+		return x.IssuerParticipantId
 	}
-	return false
+	return 0
 }
 
-func (x *MsgStartParticipantOP) GetVsOperatorAuthzFeeSpendLimit() []*v1beta1.Coin {
+func (x *MsgCreateOrUpdateParticipantSession) GetVerifierParticipantId() uint64 {
 	if x != nil {
-		return x.VsOperatorAuthzFeeSpendLimit
+		return x.VerifierParticipantId
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgStartParticipantOP) GetVsOperatorAuthzPeriod() *durationpb.Duration {
+func (x *MsgCreateOrUpdateParticipantSession) GetAgentParticipantId() uint64 {
 	if x != nil {
-		return x.VsOperatorAuthzPeriod
+		return x.AgentParticipantId
 	}
-	return nil
+	return 0
 }
 
-// MsgStartParticipantOPResponse defines the Msg/StartParticipantOP response type
-type MsgStartParticipantOPResponse struct {
+func (x *MsgCreateOrUpdateParticipantSession) GetWalletAgentParticipantId() uint64 {
+	if x != nil {
+		return x.WalletAgentParticipantId
+	}
+	return 0
+}
+
+func (x *MsgCreateOrUpdateParticipantSession) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type MsgCreateOrUpdateParticipantSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParticipantId uint64 `protobuf:"varint,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgStartParticipantOPResponse) Reset() {
-	*x = MsgStartParticipantOPResponse{}
+func (x *MsgCreateOrUpdateParticipantSessionResponse) Reset() {
+	*x = MsgCreateOrUpdateParticipantSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[3]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgStartParticipantOPResponse) String() string {
+func (x *MsgCreateOrUpdateParticipantSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgStartParticipantOPResponse) ProtoMessage() {}
+func (*MsgCreateOrUpdateParticipantSessionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgStartParticipantOPResponse.ProtoReflect.Descriptor instead.
-func (*MsgStartParticipantOPResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgCreateOrUpdateParticipantSessionResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateOrUpdateParticipantSessionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgStartParticipantOPResponse) GetParticipantId() uint64 {
+func (x *MsgCreateOrUpdateParticipantSessionResponse) GetId() string {
 	if x != nil {
-		return x.ParticipantId
+		return x.Id
+	}
+	return ""
+}
+
+type MsgSlashParticipantTrustDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation is the group account on whose behalf this message is executed
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run this Msg
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id       uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Amount   uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// [MOD-PP-MSG-12-1] reason for the slash (mandatory per spec v4)
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// evidence_digest is the SRI digest of the slash evidence. It MUST have
+	// been anchored in x/di before the slash.
+	EvidenceDigest string `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+}
+
+func (x *MsgSlashParticipantTrustDeposit) Reset() {
+	*x = MsgSlashParticipantTrustDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSlashParticipantTrustDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSlashParticipantTrustDeposit) ProtoMessage() {}
+
+// Deprecated: Use MsgSlashParticipantTrustDeposit.ProtoReflect.Descriptor instead.
+func (*MsgSlashParticipantTrustDeposit) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgSlashParticipantTrustDeposit) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *MsgSlashParticipantTrustDeposit) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgSlashParticipantTrustDeposit) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSlashParticipantTrustDeposit) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MsgSlashParticipantTrustDeposit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MsgSlashParticipantTrustDeposit) GetEvidenceDigest() string {
+	if x != nil {
+		return x.EvidenceDigest
+	}
+	return ""
+}
+
+type MsgSlashParticipantTrustDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlashId uint64 `protobuf:"varint,1,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	// dispute_deadline is the end of the window during which the slash can be
+	// appealed. The slash is final when it equals the slash time.
+	DisputeDeadline *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=dispute_deadline,json=disputeDeadline,proto3" json:"dispute_deadline,omitempty"`
+}
+
+func (x *MsgSlashParticipantTrustDepositResponse) Reset() {
+	*x = MsgSlashParticipantTrustDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSlashParticipantTrustDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSlashParticipantTrustDepositResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSlashParticipantTrustDepositResponse.ProtoReflect.Descriptor instead.
+func (*MsgSlashParticipantTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgSlashParticipantTrustDepositResponse) GetSlashId() uint64 {
+	if x != nil {
+		return x.SlashId
+	}
+	return 0
+}
+
+func (x *MsgSlashParticipantTrustDepositResponse) GetDisputeDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisputeDeadline
 	}
-	return 0
+	return nil
 }
 
-// MsgRenewParticipantOP represents a message to renew a participant onboarding process
-type MsgRenewParticipantOP struct {
+type MsgRepayParticipantSlashedTrustDeposit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// [MOD-PP-MSG-2-1] Spec v4 parameters: corporation, operator, id.
 	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
 	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // ID of the participant to renew
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Amount      uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgRenewParticipantOP) Reset() {
-	*x = MsgRenewParticipantOP{}
+func (x *MsgRepayParticipantSlashedTrustDeposit) Reset() {
+	*x = MsgRepayParticipantSlashedTrustDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[4]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRenewParticipantOP) String() string {
+func (x *MsgRepayParticipantSlashedTrustDeposit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRenewParticipantOP) ProtoMessage() {}
+func (*MsgRepayParticipantSlashedTrustDeposit) ProtoMessage() {}
 
-// Deprecated: Use MsgRenewParticipantOP.ProtoReflect.Descriptor instead.
-func (*MsgRenewParticipantOP) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgRepayParticipantSlashedTrustDeposit.ProtoReflect.Descriptor instead.
+func (*MsgRepayParticipantSlashedTrustDeposit) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgRenewParticipantOP) GetCorporation() string {
+func (x *MsgRepayParticipantSlashedTrustDeposit) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgRenewParticipantOP) GetOperator() string {
+func (x *MsgRepayParticipantSlashedTrustDeposit) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgRenewParticipantOP) GetId() uint64 {
+func (x *MsgRepayParticipantSlashedTrustDeposit) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// MsgRenewParticipantOPResponse defines the Msg/RenewParticipantOP response type
-type MsgRenewParticipantOPResponse struct {
+func (x *MsgRepayParticipantSlashedTrustDeposit) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type MsgRepayParticipantSlashedTrustDepositResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRenewParticipantOPResponse) Reset() {
-	*x = MsgRenewParticipantOPResponse{}
+func (x *MsgRepayParticipantSlashedTrustDepositResponse) Reset() {
+	*x = MsgRepayParticipantSlashedTrustDepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[5]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRenewParticipantOPResponse) String() string {
+func (x *MsgRepayParticipantSlashedTrustDepositResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRenewParticipantOPResponse) ProtoMessage() {}
+func (*MsgRepayParticipantSlashedTrustDepositResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRenewParticipantOPResponse.ProtoReflect.Descriptor instead.
-func (*MsgRenewParticipantOPResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgRepayParticipantSlashedTrustDepositResponse.ProtoReflect.Descriptor instead.
+func (*MsgRepayParticipantSlashedTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
-// MsgSetParticipantOPToValidated represents a message to set a participant onboarding process to validated state
-type MsgSetParticipantOPToValidated struct {
+type MsgSelfCreateParticipant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// corporation is the group account on whose behalf this message is executed
-	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	// operator is the account authorized by the corporation to run this Msg
-	Operator         string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id               uint64                 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	ValidationFees   uint64                 `protobuf:"varint,5,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	IssuanceFees     uint64                 `protobuf:"varint,6,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
-	VerificationFees uint64                 `protobuf:"varint,7,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	OpSummaryDigest  string                 `protobuf:"bytes,8,opt,name=op_summary_digest,json=opSummaryDigest,proto3" json:"op_summary_digest,omitempty"`
-	// Fee discount fields (scaled: 0 = 0.0, 10000 = 1.0, range 0-10000)
-	// Default to 0 (no discount), maximum 10000 (100% discount)
-	IssuanceFeeDiscount     uint64 `protobuf:"varint,9,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`              // Issuance fee discount (mandatory, default 0)
-	VerificationFeeDiscount uint64 `protobuf:"varint,10,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"` // Verification fee discount (mandatory, default 0)
+	Corporation            string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator               string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Role                   ParticipantRole        `protobuf:"varint,3,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
+	ValidatorParticipantId uint64                 `protobuf:"varint,4,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
+	Did                    string                 `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"`
+	EffectiveFrom          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveUntil         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	VerificationFees       uint64                 `protobuf:"varint,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	ValidationFees         uint64                 `protobuf:"varint,9,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	// vs_operator: the account of the Verifiable Service (optional)
+	VsOperator                   string               `protobuf:"bytes,10,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
+	VsOperatorAuthzMsgTypes      []string             `protobuf:"bytes,16,rep,name=vs_operator_authz_msg_types,json=vsOperatorAuthzMsgTypes,proto3" json:"vs_operator_authz_msg_types,omitempty"`
+	VsOperatorAuthzSpendLimit    []*v1beta1.Coin      `protobuf:"bytes,12,rep,name=vs_operator_authz_spend_limit,json=vsOperatorAuthzSpendLimit,proto3" json:"vs_operator_authz_spend_limit,omitempty"`
+	VsOperatorAuthzWithFeegrant  bool                 `protobuf:"varint,13,opt,name=vs_operator_authz_with_feegrant,json=vsOperatorAuthzWithFeegrant,proto3" json:"vs_operator_authz_with_feegrant,omitempty"`
+	VsOperatorAuthzFeeSpendLimit []*v1beta1.Coin      `protobuf:"bytes,14,rep,name=vs_operator_authz_fee_spend_limit,json=vsOperatorAuthzFeeSpendLimit,proto3" json:"vs_operator_authz_fee_spend_limit,omitempty"`
+	VsOperatorAuthzPeriod        *durationpb.Duration `protobuf:"bytes,15,opt,name=vs_operator_authz_period,json=vsOperatorAuthzPeriod,proto3" json:"vs_operator_authz_period,omitempty"`
 }
 
-func (x *MsgSetParticipantOPToValidated) Reset() {
-	*x = MsgSetParticipantOPToValidated{}
+func (x *MsgSelfCreateParticipant) Reset() {
+	*x = MsgSelfCreateParticipant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[6]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetParticipantOPToValidated) String() string {
+func (x *MsgSelfCreateParticipant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetParticipantOPToValidated) ProtoMessage() {}
+func (*MsgSelfCreateParticipant) ProtoMessage() {}
 
-// Deprecated: Use MsgSetParticipantOPToValidated.ProtoReflect.Descriptor instead.
-func (*MsgSetParticipantOPToValidated) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgSelfCreateParticipant.ProtoReflect.Descriptor instead.
+func (*MsgSelfCreateParticipant) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgSetParticipantOPToValidated) GetCorporation() string {
+func (x *MsgSelfCreateParticipant) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgSetParticipantOPToValidated) GetOperator() string {
+func (x *MsgSelfCreateParticipant) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgSetParticipantOPToValidated) GetId() uint64 {
+func (x *MsgSelfCreateParticipant) GetRole() ParticipantRole {
 	if x != nil {
-		return x.Id
+		return x.Role
+	}
+	return ParticipantRole_UNSPECIFIED
+}
+
+func (x *MsgSelfCreateParticipant) GetValidatorParticipantId() uint64 {
+	if x != nil {
+		return x.ValidatorParticipantId
 	}
 	return 0
 }
 
-func (x *MsgSetParticipantOPToValidated) GetEffectiveUntil() *timestamppb.Timestamp {
+func (x *MsgSelfCreateParticipant) GetDid() string {
 	if x != nil {
-		return x.EffectiveUntil
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgSelfCreateParticipant) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
 	}
 	return nil
 }
 
-func (x *MsgSetParticipantOPToValidated) GetValidationFees() uint64 {
+func (x *MsgSelfCreateParticipant) GetEffectiveUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ValidationFees
+		return x.EffectiveUntil
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgSetParticipantOPToValidated) GetIssuanceFees() uint64 {
+func (x *MsgSelfCreateParticipant) GetVerificationFees() uint64 {
 	if x != nil {
-		return x.IssuanceFees
+		return x.VerificationFees
 	}
 	return 0
 }
 
-func (x *MsgSetParticipantOPToValidated) GetVerificationFees() uint64 {
+func (x *MsgSelfCreateParticipant) GetValidationFees() uint64 {
 	if x != nil {
-		return x.VerificationFees
+		return x.ValidationFees
 	}
 	return 0
 }
 
-func (x *MsgSetParticipantOPToValidated) GetOpSummaryDigest() string {
+func (x *MsgSelfCreateParticipant) GetVsOperator() string {
 	if x != nil {
-		return x.OpSummaryDigest
+		return x.VsOperator
 	}
 	return ""
 }
 
-func (x *MsgSetParticipantOPToValidated) GetIssuanceFeeDiscount() uint64 {
+func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzMsgTypes() []string {
 	if x != nil {
-		return x.IssuanceFeeDiscount
+		return x.VsOperatorAuthzMsgTypes
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgSetParticipantOPToValidated) GetVerificationFeeDiscount() uint64 {
+func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzSpendLimit() []*v1beta1.Coin {
 	if x != nil {
-		return x.VerificationFeeDiscount
+		return x.VsOperatorAuthzSpendLimit
 	}
-	return 0
+	return nil
 }
 
-// MsgSetParticipantOPToValidatedResponse defines the Msg/SetParticipantOPToValidated response type
-type MsgSetParticipantOPToValidatedResponse struct {
+func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzWithFeegrant() bool {
+	if x != nil {
+		return x.VsOperatorAuthzWithFeegrant
+	}
+	return false
+}
+
+func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzFeeSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.VsOperatorAuthzFeeSpendLimit
+	}
+	return nil
+}
+
+func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.VsOperatorAuthzPeriod
+	}
+	return nil
+}
+
+type MsgSelfCreateParticipantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgSetParticipantOPToValidatedResponse) Reset() {
-	*x = MsgSetParticipantOPToValidatedResponse{}
+func (x *MsgSelfCreateParticipantResponse) Reset() {
+	*x = MsgSelfCreateParticipantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[7]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetParticipantOPToValidatedResponse) String() string {
+func (x *MsgSelfCreateParticipantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetParticipantOPToValidatedResponse) ProtoMessage() {}
+func (*MsgSelfCreateParticipantResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetParticipantOPToValidatedResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetParticipantOPToValidatedResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgSelfCreateParticipantResponse.ProtoReflect.Descriptor instead.
+func (*MsgSelfCreateParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-type MsgCancelParticipantOPLastRequest struct {
+func (x *MsgSelfCreateParticipantResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgProposeParticipantFeeChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// corporation is the group account on whose behalf this message is executed
-	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	// operator is the account authorized by the corporation to run this Msg
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id       uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	// corporation is the validator participant corporation (or the ecosystem
+	// controller for participants without validator)
+	Corporation             string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator                string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id                      uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	ValidationFees          uint64 `protobuf:"varint,4,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees            uint64 `protobuf:"varint,5,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees        uint64 `protobuf:"varint,6,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	IssuanceFeeDiscount     uint64 `protobuf:"varint,7,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`
+	VerificationFeeDiscount uint64 `protobuf:"varint,8,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"`
 }
 
-func (x *MsgCancelParticipantOPLastRequest) Reset() {
-	*x = MsgCancelParticipantOPLastRequest{}
+func (x *MsgProposeParticipantFeeChange) Reset() {
+	*x = MsgProposeParticipantFeeChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[8]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelParticipantOPLastRequest) String() string {
+func (x *MsgProposeParticipantFeeChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelParticipantOPLastRequest) ProtoMessage() {}
+func (*MsgProposeParticipantFeeChange) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelParticipantOPLastRequest.ProtoReflect.Descriptor instead.
-func (*MsgCancelParticipantOPLastRequest) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgProposeParticipantFeeChange.ProtoReflect.Descriptor instead.
+func (*MsgProposeParticipantFeeChange) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgCancelParticipantOPLastRequest) GetCorporation() string {
+func (x *MsgProposeParticipantFeeChange) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgCancelParticipantOPLastRequest) GetOperator() string {
+func (x *MsgProposeParticipantFeeChange) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgCancelParticipantOPLastRequest) GetId() uint64 {
+func (x *MsgProposeParticipantFeeChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgProposeParticipantFeeChange) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *MsgProposeParticipantFeeChange) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *MsgProposeParticipantFeeChange) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+func (x *MsgProposeParticipantFeeChange) GetIssuanceFeeDiscount() uint64 {
+	if x != nil {
+		return x.IssuanceFeeDiscount
+	}
+	return 0
+}
+
+func (x *MsgProposeParticipantFeeChange) GetVerificationFeeDiscount() uint64 {
 	if x != nil {
-		return x.Id
+		return x.VerificationFeeDiscount
 	}
 	return 0
 }
 
-type MsgCancelParticipantOPLastRequestResponse struct {
+type MsgProposeParticipantFeeChangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgCancelParticipantOPLastRequestResponse) Reset() {
-	*x = MsgCancelParticipantOPLastRequestResponse{}
+func (x *MsgProposeParticipantFeeChangeResponse) Reset() {
+	*x = MsgProposeParticipantFeeChangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[9]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelParticipantOPLastRequestResponse) String() string {
+func (x *MsgProposeParticipantFeeChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelParticipantOPLastRequestResponse) ProtoMessage() {}
+func (*MsgProposeParticipantFeeChangeResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelParticipantOPLastRequestResponse.ProtoReflect.Descriptor instead.
-func (*MsgCancelParticipantOPLastRequestResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgProposeParticipantFeeChangeResponse.ProtoReflect.Descriptor instead.
+func (*MsgProposeParticipantFeeChangeResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{25}
 }
 
-type MsgCreateRootParticipant struct {
+type MsgAcceptParticipantFeeChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// [MOD-PP-MSG-7-1] Spec v4-rc2 parameters.
-	// participant.role is hardcoded to ECOSYSTEM by the handler per [MOD-PP-MSG-7-3].
-	Corporation      string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Operator         string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	SchemaId         uint64                 `protobuf:"varint,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
-	Did              string                 `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
-	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
-	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	ValidationFees   uint64                 `protobuf:"varint,7,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	IssuanceFees     uint64                 `protobuf:"varint,8,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
-	VerificationFees uint64                 `protobuf:"varint,9,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	// vs_operator: the account of the Verifiable Service (optional, spec v4-rc2).
-	VsOperator string `protobuf:"bytes,10,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
-	// vs_operator_authz_msg_types: presence triggers VSOA record creation; MUST be a
-	// subset of [SetParticipantOPToValidated] for root participants.
-	VsOperatorAuthzMsgTypes      []string             `protobuf:"bytes,11,rep,name=vs_operator_authz_msg_types,json=vsOperatorAuthzMsgTypes,proto3" json:"vs_operator_authz_msg_types,omitempty"`
-	VsOperatorAuthzSpendLimit    []*v1beta1.Coin      `protobuf:"bytes,12,rep,name=vs_operator_authz_spend_limit,json=vsOperatorAuthzSpendLimit,proto3" json:"vs_operator_authz_spend_limit,omitempty"`
-	VsOperatorAuthzWithFeegrant  bool                 `protobuf:"varint,13,opt,name=vs_operator_authz_with_feegrant,json=vsOperatorAuthzWithFeegrant,proto3" json:"vs_operator_authz_with_feegrant,omitempty"`
-	VsOperatorAuthzFeeSpendLimit []*v1beta1.Coin      `protobuf:"bytes,14,rep,name=vs_operator_authz_fee_spend_limit,json=vsOperatorAuthzFeeSpendLimit,proto3" json:"vs_operator_authz_fee_spend_limit,omitempty"`
-	VsOperatorAuthzPeriod        *durationpb.Duration `protobuf:"bytes,15,opt,name=vs_operator_authz_period,json=vsOperatorAuthzPeriod,proto3" json:"vs_operator_authz_period,omitempty"`
+	// corporation is the participant corporation
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
 }
 
-func (x *MsgCreateRootParticipant) Reset() {
-	*x = MsgCreateRootParticipant{}
+func (x *MsgAcceptParticipantFeeChange) Reset() {
+	*x = MsgAcceptParticipantFeeChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[10]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateRootParticipant) String() string {
+func (x *MsgAcceptParticipantFeeChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateRootParticipant) ProtoMessage() {}
+func (*MsgAcceptParticipantFeeChange) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateRootParticipant.ProtoReflect.Descriptor instead.
-func (*MsgCreateRootParticipant) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgAcceptParticipantFeeChange.ProtoReflect.Descriptor instead.
+func (*MsgAcceptParticipantFeeChange) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgCreateRootParticipant) GetCorporation() string {
+func (x *MsgAcceptParticipantFeeChange) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgCreateRootParticipant) GetOperator() string {
+func (x *MsgAcceptParticipantFeeChange) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgCreateRootParticipant) GetSchemaId() uint64 {
+func (x *MsgAcceptParticipantFeeChange) GetId() uint64 {
 	if x != nil {
-		return x.SchemaId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgCreateRootParticipant) GetDid() string {
-	if x != nil {
-		return x.Did
-	}
-	return ""
+type MsgAcceptParticipantFeeChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Effective *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=effective,proto3" json:"effective,omitempty"`
 }
 
-func (x *MsgCreateRootParticipant) GetEffectiveFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EffectiveFrom
+func (x *MsgAcceptParticipantFeeChangeResponse) Reset() {
+	*x = MsgAcceptParticipantFeeChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MsgCreateRootParticipant) GetEffectiveUntil() *timestamppb.Timestamp {
+func (x *MsgAcceptParticipantFeeChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcceptParticipantFeeChangeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAcceptParticipantFeeChangeResponse.ProtoReflect.Descriptor instead.
+func (*MsgAcceptParticipantFeeChangeResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MsgAcceptParticipantFeeChangeResponse) GetEffective() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EffectiveUntil
+		return x.Effective
 	}
 	return nil
 }
 
-func (x *MsgCreateRootParticipant) GetValidationFees() uint64 {
-	if x != nil {
-		return x.ValidationFees
-	}
-	return 0
+type MsgAppealSlash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// corporation is the slashed participant corporation
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	SlashId     uint64 `protobuf:"varint,4,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	Reason      string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// evidence_digest is the optional SRI digest of the appeal evidence,
+	// anchored in x/di.
+	EvidenceDigest string `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
 }
 
-func (x *MsgCreateRootParticipant) GetIssuanceFees() uint64 {
-	if x != nil {
-		return x.IssuanceFees
+func (x *MsgAppealSlash) Reset() {
+	*x = MsgAppealSlash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MsgCreateRootParticipant) GetVerificationFees() uint64 {
-	if x != nil {
-		return x.VerificationFees
-	}
-	return 0
+func (x *MsgAppealSlash) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgCreateRootParticipant) GetVsOperator() string {
+func (*MsgAppealSlash) ProtoMessage() {}
+
+// Deprecated: Use MsgAppealSlash.ProtoReflect.Descriptor instead.
+func (*MsgAppealSlash) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MsgAppealSlash) GetCorporation() string {
 	if x != nil {
-		return x.VsOperator
+		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgCreateRootParticipant) GetVsOperatorAuthzMsgTypes() []string {
+func (x *MsgAppealSlash) GetOperator() string {
 	if x != nil {
-		return x.VsOperatorAuthzMsgTypes
+		return x.Operator
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgCreateRootParticipant) GetVsOperatorAuthzSpendLimit() []*v1beta1.Coin {
+func (x *MsgAppealSlash) GetId() uint64 {
 	if x != nil {
-		return x.VsOperatorAuthzSpendLimit
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgCreateRootParticipant) GetVsOperatorAuthzWithFeegrant() bool {
+func (x *MsgAppealSlash) GetSlashId() uint64 {
 	if x != nil {
-		return x.VsOperatorAuthzWithFeegrant
+		return x.SlashId
 	}
-	return false
+	return 0
 }
 
-func (x *MsgCreateRootParticipant) GetVsOperatorAuthzFeeSpendLimit() []*v1beta1.Coin {
+func (x *MsgAppealSlash) GetReason() string {
 	if x != nil {
-		return x.VsOperatorAuthzFeeSpendLimit
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgCreateRootParticipant) GetVsOperatorAuthzPeriod() *durationpb.Duration {
+func (x *MsgAppealSlash) GetEvidenceDigest() string {
 	if x != nil {
-		return x.VsOperatorAuthzPeriod
+		return x.EvidenceDigest
 	}
-	return nil
+	return ""
 }
 
-type MsgCreateRootParticipantResponse struct {
+type MsgAppealSlashResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created participant
+	// arbiter is the account that resolves the appeal
+	Arbiter string `protobuf:"bytes,1,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
 }
 
-func (x *MsgCreateRootParticipantResponse) Reset() {
-	*x = MsgCreateRootParticipantResponse{}
+func (x *MsgAppealSlashResponse) Reset() {
+	*x = MsgAppealSlashResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[11]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateRootParticipantResponse) String() string {
+func (x *MsgAppealSlashResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateRootParticipantResponse) ProtoMessage() {}
+func (*MsgAppealSlashResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateRootParticipantResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateRootParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgAppealSlashResponse.ProtoReflect.Descriptor instead.
+func (*MsgAppealSlashResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MsgCreateRootParticipantResponse) GetId() uint64 {
+func (x *MsgAppealSlashResponse) GetArbiter() string {
 	if x != nil {
-		return x.Id
+		return x.Arbiter
 	}
-	return 0
+	return ""
 }
 
-type MsgSetParticipantEffectiveUntil struct {
+type MsgResolveSlashAppeal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Corporation    string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Operator       string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id             uint64                 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
-	EffectiveUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	// authority MUST be the arbiter recorded on the appealed slash
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	SlashId   uint64 `protobuf:"varint,3,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	// reverse restores the slashed amount to the participant deposit, else
+	// the slash is confirmed and the slashed amount burnt.
+	Reverse bool `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
 }
 
-func (x *MsgSetParticipantEffectiveUntil) Reset() {
-	*x = MsgSetParticipantEffectiveUntil{}
+func (x *MsgResolveSlashAppeal) Reset() {
+	*x = MsgResolveSlashAppeal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[12]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetParticipantEffectiveUntil) String() string {
+func (x *MsgResolveSlashAppeal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetParticipantEffectiveUntil) ProtoMessage() {}
+func (*MsgResolveSlashAppeal) ProtoMessage() {}
 
-// Deprecated: Use MsgSetParticipantEffectiveUntil.ProtoReflect.Descriptor instead.
-func (*MsgSetParticipantEffectiveUntil) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgResolveSlashAppeal.ProtoReflect.Descriptor instead.
+func (*MsgResolveSlashAppeal) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MsgSetParticipantEffectiveUntil) GetCorporation() string {
+func (x *MsgResolveSlashAppeal) GetAuthority() string {
 	if x != nil {
-		return x.Corporation
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgSetParticipantEffectiveUntil) GetOperator() string {
+func (x *MsgResolveSlashAppeal) GetId() uint64 {
 	if x != nil {
-		return x.Operator
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgSetParticipantEffectiveUntil) GetId() uint64 {
+func (x *MsgResolveSlashAppeal) GetSlashId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.SlashId
 	}
 	return 0
 }
 
-func (x *MsgSetParticipantEffectiveUntil) GetEffectiveUntil() *timestamppb.Timestamp {
+func (x *MsgResolveSlashAppeal) GetReverse() bool {
 	if x != nil {
-		return x.EffectiveUntil
+		return x.Reverse
 	}
-	return nil
+	return false
 }
 
-type MsgSetParticipantEffectiveUntilResponse struct {
+type MsgResolveSlashAppealResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSetParticipantEffectiveUntilResponse) Reset() {
-	*x = MsgSetParticipantEffectiveUntilResponse{}
+func (x *MsgResolveSlashAppealResponse) Reset() {
+	*x = MsgResolveSlashAppealResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[13]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetParticipantEffectiveUntilResponse) String() string {
+func (x *MsgResolveSlashAppealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetParticipantEffectiveUntilResponse) ProtoMessage() {}
+func (*MsgResolveSlashAppealResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetParticipantEffectiveUntilResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetParticipantEffectiveUntilResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgResolveSlashAppealResponse.ProtoReflect.Descriptor instead.
+func (*MsgResolveSlashAppealResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
-type MsgRevokeParticipant struct {
+type MsgSuspendParticipant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// corporation is a validator ancestor corporation or the ecosystem
+	// controller
 	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
 	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
 	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MsgRevokeParticipant) Reset() {
-	*x = MsgRevokeParticipant{}
+func (x *MsgSuspendParticipant) Reset() {
+	*x = MsgSuspendParticipant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[14]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevokeParticipant) String() string {
+func (x *MsgSuspendParticipant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevokeParticipant) ProtoMessage() {}
+func (*MsgSuspendParticipant) ProtoMessage() {}
 
-// Deprecated: Use MsgRevokeParticipant.ProtoReflect.Descriptor instead.
-func (*MsgRevokeParticipant) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MsgSuspendParticipant.ProtoReflect.Descriptor instead.
+func (*MsgSuspendParticipant) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *MsgRevokeParticipant) GetCorporation() string {
+func (x *MsgSuspendParticipant) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgRevokeParticipant) GetOperator() string {
+func (x *MsgSuspendParticipant) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgRevokeParticipant) GetId() uint64 {
+func (x *MsgSuspendParticipant) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type MsgRevokeParticipantResponse struct {
+func (x *MsgSuspendParticipant) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MsgSuspendParticipantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRevokeParticipantResponse) Reset() {
-	*x = MsgRevokeParticipantResponse{}
+func (x *MsgSuspendParticipantResponse) Reset() {
+	*x = MsgSuspendParticipantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[15]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevokeParticipantResponse) String() string {
+func (x *MsgSuspendParticipantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevokeParticipantResponse) ProtoMessage() {}
+func (*MsgSuspendParticipantResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRevokeParticipantResponse.ProtoReflect.Descriptor instead.
-func (*MsgRevokeParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MsgSuspendParticipantResponse.ProtoReflect.Descriptor instead.
+func (*MsgSuspendParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{33}
 }
 
-type MsgCreateOrUpdateParticipantSession struct {
+type MsgUnsuspendParticipant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// corporation is the group account on whose behalf this message is executed
+	// corporation is a validator ancestor corporation or the ecosystem
+	// controller
 	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	// operator is the account authorized by the corporation to run this Msg (vs_operator)
-	Operator                 string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id                       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`                                                                                  // UUID (mandatory)
-	IssuerParticipantId      uint64 `protobuf:"varint,4,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`                  // optional: issuer participant id
-	VerifierParticipantId    uint64 `protobuf:"varint,5,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`            // optional: verifier participant id
-	AgentParticipantId       uint64 `protobuf:"varint,6,opt,name=agent_participant_id,json=agentParticipantId,proto3" json:"agent_participant_id,omitempty"`                     // mandatory: agent credential issuer participant id
-	WalletAgentParticipantId uint64 `protobuf:"varint,7,opt,name=wallet_agent_participant_id,json=walletAgentParticipantId,proto3" json:"wallet_agent_participant_id,omitempty"` // mandatory: wallet credential issuer participant id
-	Digest                   string `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`                                                                          // optional: digest derived from an issued or verified credential
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MsgCreateOrUpdateParticipantSession) Reset() {
-	*x = MsgCreateOrUpdateParticipantSession{}
+func (x *MsgUnsuspendParticipant) Reset() {
+	*x = MsgUnsuspendParticipant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[16]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateOrUpdateParticipantSession) String() string {
+func (x *MsgUnsuspendParticipant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateOrUpdateParticipantSession) ProtoMessage() {}
+func (*MsgUnsuspendParticipant) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateOrUpdateParticipantSession.ProtoReflect.Descriptor instead.
-func (*MsgCreateOrUpdateParticipantSession) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MsgUnsuspendParticipant.ProtoReflect.Descriptor instead.
+func (*MsgUnsuspendParticipant) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *MsgCreateOrUpdateParticipantSession) GetCorporation() string {
+func (x *MsgUnsuspendParticipant) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgCreateOrUpdateParticipantSession) GetOperator() string {
+func (x *MsgUnsuspendParticipant) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgCreateOrUpdateParticipantSession) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *MsgCreateOrUpdateParticipantSession) GetIssuerParticipantId() uint64 {
-	if x != nil {
-		return x.IssuerParticipantId
-	}
-	return 0
-}
-
-func (x *MsgCreateOrUpdateParticipantSession) GetVerifierParticipantId() uint64 {
-	if x != nil {
-		return x.VerifierParticipantId
-	}
-	return 0
-}
-
-func (x *MsgCreateOrUpdateParticipantSession) GetAgentParticipantId() uint64 {
-	if x != nil {
-		return x.AgentParticipantId
-	}
-	return 0
-}
-
-func (x *MsgCreateOrUpdateParticipantSession) GetWalletAgentParticipantId() uint64 {
+func (x *MsgUnsuspendParticipant) GetId() uint64 {
 	if x != nil {
-		return x.WalletAgentParticipantId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgCreateOrUpdateParticipantSession) GetDigest() string {
+func (x *MsgUnsuspendParticipant) GetReason() string {
 	if x != nil {
-		return x.Digest
+		return x.Reason
 	}
 	return ""
 }
 
-type MsgCreateOrUpdateParticipantSessionResponse struct {
+type MsgUnsuspendParticipantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgCreateOrUpdateParticipantSessionResponse) Reset() {
-	*x = MsgCreateOrUpdateParticipantSessionResponse{}
+func (x *MsgUnsuspendParticipantResponse) Reset() {
+	*x = MsgUnsuspendParticipantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[17]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateOrUpdateParticipantSessionResponse) String() string {
+func (x *MsgUnsuspendParticipantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateOrUpdateParticipantSessionResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgCreateOrUpdateParticipantSessionResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateOrUpdateParticipantSessionResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{17}
-}
+func (*MsgUnsuspendParticipantResponse) ProtoMessage() {}
 
-func (x *MsgCreateOrUpdateParticipantSessionResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use MsgUnsuspendParticipantResponse.ProtoReflect.Descriptor instead.
+func (*MsgUnsuspendParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{35}
 }
 
-type MsgSlashParticipantTrustDeposit struct {
+type MsgRotateParticipantDID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// corporation is the group account on whose behalf this message is executed
+	// corporation is the corporation of the participant
 	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	// operator is the account authorized by the corporation to run this Msg
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id       uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	Amount   uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// [MOD-PP-MSG-12-1] reason for the slash (mandatory per spec v4)
-	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // Participant ID
+	NewDid      string `protobuf:"bytes,4,opt,name=new_did,json=newDid,proto3" json:"new_did,omitempty"`
 }
 
-func (x *MsgSlashParticipantTrustDeposit) Reset() {
-	*x = MsgSlashParticipantTrustDeposit{}
+func (x *MsgRotateParticipantDID) Reset() {
+	*x = MsgRotateParticipantDID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[18]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSlashParticipantTrustDeposit) String() string {
+func (x *MsgRotateParticipantDID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSlashParticipantTrustDeposit) ProtoMessage() {}
+func (*MsgRotateParticipantDID) ProtoMessage() {}
 
-// Deprecated: Use MsgSlashParticipantTrustDeposit.ProtoReflect.Descriptor instead.
-func (*MsgSlashParticipantTrustDeposit) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MsgRotateParticipantDID.ProtoReflect.Descriptor instead.
+func (*MsgRotateParticipantDID) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MsgSlashParticipantTrustDeposit) GetCorporation() string {
+func (x *MsgRotateParticipantDID) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgSlashParticipantTrustDeposit) GetOperator() string {
+func (x *MsgRotateParticipantDID) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgSlashParticipantTrustDeposit) GetId() uint64 {
+func (x *MsgRotateParticipantDID) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgSlashParticipantTrustDeposit) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *MsgSlashParticipantTrustDeposit) GetReason() string {
+func (x *MsgRotateParticipantDID) GetNewDid() string {
 	if x != nil {
-		return x.Reason
+		return x.NewDid
 	}
 	return ""
 }
 
-type MsgSlashParticipantTrustDepositResponse struct {
+type MsgRotateParticipantDIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSlashParticipantTrustDepositResponse) Reset() {
-	*x = MsgSlashParticipantTrustDepositResponse{}
+func (x *MsgRotateParticipantDIDResponse) Reset() {
+	*x = MsgRotateParticipantDIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[19]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSlashParticipantTrustDepositResponse) String() string {
+func (x *MsgRotateParticipantDIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSlashParticipantTrustDepositResponse) ProtoMessage() {}
+func (*MsgRotateParticipantDIDResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSlashParticipantTrustDepositResponse.ProtoReflect.Descriptor instead.
-func (*MsgSlashParticipantTrustDepositResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use MsgRotateParticipantDIDResponse.ProtoReflect.Descriptor instead.
+func (*MsgRotateParticipantDIDResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{37}
 }
 
-type MsgRepayParticipantSlashedTrustDeposit struct {
+type MsgBatchRevokeParticipants struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	Amount      uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Corporation string                  `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string                  `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Items       []*MsgRevokeParticipant `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *MsgRepayParticipantSlashedTrustDeposit) Reset() {
-	*x = MsgRepayParticipantSlashedTrustDeposit{}
+func (x *MsgBatchRevokeParticipants) Reset() {
+	*x = MsgBatchRevokeParticipants{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[20]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRepayParticipantSlashedTrustDeposit) String() string {
+func (x *MsgBatchRevokeParticipants) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRepayParticipantSlashedTrustDeposit) ProtoMessage() {}
+func (*MsgBatchRevokeParticipants) ProtoMessage() {}
 
-// Deprecated: Use MsgRepayParticipantSlashedTrustDeposit.ProtoReflect.Descriptor instead.
-func (*MsgRepayParticipantSlashedTrustDeposit) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MsgBatchRevokeParticipants.ProtoReflect.Descriptor instead.
+func (*MsgBatchRevokeParticipants) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *MsgRepayParticipantSlashedTrustDeposit) GetCorporation() string {
+func (x *MsgBatchRevokeParticipants) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgRepayParticipantSlashedTrustDeposit) GetOperator() string {
+func (x *MsgBatchRevokeParticipants) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgRepayParticipantSlashedTrustDeposit) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *MsgRepayParticipantSlashedTrustDeposit) GetAmount() uint64 {
+func (x *MsgBatchRevokeParticipants) GetItems() []*MsgRevokeParticipant {
 	if x != nil {
-		return x.Amount
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-type MsgRepayParticipantSlashedTrustDepositResponse struct {
+type MsgBatchRevokeParticipantsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// results are the responses of the items, in order
+	Results []*MsgRevokeParticipantResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *MsgRepayParticipantSlashedTrustDepositResponse) Reset() {
-	*x = MsgRepayParticipantSlashedTrustDepositResponse{}
+func (x *MsgBatchRevokeParticipantsResponse) Reset() {
+	*x = MsgBatchRevokeParticipantsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[21]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRepayParticipantSlashedTrustDepositResponse) String() string {
+func (x *MsgBatchRevokeParticipantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRepayParticipantSlashedTrustDepositResponse) ProtoMessage() {}
+func (*MsgBatchRevokeParticipantsResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRepayParticipantSlashedTrustDepositResponse.ProtoReflect.Descriptor instead.
-func (*MsgRepayParticipantSlashedTrustDepositResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MsgBatchRevokeParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*MsgBatchRevokeParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{39}
 }
 
-type MsgSelfCreateParticipant struct {
+func (x *MsgBatchRevokeParticipantsResponse) GetResults() []*MsgRevokeParticipantResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MsgBatchSetParticipantEffectiveUntil struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Corporation            string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Operator               string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Role                   ParticipantRole        `protobuf:"varint,3,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
-	ValidatorParticipantId uint64                 `protobuf:"varint,4,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
-	Did                    string                 `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"`
-	EffectiveFrom          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
-	EffectiveUntil         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	VerificationFees       uint64                 `protobuf:"varint,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	ValidationFees         uint64                 `protobuf:"varint,9,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	// vs_operator: the account of the Verifiable Service (optional)
-	VsOperator                   string               `protobuf:"bytes,10,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
-	VsOperatorAuthzMsgTypes      []string             `protobuf:"bytes,16,rep,name=vs_operator_authz_msg_types,json=vsOperatorAuthzMsgTypes,proto3" json:"vs_operator_authz_msg_types,omitempty"`
-	VsOperatorAuthzSpendLimit    []*v1beta1.Coin      `protobuf:"bytes,12,rep,name=vs_operator_authz_spend_limit,json=vsOperatorAuthzSpendLimit,proto3" json:"vs_operator_authz_spend_limit,omitempty"`
-	VsOperatorAuthzWithFeegrant  bool                 `protobuf:"varint,13,opt,name=vs_operator_authz_with_feegrant,json=vsOperatorAuthzWithFeegrant,proto3" json:"vs_operator_authz_with_feegrant,omitempty"`
-	VsOperatorAuthzFeeSpendLimit []*v1beta1.Coin      `protobuf:"bytes,14,rep,name=vs_operator_authz_fee_spend_limit,json=vsOperatorAuthzFeeSpendLimit,proto3" json:"vs_operator_authz_fee_spend_limit,omitempty"`
-	VsOperatorAuthzPeriod        *durationpb.Duration `protobuf:"bytes,15,opt,name=vs_operator_authz_period,json=vsOperatorAuthzPeriod,proto3" json:"vs_operator_authz_period,omitempty"`
+	Corporation string                             `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string                             `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Items       []*MsgSetParticipantEffectiveUntil `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *MsgSelfCreateParticipant) Reset() {
-	*x = MsgSelfCreateParticipant{}
+func (x *MsgBatchSetParticipantEffectiveUntil) Reset() {
+	*x = MsgBatchSetParticipantEffectiveUntil{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[22]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSelfCreateParticipant) String() string {
+func (x *MsgBatchSetParticipantEffectiveUntil) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSelfCreateParticipant) ProtoMessage() {}
+func (*MsgBatchSetParticipantEffectiveUntil) ProtoMessage() {}
 
-// Deprecated: Use MsgSelfCreateParticipant.ProtoReflect.Descriptor instead.
-func (*MsgSelfCreateParticipant) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use MsgBatchSetParticipantEffectiveUntil.ProtoReflect.Descriptor instead.
+func (*MsgBatchSetParticipantEffectiveUntil) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *MsgSelfCreateParticipant) GetCorporation() string {
+func (x *MsgBatchSetParticipantEffectiveUntil) GetCorporation() string {
 	if x != nil {
 		return x.Corporation
 	}
 	return ""
 }
 
-func (x *MsgSelfCreateParticipant) GetOperator() string {
+func (x *MsgBatchSetParticipantEffectiveUntil) GetOperator() string {
 	if x != nil {
 		return x.Operator
 	}
 	return ""
 }
 
-func (x *MsgSelfCreateParticipant) GetRole() ParticipantRole {
+func (x *MsgBatchSetParticipantEffectiveUntil) GetItems() []*MsgSetParticipantEffectiveUntil {
 	if x != nil {
-		return x.Role
+		return x.Items
 	}
-	return ParticipantRole_UNSPECIFIED
+	return nil
 }
 
-func (x *MsgSelfCreateParticipant) GetValidatorParticipantId() uint64 {
-	if x != nil {
-		return x.ValidatorParticipantId
-	}
-	return 0
+type MsgBatchSetParticipantEffectiveUntilResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are the responses of the items, in order
+	Results []*MsgSetParticipantEffectiveUntilResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *MsgSelfCreateParticipant) GetDid() string {
-	if x != nil {
-		return x.Did
+func (x *MsgBatchSetParticipantEffectiveUntilResponse) Reset() {
+	*x = MsgBatchSetParticipantEffectiveUntilResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MsgSelfCreateParticipant) GetEffectiveFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EffectiveFrom
-	}
-	return nil
+func (x *MsgBatchSetParticipantEffectiveUntilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgSelfCreateParticipant) GetEffectiveUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EffectiveUntil
-	}
-	return nil
+func (*MsgBatchSetParticipantEffectiveUntilResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgBatchSetParticipantEffectiveUntilResponse.ProtoReflect.Descriptor instead.
+func (*MsgBatchSetParticipantEffectiveUntilResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *MsgSelfCreateParticipant) GetVerificationFees() uint64 {
+func (x *MsgBatchSetParticipantEffectiveUntilResponse) GetResults() []*MsgSetParticipantEffectiveUntilResponse {
 	if x != nil {
-		return x.VerificationFees
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgSelfCreateParticipant) GetValidationFees() uint64 {
-	if x != nil {
-		return x.ValidationFees
-	}
-	return 0
+type MsgBatchSelfCreateParticipants struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Corporation string                      `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string                      `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Items       []*MsgSelfCreateParticipant `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *MsgSelfCreateParticipant) GetVsOperator() string {
-	if x != nil {
-		return x.VsOperator
+func (x *MsgBatchSelfCreateParticipants) Reset() {
+	*x = MsgBatchSelfCreateParticipants{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzMsgTypes() []string {
-	if x != nil {
-		return x.VsOperatorAuthzMsgTypes
-	}
-	return nil
+func (x *MsgBatchSelfCreateParticipants) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzSpendLimit() []*v1beta1.Coin {
-	if x != nil {
-		return x.VsOperatorAuthzSpendLimit
-	}
-	return nil
+func (*MsgBatchSelfCreateParticipants) ProtoMessage() {}
+
+// Deprecated: Use MsgBatchSelfCreateParticipants.ProtoReflect.Descriptor instead.
+func (*MsgBatchSelfCreateParticipants) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzWithFeegrant() bool {
+func (x *MsgBatchSelfCreateParticipants) GetCorporation() string {
 	if x != nil {
-		return x.VsOperatorAuthzWithFeegrant
+		return x.Corporation
 	}
-	return false
+	return ""
 }
 
-func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzFeeSpendLimit() []*v1beta1.Coin {
+func (x *MsgBatchSelfCreateParticipants) GetOperator() string {
 	if x != nil {
-		return x.VsOperatorAuthzFeeSpendLimit
+		return x.Operator
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgSelfCreateParticipant) GetVsOperatorAuthzPeriod() *durationpb.Duration {
+func (x *MsgBatchSelfCreateParticipants) GetItems() []*MsgSelfCreateParticipant {
 	if x != nil {
-		return x.VsOperatorAuthzPeriod
+		return x.Items
 	}
 	return nil
 }
 
-type MsgSelfCreateParticipantResponse struct {
+type MsgBatchSelfCreateParticipantsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// results are the responses of the items, in order
+	Results []*MsgSelfCreateParticipantResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *MsgSelfCreateParticipantResponse) Reset() {
-	*x = MsgSelfCreateParticipantResponse{}
+func (x *MsgBatchSelfCreateParticipantsResponse) Reset() {
+	*x = MsgBatchSelfCreateParticipantsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_tx_proto_msgTypes[23]
+		mi := &file_verana_pp_v1_tx_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSelfCreateParticipantResponse) String() string {
+func (x *MsgBatchSelfCreateParticipantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSelfCreateParticipantResponse) ProtoMessage() {}
+func (*MsgBatchSelfCreateParticipantsResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSelfCreateParticipantResponse.ProtoReflect.Descriptor instead.
-func (*MsgSelfCreateParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MsgBatchSelfCreateParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*MsgBatchSelfCreateParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_tx_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *MsgSelfCreateParticipantResponse) GetId() uint64 {
+func (x *MsgBatchSelfCreateParticipantsResponse) GetResults() []*MsgSelfCreateParticipantResponse {
 	if x != nil {
-		return x.Id
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
 var File_verana_pp_v1_tx_proto protoreflect.FileDescriptor
@@ -16300,7 +28120,7 @@ var file_verana_pp_v1_tx_proto_rawDesc = []byte{
 	0x61, 0x72, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69,
 	0x6c, 0x22, 0x29, 0x0a, 0x27, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
 	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55,
-	0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcc, 0x01, 0x0a,
+	0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfe, 0x02, 0x0a,
 	0x14, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
 	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
@@ -16310,161 +28130,398 @@ var file_verana_pp_v1_tx_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f,
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x32, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd5, 0x03, 0x0a, 0x23,
-	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x53,
-	0x65, 0x73, 0x73, 0x22, 0x3d, 0x0a, 0x2b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x88, 0x02, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x3a, 0x32, 0x82, 0xe7, 0xb0, 0x2a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x3d, 0x0a,
+	0x1c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x49, 0x64, 0x22, 0xd5, 0x03, 0x0a,
+	0x23, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x3a, 0x37, 0x82, 0xe7, 0xb0,
+	0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x22, 0x3d, 0x0a, 0x2b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xb1, 0x02, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70,
+	0x70, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x44, 0x22, 0x95, 0x01, 0x0a, 0x27, 0x4d, 0x73, 0x67, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x49, 0x64, 0x12, 0x4f,
+	0x0a, 0x10, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22,
+	0xf7, 0x01, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f,
+	0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78,
+	0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x61, 0x72, 0x74,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x44, 0x22, 0x30, 0x0a, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x09, 0x0a, 0x18,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x38, 0x0a,
+	0x18, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x0e, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x76,
+	0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x76, 0x73, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x1b, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x6d, 0x73, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x76, 0x73, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x4d, 0x73, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x1d, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19,
+	0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x1f, 0x76, 0x73, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1b, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x7a, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12,
+	0x99, 0x01, 0x0a, 0x21, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x76,
+	0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x46, 0x65,
+	0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x18, 0x76,
+	0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x15,
+	0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f,
+	0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4a, 0x04, 0x08,
+	0x0b, 0x10, 0x0c, 0x52, 0x19, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x32,
+	0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xc4, 0x03, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70,
+	0x70, 0x2f, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x26, 0x4d, 0x73, 0x67,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x22, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x6b, 0x0a,
+	0x25, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x9c, 0x02, 0x0a, 0x0e, 0x4d,
+	0x73, 0x67, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a,
+	0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f,
+	0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x3a, 0x2c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x22, 0x4c, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x61, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x3a, 0x34,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x21, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12,
+	0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b,
+	0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x1f,
+	0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xea, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f,
+	0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x21, 0x0a, 0x1f,
+	0x4d, 0x73, 0x67, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xeb, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x65, 0x77, 0x44, 0x69, 0x64, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x49, 0x44, 0x22, 0x21, 0x0a,
+	0x1f, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x88, 0x02, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b,
+	0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x3e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x3a, 0x38, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70,
+	0x2f, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x22, 0x4d,
+	0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x9a, 0x02,
+	0x0a, 0x24, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x44, 0x22, 0x29, 0x0a,
-	0x27, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x26, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x33, 0x82,
-	0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x21, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x61, 0x72, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64,
-	0x54, 0x44, 0x22, 0x30, 0x0a, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64,
-	0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x09, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x6c, 0x66,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64,
-	0x69, 0x64, 0x12, 0x4b, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01,
-	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12,
-	0x4d, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74,
-	0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e,
-	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x2b,
-	0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
-	0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x0a, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x3c, 0x0a, 0x1b, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x7a, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x10,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x41, 0x75, 0x74, 0x68, 0x7a, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x92, 0x01,
-	0x0a, 0x1d, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x7a, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x44, 0x0a, 0x1f, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x76, 0x73, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x57, 0x69, 0x74, 0x68,
-	0x46, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x99, 0x01, 0x0a, 0x21, 0x76, 0x73, 0x5f,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x66,
-	0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x46, 0x65, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x18, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x15, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x36,
-	0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x24, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73,
-	0x67, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x52, 0x19, 0x76, 0x73,
-	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53, 0x65,
-	0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x32, 0xc7, 0x0b, 0x0a, 0x03,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f,
+	0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x45, 0x66, 0x66, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x85, 0x01, 0x0a, 0x2c, 0x4d,
+	0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x3a, 0x34, 0x82, 0xe7, 0xb0,
+	0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x22, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x22, 0x78, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6c,
+	0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0xdb, 0x14, 0x0a, 0x03,
 	0x4d, 0x73, 0x67, 0x12, 0x54, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
 	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
@@ -16556,19 +28613,92 @@ var file_verana_pp_v1_tx_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x1a, 0x2e,
 	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
 	0x67, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
-	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f,
-	0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58,
-	0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81,
+	0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2c,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x34, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7e, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x33, 0x2e,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x12, 0x1c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x1a,
+	0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x12, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x25, 0x2e,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x14, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44,
+	0x49, 0x44, 0x1a, 0x2d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x75, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x1a, 0x30, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x21, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x32,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x1a, 0x3a, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81,
+	0x01, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2c,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x1a, 0x34, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa2, 0x01, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x07,
+	0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
+	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -16583,7 +28713,7 @@ func file_verana_pp_v1_tx_proto_rawDescGZIP() []byte {
 	return file_verana_pp_v1_tx_proto_rawDescData
 }
 
-var file_verana_pp_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_verana_pp_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_verana_pp_v1_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateParams)(nil),                                // 0: verana.pp.v1.MsgUpdateParams
 	(*MsgUpdateParamsResponse)(nil),                        // 1: verana.pp.v1.MsgUpdateParamsResponse
@@ -16609,64 +28739,116 @@ var file_verana_pp_v1_tx_proto_goTypes = []interface{}{
 	(*MsgRepayParticipantSlashedTrustDepositResponse)(nil), // 21: verana.pp.v1.MsgRepayParticipantSlashedTrustDepositResponse
 	(*MsgSelfCreateParticipant)(nil),                       // 22: verana.pp.v1.MsgSelfCreateParticipant
 	(*MsgSelfCreateParticipantResponse)(nil),               // 23: verana.pp.v1.MsgSelfCreateParticipantResponse
-	(*Params)(nil),                                         // 24: verana.pp.v1.Params
-	(ParticipantRole)(0),                                   // 25: verana.pp.v1.ParticipantRole
-	(*OptionalUInt64)(nil),                                 // 26: verana.pp.v1.OptionalUInt64
-	(*v1beta1.Coin)(nil),                                   // 27: cosmos.base.v1beta1.Coin
-	(*durationpb.Duration)(nil),                            // 28: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),                          // 29: google.protobuf.Timestamp
+	(*MsgProposeParticipantFeeChange)(nil),                 // 24: verana.pp.v1.MsgProposeParticipantFeeChange
+	(*MsgProposeParticipantFeeChangeResponse)(nil),         // 25: verana.pp.v1.MsgProposeParticipantFeeChangeResponse
+	(*MsgAcceptParticipantFeeChange)(nil),                  // 26: verana.pp.v1.MsgAcceptParticipantFeeChange
+	(*MsgAcceptParticipantFeeChangeResponse)(nil),          // 27: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse
+	(*MsgAppealSlash)(nil),                                 // 28: verana.pp.v1.MsgAppealSlash
+	(*MsgAppealSlashResponse)(nil),                         // 29: verana.pp.v1.MsgAppealSlashResponse
+	(*MsgResolveSlashAppeal)(nil),                          // 30: verana.pp.v1.MsgResolveSlashAppeal
+	(*MsgResolveSlashAppealResponse)(nil),                  // 31: verana.pp.v1.MsgResolveSlashAppealResponse
+	(*MsgSuspendParticipant)(nil),                          // 32: verana.pp.v1.MsgSuspendParticipant
+	(*MsgSuspendParticipantResponse)(nil),                  // 33: verana.pp.v1.MsgSuspendParticipantResponse
+	(*MsgUnsuspendParticipant)(nil),                        // 34: verana.pp.v1.MsgUnsuspendParticipant
+	(*MsgUnsuspendParticipantResponse)(nil),                // 35: verana.pp.v1.MsgUnsuspendParticipantResponse
+	(*MsgRotateParticipantDID)(nil),                        // 36: verana.pp.v1.MsgRotateParticipantDID
+	(*MsgRotateParticipantDIDResponse)(nil),                // 37: verana.pp.v1.MsgRotateParticipantDIDResponse
+	(*MsgBatchRevokeParticipants)(nil),                     // 38: verana.pp.v1.MsgBatchRevokeParticipants
+	(*MsgBatchRevokeParticipantsResponse)(nil),             // 39: verana.pp.v1.MsgBatchRevokeParticipantsResponse
+	(*MsgBatchSetParticipantEffectiveUntil)(nil),           // 40: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil
+	(*MsgBatchSetParticipantEffectiveUntilResponse)(nil),   // 41: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse
+	(*MsgBatchSelfCreateParticipants)(nil),                 // 42: verana.pp.v1.MsgBatchSelfCreateParticipants
+	(*MsgBatchSelfCreateParticipantsResponse)(nil),         // 43: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse
+	(*Params)(nil),                // 44: verana.pp.v1.Params
+	(ParticipantRole)(0),          // 45: verana.pp.v1.ParticipantRole
+	(*OptionalUInt64)(nil),        // 46: verana.pp.v1.OptionalUInt64
+	(*v1beta1.Coin)(nil),          // 47: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),   // 48: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 49: google.protobuf.Timestamp
+	(RevocationReason)(0),         // 50: verana.pp.v1.RevocationReason
+	(CascadeMode)(0),              // 51: verana.pp.v1.CascadeMode
 }
 var file_verana_pp_v1_tx_proto_depIdxs = []int32{
-	24, // 0: verana.pp.v1.MsgUpdateParams.params:type_name -> verana.pp.v1.Params
-	25, // 1: verana.pp.v1.MsgStartParticipantOP.role:type_name -> verana.pp.v1.ParticipantRole
-	26, // 2: verana.pp.v1.MsgStartParticipantOP.validation_fees:type_name -> verana.pp.v1.OptionalUInt64
-	26, // 3: verana.pp.v1.MsgStartParticipantOP.issuance_fees:type_name -> verana.pp.v1.OptionalUInt64
-	26, // 4: verana.pp.v1.MsgStartParticipantOP.verification_fees:type_name -> verana.pp.v1.OptionalUInt64
-	27, // 5: verana.pp.v1.MsgStartParticipantOP.vs_operator_authz_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	27, // 6: verana.pp.v1.MsgStartParticipantOP.vs_operator_authz_fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	28, // 7: verana.pp.v1.MsgStartParticipantOP.vs_operator_authz_period:type_name -> google.protobuf.Duration
-	29, // 8: verana.pp.v1.MsgSetParticipantOPToValidated.effective_until:type_name -> google.protobuf.Timestamp
-	29, // 9: verana.pp.v1.MsgCreateRootParticipant.effective_from:type_name -> google.protobuf.Timestamp
-	29, // 10: verana.pp.v1.MsgCreateRootParticipant.effective_until:type_name -> google.protobuf.Timestamp
-	27, // 11: verana.pp.v1.MsgCreateRootParticipant.vs_operator_authz_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	27, // 12: verana.pp.v1.MsgCreateRootParticipant.vs_operator_authz_fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	28, // 13: verana.pp.v1.MsgCreateRootParticipant.vs_operator_authz_period:type_name -> google.protobuf.Duration
-	29, // 14: verana.pp.v1.MsgSetParticipantEffectiveUntil.effective_until:type_name -> google.protobuf.Timestamp
-	25, // 15: verana.pp.v1.MsgSelfCreateParticipant.role:type_name -> verana.pp.v1.ParticipantRole
-	29, // 16: verana.pp.v1.MsgSelfCreateParticipant.effective_from:type_name -> google.protobuf.Timestamp
-	29, // 17: verana.pp.v1.MsgSelfCreateParticipant.effective_until:type_name -> google.protobuf.Timestamp
-	27, // 18: verana.pp.v1.MsgSelfCreateParticipant.vs_operator_authz_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	27, // 19: verana.pp.v1.MsgSelfCreateParticipant.vs_operator_authz_fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
-	28, // 20: verana.pp.v1.MsgSelfCreateParticipant.vs_operator_authz_period:type_name -> google.protobuf.Duration
-	0,  // 21: verana.pp.v1.Msg.UpdateParams:input_type -> verana.pp.v1.MsgUpdateParams
-	2,  // 22: verana.pp.v1.Msg.StartParticipantOP:input_type -> verana.pp.v1.MsgStartParticipantOP
-	4,  // 23: verana.pp.v1.Msg.RenewParticipantOP:input_type -> verana.pp.v1.MsgRenewParticipantOP
-	6,  // 24: verana.pp.v1.Msg.SetParticipantOPToValidated:input_type -> verana.pp.v1.MsgSetParticipantOPToValidated
-	8,  // 25: verana.pp.v1.Msg.CancelParticipantOPLastRequest:input_type -> verana.pp.v1.MsgCancelParticipantOPLastRequest
-	10, // 26: verana.pp.v1.Msg.CreateRootParticipant:input_type -> verana.pp.v1.MsgCreateRootParticipant
-	12, // 27: verana.pp.v1.Msg.SetParticipantEffectiveUntil:input_type -> verana.pp.v1.MsgSetParticipantEffectiveUntil
-	14, // 28: verana.pp.v1.Msg.RevokeParticipant:input_type -> verana.pp.v1.MsgRevokeParticipant
-	16, // 29: verana.pp.v1.Msg.CreateOrUpdateParticipantSession:input_type -> verana.pp.v1.MsgCreateOrUpdateParticipantSession
-	18, // 30: verana.pp.v1.Msg.SlashParticipantTrustDeposit:input_type -> verana.pp.v1.MsgSlashParticipantTrustDeposit
-	20, // 31: verana.pp.v1.Msg.RepayParticipantSlashedTrustDeposit:input_type -> verana.pp.v1.MsgRepayParticipantSlashedTrustDeposit
-	22, // 32: verana.pp.v1.Msg.SelfCreateParticipant:input_type -> verana.pp.v1.MsgSelfCreateParticipant
-	1,  // 33: verana.pp.v1.Msg.UpdateParams:output_type -> verana.pp.v1.MsgUpdateParamsResponse
-	3,  // 34: verana.pp.v1.Msg.StartParticipantOP:output_type -> verana.pp.v1.MsgStartParticipantOPResponse
-	5,  // 35: verana.pp.v1.Msg.RenewParticipantOP:output_type -> verana.pp.v1.MsgRenewParticipantOPResponse
-	7,  // 36: verana.pp.v1.Msg.SetParticipantOPToValidated:output_type -> verana.pp.v1.MsgSetParticipantOPToValidatedResponse
-	9,  // 37: verana.pp.v1.Msg.CancelParticipantOPLastRequest:output_type -> verana.pp.v1.MsgCancelParticipantOPLastRequestResponse
-	11, // 38: verana.pp.v1.Msg.CreateRootParticipant:output_type -> verana.pp.v1.MsgCreateRootParticipantResponse
-	13, // 39: verana.pp.v1.Msg.SetParticipantEffectiveUntil:output_type -> verana.pp.v1.MsgSetParticipantEffectiveUntilResponse
-	15, // 40: verana.pp.v1.Msg.RevokeParticipant:output_type -> verana.pp.v1.MsgRevokeParticipantResponse
-	17, // 41: verana.pp.v1.Msg.CreateOrUpdateParticipantSession:output_type -> verana.pp.v1.MsgCreateOrUpdateParticipantSessionResponse
-	19, // 42: verana.pp.v1.Msg.SlashParticipantTrustDeposit:output_type -> verana.pp.v1.MsgSlashParticipantTrustDepositResponse
-	21, // 43: verana.pp.v1.Msg.RepayParticipantSlashedTrustDeposit:output_type -> verana.pp.v1.MsgRepayParticipantSlashedTrustDepositResponse
-	23, // 44: verana.pp.v1.Msg.SelfCreateParticipant:output_type -> verana.pp.v1.MsgSelfCreateParticipantResponse
-	33, // [33:45] is the sub-list for method output_type
-	21, // [21:33] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	44, // 0: verana.pp.v1.MsgUpdateParams.params:type_name -> verana.pp.v1.Params
+	45, // 1: verana.pp.v1.MsgStartParticipantOP.role:type_name -> verana.pp.v1.ParticipantRole
+	46, // 2: verana.pp.v1.MsgStartParticipantOP.validation_fees:type_name -> verana.pp.v1.OptionalUInt64
+	46, // 3: verana.pp.v1.MsgStartParticipantOP.issuance_fees:type_name -> verana.pp.v1.OptionalUInt64
+	46, // 4: verana.pp.v1.MsgStartParticipantOP.verification_fees:type_name -> verana.pp.v1.OptionalUInt64
+	47, // 5: verana.pp.v1.MsgStartParticipantOP.vs_operator_authz_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	47, // 6: verana.pp.v1.MsgStartParticipantOP.vs_operator_authz_fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	48, // 7: verana.pp.v1.MsgStartParticipantOP.vs_operator_authz_period:type_name -> google.protobuf.Duration
+	49, // 8: verana.pp.v1.MsgSetParticipantOPToValidated.effective_until:type_name -> google.protobuf.Timestamp
+	49, // 9: verana.pp.v1.MsgCreateRootParticipant.effective_from:type_name -> google.protobuf.Timestamp
+	49, // 10: verana.pp.v1.MsgCreateRootParticipant.effective_until:type_name -> google.protobuf.Timestamp
+	47, // 11: verana.pp.v1.MsgCreateRootParticipant.vs_operator_authz_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	47, // 12: verana.pp.v1.MsgCreateRootParticipant.vs_operator_authz_fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	48, // 13: verana.pp.v1.MsgCreateRootParticipant.vs_operator_authz_period:type_name -> google.protobuf.Duration
+	49, // 14: verana.pp.v1.MsgSetParticipantEffectiveUntil.effective_until:type_name -> google.protobuf.Timestamp
+	50, // 15: verana.pp.v1.MsgRevokeParticipant.reason:type_name -> verana.pp.v1.RevocationReason
+	51, // 16: verana.pp.v1.MsgRevokeParticipant.cascade:type_name -> verana.pp.v1.CascadeMode
+	49, // 17: verana.pp.v1.MsgSlashParticipantTrustDepositResponse.dispute_deadline:type_name -> google.protobuf.Timestamp
+	45, // 18: verana.pp.v1.MsgSelfCreateParticipant.role:type_name -> verana.pp.v1.ParticipantRole
+	49, // 19: verana.pp.v1.MsgSelfCreateParticipant.effective_from:type_name -> google.protobuf.Timestamp
+	49, // 20: verana.pp.v1.MsgSelfCreateParticipant.effective_until:type_name -> google.protobuf.Timestamp
+	47, // 21: verana.pp.v1.MsgSelfCreateParticipant.vs_operator_authz_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	47, // 22: verana.pp.v1.MsgSelfCreateParticipant.vs_operator_authz_fee_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	48, // 23: verana.pp.v1.MsgSelfCreateParticipant.vs_operator_authz_period:type_name -> google.protobuf.Duration
+	49, // 24: verana.pp.v1.MsgAcceptParticipantFeeChangeResponse.effective:type_name -> google.protobuf.Timestamp
+	14, // 25: verana.pp.v1.MsgBatchRevokeParticipants.items:type_name -> verana.pp.v1.MsgRevokeParticipant
+	15, // 26: verana.pp.v1.MsgBatchRevokeParticipantsResponse.results:type_name -> verana.pp.v1.MsgRevokeParticipantResponse
+	12, // 27: verana.pp.v1.MsgBatchSetParticipantEffectiveUntil.items:type_name -> verana.pp.v1.MsgSetParticipantEffectiveUntil
+	13, // 28: verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse.results:type_name -> verana.pp.v1.MsgSetParticipantEffectiveUntilResponse
+	22, // 29: verana.pp.v1.MsgBatchSelfCreateParticipants.items:type_name -> verana.pp.v1.MsgSelfCreateParticipant
+	23, // 30: verana.pp.v1.MsgBatchSelfCreateParticipantsResponse.results:type_name -> verana.pp.v1.MsgSelfCreateParticipantResponse
+	0,  // 31: verana.pp.v1.Msg.UpdateParams:input_type -> verana.pp.v1.MsgUpdateParams
+	2,  // 32: verana.pp.v1.Msg.StartParticipantOP:input_type -> verana.pp.v1.MsgStartParticipantOP
+	4,  // 33: verana.pp.v1.Msg.RenewParticipantOP:input_type -> verana.pp.v1.MsgRenewParticipantOP
+	6,  // 34: verana.pp.v1.Msg.SetParticipantOPToValidated:input_type -> verana.pp.v1.MsgSetParticipantOPToValidated
+	8,  // 35: verana.pp.v1.Msg.CancelParticipantOPLastRequest:input_type -> verana.pp.v1.MsgCancelParticipantOPLastRequest
+	10, // 36: verana.pp.v1.Msg.CreateRootParticipant:input_type -> verana.pp.v1.MsgCreateRootParticipant
+	12, // 37: verana.pp.v1.Msg.SetParticipantEffectiveUntil:input_type -> verana.pp.v1.MsgSetParticipantEffectiveUntil
+	14, // 38: verana.pp.v1.Msg.RevokeParticipant:input_type -> verana.pp.v1.MsgRevokeParticipant
+	16, // 39: verana.pp.v1.Msg.CreateOrUpdateParticipantSession:input_type -> verana.pp.v1.MsgCreateOrUpdateParticipantSession
+	18, // 40: verana.pp.v1.Msg.SlashParticipantTrustDeposit:input_type -> verana.pp.v1.MsgSlashParticipantTrustDeposit
+	20, // 41: verana.pp.v1.Msg.RepayParticipantSlashedTrustDeposit:input_type -> verana.pp.v1.MsgRepayParticipantSlashedTrustDeposit
+	22, // 42: verana.pp.v1.Msg.SelfCreateParticipant:input_type -> verana.pp.v1.MsgSelfCreateParticipant
+	24, // 43: verana.pp.v1.Msg.ProposeParticipantFeeChange:input_type -> verana.pp.v1.MsgProposeParticipantFeeChange
+	26, // 44: verana.pp.v1.Msg.AcceptParticipantFeeChange:input_type -> verana.pp.v1.MsgAcceptParticipantFeeChange
+	28, // 45: verana.pp.v1.Msg.AppealSlash:input_type -> verana.pp.v1.MsgAppealSlash
+	30, // 46: verana.pp.v1.Msg.ResolveSlashAppeal:input_type -> verana.pp.v1.MsgResolveSlashAppeal
+	32, // 47: verana.pp.v1.Msg.SuspendParticipant:input_type -> verana.pp.v1.MsgSuspendParticipant
+	34, // 48: verana.pp.v1.Msg.UnsuspendParticipant:input_type -> verana.pp.v1.MsgUnsuspendParticipant
+	36, // 49: verana.pp.v1.Msg.RotateParticipantDID:input_type -> verana.pp.v1.MsgRotateParticipantDID
+	38, // 50: verana.pp.v1.Msg.BatchRevokeParticipants:input_type -> verana.pp.v1.MsgBatchRevokeParticipants
+	40, // 51: verana.pp.v1.Msg.BatchSetParticipantEffectiveUntil:input_type -> verana.pp.v1.MsgBatchSetParticipantEffectiveUntil
+	42, // 52: verana.pp.v1.Msg.BatchSelfCreateParticipants:input_type -> verana.pp.v1.MsgBatchSelfCreateParticipants
+	1,  // 53: verana.pp.v1.Msg.UpdateParams:output_type -> verana.pp.v1.MsgUpdateParamsResponse
+	3,  // 54: verana.pp.v1.Msg.StartParticipantOP:output_type -> verana.pp.v1.MsgStartParticipantOPResponse
+	5,  // 55: verana.pp.v1.Msg.RenewParticipantOP:output_type -> verana.pp.v1.MsgRenewParticipantOPResponse
+	7,  // 56: verana.pp.v1.Msg.SetParticipantOPToValidated:output_type -> verana.pp.v1.MsgSetParticipantOPToValidatedResponse
+	9,  // 57: verana.pp.v1.Msg.CancelParticipantOPLastRequest:output_type -> verana.pp.v1.MsgCancelParticipantOPLastRequestResponse
+	11, // 58: verana.pp.v1.Msg.CreateRootParticipant:output_type -> verana.pp.v1.MsgCreateRootParticipantResponse
+	13, // 59: verana.pp.v1.Msg.SetParticipantEffectiveUntil:output_type -> verana.pp.v1.MsgSetParticipantEffectiveUntilResponse
+	15, // 60: verana.pp.v1.Msg.RevokeParticipant:output_type -> verana.pp.v1.MsgRevokeParticipantResponse
+	17, // 61: verana.pp.v1.Msg.CreateOrUpdateParticipantSession:output_type -> verana.pp.v1.MsgCreateOrUpdateParticipantSessionResponse
+	19, // 62: verana.pp.v1.Msg.SlashParticipantTrustDeposit:output_type -> verana.pp.v1.MsgSlashParticipantTrustDepositResponse
+	21, // 63: verana.pp.v1.Msg.RepayParticipantSlashedTrustDeposit:output_type -> verana.pp.v1.MsgRepayParticipantSlashedTrustDepositResponse
+	23, // 64: verana.pp.v1.Msg.SelfCreateParticipant:output_type -> verana.pp.v1.MsgSelfCreateParticipantResponse
+	25, // 65: verana.pp.v1.Msg.ProposeParticipantFeeChange:output_type -> verana.pp.v1.MsgProposeParticipantFeeChangeResponse
+	27, // 66: verana.pp.v1.Msg.AcceptParticipantFeeChange:output_type -> verana.pp.v1.MsgAcceptParticipantFeeChangeResponse
+	29, // 67: verana.pp.v1.Msg.AppealSlash:output_type -> verana.pp.v1.MsgAppealSlashResponse
+	31, // 68: verana.pp.v1.Msg.ResolveSlashAppeal:output_type -> verana.pp.v1.MsgResolveSlashAppealResponse
+	33, // 69: verana.pp.v1.Msg.SuspendParticipant:output_type -> verana.pp.v1.MsgSuspendParticipantResponse
+	35, // 70: verana.pp.v1.Msg.UnsuspendParticipant:output_type -> verana.pp.v1.MsgUnsuspendParticipantResponse
+	37, // 71: verana.pp.v1.Msg.RotateParticipantDID:output_type -> verana.pp.v1.MsgRotateParticipantDIDResponse
+	39, // 72: verana.pp.v1.Msg.BatchRevokeParticipants:output_type -> verana.pp.v1.MsgBatchRevokeParticipantsResponse
+	41, // 73: verana.pp.v1.Msg.BatchSetParticipantEffectiveUntil:output_type -> verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse
+	43, // 74: verana.pp.v1.Msg.BatchSelfCreateParticipants:output_type -> verana.pp.v1.MsgBatchSelfCreateParticipantsResponse
+	53, // [53:75] is the sub-list for method output_type
+	31, // [31:53] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_verana_pp_v1_tx_proto_init() }
@@ -16965,6 +29147,246 @@ func file_verana_pp_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_verana_pp_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProposeParticipantFeeChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProposeParticipantFeeChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptParticipantFeeChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptParticipantFeeChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAppealSlash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAppealSlashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveSlashAppeal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveSlashAppealResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSuspendParticipant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSuspendParticipantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUnsuspendParticipant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUnsuspendParticipantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRotateParticipantDID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRotateParticipantDIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchRevokeParticipants); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchRevokeParticipantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchSetParticipantEffectiveUntil); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchSetParticipantEffectiveUntilResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchSelfCreateParticipants); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_tx_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchSelfCreateParticipantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -16972,7 +29394,7 @@ func file_verana_pp_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_pp_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,6 +31,16 @@ const (
 	Msg_SlashParticipantTrustDeposit_FullMethodName        = "/verana.pp.v1.Msg/SlashParticipantTrustDeposit"
 	Msg_RepayParticipantSlashedTrustDeposit_FullMethodName = "/verana.pp.v1.Msg/RepayParticipantSlashedTrustDeposit"
 	Msg_SelfCreateParticipant_FullMethodName               = "/verana.pp.v1.Msg/SelfCreateParticipant"
+	Msg_ProposeParticipantFeeChange_FullMethodName         = "/verana.pp.v1.Msg/ProposeParticipantFeeChange"
+	Msg_AcceptParticipantFeeChange_FullMethodName          = "/verana.pp.v1.Msg/AcceptParticipantFeeChange"
+	Msg_AppealSlash_FullMethodName                         = "/verana.pp.v1.Msg/AppealSlash"
+	Msg_ResolveSlashAppeal_FullMethodName                  = "/verana.pp.v1.Msg/ResolveSlashAppeal"
+	Msg_SuspendParticipant_FullMethodName                  = "/verana.pp.v1.Msg/SuspendParticipant"
+	Msg_UnsuspendParticipant_FullMethodName                = "/verana.pp.v1.Msg/UnsuspendParticipant"
+	Msg_RotateParticipantDID_FullMethodName                = "/verana.pp.v1.Msg/RotateParticipantDID"
+	Msg_BatchRevokeParticipants_FullMethodName             = "/verana.pp.v1.Msg/BatchRevokeParticipants"
+	Msg_BatchSetParticipantEffectiveUntil_FullMethodName   = "/verana.pp.v1.Msg/BatchSetParticipantEffectiveUntil"
+	Msg_BatchSelfCreateParticipants_FullMethodName         = "/verana.pp.v1.Msg/BatchSelfCreateParticipants"
 )
 
 // MsgClient is the client API for Msg service.
@@ -54,6 +64,33 @@ type MsgClient interface {
 	RepayParticipantSlashedTrustDeposit(ctx context.Context, in *MsgRepayParticipantSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayParticipantSlashedTrustDepositResponse, error)
 	// [MOD-PP-MSG-14] Self Create Participant (OPEN mode)
 	SelfCreateParticipant(ctx context.Context, in *MsgSelfCreateParticipant, opts ...grpc.CallOption) (*MsgSelfCreateParticipantResponse, error)
+	// ProposeParticipantFeeChange is run by the validator of a participant to
+	// propose new fees and discounts for it.
+	ProposeParticipantFeeChange(ctx context.Context, in *MsgProposeParticipantFeeChange, opts ...grpc.CallOption) (*MsgProposeParticipantFeeChangeResponse, error)
+	// AcceptParticipantFeeChange is run by the participant to accept the
+	// proposed fee change, which takes effect after the notice period.
+	AcceptParticipantFeeChange(ctx context.Context, in *MsgAcceptParticipantFeeChange, opts ...grpc.CallOption) (*MsgAcceptParticipantFeeChangeResponse, error)
+	// AppealSlash is run by the slashed participant corporation during the
+	// dispute window of a slash to escalate it to its arbiter.
+	AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error)
+	// ResolveSlashAppeal is run by the arbiter of an appealed slash, the
+	// ecosystem corporation group policy or x/gov, to confirm or reverse it.
+	ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error)
+	// SuspendParticipant is run by a validator ancestor or the ecosystem
+	// controller to pause a participant until it is unsuspended.
+	SuspendParticipant(ctx context.Context, in *MsgSuspendParticipant, opts ...grpc.CallOption) (*MsgSuspendParticipantResponse, error)
+	// UnsuspendParticipant lifts the suspension of a participant.
+	UnsuspendParticipant(ctx context.Context, in *MsgUnsuspendParticipant, opts ...grpc.CallOption) (*MsgUnsuspendParticipantResponse, error)
+	// RotateParticipantDID is run by the participant corporation to change the
+	// did of the participant, the previous one being kept in its did history.
+	RotateParticipantDID(ctx context.Context, in *MsgRotateParticipantDID, opts ...grpc.CallOption) (*MsgRotateParticipantDIDResponse, error)
+	// BatchRevokeParticipants, BatchSetParticipantEffectiveUntil and
+	// BatchSelfCreateParticipants apply a list of operations atomically, with a
+	// single operator authorization check; the batch size is capped by the
+	// max_batch_size param.
+	BatchRevokeParticipants(ctx context.Context, in *MsgBatchRevokeParticipants, opts ...grpc.CallOption) (*MsgBatchRevokeParticipantsResponse, error)
+	BatchSetParticipantEffectiveUntil(ctx context.Context, in *MsgBatchSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgBatchSetParticipantEffectiveUntilResponse, error)
+	BatchSelfCreateParticipants(ctx context.Context, in *MsgBatchSelfCreateParticipants, opts ...grpc.CallOption) (*MsgBatchSelfCreateParticipantsResponse, error)
 }
 
 type msgClient struct {
@@ -184,6 +221,106 @@ func (c *msgClient) SelfCreateParticipant(ctx context.Context, in *MsgSelfCreate
 	return out, nil
 }
 
+func (c *msgClient) ProposeParticipantFeeChange(ctx context.Context, in *MsgProposeParticipantFeeChange, opts ...grpc.CallOption) (*MsgProposeParticipantFeeChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgProposeParticipantFeeChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeParticipantFeeChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptParticipantFeeChange(ctx context.Context, in *MsgAcceptParticipantFeeChange, opts ...grpc.CallOption) (*MsgAcceptParticipantFeeChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptParticipantFeeChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptParticipantFeeChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAppealSlashResponse)
+	err := c.cc.Invoke(ctx, Msg_AppealSlash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResolveSlashAppealResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveSlashAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuspendParticipant(ctx context.Context, in *MsgSuspendParticipant, opts ...grpc.CallOption) (*MsgSuspendParticipantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSuspendParticipantResponse)
+	err := c.cc.Invoke(ctx, Msg_SuspendParticipant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnsuspendParticipant(ctx context.Context, in *MsgUnsuspendParticipant, opts ...grpc.CallOption) (*MsgUnsuspendParticipantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnsuspendParticipantResponse)
+	err := c.cc.Invoke(ctx, Msg_UnsuspendParticipant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateParticipantDID(ctx context.Context, in *MsgRotateParticipantDID, opts ...grpc.CallOption) (*MsgRotateParticipantDIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRotateParticipantDIDResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateParticipantDID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchRevokeParticipants(ctx context.Context, in *MsgBatchRevokeParticipants, opts ...grpc.CallOption) (*MsgBatchRevokeParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchRevokeParticipantsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchRevokeParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchSetParticipantEffectiveUntil(ctx context.Context, in *MsgBatchSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgBatchSetParticipantEffectiveUntilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchSetParticipantEffectiveUntilResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchSetParticipantEffectiveUntil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchSelfCreateParticipants(ctx context.Context, in *MsgBatchSelfCreateParticipants, opts ...grpc.CallOption) (*MsgBatchSelfCreateParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchSelfCreateParticipantsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchSelfCreateParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -205,6 +342,33 @@ type MsgServer interface {
 	RepayParticipantSlashedTrustDeposit(context.Context, *MsgRepayParticipantSlashedTrustDeposit) (*MsgRepayParticipantSlashedTrustDepositResponse, error)
 	// [MOD-PP-MSG-14] Self Create Participant (OPEN mode)
 	SelfCreateParticipant(context.Context, *MsgSelfCreateParticipant) (*MsgSelfCreateParticipantResponse, error)
+	// ProposeParticipantFeeChange is run by the validator of a participant to
+	// propose new fees and discounts for it.
+	ProposeParticipantFeeChange(context.Context, *MsgProposeParticipantFeeChange) (*MsgProposeParticipantFeeChangeResponse, error)
+	// AcceptParticipantFeeChange is run by the participant to accept the
+	// proposed fee change, which takes effect after the notice period.
+	AcceptParticipantFeeChange(context.Context, *MsgAcceptParticipantFeeChange) (*MsgAcceptParticipantFeeChangeResponse, error)
+	// AppealSlash is run by the slashed participant corporation during the
+	// dispute window of a slash to escalate it to its arbiter.
+	AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error)
+	// ResolveSlashAppeal is run by the arbiter of an appealed slash, the
+	// ecosystem corporation group policy or x/gov, to confirm or reverse it.
+	ResolveSlashAppeal(context.Context, *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error)
+	// SuspendParticipant is run by a validator ancestor or the ecosystem
+	// controller to pause a participant until it is unsuspended.
+	SuspendParticipant(context.Context, *MsgSuspendParticipant) (*MsgSuspendParticipantResponse, error)
+	// UnsuspendParticipant lifts the suspension of a participant.
+	UnsuspendParticipant(context.Context, *MsgUnsuspendParticipant) (*MsgUnsuspendParticipantResponse, error)
+	// RotateParticipantDID is run by the participant corporation to change the
+	// did of the participant, the previous one being kept in its did history.
+	RotateParticipantDID(context.Context, *MsgRotateParticipantDID) (*MsgRotateParticipantDIDResponse, error)
+	// BatchRevokeParticipants, BatchSetParticipantEffectiveUntil and
+	// BatchSelfCreateParticipants apply a list of operations atomically, with a
+	// single operator authorization check; the batch size is capped by the
+	// max_batch_size param.
+	BatchRevokeParticipants(context.Context, *MsgBatchRevokeParticipants) (*MsgBatchRevokeParticipantsResponse, error)
+	BatchSetParticipantEffectiveUntil(context.Context, *MsgBatchSetParticipantEffectiveUntil) (*MsgBatchSetParticipantEffectiveUntilResponse, error)
+	BatchSelfCreateParticipants(context.Context, *MsgBatchSelfCreateParticipants) (*MsgBatchSelfCreateParticipantsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -251,6 +415,36 @@ func (UnimplementedMsgServer) RepayParticipantSlashedTrustDeposit(context.Contex
 func (UnimplementedMsgServer) SelfCreateParticipant(context.Context, *MsgSelfCreateParticipant) (*MsgSelfCreateParticipantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelfCreateParticipant not implemented")
 }
+func (UnimplementedMsgServer) ProposeParticipantFeeChange(context.Context, *MsgProposeParticipantFeeChange) (*MsgProposeParticipantFeeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeParticipantFeeChange not implemented")
+}
+func (UnimplementedMsgServer) AcceptParticipantFeeChange(context.Context, *MsgAcceptParticipantFeeChange) (*MsgAcceptParticipantFeeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptParticipantFeeChange not implemented")
+}
+func (UnimplementedMsgServer) AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealSlash not implemented")
+}
+func (UnimplementedMsgServer) ResolveSlashAppeal(context.Context, *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSlashAppeal not implemented")
+}
+func (UnimplementedMsgServer) SuspendParticipant(context.Context, *MsgSuspendParticipant) (*MsgSuspendParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendParticipant not implemented")
+}
+func (UnimplementedMsgServer) UnsuspendParticipant(context.Context, *MsgUnsuspendParticipant) (*MsgUnsuspendParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendParticipant not implemented")
+}
+func (UnimplementedMsgServer) RotateParticipantDID(context.Context, *MsgRotateParticipantDID) (*MsgRotateParticipantDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateParticipantDID not implemented")
+}
+func (UnimplementedMsgServer) BatchRevokeParticipants(context.Context, *MsgBatchRevokeParticipants) (*MsgBatchRevokeParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRevokeParticipants not implemented")
+}
+func (UnimplementedMsgServer) BatchSetParticipantEffectiveUntil(context.Context, *MsgBatchSetParticipantEffectiveUntil) (*MsgBatchSetParticipantEffectiveUntilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSetParticipantEffectiveUntil not implemented")
+}
+func (UnimplementedMsgServer) BatchSelfCreateParticipants(context.Context, *MsgBatchSelfCreateParticipants) (*MsgBatchSelfCreateParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSelfCreateParticipants not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -488,6 +682,186 @@ func _Msg_SelfCreateParticipant_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposeParticipantFeeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeParticipantFeeChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeParticipantFeeChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeParticipantFeeChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeParticipantFeeChange(ctx, req.(*MsgProposeParticipantFeeChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptParticipantFeeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptParticipantFeeChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptParticipantFeeChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptParticipantFeeChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptParticipantFeeChange(ctx, req.(*MsgAcceptParticipantFeeChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppealSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AppealSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealSlash(ctx, req.(*MsgAppealSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveSlashAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveSlashAppeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveSlashAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, req.(*MsgResolveSlashAppeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuspendParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SuspendParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendParticipant(ctx, req.(*MsgSuspendParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsuspendParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsuspendParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsuspendParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnsuspendParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsuspendParticipant(ctx, req.(*MsgUnsuspendParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateParticipantDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateParticipantDID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateParticipantDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateParticipantDID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateParticipantDID(ctx, req.(*MsgRotateParticipantDID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchRevokeParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchRevokeParticipants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchRevokeParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchRevokeParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchRevokeParticipants(ctx, req.(*MsgBatchRevokeParticipants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchSetParticipantEffectiveUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSetParticipantEffectiveUntil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSetParticipantEffectiveUntil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchSetParticipantEffectiveUntil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSetParticipantEffectiveUntil(ctx, req.(*MsgBatchSetParticipantEffectiveUntil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchSelfCreateParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSelfCreateParticipants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSelfCreateParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchSelfCreateParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSelfCreateParticipants(ctx, req.(*MsgBatchSelfCreateParticipants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -543,6 +917,46 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SelfCreateParticipant",
 			Handler:    _Msg_SelfCreateParticipant_Handler,
 		},
+		{
+			MethodName: "ProposeParticipantFeeChange",
+			Handler:    _Msg_ProposeParticipantFeeChange_Handler,
+		},
+		{
+			MethodName: "AcceptParticipantFeeChange",
+			Handler:    _Msg_AcceptParticipantFeeChange_Handler,
+		},
+		{
+			MethodName: "AppealSlash",
+			Handler:    _Msg_AppealSlash_Handler,
+		},
+		{
+			MethodName: "ResolveSlashAppeal",
+			Handler:    _Msg_ResolveSlashAppeal_Handler,
+		},
+		{
+			MethodName: "SuspendParticipant",
+			Handler:    _Msg_SuspendParticipant_Handler,
+		},
+		{
+			MethodName: "UnsuspendParticipant",
+			Handler:    _Msg_UnsuspendParticipant_Handler,
+		},
+		{
+			MethodName: "RotateParticipantDID",
+			Handler:    _Msg_RotateParticipantDID_Handler,
+		},
+		{
+			MethodName: "BatchRevokeParticipants",
+			Handler:    _Msg_BatchRevokeParticipants_Handler,
+		},
+		{
+			MethodName: "BatchSetParticipantEffectiveUntil",
+			Handler:    _Msg_BatchSetParticipantEffectiveUntil_Handler,
+		},
+		{
+			MethodName: "BatchSelfCreateParticipants",
+			Handler:    _Msg_BatchSelfCreateParticipants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/pp/v1/tx.proto",
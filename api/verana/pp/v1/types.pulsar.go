@@ -15,38 +15,201 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Participant_48_list)(nil)
+
+type _Participant_48_list struct {
+	list *[]*ParticipantSlash
+}
+
+func (x *_Participant_48_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Participant_48_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Participant_48_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantSlash)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Participant_48_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantSlash)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Participant_48_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantSlash)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Participant_48_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Participant_48_list) NewElement() protoreflect.Value {
+	v := new(ParticipantSlash)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Participant_48_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Participant_50_list)(nil)
+
+type _Participant_50_list struct {
+	list *[]*ParticipantSuspension
+}
+
+func (x *_Participant_50_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Participant_50_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Participant_50_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantSuspension)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Participant_50_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantSuspension)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Participant_50_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantSuspension)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Participant_50_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Participant_50_list) NewElement() protoreflect.Value {
+	v := new(ParticipantSuspension)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Participant_50_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Participant_51_list)(nil)
+
+type _Participant_51_list struct {
+	list *[]*ParticipantDIDRecord
+}
+
+func (x *_Participant_51_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Participant_51_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Participant_51_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantDIDRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Participant_51_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantDIDRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Participant_51_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantDIDRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Participant_51_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Participant_51_list) NewElement() protoreflect.Value {
+	v := new(ParticipantDIDRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Participant_51_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Participant                           protoreflect.MessageDescriptor
-	fd_Participant_id                        protoreflect.FieldDescriptor
-	fd_Participant_schema_id                 protoreflect.FieldDescriptor
-	fd_Participant_role                      protoreflect.FieldDescriptor
-	fd_Participant_did                       protoreflect.FieldDescriptor
-	fd_Participant_created                   protoreflect.FieldDescriptor
-	fd_Participant_adjusted                  protoreflect.FieldDescriptor
-	fd_Participant_slashed                   protoreflect.FieldDescriptor
-	fd_Participant_repaid                    protoreflect.FieldDescriptor
-	fd_Participant_effective_from            protoreflect.FieldDescriptor
-	fd_Participant_effective_until           protoreflect.FieldDescriptor
-	fd_Participant_modified                  protoreflect.FieldDescriptor
-	fd_Participant_validation_fees           protoreflect.FieldDescriptor
-	fd_Participant_issuance_fees             protoreflect.FieldDescriptor
-	fd_Participant_verification_fees         protoreflect.FieldDescriptor
-	fd_Participant_deposit                   protoreflect.FieldDescriptor
-	fd_Participant_slashed_deposit           protoreflect.FieldDescriptor
-	fd_Participant_repaid_deposit            protoreflect.FieldDescriptor
-	fd_Participant_revoked                   protoreflect.FieldDescriptor
-	fd_Participant_validator_participant_id  protoreflect.FieldDescriptor
-	fd_Participant_op_state                  protoreflect.FieldDescriptor
-	fd_Participant_op_exp                    protoreflect.FieldDescriptor
-	fd_Participant_op_last_state_change      protoreflect.FieldDescriptor
-	fd_Participant_op_validator_deposit      protoreflect.FieldDescriptor
-	fd_Participant_op_current_fees           protoreflect.FieldDescriptor
-	fd_Participant_op_current_deposit        protoreflect.FieldDescriptor
-	fd_Participant_op_summary_digest         protoreflect.FieldDescriptor
-	fd_Participant_issuance_fee_discount     protoreflect.FieldDescriptor
-	fd_Participant_verification_fee_discount protoreflect.FieldDescriptor
-	fd_Participant_vs_operator               protoreflect.FieldDescriptor
-	fd_Participant_corporation_id            protoreflect.FieldDescriptor
+	md_Participant                                  protoreflect.MessageDescriptor
+	fd_Participant_id                               protoreflect.FieldDescriptor
+	fd_Participant_schema_id                        protoreflect.FieldDescriptor
+	fd_Participant_role                             protoreflect.FieldDescriptor
+	fd_Participant_did                              protoreflect.FieldDescriptor
+	fd_Participant_created                          protoreflect.FieldDescriptor
+	fd_Participant_adjusted                         protoreflect.FieldDescriptor
+	fd_Participant_slashed                          protoreflect.FieldDescriptor
+	fd_Participant_repaid                           protoreflect.FieldDescriptor
+	fd_Participant_effective_from                   protoreflect.FieldDescriptor
+	fd_Participant_effective_until                  protoreflect.FieldDescriptor
+	fd_Participant_modified                         protoreflect.FieldDescriptor
+	fd_Participant_validation_fees                  protoreflect.FieldDescriptor
+	fd_Participant_issuance_fees                    protoreflect.FieldDescriptor
+	fd_Participant_verification_fees                protoreflect.FieldDescriptor
+	fd_Participant_deposit                          protoreflect.FieldDescriptor
+	fd_Participant_slashed_deposit                  protoreflect.FieldDescriptor
+	fd_Participant_repaid_deposit                   protoreflect.FieldDescriptor
+	fd_Participant_revoked                          protoreflect.FieldDescriptor
+	fd_Participant_validator_participant_id         protoreflect.FieldDescriptor
+	fd_Participant_op_state                         protoreflect.FieldDescriptor
+	fd_Participant_op_exp                           protoreflect.FieldDescriptor
+	fd_Participant_op_last_state_change             protoreflect.FieldDescriptor
+	fd_Participant_op_validator_deposit             protoreflect.FieldDescriptor
+	fd_Participant_op_current_fees                  protoreflect.FieldDescriptor
+	fd_Participant_op_current_deposit               protoreflect.FieldDescriptor
+	fd_Participant_op_summary_digest                protoreflect.FieldDescriptor
+	fd_Participant_issuance_fee_discount            protoreflect.FieldDescriptor
+	fd_Participant_verification_fee_discount        protoreflect.FieldDescriptor
+	fd_Participant_vs_operator                      protoreflect.FieldDescriptor
+	fd_Participant_corporation_id                   protoreflect.FieldDescriptor
+	fd_Participant_revocation_reason                protoreflect.FieldDescriptor
+	fd_Participant_revocation_evidence              protoreflect.FieldDescriptor
+	fd_Participant_revocation_evidence_digest       protoreflect.FieldDescriptor
+	fd_Participant_pending_fee_change               protoreflect.FieldDescriptor
+	fd_Participant_slashes                          protoreflect.FieldDescriptor
+	fd_Participant_suspended                        protoreflect.FieldDescriptor
+	fd_Participant_suspensions                      protoreflect.FieldDescriptor
+	fd_Participant_did_history                      protoreflect.FieldDescriptor
+	fd_Participant_released_deposit                 protoreflect.FieldDescriptor
+	fd_Participant_released_deposit_unbonding_until protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -82,6 +245,16 @@ func init() {
 	fd_Participant_verification_fee_discount = md_Participant.Fields().ByName("verification_fee_discount")
 	fd_Participant_vs_operator = md_Participant.Fields().ByName("vs_operator")
 	fd_Participant_corporation_id = md_Participant.Fields().ByName("corporation_id")
+	fd_Participant_revocation_reason = md_Participant.Fields().ByName("revocation_reason")
+	fd_Participant_revocation_evidence = md_Participant.Fields().ByName("revocation_evidence")
+	fd_Participant_revocation_evidence_digest = md_Participant.Fields().ByName("revocation_evidence_digest")
+	fd_Participant_pending_fee_change = md_Participant.Fields().ByName("pending_fee_change")
+	fd_Participant_slashes = md_Participant.Fields().ByName("slashes")
+	fd_Participant_suspended = md_Participant.Fields().ByName("suspended")
+	fd_Participant_suspensions = md_Participant.Fields().ByName("suspensions")
+	fd_Participant_did_history = md_Participant.Fields().ByName("did_history")
+	fd_Participant_released_deposit = md_Participant.Fields().ByName("released_deposit")
+	fd_Participant_released_deposit_unbonding_until = md_Participant.Fields().ByName("released_deposit_unbonding_until")
 }
 
 var _ protoreflect.Message = (*fastReflection_Participant)(nil)
@@ -329,6 +502,66 @@ func (x *fastReflection_Participant) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.RevocationReason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.RevocationReason))
+		if !f(fd_Participant_revocation_reason, value) {
+			return
+		}
+	}
+	if x.RevocationEvidence != "" {
+		value := protoreflect.ValueOfString(x.RevocationEvidence)
+		if !f(fd_Participant_revocation_evidence, value) {
+			return
+		}
+	}
+	if x.RevocationEvidenceDigest != "" {
+		value := protoreflect.ValueOfString(x.RevocationEvidenceDigest)
+		if !f(fd_Participant_revocation_evidence_digest, value) {
+			return
+		}
+	}
+	if x.PendingFeeChange != nil {
+		value := protoreflect.ValueOfMessage(x.PendingFeeChange.ProtoReflect())
+		if !f(fd_Participant_pending_fee_change, value) {
+			return
+		}
+	}
+	if len(x.Slashes) != 0 {
+		value := protoreflect.ValueOfList(&_Participant_48_list{list: &x.Slashes})
+		if !f(fd_Participant_slashes, value) {
+			return
+		}
+	}
+	if x.Suspended != nil {
+		value := protoreflect.ValueOfMessage(x.Suspended.ProtoReflect())
+		if !f(fd_Participant_suspended, value) {
+			return
+		}
+	}
+	if len(x.Suspensions) != 0 {
+		value := protoreflect.ValueOfList(&_Participant_50_list{list: &x.Suspensions})
+		if !f(fd_Participant_suspensions, value) {
+			return
+		}
+	}
+	if len(x.DidHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Participant_51_list{list: &x.DidHistory})
+		if !f(fd_Participant_did_history, value) {
+			return
+		}
+	}
+	if x.ReleasedDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReleasedDeposit)
+		if !f(fd_Participant_released_deposit, value) {
+			return
+		}
+	}
+	if x.ReleasedDepositUnbondingUntil != nil {
+		value := protoreflect.ValueOfMessage(x.ReleasedDepositUnbondingUntil.ProtoReflect())
+		if !f(fd_Participant_released_deposit_unbonding_until, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -404,6 +637,26 @@ func (x *fastReflection_Participant) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.VsOperator != ""
 	case "verana.pp.v1.Participant.corporation_id":
 		return x.CorporationId != uint64(0)
+	case "verana.pp.v1.Participant.revocation_reason":
+		return x.RevocationReason != 0
+	case "verana.pp.v1.Participant.revocation_evidence":
+		return x.RevocationEvidence != ""
+	case "verana.pp.v1.Participant.revocation_evidence_digest":
+		return x.RevocationEvidenceDigest != ""
+	case "verana.pp.v1.Participant.pending_fee_change":
+		return x.PendingFeeChange != nil
+	case "verana.pp.v1.Participant.slashes":
+		return len(x.Slashes) != 0
+	case "verana.pp.v1.Participant.suspended":
+		return x.Suspended != nil
+	case "verana.pp.v1.Participant.suspensions":
+		return len(x.Suspensions) != 0
+	case "verana.pp.v1.Participant.did_history":
+		return len(x.DidHistory) != 0
+	case "verana.pp.v1.Participant.released_deposit":
+		return x.ReleasedDeposit != uint64(0)
+	case "verana.pp.v1.Participant.released_deposit_unbonding_until":
+		return x.ReleasedDepositUnbondingUntil != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Participant"))
@@ -480,6 +733,26 @@ func (x *fastReflection_Participant) Clear(fd protoreflect.FieldDescriptor) {
 		x.VsOperator = ""
 	case "verana.pp.v1.Participant.corporation_id":
 		x.CorporationId = uint64(0)
+	case "verana.pp.v1.Participant.revocation_reason":
+		x.RevocationReason = 0
+	case "verana.pp.v1.Participant.revocation_evidence":
+		x.RevocationEvidence = ""
+	case "verana.pp.v1.Participant.revocation_evidence_digest":
+		x.RevocationEvidenceDigest = ""
+	case "verana.pp.v1.Participant.pending_fee_change":
+		x.PendingFeeChange = nil
+	case "verana.pp.v1.Participant.slashes":
+		x.Slashes = nil
+	case "verana.pp.v1.Participant.suspended":
+		x.Suspended = nil
+	case "verana.pp.v1.Participant.suspensions":
+		x.Suspensions = nil
+	case "verana.pp.v1.Participant.did_history":
+		x.DidHistory = nil
+	case "verana.pp.v1.Participant.released_deposit":
+		x.ReleasedDeposit = uint64(0)
+	case "verana.pp.v1.Participant.released_deposit_unbonding_until":
+		x.ReleasedDepositUnbondingUntil = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Participant"))
@@ -586,6 +859,45 @@ func (x *fastReflection_Participant) Get(descriptor protoreflect.FieldDescriptor
 	case "verana.pp.v1.Participant.corporation_id":
 		value := x.CorporationId
 		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.Participant.revocation_reason":
+		value := x.RevocationReason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.Participant.revocation_evidence":
+		value := x.RevocationEvidence
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.Participant.revocation_evidence_digest":
+		value := x.RevocationEvidenceDigest
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.Participant.pending_fee_change":
+		value := x.PendingFeeChange
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.Participant.slashes":
+		if len(x.Slashes) == 0 {
+			return protoreflect.ValueOfList(&_Participant_48_list{})
+		}
+		listValue := &_Participant_48_list{list: &x.Slashes}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.Participant.suspended":
+		value := x.Suspended
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.Participant.suspensions":
+		if len(x.Suspensions) == 0 {
+			return protoreflect.ValueOfList(&_Participant_50_list{})
+		}
+		listValue := &_Participant_50_list{list: &x.Suspensions}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.Participant.did_history":
+		if len(x.DidHistory) == 0 {
+			return protoreflect.ValueOfList(&_Participant_51_list{})
+		}
+		listValue := &_Participant_51_list{list: &x.DidHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.Participant.released_deposit":
+		value := x.ReleasedDeposit
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.Participant.released_deposit_unbonding_until":
+		value := x.ReleasedDepositUnbondingUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Participant"))
@@ -666,6 +978,32 @@ func (x *fastReflection_Participant) Set(fd protoreflect.FieldDescriptor, value
 		x.VsOperator = value.Interface().(string)
 	case "verana.pp.v1.Participant.corporation_id":
 		x.CorporationId = value.Uint()
+	case "verana.pp.v1.Participant.revocation_reason":
+		x.RevocationReason = (RevocationReason)(value.Enum())
+	case "verana.pp.v1.Participant.revocation_evidence":
+		x.RevocationEvidence = value.Interface().(string)
+	case "verana.pp.v1.Participant.revocation_evidence_digest":
+		x.RevocationEvidenceDigest = value.Interface().(string)
+	case "verana.pp.v1.Participant.pending_fee_change":
+		x.PendingFeeChange = value.Message().Interface().(*ParticipantFeeChange)
+	case "verana.pp.v1.Participant.slashes":
+		lv := value.List()
+		clv := lv.(*_Participant_48_list)
+		x.Slashes = *clv.list
+	case "verana.pp.v1.Participant.suspended":
+		x.Suspended = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.Participant.suspensions":
+		lv := value.List()
+		clv := lv.(*_Participant_50_list)
+		x.Suspensions = *clv.list
+	case "verana.pp.v1.Participant.did_history":
+		lv := value.List()
+		clv := lv.(*_Participant_51_list)
+		x.DidHistory = *clv.list
+	case "verana.pp.v1.Participant.released_deposit":
+		x.ReleasedDeposit = value.Uint()
+	case "verana.pp.v1.Participant.released_deposit_unbonding_until":
+		x.ReleasedDepositUnbondingUntil = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Participant"))
@@ -736,6 +1074,39 @@ func (x *fastReflection_Participant) Mutable(fd protoreflect.FieldDescriptor) pr
 			x.OpLastStateChange = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.OpLastStateChange.ProtoReflect())
+	case "verana.pp.v1.Participant.pending_fee_change":
+		if x.PendingFeeChange == nil {
+			x.PendingFeeChange = new(ParticipantFeeChange)
+		}
+		return protoreflect.ValueOfMessage(x.PendingFeeChange.ProtoReflect())
+	case "verana.pp.v1.Participant.slashes":
+		if x.Slashes == nil {
+			x.Slashes = []*ParticipantSlash{}
+		}
+		value := &_Participant_48_list{list: &x.Slashes}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.Participant.suspended":
+		if x.Suspended == nil {
+			x.Suspended = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Suspended.ProtoReflect())
+	case "verana.pp.v1.Participant.suspensions":
+		if x.Suspensions == nil {
+			x.Suspensions = []*ParticipantSuspension{}
+		}
+		value := &_Participant_50_list{list: &x.Suspensions}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.Participant.did_history":
+		if x.DidHistory == nil {
+			x.DidHistory = []*ParticipantDIDRecord{}
+		}
+		value := &_Participant_51_list{list: &x.DidHistory}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.Participant.released_deposit_unbonding_until":
+		if x.ReleasedDepositUnbondingUntil == nil {
+			x.ReleasedDepositUnbondingUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ReleasedDepositUnbondingUntil.ProtoReflect())
 	case "verana.pp.v1.Participant.id":
 		panic(fmt.Errorf("field id of message verana.pp.v1.Participant is not mutable"))
 	case "verana.pp.v1.Participant.schema_id":
@@ -776,6 +1147,14 @@ func (x *fastReflection_Participant) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field vs_operator of message verana.pp.v1.Participant is not mutable"))
 	case "verana.pp.v1.Participant.corporation_id":
 		panic(fmt.Errorf("field corporation_id of message verana.pp.v1.Participant is not mutable"))
+	case "verana.pp.v1.Participant.revocation_reason":
+		panic(fmt.Errorf("field revocation_reason of message verana.pp.v1.Participant is not mutable"))
+	case "verana.pp.v1.Participant.revocation_evidence":
+		panic(fmt.Errorf("field revocation_evidence of message verana.pp.v1.Participant is not mutable"))
+	case "verana.pp.v1.Participant.revocation_evidence_digest":
+		panic(fmt.Errorf("field revocation_evidence_digest of message verana.pp.v1.Participant is not mutable"))
+	case "verana.pp.v1.Participant.released_deposit":
+		panic(fmt.Errorf("field released_deposit of message verana.pp.v1.Participant is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Participant"))
@@ -859,6 +1238,32 @@ func (x *fastReflection_Participant) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfString("")
 	case "verana.pp.v1.Participant.corporation_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.Participant.revocation_reason":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.Participant.revocation_evidence":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.Participant.revocation_evidence_digest":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.Participant.pending_fee_change":
+		m := new(ParticipantFeeChange)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.Participant.slashes":
+		list := []*ParticipantSlash{}
+		return protoreflect.ValueOfList(&_Participant_48_list{list: &list})
+	case "verana.pp.v1.Participant.suspended":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.Participant.suspensions":
+		list := []*ParticipantSuspension{}
+		return protoreflect.ValueOfList(&_Participant_50_list{list: &list})
+	case "verana.pp.v1.Participant.did_history":
+		list := []*ParticipantDIDRecord{}
+		return protoreflect.ValueOfList(&_Participant_51_list{list: &list})
+	case "verana.pp.v1.Participant.released_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.Participant.released_deposit_unbonding_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.Participant"))
@@ -1031,6 +1436,50 @@ func (x *fastReflection_Participant) ProtoMethods() *protoiface.Methods {
 		if x.CorporationId != 0 {
 			n += 2 + runtime.Sov(uint64(x.CorporationId))
 		}
+		if x.RevocationReason != 0 {
+			n += 2 + runtime.Sov(uint64(x.RevocationReason))
+		}
+		l = len(x.RevocationEvidence)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RevocationEvidenceDigest)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingFeeChange != nil {
+			l = options.Size(x.PendingFeeChange)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Slashes) > 0 {
+			for _, e := range x.Slashes {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Suspended != nil {
+			l = options.Size(x.Suspended)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Suspensions) > 0 {
+			for _, e := range x.Suspensions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DidHistory) > 0 {
+			for _, e := range x.DidHistory {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ReleasedDeposit != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReleasedDeposit))
+		}
+		if x.ReleasedDepositUnbondingUntil != nil {
+			l = options.Size(x.ReleasedDepositUnbondingUntil)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1060,55 +1509,189 @@ func (x *fastReflection_Participant) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CorporationId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xd8
-		}
-		if len(x.VsOperator) > 0 {
-			i -= len(x.VsOperator)
-			copy(dAtA[i:], x.VsOperator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VsOperator)))
+		if x.ReleasedDepositUnbondingUntil != nil {
+			encoded, err := options.Marshal(x.ReleasedDepositUnbondingUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xaa
 		}
-		if x.VerificationFeeDiscount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFeeDiscount))
+		if x.ReleasedDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReleasedDeposit))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xa0
 		}
-		if x.IssuanceFeeDiscount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFeeDiscount))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x98
-		}
-		if len(x.OpSummaryDigest) > 0 {
-			i -= len(x.OpSummaryDigest)
-			copy(dAtA[i:], x.OpSummaryDigest)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OpSummaryDigest)))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x8a
-		}
-		if x.OpCurrentDeposit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpCurrentDeposit))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x80
+		if len(x.DidHistory) > 0 {
+			for iNdEx := len(x.DidHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DidHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x9a
+			}
 		}
-		if x.OpCurrentFees != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpCurrentFees))
-			i--
+		if len(x.Suspensions) > 0 {
+			for iNdEx := len(x.Suspensions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Suspensions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.Suspended != nil {
+			encoded, err := options.Marshal(x.Suspended)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.Slashes) > 0 {
+			for iNdEx := len(x.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Slashes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.PendingFeeChange != nil {
+			encoded, err := options.Marshal(x.PendingFeeChange)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.RevocationEvidenceDigest) > 0 {
+			i -= len(x.RevocationEvidenceDigest)
+			copy(dAtA[i:], x.RevocationEvidenceDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevocationEvidenceDigest)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.RevocationEvidence) > 0 {
+			i -= len(x.RevocationEvidence)
+			copy(dAtA[i:], x.RevocationEvidence)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevocationEvidence)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+		if x.RevocationReason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevocationReason))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe0
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd8
+		}
+		if len(x.VsOperator) > 0 {
+			i -= len(x.VsOperator)
+			copy(dAtA[i:], x.VsOperator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VsOperator)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.VerificationFeeDiscount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFeeDiscount))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.IssuanceFeeDiscount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFeeDiscount))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.OpSummaryDigest) > 0 {
+			i -= len(x.OpSummaryDigest)
+			copy(dAtA[i:], x.OpSummaryDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OpSummaryDigest)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.OpCurrentDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpCurrentDeposit))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.OpCurrentFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpCurrentFees))
+			i--
 			dAtA[i] = 0x1
 			i--
 			dAtA[i] = 0xf8
@@ -2174,29 +2757,341 @@ func (x *fastReflection_Participant) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 44:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
+				}
+				x.RevocationReason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevocationReason |= RevocationReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 45:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevocationEvidence", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevocationEvidence = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 46:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevocationEvidenceDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevocationEvidenceDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 47:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingFeeChange", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingFeeChange == nil {
+					x.PendingFeeChange = &ParticipantFeeChange{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingFeeChange); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 48:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Slashes = append(x.Slashes, &ParticipantSlash{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Slashes[len(x.Slashes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+				iNdEx = postIndex
+			case 49:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Suspended == nil {
+					x.Suspended = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Suspended); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 50:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Suspensions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Suspensions = append(x.Suspensions, &ParticipantSuspension{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Suspensions[len(x.Suspensions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 51:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DidHistory = append(x.DidHistory, &ParticipantDIDRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidHistory[len(x.DidHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 52:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedDeposit", wireType)
+				}
+				x.ReleasedDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReleasedDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 53:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedDepositUnbondingUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReleasedDepositUnbondingUntil == nil {
+					x.ReleasedDepositUnbondingUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleasedDepositUnbondingUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
 	return &protoiface.Methods{
 		NoUnkeyedLiterals: struct{}{},
@@ -2209,87 +3104,30 @@ func (x *fastReflection_Participant) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ParticipantSession_6_list)(nil)
-
-type _ParticipantSession_6_list struct {
-	list *[]*ParticipantSessionRecord
-}
-
-func (x *_ParticipantSession_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ParticipantSession_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_ParticipantSession_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ParticipantSessionRecord)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ParticipantSession_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ParticipantSessionRecord)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ParticipantSession_6_list) AppendMutable() protoreflect.Value {
-	v := new(ParticipantSessionRecord)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ParticipantSession_6_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ParticipantSession_6_list) NewElement() protoreflect.Value {
-	v := new(ParticipantSessionRecord)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ParticipantSession_6_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ParticipantSession                 protoreflect.MessageDescriptor
-	fd_ParticipantSession_id              protoreflect.FieldDescriptor
-	fd_ParticipantSession_corporation_id  protoreflect.FieldDescriptor
-	fd_ParticipantSession_vs_operator     protoreflect.FieldDescriptor
-	fd_ParticipantSession_created         protoreflect.FieldDescriptor
-	fd_ParticipantSession_modified        protoreflect.FieldDescriptor
-	fd_ParticipantSession_session_records protoreflect.FieldDescriptor
+	md_ParticipantDIDRecord             protoreflect.MessageDescriptor
+	fd_ParticipantDIDRecord_did         protoreflect.FieldDescriptor
+	fd_ParticipantDIDRecord_valid_from  protoreflect.FieldDescriptor
+	fd_ParticipantDIDRecord_valid_until protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_types_proto_init()
-	md_ParticipantSession = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantSession")
-	fd_ParticipantSession_id = md_ParticipantSession.Fields().ByName("id")
-	fd_ParticipantSession_corporation_id = md_ParticipantSession.Fields().ByName("corporation_id")
-	fd_ParticipantSession_vs_operator = md_ParticipantSession.Fields().ByName("vs_operator")
-	fd_ParticipantSession_created = md_ParticipantSession.Fields().ByName("created")
-	fd_ParticipantSession_modified = md_ParticipantSession.Fields().ByName("modified")
-	fd_ParticipantSession_session_records = md_ParticipantSession.Fields().ByName("session_records")
+	md_ParticipantDIDRecord = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantDIDRecord")
+	fd_ParticipantDIDRecord_did = md_ParticipantDIDRecord.Fields().ByName("did")
+	fd_ParticipantDIDRecord_valid_from = md_ParticipantDIDRecord.Fields().ByName("valid_from")
+	fd_ParticipantDIDRecord_valid_until = md_ParticipantDIDRecord.Fields().ByName("valid_until")
 }
 
-var _ protoreflect.Message = (*fastReflection_ParticipantSession)(nil)
+var _ protoreflect.Message = (*fastReflection_ParticipantDIDRecord)(nil)
 
-type fastReflection_ParticipantSession ParticipantSession
+type fastReflection_ParticipantDIDRecord ParticipantDIDRecord
 
-func (x *ParticipantSession) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ParticipantSession)(x)
+func (x *ParticipantDIDRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantDIDRecord)(x)
 }
 
-func (x *ParticipantSession) slowProtoReflect() protoreflect.Message {
+func (x *ParticipantDIDRecord) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_pp_v1_types_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2301,43 +3139,43 @@ func (x *ParticipantSession) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ParticipantSession_messageType fastReflection_ParticipantSession_messageType
-var _ protoreflect.MessageType = fastReflection_ParticipantSession_messageType{}
+var _fastReflection_ParticipantDIDRecord_messageType fastReflection_ParticipantDIDRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantDIDRecord_messageType{}
 
-type fastReflection_ParticipantSession_messageType struct{}
+type fastReflection_ParticipantDIDRecord_messageType struct{}
 
-func (x fastReflection_ParticipantSession_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ParticipantSession)(nil)
+func (x fastReflection_ParticipantDIDRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantDIDRecord)(nil)
 }
-func (x fastReflection_ParticipantSession_messageType) New() protoreflect.Message {
-	return new(fastReflection_ParticipantSession)
+func (x fastReflection_ParticipantDIDRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantDIDRecord)
 }
-func (x fastReflection_ParticipantSession_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParticipantSession
+func (x fastReflection_ParticipantDIDRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantDIDRecord
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ParticipantSession) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParticipantSession
+func (x *fastReflection_ParticipantDIDRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantDIDRecord
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ParticipantSession) Type() protoreflect.MessageType {
-	return _fastReflection_ParticipantSession_messageType
+func (x *fastReflection_ParticipantDIDRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantDIDRecord_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ParticipantSession) New() protoreflect.Message {
-	return new(fastReflection_ParticipantSession)
+func (x *fastReflection_ParticipantDIDRecord) New() protoreflect.Message {
+	return new(fastReflection_ParticipantDIDRecord)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ParticipantSession) Interface() protoreflect.ProtoMessage {
-	return (*ParticipantSession)(x)
+func (x *fastReflection_ParticipantDIDRecord) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantDIDRecord)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2345,40 +3183,22 @@ func (x *fastReflection_ParticipantSession) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ParticipantSession) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_ParticipantSession_id, value) {
+func (x *fastReflection_ParticipantDIDRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_ParticipantDIDRecord_did, value) {
 			return
 		}
 	}
-	if x.CorporationId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.CorporationId)
-		if !f(fd_ParticipantSession_corporation_id, value) {
-			return
-		}
-	}
-	if x.VsOperator != "" {
-		value := protoreflect.ValueOfString(x.VsOperator)
-		if !f(fd_ParticipantSession_vs_operator, value) {
-			return
-		}
-	}
-	if x.Created != nil {
-		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
-		if !f(fd_ParticipantSession_created, value) {
-			return
-		}
-	}
-	if x.Modified != nil {
-		value := protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
-		if !f(fd_ParticipantSession_modified, value) {
+	if x.ValidFrom != nil {
+		value := protoreflect.ValueOfMessage(x.ValidFrom.ProtoReflect())
+		if !f(fd_ParticipantDIDRecord_valid_from, value) {
 			return
 		}
 	}
-	if len(x.SessionRecords) != 0 {
-		value := protoreflect.ValueOfList(&_ParticipantSession_6_list{list: &x.SessionRecords})
-		if !f(fd_ParticipantSession_session_records, value) {
+	if x.ValidUntil != nil {
+		value := protoreflect.ValueOfMessage(x.ValidUntil.ProtoReflect())
+		if !f(fd_ParticipantDIDRecord_valid_until, value) {
 			return
 		}
 	}
@@ -2395,25 +3215,19 @@ func (x *fastReflection_ParticipantSession) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ParticipantSession) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParticipantDIDRecord) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSession.id":
-		return x.Id != ""
-	case "verana.pp.v1.ParticipantSession.corporation_id":
-		return x.CorporationId != uint64(0)
-	case "verana.pp.v1.ParticipantSession.vs_operator":
-		return x.VsOperator != ""
-	case "verana.pp.v1.ParticipantSession.created":
-		return x.Created != nil
-	case "verana.pp.v1.ParticipantSession.modified":
-		return x.Modified != nil
-	case "verana.pp.v1.ParticipantSession.session_records":
-		return len(x.SessionRecords) != 0
+	case "verana.pp.v1.ParticipantDIDRecord.did":
+		return x.Did != ""
+	case "verana.pp.v1.ParticipantDIDRecord.valid_from":
+		return x.ValidFrom != nil
+	case "verana.pp.v1.ParticipantDIDRecord.valid_until":
+		return x.ValidUntil != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantDIDRecord"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantDIDRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2423,25 +3237,19 @@ func (x *fastReflection_ParticipantSession) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSession) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParticipantDIDRecord) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSession.id":
-		x.Id = ""
-	case "verana.pp.v1.ParticipantSession.corporation_id":
-		x.CorporationId = uint64(0)
-	case "verana.pp.v1.ParticipantSession.vs_operator":
-		x.VsOperator = ""
-	case "verana.pp.v1.ParticipantSession.created":
-		x.Created = nil
-	case "verana.pp.v1.ParticipantSession.modified":
-		x.Modified = nil
-	case "verana.pp.v1.ParticipantSession.session_records":
-		x.SessionRecords = nil
+	case "verana.pp.v1.ParticipantDIDRecord.did":
+		x.Did = ""
+	case "verana.pp.v1.ParticipantDIDRecord.valid_from":
+		x.ValidFrom = nil
+	case "verana.pp.v1.ParticipantDIDRecord.valid_until":
+		x.ValidUntil = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantDIDRecord"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantDIDRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2451,34 +3259,22 @@ func (x *fastReflection_ParticipantSession) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ParticipantSession) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantDIDRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.pp.v1.ParticipantSession.id":
-		value := x.Id
-		return protoreflect.ValueOfString(value)
-	case "verana.pp.v1.ParticipantSession.corporation_id":
-		value := x.CorporationId
-		return protoreflect.ValueOfUint64(value)
-	case "verana.pp.v1.ParticipantSession.vs_operator":
-		value := x.VsOperator
+	case "verana.pp.v1.ParticipantDIDRecord.did":
+		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "verana.pp.v1.ParticipantSession.created":
-		value := x.Created
+	case "verana.pp.v1.ParticipantDIDRecord.valid_from":
+		value := x.ValidFrom
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "verana.pp.v1.ParticipantSession.modified":
-		value := x.Modified
+	case "verana.pp.v1.ParticipantDIDRecord.valid_until":
+		value := x.ValidUntil
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "verana.pp.v1.ParticipantSession.session_records":
-		if len(x.SessionRecords) == 0 {
-			return protoreflect.ValueOfList(&_ParticipantSession_6_list{})
-		}
-		listValue := &_ParticipantSession_6_list{list: &x.SessionRecords}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantDIDRecord"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantDIDRecord does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2492,27 +3288,19 @@ func (x *fastReflection_ParticipantSession) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSession) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParticipantDIDRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSession.id":
-		x.Id = value.Interface().(string)
-	case "verana.pp.v1.ParticipantSession.corporation_id":
-		x.CorporationId = value.Uint()
-	case "verana.pp.v1.ParticipantSession.vs_operator":
-		x.VsOperator = value.Interface().(string)
-	case "verana.pp.v1.ParticipantSession.created":
-		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
-	case "verana.pp.v1.ParticipantSession.modified":
-		x.Modified = value.Message().Interface().(*timestamppb.Timestamp)
-	case "verana.pp.v1.ParticipantSession.session_records":
-		lv := value.List()
-		clv := lv.(*_ParticipantSession_6_list)
-		x.SessionRecords = *clv.list
+	case "verana.pp.v1.ParticipantDIDRecord.did":
+		x.Did = value.Interface().(string)
+	case "verana.pp.v1.ParticipantDIDRecord.valid_from":
+		x.ValidFrom = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantDIDRecord.valid_until":
+		x.ValidUntil = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantDIDRecord"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantDIDRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2526,73 +3314,56 @@ func (x *fastReflection_ParticipantSession) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSession) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantDIDRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSession.created":
-		if x.Created == nil {
-			x.Created = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
-	case "verana.pp.v1.ParticipantSession.modified":
-		if x.Modified == nil {
-			x.Modified = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
-	case "verana.pp.v1.ParticipantSession.session_records":
-		if x.SessionRecords == nil {
-			x.SessionRecords = []*ParticipantSessionRecord{}
-		}
-		value := &_ParticipantSession_6_list{list: &x.SessionRecords}
-		return protoreflect.ValueOfList(value)
-	case "verana.pp.v1.ParticipantSession.id":
-		panic(fmt.Errorf("field id of message verana.pp.v1.ParticipantSession is not mutable"))
-	case "verana.pp.v1.ParticipantSession.corporation_id":
-		panic(fmt.Errorf("field corporation_id of message verana.pp.v1.ParticipantSession is not mutable"))
-	case "verana.pp.v1.ParticipantSession.vs_operator":
-		panic(fmt.Errorf("field vs_operator of message verana.pp.v1.ParticipantSession is not mutable"))
+	case "verana.pp.v1.ParticipantDIDRecord.valid_from":
+		if x.ValidFrom == nil {
+			x.ValidFrom = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ValidFrom.ProtoReflect())
+	case "verana.pp.v1.ParticipantDIDRecord.valid_until":
+		if x.ValidUntil == nil {
+			x.ValidUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ValidUntil.ProtoReflect())
+	case "verana.pp.v1.ParticipantDIDRecord.did":
+		panic(fmt.Errorf("field did of message verana.pp.v1.ParticipantDIDRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantDIDRecord"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantDIDRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ParticipantSession) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantDIDRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSession.id":
-		return protoreflect.ValueOfString("")
-	case "verana.pp.v1.ParticipantSession.corporation_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.pp.v1.ParticipantSession.vs_operator":
+	case "verana.pp.v1.ParticipantDIDRecord.did":
 		return protoreflect.ValueOfString("")
-	case "verana.pp.v1.ParticipantSession.created":
+	case "verana.pp.v1.ParticipantDIDRecord.valid_from":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "verana.pp.v1.ParticipantSession.modified":
+	case "verana.pp.v1.ParticipantDIDRecord.valid_until":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "verana.pp.v1.ParticipantSession.session_records":
-		list := []*ParticipantSessionRecord{}
-		return protoreflect.ValueOfList(&_ParticipantSession_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantDIDRecord"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantDIDRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ParticipantSession) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParticipantDIDRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantSession", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantDIDRecord", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2600,7 +3371,7 @@ func (x *fastReflection_ParticipantSession) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ParticipantSession) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParticipantDIDRecord) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2611,7 +3382,7 @@ func (x *fastReflection_ParticipantSession) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSession) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParticipantDIDRecord) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2623,7 +3394,7 @@ func (x *fastReflection_ParticipantSession) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ParticipantSession) IsValid() bool {
+func (x *fastReflection_ParticipantDIDRecord) IsValid() bool {
 	return x != nil
 }
 
@@ -2633,9 +3404,9 @@ func (x *fastReflection_ParticipantSession) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParticipantDIDRecord) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ParticipantSession)
+		x := input.Message.Interface().(*ParticipantDIDRecord)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2647,31 +3418,18 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CorporationId != 0 {
-			n += 1 + runtime.Sov(uint64(x.CorporationId))
-		}
-		l = len(x.VsOperator)
+		l = len(x.Did)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Created != nil {
-			l = options.Size(x.Created)
+		if x.ValidFrom != nil {
+			l = options.Size(x.ValidFrom)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Modified != nil {
-			l = options.Size(x.Modified)
+		if x.ValidUntil != nil {
+			l = options.Size(x.ValidUntil)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.SessionRecords) > 0 {
-			for _, e := range x.SessionRecords {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2682,7 +3440,7 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ParticipantSession)
+		x := input.Message.Interface().(*ParticipantDIDRecord)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2701,24 +3459,8 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SessionRecords) > 0 {
-			for iNdEx := len(x.SessionRecords) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SessionRecords[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if x.Modified != nil {
-			encoded, err := options.Marshal(x.Modified)
+		if x.ValidUntil != nil {
+			encoded, err := options.Marshal(x.ValidUntil)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2729,10 +3471,10 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1a
 		}
-		if x.Created != nil {
-			encoded, err := options.Marshal(x.Created)
+		if x.ValidFrom != nil {
+			encoded, err := options.Marshal(x.ValidFrom)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2743,24 +3485,12 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.VsOperator) > 0 {
-			i -= len(x.VsOperator)
-			copy(dAtA[i:], x.VsOperator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VsOperator)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.CorporationId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
-			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2775,7 +3505,7 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ParticipantSession)
+		x := input.Message.Interface().(*ParticipantDIDRecord)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2807,15 +3537,15 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSession: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantDIDRecord: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSession: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantDIDRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2843,32 +3573,13 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Id = string(dAtA[iNdEx:postIndex])
+				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
-				}
-				x.CorporationId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.CorporationId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2878,27 +3589,31 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VsOperator = string(dAtA[iNdEx:postIndex])
+				if x.ValidFrom == nil {
+					x.ValidFrom = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidFrom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2925,80 +3640,10 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Created == nil {
-					x.Created = &timestamppb.Timestamp{}
+				if x.ValidUntil == nil {
+					x.ValidUntil = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Modified == nil {
-					x.Modified = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modified); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionRecords", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SessionRecords = append(x.SessionRecords, &ParticipantSessionRecord{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SessionRecords[len(x.SessionRecords)-1]); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidUntil); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3038,35 +3683,41 @@ func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ParticipantSessionRecord                             protoreflect.MessageDescriptor
-	fd_ParticipantSessionRecord_id                          protoreflect.FieldDescriptor
-	fd_ParticipantSessionRecord_created                     protoreflect.FieldDescriptor
-	fd_ParticipantSessionRecord_issuer_participant_id       protoreflect.FieldDescriptor
-	fd_ParticipantSessionRecord_verifier_participant_id     protoreflect.FieldDescriptor
-	fd_ParticipantSessionRecord_wallet_agent_participant_id protoreflect.FieldDescriptor
-	fd_ParticipantSessionRecord_agent_participant_id        protoreflect.FieldDescriptor
+	md_ParticipantFeeChange                           protoreflect.MessageDescriptor
+	fd_ParticipantFeeChange_validation_fees           protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_issuance_fees             protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_verification_fees         protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_issuance_fee_discount     protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_verification_fee_discount protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_proposer_corporation_id   protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_proposed                  protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_accepted                  protoreflect.FieldDescriptor
+	fd_ParticipantFeeChange_effective                 protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_types_proto_init()
-	md_ParticipantSessionRecord = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantSessionRecord")
-	fd_ParticipantSessionRecord_id = md_ParticipantSessionRecord.Fields().ByName("id")
-	fd_ParticipantSessionRecord_created = md_ParticipantSessionRecord.Fields().ByName("created")
-	fd_ParticipantSessionRecord_issuer_participant_id = md_ParticipantSessionRecord.Fields().ByName("issuer_participant_id")
-	fd_ParticipantSessionRecord_verifier_participant_id = md_ParticipantSessionRecord.Fields().ByName("verifier_participant_id")
-	fd_ParticipantSessionRecord_wallet_agent_participant_id = md_ParticipantSessionRecord.Fields().ByName("wallet_agent_participant_id")
-	fd_ParticipantSessionRecord_agent_participant_id = md_ParticipantSessionRecord.Fields().ByName("agent_participant_id")
+	md_ParticipantFeeChange = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantFeeChange")
+	fd_ParticipantFeeChange_validation_fees = md_ParticipantFeeChange.Fields().ByName("validation_fees")
+	fd_ParticipantFeeChange_issuance_fees = md_ParticipantFeeChange.Fields().ByName("issuance_fees")
+	fd_ParticipantFeeChange_verification_fees = md_ParticipantFeeChange.Fields().ByName("verification_fees")
+	fd_ParticipantFeeChange_issuance_fee_discount = md_ParticipantFeeChange.Fields().ByName("issuance_fee_discount")
+	fd_ParticipantFeeChange_verification_fee_discount = md_ParticipantFeeChange.Fields().ByName("verification_fee_discount")
+	fd_ParticipantFeeChange_proposer_corporation_id = md_ParticipantFeeChange.Fields().ByName("proposer_corporation_id")
+	fd_ParticipantFeeChange_proposed = md_ParticipantFeeChange.Fields().ByName("proposed")
+	fd_ParticipantFeeChange_accepted = md_ParticipantFeeChange.Fields().ByName("accepted")
+	fd_ParticipantFeeChange_effective = md_ParticipantFeeChange.Fields().ByName("effective")
 }
 
-var _ protoreflect.Message = (*fastReflection_ParticipantSessionRecord)(nil)
+var _ protoreflect.Message = (*fastReflection_ParticipantFeeChange)(nil)
 
-type fastReflection_ParticipantSessionRecord ParticipantSessionRecord
+type fastReflection_ParticipantFeeChange ParticipantFeeChange
 
-func (x *ParticipantSessionRecord) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ParticipantSessionRecord)(x)
+func (x *ParticipantFeeChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantFeeChange)(x)
 }
 
-func (x *ParticipantSessionRecord) slowProtoReflect() protoreflect.Message {
+func (x *ParticipantFeeChange) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_pp_v1_types_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3078,43 +3729,43 @@ func (x *ParticipantSessionRecord) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ParticipantSessionRecord_messageType fastReflection_ParticipantSessionRecord_messageType
-var _ protoreflect.MessageType = fastReflection_ParticipantSessionRecord_messageType{}
+var _fastReflection_ParticipantFeeChange_messageType fastReflection_ParticipantFeeChange_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantFeeChange_messageType{}
 
-type fastReflection_ParticipantSessionRecord_messageType struct{}
+type fastReflection_ParticipantFeeChange_messageType struct{}
 
-func (x fastReflection_ParticipantSessionRecord_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ParticipantSessionRecord)(nil)
+func (x fastReflection_ParticipantFeeChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantFeeChange)(nil)
 }
-func (x fastReflection_ParticipantSessionRecord_messageType) New() protoreflect.Message {
-	return new(fastReflection_ParticipantSessionRecord)
+func (x fastReflection_ParticipantFeeChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantFeeChange)
 }
-func (x fastReflection_ParticipantSessionRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParticipantSessionRecord
+func (x fastReflection_ParticipantFeeChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantFeeChange
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ParticipantSessionRecord) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParticipantSessionRecord
+func (x *fastReflection_ParticipantFeeChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantFeeChange
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ParticipantSessionRecord) Type() protoreflect.MessageType {
-	return _fastReflection_ParticipantSessionRecord_messageType
+func (x *fastReflection_ParticipantFeeChange) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantFeeChange_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ParticipantSessionRecord) New() protoreflect.Message {
-	return new(fastReflection_ParticipantSessionRecord)
+func (x *fastReflection_ParticipantFeeChange) New() protoreflect.Message {
+	return new(fastReflection_ParticipantFeeChange)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ParticipantSessionRecord) Interface() protoreflect.ProtoMessage {
-	return (*ParticipantSessionRecord)(x)
+func (x *fastReflection_ParticipantFeeChange) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantFeeChange)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3122,40 +3773,58 @@ func (x *fastReflection_ParticipantSessionRecord) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ParticipantSessionRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_ParticipantSessionRecord_id, value) {
+func (x *fastReflection_ParticipantFeeChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidationFees)
+		if !f(fd_ParticipantFeeChange_validation_fees, value) {
 			return
 		}
 	}
-	if x.Created != nil {
-		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
-		if !f(fd_ParticipantSessionRecord_created, value) {
+	if x.IssuanceFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuanceFees)
+		if !f(fd_ParticipantFeeChange_issuance_fees, value) {
 			return
 		}
 	}
-	if x.IssuerParticipantId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.IssuerParticipantId)
-		if !f(fd_ParticipantSessionRecord_issuer_participant_id, value) {
+	if x.VerificationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerificationFees)
+		if !f(fd_ParticipantFeeChange_verification_fees, value) {
 			return
 		}
 	}
-	if x.VerifierParticipantId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.VerifierParticipantId)
-		if !f(fd_ParticipantSessionRecord_verifier_participant_id, value) {
+	if x.IssuanceFeeDiscount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuanceFeeDiscount)
+		if !f(fd_ParticipantFeeChange_issuance_fee_discount, value) {
 			return
 		}
 	}
-	if x.WalletAgentParticipantId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.WalletAgentParticipantId)
-		if !f(fd_ParticipantSessionRecord_wallet_agent_participant_id, value) {
+	if x.VerificationFeeDiscount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerificationFeeDiscount)
+		if !f(fd_ParticipantFeeChange_verification_fee_discount, value) {
 			return
 		}
 	}
-	if x.AgentParticipantId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AgentParticipantId)
-		if !f(fd_ParticipantSessionRecord_agent_participant_id, value) {
+	if x.ProposerCorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposerCorporationId)
+		if !f(fd_ParticipantFeeChange_proposer_corporation_id, value) {
+			return
+		}
+	}
+	if x.Proposed != nil {
+		value := protoreflect.ValueOfMessage(x.Proposed.ProtoReflect())
+		if !f(fd_ParticipantFeeChange_proposed, value) {
+			return
+		}
+	}
+	if x.Accepted != nil {
+		value := protoreflect.ValueOfMessage(x.Accepted.ProtoReflect())
+		if !f(fd_ParticipantFeeChange_accepted, value) {
+			return
+		}
+	}
+	if x.Effective != nil {
+		value := protoreflect.ValueOfMessage(x.Effective.ProtoReflect())
+		if !f(fd_ParticipantFeeChange_effective, value) {
 			return
 		}
 	}
@@ -3172,25 +3841,31 @@ func (x *fastReflection_ParticipantSessionRecord) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ParticipantSessionRecord) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParticipantFeeChange) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSessionRecord.id":
-		return x.Id != uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.created":
-		return x.Created != nil
-	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
-		return x.IssuerParticipantId != uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
-		return x.VerifierParticipantId != uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
-		return x.WalletAgentParticipantId != uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
-		return x.AgentParticipantId != uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.validation_fees":
+		return x.ValidationFees != uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fees":
+		return x.IssuanceFees != uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.verification_fees":
+		return x.VerificationFees != uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fee_discount":
+		return x.IssuanceFeeDiscount != uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.verification_fee_discount":
+		return x.VerificationFeeDiscount != uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.proposer_corporation_id":
+		return x.ProposerCorporationId != uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.proposed":
+		return x.Proposed != nil
+	case "verana.pp.v1.ParticipantFeeChange.accepted":
+		return x.Accepted != nil
+	case "verana.pp.v1.ParticipantFeeChange.effective":
+		return x.Effective != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantFeeChange"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantFeeChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3200,25 +3875,31 @@ func (x *fastReflection_ParticipantSessionRecord) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSessionRecord) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParticipantFeeChange) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSessionRecord.id":
-		x.Id = uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.created":
-		x.Created = nil
-	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
-		x.IssuerParticipantId = uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
-		x.VerifierParticipantId = uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
-		x.WalletAgentParticipantId = uint64(0)
-	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
-		x.AgentParticipantId = uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.validation_fees":
+		x.ValidationFees = uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fees":
+		x.IssuanceFees = uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.verification_fees":
+		x.VerificationFees = uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fee_discount":
+		x.IssuanceFeeDiscount = uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.verification_fee_discount":
+		x.VerificationFeeDiscount = uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.proposer_corporation_id":
+		x.ProposerCorporationId = uint64(0)
+	case "verana.pp.v1.ParticipantFeeChange.proposed":
+		x.Proposed = nil
+	case "verana.pp.v1.ParticipantFeeChange.accepted":
+		x.Accepted = nil
+	case "verana.pp.v1.ParticipantFeeChange.effective":
+		x.Effective = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantFeeChange"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantFeeChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3228,31 +3909,40 @@ func (x *fastReflection_ParticipantSessionRecord) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ParticipantSessionRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantFeeChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.pp.v1.ParticipantSessionRecord.id":
-		value := x.Id
+	case "verana.pp.v1.ParticipantFeeChange.validation_fees":
+		value := x.ValidationFees
 		return protoreflect.ValueOfUint64(value)
-	case "verana.pp.v1.ParticipantSessionRecord.created":
-		value := x.Created
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
-		value := x.IssuerParticipantId
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fees":
+		value := x.IssuanceFees
 		return protoreflect.ValueOfUint64(value)
-	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
-		value := x.VerifierParticipantId
+	case "verana.pp.v1.ParticipantFeeChange.verification_fees":
+		value := x.VerificationFees
 		return protoreflect.ValueOfUint64(value)
-	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
-		value := x.WalletAgentParticipantId
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fee_discount":
+		value := x.IssuanceFeeDiscount
 		return protoreflect.ValueOfUint64(value)
-	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
-		value := x.AgentParticipantId
+	case "verana.pp.v1.ParticipantFeeChange.verification_fee_discount":
+		value := x.VerificationFeeDiscount
 		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantFeeChange.proposer_corporation_id":
+		value := x.ProposerCorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantFeeChange.proposed":
+		value := x.Proposed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantFeeChange.accepted":
+		value := x.Accepted
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantFeeChange.effective":
+		value := x.Effective
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantFeeChange"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantFeeChange does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3266,25 +3956,31 @@ func (x *fastReflection_ParticipantSessionRecord) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSessionRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParticipantFeeChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSessionRecord.id":
-		x.Id = value.Uint()
-	case "verana.pp.v1.ParticipantSessionRecord.created":
-		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
-	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
-		x.IssuerParticipantId = value.Uint()
-	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
-		x.VerifierParticipantId = value.Uint()
-	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
-		x.WalletAgentParticipantId = value.Uint()
-	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
-		x.AgentParticipantId = value.Uint()
+	case "verana.pp.v1.ParticipantFeeChange.validation_fees":
+		x.ValidationFees = value.Uint()
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fees":
+		x.IssuanceFees = value.Uint()
+	case "verana.pp.v1.ParticipantFeeChange.verification_fees":
+		x.VerificationFees = value.Uint()
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fee_discount":
+		x.IssuanceFeeDiscount = value.Uint()
+	case "verana.pp.v1.ParticipantFeeChange.verification_fee_discount":
+		x.VerificationFeeDiscount = value.Uint()
+	case "verana.pp.v1.ParticipantFeeChange.proposer_corporation_id":
+		x.ProposerCorporationId = value.Uint()
+	case "verana.pp.v1.ParticipantFeeChange.proposed":
+		x.Proposed = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantFeeChange.accepted":
+		x.Accepted = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantFeeChange.effective":
+		x.Effective = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantFeeChange"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantFeeChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3298,64 +3994,84 @@ func (x *fastReflection_ParticipantSessionRecord) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSessionRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantFeeChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSessionRecord.created":
-		if x.Created == nil {
-			x.Created = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
-	case "verana.pp.v1.ParticipantSessionRecord.id":
-		panic(fmt.Errorf("field id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
-	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
-		panic(fmt.Errorf("field issuer_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
-	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
-		panic(fmt.Errorf("field verifier_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
-	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
-		panic(fmt.Errorf("field wallet_agent_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
-	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
-		panic(fmt.Errorf("field agent_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
+	case "verana.pp.v1.ParticipantFeeChange.proposed":
+		if x.Proposed == nil {
+			x.Proposed = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Proposed.ProtoReflect())
+	case "verana.pp.v1.ParticipantFeeChange.accepted":
+		if x.Accepted == nil {
+			x.Accepted = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Accepted.ProtoReflect())
+	case "verana.pp.v1.ParticipantFeeChange.effective":
+		if x.Effective == nil {
+			x.Effective = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Effective.ProtoReflect())
+	case "verana.pp.v1.ParticipantFeeChange.validation_fees":
+		panic(fmt.Errorf("field validation_fees of message verana.pp.v1.ParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fees":
+		panic(fmt.Errorf("field issuance_fees of message verana.pp.v1.ParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.ParticipantFeeChange.verification_fees":
+		panic(fmt.Errorf("field verification_fees of message verana.pp.v1.ParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fee_discount":
+		panic(fmt.Errorf("field issuance_fee_discount of message verana.pp.v1.ParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.ParticipantFeeChange.verification_fee_discount":
+		panic(fmt.Errorf("field verification_fee_discount of message verana.pp.v1.ParticipantFeeChange is not mutable"))
+	case "verana.pp.v1.ParticipantFeeChange.proposer_corporation_id":
+		panic(fmt.Errorf("field proposer_corporation_id of message verana.pp.v1.ParticipantFeeChange is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantFeeChange"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantFeeChange does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ParticipantSessionRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantFeeChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.ParticipantSessionRecord.id":
+	case "verana.pp.v1.ParticipantFeeChange.validation_fees":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.pp.v1.ParticipantSessionRecord.created":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fees":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
+	case "verana.pp.v1.ParticipantFeeChange.verification_fees":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
+	case "verana.pp.v1.ParticipantFeeChange.issuance_fee_discount":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
+	case "verana.pp.v1.ParticipantFeeChange.verification_fee_discount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantFeeChange.proposer_corporation_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantFeeChange.proposed":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantFeeChange.accepted":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantFeeChange.effective":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantFeeChange"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantFeeChange does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ParticipantSessionRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParticipantFeeChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantSessionRecord", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantFeeChange", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3363,7 +4079,7 @@ func (x *fastReflection_ParticipantSessionRecord) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ParticipantSessionRecord) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParticipantFeeChange) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3374,7 +4090,7 @@ func (x *fastReflection_ParticipantSessionRecord) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParticipantSessionRecord) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParticipantFeeChange) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3386,7 +4102,7 @@ func (x *fastReflection_ParticipantSessionRecord) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ParticipantSessionRecord) IsValid() bool {
+func (x *fastReflection_ParticipantFeeChange) IsValid() bool {
 	return x != nil
 }
 
@@ -3396,9 +4112,9 @@ func (x *fastReflection_ParticipantSessionRecord) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParticipantFeeChange) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ParticipantSessionRecord)
+		x := input.Message.Interface().(*ParticipantFeeChange)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3410,24 +4126,35 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		if x.ValidationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationFees))
 		}
-		if x.Created != nil {
-			l = options.Size(x.Created)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.IssuanceFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuanceFees))
 		}
-		if x.IssuerParticipantId != 0 {
-			n += 1 + runtime.Sov(uint64(x.IssuerParticipantId))
+		if x.VerificationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerificationFees))
 		}
-		if x.VerifierParticipantId != 0 {
-			n += 1 + runtime.Sov(uint64(x.VerifierParticipantId))
+		if x.IssuanceFeeDiscount != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuanceFeeDiscount))
 		}
-		if x.WalletAgentParticipantId != 0 {
-			n += 1 + runtime.Sov(uint64(x.WalletAgentParticipantId))
+		if x.VerificationFeeDiscount != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerificationFeeDiscount))
 		}
-		if x.AgentParticipantId != 0 {
-			n += 1 + runtime.Sov(uint64(x.AgentParticipantId))
+		if x.ProposerCorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposerCorporationId))
+		}
+		if x.Proposed != nil {
+			l = options.Size(x.Proposed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Accepted != nil {
+			l = options.Size(x.Accepted)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Effective != nil {
+			l = options.Size(x.Effective)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3439,7 +4166,7 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ParticipantSessionRecord)
+		x := input.Message.Interface().(*ParticipantFeeChange)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3458,28 +4185,36 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AgentParticipantId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgentParticipantId))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.WalletAgentParticipantId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletAgentParticipantId))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.VerifierParticipantId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierParticipantId))
+		if x.Effective != nil {
+			encoded, err := options.Marshal(x.Effective)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x4a
 		}
-		if x.IssuerParticipantId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerParticipantId))
+		if x.Accepted != nil {
+			encoded, err := options.Marshal(x.Accepted)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x42
 		}
-		if x.Created != nil {
-			encoded, err := options.Marshal(x.Created)
+		if x.Proposed != nil {
+			encoded, err := options.Marshal(x.Proposed)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3490,10 +4225,35 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x3a
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.ProposerCorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposerCorporationId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.VerificationFeeDiscount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFeeDiscount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.IssuanceFeeDiscount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFeeDiscount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.VerificationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFees))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.IssuanceFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFees))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValidationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationFees))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3508,7 +4268,7 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ParticipantSessionRecord)
+		x := input.Message.Interface().(*ParticipantFeeChange)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3540,17 +4300,17 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSessionRecord: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantFeeChange: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSessionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantFeeChange: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 				}
-				x.Id = 0
+				x.ValidationFees = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3560,16 +4320,16 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.ValidationFees |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
 				}
-				var msglen int
+				x.IssuanceFees = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3579,33 +4339,16 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.IssuanceFees |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Created == nil {
-					x.Created = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
 				}
-				x.IssuerParticipantId = 0
+				x.VerificationFees = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3615,16 +4358,16 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.IssuerParticipantId |= uint64(b&0x7F) << shift
+					x.VerificationFees |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
 				}
-				x.VerifierParticipantId = 0
+				x.IssuanceFeeDiscount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3634,16 +4377,16 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.VerifierParticipantId |= uint64(b&0x7F) << shift
+					x.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAgentParticipantId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
 				}
-				x.WalletAgentParticipantId = 0
+				x.VerificationFeeDiscount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3653,16 +4396,16 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.WalletAgentParticipantId |= uint64(b&0x7F) << shift
+					x.VerificationFeeDiscount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgentParticipantId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerCorporationId", wireType)
 				}
-				x.AgentParticipantId = 0
+				x.ProposerCorporationId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3672,11 +4415,119 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AgentParticipantId |= uint64(b&0x7F) << shift
+					x.ProposerCorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Proposed == nil {
+					x.Proposed = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Accepted == nil {
+					x.Accepted = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accepted); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Effective == nil {
+					x.Effective = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Effective); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3713,25 +4564,51 @@ func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_OptionalUInt64       protoreflect.MessageDescriptor
-	fd_OptionalUInt64_value protoreflect.FieldDescriptor
+	md_ParticipantSlash                        protoreflect.MessageDescriptor
+	fd_ParticipantSlash_id                     protoreflect.FieldDescriptor
+	fd_ParticipantSlash_amount                 protoreflect.FieldDescriptor
+	fd_ParticipantSlash_reason                 protoreflect.FieldDescriptor
+	fd_ParticipantSlash_evidence_digest        protoreflect.FieldDescriptor
+	fd_ParticipantSlash_slasher_corporation_id protoreflect.FieldDescriptor
+	fd_ParticipantSlash_created                protoreflect.FieldDescriptor
+	fd_ParticipantSlash_dispute_deadline       protoreflect.FieldDescriptor
+	fd_ParticipantSlash_status                 protoreflect.FieldDescriptor
+	fd_ParticipantSlash_appeal_reason          protoreflect.FieldDescriptor
+	fd_ParticipantSlash_appeal_evidence_digest protoreflect.FieldDescriptor
+	fd_ParticipantSlash_appealed               protoreflect.FieldDescriptor
+	fd_ParticipantSlash_arbiter                protoreflect.FieldDescriptor
+	fd_ParticipantSlash_resolved               protoreflect.FieldDescriptor
+	fd_ParticipantSlash_held_from_unbonding    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_pp_v1_types_proto_init()
-	md_OptionalUInt64 = File_verana_pp_v1_types_proto.Messages().ByName("OptionalUInt64")
-	fd_OptionalUInt64_value = md_OptionalUInt64.Fields().ByName("value")
+	md_ParticipantSlash = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantSlash")
+	fd_ParticipantSlash_id = md_ParticipantSlash.Fields().ByName("id")
+	fd_ParticipantSlash_amount = md_ParticipantSlash.Fields().ByName("amount")
+	fd_ParticipantSlash_reason = md_ParticipantSlash.Fields().ByName("reason")
+	fd_ParticipantSlash_evidence_digest = md_ParticipantSlash.Fields().ByName("evidence_digest")
+	fd_ParticipantSlash_slasher_corporation_id = md_ParticipantSlash.Fields().ByName("slasher_corporation_id")
+	fd_ParticipantSlash_created = md_ParticipantSlash.Fields().ByName("created")
+	fd_ParticipantSlash_dispute_deadline = md_ParticipantSlash.Fields().ByName("dispute_deadline")
+	fd_ParticipantSlash_status = md_ParticipantSlash.Fields().ByName("status")
+	fd_ParticipantSlash_appeal_reason = md_ParticipantSlash.Fields().ByName("appeal_reason")
+	fd_ParticipantSlash_appeal_evidence_digest = md_ParticipantSlash.Fields().ByName("appeal_evidence_digest")
+	fd_ParticipantSlash_appealed = md_ParticipantSlash.Fields().ByName("appealed")
+	fd_ParticipantSlash_arbiter = md_ParticipantSlash.Fields().ByName("arbiter")
+	fd_ParticipantSlash_resolved = md_ParticipantSlash.Fields().ByName("resolved")
+	fd_ParticipantSlash_held_from_unbonding = md_ParticipantSlash.Fields().ByName("held_from_unbonding")
 }
 
-var _ protoreflect.Message = (*fastReflection_OptionalUInt64)(nil)
+var _ protoreflect.Message = (*fastReflection_ParticipantSlash)(nil)
 
-type fastReflection_OptionalUInt64 OptionalUInt64
+type fastReflection_ParticipantSlash ParticipantSlash
 
-func (x *OptionalUInt64) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OptionalUInt64)(x)
+func (x *ParticipantSlash) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantSlash)(x)
 }
 
-func (x *OptionalUInt64) slowProtoReflect() protoreflect.Message {
+func (x *ParticipantSlash) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_pp_v1_types_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3743,43 +4620,43 @@ func (x *OptionalUInt64) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OptionalUInt64_messageType fastReflection_OptionalUInt64_messageType
-var _ protoreflect.MessageType = fastReflection_OptionalUInt64_messageType{}
+var _fastReflection_ParticipantSlash_messageType fastReflection_ParticipantSlash_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantSlash_messageType{}
 
-type fastReflection_OptionalUInt64_messageType struct{}
+type fastReflection_ParticipantSlash_messageType struct{}
 
-func (x fastReflection_OptionalUInt64_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OptionalUInt64)(nil)
+func (x fastReflection_ParticipantSlash_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantSlash)(nil)
 }
-func (x fastReflection_OptionalUInt64_messageType) New() protoreflect.Message {
-	return new(fastReflection_OptionalUInt64)
+func (x fastReflection_ParticipantSlash_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSlash)
 }
-func (x fastReflection_OptionalUInt64_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OptionalUInt64
+func (x fastReflection_ParticipantSlash_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSlash
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OptionalUInt64) Descriptor() protoreflect.MessageDescriptor {
-	return md_OptionalUInt64
+func (x *fastReflection_ParticipantSlash) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSlash
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OptionalUInt64) Type() protoreflect.MessageType {
-	return _fastReflection_OptionalUInt64_messageType
+func (x *fastReflection_ParticipantSlash) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantSlash_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OptionalUInt64) New() protoreflect.Message {
-	return new(fastReflection_OptionalUInt64)
+func (x *fastReflection_ParticipantSlash) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSlash)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OptionalUInt64) Interface() protoreflect.ProtoMessage {
-	return (*OptionalUInt64)(x)
+func (x *fastReflection_ParticipantSlash) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantSlash)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3787,10 +4664,88 @@ func (x *fastReflection_OptionalUInt64) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OptionalUInt64) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Value != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Value)
-		if !f(fd_OptionalUInt64_value, value) {
+func (x *fastReflection_ParticipantSlash) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ParticipantSlash_id, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_ParticipantSlash_amount, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ParticipantSlash_reason, value) {
+			return
+		}
+	}
+	if x.EvidenceDigest != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigest)
+		if !f(fd_ParticipantSlash_evidence_digest, value) {
+			return
+		}
+	}
+	if x.SlasherCorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlasherCorporationId)
+		if !f(fd_ParticipantSlash_slasher_corporation_id, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_ParticipantSlash_created, value) {
+			return
+		}
+	}
+	if x.DisputeDeadline != nil {
+		value := protoreflect.ValueOfMessage(x.DisputeDeadline.ProtoReflect())
+		if !f(fd_ParticipantSlash_dispute_deadline, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ParticipantSlash_status, value) {
+			return
+		}
+	}
+	if x.AppealReason != "" {
+		value := protoreflect.ValueOfString(x.AppealReason)
+		if !f(fd_ParticipantSlash_appeal_reason, value) {
+			return
+		}
+	}
+	if x.AppealEvidenceDigest != "" {
+		value := protoreflect.ValueOfString(x.AppealEvidenceDigest)
+		if !f(fd_ParticipantSlash_appeal_evidence_digest, value) {
+			return
+		}
+	}
+	if x.Appealed != nil {
+		value := protoreflect.ValueOfMessage(x.Appealed.ProtoReflect())
+		if !f(fd_ParticipantSlash_appealed, value) {
+			return
+		}
+	}
+	if x.Arbiter != "" {
+		value := protoreflect.ValueOfString(x.Arbiter)
+		if !f(fd_ParticipantSlash_arbiter, value) {
+			return
+		}
+	}
+	if x.Resolved != nil {
+		value := protoreflect.ValueOfMessage(x.Resolved.ProtoReflect())
+		if !f(fd_ParticipantSlash_resolved, value) {
+			return
+		}
+	}
+	if x.HeldFromUnbonding != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HeldFromUnbonding)
+		if !f(fd_ParticipantSlash_held_from_unbonding, value) {
 			return
 		}
 	}
@@ -3807,15 +4762,41 @@ func (x *fastReflection_OptionalUInt64) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OptionalUInt64) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParticipantSlash) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.pp.v1.OptionalUInt64.value":
-		return x.Value != uint64(0)
+	case "verana.pp.v1.ParticipantSlash.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.ParticipantSlash.amount":
+		return x.Amount != uint64(0)
+	case "verana.pp.v1.ParticipantSlash.reason":
+		return x.Reason != ""
+	case "verana.pp.v1.ParticipantSlash.evidence_digest":
+		return x.EvidenceDigest != ""
+	case "verana.pp.v1.ParticipantSlash.slasher_corporation_id":
+		return x.SlasherCorporationId != uint64(0)
+	case "verana.pp.v1.ParticipantSlash.created":
+		return x.Created != nil
+	case "verana.pp.v1.ParticipantSlash.dispute_deadline":
+		return x.DisputeDeadline != nil
+	case "verana.pp.v1.ParticipantSlash.status":
+		return x.Status != 0
+	case "verana.pp.v1.ParticipantSlash.appeal_reason":
+		return x.AppealReason != ""
+	case "verana.pp.v1.ParticipantSlash.appeal_evidence_digest":
+		return x.AppealEvidenceDigest != ""
+	case "verana.pp.v1.ParticipantSlash.appealed":
+		return x.Appealed != nil
+	case "verana.pp.v1.ParticipantSlash.arbiter":
+		return x.Arbiter != ""
+	case "verana.pp.v1.ParticipantSlash.resolved":
+		return x.Resolved != nil
+	case "verana.pp.v1.ParticipantSlash.held_from_unbonding":
+		return x.HeldFromUnbonding != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSlash"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSlash does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3825,15 +4806,41 @@ func (x *fastReflection_OptionalUInt64) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OptionalUInt64) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParticipantSlash) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.pp.v1.OptionalUInt64.value":
-		x.Value = uint64(0)
+	case "verana.pp.v1.ParticipantSlash.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.ParticipantSlash.amount":
+		x.Amount = uint64(0)
+	case "verana.pp.v1.ParticipantSlash.reason":
+		x.Reason = ""
+	case "verana.pp.v1.ParticipantSlash.evidence_digest":
+		x.EvidenceDigest = ""
+	case "verana.pp.v1.ParticipantSlash.slasher_corporation_id":
+		x.SlasherCorporationId = uint64(0)
+	case "verana.pp.v1.ParticipantSlash.created":
+		x.Created = nil
+	case "verana.pp.v1.ParticipantSlash.dispute_deadline":
+		x.DisputeDeadline = nil
+	case "verana.pp.v1.ParticipantSlash.status":
+		x.Status = 0
+	case "verana.pp.v1.ParticipantSlash.appeal_reason":
+		x.AppealReason = ""
+	case "verana.pp.v1.ParticipantSlash.appeal_evidence_digest":
+		x.AppealEvidenceDigest = ""
+	case "verana.pp.v1.ParticipantSlash.appealed":
+		x.Appealed = nil
+	case "verana.pp.v1.ParticipantSlash.arbiter":
+		x.Arbiter = ""
+	case "verana.pp.v1.ParticipantSlash.resolved":
+		x.Resolved = nil
+	case "verana.pp.v1.ParticipantSlash.held_from_unbonding":
+		x.HeldFromUnbonding = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSlash"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSlash does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3843,16 +4850,55 @@ func (x *fastReflection_OptionalUInt64) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OptionalUInt64) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantSlash) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.pp.v1.OptionalUInt64.value":
-		value := x.Value
+	case "verana.pp.v1.ParticipantSlash.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSlash.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSlash.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSlash.evidence_digest":
+		value := x.EvidenceDigest
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSlash.slasher_corporation_id":
+		value := x.SlasherCorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSlash.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.dispute_deadline":
+		value := x.DisputeDeadline
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.ParticipantSlash.appeal_reason":
+		value := x.AppealReason
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSlash.appeal_evidence_digest":
+		value := x.AppealEvidenceDigest
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSlash.appealed":
+		value := x.Appealed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.arbiter":
+		value := x.Arbiter
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSlash.resolved":
+		value := x.Resolved
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.held_from_unbonding":
+		value := x.HeldFromUnbonding
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSlash"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSlash does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3866,15 +4912,41 @@ func (x *fastReflection_OptionalUInt64) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OptionalUInt64) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParticipantSlash) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.pp.v1.OptionalUInt64.value":
-		x.Value = value.Uint()
+	case "verana.pp.v1.ParticipantSlash.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.ParticipantSlash.amount":
+		x.Amount = value.Uint()
+	case "verana.pp.v1.ParticipantSlash.reason":
+		x.Reason = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSlash.evidence_digest":
+		x.EvidenceDigest = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSlash.slasher_corporation_id":
+		x.SlasherCorporationId = value.Uint()
+	case "verana.pp.v1.ParticipantSlash.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSlash.dispute_deadline":
+		x.DisputeDeadline = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSlash.status":
+		x.Status = (SlashStatus)(value.Enum())
+	case "verana.pp.v1.ParticipantSlash.appeal_reason":
+		x.AppealReason = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSlash.appeal_evidence_digest":
+		x.AppealEvidenceDigest = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSlash.appealed":
+		x.Appealed = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSlash.arbiter":
+		x.Arbiter = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSlash.resolved":
+		x.Resolved = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSlash.held_from_unbonding":
+		x.HeldFromUnbonding = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSlash"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSlash does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3888,40 +4960,108 @@ func (x *fastReflection_OptionalUInt64) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OptionalUInt64) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantSlash) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.OptionalUInt64.value":
-		panic(fmt.Errorf("field value of message verana.pp.v1.OptionalUInt64 is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.dispute_deadline":
+		if x.DisputeDeadline == nil {
+			x.DisputeDeadline = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DisputeDeadline.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.appealed":
+		if x.Appealed == nil {
+			x.Appealed = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Appealed.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.resolved":
+		if x.Resolved == nil {
+			x.Resolved = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Resolved.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.amount":
+		panic(fmt.Errorf("field amount of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.reason":
+		panic(fmt.Errorf("field reason of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.evidence_digest":
+		panic(fmt.Errorf("field evidence_digest of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.slasher_corporation_id":
+		panic(fmt.Errorf("field slasher_corporation_id of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.status":
+		panic(fmt.Errorf("field status of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.appeal_reason":
+		panic(fmt.Errorf("field appeal_reason of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.appeal_evidence_digest":
+		panic(fmt.Errorf("field appeal_evidence_digest of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.arbiter":
+		panic(fmt.Errorf("field arbiter of message verana.pp.v1.ParticipantSlash is not mutable"))
+	case "verana.pp.v1.ParticipantSlash.held_from_unbonding":
+		panic(fmt.Errorf("field held_from_unbonding of message verana.pp.v1.ParticipantSlash is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSlash"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSlash does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OptionalUInt64) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParticipantSlash) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.pp.v1.OptionalUInt64.value":
+	case "verana.pp.v1.ParticipantSlash.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSlash.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSlash.reason":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSlash.evidence_digest":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSlash.slasher_corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSlash.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.dispute_deadline":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.status":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.ParticipantSlash.appeal_reason":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSlash.appeal_evidence_digest":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSlash.appealed":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.arbiter":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSlash.resolved":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSlash.held_from_unbonding":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSlash"))
 		}
-		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSlash does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OptionalUInt64) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParticipantSlash) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.OptionalUInt64", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantSlash", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3929,7 +5069,7 @@ func (x *fastReflection_OptionalUInt64) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OptionalUInt64) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParticipantSlash) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3940,7 +5080,7 @@ func (x *fastReflection_OptionalUInt64) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OptionalUInt64) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParticipantSlash) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3952,7 +5092,7 @@ func (x *fastReflection_OptionalUInt64) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OptionalUInt64) IsValid() bool {
+func (x *fastReflection_ParticipantSlash) IsValid() bool {
 	return x != nil
 }
 
@@ -3962,9 +5102,9 @@ func (x *fastReflection_OptionalUInt64) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParticipantSlash) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OptionalUInt64)
+		x := input.Message.Interface().(*ParticipantSlash)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3976,8 +5116,56 @@ func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Value != 0 {
-			n += 1 + runtime.Sov(uint64(x.Value))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlasherCorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlasherCorporationId))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisputeDeadline != nil {
+			l = options.Size(x.DisputeDeadline)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.AppealReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AppealEvidenceDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Appealed != nil {
+			l = options.Size(x.Appealed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Arbiter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Resolved != nil {
+			l = options.Size(x.Resolved)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HeldFromUnbonding != 0 {
+			n += 1 + runtime.Sov(uint64(x.HeldFromUnbonding))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3989,7 +5177,7 @@ func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OptionalUInt64)
+		x := input.Message.Interface().(*ParticipantSlash)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4008,8 +5196,119 @@ func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Value != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Value))
+		if x.HeldFromUnbonding != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HeldFromUnbonding))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Resolved != nil {
+			encoded, err := options.Marshal(x.Resolved)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Arbiter) > 0 {
+			i -= len(x.Arbiter)
+			copy(dAtA[i:], x.Arbiter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Arbiter)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Appealed != nil {
+			encoded, err := options.Marshal(x.Appealed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.AppealEvidenceDigest) > 0 {
+			i -= len(x.AppealEvidenceDigest)
+			copy(dAtA[i:], x.AppealEvidenceDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppealEvidenceDigest)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.AppealReason) > 0 {
+			i -= len(x.AppealReason)
+			copy(dAtA[i:], x.AppealReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppealReason)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DisputeDeadline != nil {
+			encoded, err := options.Marshal(x.DisputeDeadline)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SlasherCorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlasherCorporationId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.EvidenceDigest) > 0 {
+			i -= len(x.EvidenceDigest)
+			copy(dAtA[i:], x.EvidenceDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigest)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4024,7 +5323,7 @@ func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OptionalUInt64)
+		x := input.Message.Interface().(*ParticipantSlash)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4056,17 +5355,17 @@ func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalUInt64: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSlash: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalUInt64: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.Value = 0
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4076,444 +5375,5256 @@ func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Value |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlasherCorporationId", wireType)
+				}
+				x.SlasherCorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlasherCorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadline", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.DisputeDeadline == nil {
+					x.DisputeDeadline = &timestamppb.Timestamp{}
 				}
-				iNdEx += skippy
-			}
-		}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DisputeDeadline); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= SlashStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppealReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppealReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppealEvidenceDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppealEvidenceDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Appealed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Appealed == nil {
+					x.Appealed = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Appealed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Arbiter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Resolved == nil {
+					x.Resolved = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Resolved); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HeldFromUnbonding", wireType)
+				}
+				x.HeldFromUnbonding = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HeldFromUnbonding |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParticipantSuspension                            protoreflect.MessageDescriptor
+	fd_ParticipantSuspension_suspended                  protoreflect.FieldDescriptor
+	fd_ParticipantSuspension_reason                     protoreflect.FieldDescriptor
+	fd_ParticipantSuspension_suspender_corporation_id   protoreflect.FieldDescriptor
+	fd_ParticipantSuspension_unsuspended                protoreflect.FieldDescriptor
+	fd_ParticipantSuspension_unsuspend_reason           protoreflect.FieldDescriptor
+	fd_ParticipantSuspension_unsuspender_corporation_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_types_proto_init()
+	md_ParticipantSuspension = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantSuspension")
+	fd_ParticipantSuspension_suspended = md_ParticipantSuspension.Fields().ByName("suspended")
+	fd_ParticipantSuspension_reason = md_ParticipantSuspension.Fields().ByName("reason")
+	fd_ParticipantSuspension_suspender_corporation_id = md_ParticipantSuspension.Fields().ByName("suspender_corporation_id")
+	fd_ParticipantSuspension_unsuspended = md_ParticipantSuspension.Fields().ByName("unsuspended")
+	fd_ParticipantSuspension_unsuspend_reason = md_ParticipantSuspension.Fields().ByName("unsuspend_reason")
+	fd_ParticipantSuspension_unsuspender_corporation_id = md_ParticipantSuspension.Fields().ByName("unsuspender_corporation_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantSuspension)(nil)
+
+type fastReflection_ParticipantSuspension ParticipantSuspension
+
+func (x *ParticipantSuspension) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantSuspension)(x)
+}
+
+func (x *ParticipantSuspension) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantSuspension_messageType fastReflection_ParticipantSuspension_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantSuspension_messageType{}
+
+type fastReflection_ParticipantSuspension_messageType struct{}
+
+func (x fastReflection_ParticipantSuspension_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantSuspension)(nil)
+}
+func (x fastReflection_ParticipantSuspension_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSuspension)
+}
+func (x fastReflection_ParticipantSuspension_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSuspension
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantSuspension) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSuspension
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantSuspension) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantSuspension_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantSuspension) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSuspension)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantSuspension) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantSuspension)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantSuspension) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Suspended != nil {
+		value := protoreflect.ValueOfMessage(x.Suspended.ProtoReflect())
+		if !f(fd_ParticipantSuspension_suspended, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ParticipantSuspension_reason, value) {
+			return
+		}
+	}
+	if x.SuspenderCorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SuspenderCorporationId)
+		if !f(fd_ParticipantSuspension_suspender_corporation_id, value) {
+			return
+		}
+	}
+	if x.Unsuspended != nil {
+		value := protoreflect.ValueOfMessage(x.Unsuspended.ProtoReflect())
+		if !f(fd_ParticipantSuspension_unsuspended, value) {
+			return
+		}
+	}
+	if x.UnsuspendReason != "" {
+		value := protoreflect.ValueOfString(x.UnsuspendReason)
+		if !f(fd_ParticipantSuspension_unsuspend_reason, value) {
+			return
+		}
+	}
+	if x.UnsuspenderCorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnsuspenderCorporationId)
+		if !f(fd_ParticipantSuspension_unsuspender_corporation_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantSuspension) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSuspension.suspended":
+		return x.Suspended != nil
+	case "verana.pp.v1.ParticipantSuspension.reason":
+		return x.Reason != ""
+	case "verana.pp.v1.ParticipantSuspension.suspender_corporation_id":
+		return x.SuspenderCorporationId != uint64(0)
+	case "verana.pp.v1.ParticipantSuspension.unsuspended":
+		return x.Unsuspended != nil
+	case "verana.pp.v1.ParticipantSuspension.unsuspend_reason":
+		return x.UnsuspendReason != ""
+	case "verana.pp.v1.ParticipantSuspension.unsuspender_corporation_id":
+		return x.UnsuspenderCorporationId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSuspension"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSuspension) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSuspension.suspended":
+		x.Suspended = nil
+	case "verana.pp.v1.ParticipantSuspension.reason":
+		x.Reason = ""
+	case "verana.pp.v1.ParticipantSuspension.suspender_corporation_id":
+		x.SuspenderCorporationId = uint64(0)
+	case "verana.pp.v1.ParticipantSuspension.unsuspended":
+		x.Unsuspended = nil
+	case "verana.pp.v1.ParticipantSuspension.unsuspend_reason":
+		x.UnsuspendReason = ""
+	case "verana.pp.v1.ParticipantSuspension.unsuspender_corporation_id":
+		x.UnsuspenderCorporationId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSuspension"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantSuspension) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.ParticipantSuspension.suspended":
+		value := x.Suspended
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSuspension.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSuspension.suspender_corporation_id":
+		value := x.SuspenderCorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSuspension.unsuspended":
+		value := x.Unsuspended
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSuspension.unsuspend_reason":
+		value := x.UnsuspendReason
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSuspension.unsuspender_corporation_id":
+		value := x.UnsuspenderCorporationId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSuspension"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSuspension does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSuspension) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSuspension.suspended":
+		x.Suspended = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSuspension.reason":
+		x.Reason = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSuspension.suspender_corporation_id":
+		x.SuspenderCorporationId = value.Uint()
+	case "verana.pp.v1.ParticipantSuspension.unsuspended":
+		x.Unsuspended = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSuspension.unsuspend_reason":
+		x.UnsuspendReason = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSuspension.unsuspender_corporation_id":
+		x.UnsuspenderCorporationId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSuspension"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSuspension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSuspension.suspended":
+		if x.Suspended == nil {
+			x.Suspended = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Suspended.ProtoReflect())
+	case "verana.pp.v1.ParticipantSuspension.unsuspended":
+		if x.Unsuspended == nil {
+			x.Unsuspended = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Unsuspended.ProtoReflect())
+	case "verana.pp.v1.ParticipantSuspension.reason":
+		panic(fmt.Errorf("field reason of message verana.pp.v1.ParticipantSuspension is not mutable"))
+	case "verana.pp.v1.ParticipantSuspension.suspender_corporation_id":
+		panic(fmt.Errorf("field suspender_corporation_id of message verana.pp.v1.ParticipantSuspension is not mutable"))
+	case "verana.pp.v1.ParticipantSuspension.unsuspend_reason":
+		panic(fmt.Errorf("field unsuspend_reason of message verana.pp.v1.ParticipantSuspension is not mutable"))
+	case "verana.pp.v1.ParticipantSuspension.unsuspender_corporation_id":
+		panic(fmt.Errorf("field unsuspender_corporation_id of message verana.pp.v1.ParticipantSuspension is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSuspension"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantSuspension) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSuspension.suspended":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSuspension.reason":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSuspension.suspender_corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSuspension.unsuspended":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSuspension.unsuspend_reason":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSuspension.unsuspender_corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSuspension"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSuspension does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantSuspension) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantSuspension", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantSuspension) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSuspension) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantSuspension) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantSuspension) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantSuspension)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Suspended != nil {
+			l = options.Size(x.Suspended)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SuspenderCorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SuspenderCorporationId))
+		}
+		if x.Unsuspended != nil {
+			l = options.Size(x.Unsuspended)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UnsuspendReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnsuspenderCorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnsuspenderCorporationId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSuspension)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UnsuspenderCorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnsuspenderCorporationId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.UnsuspendReason) > 0 {
+			i -= len(x.UnsuspendReason)
+			copy(dAtA[i:], x.UnsuspendReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnsuspendReason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Unsuspended != nil {
+			encoded, err := options.Marshal(x.Unsuspended)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.SuspenderCorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SuspenderCorporationId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Suspended != nil {
+			encoded, err := options.Marshal(x.Suspended)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSuspension)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSuspension: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSuspension: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Suspended == nil {
+					x.Suspended = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Suspended); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspenderCorporationId", wireType)
+				}
+				x.SuspenderCorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SuspenderCorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unsuspended", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Unsuspended == nil {
+					x.Unsuspended = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unsuspended); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnsuspendReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnsuspendReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnsuspenderCorporationId", wireType)
+				}
+				x.UnsuspenderCorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnsuspenderCorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParticipantCascade_8_list)(nil)
+
+type _ParticipantCascade_8_list struct {
+	list *[]uint64
+}
+
+func (x *_ParticipantCascade_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantCascade_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_ParticipantCascade_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantCascade_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantCascade_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ParticipantCascade at list field Pending as it is not of Message kind"))
+}
+
+func (x *_ParticipantCascade_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantCascade_8_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_ParticipantCascade_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParticipantCascade                          protoreflect.MessageDescriptor
+	fd_ParticipantCascade_id                       protoreflect.FieldDescriptor
+	fd_ParticipantCascade_root_participant_id      protoreflect.FieldDescriptor
+	fd_ParticipantCascade_mode                     protoreflect.FieldDescriptor
+	fd_ParticipantCascade_reason                   protoreflect.FieldDescriptor
+	fd_ParticipantCascade_initiator_corporation_id protoreflect.FieldDescriptor
+	fd_ParticipantCascade_started                  protoreflect.FieldDescriptor
+	fd_ParticipantCascade_completed                protoreflect.FieldDescriptor
+	fd_ParticipantCascade_pending                  protoreflect.FieldDescriptor
+	fd_ParticipantCascade_visited                  protoreflect.FieldDescriptor
+	fd_ParticipantCascade_affected                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_types_proto_init()
+	md_ParticipantCascade = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantCascade")
+	fd_ParticipantCascade_id = md_ParticipantCascade.Fields().ByName("id")
+	fd_ParticipantCascade_root_participant_id = md_ParticipantCascade.Fields().ByName("root_participant_id")
+	fd_ParticipantCascade_mode = md_ParticipantCascade.Fields().ByName("mode")
+	fd_ParticipantCascade_reason = md_ParticipantCascade.Fields().ByName("reason")
+	fd_ParticipantCascade_initiator_corporation_id = md_ParticipantCascade.Fields().ByName("initiator_corporation_id")
+	fd_ParticipantCascade_started = md_ParticipantCascade.Fields().ByName("started")
+	fd_ParticipantCascade_completed = md_ParticipantCascade.Fields().ByName("completed")
+	fd_ParticipantCascade_pending = md_ParticipantCascade.Fields().ByName("pending")
+	fd_ParticipantCascade_visited = md_ParticipantCascade.Fields().ByName("visited")
+	fd_ParticipantCascade_affected = md_ParticipantCascade.Fields().ByName("affected")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantCascade)(nil)
+
+type fastReflection_ParticipantCascade ParticipantCascade
+
+func (x *ParticipantCascade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantCascade)(x)
+}
+
+func (x *ParticipantCascade) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantCascade_messageType fastReflection_ParticipantCascade_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantCascade_messageType{}
+
+type fastReflection_ParticipantCascade_messageType struct{}
+
+func (x fastReflection_ParticipantCascade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantCascade)(nil)
+}
+func (x fastReflection_ParticipantCascade_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantCascade)
+}
+func (x fastReflection_ParticipantCascade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantCascade
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantCascade) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantCascade
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantCascade) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantCascade_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantCascade) New() protoreflect.Message {
+	return new(fastReflection_ParticipantCascade)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantCascade) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantCascade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantCascade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ParticipantCascade_id, value) {
+			return
+		}
+	}
+	if x.RootParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RootParticipantId)
+		if !f(fd_ParticipantCascade_root_participant_id, value) {
+			return
+		}
+	}
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_ParticipantCascade_mode, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_ParticipantCascade_reason, value) {
+			return
+		}
+	}
+	if x.InitiatorCorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InitiatorCorporationId)
+		if !f(fd_ParticipantCascade_initiator_corporation_id, value) {
+			return
+		}
+	}
+	if x.Started != nil {
+		value := protoreflect.ValueOfMessage(x.Started.ProtoReflect())
+		if !f(fd_ParticipantCascade_started, value) {
+			return
+		}
+	}
+	if x.Completed != nil {
+		value := protoreflect.ValueOfMessage(x.Completed.ProtoReflect())
+		if !f(fd_ParticipantCascade_completed, value) {
+			return
+		}
+	}
+	if len(x.Pending) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantCascade_8_list{list: &x.Pending})
+		if !f(fd_ParticipantCascade_pending, value) {
+			return
+		}
+	}
+	if x.Visited != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Visited)
+		if !f(fd_ParticipantCascade_visited, value) {
+			return
+		}
+	}
+	if x.Affected != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Affected)
+		if !f(fd_ParticipantCascade_affected, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantCascade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantCascade.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.ParticipantCascade.root_participant_id":
+		return x.RootParticipantId != uint64(0)
+	case "verana.pp.v1.ParticipantCascade.mode":
+		return x.Mode != 0
+	case "verana.pp.v1.ParticipantCascade.reason":
+		return x.Reason != 0
+	case "verana.pp.v1.ParticipantCascade.initiator_corporation_id":
+		return x.InitiatorCorporationId != uint64(0)
+	case "verana.pp.v1.ParticipantCascade.started":
+		return x.Started != nil
+	case "verana.pp.v1.ParticipantCascade.completed":
+		return x.Completed != nil
+	case "verana.pp.v1.ParticipantCascade.pending":
+		return len(x.Pending) != 0
+	case "verana.pp.v1.ParticipantCascade.visited":
+		return x.Visited != uint64(0)
+	case "verana.pp.v1.ParticipantCascade.affected":
+		return x.Affected != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantCascade"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantCascade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantCascade.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.ParticipantCascade.root_participant_id":
+		x.RootParticipantId = uint64(0)
+	case "verana.pp.v1.ParticipantCascade.mode":
+		x.Mode = 0
+	case "verana.pp.v1.ParticipantCascade.reason":
+		x.Reason = 0
+	case "verana.pp.v1.ParticipantCascade.initiator_corporation_id":
+		x.InitiatorCorporationId = uint64(0)
+	case "verana.pp.v1.ParticipantCascade.started":
+		x.Started = nil
+	case "verana.pp.v1.ParticipantCascade.completed":
+		x.Completed = nil
+	case "verana.pp.v1.ParticipantCascade.pending":
+		x.Pending = nil
+	case "verana.pp.v1.ParticipantCascade.visited":
+		x.Visited = uint64(0)
+	case "verana.pp.v1.ParticipantCascade.affected":
+		x.Affected = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantCascade"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantCascade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.ParticipantCascade.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantCascade.root_participant_id":
+		value := x.RootParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantCascade.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.ParticipantCascade.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.pp.v1.ParticipantCascade.initiator_corporation_id":
+		value := x.InitiatorCorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantCascade.started":
+		value := x.Started
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantCascade.completed":
+		value := x.Completed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantCascade.pending":
+		if len(x.Pending) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantCascade_8_list{})
+		}
+		listValue := &_ParticipantCascade_8_list{list: &x.Pending}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.pp.v1.ParticipantCascade.visited":
+		value := x.Visited
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantCascade.affected":
+		value := x.Affected
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantCascade"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantCascade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantCascade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantCascade.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.ParticipantCascade.root_participant_id":
+		x.RootParticipantId = value.Uint()
+	case "verana.pp.v1.ParticipantCascade.mode":
+		x.Mode = (CascadeMode)(value.Enum())
+	case "verana.pp.v1.ParticipantCascade.reason":
+		x.Reason = (RevocationReason)(value.Enum())
+	case "verana.pp.v1.ParticipantCascade.initiator_corporation_id":
+		x.InitiatorCorporationId = value.Uint()
+	case "verana.pp.v1.ParticipantCascade.started":
+		x.Started = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantCascade.completed":
+		x.Completed = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantCascade.pending":
+		lv := value.List()
+		clv := lv.(*_ParticipantCascade_8_list)
+		x.Pending = *clv.list
+	case "verana.pp.v1.ParticipantCascade.visited":
+		x.Visited = value.Uint()
+	case "verana.pp.v1.ParticipantCascade.affected":
+		x.Affected = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantCascade"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantCascade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantCascade.started":
+		if x.Started == nil {
+			x.Started = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Started.ProtoReflect())
+	case "verana.pp.v1.ParticipantCascade.completed":
+		if x.Completed == nil {
+			x.Completed = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Completed.ProtoReflect())
+	case "verana.pp.v1.ParticipantCascade.pending":
+		if x.Pending == nil {
+			x.Pending = []uint64{}
+		}
+		value := &_ParticipantCascade_8_list{list: &x.Pending}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.ParticipantCascade.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.ParticipantCascade is not mutable"))
+	case "verana.pp.v1.ParticipantCascade.root_participant_id":
+		panic(fmt.Errorf("field root_participant_id of message verana.pp.v1.ParticipantCascade is not mutable"))
+	case "verana.pp.v1.ParticipantCascade.mode":
+		panic(fmt.Errorf("field mode of message verana.pp.v1.ParticipantCascade is not mutable"))
+	case "verana.pp.v1.ParticipantCascade.reason":
+		panic(fmt.Errorf("field reason of message verana.pp.v1.ParticipantCascade is not mutable"))
+	case "verana.pp.v1.ParticipantCascade.initiator_corporation_id":
+		panic(fmt.Errorf("field initiator_corporation_id of message verana.pp.v1.ParticipantCascade is not mutable"))
+	case "verana.pp.v1.ParticipantCascade.visited":
+		panic(fmt.Errorf("field visited of message verana.pp.v1.ParticipantCascade is not mutable"))
+	case "verana.pp.v1.ParticipantCascade.affected":
+		panic(fmt.Errorf("field affected of message verana.pp.v1.ParticipantCascade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantCascade"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantCascade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantCascade.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantCascade.root_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantCascade.mode":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.ParticipantCascade.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.pp.v1.ParticipantCascade.initiator_corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantCascade.started":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantCascade.completed":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantCascade.pending":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_ParticipantCascade_8_list{list: &list})
+	case "verana.pp.v1.ParticipantCascade.visited":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantCascade.affected":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantCascade"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantCascade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantCascade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantCascade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantCascade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantCascade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantCascade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantCascade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.RootParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RootParticipantId))
+		}
+		if x.Mode != 0 {
+			n += 1 + runtime.Sov(uint64(x.Mode))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.InitiatorCorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitiatorCorporationId))
+		}
+		if x.Started != nil {
+			l = options.Size(x.Started)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Completed != nil {
+			l = options.Size(x.Completed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Pending) > 0 {
+			l = 0
+			for _, e := range x.Pending {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Visited != 0 {
+			n += 1 + runtime.Sov(uint64(x.Visited))
+		}
+		if x.Affected != 0 {
+			n += 1 + runtime.Sov(uint64(x.Affected))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantCascade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Affected != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Affected))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Visited != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Visited))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Pending) > 0 {
+			var pksize2 int
+			for _, num := range x.Pending {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.Pending {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Completed != nil {
+			encoded, err := options.Marshal(x.Completed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Started != nil {
+			encoded, err := options.Marshal(x.Started)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.InitiatorCorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitiatorCorporationId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RootParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RootParticipantId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantCascade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantCascade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RootParticipantId", wireType)
+				}
+				x.RootParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RootParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				}
+				x.Mode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Mode |= CascadeMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= RevocationReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitiatorCorporationId", wireType)
+				}
+				x.InitiatorCorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InitiatorCorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Started == nil {
+					x.Started = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Started); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Completed == nil {
+					x.Completed = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Completed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Pending = append(x.Pending, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Pending) == 0 {
+						x.Pending = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Pending = append(x.Pending, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Visited", wireType)
+				}
+				x.Visited = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Visited |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Affected", wireType)
+				}
+				x.Affected = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Affected |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParticipantSession_6_list)(nil)
+
+type _ParticipantSession_6_list struct {
+	list *[]*ParticipantSessionRecord
+}
+
+func (x *_ParticipantSession_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParticipantSession_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ParticipantSession_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantSessionRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParticipantSession_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantSessionRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParticipantSession_6_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantSessionRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantSession_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParticipantSession_6_list) NewElement() protoreflect.Value {
+	v := new(ParticipantSessionRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ParticipantSession_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParticipantSession                 protoreflect.MessageDescriptor
+	fd_ParticipantSession_id              protoreflect.FieldDescriptor
+	fd_ParticipantSession_corporation_id  protoreflect.FieldDescriptor
+	fd_ParticipantSession_vs_operator     protoreflect.FieldDescriptor
+	fd_ParticipantSession_created         protoreflect.FieldDescriptor
+	fd_ParticipantSession_modified        protoreflect.FieldDescriptor
+	fd_ParticipantSession_session_records protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_types_proto_init()
+	md_ParticipantSession = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantSession")
+	fd_ParticipantSession_id = md_ParticipantSession.Fields().ByName("id")
+	fd_ParticipantSession_corporation_id = md_ParticipantSession.Fields().ByName("corporation_id")
+	fd_ParticipantSession_vs_operator = md_ParticipantSession.Fields().ByName("vs_operator")
+	fd_ParticipantSession_created = md_ParticipantSession.Fields().ByName("created")
+	fd_ParticipantSession_modified = md_ParticipantSession.Fields().ByName("modified")
+	fd_ParticipantSession_session_records = md_ParticipantSession.Fields().ByName("session_records")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantSession)(nil)
+
+type fastReflection_ParticipantSession ParticipantSession
+
+func (x *ParticipantSession) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantSession)(x)
+}
+
+func (x *ParticipantSession) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantSession_messageType fastReflection_ParticipantSession_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantSession_messageType{}
+
+type fastReflection_ParticipantSession_messageType struct{}
+
+func (x fastReflection_ParticipantSession_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantSession)(nil)
+}
+func (x fastReflection_ParticipantSession_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSession)
+}
+func (x fastReflection_ParticipantSession_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSession
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantSession) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSession
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantSession) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantSession_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantSession) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSession)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantSession) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantSession)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantSession) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_ParticipantSession_id, value) {
+			return
+		}
+	}
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_ParticipantSession_corporation_id, value) {
+			return
+		}
+	}
+	if x.VsOperator != "" {
+		value := protoreflect.ValueOfString(x.VsOperator)
+		if !f(fd_ParticipantSession_vs_operator, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_ParticipantSession_created, value) {
+			return
+		}
+	}
+	if x.Modified != nil {
+		value := protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+		if !f(fd_ParticipantSession_modified, value) {
+			return
+		}
+	}
+	if len(x.SessionRecords) != 0 {
+		value := protoreflect.ValueOfList(&_ParticipantSession_6_list{list: &x.SessionRecords})
+		if !f(fd_ParticipantSession_session_records, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantSession) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSession.id":
+		return x.Id != ""
+	case "verana.pp.v1.ParticipantSession.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.pp.v1.ParticipantSession.vs_operator":
+		return x.VsOperator != ""
+	case "verana.pp.v1.ParticipantSession.created":
+		return x.Created != nil
+	case "verana.pp.v1.ParticipantSession.modified":
+		return x.Modified != nil
+	case "verana.pp.v1.ParticipantSession.session_records":
+		return len(x.SessionRecords) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSession) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSession.id":
+		x.Id = ""
+	case "verana.pp.v1.ParticipantSession.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.pp.v1.ParticipantSession.vs_operator":
+		x.VsOperator = ""
+	case "verana.pp.v1.ParticipantSession.created":
+		x.Created = nil
+	case "verana.pp.v1.ParticipantSession.modified":
+		x.Modified = nil
+	case "verana.pp.v1.ParticipantSession.session_records":
+		x.SessionRecords = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantSession) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.ParticipantSession.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSession.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSession.vs_operator":
+		value := x.VsOperator
+		return protoreflect.ValueOfString(value)
+	case "verana.pp.v1.ParticipantSession.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSession.modified":
+		value := x.Modified
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSession.session_records":
+		if len(x.SessionRecords) == 0 {
+			return protoreflect.ValueOfList(&_ParticipantSession_6_list{})
+		}
+		listValue := &_ParticipantSession_6_list{list: &x.SessionRecords}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSession) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSession.id":
+		x.Id = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSession.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.pp.v1.ParticipantSession.vs_operator":
+		x.VsOperator = value.Interface().(string)
+	case "verana.pp.v1.ParticipantSession.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSession.modified":
+		x.Modified = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSession.session_records":
+		lv := value.List()
+		clv := lv.(*_ParticipantSession_6_list)
+		x.SessionRecords = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSession) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSession.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.pp.v1.ParticipantSession.modified":
+		if x.Modified == nil {
+			x.Modified = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+	case "verana.pp.v1.ParticipantSession.session_records":
+		if x.SessionRecords == nil {
+			x.SessionRecords = []*ParticipantSessionRecord{}
+		}
+		value := &_ParticipantSession_6_list{list: &x.SessionRecords}
+		return protoreflect.ValueOfList(value)
+	case "verana.pp.v1.ParticipantSession.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.ParticipantSession is not mutable"))
+	case "verana.pp.v1.ParticipantSession.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.pp.v1.ParticipantSession is not mutable"))
+	case "verana.pp.v1.ParticipantSession.vs_operator":
+		panic(fmt.Errorf("field vs_operator of message verana.pp.v1.ParticipantSession is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantSession) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSession.id":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSession.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSession.vs_operator":
+		return protoreflect.ValueOfString("")
+	case "verana.pp.v1.ParticipantSession.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSession.modified":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSession.session_records":
+		list := []*ParticipantSessionRecord{}
+		return protoreflect.ValueOfList(&_ParticipantSession_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSession"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantSession) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantSession", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantSession) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSession) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantSession) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantSession) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantSession)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
+		}
+		l = len(x.VsOperator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Modified != nil {
+			l = options.Size(x.Modified)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SessionRecords) > 0 {
+			for _, e := range x.SessionRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSession)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SessionRecords) > 0 {
+			for iNdEx := len(x.SessionRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SessionRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Modified != nil {
+			encoded, err := options.Marshal(x.Modified)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VsOperator) > 0 {
+			i -= len(x.VsOperator)
+			copy(dAtA[i:], x.VsOperator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VsOperator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSession)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSession: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				}
+				x.CorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VsOperator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Modified == nil {
+					x.Modified = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modified); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SessionRecords = append(x.SessionRecords, &ParticipantSessionRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SessionRecords[len(x.SessionRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParticipantSessionRecord                             protoreflect.MessageDescriptor
+	fd_ParticipantSessionRecord_id                          protoreflect.FieldDescriptor
+	fd_ParticipantSessionRecord_created                     protoreflect.FieldDescriptor
+	fd_ParticipantSessionRecord_issuer_participant_id       protoreflect.FieldDescriptor
+	fd_ParticipantSessionRecord_verifier_participant_id     protoreflect.FieldDescriptor
+	fd_ParticipantSessionRecord_wallet_agent_participant_id protoreflect.FieldDescriptor
+	fd_ParticipantSessionRecord_agent_participant_id        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_types_proto_init()
+	md_ParticipantSessionRecord = File_verana_pp_v1_types_proto.Messages().ByName("ParticipantSessionRecord")
+	fd_ParticipantSessionRecord_id = md_ParticipantSessionRecord.Fields().ByName("id")
+	fd_ParticipantSessionRecord_created = md_ParticipantSessionRecord.Fields().ByName("created")
+	fd_ParticipantSessionRecord_issuer_participant_id = md_ParticipantSessionRecord.Fields().ByName("issuer_participant_id")
+	fd_ParticipantSessionRecord_verifier_participant_id = md_ParticipantSessionRecord.Fields().ByName("verifier_participant_id")
+	fd_ParticipantSessionRecord_wallet_agent_participant_id = md_ParticipantSessionRecord.Fields().ByName("wallet_agent_participant_id")
+	fd_ParticipantSessionRecord_agent_participant_id = md_ParticipantSessionRecord.Fields().ByName("agent_participant_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantSessionRecord)(nil)
+
+type fastReflection_ParticipantSessionRecord ParticipantSessionRecord
+
+func (x *ParticipantSessionRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantSessionRecord)(x)
+}
+
+func (x *ParticipantSessionRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantSessionRecord_messageType fastReflection_ParticipantSessionRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantSessionRecord_messageType{}
+
+type fastReflection_ParticipantSessionRecord_messageType struct{}
+
+func (x fastReflection_ParticipantSessionRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantSessionRecord)(nil)
+}
+func (x fastReflection_ParticipantSessionRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSessionRecord)
+}
+func (x fastReflection_ParticipantSessionRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSessionRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantSessionRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantSessionRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantSessionRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantSessionRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantSessionRecord) New() protoreflect.Message {
+	return new(fastReflection_ParticipantSessionRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantSessionRecord) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantSessionRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantSessionRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ParticipantSessionRecord_id, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_ParticipantSessionRecord_created, value) {
+			return
+		}
+	}
+	if x.IssuerParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuerParticipantId)
+		if !f(fd_ParticipantSessionRecord_issuer_participant_id, value) {
+			return
+		}
+	}
+	if x.VerifierParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerifierParticipantId)
+		if !f(fd_ParticipantSessionRecord_verifier_participant_id, value) {
+			return
+		}
+	}
+	if x.WalletAgentParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WalletAgentParticipantId)
+		if !f(fd_ParticipantSessionRecord_wallet_agent_participant_id, value) {
+			return
+		}
+	}
+	if x.AgentParticipantId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgentParticipantId)
+		if !f(fd_ParticipantSessionRecord_agent_participant_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantSessionRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSessionRecord.id":
+		return x.Id != uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.created":
+		return x.Created != nil
+	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
+		return x.IssuerParticipantId != uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
+		return x.VerifierParticipantId != uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
+		return x.WalletAgentParticipantId != uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
+		return x.AgentParticipantId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSessionRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSessionRecord.id":
+		x.Id = uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.created":
+		x.Created = nil
+	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
+		x.IssuerParticipantId = uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
+		x.VerifierParticipantId = uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
+		x.WalletAgentParticipantId = uint64(0)
+	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
+		x.AgentParticipantId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantSessionRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.ParticipantSessionRecord.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSessionRecord.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
+		value := x.IssuerParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
+		value := x.VerifierParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
+		value := x.WalletAgentParticipantId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
+		value := x.AgentParticipantId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSessionRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSessionRecord.id":
+		x.Id = value.Uint()
+	case "verana.pp.v1.ParticipantSessionRecord.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
+		x.IssuerParticipantId = value.Uint()
+	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
+		x.VerifierParticipantId = value.Uint()
+	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
+		x.WalletAgentParticipantId = value.Uint()
+	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
+		x.AgentParticipantId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSessionRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSessionRecord.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.pp.v1.ParticipantSessionRecord.id":
+		panic(fmt.Errorf("field id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
+	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
+		panic(fmt.Errorf("field issuer_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
+	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
+		panic(fmt.Errorf("field verifier_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
+	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
+		panic(fmt.Errorf("field wallet_agent_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
+	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
+		panic(fmt.Errorf("field agent_participant_id of message verana.pp.v1.ParticipantSessionRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantSessionRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.ParticipantSessionRecord.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSessionRecord.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.pp.v1.ParticipantSessionRecord.issuer_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSessionRecord.verifier_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSessionRecord.wallet_agent_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.pp.v1.ParticipantSessionRecord.agent_participant_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.ParticipantSessionRecord"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.ParticipantSessionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantSessionRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.ParticipantSessionRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantSessionRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantSessionRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantSessionRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantSessionRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantSessionRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IssuerParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerParticipantId))
+		}
+		if x.VerifierParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierParticipantId))
+		}
+		if x.WalletAgentParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.WalletAgentParticipantId))
+		}
+		if x.AgentParticipantId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgentParticipantId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSessionRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AgentParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgentParticipantId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.WalletAgentParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletAgentParticipantId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.VerifierParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierParticipantId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IssuerParticipantId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerParticipantId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantSessionRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSessionRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantSessionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
+				}
+				x.IssuerParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+				}
+				x.VerifierParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAgentParticipantId", wireType)
+				}
+				x.WalletAgentParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WalletAgentParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgentParticipantId", wireType)
+				}
+				x.AgentParticipantId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgentParticipantId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OptionalUInt64       protoreflect.MessageDescriptor
+	fd_OptionalUInt64_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_pp_v1_types_proto_init()
+	md_OptionalUInt64 = File_verana_pp_v1_types_proto.Messages().ByName("OptionalUInt64")
+	fd_OptionalUInt64_value = md_OptionalUInt64.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_OptionalUInt64)(nil)
+
+type fastReflection_OptionalUInt64 OptionalUInt64
+
+func (x *OptionalUInt64) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OptionalUInt64)(x)
+}
+
+func (x *OptionalUInt64) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_pp_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OptionalUInt64_messageType fastReflection_OptionalUInt64_messageType
+var _ protoreflect.MessageType = fastReflection_OptionalUInt64_messageType{}
+
+type fastReflection_OptionalUInt64_messageType struct{}
+
+func (x fastReflection_OptionalUInt64_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OptionalUInt64)(nil)
+}
+func (x fastReflection_OptionalUInt64_messageType) New() protoreflect.Message {
+	return new(fastReflection_OptionalUInt64)
+}
+func (x fastReflection_OptionalUInt64_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionalUInt64
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OptionalUInt64) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionalUInt64
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OptionalUInt64) Type() protoreflect.MessageType {
+	return _fastReflection_OptionalUInt64_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OptionalUInt64) New() protoreflect.Message {
+	return new(fastReflection_OptionalUInt64)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OptionalUInt64) Interface() protoreflect.ProtoMessage {
+	return (*OptionalUInt64)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OptionalUInt64) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Value)
+		if !f(fd_OptionalUInt64_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OptionalUInt64) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.pp.v1.OptionalUInt64.value":
+		return x.Value != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalUInt64) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.pp.v1.OptionalUInt64.value":
+		x.Value = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OptionalUInt64) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.pp.v1.OptionalUInt64.value":
+		value := x.Value
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalUInt64) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.pp.v1.OptionalUInt64.value":
+		x.Value = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalUInt64) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.OptionalUInt64.value":
+		panic(fmt.Errorf("field value of message verana.pp.v1.OptionalUInt64 is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OptionalUInt64) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.pp.v1.OptionalUInt64.value":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.pp.v1.OptionalUInt64"))
+		}
+		panic(fmt.Errorf("message verana.pp.v1.OptionalUInt64 does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OptionalUInt64) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.pp.v1.OptionalUInt64", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OptionalUInt64) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OptionalUInt64) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OptionalUInt64) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OptionalUInt64) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OptionalUInt64)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Value != 0 {
+			n += 1 + runtime.Sov(uint64(x.Value))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OptionalUInt64)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Value != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Value))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OptionalUInt64)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalUInt64: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalUInt64: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				x.Value = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Value |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: verana/pp/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Participant roles
+type ParticipantRole int32
+
+const (
+	ParticipantRole_UNSPECIFIED      ParticipantRole = 0
+	ParticipantRole_ISSUER           ParticipantRole = 1
+	ParticipantRole_VERIFIER         ParticipantRole = 2
+	ParticipantRole_ISSUER_GRANTOR   ParticipantRole = 3
+	ParticipantRole_VERIFIER_GRANTOR ParticipantRole = 4
+	ParticipantRole_ECOSYSTEM        ParticipantRole = 5
+	ParticipantRole_HOLDER           ParticipantRole = 6
+)
+
+// Enum value maps for ParticipantRole.
+var (
+	ParticipantRole_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ISSUER",
+		2: "VERIFIER",
+		3: "ISSUER_GRANTOR",
+		4: "VERIFIER_GRANTOR",
+		5: "ECOSYSTEM",
+		6: "HOLDER",
+	}
+	ParticipantRole_value = map[string]int32{
+		"UNSPECIFIED":      0,
+		"ISSUER":           1,
+		"VERIFIER":         2,
+		"ISSUER_GRANTOR":   3,
+		"VERIFIER_GRANTOR": 4,
+		"ECOSYSTEM":        5,
+		"HOLDER":           6,
+	}
+)
+
+func (x ParticipantRole) Enum() *ParticipantRole {
+	p := new(ParticipantRole)
+	*p = x
+	return p
+}
+
+func (x ParticipantRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParticipantRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_pp_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (ParticipantRole) Type() protoreflect.EnumType {
+	return &file_verana_pp_v1_types_proto_enumTypes[0]
+}
+
+func (x ParticipantRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParticipantRole.Descriptor instead.
+func (ParticipantRole) EnumDescriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// Participant onboarding-process states
+type OnboardingState int32
+
+const (
+	OnboardingState_ONBOARDING_STATE_UNSPECIFIED OnboardingState = 0
+	OnboardingState_PENDING                      OnboardingState = 1
+	OnboardingState_VALIDATED                    OnboardingState = 2
+	OnboardingState_TERMINATED                   OnboardingState = 3
+)
+
+// Enum value maps for OnboardingState.
+var (
+	OnboardingState_name = map[int32]string{
+		0: "ONBOARDING_STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "VALIDATED",
+		3: "TERMINATED",
+	}
+	OnboardingState_value = map[string]int32{
+		"ONBOARDING_STATE_UNSPECIFIED": 0,
+		"PENDING":                      1,
+		"VALIDATED":                    2,
+		"TERMINATED":                   3,
+	}
+)
+
+func (x OnboardingState) Enum() *OnboardingState {
+	p := new(OnboardingState)
+	*p = x
+	return p
+}
+
+func (x OnboardingState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnboardingState) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_pp_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (OnboardingState) Type() protoreflect.EnumType {
+	return &file_verana_pp_v1_types_proto_enumTypes[1]
+}
+
+func (x OnboardingState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnboardingState.Descriptor instead.
+func (OnboardingState) EnumDescriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// Reason recorded when a participant is revoked
+type RevocationReason int32
+
+const (
+	RevocationReason_REVOCATION_REASON_UNSPECIFIED        RevocationReason = 0
+	RevocationReason_REVOCATION_REASON_KEY_COMPROMISE     RevocationReason = 1
+	RevocationReason_REVOCATION_REASON_ECOSYSTEM_DECISION RevocationReason = 2
+	RevocationReason_REVOCATION_REASON_VOLUNTARY_EXIT     RevocationReason = 3
+	RevocationReason_REVOCATION_REASON_FRAUD              RevocationReason = 4
+	RevocationReason_REVOCATION_REASON_OTHER              RevocationReason = 5
+)
+
+// Enum value maps for RevocationReason.
+var (
+	RevocationReason_name = map[int32]string{
+		0: "REVOCATION_REASON_UNSPECIFIED",
+		1: "REVOCATION_REASON_KEY_COMPROMISE",
+		2: "REVOCATION_REASON_ECOSYSTEM_DECISION",
+		3: "REVOCATION_REASON_VOLUNTARY_EXIT",
+		4: "REVOCATION_REASON_FRAUD",
+		5: "REVOCATION_REASON_OTHER",
+	}
+	RevocationReason_value = map[string]int32{
+		"REVOCATION_REASON_UNSPECIFIED":        0,
+		"REVOCATION_REASON_KEY_COMPROMISE":     1,
+		"REVOCATION_REASON_ECOSYSTEM_DECISION": 2,
+		"REVOCATION_REASON_VOLUNTARY_EXIT":     3,
+		"REVOCATION_REASON_FRAUD":              4,
+		"REVOCATION_REASON_OTHER":              5,
+	}
+)
+
+func (x RevocationReason) Enum() *RevocationReason {
+	p := new(RevocationReason)
+	*p = x
+	return p
+}
+
+func (x RevocationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevocationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_pp_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (RevocationReason) Type() protoreflect.EnumType {
+	return &file_verana_pp_v1_types_proto_enumTypes[2]
+}
+
+func (x RevocationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevocationReason.Descriptor instead.
+func (RevocationReason) EnumDescriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+type SlashStatus int32
+
+const (
+	SlashStatus_SLASH_STATUS_UNSPECIFIED SlashStatus = 0
+	// PENDING slashes can be appealed until dispute_deadline
+	SlashStatus_SLASH_STATUS_PENDING SlashStatus = 1
+	// APPEALED slashes wait for the decision of the arbiter
+	SlashStatus_SLASH_STATUS_APPEALED SlashStatus = 2
+	// CONFIRMED slashes are final, the slashed amount was burnt
+	SlashStatus_SLASH_STATUS_CONFIRMED SlashStatus = 3
+	// REVERSED slashes were cancelled by the arbiter, the slashed amount was
+	// restored to the participant deposit
+	SlashStatus_SLASH_STATUS_REVERSED SlashStatus = 4
+)
+
+// Enum value maps for SlashStatus.
+var (
+	SlashStatus_name = map[int32]string{
+		0: "SLASH_STATUS_UNSPECIFIED",
+		1: "SLASH_STATUS_PENDING",
+		2: "SLASH_STATUS_APPEALED",
+		3: "SLASH_STATUS_CONFIRMED",
+		4: "SLASH_STATUS_REVERSED",
+	}
+	SlashStatus_value = map[string]int32{
+		"SLASH_STATUS_UNSPECIFIED": 0,
+		"SLASH_STATUS_PENDING":     1,
+		"SLASH_STATUS_APPEALED":    2,
+		"SLASH_STATUS_CONFIRMED":   3,
+		"SLASH_STATUS_REVERSED":    4,
+	}
+)
+
+func (x SlashStatus) Enum() *SlashStatus {
+	p := new(SlashStatus)
+	*p = x
+	return p
+}
+
+func (x SlashStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlashStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_pp_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (SlashStatus) Type() protoreflect.EnumType {
+	return &file_verana_pp_v1_types_proto_enumTypes[3]
+}
+
+func (x SlashStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlashStatus.Descriptor instead.
+func (SlashStatus) EnumDescriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+// CascadeMode selects what happens to the descendants of a revoked
+// participant, i.e. the participants it validated, directly or not.
+type CascadeMode int32
+
+const (
+	// descendants are left untouched
+	CascadeMode_CASCADE_MODE_UNSPECIFIED CascadeMode = 0
+	CascadeMode_CASCADE_MODE_REVOKE      CascadeMode = 1
+	CascadeMode_CASCADE_MODE_SUSPEND     CascadeMode = 2
+)
+
+// Enum value maps for CascadeMode.
+var (
+	CascadeMode_name = map[int32]string{
+		0: "CASCADE_MODE_UNSPECIFIED",
+		1: "CASCADE_MODE_REVOKE",
+		2: "CASCADE_MODE_SUSPEND",
+	}
+	CascadeMode_value = map[string]int32{
+		"CASCADE_MODE_UNSPECIFIED": 0,
+		"CASCADE_MODE_REVOKE":      1,
+		"CASCADE_MODE_SUSPEND":     2,
+	}
+)
+
+func (x CascadeMode) Enum() *CascadeMode {
+	p := new(CascadeMode)
+	*p = x
+	return p
+}
+
+func (x CascadeMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CascadeMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_pp_v1_types_proto_enumTypes[4].Descriptor()
+}
+
+func (CascadeMode) Type() protoreflect.EnumType {
+	return &file_verana_pp_v1_types_proto_enumTypes[4]
+}
+
+func (x CascadeMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CascadeMode.Descriptor instead.
+func (CascadeMode) EnumDescriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+type Participant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SchemaId               uint64                 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Role                   ParticipantRole        `protobuf:"varint,3,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
+	Did                    string                 `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+	Created                *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Adjusted               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	Slashed                *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	Repaid                 *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=repaid,proto3" json:"repaid,omitempty"`
+	EffectiveFrom          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveUntil         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	Modified               *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=modified,proto3" json:"modified,omitempty"`
+	ValidationFees         uint64                 `protobuf:"varint,17,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees           uint64                 `protobuf:"varint,18,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees       uint64                 `protobuf:"varint,19,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Deposit                uint64                 `protobuf:"varint,20,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	SlashedDeposit         uint64                 `protobuf:"varint,21,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
+	RepaidDeposit          uint64                 `protobuf:"varint,22,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
+	Revoked                *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	ValidatorParticipantId uint64                 `protobuf:"varint,26,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
+	OpState                OnboardingState        `protobuf:"varint,27,opt,name=op_state,json=opState,proto3,enum=verana.pp.v1.OnboardingState" json:"op_state,omitempty"`
+	OpExp                  *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=op_exp,json=opExp,proto3" json:"op_exp,omitempty"`
+	OpLastStateChange      *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=op_last_state_change,json=opLastStateChange,proto3" json:"op_last_state_change,omitempty"`
+	OpValidatorDeposit     uint64                 `protobuf:"varint,30,opt,name=op_validator_deposit,json=opValidatorDeposit,proto3" json:"op_validator_deposit,omitempty"`
+	OpCurrentFees          uint64                 `protobuf:"varint,31,opt,name=op_current_fees,json=opCurrentFees,proto3" json:"op_current_fees,omitempty"`
+	OpCurrentDeposit       uint64                 `protobuf:"varint,32,opt,name=op_current_deposit,json=opCurrentDeposit,proto3" json:"op_current_deposit,omitempty"`
+	OpSummaryDigest        string                 `protobuf:"bytes,33,opt,name=op_summary_digest,json=opSummaryDigest,proto3" json:"op_summary_digest,omitempty"`
+	// TODO(spec v4): Fee discount should be LegacyDec in [0, 1] per spec.
+	// Currently scaled 0..10000 for compatibility; full migration deferred.
+	IssuanceFeeDiscount     uint64 `protobuf:"varint,35,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`
+	VerificationFeeDiscount uint64 `protobuf:"varint,36,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"`
+	// vs_operator is the VS operator account (spec v4-rc2, still used). The former
+	// per-permission VS-operator-authz config (fields 38-42) moved into the DE
+	// module's VSOperatorAuthorization.records[] per spec v4-rc2.
+	VsOperator string `protobuf:"bytes,37,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
+	// corporation_id is the uint64 FK to Corporation.id (resolved from the
+	// signing corporation account via AUTHZ-CHECK-5). Replaces former field 5.
+	CorporationId uint64 `protobuf:"varint,43,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// revocation_reason, revocation_evidence and revocation_evidence_digest
+	// are set together with revoked by RevokeParticipant.
+	RevocationReason         RevocationReason `protobuf:"varint,44,opt,name=revocation_reason,json=revocationReason,proto3,enum=verana.pp.v1.RevocationReason" json:"revocation_reason,omitempty"`
+	RevocationEvidence       string           `protobuf:"bytes,45,opt,name=revocation_evidence,json=revocationEvidence,proto3" json:"revocation_evidence,omitempty"`
+	RevocationEvidenceDigest string           `protobuf:"bytes,46,opt,name=revocation_evidence_digest,json=revocationEvidenceDigest,proto3" json:"revocation_evidence_digest,omitempty"`
+	// pending_fee_change is the fee change proposed by the validator, if any.
+	// Once accepted it replaces the fees below at pending_fee_change.effective.
+	PendingFeeChange *ParticipantFeeChange `protobuf:"bytes,47,opt,name=pending_fee_change,json=pendingFeeChange,proto3" json:"pending_fee_change,omitempty"`
+	// slashes records every slash of the participant trust deposit, with its
+	// evidence and the outcome of its dispute.
+	Slashes []*ParticipantSlash `protobuf:"bytes,48,rep,name=slashes,proto3" json:"slashes,omitempty"`
+	// suspended is set while the participant is suspended. A suspended
+	// participant is not valid until it is unsuspended.
+	Suspended *timestamppb.Timestamp `protobuf:"bytes,49,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// suspensions is the suspension history of the participant, oldest first.
+	Suspensions []*ParticipantSuspension `protobuf:"bytes,50,rep,name=suspensions,proto3" json:"suspensions,omitempty"`
+	// did_history are the previous dids of the participant, oldest first.
+	DidHistory []*ParticipantDIDRecord `protobuf:"bytes,51,rep,name=did_history,json=didHistory,proto3" json:"did_history,omitempty"`
+	// released_deposit is the part of the deposit released on revocation that
+	// unbonds until released_deposit_unbonding_until. It stays slashable until
+	// then, and bounds what a slash can hold back from the unbonding trust
+	// deposit of the corporation.
+	ReleasedDeposit               uint64                 `protobuf:"varint,52,opt,name=released_deposit,json=releasedDeposit,proto3" json:"released_deposit,omitempty"`
+	ReleasedDepositUnbondingUntil *timestamppb.Timestamp `protobuf:"bytes,53,opt,name=released_deposit_unbonding_until,json=releasedDepositUnbondingUntil,proto3" json:"released_deposit_unbonding_until,omitempty"`
+}
+
+func (x *Participant) Reset() {
+	*x = Participant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Participant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Participant) ProtoMessage() {}
+
+// Deprecated: Use Participant.ProtoReflect.Descriptor instead.
+func (*Participant) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Participant) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Participant) GetSchemaId() uint64 {
+	if x != nil {
+		return x.SchemaId
+	}
+	return 0
+}
+
+func (x *Participant) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_UNSPECIFIED
+}
+
+func (x *Participant) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *Participant) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Participant) GetAdjusted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Adjusted
+	}
+	return nil
+}
+
+func (x *Participant) GetSlashed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Slashed
+	}
+	return nil
+}
+
+func (x *Participant) GetRepaid() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Repaid
+	}
+	return nil
+}
+
+func (x *Participant) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *Participant) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *Participant) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *Participant) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *Participant) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *Participant) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+func (x *Participant) GetDeposit() uint64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *Participant) GetSlashedDeposit() uint64 {
+	if x != nil {
+		return x.SlashedDeposit
+	}
+	return 0
+}
+
+func (x *Participant) GetRepaidDeposit() uint64 {
+	if x != nil {
+		return x.RepaidDeposit
+	}
+	return 0
+}
+
+func (x *Participant) GetRevoked() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Revoked
+	}
+	return nil
+}
+
+func (x *Participant) GetValidatorParticipantId() uint64 {
+	if x != nil {
+		return x.ValidatorParticipantId
+	}
+	return 0
+}
+
+func (x *Participant) GetOpState() OnboardingState {
+	if x != nil {
+		return x.OpState
+	}
+	return OnboardingState_ONBOARDING_STATE_UNSPECIFIED
+}
+
+func (x *Participant) GetOpExp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpExp
+	}
+	return nil
+}
+
+func (x *Participant) GetOpLastStateChange() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpLastStateChange
+	}
+	return nil
+}
+
+func (x *Participant) GetOpValidatorDeposit() uint64 {
+	if x != nil {
+		return x.OpValidatorDeposit
+	}
+	return 0
+}
+
+func (x *Participant) GetOpCurrentFees() uint64 {
+	if x != nil {
+		return x.OpCurrentFees
+	}
+	return 0
+}
+
+func (x *Participant) GetOpCurrentDeposit() uint64 {
+	if x != nil {
+		return x.OpCurrentDeposit
+	}
+	return 0
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *Participant) GetOpSummaryDigest() string {
+	if x != nil {
+		return x.OpSummaryDigest
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *Participant) GetIssuanceFeeDiscount() uint64 {
+	if x != nil {
+		return x.IssuanceFeeDiscount
 	}
+	return 0
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: verana/pp/v1/types.proto
+func (x *Participant) GetVerificationFeeDiscount() uint64 {
+	if x != nil {
+		return x.VerificationFeeDiscount
+	}
+	return 0
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *Participant) GetVsOperator() string {
+	if x != nil {
+		return x.VsOperator
+	}
+	return ""
+}
 
-// Participant roles
-type ParticipantRole int32
+func (x *Participant) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
 
-const (
-	ParticipantRole_UNSPECIFIED      ParticipantRole = 0
-	ParticipantRole_ISSUER           ParticipantRole = 1
-	ParticipantRole_VERIFIER         ParticipantRole = 2
-	ParticipantRole_ISSUER_GRANTOR   ParticipantRole = 3
-	ParticipantRole_VERIFIER_GRANTOR ParticipantRole = 4
-	ParticipantRole_ECOSYSTEM        ParticipantRole = 5
-	ParticipantRole_HOLDER           ParticipantRole = 6
-)
+func (x *Participant) GetRevocationReason() RevocationReason {
+	if x != nil {
+		return x.RevocationReason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
 
-// Enum value maps for ParticipantRole.
-var (
-	ParticipantRole_name = map[int32]string{
-		0: "UNSPECIFIED",
-		1: "ISSUER",
-		2: "VERIFIER",
-		3: "ISSUER_GRANTOR",
-		4: "VERIFIER_GRANTOR",
-		5: "ECOSYSTEM",
-		6: "HOLDER",
+func (x *Participant) GetRevocationEvidence() string {
+	if x != nil {
+		return x.RevocationEvidence
 	}
-	ParticipantRole_value = map[string]int32{
-		"UNSPECIFIED":      0,
-		"ISSUER":           1,
-		"VERIFIER":         2,
-		"ISSUER_GRANTOR":   3,
-		"VERIFIER_GRANTOR": 4,
-		"ECOSYSTEM":        5,
-		"HOLDER":           6,
+	return ""
+}
+
+func (x *Participant) GetRevocationEvidenceDigest() string {
+	if x != nil {
+		return x.RevocationEvidenceDigest
 	}
-)
+	return ""
+}
 
-func (x ParticipantRole) Enum() *ParticipantRole {
-	p := new(ParticipantRole)
-	*p = x
-	return p
+func (x *Participant) GetPendingFeeChange() *ParticipantFeeChange {
+	if x != nil {
+		return x.PendingFeeChange
+	}
+	return nil
 }
 
-func (x ParticipantRole) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Participant) GetSlashes() []*ParticipantSlash {
+	if x != nil {
+		return x.Slashes
+	}
+	return nil
 }
 
-func (ParticipantRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_verana_pp_v1_types_proto_enumTypes[0].Descriptor()
+func (x *Participant) GetSuspended() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Suspended
+	}
+	return nil
 }
 
-func (ParticipantRole) Type() protoreflect.EnumType {
-	return &file_verana_pp_v1_types_proto_enumTypes[0]
+func (x *Participant) GetSuspensions() []*ParticipantSuspension {
+	if x != nil {
+		return x.Suspensions
+	}
+	return nil
 }
 
-func (x ParticipantRole) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Participant) GetDidHistory() []*ParticipantDIDRecord {
+	if x != nil {
+		return x.DidHistory
+	}
+	return nil
 }
 
-// Deprecated: Use ParticipantRole.Descriptor instead.
-func (ParticipantRole) EnumDescriptor() ([]byte, []int) {
-	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{0}
+func (x *Participant) GetReleasedDeposit() uint64 {
+	if x != nil {
+		return x.ReleasedDeposit
+	}
+	return 0
 }
 
-// Participant onboarding-process states
-type OnboardingState int32
+func (x *Participant) GetReleasedDepositUnbondingUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedDepositUnbondingUntil
+	}
+	return nil
+}
 
-const (
-	OnboardingState_ONBOARDING_STATE_UNSPECIFIED OnboardingState = 0
-	OnboardingState_PENDING                      OnboardingState = 1
-	OnboardingState_VALIDATED                    OnboardingState = 2
-	OnboardingState_TERMINATED                   OnboardingState = 3
-)
+// ParticipantDIDRecord is a previous did of a participant, which was its did
+// from valid_from included to valid_until excluded.
+type ParticipantDIDRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// Enum value maps for OnboardingState.
-var (
-	OnboardingState_name = map[int32]string{
-		0: "ONBOARDING_STATE_UNSPECIFIED",
-		1: "PENDING",
-		2: "VALIDATED",
-		3: "TERMINATED",
+	Did        string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	ValidFrom  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+}
+
+func (x *ParticipantDIDRecord) Reset() {
+	*x = ParticipantDIDRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	OnboardingState_value = map[string]int32{
-		"ONBOARDING_STATE_UNSPECIFIED": 0,
-		"PENDING":                      1,
-		"VALIDATED":                    2,
-		"TERMINATED":                   3,
+}
+
+func (x *ParticipantDIDRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantDIDRecord) ProtoMessage() {}
+
+// Deprecated: Use ParticipantDIDRecord.ProtoReflect.Descriptor instead.
+func (*ParticipantDIDRecord) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParticipantDIDRecord) GetDid() string {
+	if x != nil {
+		return x.Did
 	}
-)
+	return ""
+}
 
-func (x OnboardingState) Enum() *OnboardingState {
-	p := new(OnboardingState)
-	*p = x
-	return p
+func (x *ParticipantDIDRecord) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
 }
 
-func (x OnboardingState) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *ParticipantDIDRecord) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
 }
 
-func (OnboardingState) Descriptor() protoreflect.EnumDescriptor {
-	return file_verana_pp_v1_types_proto_enumTypes[1].Descriptor()
+// ParticipantFeeChange is a fee schedule change proposed by the validator of
+// a participant and accepted by the participant.
+type ParticipantFeeChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidationFees          uint64                 `protobuf:"varint,1,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees            uint64                 `protobuf:"varint,2,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees        uint64                 `protobuf:"varint,3,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	IssuanceFeeDiscount     uint64                 `protobuf:"varint,4,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`
+	VerificationFeeDiscount uint64                 `protobuf:"varint,5,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"`
+	ProposerCorporationId   uint64                 `protobuf:"varint,6,opt,name=proposer_corporation_id,json=proposerCorporationId,proto3" json:"proposer_corporation_id,omitempty"`
+	Proposed                *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=proposed,proto3" json:"proposed,omitempty"`
+	// accepted and effective are null until the participant accepts the change
+	Accepted  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Effective *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=effective,proto3" json:"effective,omitempty"`
 }
 
-func (OnboardingState) Type() protoreflect.EnumType {
-	return &file_verana_pp_v1_types_proto_enumTypes[1]
+func (x *ParticipantFeeChange) Reset() {
+	*x = ParticipantFeeChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x OnboardingState) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ParticipantFeeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Deprecated: Use OnboardingState.Descriptor instead.
-func (OnboardingState) EnumDescriptor() ([]byte, []int) {
-	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{1}
+func (*ParticipantFeeChange) ProtoMessage() {}
+
+// Deprecated: Use ParticipantFeeChange.ProtoReflect.Descriptor instead.
+func (*ParticipantFeeChange) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParticipantFeeChange) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *ParticipantFeeChange) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *ParticipantFeeChange) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+func (x *ParticipantFeeChange) GetIssuanceFeeDiscount() uint64 {
+	if x != nil {
+		return x.IssuanceFeeDiscount
+	}
+	return 0
+}
+
+func (x *ParticipantFeeChange) GetVerificationFeeDiscount() uint64 {
+	if x != nil {
+		return x.VerificationFeeDiscount
+	}
+	return 0
+}
+
+func (x *ParticipantFeeChange) GetProposerCorporationId() uint64 {
+	if x != nil {
+		return x.ProposerCorporationId
+	}
+	return 0
+}
+
+func (x *ParticipantFeeChange) GetProposed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Proposed
+	}
+	return nil
+}
+
+func (x *ParticipantFeeChange) GetAccepted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Accepted
+	}
+	return nil
+}
+
+func (x *ParticipantFeeChange) GetEffective() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
 }
 
-type Participant struct {
+type ParticipantSlash struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SchemaId               uint64                 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
-	Role                   ParticipantRole        `protobuf:"varint,3,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
-	Did                    string                 `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
-	Created                *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
-	Adjusted               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
-	Slashed                *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=slashed,proto3" json:"slashed,omitempty"`
-	Repaid                 *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=repaid,proto3" json:"repaid,omitempty"`
-	EffectiveFrom          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
-	EffectiveUntil         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	Modified               *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=modified,proto3" json:"modified,omitempty"`
-	ValidationFees         uint64                 `protobuf:"varint,17,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	IssuanceFees           uint64                 `protobuf:"varint,18,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
-	VerificationFees       uint64                 `protobuf:"varint,19,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	Deposit                uint64                 `protobuf:"varint,20,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	SlashedDeposit         uint64                 `protobuf:"varint,21,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
-	RepaidDeposit          uint64                 `protobuf:"varint,22,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
-	Revoked                *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=revoked,proto3" json:"revoked,omitempty"`
-	ValidatorParticipantId uint64                 `protobuf:"varint,26,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
-	OpState                OnboardingState        `protobuf:"varint,27,opt,name=op_state,json=opState,proto3,enum=verana.pp.v1.OnboardingState" json:"op_state,omitempty"`
-	OpExp                  *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=op_exp,json=opExp,proto3" json:"op_exp,omitempty"`
-	OpLastStateChange      *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=op_last_state_change,json=opLastStateChange,proto3" json:"op_last_state_change,omitempty"`
-	OpValidatorDeposit     uint64                 `protobuf:"varint,30,opt,name=op_validator_deposit,json=opValidatorDeposit,proto3" json:"op_validator_deposit,omitempty"`
-	OpCurrentFees          uint64                 `protobuf:"varint,31,opt,name=op_current_fees,json=opCurrentFees,proto3" json:"op_current_fees,omitempty"`
-	OpCurrentDeposit       uint64                 `protobuf:"varint,32,opt,name=op_current_deposit,json=opCurrentDeposit,proto3" json:"op_current_deposit,omitempty"`
-	OpSummaryDigest        string                 `protobuf:"bytes,33,opt,name=op_summary_digest,json=opSummaryDigest,proto3" json:"op_summary_digest,omitempty"`
-	// TODO(spec v4): Fee discount should be LegacyDec in [0, 1] per spec.
-	// Currently scaled 0..10000 for compatibility; full migration deferred.
-	IssuanceFeeDiscount     uint64 `protobuf:"varint,35,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`
-	VerificationFeeDiscount uint64 `protobuf:"varint,36,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"`
-	// vs_operator is the VS operator account (spec v4-rc2, still used). The former
-	// per-permission VS-operator-authz config (fields 38-42) moved into the DE
-	// module's VSOperatorAuthorization.records[] per spec v4-rc2.
-	VsOperator string `protobuf:"bytes,37,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
-	// corporation_id is the uint64 FK to Corporation.id (resolved from the
-	// signing corporation account via AUTHZ-CHECK-5). Replaces former field 5.
-	CorporationId uint64 `protobuf:"varint,43,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
-}
-
-func (x *Participant) Reset() {
-	*x = Participant{}
+	Id                   uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount               uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason               string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceDigest       string                 `protobuf:"bytes,4,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+	SlasherCorporationId uint64                 `protobuf:"varint,5,opt,name=slasher_corporation_id,json=slasherCorporationId,proto3" json:"slasher_corporation_id,omitempty"`
+	Created              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	DisputeDeadline      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=dispute_deadline,json=disputeDeadline,proto3" json:"dispute_deadline,omitempty"`
+	Status               SlashStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=verana.pp.v1.SlashStatus" json:"status,omitempty"`
+	// appeal_reason, appeal_evidence_digest, appealed and arbiter are set by
+	// AppealSlash. arbiter is the ecosystem corporation group policy, or the
+	// x/gov authority for ecosystem-level slashes.
+	AppealReason         string                 `protobuf:"bytes,9,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	AppealEvidenceDigest string                 `protobuf:"bytes,10,opt,name=appeal_evidence_digest,json=appealEvidenceDigest,proto3" json:"appeal_evidence_digest,omitempty"`
+	Appealed             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=appealed,proto3" json:"appealed,omitempty"`
+	Arbiter              string                 `protobuf:"bytes,12,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	// resolved is set when the slash is confirmed or reversed
+	Resolved *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// held_from_unbonding is the part of amount held back from the released
+	// deposit of the participant. It goes back to unbonding if the slash is
+	// reversed.
+	HeldFromUnbonding uint64 `protobuf:"varint,14,opt,name=held_from_unbonding,json=heldFromUnbonding,proto3" json:"held_from_unbonding,omitempty"`
+}
+
+func (x *ParticipantSlash) Reset() {
+	*x = ParticipantSlash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_types_proto_msgTypes[0]
+		mi := &file_verana_pp_v1_types_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Participant) String() string {
+func (x *ParticipantSlash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Participant) ProtoMessage() {}
+func (*ParticipantSlash) ProtoMessage() {}
 
-// Deprecated: Use Participant.ProtoReflect.Descriptor instead.
-func (*Participant) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ParticipantSlash.ProtoReflect.Descriptor instead.
+func (*ParticipantSlash) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Participant) GetId() uint64 {
+func (x *ParticipantSlash) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Participant) GetSchemaId() uint64 {
+func (x *ParticipantSlash) GetAmount() uint64 {
 	if x != nil {
-		return x.SchemaId
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *Participant) GetRole() ParticipantRole {
+func (x *ParticipantSlash) GetReason() string {
 	if x != nil {
-		return x.Role
+		return x.Reason
 	}
-	return ParticipantRole_UNSPECIFIED
+	return ""
 }
 
-func (x *Participant) GetDid() string {
+func (x *ParticipantSlash) GetEvidenceDigest() string {
 	if x != nil {
-		return x.Did
+		return x.EvidenceDigest
 	}
 	return ""
 }
 
-func (x *Participant) GetCreated() *timestamppb.Timestamp {
+func (x *ParticipantSlash) GetSlasherCorporationId() uint64 {
 	if x != nil {
-		return x.Created
+		return x.SlasherCorporationId
 	}
-	return nil
+	return 0
 }
 
-func (x *Participant) GetAdjusted() *timestamppb.Timestamp {
+func (x *ParticipantSlash) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Adjusted
+		return x.Created
 	}
 	return nil
 }
 
-func (x *Participant) GetSlashed() *timestamppb.Timestamp {
+func (x *ParticipantSlash) GetDisputeDeadline() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Slashed
+		return x.DisputeDeadline
 	}
 	return nil
 }
 
-func (x *Participant) GetRepaid() *timestamppb.Timestamp {
+func (x *ParticipantSlash) GetStatus() SlashStatus {
 	if x != nil {
-		return x.Repaid
+		return x.Status
 	}
-	return nil
+	return SlashStatus_SLASH_STATUS_UNSPECIFIED
 }
 
-func (x *Participant) GetEffectiveFrom() *timestamppb.Timestamp {
+func (x *ParticipantSlash) GetAppealReason() string {
 	if x != nil {
-		return x.EffectiveFrom
+		return x.AppealReason
 	}
-	return nil
+	return ""
 }
 
-func (x *Participant) GetEffectiveUntil() *timestamppb.Timestamp {
+func (x *ParticipantSlash) GetAppealEvidenceDigest() string {
 	if x != nil {
-		return x.EffectiveUntil
+		return x.AppealEvidenceDigest
 	}
-	return nil
+	return ""
 }
 
-func (x *Participant) GetModified() *timestamppb.Timestamp {
+func (x *ParticipantSlash) GetAppealed() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Modified
+		return x.Appealed
 	}
 	return nil
 }
 
-func (x *Participant) GetValidationFees() uint64 {
+func (x *ParticipantSlash) GetArbiter() string {
 	if x != nil {
-		return x.ValidationFees
+		return x.Arbiter
 	}
-	return 0
+	return ""
 }
 
-func (x *Participant) GetIssuanceFees() uint64 {
+func (x *ParticipantSlash) GetResolved() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IssuanceFees
+		return x.Resolved
 	}
-	return 0
+	return nil
 }
 
-func (x *Participant) GetVerificationFees() uint64 {
+func (x *ParticipantSlash) GetHeldFromUnbonding() uint64 {
 	if x != nil {
-		return x.VerificationFees
+		return x.HeldFromUnbonding
 	}
 	return 0
 }
 
-func (x *Participant) GetDeposit() uint64 {
+type ParticipantSuspension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suspended              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Reason                 string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	SuspenderCorporationId uint64                 `protobuf:"varint,3,opt,name=suspender_corporation_id,json=suspenderCorporationId,proto3" json:"suspender_corporation_id,omitempty"`
+	// unsuspended, unsuspend_reason and unsuspender_corporation_id are set
+	// when the suspension is lifted
+	Unsuspended              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=unsuspended,proto3" json:"unsuspended,omitempty"`
+	UnsuspendReason          string                 `protobuf:"bytes,5,opt,name=unsuspend_reason,json=unsuspendReason,proto3" json:"unsuspend_reason,omitempty"`
+	UnsuspenderCorporationId uint64                 `protobuf:"varint,6,opt,name=unsuspender_corporation_id,json=unsuspenderCorporationId,proto3" json:"unsuspender_corporation_id,omitempty"`
+}
+
+func (x *ParticipantSuspension) Reset() {
+	*x = ParticipantSuspension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantSuspension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantSuspension) ProtoMessage() {}
+
+// Deprecated: Use ParticipantSuspension.ProtoReflect.Descriptor instead.
+func (*ParticipantSuspension) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ParticipantSuspension) GetSuspended() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Deposit
+		return x.Suspended
 	}
-	return 0
+	return nil
 }
 
-func (x *Participant) GetSlashedDeposit() uint64 {
+func (x *ParticipantSuspension) GetReason() string {
 	if x != nil {
-		return x.SlashedDeposit
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *Participant) GetRepaidDeposit() uint64 {
+func (x *ParticipantSuspension) GetSuspenderCorporationId() uint64 {
 	if x != nil {
-		return x.RepaidDeposit
+		return x.SuspenderCorporationId
 	}
 	return 0
 }
 
-func (x *Participant) GetRevoked() *timestamppb.Timestamp {
+func (x *ParticipantSuspension) GetUnsuspended() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Revoked
+		return x.Unsuspended
 	}
 	return nil
 }
 
-func (x *Participant) GetValidatorParticipantId() uint64 {
+func (x *ParticipantSuspension) GetUnsuspendReason() string {
 	if x != nil {
-		return x.ValidatorParticipantId
+		return x.UnsuspendReason
 	}
-	return 0
+	return ""
 }
 
-func (x *Participant) GetOpState() OnboardingState {
+func (x *ParticipantSuspension) GetUnsuspenderCorporationId() uint64 {
 	if x != nil {
-		return x.OpState
+		return x.UnsuspenderCorporationId
 	}
-	return OnboardingState_ONBOARDING_STATE_UNSPECIFIED
+	return 0
 }
 
-func (x *Participant) GetOpExp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.OpExp
+// ParticipantCascade tracks the propagation of a revocation to the
+// descendants of root_participant_id. It is processed in bounded batches by
+// the EndBlocker until pending is empty.
+type ParticipantCascade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RootParticipantId      uint64                 `protobuf:"varint,2,opt,name=root_participant_id,json=rootParticipantId,proto3" json:"root_participant_id,omitempty"`
+	Mode                   CascadeMode            `protobuf:"varint,3,opt,name=mode,proto3,enum=verana.pp.v1.CascadeMode" json:"mode,omitempty"`
+	Reason                 RevocationReason       `protobuf:"varint,4,opt,name=reason,proto3,enum=verana.pp.v1.RevocationReason" json:"reason,omitempty"`
+	InitiatorCorporationId uint64                 `protobuf:"varint,5,opt,name=initiator_corporation_id,json=initiatorCorporationId,proto3" json:"initiator_corporation_id,omitempty"`
+	Started                *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`
+	// completed is set once every descendant has been visited
+	Completed *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	// pending are the descendants still to be visited, in visit order
+	Pending []uint64 `protobuf:"varint,8,rep,packed,name=pending,proto3" json:"pending,omitempty"`
+	// visited counts the descendants processed so far, affected those that
+	// were revoked or suspended (the others already were)
+	Visited  uint64 `protobuf:"varint,9,opt,name=visited,proto3" json:"visited,omitempty"`
+	Affected uint64 `protobuf:"varint,10,opt,name=affected,proto3" json:"affected,omitempty"`
+}
+
+func (x *ParticipantCascade) Reset() {
+	*x = ParticipantCascade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_pp_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Participant) GetOpLastStateChange() *timestamppb.Timestamp {
+func (x *ParticipantCascade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantCascade) ProtoMessage() {}
+
+// Deprecated: Use ParticipantCascade.ProtoReflect.Descriptor instead.
+func (*ParticipantCascade) Descriptor() ([]byte, []int) {
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParticipantCascade) GetId() uint64 {
 	if x != nil {
-		return x.OpLastStateChange
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *Participant) GetOpValidatorDeposit() uint64 {
+func (x *ParticipantCascade) GetRootParticipantId() uint64 {
 	if x != nil {
-		return x.OpValidatorDeposit
+		return x.RootParticipantId
 	}
 	return 0
 }
 
-func (x *Participant) GetOpCurrentFees() uint64 {
+func (x *ParticipantCascade) GetMode() CascadeMode {
 	if x != nil {
-		return x.OpCurrentFees
+		return x.Mode
 	}
-	return 0
+	return CascadeMode_CASCADE_MODE_UNSPECIFIED
 }
 
-func (x *Participant) GetOpCurrentDeposit() uint64 {
+func (x *ParticipantCascade) GetReason() RevocationReason {
 	if x != nil {
-		return x.OpCurrentDeposit
+		return x.Reason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
+
+func (x *ParticipantCascade) GetInitiatorCorporationId() uint64 {
+	if x != nil {
+		return x.InitiatorCorporationId
 	}
 	return 0
 }
 
-func (x *Participant) GetOpSummaryDigest() string {
+func (x *ParticipantCascade) GetStarted() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OpSummaryDigest
+		return x.Started
 	}
-	return ""
+	return nil
 }
 
-func (x *Participant) GetIssuanceFeeDiscount() uint64 {
+func (x *ParticipantCascade) GetCompleted() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IssuanceFeeDiscount
+		return x.Completed
 	}
-	return 0
+	return nil
 }
 
-func (x *Participant) GetVerificationFeeDiscount() uint64 {
+func (x *ParticipantCascade) GetPending() []uint64 {
 	if x != nil {
-		return x.VerificationFeeDiscount
+		return x.Pending
 	}
-	return 0
+	return nil
 }
 
-func (x *Participant) GetVsOperator() string {
+func (x *ParticipantCascade) GetVisited() uint64 {
 	if x != nil {
-		return x.VsOperator
+		return x.Visited
 	}
-	return ""
+	return 0
 }
 
-func (x *Participant) GetCorporationId() uint64 {
+func (x *ParticipantCascade) GetAffected() uint64 {
 	if x != nil {
-		return x.CorporationId
+		return x.Affected
 	}
 	return 0
 }
@@ -4534,7 +10645,7 @@ type ParticipantSession struct {
 func (x *ParticipantSession) Reset() {
 	*x = ParticipantSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_types_proto_msgTypes[1]
+		mi := &file_verana_pp_v1_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4548,7 +10659,7 @@ func (*ParticipantSession) ProtoMessage() {}
 
 // Deprecated: Use ParticipantSession.ProtoReflect.Descriptor instead.
 func (*ParticipantSession) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{1}
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ParticipantSession) GetId() string {
@@ -4609,7 +10720,7 @@ type ParticipantSessionRecord struct {
 func (x *ParticipantSessionRecord) Reset() {
 	*x = ParticipantSessionRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_types_proto_msgTypes[2]
+		mi := &file_verana_pp_v1_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4623,7 +10734,7 @@ func (*ParticipantSessionRecord) ProtoMessage() {}
 
 // Deprecated: Use ParticipantSessionRecord.ProtoReflect.Descriptor instead.
 func (*ParticipantSessionRecord) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ParticipantSessionRecord) GetId() uint64 {
@@ -4680,7 +10791,7 @@ type OptionalUInt64 struct {
 func (x *OptionalUInt64) Reset() {
 	*x = OptionalUInt64{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_pp_v1_types_proto_msgTypes[3]
+		mi := &file_verana_pp_v1_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4694,7 +10805,7 @@ func (*OptionalUInt64) ProtoMessage() {}
 
 // Deprecated: Use OptionalUInt64.ProtoReflect.Descriptor instead.
 func (*OptionalUInt64) Descriptor() ([]byte, []int) {
-	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{3}
+	return file_verana_pp_v1_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OptionalUInt64) GetValue() uint64 {
@@ -4714,7 +10825,7 @@ var file_verana_pp_v1_types_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x0d, 0x0a, 0x0b, 0x50,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x12, 0x0a, 0x0b, 0x50,
 	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63,
 	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
@@ -4809,89 +10920,297 @@ var file_verana_pp_v1_types_proto_rawDesc = []byte{
 	0x6e, 0x67, 0x52, 0x0a, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25,
 	0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x26, 0x10,
-	0x27, 0x4a, 0x04, 0x08, 0x27, 0x10, 0x28, 0x4a, 0x04, 0x08, 0x28, 0x10, 0x29, 0x4a, 0x04, 0x08,
-	0x29, 0x10, 0x2a, 0x4a, 0x04, 0x08, 0x2a, 0x10, 0x2b, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x52, 0x1d, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x7a, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x1f, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x7a, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x52, 0x21, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x7a, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x52, 0x1e, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x22, 0xd1, 0x02, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0a, 0x76, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x10, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x12, 0x50, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x30,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x45, 0x0a,
+	0x0b, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x32, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x64, 0x69, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x44, 0x49, 0x44, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x64,
+	0x69, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x69, 0x0a, 0x20, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x52, 0x1d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x26, 0x10, 0x27, 0x4a, 0x04, 0x08, 0x27, 0x10,
+	0x28, 0x4a, 0x04, 0x08, 0x28, 0x10, 0x29, 0x4a, 0x04, 0x08, 0x29, 0x10, 0x2a, 0x4a, 0x04, 0x08,
+	0x2a, 0x10, 0x2b, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x19, 0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x7a, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x1d, 0x76, 0x73, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x1f, 0x76, 0x73, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x21, 0x76, 0x73, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x1e,
+	0x76, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x7a, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xac,
+	0x01, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x49,
+	0x44, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xf5, 0x03,
+	0x0a, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x6d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x18, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a,
-	0x1b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x18, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x26,
-	0x0a, 0x0e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x81, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x49,
-	0x53, 0x53, 0x55, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46,
-	0x49, 0x45, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x5f,
-	0x47, 0x52, 0x41, 0x4e, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x52,
-	0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12,
-	0x0d, 0x0a, 0x09, 0x45, 0x43, 0x4f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x05, 0x12, 0x0a,
-	0x0a, 0x06, 0x48, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x06, 0x2a, 0x5f, 0x0a, 0x0f, 0x4f, 0x6e,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
-	0x1c, 0x4f, 0x4e, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
-	0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x42, 0xa5, 0x01, 0x0a, 0x10,
-	0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31,
-	0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70,
-	0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2e, 0x50, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x5c, 0x50, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xa8, 0x05, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x5f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x70, 0x65,
+	0x61, 0x6c, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x3c, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x07, 0x61, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x72, 0x62, 0x69, 0x74,
+	0x65, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68,
+	0x65, 0x6c, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0xd6, 0x02, 0x0a, 0x15, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0b,
+	0x75, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1a, 0x75,
+	0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x70, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x18, 0x75, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc1, 0x03, 0x0a, 0x12, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72,
+	0x6f, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x73, 0x63, 0x61, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xd1, 0x02,
+	0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x76,
+	0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x76, 0x73, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x4f, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x22, 0xc3, 0x02, 0x0a, 0x18, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36,
+	0x0a, 0x17, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x0e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a,
+	0x81, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x4f, 0x52,
+	0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x43, 0x4f, 0x53,
+	0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x4f, 0x4c, 0x44, 0x45,
+	0x52, 0x10, 0x06, 0x2a, 0x5f, 0x0a, 0x0f, 0x4f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x4e, 0x42, 0x4f, 0x41, 0x52,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0xe5, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x56,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20,
+	0x52, 0x45, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x4f, 0x4d, 0x49, 0x53, 0x45,
+	0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x43, 0x4f, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20,
+	0x52, 0x45, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4e, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x54,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x41, 0x55, 0x44, 0x10, 0x04, 0x12,
+	0x1b, 0x0a, 0x17, 0x52, 0x45, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x97, 0x01, 0x0a,
+	0x0b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4c,
+	0x41, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x45,
+	0x52, 0x53, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x5e, 0x0a, 0x0b, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55, 0x53,
+	0x50, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x42, 0xa5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x70, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x70,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x70, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
+	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4906,39 +11225,70 @@ func file_verana_pp_v1_types_proto_rawDescGZIP() []byte {
 	return file_verana_pp_v1_types_proto_rawDescData
 }
 
-var file_verana_pp_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_verana_pp_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_verana_pp_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_verana_pp_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_verana_pp_v1_types_proto_goTypes = []interface{}{
 	(ParticipantRole)(0),             // 0: verana.pp.v1.ParticipantRole
 	(OnboardingState)(0),             // 1: verana.pp.v1.OnboardingState
-	(*Participant)(nil),              // 2: verana.pp.v1.Participant
-	(*ParticipantSession)(nil),       // 3: verana.pp.v1.ParticipantSession
-	(*ParticipantSessionRecord)(nil), // 4: verana.pp.v1.ParticipantSessionRecord
-	(*OptionalUInt64)(nil),           // 5: verana.pp.v1.OptionalUInt64
-	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(RevocationReason)(0),            // 2: verana.pp.v1.RevocationReason
+	(SlashStatus)(0),                 // 3: verana.pp.v1.SlashStatus
+	(CascadeMode)(0),                 // 4: verana.pp.v1.CascadeMode
+	(*Participant)(nil),              // 5: verana.pp.v1.Participant
+	(*ParticipantDIDRecord)(nil),     // 6: verana.pp.v1.ParticipantDIDRecord
+	(*ParticipantFeeChange)(nil),     // 7: verana.pp.v1.ParticipantFeeChange
+	(*ParticipantSlash)(nil),         // 8: verana.pp.v1.ParticipantSlash
+	(*ParticipantSuspension)(nil),    // 9: verana.pp.v1.ParticipantSuspension
+	(*ParticipantCascade)(nil),       // 10: verana.pp.v1.ParticipantCascade
+	(*ParticipantSession)(nil),       // 11: verana.pp.v1.ParticipantSession
+	(*ParticipantSessionRecord)(nil), // 12: verana.pp.v1.ParticipantSessionRecord
+	(*OptionalUInt64)(nil),           // 13: verana.pp.v1.OptionalUInt64
+	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
 }
 var file_verana_pp_v1_types_proto_depIdxs = []int32{
 	0,  // 0: verana.pp.v1.Participant.role:type_name -> verana.pp.v1.ParticipantRole
-	6,  // 1: verana.pp.v1.Participant.created:type_name -> google.protobuf.Timestamp
-	6,  // 2: verana.pp.v1.Participant.adjusted:type_name -> google.protobuf.Timestamp
-	6,  // 3: verana.pp.v1.Participant.slashed:type_name -> google.protobuf.Timestamp
-	6,  // 4: verana.pp.v1.Participant.repaid:type_name -> google.protobuf.Timestamp
-	6,  // 5: verana.pp.v1.Participant.effective_from:type_name -> google.protobuf.Timestamp
-	6,  // 6: verana.pp.v1.Participant.effective_until:type_name -> google.protobuf.Timestamp
-	6,  // 7: verana.pp.v1.Participant.modified:type_name -> google.protobuf.Timestamp
-	6,  // 8: verana.pp.v1.Participant.revoked:type_name -> google.protobuf.Timestamp
+	14, // 1: verana.pp.v1.Participant.created:type_name -> google.protobuf.Timestamp
+	14, // 2: verana.pp.v1.Participant.adjusted:type_name -> google.protobuf.Timestamp
+	14, // 3: verana.pp.v1.Participant.slashed:type_name -> google.protobuf.Timestamp
+	14, // 4: verana.pp.v1.Participant.repaid:type_name -> google.protobuf.Timestamp
+	14, // 5: verana.pp.v1.Participant.effective_from:type_name -> google.protobuf.Timestamp
+	14, // 6: verana.pp.v1.Participant.effective_until:type_name -> google.protobuf.Timestamp
+	14, // 7: verana.pp.v1.Participant.modified:type_name -> google.protobuf.Timestamp
+	14, // 8: verana.pp.v1.Participant.revoked:type_name -> google.protobuf.Timestamp
 	1,  // 9: verana.pp.v1.Participant.op_state:type_name -> verana.pp.v1.OnboardingState
-	6,  // 10: verana.pp.v1.Participant.op_exp:type_name -> google.protobuf.Timestamp
-	6,  // 11: verana.pp.v1.Participant.op_last_state_change:type_name -> google.protobuf.Timestamp
-	6,  // 12: verana.pp.v1.ParticipantSession.created:type_name -> google.protobuf.Timestamp
-	6,  // 13: verana.pp.v1.ParticipantSession.modified:type_name -> google.protobuf.Timestamp
-	4,  // 14: verana.pp.v1.ParticipantSession.session_records:type_name -> verana.pp.v1.ParticipantSessionRecord
-	6,  // 15: verana.pp.v1.ParticipantSessionRecord.created:type_name -> google.protobuf.Timestamp
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	14, // 10: verana.pp.v1.Participant.op_exp:type_name -> google.protobuf.Timestamp
+	14, // 11: verana.pp.v1.Participant.op_last_state_change:type_name -> google.protobuf.Timestamp
+	2,  // 12: verana.pp.v1.Participant.revocation_reason:type_name -> verana.pp.v1.RevocationReason
+	7,  // 13: verana.pp.v1.Participant.pending_fee_change:type_name -> verana.pp.v1.ParticipantFeeChange
+	8,  // 14: verana.pp.v1.Participant.slashes:type_name -> verana.pp.v1.ParticipantSlash
+	14, // 15: verana.pp.v1.Participant.suspended:type_name -> google.protobuf.Timestamp
+	9,  // 16: verana.pp.v1.Participant.suspensions:type_name -> verana.pp.v1.ParticipantSuspension
+	6,  // 17: verana.pp.v1.Participant.did_history:type_name -> verana.pp.v1.ParticipantDIDRecord
+	14, // 18: verana.pp.v1.Participant.released_deposit_unbonding_until:type_name -> google.protobuf.Timestamp
+	14, // 19: verana.pp.v1.ParticipantDIDRecord.valid_from:type_name -> google.protobuf.Timestamp
+	14, // 20: verana.pp.v1.ParticipantDIDRecord.valid_until:type_name -> google.protobuf.Timestamp
+	14, // 21: verana.pp.v1.ParticipantFeeChange.proposed:type_name -> google.protobuf.Timestamp
+	14, // 22: verana.pp.v1.ParticipantFeeChange.accepted:type_name -> google.protobuf.Timestamp
+	14, // 23: verana.pp.v1.ParticipantFeeChange.effective:type_name -> google.protobuf.Timestamp
+	14, // 24: verana.pp.v1.ParticipantSlash.created:type_name -> google.protobuf.Timestamp
+	14, // 25: verana.pp.v1.ParticipantSlash.dispute_deadline:type_name -> google.protobuf.Timestamp
+	3,  // 26: verana.pp.v1.ParticipantSlash.status:type_name -> verana.pp.v1.SlashStatus
+	14, // 27: verana.pp.v1.ParticipantSlash.appealed:type_name -> google.protobuf.Timestamp
+	14, // 28: verana.pp.v1.ParticipantSlash.resolved:type_name -> google.protobuf.Timestamp
+	14, // 29: verana.pp.v1.ParticipantSuspension.suspended:type_name -> google.protobuf.Timestamp
+	14, // 30: verana.pp.v1.ParticipantSuspension.unsuspended:type_name -> google.protobuf.Timestamp
+	4,  // 31: verana.pp.v1.ParticipantCascade.mode:type_name -> verana.pp.v1.CascadeMode
+	2,  // 32: verana.pp.v1.ParticipantCascade.reason:type_name -> verana.pp.v1.RevocationReason
+	14, // 33: verana.pp.v1.ParticipantCascade.started:type_name -> google.protobuf.Timestamp
+	14, // 34: verana.pp.v1.ParticipantCascade.completed:type_name -> google.protobuf.Timestamp
+	14, // 35: verana.pp.v1.ParticipantSession.created:type_name -> google.protobuf.Timestamp
+	14, // 36: verana.pp.v1.ParticipantSession.modified:type_name -> google.protobuf.Timestamp
+	12, // 37: verana.pp.v1.ParticipantSession.session_records:type_name -> verana.pp.v1.ParticipantSessionRecord
+	14, // 38: verana.pp.v1.ParticipantSessionRecord.created:type_name -> google.protobuf.Timestamp
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_verana_pp_v1_types_proto_init() }
@@ -4960,7 +11310,7 @@ func file_verana_pp_v1_types_proto_init() {
 			}
 		}
 		file_verana_pp_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParticipantSession); i {
+			switch v := v.(*ParticipantDIDRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4972,7 +11322,7 @@ func file_verana_pp_v1_types_proto_init() {
 			}
 		}
 		file_verana_pp_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParticipantSessionRecord); i {
+			switch v := v.(*ParticipantFeeChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4984,6 +11334,66 @@ func file_verana_pp_v1_types_proto_init() {
 			}
 		}
 		file_verana_pp_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantSlash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantSuspension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantCascade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantSessionRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_pp_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OptionalUInt64); i {
 			case 0:
 				return &v.state
@@ -5001,8 +11411,8 @@ func file_verana_pp_v1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_pp_v1_types_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -66,11 +66,272 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ShareValueCheckpoint
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ShareValueCheckpoint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ShareValueCheckpoint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ShareValueCheckpoint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ShareValueCheckpoint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*UnbondingTrustDeposit
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTrustDeposit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTrustDeposit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingTrustDeposit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(UnbondingTrustDeposit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*CompensationPool
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompensationPool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompensationPool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(CompensationPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(CompensationPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*CompensationClaim
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompensationClaim)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompensationClaim)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(CompensationClaim)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(CompensationClaim)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*TrustDeposit
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDeposit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDeposit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(TrustDeposit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(TrustDeposit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                protoreflect.MessageDescriptor
-	fd_GenesisState_params         protoreflect.FieldDescriptor
-	fd_GenesisState_trust_deposits protoreflect.FieldDescriptor
-	fd_GenesisState_dust           protoreflect.FieldDescriptor
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_trust_deposits           protoreflect.FieldDescriptor
+	fd_GenesisState_dust                     protoreflect.FieldDescriptor
+	fd_GenesisState_share_value_checkpoints  protoreflect.FieldDescriptor
+	fd_GenesisState_unbonding_trust_deposits protoreflect.FieldDescriptor
+	fd_GenesisState_compensation_pools       protoreflect.FieldDescriptor
+	fd_GenesisState_compensation_claims      protoreflect.FieldDescriptor
+	fd_GenesisState_orphaned_trust_deposits  protoreflect.FieldDescriptor
+	fd_GenesisState_yield_funding_failure    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -79,6 +340,12 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_trust_deposits = md_GenesisState.Fields().ByName("trust_deposits")
 	fd_GenesisState_dust = md_GenesisState.Fields().ByName("dust")
+	fd_GenesisState_share_value_checkpoints = md_GenesisState.Fields().ByName("share_value_checkpoints")
+	fd_GenesisState_unbonding_trust_deposits = md_GenesisState.Fields().ByName("unbonding_trust_deposits")
+	fd_GenesisState_compensation_pools = md_GenesisState.Fields().ByName("compensation_pools")
+	fd_GenesisState_compensation_claims = md_GenesisState.Fields().ByName("compensation_claims")
+	fd_GenesisState_orphaned_trust_deposits = md_GenesisState.Fields().ByName("orphaned_trust_deposits")
+	fd_GenesisState_yield_funding_failure = md_GenesisState.Fields().ByName("yield_funding_failure")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -164,6 +431,42 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ShareValueCheckpoints) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ShareValueCheckpoints})
+		if !f(fd_GenesisState_share_value_checkpoints, value) {
+			return
+		}
+	}
+	if len(x.UnbondingTrustDeposits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.UnbondingTrustDeposits})
+		if !f(fd_GenesisState_unbonding_trust_deposits, value) {
+			return
+		}
+	}
+	if len(x.CompensationPools) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.CompensationPools})
+		if !f(fd_GenesisState_compensation_pools, value) {
+			return
+		}
+	}
+	if len(x.CompensationClaims) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.CompensationClaims})
+		if !f(fd_GenesisState_compensation_claims, value) {
+			return
+		}
+	}
+	if len(x.OrphanedTrustDeposits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.OrphanedTrustDeposits})
+		if !f(fd_GenesisState_orphaned_trust_deposits, value) {
+			return
+		}
+	}
+	if x.YieldFundingFailure != nil {
+		value := protoreflect.ValueOfMessage(x.YieldFundingFailure.ProtoReflect())
+		if !f(fd_GenesisState_yield_funding_failure, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -185,6 +488,18 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.TrustDeposits) != 0
 	case "verana.td.v1.GenesisState.dust":
 		return x.Dust != ""
+	case "verana.td.v1.GenesisState.share_value_checkpoints":
+		return len(x.ShareValueCheckpoints) != 0
+	case "verana.td.v1.GenesisState.unbonding_trust_deposits":
+		return len(x.UnbondingTrustDeposits) != 0
+	case "verana.td.v1.GenesisState.compensation_pools":
+		return len(x.CompensationPools) != 0
+	case "verana.td.v1.GenesisState.compensation_claims":
+		return len(x.CompensationClaims) != 0
+	case "verana.td.v1.GenesisState.orphaned_trust_deposits":
+		return len(x.OrphanedTrustDeposits) != 0
+	case "verana.td.v1.GenesisState.yield_funding_failure":
+		return x.YieldFundingFailure != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -207,6 +522,18 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.TrustDeposits = nil
 	case "verana.td.v1.GenesisState.dust":
 		x.Dust = ""
+	case "verana.td.v1.GenesisState.share_value_checkpoints":
+		x.ShareValueCheckpoints = nil
+	case "verana.td.v1.GenesisState.unbonding_trust_deposits":
+		x.UnbondingTrustDeposits = nil
+	case "verana.td.v1.GenesisState.compensation_pools":
+		x.CompensationPools = nil
+	case "verana.td.v1.GenesisState.compensation_claims":
+		x.CompensationClaims = nil
+	case "verana.td.v1.GenesisState.orphaned_trust_deposits":
+		x.OrphanedTrustDeposits = nil
+	case "verana.td.v1.GenesisState.yield_funding_failure":
+		x.YieldFundingFailure = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -235,6 +562,39 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "verana.td.v1.GenesisState.dust":
 		value := x.Dust
 		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.GenesisState.share_value_checkpoints":
+		if len(x.ShareValueCheckpoints) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ShareValueCheckpoints}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.unbonding_trust_deposits":
+		if len(x.UnbondingTrustDeposits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.UnbondingTrustDeposits}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.compensation_pools":
+		if len(x.CompensationPools) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.CompensationPools}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.compensation_claims":
+		if len(x.CompensationClaims) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.CompensationClaims}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.orphaned_trust_deposits":
+		if len(x.OrphanedTrustDeposits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.OrphanedTrustDeposits}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.yield_funding_failure":
+		value := x.YieldFundingFailure
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -263,6 +623,28 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.TrustDeposits = *clv.list
 	case "verana.td.v1.GenesisState.dust":
 		x.Dust = value.Interface().(string)
+	case "verana.td.v1.GenesisState.share_value_checkpoints":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ShareValueCheckpoints = *clv.list
+	case "verana.td.v1.GenesisState.unbonding_trust_deposits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.UnbondingTrustDeposits = *clv.list
+	case "verana.td.v1.GenesisState.compensation_pools":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.CompensationPools = *clv.list
+	case "verana.td.v1.GenesisState.compensation_claims":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.CompensationClaims = *clv.list
+	case "verana.td.v1.GenesisState.orphaned_trust_deposits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.OrphanedTrustDeposits = *clv.list
+	case "verana.td.v1.GenesisState.yield_funding_failure":
+		x.YieldFundingFailure = value.Message().Interface().(*YieldFundingFailure)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -294,6 +676,41 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.TrustDeposits}
 		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.share_value_checkpoints":
+		if x.ShareValueCheckpoints == nil {
+			x.ShareValueCheckpoints = []*ShareValueCheckpoint{}
+		}
+		value := &_GenesisState_4_list{list: &x.ShareValueCheckpoints}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.unbonding_trust_deposits":
+		if x.UnbondingTrustDeposits == nil {
+			x.UnbondingTrustDeposits = []*UnbondingTrustDeposit{}
+		}
+		value := &_GenesisState_5_list{list: &x.UnbondingTrustDeposits}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.compensation_pools":
+		if x.CompensationPools == nil {
+			x.CompensationPools = []*CompensationPool{}
+		}
+		value := &_GenesisState_6_list{list: &x.CompensationPools}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.compensation_claims":
+		if x.CompensationClaims == nil {
+			x.CompensationClaims = []*CompensationClaim{}
+		}
+		value := &_GenesisState_7_list{list: &x.CompensationClaims}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.orphaned_trust_deposits":
+		if x.OrphanedTrustDeposits == nil {
+			x.OrphanedTrustDeposits = []*TrustDeposit{}
+		}
+		value := &_GenesisState_8_list{list: &x.OrphanedTrustDeposits}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.yield_funding_failure":
+		if x.YieldFundingFailure == nil {
+			x.YieldFundingFailure = new(YieldFundingFailure)
+		}
+		return protoreflect.ValueOfMessage(x.YieldFundingFailure.ProtoReflect())
 	case "verana.td.v1.GenesisState.dust":
 		panic(fmt.Errorf("field dust of message verana.td.v1.GenesisState is not mutable"))
 	default:
@@ -317,6 +734,24 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
 	case "verana.td.v1.GenesisState.dust":
 		return protoreflect.ValueOfString("")
+	case "verana.td.v1.GenesisState.share_value_checkpoints":
+		list := []*ShareValueCheckpoint{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "verana.td.v1.GenesisState.unbonding_trust_deposits":
+		list := []*UnbondingTrustDeposit{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "verana.td.v1.GenesisState.compensation_pools":
+		list := []*CompensationPool{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "verana.td.v1.GenesisState.compensation_claims":
+		list := []*CompensationClaim{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "verana.td.v1.GenesisState.orphaned_trust_deposits":
+		list := []*TrustDeposit{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "verana.td.v1.GenesisState.yield_funding_failure":
+		m := new(YieldFundingFailure)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -400,6 +835,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ShareValueCheckpoints) > 0 {
+			for _, e := range x.ShareValueCheckpoints {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UnbondingTrustDeposits) > 0 {
+			for _, e := range x.UnbondingTrustDeposits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CompensationPools) > 0 {
+			for _, e := range x.CompensationPools {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CompensationClaims) > 0 {
+			for _, e := range x.CompensationClaims {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OrphanedTrustDeposits) > 0 {
+			for _, e := range x.OrphanedTrustDeposits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.YieldFundingFailure != nil {
+			l = options.Size(x.YieldFundingFailure)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -429,6 +898,100 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.YieldFundingFailure != nil {
+			encoded, err := options.Marshal(x.YieldFundingFailure)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.OrphanedTrustDeposits) > 0 {
+			for iNdEx := len(x.OrphanedTrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OrphanedTrustDeposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.CompensationClaims) > 0 {
+			for iNdEx := len(x.CompensationClaims) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CompensationClaims[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.CompensationPools) > 0 {
+			for iNdEx := len(x.CompensationPools) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CompensationPools[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.UnbondingTrustDeposits) > 0 {
+			for iNdEx := len(x.UnbondingTrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingTrustDeposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ShareValueCheckpoints) > 0 {
+			for iNdEx := len(x.ShareValueCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ShareValueCheckpoints[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.Dust) > 0 {
 			i -= len(x.Dust)
 			copy(dAtA[i:], x.Dust)
@@ -445,79 +1008,215 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						Buf:               input.Buf,
 					}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustDeposits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TrustDeposits = append(x.TrustDeposits, &TrustDepositRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrustDeposits[len(x.TrustDeposits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dust = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareValueCheckpoints", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.ShareValueCheckpoints = append(x.ShareValueCheckpoints, &ShareValueCheckpoint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ShareValueCheckpoints[len(x.ShareValueCheckpoints)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTrustDeposits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -544,16 +1243,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.UnbondingTrustDeposits = append(x.UnbondingTrustDeposits, &UnbondingTrustDeposit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTrustDeposits[len(x.UnbondingTrustDeposits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompensationPools", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CompensationPools = append(x.CompensationPools, &CompensationPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompensationPools[len(x.CompensationPools)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustDeposits", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompensationClaims", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -580,16 +1311,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TrustDeposits = append(x.TrustDeposits, &TrustDepositRecord{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrustDeposits[len(x.TrustDeposits)-1]); err != nil {
+				x.CompensationClaims = append(x.CompensationClaims, &CompensationClaim{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompensationClaims[len(x.CompensationClaims)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrphanedTrustDeposits", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -599,23 +1330,61 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Dust = string(dAtA[iNdEx:postIndex])
+				x.OrphanedTrustDeposits = append(x.OrphanedTrustDeposits, &TrustDeposit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OrphanedTrustDeposits[len(x.OrphanedTrustDeposits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldFundingFailure", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.YieldFundingFailure == nil {
+					x.YieldFundingFailure = &YieldFundingFailure{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.YieldFundingFailure); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -653,11 +1422,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TrustDepositRecord             protoreflect.MessageDescriptor
-	fd_TrustDepositRecord_corporation protoreflect.FieldDescriptor
-	fd_TrustDepositRecord_share       protoreflect.FieldDescriptor
-	fd_TrustDepositRecord_deposit     protoreflect.FieldDescriptor
-	fd_TrustDepositRecord_claimable   protoreflect.FieldDescriptor
+	md_TrustDepositRecord                protoreflect.MessageDescriptor
+	fd_TrustDepositRecord_corporation    protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_share          protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_deposit        protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_claimable      protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_corporation_id protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_unbonding      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -667,6 +1438,8 @@ func init() {
 	fd_TrustDepositRecord_share = md_TrustDepositRecord.Fields().ByName("share")
 	fd_TrustDepositRecord_deposit = md_TrustDepositRecord.Fields().ByName("deposit")
 	fd_TrustDepositRecord_claimable = md_TrustDepositRecord.Fields().ByName("claimable")
+	fd_TrustDepositRecord_corporation_id = md_TrustDepositRecord.Fields().ByName("corporation_id")
+	fd_TrustDepositRecord_unbonding = md_TrustDepositRecord.Fields().ByName("unbonding")
 }
 
 var _ protoreflect.Message = (*fastReflection_TrustDepositRecord)(nil)
@@ -758,6 +1531,18 @@ func (x *fastReflection_TrustDepositRecord) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_TrustDepositRecord_corporation_id, value) {
+			return
+		}
+	}
+	if x.Unbonding != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Unbonding)
+		if !f(fd_TrustDepositRecord_unbonding, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -781,6 +1566,10 @@ func (x *fastReflection_TrustDepositRecord) Has(fd protoreflect.FieldDescriptor)
 		return x.Deposit != uint64(0)
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		return x.Claimable != uint64(0)
+	case "verana.td.v1.TrustDepositRecord.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.td.v1.TrustDepositRecord.unbonding":
+		return x.Unbonding != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -805,6 +1594,10 @@ func (x *fastReflection_TrustDepositRecord) Clear(fd protoreflect.FieldDescripto
 		x.Deposit = uint64(0)
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		x.Claimable = uint64(0)
+	case "verana.td.v1.TrustDepositRecord.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.td.v1.TrustDepositRecord.unbonding":
+		x.Unbonding = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -833,6 +1626,12 @@ func (x *fastReflection_TrustDepositRecord) Get(descriptor protoreflect.FieldDes
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		value := x.Claimable
 		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDepositRecord.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDepositRecord.unbonding":
+		value := x.Unbonding
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -861,6 +1660,10 @@ func (x *fastReflection_TrustDepositRecord) Set(fd protoreflect.FieldDescriptor,
 		x.Deposit = value.Uint()
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		x.Claimable = value.Uint()
+	case "verana.td.v1.TrustDepositRecord.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.td.v1.TrustDepositRecord.unbonding":
+		x.Unbonding = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -889,6 +1692,10 @@ func (x *fastReflection_TrustDepositRecord) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field deposit of message verana.td.v1.TrustDepositRecord is not mutable"))
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		panic(fmt.Errorf("field claimable of message verana.td.v1.TrustDepositRecord is not mutable"))
+	case "verana.td.v1.TrustDepositRecord.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.td.v1.TrustDepositRecord is not mutable"))
+	case "verana.td.v1.TrustDepositRecord.unbonding":
+		panic(fmt.Errorf("field unbonding of message verana.td.v1.TrustDepositRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -910,6 +1717,10 @@ func (x *fastReflection_TrustDepositRecord) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDepositRecord.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDepositRecord.unbonding":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -993,6 +1804,12 @@ func (x *fastReflection_TrustDepositRecord) ProtoMethods() *protoiface.Methods {
 		if x.Claimable != 0 {
 			n += 1 + runtime.Sov(uint64(x.Claimable))
 		}
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
+		}
+		if x.Unbonding != 0 {
+			n += 1 + runtime.Sov(uint64(x.Unbonding))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1022,6 +1839,16 @@ func (x *fastReflection_TrustDepositRecord) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Unbonding != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Unbonding))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Claimable != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Claimable))
 			i--
@@ -1197,6 +2024,44 @@ func (x *fastReflection_TrustDepositRecord) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				}
+				x.CorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+				}
+				x.Unbonding = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Unbonding |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1256,6 +2121,16 @@ type GenesisState struct {
 	TrustDeposits []*TrustDepositRecord `protobuf:"bytes,2,rep,name=trust_deposits,json=trustDeposits,proto3" json:"trust_deposits,omitempty"`
 	// dust is the accumulated fractional yield carried forward
 	Dust string `protobuf:"bytes,3,opt,name=dust,proto3" json:"dust,omitempty"`
+	// share_value_checkpoints is the share value history, oldest first
+	ShareValueCheckpoints  []*ShareValueCheckpoint  `protobuf:"bytes,4,rep,name=share_value_checkpoints,json=shareValueCheckpoints,proto3" json:"share_value_checkpoints,omitempty"`
+	UnbondingTrustDeposits []*UnbondingTrustDeposit `protobuf:"bytes,5,rep,name=unbonding_trust_deposits,json=unbondingTrustDeposits,proto3" json:"unbonding_trust_deposits,omitempty"`
+	CompensationPools      []*CompensationPool      `protobuf:"bytes,6,rep,name=compensation_pools,json=compensationPools,proto3" json:"compensation_pools,omitempty"`
+	CompensationClaims     []*CompensationClaim     `protobuf:"bytes,7,rep,name=compensation_claims,json=compensationClaims,proto3" json:"compensation_claims,omitempty"`
+	// orphaned_trust_deposits are the v2 deposits whose account resolved to no
+	// corporation when they were re-keyed by corporation_id
+	OrphanedTrustDeposits []*TrustDeposit `protobuf:"bytes,8,rep,name=orphaned_trust_deposits,json=orphanedTrustDeposits,proto3" json:"orphaned_trust_deposits,omitempty"`
+	// yield_funding_failure is the last failed yield funding step, if any
+	YieldFundingFailure *YieldFundingFailure `protobuf:"bytes,9,opt,name=yield_funding_failure,json=yieldFundingFailure,proto3" json:"yield_funding_failure,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1299,16 +2174,60 @@ func (x *GenesisState) GetDust() string {
 	return ""
 }
 
+func (x *GenesisState) GetShareValueCheckpoints() []*ShareValueCheckpoint {
+	if x != nil {
+		return x.ShareValueCheckpoints
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUnbondingTrustDeposits() []*UnbondingTrustDeposit {
+	if x != nil {
+		return x.UnbondingTrustDeposits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCompensationPools() []*CompensationPool {
+	if x != nil {
+		return x.CompensationPools
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCompensationClaims() []*CompensationClaim {
+	if x != nil {
+		return x.CompensationClaims
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOrphanedTrustDeposits() []*TrustDeposit {
+	if x != nil {
+		return x.OrphanedTrustDeposits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetYieldFundingFailure() *YieldFundingFailure {
+	if x != nil {
+		return x.YieldFundingFailure
+	}
+	return nil
+}
+
 // TrustDepositRecord defines a trust deposit entry for genesis state
 type TrustDepositRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Share       string `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
-	Deposit     uint64 `protobuf:"varint,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	Claimable   uint64 `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	Corporation   string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Share         string `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+	Deposit       uint64 `protobuf:"varint,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Claimable     uint64 `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	CorporationId uint64 `protobuf:"varint,5,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Unbonding     uint64 `protobuf:"varint,6,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
 }
 
 func (x *TrustDepositRecord) Reset() {
@@ -1359,6 +2278,20 @@ func (x *TrustDepositRecord) GetClaimable() uint64 {
 	return 0
 }
 
+func (x *TrustDepositRecord) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *TrustDepositRecord) GetUnbonding() uint64 {
+	if x != nil {
+		return x.Unbonding
+	}
+	return 0
+}
+
 var File_verana_td_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_verana_td_v1_genesis_proto_rawDesc = []byte{
@@ -1370,42 +2303,83 @@ var file_verana_td_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x75, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x75, 0x73, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x75, 0x73, 0x74,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a,
-	0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f,
-	0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x05, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f,
-	0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x52, 0x05, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0xa7, 0x01, 0x0a,
-	0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76,
-	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76,
-	0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x54, 0x58, 0xaa, 0x02, 0x0c, 0x56,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a,
-	0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a,
+	0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x75, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x75, 0x73, 0x74,
+	0x12, 0x60, 0x0a, 0x17, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x63, 0x0a, 0x18, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x16, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x65,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x65,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x13,
+	0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x12, 0x58, 0x0a, 0x17, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64,
+	0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65,
+	0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x55,
+	0x0a, 0x15, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x59, 0x69, 0x65,
+	0x6c, 0x64, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x52, 0x13, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0b,
+	0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x72,
+	0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x0c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x52, 0x05, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0xa7, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x74, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f,
+	0x74, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x54, 0x58,
+	0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1422,18 +2396,30 @@ func file_verana_td_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_verana_td_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_verana_td_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),       // 0: verana.td.v1.GenesisState
-	(*TrustDepositRecord)(nil), // 1: verana.td.v1.TrustDepositRecord
-	(*Params)(nil),             // 2: verana.td.v1.Params
+	(*GenesisState)(nil),          // 0: verana.td.v1.GenesisState
+	(*TrustDepositRecord)(nil),    // 1: verana.td.v1.TrustDepositRecord
+	(*Params)(nil),                // 2: verana.td.v1.Params
+	(*ShareValueCheckpoint)(nil),  // 3: verana.td.v1.ShareValueCheckpoint
+	(*UnbondingTrustDeposit)(nil), // 4: verana.td.v1.UnbondingTrustDeposit
+	(*CompensationPool)(nil),      // 5: verana.td.v1.CompensationPool
+	(*CompensationClaim)(nil),     // 6: verana.td.v1.CompensationClaim
+	(*TrustDeposit)(nil),          // 7: verana.td.v1.TrustDeposit
+	(*YieldFundingFailure)(nil),   // 8: verana.td.v1.YieldFundingFailure
 }
 var file_verana_td_v1_genesis_proto_depIdxs = []int32{
 	2, // 0: verana.td.v1.GenesisState.params:type_name -> verana.td.v1.Params
 	1, // 1: verana.td.v1.GenesisState.trust_deposits:type_name -> verana.td.v1.TrustDepositRecord
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: verana.td.v1.GenesisState.share_value_checkpoints:type_name -> verana.td.v1.ShareValueCheckpoint
+	4, // 3: verana.td.v1.GenesisState.unbonding_trust_deposits:type_name -> verana.td.v1.UnbondingTrustDeposit
+	5, // 4: verana.td.v1.GenesisState.compensation_pools:type_name -> verana.td.v1.CompensationPool
+	6, // 5: verana.td.v1.GenesisState.compensation_claims:type_name -> verana.td.v1.CompensationClaim
+	7, // 6: verana.td.v1.GenesisState.orphaned_trust_deposits:type_name -> verana.td.v1.TrustDeposit
+	8, // 7: verana.td.v1.GenesisState.yield_funding_failure:type_name -> verana.td.v1.YieldFundingFailure
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_verana_td_v1_genesis_proto_init() }
@@ -1442,6 +2428,7 @@ func file_verana_td_v1_genesis_proto_init() {
 		return
 	}
 	file_verana_td_v1_params_proto_init()
+	file_verana_td_v1_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_verana_td_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -15,15 +15,24 @@ import (
 )
 
 var (
-	md_Params                                  protoreflect.MessageDescriptor
-	fd_Params_trust_deposit_reclaim_burn_rate  protoreflect.FieldDescriptor
-	fd_Params_trust_deposit_share_value        protoreflect.FieldDescriptor
-	fd_Params_trust_deposit_rate               protoreflect.FieldDescriptor
-	fd_Params_wallet_user_agent_reward_rate    protoreflect.FieldDescriptor
-	fd_Params_user_agent_reward_rate           protoreflect.FieldDescriptor
-	fd_Params_trust_deposit_max_yield_rate     protoreflect.FieldDescriptor
-	fd_Params_yield_intermediate_pool          protoreflect.FieldDescriptor
-	fd_Params_trust_deposit_block_reward_share protoreflect.FieldDescriptor
+	md_Params                                         protoreflect.MessageDescriptor
+	fd_Params_trust_deposit_reclaim_burn_rate         protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_share_value               protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_rate                      protoreflect.FieldDescriptor
+	fd_Params_wallet_user_agent_reward_rate           protoreflect.FieldDescriptor
+	fd_Params_user_agent_reward_rate                  protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_max_yield_rate            protoreflect.FieldDescriptor
+	fd_Params_yield_intermediate_pool                 protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_block_reward_share        protoreflect.FieldDescriptor
+	fd_Params_share_value_checkpoint_interval_seconds protoreflect.FieldDescriptor
+	fd_Params_share_value_history_retention_days      protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_unbonding_days            protoreflect.FieldDescriptor
+	fd_Params_compensation_pool_share                 protoreflect.FieldDescriptor
+	fd_Params_yield_curve_min_rate                    protoreflect.FieldDescriptor
+	fd_Params_yield_curve_max_rate                    protoreflect.FieldDescriptor
+	fd_Params_yield_curve_kink_rate                   protoreflect.FieldDescriptor
+	fd_Params_yield_curve_target_ratio                protoreflect.FieldDescriptor
+	fd_Params_yield_curve_ratio_basis                 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +46,15 @@ func init() {
 	fd_Params_trust_deposit_max_yield_rate = md_Params.Fields().ByName("trust_deposit_max_yield_rate")
 	fd_Params_yield_intermediate_pool = md_Params.Fields().ByName("yield_intermediate_pool")
 	fd_Params_trust_deposit_block_reward_share = md_Params.Fields().ByName("trust_deposit_block_reward_share")
+	fd_Params_share_value_checkpoint_interval_seconds = md_Params.Fields().ByName("share_value_checkpoint_interval_seconds")
+	fd_Params_share_value_history_retention_days = md_Params.Fields().ByName("share_value_history_retention_days")
+	fd_Params_trust_deposit_unbonding_days = md_Params.Fields().ByName("trust_deposit_unbonding_days")
+	fd_Params_compensation_pool_share = md_Params.Fields().ByName("compensation_pool_share")
+	fd_Params_yield_curve_min_rate = md_Params.Fields().ByName("yield_curve_min_rate")
+	fd_Params_yield_curve_max_rate = md_Params.Fields().ByName("yield_curve_max_rate")
+	fd_Params_yield_curve_kink_rate = md_Params.Fields().ByName("yield_curve_kink_rate")
+	fd_Params_yield_curve_target_ratio = md_Params.Fields().ByName("yield_curve_target_ratio")
+	fd_Params_yield_curve_ratio_basis = md_Params.Fields().ByName("yield_curve_ratio_basis")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -152,6 +170,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ShareValueCheckpointIntervalSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ShareValueCheckpointIntervalSeconds)
+		if !f(fd_Params_share_value_checkpoint_interval_seconds, value) {
+			return
+		}
+	}
+	if x.ShareValueHistoryRetentionDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ShareValueHistoryRetentionDays)
+		if !f(fd_Params_share_value_history_retention_days, value) {
+			return
+		}
+	}
+	if x.TrustDepositUnbondingDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrustDepositUnbondingDays)
+		if !f(fd_Params_trust_deposit_unbonding_days, value) {
+			return
+		}
+	}
+	if x.CompensationPoolShare != "" {
+		value := protoreflect.ValueOfString(x.CompensationPoolShare)
+		if !f(fd_Params_compensation_pool_share, value) {
+			return
+		}
+	}
+	if x.YieldCurveMinRate != "" {
+		value := protoreflect.ValueOfString(x.YieldCurveMinRate)
+		if !f(fd_Params_yield_curve_min_rate, value) {
+			return
+		}
+	}
+	if x.YieldCurveMaxRate != "" {
+		value := protoreflect.ValueOfString(x.YieldCurveMaxRate)
+		if !f(fd_Params_yield_curve_max_rate, value) {
+			return
+		}
+	}
+	if x.YieldCurveKinkRate != "" {
+		value := protoreflect.ValueOfString(x.YieldCurveKinkRate)
+		if !f(fd_Params_yield_curve_kink_rate, value) {
+			return
+		}
+	}
+	if x.YieldCurveTargetRatio != "" {
+		value := protoreflect.ValueOfString(x.YieldCurveTargetRatio)
+		if !f(fd_Params_yield_curve_target_ratio, value) {
+			return
+		}
+	}
+	if x.YieldCurveRatioBasis != "" {
+		value := protoreflect.ValueOfString(x.YieldCurveRatioBasis)
+		if !f(fd_Params_yield_curve_ratio_basis, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -183,6 +255,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.YieldIntermediatePool != ""
 	case "verana.td.v1.Params.trust_deposit_block_reward_share":
 		return x.TrustDepositBlockRewardShare != ""
+	case "verana.td.v1.Params.share_value_checkpoint_interval_seconds":
+		return x.ShareValueCheckpointIntervalSeconds != uint64(0)
+	case "verana.td.v1.Params.share_value_history_retention_days":
+		return x.ShareValueHistoryRetentionDays != uint64(0)
+	case "verana.td.v1.Params.trust_deposit_unbonding_days":
+		return x.TrustDepositUnbondingDays != uint64(0)
+	case "verana.td.v1.Params.compensation_pool_share":
+		return x.CompensationPoolShare != ""
+	case "verana.td.v1.Params.yield_curve_min_rate":
+		return x.YieldCurveMinRate != ""
+	case "verana.td.v1.Params.yield_curve_max_rate":
+		return x.YieldCurveMaxRate != ""
+	case "verana.td.v1.Params.yield_curve_kink_rate":
+		return x.YieldCurveKinkRate != ""
+	case "verana.td.v1.Params.yield_curve_target_ratio":
+		return x.YieldCurveTargetRatio != ""
+	case "verana.td.v1.Params.yield_curve_ratio_basis":
+		return x.YieldCurveRatioBasis != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -215,6 +305,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.YieldIntermediatePool = ""
 	case "verana.td.v1.Params.trust_deposit_block_reward_share":
 		x.TrustDepositBlockRewardShare = ""
+	case "verana.td.v1.Params.share_value_checkpoint_interval_seconds":
+		x.ShareValueCheckpointIntervalSeconds = uint64(0)
+	case "verana.td.v1.Params.share_value_history_retention_days":
+		x.ShareValueHistoryRetentionDays = uint64(0)
+	case "verana.td.v1.Params.trust_deposit_unbonding_days":
+		x.TrustDepositUnbondingDays = uint64(0)
+	case "verana.td.v1.Params.compensation_pool_share":
+		x.CompensationPoolShare = ""
+	case "verana.td.v1.Params.yield_curve_min_rate":
+		x.YieldCurveMinRate = ""
+	case "verana.td.v1.Params.yield_curve_max_rate":
+		x.YieldCurveMaxRate = ""
+	case "verana.td.v1.Params.yield_curve_kink_rate":
+		x.YieldCurveKinkRate = ""
+	case "verana.td.v1.Params.yield_curve_target_ratio":
+		x.YieldCurveTargetRatio = ""
+	case "verana.td.v1.Params.yield_curve_ratio_basis":
+		x.YieldCurveRatioBasis = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -255,6 +363,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "verana.td.v1.Params.trust_deposit_block_reward_share":
 		value := x.TrustDepositBlockRewardShare
 		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.share_value_checkpoint_interval_seconds":
+		value := x.ShareValueCheckpointIntervalSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.Params.share_value_history_retention_days":
+		value := x.ShareValueHistoryRetentionDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.Params.trust_deposit_unbonding_days":
+		value := x.TrustDepositUnbondingDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.Params.compensation_pool_share":
+		value := x.CompensationPoolShare
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.yield_curve_min_rate":
+		value := x.YieldCurveMinRate
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.yield_curve_max_rate":
+		value := x.YieldCurveMaxRate
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.yield_curve_kink_rate":
+		value := x.YieldCurveKinkRate
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.yield_curve_target_ratio":
+		value := x.YieldCurveTargetRatio
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.yield_curve_ratio_basis":
+		value := x.YieldCurveRatioBasis
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -291,6 +426,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.YieldIntermediatePool = value.Interface().(string)
 	case "verana.td.v1.Params.trust_deposit_block_reward_share":
 		x.TrustDepositBlockRewardShare = value.Interface().(string)
+	case "verana.td.v1.Params.share_value_checkpoint_interval_seconds":
+		x.ShareValueCheckpointIntervalSeconds = value.Uint()
+	case "verana.td.v1.Params.share_value_history_retention_days":
+		x.ShareValueHistoryRetentionDays = value.Uint()
+	case "verana.td.v1.Params.trust_deposit_unbonding_days":
+		x.TrustDepositUnbondingDays = value.Uint()
+	case "verana.td.v1.Params.compensation_pool_share":
+		x.CompensationPoolShare = value.Interface().(string)
+	case "verana.td.v1.Params.yield_curve_min_rate":
+		x.YieldCurveMinRate = value.Interface().(string)
+	case "verana.td.v1.Params.yield_curve_max_rate":
+		x.YieldCurveMaxRate = value.Interface().(string)
+	case "verana.td.v1.Params.yield_curve_kink_rate":
+		x.YieldCurveKinkRate = value.Interface().(string)
+	case "verana.td.v1.Params.yield_curve_target_ratio":
+		x.YieldCurveTargetRatio = value.Interface().(string)
+	case "verana.td.v1.Params.yield_curve_ratio_basis":
+		x.YieldCurveRatioBasis = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -327,6 +480,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field yield_intermediate_pool of message verana.td.v1.Params is not mutable"))
 	case "verana.td.v1.Params.trust_deposit_block_reward_share":
 		panic(fmt.Errorf("field trust_deposit_block_reward_share of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.share_value_checkpoint_interval_seconds":
+		panic(fmt.Errorf("field share_value_checkpoint_interval_seconds of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.share_value_history_retention_days":
+		panic(fmt.Errorf("field share_value_history_retention_days of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.trust_deposit_unbonding_days":
+		panic(fmt.Errorf("field trust_deposit_unbonding_days of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.compensation_pool_share":
+		panic(fmt.Errorf("field compensation_pool_share of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_curve_min_rate":
+		panic(fmt.Errorf("field yield_curve_min_rate of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_curve_max_rate":
+		panic(fmt.Errorf("field yield_curve_max_rate of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_curve_kink_rate":
+		panic(fmt.Errorf("field yield_curve_kink_rate of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_curve_target_ratio":
+		panic(fmt.Errorf("field yield_curve_target_ratio of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_curve_ratio_basis":
+		panic(fmt.Errorf("field yield_curve_ratio_basis of message verana.td.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -356,6 +527,24 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "verana.td.v1.Params.trust_deposit_block_reward_share":
 		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.share_value_checkpoint_interval_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.Params.share_value_history_retention_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.Params.trust_deposit_unbonding_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.Params.compensation_pool_share":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.yield_curve_min_rate":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.yield_curve_max_rate":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.yield_curve_kink_rate":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.yield_curve_target_ratio":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.yield_curve_ratio_basis":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -457,6 +646,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ShareValueCheckpointIntervalSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.ShareValueCheckpointIntervalSeconds))
+		}
+		if x.ShareValueHistoryRetentionDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.ShareValueHistoryRetentionDays))
+		}
+		if x.TrustDepositUnbondingDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrustDepositUnbondingDays))
+		}
+		l = len(x.CompensationPoolShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.YieldCurveMinRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.YieldCurveMaxRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.YieldCurveKinkRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.YieldCurveTargetRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.YieldCurveRatioBasis)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -486,6 +708,67 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.YieldCurveRatioBasis) > 0 {
+			i -= len(x.YieldCurveRatioBasis)
+			copy(dAtA[i:], x.YieldCurveRatioBasis)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YieldCurveRatioBasis)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.YieldCurveTargetRatio) > 0 {
+			i -= len(x.YieldCurveTargetRatio)
+			copy(dAtA[i:], x.YieldCurveTargetRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YieldCurveTargetRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.YieldCurveKinkRate) > 0 {
+			i -= len(x.YieldCurveKinkRate)
+			copy(dAtA[i:], x.YieldCurveKinkRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YieldCurveKinkRate)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.YieldCurveMaxRate) > 0 {
+			i -= len(x.YieldCurveMaxRate)
+			copy(dAtA[i:], x.YieldCurveMaxRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YieldCurveMaxRate)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.YieldCurveMinRate) > 0 {
+			i -= len(x.YieldCurveMinRate)
+			copy(dAtA[i:], x.YieldCurveMinRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YieldCurveMinRate)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.CompensationPoolShare) > 0 {
+			i -= len(x.CompensationPoolShare)
+			copy(dAtA[i:], x.CompensationPoolShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CompensationPoolShare)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.TrustDepositUnbondingDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrustDepositUnbondingDays))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ShareValueHistoryRetentionDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ShareValueHistoryRetentionDays))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ShareValueCheckpointIntervalSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ShareValueCheckpointIntervalSeconds))
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.TrustDepositBlockRewardShare) > 0 {
 			i -= len(x.TrustDepositBlockRewardShare)
 			copy(dAtA[i:], x.TrustDepositBlockRewardShare)
@@ -847,6 +1130,255 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.TrustDepositBlockRewardShare = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareValueCheckpointIntervalSeconds", wireType)
+				}
+				x.ShareValueCheckpointIntervalSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ShareValueCheckpointIntervalSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareValueHistoryRetentionDays", wireType)
+				}
+				x.ShareValueHistoryRetentionDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ShareValueHistoryRetentionDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustDepositUnbondingDays", wireType)
+				}
+				x.TrustDepositUnbondingDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrustDepositUnbondingDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompensationPoolShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CompensationPoolShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldCurveMinRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldCurveMinRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldCurveMaxRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldCurveMaxRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldCurveKinkRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldCurveKinkRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldCurveTargetRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldCurveTargetRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldCurveRatioBasis", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldCurveRatioBasis = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -912,6 +1444,29 @@ type Params struct {
 	YieldIntermediatePool string `protobuf:"bytes,7,opt,name=yield_intermediate_pool,json=yieldIntermediatePool,proto3" json:"yield_intermediate_pool,omitempty"`
 	// trust_deposit_block_reward_share is the fraction of block rewards allocated to trust deposit yield (e.g. "0.2" for 20%)
 	TrustDepositBlockRewardShare string `protobuf:"bytes,8,opt,name=trust_deposit_block_reward_share,json=trustDepositBlockRewardShare,proto3" json:"trust_deposit_block_reward_share,omitempty"`
+	// share_value_checkpoint_interval_seconds is the minimum time between two
+	// share value checkpoints. 0 disables the share value history.
+	ShareValueCheckpointIntervalSeconds uint64 `protobuf:"varint,9,opt,name=share_value_checkpoint_interval_seconds,json=shareValueCheckpointIntervalSeconds,proto3" json:"share_value_checkpoint_interval_seconds,omitempty"`
+	// share_value_history_retention_days is how long share value checkpoints
+	// are kept before being pruned
+	ShareValueHistoryRetentionDays uint64 `protobuf:"varint,10,opt,name=share_value_history_retention_days,json=shareValueHistoryRetentionDays,proto3" json:"share_value_history_retention_days,omitempty"`
+	// trust_deposit_unbonding_days is how long a released trust deposit stays
+	// slashable before it becomes claimable. 0 makes it claimable at once.
+	TrustDepositUnbondingDays uint64 `protobuf:"varint,11,opt,name=trust_deposit_unbonding_days,json=trustDepositUnbondingDays,proto3" json:"trust_deposit_unbonding_days,omitempty"`
+	// compensation_pool_share is the fraction of an ecosystem slash moved to
+	// the compensation pool of the ecosystem instead of being burned
+	CompensationPoolShare string `protobuf:"bytes,12,opt,name=compensation_pool_share,json=compensationPoolShare,proto3" json:"compensation_pool_share,omitempty"`
+	// The optional yield curve replaces trust_deposit_max_yield_rate when
+	// yield_curve_max_rate is positive. The rate falls from yield_curve_max_rate
+	// when nothing is deposited to yield_curve_kink_rate at a deposit ratio of
+	// yield_curve_target_ratio, then to yield_curve_min_rate at a ratio of 1.
+	// The deposit ratio is the trust deposit over yield_curve_ratio_basis.
+	YieldCurveMinRate     string `protobuf:"bytes,13,opt,name=yield_curve_min_rate,json=yieldCurveMinRate,proto3" json:"yield_curve_min_rate,omitempty"`
+	YieldCurveMaxRate     string `protobuf:"bytes,14,opt,name=yield_curve_max_rate,json=yieldCurveMaxRate,proto3" json:"yield_curve_max_rate,omitempty"`
+	YieldCurveKinkRate    string `protobuf:"bytes,15,opt,name=yield_curve_kink_rate,json=yieldCurveKinkRate,proto3" json:"yield_curve_kink_rate,omitempty"`
+	YieldCurveTargetRatio string `protobuf:"bytes,16,opt,name=yield_curve_target_ratio,json=yieldCurveTargetRatio,proto3" json:"yield_curve_target_ratio,omitempty"`
+	// yield_curve_ratio_basis is bonded_stake or total_supply
+	YieldCurveRatioBasis string `protobuf:"bytes,17,opt,name=yield_curve_ratio_basis,json=yieldCurveRatioBasis,proto3" json:"yield_curve_ratio_basis,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -990,6 +1545,69 @@ func (x *Params) GetTrustDepositBlockRewardShare() string {
 	return ""
 }
 
+func (x *Params) GetShareValueCheckpointIntervalSeconds() uint64 {
+	if x != nil {
+		return x.ShareValueCheckpointIntervalSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetShareValueHistoryRetentionDays() uint64 {
+	if x != nil {
+		return x.ShareValueHistoryRetentionDays
+	}
+	return 0
+}
+
+func (x *Params) GetTrustDepositUnbondingDays() uint64 {
+	if x != nil {
+		return x.TrustDepositUnbondingDays
+	}
+	return 0
+}
+
+func (x *Params) GetCompensationPoolShare() string {
+	if x != nil {
+		return x.CompensationPoolShare
+	}
+	return ""
+}
+
+func (x *Params) GetYieldCurveMinRate() string {
+	if x != nil {
+		return x.YieldCurveMinRate
+	}
+	return ""
+}
+
+func (x *Params) GetYieldCurveMaxRate() string {
+	if x != nil {
+		return x.YieldCurveMaxRate
+	}
+	return ""
+}
+
+func (x *Params) GetYieldCurveKinkRate() string {
+	if x != nil {
+		return x.YieldCurveKinkRate
+	}
+	return ""
+}
+
+func (x *Params) GetYieldCurveTargetRatio() string {
+	if x != nil {
+		return x.YieldCurveTargetRatio
+	}
+	return ""
+}
+
+func (x *Params) GetYieldCurveRatioBasis() string {
+	if x != nil {
+		return x.YieldCurveRatioBasis
+	}
+	return ""
+}
+
 var File_verana_td_v1_params_proto protoreflect.FileDescriptor
 
 var file_verana_td_v1_params_proto_rawDesc = []byte{
@@ -998,7 +1616,7 @@ var file_verana_td_v1_params_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
 	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xbd, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x93, 0x01,
+	0x74, 0x6f, 0x22, 0xea, 0x10, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x93, 0x01,
 	0x0a, 0x1f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
 	0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
@@ -1064,20 +1682,86 @@ var file_verana_td_v1_params_proto_rawDesc = []byte{
 	0x73, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
 	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x52, 0x1c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65,
 	0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x53, 0x68, 0x61, 0x72, 0x65, 0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x12,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x74, 0x64, 0x2f, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0xa6, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x54,
-	0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x27, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0xf2, 0xde, 0x1f, 0x2e, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x23, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x79, 0x0a, 0x22, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xf2, 0xde,
+	0x1f, 0x29, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x22, 0x52, 0x1e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x68, 0x0a, 0x1c, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x22, 0x52, 0x19, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x79, 0x73, 0x12, 0x7d, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1e, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x52, 0x15, 0x63,
+	0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x75,
+	0x72, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x42, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x11, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x75, 0x72,
+	0x76, 0x65, 0x4d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x79, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1b,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x76,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x11, 0x79, 0x69, 0x65,
+	0x6c, 0x64, 0x43, 0x75, 0x72, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x76,
+	0x0a, 0x15, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x5f, 0x6b, 0x69,
+	0x6e, 0x6b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x79, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x22, 0x52, 0x12, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x75, 0x72, 0x76, 0x65, 0x4b, 0x69,
+	0x6e, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x7f, 0x0a, 0x18, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x63, 0x75, 0x72, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f,
+	0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x75, 0x72,
+	0x76, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22,
+	0x52, 0x15, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x75, 0x72, 0x76, 0x65, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x59, 0x0a, 0x17, 0x79, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x62, 0x61, 0x73,
+	0x69, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x22, 0x52, 0x14, 0x79, 0x69,
+	0x65, 0x6c, 0x64, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x42, 0x61, 0x73,
+	0x69, 0x73, 0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x74, 0x64, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0xa6, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74,
+	0x64, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64,
+	0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x54, 0x58, 0xaa, 0x02,
+	0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c,
+	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
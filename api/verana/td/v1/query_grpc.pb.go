@@ -19,8 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName          = "/verana.td.v1.Query/Params"
-	Query_GetTrustDeposit_FullMethodName = "/verana.td.v1.Query/GetTrustDeposit"
+	Query_Params_FullMethodName                     = "/verana.td.v1.Query/Params"
+	Query_GetTrustDeposit_FullMethodName            = "/verana.td.v1.Query/GetTrustDeposit"
+	Query_ListTrustDeposits_FullMethodName          = "/verana.td.v1.Query/ListTrustDeposits"
+	Query_TrustDepositStats_FullMethodName          = "/verana.td.v1.Query/TrustDepositStats"
+	Query_GetCorporationTrustDeposit_FullMethodName = "/verana.td.v1.Query/GetCorporationTrustDeposit"
+	Query_ListUnbondingTrustDeposits_FullMethodName = "/verana.td.v1.Query/ListUnbondingTrustDeposits"
+	Query_GetCompensationPool_FullMethodName        = "/verana.td.v1.Query/GetCompensationPool"
+	Query_GetCompensationClaim_FullMethodName       = "/verana.td.v1.Query/GetCompensationClaim"
+	Query_ListCompensationClaims_FullMethodName     = "/verana.td.v1.Query/ListCompensationClaims"
+	Query_ListShareValueCheckpoints_FullMethodName  = "/verana.td.v1.Query/ListShareValueCheckpoints"
+	Query_RealizedApr_FullMethodName                = "/verana.td.v1.Query/RealizedApr"
+	Query_EffectiveYieldRate_FullMethodName         = "/verana.td.v1.Query/EffectiveYieldRate"
+	Query_AccruedYield_FullMethodName               = "/verana.td.v1.Query/AccruedYield"
+	Query_GetYieldFundingFailure_FullMethodName     = "/verana.td.v1.Query/GetYieldFundingFailure"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,7 +43,40 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetTrustDeposit looks a trust deposit up by corporation account
+	// (policy_address). Kept for compatibility, deposits are keyed by
+	// corporation_id.
 	GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error)
+	// ListTrustDeposits lists trust deposits by ascending corporation_id.
+	ListTrustDeposits(ctx context.Context, in *QueryListTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListTrustDepositsResponse, error)
+	// TrustDepositStats returns aggregate figures over all trust deposits.
+	TrustDepositStats(ctx context.Context, in *QueryTrustDepositStatsRequest, opts ...grpc.CallOption) (*QueryTrustDepositStatsResponse, error)
+	// GetCorporationTrustDeposit looks a trust deposit up by corporation_id.
+	GetCorporationTrustDeposit(ctx context.Context, in *QueryGetCorporationTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetCorporationTrustDepositResponse, error)
+	// ListUnbondingTrustDeposits returns the released trust deposits that are
+	// not claimable yet, by ascending completion time.
+	ListUnbondingTrustDeposits(ctx context.Context, in *QueryListUnbondingTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListUnbondingTrustDepositsResponse, error)
+	// GetCompensationPool returns the compensation pool of an ecosystem.
+	GetCompensationPool(ctx context.Context, in *QueryGetCompensationPoolRequest, opts ...grpc.CallOption) (*QueryGetCompensationPoolResponse, error)
+	// GetCompensationClaim returns a compensation claim.
+	GetCompensationClaim(ctx context.Context, in *QueryGetCompensationClaimRequest, opts ...grpc.CallOption) (*QueryGetCompensationClaimResponse, error)
+	// ListCompensationClaims returns the compensation claims by ascending id.
+	ListCompensationClaims(ctx context.Context, in *QueryListCompensationClaimsRequest, opts ...grpc.CallOption) (*QueryListCompensationClaimsResponse, error)
+	// ListShareValueCheckpoints returns the sampled share value history.
+	ListShareValueCheckpoints(ctx context.Context, in *QueryListShareValueCheckpointsRequest, opts ...grpc.CallOption) (*QueryListShareValueCheckpointsResponse, error)
+	// RealizedApr returns the annualized yield realized by the share value
+	// over a window.
+	RealizedApr(ctx context.Context, in *QueryRealizedAprRequest, opts ...grpc.CallOption) (*QueryRealizedAprResponse, error)
+	// EffectiveYieldRate returns the annual yield rate the current block
+	// distributes, from the yield curve if enabled.
+	EffectiveYieldRate(ctx context.Context, in *QueryEffectiveYieldRateRequest, opts ...grpc.CallOption) (*QueryEffectiveYieldRateResponse, error)
+	// AccruedYield returns an approximation of the yield accrued by a
+	// corporation's trust deposit since a given time: the current share is
+	// assumed to have been held over the whole window.
+	AccruedYield(ctx context.Context, in *QueryAccruedYieldRequest, opts ...grpc.CallOption) (*QueryAccruedYieldResponse, error)
+	// GetYieldFundingFailure returns the last yield funding step that failed,
+	// if the yield distribution has not succeeded since.
+	GetYieldFundingFailure(ctx context.Context, in *QueryGetYieldFundingFailureRequest, opts ...grpc.CallOption) (*QueryGetYieldFundingFailureResponse, error)
 }
 
 type queryClient struct {
@@ -62,6 +107,126 @@ func (c *queryClient) GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepo
 	return out, nil
 }
 
+func (c *queryClient) ListTrustDeposits(ctx context.Context, in *QueryListTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListTrustDepositsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListTrustDepositsResponse)
+	err := c.cc.Invoke(ctx, Query_ListTrustDeposits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrustDepositStats(ctx context.Context, in *QueryTrustDepositStatsRequest, opts ...grpc.CallOption) (*QueryTrustDepositStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTrustDepositStatsResponse)
+	err := c.cc.Invoke(ctx, Query_TrustDepositStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCorporationTrustDeposit(ctx context.Context, in *QueryGetCorporationTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetCorporationTrustDepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetCorporationTrustDepositResponse)
+	err := c.cc.Invoke(ctx, Query_GetCorporationTrustDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListUnbondingTrustDeposits(ctx context.Context, in *QueryListUnbondingTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListUnbondingTrustDepositsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListUnbondingTrustDepositsResponse)
+	err := c.cc.Invoke(ctx, Query_ListUnbondingTrustDeposits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCompensationPool(ctx context.Context, in *QueryGetCompensationPoolRequest, opts ...grpc.CallOption) (*QueryGetCompensationPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetCompensationPoolResponse)
+	err := c.cc.Invoke(ctx, Query_GetCompensationPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCompensationClaim(ctx context.Context, in *QueryGetCompensationClaimRequest, opts ...grpc.CallOption) (*QueryGetCompensationClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetCompensationClaimResponse)
+	err := c.cc.Invoke(ctx, Query_GetCompensationClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListCompensationClaims(ctx context.Context, in *QueryListCompensationClaimsRequest, opts ...grpc.CallOption) (*QueryListCompensationClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListCompensationClaimsResponse)
+	err := c.cc.Invoke(ctx, Query_ListCompensationClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListShareValueCheckpoints(ctx context.Context, in *QueryListShareValueCheckpointsRequest, opts ...grpc.CallOption) (*QueryListShareValueCheckpointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryListShareValueCheckpointsResponse)
+	err := c.cc.Invoke(ctx, Query_ListShareValueCheckpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RealizedApr(ctx context.Context, in *QueryRealizedAprRequest, opts ...grpc.CallOption) (*QueryRealizedAprResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRealizedAprResponse)
+	err := c.cc.Invoke(ctx, Query_RealizedApr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveYieldRate(ctx context.Context, in *QueryEffectiveYieldRateRequest, opts ...grpc.CallOption) (*QueryEffectiveYieldRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEffectiveYieldRateResponse)
+	err := c.cc.Invoke(ctx, Query_EffectiveYieldRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccruedYield(ctx context.Context, in *QueryAccruedYieldRequest, opts ...grpc.CallOption) (*QueryAccruedYieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccruedYieldResponse)
+	err := c.cc.Invoke(ctx, Query_AccruedYield_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetYieldFundingFailure(ctx context.Context, in *QueryGetYieldFundingFailureRequest, opts ...grpc.CallOption) (*QueryGetYieldFundingFailureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetYieldFundingFailureResponse)
+	err := c.cc.Invoke(ctx, Query_GetYieldFundingFailure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -70,7 +235,40 @@ func (c *queryClient) GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepo
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetTrustDeposit looks a trust deposit up by corporation account
+	// (policy_address). Kept for compatibility, deposits are keyed by
+	// corporation_id.
 	GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error)
+	// ListTrustDeposits lists trust deposits by ascending corporation_id.
+	ListTrustDeposits(context.Context, *QueryListTrustDepositsRequest) (*QueryListTrustDepositsResponse, error)
+	// TrustDepositStats returns aggregate figures over all trust deposits.
+	TrustDepositStats(context.Context, *QueryTrustDepositStatsRequest) (*QueryTrustDepositStatsResponse, error)
+	// GetCorporationTrustDeposit looks a trust deposit up by corporation_id.
+	GetCorporationTrustDeposit(context.Context, *QueryGetCorporationTrustDepositRequest) (*QueryGetCorporationTrustDepositResponse, error)
+	// ListUnbondingTrustDeposits returns the released trust deposits that are
+	// not claimable yet, by ascending completion time.
+	ListUnbondingTrustDeposits(context.Context, *QueryListUnbondingTrustDepositsRequest) (*QueryListUnbondingTrustDepositsResponse, error)
+	// GetCompensationPool returns the compensation pool of an ecosystem.
+	GetCompensationPool(context.Context, *QueryGetCompensationPoolRequest) (*QueryGetCompensationPoolResponse, error)
+	// GetCompensationClaim returns a compensation claim.
+	GetCompensationClaim(context.Context, *QueryGetCompensationClaimRequest) (*QueryGetCompensationClaimResponse, error)
+	// ListCompensationClaims returns the compensation claims by ascending id.
+	ListCompensationClaims(context.Context, *QueryListCompensationClaimsRequest) (*QueryListCompensationClaimsResponse, error)
+	// ListShareValueCheckpoints returns the sampled share value history.
+	ListShareValueCheckpoints(context.Context, *QueryListShareValueCheckpointsRequest) (*QueryListShareValueCheckpointsResponse, error)
+	// RealizedApr returns the annualized yield realized by the share value
+	// over a window.
+	RealizedApr(context.Context, *QueryRealizedAprRequest) (*QueryRealizedAprResponse, error)
+	// EffectiveYieldRate returns the annual yield rate the current block
+	// distributes, from the yield curve if enabled.
+	EffectiveYieldRate(context.Context, *QueryEffectiveYieldRateRequest) (*QueryEffectiveYieldRateResponse, error)
+	// AccruedYield returns an approximation of the yield accrued by a
+	// corporation's trust deposit since a given time: the current share is
+	// assumed to have been held over the whole window.
+	AccruedYield(context.Context, *QueryAccruedYieldRequest) (*QueryAccruedYieldResponse, error)
+	// GetYieldFundingFailure returns the last yield funding step that failed,
+	// if the yield distribution has not succeeded since.
+	GetYieldFundingFailure(context.Context, *QueryGetYieldFundingFailureRequest) (*QueryGetYieldFundingFailureResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -87,6 +285,42 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDeposit not implemented")
 }
+func (UnimplementedQueryServer) ListTrustDeposits(context.Context, *QueryListTrustDepositsRequest) (*QueryListTrustDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrustDeposits not implemented")
+}
+func (UnimplementedQueryServer) TrustDepositStats(context.Context, *QueryTrustDepositStatsRequest) (*QueryTrustDepositStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustDepositStats not implemented")
+}
+func (UnimplementedQueryServer) GetCorporationTrustDeposit(context.Context, *QueryGetCorporationTrustDepositRequest) (*QueryGetCorporationTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorporationTrustDeposit not implemented")
+}
+func (UnimplementedQueryServer) ListUnbondingTrustDeposits(context.Context, *QueryListUnbondingTrustDepositsRequest) (*QueryListUnbondingTrustDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnbondingTrustDeposits not implemented")
+}
+func (UnimplementedQueryServer) GetCompensationPool(context.Context, *QueryGetCompensationPoolRequest) (*QueryGetCompensationPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompensationPool not implemented")
+}
+func (UnimplementedQueryServer) GetCompensationClaim(context.Context, *QueryGetCompensationClaimRequest) (*QueryGetCompensationClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompensationClaim not implemented")
+}
+func (UnimplementedQueryServer) ListCompensationClaims(context.Context, *QueryListCompensationClaimsRequest) (*QueryListCompensationClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompensationClaims not implemented")
+}
+func (UnimplementedQueryServer) ListShareValueCheckpoints(context.Context, *QueryListShareValueCheckpointsRequest) (*QueryListShareValueCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShareValueCheckpoints not implemented")
+}
+func (UnimplementedQueryServer) RealizedApr(context.Context, *QueryRealizedAprRequest) (*QueryRealizedAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealizedApr not implemented")
+}
+func (UnimplementedQueryServer) EffectiveYieldRate(context.Context, *QueryEffectiveYieldRateRequest) (*QueryEffectiveYieldRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveYieldRate not implemented")
+}
+func (UnimplementedQueryServer) AccruedYield(context.Context, *QueryAccruedYieldRequest) (*QueryAccruedYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedYield not implemented")
+}
+func (UnimplementedQueryServer) GetYieldFundingFailure(context.Context, *QueryGetYieldFundingFailureRequest) (*QueryGetYieldFundingFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetYieldFundingFailure not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -144,6 +378,222 @@ func _Query_GetTrustDeposit_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListTrustDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTrustDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTrustDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListTrustDeposits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTrustDeposits(ctx, req.(*QueryListTrustDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrustDepositStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrustDepositStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrustDepositStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TrustDepositStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrustDepositStats(ctx, req.(*QueryTrustDepositStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCorporationTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCorporationTrustDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCorporationTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCorporationTrustDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCorporationTrustDeposit(ctx, req.(*QueryGetCorporationTrustDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListUnbondingTrustDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListUnbondingTrustDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListUnbondingTrustDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListUnbondingTrustDeposits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListUnbondingTrustDeposits(ctx, req.(*QueryListUnbondingTrustDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCompensationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCompensationPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCompensationPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCompensationPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCompensationPool(ctx, req.(*QueryGetCompensationPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCompensationClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCompensationClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCompensationClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCompensationClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCompensationClaim(ctx, req.(*QueryGetCompensationClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListCompensationClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCompensationClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListCompensationClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListCompensationClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCompensationClaims(ctx, req.(*QueryListCompensationClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListShareValueCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListShareValueCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListShareValueCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListShareValueCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListShareValueCheckpoints(ctx, req.(*QueryListShareValueCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RealizedApr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRealizedAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RealizedApr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RealizedApr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RealizedApr(ctx, req.(*QueryRealizedAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveYieldRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveYieldRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveYieldRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EffectiveYieldRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveYieldRate(ctx, req.(*QueryEffectiveYieldRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccruedYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedYieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccruedYield_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedYield(ctx, req.(*QueryAccruedYieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetYieldFundingFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetYieldFundingFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetYieldFundingFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetYieldFundingFailure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetYieldFundingFailure(ctx, req.(*QueryGetYieldFundingFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,6 +609,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTrustDeposit",
 			Handler:    _Query_GetTrustDeposit_Handler,
 		},
+		{
+			MethodName: "ListTrustDeposits",
+			Handler:    _Query_ListTrustDeposits_Handler,
+		},
+		{
+			MethodName: "TrustDepositStats",
+			Handler:    _Query_TrustDepositStats_Handler,
+		},
+		{
+			MethodName: "GetCorporationTrustDeposit",
+			Handler:    _Query_GetCorporationTrustDeposit_Handler,
+		},
+		{
+			MethodName: "ListUnbondingTrustDeposits",
+			Handler:    _Query_ListUnbondingTrustDeposits_Handler,
+		},
+		{
+			MethodName: "GetCompensationPool",
+			Handler:    _Query_GetCompensationPool_Handler,
+		},
+		{
+			MethodName: "GetCompensationClaim",
+			Handler:    _Query_GetCompensationClaim_Handler,
+		},
+		{
+			MethodName: "ListCompensationClaims",
+			Handler:    _Query_ListCompensationClaims_Handler,
+		},
+		{
+			MethodName: "ListShareValueCheckpoints",
+			Handler:    _Query_ListShareValueCheckpoints_Handler,
+		},
+		{
+			MethodName: "RealizedApr",
+			Handler:    _Query_RealizedApr_Handler,
+		},
+		{
+			MethodName: "EffectiveYieldRate",
+			Handler:    _Query_EffectiveYieldRate_Handler,
+		},
+		{
+			MethodName: "AccruedYield",
+			Handler:    _Query_AccruedYield_Handler,
+		},
+		{
+			MethodName: "GetYieldFundingFailure",
+			Handler:    _Query_GetYieldFundingFailure_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/td/v1/query.proto",
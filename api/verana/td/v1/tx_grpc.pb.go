@@ -23,6 +23,10 @@ const (
 	Msg_ReclaimTrustDepositYield_FullMethodName = "/verana.td.v1.Msg/ReclaimTrustDepositYield"
 	Msg_SlashTrustDeposit_FullMethodName        = "/verana.td.v1.Msg/SlashTrustDeposit"
 	Msg_RepaySlashedTrustDeposit_FullMethodName = "/verana.td.v1.Msg/RepaySlashedTrustDeposit"
+	Msg_DepositTrust_FullMethodName             = "/verana.td.v1.Msg/DepositTrust"
+	Msg_WithdrawClaimable_FullMethodName        = "/verana.td.v1.Msg/WithdrawClaimable"
+	Msg_FileCompensationClaim_FullMethodName    = "/verana.td.v1.Msg/FileCompensationClaim"
+	Msg_ResolveCompensationClaim_FullMethodName = "/verana.td.v1.Msg/ResolveCompensationClaim"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +42,14 @@ type MsgClient interface {
 	// SlashTrustDeposit defines a governance operation to slash a corporation's trust deposit
 	SlashTrustDeposit(ctx context.Context, in *MsgSlashTrustDeposit, opts ...grpc.CallOption) (*MsgSlashTrustDepositResponse, error)
 	RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepaySlashedTrustDepositResponse, error)
+	// DepositTrust voluntarily increases a corporation's trust deposit
+	DepositTrust(ctx context.Context, in *MsgDepositTrust, opts ...grpc.CallOption) (*MsgDepositTrustResponse, error)
+	// WithdrawClaimable withdraws part of a corporation's claimable balance
+	WithdrawClaimable(ctx context.Context, in *MsgWithdrawClaimable, opts ...grpc.CallOption) (*MsgWithdrawClaimableResponse, error)
+	// FileCompensationClaim files a claim against the compensation pool of an ecosystem
+	FileCompensationClaim(ctx context.Context, in *MsgFileCompensationClaim, opts ...grpc.CallOption) (*MsgFileCompensationClaimResponse, error)
+	// ResolveCompensationClaim approves and pays, or rejects, a compensation claim
+	ResolveCompensationClaim(ctx context.Context, in *MsgResolveCompensationClaim, opts ...grpc.CallOption) (*MsgResolveCompensationClaimResponse, error)
 }
 
 type msgClient struct {
@@ -88,6 +100,46 @@ func (c *msgClient) RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySl
 	return out, nil
 }
 
+func (c *msgClient) DepositTrust(ctx context.Context, in *MsgDepositTrust, opts ...grpc.CallOption) (*MsgDepositTrustResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDepositTrustResponse)
+	err := c.cc.Invoke(ctx, Msg_DepositTrust_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawClaimable(ctx context.Context, in *MsgWithdrawClaimable, opts ...grpc.CallOption) (*MsgWithdrawClaimableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWithdrawClaimableResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawClaimable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FileCompensationClaim(ctx context.Context, in *MsgFileCompensationClaim, opts ...grpc.CallOption) (*MsgFileCompensationClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFileCompensationClaimResponse)
+	err := c.cc.Invoke(ctx, Msg_FileCompensationClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveCompensationClaim(ctx context.Context, in *MsgResolveCompensationClaim, opts ...grpc.CallOption) (*MsgResolveCompensationClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResolveCompensationClaimResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveCompensationClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -101,6 +153,14 @@ type MsgServer interface {
 	// SlashTrustDeposit defines a governance operation to slash a corporation's trust deposit
 	SlashTrustDeposit(context.Context, *MsgSlashTrustDeposit) (*MsgSlashTrustDepositResponse, error)
 	RepaySlashedTrustDeposit(context.Context, *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error)
+	// DepositTrust voluntarily increases a corporation's trust deposit
+	DepositTrust(context.Context, *MsgDepositTrust) (*MsgDepositTrustResponse, error)
+	// WithdrawClaimable withdraws part of a corporation's claimable balance
+	WithdrawClaimable(context.Context, *MsgWithdrawClaimable) (*MsgWithdrawClaimableResponse, error)
+	// FileCompensationClaim files a claim against the compensation pool of an ecosystem
+	FileCompensationClaim(context.Context, *MsgFileCompensationClaim) (*MsgFileCompensationClaimResponse, error)
+	// ResolveCompensationClaim approves and pays, or rejects, a compensation claim
+	ResolveCompensationClaim(context.Context, *MsgResolveCompensationClaim) (*MsgResolveCompensationClaimResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -123,6 +183,18 @@ func (UnimplementedMsgServer) SlashTrustDeposit(context.Context, *MsgSlashTrustD
 func (UnimplementedMsgServer) RepaySlashedTrustDeposit(context.Context, *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RepaySlashedTrustDeposit not implemented")
 }
+func (UnimplementedMsgServer) DepositTrust(context.Context, *MsgDepositTrust) (*MsgDepositTrustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositTrust not implemented")
+}
+func (UnimplementedMsgServer) WithdrawClaimable(context.Context, *MsgWithdrawClaimable) (*MsgWithdrawClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawClaimable not implemented")
+}
+func (UnimplementedMsgServer) FileCompensationClaim(context.Context, *MsgFileCompensationClaim) (*MsgFileCompensationClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileCompensationClaim not implemented")
+}
+func (UnimplementedMsgServer) ResolveCompensationClaim(context.Context, *MsgResolveCompensationClaim) (*MsgResolveCompensationClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCompensationClaim not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -216,6 +288,78 @@ func _Msg_RepaySlashedTrustDeposit_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DepositTrust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositTrust)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositTrust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DepositTrust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositTrust(ctx, req.(*MsgDepositTrust))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawClaimable)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawClaimable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawClaimable(ctx, req.(*MsgWithdrawClaimable))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FileCompensationClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFileCompensationClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FileCompensationClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FileCompensationClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FileCompensationClaim(ctx, req.(*MsgFileCompensationClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveCompensationClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveCompensationClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveCompensationClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveCompensationClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveCompensationClaim(ctx, req.(*MsgResolveCompensationClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +383,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RepaySlashedTrustDeposit",
 			Handler:    _Msg_RepaySlashedTrustDeposit_Handler,
 		},
+		{
+			MethodName: "DepositTrust",
+			Handler:    _Msg_DepositTrust_Handler,
+		},
+		{
+			MethodName: "WithdrawClaimable",
+			Handler:    _Msg_WithdrawClaimable_Handler,
+		},
+		{
+			MethodName: "FileCompensationClaim",
+			Handler:    _Msg_FileCompensationClaim_Handler,
+		},
+		{
+			MethodName: "ResolveCompensationClaim",
+			Handler:    _Msg_ResolveCompensationClaim_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/td/v1/tx.proto",
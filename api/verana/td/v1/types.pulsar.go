@@ -28,6 +28,10 @@ var (
 	fd_TrustDeposit_last_slashed    protoreflect.FieldDescriptor
 	fd_TrustDeposit_last_repaid     protoreflect.FieldDescriptor
 	fd_TrustDeposit_slash_count     protoreflect.FieldDescriptor
+	fd_TrustDeposit_corporation_id  protoreflect.FieldDescriptor
+	fd_TrustDeposit_modified        protoreflect.FieldDescriptor
+	fd_TrustDeposit_burned_deposit  protoreflect.FieldDescriptor
+	fd_TrustDeposit_unbonding       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -42,6 +46,10 @@ func init() {
 	fd_TrustDeposit_last_slashed = md_TrustDeposit.Fields().ByName("last_slashed")
 	fd_TrustDeposit_last_repaid = md_TrustDeposit.Fields().ByName("last_repaid")
 	fd_TrustDeposit_slash_count = md_TrustDeposit.Fields().ByName("slash_count")
+	fd_TrustDeposit_corporation_id = md_TrustDeposit.Fields().ByName("corporation_id")
+	fd_TrustDeposit_modified = md_TrustDeposit.Fields().ByName("modified")
+	fd_TrustDeposit_burned_deposit = md_TrustDeposit.Fields().ByName("burned_deposit")
+	fd_TrustDeposit_unbonding = md_TrustDeposit.Fields().ByName("unbonding")
 }
 
 var _ protoreflect.Message = (*fastReflection_TrustDeposit)(nil)
@@ -163,6 +171,30 @@ func (x *fastReflection_TrustDeposit) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_TrustDeposit_corporation_id, value) {
+			return
+		}
+	}
+	if x.Modified != nil {
+		value := protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+		if !f(fd_TrustDeposit_modified, value) {
+			return
+		}
+	}
+	if x.BurnedDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BurnedDeposit)
+		if !f(fd_TrustDeposit_burned_deposit, value) {
+			return
+		}
+	}
+	if x.Unbonding != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Unbonding)
+		if !f(fd_TrustDeposit_unbonding, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -196,6 +228,14 @@ func (x *fastReflection_TrustDeposit) Has(fd protoreflect.FieldDescriptor) bool
 		return x.LastRepaid != nil
 	case "verana.td.v1.TrustDeposit.slash_count":
 		return x.SlashCount != uint64(0)
+	case "verana.td.v1.TrustDeposit.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.td.v1.TrustDeposit.modified":
+		return x.Modified != nil
+	case "verana.td.v1.TrustDeposit.burned_deposit":
+		return x.BurnedDeposit != uint64(0)
+	case "verana.td.v1.TrustDeposit.unbonding":
+		return x.Unbonding != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDeposit"))
@@ -230,6 +270,14 @@ func (x *fastReflection_TrustDeposit) Clear(fd protoreflect.FieldDescriptor) {
 		x.LastRepaid = nil
 	case "verana.td.v1.TrustDeposit.slash_count":
 		x.SlashCount = uint64(0)
+	case "verana.td.v1.TrustDeposit.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.td.v1.TrustDeposit.modified":
+		x.Modified = nil
+	case "verana.td.v1.TrustDeposit.burned_deposit":
+		x.BurnedDeposit = uint64(0)
+	case "verana.td.v1.TrustDeposit.unbonding":
+		x.Unbonding = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDeposit"))
@@ -273,6 +321,18 @@ func (x *fastReflection_TrustDeposit) Get(descriptor protoreflect.FieldDescripto
 	case "verana.td.v1.TrustDeposit.slash_count":
 		value := x.SlashCount
 		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDeposit.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDeposit.modified":
+		value := x.Modified
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.TrustDeposit.burned_deposit":
+		value := x.BurnedDeposit
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDeposit.unbonding":
+		value := x.Unbonding
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDeposit"))
@@ -311,6 +371,14 @@ func (x *fastReflection_TrustDeposit) Set(fd protoreflect.FieldDescriptor, value
 		x.LastRepaid = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.td.v1.TrustDeposit.slash_count":
 		x.SlashCount = value.Uint()
+	case "verana.td.v1.TrustDeposit.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.td.v1.TrustDeposit.modified":
+		x.Modified = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.TrustDeposit.burned_deposit":
+		x.BurnedDeposit = value.Uint()
+	case "verana.td.v1.TrustDeposit.unbonding":
+		x.Unbonding = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDeposit"))
@@ -341,6 +409,11 @@ func (x *fastReflection_TrustDeposit) Mutable(fd protoreflect.FieldDescriptor) p
 			x.LastRepaid = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.LastRepaid.ProtoReflect())
+	case "verana.td.v1.TrustDeposit.modified":
+		if x.Modified == nil {
+			x.Modified = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
 	case "verana.td.v1.TrustDeposit.corporation":
 		panic(fmt.Errorf("field corporation of message verana.td.v1.TrustDeposit is not mutable"))
 	case "verana.td.v1.TrustDeposit.share":
@@ -355,6 +428,12 @@ func (x *fastReflection_TrustDeposit) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field repaid_deposit of message verana.td.v1.TrustDeposit is not mutable"))
 	case "verana.td.v1.TrustDeposit.slash_count":
 		panic(fmt.Errorf("field slash_count of message verana.td.v1.TrustDeposit is not mutable"))
+	case "verana.td.v1.TrustDeposit.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.td.v1.TrustDeposit is not mutable"))
+	case "verana.td.v1.TrustDeposit.burned_deposit":
+		panic(fmt.Errorf("field burned_deposit of message verana.td.v1.TrustDeposit is not mutable"))
+	case "verana.td.v1.TrustDeposit.unbonding":
+		panic(fmt.Errorf("field unbonding of message verana.td.v1.TrustDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDeposit"))
@@ -388,6 +467,15 @@ func (x *fastReflection_TrustDeposit) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "verana.td.v1.TrustDeposit.slash_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDeposit.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDeposit.modified":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.TrustDeposit.burned_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDeposit.unbonding":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDeposit"))
@@ -488,6 +576,19 @@ func (x *fastReflection_TrustDeposit) ProtoMethods() *protoiface.Methods {
 		if x.SlashCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.SlashCount))
 		}
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
+		}
+		if x.Modified != nil {
+			l = options.Size(x.Modified)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BurnedDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BurnedDeposit))
+		}
+		if x.Unbonding != 0 {
+			n += 1 + runtime.Sov(uint64(x.Unbonding))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -517,6 +618,35 @@ func (x *fastReflection_TrustDeposit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Unbonding != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Unbonding))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.BurnedDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BurnedDeposit))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.Modified != nil {
+			encoded, err := options.Marshal(x.Modified)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
+			i--
+			dAtA[i] = 0x50
+		}
 		if x.SlashCount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashCount))
 			i--
@@ -864,6 +994,99 @@ func (x *fastReflection_TrustDeposit) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				}
+				x.CorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Modified == nil {
+					x.Modified = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modified); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnedDeposit", wireType)
+				}
+				x.BurnedDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BurnedDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+				}
+				x.Unbonding = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Unbonding |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -900,31 +1123,35 @@ func (x *fastReflection_TrustDeposit) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SlashTrustDepositProposal             protoreflect.MessageDescriptor
-	fd_SlashTrustDepositProposal_title       protoreflect.FieldDescriptor
-	fd_SlashTrustDepositProposal_description protoreflect.FieldDescriptor
-	fd_SlashTrustDepositProposal_corporation protoreflect.FieldDescriptor
-	fd_SlashTrustDepositProposal_deposit     protoreflect.FieldDescriptor
+	md_UnbondingTrustDeposit                 protoreflect.MessageDescriptor
+	fd_UnbondingTrustDeposit_id              protoreflect.FieldDescriptor
+	fd_UnbondingTrustDeposit_corporation_id  protoreflect.FieldDescriptor
+	fd_UnbondingTrustDeposit_amount          protoreflect.FieldDescriptor
+	fd_UnbondingTrustDeposit_created         protoreflect.FieldDescriptor
+	fd_UnbondingTrustDeposit_completion_time protoreflect.FieldDescriptor
+	fd_UnbondingTrustDeposit_reason          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_td_v1_types_proto_init()
-	md_SlashTrustDepositProposal = File_verana_td_v1_types_proto.Messages().ByName("SlashTrustDepositProposal")
-	fd_SlashTrustDepositProposal_title = md_SlashTrustDepositProposal.Fields().ByName("title")
-	fd_SlashTrustDepositProposal_description = md_SlashTrustDepositProposal.Fields().ByName("description")
-	fd_SlashTrustDepositProposal_corporation = md_SlashTrustDepositProposal.Fields().ByName("corporation")
-	fd_SlashTrustDepositProposal_deposit = md_SlashTrustDepositProposal.Fields().ByName("deposit")
+	md_UnbondingTrustDeposit = File_verana_td_v1_types_proto.Messages().ByName("UnbondingTrustDeposit")
+	fd_UnbondingTrustDeposit_id = md_UnbondingTrustDeposit.Fields().ByName("id")
+	fd_UnbondingTrustDeposit_corporation_id = md_UnbondingTrustDeposit.Fields().ByName("corporation_id")
+	fd_UnbondingTrustDeposit_amount = md_UnbondingTrustDeposit.Fields().ByName("amount")
+	fd_UnbondingTrustDeposit_created = md_UnbondingTrustDeposit.Fields().ByName("created")
+	fd_UnbondingTrustDeposit_completion_time = md_UnbondingTrustDeposit.Fields().ByName("completion_time")
+	fd_UnbondingTrustDeposit_reason = md_UnbondingTrustDeposit.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_SlashTrustDepositProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_UnbondingTrustDeposit)(nil)
 
-type fastReflection_SlashTrustDepositProposal SlashTrustDepositProposal
+type fastReflection_UnbondingTrustDeposit UnbondingTrustDeposit
 
-func (x *SlashTrustDepositProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SlashTrustDepositProposal)(x)
+func (x *UnbondingTrustDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingTrustDeposit)(x)
 }
 
-func (x *SlashTrustDepositProposal) slowProtoReflect() protoreflect.Message {
+func (x *UnbondingTrustDeposit) slowProtoReflect() protoreflect.Message {
 	mi := &file_verana_td_v1_types_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,43 +1163,43 @@ func (x *SlashTrustDepositProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SlashTrustDepositProposal_messageType fastReflection_SlashTrustDepositProposal_messageType
-var _ protoreflect.MessageType = fastReflection_SlashTrustDepositProposal_messageType{}
+var _fastReflection_UnbondingTrustDeposit_messageType fastReflection_UnbondingTrustDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingTrustDeposit_messageType{}
 
-type fastReflection_SlashTrustDepositProposal_messageType struct{}
+type fastReflection_UnbondingTrustDeposit_messageType struct{}
 
-func (x fastReflection_SlashTrustDepositProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SlashTrustDepositProposal)(nil)
+func (x fastReflection_UnbondingTrustDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingTrustDeposit)(nil)
 }
-func (x fastReflection_SlashTrustDepositProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_SlashTrustDepositProposal)
+func (x fastReflection_UnbondingTrustDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingTrustDeposit)
 }
-func (x fastReflection_SlashTrustDepositProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SlashTrustDepositProposal
+func (x fastReflection_UnbondingTrustDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingTrustDeposit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SlashTrustDepositProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_SlashTrustDepositProposal
+func (x *fastReflection_UnbondingTrustDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingTrustDeposit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SlashTrustDepositProposal) Type() protoreflect.MessageType {
-	return _fastReflection_SlashTrustDepositProposal_messageType
+func (x *fastReflection_UnbondingTrustDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingTrustDeposit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SlashTrustDepositProposal) New() protoreflect.Message {
-	return new(fastReflection_SlashTrustDepositProposal)
+func (x *fastReflection_UnbondingTrustDeposit) New() protoreflect.Message {
+	return new(fastReflection_UnbondingTrustDeposit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SlashTrustDepositProposal) Interface() protoreflect.ProtoMessage {
-	return (*SlashTrustDepositProposal)(x)
+func (x *fastReflection_UnbondingTrustDeposit) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingTrustDeposit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -980,28 +1207,40 @@ func (x *fastReflection_SlashTrustDepositProposal) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SlashTrustDepositProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Title != "" {
-		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_SlashTrustDepositProposal_title, value) {
+func (x *fastReflection_UnbondingTrustDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_UnbondingTrustDeposit_id, value) {
 			return
 		}
 	}
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_SlashTrustDepositProposal_description, value) {
+	if x.CorporationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CorporationId)
+		if !f(fd_UnbondingTrustDeposit_corporation_id, value) {
 			return
 		}
 	}
-	if x.Corporation != "" {
-		value := protoreflect.ValueOfString(x.Corporation)
-		if !f(fd_SlashTrustDepositProposal_corporation, value) {
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_UnbondingTrustDeposit_amount, value) {
 			return
 		}
 	}
-	if x.Deposit != "" {
-		value := protoreflect.ValueOfString(x.Deposit)
-		if !f(fd_SlashTrustDepositProposal_deposit, value) {
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_UnbondingTrustDeposit_created, value) {
+			return
+		}
+	}
+	if x.CompletionTime != nil {
+		value := protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+		if !f(fd_UnbondingTrustDeposit_completion_time, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_UnbondingTrustDeposit_reason, value) {
 			return
 		}
 	}
@@ -1018,21 +1257,25 @@ func (x *fastReflection_SlashTrustDepositProposal) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SlashTrustDepositProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_UnbondingTrustDeposit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "verana.td.v1.SlashTrustDepositProposal.title":
-		return x.Title != ""
-	case "verana.td.v1.SlashTrustDepositProposal.description":
-		return x.Description != ""
-	case "verana.td.v1.SlashTrustDepositProposal.corporation":
-		return x.Corporation != ""
-	case "verana.td.v1.SlashTrustDepositProposal.deposit":
-		return x.Deposit != ""
+	case "verana.td.v1.UnbondingTrustDeposit.id":
+		return x.Id != uint64(0)
+	case "verana.td.v1.UnbondingTrustDeposit.corporation_id":
+		return x.CorporationId != uint64(0)
+	case "verana.td.v1.UnbondingTrustDeposit.amount":
+		return x.Amount != uint64(0)
+	case "verana.td.v1.UnbondingTrustDeposit.created":
+		return x.Created != nil
+	case "verana.td.v1.UnbondingTrustDeposit.completion_time":
+		return x.CompletionTime != nil
+	case "verana.td.v1.UnbondingTrustDeposit.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.UnbondingTrustDeposit"))
 		}
-		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.td.v1.UnbondingTrustDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1042,21 +1285,25 @@ func (x *fastReflection_SlashTrustDepositProposal) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SlashTrustDepositProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_UnbondingTrustDeposit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "verana.td.v1.SlashTrustDepositProposal.title":
-		x.Title = ""
-	case "verana.td.v1.SlashTrustDepositProposal.description":
-		x.Description = ""
-	case "verana.td.v1.SlashTrustDepositProposal.corporation":
-		x.Corporation = ""
-	case "verana.td.v1.SlashTrustDepositProposal.deposit":
-		x.Deposit = ""
+	case "verana.td.v1.UnbondingTrustDeposit.id":
+		x.Id = uint64(0)
+	case "verana.td.v1.UnbondingTrustDeposit.corporation_id":
+		x.CorporationId = uint64(0)
+	case "verana.td.v1.UnbondingTrustDeposit.amount":
+		x.Amount = uint64(0)
+	case "verana.td.v1.UnbondingTrustDeposit.created":
+		x.Created = nil
+	case "verana.td.v1.UnbondingTrustDeposit.completion_time":
+		x.CompletionTime = nil
+	case "verana.td.v1.UnbondingTrustDeposit.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.UnbondingTrustDeposit"))
 		}
-		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.td.v1.UnbondingTrustDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1066,25 +1313,31 @@ func (x *fastReflection_SlashTrustDepositProposal) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SlashTrustDepositProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingTrustDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "verana.td.v1.SlashTrustDepositProposal.title":
-		value := x.Title
-		return protoreflect.ValueOfString(value)
-	case "verana.td.v1.SlashTrustDepositProposal.description":
-		value := x.Description
-		return protoreflect.ValueOfString(value)
-	case "verana.td.v1.SlashTrustDepositProposal.corporation":
-		value := x.Corporation
-		return protoreflect.ValueOfString(value)
-	case "verana.td.v1.SlashTrustDepositProposal.deposit":
-		value := x.Deposit
+	case "verana.td.v1.UnbondingTrustDeposit.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.UnbondingTrustDeposit.corporation_id":
+		value := x.CorporationId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.UnbondingTrustDeposit.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.UnbondingTrustDeposit.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.UnbondingTrustDeposit.completion_time":
+		value := x.CompletionTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.UnbondingTrustDeposit.reason":
+		value := x.Reason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.UnbondingTrustDeposit"))
 		}
-		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message verana.td.v1.UnbondingTrustDeposit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1098,21 +1351,25 @@ func (x *fastReflection_SlashTrustDepositProposal) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SlashTrustDepositProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_UnbondingTrustDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "verana.td.v1.SlashTrustDepositProposal.title":
-		x.Title = value.Interface().(string)
-	case "verana.td.v1.SlashTrustDepositProposal.description":
-		x.Description = value.Interface().(string)
-	case "verana.td.v1.SlashTrustDepositProposal.corporation":
-		x.Corporation = value.Interface().(string)
-	case "verana.td.v1.SlashTrustDepositProposal.deposit":
-		x.Deposit = value.Interface().(string)
+	case "verana.td.v1.UnbondingTrustDeposit.id":
+		x.Id = value.Uint()
+	case "verana.td.v1.UnbondingTrustDeposit.corporation_id":
+		x.CorporationId = value.Uint()
+	case "verana.td.v1.UnbondingTrustDeposit.amount":
+		x.Amount = value.Uint()
+	case "verana.td.v1.UnbondingTrustDeposit.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.UnbondingTrustDeposit.completion_time":
+		x.CompletionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.UnbondingTrustDeposit.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.UnbondingTrustDeposit"))
 		}
-		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.td.v1.UnbondingTrustDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1126,52 +1383,68 @@ func (x *fastReflection_SlashTrustDepositProposal) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SlashTrustDepositProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingTrustDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.td.v1.SlashTrustDepositProposal.title":
-		panic(fmt.Errorf("field title of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
-	case "verana.td.v1.SlashTrustDepositProposal.description":
-		panic(fmt.Errorf("field description of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
-	case "verana.td.v1.SlashTrustDepositProposal.corporation":
-		panic(fmt.Errorf("field corporation of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
-	case "verana.td.v1.SlashTrustDepositProposal.deposit":
-		panic(fmt.Errorf("field deposit of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
+	case "verana.td.v1.UnbondingTrustDeposit.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.td.v1.UnbondingTrustDeposit.completion_time":
+		if x.CompletionTime == nil {
+			x.CompletionTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+	case "verana.td.v1.UnbondingTrustDeposit.id":
+		panic(fmt.Errorf("field id of message verana.td.v1.UnbondingTrustDeposit is not mutable"))
+	case "verana.td.v1.UnbondingTrustDeposit.corporation_id":
+		panic(fmt.Errorf("field corporation_id of message verana.td.v1.UnbondingTrustDeposit is not mutable"))
+	case "verana.td.v1.UnbondingTrustDeposit.amount":
+		panic(fmt.Errorf("field amount of message verana.td.v1.UnbondingTrustDeposit is not mutable"))
+	case "verana.td.v1.UnbondingTrustDeposit.reason":
+		panic(fmt.Errorf("field reason of message verana.td.v1.UnbondingTrustDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.UnbondingTrustDeposit"))
 		}
-		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.td.v1.UnbondingTrustDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SlashTrustDepositProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnbondingTrustDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "verana.td.v1.SlashTrustDepositProposal.title":
-		return protoreflect.ValueOfString("")
-	case "verana.td.v1.SlashTrustDepositProposal.description":
-		return protoreflect.ValueOfString("")
-	case "verana.td.v1.SlashTrustDepositProposal.corporation":
-		return protoreflect.ValueOfString("")
-	case "verana.td.v1.SlashTrustDepositProposal.deposit":
+	case "verana.td.v1.UnbondingTrustDeposit.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.UnbondingTrustDeposit.corporation_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.UnbondingTrustDeposit.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.UnbondingTrustDeposit.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.UnbondingTrustDeposit.completion_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.UnbondingTrustDeposit.reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.UnbondingTrustDeposit"))
 		}
-		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message verana.td.v1.UnbondingTrustDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SlashTrustDepositProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_UnbondingTrustDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.td.v1.SlashTrustDepositProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in verana.td.v1.UnbondingTrustDeposit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1179,7 +1452,7 @@ func (x *fastReflection_SlashTrustDepositProposal) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SlashTrustDepositProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_UnbondingTrustDeposit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1190,7 +1463,7 @@ func (x *fastReflection_SlashTrustDepositProposal) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SlashTrustDepositProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_UnbondingTrustDeposit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1202,7 +1475,7 @@ func (x *fastReflection_SlashTrustDepositProposal) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SlashTrustDepositProposal) IsValid() bool {
+func (x *fastReflection_UnbondingTrustDeposit) IsValid() bool {
 	return x != nil
 }
 
@@ -1212,9 +1485,9 @@ func (x *fastReflection_SlashTrustDepositProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_UnbondingTrustDeposit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SlashTrustDepositProposal)
+		x := input.Message.Interface().(*UnbondingTrustDeposit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1226,19 +1499,24 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.Title)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		l = len(x.Description)
-		if l > 0 {
+		if x.CorporationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CorporationId))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Corporation)
-		if l > 0 {
+		if x.CompletionTime != nil {
+			l = options.Size(x.CompletionTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Deposit)
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1252,7 +1530,7 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SlashTrustDepositProposal)
+		x := input.Message.Interface().(*UnbondingTrustDeposit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1271,33 +1549,55 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Deposit) > 0 {
-			i -= len(x.Deposit)
-			copy(dAtA[i:], x.Deposit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.CompletionTime != nil {
+			encoded, err := options.Marshal(x.CompletionTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.Corporation) > 0 {
-			i -= len(x.Corporation)
-			copy(dAtA[i:], x.Corporation)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+		if x.CorporationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CorporationId))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1310,7 +1610,7 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SlashTrustDepositProposal)
+		x := input.Message.Interface().(*UnbondingTrustDeposit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1342,17 +1642,17 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashTrustDepositProposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingTrustDeposit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashTrustDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var stringLen uint64
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1362,29 +1662,54 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.CorporationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CorporationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				x.Title = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1394,29 +1719,33 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Description = string(dAtA[iNdEx:postIndex])
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1426,27 +1755,31 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Corporation = string(dAtA[iNdEx:postIndex])
+				if x.CompletionTime == nil {
+					x.CompletionTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletionTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 4:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1474,7 +1807,7 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Deposit = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1511,120 +1844,3883 @@ func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: verana/td/v1/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_ShareValueCheckpoint              protoreflect.MessageDescriptor
+	fd_ShareValueCheckpoint_timestamp    protoreflect.FieldDescriptor
+	fd_ShareValueCheckpoint_block_height protoreflect.FieldDescriptor
+	fd_ShareValueCheckpoint_share_value  protoreflect.FieldDescriptor
 )
 
-// TrustDeposit represents a corporation's trust deposit
-type TrustDeposit struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_verana_td_v1_types_proto_init()
+	md_ShareValueCheckpoint = File_verana_td_v1_types_proto.Messages().ByName("ShareValueCheckpoint")
+	fd_ShareValueCheckpoint_timestamp = md_ShareValueCheckpoint.Fields().ByName("timestamp")
+	fd_ShareValueCheckpoint_block_height = md_ShareValueCheckpoint.Fields().ByName("block_height")
+	fd_ShareValueCheckpoint_share_value = md_ShareValueCheckpoint.Fields().ByName("share_value")
+}
 
-	Corporation    string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Share          string                 `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
-	Deposit        uint64                 `protobuf:"varint,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	Claimable      uint64                 `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
-	SlashedDeposit uint64                 `protobuf:"varint,5,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
-	RepaidDeposit  uint64                 `protobuf:"varint,6,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
-	LastSlashed    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_slashed,json=lastSlashed,proto3" json:"last_slashed,omitempty"`
-	LastRepaid     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_repaid,json=lastRepaid,proto3" json:"last_repaid,omitempty"`
-	SlashCount     uint64                 `protobuf:"varint,9,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+var _ protoreflect.Message = (*fastReflection_ShareValueCheckpoint)(nil)
+
+type fastReflection_ShareValueCheckpoint ShareValueCheckpoint
+
+func (x *ShareValueCheckpoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ShareValueCheckpoint)(x)
 }
 
-func (x *TrustDeposit) Reset() {
-	*x = TrustDeposit{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_td_v1_types_proto_msgTypes[0]
+func (x *ShareValueCheckpoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_td_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *TrustDeposit) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_ShareValueCheckpoint_messageType fastReflection_ShareValueCheckpoint_messageType
+var _ protoreflect.MessageType = fastReflection_ShareValueCheckpoint_messageType{}
 
-func (*TrustDeposit) ProtoMessage() {}
+type fastReflection_ShareValueCheckpoint_messageType struct{}
 
-// Deprecated: Use TrustDeposit.ProtoReflect.Descriptor instead.
-func (*TrustDeposit) Descriptor() ([]byte, []int) {
-	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_ShareValueCheckpoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ShareValueCheckpoint)(nil)
 }
-
-func (x *TrustDeposit) GetCorporation() string {
-	if x != nil {
-		return x.Corporation
-	}
-	return ""
+func (x fastReflection_ShareValueCheckpoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_ShareValueCheckpoint)
 }
-
-func (x *TrustDeposit) GetShare() string {
-	if x != nil {
-		return x.Share
-	}
-	return ""
+func (x fastReflection_ShareValueCheckpoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ShareValueCheckpoint
 }
 
-func (x *TrustDeposit) GetDeposit() uint64 {
-	if x != nil {
-		return x.Deposit
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ShareValueCheckpoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_ShareValueCheckpoint
 }
 
-func (x *TrustDeposit) GetClaimable() uint64 {
-	if x != nil {
-		return x.Claimable
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ShareValueCheckpoint) Type() protoreflect.MessageType {
+	return _fastReflection_ShareValueCheckpoint_messageType
 }
 
-func (x *TrustDeposit) GetSlashedDeposit() uint64 {
-	if x != nil {
-		return x.SlashedDeposit
-	}
-	return 0
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ShareValueCheckpoint) New() protoreflect.Message {
+	return new(fastReflection_ShareValueCheckpoint)
 }
 
-func (x *TrustDeposit) GetRepaidDeposit() uint64 {
-	if x != nil {
-		return x.RepaidDeposit
-	}
-	return 0
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ShareValueCheckpoint) Interface() protoreflect.ProtoMessage {
+	return (*ShareValueCheckpoint)(x)
 }
 
-func (x *TrustDeposit) GetLastSlashed() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastSlashed
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ShareValueCheckpoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Timestamp != nil {
+		value := protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+		if !f(fd_ShareValueCheckpoint_timestamp, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *TrustDeposit) GetLastRepaid() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastRepaid
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_ShareValueCheckpoint_block_height, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *TrustDeposit) GetSlashCount() uint64 {
-	if x != nil {
-		return x.SlashCount
+	if x.ShareValue != "" {
+		value := protoreflect.ValueOfString(x.ShareValue)
+		if !f(fd_ShareValueCheckpoint_share_value, value) {
+			return
+		}
 	}
-	return 0
 }
 
-type SlashTrustDepositProposal struct {
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ShareValueCheckpoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.td.v1.ShareValueCheckpoint.timestamp":
+		return x.Timestamp != nil
+	case "verana.td.v1.ShareValueCheckpoint.block_height":
+		return x.BlockHeight != int64(0)
+	case "verana.td.v1.ShareValueCheckpoint.share_value":
+		return x.ShareValue != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.ShareValueCheckpoint"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.ShareValueCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareValueCheckpoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.td.v1.ShareValueCheckpoint.timestamp":
+		x.Timestamp = nil
+	case "verana.td.v1.ShareValueCheckpoint.block_height":
+		x.BlockHeight = int64(0)
+	case "verana.td.v1.ShareValueCheckpoint.share_value":
+		x.ShareValue = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.ShareValueCheckpoint"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.ShareValueCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ShareValueCheckpoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.td.v1.ShareValueCheckpoint.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.ShareValueCheckpoint.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "verana.td.v1.ShareValueCheckpoint.share_value":
+		value := x.ShareValue
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.ShareValueCheckpoint"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.ShareValueCheckpoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareValueCheckpoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.td.v1.ShareValueCheckpoint.timestamp":
+		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.ShareValueCheckpoint.block_height":
+		x.BlockHeight = value.Int()
+	case "verana.td.v1.ShareValueCheckpoint.share_value":
+		x.ShareValue = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.ShareValueCheckpoint"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.ShareValueCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareValueCheckpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.ShareValueCheckpoint.timestamp":
+		if x.Timestamp == nil {
+			x.Timestamp = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+	case "verana.td.v1.ShareValueCheckpoint.block_height":
+		panic(fmt.Errorf("field block_height of message verana.td.v1.ShareValueCheckpoint is not mutable"))
+	case "verana.td.v1.ShareValueCheckpoint.share_value":
+		panic(fmt.Errorf("field share_value of message verana.td.v1.ShareValueCheckpoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.ShareValueCheckpoint"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.ShareValueCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ShareValueCheckpoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.ShareValueCheckpoint.timestamp":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.ShareValueCheckpoint.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "verana.td.v1.ShareValueCheckpoint.share_value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.ShareValueCheckpoint"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.ShareValueCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ShareValueCheckpoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.td.v1.ShareValueCheckpoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ShareValueCheckpoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareValueCheckpoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ShareValueCheckpoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ShareValueCheckpoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ShareValueCheckpoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Timestamp != nil {
+			l = options.Size(x.Timestamp)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.ShareValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ShareValueCheckpoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ShareValue) > 0 {
+			i -= len(x.ShareValue)
+			copy(dAtA[i:], x.ShareValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShareValue)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Timestamp != nil {
+			encoded, err := options.Marshal(x.Timestamp)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ShareValueCheckpoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ShareValueCheckpoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ShareValueCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Timestamp == nil {
+					x.Timestamp = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timestamp); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ShareValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CompensationPool                protoreflect.MessageDescriptor
+	fd_CompensationPool_ecosystem_id   protoreflect.FieldDescriptor
+	fd_CompensationPool_balance        protoreflect.FieldDescriptor
+	fd_CompensationPool_total_received protoreflect.FieldDescriptor
+	fd_CompensationPool_total_paid     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_td_v1_types_proto_init()
+	md_CompensationPool = File_verana_td_v1_types_proto.Messages().ByName("CompensationPool")
+	fd_CompensationPool_ecosystem_id = md_CompensationPool.Fields().ByName("ecosystem_id")
+	fd_CompensationPool_balance = md_CompensationPool.Fields().ByName("balance")
+	fd_CompensationPool_total_received = md_CompensationPool.Fields().ByName("total_received")
+	fd_CompensationPool_total_paid = md_CompensationPool.Fields().ByName("total_paid")
+}
+
+var _ protoreflect.Message = (*fastReflection_CompensationPool)(nil)
+
+type fastReflection_CompensationPool CompensationPool
+
+func (x *CompensationPool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CompensationPool)(x)
+}
+
+func (x *CompensationPool) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_td_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CompensationPool_messageType fastReflection_CompensationPool_messageType
+var _ protoreflect.MessageType = fastReflection_CompensationPool_messageType{}
+
+type fastReflection_CompensationPool_messageType struct{}
+
+func (x fastReflection_CompensationPool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CompensationPool)(nil)
+}
+func (x fastReflection_CompensationPool_messageType) New() protoreflect.Message {
+	return new(fastReflection_CompensationPool)
+}
+func (x fastReflection_CompensationPool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompensationPool
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CompensationPool) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompensationPool
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CompensationPool) Type() protoreflect.MessageType {
+	return _fastReflection_CompensationPool_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CompensationPool) New() protoreflect.Message {
+	return new(fastReflection_CompensationPool)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CompensationPool) Interface() protoreflect.ProtoMessage {
+	return (*CompensationPool)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CompensationPool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EcosystemId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EcosystemId)
+		if !f(fd_CompensationPool_ecosystem_id, value) {
+			return
+		}
+	}
+	if x.Balance != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Balance)
+		if !f(fd_CompensationPool_balance, value) {
+			return
+		}
+	}
+	if x.TotalReceived != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalReceived)
+		if !f(fd_CompensationPool_total_received, value) {
+			return
+		}
+	}
+	if x.TotalPaid != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalPaid)
+		if !f(fd_CompensationPool_total_paid, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CompensationPool) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationPool.ecosystem_id":
+		return x.EcosystemId != uint64(0)
+	case "verana.td.v1.CompensationPool.balance":
+		return x.Balance != uint64(0)
+	case "verana.td.v1.CompensationPool.total_received":
+		return x.TotalReceived != uint64(0)
+	case "verana.td.v1.CompensationPool.total_paid":
+		return x.TotalPaid != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationPool"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPool) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationPool.ecosystem_id":
+		x.EcosystemId = uint64(0)
+	case "verana.td.v1.CompensationPool.balance":
+		x.Balance = uint64(0)
+	case "verana.td.v1.CompensationPool.total_received":
+		x.TotalReceived = uint64(0)
+	case "verana.td.v1.CompensationPool.total_paid":
+		x.TotalPaid = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationPool"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CompensationPool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.td.v1.CompensationPool.ecosystem_id":
+		value := x.EcosystemId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.CompensationPool.balance":
+		value := x.Balance
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.CompensationPool.total_received":
+		value := x.TotalReceived
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.CompensationPool.total_paid":
+		value := x.TotalPaid
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationPool"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationPool does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationPool.ecosystem_id":
+		x.EcosystemId = value.Uint()
+	case "verana.td.v1.CompensationPool.balance":
+		x.Balance = value.Uint()
+	case "verana.td.v1.CompensationPool.total_received":
+		x.TotalReceived = value.Uint()
+	case "verana.td.v1.CompensationPool.total_paid":
+		x.TotalPaid = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationPool"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationPool.ecosystem_id":
+		panic(fmt.Errorf("field ecosystem_id of message verana.td.v1.CompensationPool is not mutable"))
+	case "verana.td.v1.CompensationPool.balance":
+		panic(fmt.Errorf("field balance of message verana.td.v1.CompensationPool is not mutable"))
+	case "verana.td.v1.CompensationPool.total_received":
+		panic(fmt.Errorf("field total_received of message verana.td.v1.CompensationPool is not mutable"))
+	case "verana.td.v1.CompensationPool.total_paid":
+		panic(fmt.Errorf("field total_paid of message verana.td.v1.CompensationPool is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationPool"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CompensationPool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationPool.ecosystem_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.CompensationPool.balance":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.CompensationPool.total_received":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.CompensationPool.total_paid":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationPool"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CompensationPool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.td.v1.CompensationPool", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CompensationPool) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationPool) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CompensationPool) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CompensationPool) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CompensationPool)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EcosystemId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EcosystemId))
+		}
+		if x.Balance != 0 {
+			n += 1 + runtime.Sov(uint64(x.Balance))
+		}
+		if x.TotalReceived != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalReceived))
+		}
+		if x.TotalPaid != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalPaid))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CompensationPool)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalPaid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalPaid))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TotalReceived != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalReceived))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Balance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Balance))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EcosystemId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EcosystemId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CompensationPool)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompensationPool: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompensationPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
+				}
+				x.EcosystemId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EcosystemId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				x.Balance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Balance |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalReceived", wireType)
+				}
+				x.TotalReceived = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalReceived |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPaid", wireType)
+				}
+				x.TotalPaid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalPaid |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CompensationClaim                   protoreflect.MessageDescriptor
+	fd_CompensationClaim_id                protoreflect.FieldDescriptor
+	fd_CompensationClaim_ecosystem_id      protoreflect.FieldDescriptor
+	fd_CompensationClaim_claimant          protoreflect.FieldDescriptor
+	fd_CompensationClaim_amount            protoreflect.FieldDescriptor
+	fd_CompensationClaim_reason            protoreflect.FieldDescriptor
+	fd_CompensationClaim_evidence_digest   protoreflect.FieldDescriptor
+	fd_CompensationClaim_status            protoreflect.FieldDescriptor
+	fd_CompensationClaim_created           protoreflect.FieldDescriptor
+	fd_CompensationClaim_resolved          protoreflect.FieldDescriptor
+	fd_CompensationClaim_resolution_reason protoreflect.FieldDescriptor
+	fd_CompensationClaim_paid_amount       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_td_v1_types_proto_init()
+	md_CompensationClaim = File_verana_td_v1_types_proto.Messages().ByName("CompensationClaim")
+	fd_CompensationClaim_id = md_CompensationClaim.Fields().ByName("id")
+	fd_CompensationClaim_ecosystem_id = md_CompensationClaim.Fields().ByName("ecosystem_id")
+	fd_CompensationClaim_claimant = md_CompensationClaim.Fields().ByName("claimant")
+	fd_CompensationClaim_amount = md_CompensationClaim.Fields().ByName("amount")
+	fd_CompensationClaim_reason = md_CompensationClaim.Fields().ByName("reason")
+	fd_CompensationClaim_evidence_digest = md_CompensationClaim.Fields().ByName("evidence_digest")
+	fd_CompensationClaim_status = md_CompensationClaim.Fields().ByName("status")
+	fd_CompensationClaim_created = md_CompensationClaim.Fields().ByName("created")
+	fd_CompensationClaim_resolved = md_CompensationClaim.Fields().ByName("resolved")
+	fd_CompensationClaim_resolution_reason = md_CompensationClaim.Fields().ByName("resolution_reason")
+	fd_CompensationClaim_paid_amount = md_CompensationClaim.Fields().ByName("paid_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_CompensationClaim)(nil)
+
+type fastReflection_CompensationClaim CompensationClaim
+
+func (x *CompensationClaim) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CompensationClaim)(x)
+}
+
+func (x *CompensationClaim) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_td_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CompensationClaim_messageType fastReflection_CompensationClaim_messageType
+var _ protoreflect.MessageType = fastReflection_CompensationClaim_messageType{}
+
+type fastReflection_CompensationClaim_messageType struct{}
+
+func (x fastReflection_CompensationClaim_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CompensationClaim)(nil)
+}
+func (x fastReflection_CompensationClaim_messageType) New() protoreflect.Message {
+	return new(fastReflection_CompensationClaim)
+}
+func (x fastReflection_CompensationClaim_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompensationClaim
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CompensationClaim) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompensationClaim
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CompensationClaim) Type() protoreflect.MessageType {
+	return _fastReflection_CompensationClaim_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CompensationClaim) New() protoreflect.Message {
+	return new(fastReflection_CompensationClaim)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CompensationClaim) Interface() protoreflect.ProtoMessage {
+	return (*CompensationClaim)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CompensationClaim) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_CompensationClaim_id, value) {
+			return
+		}
+	}
+	if x.EcosystemId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EcosystemId)
+		if !f(fd_CompensationClaim_ecosystem_id, value) {
+			return
+		}
+	}
+	if x.Claimant != "" {
+		value := protoreflect.ValueOfString(x.Claimant)
+		if !f(fd_CompensationClaim_claimant, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_CompensationClaim_amount, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_CompensationClaim_reason, value) {
+			return
+		}
+	}
+	if x.EvidenceDigest != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigest)
+		if !f(fd_CompensationClaim_evidence_digest, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_CompensationClaim_status, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_CompensationClaim_created, value) {
+			return
+		}
+	}
+	if x.Resolved != nil {
+		value := protoreflect.ValueOfMessage(x.Resolved.ProtoReflect())
+		if !f(fd_CompensationClaim_resolved, value) {
+			return
+		}
+	}
+	if x.ResolutionReason != "" {
+		value := protoreflect.ValueOfString(x.ResolutionReason)
+		if !f(fd_CompensationClaim_resolution_reason, value) {
+			return
+		}
+	}
+	if x.PaidAmount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PaidAmount)
+		if !f(fd_CompensationClaim_paid_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CompensationClaim) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationClaim.id":
+		return x.Id != uint64(0)
+	case "verana.td.v1.CompensationClaim.ecosystem_id":
+		return x.EcosystemId != uint64(0)
+	case "verana.td.v1.CompensationClaim.claimant":
+		return x.Claimant != ""
+	case "verana.td.v1.CompensationClaim.amount":
+		return x.Amount != uint64(0)
+	case "verana.td.v1.CompensationClaim.reason":
+		return x.Reason != ""
+	case "verana.td.v1.CompensationClaim.evidence_digest":
+		return x.EvidenceDigest != ""
+	case "verana.td.v1.CompensationClaim.status":
+		return x.Status != 0
+	case "verana.td.v1.CompensationClaim.created":
+		return x.Created != nil
+	case "verana.td.v1.CompensationClaim.resolved":
+		return x.Resolved != nil
+	case "verana.td.v1.CompensationClaim.resolution_reason":
+		return x.ResolutionReason != ""
+	case "verana.td.v1.CompensationClaim.paid_amount":
+		return x.PaidAmount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationClaim"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationClaim) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationClaim.id":
+		x.Id = uint64(0)
+	case "verana.td.v1.CompensationClaim.ecosystem_id":
+		x.EcosystemId = uint64(0)
+	case "verana.td.v1.CompensationClaim.claimant":
+		x.Claimant = ""
+	case "verana.td.v1.CompensationClaim.amount":
+		x.Amount = uint64(0)
+	case "verana.td.v1.CompensationClaim.reason":
+		x.Reason = ""
+	case "verana.td.v1.CompensationClaim.evidence_digest":
+		x.EvidenceDigest = ""
+	case "verana.td.v1.CompensationClaim.status":
+		x.Status = 0
+	case "verana.td.v1.CompensationClaim.created":
+		x.Created = nil
+	case "verana.td.v1.CompensationClaim.resolved":
+		x.Resolved = nil
+	case "verana.td.v1.CompensationClaim.resolution_reason":
+		x.ResolutionReason = ""
+	case "verana.td.v1.CompensationClaim.paid_amount":
+		x.PaidAmount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationClaim"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CompensationClaim) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.td.v1.CompensationClaim.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.CompensationClaim.ecosystem_id":
+		value := x.EcosystemId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.CompensationClaim.claimant":
+		value := x.Claimant
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.CompensationClaim.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.CompensationClaim.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.CompensationClaim.evidence_digest":
+		value := x.EvidenceDigest
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.CompensationClaim.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.td.v1.CompensationClaim.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.CompensationClaim.resolved":
+		value := x.Resolved
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.CompensationClaim.resolution_reason":
+		value := x.ResolutionReason
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.CompensationClaim.paid_amount":
+		value := x.PaidAmount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationClaim"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationClaim does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationClaim) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationClaim.id":
+		x.Id = value.Uint()
+	case "verana.td.v1.CompensationClaim.ecosystem_id":
+		x.EcosystemId = value.Uint()
+	case "verana.td.v1.CompensationClaim.claimant":
+		x.Claimant = value.Interface().(string)
+	case "verana.td.v1.CompensationClaim.amount":
+		x.Amount = value.Uint()
+	case "verana.td.v1.CompensationClaim.reason":
+		x.Reason = value.Interface().(string)
+	case "verana.td.v1.CompensationClaim.evidence_digest":
+		x.EvidenceDigest = value.Interface().(string)
+	case "verana.td.v1.CompensationClaim.status":
+		x.Status = (CompensationClaimStatus)(value.Enum())
+	case "verana.td.v1.CompensationClaim.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.CompensationClaim.resolved":
+		x.Resolved = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.CompensationClaim.resolution_reason":
+		x.ResolutionReason = value.Interface().(string)
+	case "verana.td.v1.CompensationClaim.paid_amount":
+		x.PaidAmount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationClaim"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationClaim) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationClaim.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.td.v1.CompensationClaim.resolved":
+		if x.Resolved == nil {
+			x.Resolved = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Resolved.ProtoReflect())
+	case "verana.td.v1.CompensationClaim.id":
+		panic(fmt.Errorf("field id of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.ecosystem_id":
+		panic(fmt.Errorf("field ecosystem_id of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.claimant":
+		panic(fmt.Errorf("field claimant of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.amount":
+		panic(fmt.Errorf("field amount of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.reason":
+		panic(fmt.Errorf("field reason of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.evidence_digest":
+		panic(fmt.Errorf("field evidence_digest of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.status":
+		panic(fmt.Errorf("field status of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.resolution_reason":
+		panic(fmt.Errorf("field resolution_reason of message verana.td.v1.CompensationClaim is not mutable"))
+	case "verana.td.v1.CompensationClaim.paid_amount":
+		panic(fmt.Errorf("field paid_amount of message verana.td.v1.CompensationClaim is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationClaim"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CompensationClaim) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.CompensationClaim.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.CompensationClaim.ecosystem_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.CompensationClaim.claimant":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.CompensationClaim.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.CompensationClaim.reason":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.CompensationClaim.evidence_digest":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.CompensationClaim.status":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.td.v1.CompensationClaim.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.CompensationClaim.resolved":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.CompensationClaim.resolution_reason":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.CompensationClaim.paid_amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.CompensationClaim"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.CompensationClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CompensationClaim) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.td.v1.CompensationClaim", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CompensationClaim) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CompensationClaim) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CompensationClaim) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CompensationClaim) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CompensationClaim)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.EcosystemId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EcosystemId))
+		}
+		l = len(x.Claimant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Resolved != nil {
+			l = options.Size(x.Resolved)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ResolutionReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PaidAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaidAmount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CompensationClaim)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PaidAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaidAmount))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.ResolutionReason) > 0 {
+			i -= len(x.ResolutionReason)
+			copy(dAtA[i:], x.ResolutionReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ResolutionReason)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Resolved != nil {
+			encoded, err := options.Marshal(x.Resolved)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EvidenceDigest) > 0 {
+			i -= len(x.EvidenceDigest)
+			copy(dAtA[i:], x.EvidenceDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigest)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Claimant) > 0 {
+			i -= len(x.Claimant)
+			copy(dAtA[i:], x.Claimant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Claimant)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EcosystemId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EcosystemId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CompensationClaim)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompensationClaim: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompensationClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
+				}
+				x.EcosystemId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EcosystemId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Claimant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= CompensationClaimStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Resolved == nil {
+					x.Resolved = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Resolved); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolutionReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResolutionReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
+				}
+				x.PaidAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaidAmount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_YieldFundingFailure        protoreflect.MessageDescriptor
+	fd_YieldFundingFailure_height protoreflect.FieldDescriptor
+	fd_YieldFundingFailure_stage  protoreflect.FieldDescriptor
+	fd_YieldFundingFailure_amount protoreflect.FieldDescriptor
+	fd_YieldFundingFailure_error  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_td_v1_types_proto_init()
+	md_YieldFundingFailure = File_verana_td_v1_types_proto.Messages().ByName("YieldFundingFailure")
+	fd_YieldFundingFailure_height = md_YieldFundingFailure.Fields().ByName("height")
+	fd_YieldFundingFailure_stage = md_YieldFundingFailure.Fields().ByName("stage")
+	fd_YieldFundingFailure_amount = md_YieldFundingFailure.Fields().ByName("amount")
+	fd_YieldFundingFailure_error = md_YieldFundingFailure.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_YieldFundingFailure)(nil)
+
+type fastReflection_YieldFundingFailure YieldFundingFailure
+
+func (x *YieldFundingFailure) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_YieldFundingFailure)(x)
+}
+
+func (x *YieldFundingFailure) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_td_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_YieldFundingFailure_messageType fastReflection_YieldFundingFailure_messageType
+var _ protoreflect.MessageType = fastReflection_YieldFundingFailure_messageType{}
+
+type fastReflection_YieldFundingFailure_messageType struct{}
+
+func (x fastReflection_YieldFundingFailure_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_YieldFundingFailure)(nil)
+}
+func (x fastReflection_YieldFundingFailure_messageType) New() protoreflect.Message {
+	return new(fastReflection_YieldFundingFailure)
+}
+func (x fastReflection_YieldFundingFailure_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_YieldFundingFailure
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_YieldFundingFailure) Descriptor() protoreflect.MessageDescriptor {
+	return md_YieldFundingFailure
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_YieldFundingFailure) Type() protoreflect.MessageType {
+	return _fastReflection_YieldFundingFailure_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_YieldFundingFailure) New() protoreflect.Message {
+	return new(fastReflection_YieldFundingFailure)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_YieldFundingFailure) Interface() protoreflect.ProtoMessage {
+	return (*YieldFundingFailure)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_YieldFundingFailure) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_YieldFundingFailure_height, value) {
+			return
+		}
+	}
+	if x.Stage != "" {
+		value := protoreflect.ValueOfString(x.Stage)
+		if !f(fd_YieldFundingFailure_stage, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_YieldFundingFailure_amount, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_YieldFundingFailure_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_YieldFundingFailure) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.td.v1.YieldFundingFailure.height":
+		return x.Height != int64(0)
+	case "verana.td.v1.YieldFundingFailure.stage":
+		return x.Stage != ""
+	case "verana.td.v1.YieldFundingFailure.amount":
+		return x.Amount != uint64(0)
+	case "verana.td.v1.YieldFundingFailure.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.YieldFundingFailure"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.YieldFundingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_YieldFundingFailure) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.td.v1.YieldFundingFailure.height":
+		x.Height = int64(0)
+	case "verana.td.v1.YieldFundingFailure.stage":
+		x.Stage = ""
+	case "verana.td.v1.YieldFundingFailure.amount":
+		x.Amount = uint64(0)
+	case "verana.td.v1.YieldFundingFailure.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.YieldFundingFailure"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.YieldFundingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_YieldFundingFailure) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.td.v1.YieldFundingFailure.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "verana.td.v1.YieldFundingFailure.stage":
+		value := x.Stage
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.YieldFundingFailure.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.YieldFundingFailure.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.YieldFundingFailure"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.YieldFundingFailure does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_YieldFundingFailure) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.td.v1.YieldFundingFailure.height":
+		x.Height = value.Int()
+	case "verana.td.v1.YieldFundingFailure.stage":
+		x.Stage = value.Interface().(string)
+	case "verana.td.v1.YieldFundingFailure.amount":
+		x.Amount = value.Uint()
+	case "verana.td.v1.YieldFundingFailure.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.YieldFundingFailure"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.YieldFundingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_YieldFundingFailure) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.YieldFundingFailure.height":
+		panic(fmt.Errorf("field height of message verana.td.v1.YieldFundingFailure is not mutable"))
+	case "verana.td.v1.YieldFundingFailure.stage":
+		panic(fmt.Errorf("field stage of message verana.td.v1.YieldFundingFailure is not mutable"))
+	case "verana.td.v1.YieldFundingFailure.amount":
+		panic(fmt.Errorf("field amount of message verana.td.v1.YieldFundingFailure is not mutable"))
+	case "verana.td.v1.YieldFundingFailure.error":
+		panic(fmt.Errorf("field error of message verana.td.v1.YieldFundingFailure is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.YieldFundingFailure"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.YieldFundingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_YieldFundingFailure) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.YieldFundingFailure.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "verana.td.v1.YieldFundingFailure.stage":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.YieldFundingFailure.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.YieldFundingFailure.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.YieldFundingFailure"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.YieldFundingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_YieldFundingFailure) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.td.v1.YieldFundingFailure", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_YieldFundingFailure) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_YieldFundingFailure) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_YieldFundingFailure) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_YieldFundingFailure) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*YieldFundingFailure)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Stage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*YieldFundingFailure)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Stage) > 0 {
+			i -= len(x.Stage)
+			copy(dAtA[i:], x.Stage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stage)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*YieldFundingFailure)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: YieldFundingFailure: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: YieldFundingFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SlashTrustDepositProposal             protoreflect.MessageDescriptor
+	fd_SlashTrustDepositProposal_title       protoreflect.FieldDescriptor
+	fd_SlashTrustDepositProposal_description protoreflect.FieldDescriptor
+	fd_SlashTrustDepositProposal_corporation protoreflect.FieldDescriptor
+	fd_SlashTrustDepositProposal_deposit     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_td_v1_types_proto_init()
+	md_SlashTrustDepositProposal = File_verana_td_v1_types_proto.Messages().ByName("SlashTrustDepositProposal")
+	fd_SlashTrustDepositProposal_title = md_SlashTrustDepositProposal.Fields().ByName("title")
+	fd_SlashTrustDepositProposal_description = md_SlashTrustDepositProposal.Fields().ByName("description")
+	fd_SlashTrustDepositProposal_corporation = md_SlashTrustDepositProposal.Fields().ByName("corporation")
+	fd_SlashTrustDepositProposal_deposit = md_SlashTrustDepositProposal.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_SlashTrustDepositProposal)(nil)
+
+type fastReflection_SlashTrustDepositProposal SlashTrustDepositProposal
+
+func (x *SlashTrustDepositProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SlashTrustDepositProposal)(x)
+}
+
+func (x *SlashTrustDepositProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_td_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SlashTrustDepositProposal_messageType fastReflection_SlashTrustDepositProposal_messageType
+var _ protoreflect.MessageType = fastReflection_SlashTrustDepositProposal_messageType{}
+
+type fastReflection_SlashTrustDepositProposal_messageType struct{}
+
+func (x fastReflection_SlashTrustDepositProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SlashTrustDepositProposal)(nil)
+}
+func (x fastReflection_SlashTrustDepositProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_SlashTrustDepositProposal)
+}
+func (x fastReflection_SlashTrustDepositProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashTrustDepositProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SlashTrustDepositProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashTrustDepositProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SlashTrustDepositProposal) Type() protoreflect.MessageType {
+	return _fastReflection_SlashTrustDepositProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SlashTrustDepositProposal) New() protoreflect.Message {
+	return new(fastReflection_SlashTrustDepositProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SlashTrustDepositProposal) Interface() protoreflect.ProtoMessage {
+	return (*SlashTrustDepositProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SlashTrustDepositProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_SlashTrustDepositProposal_title, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_SlashTrustDepositProposal_description, value) {
+			return
+		}
+	}
+	if x.Corporation != "" {
+		value := protoreflect.ValueOfString(x.Corporation)
+		if !f(fd_SlashTrustDepositProposal_corporation, value) {
+			return
+		}
+	}
+	if x.Deposit != "" {
+		value := protoreflect.ValueOfString(x.Deposit)
+		if !f(fd_SlashTrustDepositProposal_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SlashTrustDepositProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.td.v1.SlashTrustDepositProposal.title":
+		return x.Title != ""
+	case "verana.td.v1.SlashTrustDepositProposal.description":
+		return x.Description != ""
+	case "verana.td.v1.SlashTrustDepositProposal.corporation":
+		return x.Corporation != ""
+	case "verana.td.v1.SlashTrustDepositProposal.deposit":
+		return x.Deposit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashTrustDepositProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.td.v1.SlashTrustDepositProposal.title":
+		x.Title = ""
+	case "verana.td.v1.SlashTrustDepositProposal.description":
+		x.Description = ""
+	case "verana.td.v1.SlashTrustDepositProposal.corporation":
+		x.Corporation = ""
+	case "verana.td.v1.SlashTrustDepositProposal.deposit":
+		x.Deposit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SlashTrustDepositProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.td.v1.SlashTrustDepositProposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.SlashTrustDepositProposal.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.SlashTrustDepositProposal.corporation":
+		value := x.Corporation
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.SlashTrustDepositProposal.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashTrustDepositProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.td.v1.SlashTrustDepositProposal.title":
+		x.Title = value.Interface().(string)
+	case "verana.td.v1.SlashTrustDepositProposal.description":
+		x.Description = value.Interface().(string)
+	case "verana.td.v1.SlashTrustDepositProposal.corporation":
+		x.Corporation = value.Interface().(string)
+	case "verana.td.v1.SlashTrustDepositProposal.deposit":
+		x.Deposit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashTrustDepositProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.SlashTrustDepositProposal.title":
+		panic(fmt.Errorf("field title of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
+	case "verana.td.v1.SlashTrustDepositProposal.description":
+		panic(fmt.Errorf("field description of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
+	case "verana.td.v1.SlashTrustDepositProposal.corporation":
+		panic(fmt.Errorf("field corporation of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
+	case "verana.td.v1.SlashTrustDepositProposal.deposit":
+		panic(fmt.Errorf("field deposit of message verana.td.v1.SlashTrustDepositProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SlashTrustDepositProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.td.v1.SlashTrustDepositProposal.title":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.SlashTrustDepositProposal.description":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.SlashTrustDepositProposal.corporation":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.SlashTrustDepositProposal.deposit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.SlashTrustDepositProposal"))
+		}
+		panic(fmt.Errorf("message verana.td.v1.SlashTrustDepositProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SlashTrustDepositProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.td.v1.SlashTrustDepositProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SlashTrustDepositProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashTrustDepositProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SlashTrustDepositProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SlashTrustDepositProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SlashTrustDepositProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Corporation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SlashTrustDepositProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Deposit) > 0 {
+			i -= len(x.Deposit)
+			copy(dAtA[i:], x.Deposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Corporation) > 0 {
+			i -= len(x.Corporation)
+			copy(dAtA[i:], x.Corporation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corporation)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SlashTrustDepositProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashTrustDepositProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashTrustDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corporation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: verana/td/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CompensationClaimStatus int32
+
+const (
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_UNSPECIFIED CompensationClaimStatus = 0
+	// PENDING claims wait for the ecosystem corporation group policy
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PENDING CompensationClaimStatus = 1
+	// PAID claims were approved and paid_amount sent to the claimant
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PAID     CompensationClaimStatus = 2
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_REJECTED CompensationClaimStatus = 3
+)
+
+// Enum value maps for CompensationClaimStatus.
+var (
+	CompensationClaimStatus_name = map[int32]string{
+		0: "COMPENSATION_CLAIM_STATUS_UNSPECIFIED",
+		1: "COMPENSATION_CLAIM_STATUS_PENDING",
+		2: "COMPENSATION_CLAIM_STATUS_PAID",
+		3: "COMPENSATION_CLAIM_STATUS_REJECTED",
+	}
+	CompensationClaimStatus_value = map[string]int32{
+		"COMPENSATION_CLAIM_STATUS_UNSPECIFIED": 0,
+		"COMPENSATION_CLAIM_STATUS_PENDING":     1,
+		"COMPENSATION_CLAIM_STATUS_PAID":        2,
+		"COMPENSATION_CLAIM_STATUS_REJECTED":    3,
+	}
+)
+
+func (x CompensationClaimStatus) Enum() *CompensationClaimStatus {
+	p := new(CompensationClaimStatus)
+	*p = x
+	return p
+}
+
+func (x CompensationClaimStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompensationClaimStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_td_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (CompensationClaimStatus) Type() protoreflect.EnumType {
+	return &file_verana_td_v1_types_proto_enumTypes[0]
+}
+
+func (x CompensationClaimStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompensationClaimStatus.Descriptor instead.
+func (CompensationClaimStatus) EnumDescriptor() ([]byte, []int) {
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// TrustDeposit represents a corporation's trust deposit, keyed by
+// corporation_id. corporation is the policy_address of the Corporation,
+// kept for the by-account lookup and fund-flows.
+type TrustDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Corporation    string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Share          string                 `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+	Deposit        uint64                 `protobuf:"varint,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Claimable      uint64                 `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	SlashedDeposit uint64                 `protobuf:"varint,5,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
+	RepaidDeposit  uint64                 `protobuf:"varint,6,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
+	LastSlashed    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_slashed,json=lastSlashed,proto3" json:"last_slashed,omitempty"`
+	LastRepaid     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_repaid,json=lastRepaid,proto3" json:"last_repaid,omitempty"`
+	SlashCount     uint64                 `protobuf:"varint,9,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	CorporationId  uint64                 `protobuf:"varint,10,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Modified       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=modified,proto3" json:"modified,omitempty"`
+	// burned_deposit is the cumulative amount burned from this deposit, by
+	// ecosystem slashes and when a governance slash is repaid
+	BurnedDeposit uint64 `protobuf:"varint,12,opt,name=burned_deposit,json=burnedDeposit,proto3" json:"burned_deposit,omitempty"`
+	// unbonding is the part of deposit released but not yet claimable, the
+	// sum of the UnbondingTrustDeposit entries of the corporation. It is
+	// still slashable.
+	Unbonding uint64 `protobuf:"varint,13,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
+}
+
+func (x *TrustDeposit) Reset() {
+	*x = TrustDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_td_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustDeposit) ProtoMessage() {}
+
+// Deprecated: Use TrustDeposit.ProtoReflect.Descriptor instead.
+func (*TrustDeposit) Descriptor() ([]byte, []int) {
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrustDeposit) GetCorporation() string {
+	if x != nil {
+		return x.Corporation
+	}
+	return ""
+}
+
+func (x *TrustDeposit) GetShare() string {
+	if x != nil {
+		return x.Share
+	}
+	return ""
+}
+
+func (x *TrustDeposit) GetDeposit() uint64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *TrustDeposit) GetClaimable() uint64 {
+	if x != nil {
+		return x.Claimable
+	}
+	return 0
+}
+
+func (x *TrustDeposit) GetSlashedDeposit() uint64 {
+	if x != nil {
+		return x.SlashedDeposit
+	}
+	return 0
+}
+
+func (x *TrustDeposit) GetRepaidDeposit() uint64 {
+	if x != nil {
+		return x.RepaidDeposit
+	}
+	return 0
+}
+
+func (x *TrustDeposit) GetLastSlashed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSlashed
+	}
+	return nil
+}
+
+func (x *TrustDeposit) GetLastRepaid() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRepaid
+	}
+	return nil
+}
+
+func (x *TrustDeposit) GetSlashCount() uint64 {
+	if x != nil {
+		return x.SlashCount
+	}
+	return 0
+}
+
+func (x *TrustDeposit) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *TrustDeposit) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *TrustDeposit) GetBurnedDeposit() uint64 {
+	if x != nil {
+		return x.BurnedDeposit
+	}
+	return 0
+}
+
+func (x *TrustDeposit) GetUnbonding() uint64 {
+	if x != nil {
+		return x.Unbonding
+	}
+	return 0
+}
+
+// UnbondingTrustDeposit is an amount released from a trust deposit that is
+// moved to claimable by the EndBlocker at completion_time.
+type UnbondingTrustDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CorporationId  uint64                 `protobuf:"varint,2,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Amount         uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Created        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	Reason         string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UnbondingTrustDeposit) Reset() {
+	*x = UnbondingTrustDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_td_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbondingTrustDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingTrustDeposit) ProtoMessage() {}
+
+// Deprecated: Use UnbondingTrustDeposit.ProtoReflect.Descriptor instead.
+func (*UnbondingTrustDeposit) Descriptor() ([]byte, []int) {
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnbondingTrustDeposit) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UnbondingTrustDeposit) GetCorporationId() uint64 {
+	if x != nil {
+		return x.CorporationId
+	}
+	return 0
+}
+
+func (x *UnbondingTrustDeposit) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UnbondingTrustDeposit) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *UnbondingTrustDeposit) GetCompletionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return nil
+}
+
+func (x *UnbondingTrustDeposit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ShareValueCheckpoint is a sample of the trust deposit share value, taken
+// by the BeginBlocker at most every share_value_checkpoint_interval_seconds.
+type ShareValueCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BlockHeight int64                  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ShareValue  string                 `protobuf:"bytes,3,opt,name=share_value,json=shareValue,proto3" json:"share_value,omitempty"`
+}
+
+func (x *ShareValueCheckpoint) Reset() {
+	*x = ShareValueCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_td_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareValueCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareValueCheckpoint) ProtoMessage() {}
+
+// Deprecated: Use ShareValueCheckpoint.ProtoReflect.Descriptor instead.
+func (*ShareValueCheckpoint) Descriptor() ([]byte, []int) {
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShareValueCheckpoint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ShareValueCheckpoint) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ShareValueCheckpoint) GetShareValue() string {
+	if x != nil {
+		return x.ShareValue
+	}
+	return ""
+}
+
+// CompensationPool holds the share of the slashes of an ecosystem's
+// participants set aside to compensate the parties they harmed. The funds are
+// held by the compensation pool module account.
+type CompensationPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EcosystemId   uint64 `protobuf:"varint,1,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+	Balance       uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	TotalReceived uint64 `protobuf:"varint,3,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
+	TotalPaid     uint64 `protobuf:"varint,4,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`
+}
+
+func (x *CompensationPool) Reset() {
+	*x = CompensationPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_td_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompensationPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompensationPool) ProtoMessage() {}
+
+// Deprecated: Use CompensationPool.ProtoReflect.Descriptor instead.
+func (*CompensationPool) Descriptor() ([]byte, []int) {
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompensationPool) GetEcosystemId() uint64 {
+	if x != nil {
+		return x.EcosystemId
+	}
+	return 0
+}
+
+func (x *CompensationPool) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *CompensationPool) GetTotalReceived() uint64 {
+	if x != nil {
+		return x.TotalReceived
+	}
+	return 0
+}
+
+func (x *CompensationPool) GetTotalPaid() uint64 {
+	if x != nil {
+		return x.TotalPaid
+	}
+	return 0
+}
+
+// CompensationClaim is a claim of a harmed party against the compensation
+// pool of an ecosystem.
+type CompensationClaim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EcosystemId    uint64                  `protobuf:"varint,2,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+	Claimant       string                  `protobuf:"bytes,3,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Amount         uint64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason         string                  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceDigest string                  `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+	Status         CompensationClaimStatus `protobuf:"varint,7,opt,name=status,proto3,enum=verana.td.v1.CompensationClaimStatus" json:"status,omitempty"`
+	Created        *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	// resolved, resolution_reason and paid_amount are set when the claim is
+	// approved or rejected
+	Resolved         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	ResolutionReason string                 `protobuf:"bytes,10,opt,name=resolution_reason,json=resolutionReason,proto3" json:"resolution_reason,omitempty"`
+	PaidAmount       uint64                 `protobuf:"varint,11,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+}
+
+func (x *CompensationClaim) Reset() {
+	*x = CompensationClaim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_td_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompensationClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompensationClaim) ProtoMessage() {}
+
+// Deprecated: Use CompensationClaim.ProtoReflect.Descriptor instead.
+func (*CompensationClaim) Descriptor() ([]byte, []int) {
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompensationClaim) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompensationClaim) GetEcosystemId() uint64 {
+	if x != nil {
+		return x.EcosystemId
+	}
+	return 0
+}
+
+func (x *CompensationClaim) GetClaimant() string {
+	if x != nil {
+		return x.Claimant
+	}
+	return ""
+}
+
+func (x *CompensationClaim) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CompensationClaim) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CompensationClaim) GetEvidenceDigest() string {
+	if x != nil {
+		return x.EvidenceDigest
+	}
+	return ""
+}
+
+func (x *CompensationClaim) GetStatus() CompensationClaimStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_UNSPECIFIED
+}
+
+func (x *CompensationClaim) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *CompensationClaim) GetResolved() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Resolved
+	}
+	return nil
+}
+
+func (x *CompensationClaim) GetResolutionReason() string {
+	if x != nil {
+		return x.ResolutionReason
+	}
+	return ""
+}
+
+func (x *CompensationClaim) GetPaidAmount() uint64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+// YieldFundingFailure records the last yield funding step that failed, it is
+// cleared by the next successful distribution.
+type YieldFundingFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// stage is the failed step: transfer or return_excess
+	Stage  string `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *YieldFundingFailure) Reset() {
+	*x = YieldFundingFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_td_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *YieldFundingFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YieldFundingFailure) ProtoMessage() {}
+
+// Deprecated: Use YieldFundingFailure.ProtoReflect.Descriptor instead.
+func (*YieldFundingFailure) Descriptor() ([]byte, []int) {
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *YieldFundingFailure) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *YieldFundingFailure) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *YieldFundingFailure) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *YieldFundingFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SlashTrustDepositProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1638,7 +5734,7 @@ type SlashTrustDepositProposal struct {
 func (x *SlashTrustDepositProposal) Reset() {
 	*x = SlashTrustDepositProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_td_v1_types_proto_msgTypes[1]
+		mi := &file_verana_td_v1_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1652,7 +5748,7 @@ func (*SlashTrustDepositProposal) ProtoMessage() {}
 
 // Deprecated: Use SlashTrustDepositProposal.ProtoReflect.Descriptor instead.
 func (*SlashTrustDepositProposal) Descriptor() ([]byte, []int) {
-	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{1}
+	return file_verana_td_v1_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SlashTrustDepositProposal) GetTitle() string {
@@ -1698,7 +5794,7 @@ var file_verana_td_v1_types_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74,
 	0x64, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xda, 0x03, 0x0a, 0x0c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x6f, 0x22, 0x84, 0x05, 0x0a, 0x0c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
 	0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
@@ -1727,35 +5823,133 @@ var file_verana_td_v1_types_proto_rawDesc = []byte{
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
 	0x01, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x64, 0x12, 0x1f, 0x0a,
 	0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x82,
-	0x02, 0x0a, 0x19, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f,
-	0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38,
-	0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63,
-	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0b, 0x63, 0x6f, 0x72,
-	0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x42, 0xa5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x54,
-	0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x8d, 0x02, 0x0a, 0x15, 0x55, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x4d, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x14, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x42, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x95,
+	0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x63, 0x6f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x61, 0x69, 0x64, 0x22, 0xdc, 0x03, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x34, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x69, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x13, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x19, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x38,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xf2,
+	0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2f, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x22, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2a, 0xb7, 0x01,
+	0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x43, 0x4f, 0x4d,
+	0x50, 0x45, 0x4e, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4d, 0x50, 0x45, 0x4e, 0x53, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x43,
+	0x4f, 0x4d, 0x50, 0x45, 0x4e, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x41, 0x49,
+	0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x02, 0x12,
+	0x26, 0x0a, 0x22, 0x43, 0x4f, 0x4d, 0x50, 0x45, 0x4e, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x42, 0xa5, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x56, 0x54, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54,
+	0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1770,20 +5964,34 @@ func file_verana_td_v1_types_proto_rawDescGZIP() []byte {
 	return file_verana_td_v1_types_proto_rawDescData
 }
 
-var file_verana_td_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_verana_td_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_verana_td_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_verana_td_v1_types_proto_goTypes = []interface{}{
-	(*TrustDeposit)(nil),              // 0: verana.td.v1.TrustDeposit
-	(*SlashTrustDepositProposal)(nil), // 1: verana.td.v1.SlashTrustDepositProposal
-	(*timestamppb.Timestamp)(nil),     // 2: google.protobuf.Timestamp
+	(CompensationClaimStatus)(0),      // 0: verana.td.v1.CompensationClaimStatus
+	(*TrustDeposit)(nil),              // 1: verana.td.v1.TrustDeposit
+	(*UnbondingTrustDeposit)(nil),     // 2: verana.td.v1.UnbondingTrustDeposit
+	(*ShareValueCheckpoint)(nil),      // 3: verana.td.v1.ShareValueCheckpoint
+	(*CompensationPool)(nil),          // 4: verana.td.v1.CompensationPool
+	(*CompensationClaim)(nil),         // 5: verana.td.v1.CompensationClaim
+	(*YieldFundingFailure)(nil),       // 6: verana.td.v1.YieldFundingFailure
+	(*SlashTrustDepositProposal)(nil), // 7: verana.td.v1.SlashTrustDepositProposal
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
 }
 var file_verana_td_v1_types_proto_depIdxs = []int32{
-	2, // 0: verana.td.v1.TrustDeposit.last_slashed:type_name -> google.protobuf.Timestamp
-	2, // 1: verana.td.v1.TrustDeposit.last_repaid:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8, // 0: verana.td.v1.TrustDeposit.last_slashed:type_name -> google.protobuf.Timestamp
+	8, // 1: verana.td.v1.TrustDeposit.last_repaid:type_name -> google.protobuf.Timestamp
+	8, // 2: verana.td.v1.TrustDeposit.modified:type_name -> google.protobuf.Timestamp
+	8, // 3: verana.td.v1.UnbondingTrustDeposit.created:type_name -> google.protobuf.Timestamp
+	8, // 4: verana.td.v1.UnbondingTrustDeposit.completion_time:type_name -> google.protobuf.Timestamp
+	8, // 5: verana.td.v1.ShareValueCheckpoint.timestamp:type_name -> google.protobuf.Timestamp
+	0, // 6: verana.td.v1.CompensationClaim.status:type_name -> verana.td.v1.CompensationClaimStatus
+	8, // 7: verana.td.v1.CompensationClaim.created:type_name -> google.protobuf.Timestamp
+	8, // 8: verana.td.v1.CompensationClaim.resolved:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_verana_td_v1_types_proto_init() }
@@ -1806,6 +6014,66 @@ func file_verana_td_v1_types_proto_init() {
 			}
 		}
 		file_verana_td_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbondingTrustDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_td_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareValueCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_td_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompensationPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_td_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompensationClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_td_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*YieldFundingFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_td_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlashTrustDepositProposal); i {
 			case 0:
 				return &v.state
@@ -1823,13 +6091,14 @@ func file_verana_td_v1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_td_v1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_verana_td_v1_types_proto_goTypes,
 		DependencyIndexes: file_verana_td_v1_types_proto_depIdxs,
+		EnumInfos:         file_verana_td_v1_types_proto_enumTypes,
 		MessageInfos:      file_verana_td_v1_types_proto_msgTypes,
 	}.Build()
 	File_verana_td_v1_types_proto = out.File
@@ -211,6 +211,7 @@ var (
 		{Account: protocolpooltypes.ModuleName},
 		{Account: protocolpooltypes.ProtocolPoolEscrowAccount},
 		{Account: trustdepositmoduletypes.YieldIntermediatePool},
+		{Account: trustdepositmoduletypes.CompensationPoolAccount},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
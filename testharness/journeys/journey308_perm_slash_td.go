@@ -10,6 +10,7 @@ import (
 	"github.com/ignite/cli/v28/ignite/pkg/cosmosclient"
 
 	cschema "github.com/verana-labs/verana/x/cs/types"
+	ditypes "github.com/verana-labs/verana/x/di/types"
 	permtypes "github.com/verana-labs/verana/x/pp/types"
 
 	"github.com/verana-labs/verana/testharness/lib"
@@ -177,32 +178,48 @@ func RunPermissionSlashTDJourney(ctx context.Context, client cosmosclient.Client
 
 	// Use the root perm's authority (policyAddr) as the slasher - it's a validator ancestor
 	slashAmount := uint64(10) // small amount to keep the perm deposit positive
+	// Slash evidence must be anchored in x/di before the slash (stored in step 1b)
+	evidenceDigest := fmt.Sprintf("sha256-journey308evidence%d", time.Now().UnixNano())
 
 	// 1a: Try WITHOUT authorization (expect failure)
 	fmt.Println("\n--- Step 1a: Operator tries SlashPermissionTrustDeposit without auth (expect failure) ---")
-	err = lib.SlashPermissionTrustDeposit(client, ctx, operatorAccount, policyAddr, issuerPermID, slashAmount, "journey 308 test slash")
+	err = lib.SlashPermissionTrustDeposit(client, ctx, operatorAccount, policyAddr, issuerPermID, slashAmount, "journey 308 test slash", evidenceDigest)
 	if err := expectAuthorizationError("Step 1a", err); err != nil {
 		return err
 	}
 	fmt.Println("OK Step 1a: SlashPermissionTrustDeposit correctly rejected without authorization")
 	waitForTx("slash rejection")
 
-	// 1b: Grant authorization for SlashPermissionTrustDeposit
-	fmt.Println("\n--- Step 1b: Grant operator auth for SlashPermissionTrustDeposit ---")
+	// 1b: Grant authorization for SlashPermissionTrustDeposit and store the evidence digest
+	fmt.Println("\n--- Step 1b: Grant operator auth for SlashPermissionTrustDeposit and StoreDigest ---")
 	err = lib.GrantOperatorAuthorizationViaGroup(
 		client, ctx, adminAccount, member1Account,
 		policyAddr, operatorAddr, operatorAddr,
-		[]string{"/verana.pp.v1.MsgSlashParticipantTrustDeposit"},
+		[]string{"/verana.pp.v1.MsgSlashParticipantTrustDeposit", "/verana.di.v1.MsgStoreDigest"},
 	)
 	if err != nil {
 		return fmt.Errorf("step 1b failed: %w", err)
 	}
-	fmt.Println("OK Step 1b: Granted SlashPermissionTrustDeposit authorization")
+	fmt.Println("OK Step 1b: Granted SlashPermissionTrustDeposit and StoreDigest authorization")
 	waitForTx("grant slash auth")
 
+	txResp, err := client.BroadcastTx(ctx, operatorAccount, &ditypes.MsgStoreDigest{
+		Authority: policyAddr,
+		Operator:  operatorAddr,
+		Digest:    evidenceDigest,
+	})
+	if err != nil {
+		return fmt.Errorf("step 1b failed: could not store evidence digest: %w", err)
+	}
+	if txResp.TxResponse.Code != 0 {
+		return fmt.Errorf("step 1b failed: store evidence digest failed with code %d: %s", txResp.TxResponse.Code, txResp.TxResponse.RawLog)
+	}
+	fmt.Printf("OK Step 1b: Stored slash evidence digest %s\n", evidenceDigest)
+	waitForTx("store evidence digest")
+
 	// 1c: Try WITH authorization (expect success - validator ancestor)
 	fmt.Println("\n--- Step 1c: Operator slashes perm trust deposit with auth (expect success) ---")
-	err = lib.SlashPermissionTrustDeposit(client, ctx, operatorAccount, policyAddr, issuerPermID, slashAmount, "journey 308 test slash")
+	err = lib.SlashPermissionTrustDeposit(client, ctx, operatorAccount, policyAddr, issuerPermID, slashAmount, "journey 308 test slash", evidenceDigest)
 	if err != nil {
 		return fmt.Errorf("step 1c failed: %w", err)
 	}
@@ -218,17 +235,20 @@ func RunPermissionSlashTDJourney(ctx context.Context, client cosmosclient.Client
 		return fmt.Errorf("step 2 query failed: %w", err)
 	}
 
-	// Verify slashed timestamp is set
-	if slashedPerm.Slashed == nil {
-		return fmt.Errorf("step 2 failed: slashed timestamp is nil")
+	// The slash is held pending for the dispute window: the deposit is reduced
+	// right away, slashed and slashed_deposit are set once the slash is final
+	if len(slashedPerm.Slashes) != 1 {
+		return fmt.Errorf("step 2 failed: expected 1 slash record, got %d", len(slashedPerm.Slashes))
 	}
-
-	// Verify slashed_by is the authority (policy address)
-	// spec v4: removed field assertion (adjusted_by/revoked_by/slashed_by no longer exist)
-
-	// Verify slashed_deposit is set
-	if slashedPerm.SlashedDeposit != slashAmount {
-		return fmt.Errorf("step 2 failed: expected slashed_deposit=%d, got %d", slashAmount, slashedPerm.SlashedDeposit)
+	slash := slashedPerm.Slashes[0]
+	if slash.Status != permtypes.SlashStatus_SLASH_STATUS_PENDING {
+		return fmt.Errorf("step 2 failed: expected pending slash, got %s", slash.Status)
+	}
+	if slash.Amount != slashAmount || slash.EvidenceDigest != evidenceDigest {
+		return fmt.Errorf("step 2 failed: unexpected slash record %+v", slash)
+	}
+	if slashedPerm.Deposit != issuerPerm.Deposit-slashAmount {
+		return fmt.Errorf("step 2 failed: expected deposit=%d, got %d", issuerPerm.Deposit-slashAmount, slashedPerm.Deposit)
 	}
 
 	// Verify modified timestamp is set
@@ -236,7 +256,7 @@ func RunPermissionSlashTDJourney(ctx context.Context, client cosmosclient.Client
 		return fmt.Errorf("step 2 failed: modified timestamp is nil")
 	}
 
-	fmt.Printf("OK Step 2: Verified slashed fields (slashed_deposit=%d)\n", slashedPerm.SlashedDeposit)
+	fmt.Printf("OK Step 2: Verified pending slash (amount=%d, dispute_deadline=%s)\n", slash.Amount, slash.DisputeDeadline)
 
 	// =========================================================================
 	// TEST 3: Unauthorized operator (negative test)
@@ -245,7 +265,7 @@ func RunPermissionSlashTDJourney(ctx context.Context, client cosmosclient.Client
 
 	fmt.Println("\n--- Step 3a: Unauthorized operator tries SlashPermissionTrustDeposit (expect failure) ---")
 	coolusrAcct := lib.GetAccount(client, lib.COOLUSER_NAME)
-	err = lib.SlashPermissionTrustDeposit(client, ctx, coolusrAcct, policyAddr, issuerPermID, slashAmount, "journey 308 test slash")
+	err = lib.SlashPermissionTrustDeposit(client, ctx, coolusrAcct, policyAddr, issuerPermID, slashAmount, "journey 308 test slash", evidenceDigest)
 	if err := expectAuthorizationError("Step 3a", err); err != nil {
 		return err
 	}
@@ -259,7 +279,7 @@ func RunPermissionSlashTDJourney(ctx context.Context, client cosmosclient.Client
 	fmt.Println("\n--- Step 4a: Correct operator but wrong authority (expect failure) ---")
 	// The operator has a self-delegation but the self-delegation authority is operatorAddr,
 	// not an ancestor validator or TR controller for the issuer perm
-	err = lib.SlashPermissionTrustDeposit(client, ctx, operatorAccount, operatorAddr, issuerPermID, slashAmount, "journey 308 test slash")
+	err = lib.SlashPermissionTrustDeposit(client, ctx, operatorAccount, operatorAddr, issuerPermID, slashAmount, "journey 308 test slash", evidenceDigest)
 	if err == nil {
 		return fmt.Errorf("step 4a failed: expected error for wrong authority, got nil")
 	}
@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -457,6 +458,27 @@ func GetTrustDepositAtHeight(client cosmosclient.Client, ctx context.Context, ac
 	return &resp.TrustDeposit, nil
 }
 
+// ListTrustDeposits pages through all trust deposits on chain
+func ListTrustDeposits(client cosmosclient.Client, ctx context.Context) ([]tdtypes.TrustDeposit, error) {
+	tdClient := tdtypes.NewQueryClient(client.Context())
+
+	var trustDeposits []tdtypes.TrustDeposit
+	var nextKey []byte
+	for {
+		resp, err := tdClient.ListTrustDeposits(ctx, &tdtypes.QueryListTrustDepositsRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list trust deposits: %v", err)
+		}
+		trustDeposits = append(trustDeposits, resp.TrustDeposits...)
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return trustDeposits, nil
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+}
+
 // ReclaimTrustDepositYield reclaims trust deposit yield
 func ReclaimTrustDepositYield(client cosmosclient.Client, ctx context.Context, creator cosmosaccount.Account) (string, error) {
 	_, _, err := ReclaimTrustDepositYieldWithResponse(client, ctx, creator)
@@ -676,7 +698,8 @@ func GetBankBalanceAtHeight(client cosmosclient.Client, ctx context.Context, add
 	return *resp.Balance, nil
 }
 
-// VerifyTrustDepositClaimable verifies a trust deposit has been made claimable
+// VerifyTrustDepositClaimable verifies a trust deposit has been released,
+// either claimable or still unbonding
 func VerifyTrustDepositClaimable(client cosmosclient.Client, ctx context.Context, account cosmosaccount.Account, initialDeposit uint64) bool {
 	trustDeposit, err := GetTrustDeposit(client, ctx, account)
 	if err != nil {
@@ -684,12 +707,12 @@ func VerifyTrustDepositClaimable(client cosmosclient.Client, ctx context.Context
 		return false
 	}
 
-	if initialDeposit > 0 && trustDeposit.Claimable == 0 {
-		fmt.Printf("❌ Trust deposit verification failed: Initial deposit was %d but claimable deposit is 0\n", initialDeposit)
+	if initialDeposit > 0 && trustDeposit.Claimable == 0 && trustDeposit.Unbonding == 0 {
+		fmt.Printf("❌ Trust deposit verification failed: Initial deposit was %d but no deposit was released\n", initialDeposit)
 		return false
 	}
 
-	fmt.Printf("✅ Verified trust deposit is claimable: %d\n", trustDeposit.Claimable)
+	fmt.Printf("✅ Verified trust deposit is released: %d claimable, %d unbonding\n", trustDeposit.Claimable, trustDeposit.Unbonding)
 	return true
 }
 
@@ -1019,19 +1042,21 @@ func CancelPermissionVPLastRequest(client cosmosclient.Client, ctx context.Conte
 }
 
 // SlashPermissionTrustDeposit slashes a permission's trust deposit.
-// [MOD-PP-MSG-12-1] reason is mandatory per spec v4 draft 13.
-func SlashPermissionTrustDeposit(client cosmosclient.Client, ctx context.Context, actor cosmosaccount.Account, authority string, id uint64, amount uint64, reason string) error {
+// [MOD-PP-MSG-12-1] reason is mandatory per spec v4 draft 13, and
+// evidenceDigest must already be stored in x/di.
+func SlashPermissionTrustDeposit(client cosmosclient.Client, ctx context.Context, actor cosmosaccount.Account, authority string, id uint64, amount uint64, reason, evidenceDigest string) error {
 	actorAddr, err := actor.Address(addressPrefix)
 	if err != nil {
 		return fmt.Errorf("failed to get actor address: %w", err)
 	}
 
 	msg := &permtypes.MsgSlashParticipantTrustDeposit{
-		Corporation: authority,
-		Operator:    actorAddr,
-		Id:          id,
-		Amount:      amount,
-		Reason:      reason,
+		Corporation:    authority,
+		Operator:       actorAddr,
+		Id:             id,
+		Amount:         amount,
+		Reason:         reason,
+		EvidenceDigest: evidenceDigest,
 	}
 
 	txResp, err := client.BroadcastTx(ctx, actor, msg)
@@ -1340,15 +1365,38 @@ func GetAllKnownTrustDepositsAtHeight(client cosmosclient.Client, ctx context.Co
 		TD      *tdtypes.TrustDeposit
 	}
 
+	// At latest height the trust deposits are paged through once instead of
+	// queried account by account
+	var listed map[string]tdtypes.TrustDeposit
+	if height == 0 {
+		trustDeposits, err := ListTrustDeposits(client, ctx)
+		if err != nil {
+			return nil, err
+		}
+		listed = make(map[string]tdtypes.TrustDeposit, len(trustDeposits))
+		for _, td := range trustDeposits {
+			listed[td.Corporation] = td
+		}
+	}
+
 	for _, acc := range accounts {
 		account, err := client.Account(acc.name)
 		if err != nil {
 			continue
 		}
 
-		td, err := GetTrustDepositAtHeight(client, ctx, account, height)
-		if err != nil {
-			continue // Account doesn't have a trust deposit
+		var td *tdtypes.TrustDeposit
+		if listed != nil {
+			found, ok := listed[acc.address]
+			if !ok {
+				continue // Account doesn't have a trust deposit
+			}
+			td = &found
+		} else {
+			td, err = GetTrustDepositAtHeight(client, ctx, account, height)
+			if err != nil {
+				continue // Account doesn't have a trust deposit
+			}
 		}
 
 		results = append(results, struct {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -22,6 +23,7 @@ import (
 	"github.com/verana-labs/verana/x/cs/keeper"
 	"github.com/verana-labs/verana/x/cs/types"
 	ectypes "github.com/verana-labs/verana/x/ec/types"
+	gftypes "github.com/verana-labs/verana/x/gf/types"
 )
 
 // MockBankKeeper is a mock implementation of types.BankKeeper
@@ -57,6 +59,10 @@ func (k *MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, de
 	return sdk.NewCoin(denom, math.ZeroInt())
 }
 
+func (k *MockBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, math.ZeroInt())
+}
+
 func (k *MockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
 	return sdk.Coins{}
 }
@@ -119,6 +125,33 @@ func (k *MockEcosystemKeeper) CreateMockEcosystem(corporation string, did string
 	return id
 }
 
+// ListVersionsByEcosystem lets MockEcosystemKeeper double as the
+// types.GovernanceFrameworkKeeper: every mock ecosystem has a single active
+// version with one governance framework document.
+func (k *MockEcosystemKeeper) ListVersionsByEcosystem(ctx context.Context, ecosystemID uint64, activeVersion uint32, activeOnly bool, preferredLang string) ([]gftypes.GovernanceFrameworkVersionWithDocs, error) {
+	ec, ok := k.ecosystems[ecosystemID]
+	if !ok {
+		return nil, ectypes.ErrEcosystemNotFound
+	}
+	return []gftypes.GovernanceFrameworkVersionWithDocs{{
+		Id:          ecosystemID,
+		EcosystemId: ecosystemID,
+		Version:     ec.ActiveVersion,
+		Documents: []gftypes.GovernanceFrameworkDocument{{
+			Id:       ecosystemID,
+			GfvId:    ecosystemID,
+			Language: ec.Language,
+			Url:      MockGovernanceFrameworkURL(ecosystemID),
+		}},
+	}}, nil
+}
+
+// MockGovernanceFrameworkURL returns the governance framework document URL
+// served by MockEcosystemKeeper for an ecosystem.
+func MockGovernanceFrameworkURL(ecosystemID uint64) string {
+	return fmt.Sprintf("https://example.com/ecosystems/%d/gf.pdf", ecosystemID)
+}
+
 // MockCorporationKeeper resolves a signing policy_address to a
 // CorporationView; shares its backing map with MockEcosystemKeeper so
 // the (ec.CorporationId == co.Id) ownership check passes for the same
@@ -172,6 +205,7 @@ func CredentialschemaKeeper(t testing.TB) (keeper.Keeper, *MockEcosystemKeeper,
 		ecosystemKeeper,
 		coKeeper,
 		mockDelegationKeeper,
+		ecosystemKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -15,11 +15,11 @@ import (
 // pre-rename testutil/keeper/trustregistry.go.
 type MockTrustDepositKeeper struct{}
 
-func (m *MockTrustDepositKeeper) AdjustTrustDeposit(_ sdk.Context, _ string, _ int64, _ string) error {
+func (m *MockTrustDepositKeeper) AdjustTrustDeposit(_ sdk.Context, _ uint64, _ int64, _ string) error {
 	return nil
 }
 
-func (m *MockTrustDepositKeeper) AdjustTrustDepositOnBehalf(_ sdk.Context, _ string, _ sdk.AccAddress, _ int64) error {
+func (m *MockTrustDepositKeeper) AdjustTrustDepositOnBehalf(_ sdk.Context, _ uint64, _ sdk.AccAddress, _ int64) error {
 	return nil
 }
 
@@ -38,10 +38,22 @@ func (m *MockTrustDepositKeeper) GetWalletUserAgentRewardRate(_ sdk.Context) mat
 	return v
 }
 
-func (m *MockTrustDepositKeeper) BurnEcosystemSlashedTrustDeposit(_ sdk.Context, _ string, _ uint64) error {
+func (m *MockTrustDepositKeeper) BurnEcosystemSlashedTrustDeposit(_ sdk.Context, _ uint64, _ uint64, _ uint64) error {
 	return nil
 }
 
+func (m *MockTrustDepositKeeper) GetUnbondingTrustDeposit(_ sdk.Context, _ uint64) (uint64, error) {
+	return 0, nil
+}
+
+func (m *MockTrustDepositKeeper) HoldUnbondingTrustDeposit(_ sdk.Context, _ uint64, _ uint64) error {
+	return nil
+}
+
+func (m *MockTrustDepositKeeper) GetTrustDepositUnbondingDays(_ sdk.Context) uint64 {
+	return 0
+}
+
 // MockDelegationKeeper is a mock implementation of the DelegationKeeper
 // interface used by cs / perm / td / ec tests. By default it allows all
 // operator authorizations (ErrToReturn is nil). Set ErrToReturn to simulate
@@ -53,6 +65,8 @@ type MockDelegationKeeper struct {
 	GrantVSOACalls  []GrantVSOACall
 	RevokeVSOACalls []uint64 // participant ids
 	UpdateVSOACalls []UpdateVSOACall
+	AuthzCheckCalls []string // msg type urls
+	AuthzSpends     []sdk.Coins
 }
 
 type GrantVSOACall struct {
@@ -70,9 +84,18 @@ func (m *MockDelegationKeeper) Reset() {
 	m.GrantVSOACalls = nil
 	m.RevokeVSOACalls = nil
 	m.UpdateVSOACalls = nil
+	m.AuthzCheckCalls = nil
+	m.AuthzSpends = nil
+}
+
+func (m *MockDelegationKeeper) CheckOperatorAuthorization(_ context.Context, _, _, msgTypeURL string, _ time.Time) error {
+	m.AuthzCheckCalls = append(m.AuthzCheckCalls, msgTypeURL)
+	return m.ErrToReturn
 }
 
-func (m *MockDelegationKeeper) CheckOperatorAuthorization(_ context.Context, _, _, _ string, _ time.Time) error {
+func (m *MockDelegationKeeper) CheckOperatorAuthorizationWithSpend(_ context.Context, _, _, msgTypeURL string, _ time.Time, spend sdk.Coins) error {
+	m.AuthzCheckCalls = append(m.AuthzCheckCalls, msgTypeURL)
+	m.AuthzSpends = append(m.AuthzSpends, spend)
 	return m.ErrToReturn
 }
 
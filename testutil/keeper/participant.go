@@ -225,6 +225,9 @@ func (k *MockCredentialSchemaKeeper) CreateMockCredentialSchemaFull(cs cstypes.C
 // participant invoked StoreDigestModuleCall during credential-issuance flows.
 type MockDigestKeeper struct {
 	Stored []MockDigestRecord
+	// Missing lists the digests HasDigest reports as not anchored, every
+	// other digest is reported as anchored.
+	Missing map[string]bool
 }
 
 type MockDigestRecord struct {
@@ -237,3 +240,7 @@ func (m *MockDigestKeeper) StoreDigestModuleCall(_ context.Context, authority, d
 	m.Stored = append(m.Stored, MockDigestRecord{Authority: authority, Digest: digest, DigestAlgorithm: digestAlgorithm})
 	return nil
 }
+
+func (m *MockDigestKeeper) HasDigest(_ context.Context, digest string) (bool, error) {
+	return !m.Missing[digest], nil
+}
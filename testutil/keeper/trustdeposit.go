@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
@@ -16,30 +19,181 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 	"github.com/stretchr/testify/require"
 
 	cotypes "github.com/verana-labs/verana/x/co/types"
+	ectypes "github.com/verana-labs/verana/x/ec/types"
 	"github.com/verana-labs/verana/x/td/keeper"
 	"github.com/verana-labs/verana/x/td/types"
 )
 
 // MockTDCorporationKeeper backs AUTHZ-CHECK-5 in MOD-TD tests. It resolves any
-// signing account by default (permissive) so pre-existing positive-path tests
-// pass; add an address to Unregistered to exercise the
-// ErrCorporationNotRegistered abort path.
+// signing account by default (permissive), registering it under the next
+// corporation id, so pre-existing positive-path tests pass; add an address to
+// Unregistered to exercise the ErrCorporationNotRegistered abort path.
 type MockTDCorporationKeeper struct {
 	Unregistered map[string]bool
+	ids          map[string]uint64
+	accounts     map[uint64]string
 }
 
 func NewMockTDCorporationKeeper() *MockTDCorporationKeeper {
-	return &MockTDCorporationKeeper{Unregistered: map[string]bool{}}
+	return &MockTDCorporationKeeper{
+		Unregistered: map[string]bool{},
+		ids:          map[string]uint64{},
+		accounts:     map[uint64]string{},
+	}
+}
+
+// Register binds addr to a corporation id (idempotent) and returns it.
+func (m *MockTDCorporationKeeper) Register(addr string) uint64 {
+	if id, ok := m.ids[addr]; ok {
+		return id
+	}
+	id := uint64(len(m.ids) + 1)
+	m.ids[addr] = id
+	m.accounts[id] = addr
+	return id
 }
 
 func (m *MockTDCorporationKeeper) ResolveCorporationByPolicyAddress(_ context.Context, addr string) (types.CorporationView, error) {
 	if m.Unregistered[addr] {
 		return types.CorporationView{}, cotypes.ErrCorporationNotRegistered
 	}
-	return types.CorporationView{Id: 1, PolicyAddress: addr}, nil
+	return types.CorporationView{Id: m.Register(addr), PolicyAddress: addr}, nil
+}
+
+func (m *MockTDCorporationKeeper) GetCorporationByID(_ context.Context, id uint64) (types.CorporationView, error) {
+	addr, ok := m.accounts[id]
+	if !ok {
+		return types.CorporationView{}, fmt.Errorf("corporation %d not found", id)
+	}
+	return types.CorporationView{Id: id, PolicyAddress: addr}, nil
+}
+
+// MockTDEcosystemKeeper resolves the ecosystems registered with
+// CreateMockEcosystem to their controlling corporation id.
+type MockTDEcosystemKeeper struct {
+	ecosystems map[uint64]ectypes.Ecosystem
+}
+
+func NewMockTDEcosystemKeeper() *MockTDEcosystemKeeper {
+	return &MockTDEcosystemKeeper{ecosystems: map[uint64]ectypes.Ecosystem{}}
+}
+
+// CreateMockEcosystem registers an ecosystem controlled by corporationID and
+// returns its id.
+func (m *MockTDEcosystemKeeper) CreateMockEcosystem(corporationID uint64) uint64 {
+	id := uint64(len(m.ecosystems) + 1)
+	m.ecosystems[id] = ectypes.Ecosystem{Id: id, CorporationId: corporationID}
+	return id
+}
+
+func (m *MockTDEcosystemKeeper) GetEcosystem(_ context.Context, id uint64) (ectypes.Ecosystem, error) {
+	if ec, ok := m.ecosystems[id]; ok {
+		return ec, nil
+	}
+	return ectypes.Ecosystem{}, ectypes.ErrEcosystemNotFound
+}
+
+// MockProtocolPoolKeeper records the funds returned to the community pool.
+// Set FundErr to make FundCommunityPool fail, ContinuousFundErr to make
+// GetContinuousFund fail, and register continuous funds in ContinuousFunds by
+// recipient address.
+type MockProtocolPoolKeeper struct {
+	ContinuousFunds   map[string]protocolpooltypes.ContinuousFund
+	Funded            sdk.Coins
+	FundErr           error
+	ContinuousFundErr error
+}
+
+func NewMockProtocolPoolKeeper() *MockProtocolPoolKeeper {
+	return &MockProtocolPoolKeeper{ContinuousFunds: map[string]protocolpooltypes.ContinuousFund{}}
+}
+
+func (m *MockProtocolPoolKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+	if m.FundErr != nil {
+		return m.FundErr
+	}
+	m.Funded = m.Funded.Add(amount...)
+	return nil
+}
+
+func (m *MockProtocolPoolKeeper) GetContinuousFund(_ context.Context, recipient sdk.AccAddress) (protocolpooltypes.ContinuousFund, error) {
+	if m.ContinuousFundErr != nil {
+		return protocolpooltypes.ContinuousFund{}, m.ContinuousFundErr
+	}
+	fund, ok := m.ContinuousFunds[recipient.String()]
+	if !ok {
+		return protocolpooltypes.ContinuousFund{}, collections.ErrNotFound
+	}
+	return fund, nil
+}
+
+// MockYieldBankKeeper tracks the module account balances moved by the yield
+// distribution, every other bank call is a no-op.
+type MockYieldBankKeeper struct {
+	*MockBankKeeper
+	Balances map[string]math.Int // keyed by bech32 address
+}
+
+func NewMockYieldBankKeeper() *MockYieldBankKeeper {
+	return &MockYieldBankKeeper{MockBankKeeper: NewMockBankKeeper(), Balances: map[string]math.Int{}}
+}
+
+// SetModuleBalance sets the balance of the module account moduleName.
+func (m *MockYieldBankKeeper) SetModuleBalance(moduleName string, amount int64) {
+	m.Balances[authtypes.NewModuleAddress(moduleName).String()] = math.NewInt(amount)
+}
+
+func (m *MockYieldBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	if balance, ok := m.Balances[addr.String()]; ok {
+		return sdk.NewCoin(denom, balance)
+	}
+	return sdk.NewCoin(denom, math.ZeroInt())
+}
+
+// GetSupply returns the sum of the tracked balances.
+func (m *MockYieldBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	supply := math.ZeroInt()
+	for _, balance := range m.Balances {
+		supply = supply.Add(balance)
+	}
+	return sdk.NewCoin(denom, supply)
+}
+
+func (m *MockYieldBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	from := authtypes.NewModuleAddress(senderModule).String()
+	to := authtypes.NewModuleAddress(recipientModule).String()
+	amount := amt.AmountOf(types.BondDenom)
+	balance, ok := m.Balances[from]
+	if !ok || balance.LT(amount) {
+		return fmt.Errorf("insufficient funds in %s", senderModule)
+	}
+	m.Balances[from] = balance.Sub(amount)
+	if _, ok := m.Balances[to]; !ok {
+		m.Balances[to] = math.ZeroInt()
+	}
+	m.Balances[to] = m.Balances[to].Add(amount)
+	return nil
+}
+
+// MockStakingKeeper reports BondedTokens, zero if unset. Set Err to make
+// TotalBondedTokens fail.
+type MockStakingKeeper struct {
+	BondedTokens math.Int
+	Err          error
+}
+
+func (m *MockStakingKeeper) TotalBondedTokens(_ context.Context) (math.Int, error) {
+	if m.Err != nil {
+		return math.Int{}, m.Err
+	}
+	if m.BondedTokens.IsNil() {
+		return math.ZeroInt(), nil
+	}
+	return m.BondedTokens, nil
 }
 
 // MockMintKeeper is a mock implementation of types.MintKeeper
@@ -78,6 +232,10 @@ func TrustdepositKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		mintKeeper,
 		delegationKeeper,
 		NewMockTDCorporationKeeper(),
+		NewMockTDEcosystemKeeper(),
+		&MockDigestKeeper{},
+		NewMockProtocolPoolKeeper(),
+		&MockStakingKeeper{},
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -116,6 +274,10 @@ func TrustdepositKeeperWithDelegation(t testing.TB) (keeper.Keeper, sdk.Context,
 		mintKeeper,
 		delegationKeeper,
 		NewMockTDCorporationKeeper(),
+		NewMockTDEcosystemKeeper(),
+		&MockDigestKeeper{},
+		NewMockProtocolPoolKeeper(),
+		&MockStakingKeeper{},
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -153,6 +315,10 @@ func TrustdepositKeeperWithCorp(t testing.TB) (keeper.Keeper, sdk.Context, *Mock
 		NewMockMintKeeper(),
 		delegationKeeper,
 		coKeeper,
+		NewMockTDEcosystemKeeper(),
+		&MockDigestKeeper{},
+		NewMockProtocolPoolKeeper(),
+		&MockStakingKeeper{},
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -162,3 +328,84 @@ func TrustdepositKeeperWithCorp(t testing.TB) (keeper.Keeper, sdk.Context, *Mock
 
 	return k, ctx, delegationKeeper, coKeeper
 }
+
+// TrustdepositKeeperWithEcosystem creates a keeper exposing the corporation,
+// ecosystem and digest mocks backing the compensation pool flows.
+func TrustdepositKeeperWithEcosystem(t testing.TB) (keeper.Keeper, sdk.Context, *MockTDCorporationKeeper, *MockTDEcosystemKeeper, *MockDigestKeeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	coKeeper := NewMockTDCorporationKeeper()
+	ecKeeper := NewMockTDEcosystemKeeper()
+	digestKeeper := &MockDigestKeeper{}
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		NewMockBankKeeper(),
+		NewMockMintKeeper(),
+		&MockDelegationKeeper{},
+		coKeeper,
+		ecKeeper,
+		digestKeeper,
+		NewMockProtocolPoolKeeper(),
+		&MockStakingKeeper{},
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, ctx, coKeeper, ecKeeper, digestKeeper
+}
+
+// TrustdepositKeeperWithProtocolPool creates a keeper whose bank tracks the
+// module account balances, exposing the protocol pool mock the yield
+// intermediate pool returns its excess to.
+func TrustdepositKeeperWithProtocolPool(t testing.TB) (keeper.Keeper, sdk.Context, *MockYieldBankKeeper, *MockProtocolPoolKeeper, *MockStakingKeeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	bankKeeper := NewMockYieldBankKeeper()
+	protocolPool := NewMockProtocolPoolKeeper()
+	staking := &MockStakingKeeper{BondedTokens: math.ZeroInt()}
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		bankKeeper,
+		NewMockMintKeeper(),
+		&MockDelegationKeeper{},
+		NewMockTDCorporationKeeper(),
+		NewMockTDEcosystemKeeper(),
+		&MockDigestKeeper{},
+		protocolPool,
+		staking,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, ctx, bankKeeper, protocolPool, staking
+}
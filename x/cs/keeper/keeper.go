@@ -26,6 +26,7 @@ type (
 		ecosystemKeeper  types.EcosystemKeeper
 		coKeeper         types.CorporationKeeper
 		delegationKeeper types.DelegationKeeper
+		gfKeeper         types.GovernanceFrameworkKeeper
 
 		// State management
 		Schema collections.Schema
@@ -45,6 +46,7 @@ func NewKeeper(
 	ecosystemKeeper types.EcosystemKeeper,
 	coKeeper types.CorporationKeeper,
 	delegationKeeper types.DelegationKeeper,
+	gfKeeper types.GovernanceFrameworkKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -61,6 +63,7 @@ func NewKeeper(
 		ecosystemKeeper:  ecosystemKeeper,
 		coKeeper:         coKeeper,
 		delegationKeeper: delegationKeeper,
+		gfKeeper:         gfKeeper,
 
 		// Initialize collections
 		CredentialSchema: collections.NewMap(
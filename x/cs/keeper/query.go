@@ -126,24 +126,125 @@ func (k Keeper) RenderJsonSchema(goCtx context.Context, req *types.QueryRenderJs
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	schema, err := k.CredentialSchema.Get(ctx, req.Id)
+	canonicalized, err := k.renderCanonicalJSONSchema(ctx, req.Id)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "credential schema not found")
+		return nil, err
+	}
+
+	return &types.QueryRenderJsonSchemaResponse{
+		Schema: canonicalized,
+	}, nil
+}
+
+func (k Keeper) RenderCredentialSchema(goCtx context.Context, req *types.QueryRenderCredentialSchemaRequest) (*types.QueryRenderCredentialSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.CredentialSchemaRenderFormat_name[int32(req.Format)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported render format: %d", req.Format))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	canonicalized, err := k.renderCanonicalJSONSchema(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts types.RenderOptions
+	if req.Format != types.CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED &&
+		req.Format != types.CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA {
+		opts, err = k.renderOptions(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	document, mediaType, err := types.RenderCredentialSchemaDocument(canonicalized, req.Format, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to render schema: %v", err))
+	}
+
+	return &types.QueryRenderCredentialSchemaResponse{
+		Document:  document,
+		MediaType: mediaType,
+	}, nil
+}
+
+// renderOptions resolves the ecosystem language and active governance framework
+// document URL of the ecosystem owning a credential schema.
+func (k Keeper) renderOptions(ctx sdk.Context, id uint64) (types.RenderOptions, error) {
+	schema, err := k.CredentialSchema.Get(ctx, id)
+	if err != nil {
+		return types.RenderOptions{}, status.Error(codes.NotFound, "credential schema not found")
+	}
+
+	ecosystem, err := k.ecosystemKeeper.GetEcosystem(ctx, schema.EcosystemId)
+	if err != nil {
+		return types.RenderOptions{}, status.Error(codes.NotFound, fmt.Sprintf("ecosystem %d not found", schema.EcosystemId))
+	}
+
+	opts := types.RenderOptions{Language: ecosystem.Language}
+	versions, err := k.gfKeeper.ListVersionsByEcosystem(ctx, ecosystem.Id, ecosystem.ActiveVersion, true, ecosystem.Language)
+	if err != nil {
+		return types.RenderOptions{}, status.Error(codes.Internal, fmt.Sprintf("failed to list governance framework versions: %v", err))
+	}
+	for _, version := range versions {
+		if len(version.Documents) > 0 {
+			opts.GovernanceFrameworkURL = version.Documents[0].Url
+			break
+		}
+	}
+
+	return opts, nil
+}
+
+func (k Keeper) ValidateCredentialSubject(goCtx context.Context, req *types.QueryValidateCredentialSubjectRequest) (*types.QueryValidateCredentialSubjectResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Payload == "" {
+		return nil, status.Error(codes.InvalidArgument, "payload cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate against the same rendering returned by RenderJsonSchema
+	canonicalized, err := k.renderCanonicalJSONSchema(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	validationErrors, err := types.ValidateCredentialSubject(canonicalized, req.Payload)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryValidateCredentialSubjectResponse{
+		Valid:  len(validationErrors) == 0,
+		Errors: validationErrors,
+	}, nil
+}
+
+// renderCanonicalJSONSchema returns the JSON schema of a credential schema with the
+// canonical $id injected and JCS canonicalization applied.
+func (k Keeper) renderCanonicalJSONSchema(ctx sdk.Context, id uint64) (string, error) {
+	schema, err := k.CredentialSchema.Get(ctx, id)
+	if err != nil {
+		return "", status.Error(codes.NotFound, "credential schema not found")
 	}
 
 	// Ensure canonical $id is present in the JSON schema
 	schemaWithCanonicalID, err := types.EnsureCanonicalID(schema.JsonSchema, ctx.ChainID(), schema.Id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to ensure canonical ID: %v", err))
+		return "", status.Error(codes.Internal, fmt.Sprintf("failed to ensure canonical ID: %v", err))
 	}
 
 	// Apply full JCS canonicalization (RFC 8785): sorted keys, no insignificant whitespace
 	canonicalized, err := types.CanonicalizeJCS(schemaWithCanonicalID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to JCS-canonicalize schema: %v", err))
+		return "", status.Error(codes.Internal, fmt.Sprintf("failed to JCS-canonicalize schema: %v", err))
 	}
 
-	return &types.QueryRenderJsonSchemaResponse{
-		Schema: canonicalized,
-	}, nil
+	return canonicalized, nil
 }
@@ -14,7 +14,8 @@ import (
 )
 
 func TestQueries(t *testing.T) {
-	k, _, ctx := keepertest.CredentialschemaKeeper(t)
+	k, ecosystemKeeper, ctx := keepertest.CredentialschemaKeeper(t)
+	ecosystemKeeper.CreateMockEcosystem(sdk.AccAddress([]byte("test_corporation")).String(), "did:example:ecosystem")
 
 	validJsonSchema := `{
         "$schema": "https://json-schema.org/draft/2020-12/schema",
@@ -205,4 +206,157 @@ func TestQueries(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("ValidateCredentialSubject", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			request     *types.QueryValidateCredentialSubjectRequest
+			expectErr   bool
+			expectValid bool
+		}{
+			{
+				name: "Valid Payload",
+				request: &types.QueryValidateCredentialSubjectRequest{
+					Id:      1,
+					Payload: `{"name": "Alice"}`,
+				},
+				expectValid: true,
+			},
+			{
+				name: "Missing Required Property",
+				request: &types.QueryValidateCredentialSubjectRequest{
+					Id:      1,
+					Payload: `{}`,
+				},
+				expectValid: false,
+			},
+			{
+				name: "Wrong Property Type",
+				request: &types.QueryValidateCredentialSubjectRequest{
+					Id:      1,
+					Payload: `{"name": 42}`,
+				},
+				expectValid: false,
+			},
+			{
+				name: "Malformed Payload",
+				request: &types.QueryValidateCredentialSubjectRequest{
+					Id:      1,
+					Payload: `{"name":`,
+				},
+				expectErr: true,
+			},
+			{
+				name: "Non-existent Schema",
+				request: &types.QueryValidateCredentialSubjectRequest{
+					Id:      999,
+					Payload: `{"name": "Alice"}`,
+				},
+				expectErr: true,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				resp, err := k.ValidateCredentialSubject(sdk.WrapSDKContext(ctx), tc.request)
+				if tc.expectErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.expectValid, resp.Valid)
+				if tc.expectValid {
+					require.Empty(t, resp.Errors)
+				} else {
+					require.NotEmpty(t, resp.Errors)
+				}
+			})
+		}
+	})
+
+	t.Run("RenderCredentialSchema", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			request       *types.QueryRenderCredentialSchemaRequest
+			expectErr     bool
+			expectedMedia string
+			expectedKey   string
+		}{
+			{
+				name:          "Default Format Is JSON Schema",
+				request:       &types.QueryRenderCredentialSchemaRequest{Id: 1},
+				expectedMedia: types.MediaTypeJSONSchema,
+				expectedKey:   "$id",
+			},
+			{
+				name: "VC JSON-LD Context",
+				request: &types.QueryRenderCredentialSchemaRequest{
+					Id:     1,
+					Format: types.CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT,
+				},
+				expectedMedia: types.MediaTypeJSONLD,
+				expectedKey:   "@context",
+			},
+			{
+				name: "SD-JWT VC Type Metadata",
+				request: &types.QueryRenderCredentialSchemaRequest{
+					Id:     1,
+					Format: types.CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA,
+				},
+				expectedMedia: types.MediaTypeSDJWTTypeMetadata,
+				expectedKey:   "vct",
+			},
+			{
+				name: "OCA Bundle",
+				request: &types.QueryRenderCredentialSchemaRequest{
+					Id:     1,
+					Format: types.CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE,
+				},
+				expectedMedia: types.MediaTypeOCABundle,
+				expectedKey:   "capture_base",
+			},
+			{
+				name: "Unknown Format",
+				request: &types.QueryRenderCredentialSchemaRequest{
+					Id:     1,
+					Format: types.CredentialSchemaRenderFormat(99),
+				},
+				expectErr: true,
+			},
+			{
+				name:      "Non-existent Schema",
+				request:   &types.QueryRenderCredentialSchemaRequest{Id: 999},
+				expectErr: true,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				resp, err := k.RenderCredentialSchema(sdk.WrapSDKContext(ctx), tc.request)
+				if tc.expectErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedMedia, resp.MediaType)
+
+				var doc map[string]interface{}
+				require.NoError(t, json.Unmarshal([]byte(resp.Document), &doc))
+				require.Contains(t, doc, tc.expectedKey)
+
+				// Renderings are deterministic
+				again, err := k.RenderCredentialSchema(sdk.WrapSDKContext(ctx), tc.request)
+				require.NoError(t, err)
+				require.Equal(t, resp.Document, again.Document)
+			})
+		}
+
+		// The OCA meta overlay references the ecosystem governance framework
+		resp, err := k.RenderCredentialSchema(sdk.WrapSDKContext(ctx), &types.QueryRenderCredentialSchemaRequest{
+			Id:     1,
+			Format: types.CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE,
+		})
+		require.NoError(t, err)
+		require.Contains(t, resp.Document, keepertest.MockGovernanceFrameworkURL(1))
+	})
 }
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: modulev1.Query_ServiceDesc.ServiceName,
+			Service:              modulev1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -70,6 +71,32 @@ $ veranad query cs schema 1`,
 						},
 					},
 				},
+				{
+					RpcMethod: "RenderCredentialSchema",
+					Use:       "render-credential-schema [id] [format]",
+					Short:     "Render a credential schema in an alternative format",
+					Long: `Render a credential schema as a JSON schema, a VC JSON-LD context, an SD-JWT VC type metadata
+document or an OCA bundle. The document is JCS-canonicalized.
+
+Formats:
+- CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA
+- CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT
+- CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA
+- CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE
+
+Example:
+$ veranad query cs render-credential-schema 1 CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "format"},
+					},
+				},
+				{
+					// Skip autocli for this RPC -- custom command provided in cli_query.go
+					// to read the credential subject from a file and support offline validation.
+					RpcMethod: "ValidateCredentialSubject",
+					Skip:      true,
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
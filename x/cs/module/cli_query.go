@@ -0,0 +1,125 @@
+package credentialschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/verana-labs/verana/x/cs/types"
+)
+
+const flagSchemaFile = "schema-file"
+
+// GetQueryCmd implements the autocli.HasCustomQueryCommand interface.
+// Commands that autocli cannot express, such as reading local files, are added
+// here; the remaining queries are generated by autocli.
+func (am AppModule) GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+	}
+
+	cmd.AddCommand(CmdValidateCredential())
+
+	return cmd
+}
+
+// CmdValidateCredential returns a cobra command validating a credential subject
+// against a credential schema, either on-chain or against a locally exported schema.
+func CmdValidateCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-credential [id] [credential-subject-file]",
+		Short: "Validate a credential subject against a credential schema",
+		Long: `Validate a JSON credential subject against the canonicalized JSON schema of a credential schema.
+
+By default the validation is performed by the ValidateCredentialSubject query. When --schema-file is set,
+the validation is performed offline against a schema previously exported with render-json-schema.
+
+Example:
+$ veranad query cs validate-credential 1 subject.json
+$ veranad query cs render-json-schema 1 -o json | jq -r .schema > schema.json
+$ veranad query cs validate-credential 1 subject.json --schema-file schema.json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+
+			payload, err := os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read credential subject file: %w", err)
+			}
+
+			schemaFile, _ := cmd.Flags().GetString(flagSchemaFile)
+			if schemaFile != "" {
+				clientCtx := client.GetClientContextFromCmd(cmd)
+				res, err := validateCredentialOffline(id, schemaFile, string(payload))
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidateCredentialSubject(cmd.Context(), &types.QueryValidateCredentialSubjectRequest{
+				Id:      id,
+				Payload: string(payload),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSchemaFile, "", "validate offline against a locally exported JSON schema file")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// validateCredentialOffline validates the payload against an exported schema file,
+// applying the same JCS canonicalization as the on-chain query.
+func validateCredentialOffline(id uint64, schemaFile string, payload string) (*types.QueryValidateCredentialSubjectResponse, error) {
+	schemaJSON, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+
+	// Ensure the exported schema is the one the id refers to
+	var doc map[string]interface{}
+	if err := json.Unmarshal(schemaJSON, &doc); err != nil {
+		return nil, fmt.Errorf("invalid schema file: %w", err)
+	}
+	schemaID, _ := doc["$id"].(string)
+	if !strings.HasSuffix(schemaID, fmt.Sprintf("/cs/v1/js/%d", id)) {
+		return nil, fmt.Errorf("schema file $id %q does not match credential schema %d", schemaID, id)
+	}
+
+	canonicalized, err := types.CanonicalizeJCS(string(schemaJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	validationErrors, err := types.ValidateCredentialSubject(canonicalized, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidateCredentialSubjectResponse{
+		Valid:  len(validationErrors) == 0,
+		Errors: validationErrors,
+	}, nil
+}
@@ -185,7 +185,8 @@ func init() {
 //
 // cstypes.EcosystemKeeper is NOT wired here: x/ec keeper.GetEcosystem
 // structurally satisfies cstypes.EcosystemKeeper, so depinject auto-binds
-// the interface to the concrete eckeeper.Keeper provided by ec/module.
+// the interface to the concrete eckeeper.Keeper provided by ec/module. The
+// same applies to cstypes.GovernanceFrameworkKeeper and gfkeeper.Keeper.
 func ProvideCorporationKeeperForCS(co cokeeper.Keeper) types.CorporationKeeper {
 	return keeper.NewCoAsCSCorporationKeeper(co)
 }
@@ -203,6 +204,7 @@ type ModuleInputs struct {
 	EcosystemKeeper   types.EcosystemKeeper
 	CorporationKeeper types.CorporationKeeper
 	DelegationKeeper  types.DelegationKeeper
+	GFKeeper          types.GovernanceFrameworkKeeper
 }
 
 type ModuleOutputs struct {
@@ -227,6 +229,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.EcosystemKeeper,
 		in.CorporationKeeper,
 		in.DelegationKeeper,
+		in.GFKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
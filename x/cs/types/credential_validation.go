@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// credentialSchemaResourceURL is the location the schema is registered under when
+// compiling it. The schema's own $id takes precedence for reference resolution.
+const credentialSchemaResourceURL = "credential-schema.json"
+
+// noExternalRefLoader refuses to load any external resource, so that schemas can
+// only be compiled from the document itself and the bundled meta-schemas.
+type noExternalRefLoader struct{}
+
+func (noExternalRefLoader) Load(url string) (any, error) {
+	return nil, fmt.Errorf("loading external resource %q is not allowed", url)
+}
+
+// ValidateCredentialSubject validates a JSON credential subject against a JSON schema.
+// It returns the list of violations found, which is empty when the payload is valid.
+// An error is only returned when the schema or the payload cannot be parsed or compiled.
+func ValidateCredentialSubject(schemaJSON string, payload string) ([]CredentialValidationError, error) {
+	schemaDoc, err := jsonschema.UnmarshalJSON(strings.NewReader(schemaJSON))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.UseLoader(noExternalRefLoader{})
+	if err := compiler.AddResource(credentialSchemaResourceURL, schemaDoc); err != nil {
+		return nil, fmt.Errorf("failed to load JSON schema: %w", err)
+	}
+	compiled, err := compiler.Compile(credentialSchemaResourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile JSON schema: %w", err)
+	}
+
+	err = compiled.Validate(instance)
+	if err == nil {
+		return []CredentialValidationError{}, nil
+	}
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return nil, fmt.Errorf("failed to validate payload: %w", err)
+	}
+
+	printer := message.NewPrinter(language.English)
+	var result []CredentialValidationError
+	collectValidationErrors(validationErr, printer, &result)
+
+	// Sort for a deterministic response regardless of validator traversal order
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].InstanceLocation != result[j].InstanceLocation {
+			return result[i].InstanceLocation < result[j].InstanceLocation
+		}
+		return result[i].KeywordLocation < result[j].KeywordLocation
+	})
+
+	return result, nil
+}
+
+// collectValidationErrors flattens the leaves of a validation error tree.
+func collectValidationErrors(e *jsonschema.ValidationError, printer *message.Printer, out *[]CredentialValidationError) {
+	if len(e.Causes) > 0 {
+		for _, cause := range e.Causes {
+			collectValidationErrors(cause, printer, out)
+		}
+		return
+	}
+
+	keywordLocation := ""
+	if idx := strings.Index(e.SchemaURL, "#"); idx >= 0 {
+		keywordLocation = e.SchemaURL[idx+1:]
+	}
+	for _, token := range e.ErrorKind.KeywordPath() {
+		keywordLocation += "/" + escapeJSONPointerToken(token)
+	}
+
+	*out = append(*out, CredentialValidationError{
+		InstanceLocation: jsonPointer(e.InstanceLocation),
+		KeywordLocation:  keywordLocation,
+		Message:          e.ErrorKind.LocalizedString(printer),
+	})
+}
+
+func jsonPointer(tokens []string) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		sb.WriteString("/")
+		sb.WriteString(escapeJSONPointerToken(token))
+	}
+	return sb.String()
+}
+
+func escapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
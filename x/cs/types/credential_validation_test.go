@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCredentialSchema = `{
+  "$id": "vpr:verana:verana-1/cs/v1/js/1",
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "Test Schema",
+  "description": "A test",
+  "type": "object",
+  "properties": {
+    "name": {"type": "string"},
+    "address": {
+      "type": "object",
+      "properties": {"zip": {"type": "string", "pattern": "^[0-9]{5}$"}}
+    }
+  },
+  "required": ["name"]
+}`
+
+func TestValidateCredentialSubject(t *testing.T) {
+	errs, err := ValidateCredentialSubject(testCredentialSchema, `{"name": "Alice", "address": {"zip": "75001"}}`)
+	require.NoError(t, err)
+	require.Empty(t, errs)
+
+	errs, err = ValidateCredentialSubject(testCredentialSchema, `{"address": {"zip": "ABC"}}`)
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	// Errors are sorted by instance location
+	require.Equal(t, "", errs[0].InstanceLocation)
+	require.Equal(t, "/required", errs[0].KeywordLocation)
+	require.Equal(t, "/address/zip", errs[1].InstanceLocation)
+	require.Equal(t, "/properties/address/properties/zip/pattern", errs[1].KeywordLocation)
+	require.NotEmpty(t, errs[1].Message)
+}
+
+func TestValidateCredentialSubject_InvalidInput(t *testing.T) {
+	_, err := ValidateCredentialSubject(`{`, `{}`)
+	require.Error(t, err)
+
+	_, err = ValidateCredentialSubject(testCredentialSchema, `not json`)
+	require.Error(t, err)
+}
+
+func TestValidateCredentialSubject_RejectsExternalRefs(t *testing.T) {
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {"name": {"$ref": "file:///etc/passwd"}}
+}`
+	_, err := ValidateCredentialSubject(schema, `{"name": "Alice"}`)
+	require.Error(t, err)
+}
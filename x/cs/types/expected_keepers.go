@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ectypes "github.com/verana-labs/verana/x/ec/types"
+	gftypes "github.com/verana-labs/verana/x/gf/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
@@ -41,6 +42,13 @@ type Ecosystem interface {
 	GetEcosystem(ctx context.Context, id uint64) (ectypes.Ecosystem, error)
 }
 
+// GovernanceFrameworkKeeper is the read surface MOD-CS needs from MOD-GF: the
+// ecosystem's governance framework documents, referenced by the alternative
+// renderings of RenderCredentialSchema. gfkeeper.Keeper satisfies it directly.
+type GovernanceFrameworkKeeper interface {
+	ListVersionsByEcosystem(ctx context.Context, ecosystemID uint64, activeVersion uint32, activeOnly bool, preferredLang string) ([]gftypes.GovernanceFrameworkVersionWithDocs, error)
+}
+
 // CorporationView is the read shape MOD-CS needs about a Corporation subject.
 type CorporationView struct {
 	Id            uint64
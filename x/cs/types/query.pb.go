@@ -430,6 +430,282 @@ func (m *QueryRenderJsonSchemaResponse) GetSchema() string {
 	return ""
 }
 
+type QueryRenderCredentialSchemaRequest struct {
+	Id     uint64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format CredentialSchemaRenderFormat `protobuf:"varint,2,opt,name=format,proto3,enum=verana.cs.v1.CredentialSchemaRenderFormat" json:"format,omitempty"`
+}
+
+func (m *QueryRenderCredentialSchemaRequest) Reset()         { *m = QueryRenderCredentialSchemaRequest{} }
+func (m *QueryRenderCredentialSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRenderCredentialSchemaRequest) ProtoMessage()    {}
+func (*QueryRenderCredentialSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cd94da9c63c70a7, []int{8}
+}
+func (m *QueryRenderCredentialSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRenderCredentialSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRenderCredentialSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRenderCredentialSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRenderCredentialSchemaRequest.Merge(m, src)
+}
+func (m *QueryRenderCredentialSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRenderCredentialSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRenderCredentialSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRenderCredentialSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryRenderCredentialSchemaRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryRenderCredentialSchemaRequest) GetFormat() CredentialSchemaRenderFormat {
+	if m != nil {
+		return m.Format
+	}
+	return CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED
+}
+
+type QueryRenderCredentialSchemaResponse struct {
+	// document is the rendered document, JCS-canonicalized.
+	Document  string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	MediaType string `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+}
+
+func (m *QueryRenderCredentialSchemaResponse) Reset()         { *m = QueryRenderCredentialSchemaResponse{} }
+func (m *QueryRenderCredentialSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRenderCredentialSchemaResponse) ProtoMessage()    {}
+func (*QueryRenderCredentialSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cd94da9c63c70a7, []int{9}
+}
+func (m *QueryRenderCredentialSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRenderCredentialSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRenderCredentialSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRenderCredentialSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRenderCredentialSchemaResponse.Merge(m, src)
+}
+func (m *QueryRenderCredentialSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRenderCredentialSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRenderCredentialSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRenderCredentialSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryRenderCredentialSchemaResponse) GetDocument() string {
+	if m != nil {
+		return m.Document
+	}
+	return ""
+}
+
+func (m *QueryRenderCredentialSchemaResponse) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
+}
+
+type QueryValidateCredentialSubjectRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payload is the JSON encoded credential subject to validate.
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *QueryValidateCredentialSubjectRequest) Reset()         { *m = QueryValidateCredentialSubjectRequest{} }
+func (m *QueryValidateCredentialSubjectRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateCredentialSubjectRequest) ProtoMessage()    {}
+func (*QueryValidateCredentialSubjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cd94da9c63c70a7, []int{10}
+}
+func (m *QueryValidateCredentialSubjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateCredentialSubjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateCredentialSubjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateCredentialSubjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateCredentialSubjectRequest.Merge(m, src)
+}
+func (m *QueryValidateCredentialSubjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateCredentialSubjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateCredentialSubjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateCredentialSubjectRequest proto.InternalMessageInfo
+
+func (m *QueryValidateCredentialSubjectRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryValidateCredentialSubjectRequest) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+type QueryValidateCredentialSubjectResponse struct {
+	Valid  bool                        `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors []CredentialValidationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors"`
+}
+
+func (m *QueryValidateCredentialSubjectResponse) Reset() {
+	*m = QueryValidateCredentialSubjectResponse{}
+}
+func (m *QueryValidateCredentialSubjectResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateCredentialSubjectResponse) ProtoMessage()    {}
+func (*QueryValidateCredentialSubjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cd94da9c63c70a7, []int{11}
+}
+func (m *QueryValidateCredentialSubjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateCredentialSubjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateCredentialSubjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateCredentialSubjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateCredentialSubjectResponse.Merge(m, src)
+}
+func (m *QueryValidateCredentialSubjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateCredentialSubjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateCredentialSubjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateCredentialSubjectResponse proto.InternalMessageInfo
+
+func (m *QueryValidateCredentialSubjectResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryValidateCredentialSubjectResponse) GetErrors() []CredentialValidationError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// CredentialValidationError describes a single JSON schema violation found in
+// a credential subject.
+type CredentialValidationError struct {
+	// instance_location is the JSON pointer of the offending value in the payload.
+	InstanceLocation string `protobuf:"bytes,1,opt,name=instance_location,json=instanceLocation,proto3" json:"instance_location,omitempty"`
+	// keyword_location is the JSON pointer of the failing keyword in the schema.
+	KeywordLocation string `protobuf:"bytes,2,opt,name=keyword_location,json=keywordLocation,proto3" json:"keyword_location,omitempty"`
+	Message         string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *CredentialValidationError) Reset()         { *m = CredentialValidationError{} }
+func (m *CredentialValidationError) String() string { return proto.CompactTextString(m) }
+func (*CredentialValidationError) ProtoMessage()    {}
+func (*CredentialValidationError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cd94da9c63c70a7, []int{12}
+}
+func (m *CredentialValidationError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialValidationError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialValidationError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialValidationError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialValidationError.Merge(m, src)
+}
+func (m *CredentialValidationError) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialValidationError) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialValidationError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialValidationError proto.InternalMessageInfo
+
+func (m *CredentialValidationError) GetInstanceLocation() string {
+	if m != nil {
+		return m.InstanceLocation
+	}
+	return ""
+}
+
+func (m *CredentialValidationError) GetKeywordLocation() string {
+	if m != nil {
+		return m.KeywordLocation
+	}
+	return ""
+}
+
+func (m *CredentialValidationError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "verana.cs.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "verana.cs.v1.QueryParamsResponse")
@@ -439,61 +715,84 @@ func init() {
 	proto.RegisterType((*QueryGetCredentialSchemaResponse)(nil), "verana.cs.v1.QueryGetCredentialSchemaResponse")
 	proto.RegisterType((*QueryRenderJsonSchemaRequest)(nil), "verana.cs.v1.QueryRenderJsonSchemaRequest")
 	proto.RegisterType((*QueryRenderJsonSchemaResponse)(nil), "verana.cs.v1.QueryRenderJsonSchemaResponse")
+	proto.RegisterType((*QueryRenderCredentialSchemaRequest)(nil), "verana.cs.v1.QueryRenderCredentialSchemaRequest")
+	proto.RegisterType((*QueryRenderCredentialSchemaResponse)(nil), "verana.cs.v1.QueryRenderCredentialSchemaResponse")
+	proto.RegisterType((*QueryValidateCredentialSubjectRequest)(nil), "verana.cs.v1.QueryValidateCredentialSubjectRequest")
+	proto.RegisterType((*QueryValidateCredentialSubjectResponse)(nil), "verana.cs.v1.QueryValidateCredentialSubjectResponse")
+	proto.RegisterType((*CredentialValidationError)(nil), "verana.cs.v1.CredentialValidationError")
 }
 
 func init() { proto.RegisterFile("verana/cs/v1/query.proto", fileDescriptor_4cd94da9c63c70a7) }
 
 var fileDescriptor_4cd94da9c63c70a7 = []byte{
-	// 783 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x6e, 0xeb, 0x44,
-	0x14, 0xc6, 0x33, 0x69, 0x6e, 0x6e, 0xee, 0xe4, 0xb6, 0xf7, 0x76, 0x9a, 0x06, 0x63, 0xb5, 0x8e,
-	0x6b, 0xb1, 0x88, 0x82, 0x6a, 0x93, 0xb0, 0xe8, 0x06, 0x21, 0x35, 0x2c, 0xa0, 0x85, 0xf2, 0xc7,
-	0x45, 0x08, 0xb1, 0x20, 0x4c, 0xe2, 0xa9, 0x33, 0x28, 0xf6, 0xa4, 0x9e, 0x49, 0xd4, 0x14, 0xb1,
-	0x61, 0xc9, 0xaa, 0x12, 0x88, 0x67, 0x40, 0xac, 0x78, 0x8c, 0x2e, 0x2b, 0xb1, 0x61, 0x55, 0x50,
-	0x8b, 0xc4, 0x3b, 0xb0, 0x42, 0x1e, 0x8f, 0xab, 0xeb, 0xd4, 0x89, 0xb2, 0x89, 0x9c, 0x73, 0xbe,
-	0xef, 0x9c, 0xdf, 0x78, 0xce, 0x8c, 0xa1, 0x36, 0x25, 0x11, 0x0e, 0xb1, 0x33, 0xe0, 0xce, 0xb4,
-	0xed, 0x9c, 0x4f, 0x48, 0x34, 0xb3, 0xc7, 0x11, 0x13, 0x0c, 0x3d, 0x4f, 0x32, 0xf6, 0x80, 0xdb,
-	0xd3, 0xb6, 0xbe, 0x89, 0x03, 0x1a, 0x32, 0x47, 0xfe, 0x26, 0x02, 0xbd, 0x35, 0x60, 0x3c, 0x60,
-	0xdc, 0xe9, 0x63, 0x4e, 0x12, 0xa7, 0x33, 0x6d, 0xf7, 0x89, 0xc0, 0x6d, 0x67, 0x8c, 0x7d, 0x1a,
-	0x62, 0x41, 0x59, 0xa8, 0xb4, 0x35, 0x9f, 0xf9, 0x4c, 0x3e, 0x3a, 0xf1, 0x93, 0x8a, 0xee, 0xf8,
-	0x8c, 0xf9, 0x23, 0xe2, 0xe0, 0x31, 0x75, 0x70, 0x18, 0x32, 0x21, 0x2d, 0x5c, 0x65, 0x1b, 0x2a,
-	0x2b, 0xff, 0xf5, 0x27, 0x67, 0x8e, 0xa0, 0x01, 0xe1, 0x02, 0x07, 0x63, 0x25, 0x78, 0x3d, 0xc3,
-	0x3e, 0xc6, 0x11, 0x0e, 0x52, 0x6f, 0x76, 0x59, 0x62, 0x36, 0x26, 0x2a, 0x63, 0xd5, 0x20, 0xfa,
-	0x2c, 0x66, 0xfd, 0x54, 0xca, 0x5d, 0x72, 0x3e, 0x21, 0x5c, 0x58, 0x1f, 0xc3, 0xad, 0x4c, 0x94,
-	0x8f, 0x59, 0xc8, 0x09, 0x3a, 0x80, 0xe5, 0xa4, 0xac, 0x06, 0x4c, 0xd0, 0xac, 0x76, 0x6a, 0xf6,
-	0xab, 0x2f, 0xc5, 0x4e, 0xd4, 0xdd, 0x67, 0xd7, 0xb7, 0x8d, 0xc2, 0xaf, 0xff, 0xfe, 0xde, 0x02,
-	0xae, 0x92, 0x5b, 0xff, 0xad, 0xc1, 0x3d, 0x59, 0xf0, 0x23, 0xca, 0xc5, 0x7b, 0x11, 0xf1, 0x48,
-	0x28, 0x28, 0x1e, 0x9d, 0x0e, 0x86, 0x24, 0xc0, 0x69, 0x57, 0xf4, 0x21, 0xdc, 0x08, 0x98, 0x47,
-	0xcf, 0x28, 0xf1, 0x7a, 0xf8, 0x4c, 0x90, 0x48, 0x2b, 0xca, 0x36, 0xba, 0x9d, 0x2c, 0xdd, 0x4e,
-	0x97, 0x6e, 0x7f, 0x9e, 0x2e, 0xbd, 0x5b, 0xb9, 0xbe, 0x6d, 0x80, 0xab, 0xbf, 0x1a, 0xc0, 0x5d,
-	0x4f, 0xbd, 0x87, 0xb1, 0x15, 0xb5, 0xe0, 0x66, 0xa4, 0xb8, 0x7b, 0x01, 0xbe, 0xe8, 0x71, 0x7a,
-	0x49, 0xb4, 0x35, 0x13, 0x34, 0xd7, 0xdd, 0x17, 0x69, 0xe2, 0x04, 0x5f, 0x9c, 0xd2, 0x4b, 0x82,
-	0x1a, 0xb0, 0xca, 0xc2, 0xd1, 0xac, 0x87, 0x07, 0x82, 0x4e, 0x89, 0x56, 0x32, 0x41, 0xb3, 0xe2,
-	0xc2, 0x38, 0x74, 0x28, 0x23, 0xe8, 0x4b, 0x58, 0xa7, 0x9c, 0x4f, 0x48, 0xd4, 0x63, 0x61, 0x9f,
-	0xe1, 0xc8, 0xa3, 0xa1, 0xdf, 0x0b, 0x98, 0x47, 0xb4, 0x27, 0x26, 0x68, 0x6e, 0x74, 0xac, 0xec,
-	0x8b, 0x38, 0x92, 0xda, 0x4f, 0x1e, 0xa4, 0x27, 0xcc, 0x23, 0x6e, 0x8d, 0xe6, 0x44, 0xd1, 0xd7,
-	0x72, 0xe4, 0x62, 0xee, 0xc7, 0xb5, 0xcb, 0xb2, 0xf6, 0x1b, 0xd9, 0xda, 0x5f, 0x28, 0xf5, 0x5c,
-	0xf5, 0xfa, 0x34, 0x37, 0x1e, 0x93, 0x0f, 0xd9, 0xc8, 0xcb, 0xa9, 0xfe, 0x34, 0x8f, 0xfc, 0x03,
-	0xa9, 0x9d, 0x27, 0x1f, 0xe6, 0x44, 0xd1, 0x1e, 0x7c, 0x4e, 0x06, 0x8c, 0xcf, 0xb8, 0x20, 0x41,
-	0x8f, 0x7a, 0x5a, 0xc5, 0x04, 0xcd, 0x92, 0x5b, 0x7d, 0x88, 0x1d, 0x79, 0xc7, 0xa5, 0x0a, 0x78,
-	0x59, 0xb4, 0x3c, 0x68, 0x2d, 0xdb, 0x7b, 0x35, 0x5b, 0xef, 0xc2, 0xa7, 0x3c, 0x09, 0x69, 0xc0,
-	0x5c, 0x6b, 0x56, 0x3b, 0x46, 0x96, 0x6c, 0xde, 0xd9, 0x2d, 0xc5, 0x63, 0xe6, 0xa6, 0x26, 0xab,
-	0x0d, 0x1b, 0xb2, 0xcb, 0xfb, 0xe4, 0x51, 0x93, 0x74, 0xbe, 0x36, 0x60, 0x91, 0x7a, 0x72, 0x74,
-	0x4b, 0x6e, 0x91, 0x7a, 0xd6, 0x37, 0xd0, 0x5c, 0x6c, 0x51, 0x58, 0xef, 0xc0, 0x72, 0xd2, 0x41,
-	0x8d, 0xfc, 0x6a, 0x54, 0xca, 0x63, 0xd9, 0x70, 0x47, 0x76, 0x70, 0x49, 0xe8, 0x91, 0xe8, 0x98,
-	0xb3, 0x70, 0x39, 0xd1, 0x01, 0xdc, 0x5d, 0xa0, 0x57, 0x38, 0xf5, 0x0c, 0xce, 0xb3, 0xb4, 0x51,
-	0xe7, 0xb7, 0x12, 0x7c, 0x22, 0x9d, 0xc8, 0x83, 0xe5, 0xe4, 0x1c, 0x22, 0x33, 0x8b, 0xfa, 0xf8,
-	0x98, 0xeb, 0x7b, 0x4b, 0x14, 0x49, 0x43, 0x6b, 0xfb, 0x87, 0x3f, 0xfe, 0xf9, 0xa9, 0xf8, 0x02,
-	0xad, 0x67, 0xae, 0x15, 0xf4, 0x0b, 0x80, 0xdb, 0xb9, 0xfb, 0x89, 0x9c, 0x9c, 0x9a, 0xcb, 0x4e,
-	0xbd, 0xfe, 0xd6, 0xea, 0x06, 0xc5, 0xa4, 0x4b, 0xa6, 0x1a, 0x42, 0x4e, 0xe6, 0x5a, 0x1b, 0x51,
-	0x2e, 0xd0, 0xcf, 0x00, 0x6e, 0xe5, 0xec, 0x27, 0xda, 0xcf, 0xe9, 0xb2, 0x78, 0x54, 0x74, 0x7b,
-	0x55, 0xb9, 0x42, 0x32, 0x24, 0x92, 0x86, 0xea, 0x59, 0x24, 0x9f, 0x08, 0xe7, 0x3b, 0xea, 0x7d,
-	0x8f, 0x7e, 0x04, 0xf0, 0xe5, 0xfc, 0xa6, 0xa2, 0x56, 0x4e, 0x93, 0x05, 0x93, 0xa2, 0xbf, 0xb9,
-	0x92, 0x56, 0xd1, 0xec, 0x4a, 0x9a, 0xd7, 0xd0, 0x76, 0x96, 0xe6, 0x5b, 0x2e, 0x61, 0xba, 0xdd,
-	0xeb, 0x3b, 0x03, 0xdc, 0xdc, 0x19, 0xe0, 0xef, 0x3b, 0x03, 0x5c, 0xdd, 0x1b, 0x85, 0x9b, 0x7b,
-	0xa3, 0xf0, 0xe7, 0xbd, 0x51, 0xf8, 0xaa, 0xe9, 0x53, 0x31, 0x9c, 0xf4, 0xed, 0x01, 0x0b, 0x94,
-	0x75, 0x7f, 0x84, 0xfb, 0x3c, 0x2d, 0x73, 0x11, 0x17, 0x92, 0x5f, 0x8f, 0x7e, 0x59, 0xde, 0xc5,
-	0x6f, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x75, 0xf9, 0x18, 0x84, 0x31, 0x07, 0x00, 0x00,
+	// 1060 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x6e, 0xe2, 0x38, 0x2f, 0xcd, 0xaf, 0xa9, 0x13, 0x36, 0x6e, 0x63, 0x3b, 0x0b,
+	0x14, 0xe3, 0xaa, 0xbb, 0x75, 0x8a, 0x54, 0x09, 0x21, 0xa4, 0x1a, 0x15, 0x68, 0x69, 0x81, 0x6e,
+	0xab, 0x0a, 0x71, 0xc0, 0x1d, 0xef, 0x4e, 0x9c, 0x29, 0xde, 0x1d, 0x77, 0x67, 0x6c, 0xe2, 0x22,
+	0x2e, 0x48, 0x5c, 0x90, 0x90, 0x2a, 0x81, 0x38, 0x73, 0xe4, 0x84, 0xf8, 0x33, 0x72, 0xac, 0xc4,
+	0x85, 0x53, 0x41, 0x09, 0x12, 0xff, 0x03, 0x07, 0x84, 0x76, 0x76, 0x36, 0x74, 0x93, 0x5d, 0x37,
+	0xbd, 0x44, 0x9e, 0x37, 0xdf, 0xef, 0x7b, 0x9f, 0x79, 0x99, 0x7d, 0xbb, 0x60, 0x8c, 0x68, 0x48,
+	0x02, 0x62, 0xbb, 0xc2, 0x1e, 0xb5, 0xec, 0x87, 0x43, 0x1a, 0x8e, 0xad, 0x41, 0xc8, 0x25, 0xc7,
+	0xa7, 0xe3, 0x1d, 0xcb, 0x15, 0xd6, 0xa8, 0x55, 0x59, 0x21, 0x3e, 0x0b, 0xb8, 0xad, 0xfe, 0xc6,
+	0x82, 0x4a, 0xd3, 0xe5, 0xc2, 0xe7, 0xc2, 0xee, 0x12, 0x41, 0x63, 0xa7, 0x3d, 0x6a, 0x75, 0xa9,
+	0x24, 0x2d, 0x7b, 0x40, 0x7a, 0x2c, 0x20, 0x92, 0xf1, 0x40, 0x6b, 0xcb, 0x3d, 0xde, 0xe3, 0xea,
+	0xa7, 0x1d, 0xfd, 0xd2, 0xd1, 0x73, 0x3d, 0xce, 0x7b, 0x7d, 0x6a, 0x93, 0x01, 0xb3, 0x49, 0x10,
+	0x70, 0xa9, 0x2c, 0x42, 0xef, 0xd6, 0xf4, 0xae, 0x5a, 0x75, 0x87, 0xdb, 0xb6, 0x64, 0x3e, 0x15,
+	0x92, 0xf8, 0x03, 0x2d, 0x58, 0x4f, 0xb1, 0x0f, 0x48, 0x48, 0xfc, 0xc4, 0x9b, 0x3e, 0x96, 0x1c,
+	0x0f, 0xa8, 0xde, 0x31, 0xcb, 0x80, 0x6f, 0x47, 0xac, 0x1f, 0x2b, 0xb9, 0x43, 0x1f, 0x0e, 0xa9,
+	0x90, 0xe6, 0x87, 0x70, 0x26, 0x15, 0x15, 0x03, 0x1e, 0x08, 0x8a, 0xaf, 0x40, 0x31, 0x4e, 0x6b,
+	0xa0, 0x3a, 0x6a, 0xcc, 0x6f, 0x95, 0xad, 0x67, 0x9b, 0x62, 0xc5, 0xea, 0xf6, 0xdc, 0xde, 0xd3,
+	0xda, 0xd4, 0xcf, 0x7f, 0xff, 0xda, 0x44, 0x8e, 0x96, 0x9b, 0xff, 0x9c, 0x82, 0x4d, 0x95, 0xf0,
+	0x26, 0x13, 0xf2, 0x9d, 0x90, 0x7a, 0x34, 0x90, 0x8c, 0xf4, 0xef, 0xb8, 0x3b, 0xd4, 0x27, 0x49,
+	0x55, 0xfc, 0x01, 0x2c, 0xfa, 0xdc, 0x63, 0xdb, 0x8c, 0x7a, 0x1d, 0xb2, 0x2d, 0x69, 0x68, 0x14,
+	0x54, 0x99, 0x8a, 0x15, 0x1f, 0xdd, 0x4a, 0x8e, 0x6e, 0xdd, 0x4d, 0x8e, 0xde, 0x2e, 0xed, 0x3d,
+	0xad, 0xa1, 0xc7, 0x7f, 0xd4, 0x90, 0xb3, 0x90, 0x78, 0xaf, 0x46, 0x56, 0xdc, 0x84, 0x95, 0x50,
+	0x73, 0x77, 0x7c, 0xb2, 0xdb, 0x11, 0xec, 0x11, 0x35, 0x4e, 0xd5, 0x51, 0x63, 0xc1, 0x59, 0x4a,
+	0x36, 0x6e, 0x91, 0xdd, 0x3b, 0xec, 0x11, 0xc5, 0x35, 0x98, 0xe7, 0x41, 0x7f, 0xdc, 0x21, 0xae,
+	0x64, 0x23, 0x6a, 0x4c, 0xd7, 0x51, 0xa3, 0xe4, 0x40, 0x14, 0xba, 0xaa, 0x22, 0xf8, 0x13, 0x58,
+	0x63, 0x42, 0x0c, 0x69, 0xd8, 0xe1, 0x41, 0x97, 0x93, 0xd0, 0x63, 0x41, 0xaf, 0xe3, 0x73, 0x8f,
+	0x1a, 0x33, 0x75, 0xd4, 0x58, 0xdc, 0x32, 0xd3, 0x8d, 0xb8, 0xae, 0xb4, 0x1f, 0x1d, 0x4a, 0x6f,
+	0x71, 0x8f, 0x3a, 0x65, 0x96, 0x11, 0xc5, 0x9f, 0xa9, 0x2b, 0x17, 0x71, 0x1f, 0xcf, 0x5d, 0x54,
+	0xb9, 0x5f, 0x49, 0xe7, 0xbe, 0xa7, 0xd5, 0x47, 0xb2, 0xaf, 0x8d, 0x32, 0xe3, 0x11, 0xf9, 0x0e,
+	0xef, 0x7b, 0x19, 0xd9, 0x67, 0xb3, 0xc8, 0xdf, 0x57, 0xda, 0xa3, 0xe4, 0x3b, 0x19, 0x51, 0xbc,
+	0x09, 0xa7, 0xa9, 0xcb, 0xc5, 0x58, 0x48, 0xea, 0x77, 0x98, 0x67, 0x94, 0xea, 0xa8, 0x31, 0xed,
+	0xcc, 0x1f, 0xc6, 0xae, 0x7b, 0x37, 0xa6, 0x4b, 0x68, 0xb9, 0x60, 0x7a, 0x60, 0x4e, 0xfa, 0xdf,
+	0xeb, 0xbb, 0xf5, 0x36, 0xcc, 0x8a, 0x38, 0x64, 0xa0, 0xfa, 0xa9, 0xc6, 0xfc, 0x56, 0x35, 0x4d,
+	0x76, 0xd4, 0xd9, 0x9e, 0x8e, 0xae, 0x99, 0x93, 0x98, 0xcc, 0x16, 0xd4, 0x54, 0x95, 0xf7, 0xe8,
+	0xb1, 0x22, 0xc9, 0xfd, 0x5a, 0x84, 0x02, 0xf3, 0xd4, 0xd5, 0x9d, 0x76, 0x0a, 0xcc, 0x33, 0xef,
+	0x43, 0x3d, 0xdf, 0xa2, 0xb1, 0xde, 0x82, 0x62, 0x5c, 0x41, 0x5f, 0xf9, 0x93, 0x51, 0x69, 0x8f,
+	0x69, 0xc1, 0x39, 0x55, 0xc1, 0xa1, 0x81, 0x47, 0xc3, 0x1b, 0x82, 0x07, 0x93, 0x89, 0xae, 0xc0,
+	0x46, 0x8e, 0x5e, 0xe3, 0xac, 0xa5, 0x70, 0xe6, 0x0e, 0x0b, 0xed, 0xea, 0x1e, 0xc7, 0xc6, 0x13,
+	0x36, 0x00, 0xb7, 0xa1, 0xb8, 0xcd, 0x43, 0x9f, 0x48, 0xf5, 0xa0, 0x2d, 0x6e, 0x35, 0x27, 0x1f,
+	0x2e, 0x4e, 0xfe, 0xae, 0x72, 0x38, 0xda, 0x69, 0xde, 0x87, 0x97, 0x27, 0x56, 0xd6, 0xe0, 0x15,
+	0x28, 0x79, 0xdc, 0x1d, 0xfa, 0x34, 0x90, 0x1a, 0xfd, 0x70, 0x8d, 0x37, 0x00, 0x7c, 0xea, 0x31,
+	0xd2, 0x89, 0x06, 0x93, 0x42, 0x99, 0x73, 0xe6, 0x54, 0xe4, 0xee, 0x78, 0x40, 0xcd, 0xdb, 0xf0,
+	0xaa, 0xaa, 0x70, 0x8f, 0xf4, 0x99, 0x47, 0x24, 0x7d, 0xa6, 0xc6, 0xb0, 0xfb, 0x80, 0xba, 0x32,
+	0xef, 0x78, 0x06, 0xcc, 0x0e, 0xc8, 0xb8, 0xcf, 0x89, 0xa7, 0x93, 0x26, 0x4b, 0xf3, 0x1b, 0x04,
+	0xe7, 0x9f, 0x97, 0x53, 0x83, 0x97, 0x61, 0x66, 0x14, 0x89, 0x54, 0xde, 0x92, 0x13, 0x2f, 0xf0,
+	0x35, 0x28, 0xd2, 0x30, 0xe4, 0xa1, 0x30, 0x0a, 0xea, 0xb2, 0xbe, 0x96, 0xd7, 0x39, 0x5d, 0x80,
+	0xf1, 0xe0, 0x5a, 0xa4, 0x4f, 0xee, 0x47, 0x6c, 0x36, 0xbf, 0x43, 0xb0, 0x9e, 0xab, 0xc5, 0x17,
+	0x60, 0x85, 0x05, 0x42, 0x92, 0xc0, 0xa5, 0x9d, 0x3e, 0x77, 0xd5, 0x8e, 0x6e, 0xde, 0x72, 0xb2,
+	0x71, 0x53, 0xc7, 0xf1, 0xeb, 0xb0, 0xfc, 0x39, 0x1d, 0x7f, 0xc1, 0x43, 0xef, 0x7f, 0x6d, 0x7c,
+	0xea, 0x25, 0x1d, 0x3f, 0x94, 0x1a, 0x30, 0xeb, 0x53, 0x21, 0x48, 0x2f, 0x1e, 0x88, 0x73, 0x4e,
+	0xb2, 0xdc, 0xfa, 0xb7, 0x08, 0x33, 0xaa, 0x2f, 0xd8, 0x83, 0x62, 0x3c, 0xce, 0x71, 0x3d, 0x7d,
+	0xb4, 0xe3, 0x6f, 0x8b, 0xca, 0xe6, 0x04, 0x45, 0xdc, 0x45, 0x73, 0xf5, 0xeb, 0xdf, 0xfe, 0xfa,
+	0xbe, 0xb0, 0x84, 0x17, 0x52, 0x6f, 0x27, 0xfc, 0x23, 0x82, 0xd5, 0xcc, 0xb1, 0x80, 0xed, 0x8c,
+	0x9c, 0x93, 0x5e, 0x1e, 0x95, 0x4b, 0x27, 0x37, 0x68, 0xa6, 0x8a, 0x62, 0x2a, 0x63, 0x6c, 0xa7,
+	0xde, 0x8e, 0x7d, 0x26, 0x24, 0xfe, 0x01, 0xc1, 0x99, 0x8c, 0xb1, 0x80, 0x2f, 0x66, 0x54, 0xc9,
+	0x9f, 0x38, 0x15, 0xeb, 0xa4, 0x72, 0x8d, 0x54, 0x55, 0x48, 0x06, 0x5e, 0x4b, 0x23, 0xf5, 0xa8,
+	0xb4, 0xbf, 0x64, 0xde, 0x57, 0xf8, 0x5b, 0x04, 0xcb, 0x47, 0x67, 0x03, 0x6e, 0x66, 0x14, 0xc9,
+	0x19, 0x38, 0x95, 0x0b, 0x27, 0xd2, 0x6a, 0x9a, 0x0d, 0x45, 0xf3, 0x12, 0x5e, 0x4d, 0xd3, 0x3c,
+	0x10, 0x31, 0xcc, 0x4f, 0x08, 0xd6, 0xb2, 0x9f, 0x7a, 0x7c, 0x29, 0xb7, 0x4c, 0x5e, 0xa7, 0x5a,
+	0x2f, 0xe0, 0xd0, 0x78, 0x9b, 0x0a, 0xef, 0x2c, 0x5e, 0x4f, 0xe3, 0x85, 0xca, 0x15, 0x23, 0xfe,
+	0x82, 0x60, 0x3d, 0xf7, 0x11, 0xc7, 0x97, 0x33, 0x6a, 0x3e, 0x6f, 0xc8, 0x54, 0xde, 0x78, 0x31,
+	0x93, 0x66, 0x3d, 0xaf, 0x58, 0xeb, 0x6f, 0xa2, 0xa6, 0x79, 0x36, 0x8d, 0x3b, 0xd2, 0x5e, 0x05,
+	0xdc, 0x6e, 0xef, 0xed, 0x57, 0xd1, 0x93, 0xfd, 0x2a, 0xfa, 0x73, 0xbf, 0x8a, 0x1e, 0x1f, 0x54,
+	0xa7, 0x9e, 0x1c, 0x54, 0xa7, 0x7e, 0x3f, 0xa8, 0x4e, 0x7d, 0xda, 0xe8, 0x31, 0xb9, 0x33, 0xec,
+	0x5a, 0x2e, 0xf7, 0x75, 0x82, 0x8b, 0x7d, 0xd2, 0x15, 0x49, 0xb2, 0xdd, 0x28, 0x9d, 0xfa, 0xb0,
+	0xeb, 0x16, 0xd5, 0x67, 0xd2, 0xe5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xab, 0x12, 0xbb, 0x2d,
+	0xcc, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -516,6 +815,11 @@ type QueryClient interface {
 	GetCredentialSchema(ctx context.Context, in *QueryGetCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryGetCredentialSchemaResponse, error)
 	// RenderJsonSchema returns the JSON schema definition
 	RenderJsonSchema(ctx context.Context, in *QueryRenderJsonSchemaRequest, opts ...grpc.CallOption) (*QueryRenderJsonSchemaResponse, error)
+	// RenderCredentialSchema renders a credential schema in the requested format
+	RenderCredentialSchema(ctx context.Context, in *QueryRenderCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryRenderCredentialSchemaResponse, error)
+	// ValidateCredentialSubject validates a JSON credential subject against the
+	// canonicalized JSON schema of a credential schema
+	ValidateCredentialSubject(ctx context.Context, in *QueryValidateCredentialSubjectRequest, opts ...grpc.CallOption) (*QueryValidateCredentialSubjectResponse, error)
 }
 
 type queryClient struct {
@@ -562,6 +866,24 @@ func (c *queryClient) RenderJsonSchema(ctx context.Context, in *QueryRenderJsonS
 	return out, nil
 }
 
+func (c *queryClient) RenderCredentialSchema(ctx context.Context, in *QueryRenderCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryRenderCredentialSchemaResponse, error) {
+	out := new(QueryRenderCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, "/verana.cs.v1.Query/RenderCredentialSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateCredentialSubject(ctx context.Context, in *QueryValidateCredentialSubjectRequest, opts ...grpc.CallOption) (*QueryValidateCredentialSubjectResponse, error) {
+	out := new(QueryValidateCredentialSubjectResponse)
+	err := c.cc.Invoke(ctx, "/verana.cs.v1.Query/ValidateCredentialSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -572,6 +894,11 @@ type QueryServer interface {
 	GetCredentialSchema(context.Context, *QueryGetCredentialSchemaRequest) (*QueryGetCredentialSchemaResponse, error)
 	// RenderJsonSchema returns the JSON schema definition
 	RenderJsonSchema(context.Context, *QueryRenderJsonSchemaRequest) (*QueryRenderJsonSchemaResponse, error)
+	// RenderCredentialSchema renders a credential schema in the requested format
+	RenderCredentialSchema(context.Context, *QueryRenderCredentialSchemaRequest) (*QueryRenderCredentialSchemaResponse, error)
+	// ValidateCredentialSubject validates a JSON credential subject against the
+	// canonicalized JSON schema of a credential schema
+	ValidateCredentialSubject(context.Context, *QueryValidateCredentialSubjectRequest) (*QueryValidateCredentialSubjectResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -590,6 +917,12 @@ func (*UnimplementedQueryServer) GetCredentialSchema(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) RenderJsonSchema(ctx context.Context, req *QueryRenderJsonSchemaRequest) (*QueryRenderJsonSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenderJsonSchema not implemented")
 }
+func (*UnimplementedQueryServer) RenderCredentialSchema(ctx context.Context, req *QueryRenderCredentialSchemaRequest) (*QueryRenderCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderCredentialSchema not implemented")
+}
+func (*UnimplementedQueryServer) ValidateCredentialSubject(ctx context.Context, req *QueryValidateCredentialSubjectRequest) (*QueryValidateCredentialSubjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCredentialSubject not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -667,6 +1000,42 @@ func _Query_RenderJsonSchema_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RenderCredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRenderCredentialSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RenderCredentialSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.cs.v1.Query/RenderCredentialSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RenderCredentialSchema(ctx, req.(*QueryRenderCredentialSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateCredentialSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateCredentialSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateCredentialSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.cs.v1.Query/ValidateCredentialSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateCredentialSubject(ctx, req.(*QueryValidateCredentialSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "verana.cs.v1.Query",
@@ -688,6 +1057,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RenderJsonSchema",
 			Handler:    _Query_RenderJsonSchema_Handler,
 		},
+		{
+			MethodName: "RenderCredentialSchema",
+			Handler:    _Query_RenderCredentialSchema_Handler,
+		},
+		{
+			MethodName: "ValidateCredentialSubject",
+			Handler:    _Query_ValidateCredentialSubject_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/cs/v1/query.proto",
@@ -973,35 +1350,231 @@ func (m *QueryRenderJsonSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRenderCredentialSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryRenderCredentialSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRenderCredentialSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Format != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRenderCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryRenderCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRenderCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MediaType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Document) > 0 {
+		i -= len(m.Document)
+		copy(dAtA[i:], m.Document)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Document)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateCredentialSubjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateCredentialSubjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateCredentialSubjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateCredentialSubjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateCredentialSubjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateCredentialSubjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialValidationError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialValidationError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialValidationError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeywordLocation) > 0 {
+		i -= len(m.KeywordLocation)
+		copy(dAtA[i:], m.KeywordLocation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeywordLocation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InstanceLocation) > 0 {
+		i -= len(m.InstanceLocation)
+		copy(dAtA[i:], m.InstanceLocation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InstanceLocation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryListCredentialSchemasRequest) Size() (n int) {
@@ -1098,6 +1671,93 @@ func (m *QueryRenderJsonSchemaResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRenderCredentialSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Format != 0 {
+		n += 1 + sovQuery(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *QueryRenderCredentialSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Document)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCredentialSubjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCredentialSubjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CredentialValidationError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InstanceLocation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeywordLocation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1825,6 +2485,559 @@ func (m *QueryRenderJsonSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRenderCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRenderCredentialSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRenderCredentialSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= CredentialSchemaRenderFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRenderCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRenderCredentialSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRenderCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Document = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateCredentialSubjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateCredentialSubjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateCredentialSubjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateCredentialSubjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateCredentialSubjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateCredentialSubjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, CredentialValidationError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialValidationError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialValidationError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialValidationError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeywordLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeywordLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
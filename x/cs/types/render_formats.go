@@ -0,0 +1,389 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Media types returned alongside each rendering.
+const (
+	MediaTypeJSONSchema        = "application/schema+json"
+	MediaTypeJSONLD            = "application/ld+json"
+	MediaTypeSDJWTTypeMetadata = "application/json"
+	MediaTypeOCABundle         = "application/json"
+)
+
+// RenderOptions carries the ecosystem-level data used by the alternative renderings.
+type RenderOptions struct {
+	// Language is the ecosystem language, used for display and label overlays.
+	Language string
+	// GovernanceFrameworkURL is the URL of the ecosystem's active governance
+	// framework document. Optional.
+	GovernanceFrameworkURL string
+}
+
+// renderSchema is the subset of the credential JSON schema used by the renderers.
+type renderSchema struct {
+	ID          string
+	Title       string
+	Description string
+	Properties  map[string]map[string]interface{}
+	Required    map[string]bool
+	Raw         map[string]interface{}
+}
+
+// RenderCredentialSchemaDocument renders a canonicalized credential JSON schema
+// (as returned by RenderJsonSchema) in the requested format. The output is
+// JCS-canonicalized so that renderings are deterministic.
+func RenderCredentialSchemaDocument(canonicalSchema string, format CredentialSchemaRenderFormat, opts RenderOptions) (document string, mediaType string, err error) {
+	schema, err := parseRenderSchema(canonicalSchema)
+	if err != nil {
+		return "", "", err
+	}
+
+	var doc interface{}
+	switch format {
+	case CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED,
+		CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA:
+		return canonicalSchema, MediaTypeJSONSchema, nil
+	case CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT:
+		doc, mediaType = renderJSONLDContext(schema), MediaTypeJSONLD
+	case CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA:
+		doc, mediaType = renderSDJWTTypeMetadata(schema, opts), MediaTypeSDJWTTypeMetadata
+	case CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE:
+		doc, err = renderOCABundle(schema, opts)
+		if err != nil {
+			return "", "", err
+		}
+		mediaType = MediaTypeOCABundle
+	default:
+		return "", "", fmt.Errorf("unsupported render format: %s", format)
+	}
+
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal rendered document: %w", err)
+	}
+	document, err = CanonicalizeJCS(string(bz))
+	if err != nil {
+		return "", "", err
+	}
+	return document, mediaType, nil
+}
+
+func parseRenderSchema(schemaJSON string) (renderSchema, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(schemaJSON), &raw); err != nil {
+		return renderSchema{}, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+
+	s := renderSchema{
+		Properties: map[string]map[string]interface{}{},
+		Required:   map[string]bool{},
+		Raw:        raw,
+	}
+	s.ID, _ = raw["$id"].(string)
+	s.Title, _ = raw["title"].(string)
+	s.Description, _ = raw["description"].(string)
+	if s.ID == "" {
+		return renderSchema{}, fmt.Errorf("JSON schema has no $id")
+	}
+
+	if props, ok := raw["properties"].(map[string]interface{}); ok {
+		for name, v := range props {
+			prop, _ := v.(map[string]interface{})
+			if prop == nil {
+				prop = map[string]interface{}{}
+			}
+			s.Properties[name] = prop
+		}
+	}
+	if required, ok := raw["required"].([]interface{}); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				s.Required[name] = true
+			}
+		}
+	}
+	return s, nil
+}
+
+// sortedPropertyNames returns property names in a deterministic order.
+func (s renderSchema) sortedPropertyNames() []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// typeName derives a JSON-LD term for the credential type from the schema title.
+func (s renderSchema) typeName() string {
+	var sb strings.Builder
+	upperNext := true
+	for _, r := range s.Title {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperNext = true
+			continue
+		}
+		if upperNext {
+			r = unicode.ToUpper(r)
+			upperNext = false
+		}
+		sb.WriteRune(r)
+	}
+	if sb.Len() == 0 {
+		return "Credential"
+	}
+	return sb.String()
+}
+
+// renderJSONLDContext builds a protected VC JSON-LD @context defining the
+// credential type and one term per schema property, all scoped under the schema $id.
+func renderJSONLDContext(s renderSchema) map[string]interface{} {
+	propertyTerms := map[string]interface{}{
+		"@protected": true,
+	}
+	for _, name := range s.sortedPropertyNames() {
+		term := map[string]interface{}{
+			"@id": s.ID + "#" + name,
+		}
+		if jsonLDType := jsonLDTypeFor(s.Properties[name]); jsonLDType != "" {
+			term["@type"] = jsonLDType
+		}
+		propertyTerms[name] = term
+	}
+
+	typeName := s.typeName()
+	return map[string]interface{}{
+		"@context": map[string]interface{}{
+			"@version":   1.1,
+			"@protected": true,
+			typeName: map[string]interface{}{
+				"@id":      s.ID + "#" + typeName,
+				"@context": propertyTerms,
+			},
+		},
+	}
+}
+
+func jsonLDTypeFor(prop map[string]interface{}) string {
+	t, _ := prop["type"].(string)
+	format, _ := prop["format"].(string)
+	switch t {
+	case "string":
+		switch format {
+		case "date-time":
+			return "http://www.w3.org/2001/XMLSchema#dateTime"
+		case "date":
+			return "http://www.w3.org/2001/XMLSchema#date"
+		case "uri", "iri":
+			return "@id"
+		}
+	case "integer":
+		return "http://www.w3.org/2001/XMLSchema#integer"
+	case "number":
+		return "http://www.w3.org/2001/XMLSchema#decimal"
+	case "boolean":
+		return "http://www.w3.org/2001/XMLSchema#boolean"
+	case "object", "array":
+		return "@json"
+	}
+	return ""
+}
+
+// renderSDJWTTypeMetadata builds an SD-JWT VC type metadata document whose vct is
+// the canonical schema $id, embedding the JSON schema and per-claim display data.
+func renderSDJWTTypeMetadata(s renderSchema, opts RenderOptions) map[string]interface{} {
+	claims := make([]interface{}, 0, len(s.Properties))
+	for _, name := range s.sortedPropertyNames() {
+		prop := s.Properties[name]
+		display := map[string]interface{}{
+			"lang":  opts.Language,
+			"label": propertyLabel(name, prop),
+		}
+		if description, ok := prop["description"].(string); ok && description != "" {
+			display["description"] = description
+		}
+		claims = append(claims, map[string]interface{}{
+			"path":    []interface{}{name},
+			"display": []interface{}{display},
+			"sd":      "allowed",
+		})
+	}
+
+	display := map[string]interface{}{
+		"lang": opts.Language,
+		"name": s.Title,
+	}
+	if s.Description != "" {
+		display["description"] = s.Description
+	}
+
+	doc := map[string]interface{}{
+		"vct":     s.ID,
+		"name":    s.Title,
+		"display": []interface{}{display},
+		"claims":  claims,
+		"schema":  s.Raw,
+	}
+	if s.Description != "" {
+		doc["description"] = s.Description
+	}
+	return doc
+}
+
+func propertyLabel(name string, prop map[string]interface{}) string {
+	if title, ok := prop["title"].(string); ok && title != "" {
+		return title
+	}
+	return name
+}
+
+// OCA 1.0 object types.
+const (
+	ocaCaptureBaseType         = "spec/capture_base/1.0"
+	ocaMetaOverlayType         = "spec/overlays/meta/1.0"
+	ocaLabelOverlayType        = "spec/overlays/label/1.0"
+	ocaInformationOverlayType  = "spec/overlays/information/1.0"
+	ocaConformanceOverlayType  = "spec/overlays/conformance/1.0"
+	ocaFormatOverlayType       = "spec/overlays/format/1.0"
+	ocaSAIDPlaceholderLength   = 44
+	ocaSAIDSHA256DerivationTag = "I"
+)
+
+// renderOCABundle builds an Overlays Capture Architecture bundle: a capture base
+// with one attribute per schema property, and meta, label, information,
+// conformance and format overlays. Every object is identified by a SAID.
+func renderOCABundle(s renderSchema, opts RenderOptions) (map[string]interface{}, error) {
+	names := s.sortedPropertyNames()
+
+	attributes := map[string]interface{}{}
+	for _, name := range names {
+		attributes[name] = ocaAttributeType(s.Properties[name])
+	}
+	captureBase := map[string]interface{}{
+		"type":               ocaCaptureBaseType,
+		"classification":     "",
+		"attributes":         attributes,
+		"flagged_attributes": []interface{}{},
+	}
+	captureBaseSAID, err := setSAID(captureBase)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := map[string]interface{}{
+		"capture_base": captureBaseSAID,
+		"type":         ocaMetaOverlayType,
+		"language":     opts.Language,
+		"name":         s.Title,
+		"description":  s.Description,
+	}
+	if opts.GovernanceFrameworkURL != "" {
+		meta["governance_framework"] = opts.GovernanceFrameworkURL
+	}
+
+	labels := map[string]interface{}{}
+	information := map[string]interface{}{}
+	conformance := map[string]interface{}{}
+	formats := map[string]interface{}{}
+	for _, name := range names {
+		prop := s.Properties[name]
+		labels[name] = propertyLabel(name, prop)
+		if description, ok := prop["description"].(string); ok && description != "" {
+			information[name] = description
+		}
+		if s.Required[name] {
+			conformance[name] = "M"
+		} else {
+			conformance[name] = "O"
+		}
+		if format, ok := prop["format"].(string); ok && format != "" {
+			formats[name] = format
+		}
+	}
+
+	overlays := map[string]interface{}{
+		"meta": meta,
+		"label": map[string]interface{}{
+			"capture_base":     captureBaseSAID,
+			"type":             ocaLabelOverlayType,
+			"language":         opts.Language,
+			"attribute_labels": labels,
+		},
+		"information": map[string]interface{}{
+			"capture_base":          captureBaseSAID,
+			"type":                  ocaInformationOverlayType,
+			"language":              opts.Language,
+			"attribute_information": information,
+		},
+		"conformance": map[string]interface{}{
+			"capture_base":          captureBaseSAID,
+			"type":                  ocaConformanceOverlayType,
+			"attribute_conformance": conformance,
+		},
+		"format": map[string]interface{}{
+			"capture_base":      captureBaseSAID,
+			"type":              ocaFormatOverlayType,
+			"attribute_formats": formats,
+		},
+	}
+	for _, key := range []string{"meta", "label", "information", "conformance", "format"} {
+		if _, err := setSAID(overlays[key].(map[string]interface{})); err != nil {
+			return nil, err
+		}
+	}
+
+	bundle := map[string]interface{}{
+		"capture_base": captureBase,
+		"overlays":     overlays,
+	}
+	if _, err := setSAID(bundle); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func ocaAttributeType(prop map[string]interface{}) string {
+	t, _ := prop["type"].(string)
+	switch t {
+	case "integer", "number":
+		return "Numeric"
+	case "boolean":
+		return "Boolean"
+	case "array":
+		if items, ok := prop["items"].(map[string]interface{}); ok {
+			return "Array[" + ocaAttributeType(items) + "]"
+		}
+		return "Array[Text]"
+	case "string":
+		if format, _ := prop["format"].(string); format == "date" || format == "date-time" {
+			return "DateTime"
+		}
+	}
+	return "Text"
+}
+
+// setSAID computes the self-addressing identifier of an OCA object and stores it
+// under "d": the digest is taken over the JCS serialization with "d" set to a
+// placeholder, and encoded as a CESR SHA2-256 digest.
+func setSAID(obj map[string]interface{}) (string, error) {
+	obj["d"] = strings.Repeat("#", ocaSAIDPlaceholderLength)
+	bz, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize OCA object: %w", err)
+	}
+	digest := sha256.Sum256(bz)
+	// CESR: one lead pad byte, base64url, then the derivation code replaces the first character
+	encoded := base64.RawURLEncoding.EncodeToString(append([]byte{0}, digest[:]...))
+	said := ocaSAIDSHA256DerivationTag + encoded[1:]
+	obj["d"] = said
+	return said, nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testRenderSchema = `{"$id":"vpr:verana:verana-1/cs/v1/js/7","$schema":"https://json-schema.org/draft/2020-12/schema","description":"Proof of membership","properties":{"birthDate":{"format":"date","type":"string"},"level":{"description":"Membership level","type":"integer"},"name":{"title":"Full name","type":"string"}},"required":["name"],"title":"Membership card","type":"object"}`
+
+func renderDoc(t *testing.T, format CredentialSchemaRenderFormat) map[string]interface{} {
+	t.Helper()
+	doc, _, err := RenderCredentialSchemaDocument(testRenderSchema, format, RenderOptions{
+		Language:               "en",
+		GovernanceFrameworkURL: "https://example.com/gf.pdf",
+	})
+	require.NoError(t, err)
+	canonical, err := CanonicalizeJCS(doc)
+	require.NoError(t, err)
+	require.Equal(t, canonical, doc)
+	var out map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(doc), &out))
+	return out
+}
+
+func TestRenderJSONLDContext(t *testing.T) {
+	doc := renderDoc(t, CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT)
+	ctx := doc["@context"].(map[string]interface{})
+	typeDef := ctx["MembershipCard"].(map[string]interface{})
+	require.Equal(t, "vpr:verana:verana-1/cs/v1/js/7#MembershipCard", typeDef["@id"])
+
+	terms := typeDef["@context"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{
+		"@id":   "vpr:verana:verana-1/cs/v1/js/7#birthDate",
+		"@type": "http://www.w3.org/2001/XMLSchema#date",
+	}, terms["birthDate"])
+	require.Equal(t, "http://www.w3.org/2001/XMLSchema#integer", terms["level"].(map[string]interface{})["@type"])
+	require.NotContains(t, terms["name"], "@type")
+}
+
+func TestRenderSDJWTTypeMetadata(t *testing.T) {
+	doc := renderDoc(t, CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA)
+	require.Equal(t, "vpr:verana:verana-1/cs/v1/js/7", doc["vct"])
+	require.Equal(t, "Membership card", doc["name"])
+	require.Contains(t, doc, "schema")
+
+	claims := doc["claims"].([]interface{})
+	require.Len(t, claims, 3)
+	name := claims[2].(map[string]interface{})
+	require.Equal(t, []interface{}{"name"}, name["path"])
+	display := name["display"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "Full name", display["label"])
+	require.Equal(t, "en", display["lang"])
+}
+
+func TestRenderOCABundle(t *testing.T) {
+	doc := renderDoc(t, CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE)
+
+	captureBase := doc["capture_base"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{
+		"birthDate": "DateTime",
+		"level":     "Numeric",
+		"name":      "Text",
+	}, captureBase["attributes"])
+	captureBaseSAID := captureBase["d"].(string)
+	require.Len(t, captureBaseSAID, ocaSAIDPlaceholderLength)
+	require.Equal(t, "I", captureBaseSAID[:1])
+
+	overlays := doc["overlays"].(map[string]interface{})
+	meta := overlays["meta"].(map[string]interface{})
+	require.Equal(t, captureBaseSAID, meta["capture_base"])
+	require.Equal(t, "https://example.com/gf.pdf", meta["governance_framework"])
+
+	conformance := overlays["conformance"].(map[string]interface{})["attribute_conformance"].(map[string]interface{})
+	require.Equal(t, "M", conformance["name"])
+	require.Equal(t, "O", conformance["level"])
+}
+
+func TestSetSAID_Deterministic(t *testing.T) {
+	a := map[string]interface{}{"type": "x", "attributes": map[string]interface{}{"a": "Text"}}
+	b := map[string]interface{}{"attributes": map[string]interface{}{"a": "Text"}, "type": "x"}
+	saidA, err := setSAID(a)
+	require.NoError(t, err)
+	saidB, err := setSAID(b)
+	require.NoError(t, err)
+	require.Equal(t, saidA, saidB)
+
+	// Recomputing over an object that already carries its SAID is stable
+	saidAgain, err := setSAID(a)
+	require.NoError(t, err)
+	require.Equal(t, saidA, saidAgain)
+}
+
+func TestRenderCredentialSchemaDocument_JSONSchemaPassthrough(t *testing.T) {
+	doc, mediaType, err := RenderCredentialSchemaDocument(testRenderSchema, CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA, RenderOptions{})
+	require.NoError(t, err)
+	require.Equal(t, testRenderSchema, doc)
+	require.Equal(t, MediaTypeJSONSchema, mediaType)
+}
@@ -166,6 +166,47 @@ func (PricingAssetType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_73b144e4376bdc5f, []int{3}
 }
 
+// CredentialSchemaRenderFormat defines the documents a credential schema can be
+// rendered as by the RenderCredentialSchema query.
+type CredentialSchemaRenderFormat int32
+
+const (
+	// Defaults to the JSON schema rendering
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED CredentialSchemaRenderFormat = 0
+	// JCS-canonicalized JSON Schema with the canonical $id
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA CredentialSchemaRenderFormat = 1
+	// W3C VC JSON-LD @context document
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT CredentialSchemaRenderFormat = 2
+	// SD-JWT VC type metadata document (vct)
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA CredentialSchemaRenderFormat = 3
+	// Overlays Capture Architecture bundle
+	CredentialSchemaRenderFormat_CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE CredentialSchemaRenderFormat = 4
+)
+
+var CredentialSchemaRenderFormat_name = map[int32]string{
+	0: "CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED",
+	1: "CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA",
+	2: "CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT",
+	3: "CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA",
+	4: "CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE",
+}
+
+var CredentialSchemaRenderFormat_value = map[string]int32{
+	"CREDENTIAL_SCHEMA_RENDER_FORMAT_UNSPECIFIED":             0,
+	"CREDENTIAL_SCHEMA_RENDER_FORMAT_JSON_SCHEMA":             1,
+	"CREDENTIAL_SCHEMA_RENDER_FORMAT_VC_JSON_LD_CONTEXT":      2,
+	"CREDENTIAL_SCHEMA_RENDER_FORMAT_SD_JWT_VC_TYPE_METADATA": 3,
+	"CREDENTIAL_SCHEMA_RENDER_FORMAT_OCA_BUNDLE":              4,
+}
+
+func (x CredentialSchemaRenderFormat) String() string {
+	return proto.EnumName(CredentialSchemaRenderFormat_name, int32(x))
+}
+
+func (CredentialSchemaRenderFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_73b144e4376bdc5f, []int{4}
+}
+
 // SchemaAuthorizationPolicyRole defines the role for a schema authorization policy.
 type SchemaAuthorizationPolicyRole int32
 
@@ -192,7 +233,7 @@ func (x SchemaAuthorizationPolicyRole) String() string {
 }
 
 func (SchemaAuthorizationPolicyRole) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_73b144e4376bdc5f, []int{4}
+	return fileDescriptor_73b144e4376bdc5f, []int{5}
 }
 
 // SchemaAuthorizationPolicy defines a versioned policy document attached to a credential schema role.
@@ -493,6 +534,7 @@ func init() {
 	proto.RegisterEnum("verana.cs.v1.VerifierOnboardingMode", VerifierOnboardingMode_name, VerifierOnboardingMode_value)
 	proto.RegisterEnum("verana.cs.v1.HolderOnboardingMode", HolderOnboardingMode_name, HolderOnboardingMode_value)
 	proto.RegisterEnum("verana.cs.v1.PricingAssetType", PricingAssetType_name, PricingAssetType_value)
+	proto.RegisterEnum("verana.cs.v1.CredentialSchemaRenderFormat", CredentialSchemaRenderFormat_name, CredentialSchemaRenderFormat_value)
 	proto.RegisterEnum("verana.cs.v1.SchemaAuthorizationPolicyRole", SchemaAuthorizationPolicyRole_name, SchemaAuthorizationPolicyRole_value)
 	proto.RegisterType((*SchemaAuthorizationPolicy)(nil), "verana.cs.v1.SchemaAuthorizationPolicy")
 	proto.RegisterType((*CredentialSchema)(nil), "verana.cs.v1.CredentialSchema")
@@ -501,74 +543,81 @@ func init() {
 func init() { proto.RegisterFile("verana/cs/v1/types.proto", fileDescriptor_73b144e4376bdc5f) }
 
 var fileDescriptor_73b144e4376bdc5f = []byte{
-	// 1070 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x52, 0xdb, 0x46,
-	0x14, 0x46, 0x36, 0x05, 0xfb, 0xf0, 0xa7, 0x6c, 0x19, 0x46, 0x21, 0x83, 0x31, 0x24, 0x69, 0x0c,
-	0x34, 0x76, 0x08, 0xcd, 0x64, 0x7a, 0xd3, 0xd6, 0x18, 0x01, 0x6a, 0x6d, 0xcb, 0xb3, 0x32, 0x4c,
-	0xc8, 0x74, 0xaa, 0x11, 0xd6, 0x62, 0x6f, 0x6b, 0x79, 0x3d, 0x2b, 0xd9, 0x53, 0xfa, 0x14, 0x79,
-	0x83, 0x3e, 0x41, 0xdf, 0x23, 0xd3, 0x9b, 0xe6, 0xb2, 0xbd, 0x69, 0x3b, 0xf0, 0x20, 0xed, 0x68,
-	0x57, 0x76, 0xc0, 0xf5, 0x0f, 0xcd, 0x8d, 0x67, 0xf7, 0xe8, 0xfb, 0xbe, 0x3d, 0xdf, 0x39, 0xab,
-	0x63, 0x81, 0xd6, 0x25, 0xdc, 0x69, 0x39, 0xb9, 0x9a, 0x9f, 0xeb, 0xee, 0xe6, 0x82, 0xcb, 0x36,
-	0xf1, 0xb3, 0x6d, 0xce, 0x02, 0x86, 0xe6, 0xe5, 0x93, 0x6c, 0xcd, 0xcf, 0x76, 0x77, 0x57, 0xef,
-	0x39, 0x1e, 0x6d, 0xb1, 0x9c, 0xf8, 0x95, 0x80, 0xd5, 0xfb, 0x35, 0xe6, 0x7b, 0xcc, 0xb7, 0xc5,
-	0x2e, 0x27, 0x37, 0xd1, 0xa3, 0xe5, 0x3a, 0xab, 0x33, 0x19, 0x0f, 0x57, 0x51, 0x74, 0xbd, 0xce,
-	0x58, 0xbd, 0x49, 0x72, 0x62, 0x77, 0xde, 0xb9, 0xc8, 0x05, 0xd4, 0x23, 0x7e, 0xe0, 0x78, 0x6d,
-	0x09, 0xd8, 0xfc, 0x35, 0x0e, 0xf7, 0xad, 0x5a, 0x83, 0x78, 0x4e, 0xbe, 0x13, 0x34, 0x18, 0xa7,
-	0x3f, 0x39, 0x01, 0x65, 0xad, 0x0a, 0x6b, 0xd2, 0xda, 0x25, 0x5a, 0x84, 0x18, 0x75, 0x35, 0x25,
-	0xad, 0x64, 0xa6, 0x71, 0x8c, 0xba, 0xe8, 0x01, 0x24, 0x7d, 0x01, 0xb6, 0xa9, 0xab, 0xc5, 0x44,
-	0x38, 0x21, 0x03, 0x86, 0x8b, 0xbe, 0x84, 0x69, 0xce, 0x9a, 0x44, 0x8b, 0xa7, 0x95, 0xcc, 0xe2,
-	0xf3, 0x9d, 0xec, 0x4d, 0x33, 0xd9, 0x91, 0x67, 0x60, 0xd6, 0x24, 0x58, 0x10, 0x91, 0x0a, 0xf1,
-	0x0e, 0x6f, 0x6a, 0xd3, 0x69, 0x25, 0x93, 0xc4, 0xe1, 0x12, 0xad, 0x01, 0xb8, 0xb4, 0x4e, 0xfc,
-	0xc0, 0xf6, 0x39, 0xd5, 0x3e, 0x12, 0x0f, 0x92, 0x32, 0x62, 0x71, 0x8a, 0xbe, 0x81, 0x45, 0x72,
-	0x71, 0x41, 0x6a, 0x01, 0xed, 0x12, 0xfb, 0x82, 0x33, 0x4f, 0x9b, 0x49, 0x2b, 0x99, 0xb9, 0xe7,
-	0xab, 0x59, 0x69, 0x3b, 0xdb, 0xb3, 0x9d, 0xad, 0xf6, 0x6c, 0xef, 0x27, 0xde, 0xfe, 0xb9, 0xae,
-	0xbc, 0xf9, 0x6b, 0x5d, 0xc1, 0x0b, 0x7d, 0xee, 0x21, 0x67, 0x1e, 0x2a, 0xc1, 0xd2, 0x7b, 0xb1,
-	0x4e, 0x2b, 0xa0, 0x4d, 0x6d, 0xf6, 0x7f, 0xa8, 0xbd, 0xcf, 0xe4, 0x24, 0xe4, 0x22, 0x0d, 0x66,
-	0x39, 0xe9, 0xb2, 0x1f, 0x88, 0xab, 0x25, 0xd2, 0x4a, 0x26, 0x81, 0x7b, 0x5b, 0xf4, 0x05, 0xcc,
-	0xd6, 0x38, 0x71, 0x02, 0xe2, 0x6a, 0xc9, 0x3b, 0x1d, 0x30, 0x25, 0x0e, 0xe8, 0x91, 0x42, 0xe5,
-	0x2e, 0xe1, 0x3e, 0x65, 0x2d, 0x0d, 0xd2, 0x4a, 0x66, 0x01, 0xf7, 0xb6, 0x9b, 0xff, 0x24, 0x40,
-	0x2d, 0x70, 0xe2, 0x92, 0x56, 0x40, 0x9d, 0xa6, 0x2c, 0xf9, 0x7f, 0x7a, 0x78, 0xe3, 0xf8, 0xf8,
-	0x87, 0x1c, 0xff, 0x15, 0x24, 0x3c, 0xe6, 0xd2, 0x0b, 0x4a, 0x5c, 0xd1, 0xaa, 0xbb, 0x0a, 0xf4,
-	0x59, 0xa1, 0x82, 0xc3, 0x6b, 0x0d, 0xda, 0x25, 0xae, 0xe8, 0xe9, 0x5d, 0x4b, 0xdc, 0x67, 0xa1,
-	0x75, 0x98, 0xfb, 0xde, 0x67, 0x2d, 0x5b, 0xde, 0x3d, 0xd1, 0xa7, 0x24, 0x86, 0x30, 0x14, 0x99,
-	0x7e, 0x05, 0x5b, 0xd4, 0xf7, 0x3b, 0x84, 0xdb, 0x75, 0xee, 0xb4, 0x02, 0xc6, 0xed, 0xae, 0xd3,
-	0xa4, 0xae, 0xb8, 0x77, 0x72, 0x49, 0x83, 0x4b, 0xbb, 0x4d, 0x38, 0x65, 0xb2, 0x3f, 0x0b, 0xf8,
-	0xb1, 0x24, 0x1c, 0x49, 0xfc, 0x69, 0x1f, 0x7e, 0x1a, 0xa1, 0x2b, 0x02, 0x8c, 0xbe, 0x85, 0x9d,
-	0x2e, 0xe1, 0xa1, 0x91, 0x3b, 0x69, 0x27, 0x85, 0xf6, 0x93, 0x1e, 0x65, 0x92, 0xba, 0x01, 0x1b,
-	0x51, 0xde, 0x63, 0x34, 0x65, 0xd7, 0x53, 0x12, 0x38, 0x52, 0xaa, 0x04, 0x0f, 0xfb, 0x89, 0x8e,
-	0x11, 0x9b, 0x13, 0x62, 0xe9, 0x1e, 0x74, 0x5c, 0x66, 0x0d, 0xd6, 0x74, 0xc7, 0x8b, 0xcd, 0xcb,
-	0xcc, 0x24, 0x70, 0xa4, 0xd4, 0x2b, 0x58, 0x89, 0x4c, 0xb2, 0xd6, 0x39, 0x73, 0xb8, 0x4b, 0x5b,
-	0x75, 0xdb, 0x63, 0x2e, 0xd1, 0x16, 0xc4, 0xe8, 0xd8, 0xbc, 0x3d, 0x3a, 0x0c, 0x81, 0x35, 0xfb,
-	0xd0, 0x12, 0x73, 0x09, 0x5e, 0xa6, 0x43, 0xa2, 0xe8, 0x3b, 0x31, 0x5c, 0xa5, 0xe7, 0x41, 0xed,
-	0x45, 0xa1, 0xfd, 0xe8, 0xb6, 0xf6, 0x69, 0x84, 0x1e, 0x50, 0x5f, 0xe9, 0x0e, 0x8d, 0xa3, 0x22,
-	0xa0, 0x36, 0xa7, 0xb5, 0x50, 0xd3, 0xf1, 0x7d, 0x12, 0xd8, 0xe1, 0xf4, 0xd6, 0x96, 0x84, 0x72,
-	0xea, 0xb6, 0x72, 0x45, 0xe2, 0xf2, 0x21, 0xac, 0x7a, 0xd9, 0x26, 0x58, 0x6d, 0x0f, 0x44, 0xd0,
-	0x43, 0x58, 0xb8, 0xa5, 0xa6, 0xa9, 0xe2, 0x1e, 0xcf, 0xdf, 0x04, 0xa2, 0x2d, 0x50, 0xa3, 0x11,
-	0xe8, 0x34, 0xeb, 0x8c, 0xd3, 0xa0, 0xe1, 0x69, 0xf7, 0x04, 0x6e, 0x49, 0xc6, 0xf3, 0xbd, 0x70,
-	0x58, 0xd7, 0xa8, 0x45, 0x83, 0xde, 0xd1, 0xb0, 0xba, 0x1e, 0x0b, 0xec, 0x60, 0x5d, 0x1b, 0x43,
-	0xa2, 0x68, 0x03, 0xe6, 0x49, 0x8d, 0xf9, 0x97, 0x7e, 0x40, 0xbc, 0x70, 0xf4, 0x7f, 0x2c, 0xa6,
-	0xc9, 0x5c, 0x3f, 0x66, 0xb8, 0x5f, 0x4f, 0x27, 0x62, 0x6a, 0x7c, 0xfb, 0x37, 0x05, 0x96, 0x87,
-	0xf5, 0x0b, 0x7d, 0x02, 0x9b, 0x86, 0x65, 0x9d, 0xe8, 0xd8, 0x36, 0xcb, 0xfb, 0x66, 0x1e, 0x1f,
-	0x18, 0xe5, 0x23, 0xbb, 0x64, 0x1e, 0xe8, 0xf6, 0x49, 0xd9, 0xaa, 0xe8, 0x05, 0xe3, 0xd0, 0xd0,
-	0x0f, 0xd4, 0x29, 0xb4, 0x0e, 0x0f, 0x46, 0xe0, 0xcc, 0x8a, 0x5e, 0x56, 0x15, 0xf4, 0x12, 0xf6,
-	0x46, 0x00, 0xf4, 0x82, 0x69, 0x9d, 0x59, 0x55, 0xbd, 0x64, 0x9f, 0xe6, 0x8b, 0xc6, 0x41, 0xbe,
-	0x6a, 0x98, 0x65, 0xbb, 0x82, 0xcd, 0x82, 0x6e, 0x59, 0x6a, 0x0c, 0xbd, 0x80, 0xdd, 0x11, 0xc4,
-	0x23, 0x9c, 0x2f, 0x57, 0x4d, 0x3c, 0x8c, 0x16, 0xdf, 0xfe, 0x43, 0x81, 0x95, 0xe1, 0xb7, 0x04,
-	0x65, 0xe0, 0xd1, 0xa9, 0x8e, 0xc3, 0xcc, 0x27, 0xb9, 0xda, 0x80, 0xb5, 0x91, 0xc8, 0xc8, 0xd7,
-	0xe7, 0xf0, 0x62, 0x24, 0x64, 0x82, 0xb3, 0x97, 0xb0, 0x37, 0x92, 0x3a, 0xd6, 0xdb, 0xcf, 0x0a,
-	0x2c, 0x0f, 0xbb, 0x05, 0x61, 0xb7, 0x8e, 0xcd, 0xe2, 0xc1, 0x44, 0x5f, 0x9f, 0xc1, 0xb3, 0x11,
-	0xb8, 0xa8, 0xd4, 0x43, 0x8e, 0x55, 0xd0, 0x16, 0x3c, 0x1e, 0xc1, 0xaa, 0xe8, 0xb8, 0x64, 0x58,
-	0x96, 0x61, 0x96, 0x8b, 0xc2, 0xda, 0x36, 0x06, 0x75, 0xf0, 0x45, 0x42, 0x9b, 0x90, 0xaa, 0x60,
-	0xa3, 0x10, 0x92, 0xf2, 0x96, 0xa5, 0x57, 0xed, 0xea, 0x59, 0x65, 0x30, 0xb1, 0x19, 0x88, 0x55,
-	0x4f, 0x54, 0x05, 0x25, 0x60, 0xba, 0x60, 0x1a, 0x65, 0x35, 0x16, 0xae, 0x0e, 0x8d, 0x7c, 0x55,
-	0x8d, 0x6f, 0xff, 0xa2, 0xc0, 0xda, 0xd8, 0xcf, 0x11, 0xf4, 0x0c, 0x3e, 0xb5, 0x0a, 0xc7, 0x7a,
-	0x29, 0x6f, 0xe7, 0x4f, 0xaa, 0xc7, 0x26, 0x36, 0x5e, 0x47, 0x16, 0xcc, 0xa2, 0x51, 0x38, 0xb3,
-	0xb1, 0x59, 0x1c, 0x3c, 0x6f, 0x07, 0x9e, 0x4c, 0x64, 0xc8, 0x92, 0xa8, 0x0a, 0x7a, 0x0a, 0x5b,
-	0x13, 0xc1, 0xbd, 0x86, 0xaa, 0xb1, 0xfd, 0xfd, 0xb7, 0x57, 0x29, 0xe5, 0xdd, 0x55, 0x4a, 0xf9,
-	0xfb, 0x2a, 0xa5, 0xbc, 0xb9, 0x4e, 0x4d, 0xbd, 0xbb, 0x4e, 0x4d, 0xfd, 0x7e, 0x9d, 0x9a, 0x7a,
-	0x9d, 0xa9, 0xd3, 0xa0, 0xd1, 0x39, 0xcf, 0xd6, 0x98, 0x97, 0x93, 0xaf, 0xf6, 0xd3, 0xa6, 0x73,
-	0xee, 0x47, 0xeb, 0xdc, 0x8f, 0xe1, 0x27, 0xa6, 0xf8, 0xbe, 0x3c, 0x9f, 0x11, 0x7f, 0xac, 0x7b,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x9f, 0xa5, 0xa5, 0x7c, 0x0a, 0x00, 0x00,
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+	0x17, 0x8e, 0xec, 0xfc, 0x5a, 0xe7, 0xe4, 0x4f, 0xd5, 0xfd, 0x65, 0x3a, 0x6a, 0x4b, 0x1d, 0x37,
+	0x6d, 0xa9, 0x93, 0x50, 0xbb, 0x7f, 0x28, 0x1d, 0x86, 0x19, 0x40, 0x91, 0x95, 0x46, 0xc5, 0x96,
+	0x3c, 0x2b, 0x25, 0xb4, 0x1d, 0x06, 0x8d, 0x62, 0x6d, 0xec, 0x05, 0xcb, 0xeb, 0x59, 0x29, 0x1e,
+	0xc2, 0x53, 0xf4, 0x0d, 0xb8, 0xe2, 0x92, 0xf7, 0xe8, 0x70, 0x43, 0x2f, 0xe1, 0x06, 0x98, 0xf6,
+	0x41, 0x60, 0xb4, 0x2b, 0xbb, 0x8d, 0xb1, 0xe3, 0xc0, 0x4d, 0x66, 0xf7, 0xe8, 0xfb, 0xbe, 0x3d,
+	0xdf, 0x9e, 0x93, 0xe3, 0x05, 0x6d, 0x40, 0x78, 0xd0, 0x0b, 0xaa, 0xad, 0xb8, 0x3a, 0xb8, 0x57,
+	0x4d, 0x8e, 0xfb, 0x24, 0xae, 0xf4, 0x39, 0x4b, 0x18, 0x5a, 0x92, 0x5f, 0x2a, 0xad, 0xb8, 0x32,
+	0xb8, 0x77, 0xe5, 0x62, 0x10, 0xd1, 0x1e, 0xab, 0x8a, 0xbf, 0x12, 0x70, 0xe5, 0x72, 0x8b, 0xc5,
+	0x11, 0x8b, 0x7d, 0xb1, 0xab, 0xca, 0x4d, 0xf6, 0x69, 0xb5, 0xcd, 0xda, 0x4c, 0xc6, 0xd3, 0x55,
+	0x16, 0x5d, 0x6b, 0x33, 0xd6, 0xee, 0x92, 0xaa, 0xd8, 0x1d, 0x1c, 0x1d, 0x56, 0x13, 0x1a, 0x91,
+	0x38, 0x09, 0xa2, 0xbe, 0x04, 0xac, 0xff, 0x9c, 0x87, 0xcb, 0x6e, 0xab, 0x43, 0xa2, 0x40, 0x3f,
+	0x4a, 0x3a, 0x8c, 0xd3, 0xef, 0x83, 0x84, 0xb2, 0x5e, 0x93, 0x75, 0x69, 0xeb, 0x18, 0xad, 0x40,
+	0x8e, 0x86, 0x9a, 0x52, 0x52, 0xca, 0xf3, 0x38, 0x47, 0x43, 0x74, 0x15, 0x16, 0x62, 0x01, 0xf6,
+	0x69, 0xa8, 0xe5, 0x44, 0xb8, 0x20, 0x03, 0x56, 0x88, 0x3e, 0x83, 0x79, 0xce, 0xba, 0x44, 0xcb,
+	0x97, 0x94, 0xf2, 0xca, 0xfd, 0xad, 0xca, 0xbb, 0x66, 0x2a, 0x53, 0xcf, 0xc0, 0xac, 0x4b, 0xb0,
+	0x20, 0x22, 0x15, 0xf2, 0x47, 0xbc, 0xab, 0xcd, 0x97, 0x94, 0xf2, 0x02, 0x4e, 0x97, 0xe8, 0x1a,
+	0x40, 0x48, 0xdb, 0x24, 0x4e, 0xfc, 0x98, 0x53, 0xed, 0x7f, 0xe2, 0xc3, 0x82, 0x8c, 0xb8, 0x9c,
+	0xa2, 0x2f, 0x60, 0x85, 0x1c, 0x1e, 0x92, 0x56, 0x42, 0x07, 0xc4, 0x3f, 0xe4, 0x2c, 0xd2, 0xce,
+	0x95, 0x94, 0xf2, 0xe2, 0xfd, 0x2b, 0x15, 0x69, 0xbb, 0x32, 0xb4, 0x5d, 0xf1, 0x86, 0xb6, 0xb7,
+	0x0b, 0x2f, 0x7f, 0x5f, 0x53, 0x5e, 0xfc, 0xb1, 0xa6, 0xe0, 0xe5, 0x11, 0x77, 0x87, 0xb3, 0x08,
+	0x35, 0xe0, 0xc2, 0x5b, 0xb1, 0xa3, 0x5e, 0x42, 0xbb, 0xda, 0xf9, 0x7f, 0xa1, 0xf6, 0x36, 0x93,
+	0xbd, 0x94, 0x8b, 0x34, 0x38, 0xcf, 0xc9, 0x80, 0x7d, 0x4b, 0x42, 0xad, 0x50, 0x52, 0xca, 0x05,
+	0x3c, 0xdc, 0xa2, 0x4f, 0xe1, 0x7c, 0x8b, 0x93, 0x20, 0x21, 0xa1, 0xb6, 0x70, 0xa6, 0x03, 0xe6,
+	0xc4, 0x01, 0x43, 0x52, 0xaa, 0x3c, 0x20, 0x3c, 0xa6, 0xac, 0xa7, 0x41, 0x49, 0x29, 0x2f, 0xe3,
+	0xe1, 0x76, 0xfd, 0xaf, 0x02, 0xa8, 0x06, 0x27, 0x21, 0xe9, 0x25, 0x34, 0xe8, 0xca, 0x2b, 0xff,
+	0x47, 0x0d, 0xdf, 0x39, 0x3e, 0xff, 0x5f, 0x8e, 0xff, 0x1c, 0x0a, 0x11, 0x0b, 0xe9, 0x21, 0x25,
+	0xa1, 0x28, 0xd5, 0x59, 0x05, 0x46, 0xac, 0x54, 0x21, 0xe0, 0xad, 0x0e, 0x1d, 0x90, 0x50, 0xd4,
+	0xf4, 0xac, 0x57, 0x3c, 0x62, 0xa1, 0x35, 0x58, 0xfc, 0x26, 0x66, 0x3d, 0x5f, 0xf6, 0x9e, 0xa8,
+	0xd3, 0x02, 0x86, 0x34, 0x94, 0x99, 0x7e, 0x0a, 0x1b, 0x34, 0x8e, 0x8f, 0x08, 0xf7, 0xdb, 0x3c,
+	0xe8, 0x25, 0x8c, 0xfb, 0x83, 0xa0, 0x4b, 0x43, 0xd1, 0x77, 0x72, 0x49, 0x93, 0x63, 0xbf, 0x4f,
+	0x38, 0x65, 0xb2, 0x3e, 0xcb, 0xf8, 0x96, 0x24, 0x3c, 0x96, 0xf8, 0xfd, 0x11, 0x7c, 0x3f, 0x43,
+	0x37, 0x05, 0x18, 0x7d, 0x05, 0x5b, 0x03, 0xc2, 0x53, 0x23, 0x67, 0xd2, 0x5e, 0x10, 0xda, 0xb7,
+	0x87, 0x94, 0x59, 0xea, 0x16, 0x5c, 0xcf, 0xf2, 0x3e, 0x45, 0x53, 0x56, 0xbd, 0x28, 0x81, 0x53,
+	0xa5, 0x1a, 0x70, 0x63, 0x94, 0xe8, 0x29, 0x62, 0x8b, 0x42, 0xac, 0x34, 0x84, 0x9e, 0x96, 0x59,
+	0x87, 0x75, 0xc3, 0xd3, 0xc5, 0x96, 0x64, 0x66, 0x12, 0x38, 0x55, 0xea, 0x29, 0x5c, 0xca, 0x4c,
+	0xb2, 0xde, 0x01, 0x0b, 0x78, 0x48, 0x7b, 0x6d, 0x3f, 0x62, 0x21, 0xd1, 0x96, 0xc5, 0xe8, 0x58,
+	0x3f, 0x39, 0x3a, 0x2c, 0x81, 0x75, 0x46, 0xd0, 0x06, 0x0b, 0x09, 0x5e, 0xa5, 0x13, 0xa2, 0xe8,
+	0x6b, 0x31, 0x5c, 0xa5, 0xe7, 0x71, 0xed, 0x15, 0xa1, 0x7d, 0xf3, 0xa4, 0xf6, 0x7e, 0x86, 0x1e,
+	0x53, 0xbf, 0x34, 0x98, 0x18, 0x47, 0x75, 0x40, 0x7d, 0x4e, 0x5b, 0xa9, 0x66, 0x10, 0xc7, 0x24,
+	0xf1, 0xd3, 0xe9, 0xad, 0x5d, 0x10, 0xca, 0xc5, 0x93, 0xca, 0x4d, 0x89, 0xd3, 0x53, 0x98, 0x77,
+	0xdc, 0x27, 0x58, 0xed, 0x8f, 0x45, 0xd0, 0x0d, 0x58, 0x3e, 0xa1, 0xa6, 0xa9, 0xa2, 0x8f, 0x97,
+	0xde, 0x05, 0xa2, 0x0d, 0x50, 0xb3, 0x11, 0x18, 0x74, 0xdb, 0x8c, 0xd3, 0xa4, 0x13, 0x69, 0x17,
+	0x05, 0xee, 0x82, 0x8c, 0xeb, 0xc3, 0x70, 0x7a, 0xaf, 0x59, 0x89, 0xc6, 0xbd, 0xa3, 0x49, 0xf7,
+	0xba, 0x2b, 0xb0, 0xe3, 0xf7, 0xda, 0x99, 0x10, 0x45, 0xd7, 0x61, 0x89, 0xb4, 0x58, 0x7c, 0x1c,
+	0x27, 0x24, 0x4a, 0x47, 0xff, 0xff, 0xc5, 0x34, 0x59, 0x1c, 0xc5, 0xac, 0xf0, 0xc9, 0x7c, 0x21,
+	0xa7, 0xe6, 0x37, 0x7f, 0x51, 0x60, 0x75, 0x52, 0xbd, 0xd0, 0xfb, 0xb0, 0x6e, 0xb9, 0xee, 0x9e,
+	0x89, 0x7d, 0xc7, 0xde, 0x76, 0x74, 0x5c, 0xb3, 0xec, 0xc7, 0x7e, 0xc3, 0xa9, 0x99, 0xfe, 0x9e,
+	0xed, 0x36, 0x4d, 0xc3, 0xda, 0xb1, 0xcc, 0x9a, 0x3a, 0x87, 0xd6, 0xe0, 0xea, 0x14, 0x9c, 0xd3,
+	0x34, 0x6d, 0x55, 0x41, 0x8f, 0xe0, 0xc1, 0x14, 0x80, 0x69, 0x38, 0xee, 0x33, 0xd7, 0x33, 0x1b,
+	0xfe, 0xbe, 0x5e, 0xb7, 0x6a, 0xba, 0x67, 0x39, 0xb6, 0xdf, 0xc4, 0x8e, 0x61, 0xba, 0xae, 0x9a,
+	0x43, 0x0f, 0xe1, 0xde, 0x14, 0xe2, 0x63, 0xac, 0xdb, 0x9e, 0x83, 0x27, 0xd1, 0xf2, 0x9b, 0xbf,
+	0x29, 0x70, 0x69, 0x72, 0x97, 0xa0, 0x32, 0xdc, 0xdc, 0x37, 0x71, 0x9a, 0xf9, 0x2c, 0x57, 0xd7,
+	0xe1, 0xda, 0x54, 0x64, 0xe6, 0xeb, 0x63, 0x78, 0x38, 0x15, 0x32, 0xc3, 0xd9, 0x23, 0x78, 0x30,
+	0x95, 0x7a, 0xaa, 0xb7, 0x1f, 0x14, 0x58, 0x9d, 0xd4, 0x05, 0x69, 0xb5, 0x76, 0x9d, 0x7a, 0x6d,
+	0xa6, 0xaf, 0x0f, 0xe1, 0xee, 0x14, 0x5c, 0x76, 0xd5, 0x13, 0x8e, 0x55, 0xd0, 0x06, 0xdc, 0x9a,
+	0xc2, 0x6a, 0x9a, 0xb8, 0x61, 0xb9, 0xae, 0xe5, 0xd8, 0x75, 0x61, 0x6d, 0x13, 0x83, 0x3a, 0xfe,
+	0x8f, 0x84, 0xd6, 0xa1, 0xd8, 0xc4, 0x96, 0x91, 0x92, 0x74, 0xd7, 0x35, 0x3d, 0xdf, 0x7b, 0xd6,
+	0x1c, 0x4f, 0xec, 0x1c, 0xe4, 0xbc, 0x3d, 0x55, 0x41, 0x05, 0x98, 0x37, 0x1c, 0xcb, 0x56, 0x73,
+	0xe9, 0x6a, 0xc7, 0xd2, 0x3d, 0x35, 0xbf, 0xf9, 0x63, 0x0e, 0xde, 0x1b, 0xff, 0x95, 0xc4, 0xa4,
+	0x17, 0x12, 0xbe, 0xc3, 0x78, 0x14, 0x24, 0xa8, 0x0a, 0x5b, 0x06, 0x36, 0x6b, 0xa6, 0xed, 0x59,
+	0x7a, 0xdd, 0x77, 0x8d, 0x5d, 0xb3, 0xa1, 0xfb, 0xd8, 0xb4, 0xd3, 0x8c, 0x77, 0x1c, 0xdc, 0xd0,
+	0xbd, 0xb1, 0xd3, 0xce, 0x40, 0x78, 0xe2, 0x3a, 0x76, 0xf6, 0x45, 0x55, 0xd0, 0x47, 0x70, 0x7f,
+	0x16, 0x61, 0xdf, 0x90, 0x9c, 0x7a, 0xcd, 0x37, 0x1c, 0xdb, 0x33, 0x9f, 0x7a, 0x6a, 0x0e, 0x7d,
+	0x02, 0x8f, 0x66, 0xf1, 0xdc, 0x9a, 0xff, 0xe4, 0x4b, 0x41, 0x17, 0xd7, 0xd2, 0x30, 0x3d, 0xbd,
+	0xa6, 0x7b, 0xba, 0x9a, 0x47, 0x15, 0xd8, 0x9c, 0x45, 0x76, 0x0c, 0xdd, 0xdf, 0xde, 0xb3, 0x6b,
+	0x75, 0x53, 0x9d, 0xdf, 0xfc, 0x49, 0x81, 0x6b, 0xa7, 0x3e, 0xdb, 0xd0, 0x5d, 0xf8, 0x20, 0x93,
+	0xd1, 0xf7, 0xbc, 0x5d, 0x07, 0x5b, 0xcf, 0xb3, 0x52, 0x3b, 0x75, 0xcb, 0x78, 0xe6, 0x63, 0xa7,
+	0x3e, 0x5e, 0x97, 0x2d, 0xb8, 0x3d, 0x93, 0x21, 0x5b, 0x47, 0x55, 0xd0, 0x1d, 0xd8, 0x98, 0x09,
+	0x1e, 0x36, 0xbe, 0x9a, 0xdb, 0xde, 0x7e, 0xf9, 0xba, 0xa8, 0xbc, 0x7a, 0x5d, 0x54, 0xfe, 0x7c,
+	0x5d, 0x54, 0x5e, 0xbc, 0x29, 0xce, 0xbd, 0x7a, 0x53, 0x9c, 0xfb, 0xf5, 0x4d, 0x71, 0xee, 0x79,
+	0xb9, 0x4d, 0x93, 0xce, 0xd1, 0x41, 0xa5, 0xc5, 0xa2, 0xaa, 0x1c, 0x81, 0x77, 0xba, 0xc1, 0x41,
+	0x9c, 0xad, 0xab, 0xdf, 0xa5, 0x4f, 0x71, 0xf1, 0x0e, 0x3f, 0x38, 0x27, 0x1e, 0x20, 0x0f, 0xfe,
+	0x0e, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xde, 0xd3, 0x0d, 0xa4, 0x0b, 0x00, 0x00,
 }
 
 func (m *SchemaAuthorizationPolicy) Marshal() (dAtA []byte, err error) {
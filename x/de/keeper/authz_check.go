@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/verana-labs/verana/x/de/types"
@@ -95,6 +96,39 @@ func (k Keeper) CheckOperatorAuthorizationWithSpend(
 	return nil
 }
 
+// checkCorporationNotFrozen returns ErrCorporationFrozen while the operators of
+// corporationID are frozen.
+func (k Keeper) checkCorporationNotFrozen(ctx context.Context, corporationID uint64) error {
+	frozen, err := k.CorporationFreezes.Has(ctx, corporationID)
+	if err != nil {
+		return fmt.Errorf("failed to read corporation freeze: %w", err)
+	}
+	if frozen {
+		return errorsmod.Wrapf(types.ErrCorporationFrozen, "corporation %d", corporationID)
+	}
+	return nil
+}
+
+// remainingSpend returns what is left of the spend_limit of oa at now,
+// accounting for a period reset that has not been recorded in the ledger yet.
+// It returns nil when oa has no spend_limit.
+func (k Keeper) remainingSpend(ctx context.Context, oa types.OperatorAuthorization, now time.Time) (sdk.Coins, error) {
+	if len(oa.SpendLimit) == 0 {
+		return nil, nil
+	}
+	usage, err := k.OperatorAuthorizationUsage.Get(ctx, oa.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return oa.SpendLimit, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read usage ledger: %w", err)
+	}
+	if oa.Period != nil && *oa.Period > 0 && now.Sub(usage.LastReset) >= *oa.Period {
+		return oa.SpendLimit, nil
+	}
+	return usage.Remaining, nil
+}
+
 // checkOperatorAuthorizationCore performs the expiration + msg_type checks and
 // returns the loaded OperatorAuthorization so spend-limit enforcement can use it
 // without a second keeper lookup.
@@ -117,6 +151,11 @@ func (k Keeper) checkOperatorAuthorizationCore(
 		return types.OperatorAuthorization{}, types.ErrAuthzNotFound
 	}
 
+	// A frozen corporation rejects every operator until unfrozen.
+	if err := k.checkCorporationNotFrozen(ctx, co.Id); err != nil {
+		return types.OperatorAuthorization{}, err
+	}
+
 	// 1. Load OperatorAuthorization via the (corporation_id, operator) index.
 	oa, found, err := k.getOperatorAuthorizationByCorpOp(ctx, co.Id, operator)
 	if err != nil {
@@ -131,7 +170,13 @@ func (k Keeper) checkOperatorAuthorizationCore(
 		return types.OperatorAuthorization{}, types.ErrAuthzExpired
 	}
 
-	// 3. Check that the requested msg type is authorized.
+	// 3. Ignore an authorization that is not active yet: pending acceptance by
+	// the operator or time locked by not_before.
+	if !oa.IsActive(now) {
+		return types.OperatorAuthorization{}, errorsmod.Wrap(types.ErrAuthzNotFound, "operator authorization is not active yet")
+	}
+
+	// 4. Check that the requested msg type is authorized.
 	found = false
 	for _, mt := range oa.MsgTypes {
 		if mt == msgTypeURL {
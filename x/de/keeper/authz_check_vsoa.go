@@ -35,6 +35,11 @@ func (k Keeper) CheckVSOperatorAuthorizationOnParticipant(
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	now := sdkCtx.BlockTime()
 
+	// A frozen corporation rejects every VS operator until unfrozen.
+	if err := k.checkCorporationNotFrozen(ctx, corporationID); err != nil {
+		return err
+	}
+
 	// 1. Record MUST exist for participant_id.
 	vsoaID, err := k.VSOAByParticipant.Get(ctx, participantID)
 	if err != nil {
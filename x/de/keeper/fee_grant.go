@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -80,6 +81,26 @@ func (k Keeper) GrantFeeAllowance(
 	return nil
 }
 
+// setFeeGrantActivation mirrors the pending state and not_before of an
+// operator authorization on the FeeGrant of (grantorCorporationID, grantee),
+// if any, so the fee grant is not usable before the authorization.
+func (k Keeper) setFeeGrantActivation(ctx context.Context, grantorCorporationID uint64, grantee string, pending bool, notBefore *time.Time) error {
+	key := collections.Join(grantorCorporationID, grantee)
+	feeGrant, err := k.FeeGrants.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get FeeGrant: %w", err)
+	}
+	feeGrant.Pending = pending
+	feeGrant.NotBefore = notBefore
+	if err := k.FeeGrants.Set(ctx, key, feeGrant); err != nil {
+		return fmt.Errorf("failed to set FeeGrant: %w", err)
+	}
+	return nil
+}
+
 // RevokeFeeAllowance implements [MOD-DE-MSG-2]. It removes the FeeGrant for the
 // composite (grantor_corporation_id, grantee). This is an internal method called
 // by GrantOperatorAuthorization, RevokeOperatorAuthorization, and the MSG-5-5
@@ -16,6 +16,18 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return err
 	}
 
+	for _, role := range genState.OperatorRoles {
+		if err := k.OperatorRoles.Set(ctx, collections.Join(role.CorporationId, role.Name), role); err != nil {
+			return fmt.Errorf("failed to set operator role: %w", err)
+		}
+	}
+
+	for _, freeze := range genState.CorporationFreezes {
+		if err := k.CorporationFreezes.Set(ctx, freeze.CorporationId, freeze); err != nil {
+			return fmt.Errorf("failed to set corporation freeze: %w", err)
+		}
+	}
+
 	var maxOAID uint64
 	for _, oa := range genState.OperatorAuthorizations {
 		if err := k.OperatorAuthorizations.Set(ctx, oa.Id, oa); err != nil {
@@ -24,6 +36,14 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.OperatorAuthorizationByCorpOp.Set(ctx, collections.Join(oa.CorporationId, oa.Operator), oa.Id); err != nil {
 			return fmt.Errorf("failed to set operator authorization index: %w", err)
 		}
+		if err := k.OperatorAuthorizationByOperator.Set(ctx, collections.Join(oa.Operator, oa.CorporationId)); err != nil {
+			return fmt.Errorf("failed to set operator authorization operator index: %w", err)
+		}
+		if oa.Role != "" {
+			if err := k.OperatorAuthorizationByRole.Set(ctx, collections.Join3(oa.CorporationId, oa.Role, oa.Id)); err != nil {
+				return fmt.Errorf("failed to set operator authorization role index: %w", err)
+			}
+		}
 		if oa.Id > maxOAID {
 			maxOAID = oa.Id
 		}
@@ -55,6 +75,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.VSOAByCorpOp.Set(ctx, collections.Join(vsoa.CorporationId, vsoa.VsOperator), vsoa.Id); err != nil {
 			return fmt.Errorf("failed to set vs operator authorization index: %w", err)
 		}
+		if err := k.VSOAByOperator.Set(ctx, collections.Join(vsoa.VsOperator, vsoa.CorporationId)); err != nil {
+			return fmt.Errorf("failed to set vs operator authorization operator index: %w", err)
+		}
 		for _, rec := range vsoa.Records {
 			if err := k.VSOAByParticipant.Set(ctx, rec.ParticipantId, vsoa.Id); err != nil {
 				return fmt.Errorf("failed to set participant index: %w", err)
@@ -134,5 +157,25 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	sort.Slice(vsoaList, func(i, j int) bool { return vsoaList[i].Id < vsoaList[j].Id })
 	genesis.VsOperatorAuthorizations = vsoaList
 
+	// Operator roles (composite key, iterated in key order).
+	roleList := []types.OperatorRole{}
+	if err := k.OperatorRoles.Walk(ctx, nil, func(_ collections.Pair[uint64, string], val types.OperatorRole) (bool, error) {
+		roleList = append(roleList, val)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export operator roles: %w", err)
+	}
+	genesis.OperatorRoles = roleList
+
+	// Corporation freezes (keyed by corporation_id).
+	freezeList := []types.CorporationFreeze{}
+	if err := k.CorporationFreezes.Walk(ctx, nil, func(_ uint64, val types.CorporationFreeze) (bool, error) {
+		freezeList = append(freezeList, val)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export corporation freezes: %w", err)
+	}
+	genesis.CorporationFreezes = freezeList
+
 	return genesis, nil
 }
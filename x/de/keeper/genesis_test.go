@@ -3,15 +3,26 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/verana-labs/verana/x/de/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	operator := sdk.AccAddress([]byte("schema_admin________")).String()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		OperatorRoles: []types.OperatorRole{
+			{CorporationId: 1, Name: "schema-admin", MsgTypes: []string{"/verana.cs.v1.MsgCreateCredentialSchema"}},
+		},
+		OperatorAuthorizations: []types.OperatorAuthorization{
+			{Id: 1, CorporationId: 1, Operator: operator, MsgTypes: []string{"/verana.cs.v1.MsgCreateCredentialSchema"}, Role: "schema-admin"},
+		},
 	}
+	require.NoError(t, genesisState.Validate())
 
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -21,4 +32,13 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.OperatorRoles, got.OperatorRoles)
+
+	has, err := f.keeper.OperatorAuthorizationByRole.Has(f.ctx, collections.Join3(uint64(1), "schema-admin", uint64(1)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Authorizations cannot reference a missing role.
+	genesisState.OperatorRoles = nil
+	require.Error(t, genesisState.Validate())
 }
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/verana-labs/verana/x/de/types"
@@ -20,6 +21,8 @@ type Keeper struct {
 	// Address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
 	authority []byte
+	// router executes the inner messages of MsgExecAsCorporation.
+	router baseapp.MessageRouter
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
@@ -31,6 +34,9 @@ type Keeper struct {
 	OperatorAuthorizationByCorpOp collections.Map[collections.Pair[uint64, string], uint64]
 	OperatorAuthorizationSeq      collections.Sequence
 	OperatorAuthorizationUsage    collections.Map[uint64, types.OperatorAuthorizationUsage]
+	// OperatorAuthorizationByOperator is the (operator, corporation_id) reverse
+	// index listing the corporations an operator holds authorizations from.
+	OperatorAuthorizationByOperator collections.KeySet[collections.Pair[string, uint64]]
 
 	// FeeGrant: composite key (grantor_corporation_id, grantee).
 	FeeGrants collections.Map[collections.Pair[uint64, string], types.FeeGrant]
@@ -42,6 +48,18 @@ type Keeper struct {
 	VSOAByCorpOp             collections.Map[collections.Pair[uint64, string], uint64]
 	VSOAByParticipant        collections.Map[uint64, uint64]
 	VSOASeq                  collections.Sequence
+	// VSOAByOperator is the (vs_operator, corporation_id) reverse index.
+	VSOAByOperator collections.KeySet[collections.Pair[string, uint64]]
+
+	// OperatorRole: composite key (corporation_id, name); the role index
+	// (corporation_id, role, OperatorAuthorization.id) lists the authorizations
+	// granted from each role.
+	OperatorRoles               collections.Map[collections.Pair[uint64, string], types.OperatorRole]
+	OperatorAuthorizationByRole collections.KeySet[collections.Triple[uint64, string, uint64]]
+
+	// CorporationFreezes holds the corporations whose operators are frozen,
+	// keyed by corporation_id.
+	CorporationFreezes collections.Map[uint64, types.CorporationFreeze]
 
 	// corpRef backs AUTHZ-CHECK-5; wired post-construction via
 	// SetCorporationKeeper to break the MOD-DE ↔ MOD-CO cycle (#308).
@@ -53,7 +71,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	router baseapp.MessageRouter,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -62,12 +80,14 @@ func NewKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	corpOpKeyCodec := collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)
+	opCorpKeyCodec := collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
 
 	k := Keeper{
 		storeService: storeService,
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
+		router:       router,
 		corpRef:      &corpKeeperRef{K: StubCorporationKeeper{}},
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
@@ -79,6 +99,8 @@ func NewKeeper(
 		OperatorAuthorizationSeq: collections.NewSequence(sb, types.OperatorAuthorizationSeqKey, "operator_authorization_seq"),
 		OperatorAuthorizationUsage: collections.NewMap(sb, types.OperatorAuthorizationUsageKey, "operator_authorization_usage",
 			collections.Uint64Key, codec.CollValue[types.OperatorAuthorizationUsage](cdc)),
+		OperatorAuthorizationByOperator: collections.NewKeySet(sb, types.OperatorAuthorizationByOperatorKey, "operator_authorization_by_operator",
+			opCorpKeyCodec),
 
 		FeeGrants: collections.NewMap(sb, types.FeeGrantKey, "fee_grant",
 			corpOpKeyCodec, codec.CollValue[types.FeeGrant](cdc)),
@@ -90,6 +112,16 @@ func NewKeeper(
 		VSOAByParticipant: collections.NewMap(sb, types.VSOAByParticipantKey, "vsoa_by_participant",
 			collections.Uint64Key, collections.Uint64Value),
 		VSOASeq: collections.NewSequence(sb, types.VSOASeqKey, "vsoa_seq"),
+		VSOAByOperator: collections.NewKeySet(sb, types.VSOAByOperatorKey, "vsoa_by_operator",
+			opCorpKeyCodec),
+
+		OperatorRoles: collections.NewMap(sb, types.OperatorRoleKey, "operator_role",
+			corpOpKeyCodec, codec.CollValue[types.OperatorRole](cdc)),
+		OperatorAuthorizationByRole: collections.NewKeySet(sb, types.OperatorAuthorizationByRoleKey, "operator_authorization_by_role",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Uint64Key)),
+
+		CorporationFreezes: collections.NewMap(sb, types.CorporationFreezeKey, "corporation_freeze",
+			collections.Uint64Key, codec.CollValue[types.CorporationFreeze](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -173,3 +205,26 @@ func (k Keeper) getVSOAByCorpOp(ctx context.Context, corporationID uint64, vsOpe
 	}
 	return vsoa, true, nil
 }
+
+// RebuildOperatorIndexes recreates the (operator, corporation_id) reverse
+// indexes from the stored operator and VS operator authorizations.
+func (k Keeper) RebuildOperatorIndexes(ctx context.Context) error {
+	if err := k.OperatorAuthorizationByOperator.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.OperatorAuthorizations.Walk(ctx, nil, func(_ uint64, oa types.OperatorAuthorization) (bool, error) {
+		return false, k.OperatorAuthorizationByOperator.Set(ctx, collections.Join(oa.Operator, oa.CorporationId))
+	}); err != nil {
+		return fmt.Errorf("failed to rebuild operator authorization index: %w", err)
+	}
+
+	if err := k.VSOAByOperator.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.VSOperatorAuthorizations.Walk(ctx, nil, func(_ uint64, vsoa types.VSOperatorAuthorization) (bool, error) {
+		return false, k.VSOAByOperator.Set(ctx, collections.Join(vsoa.VsOperator, vsoa.CorporationId))
+	}); err != nil {
+		return fmt.Errorf("failed to rebuild vs operator authorization index: %w", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -56,12 +57,19 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 
+	// The router executes the inner messages of MsgExecAsCorporation; only the
+	// MOD-DE msg server is routed in these tests.
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		router,
 	)
+	types.RegisterMsgServer(router, keeper.NewMsgServerImpl(k))
 
 	// AUTHZ-CHECK-5: wire a permissive corporation keeper (the app wires the real
 	// MOD-CO keeper post-construction via the depinject cycle break).
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+// AcceptOperatorAuthorization lets the grantee of an operator authorization
+// granted with require_acceptance consent to it. The authorization and its fee
+// grant become active once accepted and past their not_before.
+func (ms msgServer) AcceptOperatorAuthorization(goCtx context.Context, msg *types.MsgAcceptOperatorAuthorization) (*types.MsgAcceptOperatorAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// An OperatorAuthorization pending acceptance MUST exist for
+	// (corporation_id, grantee).
+	oa, found, err := ms.getOperatorAuthorizationByCorpOp(ctx, msg.CorporationId, msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrOperatorAuthzNotFound
+	}
+	if !oa.Pending {
+		return nil, types.ErrOperatorAuthzNotPending
+	}
+	if oa.Expiration != nil && !oa.Expiration.After(now) {
+		return nil, types.ErrAuthzExpired
+	}
+
+	oa.Pending = false
+	if err := ms.OperatorAuthorizations.Set(ctx, oa.Id, oa); err != nil {
+		return nil, fmt.Errorf("failed to set OperatorAuthorization: %w", err)
+	}
+	if err := ms.setFeeGrantActivation(ctx, oa.CorporationId, msg.Grantee, false, oa.NotBefore); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptOperatorAuthorization,
+			sdk.NewAttribute(types.AttributeKeyAuthzID, strconv.FormatUint(oa.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(oa.CorporationId, 10)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return &types.MsgAcceptOperatorAuthorizationResponse{}, nil
+}
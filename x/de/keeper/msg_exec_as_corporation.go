@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+// corporationMsg is implemented by the Verana messages run by an operator on
+// behalf of a corporation account.
+type corporationMsg interface {
+	GetCorporation() string
+	GetOperator() string
+}
+
+// authorityMsg is implemented by the Verana messages naming the corporation
+// account authority instead of corporation.
+type authorityMsg interface {
+	GetAuthority() string
+	GetOperator() string
+}
+
+// ExecAsCorporation checks the operator's authorization for every inner
+// message type, then executes the inner messages in order through the message
+// router. The inner messages are applied only if all of them succeed, and
+// their events are emitted with the index of the message that emitted them.
+func (ms msgServer) ExecAsCorporation(goCtx context.Context, msg *types.MsgExecAsCorporation) (*types.MsgExecAsCorporationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	if ms.router == nil {
+		return nil, fmt.Errorf("message router is required to execute messages")
+	}
+
+	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation;
+	// resolve it to co.id.
+	co, err := ms.corporationKeeper().ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check every inner message before executing any of them.
+	for i, inner := range msgs {
+		typeURL := sdk.MsgTypeURL(inner)
+		if !types.VPRDelegableMsgTypes[typeURL] || typeURL == types.MsgCreateOrUpdateParticipantSessionTypeURL {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMsgType, "message %d: %s", i, typeURL)
+		}
+		if err := ms.validateExecMsg(inner, msg.Corporation, msg.Operator); err != nil {
+			return nil, errorsmod.Wrapf(err, "message %d: %s", i, typeURL)
+		}
+
+		// [AUTHZ-CHECK-1] The operator MUST be authorized for the inner message type.
+		if err := ms.CheckOperatorAuthorization(ctx, msg.Corporation, msg.Operator, typeURL, now); err != nil {
+			return nil, errorsmod.Wrapf(err, "message %d: %s", i, typeURL)
+		}
+	}
+
+	// Execute on a cached context written back only once every message succeeded.
+	cacheCtx, write := ctx.CacheContext()
+	results := make([][]byte, len(msgs))
+	for i, inner := range msgs {
+		handler := ms.router.Handler(inner)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(inner))
+		}
+		res, err := handler(cacheCtx, inner)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d: %s", i, sdk.MsgTypeURL(inner))
+		}
+		results[i] = res.Data
+
+		// The router runs the handler on its own event manager, re-emit its
+		// events like x/authz does for the messages it dispatches.
+		events := make(sdk.Events, 0, len(res.GetEvents()))
+		for _, event := range res.GetEvents() {
+			e := sdk.Event(event)
+			e.Attributes = append(e.Attributes, abci.EventAttribute{Key: types.AttributeKeyMsgIndex, Value: strconv.Itoa(i)})
+			events = append(events, e)
+		}
+		cacheCtx.EventManager().EmitEvents(events)
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAsCorporation,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyMsgCount, strconv.Itoa(len(msgs))),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return &types.MsgExecAsCorporationResponse{Results: results}, nil
+}
+
+// validateExecMsg checks that inner is run by operator for corporation and is
+// signed by one of them, so that the inner handler runs its own authorization
+// check, spend metering included, for the same pair.
+func (ms msgServer) validateExecMsg(inner sdk.Msg, corporation, operator string) error {
+	var innerCorporation, innerOperator string
+	switch m := inner.(type) {
+	case corporationMsg:
+		innerCorporation, innerOperator = m.GetCorporation(), m.GetOperator()
+	case authorityMsg:
+		innerCorporation, innerOperator = m.GetAuthority(), m.GetOperator()
+	default:
+		return errorsmod.Wrap(types.ErrInvalidExecMsg, "message has no corporation and operator")
+	}
+	if innerCorporation != corporation || innerOperator != operator {
+		return errorsmod.Wrapf(types.ErrInvalidExecMsg, "message must be run by operator %s for corporation %s", operator, corporation)
+	}
+
+	signers, _, err := ms.cdc.GetMsgV1Signers(inner)
+	if err != nil {
+		return err
+	}
+	corporationAddr, err := ms.addressCodec.StringToBytes(corporation)
+	if err != nil {
+		return err
+	}
+	operatorAddr, err := ms.addressCodec.StringToBytes(operator)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 || (!bytes.Equal(signers[0], corporationAddr) && !bytes.Equal(signers[0], operatorAddr)) {
+		return errorsmod.Wrap(types.ErrInvalidExecMsg, "message must be signed by the corporation or the operator")
+	}
+	return nil
+}
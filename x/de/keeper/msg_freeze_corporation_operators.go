@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+// FreezeCorporationOperators suspends every operator and VS operator
+// authorization of the signing corporation until it is unfrozen through a group
+// proposal.
+func (ms msgServer) FreezeCorporationOperators(goCtx context.Context, msg *types.MsgFreezeCorporationOperators) (*types.MsgFreezeCorporationOperatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// [AUTHZ-CHECK-1] Verify operator authorization for this (corporation, operator)
+	// pair. An already frozen corporation rejects its operators here.
+	if err := ms.CheckOperatorAuthorization(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.de.v1.MsgFreezeCorporationOperators",
+		now,
+	); err != nil {
+		return nil, err
+	}
+
+	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation;
+	// resolve it to co.id.
+	co, err := ms.corporationKeeper().ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkCorporationNotFrozen(ctx, co.Id); err != nil {
+		return nil, err
+	}
+
+	freeze := types.CorporationFreeze{
+		CorporationId: co.Id,
+		FrozenBy:      msg.Operator,
+		Reason:        msg.Reason,
+		FrozenAt:      now,
+	}
+	if err := ms.CorporationFreezes.Set(ctx, co.Id, freeze); err != nil {
+		return nil, fmt.Errorf("failed to set corporation freeze: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeCorporationOperators,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return &types.MsgFreezeCorporationOperatorsResponse{}, nil
+}
+
+// UnfreezeCorporationOperators lifts the freeze of the signing corporation. The
+// message has no operator: it is only executed by the corporation group policy.
+func (ms msgServer) UnfreezeCorporationOperators(goCtx context.Context, msg *types.MsgUnfreezeCorporationOperators) (*types.MsgUnfreezeCorporationOperatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation;
+	// resolve it to co.id.
+	co, err := ms.corporationKeeper().ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := ms.CorporationFreezes.Has(ctx, co.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read corporation freeze: %w", err)
+	}
+	if !frozen {
+		return nil, errorsmod.Wrapf(types.ErrCorporationNotFrozen, "corporation %d", co.Id)
+	}
+	if err := ms.CorporationFreezes.Remove(ctx, co.Id); err != nil {
+		return nil, fmt.Errorf("failed to remove corporation freeze: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreezeCorporationOperators,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	)
+
+	return &types.MsgUnfreezeCorporationOperatorsResponse{}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/verana-labs/verana/x/de/types"
@@ -49,6 +50,12 @@ func (ms msgServer) GrantOperatorAuthorization(goCtx context.Context, msg *types
 		return nil, types.ErrExpirationInPast
 	}
 
+	// not_before must be in the future if specified, giving the members of the
+	// corporation time to revoke the authorization before it activates.
+	if msg.NotBefore != nil && !msg.NotBefore.After(now) {
+		return nil, fmt.Errorf("not_before must be in the future")
+	}
+
 	// authz_spend_limit_period must be valid if authz_spend_limit is set.
 	if len(msg.AuthzSpendLimit) > 0 && msg.AuthzSpendLimitPeriod != nil && *msg.AuthzSpendLimitPeriod <= 0 {
 		return nil, fmt.Errorf("authz_spend_limit_period must be a positive duration")
@@ -64,6 +71,32 @@ func (ms msgServer) GrantOperatorAuthorization(goCtx context.Context, msg *types
 		return nil, types.ErrVSOperatorAuthzExists
 	}
 
+	// A referenced role MUST exist; it provides the msg_types and defaults the
+	// unset limits.
+	msgTypes := msg.MsgTypes
+	spendLimit := msg.AuthzSpendLimit
+	feeSpendLimit := msg.FeeSpendLimit
+	period := msg.AuthzSpendLimitPeriod
+	if msg.Role != "" {
+		role, err := ms.OperatorRoles.Get(ctx, collections.Join(co.Id, msg.Role))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, errorsmod.Wrapf(types.ErrOperatorRoleNotFound, "role %s", msg.Role)
+			}
+			return nil, fmt.Errorf("failed to get operator role: %w", err)
+		}
+		msgTypes = role.MsgTypes
+		if len(spendLimit) == 0 {
+			spendLimit = role.SpendLimit
+		}
+		if len(feeSpendLimit) == 0 {
+			feeSpendLimit = role.FeeSpendLimit
+		}
+		if period == nil {
+			period = role.Period
+		}
+	}
+
 	// [MOD-DE-MSG-3-4] Execution.
 
 	// 1. Lookup existing OperatorAuthorization by (co.id, grantee): preserve id
@@ -73,8 +106,10 @@ func (ms msgServer) GrantOperatorAuthorization(goCtx context.Context, msg *types
 		return nil, err
 	}
 	var oaID uint64
+	oldRole := ""
 	if found {
 		oaID = existing.Id
+		oldRole = existing.Role
 	} else {
 		oaID, err = ms.nextOperatorAuthorizationID(ctx)
 		if err != nil {
@@ -86,11 +121,14 @@ func (ms msgServer) GrantOperatorAuthorization(goCtx context.Context, msg *types
 		Id:            oaID,
 		CorporationId: co.Id,
 		Operator:      msg.Grantee,
-		MsgTypes:      msg.MsgTypes,
-		SpendLimit:    msg.AuthzSpendLimit,
-		FeeSpendLimit: msg.FeeSpendLimit,
+		MsgTypes:      msgTypes,
+		SpendLimit:    spendLimit,
+		FeeSpendLimit: feeSpendLimit,
 		Expiration:    msg.Expiration,
-		Period:        msg.AuthzSpendLimitPeriod,
+		Period:        period,
+		Role:          msg.Role,
+		Pending:       msg.RequireAcceptance,
+		NotBefore:     msg.NotBefore,
 	}
 	if err := ms.OperatorAuthorizations.Set(ctx, oaID, oa); err != nil {
 		return nil, fmt.Errorf("failed to set OperatorAuthorization: %w", err)
@@ -98,6 +136,12 @@ func (ms msgServer) GrantOperatorAuthorization(goCtx context.Context, msg *types
 	if err := ms.OperatorAuthorizationByCorpOp.Set(ctx, collections.Join(co.Id, msg.Grantee), oaID); err != nil {
 		return nil, fmt.Errorf("failed to set OperatorAuthorization index: %w", err)
 	}
+	if err := ms.OperatorAuthorizationByOperator.Set(ctx, collections.Join(msg.Grantee, co.Id)); err != nil {
+		return nil, fmt.Errorf("failed to set OperatorAuthorization operator index: %w", err)
+	}
+	if err := ms.updateOperatorAuthorizationRole(ctx, co.Id, oaID, oldRole, msg.Role); err != nil {
+		return nil, err
+	}
 
 	// Reset spend ledger when re-granting so a new limit takes effect from zero.
 	if err := ms.OperatorAuthorizationUsage.Remove(ctx, oaID); err != nil && !errors.Is(err, collections.ErrNotFound) {
@@ -114,26 +158,32 @@ func (ms msgServer) GrantOperatorAuthorization(goCtx context.Context, msg *types
 			ctx,
 			co.Id,
 			msg.Grantee,
-			msg.MsgTypes,
+			msgTypes,
 			msg.Expiration,
 			msg.FeegrantSpendLimit,
 			msg.FeegrantSpendLimitPeriod,
 		); err != nil {
 			return nil, fmt.Errorf("failed to grant fee allowance: %w", err)
 		}
+		if err := ms.setFeeGrantActivation(ctx, co.Id, msg.Grantee, oa.Pending, oa.NotBefore); err != nil {
+			return nil, err
+		}
 	}
 
 	// 3. Emit events.
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeGrantOperatorAuthorization,
-			sdk.NewAttribute(types.AttributeKeyAuthzID, strconv.FormatUint(oaID, 10)),
-			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
-			sdk.NewAttribute(types.AttributeKeyWithFeegrant, fmt.Sprintf("%t", msg.WithFeegrant)),
-			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
-		),
-	})
+	event := sdk.NewEvent(
+		types.EventTypeGrantOperatorAuthorization,
+		sdk.NewAttribute(types.AttributeKeyAuthzID, strconv.FormatUint(oaID, 10)),
+		sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+		sdk.NewAttribute(types.AttributeKeyWithFeegrant, fmt.Sprintf("%t", msg.WithFeegrant)),
+		sdk.NewAttribute(types.AttributeKeyPending, fmt.Sprintf("%t", oa.Pending)),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+	)
+	if oa.NotBefore != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyNotBefore, oa.NotBefore.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
 
 	return &types.MsgGrantOperatorAuthorizationResponse{}, nil
 }
@@ -55,6 +55,12 @@ func (ms msgServer) RevokeOperatorAuthorization(goCtx context.Context, msg *type
 	if err := ms.OperatorAuthorizationByCorpOp.Remove(ctx, collections.Join(co.Id, msg.Grantee)); err != nil {
 		return nil, fmt.Errorf("failed to remove OperatorAuthorization index: %w", err)
 	}
+	if err := ms.OperatorAuthorizationByOperator.Remove(ctx, collections.Join(msg.Grantee, co.Id)); err != nil {
+		return nil, fmt.Errorf("failed to remove OperatorAuthorization operator index: %w", err)
+	}
+	if err := ms.updateOperatorAuthorizationRole(ctx, co.Id, existing.Id, existing.Role, ""); err != nil {
+		return nil, err
+	}
 	if err := ms.OperatorAuthorizationUsage.Remove(ctx, existing.Id); err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return nil, fmt.Errorf("failed to clear usage ledger: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -164,6 +165,386 @@ func TestRevokeOperatorAuthorization(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrOperatorAuthzNotFound)
 }
 
+// ---------------------------------------------------------------------------
+// Operator roles
+// ---------------------------------------------------------------------------
+
+func TestOperatorRoles(t *testing.T) {
+	f, ms, ctx := setupMsgServer(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	corporation := acc("corp________________")
+	admin := acc("schema_admin________")
+	custom := acc("custom_limits_______")
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("uvna", 100))
+
+	_, err := ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: admin, Role: "schema-admin",
+	})
+	require.ErrorIs(t, err, types.ErrOperatorRoleNotFound)
+
+	res, err := ms.SetOperatorRole(ctx, &types.MsgSetOperatorRole{
+		Corporation: corporation, Name: "schema-admin", MsgTypes: []string{mtSchema}, SpendLimit: spendLimit,
+	})
+	require.NoError(t, err)
+	require.Zero(t, res.UpdatedAuthorizations)
+
+	// Grants take the role msg_types and default limits unless overridden.
+	_, err = ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: admin, Role: "schema-admin", WithFeegrant: true,
+	})
+	require.NoError(t, err)
+	override := sdk.NewCoins(sdk.NewInt64Coin("uvna", 5))
+	_, err = ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: custom, Role: "schema-admin", AuthzSpendLimit: override,
+	})
+	require.NoError(t, err)
+
+	operators, err := qs.ListRoleOperators(ctx, &types.QueryListRoleOperatorsRequest{CorporationId: 1, Role: "schema-admin"})
+	require.NoError(t, err)
+	require.Len(t, operators.OperatorAuthorizations, 2)
+	require.Equal(t, []string{mtSchema}, operators.OperatorAuthorizations[0].MsgTypes)
+	require.Equal(t, spendLimit, operators.OperatorAuthorizations[0].SpendLimit)
+	require.Equal(t, override, operators.OperatorAuthorizations[1].SpendLimit)
+
+	// Editing the role propagates to every grant, limits only where inherited.
+	newLimit := sdk.NewCoins(sdk.NewInt64Coin("uvna", 200))
+	res, err = ms.SetOperatorRole(ctx, &types.MsgSetOperatorRole{
+		Corporation: corporation, Name: "schema-admin", MsgTypes: []string{mtSchema, mtEcosystem}, SpendLimit: newLimit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.UpdatedAuthorizations)
+
+	getOA := func(operator string) types.OperatorAuthorization {
+		id, err := f.keeper.OperatorAuthorizationByCorpOp.Get(ctx, collections.Join(uint64(1), operator))
+		require.NoError(t, err)
+		oa, err := f.keeper.OperatorAuthorizations.Get(ctx, id)
+		require.NoError(t, err)
+		return oa
+	}
+	oa := getOA(admin)
+	require.Equal(t, []string{mtSchema, mtEcosystem}, oa.MsgTypes)
+	require.Equal(t, newLimit, oa.SpendLimit)
+	fg, err := f.keeper.FeeGrants.Get(ctx, collections.Join(uint64(1), admin))
+	require.NoError(t, err)
+	require.Equal(t, []string{mtSchema, mtEcosystem}, fg.MsgTypes)
+
+	oa = getOA(custom)
+	require.Equal(t, []string{mtSchema, mtEcosystem}, oa.MsgTypes)
+	require.Equal(t, override, oa.SpendLimit)
+
+	roles, err := qs.ListOperatorRoles(ctx, &types.QueryListOperatorRolesRequest{CorporationId: 1})
+	require.NoError(t, err)
+	require.Len(t, roles.OperatorRoles, 1)
+
+	// A role in use cannot be deleted; re-granting without role or revoking
+	// releases it.
+	_, err = ms.DeleteOperatorRole(ctx, &types.MsgDeleteOperatorRole{Corporation: corporation, Name: "schema-admin"})
+	require.ErrorIs(t, err, types.ErrOperatorRoleInUse)
+	_, err = ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: admin, MsgTypes: []string{mtEcosystem},
+	})
+	require.NoError(t, err)
+	_, err = ms.RevokeOperatorAuthorization(ctx, &types.MsgRevokeOperatorAuthorization{Corporation: corporation, Grantee: custom})
+	require.NoError(t, err)
+	operators, err = qs.ListRoleOperators(ctx, &types.QueryListRoleOperatorsRequest{CorporationId: 1, Role: "schema-admin"})
+	require.NoError(t, err)
+	require.Empty(t, operators.OperatorAuthorizations)
+
+	_, err = ms.DeleteOperatorRole(ctx, &types.MsgDeleteOperatorRole{Corporation: corporation, Name: "schema-admin"})
+	require.NoError(t, err)
+	_, err = qs.ListRoleOperators(ctx, &types.QueryListRoleOperatorsRequest{CorporationId: 1, Role: "schema-admin"})
+	require.Error(t, err)
+}
+
+func TestOperatorRoles_EscalationGuard(t *testing.T) {
+	_, ms, ctx := setupMsgServer(t)
+	corporation := acc("corp________________")
+	operator := acc("operator____________")
+
+	_, err := ms.SetOperatorRole(ctx, &types.MsgSetOperatorRole{
+		Corporation: corporation, Name: "role-admin", MsgTypes: []string{"/verana.de.v1.MsgSetOperatorRole"},
+	})
+	require.NoError(t, err)
+	_, err = ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: operator, Role: "role-admin",
+	})
+	require.NoError(t, err)
+
+	// The operator cannot widen the role it is granted from.
+	_, err = ms.SetOperatorRole(ctx, &types.MsgSetOperatorRole{
+		Corporation: corporation, Operator: operator, Name: "role-admin",
+		MsgTypes: []string{"/verana.de.v1.MsgSetOperatorRole", mtEcosystem},
+	})
+	require.ErrorContains(t, err, "operator cannot edit the role it is granted from")
+
+	// Other roles remain editable by the operator, and the group can edit its
+	// role.
+	_, err = ms.SetOperatorRole(ctx, &types.MsgSetOperatorRole{
+		Corporation: corporation, Operator: operator, Name: "issuer-ops", MsgTypes: []string{mtSchema},
+	})
+	require.NoError(t, err)
+	res, err := ms.SetOperatorRole(ctx, &types.MsgSetOperatorRole{
+		Corporation: corporation, Name: "role-admin",
+		MsgTypes: []string{"/verana.de.v1.MsgSetOperatorRole", mtEcosystem},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.UpdatedAuthorizations)
+}
+
+func TestOperatorRoles_ValidateBasic(t *testing.T) {
+	corporation := acc("corp________________")
+
+	require.NoError(t, (&types.MsgSetOperatorRole{Corporation: corporation, Name: "issuer-ops", MsgTypes: []string{mtSchema}}).ValidateBasic())
+	require.Error(t, (&types.MsgSetOperatorRole{Corporation: corporation, Name: "Issuer Ops", MsgTypes: []string{mtSchema}}).ValidateBasic())
+	require.Error(t, (&types.MsgSetOperatorRole{Corporation: corporation, Name: "issuer-ops"}).ValidateBasic())
+
+	grantee := acc("grantee_____________")
+	require.NoError(t, (&types.MsgGrantOperatorAuthorization{Corporation: corporation, Grantee: grantee, Role: "issuer-ops"}).ValidateBasic())
+	require.Error(t, (&types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: grantee, Role: "issuer-ops", MsgTypes: []string{mtSchema},
+	}).ValidateBasic())
+}
+
+func TestListAuthorizationsByGrantee(t *testing.T) {
+	f, ms, ctx := setupMsgServer(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	corporation := acc("corp________________")
+	grantee := acc("grantee_____________")
+	now := ctx.BlockTime()
+	expiration := now.Add(time.Hour)
+
+	_, err := ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: grantee, MsgTypes: []string{mtEcosystem},
+		Expiration: &expiration, AuthzSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uvna", 100)),
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CheckOperatorAuthorizationWithSpend(ctx, corporation, grantee, mtEcosystem, now,
+		sdk.NewCoins(sdk.NewInt64Coin("uvna", 30))))
+	require.NoError(t, f.keeper.GrantVSOperatorAuthorization(ctx, 2, grantee,
+		types.ParticipantAuthorizationRecord{ParticipantId: 1, MsgTypes: []string{mtValidated}, Expiration: &expiration}))
+
+	check := func() {
+		res, err := qs.ListAuthorizationsByGrantee(ctx, &types.QueryListAuthorizationsByGranteeRequest{Grantee: grantee})
+		require.NoError(t, err)
+		require.Len(t, res.Authorizations, 2)
+		require.Equal(t, uint64(1), res.Authorizations[0].CorporationId)
+		require.NotNil(t, res.Authorizations[0].OperatorAuthorization)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uvna", 70)), res.Authorizations[0].RemainingSpend)
+		require.True(t, expiration.Equal(*res.Authorizations[0].Expiration))
+		require.Equal(t, uint64(2), res.Authorizations[1].CorporationId)
+		require.NotNil(t, res.Authorizations[1].VsOperatorAuthorization)
+		require.True(t, expiration.Equal(*res.Authorizations[1].Expiration))
+
+		list, err := qs.ListOperatorAuthorizations(ctx, &types.QueryListOperatorAuthorizationsRequest{Operator: grantee})
+		require.NoError(t, err)
+		require.Len(t, list.OperatorAuthorizations, 1)
+	}
+	check()
+
+	// The v2 migration backfills the reverse indexes.
+	require.NoError(t, f.keeper.OperatorAuthorizationByOperator.Clear(ctx, nil))
+	require.NoError(t, f.keeper.VSOAByOperator.Clear(ctx, nil))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	check()
+
+	_, err = ms.RevokeOperatorAuthorization(ctx, &types.MsgRevokeOperatorAuthorization{Corporation: corporation, Grantee: grantee})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.RevokeVSOperatorAuthorization(ctx, 1))
+	res, err := qs.ListAuthorizationsByGrantee(ctx, &types.QueryListAuthorizationsByGranteeRequest{Grantee: grantee})
+	require.NoError(t, err)
+	require.Empty(t, res.Authorizations)
+}
+
+func TestFreezeCorporationOperators(t *testing.T) {
+	f, ms, ctx := setupMsgServer(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	corporation := acc("corp________________")
+	guardian := acc("guardian____________")
+	operator := acc("operator____________")
+	vsOperator := acc("vsop________________")
+	now := ctx.BlockTime()
+	expiration := now.Add(time.Hour)
+
+	_, err := ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: guardian, MsgTypes: []string{"/verana.de.v1.MsgFreezeCorporationOperators"},
+	})
+	require.NoError(t, err)
+	_, err = ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: operator, MsgTypes: []string{mtEcosystem},
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.GrantVSOperatorAuthorization(ctx, 1, vsOperator,
+		types.ParticipantAuthorizationRecord{ParticipantId: 5, MsgTypes: []string{mtValidated}, Expiration: &expiration}))
+
+	// Operators without the freeze permission cannot freeze.
+	_, err = ms.FreezeCorporationOperators(ctx, &types.MsgFreezeCorporationOperators{Corporation: corporation, Operator: operator})
+	require.ErrorIs(t, err, types.ErrAuthzMsgTypeNotFound)
+
+	_, err = ms.FreezeCorporationOperators(ctx, &types.MsgFreezeCorporationOperators{
+		Corporation: corporation, Operator: guardian, Reason: "operator key compromised",
+	})
+	require.NoError(t, err)
+
+	// Every operator and VS operator is rejected, the group policy is not.
+	require.ErrorIs(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, operator, mtEcosystem, now), types.ErrCorporationFrozen)
+	require.ErrorIs(t, f.keeper.CheckVSOperatorAuthorizationOnParticipant(ctx, 1, vsOperator, 5, mtValidated), types.ErrCorporationFrozen)
+	require.NoError(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, "", mtEcosystem, now))
+
+	res, err := qs.GetCorporationFreeze(ctx, &types.QueryGetCorporationFreezeRequest{CorporationId: 1})
+	require.NoError(t, err)
+	require.True(t, res.Frozen)
+	require.Equal(t, guardian, res.Freeze.FrozenBy)
+	require.Equal(t, "operator key compromised", res.Freeze.Reason)
+
+	_, err = ms.FreezeCorporationOperators(ctx, &types.MsgFreezeCorporationOperators{Corporation: corporation})
+	require.ErrorIs(t, err, types.ErrCorporationFrozen)
+
+	_, err = ms.UnfreezeCorporationOperators(ctx, &types.MsgUnfreezeCorporationOperators{Corporation: corporation})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, operator, mtEcosystem, now))
+	require.NoError(t, f.keeper.CheckVSOperatorAuthorizationOnParticipant(ctx, 1, vsOperator, 5, mtValidated))
+
+	res, err = qs.GetCorporationFreeze(ctx, &types.QueryGetCorporationFreezeRequest{CorporationId: 1})
+	require.NoError(t, err)
+	require.False(t, res.Frozen)
+	require.Nil(t, res.Freeze)
+
+	_, err = ms.UnfreezeCorporationOperators(ctx, &types.MsgUnfreezeCorporationOperators{Corporation: corporation})
+	require.ErrorIs(t, err, types.ErrCorporationNotFrozen)
+}
+
+func TestAcceptOperatorAuthorization(t *testing.T) {
+	f, ms, ctx := setupMsgServer(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	corporation := acc("corp________________")
+	operator := acc("operator____________")
+	locked := acc("locked______________")
+	now := ctx.BlockTime()
+	notBefore := now.Add(time.Hour)
+
+	_, err := ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: operator, MsgTypes: []string{mtEcosystem},
+		WithFeegrant: true, RequireAcceptance: true,
+	})
+	require.NoError(t, err)
+	_, err = ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: locked, MsgTypes: []string{mtEcosystem}, NotBefore: &notBefore,
+	})
+	require.NoError(t, err)
+	_, err = ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: locked, MsgTypes: []string{mtEcosystem}, NotBefore: &now,
+	})
+	require.ErrorContains(t, err, "not_before must be in the future")
+
+	// Neither authorization is active yet.
+	require.ErrorIs(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, operator, mtEcosystem, now), types.ErrAuthzNotFound)
+	require.ErrorIs(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, locked, mtEcosystem, now), types.ErrAuthzNotFound)
+	fg, err := f.keeper.FeeGrants.Get(ctx, collections.Join(uint64(1), operator))
+	require.NoError(t, err)
+	require.True(t, fg.Pending)
+
+	pending, err := qs.ListPendingOperatorAuthorizations(ctx, &types.QueryListPendingOperatorAuthorizationsRequest{CorporationId: 1})
+	require.NoError(t, err)
+	require.Len(t, pending.OperatorAuthorizations, 2)
+
+	// Only the grantee of a pending authorization can accept it.
+	_, err = ms.AcceptOperatorAuthorization(ctx, &types.MsgAcceptOperatorAuthorization{Grantee: locked, CorporationId: 1})
+	require.ErrorIs(t, err, types.ErrOperatorAuthzNotPending)
+	_, err = ms.AcceptOperatorAuthorization(ctx, &types.MsgAcceptOperatorAuthorization{Grantee: acc("stranger____________"), CorporationId: 1})
+	require.ErrorIs(t, err, types.ErrOperatorAuthzNotFound)
+
+	_, err = ms.AcceptOperatorAuthorization(ctx, &types.MsgAcceptOperatorAuthorization{Grantee: operator, CorporationId: 1})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, operator, mtEcosystem, now))
+	fg, err = f.keeper.FeeGrants.Get(ctx, collections.Join(uint64(1), operator))
+	require.NoError(t, err)
+	require.False(t, fg.Pending)
+
+	// The time locked authorization activates at not_before.
+	require.NoError(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, locked, mtEcosystem, notBefore))
+	pending, err = qs.ListPendingOperatorAuthorizations(ctx, &types.QueryListPendingOperatorAuthorizationsRequest{Operator: locked})
+	require.NoError(t, err)
+	require.Len(t, pending.OperatorAuthorizations, 1)
+	pending, err = qs.ListPendingOperatorAuthorizations(ctx.WithBlockTime(notBefore), &types.QueryListPendingOperatorAuthorizationsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, pending.OperatorAuthorizations)
+
+	// It can be revoked before it activates.
+	_, err = ms.RevokeOperatorAuthorization(ctx, &types.MsgRevokeOperatorAuthorization{Corporation: corporation, Grantee: locked})
+	require.NoError(t, err)
+	require.ErrorIs(t, f.keeper.CheckOperatorAuthorization(ctx, corporation, locked, mtEcosystem, notBefore), types.ErrAuthzNotFound)
+
+	expiration := now.Add(time.Minute)
+	require.Error(t, (&types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: locked, MsgTypes: []string{mtEcosystem}, Expiration: &expiration, NotBefore: &notBefore,
+	}).ValidateBasic())
+}
+
+func TestExecAsCorporation(t *testing.T) {
+	f, ms, ctx := setupMsgServer(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	corporation := acc("corp________________")
+	operator := acc("operator____________")
+
+	_, err := ms.GrantOperatorAuthorization(ctx, &types.MsgGrantOperatorAuthorization{
+		Corporation: corporation, Grantee: operator, MsgTypes: []string{"/verana.de.v1.MsgSetOperatorRole"},
+	})
+	require.NoError(t, err)
+
+	exec := func(msgs ...sdk.Msg) error {
+		anys := make([]*codectypes.Any, len(msgs))
+		for i, m := range msgs {
+			a, err := codectypes.NewAnyWithValue(m)
+			require.NoError(t, err)
+			anys[i] = a
+		}
+		_, err := ms.ExecAsCorporation(ctx, &types.MsgExecAsCorporation{Corporation: corporation, Operator: operator, Msgs: anys})
+		return err
+	}
+	setRole := func(name string) *types.MsgSetOperatorRole {
+		return &types.MsgSetOperatorRole{Corporation: corporation, Operator: operator, Name: name, MsgTypes: []string{mtSchema}}
+	}
+	listRoles := func() []types.OperatorRole {
+		res, err := qs.ListOperatorRoles(ctx, &types.QueryListOperatorRolesRequest{CorporationId: 1})
+		require.NoError(t, err)
+		return res.OperatorRoles
+	}
+
+	// Every inner message type must be authorized before any is executed.
+	err = exec(setRole("issuer-ops"), &types.MsgDeleteOperatorRole{Corporation: corporation, Operator: operator, Name: "issuer-ops"})
+	require.ErrorIs(t, err, types.ErrAuthzMsgTypeNotFound)
+	require.Empty(t, listRoles())
+
+	// Inner messages must be run by the same operator for the same corporation.
+	other := setRole("issuer-ops")
+	other.Operator = ""
+	require.ErrorIs(t, exec(other), types.ErrInvalidExecMsg)
+
+	// A failing inner message reverts the ones executed before it.
+	invalid := setRole("schema-admin")
+	invalid.MsgTypes = []string{"/verana.de.v1.MsgUpdateParams"}
+	require.Error(t, exec(setRole("issuer-ops"), invalid))
+	require.Empty(t, listRoles())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, exec(setRole("issuer-ops"), setRole("schema-admin")))
+	require.Len(t, listRoles(), 2)
+
+	// The events of the inner messages are emitted with their index.
+	var inner []string
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeSetOperatorRole {
+			continue
+		}
+		role, ok := ev.GetAttribute(types.AttributeKeyRole)
+		require.True(t, ok)
+		index, ok := ev.GetAttribute(types.AttributeKeyMsgIndex)
+		require.True(t, ok)
+		inner = append(inner, index.Value+":"+role.Value)
+	}
+	require.Equal(t, []string{"0:issuer-ops", "1:schema-admin"}, inner)
+}
+
 // ---------------------------------------------------------------------------
 // [MOD-DE-MSG-5/6/9] VS Operator Authorization (module-call keeper methods)
 // ---------------------------------------------------------------------------
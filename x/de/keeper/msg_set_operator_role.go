@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+// SetOperatorRole creates or updates a named operator role of a corporation
+// and propagates an update to the authorizations granted from the role.
+func (ms msgServer) SetOperatorRole(goCtx context.Context, msg *types.MsgSetOperatorRole) (*types.MsgSetOperatorRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// [AUTHZ-CHECK-1] Verify operator authorization for this (corporation, operator) pair.
+	if err := ms.CheckOperatorAuthorization(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.de.v1.MsgSetOperatorRole",
+		now,
+	); err != nil {
+		return nil, err
+	}
+
+	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation;
+	// resolve it to co.id.
+	co, err := ms.corporationKeeper().ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	// Role escalation guard. An operator invoking this method cannot edit the
+	// role its own authorization is granted from, the edit would propagate to
+	// its grant. Such edits are only permitted via a group proposal
+	// (operator == "").
+	if msg.Operator != "" {
+		oa, found, err := ms.getOperatorAuthorizationByCorpOp(ctx, co.Id, msg.Operator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get operator authorization: %w", err)
+		}
+		if found && oa.Role == msg.Name {
+			return nil, fmt.Errorf("operator cannot edit the role it is granted from; use a group proposal")
+		}
+	}
+
+	key := collections.Join(co.Id, msg.Name)
+	previous, err := ms.OperatorRoles.Get(ctx, key)
+	found := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get operator role: %w", err)
+	}
+
+	role := types.OperatorRole{
+		CorporationId: co.Id,
+		Name:          msg.Name,
+		MsgTypes:      msg.MsgTypes,
+		SpendLimit:    msg.SpendLimit,
+		FeeSpendLimit: msg.FeeSpendLimit,
+		Period:        msg.Period,
+	}
+	if err := ms.OperatorRoles.Set(ctx, key, role); err != nil {
+		return nil, fmt.Errorf("failed to set operator role: %w", err)
+	}
+
+	var updated uint64
+	if found {
+		updated, err = ms.propagateOperatorRole(ctx, previous, role)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to propagate role %s", msg.Name)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetOperatorRole,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAuthz, strconv.FormatUint(updated, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return &types.MsgSetOperatorRoleResponse{UpdatedAuthorizations: updated}, nil
+}
+
+// DeleteOperatorRole deletes an operator role of a corporation no operator
+// authorization is granted from.
+func (ms msgServer) DeleteOperatorRole(goCtx context.Context, msg *types.MsgDeleteOperatorRole) (*types.MsgDeleteOperatorRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// [AUTHZ-CHECK-1] Verify operator authorization for this (corporation, operator) pair.
+	if err := ms.CheckOperatorAuthorization(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.de.v1.MsgDeleteOperatorRole",
+		now,
+	); err != nil {
+		return nil, err
+	}
+
+	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation;
+	// resolve it to co.id.
+	co, err := ms.corporationKeeper().ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(co.Id, msg.Name)
+	has, err := ms.OperatorRoles.Has(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator role: %w", err)
+	}
+	if !has {
+		return nil, errorsmod.Wrapf(types.ErrOperatorRoleNotFound, "role %s", msg.Name)
+	}
+
+	ids, err := ms.getRoleOperatorAuthorizationIDs(ctx, co.Id, msg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role operator authorizations: %w", err)
+	}
+	if len(ids) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrOperatorRoleInUse, "role %s is granted to %d operators", msg.Name, len(ids))
+	}
+
+	if err := ms.OperatorRoles.Remove(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to remove operator role: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteOperatorRole,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return &types.MsgDeleteOperatorRoleResponse{}, nil
+}
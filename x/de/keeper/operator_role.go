@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+// updateOperatorAuthorizationRole moves OperatorAuthorization oaID of
+// corporationID from the role index of oldRole to the one of newRole. Empty
+// role names are not indexed.
+func (k Keeper) updateOperatorAuthorizationRole(ctx context.Context, corporationID, oaID uint64, oldRole, newRole string) error {
+	if oldRole == newRole {
+		return nil
+	}
+	if oldRole != "" {
+		if err := k.OperatorAuthorizationByRole.Remove(ctx, collections.Join3(corporationID, oldRole, oaID)); err != nil {
+			return fmt.Errorf("failed to remove OperatorAuthorization role index: %w", err)
+		}
+	}
+	if newRole != "" {
+		if err := k.OperatorAuthorizationByRole.Set(ctx, collections.Join3(corporationID, newRole, oaID)); err != nil {
+			return fmt.Errorf("failed to set OperatorAuthorization role index: %w", err)
+		}
+	}
+	return nil
+}
+
+// getRoleOperatorAuthorizationIDs returns the ids of the operator
+// authorizations granted from role name of corporationID.
+func (k Keeper) getRoleOperatorAuthorizationIDs(ctx context.Context, corporationID uint64, name string) ([]uint64, error) {
+	var ids []uint64
+	rng := collections.NewSuperPrefixedTripleRange[uint64, string, uint64](corporationID, name)
+	if err := k.OperatorAuthorizationByRole.Walk(ctx, rng, func(key collections.Triple[uint64, string, uint64]) (bool, error) {
+		ids = append(ids, key.K3())
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// propagateOperatorRole applies the update of a role from previous to role to
+// every operator authorization granted from it: msg_types always follow the
+// role, limits only when the authorization still carries the previous role
+// defaults. The spend ledger of an authorization whose limits change is reset
+// and its fee grant, if any, follows the new msg_types. It returns the number
+// of updated authorizations.
+func (k Keeper) propagateOperatorRole(ctx context.Context, previous, role types.OperatorRole) (uint64, error) {
+	ids, err := k.getRoleOperatorAuthorizationIDs(ctx, role.CorporationId, role.Name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list role operator authorizations: %w", err)
+	}
+
+	for _, id := range ids {
+		oa, err := k.OperatorAuthorizations.Get(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get OperatorAuthorization %d: %w", id, err)
+		}
+
+		oa.MsgTypes = role.MsgTypes
+		limitsChanged := false
+		if oa.SpendLimit.Equal(previous.SpendLimit) && !role.SpendLimit.Equal(previous.SpendLimit) {
+			oa.SpendLimit = role.SpendLimit
+			limitsChanged = true
+		}
+		if oa.FeeSpendLimit.Equal(previous.FeeSpendLimit) && !role.FeeSpendLimit.Equal(previous.FeeSpendLimit) {
+			oa.FeeSpendLimit = role.FeeSpendLimit
+			limitsChanged = true
+		}
+		if durationsEqual(oa.Period, previous.Period) && !durationsEqual(role.Period, previous.Period) {
+			oa.Period = role.Period
+			limitsChanged = true
+		}
+		if err := k.OperatorAuthorizations.Set(ctx, id, oa); err != nil {
+			return 0, fmt.Errorf("failed to set OperatorAuthorization %d: %w", id, err)
+		}
+		if limitsChanged {
+			if err := k.OperatorAuthorizationUsage.Remove(ctx, id); err != nil && !errors.Is(err, collections.ErrNotFound) {
+				return 0, fmt.Errorf("failed to reset usage ledger: %w", err)
+			}
+		}
+
+		fgKey := collections.Join(oa.CorporationId, oa.Operator)
+		fg, err := k.FeeGrants.Get(ctx, fgKey)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to get FeeGrant: %w", err)
+		}
+		fg.MsgTypes = role.MsgTypes
+		if err := k.FeeGrants.Set(ctx, fgKey, fg); err != nil {
+			return 0, fmt.Errorf("failed to set FeeGrant: %w", err)
+		}
+	}
+
+	return uint64(len(ids)), nil
+}
+
+// durationsEqual reports whether two optional durations are equal.
+func durationsEqual(a, b *time.Duration) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
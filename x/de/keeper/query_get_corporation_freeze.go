@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+// GetCorporationFreeze returns whether the operators of a corporation are
+// frozen, with the active freeze if any.
+func (q queryServer) GetCorporationFreeze(ctx context.Context, req *types.QueryGetCorporationFreezeRequest) (*types.QueryGetCorporationFreezeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.CorporationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "corporation_id must be greater than 0")
+	}
+
+	freeze, err := q.k.CorporationFreezes.Get(ctx, req.CorporationId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return &types.QueryGetCorporationFreezeResponse{}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetCorporationFreezeResponse{Frozen: true, Freeze: &freeze}, nil
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+func (q queryServer) ListAuthorizationsByGrantee(ctx context.Context, req *types.QueryListAuthorizationsByGranteeRequest) (*types.QueryListAuthorizationsByGranteeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Grantee); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address: %s", err)
+	}
+
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	rng := collections.NewPrefixedPairRange[string, uint64](req.Grantee)
+	var results []types.GranteeAuthorization
+
+	err := q.k.OperatorAuthorizationByOperator.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		oa, found, err := q.k.getOperatorAuthorizationByCorpOp(ctx, key.K2(), req.Grantee)
+		if err != nil || !found {
+			return true, err
+		}
+		remaining, err := q.k.remainingSpend(ctx, oa, now)
+		if err != nil {
+			return true, err
+		}
+		results = append(results, types.GranteeAuthorization{
+			CorporationId:         oa.CorporationId,
+			OperatorAuthorization: &oa,
+			RemainingSpend:        remaining,
+			Expiration:            oa.Expiration,
+		})
+		return len(results) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = q.k.VSOAByOperator.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		vsoa, found, err := q.k.getVSOAByCorpOp(ctx, key.K2(), req.Grantee)
+		if err != nil || !found {
+			return true, err
+		}
+		results = append(results, types.GranteeAuthorization{
+			CorporationId:           vsoa.CorporationId,
+			VsOperatorAuthorization: &vsoa,
+			Expiration:              latestRecordExpiration(vsoa),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Merge both kinds by corporation; a (corporation, grantee) pair holds at
+	// most one of them.
+	sort.SliceStable(results, func(i, j int) bool { return results[i].CorporationId < results[j].CorporationId })
+	if len(results) > int(req.ResponseMaxSize) {
+		results = results[:req.ResponseMaxSize]
+	}
+
+	return &types.QueryListAuthorizationsByGranteeResponse{
+		Authorizations: results,
+	}, nil
+}
+
+// latestRecordExpiration returns the latest expiration of the records of
+// vsoa, nil if any record does not expire.
+func latestRecordExpiration(vsoa types.VSOperatorAuthorization) *time.Time {
+	var latest *time.Time
+	for _, rec := range vsoa.Records {
+		if rec.Expiration == nil {
+			return nil
+		}
+		if latest == nil || rec.Expiration.After(*latest) {
+			latest = rec.Expiration
+		}
+	}
+	return latest
+}
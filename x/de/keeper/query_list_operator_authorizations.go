@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -25,16 +27,34 @@ func (q queryServer) ListOperatorAuthorizations(ctx context.Context, req *types.
 	// [MOD-DE-QRY-1-3] Walk through all operator authorizations and apply filters
 	var results []types.OperatorAuthorization
 
+	// With an operator filter, only the corporations of the operator index are
+	// visited instead of every authorization.
+	if req.Operator != "" {
+		rng := collections.NewPrefixedPairRange[string, uint64](req.Operator)
+		err := q.k.OperatorAuthorizationByOperator.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+			if req.CorporationId != 0 && key.K2() != req.CorporationId {
+				return false, nil
+			}
+			oa, found, err := q.k.getOperatorAuthorizationByCorpOp(ctx, key.K2(), req.Operator)
+			if err != nil || !found {
+				return true, err
+			}
+			results = append(results, oa)
+			return len(results) >= int(req.ResponseMaxSize), nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryListOperatorAuthorizationsResponse{
+			OperatorAuthorizations: results,
+		}, nil
+	}
+
 	err := q.k.OperatorAuthorizations.Walk(ctx, nil, func(_ uint64, oa types.OperatorAuthorization) (bool, error) {
 		// Filter by corporation_id if specified
 		if req.CorporationId != 0 && oa.CorporationId != req.CorporationId {
 			return false, nil
 		}
-		// Filter by operator if specified
-		if req.Operator != "" && oa.Operator != req.Operator {
-			return false, nil
-		}
-
 		results = append(results, oa)
 		return len(results) >= int(req.ResponseMaxSize), nil
 	})
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+func (q queryServer) ListOperatorRoles(ctx context.Context, req *types.QueryListOperatorRolesRequest) (*types.QueryListOperatorRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.CorporationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "corporation_id is required")
+	}
+
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	var results []types.OperatorRole
+
+	rng := collections.NewPrefixedPairRange[uint64, string](req.CorporationId)
+	err := q.k.OperatorRoles.Walk(ctx, rng, func(_ collections.Pair[uint64, string], role types.OperatorRole) (bool, error) {
+		results = append(results, role)
+		return len(results) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListOperatorRolesResponse{
+		OperatorRoles: results,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+func (q queryServer) ListPendingOperatorAuthorizations(ctx context.Context, req *types.QueryListPendingOperatorAuthorizationsRequest) (*types.QueryListPendingOperatorAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	var results []types.OperatorAuthorization
+
+	// With an operator filter, only the corporations of the operator index are
+	// visited instead of every authorization.
+	if req.Operator != "" {
+		rng := collections.NewPrefixedPairRange[string, uint64](req.Operator)
+		err := q.k.OperatorAuthorizationByOperator.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+			if req.CorporationId != 0 && key.K2() != req.CorporationId {
+				return false, nil
+			}
+			oa, found, err := q.k.getOperatorAuthorizationByCorpOp(ctx, key.K2(), req.Operator)
+			if err != nil || !found {
+				return true, err
+			}
+			if oa.IsActive(now) {
+				return false, nil
+			}
+			results = append(results, oa)
+			return len(results) >= int(req.ResponseMaxSize), nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryListPendingOperatorAuthorizationsResponse{
+			OperatorAuthorizations: results,
+		}, nil
+	}
+
+	err := q.k.OperatorAuthorizations.Walk(ctx, nil, func(_ uint64, oa types.OperatorAuthorization) (bool, error) {
+		if req.CorporationId != 0 && oa.CorporationId != req.CorporationId {
+			return false, nil
+		}
+		if oa.IsActive(now) {
+			return false, nil
+		}
+		results = append(results, oa)
+		return len(results) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPendingOperatorAuthorizationsResponse{
+		OperatorAuthorizations: results,
+	}, nil
+}
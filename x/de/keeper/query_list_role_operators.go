@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/de/types"
+)
+
+func (q queryServer) ListRoleOperators(ctx context.Context, req *types.QueryListRoleOperatorsRequest) (*types.QueryListRoleOperatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.CorporationId == 0 || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "corporation_id and role are required")
+	}
+
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	has, err := q.k.OperatorRoles.Has(ctx, collections.Join(req.CorporationId, req.Role))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "operator role %s not found", req.Role)
+	}
+
+	var results []types.OperatorAuthorization
+
+	rng := collections.NewSuperPrefixedTripleRange[uint64, string, uint64](req.CorporationId, req.Role)
+	err = q.k.OperatorAuthorizationByRole.Walk(ctx, rng, func(key collections.Triple[uint64, string, uint64]) (bool, error) {
+		oa, err := q.k.OperatorAuthorizations.Get(ctx, key.K3())
+		if err != nil {
+			return true, err
+		}
+		results = append(results, oa)
+		return len(results) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListRoleOperatorsResponse{
+		OperatorAuthorizations: results,
+	}, nil
+}
@@ -101,6 +101,9 @@ func (k Keeper) GrantVSOperatorAuthorization(
 	if err := k.VSOAByCorpOp.Set(ctx, collections.Join(corporationID, vsOperator), vsoa.Id); err != nil {
 		return fmt.Errorf("failed to set VSOA index: %w", err)
 	}
+	if err := k.VSOAByOperator.Set(ctx, collections.Join(vsOperator, corporationID)); err != nil {
+		return fmt.Errorf("failed to set VSOA operator index: %w", err)
+	}
 	if err := k.VSOAByParticipant.Set(ctx, record.ParticipantId, vsoa.Id); err != nil {
 		return fmt.Errorf("failed to set participant index: %w", err)
 	}
@@ -160,6 +163,9 @@ func (k Keeper) RevokeVSOperatorAuthorization(ctx context.Context, participantID
 		if err := k.VSOAByCorpOp.Remove(ctx, collections.Join(vsoa.CorporationId, vsoa.VsOperator)); err != nil {
 			return fmt.Errorf("failed to remove VSOA index: %w", err)
 		}
+		if err := k.VSOAByOperator.Remove(ctx, collections.Join(vsoa.VsOperator, vsoa.CorporationId)); err != nil {
+			return fmt.Errorf("failed to remove VSOA operator index: %w", err)
+		}
 	} else {
 		if err := k.VSOperatorAuthorizations.Set(ctx, vsoaID, vsoa); err != nil {
 			return fmt.Errorf("failed to update VSOperatorAuthorization: %w", err)
@@ -0,0 +1,21 @@
+package v2
+
+import (
+	"context"
+)
+
+// Keeper defines the interface required for migration.
+// This interface allows the migration to work without importing the keeper package,
+// breaking the cyclic dependency.
+type Keeper interface {
+	// RebuildOperatorIndexes recreates the (operator, corporation_id) reverse indexes
+	RebuildOperatorIndexes(ctx context.Context) error
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// v2 adds the (operator, corporation_id) reverse indexes over
+// OperatorAuthorization and VSOperatorAuthorization; they are backfilled from
+// the existing authorizations.
+func MigrateStore(ctx context.Context, k Keeper) error {
+	return k.RebuildOperatorIndexes(ctx)
+}
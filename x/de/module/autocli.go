@@ -29,6 +29,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "ListVSOperatorAuthorizations",
 					Skip:      true,
 				},
+				{
+					// Skip autocli for this RPC -- custom command provided in cli_query.go
+					RpcMethod: "ListOperatorRoles",
+					Skip:      true,
+				},
+				{
+					// Skip autocli for this RPC -- custom command provided in cli_query.go
+					RpcMethod: "ListRoleOperators",
+					Skip:      true,
+				},
+				{
+					// Skip autocli for this RPC -- custom command provided in cli_query.go
+					RpcMethod: "ListAuthorizationsByGrantee",
+					Skip:      true,
+				},
+				{
+					// Skip autocli for this RPC -- custom command provided in cli_query.go
+					RpcMethod: "GetCorporationFreeze",
+					Skip:      true,
+				},
+				{
+					// Skip autocli for this RPC -- custom command provided in cli_query.go
+					RpcMethod: "ListPendingOperatorAuthorizations",
+					Skip:      true,
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -82,6 +107,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Name:  "feegrant-spend-limit-period",
 							Usage: "reset period for fee spend limit. Ignored if --with-feegrant is false",
 						},
+						"role": {
+							Name:  "role",
+							Usage: "operator role providing the msg types and default limits; --msg-types must be empty",
+						},
+						"require_acceptance": {
+							Name:         "require-acceptance",
+							Usage:        "keep the authorization pending until the grantee accepts it",
+							DefaultValue: "false",
+						},
+						"not_before": {
+							Name:  "not-before",
+							Usage: "time before which the authorization is not active (RFC3339)",
+						},
 					},
 				},
 				{
@@ -99,6 +137,84 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "SetOperatorRole",
+					Use:       "set-operator-role [name]",
+					Short:     "Create or update an operator role of a corporation",
+					Long:      "Create or update a named operator role holding msg types and default limits. The signing account (--from) must be the corporation. Updating a role propagates its msg types to every operator authorization granted from it, and its default limits to those still carrying the previous defaults.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"operator": {
+							Name:  "operator",
+							Usage: "optional operator account authorized to run this Msg",
+						},
+						"msg_types": {
+							Name:  "msg-types",
+							Usage: "comma-separated list of VPR delegable message type URLs",
+						},
+						"spend_limit": {
+							Name:  "spend-limit",
+							Usage: "default authz spend limit (e.g. 100stake)",
+						},
+						"fee_spend_limit": {
+							Name:  "fee-spend-limit",
+							Usage: "default fee spend limit (e.g. 100stake)",
+						},
+						"period": {
+							Name:  "period",
+							Usage: "default reset period of the spend limits",
+						},
+					},
+				},
+				{
+					RpcMethod: "DeleteOperatorRole",
+					Use:       "delete-operator-role [name]",
+					Short:     "Delete an operator role no authorization is granted from",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"operator": {
+							Name:  "operator",
+							Usage: "optional operator account authorized to run this Msg",
+						},
+					},
+				},
+				{
+					RpcMethod: "FreezeCorporationOperators",
+					Use:       "freeze-corporation-operators",
+					Short:     "Freeze every operator authorization of a corporation",
+					Long:      "Make every operator and VS operator authorization check of the corporation fail until it is unfrozen through a group proposal. The signing account (--from) must be the corporation; --operator must hold the freeze permission.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"operator": {
+							Name:  "operator",
+							Usage: "optional operator account authorized to run this Msg",
+						},
+						"reason": {
+							Name:  "reason",
+							Usage: "optional reason of the freeze",
+						},
+					},
+				},
+				{
+					RpcMethod: "UnfreezeCorporationOperators",
+					Skip:      true, // skipped because executed through a group proposal
+				},
+				{
+					RpcMethod: "ExecAsCorporation",
+					Skip:      true, // skipped because inner msgs are packed as Any by client tooling
+				},
+				{
+					RpcMethod: "AcceptOperatorAuthorization",
+					Use:       "accept-operator-authz [corporation-id]",
+					Short:     "Accept an operator authorization pending acceptance",
+					Long:      "Accept an operator authorization granted with --require-acceptance. The signing account (--from) must be the grantee. The authorization becomes active once accepted and past its not_before.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "corporation_id"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -25,6 +25,11 @@ func (am AppModule) GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdListVSOperatorAuthorizations())
 	cmd.AddCommand(CmdGetOperatorAuthorization())
 	cmd.AddCommand(CmdGetVSOperatorAuthorization())
+	cmd.AddCommand(CmdListOperatorRoles())
+	cmd.AddCommand(CmdListRoleOperators())
+	cmd.AddCommand(CmdListAuthorizationsByGrantee())
+	cmd.AddCommand(CmdGetCorporationFreeze())
+	cmd.AddCommand(CmdListPendingOperatorAuthorizations())
 
 	return cmd
 }
@@ -172,3 +177,175 @@ func CmdListVSOperatorAuthorizations() *cobra.Command {
 
 	return cmd
 }
+
+// CmdListOperatorRoles returns a cobra command for the ListOperatorRoles query.
+func CmdListOperatorRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-operator-roles [corporation-id]",
+		Short: "List the operator roles of a corporation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			corporationID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid corporation id: %w", err)
+			}
+			limit, _ := cmd.Flags().GetUint32("limit")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ListOperatorRoles(cmd.Context(), &types.QueryListOperatorRolesRequest{
+				CorporationId:   corporationID,
+				ResponseMaxSize: limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32("limit", 64, "maximum number of results (1-1024, default 64)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdListRoleOperators returns a cobra command for the ListRoleOperators query.
+func CmdListRoleOperators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-role-operators [corporation-id] [role]",
+		Short: "List the operator authorizations granted from an operator role",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			corporationID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid corporation id: %w", err)
+			}
+			limit, _ := cmd.Flags().GetUint32("limit")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ListRoleOperators(cmd.Context(), &types.QueryListRoleOperatorsRequest{
+				CorporationId:   corporationID,
+				Role:            args[1],
+				ResponseMaxSize: limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32("limit", 64, "maximum number of results (1-1024, default 64)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdListAuthorizationsByGrantee returns a cobra command for the
+// ListAuthorizationsByGrantee query.
+func CmdListAuthorizationsByGrantee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-authorizations-by-grantee [grantee]",
+		Short: "List the operator and VS operator authorizations held by a grantee",
+		Long:  "List every operator and VS operator authorization held by a grantee across corporations, with the remaining spend and expiration of each.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, _ := cmd.Flags().GetUint32("limit")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ListAuthorizationsByGrantee(cmd.Context(), &types.QueryListAuthorizationsByGranteeRequest{
+				Grantee:         args[0],
+				ResponseMaxSize: limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32("limit", 64, "maximum number of results (1-1024, default 64)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGetCorporationFreeze returns a cobra command for the GetCorporationFreeze
+// query.
+func CmdGetCorporationFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-corporation-freeze [corporation-id]",
+		Short: "Show whether the operators of a corporation are frozen",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			corporationID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid corporation id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetCorporationFreeze(cmd.Context(), &types.QueryGetCorporationFreezeRequest{CorporationId: corporationID})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdListPendingOperatorAuthorizations returns a cobra command for the
+// ListPendingOperatorAuthorizations query.
+func CmdListPendingOperatorAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-operator-authorizations",
+		Short: "List operator authorizations that are not active yet",
+		Long:  "List operator authorizations awaiting acceptance by their operator or time locked by not_before. Optionally filter by corporation and/or operator address.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			corporationID, _ := cmd.Flags().GetUint64("corporation-id")
+			operator, _ := cmd.Flags().GetString("operator")
+			limit, _ := cmd.Flags().GetUint32("limit")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ListPendingOperatorAuthorizations(cmd.Context(), &types.QueryListPendingOperatorAuthorizationsRequest{
+				CorporationId:   corporationID,
+				Operator:        operator,
+				ResponseMaxSize: limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64("corporation-id", 0, "filter by the corporation id that granted the authorization")
+	cmd.Flags().String("operator", "", "filter by the operator account that received the authorization")
+	cmd.Flags().Uint32("limit", 64, "maximum number of results (1-1024, default 64)")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
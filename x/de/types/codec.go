@@ -12,6 +12,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "verana/x/de/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgGrantOperatorAuthorization{}, "verana/x/de/MsgGrantOpAuthorization")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeOperatorAuthorization{}, "verana/x/de/MsgRevokeOpAuthorization")
+	legacy.RegisterAminoMsg(cdc, &MsgSetOperatorRole{}, "verana/x/de/MsgSetOperatorRole")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteOperatorRole{}, "verana/x/de/MsgDeleteOperatorRole")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeCorporationOperators{}, "verana/x/de/MsgFreezeCorpOperators")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreezeCorporationOperators{}, "verana/x/de/MsgUnfreezeCorpOperators")
+	legacy.RegisterAminoMsg(cdc, &MsgExecAsCorporation{}, "verana/x/de/MsgExecAsCorporation")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptOperatorAuthorization{}, "verana/x/de/MsgAcceptOpAuthorization")
 }
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
@@ -19,6 +25,12 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgGrantOperatorAuthorization{},
 		&MsgRevokeOperatorAuthorization{},
+		&MsgSetOperatorRole{},
+		&MsgDeleteOperatorRole{},
+		&MsgFreezeCorporationOperators{},
+		&MsgUnfreezeCorporationOperators{},
+		&MsgExecAsCorporation{},
+		&MsgAcceptOperatorAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
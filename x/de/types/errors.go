@@ -24,4 +24,10 @@ var (
 	ErrVSOAOtherCorporation     = errors.Register(ModuleName, 1113, "vs_operator already has a VSOperatorAuthorization from a different corporation; single-corp constraint violated")
 	ErrVSOperatorAuthzNotFound  = errors.Register(ModuleName, 1114, "VS operator authorization not found")
 	ErrVSOFeegrantNotEnabled    = errors.Register(ModuleName, 1115, "VS operator authorization record does not enable fee grant")
+	ErrOperatorRoleNotFound     = errors.Register(ModuleName, 1116, "operator role not found")
+	ErrOperatorRoleInUse        = errors.Register(ModuleName, 1117, "operator role is referenced by operator authorizations")
+	ErrCorporationFrozen        = errors.Register(ModuleName, 1118, "operators of the corporation are frozen")
+	ErrCorporationNotFrozen     = errors.Register(ModuleName, 1119, "operators of the corporation are not frozen")
+	ErrInvalidExecMsg           = errors.Register(ModuleName, 1120, "message cannot be executed as the corporation")
+	ErrOperatorAuthzNotPending  = errors.Register(ModuleName, 1121, "operator authorization is not pending acceptance")
 )
@@ -8,6 +8,12 @@ const (
 	EventTypeGrantVSOperatorAuthorization  = "grant_vs_operator_authorization"
 	EventTypeRevokeVSOperatorAuthorization = "revoke_vs_operator_authorization"
 	EventTypeUpdateVSOperatorAuthorization = "update_vs_operator_authorization"
+	EventTypeSetOperatorRole               = "set_operator_role"
+	EventTypeDeleteOperatorRole            = "delete_operator_role"
+	EventTypeFreezeCorporationOperators    = "freeze_corporation_operators"
+	EventTypeUnfreezeCorporationOperators  = "unfreeze_corporation_operators"
+	EventTypeExecAsCorporation             = "exec_as_corporation"
+	EventTypeAcceptOperatorAuthorization   = "accept_operator_authorization"
 
 	AttributeKeyCorporation   = "corporation"
 	AttributeKeyCorporationID = "corporation_id"
@@ -20,4 +26,11 @@ const (
 	AttributeKeyAuthzID       = "authz_id"
 	AttributeKeyVsoaID        = "vsoa_id"
 	AttributeKeyParticipantID = "participant_id"
+	AttributeKeyRole          = "role"
+	AttributeKeyUpdatedAuthz  = "updated_authorizations"
+	AttributeKeyReason        = "reason"
+	AttributeKeyMsgCount      = "msg_count"
+	AttributeKeyMsgIndex      = "exec_msg_index"
+	AttributeKeyPending       = "pending"
+	AttributeKeyNotBefore     = "not_before"
 )
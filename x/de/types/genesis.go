@@ -14,6 +14,8 @@ func DefaultGenesis() *GenesisState {
 		FeeGrants:                   []FeeGrant{},
 		VsOperatorAuthorizations:    []VSOperatorAuthorization{},
 		OperatorAuthorizationUsages: []OperatorAuthorizationUsage{},
+		OperatorRoles:               []OperatorRole{},
+		CorporationFreezes:          []CorporationFreeze{},
 	}
 }
 
@@ -24,6 +26,25 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// OperatorRole: composite key (corporation_id, name) unique.
+	roleKeys := make(map[string]bool)
+	for i, role := range gs.OperatorRoles {
+		if role.CorporationId == 0 {
+			return fmt.Errorf("operator_roles[%d]: corporation_id cannot be 0", i)
+		}
+		if err := ValidateOperatorRoleName(role.Name); err != nil {
+			return fmt.Errorf("operator_roles[%d]: %w", i, err)
+		}
+		if err := validateOperatorMsgTypes(role.MsgTypes); err != nil {
+			return fmt.Errorf("operator_roles[%d]: %w", i, err)
+		}
+		key := fmt.Sprintf("%d/%s", role.CorporationId, role.Name)
+		if roleKeys[key] {
+			return fmt.Errorf("operator_roles[%d]: duplicate (corporation_id, name) %s", i, key)
+		}
+		roleKeys[key] = true
+	}
+
 	// OperatorAuthorization: id (key) non-zero + unique; (corporation_id,
 	// operator) secondary index unique per spec v4-rc2.
 	oaIDs := make(map[uint64]bool)
@@ -50,6 +71,9 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("operator_authorizations[%d]: duplicate (corporation_id, operator) %s", i, idx)
 		}
 		oaCorpOp[idx] = true
+		if oa.Role != "" && !roleKeys[fmt.Sprintf("%d/%s", oa.CorporationId, oa.Role)] {
+			return fmt.Errorf("operator_authorizations[%d]: role %s not found", i, oa.Role)
+		}
 	}
 
 	// FeeGrant: composite key (grantor_corporation_id, grantee) unique.
@@ -113,6 +137,18 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// CorporationFreeze: corporation_id non-zero + unique.
+	frozen := make(map[uint64]bool)
+	for i, freeze := range gs.CorporationFreezes {
+		if freeze.CorporationId == 0 {
+			return fmt.Errorf("corporation_freezes[%d]: corporation_id cannot be 0", i)
+		}
+		if frozen[freeze.CorporationId] {
+			return fmt.Errorf("corporation_freezes[%d]: duplicate corporation_id %d", i, freeze.CorporationId)
+		}
+		frozen[freeze.CorporationId] = true
+	}
+
 	// OperatorAuthorizationUsage: must reference an OperatorAuthorization id.
 	for i, usage := range gs.OperatorAuthorizationUsages {
 		if usage.OperatorAuthorizationId == 0 {
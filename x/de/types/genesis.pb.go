@@ -36,6 +36,10 @@ type GenesisState struct {
 	VsOperatorAuthorizations []VSOperatorAuthorization `protobuf:"bytes,4,rep,name=vs_operator_authorizations,json=vsOperatorAuthorizations,proto3" json:"vs_operator_authorizations"`
 	// operator_authorization_usages is a list of all OperatorAuthorizationUsage objects
 	OperatorAuthorizationUsages []OperatorAuthorizationUsage `protobuf:"bytes,5,rep,name=operator_authorization_usages,json=operatorAuthorizationUsages,proto3" json:"operator_authorization_usages"`
+	// operator_roles is a list of all OperatorRole objects
+	OperatorRoles []OperatorRole `protobuf:"bytes,6,rep,name=operator_roles,json=operatorRoles,proto3" json:"operator_roles"`
+	// corporation_freezes is a list of all CorporationFreeze objects
+	CorporationFreezes []CorporationFreeze `protobuf:"bytes,7,rep,name=corporation_freezes,json=corporationFreezes,proto3" json:"corporation_freezes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +110,20 @@ func (m *GenesisState) GetOperatorAuthorizationUsages() []OperatorAuthorizationU
 	return nil
 }
 
+func (m *GenesisState) GetOperatorRoles() []OperatorRole {
+	if m != nil {
+		return m.OperatorRoles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCorporationFreezes() []CorporationFreeze {
+	if m != nil {
+		return m.CorporationFreezes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "verana.de.v1.GenesisState")
 }
@@ -113,30 +131,34 @@ func init() {
 func init() { proto.RegisterFile("verana/de/v1/genesis.proto", fileDescriptor_5cd051b141285978) }
 
 var fileDescriptor_5cd051b141285978 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0x86, 0x13, 0x5b, 0x0b, 0xbd, 0x76, 0x31, 0x94, 0x1a, 0x23, 0xc6, 0xa2, 0x08, 0x41, 0x30,
-	0x47, 0xeb, 0xe0, 0xe0, 0x64, 0x06, 0x3b, 0x56, 0x5a, 0x74, 0x70, 0x09, 0x17, 0xfb, 0x35, 0x0d,
-	0xd8, 0x5c, 0xb8, 0xbb, 0x06, 0xf5, 0x57, 0xf8, 0x33, 0x1c, 0xfd, 0x17, 0x76, 0xec, 0xe8, 0x24,
-	0xd2, 0x0e, 0xfe, 0x0d, 0xc9, 0xe5, 0x86, 0x06, 0x5a, 0x71, 0x09, 0x5f, 0xf2, 0xbe, 0xdf, 0xf3,
-	0xbe, 0x84, 0x0f, 0x59, 0x29, 0x30, 0x12, 0x13, 0x3c, 0x04, 0x9c, 0xb6, 0x71, 0x08, 0x31, 0xf0,
-	0x88, 0xbb, 0x09, 0xa3, 0x82, 0x1a, 0xf5, 0x5c, 0x73, 0x87, 0xe0, 0xa6, 0x6d, 0x6b, 0x87, 0x4c,
-	0xa2, 0x98, 0x62, 0xf9, 0xcc, 0x0d, 0x56, 0x23, 0xa4, 0x21, 0x95, 0x23, 0xce, 0x26, 0xf5, 0x75,
-	0xaf, 0x80, 0x4c, 0x08, 0x23, 0x13, 0x45, 0xb4, 0xcc, 0x82, 0x24, 0x9e, 0x13, 0x50, 0xca, 0xd1,
-	0x47, 0x09, 0xd5, 0xbb, 0x79, 0xfa, 0x40, 0x10, 0x01, 0xc6, 0x05, 0xaa, 0xe4, 0xab, 0xa6, 0xde,
-	0xd2, 0x9d, 0x5a, 0xa7, 0xe1, 0xae, 0xb6, 0x71, 0x6f, 0xa4, 0xe6, 0x55, 0x67, 0x5f, 0x87, 0xda,
-	0xdb, 0xcf, 0xfb, 0xa9, 0xde, 0x57, 0x76, 0x23, 0x40, 0xbb, 0x34, 0x01, 0x46, 0x04, 0x65, 0x3e,
-	0x99, 0x8a, 0x31, 0x65, 0xd1, 0x0b, 0x11, 0x11, 0x8d, 0xb9, 0xb9, 0xd5, 0x2a, 0x39, 0xb5, 0xce,
-	0x71, 0x91, 0xd4, 0x53, 0xe6, 0xab, 0x55, 0xaf, 0x57, 0xce, 0xc0, 0xfd, 0x26, 0x5d, 0x27, 0x72,
-	0xe3, 0x12, 0xa1, 0x11, 0x80, 0x1f, 0x32, 0x12, 0x0b, 0x6e, 0x96, 0x24, 0xb6, 0x59, 0xc4, 0x5e,
-	0x03, 0x74, 0x33, 0x59, 0x91, 0xaa, 0x23, 0xf5, 0xce, 0x8d, 0x08, 0x59, 0x29, 0xf7, 0x37, 0x75,
-	0x2c, 0x4b, 0xd8, 0x49, 0x11, 0x76, 0x37, 0xf8, 0xab, 0xa5, 0x99, 0xf2, 0xde, 0xfa, 0x9e, 0x0c,
-	0x1d, 0xac, 0xcf, 0xf1, 0xa7, 0x9c, 0x84, 0xc0, 0xcd, 0x6d, 0x99, 0xe6, 0xfc, 0xe3, 0x8f, 0xdc,
-	0x66, 0x0b, 0x2a, 0x70, 0x9f, 0x6e, 0x74, 0x70, 0xcf, 0x9b, 0x2d, 0x6c, 0x7d, 0xbe, 0xb0, 0xf5,
-	0xef, 0x85, 0xad, 0xbf, 0x2e, 0x6d, 0x6d, 0xbe, 0xb4, 0xb5, 0xcf, 0xa5, 0xad, 0xdd, 0x3b, 0x61,
-	0x24, 0xc6, 0xd3, 0xc0, 0x7d, 0xa0, 0x13, 0x9c, 0x07, 0x9e, 0x3d, 0x92, 0x80, 0xab, 0x19, 0x3f,
-	0x65, 0x67, 0x21, 0x6f, 0x22, 0xa8, 0xc8, 0xa3, 0x38, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x94,
-	0xdd, 0x59, 0x40, 0x9e, 0x02, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x13, 0xbb, 0xae, 0x74, 0x5a, 0x05, 0xc7, 0x52, 0xc7, 0x88, 0x69, 0x51, 0x84, 0x20,
+	0x98, 0xd0, 0x7a, 0xf0, 0xe0, 0xc9, 0x08, 0xdd, 0x63, 0x65, 0x8b, 0x3d, 0x78, 0x09, 0x93, 0xed,
+	0xdb, 0x34, 0xb0, 0x9b, 0x17, 0xe6, 0xcd, 0x06, 0xed, 0xa7, 0xf0, 0x63, 0x78, 0xf4, 0x63, 0xec,
+	0x71, 0x8f, 0x9e, 0x44, 0x76, 0x0f, 0xfa, 0x31, 0x24, 0x93, 0x51, 0x37, 0x92, 0x95, 0x5e, 0xc2,
+	0xcb, 0xfc, 0xff, 0xf3, 0xfb, 0xff, 0x19, 0x1e, 0xf3, 0x2a, 0x50, 0xb2, 0x90, 0xd1, 0x05, 0x44,
+	0xd5, 0x51, 0x94, 0x41, 0x01, 0x94, 0x53, 0x58, 0x2a, 0xd4, 0xc8, 0x77, 0x1b, 0x2d, 0xbc, 0x80,
+	0xb0, 0x3a, 0xf2, 0xee, 0xca, 0x69, 0x5e, 0x60, 0x64, 0xbe, 0x8d, 0xc1, 0xdb, 0xcb, 0x30, 0x43,
+	0x33, 0x46, 0xf5, 0x64, 0x4f, 0x1f, 0xb4, 0x90, 0xa5, 0x54, 0x72, 0x6a, 0x89, 0x9e, 0x68, 0x49,
+	0xfa, 0x63, 0x09, 0x56, 0x79, 0xfc, 0xb3, 0xc7, 0x76, 0x07, 0x4d, 0xfa, 0x99, 0x96, 0x1a, 0xf8,
+	0x4b, 0xd6, 0x6f, 0xae, 0x0a, 0xf7, 0xd0, 0x0d, 0x76, 0x8e, 0xf7, 0xc2, 0xf5, 0x36, 0xe1, 0x5b,
+	0xa3, 0xc5, 0xdb, 0xf3, 0x6f, 0x07, 0xce, 0xe7, 0x1f, 0x5f, 0x9e, 0xb9, 0x43, 0x6b, 0xe7, 0x29,
+	0xbb, 0x8f, 0x25, 0x28, 0xa9, 0x51, 0x25, 0x72, 0xa6, 0x2f, 0x51, 0xe5, 0x57, 0x52, 0xe7, 0x58,
+	0x90, 0xb8, 0x71, 0xb8, 0x15, 0xec, 0x1c, 0x3f, 0x69, 0x93, 0x4e, 0xad, 0xf9, 0xf5, 0xba, 0x37,
+	0xee, 0xd5, 0xe0, 0xe1, 0x3e, 0x76, 0x89, 0xc4, 0x5f, 0x31, 0x36, 0x06, 0x48, 0x32, 0x25, 0x0b,
+	0x4d, 0x62, 0xcb, 0x60, 0xf7, 0xdb, 0xd8, 0x13, 0x80, 0x41, 0x2d, 0x5b, 0xd2, 0xf6, 0xd8, 0xfe,
+	0x13, 0xcf, 0x99, 0x57, 0x51, 0xb2, 0xa9, 0x63, 0xcf, 0xc0, 0x9e, 0xb6, 0x61, 0xe7, 0x67, 0xff,
+	0x6b, 0x29, 0x2a, 0x3a, 0xed, 0xee, 0xa9, 0xd8, 0xa3, 0xee, 0x9c, 0x64, 0x46, 0x32, 0x03, 0x12,
+	0x37, 0x4d, 0x5a, 0x70, 0x8d, 0x17, 0x79, 0x57, 0x5f, 0xb0, 0x81, 0x0f, 0x71, 0xa3, 0x83, 0xf8,
+	0x80, 0xdd, 0xf9, 0x93, 0xa9, 0x70, 0x02, 0x24, 0xfa, 0x26, 0xc4, 0xeb, 0x0e, 0x19, 0xe2, 0xe4,
+	0x37, 0xf6, 0x36, 0xae, 0x9d, 0x11, 0x3f, 0x67, 0xf7, 0x46, 0xa8, 0x4a, 0x54, 0x4d, 0xe3, 0xb1,
+	0x02, 0xb8, 0x02, 0x12, 0xb7, 0x0c, 0xed, 0xa0, 0x4d, 0x7b, 0xf3, 0xd7, 0x78, 0x62, 0x7c, 0x16,
+	0xc9, 0x47, 0xff, 0x0a, 0x14, 0xc7, 0xf3, 0xa5, 0xef, 0x2e, 0x96, 0xbe, 0xfb, 0x7d, 0xe9, 0xbb,
+	0x9f, 0x56, 0xbe, 0xb3, 0x58, 0xf9, 0xce, 0xd7, 0x95, 0xef, 0xbc, 0x0f, 0xb2, 0x5c, 0x5f, 0xce,
+	0xd2, 0x70, 0x84, 0xd3, 0xa8, 0xc1, 0x3f, 0x9f, 0xc8, 0x94, 0xec, 0x1c, 0x7d, 0xa8, 0xf7, 0xd6,
+	0x2c, 0x6d, 0xda, 0x37, 0x5b, 0xfb, 0xe2, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0xf9, 0xf8,
+	0x66, 0x3f, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -159,6 +181,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CorporationFreezes) > 0 {
+		for iNdEx := len(m.CorporationFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorporationFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OperatorRoles) > 0 {
+		for iNdEx := len(m.OperatorRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.OperatorAuthorizationUsages) > 0 {
 		for iNdEx := len(m.OperatorAuthorizationUsages) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -271,6 +321,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OperatorRoles) > 0 {
+		for _, e := range m.OperatorRoles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CorporationFreezes) > 0 {
+		for _, e := range m.CorporationFreezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -478,6 +540,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorRoles = append(m.OperatorRoles, OperatorRole{})
+			if err := m.OperatorRoles[len(m.OperatorRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorporationFreezes = append(m.CorporationFreezes, CorporationFreeze{})
+			if err := m.CorporationFreezes[len(m.CorporationFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
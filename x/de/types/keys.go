@@ -48,4 +48,23 @@ var (
 	VSOAByParticipantKey = collections.NewPrefix("vsoa_part_de")
 	// VSOASeqKey backs the VSOperatorAuthorization id counter.
 	VSOASeqKey = collections.NewPrefix("vsoa_seq_de")
+
+	// OperatorRoleKey is the prefix for OperatorRole storage, keyed by the
+	// composite (corporation_id, name).
+	OperatorRoleKey = collections.NewPrefix("orole_de")
+	// OperatorAuthorizationByRoleKey is the index
+	// (corporation_id, role, OperatorAuthorization.id) of the authorizations
+	// granted from a role.
+	OperatorAuthorizationByRoleKey = collections.NewPrefix("oa_role_de")
+
+	// OperatorAuthorizationByOperatorKey is the reverse index
+	// (operator, corporation_id) over OperatorAuthorization.
+	OperatorAuthorizationByOperatorKey = collections.NewPrefix("oa_op_de")
+	// VSOAByOperatorKey is the reverse index (vs_operator, corporation_id) over
+	// VSOperatorAuthorization.
+	VSOAByOperatorKey = collections.NewPrefix("vsoa_op_de")
+
+	// CorporationFreezeKey is the prefix for CorporationFreeze storage, keyed
+	// by corporation_id.
+	CorporationFreezeKey = collections.NewPrefix("freeze_de")
 )
@@ -7,24 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -537,791 +543,3138 @@ func (m *QueryGetVSOperatorAuthorizationResponse) GetVsOperatorAuthorization() V
 	return VSOperatorAuthorization{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "verana.de.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "verana.de.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryListOperatorAuthorizationsRequest)(nil), "verana.de.v1.QueryListOperatorAuthorizationsRequest")
-	proto.RegisterType((*QueryListOperatorAuthorizationsResponse)(nil), "verana.de.v1.QueryListOperatorAuthorizationsResponse")
-	proto.RegisterType((*QueryListVSOperatorAuthorizationsRequest)(nil), "verana.de.v1.QueryListVSOperatorAuthorizationsRequest")
-	proto.RegisterType((*QueryListVSOperatorAuthorizationsResponse)(nil), "verana.de.v1.QueryListVSOperatorAuthorizationsResponse")
-	proto.RegisterType((*QueryGetOperatorAuthorizationRequest)(nil), "verana.de.v1.QueryGetOperatorAuthorizationRequest")
-	proto.RegisterType((*QueryGetOperatorAuthorizationResponse)(nil), "verana.de.v1.QueryGetOperatorAuthorizationResponse")
-	proto.RegisterType((*QueryGetVSOperatorAuthorizationRequest)(nil), "verana.de.v1.QueryGetVSOperatorAuthorizationRequest")
-	proto.RegisterType((*QueryGetVSOperatorAuthorizationResponse)(nil), "verana.de.v1.QueryGetVSOperatorAuthorizationResponse")
+// QueryListOperatorRolesRequest is the request type for the
+// Query/ListOperatorRoles RPC method.
+type QueryListOperatorRolesRequest struct {
+	// corporation_id is the id of the corporation owning the roles.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// response_max_size limits the number of results. Must be 1-1024, defaults to 64.
+	ResponseMaxSize uint32 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/de/v1/query.proto", fileDescriptor_41e9e1468cb47da6) }
-
-var fileDescriptor_41e9e1468cb47da6 = []byte{
-	// 748 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4f, 0x13, 0x41,
-	0x1c, 0xed, 0x14, 0x6c, 0x64, 0x10, 0x0c, 0x63, 0x85, 0x65, 0x43, 0x6a, 0x5d, 0xad, 0xac, 0x8d,
-	0xed, 0xd8, 0xf2, 0x4f, 0x8f, 0xf4, 0x42, 0x4c, 0x54, 0xb4, 0x24, 0x1e, 0xbc, 0xd4, 0x29, 0x3b,
-	0x59, 0x26, 0xa1, 0x3b, 0xcb, 0xce, 0xb6, 0x02, 0xc6, 0x8b, 0x37, 0x0f, 0x26, 0x46, 0x13, 0x3f,
-	0x83, 0x47, 0x4d, 0x4c, 0xe4, 0xa8, 0x37, 0x8e, 0x44, 0x2f, 0x9e, 0x8c, 0x01, 0x13, 0xbf, 0x84,
-	0x07, 0xd3, 0xd9, 0x29, 0xb2, 0xba, 0x4b, 0x5b, 0xe3, 0xa5, 0xe9, 0xce, 0xfc, 0xfe, 0xbc, 0xf7,
-	0x66, 0xde, 0x2f, 0x03, 0xb5, 0x16, 0xf5, 0x88, 0x43, 0xb0, 0x45, 0x71, 0xab, 0x84, 0x37, 0x9a,
-	0xd4, 0xdb, 0x2a, 0xba, 0x1e, 0xf7, 0x39, 0x3a, 0x15, 0xec, 0x14, 0x2d, 0x5a, 0x6c, 0x95, 0xf4,
-	0x31, 0xd2, 0x60, 0x0e, 0xc7, 0xf2, 0x37, 0x08, 0xd0, 0xf3, 0xab, 0x5c, 0x34, 0xb8, 0xc0, 0x75,
-	0x22, 0x68, 0x90, 0x89, 0x5b, 0xa5, 0x3a, 0xf5, 0x49, 0x09, 0xbb, 0xc4, 0x66, 0x0e, 0xf1, 0x19,
-	0x77, 0x54, 0xec, 0x64, 0x10, 0x5b, 0x93, 0x5f, 0x38, 0xf8, 0x50, 0x5b, 0x69, 0x9b, 0xdb, 0x3c,
-	0x58, 0x6f, 0xff, 0x53, 0xab, 0x53, 0x36, 0xe7, 0xf6, 0x3a, 0xc5, 0xc4, 0x65, 0x98, 0x38, 0x0e,
-	0xf7, 0x65, 0xb5, 0x4e, 0xce, 0x64, 0x08, 0xb5, 0x4b, 0x3c, 0xd2, 0xe8, 0x6c, 0x85, 0x09, 0xf9,
-	0x5b, 0x2e, 0x55, 0x3b, 0x46, 0x1a, 0xa2, 0xbb, 0x6d, 0x94, 0x77, 0x64, 0x78, 0x95, 0x6e, 0x34,
-	0xa9, 0xf0, 0x8d, 0xdb, 0xf0, 0x4c, 0x68, 0x55, 0xb8, 0xdc, 0x11, 0x14, 0x2d, 0xc0, 0x54, 0x50,
-	0x56, 0x03, 0x59, 0x60, 0x0e, 0x97, 0xd3, 0xc5, 0xa3, 0x72, 0x14, 0x83, 0xe8, 0xca, 0xd0, 0xee,
-	0xd7, 0x73, 0x89, 0xd7, 0x3f, 0xde, 0xe4, 0x41, 0x55, 0x85, 0x1b, 0x6f, 0x01, 0xbc, 0x24, 0x0b,
-	0xde, 0x64, 0xc2, 0x5f, 0x76, 0xa9, 0x47, 0x7c, 0xee, 0x2d, 0x36, 0xfd, 0x35, 0xee, 0xb1, 0xed,
-	0x80, 0x84, 0x6a, 0x8d, 0x72, 0x70, 0x74, 0x95, 0x7b, 0x2e, 0xf7, 0xe4, 0x72, 0x8d, 0x59, 0xb2,
-	0xd7, 0x60, 0x75, 0xe4, 0xc8, 0xea, 0x0d, 0x0b, 0xcd, 0xc2, 0x93, 0x5c, 0xd5, 0xd1, 0x92, 0x59,
-	0x60, 0x0e, 0x55, 0xb4, 0x4f, 0xef, 0x0a, 0x69, 0x25, 0xe2, 0xa2, 0x65, 0x79, 0x54, 0x88, 0x15,
-	0xdf, 0x63, 0x8e, 0x5d, 0x3d, 0x8c, 0x44, 0x79, 0x38, 0xe6, 0x29, 0x32, 0xb5, 0x06, 0xd9, 0xac,
-	0x09, 0xb6, 0x4d, 0xb5, 0x81, 0x2c, 0x30, 0x47, 0xaa, 0xa7, 0x3b, 0x1b, 0xb7, 0xc8, 0xe6, 0x0a,
-	0xdb, 0xa6, 0xc6, 0x33, 0x00, 0xa7, 0xbb, 0x62, 0x56, 0xc2, 0xd4, 0xe1, 0x44, 0xa7, 0x47, 0x8d,
-	0x84, 0x42, 0x34, 0x90, 0x1d, 0x30, 0x87, 0xcb, 0x17, 0xc2, 0x4a, 0x45, 0x96, 0xab, 0x0c, 0xb6,
-	0x85, 0xab, 0x8e, 0xf3, 0xc8, 0x5e, 0xc6, 0x0e, 0x80, 0xe6, 0x21, 0x9e, 0x7b, 0x2b, 0xff, 0x45,
-	0xc5, 0xeb, 0x70, 0xb8, 0x25, 0x6a, 0x3d, 0x0b, 0x09, 0x5b, 0x62, 0xf9, 0x5f, 0xa4, 0x7c, 0x05,
-	0xe0, 0xe5, 0x1e, 0xa0, 0x2b, 0x31, 0x19, 0xd4, 0x8f, 0x80, 0x8a, 0xd6, 0x33, 0x17, 0xd6, 0x33,
-	0xa6, 0xa6, 0x52, 0x54, 0xfb, 0x0d, 0xfb, 0x0f, 0x4d, 0xe7, 0xe1, 0x45, 0x89, 0x6b, 0x89, 0x46,
-	0x9f, 0x70, 0x47, 0xce, 0x51, 0x98, 0x3c, 0x94, 0x30, 0xc9, 0x2c, 0xe3, 0x29, 0x80, 0xb9, 0x2e,
-	0x89, 0x8a, 0xcc, 0x03, 0x38, 0x1e, 0xcd, 0x44, 0x59, 0xa8, 0x8f, 0x8b, 0x71, 0x36, 0xf2, 0x62,
-	0x18, 0xd7, 0x94, 0xb5, 0x96, 0x68, 0x9c, 0xb4, 0x71, 0x2c, 0x5e, 0x74, 0x6e, 0xf8, 0x71, 0xa9,
-	0x8a, 0x87, 0x0d, 0x27, 0x63, 0x0f, 0x45, 0x51, 0xe9, 0xeb, 0x4c, 0x26, 0x62, 0xce, 0xa4, 0xfc,
-	0x33, 0x05, 0x4f, 0x48, 0x50, 0xe8, 0x21, 0x4c, 0x05, 0x13, 0x05, 0x65, 0xc3, 0x95, 0xff, 0x1e,
-	0x58, 0xfa, 0xf9, 0x63, 0x22, 0x02, 0x06, 0x86, 0xf9, 0xe4, 0xf3, 0xf7, 0x97, 0x49, 0x03, 0x65,
-	0x71, 0x10, 0x5a, 0x58, 0x27, 0x75, 0x81, 0x23, 0x66, 0x26, 0xda, 0x01, 0x50, 0x8f, 0x37, 0x3d,
-	0x9a, 0x8d, 0xe8, 0xd5, 0x75, 0xae, 0xe9, 0x73, 0x7d, 0x66, 0x29, 0xd4, 0x05, 0x89, 0x7a, 0x1a,
-	0xe5, 0xc2, 0x48, 0x3b, 0x07, 0x51, 0x08, 0xbb, 0x03, 0x7d, 0x04, 0x70, 0xea, 0x38, 0x93, 0xa1,
-	0xf9, 0x18, 0x18, 0x5d, 0x06, 0x8a, 0xbe, 0xd0, 0x77, 0x9e, 0x22, 0x70, 0x55, 0x12, 0xc8, 0x23,
-	0x33, 0x4c, 0xa0, 0x25, 0x0a, 0x71, 0x1c, 0xde, 0x03, 0xa8, 0xc5, 0xf9, 0x0a, 0x95, 0x23, 0x70,
-	0x74, 0x71, 0xaf, 0x3e, 0xd3, 0x57, 0x8e, 0xc2, 0x5d, 0x96, 0xb8, 0xaf, 0xa0, 0x7c, 0x4f, 0xc2,
-	0xe3, 0x47, 0xcc, 0x7a, 0x8c, 0x3e, 0x00, 0xa8, 0xc7, 0x7b, 0x29, 0xf2, 0xe2, 0x74, 0x75, 0x6d,
-	0xe4, 0xc5, 0xe9, 0x6e, 0x58, 0x63, 0x4e, 0xe2, 0xc7, 0xa8, 0xd0, 0xab, 0xee, 0x92, 0x42, 0xa5,
-	0xb2, 0xbb, 0x9f, 0x01, 0x7b, 0xfb, 0x19, 0xf0, 0x6d, 0x3f, 0x03, 0x9e, 0x1f, 0x64, 0x12, 0x7b,
-	0x07, 0x99, 0xc4, 0x97, 0x83, 0x4c, 0xe2, 0xbe, 0x69, 0x33, 0x7f, 0xad, 0x59, 0x2f, 0xae, 0xf2,
-	0x46, 0x94, 0x83, 0x36, 0xdb, 0x0d, 0xe4, 0xcb, 0xa2, 0x9e, 0x92, 0x4f, 0x8b, 0x99, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0x16, 0xd9, 0x17, 0x47, 0x09, 0x00, 0x00,
+func (m *QueryListOperatorRolesRequest) Reset()         { *m = QueryListOperatorRolesRequest{} }
+func (m *QueryListOperatorRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListOperatorRolesRequest) ProtoMessage()    {}
+func (*QueryListOperatorRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{10}
+}
+func (m *QueryListOperatorRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListOperatorRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListOperatorRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListOperatorRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListOperatorRolesRequest.Merge(m, src)
+}
+func (m *QueryListOperatorRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListOperatorRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListOperatorRolesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryListOperatorRolesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListOperatorAuthorizations returns operator authorizations matching optional filters.
-	ListOperatorAuthorizations(ctx context.Context, in *QueryListOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListOperatorAuthorizationsResponse, error)
-	// ListVSOperatorAuthorizations returns VS operator authorizations matching optional filters.
-	ListVSOperatorAuthorizations(ctx context.Context, in *QueryListVSOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListVSOperatorAuthorizationsResponse, error)
-	// [MOD-DE-QRY-3] GetOperatorAuthorization returns a single OperatorAuthorization by id.
-	GetOperatorAuthorization(ctx context.Context, in *QueryGetOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetOperatorAuthorizationResponse, error)
-	// [MOD-DE-QRY-4] GetVSOperatorAuthorization returns a single VSOperatorAuthorization by id.
-	GetVSOperatorAuthorization(ctx context.Context, in *QueryGetVSOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetVSOperatorAuthorizationResponse, error)
+func (m *QueryListOperatorRolesRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryListOperatorRolesRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryListOperatorRolesResponse is the response type for the
+// Query/ListOperatorRoles RPC method.
+type QueryListOperatorRolesResponse struct {
+	OperatorRoles []OperatorRole `protobuf:"bytes,1,rep,name=operator_roles,json=operatorRoles,proto3" json:"operator_roles"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListOperatorRolesResponse) Reset()         { *m = QueryListOperatorRolesResponse{} }
+func (m *QueryListOperatorRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListOperatorRolesResponse) ProtoMessage()    {}
+func (*QueryListOperatorRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{11}
+}
+func (m *QueryListOperatorRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListOperatorRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListOperatorRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListOperatorRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListOperatorRolesResponse.Merge(m, src)
+}
+func (m *QueryListOperatorRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListOperatorRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListOperatorRolesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListOperatorAuthorizations(ctx context.Context, in *QueryListOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListOperatorAuthorizationsResponse, error) {
-	out := new(QueryListOperatorAuthorizationsResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListOperatorAuthorizations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListOperatorRolesResponse proto.InternalMessageInfo
+
+func (m *QueryListOperatorRolesResponse) GetOperatorRoles() []OperatorRole {
+	if m != nil {
+		return m.OperatorRoles
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListVSOperatorAuthorizations(ctx context.Context, in *QueryListVSOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListVSOperatorAuthorizationsResponse, error) {
-	out := new(QueryListVSOperatorAuthorizationsResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListVSOperatorAuthorizations", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryListRoleOperatorsRequest is the request type for the
+// Query/ListRoleOperators RPC method.
+type QueryListRoleOperatorsRequest struct {
+	// corporation_id is the id of the corporation owning the role.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// role is the name of the role.
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// response_max_size limits the number of results. Must be 1-1024, defaults to 64.
+	ResponseMaxSize uint32 `protobuf:"varint,3,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListRoleOperatorsRequest) Reset()         { *m = QueryListRoleOperatorsRequest{} }
+func (m *QueryListRoleOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListRoleOperatorsRequest) ProtoMessage()    {}
+func (*QueryListRoleOperatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{12}
+}
+func (m *QueryListRoleOperatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRoleOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRoleOperatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListRoleOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRoleOperatorsRequest.Merge(m, src)
+}
+func (m *QueryListRoleOperatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRoleOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRoleOperatorsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetOperatorAuthorization(ctx context.Context, in *QueryGetOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetOperatorAuthorizationResponse, error) {
-	out := new(QueryGetOperatorAuthorizationResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/GetOperatorAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListRoleOperatorsRequest proto.InternalMessageInfo
+
+func (m *QueryListRoleOperatorsRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetVSOperatorAuthorization(ctx context.Context, in *QueryGetVSOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetVSOperatorAuthorizationResponse, error) {
-	out := new(QueryGetVSOperatorAuthorizationResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/GetVSOperatorAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListRoleOperatorsRequest) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListOperatorAuthorizations returns operator authorizations matching optional filters.
-	ListOperatorAuthorizations(context.Context, *QueryListOperatorAuthorizationsRequest) (*QueryListOperatorAuthorizationsResponse, error)
-	// ListVSOperatorAuthorizations returns VS operator authorizations matching optional filters.
-	ListVSOperatorAuthorizations(context.Context, *QueryListVSOperatorAuthorizationsRequest) (*QueryListVSOperatorAuthorizationsResponse, error)
-	// [MOD-DE-QRY-3] GetOperatorAuthorization returns a single OperatorAuthorization by id.
-	GetOperatorAuthorization(context.Context, *QueryGetOperatorAuthorizationRequest) (*QueryGetOperatorAuthorizationResponse, error)
-	// [MOD-DE-QRY-4] GetVSOperatorAuthorization returns a single VSOperatorAuthorization by id.
-	GetVSOperatorAuthorization(context.Context, *QueryGetVSOperatorAuthorizationRequest) (*QueryGetVSOperatorAuthorizationResponse, error)
+func (m *QueryListRoleOperatorsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryListRoleOperatorsResponse is the response type for the
+// Query/ListRoleOperators RPC method.
+type QueryListRoleOperatorsResponse struct {
+	OperatorAuthorizations []OperatorAuthorization `protobuf:"bytes,1,rep,name=operator_authorizations,json=operatorAuthorizations,proto3" json:"operator_authorizations"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryListRoleOperatorsResponse) Reset()         { *m = QueryListRoleOperatorsResponse{} }
+func (m *QueryListRoleOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListRoleOperatorsResponse) ProtoMessage()    {}
+func (*QueryListRoleOperatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{13}
 }
-func (*UnimplementedQueryServer) ListOperatorAuthorizations(ctx context.Context, req *QueryListOperatorAuthorizationsRequest) (*QueryListOperatorAuthorizationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOperatorAuthorizations not implemented")
+func (m *QueryListRoleOperatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListVSOperatorAuthorizations(ctx context.Context, req *QueryListVSOperatorAuthorizationsRequest) (*QueryListVSOperatorAuthorizationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVSOperatorAuthorizations not implemented")
+func (m *QueryListRoleOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRoleOperatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetOperatorAuthorization(ctx context.Context, req *QueryGetOperatorAuthorizationRequest) (*QueryGetOperatorAuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOperatorAuthorization not implemented")
+func (m *QueryListRoleOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRoleOperatorsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetVSOperatorAuthorization(ctx context.Context, req *QueryGetVSOperatorAuthorizationRequest) (*QueryGetVSOperatorAuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVSOperatorAuthorization not implemented")
+func (m *QueryListRoleOperatorsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryListRoleOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRoleOperatorsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryListRoleOperatorsResponse proto.InternalMessageInfo
+
+func (m *QueryListRoleOperatorsResponse) GetOperatorAuthorizations() []OperatorAuthorization {
+	if m != nil {
+		return m.OperatorAuthorizations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListOperatorAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListOperatorAuthorizationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListOperatorAuthorizations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Query/ListOperatorAuthorizations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListOperatorAuthorizations(ctx, req.(*QueryListOperatorAuthorizationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryListAuthorizationsByGranteeRequest is the request type for the
+// Query/ListAuthorizationsByGrantee RPC method.
+type QueryListAuthorizationsByGranteeRequest struct {
+	// grantee is the operator or VS operator account holding the authorizations.
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// response_max_size limits the number of results. Must be 1-1024, defaults to 64.
+	ResponseMaxSize uint32 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
 }
 
-func _Query_ListVSOperatorAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListVSOperatorAuthorizationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListVSOperatorAuthorizations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Query/ListVSOperatorAuthorizations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListVSOperatorAuthorizations(ctx, req.(*QueryListVSOperatorAuthorizationsRequest))
+func (m *QueryListAuthorizationsByGranteeRequest) Reset() {
+	*m = QueryListAuthorizationsByGranteeRequest{}
+}
+func (m *QueryListAuthorizationsByGranteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListAuthorizationsByGranteeRequest) ProtoMessage()    {}
+func (*QueryListAuthorizationsByGranteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{14}
+}
+func (m *QueryListAuthorizationsByGranteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListAuthorizationsByGranteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListAuthorizationsByGranteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryListAuthorizationsByGranteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListAuthorizationsByGranteeRequest.Merge(m, src)
+}
+func (m *QueryListAuthorizationsByGranteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListAuthorizationsByGranteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListAuthorizationsByGranteeRequest.DiscardUnknown(m)
 }
 
-func _Query_GetOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOperatorAuthorizationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListAuthorizationsByGranteeRequest proto.InternalMessageInfo
+
+func (m *QueryListAuthorizationsByGranteeRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetOperatorAuthorization(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Query/GetOperatorAuthorization",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetOperatorAuthorization(ctx, req.(*QueryGetOperatorAuthorizationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetVSOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVSOperatorAuthorizationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVSOperatorAuthorization(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Query/GetVSOperatorAuthorization",
+func (m *QueryListAuthorizationsByGranteeRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVSOperatorAuthorization(ctx, req.(*QueryGetVSOperatorAuthorizationRequest))
+	return 0
+}
+
+// GranteeAuthorization is an authorization held by a grantee from one
+// corporation. Exactly one of operator_authorization and
+// vs_operator_authorization is set.
+type GranteeAuthorization struct {
+	// corporation_id is the id of the corporation granting the authorization.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// operator_authorization is set for an OperatorAuthorization.
+	OperatorAuthorization *OperatorAuthorization `protobuf:"bytes,2,opt,name=operator_authorization,json=operatorAuthorization,proto3" json:"operator_authorization,omitempty"`
+	// vs_operator_authorization is set for a VSOperatorAuthorization.
+	VsOperatorAuthorization *VSOperatorAuthorization `protobuf:"bytes,3,opt,name=vs_operator_authorization,json=vsOperatorAuthorization,proto3" json:"vs_operator_authorization,omitempty"`
+	// remaining_spend is what is left of the spend_limit of an operator
+	// authorization in its current period. Empty without spend_limit; the
+	// records of a VS operator authorization carry their own.
+	RemainingSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=remaining_spend,json=remainingSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_spend"`
+	// expiration is when the authorization stops being usable: the expiration
+	// of an operator authorization, or the latest record expiration of a VS
+	// operator authorization. Unset if it does not expire.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *GranteeAuthorization) Reset()         { *m = GranteeAuthorization{} }
+func (m *GranteeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*GranteeAuthorization) ProtoMessage()    {}
+func (*GranteeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{15}
+}
+func (m *GranteeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GranteeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranteeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GranteeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranteeAuthorization.Merge(m, src)
+}
+func (m *GranteeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranteeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranteeAuthorization.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.de.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListOperatorAuthorizations",
-			Handler:    _Query_ListOperatorAuthorizations_Handler,
-		},
-		{
-			MethodName: "ListVSOperatorAuthorizations",
-			Handler:    _Query_ListVSOperatorAuthorizations_Handler,
-		},
-		{
-			MethodName: "GetOperatorAuthorization",
-			Handler:    _Query_GetOperatorAuthorization_Handler,
-		},
-		{
-			MethodName: "GetVSOperatorAuthorization",
-			Handler:    _Query_GetVSOperatorAuthorization_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/de/v1/query.proto",
+var xxx_messageInfo_GranteeAuthorization proto.InternalMessageInfo
+
+func (m *GranteeAuthorization) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GranteeAuthorization) GetOperatorAuthorization() *OperatorAuthorization {
+	if m != nil {
+		return m.OperatorAuthorization
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GranteeAuthorization) GetVsOperatorAuthorization() *VSOperatorAuthorization {
+	if m != nil {
+		return m.VsOperatorAuthorization
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GranteeAuthorization) GetRemainingSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingSpend
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GranteeAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryListAuthorizationsByGranteeResponse is the response type for the
+// Query/ListAuthorizationsByGrantee RPC method.
+type QueryListAuthorizationsByGranteeResponse struct {
+	// authorizations are ordered by corporation_id.
+	Authorizations []GranteeAuthorization `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryListAuthorizationsByGranteeResponse) Reset() {
+	*m = QueryListAuthorizationsByGranteeResponse{}
+}
+func (m *QueryListAuthorizationsByGranteeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListAuthorizationsByGranteeResponse) ProtoMessage()    {}
+func (*QueryListAuthorizationsByGranteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{16}
+}
+func (m *QueryListAuthorizationsByGranteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListAuthorizationsByGranteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListAuthorizationsByGranteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryListAuthorizationsByGranteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListAuthorizationsByGranteeResponse.Merge(m, src)
+}
+func (m *QueryListAuthorizationsByGranteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListAuthorizationsByGranteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListAuthorizationsByGranteeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListOperatorAuthorizationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListAuthorizationsByGranteeResponse proto.InternalMessageInfo
+
+func (m *QueryListAuthorizationsByGranteeResponse) GetAuthorizations() []GranteeAuthorization {
+	if m != nil {
+		return m.Authorizations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListOperatorAuthorizationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetCorporationFreezeRequest is the request type for the
+// Query/GetCorporationFreeze RPC method.
+type QueryGetCorporationFreezeRequest struct {
+	// corporation_id is the id of the corporation.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
 }
 
-func (m *QueryListOperatorAuthorizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ResponseMaxSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CorporationId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetCorporationFreezeRequest) Reset()         { *m = QueryGetCorporationFreezeRequest{} }
+func (m *QueryGetCorporationFreezeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCorporationFreezeRequest) ProtoMessage()    {}
+func (*QueryGetCorporationFreezeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{17}
+}
+func (m *QueryGetCorporationFreezeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCorporationFreezeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCorporationFreezeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCorporationFreezeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCorporationFreezeRequest.Merge(m, src)
+}
+func (m *QueryGetCorporationFreezeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCorporationFreezeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCorporationFreezeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListOperatorAuthorizationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCorporationFreezeRequest proto.InternalMessageInfo
+
+func (m *QueryGetCorporationFreezeRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListOperatorAuthorizationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetCorporationFreezeResponse is the response type for the
+// Query/GetCorporationFreeze RPC method.
+type QueryGetCorporationFreezeResponse struct {
+	// frozen is true while the operators of the corporation are frozen.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// freeze is the active freeze, unset when not frozen.
+	Freeze *CorporationFreeze `protobuf:"bytes,2,opt,name=freeze,proto3" json:"freeze,omitempty"`
 }
 
-func (m *QueryListOperatorAuthorizationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAuthorizations) > 0 {
-		for iNdEx := len(m.OperatorAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OperatorAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetCorporationFreezeResponse) Reset()         { *m = QueryGetCorporationFreezeResponse{} }
+func (m *QueryGetCorporationFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCorporationFreezeResponse) ProtoMessage()    {}
+func (*QueryGetCorporationFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{18}
+}
+func (m *QueryGetCorporationFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCorporationFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCorporationFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCorporationFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCorporationFreezeResponse.Merge(m, src)
+}
+func (m *QueryGetCorporationFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCorporationFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCorporationFreezeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListVSOperatorAuthorizationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCorporationFreezeResponse proto.InternalMessageInfo
+
+func (m *QueryGetCorporationFreezeResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryListVSOperatorAuthorizationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (m *QueryGetCorporationFreezeResponse) GetFreeze() *CorporationFreeze {
+	if m != nil {
+		return m.Freeze
+	}
+	return nil
+}
 
-func (m *QueryListVSOperatorAuthorizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ResponseMaxSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
-		i--
-		dAtA[i] = 0x18
+// QueryListPendingOperatorAuthorizationsRequest is the request type for the
+// Query/ListPendingOperatorAuthorizations RPC method.
+type QueryListPendingOperatorAuthorizationsRequest struct {
+	// corporation_id filters by the corporation that granted the authorization.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// operator filters by the operator account that received the authorization.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// response_max_size limits the number of results. Must be 1-1024, defaults to 64.
+	ResponseMaxSize uint32 `protobuf:"varint,3,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListPendingOperatorAuthorizationsRequest) Reset() {
+	*m = QueryListPendingOperatorAuthorizationsRequest{}
+}
+func (m *QueryListPendingOperatorAuthorizationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryListPendingOperatorAuthorizationsRequest) ProtoMessage() {}
+func (*QueryListPendingOperatorAuthorizationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{19}
+}
+func (m *QueryListPendingOperatorAuthorizationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPendingOperatorAuthorizationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPendingOperatorAuthorizationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.VsOperator) > 0 {
-		i -= len(m.VsOperator)
-		copy(dAtA[i:], m.VsOperator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VsOperator)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryListPendingOperatorAuthorizationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPendingOperatorAuthorizationsRequest.Merge(m, src)
+}
+func (m *QueryListPendingOperatorAuthorizationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPendingOperatorAuthorizationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPendingOperatorAuthorizationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListPendingOperatorAuthorizationsRequest proto.InternalMessageInfo
+
+func (m *QueryListPendingOperatorAuthorizationsRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
 	}
-	if m.CorporationId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *QueryListPendingOperatorAuthorizationsRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryListVSOperatorAuthorizationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListPendingOperatorAuthorizationsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListVSOperatorAuthorizationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryListPendingOperatorAuthorizationsResponse is the response type for the
+// Query/ListPendingOperatorAuthorizations RPC method.
+type QueryListPendingOperatorAuthorizationsResponse struct {
+	// operator_authorizations are awaiting acceptance or before their
+	// not_before.
+	OperatorAuthorizations []OperatorAuthorization `protobuf:"bytes,1,rep,name=operator_authorizations,json=operatorAuthorizations,proto3" json:"operator_authorizations"`
 }
 
-func (m *QueryListVSOperatorAuthorizationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VsOperatorAuthorizations) > 0 {
-		for iNdEx := len(m.VsOperatorAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VsOperatorAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryListPendingOperatorAuthorizationsResponse) Reset() {
+	*m = QueryListPendingOperatorAuthorizationsResponse{}
+}
+func (m *QueryListPendingOperatorAuthorizationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryListPendingOperatorAuthorizationsResponse) ProtoMessage() {}
+func (*QueryListPendingOperatorAuthorizationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e9e1468cb47da6, []int{20}
+}
+func (m *QueryListPendingOperatorAuthorizationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPendingOperatorAuthorizationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPendingOperatorAuthorizationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryListPendingOperatorAuthorizationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPendingOperatorAuthorizationsResponse.Merge(m, src)
+}
+func (m *QueryListPendingOperatorAuthorizationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPendingOperatorAuthorizationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPendingOperatorAuthorizationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetOperatorAuthorizationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListPendingOperatorAuthorizationsResponse proto.InternalMessageInfo
+
+func (m *QueryListPendingOperatorAuthorizationsResponse) GetOperatorAuthorizations() []OperatorAuthorization {
+	if m != nil {
+		return m.OperatorAuthorizations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetOperatorAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "verana.de.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "verana.de.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryListOperatorAuthorizationsRequest)(nil), "verana.de.v1.QueryListOperatorAuthorizationsRequest")
+	proto.RegisterType((*QueryListOperatorAuthorizationsResponse)(nil), "verana.de.v1.QueryListOperatorAuthorizationsResponse")
+	proto.RegisterType((*QueryListVSOperatorAuthorizationsRequest)(nil), "verana.de.v1.QueryListVSOperatorAuthorizationsRequest")
+	proto.RegisterType((*QueryListVSOperatorAuthorizationsResponse)(nil), "verana.de.v1.QueryListVSOperatorAuthorizationsResponse")
+	proto.RegisterType((*QueryGetOperatorAuthorizationRequest)(nil), "verana.de.v1.QueryGetOperatorAuthorizationRequest")
+	proto.RegisterType((*QueryGetOperatorAuthorizationResponse)(nil), "verana.de.v1.QueryGetOperatorAuthorizationResponse")
+	proto.RegisterType((*QueryGetVSOperatorAuthorizationRequest)(nil), "verana.de.v1.QueryGetVSOperatorAuthorizationRequest")
+	proto.RegisterType((*QueryGetVSOperatorAuthorizationResponse)(nil), "verana.de.v1.QueryGetVSOperatorAuthorizationResponse")
+	proto.RegisterType((*QueryListOperatorRolesRequest)(nil), "verana.de.v1.QueryListOperatorRolesRequest")
+	proto.RegisterType((*QueryListOperatorRolesResponse)(nil), "verana.de.v1.QueryListOperatorRolesResponse")
+	proto.RegisterType((*QueryListRoleOperatorsRequest)(nil), "verana.de.v1.QueryListRoleOperatorsRequest")
+	proto.RegisterType((*QueryListRoleOperatorsResponse)(nil), "verana.de.v1.QueryListRoleOperatorsResponse")
+	proto.RegisterType((*QueryListAuthorizationsByGranteeRequest)(nil), "verana.de.v1.QueryListAuthorizationsByGranteeRequest")
+	proto.RegisterType((*GranteeAuthorization)(nil), "verana.de.v1.GranteeAuthorization")
+	proto.RegisterType((*QueryListAuthorizationsByGranteeResponse)(nil), "verana.de.v1.QueryListAuthorizationsByGranteeResponse")
+	proto.RegisterType((*QueryGetCorporationFreezeRequest)(nil), "verana.de.v1.QueryGetCorporationFreezeRequest")
+	proto.RegisterType((*QueryGetCorporationFreezeResponse)(nil), "verana.de.v1.QueryGetCorporationFreezeResponse")
+	proto.RegisterType((*QueryListPendingOperatorAuthorizationsRequest)(nil), "verana.de.v1.QueryListPendingOperatorAuthorizationsRequest")
+	proto.RegisterType((*QueryListPendingOperatorAuthorizationsResponse)(nil), "verana.de.v1.QueryListPendingOperatorAuthorizationsResponse")
 }
 
-func (m *QueryGetOperatorAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("verana/de/v1/query.proto", fileDescriptor_41e9e1468cb47da6) }
+
+var fileDescriptor_41e9e1468cb47da6 = []byte{
+	// 1272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x69, 0x68, 0x5f, 0x89, 0xab, 0x0c, 0x26, 0x75, 0x96, 0xe2, 0x38, 0x0b, 0xa1,
+	0x26, 0xd4, 0xbb, 0x89, 0x9b, 0xa6, 0xfc, 0x12, 0x6a, 0x5d, 0xa9, 0x51, 0x25, 0xa0, 0xc1, 0x41,
+	0x1c, 0x7a, 0x31, 0xeb, 0xec, 0x64, 0x3b, 0x22, 0xde, 0xd9, 0xee, 0x6e, 0x4c, 0x7e, 0x90, 0x0b,
+	0x42, 0x48, 0x3d, 0x20, 0x55, 0x80, 0xf8, 0x0f, 0x90, 0x10, 0x27, 0x7e, 0x54, 0xd0, 0x23, 0x48,
+	0x1c, 0x7a, 0xac, 0x40, 0x48, 0x9c, 0x28, 0x4a, 0x90, 0xf8, 0x37, 0xd0, 0xce, 0xce, 0xba, 0xbb,
+	0xc9, 0xac, 0xed, 0xad, 0x50, 0x25, 0x2e, 0x89, 0x77, 0xe6, 0xbd, 0x99, 0xef, 0xfb, 0xe6, 0xbd,
+	0x99, 0x0f, 0x8a, 0x1d, 0xe2, 0x1a, 0xb6, 0xa1, 0x9b, 0x44, 0xef, 0xcc, 0xeb, 0x37, 0x36, 0x88,
+	0xbb, 0xa5, 0x39, 0x2e, 0xf3, 0x19, 0x7e, 0x3c, 0x9c, 0xd1, 0x4c, 0xa2, 0x75, 0xe6, 0x95, 0x71,
+	0xa3, 0x4d, 0x6d, 0xa6, 0xf3, 0xbf, 0x61, 0x80, 0x52, 0x5a, 0x65, 0x5e, 0x9b, 0x79, 0x7a, 0xcb,
+	0xf0, 0x82, 0xe4, 0x16, 0xf1, 0x8d, 0x79, 0x7d, 0x95, 0x51, 0x5b, 0xcc, 0xcf, 0xc6, 0xe7, 0xf9,
+	0xca, 0xdd, 0x28, 0xc7, 0xb0, 0xa8, 0x6d, 0xf8, 0x94, 0x45, 0xb1, 0x93, 0x61, 0x6c, 0x93, 0x7f,
+	0xe9, 0xe1, 0x87, 0x98, 0x2a, 0x58, 0xcc, 0x62, 0xe1, 0x78, 0xf0, 0x4b, 0x8c, 0x9e, 0xb2, 0x18,
+	0xb3, 0xd6, 0x89, 0x6e, 0x38, 0x54, 0x37, 0x6c, 0x9b, 0xf9, 0x7c, 0xb5, 0x28, 0x67, 0x4a, 0xcc,
+	0xf2, 0xaf, 0xd6, 0xc6, 0x9a, 0xee, 0xd3, 0x36, 0xf1, 0x7c, 0xa3, 0xed, 0x44, 0xfb, 0x25, 0x68,
+	0x3b, 0x86, 0x6b, 0xb4, 0xa3, 0xdc, 0xa4, 0x22, 0xfe, 0x96, 0x43, 0xc4, 0x8c, 0x5a, 0x00, 0xfc,
+	0x56, 0x40, 0x63, 0x99, 0x87, 0x37, 0xc8, 0x8d, 0x0d, 0xe2, 0xf9, 0xea, 0x9b, 0xf0, 0x44, 0x62,
+	0xd4, 0x73, 0x98, 0xed, 0x11, 0x7c, 0x1e, 0x46, 0xc3, 0x65, 0x8b, 0xa8, 0x8c, 0x2a, 0xc7, 0x6b,
+	0x05, 0x2d, 0xae, 0xa7, 0x16, 0x46, 0xd7, 0x8f, 0xdd, 0xfd, 0x73, 0x6a, 0xe8, 0xab, 0x7f, 0xbe,
+	0x99, 0x45, 0x0d, 0x11, 0xae, 0x7e, 0x8b, 0xe0, 0x39, 0xbe, 0xe0, 0xeb, 0xd4, 0xf3, 0xaf, 0x3a,
+	0xc4, 0x35, 0x7c, 0xe6, 0x5e, 0xdc, 0xf0, 0xaf, 0x33, 0x97, 0x6e, 0x87, 0x2c, 0xc5, 0xd6, 0x78,
+	0x06, 0xf2, 0xab, 0xcc, 0x75, 0x98, 0xcb, 0x87, 0x9b, 0xd4, 0xe4, 0x7b, 0x8d, 0x34, 0xc6, 0x62,
+	0xa3, 0x57, 0x4c, 0xbc, 0x00, 0x47, 0x99, 0x58, 0xa7, 0x98, 0x2b, 0xa3, 0xca, 0xb1, 0x7a, 0xf1,
+	0xd7, 0xdb, 0xd5, 0x82, 0x50, 0xf9, 0xa2, 0x69, 0xba, 0xc4, 0xf3, 0x56, 0x7c, 0x97, 0xda, 0x56,
+	0xa3, 0x1b, 0x89, 0x67, 0x61, 0xdc, 0x15, 0x64, 0x9a, 0x6d, 0x63, 0xb3, 0xe9, 0xd1, 0x6d, 0x52,
+	0x1c, 0x2e, 0xa3, 0xca, 0x58, 0xe3, 0x44, 0x34, 0xf1, 0x86, 0xb1, 0xb9, 0x42, 0xb7, 0x89, 0xfa,
+	0x09, 0x82, 0xd3, 0x7d, 0x31, 0x0b, 0x61, 0x5a, 0x70, 0x32, 0xda, 0xa3, 0x69, 0x24, 0x42, 0x8a,
+	0xa8, 0x3c, 0x5c, 0x39, 0x5e, 0x7b, 0x26, 0xa9, 0x94, 0x74, 0xb9, 0xfa, 0x48, 0x20, 0x5c, 0x63,
+	0x82, 0x49, 0xf7, 0x52, 0xef, 0x20, 0xa8, 0x74, 0xf1, 0xbc, 0xb3, 0xf2, 0x9f, 0xa8, 0xf8, 0x12,
+	0x1c, 0xef, 0x78, 0xcd, 0x81, 0x85, 0x84, 0x8e, 0x77, 0xf5, 0x61, 0xa4, 0xfc, 0x02, 0xc1, 0xf3,
+	0x03, 0x40, 0x17, 0x62, 0x52, 0x50, 0x62, 0xa0, 0xe4, 0x7a, 0xce, 0x24, 0xf5, 0x4c, 0x59, 0x53,
+	0x28, 0x5a, 0x7c, 0x00, 0xfb, 0x80, 0xa6, 0x8b, 0xf0, 0x2c, 0xc7, 0xb5, 0x44, 0xe4, 0x27, 0x1c,
+	0xc9, 0x99, 0x87, 0x5c, 0x57, 0xc2, 0x1c, 0x35, 0xd5, 0x9b, 0x08, 0x66, 0xfa, 0x24, 0x0a, 0x32,
+	0xef, 0xc2, 0x84, 0x9c, 0x89, 0x68, 0xa1, 0x0c, 0x85, 0xf1, 0xa4, 0xb4, 0x30, 0xd4, 0x17, 0x45,
+	0x6b, 0x2d, 0x91, 0x34, 0x69, 0xd3, 0x58, 0x7c, 0x1a, 0x55, 0x78, 0xaf, 0x54, 0xc1, 0xc3, 0x82,
+	0xc9, 0xd4, 0x43, 0x11, 0x54, 0x32, 0x9d, 0xc9, 0xc9, 0x94, 0x33, 0x51, 0x5d, 0x78, 0xfa, 0x50,
+	0xd7, 0x35, 0xd8, 0x3a, 0xc9, 0x5a, 0xda, 0xd2, 0xfa, 0xcc, 0xc9, 0xeb, 0x93, 0x42, 0x29, 0x6d,
+	0x4f, 0x41, 0x7f, 0x09, 0xf2, 0x5d, 0xee, 0x6e, 0x30, 0x23, 0xea, 0x50, 0x91, 0x1f, 0x5f, 0x90,
+	0x2c, 0x88, 0x8e, 0xb1, 0xf8, 0x82, 0xea, 0xc7, 0x28, 0xc6, 0x2f, 0x18, 0x8a, 0x52, 0xb2, 0xf2,
+	0xc3, 0x30, 0x12, 0x00, 0x09, 0x7b, 0xb6, 0xc1, 0x7f, 0x67, 0xea, 0xc9, 0x8f, 0x50, 0x8c, 0xf4,
+	0x01, 0x20, 0x8f, 0xf0, 0x56, 0xbb, 0x19, 0xbf, 0x65, 0x93, 0x73, 0xf5, 0xad, 0x25, 0xd7, 0xb0,
+	0x7d, 0x42, 0x22, 0x65, 0x6a, 0xf0, 0x98, 0x15, 0x8e, 0x70, 0x49, 0x7a, 0xdd, 0x54, 0x51, 0x60,
+	0xa6, 0x32, 0xb8, 0x3d, 0x0c, 0x05, 0xb1, 0x65, 0x02, 0xc9, 0xa0, 0x47, 0x72, 0x2d, 0xb5, 0xd7,
+	0x73, 0x03, 0xf7, 0x7a, 0x4a, 0x97, 0x63, 0xa3, 0x57, 0xff, 0x0d, 0x67, 0xe8, 0xbf, 0xd4, 0xce,
+	0xc3, 0x3e, 0x9c, 0x70, 0x49, 0xdb, 0xa0, 0x36, 0xb5, 0xad, 0xa6, 0xe7, 0x10, 0xdb, 0x2c, 0x8e,
+	0xf0, 0x63, 0x9e, 0xd4, 0x84, 0xc6, 0x81, 0xeb, 0xd1, 0x84, 0xdf, 0xd1, 0x2e, 0x31, 0x6a, 0xd7,
+	0xe7, 0x82, 0xc3, 0xfd, 0xfa, 0xfe, 0x54, 0xc5, 0xa2, 0xfe, 0xf5, 0x8d, 0x96, 0xb6, 0xca, 0xda,
+	0xc2, 0xe9, 0x88, 0x7f, 0x55, 0xcf, 0x7c, 0x4f, 0x18, 0x8e, 0x20, 0xc1, 0x6b, 0xe4, 0xbb, 0x7b,
+	0xac, 0x04, 0x5b, 0xe0, 0x0b, 0x00, 0x64, 0xd3, 0xa1, 0xa1, 0x88, 0xc5, 0x23, 0x9c, 0x89, 0xa2,
+	0x85, 0x5e, 0x47, 0x8b, 0xbc, 0x8e, 0xf6, 0x76, 0xe4, 0x75, 0xea, 0x23, 0xb7, 0xee, 0x4f, 0xa1,
+	0x46, 0x2c, 0x47, 0xfd, 0x20, 0xf6, 0x2e, 0xa6, 0x56, 0x90, 0x28, 0xe9, 0x65, 0xc8, 0x4b, 0x2b,
+	0x59, 0x4d, 0x6a, 0x27, 0xab, 0x02, 0x51, 0xc8, 0x07, 0xf2, 0xd5, 0x2b, 0x50, 0x8e, 0xee, 0xd0,
+	0x4b, 0x0f, 0xaa, 0xe1, 0xb2, 0x4b, 0xc8, 0x36, 0xc9, 0xd6, 0xd2, 0xaa, 0x0f, 0xd3, 0x3d, 0x96,
+	0x12, 0x0c, 0x26, 0x60, 0x74, 0xcd, 0x65, 0xdb, 0x24, 0xbc, 0x75, 0x8f, 0x36, 0xc4, 0x57, 0xe0,
+	0xcd, 0xd6, 0x78, 0xa4, 0x28, 0xb6, 0xa9, 0x24, 0xa3, 0xc3, 0x0b, 0x8a, 0xf0, 0xc0, 0x57, 0x54,
+	0xbb, 0xfa, 0x2d, 0x13, 0xdb, 0xa4, 0xb6, 0xf5, 0x3f, 0xb1, 0x68, 0x9f, 0x23, 0xd0, 0x06, 0x85,
+	0xfe, 0xe8, 0xee, 0xb4, 0xda, 0x77, 0x79, 0x38, 0xc2, 0x61, 0xe1, 0xf7, 0x61, 0x34, 0x34, 0xc5,
+	0xb8, 0x9c, 0x5c, 0xf6, 0xb0, 0xe7, 0x56, 0xa6, 0x7b, 0x44, 0x84, 0xe0, 0xd5, 0xca, 0x87, 0xbf,
+	0xfd, 0xfd, 0x59, 0x4e, 0xc5, 0x65, 0x3d, 0x0c, 0xad, 0xae, 0x1b, 0x2d, 0x4f, 0x97, 0xd8, 0x7e,
+	0x7c, 0x07, 0x81, 0x92, 0xee, 0x5b, 0xf1, 0x82, 0x64, 0xaf, 0xbe, 0xd6, 0x5c, 0x39, 0x97, 0x31,
+	0x4b, 0xa0, 0xae, 0x72, 0xd4, 0xa7, 0xf1, 0x4c, 0x12, 0x69, 0x24, 0x5e, 0x35, 0x79, 0x0c, 0xf8,
+	0x67, 0x04, 0xa7, 0x7a, 0xf9, 0x44, 0xbc, 0x98, 0x02, 0xa3, 0x8f, 0x27, 0x56, 0xce, 0x67, 0xce,
+	0x13, 0x04, 0xe6, 0x38, 0x81, 0x59, 0x5c, 0x49, 0x12, 0xe8, 0x78, 0xd5, 0x34, 0x0e, 0x3f, 0x22,
+	0x28, 0xa6, 0x59, 0x43, 0x5c, 0x93, 0xe0, 0xe8, 0x63, 0x40, 0x95, 0xb3, 0x99, 0x72, 0x04, 0xee,
+	0x1a, 0xc7, 0x7d, 0x06, 0xcf, 0x0e, 0x24, 0xbc, 0xbe, 0x43, 0xcd, 0x5d, 0xfc, 0x13, 0x02, 0x25,
+	0xdd, 0x0e, 0x4a, 0x0b, 0xa7, 0xaf, 0xf1, 0x94, 0x16, 0x4e, 0x7f, 0xcf, 0xa9, 0x9e, 0xe3, 0xf8,
+	0x75, 0x5c, 0x1d, 0x54, 0xf7, 0x90, 0xc2, 0x97, 0x08, 0xc6, 0x0f, 0x39, 0x39, 0xfc, 0x42, 0x9f,
+	0xe2, 0x8d, 0x7b, 0x4c, 0xe5, 0xcc, 0x60, 0xc1, 0xbd, 0x71, 0x76, 0x41, 0x72, 0xc3, 0xa8, 0xef,
+	0x24, 0xef, 0xcc, 0x5d, 0xfc, 0x83, 0xc0, 0x99, 0x30, 0x5f, 0xa9, 0x38, 0x65, 0x5e, 0x31, 0x15,
+	0xa7, 0xd4, 0xcf, 0xa9, 0x97, 0x39, 0xce, 0x0b, 0xf8, 0xb5, 0x4c, 0x38, 0xf5, 0x9d, 0x60, 0x7c,
+	0xb7, 0x1b, 0xe6, 0xe1, 0x5f, 0x10, 0x3c, 0xd5, 0xe3, 0xb1, 0xc5, 0x69, 0xf7, 0x44, 0x6f, 0x7b,
+	0xa7, 0x2c, 0x66, 0x4d, 0x13, 0xb4, 0x16, 0x39, 0xad, 0x39, 0xac, 0x25, 0x69, 0x09, 0x07, 0x78,
+	0xa8, 0x44, 0xc4, 0xf8, 0x2e, 0xfe, 0x1e, 0x41, 0x41, 0xf6, 0xd4, 0x62, 0x4d, 0x5e, 0xae, 0x69,
+	0xcf, 0xbb, 0xa2, 0x0f, 0x1c, 0x2f, 0x10, 0xbf, 0xcc, 0x11, 0x2f, 0xe0, 0x5a, 0x12, 0x71, 0x4c,
+	0xf8, 0x6a, 0xf8, 0x38, 0x4b, 0xaa, 0xe6, 0x77, 0x04, 0xd3, 0x7d, 0x9f, 0x3b, 0xfc, 0x4a, 0x8a,
+	0x96, 0x83, 0xbc, 0xef, 0xca, 0xab, 0x0f, 0x97, 0xdc, 0xbb, 0x1b, 0x9c, 0x30, 0x39, 0xad, 0x75,
+	0xeb, 0xf5, 0xbb, 0x7b, 0x25, 0x74, 0x6f, 0xaf, 0x84, 0xfe, 0xda, 0x2b, 0xa1, 0x5b, 0xfb, 0xa5,
+	0xa1, 0x7b, 0xfb, 0xa5, 0xa1, 0x3f, 0xf6, 0x4b, 0x43, 0xd7, 0xe2, 0xde, 0x52, 0xf2, 0xee, 0x6d,
+	0x06, 0x1b, 0x70, 0x87, 0xd9, 0x1a, 0xe5, 0x7e, 0xf1, 0xec, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x3e, 0x9c, 0x3d, 0x54, 0x01, 0x14, 0x00, 0x00,
 }
 
-func (m *QueryGetOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListOperatorAuthorizations returns operator authorizations matching optional filters.
+	ListOperatorAuthorizations(ctx context.Context, in *QueryListOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListOperatorAuthorizationsResponse, error)
+	// ListVSOperatorAuthorizations returns VS operator authorizations matching optional filters.
+	ListVSOperatorAuthorizations(ctx context.Context, in *QueryListVSOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListVSOperatorAuthorizationsResponse, error)
+	// [MOD-DE-QRY-3] GetOperatorAuthorization returns a single OperatorAuthorization by id.
+	GetOperatorAuthorization(ctx context.Context, in *QueryGetOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetOperatorAuthorizationResponse, error)
+	// [MOD-DE-QRY-4] GetVSOperatorAuthorization returns a single VSOperatorAuthorization by id.
+	GetVSOperatorAuthorization(ctx context.Context, in *QueryGetVSOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetVSOperatorAuthorizationResponse, error)
+	// ListOperatorRoles returns the operator roles of a corporation.
+	ListOperatorRoles(ctx context.Context, in *QueryListOperatorRolesRequest, opts ...grpc.CallOption) (*QueryListOperatorRolesResponse, error)
+	// ListRoleOperators returns the operator authorizations granted from an
+	// operator role.
+	ListRoleOperators(ctx context.Context, in *QueryListRoleOperatorsRequest, opts ...grpc.CallOption) (*QueryListRoleOperatorsResponse, error)
+	// ListAuthorizationsByGrantee returns the operator and VS operator
+	// authorizations held by a grantee across all corporations.
+	ListAuthorizationsByGrantee(ctx context.Context, in *QueryListAuthorizationsByGranteeRequest, opts ...grpc.CallOption) (*QueryListAuthorizationsByGranteeResponse, error)
+	// GetCorporationFreeze returns whether the operators of a corporation are frozen.
+	GetCorporationFreeze(ctx context.Context, in *QueryGetCorporationFreezeRequest, opts ...grpc.CallOption) (*QueryGetCorporationFreezeResponse, error)
+	// ListPendingOperatorAuthorizations lists the operator authorizations not active yet.
+	ListPendingOperatorAuthorizations(ctx context.Context, in *QueryListPendingOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListPendingOperatorAuthorizationsResponse, error)
 }
 
-func (m *QueryGetOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryGetOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OperatorAuthorization.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryGetVSOperatorAuthorizationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetVSOperatorAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListOperatorAuthorizations(ctx context.Context, in *QueryListOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListOperatorAuthorizationsResponse, error) {
+	out := new(QueryListOperatorAuthorizationsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListOperatorAuthorizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetVSOperatorAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ListVSOperatorAuthorizations(ctx context.Context, in *QueryListVSOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListVSOperatorAuthorizationsResponse, error) {
+	out := new(QueryListVSOperatorAuthorizationsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListVSOperatorAuthorizations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetVSOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetOperatorAuthorization(ctx context.Context, in *QueryGetOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetOperatorAuthorizationResponse, error) {
+	out := new(QueryGetOperatorAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/GetOperatorAuthorization", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetVSOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetVSOperatorAuthorization(ctx context.Context, in *QueryGetVSOperatorAuthorizationRequest, opts ...grpc.CallOption) (*QueryGetVSOperatorAuthorizationResponse, error) {
+	out := new(QueryGetVSOperatorAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/GetVSOperatorAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetVSOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VsOperatorAuthorization.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ListOperatorRoles(ctx context.Context, in *QueryListOperatorRolesRequest, opts ...grpc.CallOption) (*QueryListOperatorRolesResponse, error) {
+	out := new(QueryListOperatorRolesResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListOperatorRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) ListRoleOperators(ctx context.Context, in *QueryListRoleOperatorsRequest, opts ...grpc.CallOption) (*QueryListRoleOperatorsResponse, error) {
+	out := new(QueryListRoleOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListRoleOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) ListAuthorizationsByGrantee(ctx context.Context, in *QueryListAuthorizationsByGranteeRequest, opts ...grpc.CallOption) (*QueryListAuthorizationsByGranteeResponse, error) {
+	out := new(QueryListAuthorizationsByGranteeResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListAuthorizationsByGrantee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetCorporationFreeze(ctx context.Context, in *QueryGetCorporationFreezeRequest, opts ...grpc.CallOption) (*QueryGetCorporationFreezeResponse, error) {
+	out := new(QueryGetCorporationFreezeResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/GetCorporationFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryListOperatorAuthorizationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListPendingOperatorAuthorizations(ctx context.Context, in *QueryListPendingOperatorAuthorizationsRequest, opts ...grpc.CallOption) (*QueryListPendingOperatorAuthorizationsResponse, error) {
+	out := new(QueryListPendingOperatorAuthorizationsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Query/ListPendingOperatorAuthorizations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CorporationId != 0 {
-		n += 1 + sovQuery(uint64(m.CorporationId))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListOperatorAuthorizations returns operator authorizations matching optional filters.
+	ListOperatorAuthorizations(context.Context, *QueryListOperatorAuthorizationsRequest) (*QueryListOperatorAuthorizationsResponse, error)
+	// ListVSOperatorAuthorizations returns VS operator authorizations matching optional filters.
+	ListVSOperatorAuthorizations(context.Context, *QueryListVSOperatorAuthorizationsRequest) (*QueryListVSOperatorAuthorizationsResponse, error)
+	// [MOD-DE-QRY-3] GetOperatorAuthorization returns a single OperatorAuthorization by id.
+	GetOperatorAuthorization(context.Context, *QueryGetOperatorAuthorizationRequest) (*QueryGetOperatorAuthorizationResponse, error)
+	// [MOD-DE-QRY-4] GetVSOperatorAuthorization returns a single VSOperatorAuthorization by id.
+	GetVSOperatorAuthorization(context.Context, *QueryGetVSOperatorAuthorizationRequest) (*QueryGetVSOperatorAuthorizationResponse, error)
+	// ListOperatorRoles returns the operator roles of a corporation.
+	ListOperatorRoles(context.Context, *QueryListOperatorRolesRequest) (*QueryListOperatorRolesResponse, error)
+	// ListRoleOperators returns the operator authorizations granted from an
+	// operator role.
+	ListRoleOperators(context.Context, *QueryListRoleOperatorsRequest) (*QueryListRoleOperatorsResponse, error)
+	// ListAuthorizationsByGrantee returns the operator and VS operator
+	// authorizations held by a grantee across all corporations.
+	ListAuthorizationsByGrantee(context.Context, *QueryListAuthorizationsByGranteeRequest) (*QueryListAuthorizationsByGranteeResponse, error)
+	// GetCorporationFreeze returns whether the operators of a corporation are frozen.
+	GetCorporationFreeze(context.Context, *QueryGetCorporationFreezeRequest) (*QueryGetCorporationFreezeResponse, error)
+	// ListPendingOperatorAuthorizations lists the operator authorizations not active yet.
+	ListPendingOperatorAuthorizations(context.Context, *QueryListPendingOperatorAuthorizationsRequest) (*QueryListPendingOperatorAuthorizationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ListOperatorAuthorizations(ctx context.Context, req *QueryListOperatorAuthorizationsRequest) (*QueryListOperatorAuthorizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperatorAuthorizations not implemented")
+}
+func (*UnimplementedQueryServer) ListVSOperatorAuthorizations(ctx context.Context, req *QueryListVSOperatorAuthorizationsRequest) (*QueryListVSOperatorAuthorizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVSOperatorAuthorizations not implemented")
+}
+func (*UnimplementedQueryServer) GetOperatorAuthorization(ctx context.Context, req *QueryGetOperatorAuthorizationRequest) (*QueryGetOperatorAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperatorAuthorization not implemented")
+}
+func (*UnimplementedQueryServer) GetVSOperatorAuthorization(ctx context.Context, req *QueryGetVSOperatorAuthorizationRequest) (*QueryGetVSOperatorAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVSOperatorAuthorization not implemented")
+}
+func (*UnimplementedQueryServer) ListOperatorRoles(ctx context.Context, req *QueryListOperatorRolesRequest) (*QueryListOperatorRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperatorRoles not implemented")
+}
+func (*UnimplementedQueryServer) ListRoleOperators(ctx context.Context, req *QueryListRoleOperatorsRequest) (*QueryListRoleOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleOperators not implemented")
+}
+func (*UnimplementedQueryServer) ListAuthorizationsByGrantee(ctx context.Context, req *QueryListAuthorizationsByGranteeRequest) (*QueryListAuthorizationsByGranteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorizationsByGrantee not implemented")
+}
+func (*UnimplementedQueryServer) GetCorporationFreeze(ctx context.Context, req *QueryGetCorporationFreezeRequest) (*QueryGetCorporationFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorporationFreeze not implemented")
+}
+func (*UnimplementedQueryServer) ListPendingOperatorAuthorizations(ctx context.Context, req *QueryListPendingOperatorAuthorizationsRequest) (*QueryListPendingOperatorAuthorizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingOperatorAuthorizations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.ResponseMaxSize != 0 {
-		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListOperatorAuthorizationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListOperatorAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListOperatorAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.OperatorAuthorizations) > 0 {
-		for _, e := range m.OperatorAuthorizations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListOperatorAuthorizations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/ListOperatorAuthorizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListOperatorAuthorizations(ctx, req.(*QueryListOperatorAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListVSOperatorAuthorizationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListVSOperatorAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListVSOperatorAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CorporationId != 0 {
-		n += 1 + sovQuery(uint64(m.CorporationId))
+	if interceptor == nil {
+		return srv.(QueryServer).ListVSOperatorAuthorizations(ctx, in)
 	}
-	l = len(m.VsOperator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/ListVSOperatorAuthorizations",
 	}
-	if m.ResponseMaxSize != 0 {
-		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVSOperatorAuthorizations(ctx, req.(*QueryListVSOperatorAuthorizationsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListVSOperatorAuthorizationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOperatorAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.VsOperatorAuthorizations) > 0 {
-		for _, e := range m.VsOperatorAuthorizations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetOperatorAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/GetOperatorAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOperatorAuthorization(ctx, req.(*QueryGetOperatorAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVSOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVSOperatorAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVSOperatorAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/GetVSOperatorAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVSOperatorAuthorization(ctx, req.(*QueryGetVSOperatorAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListOperatorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListOperatorRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListOperatorRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/ListOperatorRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListOperatorRoles(ctx, req.(*QueryListOperatorRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRoleOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRoleOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRoleOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/ListRoleOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRoleOperators(ctx, req.(*QueryListRoleOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAuthorizationsByGrantee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListAuthorizationsByGranteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAuthorizationsByGrantee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/ListAuthorizationsByGrantee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAuthorizationsByGrantee(ctx, req.(*QueryListAuthorizationsByGranteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCorporationFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCorporationFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCorporationFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/GetCorporationFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCorporationFreeze(ctx, req.(*QueryGetCorporationFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPendingOperatorAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPendingOperatorAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPendingOperatorAuthorizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Query/ListPendingOperatorAuthorizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPendingOperatorAuthorizations(ctx, req.(*QueryListPendingOperatorAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.de.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ListOperatorAuthorizations",
+			Handler:    _Query_ListOperatorAuthorizations_Handler,
+		},
+		{
+			MethodName: "ListVSOperatorAuthorizations",
+			Handler:    _Query_ListVSOperatorAuthorizations_Handler,
+		},
+		{
+			MethodName: "GetOperatorAuthorization",
+			Handler:    _Query_GetOperatorAuthorization_Handler,
+		},
+		{
+			MethodName: "GetVSOperatorAuthorization",
+			Handler:    _Query_GetVSOperatorAuthorization_Handler,
+		},
+		{
+			MethodName: "ListOperatorRoles",
+			Handler:    _Query_ListOperatorRoles_Handler,
+		},
+		{
+			MethodName: "ListRoleOperators",
+			Handler:    _Query_ListRoleOperators_Handler,
+		},
+		{
+			MethodName: "ListAuthorizationsByGrantee",
+			Handler:    _Query_ListAuthorizationsByGrantee_Handler,
+		},
+		{
+			MethodName: "GetCorporationFreeze",
+			Handler:    _Query_GetCorporationFreeze_Handler,
+		},
+		{
+			MethodName: "ListPendingOperatorAuthorizations",
+			Handler:    _Query_ListPendingOperatorAuthorizations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/de/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListOperatorAuthorizationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListOperatorAuthorizationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListOperatorAuthorizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListOperatorAuthorizationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListOperatorAuthorizationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListOperatorAuthorizationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAuthorizations) > 0 {
+		for iNdEx := len(m.OperatorAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVSOperatorAuthorizationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVSOperatorAuthorizationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVSOperatorAuthorizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VsOperator) > 0 {
+		i -= len(m.VsOperator)
+		copy(dAtA[i:], m.VsOperator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VsOperator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVSOperatorAuthorizationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVSOperatorAuthorizationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVSOperatorAuthorizationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VsOperatorAuthorizations) > 0 {
+		for iNdEx := len(m.VsOperatorAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VsOperatorAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOperatorAuthorizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOperatorAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOperatorAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OperatorAuthorization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVSOperatorAuthorizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVSOperatorAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVSOperatorAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVSOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVSOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVSOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VsOperatorAuthorization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListOperatorRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListOperatorRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListOperatorRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListOperatorRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListOperatorRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListOperatorRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorRoles) > 0 {
+		for iNdEx := len(m.OperatorRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRoleOperatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRoleOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRoleOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRoleOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRoleOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRoleOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAuthorizations) > 0 {
+		for iNdEx := len(m.OperatorAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAuthorizationsByGranteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAuthorizationsByGranteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAuthorizationsByGranteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GranteeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranteeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranteeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintQuery(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RemainingSpend) > 0 {
+		for iNdEx := len(m.RemainingSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.VsOperatorAuthorization != nil {
+		{
+			size, err := m.VsOperatorAuthorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OperatorAuthorization != nil {
+		{
+			size, err := m.OperatorAuthorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAuthorizationsByGranteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAuthorizationsByGranteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAuthorizationsByGranteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCorporationFreezeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCorporationFreezeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCorporationFreezeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCorporationFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCorporationFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCorporationFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Freeze != nil {
+		{
+			size, err := m.Freeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingOperatorAuthorizationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingOperatorAuthorizationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingOperatorAuthorizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingOperatorAuthorizationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingOperatorAuthorizationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingOperatorAuthorizationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAuthorizations) > 0 {
+		for iNdEx := len(m.OperatorAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListOperatorAuthorizationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListOperatorAuthorizationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorAuthorizations) > 0 {
+		for _, e := range m.OperatorAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListVSOperatorAuthorizationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = len(m.VsOperator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListVSOperatorAuthorizationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VsOperatorAuthorizations) > 0 {
+		for _, e := range m.VsOperatorAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetOperatorAuthorizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetOperatorAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OperatorAuthorization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetVSOperatorAuthorizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetVSOperatorAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VsOperatorAuthorization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListOperatorRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListOperatorRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorRoles) > 0 {
+		for _, e := range m.OperatorRoles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListRoleOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListRoleOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorAuthorizations) > 0 {
+		for _, e := range m.OperatorAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListAuthorizationsByGranteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *GranteeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	if m.OperatorAuthorization != nil {
+		l = m.OperatorAuthorization.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VsOperatorAuthorization != nil {
+		l = m.VsOperatorAuthorization.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RemainingSpend) > 0 {
+		for _, e := range m.RemainingSpend {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListAuthorizationsByGranteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetCorporationFreezeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	return n
+}
+
+func (m *QueryGetCorporationFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	if m.Freeze != nil {
+		l = m.Freeze.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPendingOperatorAuthorizationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListPendingOperatorAuthorizationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorAuthorizations) > 0 {
+		for _, e := range m.OperatorAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListOperatorAuthorizationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListOperatorAuthorizationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListOperatorAuthorizationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListOperatorAuthorizationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAuthorizations = append(m.OperatorAuthorizations, OperatorAuthorization{})
+			if err := m.OperatorAuthorizations[len(m.OperatorAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListVSOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListVSOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthorizations = append(m.VsOperatorAuthorizations, VSOperatorAuthorization{})
+			if err := m.VsOperatorAuthorizations[len(m.VsOperatorAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOperatorAuthorizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOperatorAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOperatorAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatorAuthorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVSOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryGetOperatorAuthorizationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
-}
 
-func (m *QueryGetOperatorAuthorizationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.OperatorAuthorization.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryGetVSOperatorAuthorizationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryGetVSOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VsOperatorAuthorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetVSOperatorAuthorizationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.VsOperatorAuthorization.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListOperatorRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1344,12 +3697,50 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListOperatorRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListOperatorRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1371,7 +3762,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListOperatorRolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1394,15 +3785,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListOperatorRolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListOperatorRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorRoles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1429,7 +3820,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorRoles = append(m.OperatorRoles, OperatorRole{})
+			if err := m.OperatorRoles[len(m.OperatorRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1454,7 +3846,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListRoleOperatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1477,10 +3869,10 @@ func (m *QueryListOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListOperatorAuthorizationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListRoleOperatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListOperatorAuthorizationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListRoleOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1504,7 +3896,7 @@ func (m *QueryListOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1532,7 +3924,7 @@ func (m *QueryListOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -1574,7 +3966,91 @@ func (m *QueryListOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListRoleOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRoleOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRoleOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAuthorizations = append(m.OperatorAuthorizations, OperatorAuthorization{})
+			if err := m.OperatorAuthorizations[len(m.OperatorAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAuthorizationsByGranteeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1597,17 +4073,17 @@ func (m *QueryListOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListOperatorAuthorizationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListAuthorizationsByGranteeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListOperatorAuthorizationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListAuthorizationsByGranteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1617,26 +4093,43 @@ func (m *QueryListOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAuthorizations = append(m.OperatorAuthorizations, OperatorAuthorization{})
-			if err := m.OperatorAuthorizations[len(m.OperatorAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1658,7 +4151,7 @@ func (m *QueryListOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListVSOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
+func (m *GranteeAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1681,10 +4174,10 @@ func (m *QueryListVSOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GranteeAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GranteeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1708,9 +4201,9 @@ func (m *QueryListVSOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorization", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1720,29 +4213,33 @@ func (m *QueryListVSOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			if m.OperatorAuthorization == nil {
+				m.OperatorAuthorization = &OperatorAuthorization{}
+			}
+			if err := m.OperatorAuthorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthorization", wireType)
 			}
-			m.ResponseMaxSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1752,11 +4249,98 @@ func (m *QueryListVSOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VsOperatorAuthorization == nil {
+				m.VsOperatorAuthorization = &VSOperatorAuthorization{}
+			}
+			if err := m.VsOperatorAuthorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingSpend = append(m.RemainingSpend, types.Coin{})
+			if err := m.RemainingSpend[len(m.RemainingSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1778,7 +4362,7 @@ func (m *QueryListVSOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryListVSOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListAuthorizationsByGranteeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1801,15 +4385,15 @@ func (m *QueryListVSOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListAuthorizationsByGranteeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListVSOperatorAuthorizationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListAuthorizationsByGranteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1836,8 +4420,8 @@ func (m *QueryListVSOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthorizations = append(m.VsOperatorAuthorizations, VSOperatorAuthorization{})
-			if err := m.VsOperatorAuthorizations[len(m.VsOperatorAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authorizations = append(m.Authorizations, GranteeAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1862,7 +4446,7 @@ func (m *QueryListVSOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCorporationFreezeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1885,17 +4469,17 @@ func (m *QueryGetOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOperatorAuthorizationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCorporationFreezeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOperatorAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCorporationFreezeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 			}
-			m.Id = 0
+			m.CorporationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1905,7 +4489,7 @@ func (m *QueryGetOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CorporationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1931,7 +4515,7 @@ func (m *QueryGetOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCorporationFreezeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,15 +4538,35 @@ func (m *QueryGetOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOperatorAuthorizationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCorporationFreezeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCorporationFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1989,7 +4593,10 @@ func (m *QueryGetOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OperatorAuthorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Freeze == nil {
+				m.Freeze = &CorporationFreeze{}
+			}
+			if err := m.Freeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2014,7 +4621,7 @@ func (m *QueryGetOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVSOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingOperatorAuthorizationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2037,17 +4644,17 @@ func (m *QueryGetVSOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingOperatorAuthorizationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingOperatorAuthorizationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 			}
-			m.Id = 0
+			m.CorporationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2057,7 +4664,58 @@ func (m *QueryGetVSOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2083,7 +4741,7 @@ func (m *QueryGetVSOperatorAuthorizationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVSOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingOperatorAuthorizationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2106,15 +4764,15 @@ func (m *QueryGetVSOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingOperatorAuthorizationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVSOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingOperatorAuthorizationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAuthorizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2141,7 +4799,8 @@ func (m *QueryGetVSOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VsOperatorAuthorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorAuthorizations = append(m.OperatorAuthorizations, OperatorAuthorization{})
+			if err := m.OperatorAuthorizations[len(m.OperatorAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -176,6 +177,17 @@ type MsgGrantOperatorAuthorization struct {
 	// fee_spend_limit is the maximum total amount of fees this authorization
 	// allows the grantee to spend (stored on the OperatorAuthorization record).
 	FeeSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=fee_spend_limit,json=feeSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_spend_limit"`
+	// role is the optional name of an OperatorRole of the corporation. When set,
+	// msg_types MUST be empty and are taken from the role, and unset
+	// authz_spend_limit, authz_spend_limit_period and fee_spend_limit default to
+	// the role's.
+	Role string `protobuf:"bytes,12,opt,name=role,proto3" json:"role,omitempty"`
+	// require_acceptance keeps the authorization pending until the grantee
+	// accepts it with MsgAcceptOperatorAuthorization.
+	RequireAcceptance bool `protobuf:"varint,13,opt,name=require_acceptance,json=requireAcceptance,proto3" json:"require_acceptance,omitempty"`
+	// not_before is the optional time lock before which the authorization is not
+	// active. It MUST be in the future and before expiration.
+	NotBefore *time.Time `protobuf:"bytes,14,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
 }
 
 func (m *MsgGrantOperatorAuthorization) Reset()         { *m = MsgGrantOperatorAuthorization{} }
@@ -288,6 +300,27 @@ func (m *MsgGrantOperatorAuthorization) GetFeeSpendLimit() github_com_cosmos_cos
 	return nil
 }
 
+func (m *MsgGrantOperatorAuthorization) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *MsgGrantOperatorAuthorization) GetRequireAcceptance() bool {
+	if m != nil {
+		return m.RequireAcceptance
+	}
+	return false
+}
+
+func (m *MsgGrantOperatorAuthorization) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
 // MsgGrantOperatorAuthorizationResponse defines the response for
 // MsgGrantOperatorAuthorization.
 type MsgGrantOperatorAuthorizationResponse struct {
@@ -433,661 +466,3185 @@ func (m *MsgRevokeOperatorAuthorizationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeOperatorAuthorizationResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "verana.de.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.de.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgGrantOperatorAuthorization)(nil), "verana.de.v1.MsgGrantOperatorAuthorization")
-	proto.RegisterType((*MsgGrantOperatorAuthorizationResponse)(nil), "verana.de.v1.MsgGrantOperatorAuthorizationResponse")
-	proto.RegisterType((*MsgRevokeOperatorAuthorization)(nil), "verana.de.v1.MsgRevokeOperatorAuthorization")
-	proto.RegisterType((*MsgRevokeOperatorAuthorizationResponse)(nil), "verana.de.v1.MsgRevokeOperatorAuthorizationResponse")
+// MsgSetOperatorRole creates or updates a named operator role on behalf of a
+// corporation. Updating a role propagates its msg_types to every operator
+// authorization granted from it, and its default limits to those still
+// carrying the previous defaults. Same signer semantics as
+// MsgGrantOperatorAuthorization.
+type MsgSetOperatorRole struct {
+	// corporation is the group account owning the role.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the optional account authorized by the corporation to run this
+	// Msg on its behalf.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// name identifies the role within the corporation.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_types is the list of VPR delegable message types granted by the role.
+	MsgTypes []string `protobuf:"bytes,4,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// spend_limit is the optional default authz spend limit.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// fee_spend_limit is the optional default fee spend limit.
+	FeeSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_spend_limit,json=feeSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_spend_limit"`
+	// period is the optional default reset period of the limits.
+	Period *time.Duration `protobuf:"bytes,7,opt,name=period,proto3,stdduration" json:"period,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/de/v1/tx.proto", fileDescriptor_05df44ca220845bb) }
-
-var fileDescriptor_05df44ca220845bb = []byte{
-	// 794 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4d, 0x4f, 0xdb, 0x48,
-	0x18, 0x8e, 0xc9, 0x02, 0xc9, 0x04, 0x84, 0xb0, 0x82, 0x70, 0x1c, 0xe1, 0x44, 0x61, 0xd9, 0x8d,
-	0xd8, 0xc5, 0x5e, 0x02, 0xda, 0x8f, 0xdc, 0xc8, 0xa2, 0xf6, 0xd2, 0xa8, 0xc8, 0xd0, 0x4b, 0x55,
-	0x29, 0x72, 0xe2, 0xc9, 0x60, 0x81, 0x3d, 0x96, 0x67, 0x12, 0x3e, 0xa4, 0x56, 0xa5, 0xc7, 0x9e,
-	0x38, 0xf6, 0x27, 0x54, 0x3d, 0xa1, 0xaa, 0xea, 0x6f, 0xe0, 0x88, 0x7a, 0xea, 0x09, 0x2a, 0x90,
-	0xca, 0xdf, 0xa8, 0x66, 0x3c, 0x86, 0x7c, 0xd0, 0x50, 0x24, 0x2e, 0xbd, 0x24, 0x99, 0x79, 0xdf,
-	0x79, 0x9f, 0xf7, 0x79, 0xe6, 0x7d, 0x26, 0x60, 0xaa, 0x0d, 0x03, 0xcb, 0xb3, 0x0c, 0x1b, 0x1a,
-	0xed, 0x45, 0x83, 0xee, 0xea, 0x7e, 0x80, 0x29, 0x96, 0xc7, 0xc2, 0x6d, 0xdd, 0x86, 0x7a, 0x7b,
-	0x51, 0x9d, 0xb4, 0x5c, 0xc7, 0xc3, 0x06, 0xff, 0x0c, 0x13, 0x54, 0xad, 0x81, 0x89, 0x8b, 0x89,
-	0x51, 0xb7, 0x08, 0x3b, 0x59, 0x87, 0xd4, 0x5a, 0x34, 0x1a, 0xd8, 0xf1, 0x44, 0x7c, 0x5a, 0xc4,
-	0x5d, 0x82, 0x58, 0x61, 0x97, 0x20, 0x11, 0xc8, 0x84, 0x81, 0x1a, 0x5f, 0x19, 0xe1, 0x42, 0x84,
-	0xd2, 0x08, 0x23, 0x1c, 0xee, 0xb3, 0x5f, 0x11, 0x12, 0xc2, 0x18, 0x6d, 0x43, 0x83, 0xaf, 0xea,
-	0xad, 0xa6, 0x61, 0xb7, 0x02, 0x8b, 0x3a, 0x38, 0x42, 0xca, 0xf5, 0xc6, 0xa9, 0xe3, 0x42, 0x42,
-	0x2d, 0xd7, 0x8f, 0x10, 0xbb, 0x28, 0xfa, 0x56, 0x60, 0xb9, 0x02, 0xb1, 0xf0, 0x5e, 0x02, 0x13,
-	0x55, 0x82, 0x9e, 0xf8, 0xb6, 0x45, 0xe1, 0x1a, 0x8f, 0xc8, 0x7f, 0x83, 0xa4, 0xd5, 0xa2, 0x9b,
-	0x38, 0x70, 0xe8, 0x9e, 0x22, 0xe5, 0xa5, 0x62, 0xb2, 0xa2, 0x7c, 0xfa, 0xb0, 0x90, 0x16, 0xad,
-	0xae, 0xd8, 0x76, 0x00, 0x09, 0x59, 0xa7, 0x81, 0xe3, 0x21, 0xf3, 0x3a, 0x55, 0xfe, 0x07, 0x8c,
-	0x84, 0xb5, 0x95, 0xa1, 0xbc, 0x54, 0x4c, 0x95, 0xd2, 0x7a, 0xa7, 0x86, 0x7a, 0x58, 0xbd, 0x92,
-	0x3c, 0x3e, 0xcd, 0xc5, 0xde, 0x5e, 0x1e, 0xcd, 0x4b, 0xa6, 0x48, 0x2f, 0xeb, 0xaf, 0x2e, 0x8f,
-	0xe6, 0xaf, 0x0b, 0xbd, 0xbe, 0x3c, 0x9a, 0xcf, 0x8a, 0x96, 0x77, 0x59, 0xd3, 0x3d, 0x0d, 0x16,
-	0x32, 0x60, 0xba, 0x67, 0xcb, 0x84, 0xc4, 0xc7, 0x1e, 0x81, 0x85, 0x8f, 0xa3, 0x60, 0xa6, 0x4a,
-	0xd0, 0xc3, 0xc0, 0xf2, 0xe8, 0x63, 0x1f, 0x06, 0x16, 0xc5, 0xc1, 0x4a, 0x58, 0x78, 0x9f, 0x6b,
-	0x26, 0x97, 0x41, 0xaa, 0x81, 0x03, 0x1f, 0x87, 0x12, 0xde, 0xca, 0xaf, 0x33, 0x59, 0x5e, 0x06,
-	0x09, 0x2c, 0x8a, 0x72, 0x8e, 0x83, 0x0e, 0x5e, 0x65, 0xca, 0x25, 0x30, 0x8a, 0x58, 0x3f, 0x10,
-	0x2a, 0xf1, 0x5b, 0x0e, 0x45, 0x89, 0x72, 0x16, 0x24, 0x5d, 0x82, 0x6a, 0x74, 0xcf, 0x87, 0x44,
-	0xf9, 0x25, 0x1f, 0x2f, 0x26, 0xcd, 0x84, 0x4b, 0xd0, 0x06, 0x5b, 0xcb, 0xab, 0x00, 0xc0, 0x5d,
-	0xdf, 0x11, 0x0c, 0x86, 0xb9, 0xd8, 0xaa, 0x1e, 0x4e, 0x81, 0x1e, 0x4d, 0x81, 0xbe, 0x11, 0x4d,
-	0x41, 0x25, 0x71, 0x7c, 0x9a, 0x93, 0x0e, 0xcf, 0x72, 0x92, 0xd9, 0x71, 0x4e, 0xde, 0x01, 0x93,
-	0x4c, 0xf2, 0xfd, 0x1a, 0xf1, 0xa1, 0x67, 0xd7, 0xb6, 0x1d, 0xd7, 0xa1, 0xca, 0x48, 0x3e, 0x5e,
-	0x4c, 0x95, 0x32, 0xba, 0xe8, 0x8e, 0x0d, 0xb7, 0x2e, 0x86, 0x5b, 0xff, 0x1f, 0x3b, 0x5e, 0xe5,
-	0x2f, 0x76, 0x7d, 0xef, 0xce, 0x72, 0x45, 0xe4, 0xd0, 0xcd, 0x56, 0x5d, 0x6f, 0x60, 0x57, 0xcc,
-	0xb0, 0xf8, 0x5a, 0x20, 0xf6, 0x96, 0xc1, 0xfb, 0xe6, 0x07, 0x88, 0x39, 0xc1, 0x51, 0xd6, 0x19,
-	0xc8, 0x23, 0x86, 0x21, 0x3f, 0x03, 0x4a, 0x1f, 0x70, 0xcd, 0x87, 0x81, 0x83, 0x6d, 0x65, 0x94,
-	0x93, 0xc9, 0xf4, 0x91, 0x59, 0x15, 0x23, 0x1f, 0x72, 0x79, 0xc3, 0xb8, 0x4c, 0xf5, 0xd4, 0x5d,
-	0xe3, 0x15, 0xe4, 0x59, 0x30, 0xbe, 0xe3, 0xd0, 0xcd, 0x5a, 0x13, 0x42, 0x2e, 0xa6, 0x92, 0xc8,
-	0x4b, 0xc5, 0x84, 0x39, 0xc6, 0x36, 0x1f, 0x88, 0x3d, 0xf9, 0x39, 0x48, 0x47, 0xf1, 0x2e, 0xfa,
-	0xc9, 0xfb, 0xa7, 0x2f, 0x47, 0x40, 0x1d, 0x0a, 0xd4, 0x41, 0xf6, 0x26, 0xf8, 0x48, 0x04, 0xf0,
-	0xe3, 0x22, 0x28, 0xfd, 0xd5, 0x85, 0x0e, 0x04, 0x4c, 0x34, 0x21, 0xec, 0x62, 0x97, 0xba, 0x7f,
-	0x76, 0xe3, 0x4d, 0x08, 0xaf, 0xa1, 0xcb, 0xff, 0x32, 0x27, 0x77, 0x5a, 0x86, 0x79, 0x79, 0xb6,
-	0xc7, 0xcb, 0xc2, 0x9c, 0x5d, 0xb6, 0x2c, 0xfc, 0x0e, 0xe6, 0x06, 0xfa, 0xf6, 0xca, 0xe1, 0x07,
-	0x43, 0x40, 0xab, 0x12, 0x64, 0xc2, 0x36, 0xde, 0x82, 0x3f, 0xb1, 0xc5, 0xcb, 0xff, 0xdd, 0xa4,
-	0xd5, 0xaf, 0x3d, 0x5a, 0x45, 0x34, 0xbb, 0xc5, 0x2a, 0x82, 0xdf, 0x06, 0x4b, 0x10, 0xa9, 0x55,
-	0xfa, 0x3a, 0x04, 0xe2, 0x55, 0x82, 0xe4, 0x0d, 0x30, 0xd6, 0xf5, 0xc6, 0xcf, 0x74, 0xbf, 0xcd,
-	0x3d, 0xcf, 0xa9, 0x3a, 0x37, 0x30, 0x1c, 0x55, 0x97, 0x5f, 0x00, 0x75, 0xc0, 0x4b, 0xfb, 0x47,
-	0x5f, 0x91, 0xef, 0x27, 0xab, 0x4b, 0x77, 0x48, 0xbe, 0xc2, 0x3f, 0x90, 0x40, 0x76, 0xd0, 0x20,
-	0xfc, 0xd9, 0x57, 0x74, 0x40, 0xb6, 0xba, 0x7c, 0x97, 0xec, 0xa8, 0x07, 0x75, 0xf8, 0x25, 0xfb,
-	0x2f, 0xab, 0x54, 0x8e, 0xcf, 0x35, 0xe9, 0xe4, 0x5c, 0x93, 0xbe, 0x9c, 0x6b, 0xd2, 0xe1, 0x85,
-	0x16, 0x3b, 0xb9, 0xd0, 0x62, 0x9f, 0x2f, 0xb4, 0xd8, 0xd3, 0x4e, 0x33, 0x85, 0x00, 0x0b, 0xdb,
-	0x56, 0x9d, 0x18, 0x9d, 0x37, 0xcd, 0x2d, 0x55, 0x1f, 0xe1, 0x4e, 0x5f, 0xfa, 0x16, 0x00, 0x00,
-	0xff, 0xff, 0xab, 0x9b, 0x56, 0x9b, 0x93, 0x08, 0x00, 0x00,
+func (m *MsgSetOperatorRole) Reset()         { *m = MsgSetOperatorRole{} }
+func (m *MsgSetOperatorRole) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorRole) ProtoMessage()    {}
+func (*MsgSetOperatorRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{6}
+}
+func (m *MsgSetOperatorRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOperatorRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorRole.Merge(m, src)
+}
+func (m *MsgSetOperatorRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorRole.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetOperatorRole proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// [MOD-DE-MSG-3] Grant Operator Authorization
-	GrantOperatorAuthorization(ctx context.Context, in *MsgGrantOperatorAuthorization, opts ...grpc.CallOption) (*MsgGrantOperatorAuthorizationResponse, error)
-	// [MOD-DE-MSG-4] Revoke Operator Authorization
-	RevokeOperatorAuthorization(ctx context.Context, in *MsgRevokeOperatorAuthorization, opts ...grpc.CallOption) (*MsgRevokeOperatorAuthorizationResponse, error)
+func (m *MsgSetOperatorRole) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetOperatorRole) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetOperatorRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetOperatorRole) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) GrantOperatorAuthorization(ctx context.Context, in *MsgGrantOperatorAuthorization, opts ...grpc.CallOption) (*MsgGrantOperatorAuthorizationResponse, error) {
-	out := new(MsgGrantOperatorAuthorizationResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/GrantOperatorAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetOperatorRole) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RevokeOperatorAuthorization(ctx context.Context, in *MsgRevokeOperatorAuthorization, opts ...grpc.CallOption) (*MsgRevokeOperatorAuthorizationResponse, error) {
-	out := new(MsgRevokeOperatorAuthorizationResponse)
-	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/RevokeOperatorAuthorization", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetOperatorRole) GetFeeSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeSpendLimit
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// [MOD-DE-MSG-3] Grant Operator Authorization
-	GrantOperatorAuthorization(context.Context, *MsgGrantOperatorAuthorization) (*MsgGrantOperatorAuthorizationResponse, error)
-	// [MOD-DE-MSG-4] Revoke Operator Authorization
-	RevokeOperatorAuthorization(context.Context, *MsgRevokeOperatorAuthorization) (*MsgRevokeOperatorAuthorizationResponse, error)
+func (m *MsgSetOperatorRole) GetPeriod() *time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSetOperatorRoleResponse defines the response for MsgSetOperatorRole.
+type MsgSetOperatorRoleResponse struct {
+	// updated_authorizations is the number of operator authorizations the role
+	// update was propagated to.
+	UpdatedAuthorizations uint64 `protobuf:"varint,1,opt,name=updated_authorizations,json=updatedAuthorizations,proto3" json:"updated_authorizations,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetOperatorRoleResponse) Reset()         { *m = MsgSetOperatorRoleResponse{} }
+func (m *MsgSetOperatorRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorRoleResponse) ProtoMessage()    {}
+func (*MsgSetOperatorRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{7}
 }
-func (*UnimplementedMsgServer) GrantOperatorAuthorization(ctx context.Context, req *MsgGrantOperatorAuthorization) (*MsgGrantOperatorAuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantOperatorAuthorization not implemented")
+func (m *MsgSetOperatorRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RevokeOperatorAuthorization(ctx context.Context, req *MsgRevokeOperatorAuthorization) (*MsgRevokeOperatorAuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperatorAuthorization not implemented")
+func (m *MsgSetOperatorRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetOperatorRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorRoleResponse.Merge(m, src)
+}
+func (m *MsgSetOperatorRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorRoleResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgSetOperatorRoleResponse proto.InternalMessageInfo
+
+func (m *MsgSetOperatorRoleResponse) GetUpdatedAuthorizations() uint64 {
+	if m != nil {
+		return m.UpdatedAuthorizations
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_GrantOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantOperatorAuthorization)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantOperatorAuthorization(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Msg/GrantOperatorAuthorization",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantOperatorAuthorization(ctx, req.(*MsgGrantOperatorAuthorization))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgDeleteOperatorRole deletes an operator role of a corporation. The role
+// MUST NOT be referenced by any operator authorization. Same signer semantics
+// as MsgGrantOperatorAuthorization.
+type MsgDeleteOperatorRole struct {
+	// corporation is the group account owning the role.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the optional account authorized by the corporation to run this
+	// Msg on its behalf.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// name identifies the role within the corporation.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func _Msg_RevokeOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeOperatorAuthorization)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeOperatorAuthorization(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.de.v1.Msg/RevokeOperatorAuthorization",
+func (m *MsgDeleteOperatorRole) Reset()         { *m = MsgDeleteOperatorRole{} }
+func (m *MsgDeleteOperatorRole) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOperatorRole) ProtoMessage()    {}
+func (*MsgDeleteOperatorRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{8}
+}
+func (m *MsgDeleteOperatorRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteOperatorRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOperatorRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeOperatorAuthorization(ctx, req.(*MsgRevokeOperatorAuthorization))
+}
+func (m *MsgDeleteOperatorRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOperatorRole.Merge(m, src)
+}
+func (m *MsgDeleteOperatorRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteOperatorRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOperatorRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteOperatorRole proto.InternalMessageInfo
+
+func (m *MsgDeleteOperatorRole) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.de.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "GrantOperatorAuthorization",
-			Handler:    _Msg_GrantOperatorAuthorization_Handler,
-		},
-		{
-			MethodName: "RevokeOperatorAuthorization",
-			Handler:    _Msg_RevokeOperatorAuthorization_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/de/v1/tx.proto",
+func (m *MsgDeleteOperatorRole) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteOperatorRole) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDeleteOperatorRoleResponse defines the response for MsgDeleteOperatorRole.
+type MsgDeleteOperatorRoleResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDeleteOperatorRoleResponse) Reset()         { *m = MsgDeleteOperatorRoleResponse{} }
+func (m *MsgDeleteOperatorRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOperatorRoleResponse) ProtoMessage()    {}
+func (*MsgDeleteOperatorRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{9}
+}
+func (m *MsgDeleteOperatorRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteOperatorRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOperatorRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDeleteOperatorRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOperatorRoleResponse.Merge(m, src)
+}
+func (m *MsgDeleteOperatorRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteOperatorRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOperatorRoleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteOperatorRoleResponse proto.InternalMessageInfo
+
+// MsgFreezeCorporationOperators makes every operator and VS operator
+// authorization check of a corporation fail until it is unfrozen. It is
+// executed by the corporation through a group proposal (operator empty), or by
+// an operator authorized for this message type.
+type MsgFreezeCorporationOperators struct {
+	// corporation is the group account whose operators are frozen.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the optional account authorized by the corporation to run this
+	// Msg on its behalf.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// reason is the optional reason of the freeze.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgFreezeCorporationOperators) Reset()         { *m = MsgFreezeCorporationOperators{} }
+func (m *MsgFreezeCorporationOperators) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeCorporationOperators) ProtoMessage()    {}
+func (*MsgFreezeCorporationOperators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{10}
+}
+func (m *MsgFreezeCorporationOperators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeCorporationOperators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeCorporationOperators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFreezeCorporationOperators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeCorporationOperators.Merge(m, src)
+}
+func (m *MsgFreezeCorporationOperators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeCorporationOperators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeCorporationOperators.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFreezeCorporationOperators proto.InternalMessageInfo
+
+func (m *MsgFreezeCorporationOperators) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgFreezeCorporationOperators) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *MsgGrantOperatorAuthorization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeCorporationOperators) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgGrantOperatorAuthorization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFreezeCorporationOperatorsResponse defines the response for
+// MsgFreezeCorporationOperators.
+type MsgFreezeCorporationOperatorsResponse struct {
 }
 
-func (m *MsgGrantOperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeSpendLimit) > 0 {
-		for iNdEx := len(m.FeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.FeegrantSpendLimitPeriod != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.FeegrantSpendLimitPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.FeegrantSpendLimitPeriod):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintTx(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.FeegrantSpendLimit) > 0 {
-		for iNdEx := len(m.FeegrantSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeegrantSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.WithFeegrant {
-		i--
-		if m.WithFeegrant {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.AuthzSpendLimitPeriod != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.AuthzSpendLimitPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.AuthzSpendLimitPeriod):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintTx(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AuthzSpendLimit) > 0 {
-		for iNdEx := len(m.AuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.Expiration != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err4 != nil {
-			return 0, err4
+func (m *MsgFreezeCorporationOperatorsResponse) Reset()         { *m = MsgFreezeCorporationOperatorsResponse{} }
+func (m *MsgFreezeCorporationOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeCorporationOperatorsResponse) ProtoMessage()    {}
+func (*MsgFreezeCorporationOperatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{11}
+}
+func (m *MsgFreezeCorporationOperatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeCorporationOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeCorporationOperatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n4
-		i = encodeVarintTx(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.MsgTypes) > 0 {
-		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MsgTypes[iNdEx])
-			copy(dAtA[i:], m.MsgTypes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+}
+func (m *MsgFreezeCorporationOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeCorporationOperatorsResponse.Merge(m, src)
+}
+func (m *MsgFreezeCorporationOperatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeCorporationOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeCorporationOperatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeCorporationOperatorsResponse proto.InternalMessageInfo
+
+// MsgUnfreezeCorporationOperators lifts the freeze of a corporation. It can
+// only be executed by the corporation itself through a group proposal.
+type MsgUnfreezeCorporationOperators struct {
+	// corporation is the group account whose operators are unfrozen.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+}
+
+func (m *MsgUnfreezeCorporationOperators) Reset()         { *m = MsgUnfreezeCorporationOperators{} }
+func (m *MsgUnfreezeCorporationOperators) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeCorporationOperators) ProtoMessage()    {}
+func (*MsgUnfreezeCorporationOperators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{12}
+}
+func (m *MsgUnfreezeCorporationOperators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeCorporationOperators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeCorporationOperators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnfreezeCorporationOperators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeCorporationOperators.Merge(m, src)
+}
+func (m *MsgUnfreezeCorporationOperators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeCorporationOperators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeCorporationOperators.DiscardUnknown(m)
 }
 
-func (m *MsgGrantOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnfreezeCorporationOperators proto.InternalMessageInfo
+
+func (m *MsgUnfreezeCorporationOperators) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgGrantOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnfreezeCorporationOperatorsResponse defines the response for
+// MsgUnfreezeCorporationOperators.
+type MsgUnfreezeCorporationOperatorsResponse struct {
 }
 
-func (m *MsgGrantOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUnfreezeCorporationOperatorsResponse) Reset() {
+	*m = MsgUnfreezeCorporationOperatorsResponse{}
 }
-
-func (m *MsgRevokeOperatorAuthorization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnfreezeCorporationOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeCorporationOperatorsResponse) ProtoMessage()    {}
+func (*MsgUnfreezeCorporationOperatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{13}
+}
+func (m *MsgUnfreezeCorporationOperatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeCorporationOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeCorporationOperatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnfreezeCorporationOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeCorporationOperatorsResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeCorporationOperatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeCorporationOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeCorporationOperatorsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeOperatorAuthorization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnfreezeCorporationOperatorsResponse proto.InternalMessageInfo
+
+// MsgExecAsCorporation executes several Verana messages of one corporation run
+// by one operator, atomically: either every message succeeds or none is
+// applied. The operator's authorization is checked for each inner message type
+// before any is executed, and every inner message MUST carry the same
+// corporation and operator.
+type MsgExecAsCorporation struct {
+	// corporation is the group account the messages are executed for.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run the messages.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// msgs are the VPR delegable messages to execute, in order.
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func (m *MsgRevokeOperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgExecAsCorporation) Reset()         { *m = MsgExecAsCorporation{} }
+func (m *MsgExecAsCorporation) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAsCorporation) ProtoMessage()    {}
+func (*MsgExecAsCorporation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{14}
+}
+func (m *MsgExecAsCorporation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAsCorporation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAsCorporation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgExecAsCorporation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAsCorporation.Merge(m, src)
+}
+func (m *MsgExecAsCorporation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAsCorporation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAsCorporation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecAsCorporation proto.InternalMessageInfo
+
+func (m *MsgExecAsCorporation) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRevokeOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExecAsCorporation) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRevokeOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExecAsCorporation) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
 }
 
-func (m *MsgRevokeOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgExecAsCorporationResponse defines the response for MsgExecAsCorporation.
+type MsgExecAsCorporationResponse struct {
+	// results are the response data of the inner messages, in order.
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgExecAsCorporationResponse) Reset()         { *m = MsgExecAsCorporationResponse{} }
+func (m *MsgExecAsCorporationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAsCorporationResponse) ProtoMessage()    {}
+func (*MsgExecAsCorporationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{15}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgExecAsCorporationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAsCorporationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAsCorporationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgExecAsCorporationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAsCorporationResponse.Merge(m, src)
+}
+func (m *MsgExecAsCorporationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAsCorporationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAsCorporationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgExecAsCorporationResponse proto.InternalMessageInfo
+
+func (m *MsgExecAsCorporationResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgGrantOperatorAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.MsgTypes) > 0 {
-		for _, s := range m.MsgTypes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Expiration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.AuthzSpendLimit) > 0 {
-		for _, e := range m.AuthzSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+// MsgAcceptOperatorAuthorization is signed by the grantee of an operator
+// authorization granted with require_acceptance to consent to it. The
+// authorization becomes active once accepted and past its not_before.
+type MsgAcceptOperatorAuthorization struct {
+	// grantee is the operator account accepting the authorization.
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// corporation_id is the id of the corporation that granted the
+	// authorization.
+	CorporationId uint64 `protobuf:"varint,2,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+}
+
+func (m *MsgAcceptOperatorAuthorization) Reset()         { *m = MsgAcceptOperatorAuthorization{} }
+func (m *MsgAcceptOperatorAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOperatorAuthorization) ProtoMessage()    {}
+func (*MsgAcceptOperatorAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{16}
+}
+func (m *MsgAcceptOperatorAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOperatorAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOperatorAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.AuthzSpendLimitPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.AuthzSpendLimitPeriod)
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgAcceptOperatorAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOperatorAuthorization.Merge(m, src)
+}
+func (m *MsgAcceptOperatorAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOperatorAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOperatorAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOperatorAuthorization proto.InternalMessageInfo
+
+func (m *MsgAcceptOperatorAuthorization) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	if m.WithFeegrant {
-		n += 2
+	return ""
+}
+
+func (m *MsgAcceptOperatorAuthorization) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
 	}
-	if len(m.FeegrantSpendLimit) > 0 {
-		for _, e := range m.FeegrantSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgAcceptOperatorAuthorizationResponse defines the response for
+// MsgAcceptOperatorAuthorization.
+type MsgAcceptOperatorAuthorizationResponse struct {
+}
+
+func (m *MsgAcceptOperatorAuthorizationResponse) Reset() {
+	*m = MsgAcceptOperatorAuthorizationResponse{}
+}
+func (m *MsgAcceptOperatorAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOperatorAuthorizationResponse) ProtoMessage()    {}
+func (*MsgAcceptOperatorAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05df44ca220845bb, []int{17}
+}
+func (m *MsgAcceptOperatorAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOperatorAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOperatorAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FeegrantSpendLimitPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.FeegrantSpendLimitPeriod)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.FeeSpendLimit) > 0 {
-		for _, e := range m.FeeSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgAcceptOperatorAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOperatorAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgAcceptOperatorAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOperatorAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOperatorAuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOperatorAuthorizationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "verana.de.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.de.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgGrantOperatorAuthorization)(nil), "verana.de.v1.MsgGrantOperatorAuthorization")
+	proto.RegisterType((*MsgGrantOperatorAuthorizationResponse)(nil), "verana.de.v1.MsgGrantOperatorAuthorizationResponse")
+	proto.RegisterType((*MsgRevokeOperatorAuthorization)(nil), "verana.de.v1.MsgRevokeOperatorAuthorization")
+	proto.RegisterType((*MsgRevokeOperatorAuthorizationResponse)(nil), "verana.de.v1.MsgRevokeOperatorAuthorizationResponse")
+	proto.RegisterType((*MsgSetOperatorRole)(nil), "verana.de.v1.MsgSetOperatorRole")
+	proto.RegisterType((*MsgSetOperatorRoleResponse)(nil), "verana.de.v1.MsgSetOperatorRoleResponse")
+	proto.RegisterType((*MsgDeleteOperatorRole)(nil), "verana.de.v1.MsgDeleteOperatorRole")
+	proto.RegisterType((*MsgDeleteOperatorRoleResponse)(nil), "verana.de.v1.MsgDeleteOperatorRoleResponse")
+	proto.RegisterType((*MsgFreezeCorporationOperators)(nil), "verana.de.v1.MsgFreezeCorporationOperators")
+	proto.RegisterType((*MsgFreezeCorporationOperatorsResponse)(nil), "verana.de.v1.MsgFreezeCorporationOperatorsResponse")
+	proto.RegisterType((*MsgUnfreezeCorporationOperators)(nil), "verana.de.v1.MsgUnfreezeCorporationOperators")
+	proto.RegisterType((*MsgUnfreezeCorporationOperatorsResponse)(nil), "verana.de.v1.MsgUnfreezeCorporationOperatorsResponse")
+	proto.RegisterType((*MsgExecAsCorporation)(nil), "verana.de.v1.MsgExecAsCorporation")
+	proto.RegisterType((*MsgExecAsCorporationResponse)(nil), "verana.de.v1.MsgExecAsCorporationResponse")
+	proto.RegisterType((*MsgAcceptOperatorAuthorization)(nil), "verana.de.v1.MsgAcceptOperatorAuthorization")
+	proto.RegisterType((*MsgAcceptOperatorAuthorizationResponse)(nil), "verana.de.v1.MsgAcceptOperatorAuthorizationResponse")
+}
+
+func init() { proto.RegisterFile("verana/de/v1/tx.proto", fileDescriptor_05df44ca220845bb) }
+
+var fileDescriptor_05df44ca220845bb = []byte{
+	// 1295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xbd, 0x6f, 0xdb, 0xc6,
+	0x1b, 0x36, 0x63, 0xc5, 0x91, 0x5e, 0xdb, 0x31, 0x4c, 0xc8, 0x09, 0x4d, 0x27, 0x92, 0x7e, 0xca,
+	0x2f, 0x8d, 0xea, 0x54, 0x54, 0xe3, 0x7c, 0x56, 0x9d, 0xac, 0x7c, 0x14, 0x05, 0x2a, 0x34, 0xa0,
+	0xd3, 0xa5, 0x68, 0x21, 0x50, 0xe2, 0x89, 0x21, 0x22, 0xf2, 0x58, 0x1e, 0xe5, 0xd8, 0x01, 0x5a,
+	0x34, 0x6d, 0xa7, 0xa2, 0x43, 0xc6, 0x2c, 0xdd, 0x8b, 0x4e, 0x46, 0x91, 0xb9, 0x73, 0xd0, 0x29,
+	0x28, 0x50, 0xa0, 0x53, 0x52, 0x38, 0x83, 0xff, 0x8d, 0xe2, 0x8e, 0x47, 0x8a, 0x22, 0x19, 0xca,
+	0x06, 0x9c, 0xa6, 0x5d, 0x6c, 0xf1, 0xde, 0xe7, 0xde, 0x7b, 0x9f, 0x87, 0xef, 0x3d, 0x77, 0x84,
+	0xa5, 0x4d, 0xe4, 0x6a, 0xb6, 0xd6, 0xd0, 0x51, 0x63, 0xf3, 0x42, 0xc3, 0xdb, 0x52, 0x1c, 0x17,
+	0x7b, 0x58, 0x9c, 0xf3, 0x87, 0x15, 0x1d, 0x29, 0x9b, 0x17, 0xe4, 0x45, 0xcd, 0x32, 0x6d, 0xdc,
+	0x60, 0x7f, 0x7d, 0x80, 0x5c, 0xea, 0x61, 0x62, 0x61, 0xd2, 0xe8, 0x6a, 0x84, 0xce, 0xec, 0x22,
+	0x4f, 0xbb, 0xd0, 0xe8, 0x61, 0xd3, 0xe6, 0xf1, 0x93, 0x3c, 0x6e, 0x11, 0x83, 0x26, 0xb6, 0x88,
+	0xc1, 0x03, 0xcb, 0x7e, 0xa0, 0xc3, 0x9e, 0x1a, 0xfe, 0x03, 0x0f, 0x15, 0x0d, 0x6c, 0x60, 0x7f,
+	0x9c, 0xfe, 0x0a, 0x26, 0x18, 0x18, 0x1b, 0x03, 0xd4, 0x60, 0x4f, 0xdd, 0x61, 0xbf, 0xa1, 0xd9,
+	0xdb, 0x41, 0x11, 0xf1, 0x90, 0x3e, 0x74, 0x35, 0xcf, 0xc4, 0x41, 0x11, 0xe5, 0x78, 0xdc, 0x33,
+	0x2d, 0x44, 0x3c, 0xcd, 0x72, 0x82, 0xdc, 0x63, 0xec, 0x1d, 0xcd, 0xd5, 0x2c, 0x5e, 0x4c, 0xf5,
+	0x17, 0x01, 0x16, 0xda, 0xc4, 0xf8, 0xc4, 0xd1, 0x35, 0x0f, 0xdd, 0x66, 0x11, 0xf1, 0x0a, 0x14,
+	0xb4, 0xa1, 0x77, 0x17, 0xbb, 0xa6, 0xb7, 0x2d, 0x09, 0x15, 0xa1, 0x56, 0x68, 0x49, 0xbf, 0x3f,
+	0xa9, 0x17, 0x39, 0x8b, 0x75, 0x5d, 0x77, 0x11, 0x21, 0x1b, 0x9e, 0x6b, 0xda, 0x86, 0x3a, 0x82,
+	0x8a, 0x57, 0x61, 0xc6, 0xcf, 0x2d, 0x1d, 0xa9, 0x08, 0xb5, 0xd9, 0xb5, 0xa2, 0x12, 0x95, 0x57,
+	0xf1, 0xb3, 0xb7, 0x0a, 0x4f, 0x9f, 0x97, 0xa7, 0x7e, 0xda, 0xdb, 0x59, 0x15, 0x54, 0x0e, 0x6f,
+	0x2a, 0xdf, 0xec, 0xed, 0xac, 0x8e, 0x12, 0x7d, 0xbf, 0xb7, 0xb3, 0xba, 0xc2, 0x4b, 0xde, 0xa2,
+	0x45, 0xc7, 0x0a, 0xac, 0x2e, 0xc3, 0xc9, 0xd8, 0x90, 0x8a, 0x88, 0x83, 0x6d, 0x82, 0xaa, 0xbf,
+	0xe6, 0xe1, 0x74, 0x9b, 0x18, 0x1f, 0xb8, 0x9a, 0xed, 0x7d, 0xec, 0x20, 0x57, 0xf3, 0xb0, 0xbb,
+	0xee, 0x27, 0x7e, 0xc0, 0x34, 0x13, 0x9b, 0x30, 0xdb, 0xc3, 0xae, 0x83, 0x7d, 0x09, 0x27, 0xf2,
+	0x8b, 0x82, 0xc5, 0x4b, 0x90, 0xc7, 0x3c, 0x29, 0xe3, 0x98, 0x35, 0x31, 0x44, 0x8a, 0x6b, 0x70,
+	0xcc, 0xa0, 0xf5, 0x20, 0x24, 0x4d, 0x4f, 0x98, 0x14, 0x00, 0xc5, 0x15, 0x28, 0x58, 0xc4, 0xe8,
+	0x78, 0xdb, 0x0e, 0x22, 0x52, 0xae, 0x32, 0x5d, 0x2b, 0xa8, 0x79, 0x8b, 0x18, 0x77, 0xe8, 0xb3,
+	0x78, 0x03, 0x00, 0x6d, 0x39, 0x26, 0x67, 0x70, 0x94, 0x89, 0x2d, 0x2b, 0x7e, 0x17, 0x28, 0x41,
+	0x17, 0x28, 0x77, 0x82, 0x2e, 0x68, 0xe5, 0x9f, 0x3e, 0x2f, 0x0b, 0x8f, 0x5e, 0x94, 0x05, 0x35,
+	0x32, 0x4f, 0xbc, 0x0f, 0x8b, 0x54, 0xf2, 0x07, 0x1d, 0xe2, 0x20, 0x5b, 0xef, 0x0c, 0x4c, 0xcb,
+	0xf4, 0xa4, 0x99, 0xca, 0x74, 0x6d, 0x76, 0x6d, 0x59, 0xe1, 0xd5, 0xd1, 0xbe, 0x57, 0x78, 0xdf,
+	0x2b, 0xd7, 0xb1, 0x69, 0xb7, 0xde, 0xa5, 0xaf, 0xef, 0xe7, 0x17, 0xe5, 0x9a, 0x61, 0x7a, 0x77,
+	0x87, 0x5d, 0xa5, 0x87, 0x2d, 0xde, 0xde, 0xfc, 0x5f, 0x9d, 0xe8, 0xf7, 0x1a, 0xac, 0x6e, 0x36,
+	0x81, 0xa8, 0x0b, 0x6c, 0x95, 0x0d, 0xba, 0xc8, 0x47, 0x74, 0x0d, 0xf1, 0x33, 0x90, 0x12, 0x0b,
+	0x77, 0x1c, 0xe4, 0x9a, 0x58, 0x97, 0x8e, 0x31, 0x32, 0xcb, 0x09, 0x32, 0x37, 0x78, 0xcb, 0xfb,
+	0x5c, 0x1e, 0x53, 0x2e, 0x4b, 0xb1, 0xbc, 0xb7, 0x59, 0x06, 0xf1, 0x0c, 0xcc, 0xdf, 0x37, 0xbd,
+	0xbb, 0x9d, 0x3e, 0x42, 0x4c, 0x4c, 0x29, 0x5f, 0x11, 0x6a, 0x79, 0x75, 0x8e, 0x0e, 0xde, 0xe2,
+	0x63, 0xe2, 0x97, 0x50, 0x0c, 0xe2, 0x63, 0xf4, 0x0b, 0x87, 0x4f, 0x5f, 0x0c, 0x16, 0x8a, 0x28,
+	0xd0, 0x85, 0x95, 0xb4, 0xe5, 0x03, 0x11, 0x60, 0xff, 0x22, 0x48, 0xc9, 0xec, 0x5c, 0x07, 0x02,
+	0x0b, 0x7d, 0x84, 0xc6, 0xd8, 0xcd, 0x1e, 0x3e, 0xbb, 0xf9, 0x3e, 0x42, 0x11, 0x62, 0x22, 0xe4,
+	0x5c, 0x3c, 0x40, 0xd2, 0x1c, 0xed, 0x73, 0x95, 0xfd, 0x16, 0xeb, 0x20, 0xba, 0xe8, 0x8b, 0xa1,
+	0xe9, 0xa2, 0x8e, 0xd6, 0xeb, 0x21, 0xc7, 0xd3, 0xec, 0x1e, 0x92, 0xe6, 0xd9, 0x5b, 0x59, 0xe4,
+	0x91, 0xf5, 0x30, 0x20, 0x5e, 0x07, 0xb0, 0xb1, 0xd7, 0xe9, 0xa2, 0x3e, 0x76, 0x91, 0x74, 0xfc,
+	0x00, 0xcd, 0x5d, 0xb0, 0xb1, 0xd7, 0x62, 0xd3, 0x9a, 0xd7, 0xa8, 0xa3, 0x44, 0xb7, 0x2e, 0xf5,
+	0x94, 0x33, 0x31, 0x4f, 0xe1, 0x26, 0x31, 0x66, 0x0f, 0xd5, 0x73, 0x70, 0x36, 0xd3, 0x3f, 0x42,
+	0xa7, 0x79, 0x78, 0x04, 0x4a, 0x6d, 0x62, 0xa8, 0x68, 0x13, 0xdf, 0x43, 0xff, 0x61, 0xab, 0x69,
+	0xbe, 0x97, 0xa6, 0xd5, 0xff, 0x63, 0x5a, 0x05, 0x34, 0xc7, 0xc5, 0xaa, 0xc1, 0x5b, 0xd9, 0x12,
+	0x84, 0x6a, 0xfd, 0x90, 0x03, 0xb1, 0x4d, 0x8c, 0x0d, 0x14, 0xaa, 0xaa, 0xd2, 0xde, 0xf8, 0xe7,
+	0x15, 0x12, 0x21, 0x67, 0x6b, 0x16, 0x97, 0x47, 0x65, 0xbf, 0xb3, 0xcd, 0x76, 0x00, 0xb3, 0xd1,
+	0x3d, 0x74, 0xf4, 0xf0, 0xf7, 0x10, 0x90, 0xd1, 0x06, 0x4a, 0xd9, 0xb5, 0x33, 0xaf, 0x7d, 0xd7,
+	0xbe, 0x0f, 0x33, 0x07, 0xb7, 0x5f, 0x3e, 0xa5, 0x79, 0x31, 0xad, 0x7d, 0x4a, 0xb1, 0xf6, 0x89,
+	0xbd, 0xf7, 0xea, 0x06, 0xc8, 0xc9, 0xd1, 0xa0, 0x59, 0xc4, 0xcb, 0x70, 0x62, 0xc8, 0x0e, 0x77,
+	0xbd, 0xa3, 0x45, 0xbb, 0x89, 0xb0, 0x06, 0xc9, 0xa9, 0x4b, 0x3c, 0x3a, 0xd6, 0x6a, 0xa4, 0xfa,
+	0x87, 0x00, 0x4b, 0x6d, 0x62, 0xdc, 0x40, 0x03, 0xe4, 0xa1, 0x7f, 0x5f, 0x9b, 0x35, 0xaf, 0xa4,
+	0x29, 0xf5, 0xbf, 0x98, 0x52, 0xc9, 0xea, 0xab, 0x65, 0x76, 0xa5, 0x49, 0x06, 0xc2, 0xcd, 0xb5,
+	0x2b, 0x30, 0xc4, 0x2d, 0x17, 0xa1, 0x07, 0xe8, 0xfa, 0x28, 0x7f, 0x00, 0x26, 0x6f, 0x40, 0x80,
+	0x13, 0x30, 0xe3, 0x22, 0x8d, 0x60, 0x9b, 0x4b, 0xc0, 0x9f, 0x9a, 0x57, 0xd3, 0x44, 0xa8, 0xc6,
+	0x44, 0x18, 0x31, 0x09, 0x29, 0x70, 0x63, 0x7e, 0x35, 0xc7, 0x50, 0x8d, 0xc7, 0x02, 0x94, 0xe9,
+	0xf5, 0xd0, 0xee, 0xbf, 0x16, 0x3d, 0xf6, 0xe7, 0x97, 0xd1, 0xd5, 0x47, 0x1c, 0xde, 0x86, 0x73,
+	0x13, 0x2a, 0x0b, 0x59, 0x7c, 0x7b, 0x04, 0x8a, 0x6d, 0x62, 0xdc, 0xdc, 0x42, 0xbd, 0x75, 0x12,
+	0x41, 0xbe, 0x81, 0x57, 0x79, 0x13, 0x72, 0x16, 0x31, 0x88, 0x34, 0xcd, 0x8c, 0xa8, 0x98, 0x30,
+	0x87, 0x75, 0x7b, 0xbb, 0xb5, 0xf2, 0xdb, 0x93, 0xfa, 0xc9, 0x34, 0x87, 0xa2, 0xe7, 0x03, 0x9b,
+	0xde, 0x5c, 0xa3, 0xba, 0x85, 0x59, 0xa9, 0x68, 0x95, 0x98, 0x68, 0x09, 0xb2, 0xd5, 0x6b, 0x70,
+	0x2a, 0x6d, 0x3c, 0x74, 0x0a, 0x09, 0x8e, 0xb9, 0x88, 0x0c, 0x07, 0x1e, 0xb5, 0x86, 0xe9, 0xda,
+	0x9c, 0x1a, 0x3c, 0xd2, 0x0f, 0x1b, 0x7a, 0x3c, 0xfb, 0x17, 0x8b, 0xf4, 0xe3, 0x39, 0x72, 0x58,
+	0x0a, 0xfb, 0xbd, 0x97, 0x9f, 0x85, 0xe3, 0x11, 0x41, 0x3b, 0xa6, 0xce, 0x74, 0xcc, 0xa9, 0xf3,
+	0x91, 0xd1, 0x0f, 0xf5, 0xe6, 0x65, 0xca, 0x35, 0x98, 0x94, 0xd6, 0x1f, 0x41, 0x5d, 0x69, 0xe7,
+	0x69, 0x46, 0xcd, 0x01, 0xf1, 0xb5, 0x1f, 0xf3, 0x30, 0xdd, 0x26, 0x86, 0x78, 0x07, 0xe6, 0xc6,
+	0xbe, 0xdd, 0x4e, 0x8f, 0x7f, 0x73, 0xc5, 0x3e, 0x93, 0xe4, 0xb3, 0x99, 0xe1, 0x50, 0xd6, 0xaf,
+	0x40, 0xce, 0xf8, 0x82, 0x3a, 0x9f, 0x48, 0xf2, 0x6a, 0xb0, 0x7c, 0xf1, 0x00, 0xe0, 0x70, 0xfd,
+	0x87, 0x02, 0xac, 0x64, 0x5d, 0xac, 0xde, 0x49, 0x24, 0xcd, 0x40, 0xcb, 0x97, 0x0e, 0x82, 0x0e,
+	0x6b, 0xf8, 0x1c, 0x16, 0xe2, 0xb7, 0x95, 0x4a, 0x22, 0x51, 0x0c, 0x21, 0xd7, 0x26, 0x21, 0xc2,
+	0xf4, 0x7d, 0x10, 0x53, 0x0e, 0xaa, 0x33, 0x89, 0xf9, 0x49, 0x90, 0x7c, 0x7e, 0x1f, 0xa0, 0xe8,
+	0xab, 0xcc, 0x38, 0x17, 0x92, 0xa9, 0x5e, 0x0d, 0x4e, 0x79, 0x95, 0x93, 0xdd, 0x58, 0xfc, 0x4e,
+	0x80, 0x53, 0x99, 0x56, 0x5c, 0x4f, 0xb6, 0x64, 0x06, 0x5c, 0xbe, 0x7c, 0x20, 0x78, 0x58, 0x46,
+	0x0f, 0x16, 0x93, 0x56, 0x5a, 0x4d, 0xe4, 0x4a, 0x60, 0xe4, 0xd5, 0xc9, 0x98, 0xb1, 0xb6, 0xcd,
+	0x32, 0x9c, 0x64, 0xdb, 0x66, 0xa0, 0x53, 0xda, 0x76, 0x1f, 0xc6, 0x20, 0x1f, 0xfd, 0x7a, 0x6f,
+	0x67, 0x55, 0x68, 0xb5, 0x9e, 0xee, 0x96, 0x84, 0x67, 0xbb, 0x25, 0xe1, 0xaf, 0xdd, 0x92, 0xf0,
+	0xe8, 0x65, 0x69, 0xea, 0xd9, 0xcb, 0xd2, 0xd4, 0x9f, 0x2f, 0x4b, 0x53, 0x9f, 0x46, 0x6f, 0x89,
+	0xfe, 0x02, 0xf5, 0x81, 0xd6, 0x25, 0x8d, 0xa8, 0x41, 0xb1, 0xbb, 0x62, 0x77, 0x86, 0x39, 0xfc,
+	0xc5, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x48, 0xcf, 0x9c, 0x8a, 0x3d, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// [MOD-DE-MSG-3] Grant Operator Authorization
+	GrantOperatorAuthorization(ctx context.Context, in *MsgGrantOperatorAuthorization, opts ...grpc.CallOption) (*MsgGrantOperatorAuthorizationResponse, error)
+	// [MOD-DE-MSG-4] Revoke Operator Authorization
+	RevokeOperatorAuthorization(ctx context.Context, in *MsgRevokeOperatorAuthorization, opts ...grpc.CallOption) (*MsgRevokeOperatorAuthorizationResponse, error)
+	// SetOperatorRole creates or updates a named operator role of a corporation
+	SetOperatorRole(ctx context.Context, in *MsgSetOperatorRole, opts ...grpc.CallOption) (*MsgSetOperatorRoleResponse, error)
+	// DeleteOperatorRole deletes an operator role no authorization is granted from
+	DeleteOperatorRole(ctx context.Context, in *MsgDeleteOperatorRole, opts ...grpc.CallOption) (*MsgDeleteOperatorRoleResponse, error)
+	// FreezeCorporationOperators suspends every operator authorization of a corporation
+	FreezeCorporationOperators(ctx context.Context, in *MsgFreezeCorporationOperators, opts ...grpc.CallOption) (*MsgFreezeCorporationOperatorsResponse, error)
+	// UnfreezeCorporationOperators lifts a freeze, through a group proposal only
+	UnfreezeCorporationOperators(ctx context.Context, in *MsgUnfreezeCorporationOperators, opts ...grpc.CallOption) (*MsgUnfreezeCorporationOperatorsResponse, error)
+	// ExecAsCorporation atomically executes messages of a corporation run by one operator
+	ExecAsCorporation(ctx context.Context, in *MsgExecAsCorporation, opts ...grpc.CallOption) (*MsgExecAsCorporationResponse, error)
+	// AcceptOperatorAuthorization lets a grantee accept a pending operator authorization
+	AcceptOperatorAuthorization(ctx context.Context, in *MsgAcceptOperatorAuthorization, opts ...grpc.CallOption) (*MsgAcceptOperatorAuthorizationResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantOperatorAuthorization(ctx context.Context, in *MsgGrantOperatorAuthorization, opts ...grpc.CallOption) (*MsgGrantOperatorAuthorizationResponse, error) {
+	out := new(MsgGrantOperatorAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/GrantOperatorAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeOperatorAuthorization(ctx context.Context, in *MsgRevokeOperatorAuthorization, opts ...grpc.CallOption) (*MsgRevokeOperatorAuthorizationResponse, error) {
+	out := new(MsgRevokeOperatorAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/RevokeOperatorAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOperatorRole(ctx context.Context, in *MsgSetOperatorRole, opts ...grpc.CallOption) (*MsgSetOperatorRoleResponse, error) {
+	out := new(MsgSetOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/SetOperatorRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteOperatorRole(ctx context.Context, in *MsgDeleteOperatorRole, opts ...grpc.CallOption) (*MsgDeleteOperatorRoleResponse, error) {
+	out := new(MsgDeleteOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/DeleteOperatorRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeCorporationOperators(ctx context.Context, in *MsgFreezeCorporationOperators, opts ...grpc.CallOption) (*MsgFreezeCorporationOperatorsResponse, error) {
+	out := new(MsgFreezeCorporationOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/FreezeCorporationOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeCorporationOperators(ctx context.Context, in *MsgUnfreezeCorporationOperators, opts ...grpc.CallOption) (*MsgUnfreezeCorporationOperatorsResponse, error) {
+	out := new(MsgUnfreezeCorporationOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/UnfreezeCorporationOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecAsCorporation(ctx context.Context, in *MsgExecAsCorporation, opts ...grpc.CallOption) (*MsgExecAsCorporationResponse, error) {
+	out := new(MsgExecAsCorporationResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/ExecAsCorporation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOperatorAuthorization(ctx context.Context, in *MsgAcceptOperatorAuthorization, opts ...grpc.CallOption) (*MsgAcceptOperatorAuthorizationResponse, error) {
+	out := new(MsgAcceptOperatorAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/verana.de.v1.Msg/AcceptOperatorAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// [MOD-DE-MSG-3] Grant Operator Authorization
+	GrantOperatorAuthorization(context.Context, *MsgGrantOperatorAuthorization) (*MsgGrantOperatorAuthorizationResponse, error)
+	// [MOD-DE-MSG-4] Revoke Operator Authorization
+	RevokeOperatorAuthorization(context.Context, *MsgRevokeOperatorAuthorization) (*MsgRevokeOperatorAuthorizationResponse, error)
+	// SetOperatorRole creates or updates a named operator role of a corporation
+	SetOperatorRole(context.Context, *MsgSetOperatorRole) (*MsgSetOperatorRoleResponse, error)
+	// DeleteOperatorRole deletes an operator role no authorization is granted from
+	DeleteOperatorRole(context.Context, *MsgDeleteOperatorRole) (*MsgDeleteOperatorRoleResponse, error)
+	// FreezeCorporationOperators suspends every operator authorization of a corporation
+	FreezeCorporationOperators(context.Context, *MsgFreezeCorporationOperators) (*MsgFreezeCorporationOperatorsResponse, error)
+	// UnfreezeCorporationOperators lifts a freeze, through a group proposal only
+	UnfreezeCorporationOperators(context.Context, *MsgUnfreezeCorporationOperators) (*MsgUnfreezeCorporationOperatorsResponse, error)
+	// ExecAsCorporation atomically executes messages of a corporation run by one operator
+	ExecAsCorporation(context.Context, *MsgExecAsCorporation) (*MsgExecAsCorporationResponse, error)
+	// AcceptOperatorAuthorization lets a grantee accept a pending operator authorization
+	AcceptOperatorAuthorization(context.Context, *MsgAcceptOperatorAuthorization) (*MsgAcceptOperatorAuthorizationResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) GrantOperatorAuthorization(ctx context.Context, req *MsgGrantOperatorAuthorization) (*MsgGrantOperatorAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantOperatorAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) RevokeOperatorAuthorization(ctx context.Context, req *MsgRevokeOperatorAuthorization) (*MsgRevokeOperatorAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperatorAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) SetOperatorRole(ctx context.Context, req *MsgSetOperatorRole) (*MsgSetOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorRole not implemented")
+}
+func (*UnimplementedMsgServer) DeleteOperatorRole(ctx context.Context, req *MsgDeleteOperatorRole) (*MsgDeleteOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOperatorRole not implemented")
+}
+func (*UnimplementedMsgServer) FreezeCorporationOperators(ctx context.Context, req *MsgFreezeCorporationOperators) (*MsgFreezeCorporationOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeCorporationOperators not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeCorporationOperators(ctx context.Context, req *MsgUnfreezeCorporationOperators) (*MsgUnfreezeCorporationOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeCorporationOperators not implemented")
+}
+func (*UnimplementedMsgServer) ExecAsCorporation(ctx context.Context, req *MsgExecAsCorporation) (*MsgExecAsCorporationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecAsCorporation not implemented")
+}
+func (*UnimplementedMsgServer) AcceptOperatorAuthorization(ctx context.Context, req *MsgAcceptOperatorAuthorization) (*MsgAcceptOperatorAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOperatorAuthorization not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantOperatorAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantOperatorAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/GrantOperatorAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantOperatorAuthorization(ctx, req.(*MsgGrantOperatorAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeOperatorAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeOperatorAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/RevokeOperatorAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeOperatorAuthorization(ctx, req.(*MsgRevokeOperatorAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/SetOperatorRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperatorRole(ctx, req.(*MsgSetOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/DeleteOperatorRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOperatorRole(ctx, req.(*MsgDeleteOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeCorporationOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeCorporationOperators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeCorporationOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/FreezeCorporationOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeCorporationOperators(ctx, req.(*MsgFreezeCorporationOperators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeCorporationOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeCorporationOperators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeCorporationOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/UnfreezeCorporationOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeCorporationOperators(ctx, req.(*MsgUnfreezeCorporationOperators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecAsCorporation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecAsCorporation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecAsCorporation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/ExecAsCorporation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecAsCorporation(ctx, req.(*MsgExecAsCorporation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOperatorAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOperatorAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOperatorAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.de.v1.Msg/AcceptOperatorAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOperatorAuthorization(ctx, req.(*MsgAcceptOperatorAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.de.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "GrantOperatorAuthorization",
+			Handler:    _Msg_GrantOperatorAuthorization_Handler,
+		},
+		{
+			MethodName: "RevokeOperatorAuthorization",
+			Handler:    _Msg_RevokeOperatorAuthorization_Handler,
+		},
+		{
+			MethodName: "SetOperatorRole",
+			Handler:    _Msg_SetOperatorRole_Handler,
+		},
+		{
+			MethodName: "DeleteOperatorRole",
+			Handler:    _Msg_DeleteOperatorRole_Handler,
+		},
+		{
+			MethodName: "FreezeCorporationOperators",
+			Handler:    _Msg_FreezeCorporationOperators_Handler,
+		},
+		{
+			MethodName: "UnfreezeCorporationOperators",
+			Handler:    _Msg_UnfreezeCorporationOperators_Handler,
+		},
+		{
+			MethodName: "ExecAsCorporation",
+			Handler:    _Msg_ExecAsCorporation_Handler,
+		},
+		{
+			MethodName: "AcceptOperatorAuthorization",
+			Handler:    _Msg_AcceptOperatorAuthorization_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/de/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantOperatorAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantOperatorAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantOperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NotBefore != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RequireAcceptance {
+		i--
+		if m.RequireAcceptance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FeeSpendLimit) > 0 {
+		for iNdEx := len(m.FeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.FeegrantSpendLimitPeriod != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.FeegrantSpendLimitPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.FeegrantSpendLimitPeriod):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FeegrantSpendLimit) > 0 {
+		for iNdEx := len(m.FeegrantSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeegrantSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.WithFeegrant {
+		i--
+		if m.WithFeegrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AuthzSpendLimitPeriod != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.AuthzSpendLimitPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.AuthzSpendLimitPeriod):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AuthzSpendLimit) > 0 {
+		for iNdEx := len(m.AuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Expiration != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOperatorAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOperatorAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperatorRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FeeSpendLimit) > 0 {
+		for iNdEx := len(m.FeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperatorRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAuthorizations != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpdatedAuthorizations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOperatorRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOperatorRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOperatorRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOperatorRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOperatorRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOperatorRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeCorporationOperators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeCorporationOperators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeCorporationOperators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeCorporationOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeCorporationOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeCorporationOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeCorporationOperators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeCorporationOperators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeCorporationOperators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeCorporationOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeCorporationOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeCorporationOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecAsCorporation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAsCorporation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAsCorporation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecAsCorporationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAsCorporationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAsCorporationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOperatorAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOperatorAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOperatorAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOperatorAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOperatorAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantOperatorAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AuthzSpendLimit) > 0 {
+		for _, e := range m.AuthzSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AuthzSpendLimitPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.AuthzSpendLimitPeriod)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithFeegrant {
+		n += 2
+	}
+	if len(m.FeegrantSpendLimit) > 0 {
+		for _, e := range m.FeegrantSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.FeegrantSpendLimitPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.FeegrantSpendLimitPeriod)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeSpendLimit) > 0 {
+		for _, e := range m.FeeSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequireAcceptance {
+		n += 2
+	}
+	if m.NotBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantOperatorAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeOperatorAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeOperatorAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOperatorRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FeeSpendLimit) > 0 {
+		for _, e := range m.FeeSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Period != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOperatorRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatedAuthorizations != 0 {
+		n += 1 + sovTx(uint64(m.UpdatedAuthorizations))
+	}
+	return n
+}
+
+func (m *MsgDeleteOperatorRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteOperatorRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeCorporationOperators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeCorporationOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeCorporationOperators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeCorporationOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecAsCorporation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecAsCorporationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcceptOperatorAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CorporationId != 0 {
+		n += 1 + sovTx(uint64(m.CorporationId))
+	}
+	return n
+}
+
+func (m *MsgAcceptOperatorAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantOperatorAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantOperatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthzSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthzSpendLimit = append(m.AuthzSpendLimit, types.Coin{})
+			if err := m.AuthzSpendLimit[len(m.AuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthzSpendLimitPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthzSpendLimitPeriod == nil {
+				m.AuthzSpendLimitPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.AuthzSpendLimitPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithFeegrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithFeegrant = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeegrantSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeegrantSpendLimit = append(m.FeegrantSpendLimit, types.Coin{})
+			if err := m.FeegrantSpendLimit[len(m.FeegrantSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeegrantSpendLimitPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeegrantSpendLimitPeriod == nil {
+				m.FeegrantSpendLimitPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.FeegrantSpendLimitPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSpendLimit = append(m.FeeSpendLimit, types.Coin{})
+			if err := m.FeeSpendLimit[len(m.FeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAcceptance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAcceptance = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantOperatorAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperatorAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperatorAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgGrantOperatorAuthorizationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRevokeOperatorAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRevokeOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperatorAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRevokeOperatorAuthorizationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,15 +3667,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,11 +3703,175 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSpendLimit = append(m.FeeSpendLimit, types.Coin{})
+			if err := m.FeeSpendLimit[len(m.FeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1177,7 +3898,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1202,7 +3926,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,12 +3949,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAuthorizations", wireType)
+			}
+			m.UpdatedAuthorizations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAuthorizations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1252,7 +3995,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteOperatorRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1275,10 +4018,10 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantOperatorAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteOperatorRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantOperatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteOperatorRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1347,7 +4090,7 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,11 +4118,111 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteOperatorRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteOperatorRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteOperatorRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeCorporationOperators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeCorporationOperators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeCorporationOperators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1407,13 +4250,13 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1423,33 +4266,29 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthzSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1459,31 +4298,129 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthzSpendLimit = append(m.AuthzSpendLimit, types.Coin{})
-			if err := m.AuthzSpendLimit[len(m.AuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeCorporationOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeCorporationOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeCorporationOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeCorporationOperators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeCorporationOperators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeCorporationOperators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthzSpendLimitPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1493,53 +4430,129 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthzSpendLimitPeriod == nil {
-				m.AuthzSpendLimitPeriod = new(time.Duration)
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.AuthzSpendLimitPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeCorporationOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeCorporationOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeCorporationOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithFeegrant", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.WithFeegrant = bool(v != 0)
-		case 9:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecAsCorporation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecAsCorporation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecAsCorporation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeegrantSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1549,31 +4562,29 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeegrantSpendLimit = append(m.FeegrantSpendLimit, types.Coin{})
-			if err := m.FeegrantSpendLimit[len(m.FeegrantSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeegrantSpendLimitPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1583,31 +4594,27 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeegrantSpendLimitPeriod == nil {
-				m.FeegrantSpendLimitPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.FeegrantSpendLimitPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1634,8 +4641,8 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeSpendLimit = append(m.FeeSpendLimit, types.Coin{})
-			if err := m.FeeSpendLimit[len(m.FeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1660,7 +4667,7 @@ func (m *MsgGrantOperatorAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecAsCorporationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1683,12 +4690,44 @@ func (m *MsgGrantOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantOperatorAuthorizationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecAsCorporationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecAsCorporationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1710,7 +4749,7 @@ func (m *MsgGrantOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperatorAuthorization) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOperatorAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1733,15 +4772,15 @@ func (m *MsgRevokeOperatorAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperatorAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOperatorAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOperatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1769,45 +4808,13 @@ func (m *MsgRevokeOperatorAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corporation = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 			}
-			var stringLen uint64
+			m.CorporationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1817,24 +4824,11 @@ func (m *MsgRevokeOperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CorporationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1856,7 +4850,7 @@ func (m *MsgRevokeOperatorAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1879,10 +4873,10 @@ func (m *MsgRevokeOperatorAuthorizationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperatorAuthorizationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOperatorAuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOperatorAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,10 +2,13 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // VPRDelegableMsgTypes is the set of VPR message types that can be delegated
@@ -39,14 +42,28 @@ var VPRDelegableMsgTypes = map[string]bool{
 	"/verana.pp.v1.MsgRepayParticipantSlashedTrustDeposit": true,
 	"/verana.pp.v1.MsgSelfCreateParticipant":               true,
 	"/verana.pp.v1.MsgCreateOrUpdateParticipantSession":    true,
+	"/verana.pp.v1.MsgProposeParticipantFeeChange":         true,
+	"/verana.pp.v1.MsgAcceptParticipantFeeChange":          true,
+	"/verana.pp.v1.MsgAppealSlash":                         true,
+	"/verana.pp.v1.MsgSuspendParticipant":                  true,
+	"/verana.pp.v1.MsgUnsuspendParticipant":                true,
+	"/verana.pp.v1.MsgRotateParticipantDID":                true,
+	"/verana.pp.v1.MsgBatchRevokeParticipants":             true,
+	"/verana.pp.v1.MsgBatchSetParticipantEffectiveUntil":   true,
+	"/verana.pp.v1.MsgBatchSelfCreateParticipants":         true,
 	// Trust Deposit (TD)
 	"/verana.td.v1.MsgReclaimTrustDepositYield": true,
 	"/verana.td.v1.MsgRepaySlashedTrustDeposit": true,
+	"/verana.td.v1.MsgDepositTrust":             true,
+	"/verana.td.v1.MsgWithdrawClaimable":        true,
 	// Digest (DI)
 	"/verana.di.v1.MsgStoreDigest": true,
 	// Delegation (DE)
 	"/verana.de.v1.MsgGrantOperatorAuthorization":  true,
 	"/verana.de.v1.MsgRevokeOperatorAuthorization": true,
+	"/verana.de.v1.MsgSetOperatorRole":             true,
+	"/verana.de.v1.MsgDeleteOperatorRole":          true,
+	"/verana.de.v1.MsgFreezeCorporationOperators":  true,
 	// Exchange Rate (XR)
 	"/verana.xr.v1.MsgUpdateExchangeRate": true,
 }
@@ -75,17 +92,17 @@ func (msg *MsgGrantOperatorAuthorization) ValidateBasic() error {
 		return fmt.Errorf("invalid grantee address: %w", err)
 	}
 
-	// msg_types must not be empty and must be VPR delegable
-	if len(msg.MsgTypes) == 0 {
-		return fmt.Errorf("msg_types must not be empty")
-	}
-	for _, mt := range msg.MsgTypes {
-		if !VPRDelegableMsgTypes[mt] {
-			return fmt.Errorf("msg_type %s is not a VPR delegable message type", mt)
+	// msg_types come from the role if one is referenced, otherwise they must
+	// not be empty and must be VPR delegable
+	if msg.Role != "" {
+		if err := ValidateOperatorRoleName(msg.Role); err != nil {
+			return err
 		}
-		if mt == MsgCreateOrUpdateParticipantSessionTypeURL {
-			return fmt.Errorf("msg_type %s is not allowed in operator authorization", mt)
+		if len(msg.MsgTypes) > 0 {
+			return fmt.Errorf("msg_types must be empty when role is set")
 		}
+	} else if err := validateOperatorMsgTypes(msg.MsgTypes); err != nil {
+		return err
 	}
 
 	// authz_spend_limit if specified must be valid and all-positive
@@ -123,9 +140,40 @@ func (msg *MsgGrantOperatorAuthorization) ValidateBasic() error {
 		return fmt.Errorf("feegrant_spend_limit_period must be a positive duration")
 	}
 
+	// not_before if specified must leave the authorization a chance to be active
+	if msg.NotBefore != nil && msg.Expiration != nil && !msg.NotBefore.Before(*msg.Expiration) {
+		return fmt.Errorf("not_before must be before expiration")
+	}
+
+	return nil
+}
+
+// validateOperatorMsgTypes checks that msgTypes is a non-empty list of VPR
+// delegable message types allowed in operator authorizations.
+func validateOperatorMsgTypes(msgTypes []string) error {
+	if len(msgTypes) == 0 {
+		return fmt.Errorf("msg_types must not be empty")
+	}
+	for _, mt := range msgTypes {
+		if !VPRDelegableMsgTypes[mt] {
+			return fmt.Errorf("msg_type %s is not a VPR delegable message type", mt)
+		}
+		if mt == MsgCreateOrUpdateParticipantSessionTypeURL {
+			return fmt.Errorf("msg_type %s is not allowed in operator authorization", mt)
+		}
+	}
 	return nil
 }
 
+// IsActive reports whether oa can be used at now: accepted by its operator
+// if acceptance was required, and past its not_before if time locked.
+func (oa OperatorAuthorization) IsActive(now time.Time) bool {
+	if oa.Pending {
+		return false
+	}
+	return oa.NotBefore == nil || !now.Before(*oa.NotBefore)
+}
+
 // ValidateBasic performs stateless validation on MsgRevokeOperatorAuthorization.
 func (msg *MsgRevokeOperatorAuthorization) ValidateBasic() error {
 	// corporation is mandatory
@@ -147,3 +195,160 @@ func (msg *MsgRevokeOperatorAuthorization) ValidateBasic() error {
 
 	return nil
 }
+
+// MaxOperatorRoleNameLength is the maximum length of an operator role name.
+const MaxOperatorRoleNameLength = 64
+
+// ValidateOperatorRoleName checks that name is a non-empty operator role name
+// of lowercase letters, digits, '-' and '_'.
+func ValidateOperatorRoleName(name string) error {
+	if name == "" {
+		return fmt.Errorf("role name must not be empty")
+	}
+	if len(name) > MaxOperatorRoleNameLength {
+		return fmt.Errorf("role name must not exceed %d characters", MaxOperatorRoleNameLength)
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return fmt.Errorf("invalid role name %q: only lowercase letters, digits, '-' and '_' are allowed", name)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgSetOperatorRole.
+func (msg *MsgSetOperatorRole) ValidateBasic() error {
+	// corporation is mandatory
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// operator is optional; if present, must be valid
+	if msg.Operator != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+			return fmt.Errorf("invalid operator address: %w", err)
+		}
+	}
+
+	if err := ValidateOperatorRoleName(msg.Name); err != nil {
+		return err
+	}
+	if err := validateOperatorMsgTypes(msg.MsgTypes); err != nil {
+		return err
+	}
+
+	// default limits if specified must be valid and all-positive
+	if len(msg.SpendLimit) > 0 && (!msg.SpendLimit.IsValid() || !msg.SpendLimit.IsAllPositive()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "spend_limit amounts must be valid and positive")
+	}
+	if len(msg.FeeSpendLimit) > 0 && (!msg.FeeSpendLimit.IsValid() || !msg.FeeSpendLimit.IsAllPositive()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee_spend_limit amounts must be valid and positive")
+	}
+	if msg.Period != nil && *msg.Period <= 0 {
+		return fmt.Errorf("period must be a positive duration")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgDeleteOperatorRole.
+func (msg *MsgDeleteOperatorRole) ValidateBasic() error {
+	// corporation is mandatory
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// operator is optional; if present, must be valid
+	if msg.Operator != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+			return fmt.Errorf("invalid operator address: %w", err)
+		}
+	}
+
+	return ValidateOperatorRoleName(msg.Name)
+}
+
+// MaxFreezeReasonLength is the maximum length of the reason of a freeze.
+const MaxFreezeReasonLength = 512
+
+// ValidateBasic performs stateless validation on MsgFreezeCorporationOperators.
+func (msg *MsgFreezeCorporationOperators) ValidateBasic() error {
+	// corporation is mandatory
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// operator is optional; if present, must be valid
+	if msg.Operator != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+			return fmt.Errorf("invalid operator address: %w", err)
+		}
+	}
+
+	if len(msg.Reason) > MaxFreezeReasonLength {
+		return fmt.Errorf("reason must not exceed %d characters", MaxFreezeReasonLength)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUnfreezeCorporationOperators.
+func (msg *MsgUnfreezeCorporationOperators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgAcceptOperatorAuthorization.
+func (msg *MsgAcceptOperatorAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee address: %w", err)
+	}
+	if msg.CorporationId == 0 {
+		return fmt.Errorf("corporation_id must be specified")
+	}
+	return nil
+}
+
+// MaxExecMsgs is the maximum number of messages of a MsgExecAsCorporation.
+const MaxExecMsgs = 32
+
+var _ codectypes.UnpackInterfacesMessage = &MsgExecAsCorporation{}
+
+// GetMessages returns the unpacked inner messages of MsgExecAsCorporation.
+func (msg *MsgExecAsCorporation) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgExecAsCorporation")
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (msg *MsgExecAsCorporation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, m := range msg.Msgs {
+		var inner sdk.Msg
+		if err := unpacker.UnpackAny(m, &inner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgExecAsCorporation.
+func (msg *MsgExecAsCorporation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// operator is mandatory: a group proposal executes the messages directly
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	if len(msg.Msgs) == 0 {
+		return fmt.Errorf("msgs must not be empty")
+	}
+	if len(msg.Msgs) > MaxExecMsgs {
+		return fmt.Errorf("msgs must not exceed %d messages", MaxExecMsgs)
+	}
+
+	return nil
+}
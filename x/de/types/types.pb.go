@@ -61,6 +61,16 @@ type OperatorAuthorization struct {
 	// period is the reset period for spend_limit and fee_spend_limit. If set,
 	// expiration MUST also be set.
 	Period *time.Duration `protobuf:"bytes,10,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// role is the name of the OperatorRole of corporation_id this authorization
+	// was granted from, empty if granted without role. msg_types follow the
+	// role when it is edited.
+	Role string `protobuf:"bytes,11,opt,name=role,proto3" json:"role,omitempty"`
+	// pending is true while the authorization awaits the
+	// MsgAcceptOperatorAuthorization of its operator.
+	Pending bool `protobuf:"varint,12,opt,name=pending,proto3" json:"pending,omitempty"`
+	// not_before is the optional time before which the authorization is not
+	// active, leaving the members of the corporation time to revoke it.
+	NotBefore *time.Time `protobuf:"bytes,13,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
 }
 
 func (m *OperatorAuthorization) Reset()         { *m = OperatorAuthorization{} }
@@ -166,6 +176,123 @@ func (m *OperatorAuthorization) GetPeriod() *time.Duration {
 	return nil
 }
 
+func (m *OperatorAuthorization) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *OperatorAuthorization) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *OperatorAuthorization) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+// OperatorRole is a corporation-scoped named template of msg_types and
+// default limits that operator authorizations can be granted from.
+type OperatorRole struct {
+	// corporation_id is the id of the corporation owning the role. The
+	// (corporation_id, name) tuple MUST be unique.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// name identifies the role within the corporation (e.g. "schema-admin").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_types is the list of VPR delegable message types granted by the role.
+	MsgTypes []string `protobuf:"bytes,3,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// spend_limit is the default spend_limit of the authorizations granted from
+	// the role.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// fee_spend_limit is the default fee_spend_limit of the authorizations
+	// granted from the role.
+	FeeSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee_spend_limit,json=feeSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_spend_limit"`
+	// period is the default reset period of the authorizations granted from the
+	// role.
+	Period *time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+}
+
+func (m *OperatorRole) Reset()         { *m = OperatorRole{} }
+func (m *OperatorRole) String() string { return proto.CompactTextString(m) }
+func (*OperatorRole) ProtoMessage()    {}
+func (*OperatorRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ceceb116c414c04d, []int{1}
+}
+func (m *OperatorRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorRole.Merge(m, src)
+}
+func (m *OperatorRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorRole proto.InternalMessageInfo
+
+func (m *OperatorRole) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
+}
+
+func (m *OperatorRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OperatorRole) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *OperatorRole) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *OperatorRole) GetFeeSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeSpendLimit
+	}
+	return nil
+}
+
+func (m *OperatorRole) GetPeriod() *time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
 // OperatorAuthorizationUsage tracks per-authorization spend consumption so spec
 // [AUTHZ-CHECK-1] can enforce the spend_limit / period-reset invariant. Keyed by
 // the parent OperatorAuthorization id.
@@ -189,7 +316,7 @@ func (m *OperatorAuthorizationUsage) Reset()         { *m = OperatorAuthorizatio
 func (m *OperatorAuthorizationUsage) String() string { return proto.CompactTextString(m) }
 func (*OperatorAuthorizationUsage) ProtoMessage()    {}
 func (*OperatorAuthorizationUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ceceb116c414c04d, []int{1}
+	return fileDescriptor_ceceb116c414c04d, []int{2}
 }
 func (m *OperatorAuthorizationUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,13 +387,19 @@ type FeeGrant struct {
 	// period is the reset period for spend_limit. If set, expiration MUST also be
 	// set.
 	Period *time.Duration `protobuf:"bytes,7,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// pending mirrors the pending field of the OperatorAuthorization the fee
+	// grant was granted with; the fee grant is not usable while true.
+	Pending bool `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	// not_before mirrors the not_before field of the OperatorAuthorization the
+	// fee grant was granted with; the fee grant is not usable before it.
+	NotBefore *time.Time `protobuf:"bytes,9,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
 }
 
 func (m *FeeGrant) Reset()         { *m = FeeGrant{} }
 func (m *FeeGrant) String() string { return proto.CompactTextString(m) }
 func (*FeeGrant) ProtoMessage()    {}
 func (*FeeGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ceceb116c414c04d, []int{2}
+	return fileDescriptor_ceceb116c414c04d, []int{3}
 }
 func (m *FeeGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +477,20 @@ func (m *FeeGrant) GetPeriod() *time.Duration {
 	return nil
 }
 
+func (m *FeeGrant) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *FeeGrant) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
 // ParticipantAuthorizationRecord is a per-participant VS-operator authorization
 // nested inside a VSOperatorAuthorization. Keyed by participant_id, which is
 // globally unique across all records of all VSOperatorAuthorizations.
@@ -379,7 +526,7 @@ func (m *ParticipantAuthorizationRecord) Reset()         { *m = ParticipantAutho
 func (m *ParticipantAuthorizationRecord) String() string { return proto.CompactTextString(m) }
 func (*ParticipantAuthorizationRecord) ProtoMessage()    {}
 func (*ParticipantAuthorizationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ceceb116c414c04d, []int{3}
+	return fileDescriptor_ceceb116c414c04d, []int{4}
 }
 func (m *ParticipantAuthorizationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +638,7 @@ func (m *VSOperatorAuthorization) Reset()         { *m = VSOperatorAuthorization
 func (m *VSOperatorAuthorization) String() string { return proto.CompactTextString(m) }
 func (*VSOperatorAuthorization) ProtoMessage()    {}
 func (*VSOperatorAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ceceb116c414c04d, []int{4}
+	return fileDescriptor_ceceb116c414c04d, []int{5}
 }
 func (m *VSOperatorAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,69 +695,156 @@ func (m *VSOperatorAuthorization) GetRecords() []ParticipantAuthorizationRecord
 	return nil
 }
 
+// CorporationFreeze records that every operator and VS operator authorization
+// of a corporation is suspended until the corporation unfreezes them through a
+// group proposal.
+type CorporationFreeze struct {
+	// corporation_id is the id of the frozen corporation.
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// frozen_by is the operator that froze the corporation, empty when it was
+	// frozen through a group proposal.
+	FrozenBy string `protobuf:"bytes,2,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty"`
+	// reason is the optional reason given for the freeze.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// frozen_at is the time the corporation was frozen.
+	FrozenAt time.Time `protobuf:"bytes,4,opt,name=frozen_at,json=frozenAt,proto3,stdtime" json:"frozen_at"`
+}
+
+func (m *CorporationFreeze) Reset()         { *m = CorporationFreeze{} }
+func (m *CorporationFreeze) String() string { return proto.CompactTextString(m) }
+func (*CorporationFreeze) ProtoMessage()    {}
+func (*CorporationFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ceceb116c414c04d, []int{6}
+}
+func (m *CorporationFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CorporationFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CorporationFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CorporationFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorporationFreeze.Merge(m, src)
+}
+func (m *CorporationFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *CorporationFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorporationFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorporationFreeze proto.InternalMessageInfo
+
+func (m *CorporationFreeze) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
+}
+
+func (m *CorporationFreeze) GetFrozenBy() string {
+	if m != nil {
+		return m.FrozenBy
+	}
+	return ""
+}
+
+func (m *CorporationFreeze) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CorporationFreeze) GetFrozenAt() time.Time {
+	if m != nil {
+		return m.FrozenAt
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*OperatorAuthorization)(nil), "verana.de.v1.OperatorAuthorization")
+	proto.RegisterType((*OperatorRole)(nil), "verana.de.v1.OperatorRole")
 	proto.RegisterType((*OperatorAuthorizationUsage)(nil), "verana.de.v1.OperatorAuthorizationUsage")
 	proto.RegisterType((*FeeGrant)(nil), "verana.de.v1.FeeGrant")
 	proto.RegisterType((*ParticipantAuthorizationRecord)(nil), "verana.de.v1.ParticipantAuthorizationRecord")
 	proto.RegisterType((*VSOperatorAuthorization)(nil), "verana.de.v1.VSOperatorAuthorization")
+	proto.RegisterType((*CorporationFreeze)(nil), "verana.de.v1.CorporationFreeze")
 }
 
 func init() { proto.RegisterFile("verana/de/v1/types.proto", fileDescriptor_ceceb116c414c04d) }
 
 var fileDescriptor_ceceb116c414c04d = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x4f, 0xdb, 0x48,
-	0x18, 0x8e, 0xf3, 0x9d, 0x09, 0x01, 0xad, 0x97, 0x5d, 0x9c, 0xac, 0xe4, 0x44, 0x59, 0xad, 0x94,
-	0xc3, 0x62, 0x2f, 0x2c, 0xd2, 0x6a, 0x7b, 0x2a, 0xa1, 0xa2, 0x42, 0x42, 0x6a, 0xe5, 0xd0, 0x1e,
-	0x7a, 0xb1, 0x26, 0xf1, 0x60, 0x46, 0x8d, 0x3d, 0xd6, 0xcc, 0x24, 0xa5, 0xed, 0x9f, 0xe0, 0xd8,
-	0x5b, 0xef, 0x3d, 0xf7, 0x47, 0x70, 0x44, 0x3d, 0x55, 0x3d, 0x94, 0x0a, 0x7e, 0x42, 0x4f, 0x3d,
-	0xb5, 0x9a, 0xf1, 0x24, 0x71, 0x28, 0x8a, 0x8a, 0x48, 0xe1, 0x84, 0xe7, 0xfd, 0x7a, 0x98, 0xf7,
-	0x7d, 0xde, 0x27, 0x03, 0x8c, 0x21, 0xa2, 0x30, 0x84, 0xb6, 0x87, 0xec, 0xe1, 0x9a, 0xcd, 0x9f,
-	0x47, 0x88, 0x59, 0x11, 0x25, 0x9c, 0xe8, 0x0b, 0xb1, 0xc7, 0xf2, 0x90, 0x35, 0x5c, 0xab, 0x99,
-	0x3d, 0xc2, 0x02, 0xc2, 0xec, 0x2e, 0x64, 0x22, 0xb2, 0x8b, 0x38, 0x5c, 0xb3, 0x7b, 0x04, 0x87,
-	0x71, 0x74, 0xad, 0x1a, 0xfb, 0x5d, 0x79, 0xb2, 0xe3, 0x83, 0x72, 0x2d, 0xfb, 0xc4, 0x27, 0xb1,
-	0x5d, 0x7c, 0x29, 0xab, 0xe9, 0x13, 0xe2, 0xf7, 0x91, 0x2d, 0x4f, 0xdd, 0xc1, 0xbe, 0xed, 0x0d,
-	0x28, 0xe4, 0x98, 0x8c, 0x0a, 0xd6, 0x2f, 0xfa, 0x39, 0x0e, 0x10, 0xe3, 0x30, 0x88, 0xe2, 0x80,
-	0xe6, 0xd7, 0x1c, 0xf8, 0xed, 0x41, 0x84, 0x28, 0xe4, 0x84, 0x6e, 0x0e, 0xf8, 0x01, 0xa1, 0xf8,
-	0x85, 0x2c, 0xa0, 0x2f, 0x82, 0x34, 0xf6, 0x0c, 0xad, 0xa1, 0xb5, 0xb2, 0x4e, 0x1a, 0x7b, 0xfa,
-	0x5f, 0x60, 0xb1, 0x47, 0x68, 0x44, 0xe2, 0xfa, 0x2e, 0xf6, 0x8c, 0xb4, 0xf4, 0x55, 0x12, 0xd6,
-	0x1d, 0x4f, 0xdf, 0x00, 0x45, 0xa2, 0xea, 0x19, 0x99, 0x86, 0xd6, 0x2a, 0xb5, 0x8d, 0x77, 0x6f,
-	0x57, 0x97, 0xd5, 0x5d, 0x36, 0x3d, 0x8f, 0x22, 0xc6, 0x3a, 0x9c, 0xe2, 0xd0, 0x77, 0xc6, 0x91,
-	0xfa, 0x1f, 0xa0, 0x14, 0x30, 0xdf, 0x95, 0x9d, 0x33, 0xb2, 0x8d, 0x4c, 0xab, 0xe4, 0x14, 0x03,
-	0xe6, 0xef, 0x89, 0xb3, 0xde, 0x07, 0x65, 0x16, 0xa1, 0xd0, 0x73, 0xfb, 0x38, 0xc0, 0xdc, 0xc8,
-	0x35, 0x32, 0xad, 0xf2, 0x7a, 0xd5, 0x52, 0x25, 0x45, 0x2f, 0x2d, 0xd5, 0x4b, 0x6b, 0x8b, 0xe0,
-	0xb0, 0xfd, 0xcf, 0xf1, 0xc7, 0x7a, 0xea, 0xcd, 0x69, 0xbd, 0xe5, 0x63, 0x7e, 0x30, 0xe8, 0x5a,
-	0x3d, 0x12, 0xa8, 0x5e, 0xaa, 0x3f, 0xab, 0xcc, 0x7b, 0xaa, 0xa6, 0x24, 0x12, 0x98, 0x03, 0x64,
-	0xfd, 0x5d, 0x51, 0x5e, 0xe7, 0x60, 0x89, 0xa2, 0x00, 0xe2, 0x10, 0x87, 0xbe, 0x2b, 0xed, 0x46,
-	0x7e, 0xfe, 0x88, 0x8b, 0x63, 0x8c, 0x8e, 0x80, 0xd0, 0x19, 0x58, 0xda, 0x47, 0xc8, 0x4d, 0xde,
-	0xb3, 0x30, 0x7f, 0xd4, 0xca, 0x3e, 0x42, 0x9d, 0xc9, 0x55, 0x5f, 0x82, 0x5f, 0x27, 0x57, 0x1d,
-	0xc3, 0x1b, 0xc5, 0xf9, 0x03, 0xff, 0x32, 0xc6, 0xd9, 0x56, 0xff, 0x81, 0x7e, 0x17, 0x00, 0x74,
-	0x18, 0xe1, 0x98, 0x38, 0x46, 0xa9, 0xa1, 0xb5, 0xca, 0xeb, 0x35, 0x2b, 0xe6, 0xab, 0x35, 0xe2,
-	0xab, 0xb5, 0x37, 0xe2, 0x6b, 0x3b, 0x7b, 0x74, 0x5a, 0xd7, 0x9c, 0x44, 0x8e, 0xfe, 0x1f, 0xc8,
-	0x47, 0x88, 0x62, 0xe2, 0x19, 0x40, 0x66, 0x57, 0xbf, 0xcb, 0xbe, 0xa7, 0xb6, 0xa1, 0x9d, 0x7d,
-	0x25, 0x92, 0x55, 0xb8, 0x53, 0x4e, 0x50, 0xb6, 0xf9, 0x3a, 0x0d, 0x6a, 0x97, 0x6e, 0xc0, 0x23,
-	0x06, 0x7d, 0xa4, 0xdf, 0x01, 0xd5, 0x11, 0x4b, 0x5d, 0x98, 0x74, 0xbb, 0xe3, 0xed, 0x58, 0x21,
-	0x97, 0xa5, 0xef, 0x78, 0x3a, 0x06, 0xa5, 0xf1, 0xbd, 0x8d, 0xf4, 0xfc, 0xbb, 0x3a, 0xa9, 0xae,
-	0x6f, 0x01, 0xd0, 0x87, 0x8c, 0xbb, 0x14, 0x31, 0xc4, 0xe5, 0xe2, 0xcd, 0xee, 0x66, 0x51, 0x80,
-	0xc9, 0x8e, 0x96, 0x44, 0x9e, 0x23, 0xd2, 0xa6, 0xfa, 0x32, 0x59, 0xce, 0xe6, 0x97, 0x0c, 0x28,
-	0x6e, 0x23, 0x74, 0x9f, 0xc2, 0x90, 0xeb, 0x1b, 0xe0, 0x77, 0x5f, 0x7c, 0x10, 0xea, 0x5e, 0x90,
-	0x83, 0xb8, 0x19, 0xcb, 0xca, 0xbb, 0x35, 0xa5, 0x0a, 0xeb, 0xa0, 0x20, 0xed, 0x08, 0x49, 0xd5,
-	0x98, 0x25, 0x0a, 0xa3, 0xc0, 0x69, 0x4d, 0xc8, 0xcc, 0xd6, 0x84, 0xec, 0x8d, 0x6b, 0x42, 0xee,
-	0xe7, 0x6b, 0xc2, 0xf4, 0x86, 0xe4, 0xaf, 0xb5, 0x21, 0x85, 0xab, 0x6d, 0x48, 0x41, 0x4d, 0xb1,
-	0xf9, 0x21, 0x07, 0xcc, 0x87, 0x90, 0x72, 0xdc, 0xc3, 0x11, 0x0c, 0xf9, 0x14, 0xc3, 0x1d, 0xd4,
-	0x23, 0x54, 0xfe, 0x30, 0x44, 0x93, 0x88, 0x09, 0x13, 0x2a, 0x09, 0xeb, 0x8e, 0x37, 0x3d, 0xce,
-	0xf4, 0xec, 0x71, 0x66, 0x6e, 0x7c, 0x9c, 0xd9, 0x5b, 0x91, 0xf8, 0xdc, 0x6d, 0x49, 0x7c, 0xfe,
-	0x46, 0x24, 0xfe, 0x4f, 0x50, 0x79, 0x86, 0xf9, 0x81, 0xc0, 0x95, 0x7c, 0x92, 0x2c, 0x2c, 0x3a,
-	0x0b, 0xc2, 0xb8, 0xad, 0x6c, 0x17, 0x58, 0x5e, 0xbc, 0x16, 0xcb, 0x4b, 0x57, 0x62, 0x79, 0xf3,
-	0xb3, 0x06, 0x56, 0x1e, 0x77, 0xe6, 0xfa, 0xfc, 0xf9, 0x1f, 0x94, 0x87, 0xcc, 0xfd, 0xe1, 0x17,
-	0x10, 0x18, 0xb2, 0x11, 0xbc, 0xbe, 0x0b, 0x0a, 0x54, 0x6e, 0x14, 0x53, 0x6c, 0xfc, 0xdb, 0x4a,
-	0x3e, 0x1e, 0xad, 0xd9, 0x6b, 0xd8, 0xce, 0x8a, 0x89, 0x39, 0xa3, 0x12, 0xd3, 0x5a, 0x5e, 0x8e,
-	0x10, 0x0d, 0x30, 0x63, 0x98, 0x84, 0xac, 0xdd, 0x3e, 0x3e, 0x33, 0xb5, 0x93, 0x33, 0x53, 0xfb,
-	0x74, 0x66, 0x6a, 0x47, 0xe7, 0x66, 0xea, 0xe4, 0xdc, 0x4c, 0xbd, 0x3f, 0x37, 0x53, 0x4f, 0x92,
-	0xc3, 0x8e, 0xa1, 0x57, 0xfb, 0xb0, 0xcb, 0xd4, 0xb7, 0x7d, 0x28, 0xde, 0xb7, 0x72, 0xe4, 0xdd,
-	0xbc, 0x6c, 0xed, 0xbf, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x7b, 0xef, 0x8f, 0xf9, 0x0a,
-	0x00, 0x00,
+	// 960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x6b, 0x7b, 0xf7, 0x39, 0x49, 0xd5, 0x21, 0xb4, 0x9b, 0x20, 0x39, 0x96, 0x11,
+	0x92, 0x0f, 0x64, 0x4d, 0x42, 0x11, 0x82, 0x0b, 0xc4, 0x41, 0x41, 0x91, 0x2a, 0x81, 0x36, 0x85,
+	0x03, 0x97, 0xd5, 0xd8, 0x3b, 0xde, 0x8c, 0xf0, 0xee, 0xac, 0x66, 0x26, 0xa6, 0x09, 0x37, 0x3e,
+	0x41, 0x8f, 0xdc, 0xb8, 0x73, 0xe6, 0x43, 0xf4, 0xc0, 0xa1, 0x42, 0x1c, 0x10, 0x07, 0x8a, 0x92,
+	0x3b, 0x17, 0xbe, 0x00, 0x9a, 0xd9, 0xb1, 0xbd, 0x6e, 0x2d, 0x93, 0xa8, 0x4e, 0xdb, 0x93, 0x67,
+	0xde, 0xdf, 0xdd, 0xb7, 0xbf, 0xdf, 0x7b, 0xcf, 0xe0, 0x8d, 0x08, 0xc7, 0x29, 0xee, 0x44, 0xa4,
+	0x33, 0xda, 0xed, 0xc8, 0xb3, 0x8c, 0x08, 0x3f, 0xe3, 0x4c, 0x32, 0xb4, 0x9a, 0x6b, 0xfc, 0x88,
+	0xf8, 0xa3, 0xdd, 0xad, 0x46, 0x9f, 0x89, 0x84, 0x89, 0x4e, 0x0f, 0x0b, 0x65, 0xd9, 0x23, 0x12,
+	0xef, 0x76, 0xfa, 0x8c, 0xa6, 0xb9, 0xf5, 0xd6, 0x66, 0xae, 0x0f, 0xf5, 0xad, 0x93, 0x5f, 0x8c,
+	0x6a, 0x23, 0x66, 0x31, 0xcb, 0xe5, 0xea, 0x64, 0xa4, 0x8d, 0x98, 0xb1, 0x78, 0x48, 0x3a, 0xfa,
+	0xd6, 0x3b, 0x1d, 0x74, 0xa2, 0x53, 0x8e, 0x25, 0x65, 0xe3, 0x80, 0xdb, 0xcf, 0xea, 0x25, 0x4d,
+	0x88, 0x90, 0x38, 0xc9, 0x72, 0x83, 0xd6, 0x3f, 0x55, 0x78, 0xf3, 0x8b, 0x8c, 0x70, 0x2c, 0x19,
+	0xdf, 0x3f, 0x95, 0x27, 0x8c, 0xd3, 0x73, 0x1d, 0x00, 0xad, 0x43, 0x89, 0x46, 0x9e, 0xd5, 0xb4,
+	0xda, 0x76, 0x50, 0xa2, 0x11, 0x7a, 0x07, 0xd6, 0xfb, 0x8c, 0x67, 0x2c, 0x8f, 0x1f, 0xd2, 0xc8,
+	0x2b, 0x69, 0xdd, 0x5a, 0x41, 0x7a, 0x14, 0xa1, 0x7b, 0xe0, 0x30, 0x13, 0xcf, 0x2b, 0x37, 0xad,
+	0xb6, 0xdb, 0xf5, 0x7e, 0xfb, 0x65, 0x67, 0xc3, 0xbc, 0xcb, 0x7e, 0x14, 0x71, 0x22, 0xc4, 0xb1,
+	0xe4, 0x34, 0x8d, 0x83, 0x89, 0x25, 0x7a, 0x0b, 0xdc, 0x44, 0xc4, 0xa1, 0xae, 0x9c, 0x67, 0x37,
+	0xcb, 0x6d, 0x37, 0x70, 0x12, 0x11, 0x3f, 0x50, 0x77, 0x34, 0x84, 0xba, 0xc8, 0x48, 0x1a, 0x85,
+	0x43, 0x9a, 0x50, 0xe9, 0x55, 0x9a, 0xe5, 0x76, 0x7d, 0x6f, 0xd3, 0x37, 0x21, 0x55, 0x2d, 0x7d,
+	0x53, 0x4b, 0xff, 0x80, 0xd1, 0xb4, 0xfb, 0xde, 0xe3, 0xbf, 0xb6, 0x57, 0x7e, 0x7e, 0xba, 0xdd,
+	0x8e, 0xa9, 0x3c, 0x39, 0xed, 0xf9, 0x7d, 0x96, 0x98, 0x5a, 0x9a, 0x9f, 0x1d, 0x11, 0x7d, 0x6b,
+	0xbe, 0x92, 0x72, 0x10, 0x01, 0xe8, 0xf8, 0xf7, 0x55, 0x78, 0x24, 0xe1, 0x16, 0x27, 0x09, 0xa6,
+	0x29, 0x4d, 0xe3, 0x50, 0xcb, 0xbd, 0xea, 0xf2, 0x33, 0xae, 0x4f, 0x72, 0x1c, 0xab, 0x14, 0x48,
+	0xc0, 0xad, 0x01, 0x21, 0x61, 0xf1, 0x3d, 0x6b, 0xcb, 0xcf, 0xba, 0x36, 0x20, 0xe4, 0x78, 0xfa,
+	0xaa, 0xdf, 0xc3, 0x1b, 0xd3, 0x57, 0x9d, 0xa4, 0xf7, 0x9c, 0xe5, 0x27, 0xbe, 0x3d, 0xc9, 0x73,
+	0x68, 0x9e, 0x00, 0x7d, 0x0a, 0x40, 0x1e, 0x66, 0x34, 0x07, 0x8e, 0xe7, 0x36, 0xad, 0x76, 0x7d,
+	0x6f, 0xcb, 0xcf, 0xf1, 0xea, 0x8f, 0xf1, 0xea, 0x3f, 0x18, 0xe3, 0xb5, 0x6b, 0x3f, 0x7a, 0xba,
+	0x6d, 0x05, 0x05, 0x1f, 0xf4, 0x21, 0x54, 0x33, 0xc2, 0x29, 0x8b, 0x3c, 0xd0, 0xde, 0x9b, 0xcf,
+	0x79, 0x7f, 0x66, 0xd8, 0xd0, 0xb5, 0x7f, 0x54, 0xce, 0xc6, 0x1c, 0x21, 0xb0, 0x39, 0x1b, 0x12,
+	0xaf, 0xae, 0xf0, 0x19, 0xe8, 0x33, 0xf2, 0xa0, 0xa6, 0x1e, 0x8b, 0xa6, 0xb1, 0xb7, 0xda, 0xb4,
+	0xda, 0x4e, 0x30, 0xbe, 0xa2, 0x4f, 0x00, 0x52, 0x26, 0xc3, 0x1e, 0x19, 0x30, 0x4e, 0xbc, 0xb5,
+	0x2b, 0x3e, 0xa8, 0x9b, 0x32, 0xd9, 0xd5, 0x2e, 0x41, 0xbd, 0xc0, 0x90, 0xd6, 0x0f, 0x65, 0x58,
+	0x1d, 0x13, 0x2e, 0x50, 0x89, 0x9f, 0xe7, 0x95, 0x35, 0x8f, 0x57, 0x08, 0xec, 0x14, 0x27, 0x44,
+	0x93, 0xce, 0x0d, 0xf4, 0x79, 0x96, 0x35, 0xe5, 0xc5, 0xac, 0xb1, 0x6f, 0x96, 0x35, 0x73, 0xf0,
+	0x5b, 0xb9, 0x71, 0xfc, 0x4e, 0x01, 0x50, 0xbd, 0x16, 0x00, 0x5a, 0x3f, 0x95, 0x60, 0x6b, 0x6e,
+	0xd7, 0xfb, 0x4a, 0xe0, 0x98, 0xa0, 0x8f, 0x61, 0x73, 0xdc, 0x99, 0x42, 0x5c, 0x54, 0x4f, 0xbf,
+	0xce, 0x5d, 0x36, 0xcf, 0xfd, 0x28, 0x42, 0x14, 0xdc, 0x09, 0xd6, 0xbd, 0xd2, 0xf2, 0x4b, 0x30,
+	0x8d, 0x8e, 0x0e, 0x00, 0x86, 0x58, 0xc8, 0x90, 0x13, 0x41, 0xa4, 0x6e, 0xb6, 0x8b, 0x81, 0xe9,
+	0xa8, 0x64, 0x39, 0x38, 0x95, 0x5f, 0xa0, 0xdc, 0x66, 0xc0, 0x39, 0x6d, 0xc8, 0xad, 0xdf, 0x6d,
+	0x70, 0x0e, 0x09, 0xf9, 0x9c, 0xe3, 0x54, 0xa2, 0x7b, 0x70, 0x27, 0x56, 0x07, 0xc6, 0xc3, 0xb9,
+	0x50, 0xdd, 0x30, 0xda, 0x83, 0x19, 0xc4, 0xee, 0x41, 0x4d, 0xcb, 0x89, 0x01, 0xed, 0x82, 0x41,
+	0x30, 0x36, 0x7c, 0x9d, 0x10, 0x3d, 0x67, 0x0e, 0x54, 0x6e, 0x7e, 0x0e, 0xcc, 0x76, 0xc5, 0xea,
+	0x0b, 0x75, 0xc5, 0xda, 0xf5, 0xba, 0x62, 0xa1, 0x03, 0x3a, 0x8b, 0x3a, 0xa0, 0x7b, 0xfd, 0x0e,
+	0x58, 0x33, 0x00, 0x69, 0xfd, 0x59, 0x81, 0xc6, 0x97, 0x98, 0x4b, 0xda, 0xa7, 0x19, 0x4e, 0xe5,
+	0x0c, 0x79, 0x02, 0xd2, 0x67, 0x5c, 0xef, 0x19, 0xd9, 0xd4, 0xa2, 0xd0, 0x0f, 0x0b, 0xd2, 0xa3,
+	0x68, 0x16, 0x29, 0xa5, 0xc5, 0x48, 0x29, 0xbf, 0x74, 0xa4, 0xd8, 0xaf, 0x64, 0x63, 0xa8, 0xbc,
+	0xaa, 0x8d, 0xa1, 0xfa, 0x52, 0x36, 0x86, 0xb7, 0x61, 0xed, 0x3b, 0x2a, 0x4f, 0x54, 0x5e, 0x8d,
+	0x27, 0x0d, 0x70, 0x27, 0x58, 0x55, 0xc2, 0x43, 0x23, 0x7b, 0x86, 0x40, 0xce, 0x0b, 0x11, 0xc8,
+	0xbd, 0xde, 0x54, 0xf9, 0xd7, 0x82, 0xbb, 0x5f, 0x1f, 0x2f, 0x75, 0x9b, 0xfe, 0x08, 0xea, 0x23,
+	0x11, 0x5e, 0x79, 0xa1, 0x86, 0x91, 0x18, 0xa7, 0x47, 0xf7, 0xa1, 0xc6, 0x35, 0xa3, 0x84, 0x41,
+	0xe3, 0xbb, 0x7e, 0xf1, 0xbf, 0x88, 0xbf, 0x98, 0x86, 0x5d, 0x5b, 0x7d, 0xb1, 0x60, 0x1c, 0x62,
+	0x76, 0x4c, 0xd4, 0x33, 0xc2, 0x13, 0x2a, 0x04, 0x65, 0xa9, 0x68, 0xfd, 0x6a, 0xc1, 0xed, 0x42,
+	0xe3, 0x3f, 0xe4, 0x84, 0x9c, 0x5f, 0x79, 0xab, 0xf9, 0x00, 0xdc, 0x01, 0x67, 0xe7, 0x24, 0x0d,
+	0x7b, 0x67, 0xff, 0x3b, 0x25, 0x9c, 0xdc, 0xb4, 0x7b, 0x86, 0xee, 0x40, 0x95, 0x13, 0x2c, 0x58,
+	0x9a, 0x57, 0x24, 0x30, 0x37, 0xb4, 0x3f, 0x09, 0x87, 0xd5, 0x7c, 0xb8, 0xfa, 0x40, 0x34, 0xa1,
+	0xf7, 0x65, 0xb7, 0xfb, 0xf8, 0xa2, 0x61, 0x3d, 0xb9, 0x68, 0x58, 0x7f, 0x5f, 0x34, 0xac, 0x47,
+	0x97, 0x8d, 0x95, 0x27, 0x97, 0x8d, 0x95, 0x3f, 0x2e, 0x1b, 0x2b, 0xdf, 0x14, 0xb1, 0x9b, 0x57,
+	0x72, 0x67, 0x88, 0x7b, 0xc2, 0x9c, 0x3b, 0x0f, 0xd5, 0xbf, 0x3f, 0x8d, 0xe0, 0x5e, 0x55, 0xe7,
+	0x7a, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x86, 0x6e, 0xee, 0x17, 0x0e, 0x00, 0x00,
 }
 
 func (m *OperatorAuthorization) Marshal() (dAtA []byte, err error) {
@@ -633,24 +867,51 @@ func (m *OperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Period != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+	if m.NotBefore != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore):])
 		if err1 != nil {
 			return 0, err1
 		}
 		i -= n1
 		i = encodeVarintTypes(dAtA, i, uint64(n1))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x6a
 	}
-	if m.Expiration != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Period != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
 		if err2 != nil {
 			return 0, err2
 		}
 		i -= n2
 		i = encodeVarintTypes(dAtA, i, uint64(n2))
 		i--
+		dAtA[i] = 0x52
+	}
+	if m.Expiration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
 		dAtA[i] = 0x4a
 	}
 	if len(m.RemainingFeeSpend) > 0 {
@@ -738,6 +999,88 @@ func (m *OperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperatorRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTypes(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FeeSpendLimit) > 0 {
+		for iNdEx := len(m.FeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OperatorAuthorizationUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -758,12 +1101,12 @@ func (m *OperatorAuthorizationUsage) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReset):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReset):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.Remaining) > 0 {
@@ -808,23 +1151,43 @@ func (m *FeeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NotBefore != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Period != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
-		if err4 != nil {
-			return 0, err4
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n4
-		i = encodeVarintTypes(dAtA, i, uint64(n4))
+		i -= n7
+		i = encodeVarintTypes(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.Expiration != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err5 != nil {
-			return 0, err5
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n5
-		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i -= n8
+		i = encodeVarintTypes(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -901,22 +1264,22 @@ func (m *ParticipantAuthorizationRecord) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if m.Period != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
-		if err6 != nil {
-			return 0, err6
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n6
-		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i -= n9
+		i = encodeVarintTypes(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.Expiration != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err7 != nil {
-			return 0, err7
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n7
-		i = encodeVarintTypes(dAtA, i, uint64(n7))
+		i -= n10
+		i = encodeVarintTypes(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1057,6 +1420,56 @@ func (m *VSOperatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *CorporationFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorporationFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorporationFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FrozenAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FrozenAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FrozenBy) > 0 {
+		i -= len(m.FrozenBy)
+		copy(dAtA[i:], m.FrozenBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FrozenBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1122,6 +1535,55 @@ func (m *OperatorAuthorization) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pending {
+		n += 2
+	}
+	if m.NotBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.CorporationId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.FeeSpendLimit) > 0 {
+		for _, e := range m.FeeSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Period != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1184,6 +1646,13 @@ func (m *FeeGrant) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Pending {
+		n += 2
+	}
+	if m.NotBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1265,6 +1734,28 @@ func (m *VSOperatorAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *CorporationFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.CorporationId))
+	}
+	l = len(m.FrozenBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FrozenAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1450,31 +1941,360 @@ func (m *OperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingSpend = append(m.RemainingSpend, types.Coin{})
+			if err := m.RemainingSpend[len(m.RemainingSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSpendLimit = append(m.FeeSpendLimit, types.Coin{})
+			if err := m.FeeSpendLimit[len(m.FeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingFeeSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingFeeSpend = append(m.RemainingFeeSpend, types.Coin{})
+			if err := m.RemainingFeeSpend[len(m.RemainingFeeSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemainingSpend = append(m.RemainingSpend, types.Coin{})
-			if err := m.RemainingSpend[len(m.RemainingSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1484,29 +2304,27 @@ func (m *OperatorAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeSpendLimit = append(m.FeeSpendLimit, types.Coin{})
-			if err := m.FeeSpendLimit[len(m.FeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingFeeSpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1533,14 +2351,14 @@ func (m *OperatorAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemainingFeeSpend = append(m.RemainingFeeSpend, types.Coin{})
-			if err := m.RemainingFeeSpend[len(m.RemainingFeeSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1567,14 +2385,12 @@ func (m *OperatorAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeSpendLimit = append(m.FeeSpendLimit, types.Coin{})
+			if err := m.FeeSpendLimit[len(m.FeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
@@ -2019,6 +2835,62 @@ func (m *FeeGrant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2523,6 +3395,172 @@ func (m *VSOperatorAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CorporationFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CorporationFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CorporationFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FrozenAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
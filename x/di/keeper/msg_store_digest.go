@@ -109,3 +109,9 @@ func (k Keeper) StoreDigestModuleCall(ctx context.Context, authority, digest, di
 
 	return nil
 }
+
+// HasDigest reports whether digest was anchored in the digest store. Other
+// modules use it to require that evidence was stored before it is referenced.
+func (k Keeper) HasDigest(ctx context.Context, digest string) (bool, error) {
+	return k.Digests.Has(ctx, digest)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// checkBatch runs the single AUTHZ-CHECK of a batch and enforces the
+// max_batch_size param.
+func (ms msgServer) checkBatch(ctx sdk.Context, corporation, operator, msgTypeURL string, size int, now time.Time) error {
+	maxBatchSize := ms.GetParams(ctx).MaxBatchSize
+	if size == 0 {
+		return fmt.Errorf("batch must not be empty")
+	}
+	if uint64(size) > maxBatchSize {
+		return fmt.Errorf("batch size %d exceeds max_batch_size %d", size, maxBatchSize)
+	}
+
+	// [AUTHZ-CHECK] Verify operator authorization, once for the whole batch
+	if ms.delegationKeeper == nil {
+		return fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.delegationKeeper.CheckOperatorAuthorization(ctx, corporation, operator, msgTypeURL, now); err != nil {
+		return fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	return nil
+}
+
+// BatchRevokeParticipants handles the MsgBatchRevokeParticipants message.
+// Items are revoked in order; any failure aborts the whole batch.
+func (ms msgServer) BatchRevokeParticipants(goCtx context.Context, msg *types.MsgBatchRevokeParticipants) (*types.MsgBatchRevokeParticipantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	if err := ms.checkBatch(ctx, msg.Corporation, msg.Operator, "/verana.pp.v1.MsgBatchRevokeParticipants", len(msg.Items), now); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.MsgRevokeParticipantResponse, 0, len(msg.Items))
+	for i := range msg.Items {
+		item := msg.Items[i]
+		item.Corporation, item.Operator = msg.Corporation, msg.Operator
+		res, err := ms.runRevokeParticipant(ctx, &item, now)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		results = append(results, *res)
+	}
+
+	return &types.MsgBatchRevokeParticipantsResponse{Results: results}, nil
+}
+
+// BatchSetParticipantEffectiveUntil handles the
+// MsgBatchSetParticipantEffectiveUntil message. Items are applied in order;
+// any failure aborts the whole batch.
+func (ms msgServer) BatchSetParticipantEffectiveUntil(goCtx context.Context, msg *types.MsgBatchSetParticipantEffectiveUntil) (*types.MsgBatchSetParticipantEffectiveUntilResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	if err := ms.checkBatch(ctx, msg.Corporation, msg.Operator, "/verana.pp.v1.MsgBatchSetParticipantEffectiveUntil", len(msg.Items), now); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.MsgSetParticipantEffectiveUntilResponse, 0, len(msg.Items))
+	for i := range msg.Items {
+		item := msg.Items[i]
+		item.Corporation, item.Operator = msg.Corporation, msg.Operator
+		res, err := ms.runSetParticipantEffectiveUntil(ctx, &item, now)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		results = append(results, *res)
+	}
+
+	return &types.MsgBatchSetParticipantEffectiveUntilResponse{Results: results}, nil
+}
+
+// BatchSelfCreateParticipants handles the MsgBatchSelfCreateParticipants
+// message. Items are created in order; any failure aborts the whole batch.
+func (ms msgServer) BatchSelfCreateParticipants(goCtx context.Context, msg *types.MsgBatchSelfCreateParticipants) (*types.MsgBatchSelfCreateParticipantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	if err := ms.checkBatch(ctx, msg.Corporation, msg.Operator, "/verana.pp.v1.MsgBatchSelfCreateParticipants", len(msg.Items), now); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.MsgSelfCreateParticipantResponse, 0, len(msg.Items))
+	for i := range msg.Items {
+		item := msg.Items[i]
+		item.Corporation, item.Operator = msg.Corporation, msg.Operator
+		res, err := ms.runSelfCreateParticipant(ctx, &item, now)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		results = append(results, *res)
+	}
+
+	return &types.MsgBatchSelfCreateParticipantsResponse{Results: results}, nil
+}
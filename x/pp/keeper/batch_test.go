@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/verana-labs/verana/x/cs/types"
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+func TestBatchParticipantOperations(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx, delKeeper := setupMsgServerWithDelegation(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	now := sdkCtx.BlockTime()
+	past := now.Add(-1 * time.Hour)
+	future := now.Add(1 * time.Hour)
+	farFuture := now.Add(360 * 24 * time.Hour)
+
+	params := k.GetParams(sdkCtx)
+	params.MaxBatchSize = 3
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	corp := sdk.AccAddress([]byte("batch_corp__________")).String()
+	ecoDID := "did:example:batch-ecosystem"
+	trID := trkKeeper.CreateMockEcosystem(corp, ecoDID)
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_OPEN,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_OPEN)
+	validatorID, err := k.CreateParticipant(sdkCtx, types.Participant{
+		SchemaId: 1, Role: types.ParticipantRole_ECOSYSTEM, Did: ecoDID,
+		CorporationId: trkKeeper.RegisterCorp(corp), Created: &now, Modified: &now,
+		OpState: types.OnboardingState_VALIDATED, EffectiveFrom: &past,
+	})
+	require.NoError(t, err)
+
+	// An ISSUER and a VERIFIER, same-role windows of a corporation would overlap
+	selfCreate := func(role types.ParticipantRole, did string) types.MsgSelfCreateParticipant {
+		return types.MsgSelfCreateParticipant{
+			Role: role, ValidatorParticipantId: validatorID, Did: did,
+			EffectiveFrom: &future, EffectiveUntil: &farFuture,
+		}
+	}
+
+	t.Run("batch size is capped", func(t *testing.T) {
+		_, err := ms.BatchSelfCreateParticipants(sdkCtx, &types.MsgBatchSelfCreateParticipants{
+			Corporation: corp, Operator: corp,
+			Items: []types.MsgSelfCreateParticipant{
+				selfCreate(types.ParticipantRole_ISSUER, "did:example:batch-1"), selfCreate(types.ParticipantRole_VERIFIER, "did:example:batch-2"),
+				selfCreate(types.ParticipantRole_ISSUER, "did:example:batch-3"), selfCreate(types.ParticipantRole_VERIFIER, "did:example:batch-4"),
+			},
+		})
+		require.ErrorContains(t, err, "exceeds max_batch_size 3")
+	})
+
+	delKeeper.Reset()
+	res, err := ms.BatchSelfCreateParticipants(sdkCtx, &types.MsgBatchSelfCreateParticipants{
+		Corporation: corp, Operator: corp,
+		Items: []types.MsgSelfCreateParticipant{selfCreate(types.ParticipantRole_ISSUER, "did:example:batch-1"), selfCreate(types.ParticipantRole_VERIFIER, "did:example:batch-2")},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+	require.Equal(t, []string{"/verana.pp.v1.MsgBatchSelfCreateParticipants"}, delKeeper.AuthzCheckCalls)
+	ids := []uint64{res.Results[0].Id, res.Results[1].Id}
+	for i, id := range ids {
+		p, err := k.GetParticipantByID(sdkCtx, id)
+		require.NoError(t, err)
+		require.Equal(t, future, *p.EffectiveFrom)
+		require.Equal(t, []string{"did:example:batch-1", "did:example:batch-2"}[i], p.Did)
+	}
+
+	activeCtx := sdkCtx.WithBlockTime(future.Add(time.Hour))
+	until := future.Add(30 * 24 * time.Hour)
+
+	delKeeper.Reset()
+	_, err = ms.BatchSetParticipantEffectiveUntil(activeCtx, &types.MsgBatchSetParticipantEffectiveUntil{
+		Corporation: corp, Operator: corp,
+		Items: []types.MsgSetParticipantEffectiveUntil{
+			{Id: ids[0], EffectiveUntil: &until},
+			{Id: ids[1], EffectiveUntil: &until},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/verana.pp.v1.MsgBatchSetParticipantEffectiveUntil"}, delKeeper.AuthzCheckCalls)
+	for _, id := range ids {
+		p, err := k.GetParticipantByID(sdkCtx, id)
+		require.NoError(t, err)
+		require.Equal(t, until, *p.EffectiveUntil)
+	}
+
+	t.Run("a failing item aborts the batch", func(t *testing.T) {
+		// The tx state is discarded on error, as the cache context here
+		cacheCtx, _ := activeCtx.CacheContext()
+		_, err := ms.BatchRevokeParticipants(cacheCtx, &types.MsgBatchRevokeParticipants{
+			Corporation: corp, Operator: corp,
+			Items: []types.MsgRevokeParticipant{{Id: ids[0]}, {Id: 9999}},
+		})
+		require.ErrorContains(t, err, "item 1: participant not found")
+	})
+
+	delKeeper.Reset()
+	revokeRes, err := ms.BatchRevokeParticipants(activeCtx, &types.MsgBatchRevokeParticipants{
+		Corporation: corp, Operator: corp,
+		Items: []types.MsgRevokeParticipant{
+			{Id: ids[0], Reason: types.RevocationReason_REVOCATION_REASON_ECOSYSTEM_DECISION},
+			{Id: ids[1], Reason: types.RevocationReason_REVOCATION_REASON_ECOSYSTEM_DECISION},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, revokeRes.Results, 2)
+	require.Equal(t, []string{"/verana.pp.v1.MsgBatchRevokeParticipants"}, delKeeper.AuthzCheckCalls)
+	for _, id := range ids {
+		p, err := k.GetParticipantByID(sdkCtx, id)
+		require.NoError(t, err)
+		require.NotNil(t, p.Revoked)
+		require.Equal(t, types.RevocationReason_REVOCATION_REASON_ECOSYSTEM_DECISION, p.RevocationReason)
+	}
+}
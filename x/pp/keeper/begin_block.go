@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker applies the participant fee changes that reach their effective
+// time, so that the sessions of the block are charged the new fees, and emits
+// the participant expiry notices that are due. Failures are logged and never
+// halt the chain.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.applyScheduledFeeChanges(sdkCtx, sdkCtx.BlockTime(), MaxAppliedFeeChangesPerBlock); err != nil {
+		k.Logger().Error("failed to apply scheduled fee changes", "error", err)
+	}
+
+	if err := k.emitExpiryNotices(sdkCtx, sdkCtx.BlockTime(), MaxExpiryNoticesPerBlock); err != nil {
+		k.Logger().Error("failed to emit expiry notices", "error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// MaxCascadedParticipantsPerBlock bounds the number of descendants visited
+// by revocation cascades in a single EndBlocker run, so that a large
+// validator tree is processed over several blocks instead of one.
+const MaxCascadedParticipantsPerBlock = 100
+
+// startParticipantCascade records a cascade of mode over the descendants of
+// root and returns its id. The descendants are visited by the EndBlocker.
+func (k Keeper) startParticipantCascade(ctx sdk.Context, root types.Participant, mode types.CascadeMode, reason types.RevocationReason, initiatorCorpID uint64, now time.Time) (uint64, error) {
+	id, err := k.getNextParticipantCascadeID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	children, err := k.validatedParticipantIDs(ctx, root.Id)
+	if err != nil {
+		return 0, err
+	}
+
+	cascade := types.ParticipantCascade{
+		Id:                     id,
+		RootParticipantId:      root.Id,
+		Mode:                   mode,
+		Reason:                 reason,
+		InitiatorCorporationId: initiatorCorpID,
+		Started:                &now,
+		Pending:                children,
+	}
+
+	// Nothing to propagate to, the cascade is done right away
+	if len(children) == 0 {
+		cascade.Completed = &now
+	} else if err := k.PendingParticipantCascade.Set(ctx, id); err != nil {
+		return 0, err
+	}
+
+	if err := k.ParticipantCascade.Set(ctx, id, cascade); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// getNextParticipantCascadeID gets the next available cascade ID
+func (k Keeper) getNextParticipantCascadeID(ctx sdk.Context) (uint64, error) {
+	id, err := k.ParticipantCascadeCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	nextID := id + 1
+	if err := k.ParticipantCascadeCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set participant cascade counter: %w", err)
+	}
+
+	return nextID, nil
+}
+
+// validatedParticipantIDs returns the ids of the participants directly
+// validated by validatorID, in ascending order.
+func (k Keeper) validatedParticipantIDs(ctx sdk.Context, validatorID uint64) ([]uint64, error) {
+	var ids []uint64
+	rng := collections.NewPrefixedPairRange[uint64, uint64](validatorID)
+	err := k.ParticipantByValidator.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	return ids, err
+}
+
+// processParticipantCascades visits up to limit pending descendants, oldest
+// cascade first. Each visited descendant is revoked or suspended according
+// to the cascade mode, in its own cache context, and its own descendants are
+// queued. A descendant that cannot be revoked or suspended is logged,
+// reported by a cascade_participant_failed event and skipped, so that it does
+// not halt the chain nor block the cascade.
+func (k Keeper) processParticipantCascades(ctx sdk.Context, now time.Time, limit int) error {
+	// Collect first, the index cannot be mutated while it is iterated
+	var ids []uint64
+	if err := k.PendingParticipantCascade.Walk(ctx, nil, func(id uint64) (bool, error) {
+		ids = append(ids, id)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if limit <= 0 {
+			return nil
+		}
+
+		cascade, err := k.ParticipantCascade.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for len(cascade.Pending) > 0 && limit > 0 {
+			participantID := cascade.Pending[0]
+			cascade.Pending = cascade.Pending[1:]
+			limit--
+
+			children, err := k.validatedParticipantIDs(ctx, participantID)
+			if err != nil {
+				return err
+			}
+			cascade.Pending = append(cascade.Pending, children...)
+			cascade.Visited++
+
+			cacheCtx, write := ctx.CacheContext()
+			affected, err := k.cascadeToParticipant(cacheCtx, cascade, participantID, now)
+			if err != nil {
+				k.Logger().Error("failed to cascade to participant", "cascade_id", cascade.Id, "participant_id", participantID, "error", err)
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeCascadeParticipantFailed,
+						sdk.NewAttribute(types.AttributeKeyCascadeID, strconv.FormatUint(cascade.Id, 10)),
+						sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(participantID, 10)),
+						sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+						sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+					),
+				)
+				continue
+			}
+			write()
+			if !affected {
+				continue
+			}
+			cascade.Affected++
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCascadeParticipant,
+					sdk.NewAttribute(types.AttributeKeyCascadeID, strconv.FormatUint(cascade.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyRootParticipantID, strconv.FormatUint(cascade.RootParticipantId, 10)),
+					sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(participantID, 10)),
+					sdk.NewAttribute(types.AttributeKeyCascadeMode, cascade.Mode.String()),
+					sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+				),
+			)
+		}
+
+		if len(cascade.Pending) == 0 {
+			cascade.Completed = &now
+			if err := k.PendingParticipantCascade.Remove(ctx, id); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCompleteCascade,
+					sdk.NewAttribute(types.AttributeKeyCascadeID, strconv.FormatUint(cascade.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyRootParticipantID, strconv.FormatUint(cascade.RootParticipantId, 10)),
+					sdk.NewAttribute(types.AttributeKeyAffectedCount, strconv.FormatUint(cascade.Affected, 10)),
+					sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+				),
+			)
+		}
+
+		if err := k.ParticipantCascade.Set(ctx, id, cascade); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cascadeToParticipant revokes or suspends the participant id. It returns
+// false if the participant was already revoked, or suspended for a suspend
+// cascade, and was left untouched.
+func (k Keeper) cascadeToParticipant(ctx sdk.Context, cascade types.ParticipantCascade, id uint64, now time.Time) (bool, error) {
+	participant, err := k.Participant.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if participant.Revoked != nil {
+		return false, nil
+	}
+
+	reason := fmt.Sprintf("cascade %d from participant %d", cascade.Id, cascade.RootParticipantId)
+
+	switch cascade.Mode {
+	case types.CascadeMode_CASCADE_MODE_REVOKE:
+		if err := k.revokeParticipant(ctx, participant, cascade.Reason, reason, "", now); err != nil {
+			return false, err
+		}
+
+		// If participant.type is ISSUER or VERIFIER:
+		// Delete authorization for participant.vs_operator
+		if participant.Role == types.ParticipantRole_ISSUER || participant.Role == types.ParticipantRole_VERIFIER {
+			if k.delegationKeeper == nil {
+				return false, fmt.Errorf("delegation keeper is required for VS operator authorization")
+			}
+			if err := k.delegationKeeper.RevokeVSOperatorAuthorization(ctx, participant.Id); err != nil {
+				return false, fmt.Errorf("failed to revoke VS operator authorization: %w", err)
+			}
+		}
+	case types.CascadeMode_CASCADE_MODE_SUSPEND:
+		if participant.Suspended != nil {
+			return false, nil
+		}
+		suspendParticipant(&participant, reason, cascade.InitiatorCorporationId, now)
+		if err := k.UpdateParticipant(ctx, participant); err != nil {
+			return false, err
+		}
+	default:
+		return false, fmt.Errorf("invalid cascade mode: %s", cascade.Mode)
+	}
+
+	return true, nil
+}
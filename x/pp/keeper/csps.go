@@ -183,36 +183,78 @@ func (ms msgServer) validateCreateOrUpdateParticipantSessionPreconditions(ctx sd
 	return nil
 }
 
-// [MOD-PP-MSG-10-3] Create or Update Participant Session fee checks
-func (ms msgServer) validateCreateOrUpdateParticipantSessionFees(ctx sdk.Context, msg *types.MsgCreateOrUpdateParticipantSession) ([]types.Participant, uint64, uint64, error) {
+// beneficiaryFeeShare is the part of the session fees owed to one beneficiary.
+type beneficiaryFeeShare struct {
+	participant types.Participant
+	// fees in trust units, after the executor discount
+	fees uint64
+	// fees converted to the native denom, split between the beneficiary
+	// account and its trust deposit
+	feesInDenom  uint64
+	toAccount    uint64
+	trustDeposit uint64
+}
+
+// agentRewardShare is an agent reward split between the agent account and its
+// trust deposit.
+type agentRewardShare struct {
+	toAccount    uint64
+	trustDeposit uint64
+}
+
+// sessionFees is the itemized outcome of the [MOD-PP-MSG-10-3] fee computation.
+// It is shared by the session execution and the EstimateSessionFees query so
+// that estimates always match what is actually charged.
+type sessionFees struct {
+	payer             types.Participant
+	executorDiscount  uint64
+	beneficiaries     []beneficiaryFeeShare
+	userAgentReward   agentRewardShare
+	walletAgentReward agentRewardShare
+	// beneficiaryFees is the sum of the discounted beneficiary fees in trust units
+	beneficiaryFees uint64
+	// trustFees is the balance the payer must hold, in the native denom
+	trustFees uint64
+}
+
+// calculateSessionFees computes the session fees for the given issuer and/or
+// verifier participant. It only reads state.
+func (ms msgServer) calculateSessionFees(ctx sdk.Context, issuerParticipantId, verifierParticipantId uint64) (sessionFees, error) {
+	var result sessionFees
+
 	// use "Find Beneficiaries" query method to get the set of beneficiary participant found_participant_set
-	foundParticipantSet, err := ms.findBeneficiaries(ctx, msg.IssuerParticipantId, msg.VerifierParticipantId)
+	foundParticipantSet, err := ms.findBeneficiaries(ctx, issuerParticipantId, verifierParticipantId)
 	if err != nil {
-		return nil, 0, 0, fmt.Errorf("failed to find beneficiaries: %w", err)
+		return result, fmt.Errorf("failed to find beneficiaries: %w", err)
 	}
 
-	// calculate the required beneficiary fees
-	// Apply discounts from executor participant (issuer or verifier)
-	beneficiaryFees := uint64(0)
-	isVerification := msg.VerifierParticipantId != 0
-	const discountScale = 10000 // 10000 = 1.0 = 100% discount
-
-	// Get executor participant's discount
-	var executorDiscount uint64
+	// Get executor (payer) participant's discount
+	isVerification := verifierParticipantId != 0
 	if isVerification {
-		executorParticipant, err := ms.Participant.Get(ctx, msg.VerifierParticipantId)
+		result.payer, err = ms.Participant.Get(ctx, verifierParticipantId)
 		if err != nil {
-			return nil, 0, 0, fmt.Errorf("failed to get verifier participant: %w", err)
+			return result, fmt.Errorf("failed to get verifier participant: %w", err)
 		}
-		executorDiscount = executorParticipant.VerificationFeeDiscount
+		result.executorDiscount = result.payer.VerificationFeeDiscount
 	} else {
-		executorParticipant, err := ms.Participant.Get(ctx, msg.IssuerParticipantId)
+		result.payer, err = ms.Participant.Get(ctx, issuerParticipantId)
 		if err != nil {
-			return nil, 0, 0, fmt.Errorf("failed to get issuer participant: %w", err)
+			return result, fmt.Errorf("failed to get issuer participant: %w", err)
 		}
-		executorDiscount = executorParticipant.IssuanceFeeDiscount
+		result.executorDiscount = result.payer.IssuanceFeeDiscount
 	}
 
+	// Get global variables for calculations
+	userAgentRewardRate := ms.trustDeposit.GetUserAgentRewardRate(ctx)
+	walletUserAgentRewardRate := ms.trustDeposit.GetWalletUserAgentRewardRate(ctx)
+	trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
+	trustUnitPrice := ms.ecosystemKeeper.GetTrustUnitPrice(ctx)
+
+	// Initialize agent reward accumulators
+	accumulatedUserAgentReward := math.LegacyZeroDec()
+	accumulatedWalletAgentReward := math.LegacyZeroDec()
+
+	const discountScale = 10000 // 10000 = 1.0 = 100% discount
 	for _, participant := range foundParticipantSet {
 		var fees uint64
 		if isVerification {
@@ -222,18 +264,50 @@ func (ms msgServer) validateCreateOrUpdateParticipantSessionFees(ctx sdk.Context
 		}
 
 		// Apply executor's discount: beneficiary_fee = participant.fee * (1 - discount/10000)
-		if executorDiscount > 0 {
-			fees = (fees * (discountScale - executorDiscount)) / discountScale
+		if result.executorDiscount > 0 {
+			fees = (fees * (discountScale - result.executorDiscount)) / discountScale
 		}
 
-		beneficiaryFees += fees
+		share := beneficiaryFeeShare{participant: participant, fees: fees}
+		if fees > 0 {
+			// Calculate fee_in_native_denom (using trust unit price for now - Case B: TU pricing).
+			//
+			// Safety: compute fees * trustUnitPrice via math.Int (arbitrary precision)
+			// to avoid uint64*uint64 overflow, then lift into LegacyDec for the rate math.
+			feeInNativeDenom := math.LegacyNewDecFromInt(
+				math.NewIntFromUint64(fees).Mul(math.NewIntFromUint64(trustUnitPrice)),
+			)
+
+			// Calculate trust deposit and direct account amounts
+			payerTrustDepositInt := feeInNativeDenom.Mul(trustDepositRate).TruncateInt()
+			if !payerTrustDepositInt.IsUint64() {
+				return result, fmt.Errorf("payer trust deposit overflows uint64: %s", payerTrustDepositInt.String())
+			}
+			feeNativeInt := feeInNativeDenom.TruncateInt()
+			if !feeNativeInt.IsUint64() {
+				return result, fmt.Errorf("fee in native denom overflows uint64: %s", feeNativeInt.String())
+			}
+			share.feesInDenom = feeNativeInt.Uint64()
+			share.trustDeposit = payerTrustDepositInt.Uint64()
+			share.toAccount = share.feesInDenom - share.trustDeposit
+
+			// Accumulate agent rewards
+			accumulatedUserAgentReward = accumulatedUserAgentReward.Add(feeInNativeDenom.Mul(userAgentRewardRate))
+			accumulatedWalletAgentReward = accumulatedWalletAgentReward.Add(feeInNativeDenom.Mul(walletUserAgentRewardRate))
+		}
+
+		result.beneficiaries = append(result.beneficiaries, share)
+		result.beneficiaryFees += fees
 	}
 
-	// Get global variables for calculations
-	userAgentRewardRate := ms.trustDeposit.GetUserAgentRewardRate(ctx)
-	walletUserAgentRewardRate := ms.trustDeposit.GetWalletUserAgentRewardRate(ctx)
-	trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
-	trustUnitPrice := ms.ecosystemKeeper.GetTrustUnitPrice(ctx)
+	result.userAgentReward, err = splitAgentReward(accumulatedUserAgentReward, trustDepositRate, "agent")
+	if err != nil {
+		return result, err
+	}
+	result.walletAgentReward, err = splitAgentReward(accumulatedWalletAgentReward, trustDepositRate, "wallet agent")
+	if err != nil {
+		return result, err
+	}
 
 	// Calculate trust_fees = beneficiary_fees * (1 + user_agent_reward_rate + wallet_user_agent_reward_rate + trust_deposit_rate) * trust_unit_price
 	//
@@ -243,292 +317,146 @@ func (ms msgServer) validateCreateOrUpdateParticipantSessionFees(ctx sdk.Context
 	// math.NewIntFromUint64, multiply through LegacyDec, then bounds-check
 	// before narrowing back to uint64/int64.
 	multiplier := math.LegacyOneDec().Add(userAgentRewardRate).Add(walletUserAgentRewardRate).Add(trustDepositRate)
-	trustFeesDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(beneficiaryFees)).
+	trustFeesDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(result.beneficiaryFees)).
 		Mul(multiplier).
 		Mul(math.LegacyNewDecFromInt(math.NewIntFromUint64(trustUnitPrice)))
 	trustFeesInt := trustFeesDec.TruncateInt()
 	if !trustFeesInt.IsUint64() {
-		return nil, 0, 0, fmt.Errorf("trust fees overflow uint64: %s", trustFeesInt.String())
+		return result, fmt.Errorf("trust fees overflow uint64: %s", trustFeesInt.String())
+	}
+	result.trustFees = trustFeesInt.Uint64()
+
+	return result, nil
+}
+
+// splitAgentReward splits an accumulated agent reward between the agent
+// account and its trust deposit.
+func splitAgentReward(reward math.LegacyDec, trustDepositRate math.LegacyDec, name string) (agentRewardShare, error) {
+	if !reward.IsPositive() {
+		return agentRewardShare{}, nil
+	}
+	trustDepositInt := reward.Mul(trustDepositRate).TruncateInt()
+	if !trustDepositInt.IsUint64() {
+		return agentRewardShare{}, fmt.Errorf("%s trust deposit overflows uint64: %s", name, trustDepositInt.String())
+	}
+	accumInt := reward.TruncateInt()
+	if !accumInt.IsUint64() {
+		return agentRewardShare{}, fmt.Errorf("%s accumulated reward overflows uint64: %s", name, accumInt.String())
+	}
+	return agentRewardShare{
+		toAccount:    accumInt.Uint64() - trustDepositInt.Uint64(),
+		trustDeposit: trustDepositInt.Uint64(),
+	}, nil
+}
+
+// [MOD-PP-MSG-10-3] Create or Update Participant Session fee checks
+func (ms msgServer) validateCreateOrUpdateParticipantSessionFees(ctx sdk.Context, msg *types.MsgCreateOrUpdateParticipantSession) (sessionFees, error) {
+	fees, err := ms.calculateSessionFees(ctx, msg.IssuerParticipantId, msg.VerifierParticipantId)
+	if err != nil {
+		return fees, err
 	}
-	trustFees := trustFeesInt.Uint64()
 
 	// authority account MUST have sufficient available balance
 	authorityAddr, err := sdk.AccAddressFromBech32(msg.Corporation)
 	if err != nil {
-		return nil, 0, 0, fmt.Errorf("invalid authority address: %w", err)
+		return fees, fmt.Errorf("invalid authority address: %w", err)
 	}
 
-	trustFeesI64, err := uint64ToInt64(trustFees, "trust_fees")
+	trustFeesI64, err := uint64ToInt64(fees.trustFees, "trust_fees")
 	if err != nil {
-		return nil, 0, 0, err
+		return fees, err
 	}
 	requiredAmount := sdk.NewInt64Coin(types.BondDenom, trustFeesI64)
 	if !ms.bankKeeper.HasBalance(ctx, authorityAddr, requiredAmount) {
-		return nil, 0, 0, fmt.Errorf("insufficient funds: required %s", requiredAmount)
+		return fees, fmt.Errorf("insufficient funds: required %s", requiredAmount)
 	}
 
-	return foundParticipantSet, beneficiaryFees, trustFees, nil
+	return fees, nil
 }
 
 // [MOD-PP-MSG-10-4] Create or Update Participant Session execution
-func (ms msgServer) executeCreateOrUpdateParticipantSession(ctx sdk.Context, msg *types.MsgCreateOrUpdateParticipantSession, foundParticipantSet []types.Participant, beneficiaryFees, trustFees uint64, now time.Time) error {
-	isVerification := msg.VerifierParticipantId != 0
-	trustUnitPrice := ms.ecosystemKeeper.GetTrustUnitPrice(ctx)
-	trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
-	userAgentRewardRate := ms.trustDeposit.GetUserAgentRewardRate(ctx)
-	walletUserAgentRewardRate := ms.trustDeposit.GetWalletUserAgentRewardRate(ctx)
-
+func (ms msgServer) executeCreateOrUpdateParticipantSession(ctx sdk.Context, msg *types.MsgCreateOrUpdateParticipantSession, fees sessionFees, now time.Time) error {
 	authorityAddr, err := sdk.AccAddressFromBech32(msg.Corporation)
 	if err != nil {
 		return fmt.Errorf("invalid authority address: %w", err)
 	}
 
-	// Get payer participant for deposit updates
-	var payerParticipant types.Participant
-	if isVerification {
-		payerParticipant, err = ms.Participant.Get(ctx, msg.VerifierParticipantId)
-	} else {
-		payerParticipant, err = ms.Participant.Get(ctx, msg.IssuerParticipantId)
-	}
-	if err != nil {
-		return fmt.Errorf("failed to get payer participant: %w", err)
-	}
-
-	// Initialize agent reward accumulators
-	accumulatedUserAgentReward := math.LegacyZeroDec()
-	accumulatedWalletAgentReward := math.LegacyZeroDec()
-
-	// Get executor's discount
-	var executorDiscount uint64
-	if isVerification {
-		executorDiscount = payerParticipant.VerificationFeeDiscount
-	} else {
-		executorDiscount = payerParticipant.IssuanceFeeDiscount
-	}
+	// Payer participant for deposit updates
+	payerParticipant := fees.payer
 
 	// Step 1: Process fee distribution to each beneficiary
-	const discountScale = 10000
-	for _, participant := range foundParticipantSet {
-		var fees uint64
-		if isVerification {
-			fees = participant.VerificationFees
-		} else {
-			fees = participant.IssuanceFees
+	for _, share := range fees.beneficiaries {
+		if share.fees == 0 {
+			continue
 		}
-
-		if fees > 0 {
-			participantCorpAcct, err := ms.corpAccountFromID(ctx, participant.CorporationId)
-			if err != nil {
-				return err
-			}
-			// Apply executor's discount: beneficiary_fee = participant.fee * (1 - discount/10000)
-			if executorDiscount > 0 {
-				fees = (fees * (discountScale - executorDiscount)) / discountScale
-			}
-
-			// Calculate fee_in_native_denom (using trust unit price for now - Case B: TU pricing).
-			//
-			// Safety: compute fees * trustUnitPrice via math.Int (arbitrary precision)
-			// to avoid uint64*uint64 overflow, then lift into LegacyDec for the rate math.
-			feeInNativeDenom := math.LegacyNewDecFromInt(
-				math.NewIntFromUint64(fees).Mul(math.NewIntFromUint64(trustUnitPrice)),
-			)
-
-			// Calculate trust deposit and direct account amounts
-			payerTrustDepositInt := feeInNativeDenom.Mul(trustDepositRate).TruncateInt()
-			if !payerTrustDepositInt.IsUint64() {
-				return fmt.Errorf("payer trust deposit overflows uint64: %s", payerTrustDepositInt.String())
-			}
-			payerTrustDeposit := payerTrustDepositInt.Uint64()
-			feeNativeInt := feeInNativeDenom.TruncateInt()
-			if !feeNativeInt.IsUint64() {
-				return fmt.Errorf("fee in native denom overflows uint64: %s", feeNativeInt.String())
-			}
-			payeeFeesToAccount := feeNativeInt.Uint64() - payerTrustDeposit
-
-			// Accumulate agent rewards
-			accumulatedUserAgentReward = accumulatedUserAgentReward.Add(feeInNativeDenom.Mul(userAgentRewardRate))
-			accumulatedWalletAgentReward = accumulatedWalletAgentReward.Add(feeInNativeDenom.Mul(walletUserAgentRewardRate))
-
-			// Transfer payee_fees_to_account to participant.authority
-			if payeeFeesToAccount > 0 {
-				granteeAddr, err := sdk.AccAddressFromBech32(participantCorpAcct)
-				if err != nil {
-					return fmt.Errorf("invalid grantee address: %w", err)
-				}
-
-				payeeFeesI64, err := uint64ToInt64(payeeFeesToAccount, "payee_fees_to_account")
-				if err != nil {
-					return err
-				}
-				err = ms.bankKeeper.SendCoins(
-					ctx,
-					authorityAddr,
-					granteeAddr,
-					sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, payeeFeesI64)),
-				)
-				if err != nil {
-					return fmt.Errorf("failed to transfer direct fees: %w", err)
-				}
-			}
-
-			// Increase trust deposit of participant.authority (payee) and participant.deposit
-			if payerTrustDeposit > 0 {
-				payerTDI64, err := uint64ToInt64(payerTrustDeposit, "payer_trust_deposit")
-				if err != nil {
-					return err
-				}
-				// Increase beneficiary's TD funded by payer (transfers from payer to TD module directly)
-				err = ms.trustDeposit.AdjustTrustDepositOnBehalf(ctx, participantCorpAcct, authorityAddr, payerTDI64)
-				if err != nil {
-					return fmt.Errorf("failed to adjust grantee trust deposit: %w", err)
-				}
-
-				// Increase participant.deposit
-				participant.Deposit += payerTrustDeposit
-				if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
-					return fmt.Errorf("failed to update grantee participant deposit: %w", err)
-				}
-
-				// Increase payer's own TD (standard self-funded adjustment)
-				err = ms.trustDeposit.AdjustTrustDeposit(ctx, msg.Corporation, payerTDI64, "csps_payer_trust_deposit")
-				if err != nil {
-					return fmt.Errorf("failed to adjust payer trust deposit: %w", err)
-				}
-
-				payerParticipant.Deposit += payerTrustDeposit
-				if err := ms.Keeper.UpdateParticipant(ctx, payerParticipant); err != nil {
-					return fmt.Errorf("failed to update payer participant deposit: %w", err)
-				}
-			}
-		}
-	}
-
-	// Step 2: Process agent rewards
-	// User Agent Reward
-	if accumulatedUserAgentReward.IsPositive() {
-		agentParticipant, err := ms.Participant.Get(ctx, msg.AgentParticipantId)
-		if err != nil {
-			return fmt.Errorf("failed to get agent participant: %w", err)
-		}
-
-		agentTrustDepositInt := accumulatedUserAgentReward.Mul(trustDepositRate).TruncateInt()
-		if !agentTrustDepositInt.IsUint64() {
-			return fmt.Errorf("agent trust deposit overflows uint64: %s", agentTrustDepositInt.String())
-		}
-		agentTrustDeposit := agentTrustDepositInt.Uint64()
-		agentAccumInt := accumulatedUserAgentReward.TruncateInt()
-		if !agentAccumInt.IsUint64() {
-			return fmt.Errorf("agent accumulated reward overflows uint64: %s", agentAccumInt.String())
-		}
-		agentFeesToAccount := agentAccumInt.Uint64() - agentTrustDeposit
-
-		// Transfer direct amount to agent_participant.authority
-		agentCorpAcct, err := ms.corpAccountFromID(ctx, agentParticipant.CorporationId)
+		participant := share.participant
+		participantCorpAcct, err := ms.corpAccountFromID(ctx, participant.CorporationId)
 		if err != nil {
 			return err
 		}
-		if agentFeesToAccount > 0 {
-			agentGranteeAddr, err := sdk.AccAddressFromBech32(agentCorpAcct)
+
+		// Transfer payee_fees_to_account to participant.authority
+		if share.toAccount > 0 {
+			granteeAddr, err := sdk.AccAddressFromBech32(participantCorpAcct)
 			if err != nil {
-				return fmt.Errorf("invalid agent grantee address: %w", err)
+				return fmt.Errorf("invalid grantee address: %w", err)
 			}
 
-			agentFeesI64, err := uint64ToInt64(agentFeesToAccount, "agent_fees_to_account")
+			payeeFeesI64, err := uint64ToInt64(share.toAccount, "payee_fees_to_account")
 			if err != nil {
 				return err
 			}
 			err = ms.bankKeeper.SendCoins(
 				ctx,
 				authorityAddr,
-				agentGranteeAddr,
-				sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, agentFeesI64)),
+				granteeAddr,
+				sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, payeeFeesI64)),
 			)
 			if err != nil {
-				return fmt.Errorf("failed to transfer user agent reward: %w", err)
+				return fmt.Errorf("failed to transfer direct fees: %w", err)
 			}
 		}
 
-		// Increase trust deposit of agent_participant.authority and agent_participant.deposit
-		if agentTrustDeposit > 0 {
-			agentTDI64, err := uint64ToInt64(agentTrustDeposit, "agent_trust_deposit")
+		// Increase trust deposit of participant.authority (payee) and participant.deposit
+		if share.trustDeposit > 0 {
+			payerTDI64, err := uint64ToInt64(share.trustDeposit, "payer_trust_deposit")
 			if err != nil {
 				return err
 			}
-			// Increase agent's TD funded by payer (transfers from payer to TD module directly)
-			err = ms.trustDeposit.AdjustTrustDepositOnBehalf(ctx, agentCorpAcct, authorityAddr, agentTDI64)
+			// Increase beneficiary's TD funded by payer (transfers from payer to TD module directly)
+			err = ms.trustDeposit.AdjustTrustDepositOnBehalf(ctx, participant.CorporationId, authorityAddr, payerTDI64)
 			if err != nil {
-				return fmt.Errorf("failed to adjust agent trust deposit: %w", err)
+				return fmt.Errorf("failed to adjust grantee trust deposit: %w", err)
 			}
 
-			agentParticipant.Deposit += agentTrustDeposit
-			if err := ms.Keeper.UpdateParticipant(ctx, agentParticipant); err != nil {
-				return fmt.Errorf("failed to update agent participant deposit: %w", err)
+			// Increase participant.deposit
+			participant.Deposit += share.trustDeposit
+			if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+				return fmt.Errorf("failed to update grantee participant deposit: %w", err)
 			}
-		}
-	}
-
-	// Wallet Agent Reward
-	if accumulatedWalletAgentReward.IsPositive() {
-		walletAgentParticipant, err := ms.Participant.Get(ctx, msg.WalletAgentParticipantId)
-		if err != nil {
-			return fmt.Errorf("failed to get wallet agent participant: %w", err)
-		}
 
-		walletAgentTDInt := accumulatedWalletAgentReward.Mul(trustDepositRate).TruncateInt()
-		if !walletAgentTDInt.IsUint64() {
-			return fmt.Errorf("wallet agent trust deposit overflows uint64: %s", walletAgentTDInt.String())
-		}
-		walletAgentTrustDeposit := walletAgentTDInt.Uint64()
-		walletAccumInt := accumulatedWalletAgentReward.TruncateInt()
-		if !walletAccumInt.IsUint64() {
-			return fmt.Errorf("wallet agent accumulated reward overflows uint64: %s", walletAccumInt.String())
-		}
-		walletAgentFeesToAccount := walletAccumInt.Uint64() - walletAgentTrustDeposit
-
-		// Transfer direct amount to wallet_agent_participant.authority
-		walletAgentCorpAcct, err := ms.corpAccountFromID(ctx, walletAgentParticipant.CorporationId)
-		if err != nil {
-			return err
-		}
-		if walletAgentFeesToAccount > 0 {
-			walletAgentGranteeAddr, err := sdk.AccAddressFromBech32(walletAgentCorpAcct)
+			// Increase payer's own TD (standard self-funded adjustment)
+			err = ms.trustDeposit.AdjustTrustDeposit(ctx, payerParticipant.CorporationId, payerTDI64, "csps_payer_trust_deposit")
 			if err != nil {
-				return fmt.Errorf("invalid wallet agent grantee address: %w", err)
+				return fmt.Errorf("failed to adjust payer trust deposit: %w", err)
 			}
 
-			walletAgentFeesI64, err := uint64ToInt64(walletAgentFeesToAccount, "wallet_agent_fees_to_account")
-			if err != nil {
-				return err
-			}
-			err = ms.bankKeeper.SendCoins(
-				ctx,
-				authorityAddr,
-				walletAgentGranteeAddr,
-				sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, walletAgentFeesI64)),
-			)
-			if err != nil {
-				return fmt.Errorf("failed to transfer wallet user agent reward: %w", err)
+			payerParticipant.Deposit += share.trustDeposit
+			if err := ms.Keeper.UpdateParticipant(ctx, payerParticipant); err != nil {
+				return fmt.Errorf("failed to update payer participant deposit: %w", err)
 			}
 		}
+	}
 
-		// Increase trust deposit of wallet_agent_participant.authority and wallet_agent_participant.deposit
-		if walletAgentTrustDeposit > 0 {
-			// Increase wallet agent's TD funded by payer (transfers from payer to TD module directly)
-			walletAgentTDI64, err := uint64ToInt64(walletAgentTrustDeposit, "wallet_agent_trust_deposit")
-			if err != nil {
-				return err
-			}
-			err = ms.trustDeposit.AdjustTrustDepositOnBehalf(ctx, walletAgentCorpAcct, authorityAddr, walletAgentTDI64)
-			if err != nil {
-				return fmt.Errorf("failed to adjust wallet agent trust deposit: %w", err)
-			}
+	// Step 2: Process agent rewards
+	// User Agent Reward
+	if err := ms.payAgentReward(ctx, authorityAddr, msg.AgentParticipantId, fees.userAgentReward, "user agent"); err != nil {
+		return err
+	}
 
-			walletAgentParticipant.Deposit += walletAgentTrustDeposit
-			if err := ms.Keeper.UpdateParticipant(ctx, walletAgentParticipant); err != nil {
-				return fmt.Errorf("failed to update wallet agent participant deposit: %w", err)
-			}
-		}
+	// Wallet Agent Reward
+	if err := ms.payAgentReward(ctx, authorityAddr, msg.WalletAgentParticipantId, fees.walletAgentReward, "wallet user agent"); err != nil {
+		return err
 	}
 
 	// Step 3: Create or update session records
@@ -553,6 +481,65 @@ func (ms msgServer) executeCreateOrUpdateParticipantSession(ctx sdk.Context, msg
 	return nil
 }
 
+// payAgentReward transfers an agent reward to the agent corporation account
+// and trust deposit, funded by the payer.
+func (ms msgServer) payAgentReward(ctx sdk.Context, payer sdk.AccAddress, agentParticipantId uint64, reward agentRewardShare, name string) error {
+	if reward.toAccount == 0 && reward.trustDeposit == 0 {
+		return nil
+	}
+
+	agentParticipant, err := ms.Participant.Get(ctx, agentParticipantId)
+	if err != nil {
+		return fmt.Errorf("failed to get %s participant: %w", name, err)
+	}
+
+	// Transfer direct amount to agent_participant.authority
+	agentCorpAcct, err := ms.corpAccountFromID(ctx, agentParticipant.CorporationId)
+	if err != nil {
+		return err
+	}
+	if reward.toAccount > 0 {
+		agentGranteeAddr, err := sdk.AccAddressFromBech32(agentCorpAcct)
+		if err != nil {
+			return fmt.Errorf("invalid %s grantee address: %w", name, err)
+		}
+
+		agentFeesI64, err := uint64ToInt64(reward.toAccount, "agent_fees_to_account")
+		if err != nil {
+			return err
+		}
+		err = ms.bankKeeper.SendCoins(
+			ctx,
+			payer,
+			agentGranteeAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, agentFeesI64)),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to transfer %s reward: %w", name, err)
+		}
+	}
+
+	// Increase trust deposit of agent_participant.authority and agent_participant.deposit
+	if reward.trustDeposit > 0 {
+		agentTDI64, err := uint64ToInt64(reward.trustDeposit, "agent_trust_deposit")
+		if err != nil {
+			return err
+		}
+		// Increase agent's TD funded by payer (transfers from payer to TD module directly)
+		err = ms.trustDeposit.AdjustTrustDepositOnBehalf(ctx, agentParticipant.CorporationId, payer, agentTDI64)
+		if err != nil {
+			return fmt.Errorf("failed to adjust %s trust deposit: %w", name, err)
+		}
+
+		agentParticipant.Deposit += reward.trustDeposit
+		if err := ms.Keeper.UpdateParticipant(ctx, agentParticipant); err != nil {
+			return fmt.Errorf("failed to update %s participant deposit: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 func (ms msgServer) validateSessionAccess(ctx sdk.Context, msg *types.MsgCreateOrUpdateParticipantSession) error {
 	existingSession, err := ms.ParticipantSession.Get(ctx, msg.Id)
 	if err != nil {
@@ -617,7 +604,7 @@ func (ms msgServer) createOrUpdateSession(ctx sdk.Context, msg *types.MsgCreateO
 	// Add the record to session.session_records
 	session.SessionRecords = append(session.SessionRecords, record)
 
-	return ms.ParticipantSession.Set(ctx, msg.Id, *session)
+	return ms.setParticipantSession(ctx, *session)
 }
 
 // findBeneficiaries gets the set of participants that should receive fees
@@ -671,7 +658,7 @@ func (ms msgServer) findBeneficiaries(ctx sdk.Context, issuerParticipantId, veri
 		err = ms.Participant.Walk(ctx, nil, func(id uint64, participant types.Participant) (bool, error) {
 			if participant.SchemaId == schemaID &&
 				participant.Role == types.ParticipantRole_ECOSYSTEM &&
-				participant.Revoked == nil && participant.Slashed == nil {
+				participant.Revoked == nil && participant.Slashed == nil && participant.Suspended == nil {
 				foundParticipants = append(foundParticipants, participant)
 				return true, nil // Stop iteration once found
 			}
@@ -702,7 +689,7 @@ func (ms msgServer) findBeneficiaries(ctx sdk.Context, issuerParticipantId, veri
 				}
 
 				// Add to set if valid and not already included
-				if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil && !containsParticipant(currentParticipantID) {
+				if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil && currentParticipant.Suspended == nil && !containsParticipant(currentParticipantID) {
 					foundParticipants = append(foundParticipants, currentParticipant)
 				}
 
@@ -717,7 +704,7 @@ func (ms msgServer) findBeneficiaries(ctx sdk.Context, issuerParticipantId, veri
 		// First add issuer participant to the set if provided
 		if issuerParticipantId != 0 {
 			issuerParticipant, err := ms.Participant.Get(ctx, issuerParticipantId)
-			if err == nil && issuerParticipant.Revoked == nil && !containsParticipant(issuerParticipantId) {
+			if err == nil && issuerParticipant.Revoked == nil && issuerParticipant.Suspended == nil && !containsParticipant(issuerParticipantId) {
 				foundParticipants = append(foundParticipants, issuerParticipant)
 			}
 		}
@@ -737,7 +724,7 @@ func (ms msgServer) findBeneficiaries(ctx sdk.Context, issuerParticipantId, veri
 				}
 
 				// Add to set if valid and not already included
-				if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil && !containsParticipant(currentParticipantID) {
+				if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil && currentParticipant.Suspended == nil && !containsParticipant(currentParticipantID) {
 					foundParticipants = append(foundParticipants, currentParticipant)
 				}
 
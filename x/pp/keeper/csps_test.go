@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store"
@@ -180,12 +183,17 @@ func (k *TrackingBankKeeper) GetTotalReceived(addr string) sdk.Coins {
 // TrackingTrustDepositKeeper tracks trust deposit changes
 type TrackingTrustDepositKeeper struct {
 	TrustDeposits       map[string]int64
+	Burned              map[string]uint64
+	Available           map[string]uint64 // when set for an account, bounds what can be burned
+	Unbonding           map[string]uint64 // released amounts, held back by slashes
 	AdjustmentLog       []TrustDepositAdjustment
 	OnBehalfLog         []TrustDepositOnBehalfAdjustment
 	UserAgentRewardRate math.LegacyDec
 	WalletUARewardRate  math.LegacyDec
 	TrustDepositRate    math.LegacyDec
+	UnbondingDays       uint64
 	bankKeeper          *TrackingBankKeeper // reference for on-behalf coin tracking
+	coKeeper            *TrackingCorporationKeeper
 }
 
 type TrustDepositAdjustment struct {
@@ -205,19 +213,67 @@ func NewTrackingTrustDepositKeeper(uaRate, wuaRate, tdRate string, bankKeeper *T
 	td, _ := math.LegacyNewDecFromStr(tdRate)
 	return &TrackingTrustDepositKeeper{
 		TrustDeposits:       make(map[string]int64),
+		Burned:              make(map[string]uint64),
+		Unbonding:           make(map[string]uint64),
 		AdjustmentLog:       make([]TrustDepositAdjustment, 0),
 		OnBehalfLog:         make([]TrustDepositOnBehalfAdjustment, 0),
 		UserAgentRewardRate: ua,
 		WalletUARewardRate:  wua,
 		TrustDepositRate:    td,
+		UnbondingDays:       21,
 		bankKeeper:          bankKeeper,
 	}
 }
 
-func (m *TrackingTrustDepositKeeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error {
+// account reverses corporationID to its policy_address, the tracking maps
+// are keyed by account.
+func (m *TrackingTrustDepositKeeper) account(ctx sdk.Context, corporationID uint64) (string, error) {
+	co, ok := m.coKeeper.ResolveByID(ctx, corporationID)
+	if !ok {
+		return "", fmt.Errorf("corporation %d not found", corporationID)
+	}
+	return co.PolicyAddress, nil
+}
+
+func (m *TrackingTrustDepositKeeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, _ uint64, corporationID uint64, amount uint64) error {
+	account, err := m.account(ctx, corporationID)
+	if err != nil {
+		return err
+	}
+	if available, ok := m.Available[account]; ok {
+		if amount > available {
+			return fmt.Errorf("amount %d exceeds trust deposit %d", amount, available)
+		}
+		m.Available[account] = available - amount
+	}
+	m.Burned[account] += amount
 	return nil
 }
 
+func (m *TrackingTrustDepositKeeper) GetUnbondingTrustDeposit(ctx sdk.Context, corporationID uint64) (uint64, error) {
+	account, err := m.account(ctx, corporationID)
+	if err != nil {
+		return 0, err
+	}
+	return m.Unbonding[account], nil
+}
+
+func (m *TrackingTrustDepositKeeper) HoldUnbondingTrustDeposit(ctx sdk.Context, corporationID uint64, amount uint64) error {
+	account, err := m.account(ctx, corporationID)
+	if err != nil {
+		return err
+	}
+	if amount > m.Unbonding[account] {
+		return fmt.Errorf("amount exceeds unbonding deposit: %d > %d", amount, m.Unbonding[account])
+	}
+	m.Unbonding[account] -= amount
+	return nil
+}
+
+func (m *TrackingTrustDepositKeeper) GetTrustDepositUnbondingDays(ctx sdk.Context) uint64 {
+	return m.UnbondingDays
+}
+
 func (m *TrackingTrustDepositKeeper) GetUserAgentRewardRate(ctx sdk.Context) math.LegacyDec {
 	return m.UserAgentRewardRate
 }
@@ -230,13 +286,24 @@ func (m *TrackingTrustDepositKeeper) GetTrustDepositRate(ctx sdk.Context) math.L
 	return m.TrustDepositRate
 }
 
-func (m *TrackingTrustDepositKeeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64, _ string) error {
+func (m *TrackingTrustDepositKeeper) AdjustTrustDeposit(ctx sdk.Context, corporationID uint64, augend int64, _ string) error {
+	account, err := m.account(ctx, corporationID)
+	if err != nil {
+		return err
+	}
 	m.TrustDeposits[account] += augend
+	if augend < 0 {
+		m.Unbonding[account] += uint64(-augend)
+	}
 	m.AdjustmentLog = append(m.AdjustmentLog, TrustDepositAdjustment{Account: account, Amount: augend})
 	return nil
 }
 
-func (m *TrackingTrustDepositKeeper) AdjustTrustDepositOnBehalf(ctx sdk.Context, account string, funder sdk.AccAddress, amount int64) error {
+func (m *TrackingTrustDepositKeeper) AdjustTrustDepositOnBehalf(ctx sdk.Context, corporationID uint64, funder sdk.AccAddress, amount int64) error {
+	account, err := m.account(ctx, corporationID)
+	if err != nil {
+		return err
+	}
 	// Mirror real implementation: deduct from funder via SendCoinsFromAccountToModule
 	if m.bankKeeper != nil {
 		err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, "td", sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, amount)))
@@ -404,6 +471,22 @@ func setupTrackingMsgServer(t testing.TB, uaRate, wuaRate, tdRate string, trustU
 	*TrackingBankKeeper,
 	*TrackingTrustDepositKeeper,
 	sdk.Context,
+) {
+	k, ms, csKeeper, ekKeeper, bankKeeper, tdKeeper, _, ctx := setupTrackingMsgServerWithDigest(t, uaRate, wuaRate, tdRate, trustUnitPrice)
+	return k, ms, csKeeper, ekKeeper, bankKeeper, tdKeeper, ctx
+}
+
+// setupTrackingMsgServerWithDigest is setupTrackingMsgServer that also
+// returns the digest keeper mock
+func setupTrackingMsgServerWithDigest(t testing.TB, uaRate, wuaRate, tdRate string, trustUnitPrice uint64) (
+	keeper.Keeper,
+	types.MsgServer,
+	*TrackingCredentialSchemaKeeper,
+	*TrackingEcosystemKeeper,
+	*TrackingBankKeeper,
+	*TrackingTrustDepositKeeper,
+	*keepertest.MockDigestKeeper,
+	sdk.Context,
 ) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
@@ -422,6 +505,8 @@ func setupTrackingMsgServer(t testing.TB, uaRate, wuaRate, tdRate string, trustU
 	coKeeper := NewTrackingCorporationKeeper(ekKeeper)
 	bankKeeper := NewTrackingBankKeeper()
 	tdKeeper := NewTrackingTrustDepositKeeper(uaRate, wuaRate, tdRate, bankKeeper)
+	tdKeeper.coKeeper = coKeeper
+	digestKeeper := &keepertest.MockDigestKeeper{}
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -434,7 +519,7 @@ func setupTrackingMsgServer(t testing.TB, uaRate, wuaRate, tdRate string, trustU
 		tdKeeper,
 		bankKeeper,
 		&keepertest.MockDelegationKeeper{}, // permissive mock for CSPS tests
-		&keepertest.MockDigestKeeper{},     // permissive mock for CSPS tests
+		digestKeeper,                       // permissive mock for CSPS tests
 	)
 
 	// Set a specific block time for consistent testing
@@ -446,8 +531,7 @@ func setupTrackingMsgServer(t testing.TB, uaRate, wuaRate, tdRate string, trustU
 		panic(err)
 	}
 
-	return k, keeper.NewMsgServerImpl(k), csKeeper, ekKeeper, bankKeeper, tdKeeper, ctx
-
+	return k, keeper.NewMsgServerImpl(k), csKeeper, ekKeeper, bankKeeper, tdKeeper, digestKeeper, ctx
 }
 
 // TestAgentRewardsDistribution tests the complete fee distribution flow including:
@@ -995,3 +1079,1250 @@ func TestAgentRewardsWithDiscount(t *testing.T) {
 			"Agent TD should increase by 1")
 	})
 }
+
+// TestEstimateSessionFeesMatchesExecution checks that the EstimateSessionFees
+// breakdown matches what CreateOrUpdateParticipantSession actually charges.
+func TestEstimateSessionFeesMatchesExecution(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, bankKeeper, tdKeeper, ctx := setupTrackingMsgServer(t,
+		"0.1",  // user_agent_reward_rate
+		"0.05", // wallet_user_agent_reward_rate
+		"0.2",  // trust_deposit_rate
+		3,      // trust_unit_price
+	)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sdk.AccAddress([]byte("creator_address_____")).String()
+	ecosystem := sdk.AccAddress([]byte("ecosystem_address___")).String()
+	grantor := sdk.AccAddress([]byte("grantor_address_____")).String()
+	agent := sdk.AccAddress([]byte("agent_address_______")).String()
+	walletAgent := sdk.AccAddress([]byte("wallet_agent_addr___")).String()
+
+	bankKeeper.SetBalance(creator, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 100000)))
+
+	trID := trkKeeper.CreateMockEcosystem(ecosystem, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS)
+
+	now := sdkCtx.BlockTime()
+	pastTime := now.Add(-1 * time.Hour)
+
+	newParticipant := func(role types.ParticipantRole, corp string, validatorID uint64, issuanceFees uint64) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:               1,
+			Role:                   role,
+			CorporationId:          trkKeeper.RegisterCorp(corp),
+			Created:                &now,
+			Modified:               &now,
+			ValidatorParticipantId: validatorID,
+			OpState:                types.OnboardingState_VALIDATED,
+			IssuanceFees:           issuanceFees,
+			EffectiveFrom:          &pastTime,
+			VsOperator:             corp,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemID := newParticipant(types.ParticipantRole_ECOSYSTEM, ecosystem, 0, 101)
+	grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, ecosystemID, 33)
+	issuerID := newParticipant(types.ParticipantRole_ISSUER, creator, grantorID, 0)
+	agentID := newParticipant(types.ParticipantRole_ISSUER, agent, issuerID, 0)
+	walletAgentID := newParticipant(types.ParticipantRole_ISSUER, walletAgent, issuerID, 0)
+
+	t.Run("rejects missing issuer and verifier", func(t *testing.T) {
+		_, err := k.EstimateSessionFees(ctx, &types.QueryEstimateSessionFeesRequest{})
+		require.Error(t, err)
+	})
+
+	t.Run("rejects agent with wrong role", func(t *testing.T) {
+		_, err := k.EstimateSessionFees(ctx, &types.QueryEstimateSessionFeesRequest{
+			IssuerParticipantId: issuerID,
+			AgentParticipantId:  grantorID,
+		})
+		require.ErrorContains(t, err, "agent participant must be ISSUER type")
+	})
+
+	estimate, err := k.EstimateSessionFees(ctx, &types.QueryEstimateSessionFeesRequest{
+		IssuerParticipantId:      issuerID,
+		AgentParticipantId:       agentID,
+		WalletAgentParticipantId: walletAgentID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, creator, estimate.Payer)
+	require.Equal(t, uint64(134), estimate.BeneficiaryFees)
+	require.Equal(t, uint64(3), estimate.TrustUnitPrice)
+	require.Len(t, estimate.Beneficiaries, 2)
+	require.True(t, estimate.SufficientFunds)
+	require.Equal(t, uint64(100000), estimate.PayerBalance)
+
+	// The estimate must not have moved any funds
+	require.Empty(t, bankKeeper.TransferLog)
+	require.Empty(t, bankKeeper.ModuleTransferLog)
+
+	_, err = ms.CreateOrUpdateParticipantSession(ctx, &types.MsgCreateOrUpdateParticipantSession{
+		Corporation:              creator,
+		Operator:                 creator,
+		Id:                       uuid.New().String(),
+		IssuerParticipantId:      issuerID,
+		AgentParticipantId:       agentID,
+		WalletAgentParticipantId: walletAgentID,
+	})
+	require.NoError(t, err)
+
+	toAccount := make(map[string]int64)
+	toTrustDeposit := make(map[string]int64)
+	var total uint64
+	for _, item := range estimate.Items {
+		total += item.Amount
+		switch item.Destination {
+		case types.FeeDestination_FEE_DESTINATION_ACCOUNT:
+			toAccount[item.Account] += int64(item.Amount)
+		case types.FeeDestination_FEE_DESTINATION_TRUST_DEPOSIT, types.FeeDestination_FEE_DESTINATION_PAYER_TRUST_DEPOSIT:
+			toTrustDeposit[item.Account] += int64(item.Amount)
+		default:
+			t.Fatalf("unexpected destination %s", item.Destination)
+		}
+	}
+
+	for _, account := range []string{ecosystem, grantor, agent, walletAgent} {
+		require.Equal(t, toAccount[account], bankKeeper.GetTotalReceived(account).AmountOf(types.BondDenom).Int64(), account)
+		require.Equal(t, toTrustDeposit[account], tdKeeper.GetTotalAdjustment(account), account)
+	}
+	require.Equal(t, toTrustDeposit[creator], tdKeeper.GetTotalAdjustment(creator))
+	require.Equal(t, int64(total), bankKeeper.GetTotalDeducted(creator).AmountOf(types.BondDenom).Int64()+tdKeeper.GetTotalAdjustment(creator))
+	require.LessOrEqual(t, total, estimate.RequiredPayerBalance)
+}
+
+// TestEstimateOnboardingFees checks the EstimateOnboardingFees breakdown for a
+// new onboarding process and a renewal.
+func TestEstimateOnboardingFees(t *testing.T) {
+	k, _, csKeeper, trkKeeper, bankKeeper, _, ctx := setupTrackingMsgServer(t, "0.1", "0.05", "0.2", 2)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ecosystem := sdk.AccAddress([]byte("ecosystem_address___")).String()
+	applicant := sdk.AccAddress([]byte("applicant_address___")).String()
+	bankKeeper.SetBalance(applicant, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 200)))
+	applicantCorpID := trkKeeper.RegisterCorp(applicant)
+
+	trID := trkKeeper.CreateMockEcosystem(ecosystem, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS)
+
+	now := sdkCtx.BlockTime()
+	pastTime := now.Add(-1 * time.Hour)
+	ecosystemCorpID := trkKeeper.RegisterCorp(ecosystem)
+	validatorID, err := k.CreateParticipant(sdkCtx, types.Participant{
+		SchemaId:       1,
+		Role:           types.ParticipantRole_ECOSYSTEM,
+		CorporationId:  ecosystemCorpID,
+		Created:        &now,
+		Modified:       &now,
+		ValidationFees: 50,
+		EffectiveFrom:  &pastTime,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		req        *types.QueryEstimateOnboardingFeesRequest
+		expErr     string
+		sufficient bool
+	}{
+		{
+			name: "start issuer grantor",
+			req: &types.QueryEstimateOnboardingFeesRequest{
+				ValidatorParticipantId: validatorID,
+				Role:                   types.ParticipantRole_ISSUER_GRANTOR,
+				Corporation:            applicant,
+			},
+			sufficient: true,
+		},
+		{
+			name: "renewal without role",
+			req: &types.QueryEstimateOnboardingFeesRequest{
+				ValidatorParticipantId: validatorID,
+			},
+		},
+		{
+			name: "role not validated by validator",
+			req: &types.QueryEstimateOnboardingFeesRequest{
+				ValidatorParticipantId: validatorID,
+				Role:                   types.ParticipantRole_ISSUER,
+			},
+			expErr: "FailedPrecondition",
+		},
+		{
+			name:   "unknown validator",
+			req:    &types.QueryEstimateOnboardingFeesRequest{ValidatorParticipantId: 99},
+			expErr: "NotFound",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := k.EstimateOnboardingFees(ctx, tc.req)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// fees = 50 * 2 = 100 escrowed, deposit = 100 * 0.2 = 20
+			require.Equal(t, uint64(50), resp.ValidationFees)
+			require.Equal(t, uint64(120), resp.RequiredPayerBalance)
+			require.Equal(t, tc.sufficient, resp.SufficientFunds)
+			require.Len(t, resp.Items, 2)
+			require.Equal(t, types.FeeDestination_FEE_DESTINATION_ESCROW, resp.Items[0].Destination)
+			require.Equal(t, uint64(100), resp.Items[0].Amount)
+			require.Equal(t, ecosystemCorpID, resp.Items[0].CorporationId)
+			require.Equal(t, types.FeeDestination_FEE_DESTINATION_PAYER_TRUST_DEPOSIT, resp.Items[1].Destination)
+			require.Equal(t, uint64(20), resp.Items[1].Amount)
+			if tc.req.Corporation != "" {
+				require.Equal(t, applicantCorpID, resp.Items[1].CorporationId)
+				require.Equal(t, uint64(200), resp.PayerBalance)
+			}
+		})
+	}
+}
+
+func TestParticipantSessionIndexesAndPruning(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, bankKeeper, _, ctx := setupTrackingMsgServer(t, "0.1", "0.05", "0.2", 1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sdk.AccAddress([]byte("creator_address_____")).String()
+	ecosystem := sdk.AccAddress([]byte("ecosystem_address___")).String()
+	agent := sdk.AccAddress([]byte("agent_address_______")).String()
+	other := sdk.AccAddress([]byte("other_address_______")).String()
+
+	bankKeeper.SetBalance(creator, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 100000)))
+
+	trID := trkKeeper.CreateMockEcosystem(ecosystem, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_OPEN,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_OPEN)
+
+	now := sdkCtx.BlockTime()
+	pastTime := now.Add(-1 * time.Hour)
+	newParticipant := func(corp string) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:      1,
+			Role:          types.ParticipantRole_ISSUER,
+			CorporationId: trkKeeper.RegisterCorp(corp),
+			Created:       &now,
+			Modified:      &now,
+			OpState:       types.OnboardingState_VALIDATED,
+			EffectiveFrom: &pastTime,
+			VsOperator:    corp,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	issuerID := newParticipant(creator)
+	agentID := newParticipant(agent)
+	corporationID := trkKeeper.RegisterCorp(creator)
+
+	oldSession := uuid.New().String()
+	_, err := ms.CreateOrUpdateParticipantSession(sdkCtx, &types.MsgCreateOrUpdateParticipantSession{
+		Corporation:              creator,
+		Operator:                 creator,
+		Id:                       oldSession,
+		IssuerParticipantId:      issuerID,
+		AgentParticipantId:       agentID,
+		WalletAgentParticipantId: agentID,
+	})
+	require.NoError(t, err)
+
+	recentSession := uuid.New().String()
+	_, err = ms.CreateOrUpdateParticipantSession(sdkCtx, &types.MsgCreateOrUpdateParticipantSession{
+		Corporation:              creator,
+		Operator:                 creator,
+		Id:                       recentSession,
+		IssuerParticipantId:      issuerID,
+		AgentParticipantId:       issuerID,
+		WalletAgentParticipantId: issuerID,
+	})
+	require.NoError(t, err)
+
+	listIDs := func(ctx sdk.Context, req *types.QueryListParticipantSessionsRequest) []string {
+		res, err := k.ListParticipantSessions(ctx, req)
+		require.NoError(t, err)
+		var ids []string
+		for _, s := range res.Sessions {
+			ids = append(ids, s.Id)
+		}
+		return ids
+	}
+
+	require.ElementsMatch(t, []string{oldSession, recentSession}, listIDs(sdkCtx, &types.QueryListParticipantSessionsRequest{CorporationId: corporationID}))
+	require.ElementsMatch(t, []string{oldSession, recentSession}, listIDs(sdkCtx, &types.QueryListParticipantSessionsRequest{VsOperator: creator}))
+	require.ElementsMatch(t, []string{oldSession, recentSession}, listIDs(sdkCtx, &types.QueryListParticipantSessionsRequest{ParticipantId: issuerID}))
+	require.Equal(t, []string{oldSession}, listIDs(sdkCtx, &types.QueryListParticipantSessionsRequest{ParticipantId: agentID}))
+	require.Empty(t, listIDs(sdkCtx, &types.QueryListParticipantSessionsRequest{VsOperator: other}))
+	require.Empty(t, listIDs(sdkCtx, &types.QueryListParticipantSessionsRequest{CorporationId: corporationID + 100}))
+	require.Equal(t, []string{oldSession}, listIDs(sdkCtx, &types.QueryListParticipantSessionsRequest{CorporationId: corporationID, ParticipantId: agentID}))
+
+	// Retention disabled by default: nothing is pruned
+	later := sdkCtx.WithBlockTime(now.Add(72 * time.Hour))
+	require.NoError(t, k.EndBlocker(later))
+	require.Len(t, listIDs(later, &types.QueryListParticipantSessionsRequest{}), 2)
+
+	params := k.GetParams(sdkCtx)
+	params.ParticipantSessionRetentionDays = 2
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	// Touch the recent session so that only the old one is past retention
+	_, err = ms.CreateOrUpdateParticipantSession(later.WithBlockTime(now.Add(24*time.Hour)), &types.MsgCreateOrUpdateParticipantSession{
+		Corporation:              creator,
+		Operator:                 creator,
+		Id:                       recentSession,
+		IssuerParticipantId:      issuerID,
+		AgentParticipantId:       issuerID,
+		WalletAgentParticipantId: issuerID,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, k.EndBlocker(later))
+	require.Equal(t, []string{recentSession}, listIDs(later, &types.QueryListParticipantSessionsRequest{}))
+	require.Empty(t, listIDs(later, &types.QueryListParticipantSessionsRequest{ParticipantId: agentID}))
+
+	has, err := k.ParticipantSessionByCorporation.Has(later, collections.Join(corporationID, oldSession))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	found := false
+	for _, event := range later.EventManager().Events() {
+		if event.Type == types.EventTypePruneParticipantSessions {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestPruneParticipantSessionsIsBounded(t *testing.T) {
+	k, _, _, _, _, _, ctx := setupTrackingMsgServer(t, "0.1", "0.05", "0.2", 1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	created := sdkCtx.BlockTime()
+	total := keeper.MaxPrunedParticipantSessionsPerBlock + 5
+	for i := 0; i < total; i++ {
+		session := types.ParticipantSession{
+			Id:            uuid.New().String(),
+			CorporationId: 1,
+			Created:       &created,
+			Modified:      &created,
+		}
+		require.NoError(t, k.ParticipantSession.Set(sdkCtx, session.Id, session))
+	}
+	require.NoError(t, k.RebuildParticipantSessionIndexes(sdkCtx))
+
+	params := k.GetParams(sdkCtx)
+	params.ParticipantSessionRetentionDays = 1
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	later := sdkCtx.WithBlockTime(created.Add(48 * time.Hour))
+	count := func() int {
+		n := 0
+		require.NoError(t, k.ParticipantSession.Walk(later, nil, func(string, types.ParticipantSession) (bool, error) {
+			n++
+			return false, nil
+		}))
+		return n
+	}
+
+	require.NoError(t, k.EndBlocker(later))
+	require.Equal(t, 5, count())
+	require.NoError(t, k.EndBlocker(later))
+	require.Equal(t, 0, count())
+}
+
+func TestParticipantFeeChange(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, bankKeeper, _, ctx := setupTrackingMsgServer(t, "0", "0", "0", 3)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sdk.AccAddress([]byte("creator_address_____")).String()
+	ecosystem := sdk.AccAddress([]byte("ecosystem_address___")).String()
+	grantor := sdk.AccAddress([]byte("grantor_address_____")).String()
+
+	bankKeeper.SetBalance(creator, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 100000)))
+
+	trID := trkKeeper.CreateMockEcosystem(ecosystem, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS)
+
+	now := sdkCtx.BlockTime()
+	pastTime := now.Add(-1 * time.Hour)
+	newParticipant := func(role types.ParticipantRole, corp string, validatorID uint64, issuanceFees uint64) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:               1,
+			Role:                   role,
+			CorporationId:          trkKeeper.RegisterCorp(corp),
+			Created:                &now,
+			Modified:               &now,
+			ValidatorParticipantId: validatorID,
+			OpState:                types.OnboardingState_VALIDATED,
+			IssuanceFees:           issuanceFees,
+			EffectiveFrom:          &pastTime,
+			VsOperator:             corp,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemID := newParticipant(types.ParticipantRole_ECOSYSTEM, ecosystem, 0, 101)
+	grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, ecosystemID, 33)
+	issuerID := newParticipant(types.ParticipantRole_ISSUER, creator, grantorID, 0)
+
+	beneficiaryFees := func(ctx sdk.Context) uint64 {
+		res, err := k.EstimateSessionFees(ctx, &types.QueryEstimateSessionFeesRequest{
+			IssuerParticipantId:      issuerID,
+			AgentParticipantId:       issuerID,
+			WalletAgentParticipantId: issuerID,
+		})
+		require.NoError(t, err)
+		return res.BeneficiaryFees
+	}
+	getParticipant := func(ctx sdk.Context, id uint64) types.Participant {
+		res, err := k.GetParticipant(ctx, &types.QueryGetParticipantRequest{Id: id})
+		require.NoError(t, err)
+		return res.Participant
+	}
+	propose := func(ctx sdk.Context, corp string, id uint64, issuanceFees uint64) error {
+		_, err := ms.ProposeParticipantFeeChange(ctx, &types.MsgProposeParticipantFeeChange{
+			Corporation:  corp,
+			Operator:     corp,
+			Id:           id,
+			IssuanceFees: issuanceFees,
+		})
+		return err
+	}
+	accept := func(ctx sdk.Context, corp string, id uint64) (time.Time, error) {
+		res, err := ms.AcceptParticipantFeeChange(ctx, &types.MsgAcceptParticipantFeeChange{
+			Corporation: corp,
+			Operator:    corp,
+			Id:          id,
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+		return res.Effective, nil
+	}
+
+	require.Equal(t, uint64(134), beneficiaryFees(sdkCtx))
+
+	t.Run("only the validator can propose", func(t *testing.T) {
+		require.ErrorContains(t, propose(sdkCtx, creator, grantorID, 50), "only the validator participant corporation")
+		require.ErrorContains(t, propose(sdkCtx, grantor, ecosystemID, 50), "only the ecosystem controller")
+	})
+
+	t.Run("accepting requires a pending change", func(t *testing.T) {
+		_, err := accept(sdkCtx, grantor, grantorID)
+		require.ErrorContains(t, err, "no pending fee change")
+	})
+
+	// The ecosystem proposes new issuance fees for the grantor
+	require.NoError(t, propose(sdkCtx, ecosystem, grantorID, 50))
+	pending := getParticipant(sdkCtx, grantorID)
+	require.Equal(t, uint64(33), pending.IssuanceFees)
+	require.NotNil(t, pending.PendingFeeChange)
+	require.Equal(t, uint64(50), pending.PendingFeeChange.IssuanceFees)
+	require.Nil(t, pending.PendingFeeChange.Accepted)
+
+	_, err := accept(sdkCtx, creator, grantorID)
+	require.ErrorContains(t, err, "not the participant corporation")
+
+	effective, err := accept(sdkCtx, grantor, grantorID)
+	require.NoError(t, err)
+	require.Equal(t, now.AddDate(0, 0, int(types.DefaultFeeChangeNoticePeriodDays)), effective)
+	require.Equal(t, effective, *getParticipant(sdkCtx, grantorID).PendingFeeChange.Effective)
+
+	_, err = accept(sdkCtx, grantor, grantorID)
+	require.ErrorContains(t, err, "already accepted")
+
+	// Sessions keep the old prices during the notice period
+	beforeEffective := sdkCtx.WithBlockTime(effective.Add(-time.Second))
+	require.NoError(t, k.BeginBlocker(beforeEffective))
+	require.Equal(t, uint64(134), beneficiaryFees(beforeEffective))
+
+	// and switch to the new ones at the effective time
+	atEffective := sdkCtx.WithBlockTime(effective)
+	require.NoError(t, k.BeginBlocker(atEffective))
+	applied := getParticipant(atEffective, grantorID)
+	require.Equal(t, uint64(50), applied.IssuanceFees)
+	require.Nil(t, applied.PendingFeeChange)
+	require.Equal(t, uint64(151), beneficiaryFees(atEffective))
+
+	_, err = ms.CreateOrUpdateParticipantSession(atEffective, &types.MsgCreateOrUpdateParticipantSession{
+		Corporation:              creator,
+		Operator:                 creator,
+		Id:                       uuid.New().String(),
+		IssuerParticipantId:      issuerID,
+		AgentParticipantId:       issuerID,
+		WalletAgentParticipantId: issuerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 151*3)), bankKeeper.GetTotalDeducted(creator))
+
+	t.Run("a new proposal supersedes an accepted change", func(t *testing.T) {
+		require.NoError(t, propose(atEffective, ecosystem, grantorID, 60))
+		effective, err := accept(atEffective, grantor, grantorID)
+		require.NoError(t, err)
+		require.NoError(t, propose(atEffective, ecosystem, grantorID, 70))
+
+		later := atEffective.WithBlockTime(effective)
+		require.NoError(t, k.BeginBlocker(later))
+		participant := getParticipant(later, grantorID)
+		require.Equal(t, uint64(50), participant.IssuanceFees)
+		require.Equal(t, uint64(70), participant.PendingFeeChange.IssuanceFees)
+		require.Nil(t, participant.PendingFeeChange.Accepted)
+	})
+
+	t.Run("root participant without notice period", func(t *testing.T) {
+		params := k.GetParams(atEffective)
+		params.FeeChangeNoticePeriodDays = 0
+		require.NoError(t, k.SetParams(atEffective, params))
+
+		require.NoError(t, propose(atEffective, ecosystem, ecosystemID, 1))
+		_, err := accept(atEffective, ecosystem, ecosystemID)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), getParticipant(atEffective, ecosystemID).IssuanceFees)
+		require.Equal(t, uint64(51), beneficiaryFees(atEffective))
+	})
+
+	t.Run("failing fee change is skipped without halting the chain", func(t *testing.T) {
+		params := k.GetParams(atEffective)
+		params.FeeChangeNoticePeriodDays = 1
+		require.NoError(t, k.SetParams(atEffective, params))
+
+		require.NoError(t, propose(atEffective, ecosystem, grantorID, 80))
+		effective, err := accept(atEffective, grantor, grantorID)
+		require.NoError(t, err)
+
+		// An entry scheduled before it references a missing participant
+		require.NoError(t, k.ScheduledFeeChange.Set(atEffective, collections.Join(effective.Add(-time.Second), uint64(999))))
+
+		blockCtx := atEffective.WithBlockTime(effective).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.BeginBlocker(blockCtx))
+		require.Equal(t, uint64(80), getParticipant(blockCtx, grantorID).IssuanceFees)
+
+		var failed []string
+		for _, ev := range blockCtx.EventManager().Events() {
+			if ev.Type != types.EventTypeApplyParticipantFeeChangeFailed {
+				continue
+			}
+			id, ok := ev.GetAttribute(types.AttributeKeyParticipantID)
+			require.True(t, ok)
+			failed = append(failed, id.Value)
+		}
+		require.Equal(t, []string{"999"}, failed)
+	})
+}
+
+func TestSlashDispute(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, _, tdKeeper, digestKeeper, ctx := setupTrackingMsgServerWithDigest(t, "0", "0", "0", 1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	issuer := sdk.AccAddress([]byte("issuer_address______")).String()
+	ecosystem := sdk.AccAddress([]byte("ecosystem_address___")).String()
+	grantor := sdk.AccAddress([]byte("grantor_address_____")).String()
+	const evidence = "sha384-slashEvidence"
+	digestKeeper.Missing = map[string]bool{"sha384-missingEvidence": true}
+
+	trID := trkKeeper.CreateMockEcosystem(ecosystem, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS)
+
+	now := sdkCtx.BlockTime()
+	pastTime := now.Add(-1 * time.Hour)
+	newParticipant := func(role types.ParticipantRole, corp string, validatorID uint64) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:               1,
+			Role:                   role,
+			CorporationId:          trkKeeper.RegisterCorp(corp),
+			Created:                &now,
+			Modified:               &now,
+			ValidatorParticipantId: validatorID,
+			OpState:                types.OnboardingState_VALIDATED,
+			Deposit:                1000,
+			EffectiveFrom:          &pastTime,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemID := newParticipant(types.ParticipantRole_ECOSYSTEM, ecosystem, 0)
+	grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, ecosystemID)
+	issuerID := newParticipant(types.ParticipantRole_ISSUER, issuer, grantorID)
+
+	slash := func(ctx sdk.Context, corp string, id, amount uint64, digest string) (*types.MsgSlashParticipantTrustDepositResponse, error) {
+		return ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
+			Corporation:    corp,
+			Operator:       corp,
+			Id:             id,
+			Amount:         amount,
+			Reason:         "forged credentials",
+			EvidenceDigest: digest,
+		})
+	}
+	appeal := func(ctx sdk.Context, corp string, id, slashID uint64, digest string) (*types.MsgAppealSlashResponse, error) {
+		return ms.AppealSlash(ctx, &types.MsgAppealSlash{
+			Corporation:    corp,
+			Operator:       corp,
+			Id:             id,
+			SlashId:        slashID,
+			Reason:         "evidence is forged",
+			EvidenceDigest: digest,
+		})
+	}
+	resolve := func(ctx sdk.Context, authority string, id, slashID uint64, reverse bool) error {
+		_, err := ms.ResolveSlashAppeal(ctx, &types.MsgResolveSlashAppeal{
+			Authority: authority,
+			Id:        id,
+			SlashId:   slashID,
+			Reverse:   reverse,
+		})
+		return err
+	}
+	getParticipant := func(id uint64) types.Participant {
+		p, err := k.GetParticipantByID(sdkCtx, id)
+		require.NoError(t, err)
+		return p
+	}
+
+	t.Run("evidence must be anchored", func(t *testing.T) {
+		_, err := slash(sdkCtx, grantor, issuerID, 100, "sha384-missingEvidence")
+		require.ErrorContains(t, err, "is not anchored")
+	})
+
+	t.Run("appealed slash reversed by the ecosystem corporation", func(t *testing.T) {
+		res, err := slash(sdkCtx, grantor, issuerID, 100, evidence)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.SlashId)
+		require.Equal(t, now.AddDate(0, 0, int(types.DefaultSlashDisputeWindowDays)), res.DisputeDeadline)
+
+		// The amount is held, not burnt, and the participant is not slashed yet
+		p := getParticipant(issuerID)
+		require.Equal(t, uint64(900), p.Deposit)
+		require.Zero(t, p.SlashedDeposit)
+		require.Nil(t, p.Slashed)
+		require.Len(t, p.Slashes, 1)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_PENDING, p.Slashes[0].Status)
+		require.Equal(t, evidence, p.Slashes[0].EvidenceDigest)
+		require.Zero(t, tdKeeper.Burned[issuer])
+
+		_, err = appeal(sdkCtx, grantor, issuerID, 1, "")
+		require.ErrorContains(t, err, "corporation is not the participant corporation")
+		_, err = appeal(sdkCtx, issuer, issuerID, 2, "")
+		require.ErrorContains(t, err, "slash 2 not found")
+		_, err = appeal(sdkCtx, issuer, issuerID, 1, "sha384-missingEvidence")
+		require.ErrorContains(t, err, "is not anchored")
+
+		// Slashed by the validator, the appeal goes to the ecosystem corporation
+		appealRes, err := appeal(sdkCtx, issuer, issuerID, 1, "sha384-appealEvidence")
+		require.NoError(t, err)
+		require.Equal(t, ecosystem, appealRes.Arbiter)
+		_, err = appeal(sdkCtx, issuer, issuerID, 1, "")
+		require.ErrorContains(t, err, "is not pending")
+
+		require.ErrorContains(t, resolve(sdkCtx, k.GetAuthority(), issuerID, 1, true), "invalid authority")
+		require.NoError(t, resolve(sdkCtx, ecosystem, issuerID, 1, true))
+		require.ErrorContains(t, resolve(sdkCtx, ecosystem, issuerID, 1, true), "is not appealed")
+
+		p = getParticipant(issuerID)
+		require.Equal(t, uint64(1000), p.Deposit)
+		require.Nil(t, p.Slashed)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_REVERSED, p.Slashes[0].Status)
+		require.Equal(t, "sha384-appealEvidence", p.Slashes[0].AppealEvidenceDigest)
+		require.NotNil(t, p.Slashes[0].Resolved)
+		require.Zero(t, tdKeeper.Burned[issuer])
+	})
+
+	t.Run("ecosystem-level slash confirmed by governance", func(t *testing.T) {
+		_, err := slash(sdkCtx, ecosystem, grantorID, 50, evidence)
+		require.NoError(t, err)
+
+		appealRes, err := appeal(sdkCtx, grantor, grantorID, 1, "")
+		require.NoError(t, err)
+		require.Equal(t, k.GetAuthority(), appealRes.Arbiter)
+
+		require.ErrorContains(t, resolve(sdkCtx, ecosystem, grantorID, 1, false), "invalid authority")
+		require.NoError(t, resolve(sdkCtx, k.GetAuthority(), grantorID, 1, false))
+
+		p := getParticipant(grantorID)
+		require.Equal(t, uint64(950), p.Deposit)
+		require.Equal(t, uint64(50), p.SlashedDeposit)
+		require.NotNil(t, p.Slashed)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_CONFIRMED, p.Slashes[0].Status)
+		require.Equal(t, uint64(50), tdKeeper.Burned[grantor])
+	})
+
+	t.Run("unappealed slash is confirmed at the dispute deadline", func(t *testing.T) {
+		// The grantor is slashed now, the ecosystem executes the slash
+		res, err := slash(sdkCtx, ecosystem, issuerID, 200, evidence)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), res.SlashId)
+
+		// Before the deadline the slash stays pending
+		beforeCtx := sdkCtx.WithBlockTime(res.DisputeDeadline.Add(-time.Second))
+		require.NoError(t, k.EndBlocker(beforeCtx))
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_PENDING, getParticipant(issuerID).Slashes[1].Status)
+
+		deadlineCtx := sdkCtx.WithBlockTime(res.DisputeDeadline).WithEventManager(sdk.NewEventManager())
+		_, err = appeal(deadlineCtx, issuer, issuerID, 2, "")
+		require.ErrorContains(t, err, "dispute window of slash 2 closed")
+
+		require.NoError(t, k.EndBlocker(deadlineCtx))
+		p := getParticipant(issuerID)
+		require.Equal(t, uint64(800), p.Deposit)
+		require.Equal(t, uint64(200), p.SlashedDeposit)
+		require.NotNil(t, p.Slashed)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_CONFIRMED, p.Slashes[1].Status)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_REVERSED, p.Slashes[0].Status)
+		require.Equal(t, uint64(200), tdKeeper.Burned[issuer])
+
+		var finalized int
+		for _, ev := range deadlineCtx.EventManager().Events() {
+			if ev.Type == types.EventTypeFinalizeSlash {
+				finalized++
+			}
+		}
+		require.Equal(t, 1, finalized)
+
+		// The index entry is consumed
+		has, err := k.SlashDisputeDeadline.Has(sdkCtx, collections.Join(res.DisputeDeadline, issuerID))
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("revoked participant is slashable while its deposit unbonds", func(t *testing.T) {
+		revoked := sdk.AccAddress([]byte("revoked_issuer______")).String()
+		revokedID := newParticipant(types.ParticipantRole_ISSUER, revoked, grantorID)
+		_, err := ms.RevokeParticipant(sdkCtx, &types.MsgRevokeParticipant{
+			Corporation: ecosystem,
+			Operator:    ecosystem,
+			Id:          revokedID,
+			Reason:      types.RevocationReason_REVOCATION_REASON_FRAUD,
+		})
+		require.NoError(t, err)
+		require.Zero(t, getParticipant(revokedID).Deposit)
+		require.Equal(t, uint64(1000), getParticipant(revokedID).ReleasedDeposit)
+		require.Equal(t, uint64(1000), tdKeeper.Unbonding[revoked])
+
+		// Deposits released by other participants of the corporation are
+		// not slashable for this one
+		tdKeeper.Unbonding[revoked] += 5000
+		_, err = slash(sdkCtx, ecosystem, revokedID, 1001, evidence)
+		require.ErrorContains(t, err, "amount exceeds available deposit: 1001 > 1000")
+		tdKeeper.Unbonding[revoked] -= 5000
+
+		// The slash is held back from the unbonding deposit
+		res, err := slash(sdkCtx, ecosystem, revokedID, 400, evidence)
+		require.NoError(t, err)
+		require.Equal(t, uint64(600), tdKeeper.Unbonding[revoked])
+		p := getParticipant(revokedID)
+		require.Zero(t, p.Deposit)
+		require.Equal(t, uint64(600), p.ReleasedDeposit)
+		require.Equal(t, uint64(400), p.Slashes[0].HeldFromUnbonding)
+
+		// Once unbonded, the released deposit is no longer slashable
+		unbondedCtx := sdkCtx.WithBlockTime(p.ReleasedDepositUnbondingUntil.Add(time.Second))
+		_, err = slash(unbondedCtx, ecosystem, revokedID, 1, evidence)
+		require.ErrorContains(t, err, "amount exceeds available deposit: 1 > 0")
+
+		require.NoError(t, k.EndBlocker(sdkCtx.WithBlockTime(res.DisputeDeadline)))
+		p = getParticipant(revokedID)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_CONFIRMED, p.Slashes[0].Status)
+		require.Equal(t, uint64(400), p.SlashedDeposit)
+		require.Equal(t, uint64(400), tdKeeper.Burned[revoked])
+	})
+
+	t.Run("reversed slash of a released deposit goes back to unbonding", func(t *testing.T) {
+		released := sdk.AccAddress([]byte("released_issuer_____")).String()
+		releasedID := newParticipant(types.ParticipantRole_ISSUER, released, grantorID)
+		_, err := ms.RevokeParticipant(sdkCtx, &types.MsgRevokeParticipant{
+			Corporation: ecosystem,
+			Operator:    ecosystem,
+			Id:          releasedID,
+			Reason:      types.RevocationReason_REVOCATION_REASON_FRAUD,
+		})
+		require.NoError(t, err)
+
+		_, err = slash(sdkCtx, ecosystem, releasedID, 300, evidence)
+		require.NoError(t, err)
+		require.Equal(t, uint64(700), tdKeeper.Unbonding[released])
+		_, err = appeal(sdkCtx, released, releasedID, 1, evidence)
+		require.NoError(t, err)
+		require.NoError(t, resolve(sdkCtx, k.GetAuthority(), releasedID, 1, true))
+
+		p := getParticipant(releasedID)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_REVERSED, p.Slashes[0].Status)
+		require.Zero(t, p.Deposit)
+		require.Equal(t, uint64(1000), p.ReleasedDeposit)
+		require.Equal(t, uint64(1000), tdKeeper.Unbonding[released])
+		require.Zero(t, tdKeeper.Burned[released])
+	})
+
+	t.Run("failing finalization is skipped without halting the chain", func(t *testing.T) {
+		res, err := slash(sdkCtx, ecosystem, grantorID, 300, evidence)
+		require.NoError(t, err)
+
+		// The trust deposit was reduced below the held amount in the meantime
+		tdKeeper.Available = map[string]uint64{grantor: 100}
+		defer func() { tdKeeper.Available = nil }()
+
+		deadlineCtx := sdkCtx.WithBlockTime(res.DisputeDeadline).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.EndBlocker(deadlineCtx))
+
+		p := getParticipant(grantorID)
+		require.Equal(t, uint64(650), p.Deposit)
+		require.Equal(t, uint64(50), p.SlashedDeposit)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_PENDING, p.Slashes[1].Status)
+		require.Equal(t, uint64(50), tdKeeper.Burned[grantor])
+
+		var failed int
+		for _, ev := range deadlineCtx.EventManager().Events() {
+			require.NotEqual(t, types.EventTypeFinalizeSlash, ev.Type)
+			if ev.Type == types.EventTypeFinalizeSlashFailed {
+				failed++
+			}
+		}
+		require.Equal(t, 1, failed)
+
+		// The participant is queued again for a later block
+		has, err := k.SlashDisputeDeadline.Has(sdkCtx, collections.Join(res.DisputeDeadline, grantorID))
+		require.NoError(t, err)
+		require.False(t, has)
+		retryAt := res.DisputeDeadline.Add(keeper.SlashFinalizationRetryInterval)
+		has, err = k.SlashDisputeDeadline.Has(sdkCtx, collections.Join(retryAt, grantorID))
+		require.NoError(t, err)
+		require.True(t, has)
+
+		// Once the trust deposit covers the held amount again, the retry
+		// confirms the slash
+		tdKeeper.Available = nil
+		require.NoError(t, k.EndBlocker(sdkCtx.WithBlockTime(retryAt)))
+		p = getParticipant(grantorID)
+		require.Equal(t, types.SlashStatus_SLASH_STATUS_CONFIRMED, p.Slashes[1].Status)
+		require.Equal(t, uint64(350), tdKeeper.Burned[grantor])
+		has, err = k.SlashDisputeDeadline.Has(sdkCtx, collections.Join(retryAt, grantorID))
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+}
+
+func TestParticipantSuspension(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, _, _, ctx := setupTrackingMsgServer(t, "0", "0", "0", 1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	issuer := sdk.AccAddress([]byte("issuer_address______")).String()
+	ecosystem := sdk.AccAddress([]byte("ecosystem_address___")).String()
+	grantor := sdk.AccAddress([]byte("grantor_address_____")).String()
+	stranger := sdk.AccAddress([]byte("stranger_address____")).String()
+	const issuerDid = "did:example:issuer"
+
+	trID := trkKeeper.CreateMockEcosystem(ecosystem, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS)
+	trkKeeper.RegisterCorp(stranger)
+
+	now := sdkCtx.BlockTime()
+	pastTime := now.Add(-1 * time.Hour)
+	newParticipant := func(role types.ParticipantRole, corp, did string, validatorID uint64) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:               1,
+			Role:                   role,
+			Did:                    did,
+			CorporationId:          trkKeeper.RegisterCorp(corp),
+			Created:                &now,
+			Modified:               &now,
+			ValidatorParticipantId: validatorID,
+			OpState:                types.OnboardingState_VALIDATED,
+			EffectiveFrom:          &pastTime,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemID := newParticipant(types.ParticipantRole_ECOSYSTEM, ecosystem, "", 0)
+	grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, "", ecosystemID)
+	issuerID := newParticipant(types.ParticipantRole_ISSUER, issuer, issuerDid, grantorID)
+
+	suspend := func(ctx sdk.Context, corp string, id uint64) error {
+		_, err := ms.SuspendParticipant(ctx, &types.MsgSuspendParticipant{
+			Corporation: corp,
+			Operator:    corp,
+			Id:          id,
+			Reason:      "under investigation",
+		})
+		return err
+	}
+	unsuspend := func(ctx sdk.Context, corp string, id uint64) error {
+		_, err := ms.UnsuspendParticipant(ctx, &types.MsgUnsuspendParticipant{
+			Corporation: corp,
+			Operator:    corp,
+			Id:          id,
+			Reason:      "investigation closed",
+		})
+		return err
+	}
+	getParticipant := func(id uint64) types.Participant {
+		p, err := k.GetParticipantByID(sdkCtx, id)
+		require.NoError(t, err)
+		return p
+	}
+	beneficiaryIDs := func(ctx sdk.Context) []uint64 {
+		resp, err := k.FindBeneficiaries(ctx, &types.QueryFindBeneficiariesRequest{IssuerParticipantId: issuerID})
+		require.NoError(t, err)
+		var ids []uint64
+		for _, p := range resp.Participants {
+			ids = append(ids, p.Id)
+		}
+		return ids
+	}
+
+	require.ElementsMatch(t, []uint64{ecosystemID, grantorID}, beneficiaryIDs(sdkCtx))
+
+	t.Run("only a validator ancestor or the ecosystem may suspend", func(t *testing.T) {
+		require.ErrorContains(t, suspend(sdkCtx, stranger, issuerID), "not authorized to suspend")
+		require.ErrorContains(t, suspend(sdkCtx, issuer, issuerID), "not authorized to suspend")
+		require.ErrorContains(t, unsuspend(sdkCtx, grantor, issuerID), "participant is not suspended")
+	})
+
+	suspendedAt := now.Add(time.Hour)
+	liftedAt := now.Add(2 * time.Hour)
+
+	t.Run("suspended participant is not valid and not a beneficiary", func(t *testing.T) {
+		ctx1 := sdkCtx.WithBlockTime(suspendedAt)
+		require.NoError(t, suspend(ctx1, ecosystem, grantorID))
+		require.ErrorContains(t, suspend(ctx1, ecosystem, grantorID), "already suspended")
+
+		grantorP := getParticipant(grantorID)
+		require.NotNil(t, grantorP.Suspended)
+		require.Len(t, grantorP.Suspensions, 1)
+		require.Equal(t, "under investigation", grantorP.Suspensions[0].Reason)
+		require.Equal(t, trID, grantorP.Suspensions[0].SuspenderCorporationId)
+
+		require.ErrorContains(t, keeper.IsValidParticipant(grantorP, ctx1.BlockTime()), "participant is suspended")
+		require.Equal(t, []uint64{ecosystemID}, beneficiaryIDs(ctx1))
+
+		// A suspended grantor can no longer act as a validator ancestor
+		require.ErrorContains(t, suspend(ctx1, grantor, issuerID), "not authorized to suspend")
+
+		resp, err := k.ListParticipants(ctx1, &types.QueryListParticipantsRequest{Suspended: true})
+		require.NoError(t, err)
+		require.Len(t, resp.Participants, 1)
+		require.Equal(t, grantorID, resp.Participants[0].Id)
+	})
+
+	t.Run("unsuspend restores validity and keeps the history", func(t *testing.T) {
+		ctx2 := sdkCtx.WithBlockTime(liftedAt)
+		require.NoError(t, unsuspend(ctx2, ecosystem, grantorID))
+
+		grantorP := getParticipant(grantorID)
+		require.Nil(t, grantorP.Suspended)
+		require.Len(t, grantorP.Suspensions, 1)
+		require.Equal(t, liftedAt, *grantorP.Suspensions[0].Unsuspended)
+		require.Equal(t, "investigation closed", grantorP.Suspensions[0].UnsuspendReason)
+
+		require.NoError(t, keeper.IsValidParticipant(grantorP, ctx2.BlockTime()))
+		require.ElementsMatch(t, []uint64{ecosystemID, grantorID}, beneficiaryIDs(ctx2))
+	})
+
+	t.Run("validity at a past time honours the suspension history", func(t *testing.T) {
+		ctx3 := sdkCtx.WithBlockTime(liftedAt.Add(time.Hour))
+		require.NoError(t, suspend(ctx3, grantor, issuerID))
+		require.NoError(t, unsuspend(ctx3.WithBlockTime(liftedAt.Add(2*time.Hour)), grantor, issuerID))
+
+		find := func(when time.Time) int {
+			resp, err := k.FindParticipantsWithDID(ctx3, &types.QueryFindParticipantsWithDIDRequest{
+				Did:      issuerDid,
+				Role:     uint32(types.ParticipantRole_ISSUER),
+				SchemaId: 1,
+				When:     &when,
+			})
+			require.NoError(t, err)
+			return len(resp.Participants)
+		}
+		require.Equal(t, 1, find(liftedAt))
+		require.Equal(t, 0, find(liftedAt.Add(90*time.Minute)))
+		require.Equal(t, 1, find(liftedAt.Add(3*time.Hour)))
+	})
+}
+
+func TestParticipantCascade(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, _, _, ctx := setupTrackingMsgServer(t, "0", "0", "0", 1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ecosystem := sdk.AccAddress([]byte("ecosystem_address___")).String()
+	grantor := sdk.AccAddress([]byte("grantor_address_____")).String()
+	issuer := sdk.AccAddress([]byte("issuer_address______")).String()
+
+	trID := trkKeeper.CreateMockEcosystem(ecosystem, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS)
+
+	now := sdkCtx.BlockTime()
+	pastTime := now.Add(-1 * time.Hour)
+	newParticipant := func(role types.ParticipantRole, corp string, validatorID uint64) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:               1,
+			Role:                   role,
+			CorporationId:          trkKeeper.RegisterCorp(corp),
+			Created:                &now,
+			Modified:               &now,
+			ValidatorParticipantId: validatorID,
+			OpState:                types.OnboardingState_VALIDATED,
+			EffectiveFrom:          &pastTime,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemID := newParticipant(types.ParticipantRole_ECOSYSTEM, ecosystem, 0)
+
+	revoke := func(ctx sdk.Context, id uint64, mode types.CascadeMode) uint64 {
+		res, err := ms.RevokeParticipant(ctx, &types.MsgRevokeParticipant{
+			Corporation: ecosystem,
+			Operator:    ecosystem,
+			Id:          id,
+			Reason:      types.RevocationReason_REVOCATION_REASON_FRAUD,
+			Cascade:     mode,
+		})
+		require.NoError(t, err)
+		return res.CascadeId
+	}
+	getCascade := func(id uint64) *types.QueryGetParticipantCascadeResponse {
+		res, err := k.GetParticipantCascade(sdkCtx, &types.QueryGetParticipantCascadeRequest{Id: id})
+		require.NoError(t, err)
+		return res
+	}
+	countEvents := func(ctx sdk.Context, eventType string) int {
+		n := 0
+		for _, ev := range ctx.EventManager().Events() {
+			if ev.Type == eventType {
+				n++
+			}
+		}
+		return n
+	}
+
+	t.Run("no cascade leaves descendants untouched", func(t *testing.T) {
+		grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, ecosystemID)
+		issuerID := newParticipant(types.ParticipantRole_ISSUER, issuer, grantorID)
+
+		require.Zero(t, revoke(sdkCtx, grantorID, types.CascadeMode_CASCADE_MODE_UNSPECIFIED))
+		require.NoError(t, k.EndBlocker(sdkCtx))
+
+		p, err := k.GetParticipantByID(sdkCtx, issuerID)
+		require.NoError(t, err)
+		require.Nil(t, p.Revoked)
+	})
+
+	t.Run("revoke cascade runs in bounded batches", func(t *testing.T) {
+		grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, ecosystemID)
+		subGrantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, grantorID)
+		var issuerIDs []uint64
+		for i := 0; i < keeper.MaxCascadedParticipantsPerBlock; i++ {
+			issuerIDs = append(issuerIDs, newParticipant(types.ParticipantRole_ISSUER, issuer, subGrantorID))
+		}
+		// Already revoked descendants are visited but not affected
+		revoke(sdkCtx, issuerIDs[0], types.CascadeMode_CASCADE_MODE_UNSPECIFIED)
+
+		cascadeID := revoke(sdkCtx, grantorID, types.CascadeMode_CASCADE_MODE_REVOKE)
+		require.NotZero(t, cascadeID)
+		res := getCascade(cascadeID)
+		require.Equal(t, uint64(1), res.PendingCount)
+		require.Nil(t, res.Cascade.Completed)
+
+		ctx1 := sdkCtx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.EndBlocker(ctx1))
+		res = getCascade(cascadeID)
+		require.Equal(t, uint64(keeper.MaxCascadedParticipantsPerBlock), res.Cascade.Visited)
+		require.Equal(t, uint64(1), res.PendingCount)
+		require.Nil(t, res.Cascade.Completed)
+		require.Equal(t, keeper.MaxCascadedParticipantsPerBlock-1, countEvents(ctx1, types.EventTypeCascadeParticipant))
+
+		ctx2 := sdkCtx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.EndBlocker(ctx2))
+		res = getCascade(cascadeID)
+		require.Zero(t, res.PendingCount)
+		require.NotNil(t, res.Cascade.Completed)
+		require.Equal(t, uint64(keeper.MaxCascadedParticipantsPerBlock+1), res.Cascade.Visited)
+		require.Equal(t, uint64(keeper.MaxCascadedParticipantsPerBlock), res.Cascade.Affected)
+		require.Equal(t, 1, countEvents(ctx2, types.EventTypeCascadeParticipant))
+		require.Equal(t, 1, countEvents(ctx2, types.EventTypeCompleteCascade))
+
+		for _, id := range append(issuerIDs, subGrantorID) {
+			p, err := k.GetParticipantByID(sdkCtx, id)
+			require.NoError(t, err)
+			require.NotNil(t, p.Revoked)
+			require.Equal(t, types.RevocationReason_REVOCATION_REASON_FRAUD, p.RevocationReason)
+		}
+	})
+
+	t.Run("failing descendant is skipped without halting the chain", func(t *testing.T) {
+		grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, ecosystemID)
+		issuerID := newParticipant(types.ParticipantRole_ISSUER, issuer, grantorID)
+		// The deposit of an unknown corporation cannot be released
+		brokenID, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:               1,
+			Role:                   types.ParticipantRole_ISSUER,
+			CorporationId:          999,
+			Created:                &now,
+			Modified:               &now,
+			ValidatorParticipantId: grantorID,
+			OpState:                types.OnboardingState_VALIDATED,
+			Deposit:                10,
+			EffectiveFrom:          &pastTime,
+		})
+		require.NoError(t, err)
+
+		cascadeID := revoke(sdkCtx, grantorID, types.CascadeMode_CASCADE_MODE_REVOKE)
+		blockCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.EndBlocker(blockCtx))
+		require.Equal(t, 1, countEvents(blockCtx, types.EventTypeCascadeParticipantFailed))
+
+		res := getCascade(cascadeID)
+		require.NotNil(t, res.Cascade.Completed)
+		require.Equal(t, uint64(2), res.Cascade.Visited)
+		require.Equal(t, uint64(1), res.Cascade.Affected)
+
+		p, err := k.GetParticipantByID(sdkCtx, issuerID)
+		require.NoError(t, err)
+		require.NotNil(t, p.Revoked)
+		p, err = k.GetParticipantByID(sdkCtx, brokenID)
+		require.NoError(t, err)
+		require.Nil(t, p.Revoked)
+		require.Equal(t, uint64(10), p.Deposit)
+	})
+
+	t.Run("suspend cascade", func(t *testing.T) {
+		grantorID := newParticipant(types.ParticipantRole_ISSUER_GRANTOR, grantor, ecosystemID)
+		issuerID := newParticipant(types.ParticipantRole_ISSUER, issuer, grantorID)
+
+		cascadeID := revoke(sdkCtx, grantorID, types.CascadeMode_CASCADE_MODE_SUSPEND)
+		require.NoError(t, k.EndBlocker(sdkCtx))
+		require.NotNil(t, getCascade(cascadeID).Cascade.Completed)
+
+		p, err := k.GetParticipantByID(sdkCtx, issuerID)
+		require.NoError(t, err)
+		require.Nil(t, p.Revoked)
+		require.NotNil(t, p.Suspended)
+		require.Equal(t, trID, p.Suspensions[0].SuspenderCorporationId)
+		require.Contains(t, p.Suspensions[0].Reason, "cascade")
+	})
+
+	t.Run("cascade without descendants completes at once", func(t *testing.T) {
+		issuerID := newParticipant(types.ParticipantRole_ISSUER, issuer, ecosystemID)
+		cascadeID := revoke(sdkCtx, issuerID, types.CascadeMode_CASCADE_MODE_REVOKE)
+		require.NotNil(t, getCascade(cascadeID).Cascade.Completed)
+
+		_, err := k.GetParticipantCascade(sdkCtx, &types.QueryGetParticipantCascadeRequest{Id: cascadeID + 1})
+		require.ErrorContains(t, err, "cascade not found")
+	})
+}
+
+func TestExpiryNotices(t *testing.T) {
+	k, _, _, trkKeeper, _, _, ctx := setupTrackingMsgServer(t, "0", "0", "0", 1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params := k.GetParams(sdkCtx)
+	params.ExpiryNoticeLeadDays = []uint64{7, 1}
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	invalid := params
+	invalid.ExpiryNoticeLeadDays = []uint64{7, 7}
+	require.ErrorContains(t, invalid.Validate(), "duplicate lead time")
+	invalid.ExpiryNoticeLeadDays = []uint64{0}
+	require.ErrorContains(t, invalid.Validate(), "lead time must be positive")
+
+	now := sdkCtx.BlockTime()
+	day := 24 * time.Hour
+	effectiveUntil := now.Add(10 * day)
+	newParticipant := func(corp string) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:       1,
+			Role:           types.ParticipantRole_ISSUER,
+			CorporationId:  trkKeeper.RegisterCorp(corp),
+			Created:        &now,
+			Modified:       &now,
+			OpState:        types.OnboardingState_VALIDATED,
+			EffectiveFrom:  &now,
+			EffectiveUntil: &effectiveUntil,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	id := newParticipant(sdk.AccAddress([]byte("issuer_address______")).String())
+	revokedID := newParticipant(sdk.AccAddress([]byte("revoked_address_____")).String())
+	revoked, err := k.GetParticipantByID(sdkCtx, revokedID)
+	require.NoError(t, err)
+	revoked.Revoked = &now
+	require.NoError(t, k.UpdateParticipant(sdkCtx, revoked))
+
+	type notice struct {
+		eventType, participantID, leadDays string
+	}
+	beginBlock := func(at time.Time) []notice {
+		blockCtx := sdkCtx.WithBlockTime(at).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.BeginBlocker(blockCtx))
+		var notices []notice
+		for _, ev := range blockCtx.EventManager().Events() {
+			if ev.Type != types.EventTypeParticipantExpiring && ev.Type != types.EventTypeParticipantExpired {
+				continue
+			}
+			n := notice{eventType: ev.Type}
+			for _, attr := range ev.Attributes {
+				switch attr.Key {
+				case types.AttributeKeyParticipantID:
+					n.participantID = attr.Value
+				case types.AttributeKeyLeadDays:
+					n.leadDays = attr.Value
+				}
+			}
+			notices = append(notices, n)
+		}
+		return notices
+	}
+	pid := strconv.FormatUint(id, 10)
+
+	require.Empty(t, beginBlock(now.Add(2*day)))
+	require.Equal(t, []notice{{types.EventTypeParticipantExpiring, pid, "7"}}, beginBlock(now.Add(3*day)))
+
+	// An update in the block of a notice does not emit it again
+	participant, err := k.GetParticipantByID(sdkCtx, id)
+	require.NoError(t, err)
+	require.NoError(t, k.UpdateParticipant(sdkCtx.WithBlockTime(now.Add(3*day)), participant))
+	require.Empty(t, beginBlock(now.Add(3*day).Add(time.Second)))
+	require.Empty(t, beginBlock(now.Add(4*day)))
+	require.Equal(t, []notice{{types.EventTypeParticipantExpiring, pid, "1"}}, beginBlock(now.Add(9*day)))
+
+	// Moving the deadline drops the notices of the previous one
+	participant, err = k.GetParticipantByID(sdkCtx, id)
+	require.NoError(t, err)
+	extended := now.Add(20 * day)
+	participant.EffectiveUntil = &extended
+	require.NoError(t, k.UpdateParticipant(sdkCtx.WithBlockTime(now.Add(9*day)), participant))
+
+	require.Empty(t, beginBlock(now.Add(10*day)))
+	require.Equal(t, []notice{
+		{types.EventTypeParticipantExpiring, pid, "7"},
+		{types.EventTypeParticipantExpiring, pid, "1"},
+		{types.EventTypeParticipantExpired, pid, ""},
+	}, beginBlock(extended))
+	require.Empty(t, beginBlock(extended.Add(day)))
+
+	// A notice of a missing participant is skipped without halting the chain
+	missingAt := extended.Add(2 * day)
+	require.NoError(t, k.ExpiryNotice.Set(sdkCtx, collections.Join3(missingAt, uint64(999), uint64(0))))
+	blockCtx := sdkCtx.WithBlockTime(missingAt).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(blockCtx))
+	var failed int
+	for _, ev := range blockCtx.EventManager().Events() {
+		if ev.Type == types.EventTypeParticipantExpiryNoticeFailed {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed)
+}
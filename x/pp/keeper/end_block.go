@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// MaxPrunedParticipantSessionsPerBlock bounds the number of expired
+// participant sessions removed in a single EndBlocker run, so that a large
+// backlog is drained over several blocks instead of one.
+const MaxPrunedParticipantSessionsPerBlock = 100
+
+// EndBlocker confirms the slashes whose dispute window closed without appeal,
+// advances the pending revocation cascades and prunes participant sessions
+// that were not modified for longer than participant_session_retention_days.
+// Slash finalization, cascade and pruning failures are logged and never halt
+// the chain.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+
+	if err := k.finalizeExpiredSlashes(sdkCtx, sdkCtx.BlockTime(), MaxFinalizedSlashesPerBlock); err != nil {
+		k.Logger().Error("failed to finalize slashes", "error", err)
+	}
+
+	if err := k.processParticipantCascades(sdkCtx, sdkCtx.BlockTime(), MaxCascadedParticipantsPerBlock); err != nil {
+		k.Logger().Error("failed to process participant cascades", "error", err)
+	}
+
+	// Retention disabled, sessions are kept forever
+	if params.ParticipantSessionRetentionDays == 0 {
+		return nil
+	}
+
+	cutoff := sdkCtx.BlockTime().AddDate(0, 0, -int(params.ParticipantSessionRetentionDays))
+	// Pruning is retried next block, a failure leaves no partial write
+	cacheCtx, write := sdkCtx.CacheContext()
+	pruned, err := k.pruneParticipantSessions(cacheCtx, cutoff, MaxPrunedParticipantSessionsPerBlock)
+	if err != nil {
+		k.Logger().Error("failed to prune participant sessions", "error", err)
+		return nil
+	}
+	write()
+
+	if pruned > 0 {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneParticipantSessions,
+				sdk.NewAttribute(types.AttributeKeyPrunedCount, strconv.Itoa(pruned)),
+				sdk.NewAttribute(types.AttributeKeyCutoff, cutoff.Format(time.RFC3339)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// pruneParticipantSessions removes up to limit sessions last modified strictly
+// before cutoff, oldest first, and returns the number removed.
+func (k Keeper) pruneParticipantSessions(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	rng := new(collections.Range[collections.Pair[time.Time, string]]).
+		EndExclusive(collections.Join(cutoff, ""))
+
+	// Collect first, the index cannot be mutated while it is iterated
+	var keys []collections.Pair[time.Time, string]
+	err := k.ParticipantSessionByModified.Walk(ctx, rng, func(key collections.Pair[time.Time, string]) (bool, error) {
+		keys = append(keys, key)
+		return len(keys) >= limit, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		session, err := k.ParticipantSession.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			// Stale index entry, drop it
+			if err := k.ParticipantSessionByModified.Remove(ctx, key); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if err := k.removeParticipantSession(ctx, session); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(keys), nil
+}
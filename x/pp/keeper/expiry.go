@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// MaxExpiryNoticesPerBlock bounds the number of expiry notices emitted in a
+// single BeginBlocker run.
+const MaxExpiryNoticesPerBlock = 100
+
+// Deadlines of a participant covered by expiry notices
+const (
+	ExpiryFieldEffectiveUntil = "effective_until"
+	ExpiryFieldOpExp          = "op_exp"
+)
+
+// participantDeadlines returns the expiry deadlines of participant by field.
+func participantDeadlines(participant types.Participant) map[string]time.Time {
+	deadlines := make(map[string]time.Time, 2)
+	if participant.EffectiveUntil != nil {
+		deadlines[ExpiryFieldEffectiveUntil] = *participant.EffectiveUntil
+	}
+	if participant.OpExp != nil {
+		deadlines[ExpiryFieldOpExp] = *participant.OpExp
+	}
+	return deadlines
+}
+
+// setExpiryNoticeIndexes adds the upcoming expiry notices of participant to
+// the index walked by the BeginBlocker: one per configured lead time and one
+// at expiry, for each deadline. Entries left behind by a deadline that moved
+// no longer match the participant and are dropped when reached.
+func (k Keeper) setExpiryNoticeIndexes(ctx context.Context, participant types.Participant) error {
+	if participant.Revoked != nil {
+		return nil
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	leadDays := append([]uint64{0}, k.GetParams(ctx).ExpiryNoticeLeadDays...)
+	for _, deadline := range participantDeadlines(participant) {
+		for _, days := range leadDays {
+			at := deadline.AddDate(0, 0, -int(days))
+			// A notice due now was emitted by this block's BeginBlocker
+			if !at.After(now) {
+				continue
+			}
+			if err := k.ExpiryNotice.Set(ctx, collections.Join3(at, participant.Id, days)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rebuildExpiryNoticeIndexes adds the expiry notices of every participant,
+// used when expiry_notice_lead_days changes.
+func (k Keeper) rebuildExpiryNoticeIndexes(ctx context.Context) error {
+	return k.Participant.Walk(ctx, nil, func(_ uint64, participant types.Participant) (bool, error) {
+		return false, k.setExpiryNoticeIndexes(ctx, participant)
+	})
+}
+
+// emitExpiryNotices emits up to limit expiry notices due at or before now,
+// oldest first: participant_expiring for a lead time, participant_expired at
+// expiry. Notices of revoked participants, of lead times no longer
+// configured or of deadlines that moved are dropped. Each notice is emitted in
+// its own cache context, a failure is logged and reported by a
+// participant_expiry_notice_failed event and the notice is skipped.
+func (k Keeper) emitExpiryNotices(ctx sdk.Context, now time.Time, limit int) error {
+	rng := new(collections.Range[collections.Triple[time.Time, uint64, uint64]]).
+		EndInclusive(collections.Join3(now, uint64(math.MaxUint64), uint64(math.MaxUint64)))
+
+	// Collect first, the index cannot be mutated while it is iterated
+	var keys []collections.Triple[time.Time, uint64, uint64]
+	err := k.ExpiryNotice.Walk(ctx, rng, func(key collections.Triple[time.Time, uint64, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return len(keys) >= limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	leadDays := k.GetParams(ctx).ExpiryNoticeLeadDays
+	for _, key := range keys {
+		if err := k.ExpiryNotice.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.emitExpiryNotice(cacheCtx, key, leadDays, now); err != nil {
+			k.Logger().Error("failed to emit expiry notice", "participant_id", key.K2(), "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParticipantExpiryNoticeFailed,
+					sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(key.K2(), 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// emitExpiryNotice emits the notice of the expiry notice index entry key.
+func (k Keeper) emitExpiryNotice(ctx sdk.Context, key collections.Triple[time.Time, uint64, uint64], leadDays []uint64, now time.Time) error {
+	at, id, days := key.K1(), key.K2(), key.K3()
+	if days != 0 && !slices.Contains(leadDays, days) {
+		return nil
+	}
+
+	participant, err := k.Participant.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if participant.Revoked != nil {
+		return nil
+	}
+
+	// Sorted for a deterministic event order
+	deadlines := participantDeadlines(participant)
+	for _, field := range []string{ExpiryFieldEffectiveUntil, ExpiryFieldOpExp} {
+		deadline, ok := deadlines[field]
+		if !ok || !deadline.AddDate(0, 0, -int(days)).Equal(at) {
+			continue
+		}
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyExpiryField, field),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, deadline.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		}
+		eventType := types.EventTypeParticipantExpired
+		if days != 0 {
+			eventType = types.EventTypeParticipantExpiring
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyLeadDays, strconv.FormatUint(days, 10)))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+	}
+
+	return nil
+}
@@ -0,0 +1,377 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	credentialschematypes "github.com/verana-labs/verana/x/cs/types"
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// MaxAppliedFeeChangesPerBlock bounds the number of scheduled fee changes
+// applied in a single BeginBlocker run.
+const MaxAppliedFeeChangesPerBlock = 100
+
+// ProposeParticipantFeeChange handles the MsgProposeParticipantFeeChange message
+func (ms msgServer) ProposeParticipantFeeChange(goCtx context.Context, msg *types.MsgProposeParticipantFeeChange) (*types.MsgProposeParticipantFeeChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// [AUTHZ-CHECK] Verify operator authorization
+	if ms.delegationKeeper == nil {
+		return nil, fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.delegationKeeper.CheckOperatorAuthorization(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.pp.v1.MsgProposeParticipantFeeChange",
+		now,
+	); err != nil {
+		return nil, fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	// Load the participant, it MUST be an active participant
+	participant, err := ms.Keeper.GetParticipantByID(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("participant not found: %w", err)
+	}
+	if err := IsValidParticipant(participant, now); err != nil {
+		return nil, fmt.Errorf("participant is not active: %w", err)
+	}
+
+	proposerCorpID, err := ms.corpIDFromAccount(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fee change MUST be proposed by the active validator participant, or
+	// by the controlling Ecosystem for participants without validator
+	var validatorParticipant types.Participant
+	if participant.ValidatorParticipantId != 0 {
+		validatorParticipant, err = ms.Keeper.GetParticipantByID(ctx, participant.ValidatorParticipantId)
+		if err != nil {
+			return nil, fmt.Errorf("validator participant not found: %w", err)
+		}
+		if err := IsValidParticipant(validatorParticipant, now); err != nil {
+			return nil, fmt.Errorf("validator participant is not valid: %w", err)
+		}
+		if validatorParticipant.CorporationId != proposerCorpID {
+			return nil, fmt.Errorf("only the validator participant corporation can propose a fee change")
+		}
+	} else if !ms.checkEcosystemControllerOption(ctx, msg.Corporation, participant) {
+		return nil, fmt.Errorf("only the ecosystem controller can propose a fee change for a participant without validator")
+	}
+
+	// Discounts follow the same rules as in SetParticipantOPToValidated
+	cs, err := ms.credentialSchemaKeeper.GetCredentialSchemaById(ctx, participant.SchemaId)
+	if err != nil {
+		return nil, fmt.Errorf("credential schema not found: %w", err)
+	}
+	if err := validateFeeDiscounts(cs, participant, validatorParticipant, msg.IssuanceFeeDiscount, msg.VerificationFeeDiscount); err != nil {
+		return nil, err
+	}
+
+	// A new proposal replaces any pending change, including an accepted one
+	if err := ms.removeScheduledFeeChangeIndex(ctx, participant); err != nil {
+		return nil, err
+	}
+
+	participant.PendingFeeChange = &types.ParticipantFeeChange{
+		ValidationFees:          msg.ValidationFees,
+		IssuanceFees:            msg.IssuanceFees,
+		VerificationFees:        msg.VerificationFees,
+		IssuanceFeeDiscount:     msg.IssuanceFeeDiscount,
+		VerificationFeeDiscount: msg.VerificationFeeDiscount,
+		ProposerCorporationId:   proposerCorpID,
+		Proposed:                &now,
+	}
+	participant.Modified = &now
+
+	if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProposeParticipantFeeChange,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyValidationFees, strconv.FormatUint(msg.ValidationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuanceFees, strconv.FormatUint(msg.IssuanceFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerificationFees, strconv.FormatUint(msg.VerificationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuanceFeeDiscount, strconv.FormatUint(msg.IssuanceFeeDiscount, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerificationFeeDiscount, strconv.FormatUint(msg.VerificationFeeDiscount, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgProposeParticipantFeeChangeResponse{}, nil
+}
+
+// AcceptParticipantFeeChange handles the MsgAcceptParticipantFeeChange message
+func (ms msgServer) AcceptParticipantFeeChange(goCtx context.Context, msg *types.MsgAcceptParticipantFeeChange) (*types.MsgAcceptParticipantFeeChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// [AUTHZ-CHECK] Verify operator authorization
+	if ms.delegationKeeper == nil {
+		return nil, fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.delegationKeeper.CheckOperatorAuthorization(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.pp.v1.MsgAcceptParticipantFeeChange",
+		now,
+	); err != nil {
+		return nil, fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	participant, err := ms.Keeper.GetParticipantByID(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("participant not found: %w", err)
+	}
+
+	// The fee change MUST be accepted by the participant corporation
+	corpID, err := ms.corpIDFromAccount(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+	if participant.CorporationId != corpID {
+		return nil, fmt.Errorf("corporation is not the participant corporation")
+	}
+
+	if err := IsValidParticipant(participant, now); err != nil {
+		return nil, fmt.Errorf("participant is not active: %w", err)
+	}
+
+	change := participant.PendingFeeChange
+	if change == nil {
+		return nil, fmt.Errorf("participant has no pending fee change")
+	}
+	if change.Accepted != nil {
+		return nil, fmt.Errorf("pending fee change was already accepted")
+	}
+
+	params := ms.GetParams(ctx)
+	effective := now.AddDate(0, 0, int(params.FeeChangeNoticePeriodDays))
+	change.Accepted = &now
+	change.Effective = &effective
+	participant.Modified = &now
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptParticipantFeeChange,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyEffective, effective.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	// Without notice period the change applies right away
+	if !effective.After(now) {
+		if err := ms.applyFeeChange(ctx, participant, now); err != nil {
+			return nil, err
+		}
+		return &types.MsgAcceptParticipantFeeChangeResponse{Effective: effective}, nil
+	}
+
+	if err := ms.setScheduledFeeChangeIndex(ctx, participant); err != nil {
+		return nil, err
+	}
+	if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	return &types.MsgAcceptParticipantFeeChangeResponse{Effective: effective}, nil
+}
+
+// validateFeeDiscounts checks the range and the applicability of the issuance
+// and verification fee discounts of applicant, given its credential schema
+// management modes and its validator participant.
+func validateFeeDiscounts(cs credentialschematypes.CredentialSchema, applicant, validator types.Participant, issuanceFeeDiscount, verificationFeeDiscount uint64) error {
+	const maxDiscount = 10000 // 10000 = 1.0 = 100% discount
+
+	// Validate issuance_fee_discount
+	if issuanceFeeDiscount > maxDiscount {
+		return fmt.Errorf("issuance_fee_discount cannot exceed %d (100%% discount)", maxDiscount)
+	}
+
+	// Only validate applicability if discount > 0 (0 is always allowed as default)
+	if issuanceFeeDiscount > 0 {
+		if cs.IssuerOnboardingMode == credentialschematypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS {
+			if applicant.Role == types.ParticipantRole_ISSUER_GRANTOR {
+				// ISSUER_GRANTOR: can set 0-1 (100% discount)
+				// Already validated range above
+			} else if applicant.Role == types.ParticipantRole_ISSUER {
+				// ISSUER in GRANTOR mode: if validator_participant.issuance_fee_discount is defined,
+				// can only set 0 to validator_participant.issuance_fee_discount inclusive
+				if validator.IssuanceFeeDiscount > 0 {
+					if issuanceFeeDiscount > validator.IssuanceFeeDiscount {
+						return fmt.Errorf("issuance_fee_discount cannot exceed validator's discount of %d", validator.IssuanceFeeDiscount)
+					}
+				}
+			} else {
+				return fmt.Errorf("issuance_fee_discount can only be set on ISSUER_GRANTOR or ISSUER participants in GRANTOR mode")
+			}
+		} else if cs.IssuerOnboardingMode == credentialschematypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_ECOSYSTEM_VALIDATION_PROCESS {
+			if applicant.Role == types.ParticipantRole_ISSUER {
+				// ISSUER in ECOSYSTEM mode: can set 0-1 (100% discount)
+				// Already validated range above
+			} else {
+				return fmt.Errorf("issuance_fee_discount can only be set on ISSUER participants in ECOSYSTEM mode")
+			}
+		} else {
+			// OPEN mode or other - issuance_fee_discount not applicable
+			return fmt.Errorf("issuance_fee_discount cannot be set in this participant management mode")
+		}
+	}
+
+	// Validate verification_fee_discount
+	if verificationFeeDiscount > maxDiscount {
+		return fmt.Errorf("verification_fee_discount cannot exceed %d (100%% discount)", maxDiscount)
+	}
+
+	// Only validate applicability if discount > 0 (0 is always allowed as default)
+	if verificationFeeDiscount > 0 {
+		if cs.VerifierOnboardingMode == credentialschematypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS {
+			if applicant.Role == types.ParticipantRole_VERIFIER_GRANTOR {
+				// VERIFIER_GRANTOR: can set 0-1 (100% discount)
+				// Already validated range above
+			} else if applicant.Role == types.ParticipantRole_VERIFIER {
+				// VERIFIER in GRANTOR mode: if validator_participant.verification_fee_discount is defined,
+				// can only set 0 to validator_participant.verification_fee_discount inclusive
+				if validator.VerificationFeeDiscount > 0 {
+					if verificationFeeDiscount > validator.VerificationFeeDiscount {
+						return fmt.Errorf("verification_fee_discount cannot exceed validator's discount of %d", validator.VerificationFeeDiscount)
+					}
+				}
+			} else {
+				return fmt.Errorf("verification_fee_discount can only be set on VERIFIER_GRANTOR or VERIFIER participants in GRANTOR mode")
+			}
+		} else if cs.VerifierOnboardingMode == credentialschematypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_ECOSYSTEM_VALIDATION_PROCESS {
+			if applicant.Role == types.ParticipantRole_VERIFIER {
+				// VERIFIER in ECOSYSTEM mode: can set 0-1 (100% discount)
+				// Already validated range above
+			} else {
+				return fmt.Errorf("verification_fee_discount can only be set on VERIFIER participants in ECOSYSTEM mode")
+			}
+		} else {
+			// OPEN mode or other - verification_fee_discount not applicable
+			return fmt.Errorf("verification_fee_discount cannot be set in this participant management mode")
+		}
+	}
+
+	return nil
+}
+
+// removeScheduledFeeChangeIndex removes the index entry of an accepted fee
+// change that is being replaced.
+func (k Keeper) removeScheduledFeeChangeIndex(ctx context.Context, participant types.Participant) error {
+	change := participant.PendingFeeChange
+	if change == nil || change.Effective == nil {
+		return nil
+	}
+	return k.ScheduledFeeChange.Remove(ctx, collections.Join(*change.Effective, participant.Id))
+}
+
+// applyFeeChange replaces the fees and discounts of participant with its
+// accepted pending fee change.
+func (k Keeper) applyFeeChange(ctx sdk.Context, participant types.Participant, now time.Time) error {
+	change := participant.PendingFeeChange
+	participant.ValidationFees = change.ValidationFees
+	participant.IssuanceFees = change.IssuanceFees
+	participant.VerificationFees = change.VerificationFees
+	participant.IssuanceFeeDiscount = change.IssuanceFeeDiscount
+	participant.VerificationFeeDiscount = change.VerificationFeeDiscount
+	participant.PendingFeeChange = nil
+	participant.Modified = &now
+
+	if err := k.UpdateParticipant(ctx, participant); err != nil {
+		return fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApplyParticipantFeeChange,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(participant.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidationFees, strconv.FormatUint(participant.ValidationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuanceFees, strconv.FormatUint(participant.IssuanceFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerificationFees, strconv.FormatUint(participant.VerificationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuanceFeeDiscount, strconv.FormatUint(participant.IssuanceFeeDiscount, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerificationFeeDiscount, strconv.FormatUint(participant.VerificationFeeDiscount, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return nil
+}
+
+// applyScheduledFeeChanges applies up to limit accepted fee changes whose
+// effective time is reached, oldest first. Each change is applied in its own
+// cache context: a failure is logged and reported by an
+// apply_participant_fee_change_failed event, and the change is skipped so that
+// it does not halt the chain nor block the following ones.
+func (k Keeper) applyScheduledFeeChanges(ctx sdk.Context, now time.Time, limit int) error {
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(now, uint64(math.MaxUint64)))
+
+	// Collect first, the index cannot be mutated while it is iterated
+	var keys []collections.Pair[time.Time, uint64]
+	err := k.ScheduledFeeChange.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return len(keys) >= limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.ScheduledFeeChange.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.applyScheduledFeeChange(cacheCtx, key.K2(), key.K1(), now); err != nil {
+			k.Logger().Error("failed to apply scheduled fee change", "participant_id", key.K2(), "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeApplyParticipantFeeChangeFailed,
+					sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(key.K2(), 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// applyScheduledFeeChange applies the fee change of participant id scheduled
+// at effective, unless a newer proposal superseded it.
+func (k Keeper) applyScheduledFeeChange(ctx sdk.Context, id uint64, effective time.Time, now time.Time) error {
+	participant, err := k.Participant.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	change := participant.PendingFeeChange
+	if change == nil || change.Effective == nil || !change.Effective.Equal(effective) {
+		return nil
+	}
+
+	return k.applyFeeChange(ctx, participant, now)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -28,6 +29,30 @@ type (
 		ParticipantCounter collections.Item[uint64]
 		ParticipantSession collections.Map[string, types.ParticipantSession]
 
+		// ParticipantSession indexes, maintained by setParticipantSession and
+		// removeParticipantSession
+		ParticipantSessionByCorporation collections.KeySet[collections.Pair[uint64, string]]
+		ParticipantSessionByVSOperator  collections.KeySet[collections.Pair[string, string]]
+		ParticipantSessionByParticipant collections.KeySet[collections.Pair[uint64, string]]
+		ParticipantSessionByModified    collections.KeySet[collections.Pair[time.Time, string]]
+
+		// RevokedParticipant indexes revoked participants by revocation time
+		RevokedParticipant collections.KeySet[collections.Pair[time.Time, uint64]]
+		// ScheduledFeeChange indexes accepted fee changes by effective time
+		ScheduledFeeChange collections.KeySet[collections.Pair[time.Time, uint64]]
+		// SlashDisputeDeadline indexes pending slashes by dispute deadline
+		SlashDisputeDeadline collections.KeySet[collections.Pair[time.Time, uint64]]
+		// ParticipantByValidator indexes participants by validator participant
+		ParticipantByValidator collections.KeySet[collections.Pair[uint64, uint64]]
+
+		// ParticipantCascade holds the revocation cascades, the ones in
+		// progress being indexed by PendingParticipantCascade
+		ParticipantCascade        collections.Map[uint64, types.ParticipantCascade]
+		ParticipantCascadeCounter collections.Item[uint64]
+		PendingParticipantCascade collections.KeySet[uint64]
+		// ExpiryNotice indexes the expiry notices to emit by notice time
+		ExpiryNotice collections.KeySet[collections.Triple[time.Time, uint64, uint64]]
+
 		// external keeper
 		credentialSchemaKeeper types.CredentialSchemaKeeper
 		ecosystemKeeper        types.EcosystemKeeper
@@ -59,13 +84,34 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:                    cdc,
-		storeService:           storeService,
-		authority:              authority,
-		logger:                 logger,
-		Participant:            collections.NewMap(sb, types.ParticipantKey, "participant", collections.Uint64Key, codec.CollValue[types.Participant](cdc)),
-		ParticipantCounter:     collections.NewItem(sb, types.ParticipantCounterKey, "participant_counter", collections.Uint64Value),
-		ParticipantSession:     collections.NewMap(sb, types.ParticipantSessionKey, "participant_session", collections.StringKey, codec.CollValue[types.ParticipantSession](cdc)),
+		cdc:                cdc,
+		storeService:       storeService,
+		authority:          authority,
+		logger:             logger,
+		Participant:        collections.NewMap(sb, types.ParticipantKey, "participant", collections.Uint64Key, codec.CollValue[types.Participant](cdc)),
+		ParticipantCounter: collections.NewItem(sb, types.ParticipantCounterKey, "participant_counter", collections.Uint64Value),
+		ParticipantSession: collections.NewMap(sb, types.ParticipantSessionKey, "participant_session", collections.StringKey, codec.CollValue[types.ParticipantSession](cdc)),
+		ParticipantSessionByCorporation: collections.NewKeySet(sb, types.ParticipantSessionByCorporationKey, "participant_session_by_corporation",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		ParticipantSessionByVSOperator: collections.NewKeySet(sb, types.ParticipantSessionByVSOperatorKey, "participant_session_by_vs_operator",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ParticipantSessionByParticipant: collections.NewKeySet(sb, types.ParticipantSessionByParticipantKey, "participant_session_by_participant",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		ParticipantSessionByModified: collections.NewKeySet(sb, types.ParticipantSessionByModifiedKey, "participant_session_by_modified",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		RevokedParticipant: collections.NewKeySet(sb, types.RevokedParticipantKey, "revoked_participant",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		ScheduledFeeChange: collections.NewKeySet(sb, types.ScheduledFeeChangeKey, "scheduled_fee_change",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		SlashDisputeDeadline: collections.NewKeySet(sb, types.SlashDisputeDeadlineKey, "slash_dispute_deadline",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		ParticipantByValidator: collections.NewKeySet(sb, types.ParticipantByValidatorKey, "participant_by_validator",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		ParticipantCascade:        collections.NewMap(sb, types.ParticipantCascadeKey, "participant_cascade", collections.Uint64Key, codec.CollValue[types.ParticipantCascade](cdc)),
+		ParticipantCascadeCounter: collections.NewItem(sb, types.ParticipantCascadeCounterKey, "participant_cascade_counter", collections.Uint64Value),
+		PendingParticipantCascade: collections.NewKeySet(sb, types.PendingParticipantCascadeKey, "pending_participant_cascade", collections.Uint64Key),
+		ExpiryNotice: collections.NewKeySet(sb, types.ExpiryNoticeKey, "expiry_notice",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.Uint64Key, collections.Uint64Key)),
 		credentialSchemaKeeper: credentialSchemaKeeper,
 		ecosystemKeeper:        ecosystemKeeper,
 		coKeeper:               coKeeper,
@@ -100,6 +146,12 @@ func (k Keeper) CreateParticipant(ctx sdk.Context, participant types.Participant
 	if err := k.Participant.Set(ctx, id, participant); err != nil {
 		return 0, err
 	}
+	if err := k.setParticipantByValidatorIndex(ctx, participant); err != nil {
+		return 0, err
+	}
+	if err := k.setExpiryNoticeIndexes(ctx, participant); err != nil {
+		return 0, err
+	}
 
 	return id, nil
 }
@@ -121,7 +173,100 @@ func (k Keeper) getNextParticipantID(ctx sdk.Context) (uint64, error) {
 }
 
 func (k Keeper) UpdateParticipant(ctx sdk.Context, participant types.Participant) error {
-	return k.Participant.Set(ctx, participant.Id, participant)
+	if err := k.Participant.Set(ctx, participant.Id, participant); err != nil {
+		return err
+	}
+	// effective_until and op_exp may have moved
+	return k.setExpiryNoticeIndexes(ctx, participant)
+}
+
+// setRevokedParticipantIndex adds a revoked participant to the revocation
+// index used by ListRevokedParticipants.
+func (k Keeper) setRevokedParticipantIndex(ctx context.Context, participant types.Participant) error {
+	if participant.Revoked == nil {
+		return nil
+	}
+	return k.RevokedParticipant.Set(ctx, collections.Join(*participant.Revoked, participant.Id))
+}
+
+// setScheduledFeeChangeIndex adds an accepted fee change to the index
+// walked by the BeginBlocker to apply it at its effective time.
+func (k Keeper) setScheduledFeeChangeIndex(ctx context.Context, participant types.Participant) error {
+	change := participant.PendingFeeChange
+	if change == nil || change.Effective == nil {
+		return nil
+	}
+	return k.ScheduledFeeChange.Set(ctx, collections.Join(*change.Effective, participant.Id))
+}
+
+// setSlashDisputeDeadlineIndexes adds the pending slashes of a participant
+// to the index walked by the EndBlocker to finalize them at their dispute
+// deadline.
+func (k Keeper) setSlashDisputeDeadlineIndexes(ctx context.Context, participant types.Participant) error {
+	for _, slash := range participant.Slashes {
+		if slash == nil || slash.Status != types.SlashStatus_SLASH_STATUS_PENDING || slash.DisputeDeadline == nil {
+			continue
+		}
+		if err := k.SlashDisputeDeadline.Set(ctx, collections.Join(*slash.DisputeDeadline, participant.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setParticipantByValidatorIndex adds a participant to the index of the
+// participants validated by its validator participant, walked by revocation
+// cascades. validator_participant_id never changes once set.
+func (k Keeper) setParticipantByValidatorIndex(ctx context.Context, participant types.Participant) error {
+	if participant.ValidatorParticipantId == 0 {
+		return nil
+	}
+	return k.ParticipantByValidator.Set(ctx, collections.Join(participant.ValidatorParticipantId, participant.Id))
+}
+
+// RebuildParticipantIndexes recreates the revocation, scheduled fee change,
+// slash dispute deadline, validator and expiry notice indexes from the
+// stored participants, and the pending cascade index from the stored
+// cascades. Used by genesis import and the v2 store migration.
+func (k Keeper) RebuildParticipantIndexes(ctx context.Context) error {
+	err := k.Participant.Walk(ctx, nil, func(_ uint64, participant types.Participant) (bool, error) {
+		if err := k.setRevokedParticipantIndex(ctx, participant); err != nil {
+			return true, err
+		}
+		if err := k.setScheduledFeeChangeIndex(ctx, participant); err != nil {
+			return true, err
+		}
+		if err := k.setParticipantByValidatorIndex(ctx, participant); err != nil {
+			return true, err
+		}
+		if err := k.setExpiryNoticeIndexes(ctx, participant); err != nil {
+			return true, err
+		}
+		return false, k.setSlashDisputeDeadlineIndexes(ctx, participant)
+	})
+	if err != nil {
+		return err
+	}
+	return k.ParticipantCascade.Walk(ctx, nil, func(id uint64, cascade types.ParticipantCascade) (bool, error) {
+		if cascade.Completed != nil {
+			return false, nil
+		}
+		return false, k.PendingParticipantCascade.Set(ctx, id)
+	})
+}
+
+// suspensionAt returns the suspension of participant in effect at t, if any.
+// A suspension applies from suspended included to unsuspended excluded.
+func suspensionAt(participant types.Participant, t time.Time) *types.ParticipantSuspension {
+	for _, suspension := range participant.Suspensions {
+		if suspension == nil || suspension.Suspended == nil || t.Before(*suspension.Suspended) {
+			continue
+		}
+		if suspension.Unsuspended == nil || t.Before(*suspension.Unsuspended) {
+			return suspension
+		}
+	}
+	return nil
 }
 
 // IsValidParticipant checks if a participant is valid for a given time
@@ -130,6 +275,7 @@ func (k Keeper) UpdateParticipant(ctx sdk.Context, participant types.Participant
 // - Is not revoked
 // - Is not slashed
 // - Is not repaid
+// - Is not suspended
 // According to the spec, if validator participant is INACTIVE (not valid), it must abort.
 // INACTIVE means: effective_from is null OR effective_from equals now() exactly (not before).
 func IsValidParticipant(participant types.Participant, checkTime time.Time) error {
@@ -150,6 +296,11 @@ func IsValidParticipant(participant types.Participant, checkTime time.Time) erro
 		return fmt.Errorf("participant is revoked since %v", participant.Revoked)
 	}
 
+	// Check if participant is suspended at checkTime
+	if suspension := suspensionAt(participant, checkTime); suspension != nil {
+		return fmt.Errorf("participant is suspended since %v", suspension.Suspended)
+	}
+
 	// Check if participant is expired (EXPIRED state)
 	if participant.EffectiveUntil != nil && !checkTime.Before(*participant.EffectiveUntil) {
 		return fmt.Errorf("participant expired: ended at %v", participant.EffectiveUntil)
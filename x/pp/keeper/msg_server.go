@@ -186,7 +186,7 @@ func (ms msgServer) executeRenewParticipantVP(ctx sdk.Context, participant types
 		if err != nil {
 			return err
 		}
-		if err := ms.trustDeposit.AdjustTrustDeposit(ctx, corpAcct, depositI64, "renew_participant_deposit"); err != nil {
+		if err := ms.trustDeposit.AdjustTrustDeposit(ctx, participant.CorporationId, depositI64, "renew_participant_deposit"); err != nil {
 			return fmt.Errorf("failed to increase trust deposit: %w", err)
 		}
 	}
@@ -292,8 +292,6 @@ func (ms msgServer) SetParticipantOPToValidated(goCtx context.Context, msg *type
 		return nil, fmt.Errorf("validator participant not found: %w", err)
 	}
 
-	const maxDiscount = 10000 // 10000 = 1.0 = 100% discount
-
 	// If renewal, discount must equal existing discount
 	if applicantParticipant.EffectiveFrom != nil {
 		if msg.IssuanceFeeDiscount != applicantParticipant.IssuanceFeeDiscount {
@@ -304,74 +302,8 @@ func (ms msgServer) SetParticipantOPToValidated(goCtx context.Context, msg *type
 		}
 	} else {
 		// First time validation - validate discount range and applicability
-		// Validate issuance_fee_discount
-		if msg.IssuanceFeeDiscount > maxDiscount {
-			return nil, fmt.Errorf("issuance_fee_discount cannot exceed %d (100%% discount)", maxDiscount)
-		}
-
-		// Only validate applicability if discount > 0 (0 is always allowed as default)
-		if msg.IssuanceFeeDiscount > 0 {
-			if cs.IssuerOnboardingMode == credentialschematypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS {
-				if applicantParticipant.Role == types.ParticipantRole_ISSUER_GRANTOR {
-					// ISSUER_GRANTOR: can set 0-1 (100% discount)
-					// Already validated range above
-				} else if applicantParticipant.Role == types.ParticipantRole_ISSUER {
-					// ISSUER in GRANTOR mode: if validator_participant.issuance_fee_discount is defined,
-					// can only set 0 to validator_participant.issuance_fee_discount inclusive
-					if validatorParticipant.IssuanceFeeDiscount > 0 {
-						if msg.IssuanceFeeDiscount > validatorParticipant.IssuanceFeeDiscount {
-							return nil, fmt.Errorf("issuance_fee_discount cannot exceed validator's discount of %d", validatorParticipant.IssuanceFeeDiscount)
-						}
-					}
-				} else {
-					return nil, fmt.Errorf("issuance_fee_discount can only be set on ISSUER_GRANTOR or ISSUER participants in GRANTOR mode")
-				}
-			} else if cs.IssuerOnboardingMode == credentialschematypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_ECOSYSTEM_VALIDATION_PROCESS {
-				if applicantParticipant.Role == types.ParticipantRole_ISSUER {
-					// ISSUER in ECOSYSTEM mode: can set 0-1 (100% discount)
-					// Already validated range above
-				} else {
-					return nil, fmt.Errorf("issuance_fee_discount can only be set on ISSUER participants in ECOSYSTEM mode")
-				}
-			} else {
-				// OPEN mode or other - issuance_fee_discount not applicable
-				return nil, fmt.Errorf("issuance_fee_discount cannot be set in this participant management mode")
-			}
-		}
-
-		// Validate verification_fee_discount
-		if msg.VerificationFeeDiscount > maxDiscount {
-			return nil, fmt.Errorf("verification_fee_discount cannot exceed %d (100%% discount)", maxDiscount)
-		}
-
-		// Only validate applicability if discount > 0 (0 is always allowed as default)
-		if msg.VerificationFeeDiscount > 0 {
-			if cs.VerifierOnboardingMode == credentialschematypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS {
-				if applicantParticipant.Role == types.ParticipantRole_VERIFIER_GRANTOR {
-					// VERIFIER_GRANTOR: can set 0-1 (100% discount)
-					// Already validated range above
-				} else if applicantParticipant.Role == types.ParticipantRole_VERIFIER {
-					// VERIFIER in GRANTOR mode: if validator_participant.verification_fee_discount is defined,
-					// can only set 0 to validator_participant.verification_fee_discount inclusive
-					if validatorParticipant.VerificationFeeDiscount > 0 {
-						if msg.VerificationFeeDiscount > validatorParticipant.VerificationFeeDiscount {
-							return nil, fmt.Errorf("verification_fee_discount cannot exceed validator's discount of %d", validatorParticipant.VerificationFeeDiscount)
-						}
-					}
-				} else {
-					return nil, fmt.Errorf("verification_fee_discount can only be set on VERIFIER_GRANTOR or VERIFIER participants in GRANTOR mode")
-				}
-			} else if cs.VerifierOnboardingMode == credentialschematypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_ECOSYSTEM_VALIDATION_PROCESS {
-				if applicantParticipant.Role == types.ParticipantRole_VERIFIER {
-					// VERIFIER in ECOSYSTEM mode: can set 0-1 (100% discount)
-					// Already validated range above
-				} else {
-					return nil, fmt.Errorf("verification_fee_discount can only be set on VERIFIER participants in ECOSYSTEM mode")
-				}
-			} else {
-				// OPEN mode or other - verification_fee_discount not applicable
-				return nil, fmt.Errorf("verification_fee_discount cannot be set in this participant management mode")
-			}
+		if err := validateFeeDiscounts(cs, applicantParticipant, validatorParticipant, msg.IssuanceFeeDiscount, msg.VerificationFeeDiscount); err != nil {
+			return nil, err
 		}
 	}
 
@@ -565,7 +497,7 @@ func (ms msgServer) executeCancelParticipantVPLastRequest(ctx sdk.Context, parti
 		// to move funds from deposit to claimable
 		if err := ms.trustDeposit.AdjustTrustDeposit(
 			ctx,
-			corpAcct,
+			participant.CorporationId,
 			-currentDepositI64, // Negative value to reduce deposit and increase claimable
 			"participant_deactivate_release_deposit",
 		); err != nil {
@@ -805,6 +737,12 @@ func (ms msgServer) SetParticipantEffectiveUntil(goCtx context.Context, msg *typ
 		return nil, fmt.Errorf("authorization check failed: %w", err)
 	}
 
+	return ms.runSetParticipantEffectiveUntil(ctx, msg, now)
+}
+
+// runSetParticipantEffectiveUntil runs SetParticipantEffectiveUntil past the
+// AUTHZ-CHECK, shared with its batch variant.
+func (ms msgServer) runSetParticipantEffectiveUntil(ctx sdk.Context, msg *types.MsgSetParticipantEffectiveUntil, now time.Time) (*types.MsgSetParticipantEffectiveUntilResponse, error) {
 	// [MOD-PP-MSG-8-2-1] Adjust Participant basic checks
 	applicantParticipant, err := ms.validateAdjustParticipantBasicChecks(ctx, msg, now)
 	if err != nil {
@@ -1011,6 +949,12 @@ func (ms msgServer) RevokeParticipant(goCtx context.Context, msg *types.MsgRevok
 		return nil, fmt.Errorf("authorization check failed: %w", err)
 	}
 
+	return ms.runRevokeParticipant(ctx, msg, now)
+}
+
+// runRevokeParticipant runs RevokeParticipant past the
+// AUTHZ-CHECK, shared with its batch variant.
+func (ms msgServer) runRevokeParticipant(ctx sdk.Context, msg *types.MsgRevokeParticipant, now time.Time) (*types.MsgRevokeParticipantResponse, error) {
 	// [MOD-PP-MSG-9-2-1] Revoke Participant basic checks
 	applicantParticipant, err := ms.validateRevokeParticipantBasicChecks(ctx, msg, now)
 	if err != nil {
@@ -1027,7 +971,7 @@ func (ms msgServer) RevokeParticipant(goCtx context.Context, msg *types.MsgRevok
 	// (This is handled by the SDK automatically during transaction processing)
 
 	// [MOD-PP-MSG-9-3] Revoke Participant execution
-	if err := ms.executeRevokeParticipant(ctx, applicantParticipant, now); err != nil {
+	if err := ms.executeRevokeParticipant(ctx, applicantParticipant, msg, now); err != nil {
 		return nil, fmt.Errorf("failed to revoke participant: %w", err)
 	}
 
@@ -1039,6 +983,19 @@ func (ms msgServer) RevokeParticipant(goCtx context.Context, msg *types.MsgRevok
 		}
 	}
 
+	// Descendants are revoked or suspended by the EndBlocker, in batches
+	var cascadeID uint64
+	if msg.Cascade != types.CascadeMode_CASCADE_MODE_UNSPECIFIED {
+		initiatorCorpID, err := ms.corpIDFromAccount(ctx, msg.Corporation)
+		if err != nil {
+			return nil, err
+		}
+		cascadeID, err = ms.startParticipantCascade(ctx, applicantParticipant, msg.Cascade, msg.Reason, initiatorCorpID, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start cascade: %w", err)
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRevokeParticipant,
@@ -1046,11 +1003,15 @@ func (ms msgServer) RevokeParticipant(goCtx context.Context, msg *types.MsgRevok
 			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
 			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
 			sdk.NewAttribute(types.AttributeKeyRevokedAt, now.String()),
+			sdk.NewAttribute(types.AttributeKeyRevocationReason, msg.Reason.String()),
+			sdk.NewAttribute(types.AttributeKeyEvidenceDigest, msg.EvidenceDigest),
+			sdk.NewAttribute(types.AttributeKeyCascadeMode, msg.Cascade.String()),
+			sdk.NewAttribute(types.AttributeKeyCascadeID, strconv.FormatUint(cascadeID, 10)),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
 		),
 	})
 
-	return &types.MsgRevokeParticipantResponse{}, nil
+	return &types.MsgRevokeParticipantResponse{CascadeId: cascadeID}, nil
 }
 
 // [MOD-PP-MSG-9-2-1] Revoke Participant basic checks
@@ -1155,27 +1116,59 @@ func (ms msgServer) checkEcosystemControllerOption(ctx sdk.Context, authority st
 }
 
 // [MOD-PP-MSG-9-3] Revoke Participant execution
-func (ms msgServer) executeRevokeParticipant(ctx sdk.Context, participant types.Participant, now time.Time) error {
+func (ms msgServer) executeRevokeParticipant(ctx sdk.Context, participant types.Participant, msg *types.MsgRevokeParticipant, now time.Time) error {
+	return ms.revokeParticipant(ctx, participant, msg.Reason, msg.Evidence, msg.EvidenceDigest, now)
+}
+
+// revokeParticipant releases the trust deposit of participant and marks it
+// revoked at now. Shared by RevokeParticipant and revocation cascades.
+func (k Keeper) revokeParticipant(ctx sdk.Context, participant types.Participant, reason types.RevocationReason, evidence, evidenceDigest string, now time.Time) error {
 	// Free associated trust deposit if non-zero
 	if participant.Deposit > 0 {
-		corpAcct, err := ms.corpAccountFromID(ctx, participant.CorporationId)
-		if err != nil {
-			return err
-		}
 		depositI64 := int64(participant.Deposit)
-		if err := ms.trustDeposit.AdjustTrustDeposit(ctx, corpAcct, -depositI64, "participant_revoke_release_deposit"); err != nil {
+		if err := k.trustDeposit.AdjustTrustDeposit(ctx, participant.CorporationId, -depositI64, "participant_revoke_release_deposit"); err != nil {
 			return fmt.Errorf("failed to release trust deposit on revocation: %w", err)
 		}
+		k.recordReleasedDeposit(ctx, &participant, participant.Deposit, now)
 		participant.Deposit = 0
 	}
 
 	// set applicant_participant.revoked to now
 	participant.Revoked = &now
+	participant.RevocationReason = reason
+	participant.RevocationEvidence = evidence
+	participant.RevocationEvidenceDigest = evidenceDigest
 
 	// set applicant_participant.modified to now
 	participant.Modified = &now
 
-	return ms.Keeper.UpdateParticipant(ctx, participant)
+	if err := k.setRevokedParticipantIndex(ctx, participant); err != nil {
+		return err
+	}
+
+	return k.UpdateParticipant(ctx, participant)
+}
+
+// recordReleasedDeposit records amount as released from the deposit of
+// participant at now, so that it stays slashable until it is unbonded.
+// Nothing is recorded when released deposits do not unbond.
+func (k Keeper) recordReleasedDeposit(ctx sdk.Context, participant *types.Participant, amount uint64, now time.Time) {
+	days := k.trustDeposit.GetTrustDepositUnbondingDays(ctx)
+	if days == 0 {
+		return
+	}
+	until := now.AddDate(0, 0, int(days))
+	participant.ReleasedDeposit = releasedDeposit(*participant, now) + amount
+	participant.ReleasedDepositUnbondingUntil = &until
+}
+
+// releasedDeposit returns the released deposit of participant still
+// unbonding at now.
+func releasedDeposit(participant types.Participant, now time.Time) uint64 {
+	if participant.ReleasedDepositUnbondingUntil == nil || !now.Before(*participant.ReleasedDepositUnbondingUntil) {
+		return 0
+	}
+	return participant.ReleasedDeposit
 }
 
 // revokeVSOperatorAuthorization implements [MOD-DE-MSG-6] orchestration.
@@ -1203,13 +1196,13 @@ func (ms msgServer) CreateOrUpdateParticipantSession(goCtx context.Context, msg
 	}
 
 	// [MOD-PP-MSG-10-3] Create or Update Participant Session fee checks
-	foundParticipantSet, beneficiaryFees, trustFees, err := ms.validateCreateOrUpdateParticipantSessionFees(ctx, msg)
+	fees, err := ms.validateCreateOrUpdateParticipantSessionFees(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
 	// [MOD-PP-MSG-10-4] Create or Update Participant Session execution
-	if err := ms.executeCreateOrUpdateParticipantSession(ctx, msg, foundParticipantSet, beneficiaryFees, trustFees, now); err != nil {
+	if err := ms.executeCreateOrUpdateParticipantSession(ctx, msg, fees, now); err != nil {
 		return nil, fmt.Errorf("failed to create/update participant session: %w", err)
 	}
 
@@ -1263,37 +1256,53 @@ func (ms msgServer) SlashParticipantTrustDeposit(goCtx context.Context, msg *typ
 		return nil, err
 	}
 
+	// The slash evidence MUST have been anchored in x/di
+	if ms.digestKeeper == nil {
+		return nil, fmt.Errorf("digest keeper is required for slash evidence")
+	}
+	anchored, err := ms.digestKeeper.HasDigest(ctx, msg.EvidenceDigest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check evidence digest: %w", err)
+	}
+	if !anchored {
+		return nil, fmt.Errorf("evidence digest %s is not anchored", msg.EvidenceDigest)
+	}
+
+	slasherCorpID, err := ms.corpIDFromAccount(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
 	// [MOD-PP-MSG-12-2-3] Slash Participant Trust Deposit fee checks
 	// Account MUST have the required estimated transaction fees available
 	// (This is handled by the SDK automatically during transaction processing)
 
 	// [MOD-PP-MSG-12-3] Slash Participant Trust Deposit execution
-	if err := ms.executeSlashParticipantTrustDeposit(ctx, applicantParticipant, msg.Amount, now); err != nil {
+	slash, err := ms.executeSlashParticipantTrustDeposit(ctx, applicantParticipant, msg, slasherCorpID, now)
+	if err != nil {
 		return nil, fmt.Errorf("failed to slash participant trust deposit: %w", err)
 	}
 
-	// [MOD-PP-MSG-12-3] If applicant_participant.type is ISSUER or VERIFIER:
-	// Delete authorization for applicant_participant.vs_operator
-	if applicantParticipant.Role == types.ParticipantRole_ISSUER || applicantParticipant.Role == types.ParticipantRole_VERIFIER {
-		if err := ms.revokeVSOperatorAuthorization(ctx, applicantParticipant); err != nil {
-			return nil, fmt.Errorf("failed to revoke VS operator authorization: %w", err)
-		}
-	}
-
 	// Emit event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSlashParticipantTrustDeposit,
 			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(slash.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(msg.Amount, 10)),
 			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
 			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
-			sdk.NewAttribute("reason", msg.Reason),
+			sdk.NewAttribute(types.AttributeKeySlashReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyEvidenceDigest, msg.EvidenceDigest),
+			sdk.NewAttribute(types.AttributeKeyDisputeDeadline, slash.DisputeDeadline.String()),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
 		),
 	})
 
-	return &types.MsgSlashParticipantTrustDepositResponse{}, nil
+	return &types.MsgSlashParticipantTrustDepositResponse{
+		SlashId:         slash.Id,
+		DisputeDeadline: *slash.DisputeDeadline,
+	}, nil
 }
 
 // [MOD-PP-MSG-12-2-1] Slash Participant Trust Deposit basic checks
@@ -1309,9 +1318,23 @@ func (ms msgServer) validateSlashParticipantBasicChecks(ctx sdk.Context, msg *ty
 	}
 	applicantParticipant = participant
 
-	// [MOD-PP-MSG-12-2-1] amount MUST be lower or equal to applicant_participant.deposit else MUST abort
+	// [MOD-PP-MSG-12-2-1] amount MUST be lower or equal to applicant_participant.deposit else MUST abort.
+	// The deposit released when the participant was revoked stays slashable
+	// while it unbonds, within what the corporation still has unbonding.
 	if msg.Amount > applicantParticipant.Deposit {
-		return applicantParticipant, fmt.Errorf("amount exceeds available deposit: %d > %d", msg.Amount, applicantParticipant.Deposit)
+		released := releasedDeposit(applicantParticipant, ctx.BlockTime())
+		if released > 0 {
+			unbonding, err := ms.trustDeposit.GetUnbondingTrustDeposit(ctx, applicantParticipant.CorporationId)
+			if err != nil {
+				return applicantParticipant, fmt.Errorf("failed to get unbonding trust deposit: %w", err)
+			}
+			if released > unbonding {
+				released = unbonding
+			}
+		}
+		if msg.Amount > applicantParticipant.Deposit+released {
+			return applicantParticipant, fmt.Errorf("amount exceeds available deposit: %d > %d", msg.Amount, applicantParticipant.Deposit+released)
+		}
 	}
 
 	// Note: Even if the participant has expired or is revoked, it is still possible to slash it.
@@ -1336,43 +1359,64 @@ func (ms msgServer) validateSlashParticipantValidatorParticipants(ctx sdk.Contex
 	return fmt.Errorf("authority is not authorized to slash this participant")
 }
 
-// [MOD-PP-MSG-12-3] Slash Participant Trust Deposit execution
-func (ms msgServer) executeSlashParticipantTrustDeposit(ctx sdk.Context, applicantParticipant types.Participant, amount uint64, now time.Time) error {
+// [MOD-PP-MSG-12-3] Slash Participant Trust Deposit execution. The slashed
+// amount leaves the participant deposit right away but stays locked in the
+// trust deposit until the slash is confirmed, at the end of the dispute window
+// or by the arbiter of an appeal. Without dispute window the slash is
+// confirmed immediately.
+func (ms msgServer) executeSlashParticipantTrustDeposit(ctx sdk.Context, applicantParticipant types.Participant, msg *types.MsgSlashParticipantTrustDeposit, slasherCorpID uint64, now time.Time) (*types.ParticipantSlash, error) {
 	// Load Participant entry validator_participant from applicant_participant.validator_participant_id
 	if applicantParticipant.ValidatorParticipantId != 0 {
 		_, err := ms.Keeper.GetParticipantByID(ctx, applicantParticipant.ValidatorParticipantId)
 		if err != nil {
-			return fmt.Errorf("validator participant not found: %w", err)
+			return nil, fmt.Errorf("validator participant not found: %w", err)
 		}
 	}
 
-	// set applicant_participant.slashed to now
-	applicantParticipant.Slashed = &now
+	params := ms.GetParams(ctx)
+	deadline := now.AddDate(0, 0, int(params.SlashDisputeWindowDays))
+	slash := &types.ParticipantSlash{
+		Id:                   uint64(len(applicantParticipant.Slashes)) + 1,
+		Amount:               msg.Amount,
+		Reason:               msg.Reason,
+		EvidenceDigest:       msg.EvidenceDigest,
+		SlasherCorporationId: slasherCorpID,
+		Created:              &now,
+		DisputeDeadline:      &deadline,
+		Status:               types.SlashStatus_SLASH_STATUS_PENDING,
+	}
+	applicantParticipant.Slashes = append(applicantParticipant.Slashes, slash)
+
+	// decrement applicant_participant.deposit by amount; what exceeds the
+	// deposit is held back from the released deposit of the participant
+	held := msg.Amount
+	if held > applicantParticipant.Deposit {
+		held = applicantParticipant.Deposit
+		slash.HeldFromUnbonding = msg.Amount - held
+		if err := ms.trustDeposit.HoldUnbondingTrustDeposit(ctx, applicantParticipant.CorporationId, slash.HeldFromUnbonding); err != nil {
+			return nil, fmt.Errorf("failed to hold unbonding trust deposit: %w", err)
+		}
+		applicantParticipant.ReleasedDeposit -= slash.HeldFromUnbonding
+	}
+	applicantParticipant.Deposit -= held
 
 	// set applicant_participant.modified to now
 	applicantParticipant.Modified = &now
 
-	// set applicant_participant.slashed_deposit to applicant_participant.slashed_deposit + amount
-	applicantParticipant.SlashedDeposit = applicantParticipant.SlashedDeposit + amount
-
-	// decrement applicant_participant.deposit by amount
-	applicantParticipant.Deposit -= amount
-
-	// use MOD-TD-MSG-7 to burn the slashed amount from the trust deposit of applicant_participant.authority
-	corpAcct, err := ms.corpAccountFromID(ctx, applicantParticipant.CorporationId)
-	if err != nil {
-		return err
-	}
-	if err := ms.trustDeposit.BurnEcosystemSlashedTrustDeposit(ctx, corpAcct, amount); err != nil {
-		return fmt.Errorf("failed to burn trust deposit: %w", err)
+	if !deadline.After(now) {
+		if err := ms.confirmSlash(ctx, &applicantParticipant, slash, now); err != nil {
+			return nil, err
+		}
+	} else if err := ms.setSlashDisputeDeadlineIndexes(ctx, applicantParticipant); err != nil {
+		return nil, err
 	}
 
 	// Update participant
 	if err := ms.Keeper.UpdateParticipant(ctx, applicantParticipant); err != nil {
-		return fmt.Errorf("failed to update participant: %w", err)
+		return nil, fmt.Errorf("failed to update participant: %w", err)
 	}
 
-	return nil
+	return slash, nil
 }
 
 // RepayParticipantSlashedTrustDeposit handles the MsgRepayParticipantSlashedTrustDeposit message
@@ -1436,7 +1480,7 @@ func (ms msgServer) RepayParticipantSlashedTrustDeposit(goCtx context.Context, m
 
 	// [MOD-PP-MSG-13-3] Execution
 	// Use AdjustTrustDeposit to transfer msg.Amount to trust deposit of applicant_participant.authority
-	if err := ms.trustDeposit.AdjustTrustDeposit(ctx, applicantCorpAcct, repayAmountI64, "participant_repay_slashed_deposit"); err != nil {
+	if err := ms.trustDeposit.AdjustTrustDeposit(ctx, applicantParticipant.CorporationId, repayAmountI64, "participant_repay_slashed_deposit"); err != nil {
 		return nil, fmt.Errorf("failed to adjust trust deposit: %w", err)
 	}
 
@@ -1482,6 +1526,12 @@ func (ms msgServer) SelfCreateParticipant(goCtx context.Context, msg *types.MsgS
 		return nil, fmt.Errorf("authorization check failed: %w", err)
 	}
 
+	return ms.runSelfCreateParticipant(ctx, msg, now)
+}
+
+// runSelfCreateParticipant runs SelfCreateParticipant past the
+// AUTHZ-CHECK, shared with its batch variant.
+func (ms msgServer) runSelfCreateParticipant(ctx sdk.Context, msg *types.MsgSelfCreateParticipant, now time.Time) (*types.MsgSelfCreateParticipantResponse, error) {
 	// [MOD-PP-MSG-14-2-1] Load validator_participant from validator_participant_id
 	validatorParticipant, err := ms.Keeper.GetParticipantByID(ctx, msg.ValidatorParticipantId)
 	if err != nil {
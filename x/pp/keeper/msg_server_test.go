@@ -3048,9 +3048,12 @@ func TestRevokeParticipant(t *testing.T) {
 		{
 			name: "Valid revocation by validator ancestor",
 			msg: &types.MsgRevokeParticipant{
-				Corporation: validatorAddr,
-				Operator:    operatorAddr,
-				Id:          applicantParticipantID,
+				Corporation:    validatorAddr,
+				Operator:       operatorAddr,
+				Id:             applicantParticipantID,
+				Reason:         types.RevocationReason_REVOCATION_REASON_KEY_COMPROMISE,
+				Evidence:       "signing key leaked",
+				EvidenceDigest: "sha384-evidenceDigest",
 			},
 			expectErr: false,
 		},
@@ -3092,11 +3095,103 @@ func TestRevokeParticipant(t *testing.T) {
 				participant, err := k.GetParticipantByID(sdkCtx, tc.msg.Id)
 				require.NoError(t, err)
 				require.NotNil(t, participant.Revoked)
+				require.Equal(t, tc.msg.Reason, participant.RevocationReason)
+				require.Equal(t, tc.msg.Evidence, participant.RevocationEvidence)
+				require.Equal(t, tc.msg.EvidenceDigest, participant.RevocationEvidenceDigest)
 			}
 		})
 	}
 }
 
+func TestListRevokedParticipants(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	authority := sdk.AccAddress([]byte("test_authority__")).String()
+	operatorAddr := sdk.AccAddress([]byte("test_operator___")).String()
+
+	csKeeper.CreateMockCredentialSchemaFull(cstypes.CredentialSchema{Id: 1, EcosystemId: 10})
+	csKeeper.CreateMockCredentialSchemaFull(cstypes.CredentialSchema{Id: 2, EcosystemId: 20})
+
+	start := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	pastTime := start.Add(-1 * time.Hour)
+	corpID := trkKeeper.RegisterCorp(authority)
+
+	newParticipant := func(schemaID uint64) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId:      schemaID,
+			Role:          types.ParticipantRole_ISSUER,
+			CorporationId: corpID,
+			Created:       &pastTime,
+			Modified:      &pastTime,
+			OpState:       types.OnboardingState_VALIDATED,
+			EffectiveFrom: &pastTime,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	first := newParticipant(1)
+	second := newParticipant(2)
+	third := newParticipant(1)
+	newParticipant(1) // never revoked
+
+	// first and second are revoked in the same block, third one hour later
+	revoke := func(at time.Time, id uint64) {
+		_, err := ms.RevokeParticipant(sdkCtx.WithBlockTime(at), &types.MsgRevokeParticipant{
+			Corporation: authority,
+			Operator:    operatorAddr,
+			Id:          id,
+			Reason:      types.RevocationReason_REVOCATION_REASON_VOLUNTARY_EXIT,
+		})
+		require.NoError(t, err)
+	}
+	revoke(start, first)
+	revoke(start, second)
+	revoke(start.Add(time.Hour), third)
+
+	list := func(req *types.QueryListRevokedParticipantsRequest) []uint64 {
+		res, err := k.ListRevokedParticipants(sdkCtx, req)
+		require.NoError(t, err)
+		var ids []uint64
+		for _, p := range res.Participants {
+			require.NotNil(t, p.Revoked)
+			ids = append(ids, p.Id)
+		}
+		return ids
+	}
+
+	require.Equal(t, []uint64{first, second, third}, list(&types.QueryListRevokedParticipantsRequest{}))
+	require.Equal(t, []uint64{first, third}, list(&types.QueryListRevokedParticipantsRequest{SchemaId: 1}))
+	require.Equal(t, []uint64{second}, list(&types.QueryListRevokedParticipantsRequest{EcosystemId: 20}))
+	require.Empty(t, list(&types.QueryListRevokedParticipantsRequest{EcosystemId: 30}))
+
+	before := start.Add(30 * time.Minute)
+	require.Equal(t, []uint64{first, second}, list(&types.QueryListRevokedParticipantsRequest{RevokedBefore: &before}))
+
+	// revoked_after alone excludes the participants revoked at that time
+	require.Equal(t, []uint64{third}, list(&types.QueryListRevokedParticipantsRequest{RevokedAfter: &start}))
+	require.Equal(t, []uint64{second, third}, list(&types.QueryListRevokedParticipantsRequest{RevokedAfter: &start, AfterId: first}))
+
+	// Incremental sync: page size 1, resume from the last returned entry
+	var synced []uint64
+	req := &types.QueryListRevokedParticipantsRequest{ResponseMaxSize: 1}
+	for {
+		res, err := k.ListRevokedParticipants(sdkCtx, req)
+		require.NoError(t, err)
+		if len(res.Participants) == 0 {
+			break
+		}
+		last := res.Participants[len(res.Participants)-1]
+		synced = append(synced, last.Id)
+		req.RevokedAfter = last.Revoked
+		req.AfterId = last.Id
+	}
+	require.Equal(t, []uint64{first, second, third}, synced)
+
+	_, err := k.ListRevokedParticipants(sdkCtx, &types.QueryListRevokedParticipantsRequest{RevokedAfter: &before, RevokedBefore: &start})
+	require.Error(t, err)
+}
+
 func TestCreateOrUpdateParticipantSession(t *testing.T) {
 	k, ms, csKeeper, trkKeeper, ctx, delKeeper := setupMsgServerWithDelegation(t)
 	_ = trkKeeper
@@ -3911,6 +4006,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 	sdkCtx = sdkCtx.WithBlockTime(blockTime)
 	ctx = sdk.WrapSDKContext(sdkCtx)
 
+	// Without dispute window slashes are final immediately
+	params := k.GetParams(sdkCtx)
+	params.SlashDisputeWindowDays = 0
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
 	authority := sdk.AccAddress([]byte("test_authority__")).String()
 	operator := sdk.AccAddress([]byte("test_operator___")).String()
 	validatorAddr := sdk.AccAddress([]byte("test_validator__")).String()
@@ -4026,10 +4126,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 	t.Run("AUTHZ check - operator authorization failure", func(t *testing.T) {
 		delKeeper.ErrToReturn = fmt.Errorf("operator authorization not found")
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    operator,
-			Id:          applicantParticipantID,
-			Amount:      100,
+			Corporation:    validatorAddr,
+			Operator:       operator,
+			Id:             applicantParticipantID,
+			Amount:         100,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "authorization check failed")
@@ -4039,10 +4140,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("Valid slash by validator ancestor", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    operator,
-			Id:          applicantParticipantID,
-			Amount:      100,
+			Corporation:    validatorAddr,
+			Operator:       operator,
+			Id:             applicantParticipantID,
+			Amount:         100,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -4055,10 +4157,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("Valid slash by TR controller", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: trControllerAddr,
-			Operator:    operator,
-			Id:          applicantParticipantID,
-			Amount:      100,
+			Corporation:    trControllerAddr,
+			Operator:       operator,
+			Id:             applicantParticipantID,
+			Amount:         100,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -4070,10 +4173,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("Valid slash on expired participant (still slashable per spec)", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    operator,
-			Id:          expiredParticipantID,
-			Amount:      50,
+			Corporation:    validatorAddr,
+			Operator:       operator,
+			Id:             expiredParticipantID,
+			Amount:         50,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -4086,10 +4190,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("Valid slash on revoked participant (still slashable per spec)", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    operator,
-			Id:          revokedParticipantID,
-			Amount:      50,
+			Corporation:    validatorAddr,
+			Operator:       operator,
+			Id:             revokedParticipantID,
+			Amount:         50,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -4101,10 +4206,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("VS operator revocation on VERIFIER participant", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    operator,
-			Id:          verifierParticipantID,
-			Amount:      50,
+			Corporation:    validatorAddr,
+			Operator:       operator,
+			Id:             verifierParticipantID,
+			Amount:         50,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -4112,10 +4218,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("No VS operator revocation on ECOSYSTEM participant", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: trControllerAddr,
-			Operator:    operator,
-			Id:          ecosystemParticipantID,
-			Amount:      50,
+			Corporation:    trControllerAddr,
+			Operator:       operator,
+			Id:             ecosystemParticipantID,
+			Amount:         50,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -4123,10 +4230,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("Participant not found", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    operator,
-			Id:          9999,
-			Amount:      100,
+			Corporation:    validatorAddr,
+			Operator:       operator,
+			Id:             9999,
+			Amount:         100,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "participant not found")
@@ -4135,10 +4243,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("Amount exceeds deposit", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    operator,
-			Id:          applicantParticipantID,
-			Amount:      999999,
+			Corporation:    validatorAddr,
+			Operator:       operator,
+			Id:             applicantParticipantID,
+			Amount:         999999,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "amount exceeds available deposit")
@@ -4147,10 +4256,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 
 	t.Run("Unauthorized authority - not validator ancestor, not TR controller", func(t *testing.T) {
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: unauthorizedAddr,
-			Operator:    operator,
-			Id:          applicantParticipantID,
-			Amount:      10,
+			Corporation:    unauthorizedAddr,
+			Operator:       operator,
+			Id:             applicantParticipantID,
+			Amount:         10,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "authority is not authorized to slash this participant")
@@ -4160,10 +4270,11 @@ func TestSlashParticipantTrustDeposit(t *testing.T) {
 	t.Run("Wrong authority - applicant own authority cannot slash", func(t *testing.T) {
 		// Unlike revoke, slash does NOT have Option #3 (self-authority)
 		resp, err := ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: applicantAuthority,
-			Operator:    operator,
-			Id:          applicantParticipantID,
-			Amount:      10,
+			Corporation:    applicantAuthority,
+			Operator:       operator,
+			Id:             applicantParticipantID,
+			Amount:         10,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "authority is not authorized to slash this participant")
@@ -4183,6 +4294,11 @@ func TestRepayParticipantSlashedTrustDeposit(t *testing.T) {
 	sdkCtx = sdkCtx.WithBlockTime(blockTime)
 	ctx = sdk.WrapSDKContext(sdkCtx)
 
+	// Without dispute window slashes are final immediately
+	params := k.GetParams(sdkCtx)
+	params.SlashDisputeWindowDays = 0
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
 	authority := sdk.AccAddress([]byte("test_authority_addr")).String()
 	operator := sdk.AccAddress([]byte("test_operator_addr")).String()
 	validatorAddr := sdk.AccAddress([]byte("test_validator")).String()
@@ -4254,10 +4370,11 @@ func TestRepayParticipantSlashedTrustDeposit(t *testing.T) {
 
 	// Slash the applicant participant first
 	slashMsg := &types.MsgSlashParticipantTrustDeposit{
-		Corporation: validatorAddr,
-		Operator:    validatorAddr,
-		Id:          applicantParticipantID,
-		Amount:      500,
+		Corporation:    validatorAddr,
+		Operator:       validatorAddr,
+		Id:             applicantParticipantID,
+		Amount:         500,
+		EvidenceDigest: "sha384-slashEvidence",
 	}
 	_, err = ms.SlashParticipantTrustDeposit(ctx, slashMsg)
 	require.NoError(t, err)
@@ -4337,10 +4454,11 @@ func TestRepayParticipantSlashedTrustDeposit(t *testing.T) {
 		require.NoError(t, err)
 		// Slash it
 		_, err = ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    validatorAddr,
-			Id:          otherParticipantID,
-			Amount:      100,
+			Corporation:    validatorAddr,
+			Operator:       validatorAddr,
+			Id:             otherParticipantID,
+			Amount:         100,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 
@@ -4383,10 +4501,11 @@ func TestRepayParticipantSlashedTrustDeposit(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = ms.SlashParticipantTrustDeposit(ctx, &types.MsgSlashParticipantTrustDeposit{
-			Corporation: validatorAddr,
-			Operator:    validatorAddr,
-			Id:          pid,
-			Amount:      400,
+			Corporation:    validatorAddr,
+			Operator:       validatorAddr,
+			Id:             pid,
+			Amount:         400,
+			EvidenceDigest: "sha384-slashEvidence",
 		})
 		require.NoError(t, err)
 
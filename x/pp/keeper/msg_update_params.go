@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,9 +16,17 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	previous := ms.GetParams(ctx)
 	if err := ms.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	// Index the notices of the new lead times
+	if !slices.Equal(previous.ExpiryNoticeLeadDays, req.Params.ExpiryNoticeLeadDays) {
+		if err := ms.rebuildExpiryNoticeIndexes(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
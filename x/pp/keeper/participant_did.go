@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/verana-labs/verana/x/pp/types"
 )
@@ -15,8 +18,10 @@ import (
 // every existing Participant entry that shares the given did MUST belong to
 // corporationID; otherwise the create MUST abort. This is NOT a DID-uniqueness
 // check — the same did may be reused across multiple participants of the same
-// corporation. x/pp has no (did) index, so the check walks the Participant map;
-// creates are infrequent, so the linear scan is acceptable.
+// corporation. Previous dids kept in the did history count as well, so that a
+// rotated-away did cannot be taken over by another corporation. x/pp has no
+// (did) index, so the check walks the Participant map; creates and rotations
+// are infrequent, so the linear scan is acceptable.
 func (k Keeper) assertDIDCorporationConsistent(ctx context.Context, did string, corporationID uint64) error {
 	if did == "" {
 		return nil
@@ -26,7 +31,7 @@ func (k Keeper) assertDIDCorporationConsistent(ctx context.Context, did string,
 		conflictCorp uint64
 	)
 	err := k.Participant.Walk(ctx, nil, func(_ uint64, p types.Participant) (bool, error) {
-		if p.Did == did && p.CorporationId != corporationID {
+		if p.CorporationId != corporationID && (p.Did == did || hadDID(p, did)) {
 			conflict = true
 			conflictCorp = p.CorporationId
 			return true, nil // stop on first conflict
@@ -42,3 +47,109 @@ func (k Keeper) assertDIDCorporationConsistent(ctx context.Context, did string,
 	}
 	return nil
 }
+
+// hadDID reports whether did is in the did history of participant.
+func hadDID(participant types.Participant, did string) bool {
+	for _, record := range participant.DidHistory {
+		if record != nil && record.Did == did {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDIDAt reports whether did was the did of participant at when: either a
+// previous did whose validity window contains when, or the current did if
+// when is not before the last rotation.
+func hasDIDAt(participant types.Participant, did string, when time.Time) bool {
+	var currentSince *time.Time
+	for _, record := range participant.DidHistory {
+		if record == nil {
+			continue
+		}
+		if record.Did == did && record.ValidFrom != nil && record.ValidUntil != nil &&
+			!when.Before(*record.ValidFrom) && when.Before(*record.ValidUntil) {
+			return true
+		}
+		currentSince = record.ValidUntil
+	}
+	return participant.Did == did && (currentSince == nil || !when.Before(*currentSince))
+}
+
+// RotateParticipantDID handles the MsgRotateParticipantDID message
+func (ms msgServer) RotateParticipantDID(goCtx context.Context, msg *types.MsgRotateParticipantDID) (*types.MsgRotateParticipantDIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// [AUTHZ-CHECK] Verify operator authorization
+	if ms.delegationKeeper == nil {
+		return nil, fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.delegationKeeper.CheckOperatorAuthorization(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.pp.v1.MsgRotateParticipantDID",
+		now,
+	); err != nil {
+		return nil, fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	participant, err := ms.Keeper.GetParticipantByID(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("participant not found: %w", err)
+	}
+
+	// Only the corporation of the participant can rotate its did
+	corpID, err := ms.corpIDFromAccount(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+	if participant.CorporationId != corpID {
+		return nil, fmt.Errorf("corporation is not the participant corporation")
+	}
+	if participant.Revoked != nil {
+		return nil, fmt.Errorf("participant is revoked")
+	}
+	if participant.Did == msg.NewDid {
+		return nil, fmt.Errorf("new did is the current did of the participant")
+	}
+
+	// The new did follows the same (did, corporation_id) consistency
+	// invariant as at create time
+	if err := ms.assertDIDCorporationConsistent(ctx, msg.NewDid, participant.CorporationId); err != nil {
+		return nil, err
+	}
+
+	// The previous did was valid since the last rotation, or since creation
+	validFrom := participant.Created
+	if n := len(participant.DidHistory); n > 0 {
+		validFrom = participant.DidHistory[n-1].ValidUntil
+	}
+	previousDid := participant.Did
+	participant.DidHistory = append(participant.DidHistory, &types.ParticipantDIDRecord{
+		Did:        previousDid,
+		ValidFrom:  validFrom,
+		ValidUntil: &now,
+	})
+	participant.Did = msg.NewDid
+	participant.Modified = &now
+
+	if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateParticipantDID,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyPreviousDID, previousDid),
+			sdk.NewAttribute(types.AttributeKeyDID, msg.NewDid),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgRotateParticipantDIDResponse{}, nil
+}
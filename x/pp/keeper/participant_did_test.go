@@ -152,3 +152,81 @@ func TestSelfCreateParticipant_DIDCorporationConsistency(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestRotateParticipantDID(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx, _ := setupMsgServerWithDelegation(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	created := sdkCtx.BlockTime()
+	past := created.Add(-1 * time.Hour)
+
+	corpA := sdk.AccAddress([]byte("did_rotate_corp_a___")).String()
+	corpB := sdk.AccAddress([]byte("did_rotate_corp_b___")).String()
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.IssuerOnboardingMode_ISSUER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS,
+		cstypes.VerifierOnboardingMode_VERIFIER_ONBOARDING_MODE_GRANTOR_VALIDATION_PROCESS)
+
+	const (
+		webDID   = "did:web:example.com"
+		webvhDID = "did:webvh:example.com"
+		otherDID = "did:web:other.example.com"
+	)
+	newParticipant := func(corp, did string) uint64 {
+		id, err := k.CreateParticipant(sdkCtx, types.Participant{
+			SchemaId: 1, Role: types.ParticipantRole_ISSUER, Did: did,
+			CorporationId: trkKeeper.RegisterCorp(corp), Created: &created, Modified: &created,
+			OpState: types.OnboardingState_VALIDATED, EffectiveFrom: &past,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	id := newParticipant(corpA, webDID)
+	otherID := newParticipant(corpB, otherDID)
+
+	rotate := func(ctx sdk.Context, corp string, id uint64, did string) error {
+		_, err := ms.RotateParticipantDID(ctx, &types.MsgRotateParticipantDID{
+			Corporation: corp, Operator: corp, Id: id, NewDid: did,
+		})
+		return err
+	}
+	find := func(did string, when *time.Time, history bool) []uint64 {
+		res, err := k.FindParticipantsWithDID(sdkCtx, &types.QueryFindParticipantsWithDIDRequest{
+			Did: did, Role: uint32(types.ParticipantRole_ISSUER), SchemaId: 1,
+			When: when, IncludeDidHistory: history,
+		})
+		require.NoError(t, err)
+		var ids []uint64
+		for _, p := range res.Participants {
+			ids = append(ids, p.Id)
+		}
+		return ids
+	}
+
+	rotatedAt := created.Add(24 * time.Hour)
+	rotateCtx := sdkCtx.WithBlockTime(rotatedAt)
+
+	// Only the participant corporation can rotate, to a did it may control
+	require.ErrorContains(t, rotate(rotateCtx, corpB, id, webvhDID), "not the participant corporation")
+	require.ErrorContains(t, rotate(rotateCtx, corpA, id, webDID), "current did")
+	require.ErrorIs(t, rotate(rotateCtx, corpA, id, otherDID), types.ErrDIDOwnershipConflict)
+
+	require.NoError(t, rotate(rotateCtx, corpA, id, webvhDID))
+	participant, err := k.GetParticipantByID(sdkCtx, id)
+	require.NoError(t, err)
+	require.Equal(t, webvhDID, participant.Did)
+	require.Len(t, participant.DidHistory, 1)
+	require.Equal(t, webDID, participant.DidHistory[0].Did)
+	require.Equal(t, created, *participant.DidHistory[0].ValidFrom)
+	require.Equal(t, rotatedAt, *participant.DidHistory[0].ValidUntil)
+
+	// The rotated-away did stays bound to corpA
+	require.ErrorIs(t, rotate(rotateCtx, corpB, otherID, webDID), types.ErrDIDOwnershipConflict)
+
+	before := rotatedAt.Add(-time.Hour)
+	after := rotatedAt.Add(time.Hour)
+	require.Empty(t, find(webDID, nil, false))
+	require.Equal(t, []uint64{id}, find(webDID, nil, true))
+	require.Equal(t, []uint64{id}, find(webDID, &before, true))
+	require.Empty(t, find(webDID, &after, true))
+	require.Equal(t, []uint64{id}, find(webvhDID, &after, true))
+	require.Empty(t, find(webvhDID, &before, true))
+}
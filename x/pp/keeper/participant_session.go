@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// setParticipantSession stores a session and keeps the session indexes in
+// sync, replacing the index entries of the previously stored version.
+func (k Keeper) setParticipantSession(ctx context.Context, session types.ParticipantSession) error {
+	existing, err := k.ParticipantSession.Get(ctx, session.Id)
+	if err == nil {
+		if err := k.removeParticipantSessionIndexes(ctx, existing); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.ParticipantSession.Set(ctx, session.Id, session); err != nil {
+		return err
+	}
+	return k.addParticipantSessionIndexes(ctx, session)
+}
+
+// removeParticipantSession deletes a session, including all its records, and
+// its index entries.
+func (k Keeper) removeParticipantSession(ctx context.Context, session types.ParticipantSession) error {
+	if err := k.removeParticipantSessionIndexes(ctx, session); err != nil {
+		return err
+	}
+	return k.ParticipantSession.Remove(ctx, session.Id)
+}
+
+func (k Keeper) addParticipantSessionIndexes(ctx context.Context, session types.ParticipantSession) error {
+	if err := k.ParticipantSessionByCorporation.Set(ctx, collections.Join(session.CorporationId, session.Id)); err != nil {
+		return err
+	}
+	if err := k.ParticipantSessionByVSOperator.Set(ctx, collections.Join(session.VsOperator, session.Id)); err != nil {
+		return err
+	}
+	for _, participantID := range sessionParticipantIDs(session) {
+		if err := k.ParticipantSessionByParticipant.Set(ctx, collections.Join(participantID, session.Id)); err != nil {
+			return err
+		}
+	}
+	if modified, ok := sessionLastModified(session); ok {
+		if err := k.ParticipantSessionByModified.Set(ctx, collections.Join(modified, session.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) removeParticipantSessionIndexes(ctx context.Context, session types.ParticipantSession) error {
+	if err := k.ParticipantSessionByCorporation.Remove(ctx, collections.Join(session.CorporationId, session.Id)); err != nil {
+		return err
+	}
+	if err := k.ParticipantSessionByVSOperator.Remove(ctx, collections.Join(session.VsOperator, session.Id)); err != nil {
+		return err
+	}
+	for _, participantID := range sessionParticipantIDs(session) {
+		if err := k.ParticipantSessionByParticipant.Remove(ctx, collections.Join(participantID, session.Id)); err != nil {
+			return err
+		}
+	}
+	if modified, ok := sessionLastModified(session); ok {
+		if err := k.ParticipantSessionByModified.Remove(ctx, collections.Join(modified, session.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sessionParticipantIDs returns the distinct non-zero participant ids
+// referenced by the records of a session.
+func sessionParticipantIDs(session types.ParticipantSession) []uint64 {
+	seen := make(map[uint64]bool)
+	var ids []uint64
+	for _, record := range session.SessionRecords {
+		if record == nil {
+			continue
+		}
+		for _, id := range []uint64{
+			record.IssuerParticipantId,
+			record.VerifierParticipantId,
+			record.AgentParticipantId,
+			record.WalletAgentParticipantId,
+		} {
+			if id != 0 && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// sessionLastModified returns the time retention is counted from: modified,
+// or created for sessions that were never updated.
+func sessionLastModified(session types.ParticipantSession) (time.Time, bool) {
+	if session.Modified != nil {
+		return *session.Modified, true
+	}
+	if session.Created != nil {
+		return *session.Created, true
+	}
+	return time.Time{}, false
+}
+
+// RebuildParticipantSessionIndexes recreates the index entries of every
+// stored session. Used by genesis import and the v2 store migration.
+func (k Keeper) RebuildParticipantSessionIndexes(ctx context.Context) error {
+	return k.ParticipantSession.Walk(ctx, nil, func(_ string, session types.ParticipantSession) (bool, error) {
+		return false, k.addParticipantSessionIndexes(ctx, session)
+	})
+}
@@ -175,7 +175,7 @@ func (ms msgServer) executeSetParticipantVPToValidated(
 		}
 		err = ms.trustDeposit.AdjustTrustDeposit(
 			ctx,
-			validatorCorpAcct,
+			validatorParticipant.CorporationId,
 			vpCurrentDepositI64,
 			"participant_validated_deposit",
 		)
@@ -4,6 +4,7 @@ import (
 	"context"
 	errors2 "errors"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -43,6 +44,9 @@ func (k Keeper) ListParticipants(goCtx context.Context, req *types.QueryListPart
 		if req.ModifiedAfter != nil && !participant.Modified.After(*req.ModifiedAfter) {
 			return false, nil
 		}
+		if req.Suspended && participant.Suspended == nil {
+			return false, nil
+		}
 
 		participants = append(participants, participant)
 		return len(participants) >= int(req.ResponseMaxSize), nil
@@ -126,15 +130,56 @@ func (k Keeper) ListParticipantSessions(ctx context.Context, req *types.QueryLis
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	var sessions []types.ParticipantSession
 
-	err := k.ParticipantSession.Walk(sdkCtx, nil, func(key string, session types.ParticipantSession) (bool, error) {
-		// Apply modified_after filter if provided
-		if req.ModifiedAfter != nil && !session.Modified.After(*req.ModifiedAfter) {
-			return false, nil
+	// matches applies every filter of the request to a session
+	matches := func(session types.ParticipantSession) bool {
+		if req.ModifiedAfter != nil && (session.Modified == nil || !session.Modified.After(*req.ModifiedAfter)) {
+			return false
+		}
+		if req.CorporationId != 0 && session.CorporationId != req.CorporationId {
+			return false
+		}
+		if req.VsOperator != "" && session.VsOperator != req.VsOperator {
+			return false
+		}
+		if req.ParticipantId != 0 && !slices.Contains(sessionParticipantIDs(session), req.ParticipantId) {
+			return false
 		}
+		return true
+	}
 
-		sessions = append(sessions, session)
+	// collect loads an indexed session and appends it when it matches
+	collect := func(id string) (bool, error) {
+		session, err := k.ParticipantSession.Get(sdkCtx, id)
+		if err != nil {
+			return true, err
+		}
+		if matches(session) {
+			sessions = append(sessions, session)
+		}
 		return len(sessions) >= int(req.ResponseMaxSize), nil
-	})
+	}
+
+	// Walk the most selective index available, or all sessions when no
+	// indexed filter is set
+	var err error
+	switch {
+	case req.ParticipantId != 0:
+		err = k.ParticipantSessionByParticipant.Walk(sdkCtx, collections.NewPrefixedPairRange[uint64, string](req.ParticipantId),
+			func(key collections.Pair[uint64, string]) (bool, error) { return collect(key.K2()) })
+	case req.CorporationId != 0:
+		err = k.ParticipantSessionByCorporation.Walk(sdkCtx, collections.NewPrefixedPairRange[uint64, string](req.CorporationId),
+			func(key collections.Pair[uint64, string]) (bool, error) { return collect(key.K2()) })
+	case req.VsOperator != "":
+		err = k.ParticipantSessionByVSOperator.Walk(sdkCtx, collections.NewPrefixedPairRange[string, string](req.VsOperator),
+			func(key collections.Pair[string, string]) (bool, error) { return collect(key.K2()) })
+	default:
+		err = k.ParticipantSession.Walk(sdkCtx, nil, func(key string, session types.ParticipantSession) (bool, error) {
+			if matches(session) {
+				sessions = append(sessions, session)
+			}
+			return len(sessions) >= int(req.ResponseMaxSize), nil
+		})
+	}
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to list sessions")
@@ -209,8 +254,11 @@ func (k Keeper) FindParticipantsWithDID(goCtx context.Context, req *types.QueryF
 			return false, nil
 		}
 
-		// Filter by DID and type
-		if participant.Did != req.Did || participant.Role != participantType {
+		// Filter by DID and type, previous DIDs matching on request
+		if participant.Role != participantType {
+			return false, nil
+		}
+		if participant.Did != req.Did && !(req.IncludeDidHistory && hadDID(participant, req.Did)) {
 			return false, nil
 		}
 
@@ -220,6 +268,11 @@ func (k Keeper) FindParticipantsWithDID(goCtx context.Context, req *types.QueryF
 			return false, nil
 		}
 
+		// The DID must have been the participant DID at "when"
+		if req.IncludeDidHistory && !hasDIDAt(participant, req.Did, *req.When) {
+			return false, nil
+		}
+
 		// Filter by time validity
 		if isParticipantValidAtTime(participant, *req.When) {
 			foundParticipants = append(foundParticipants, participant)
@@ -290,6 +343,11 @@ func isParticipantValidAtTime(participant types.Participant, when time.Time) boo
 		return false
 	}
 
+	// Check suspended at the given time
+	if suspensionAt(participant, when) != nil {
+		return false
+	}
+
 	// Check expired (EXPIRED state)
 	if participant.EffectiveUntil != nil && !when.Before(*participant.EffectiveUntil) {
 		return false
@@ -374,7 +432,8 @@ func (k Keeper) FindBeneficiaries(goCtx context.Context, req *types.QueryFindBen
 
 			// if current_participant.revoked IS NULL AND current_participant.slashed IS NULL, Add current_participant to found_participant_set
 			// Note: SlashedDeposit > 0 indicates the participant has been slashed
-			if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil {
+			// Suspended participants are not beneficiaries while suspended
+			if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil && currentParticipant.Suspended == nil {
 				foundParticipantMap[currentParticipant.Id] = *currentParticipant
 			}
 		}
@@ -384,7 +443,7 @@ func (k Keeper) FindBeneficiaries(goCtx context.Context, req *types.QueryFindBen
 	if verifierParticipant != nil {
 		// if issuer_participant is not null, add issuer_participant to found_participant_set
 		if issuerParticipant != nil {
-			if issuerParticipant.Revoked == nil && issuerParticipant.Slashed == nil {
+			if issuerParticipant.Revoked == nil && issuerParticipant.Slashed == nil && issuerParticipant.Suspended == nil {
 				foundParticipantMap[issuerParticipant.Id] = *issuerParticipant
 			}
 		}
@@ -402,7 +461,7 @@ func (k Keeper) FindBeneficiaries(goCtx context.Context, req *types.QueryFindBen
 			currentParticipant = &participant
 
 			// if current_participant.revoked IS NULL AND current_participant.slashed IS NULL, Add current_participant to found_participant_set
-			if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil {
+			if currentParticipant.Revoked == nil && currentParticipant.Slashed == nil && currentParticipant.Suspended == nil {
 				foundParticipantMap[currentParticipant.Id] = *currentParticipant
 			}
 		}
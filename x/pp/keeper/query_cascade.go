@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// GetParticipantCascade returns a revocation cascade and the number of
+// descendants it has still to visit.
+func (k Keeper) GetParticipantCascade(goCtx context.Context, req *types.QueryGetParticipantCascadeRequest) (*types.QueryGetParticipantCascadeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "cascade ID cannot be 0")
+	}
+
+	cascade, err := k.ParticipantCascade.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "cascade not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get cascade: %v", err))
+	}
+
+	return &types.QueryGetParticipantCascadeResponse{
+		Cascade:      cascade,
+		PendingCount: uint64(len(cascade.Pending)),
+	}, nil
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// EstimateSessionFees returns the fees a CreateOrUpdateParticipantSession would
+// charge, computed by the same fee path as the message but without any write.
+// The payer trust deposit items assume the payer has no claimable balance to
+// draw from, so required_payer_balance is an upper bound.
+func (k Keeper) EstimateSessionFees(goCtx context.Context, req *types.QueryEstimateSessionFeesRequest) (*types.QueryEstimateSessionFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms := msgServer{Keeper: k}
+
+	// [MOD-PP-MSG-10-2] participant checks, without the session and operator checks
+	if req.IssuerParticipantId == 0 && req.VerifierParticipantId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one of issuer_participant_id or verifier_participant_id must be provided")
+	}
+	if req.IssuerParticipantId != 0 {
+		if _, err := k.getActiveParticipantWithRole(ctx, req.IssuerParticipantId, types.ParticipantRole_ISSUER, "issuer"); err != nil {
+			return nil, err
+		}
+	}
+	if req.VerifierParticipantId != 0 {
+		if _, err := k.getActiveParticipantWithRole(ctx, req.VerifierParticipantId, types.ParticipantRole_VERIFIER, "verifier"); err != nil {
+			return nil, err
+		}
+	}
+
+	var agentParticipant, walletAgentParticipant *types.Participant
+	if req.AgentParticipantId != 0 {
+		participant, err := k.getActiveParticipantWithRole(ctx, req.AgentParticipantId, types.ParticipantRole_ISSUER, "agent")
+		if err != nil {
+			return nil, err
+		}
+		agentParticipant = &participant
+	}
+	if req.WalletAgentParticipantId != 0 {
+		participant, err := k.getActiveParticipantWithRole(ctx, req.WalletAgentParticipantId, types.ParticipantRole_ISSUER, "wallet agent")
+		if err != nil {
+			return nil, err
+		}
+		walletAgentParticipant = &participant
+	}
+
+	// [MOD-PP-MSG-10-3] fee computation
+	fees, err := ms.calculateSessionFees(ctx, req.IssuerParticipantId, req.VerifierParticipantId)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	payer, err := ms.corpAccountFromID(ctx, fees.payer.CorporationId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	resp := &types.QueryEstimateSessionFeesResponse{
+		Beneficiaries:        make([]types.BeneficiaryFeeEstimate, 0, len(fees.beneficiaries)),
+		Items:                []types.FeeEstimateItem{},
+		BeneficiaryFees:      fees.beneficiaryFees,
+		Discount:             fees.executorDiscount,
+		TrustUnitPrice:       k.ecosystemKeeper.GetTrustUnitPrice(ctx),
+		Denom:                types.BondDenom,
+		Payer:                payer,
+		RequiredPayerBalance: fees.trustFees,
+	}
+
+	for _, share := range fees.beneficiaries {
+		participant := share.participant
+		resp.Beneficiaries = append(resp.Beneficiaries, types.BeneficiaryFeeEstimate{
+			ParticipantId: participant.Id,
+			Role:          participant.Role,
+			CorporationId: participant.CorporationId,
+			Fees:          share.fees,
+			FeesInDenom:   share.feesInDenom,
+		})
+		if share.fees == 0 {
+			continue
+		}
+
+		account, err := ms.corpAccountFromID(ctx, participant.CorporationId)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		resp.Items = appendFeeEstimateItem(resp.Items, types.FeeItemKind_FEE_ITEM_KIND_BENEFICIARY_FEE, types.FeeDestination_FEE_DESTINATION_ACCOUNT,
+			participant.Id, participant.CorporationId, account, share.toAccount)
+		resp.Items = appendFeeEstimateItem(resp.Items, types.FeeItemKind_FEE_ITEM_KIND_BENEFICIARY_FEE, types.FeeDestination_FEE_DESTINATION_TRUST_DEPOSIT,
+			participant.Id, participant.CorporationId, account, share.trustDeposit)
+		resp.Items = appendFeeEstimateItem(resp.Items, types.FeeItemKind_FEE_ITEM_KIND_BENEFICIARY_FEE, types.FeeDestination_FEE_DESTINATION_PAYER_TRUST_DEPOSIT,
+			fees.payer.Id, fees.payer.CorporationId, payer, share.trustDeposit)
+	}
+
+	resp.Items, err = ms.appendAgentRewardItems(ctx, resp.Items, types.FeeItemKind_FEE_ITEM_KIND_USER_AGENT_REWARD, agentParticipant, fees.userAgentReward)
+	if err != nil {
+		return nil, err
+	}
+	resp.Items, err = ms.appendAgentRewardItems(ctx, resp.Items, types.FeeItemKind_FEE_ITEM_KIND_WALLET_USER_AGENT_REWARD, walletAgentParticipant, fees.walletAgentReward)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.PayerBalance, resp.SufficientFunds = k.payerBalance(ctx, payer, fees.trustFees)
+
+	return resp, nil
+}
+
+// EstimateOnboardingFees returns the validation fees and trust deposit a
+// StartParticipantOP or RenewParticipantOP would charge to be validated by the
+// given validator participant, computed by the same fee path as the messages.
+func (k Keeper) EstimateOnboardingFees(goCtx context.Context, req *types.QueryEstimateOnboardingFeesRequest) (*types.QueryEstimateOnboardingFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms := msgServer{Keeper: k}
+
+	var applicantCorpID uint64
+	if req.Corporation != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Corporation); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid corporation address: %v", err))
+		}
+		corpID, err := ms.corpIDFromAccount(ctx, req.Corporation)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		applicantCorpID = corpID
+	}
+
+	var validatorParticipant types.Participant
+	if req.Role != types.ParticipantRole_UNSPECIFIED {
+		// [MOD-PP-MSG-1-2-2] Participant checks of StartParticipantOP
+		participant, err := ms.validateParticipantChecks(ctx, &types.MsgStartParticipantOP{
+			Corporation:            req.Corporation,
+			Role:                   req.Role,
+			ValidatorParticipantId: req.ValidatorParticipantId,
+		})
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		validatorParticipant = participant
+	} else {
+		// [MOD-PP-MSG-2-2-2] Validator checks of RenewParticipantOP
+		participant, err := k.Participant.Get(ctx, req.ValidatorParticipantId)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("validator participant not found: %v", err))
+		}
+		if err := IsValidParticipant(participant, ctx.BlockTime()); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("validator participant is not valid: %v", err))
+		}
+		validatorParticipant = participant
+	}
+
+	// [MOD-PP-MSG-1-2-3] Fee checks
+	fees, deposit, err := ms.validateAndCalculateFees(ctx, validatorParticipant)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	required := math.NewIntFromUint64(fees).Add(math.NewIntFromUint64(deposit))
+	if !required.IsUint64() {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("required balance overflows uint64: %s", required.String()))
+	}
+
+	resp := &types.QueryEstimateOnboardingFeesResponse{
+		Items:                []types.FeeEstimateItem{},
+		ValidationFees:       validatorParticipant.ValidationFees,
+		TrustUnitPrice:       k.ecosystemKeeper.GetTrustUnitPrice(ctx),
+		Denom:                types.BondDenom,
+		RequiredPayerBalance: required.Uint64(),
+	}
+
+	// Validation fees are escrowed by the module and paid to the validator on validation
+	resp.Items = appendFeeEstimateItem(resp.Items, types.FeeItemKind_FEE_ITEM_KIND_VALIDATION_FEE, types.FeeDestination_FEE_DESTINATION_ESCROW,
+		validatorParticipant.Id, validatorParticipant.CorporationId, "", fees)
+	resp.Items = appendFeeEstimateItem(resp.Items, types.FeeItemKind_FEE_ITEM_KIND_VALIDATION_DEPOSIT, types.FeeDestination_FEE_DESTINATION_PAYER_TRUST_DEPOSIT,
+		0, applicantCorpID, req.Corporation, deposit)
+
+	if req.Corporation != "" {
+		resp.PayerBalance, resp.SufficientFunds = k.payerBalance(ctx, req.Corporation, resp.RequiredPayerBalance)
+	}
+
+	return resp, nil
+}
+
+// getActiveParticipantWithRole loads a participant that MUST exist, have the
+// given role and be active, mirroring the session precondition checks.
+func (k Keeper) getActiveParticipantWithRole(ctx sdk.Context, id uint64, role types.ParticipantRole, name string) (types.Participant, error) {
+	participant, err := k.Participant.Get(ctx, id)
+	if err != nil {
+		return participant, status.Error(codes.NotFound, fmt.Sprintf("%s participant not found: %v", name, err))
+	}
+	if participant.Role != role {
+		return participant, status.Error(codes.InvalidArgument, fmt.Sprintf("%s participant must be %s type", name, role.String()))
+	}
+	if err := IsValidParticipant(participant, ctx.BlockTime()); err != nil {
+		return participant, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s participant is not valid: %v", name, err))
+	}
+	return participant, nil
+}
+
+// appendAgentRewardItems adds the account and trust deposit items of an agent
+// reward. The recipient is left empty when the agent participant is unknown.
+func (ms msgServer) appendAgentRewardItems(ctx sdk.Context, items []types.FeeEstimateItem, kind types.FeeItemKind, agent *types.Participant, reward agentRewardShare) ([]types.FeeEstimateItem, error) {
+	var participantID, corporationID uint64
+	var account string
+	if agent != nil {
+		corpAcct, err := ms.corpAccountFromID(ctx, agent.CorporationId)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		participantID, corporationID, account = agent.Id, agent.CorporationId, corpAcct
+	}
+
+	items = appendFeeEstimateItem(items, kind, types.FeeDestination_FEE_DESTINATION_ACCOUNT, participantID, corporationID, account, reward.toAccount)
+	items = appendFeeEstimateItem(items, kind, types.FeeDestination_FEE_DESTINATION_TRUST_DEPOSIT, participantID, corporationID, account, reward.trustDeposit)
+	return items, nil
+}
+
+// appendFeeEstimateItem adds an item to the breakdown, skipping zero amounts.
+func appendFeeEstimateItem(items []types.FeeEstimateItem, kind types.FeeItemKind, destination types.FeeDestination, participantID, corporationID uint64, account string, amount uint64) []types.FeeEstimateItem {
+	if amount == 0 {
+		return items
+	}
+	return append(items, types.FeeEstimateItem{
+		Kind:          kind,
+		Destination:   destination,
+		ParticipantId: participantID,
+		CorporationId: corporationID,
+		Account:       account,
+		Amount:        amount,
+	})
+}
+
+// payerBalance returns the spendable bond denom balance of the payer and
+// whether it covers the required amount.
+func (k Keeper) payerBalance(ctx sdk.Context, account string, required uint64) (uint64, bool) {
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return 0, false
+	}
+	balance := k.bankKeeper.SpendableCoins(ctx, addr).AmountOf(types.BondDenom)
+	sufficient := balance.GTE(math.NewIntFromUint64(required))
+	if !balance.IsUint64() {
+		return ^uint64(0), sufficient
+	}
+	return balance.Uint64(), sufficient
+}
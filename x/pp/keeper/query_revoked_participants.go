@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// ListRevokedParticipants returns revoked participants ordered by revocation
+// time then id. Callers sync incrementally by passing the revoked time and id
+// of the last returned participant as revoked_after and after_id. Without
+// after_id, revoked_after is an exclusive bound on the revocation time.
+func (k Keeper) ListRevokedParticipants(goCtx context.Context, req *types.QueryListRevokedParticipantsRequest) (*types.QueryListRevokedParticipantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+	if req.RevokedAfter != nil && req.RevokedBefore != nil && !req.RevokedAfter.Before(*req.RevokedBefore) {
+		return nil, status.Error(codes.InvalidArgument, "revoked_after must be before revoked_before")
+	}
+
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]])
+	if req.RevokedAfter != nil {
+		// Without after_id the whole revoked_after timestamp is skipped
+		afterID := req.AfterId
+		if afterID == 0 {
+			afterID = math.MaxUint64
+		}
+		rng = rng.StartExclusive(collections.Join(*req.RevokedAfter, afterID))
+	}
+	if req.RevokedBefore != nil {
+		rng = rng.EndExclusive(collections.Join(*req.RevokedBefore, uint64(0)))
+	}
+
+	// Ecosystem filter resolves through the participant schema, cache lookups
+	schemaEcosystem := make(map[uint64]uint64)
+	inEcosystem := func(schemaID uint64) (bool, error) {
+		ecosystemID, ok := schemaEcosystem[schemaID]
+		if !ok {
+			cs, err := k.credentialSchemaKeeper.GetCredentialSchemaById(ctx, schemaID)
+			if err != nil {
+				return false, err
+			}
+			ecosystemID = cs.EcosystemId
+			schemaEcosystem[schemaID] = ecosystemID
+		}
+		return ecosystemID == req.EcosystemId, nil
+	}
+
+	participants := []types.Participant{}
+	err := k.RevokedParticipant.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		participant, err := k.Participant.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		if req.SchemaId != 0 && participant.SchemaId != req.SchemaId {
+			return false, nil
+		}
+		if req.EcosystemId != 0 {
+			ok, err := inEcosystem(participant.SchemaId)
+			if err != nil {
+				return true, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+
+		participants = append(participants, participant)
+		return len(participants) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListRevokedParticipantsResponse{
+		Participants: participants,
+	}, nil
+}
@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// MaxFinalizedSlashesPerBlock bounds the number of participants whose pending
+// slashes are confirmed in a single EndBlocker run.
+const MaxFinalizedSlashesPerBlock = 100
+
+// SlashFinalizationRetryInterval is the delay after which the pending slashes
+// of a participant whose finalization failed are retried.
+const SlashFinalizationRetryInterval = time.Hour
+
+// AppealSlash handles the MsgAppealSlash message
+func (ms msgServer) AppealSlash(goCtx context.Context, msg *types.MsgAppealSlash) (*types.MsgAppealSlashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// [AUTHZ-CHECK] Verify operator authorization
+	if ms.delegationKeeper == nil {
+		return nil, fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.delegationKeeper.CheckOperatorAuthorization(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.pp.v1.MsgAppealSlash",
+		now,
+	); err != nil {
+		return nil, fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	participant, err := ms.Keeper.GetParticipantByID(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("participant not found: %w", err)
+	}
+
+	// The slash MUST be appealed by the slashed participant corporation
+	corpID, err := ms.corpIDFromAccount(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+	if participant.CorporationId != corpID {
+		return nil, fmt.Errorf("corporation is not the participant corporation")
+	}
+
+	slash, err := findSlash(participant, msg.SlashId)
+	if err != nil {
+		return nil, err
+	}
+	if slash.Status != types.SlashStatus_SLASH_STATUS_PENDING {
+		return nil, fmt.Errorf("slash %d is not pending: %s", slash.Id, slash.Status)
+	}
+	if !now.Before(*slash.DisputeDeadline) {
+		return nil, fmt.Errorf("dispute window of slash %d closed at %s", slash.Id, slash.DisputeDeadline)
+	}
+
+	// The appeal evidence, if any, MUST have been anchored in x/di
+	if msg.EvidenceDigest != "" {
+		if ms.digestKeeper == nil {
+			return nil, fmt.Errorf("digest keeper is required for appeal evidence")
+		}
+		anchored, err := ms.digestKeeper.HasDigest(ctx, msg.EvidenceDigest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check evidence digest: %w", err)
+		}
+		if !anchored {
+			return nil, fmt.Errorf("evidence digest %s is not anchored", msg.EvidenceDigest)
+		}
+	}
+
+	arbiter, err := ms.slashArbiter(ctx, participant, slash)
+	if err != nil {
+		return nil, err
+	}
+
+	slash.Status = types.SlashStatus_SLASH_STATUS_APPEALED
+	slash.AppealReason = msg.Reason
+	slash.AppealEvidenceDigest = msg.EvidenceDigest
+	slash.Appealed = &now
+	slash.Arbiter = arbiter
+	participant.Modified = &now
+
+	if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAppealSlash,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(msg.SlashId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeySlashReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyEvidenceDigest, msg.EvidenceDigest),
+			sdk.NewAttribute(types.AttributeKeyArbiter, arbiter),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgAppealSlashResponse{Arbiter: arbiter}, nil
+}
+
+// ResolveSlashAppeal handles the MsgResolveSlashAppeal message
+func (ms msgServer) ResolveSlashAppeal(goCtx context.Context, msg *types.MsgResolveSlashAppeal) (*types.MsgResolveSlashAppealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	participant, err := ms.Keeper.GetParticipantByID(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("participant not found: %w", err)
+	}
+
+	slash, err := findSlash(participant, msg.SlashId)
+	if err != nil {
+		return nil, err
+	}
+	if slash.Status != types.SlashStatus_SLASH_STATUS_APPEALED {
+		return nil, fmt.Errorf("slash %d is not appealed: %s", slash.Id, slash.Status)
+	}
+
+	// Only the arbiter recorded at appeal time can resolve it
+	if slash.Arbiter != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", slash.Arbiter, msg.Authority)
+	}
+
+	if msg.Reverse {
+		if err := ms.reverseSlash(ctx, &participant, slash, now); err != nil {
+			return nil, err
+		}
+	} else if err := ms.confirmSlash(ctx, &participant, slash, now); err != nil {
+		return nil, err
+	}
+	participant.Modified = &now
+
+	if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResolveSlashAppeal,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(msg.SlashId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeySlashStatus, slash.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgResolveSlashAppealResponse{}, nil
+}
+
+// slashArbiter returns the account that resolves an appeal of slash: the
+// ecosystem corporation group policy, or the x/gov authority for
+// ecosystem-level slashes, i.e. slashes of participants without validator or
+// slashes executed by the ecosystem corporation itself.
+func (ms msgServer) slashArbiter(ctx sdk.Context, participant types.Participant, slash *types.ParticipantSlash) (string, error) {
+	cs, err := ms.credentialSchemaKeeper.GetCredentialSchemaById(ctx, participant.SchemaId)
+	if err != nil {
+		return "", fmt.Errorf("credential schema not found: %w", err)
+	}
+	ec, err := ms.ecosystemKeeper.GetEcosystem(ctx, cs.EcosystemId)
+	if err != nil {
+		return "", fmt.Errorf("ecosystem not found: %w", err)
+	}
+
+	if participant.ValidatorParticipantId == 0 || slash.SlasherCorporationId == ec.CorporationId {
+		return ms.GetAuthority(), nil
+	}
+
+	return ms.corpAccountFromID(ctx, ec.CorporationId)
+}
+
+// findSlash returns the slash record of participant with the given id.
+func findSlash(participant types.Participant, slashID uint64) (*types.ParticipantSlash, error) {
+	for _, slash := range participant.Slashes {
+		if slash != nil && slash.Id == slashID {
+			return slash, nil
+		}
+	}
+	return nil, fmt.Errorf("slash %d not found on participant %d", slashID, participant.Id)
+}
+
+// confirmSlash makes slash final: the held amount is burnt from the trust
+// deposit of the participant corporation (MOD-TD-MSG-7), less the share
+// funding the compensation pool of the schema's ecosystem, the participant is
+// marked slashed and the VS operator authorization of ISSUER and VERIFIER
+// participants is revoked. The caller stores the participant.
+func (k Keeper) confirmSlash(ctx sdk.Context, participant *types.Participant, slash *types.ParticipantSlash, now time.Time) error {
+	// the ecosystem of the participant receives the compensation share of the slash
+	cs, err := k.credentialSchemaKeeper.GetCredentialSchemaById(ctx, participant.SchemaId)
+	if err != nil {
+		return fmt.Errorf("credential schema not found: %w", err)
+	}
+	if err := k.trustDeposit.BurnEcosystemSlashedTrustDeposit(ctx, cs.EcosystemId, participant.CorporationId, slash.Amount); err != nil {
+		return fmt.Errorf("failed to burn trust deposit: %w", err)
+	}
+
+	// set applicant_participant.slashed to now and increment
+	// applicant_participant.slashed_deposit by the slashed amount
+	participant.Slashed = &now
+	participant.SlashedDeposit += slash.Amount
+
+	slash.Status = types.SlashStatus_SLASH_STATUS_CONFIRMED
+	slash.Resolved = &now
+
+	// [MOD-PP-MSG-12-3] If applicant_participant.type is ISSUER or VERIFIER:
+	// Delete authorization for applicant_participant.vs_operator
+	if participant.Role == types.ParticipantRole_ISSUER || participant.Role == types.ParticipantRole_VERIFIER {
+		if k.delegationKeeper == nil {
+			return fmt.Errorf("delegation keeper is required for VS operator authorization")
+		}
+		if err := k.delegationKeeper.RevokeVSOperatorAuthorization(ctx, participant.Id); err != nil {
+			return fmt.Errorf("failed to revoke VS operator authorization: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// reverseSlash cancels slash. The part held back from the released deposit
+// goes back to unbonding, the rest returns to the participant deposit, or is
+// released as well if the participant was revoked in the meantime. The
+// caller stores the participant.
+func (k Keeper) reverseSlash(ctx sdk.Context, participant *types.Participant, slash *types.ParticipantSlash, now time.Time) error {
+	released := slash.HeldFromUnbonding
+	if participant.Revoked != nil {
+		released = slash.Amount
+	} else {
+		participant.Deposit += slash.Amount - slash.HeldFromUnbonding
+	}
+
+	if released > 0 {
+		if err := k.trustDeposit.AdjustTrustDeposit(ctx, participant.CorporationId, -int64(released), "participant_slash_reversed_release_deposit"); err != nil {
+			return fmt.Errorf("failed to release trust deposit: %w", err)
+		}
+		k.recordReleasedDeposit(ctx, participant, released, now)
+	}
+
+	slash.Status = types.SlashStatus_SLASH_STATUS_REVERSED
+	slash.Resolved = &now
+
+	return nil
+}
+
+// finalizeExpiredSlashes confirms the pending slashes whose dispute deadline
+// is reached, for up to limit participants, oldest deadline first. Each
+// participant is finalized in its own cache context: a failure, e.g. a trust
+// deposit already reduced below the held amount, is logged and reported by a
+// finalize_slash_failed event, and the participant is queued again
+// SlashFinalizationRetryInterval later, so that it does not halt the chain
+// nor block the following participants, and its slashes do not stay pending.
+func (k Keeper) finalizeExpiredSlashes(ctx sdk.Context, now time.Time, limit int) error {
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(now, uint64(math.MaxUint64)))
+
+	// Collect first, the index cannot be mutated while it is iterated
+	var keys []collections.Pair[time.Time, uint64]
+	err := k.SlashDisputeDeadline.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return len(keys) >= limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.SlashDisputeDeadline.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.finalizeParticipantSlashes(cacheCtx, key.K2(), now); err != nil {
+			k.Logger().Error("failed to finalize slashes", "participant_id", key.K2(), "error", err)
+			if err := k.SlashDisputeDeadline.Set(ctx, collections.Join(now.Add(SlashFinalizationRetryInterval), key.K2())); err != nil {
+				return err
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeFinalizeSlashFailed,
+					sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(key.K2(), 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// finalizeParticipantSlashes confirms the pending slashes of participant id
+// whose dispute deadline is reached.
+func (k Keeper) finalizeParticipantSlashes(ctx sdk.Context, id uint64, now time.Time) error {
+	participant, err := k.Participant.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Appealed slashes wait for their arbiter, and a participant may
+	// have several pending slashes with different deadlines
+	var confirmed []*types.ParticipantSlash
+	for _, slash := range participant.Slashes {
+		if slash == nil || slash.Status != types.SlashStatus_SLASH_STATUS_PENDING ||
+			slash.DisputeDeadline == nil || slash.DisputeDeadline.After(now) {
+			continue
+		}
+		if err := k.confirmSlash(ctx, &participant, slash, now); err != nil {
+			return fmt.Errorf("failed to confirm slash %d: %w", slash.Id, err)
+		}
+		confirmed = append(confirmed, slash)
+	}
+	if len(confirmed) == 0 {
+		return nil
+	}
+
+	participant.Modified = &now
+	if err := k.UpdateParticipant(ctx, participant); err != nil {
+		return fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	for _, slash := range confirmed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFinalizeSlash,
+				sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(participant.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(slash.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(slash.Amount, 10)),
+				sdk.NewAttribute(types.AttributeKeySlashStatus, slash.Status.String()),
+				sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+			),
+		)
+	}
+
+	return nil
+}
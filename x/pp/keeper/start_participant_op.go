@@ -96,13 +96,19 @@ func (ms msgServer) executeStartParticipantVP(ctx sdk.Context, msg *types.MsgSta
 	validationFeesInDenom := fees
 	validationTrustDepositInDenom := deposit
 
+	// Resolve the signing corporation account (policy_address) to its uint64 id.
+	corporationId, err := ms.corpIDFromAccount(ctx, msg.Corporation)
+	if err != nil {
+		return 0, err
+	}
+
 	// [MOD-PP-MSG-1-3] Use [MOD-TD-MSG-1] to increase trust deposit
 	if validationTrustDepositInDenom > 0 {
 		tdI64, err := uint64ToInt64(validationTrustDepositInDenom, "validation_trust_deposit")
 		if err != nil {
 			return 0, err
 		}
-		if err := ms.trustDeposit.AdjustTrustDeposit(ctx, msg.Corporation, tdI64, "start_participant_vp_deposit"); err != nil {
+		if err := ms.trustDeposit.AdjustTrustDeposit(ctx, corporationId, tdI64, "start_participant_vp_deposit"); err != nil {
 			return 0, fmt.Errorf("failed to increase trust deposit: %w", err)
 		}
 	}
@@ -146,12 +152,6 @@ func (ms msgServer) executeStartParticipantVP(ctx sdk.Context, msg *types.MsgSta
 		requestedVerificationFees = msg.VerificationFees.Value
 	}
 
-	// Resolve the signing corporation account (policy_address) to its uint64 id.
-	corporationId, err := ms.corpIDFromAccount(ctx, msg.Corporation)
-	if err != nil {
-		return 0, err
-	}
-
 	// [MOD-PP-MSG-1-2-1] (did, corporation_id) consistency: did MUST NOT already
 	// be controlled by a different corporation.
 	if err := ms.assertDIDCorporationConsistent(ctx, msg.Did, corporationId); err != nil {
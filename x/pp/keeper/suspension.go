@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// SuspendParticipant handles the MsgSuspendParticipant message
+func (ms msgServer) SuspendParticipant(goCtx context.Context, msg *types.MsgSuspendParticipant) (*types.MsgSuspendParticipantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	participant, corpID, err := ms.loadParticipantForSuspension(ctx, msg.Corporation, msg.Operator, "/verana.pp.v1.MsgSuspendParticipant", msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revocation is final, there is nothing left to suspend
+	if participant.Revoked != nil {
+		return nil, fmt.Errorf("participant is revoked")
+	}
+	if participant.Suspended != nil {
+		return nil, fmt.Errorf("participant is already suspended since %v", participant.Suspended)
+	}
+
+	suspendParticipant(&participant, msg.Reason, corpID, now)
+
+	if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSuspendParticipant,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeySuspensionReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgSuspendParticipantResponse{}, nil
+}
+
+// UnsuspendParticipant handles the MsgUnsuspendParticipant message
+func (ms msgServer) UnsuspendParticipant(goCtx context.Context, msg *types.MsgUnsuspendParticipant) (*types.MsgUnsuspendParticipantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	participant, corpID, err := ms.loadParticipantForSuspension(ctx, msg.Corporation, msg.Operator, "/verana.pp.v1.MsgUnsuspendParticipant", msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if participant.Suspended == nil {
+		return nil, fmt.Errorf("participant is not suspended")
+	}
+
+	// The open suspension is the last entry of the history
+	suspension := participant.Suspensions[len(participant.Suspensions)-1]
+	suspension.Unsuspended = &now
+	suspension.UnsuspendReason = msg.Reason
+	suspension.UnsuspenderCorporationId = corpID
+	participant.Suspended = nil
+	participant.Modified = &now
+
+	if err := ms.Keeper.UpdateParticipant(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnsuspendParticipant,
+			sdk.NewAttribute(types.AttributeKeyParticipantID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporation, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeySuspensionReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgUnsuspendParticipantResponse{}, nil
+}
+
+// suspendParticipant opens a suspension of participant at now. Shared by
+// SuspendParticipant and revocation cascades.
+func suspendParticipant(participant *types.Participant, reason string, suspenderCorpID uint64, now time.Time) {
+	participant.Suspended = &now
+	participant.Suspensions = append(participant.Suspensions, &types.ParticipantSuspension{
+		Suspended:              &now,
+		Reason:                 reason,
+		SuspenderCorporationId: suspenderCorpID,
+	})
+	participant.Modified = &now
+}
+
+// loadParticipantForSuspension runs the AUTHZ-CHECK, loads the participant
+// and checks that corporation may suspend it: like for slashing, it MUST be a
+// validator ancestor or the controlling Ecosystem. It returns the participant
+// and the co.id of corporation.
+func (ms msgServer) loadParticipantForSuspension(ctx sdk.Context, corporation, operator, msgTypeURL string, id uint64) (types.Participant, uint64, error) {
+	// [AUTHZ-CHECK] Verify operator authorization
+	if ms.delegationKeeper == nil {
+		return types.Participant{}, 0, fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.delegationKeeper.CheckOperatorAuthorization(ctx, corporation, operator, msgTypeURL, ctx.BlockTime()); err != nil {
+		return types.Participant{}, 0, fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	participant, err := ms.Keeper.GetParticipantByID(ctx, id)
+	if err != nil {
+		return types.Participant{}, 0, fmt.Errorf("participant not found: %w", err)
+	}
+
+	// Option #1: executed by a validator ancestor
+	// Option #2: executed by the controlling Ecosystem
+	if !ms.checkValidatorAncestorOption(ctx, corporation, participant, ctx.BlockTime()) &&
+		!ms.checkEcosystemControllerOption(ctx, corporation, participant) {
+		return types.Participant{}, 0, fmt.Errorf("authority is not authorized to suspend this participant")
+	}
+
+	corpID, err := ms.corpIDFromAccount(ctx, corporation)
+	if err != nil {
+		return types.Participant{}, 0, err
+	}
+
+	return participant, corpID, nil
+}
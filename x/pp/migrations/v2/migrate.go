@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"context"
+	"slices"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// Keeper defines the interface required for migration.
+// This interface allows the migration to work without importing the keeper package,
+// breaking the cyclic dependency.
+type Keeper interface {
+	// RebuildParticipantSessionIndexes recreates the index entries of every stored session
+	RebuildParticipantSessionIndexes(ctx context.Context) error
+	// RebuildParticipantIndexes recreates the derived indexes over participants
+	RebuildParticipantIndexes(ctx context.Context) error
+	GetParams(ctx context.Context) types.Params
+	SetParams(ctx context.Context, params types.Params) error
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// v2 adds the corporation, vs_operator, participant and modified-time indexes
+// over ParticipantSession and the revoked participant, scheduled fee change,
+// slash dispute deadline, validator participant and expiry notice indexes;
+// they are backfilled from the existing sessions and participants. The new
+// participant_session_retention_days param decodes as 0 from v1 params, which
+// keeps sessions forever until governance enables pruning; fee_change_notice_period_days, slash_dispute_window_days
+// and expiry_notice_lead_days are set to their defaults.
+func MigrateStore(ctx context.Context, k Keeper) error {
+	// Params first, the expiry notice index depends on them
+	params := k.GetParams(ctx)
+	params.FeeChangeNoticePeriodDays = types.DefaultFeeChangeNoticePeriodDays
+	params.SlashDisputeWindowDays = types.DefaultSlashDisputeWindowDays
+	params.ExpiryNoticeLeadDays = slices.Clone(types.DefaultExpiryNoticeLeadDays)
+	params.MaxBatchSize = types.DefaultMaxBatchSize
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	if err := k.RebuildParticipantSessionIndexes(ctx); err != nil {
+		return err
+	}
+	return k.RebuildParticipantIndexes(ctx)
+}
@@ -73,6 +73,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Usage:        "Maximum number of results to return (1-1024)",
 							DefaultValue: "64",
 						},
+						"corporation_id": {
+							Name:  "corporation-id",
+							Usage: "Filter by corporation id",
+						},
+						"vs_operator": {
+							Name:  "vs-operator",
+							Usage: "Filter by VS operator account",
+						},
+						"participant_id": {
+							Name:  "participant-id",
+							Usage: "Filter by participant id referenced by any session record",
+						},
 					},
 				},
 				{
@@ -91,6 +103,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							DefaultValue: "",
 							Usage:        "Filter by validity at specified timestamp (RFC3339 format)",
 						},
+						"include_did_history": {
+							Name:  "include-did-history",
+							Usage: "Also match participants that had the DID before a rotation",
+						},
 					},
 				},
 				{
@@ -111,6 +127,97 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "ListRevokedParticipants",
+					Use:       "list-revoked-participants",
+					Short:     "List revoked participants",
+					Long:      "List revoked participants ordered by revocation time, optionally filtered by schema, ecosystem and revocation time range. Pass the revoked time and id of the last returned participant as --revoked-after and --after-id to sync incrementally.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"schema_id": {
+							Name:  "schema-id",
+							Usage: "Filter by credential schema id",
+						},
+						"ecosystem_id": {
+							Name:  "ecosystem-id",
+							Usage: "Filter by ecosystem id",
+						},
+						"revoked_after": {
+							Name:  "revoked-after",
+							Usage: "Exclusive lower bound of the revocation time (RFC3339 format)",
+						},
+						"after_id": {
+							Name:  "after-id",
+							Usage: "Participant id cursor for participants revoked exactly at revoked-after",
+						},
+						"revoked_before": {
+							Name:  "revoked-before",
+							Usage: "Exclusive upper bound of the revocation time (RFC3339 format)",
+						},
+						"response_max_size": {
+							Name:         "response-max-size",
+							Usage:        "Maximum number of results to return (1-1024)",
+							DefaultValue: "64",
+						},
+					},
+				},
+				{
+					RpcMethod: "EstimateSessionFees",
+					Use:       "estimate-session-fees",
+					Short:     "Estimate the fees charged by a participant session",
+					Long:      "Estimate the fees a create-or-update-participant-session would charge, itemized per beneficiary and destination, including the balance the payer must hold. At least one of issuer-participant-id or verifier-participant-id must be provided.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"issuer_participant_id": {
+							Name:         "issuer-participant-id",
+							DefaultValue: "0",
+							Usage:        "ID of the issuer participant",
+						},
+						"verifier_participant_id": {
+							Name:         "verifier-participant-id",
+							DefaultValue: "0",
+							Usage:        "ID of the verifier participant",
+						},
+						"agent_participant_id": {
+							Name:         "agent-participant-id",
+							DefaultValue: "0",
+							Usage:        "ID of the user agent participant",
+						},
+						"wallet_agent_participant_id": {
+							Name:         "wallet-agent-participant-id",
+							DefaultValue: "0",
+							Usage:        "ID of the wallet user agent participant",
+						},
+					},
+				},
+				{
+					RpcMethod: "EstimateOnboardingFees",
+					Use:       "estimate-onboarding-fees [validator-participant-id]",
+					Short:     "Estimate the fees charged to start or renew an onboarding process",
+					Long:      "Estimate the validation fees and trust deposit charged to be validated by the given validator participant. Set --role to run the start-participant-op checks, omit it for a renewal.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_participant_id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"role": {
+							Name:  "role",
+							Usage: "Role requested by the applicant",
+						},
+						"corporation": {
+							Name:  "corporation",
+							Usage: "Applicant corporation account, used to report its balance",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetParticipantCascade",
+					Use:       "get-participant-cascade [id]",
+					Short:     "Get a revocation cascade by ID",
+					Long:      "Get a revocation cascade started by revoke-participant --cascade, with the number of descendants it has still to visit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -348,6 +455,19 @@ Parameters:
 						},
 					},
 					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"reason": {
+							Usage: "Revocation reason (e.g. REVOCATION_REASON_KEY_COMPROMISE)",
+						},
+						"evidence": {
+							Usage: "Free-text evidence for the revocation",
+						},
+						"evidence_digest": {
+							Name:  "evidence-digest",
+							Usage: "SRI digest of off-chain evidence for the revocation",
+						},
+						"cascade": {
+							Usage: "Also revoke or suspend the descendants of the participant (CASCADE_MODE_REVOKE or CASCADE_MODE_SUSPEND)",
+						},
 						"corporation": {
 							DefaultValue: "",
 							Usage:        "The group policy address (corporation) on whose behalf this message is executed",
@@ -394,9 +514,11 @@ Parameters:
 				},
 				{
 					RpcMethod: "SlashParticipantTrustDeposit",
-					Use:       "slash-participant-td [id] [amount] [reason]",
+					Use:       "slash-participant-td [id] [amount] [reason] [evidence-digest]",
 					Short:     "Slash a participant's trust deposit",
-					Long:      "Slash a participant's trust deposit. Requires a non-empty reason per [MOD-PP-MSG-12-1].",
+					Long: `Slash a participant's trust deposit. Requires a non-empty reason per [MOD-PP-MSG-12-1] and the SRI digest
+of the slash evidence, which must already be stored in the digest module.
+The slashed amount is held until the dispute window closes, during which the participant can appeal the slash.`,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{
 							ProtoField: "id",
@@ -407,6 +529,9 @@ Parameters:
 						{
 							ProtoField: "reason",
 						},
+						{
+							ProtoField: "evidence_digest",
+						},
 					},
 					FlagOptions: map[string]*autocliv1.FlagOptions{
 						"corporation": {
@@ -465,6 +590,171 @@ Parameters:
 						},
 					},
 				},
+				{
+					RpcMethod: "ProposeParticipantFeeChange",
+					Use:       "propose-participant-fee-change [id] --corporation [corporation]",
+					Short:     "Propose new fees and discounts for a participant",
+					Long:      "Propose new fees and discounts for a participant. Must be executed by the validator participant corporation, or by the ecosystem controller for participants without validator. The change takes effect once accepted by the participant and after the notice period.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"corporation": {
+							DefaultValue: "",
+							Usage:        "The group policy address (corporation) on whose behalf this message is executed",
+						},
+						"validation_fees": {
+							Name:  "validation-fees",
+							Usage: "New validation fees in trust units",
+						},
+						"issuance_fees": {
+							Name:  "issuance-fees",
+							Usage: "New issuance fees in trust units",
+						},
+						"verification_fees": {
+							Name:  "verification-fees",
+							Usage: "New verification fees in trust units",
+						},
+						"issuance_fee_discount": {
+							Name:  "issuance-fee-discount",
+							Usage: "New issuance fee discount (0-10000, 10000 = 100%)",
+						},
+						"verification_fee_discount": {
+							Name:  "verification-fee-discount",
+							Usage: "New verification fee discount (0-10000, 10000 = 100%)",
+						},
+					},
+				},
+				{
+					RpcMethod: "AcceptParticipantFeeChange",
+					Use:       "accept-participant-fee-change [id] --corporation [corporation]",
+					Short:     "Accept the pending fee change of a participant",
+					Long:      "Accept the fee change proposed by the validator of a participant. The new fees take effect after the notice period.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"corporation": {
+							DefaultValue: "",
+							Usage:        "The group policy address (corporation) on whose behalf this message is executed",
+						},
+					},
+				},
+				{
+					RpcMethod: "AppealSlash",
+					Use:       "appeal-slash [id] [slash-id] [reason] --corporation [corporation]",
+					Short:     "Appeal a pending slash of a participant",
+					Long: `Appeal a slash of a participant during its dispute window. Can only be called by the corporation that owns the participant.
+The appeal is resolved by the ecosystem corporation, or by governance for ecosystem-level slashes.`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+						{
+							ProtoField: "slash_id",
+						},
+						{
+							ProtoField: "reason",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"corporation": {
+							DefaultValue: "",
+							Usage:        "The group policy address (corporation) on whose behalf this message is executed",
+						},
+						"evidence_digest": {
+							Name:  "evidence-digest",
+							Usage: "SRI digest of the appeal evidence, stored in the digest module",
+						},
+					},
+				},
+				{
+					RpcMethod: "ResolveSlashAppeal",
+					Skip:      true, // skipped because arbiter gated, submitted through a group or gov proposal
+				},
+				{
+					RpcMethod: "SuspendParticipant",
+					Use:       "suspend-participant [id] [reason] --corporation [corporation]",
+					Short:     "Suspend a participant",
+					Long: `Temporarily suspend a participant, for instance during an investigation. A suspended participant is not valid
+until it is unsuspended. Can be called by a validator ancestor or by the ecosystem corporation.`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+						{
+							ProtoField: "reason",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"corporation": {
+							DefaultValue: "",
+							Usage:        "The group policy address (corporation) on whose behalf this message is executed",
+						},
+					},
+				},
+				{
+					RpcMethod: "UnsuspendParticipant",
+					Use:       "unsuspend-participant [id] --corporation [corporation]",
+					Short:     "Lift the suspension of a participant",
+					Long:      "Lift the current suspension of a participant. Can be called by a validator ancestor or by the ecosystem corporation.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"corporation": {
+							DefaultValue: "",
+							Usage:        "The group policy address (corporation) on whose behalf this message is executed",
+						},
+						"reason": {
+							Usage: "Reason for lifting the suspension",
+						},
+					},
+				},
+				{
+					RpcMethod: "RotateParticipantDID",
+					Use:       "rotate-participant-did [id] [new-did] --corporation [corporation]",
+					Short:     "Rotate the DID of a participant",
+					Long:      "Change the DID of a participant, the previous DID being kept in its DID history. Can only be called by the corporation of the participant.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+						{
+							ProtoField: "new_did",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"corporation": {
+							DefaultValue: "",
+							Usage:        "The group policy address (corporation) on whose behalf this message is executed",
+						},
+					},
+				},
+				{
+					// Skip autocli for this RPC -- items are nested messages, the
+					// tx is built as JSON and signed with `tx sign`.
+					RpcMethod: "BatchRevokeParticipants",
+					Skip:      true,
+				},
+				{
+					// Skip autocli for this RPC -- items are nested messages, the
+					// tx is built as JSON and signed with `tx sign`.
+					RpcMethod: "BatchSetParticipantEffectiveUntil",
+					Skip:      true,
+				},
+				{
+					// Skip autocli for this RPC -- items are nested messages, the
+					// tx is built as JSON and signed with `tx sign`.
+					RpcMethod: "BatchSelfCreateParticipants",
+					Skip:      true,
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -19,6 +19,7 @@ func DefaultGenesis() *types.GenesisState {
 		Participants:        []types.Participant{},
 		ParticipantSessions: []types.ParticipantSession{},
 		NextParticipantId:   1, // Start with 1 as first ID
+		ParticipantCascades: []types.ParticipantCascade{},
 	}
 }
 
@@ -43,6 +44,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// Import all participant cascades, the counter resumes after the last one
+	var lastCascadeID uint64
+	for _, cascade := range genState.ParticipantCascades {
+		if err := k.ParticipantCascade.Set(ctx, cascade.Id, cascade); err != nil {
+			panic(fmt.Errorf("failed to set participant cascade: %w", err))
+		}
+		lastCascadeID = max(lastCascadeID, cascade.Id)
+	}
+	if err := k.ParticipantCascadeCounter.Set(ctx, lastCascadeID); err != nil {
+		panic(fmt.Errorf("failed to set participant cascade counter: %w", err))
+	}
+
+	// Indexes are derived state and not exported, rebuild them
+	if err := k.RebuildParticipantSessionIndexes(ctx); err != nil {
+		panic(fmt.Errorf("failed to rebuild participant session indexes: %w", err))
+	}
+	if err := k.RebuildParticipantIndexes(ctx); err != nil {
+		panic(fmt.Errorf("failed to rebuild revoked participant index: %w", err))
+	}
+
 	// Set the participants counter
 	if err := k.ParticipantCounter.Set(ctx, genState.NextParticipantId); err != nil {
 		panic(fmt.Errorf("failed to set participant counter: %w", err))
@@ -88,6 +109,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.ParticipantSessions = sessions
 
+	// Export all participant cascades, ordered by ID
+	cascades := []types.ParticipantCascade{}
+	if err := k.ParticipantCascade.Walk(ctx, nil, func(id uint64, cascade types.ParticipantCascade) (bool, error) {
+		cascades = append(cascades, cascade)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export participant cascades: %w", err))
+	}
+
+	genesis.ParticipantCascades = cascades
+
 	// Export participant counter
 	nextId, err := k.ParticipantCounter.Get(ctx)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
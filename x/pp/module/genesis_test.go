@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -240,6 +241,16 @@ func TestGenesisImportExport(t *testing.T) {
 	require.NoError(t, k.ParticipantSession.Set(ctx, session1.Id, session1))
 	require.NoError(t, k.ParticipantSession.Set(ctx, session2.Id, session2))
 
+	// A cascade still in progress
+	cascade := types.ParticipantCascade{
+		Id:                1,
+		RootParticipantId: 1,
+		Mode:              types.CascadeMode_CASCADE_MODE_SUSPEND,
+		Started:           &nowTime,
+		Pending:           []uint64{2, 3},
+	}
+	require.NoError(t, k.ParticipantCascade.Set(ctx, cascade.Id, cascade))
+
 	// Export genesis state
 	genesisState := participant.ExportGenesis(ctx, k)
 
@@ -247,6 +258,7 @@ func TestGenesisImportExport(t *testing.T) {
 	require.Equal(t, uint64(4), genesisState.NextParticipantId)
 	require.Len(t, genesisState.Participants, 3)
 	require.Len(t, genesisState.ParticipantSessions, 2)
+	require.Len(t, genesisState.ParticipantCascades, 1)
 
 	// Create a new keeper instance
 	k2, _, _, _, ctx2, _ := keepertest.ParticipantKeeper(t)
@@ -285,6 +297,17 @@ func TestGenesisImportExport(t *testing.T) {
 	require.Equal(t, session2.Id, session2Get.Id)
 	require.Equal(t, session2.SessionRecords[0].WalletAgentParticipantId, session2Get.SessionRecords[0].WalletAgentParticipantId)
 
+	// Derived indexes are rebuilt on import
+	pending, err := k2.PendingParticipantCascade.Has(ctx2, cascade.Id)
+	require.NoError(t, err)
+	require.True(t, pending)
+	validated, err := k2.ParticipantByValidator.Has(ctx2, collections.Join(uint64(1), uint64(3)))
+	require.NoError(t, err)
+	require.True(t, validated)
+	cascadeCounter, err := k2.ParticipantCascadeCounter.Get(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, cascade.Id, cascadeCounter)
+
 	// Export from the new keeper and verify it matches the original export
 	exportedState2 := participant.ExportGenesis(ctx2, k2)
 
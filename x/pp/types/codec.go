@@ -22,6 +22,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSlashParticipantTrustDeposit{}, "verana/x/pp/MsgSlashParticipantTD")
 	legacy.RegisterAminoMsg(cdc, &MsgRepayParticipantSlashedTrustDeposit{}, "verana/x/pp/MsgRepayPartSlashedTD")
 	legacy.RegisterAminoMsg(cdc, &MsgSelfCreateParticipant{}, "verana/x/pp/MsgSelfCreateParticipant")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeParticipantFeeChange{}, "verana/x/pp/MsgProposePartFeeChange")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptParticipantFeeChange{}, "verana/x/pp/MsgAcceptPartFeeChange")
+	legacy.RegisterAminoMsg(cdc, &MsgAppealSlash{}, "verana/x/pp/MsgAppealSlash")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveSlashAppeal{}, "verana/x/pp/MsgResolveSlashAppeal")
+	legacy.RegisterAminoMsg(cdc, &MsgSuspendParticipant{}, "verana/x/pp/MsgSuspendParticipant")
+	legacy.RegisterAminoMsg(cdc, &MsgUnsuspendParticipant{}, "verana/x/pp/MsgUnsuspendParticipant")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateParticipantDID{}, "verana/x/pp/MsgRotateParticipantDID")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchRevokeParticipants{}, "verana/x/pp/MsgBatchRevokeParticipants")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchSetParticipantEffectiveUntil{}, "verana/x/pp/MsgBatchSetPartEffUntil")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchSelfCreateParticipants{}, "verana/x/pp/MsgBatchSelfCreatePart")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -40,6 +50,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSlashParticipantTrustDeposit{},
 		&MsgRepayParticipantSlashedTrustDeposit{},
 		&MsgSelfCreateParticipant{},
+		&MsgProposeParticipantFeeChange{},
+		&MsgAcceptParticipantFeeChange{},
+		&MsgAppealSlash{},
+		&MsgResolveSlashAppeal{},
+		&MsgSuspendParticipant{},
+		&MsgUnsuspendParticipant{},
+		&MsgRotateParticipantDID{},
+		&MsgBatchRevokeParticipants{},
+		&MsgBatchSetParticipantEffectiveUntil{},
+		&MsgBatchSelfCreateParticipants{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -45,6 +45,53 @@ const (
 	EventTypeSetParticipantEffectiveUntil = "set_participant_effective_until"
 	AttributeKeyNewEffectiveUntil         = "new_effective_until"
 
-	EventTypeRevokeParticipant = "revoke_participant"
-	AttributeKeyRevokedAt      = "revoked_at"
+	EventTypeRevokeParticipant   = "revoke_participant"
+	AttributeKeyRevokedAt        = "revoked_at"
+	AttributeKeyRevocationReason = "revocation_reason"
+	AttributeKeyEvidenceDigest   = "evidence_digest"
+
+	EventTypePruneParticipantSessions = "prune_participant_sessions"
+	AttributeKeyPrunedCount           = "pruned_count"
+	AttributeKeyCutoff                = "cutoff"
+
+	EventTypeProposeParticipantFeeChange     = "propose_participant_fee_change"
+	EventTypeAcceptParticipantFeeChange      = "accept_participant_fee_change"
+	EventTypeApplyParticipantFeeChange       = "apply_participant_fee_change"
+	EventTypeApplyParticipantFeeChangeFailed = "apply_participant_fee_change_failed"
+	AttributeKeyIssuanceFeeDiscount          = "issuance_fee_discount"
+	AttributeKeyVerificationFeeDiscount      = "verification_fee_discount"
+	AttributeKeyEffective                    = "effective"
+
+	EventTypeAppealSlash         = "appeal_slash"
+	EventTypeResolveSlashAppeal  = "resolve_slash_appeal"
+	EventTypeFinalizeSlash       = "finalize_slash"
+	EventTypeFinalizeSlashFailed = "finalize_slash_failed"
+	AttributeKeySlashID          = "slash_id"
+	AttributeKeySlashStatus      = "slash_status"
+	AttributeKeySlashReason      = "reason"
+	AttributeKeyDisputeDeadline  = "dispute_deadline"
+	AttributeKeyArbiter          = "arbiter"
+	AttributeKeyError            = "error"
+
+	EventTypeSuspendParticipant   = "suspend_participant"
+	EventTypeUnsuspendParticipant = "unsuspend_participant"
+	AttributeKeySuspensionReason  = "reason"
+
+	EventTypeCascadeParticipant       = "cascade_participant"
+	EventTypeCascadeParticipantFailed = "cascade_participant_failed"
+	EventTypeCompleteCascade          = "complete_cascade"
+	AttributeKeyCascadeID             = "cascade_id"
+	AttributeKeyCascadeMode           = "cascade_mode"
+	AttributeKeyAffectedCount         = "affected_count"
+
+	EventTypeParticipantExpiring           = "participant_expiring"
+	EventTypeParticipantExpired            = "participant_expired"
+	EventTypeParticipantExpiryNoticeFailed = "participant_expiry_notice_failed"
+	AttributeKeyExpiryField                = "field"
+	AttributeKeyExpiresAt                  = "expires_at"
+	AttributeKeyLeadDays                   = "lead_days"
+
+	EventTypeRotateParticipantDID = "rotate_participant_did"
+	AttributeKeyDID               = "did"
+	AttributeKeyPreviousDID       = "previous_did"
 )
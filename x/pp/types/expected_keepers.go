@@ -65,22 +65,28 @@ type CorporationKeeper interface {
 }
 
 // TrustDepositKeeper defines the expected interface for the Trust Deposit module.
+// Trust deposits are keyed by corporation_id.
 type TrustDepositKeeper interface {
-	AdjustTrustDeposit(ctx sdk.Context, account string, augend int64, reason string) error
-	AdjustTrustDepositOnBehalf(ctx sdk.Context, account string, funder sdk.AccAddress, amount int64) error
+	AdjustTrustDeposit(ctx sdk.Context, corporationID uint64, augend int64, reason string) error
+	AdjustTrustDepositOnBehalf(ctx sdk.Context, corporationID uint64, funder sdk.AccAddress, amount int64) error
 	GetTrustDepositRate(ctx sdk.Context) math.LegacyDec
 	GetUserAgentRewardRate(ctx sdk.Context) math.LegacyDec
 	GetWalletUserAgentRewardRate(ctx sdk.Context) math.LegacyDec
-	BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error
+	BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, ecosystemID uint64, corporationID uint64, amount uint64) error
+	GetUnbondingTrustDeposit(ctx sdk.Context, corporationID uint64) (uint64, error)
+	HoldUnbondingTrustDeposit(ctx sdk.Context, corporationID uint64, amount uint64) error
+	GetTrustDepositUnbondingDays(ctx sdk.Context) uint64
 }
 
 // DigestKeeper defines the expected interface for the Digest (DI) module.
 // Used by [MOD-PP-MSG-10] to persist credential digests discovered during
 // participant-session creation. Called keeper-to-keeper (no signer check) per
 // spec [MOD-DI-MSG-1] header: "This method can be called directly by Create
-// or Update Participant Session module with no checks."
+// or Update Participant Session module with no checks." HasDigest backs the
+// evidence checks of slashes and slash appeals.
 type DigestKeeper interface {
 	StoreDigestModuleCall(ctx context.Context, authority, digest, digestAlgorithm string) error
+	HasDigest(ctx context.Context, digest string) (bool, error)
 }
 
 // DelegationKeeper defines the expected interface for the Delegation Engine (DE)
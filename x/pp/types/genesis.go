@@ -11,6 +11,7 @@ func DefaultGenesis() *GenesisState {
 		Participants:        []Participant{},
 		ParticipantSessions: []ParticipantSession{},
 		NextParticipantId:   0,
+		ParticipantCascades: []ParticipantCascade{},
 	}
 }
 
@@ -74,6 +75,27 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Check for duplicate cascade IDs and dangling participant references
+	cascadeIds := make(map[uint64]bool)
+	for _, cascade := range gs.ParticipantCascades {
+		if cascade.Id == 0 {
+			return fmt.Errorf("participant cascade ID cannot be 0")
+		}
+		if cascadeIds[cascade.Id] {
+			return fmt.Errorf("duplicate participant cascade ID found: %d", cascade.Id)
+		}
+		cascadeIds[cascade.Id] = true
+
+		if !participantIds[cascade.RootParticipantId] {
+			return fmt.Errorf("root participant ID %d not found for cascade ID %d", cascade.RootParticipantId, cascade.Id)
+		}
+		for _, id := range cascade.Pending {
+			if !participantIds[id] {
+				return fmt.Errorf("pending participant ID %d not found for cascade ID %d", id, cascade.Id)
+			}
+		}
+	}
+
 	// Validate next participant ID is greater than max participant ID
 	if len(gs.Participants) > 0 && gs.NextParticipantId <= maxParticipantId {
 		return fmt.Errorf("next_participant_id (%d) must be greater than the maximum participant ID (%d)",
@@ -34,6 +34,9 @@ type GenesisState struct {
 	ParticipantSessions []ParticipantSession `protobuf:"bytes,3,rep,name=participant_sessions,json=participantSessions,proto3" json:"participant_sessions"`
 	// next_participant_id is the next auto-increment ID to be assigned to a new participant
 	NextParticipantId uint64 `protobuf:"varint,4,opt,name=next_participant_id,json=nextParticipantId,proto3" json:"next_participant_id,omitempty"`
+	// participant_cascades is a list of all ParticipantCascade objects,
+	// including the ones still in progress
+	ParticipantCascades []ParticipantCascade `protobuf:"bytes,5,rep,name=participant_cascades,json=participantCascades,proto3" json:"participant_cascades"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +100,13 @@ func (m *GenesisState) GetNextParticipantId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParticipantCascades() []ParticipantCascade {
+	if m != nil {
+		return m.ParticipantCascades
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "verana.pp.v1.GenesisState")
 }
@@ -104,27 +114,28 @@ func init() {
 func init() { proto.RegisterFile("verana/pp/v1/genesis.proto", fileDescriptor_9371041142ade8df) }
 
 var fileDescriptor_9371041142ade8df = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0xd4, 0x2f, 0x28, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xc8, 0xe9, 0x15, 0x14, 0xe8, 0x95, 0x19,
-	0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x02, 0x29, 0x91, 0xf4, 0xfc,
-	0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0xa2, 0x18, 0x59, 0x90, 0x58, 0x94, 0x98,
-	0x0b, 0x35, 0x51, 0x4a, 0x02, 0x45, 0xaa, 0xa4, 0xb2, 0x20, 0x15, 0x2a, 0xa3, 0x34, 0x9d, 0x89,
-	0x8b, 0xc7, 0x1d, 0x62, 0x7b, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x39, 0x17, 0x1b, 0x44, 0xab,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xb2, 0x6b, 0xf4, 0x02, 0xc0, 0x72, 0x4e,
-	0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0x5c, 0xc8, 0x99,
-	0x8b, 0xa7, 0x20, 0xb1, 0xa8, 0x24, 0x33, 0x39, 0xb3, 0x20, 0x31, 0xaf, 0xa4, 0x58, 0x82, 0x49,
-	0x81, 0x59, 0x83, 0xdb, 0x48, 0x12, 0x43, 0x3b, 0x4c, 0x85, 0x13, 0x0b, 0xc8, 0x8c, 0x20, 0x14,
-	0x4d, 0x42, 0x91, 0x5c, 0x22, 0x48, 0xfc, 0xf8, 0xe2, 0xd4, 0xe2, 0xe2, 0xcc, 0xfc, 0xbc, 0x62,
-	0x09, 0x66, 0xb0, 0x61, 0x0a, 0x38, 0x0d, 0x0b, 0x86, 0x28, 0x84, 0x9a, 0x29, 0x5c, 0x80, 0x21,
-	0x53, 0x2c, 0xa4, 0xc7, 0x25, 0x9c, 0x97, 0x5a, 0x51, 0x12, 0x8f, 0x6c, 0x7e, 0x66, 0x8a, 0x04,
-	0x8b, 0x02, 0xa3, 0x06, 0x4b, 0x90, 0x20, 0x48, 0x0a, 0xc9, 0x3c, 0xcf, 0x14, 0x27, 0xa7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x38, 0x48, 0x37, 0x27, 0x31, 0xa9, 0x18, 0xca, 0xd6, 0xaf,
-	0x00, 0x05, 0x33, 0x38, 0x8c, 0x93, 0xd8, 0xc0, 0x81, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x87, 0x98, 0x19, 0xb3, 0xee, 0x01, 0x00, 0x00,
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0xc7, 0xe3, 0xb6, 0x5f, 0xa5, 0x2f, 0xed, 0xd2, 0xb4, 0x43, 0x9a, 0x21, 0x44, 0x4c, 0x11,
+	0x12, 0xb6, 0x5a, 0x06, 0xf6, 0x74, 0x40, 0x6c, 0xa8, 0x9d, 0x60, 0xa9, 0xdc, 0xd4, 0x0a, 0x96,
+	0x68, 0x6c, 0xe5, 0x4c, 0x55, 0xde, 0x82, 0xc7, 0x60, 0xe4, 0x31, 0x3a, 0x76, 0x64, 0x42, 0xa8,
+	0x1d, 0xd8, 0x79, 0x02, 0x14, 0xdb, 0x48, 0x29, 0x05, 0xb1, 0x44, 0x17, 0xff, 0xfe, 0xf7, 0xbb,
+	0x93, 0xce, 0x0d, 0x96, 0xac, 0xa0, 0x39, 0x25, 0x52, 0x92, 0xe5, 0x80, 0x64, 0x2c, 0x67, 0xc0,
+	0x01, 0xcb, 0x42, 0x28, 0xe1, 0xb5, 0x0d, 0xc3, 0x52, 0xe2, 0xe5, 0x20, 0xe8, 0xd0, 0x05, 0xcf,
+	0x05, 0xd1, 0x5f, 0x13, 0x08, 0x7a, 0x99, 0xc8, 0x84, 0x2e, 0x49, 0x59, 0xd9, 0xd7, 0xfe, 0x9e,
+	0x52, 0xd2, 0x82, 0x2e, 0xac, 0x31, 0xf0, 0xf7, 0x90, 0x7a, 0x90, 0xcc, 0x92, 0xe3, 0x8f, 0x9a,
+	0xdb, 0xbe, 0x30, 0xd3, 0x27, 0x8a, 0x2a, 0xe6, 0x9d, 0xbb, 0x4d, 0xd3, 0xea, 0xa3, 0x08, 0xc5,
+	0xad, 0x61, 0x0f, 0x57, 0xb7, 0xc1, 0x57, 0x9a, 0x25, 0xff, 0xd7, 0xaf, 0x47, 0xce, 0xd3, 0xfb,
+	0xf3, 0x09, 0x1a, 0xdb, 0xb8, 0x37, 0x72, 0xdb, 0x92, 0x16, 0x8a, 0xa7, 0x5c, 0xd2, 0x5c, 0x81,
+	0x5f, 0x8b, 0xea, 0x71, 0x6b, 0xd8, 0x3f, 0x68, 0xff, 0x4a, 0x24, 0x8d, 0xd2, 0x31, 0xde, 0x6b,
+	0xf2, 0xae, 0xdd, 0x5e, 0xe5, 0x7f, 0x0a, 0x0c, 0x80, 0x8b, 0x1c, 0xfc, 0xba, 0x96, 0x45, 0xbf,
+	0xca, 0x26, 0x26, 0x68, 0x9d, 0x5d, 0x79, 0x40, 0xc0, 0xc3, 0x6e, 0x37, 0x67, 0x2b, 0x35, 0xad,
+	0xfa, 0xf9, 0xdc, 0x6f, 0x44, 0x28, 0x6e, 0x8c, 0x3b, 0x25, 0xaa, 0xf8, 0x2e, 0xe7, 0xdf, 0x57,
+	0x49, 0x29, 0xa4, 0x74, 0xce, 0xc0, 0xff, 0xf7, 0xc7, 0x2a, 0x23, 0x13, 0xfc, 0x61, 0x15, 0x4b,
+	0x20, 0x49, 0xd6, 0xdb, 0x10, 0x6d, 0xb6, 0x21, 0x7a, 0xdb, 0x86, 0xe8, 0x71, 0x17, 0x3a, 0x9b,
+	0x5d, 0xe8, 0xbc, 0xec, 0x42, 0xe7, 0x26, 0xce, 0xb8, 0xba, 0xbd, 0x9f, 0xe1, 0x54, 0x2c, 0x88,
+	0x19, 0x70, 0x7a, 0x47, 0x67, 0x60, 0x6b, 0xb2, 0x2a, 0x2f, 0xa8, 0xcf, 0x37, 0x6b, 0xea, 0xfb,
+	0x9d, 0x7d, 0x06, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x6e, 0xdc, 0x78, 0x49, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +158,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParticipantCascades) > 0 {
+		for iNdEx := len(m.ParticipantCascades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantCascades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.NextParticipantId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextParticipantId))
 		i--
@@ -227,6 +252,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextParticipantId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextParticipantId))
 	}
+	if len(m.ParticipantCascades) > 0 {
+		for _, e := range m.ParticipantCascades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -385,6 +416,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantCascades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantCascades = append(m.ParticipantCascades, ParticipantCascade{})
+			if err := m.ParticipantCascades[len(m.ParticipantCascades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,6 +22,36 @@ var (
 	ParticipantKey        = collections.NewPrefix(0)
 	ParticipantCounterKey = collections.NewPrefix(1)
 	ParticipantSessionKey = collections.NewPrefix(2)
+
+	// Secondary indexes over ParticipantSession, keyed by (field, session id)
+	ParticipantSessionByCorporationKey = collections.NewPrefix(3)
+	ParticipantSessionByVSOperatorKey  = collections.NewPrefix(4)
+	ParticipantSessionByParticipantKey = collections.NewPrefix(5)
+	ParticipantSessionByModifiedKey    = collections.NewPrefix(6)
+
+	// RevokedParticipantKey indexes revoked participants by (revoked, id)
+	RevokedParticipantKey = collections.NewPrefix(7)
+
+	// ScheduledFeeChangeKey indexes accepted participant fee changes by
+	// (effective, id)
+	ScheduledFeeChangeKey = collections.NewPrefix(8)
+
+	// SlashDisputeDeadlineKey indexes participants with pending slashes by
+	// (dispute_deadline, id)
+	SlashDisputeDeadlineKey = collections.NewPrefix(9)
+
+	// ParticipantByValidatorKey indexes participants by
+	// (validator_participant_id, id)
+	ParticipantByValidatorKey = collections.NewPrefix(10)
+
+	// Revocation cascades, and the ids of the ones still in progress
+	ParticipantCascadeKey        = collections.NewPrefix(11)
+	ParticipantCascadeCounterKey = collections.NewPrefix(12)
+	PendingParticipantCascadeKey = collections.NewPrefix(13)
+
+	// ExpiryNoticeKey indexes upcoming expiry notices by
+	// (notice time, participant id, lead days)
+	ExpiryNoticeKey = collections.NewPrefix(14)
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+func TestMsgBatchRevokeParticipants_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+	otherAddr := sdk.AccAddress([]byte("other_address_______")).String()
+
+	valid := func() *types.MsgBatchRevokeParticipants {
+		return &types.MsgBatchRevokeParticipants{
+			Corporation: validAddr,
+			Operator:    validAddr,
+			Items:       []types.MsgRevokeParticipant{{Id: 1}, {Corporation: validAddr, Operator: validAddr, Id: 2}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(m *types.MsgBatchRevokeParticipants)
+		wantErr string
+	}{
+		{"valid baseline", func(m *types.MsgBatchRevokeParticipants) {}, ""},
+		{"empty corporation", func(m *types.MsgBatchRevokeParticipants) { m.Corporation = "" }, "invalid corporation address"},
+		{"empty operator", func(m *types.MsgBatchRevokeParticipants) { m.Operator = "" }, "invalid operator address"},
+		{"no items", func(m *types.MsgBatchRevokeParticipants) { m.Items = nil }, "batch must not be empty"},
+		{"item of another corporation", func(m *types.MsgBatchRevokeParticipants) { m.Items[1].Corporation = otherAddr }, "item 1: corporation must be empty"},
+		{"item of another operator", func(m *types.MsgBatchRevokeParticipants) { m.Items[0].Operator = otherAddr }, "item 0: operator must be empty"},
+		{"invalid item", func(m *types.MsgBatchRevokeParticipants) { m.Items[1].Id = 0 }, "item 1: participant ID cannot be 0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid()
+			tc.mutate(m)
+			err := m.ValidateBasic()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgBatchSetParticipantEffectiveUntil_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	until := time.Now().Add(24 * time.Hour)
+
+	require.NoError(t, (&types.MsgBatchSetParticipantEffectiveUntil{
+		Corporation: validAddr, Operator: validAddr,
+		Items: []types.MsgSetParticipantEffectiveUntil{{Id: 1, EffectiveUntil: &until}},
+	}).ValidateBasic())
+	require.ErrorContains(t, (&types.MsgBatchSetParticipantEffectiveUntil{
+		Corporation: validAddr, Operator: validAddr,
+		Items: []types.MsgSetParticipantEffectiveUntil{{Id: 1, EffectiveUntil: &until}, {Id: 2}},
+	}).ValidateBasic(), "item 1:")
+}
+
+func TestMsgBatchSelfCreateParticipants_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	require.ErrorContains(t, (&types.MsgBatchSelfCreateParticipants{
+		Corporation: validAddr, Operator: validAddr,
+	}).ValidateBasic(), "batch must not be empty")
+	require.ErrorContains(t, (&types.MsgBatchSelfCreateParticipants{
+		Corporation: validAddr, Operator: validAddr,
+		Items: []types.MsgSelfCreateParticipant{{Role: types.ParticipantRole_ISSUER}},
+	}).ValidateBasic(), "item 0:")
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// TestMsgRevokeParticipant_ValidateBasic covers the revocation reason and the
+// optional free-text and digest evidence.
+func TestMsgRevokeParticipant_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	valid := func() *types.MsgRevokeParticipant {
+		return &types.MsgRevokeParticipant{
+			Corporation:    validAddr,
+			Operator:       validAddr,
+			Id:             1,
+			Reason:         types.RevocationReason_REVOCATION_REASON_FRAUD,
+			Evidence:       "forged credentials",
+			EvidenceDigest: "sha384-evidenceDigest",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(m *types.MsgRevokeParticipant)
+		wantErr string
+	}{
+		{"valid baseline", func(m *types.MsgRevokeParticipant) {}, ""},
+		{"no reason nor evidence", func(m *types.MsgRevokeParticipant) {
+			m.Reason = types.RevocationReason_REVOCATION_REASON_UNSPECIFIED
+			m.Evidence = ""
+			m.EvidenceDigest = ""
+		}, ""},
+		{"empty corporation", func(m *types.MsgRevokeParticipant) { m.Corporation = "" }, "invalid corporation address"},
+		{"empty operator", func(m *types.MsgRevokeParticipant) { m.Operator = "" }, "invalid operator address"},
+		{"id = 0", func(m *types.MsgRevokeParticipant) { m.Id = 0 }, "participant ID cannot be 0"},
+		{"unknown reason", func(m *types.MsgRevokeParticipant) { m.Reason = 99 }, "invalid revocation reason"},
+		{"evidence too long", func(m *types.MsgRevokeParticipant) {
+			m.Evidence = strings.Repeat("a", types.MaxRevocationEvidenceLength+1)
+		}, "evidence must not exceed"},
+		{"invalid evidence digest", func(m *types.MsgRevokeParticipant) { m.EvidenceDigest = "not-a-digest" }, "invalid evidence_digest format"},
+		{"cascade suspend", func(m *types.MsgRevokeParticipant) { m.Cascade = types.CascadeMode_CASCADE_MODE_SUSPEND }, ""},
+		{"unknown cascade mode", func(m *types.MsgRevokeParticipant) { m.Cascade = 99 }, "invalid cascade mode"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid()
+			tc.mutate(m)
+			err := m.ValidateBasic()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
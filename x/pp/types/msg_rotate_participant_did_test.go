@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+func TestMsgRotateParticipantDID_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	valid := func() *types.MsgRotateParticipantDID {
+		return &types.MsgRotateParticipantDID{
+			Corporation: validAddr,
+			Operator:    validAddr,
+			Id:          1,
+			NewDid:      "did:webvh:example.com",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(m *types.MsgRotateParticipantDID)
+		wantErr string
+	}{
+		{"valid baseline", func(m *types.MsgRotateParticipantDID) {}, ""},
+		{"empty corporation", func(m *types.MsgRotateParticipantDID) { m.Corporation = "" }, "invalid corporation address"},
+		{"empty operator", func(m *types.MsgRotateParticipantDID) { m.Operator = "" }, "invalid operator address"},
+		{"id = 0", func(m *types.MsgRotateParticipantDID) { m.Id = 0 }, "participant ID cannot be 0"},
+		{"empty new did", func(m *types.MsgRotateParticipantDID) { m.NewDid = "" }, "new_did is mandatory"},
+		{"invalid new did", func(m *types.MsgRotateParticipantDID) { m.NewDid = "not-a-did" }, "invalid DID syntax"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid()
+			tc.mutate(m)
+			err := m.ValidateBasic()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
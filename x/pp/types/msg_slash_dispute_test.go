@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+// TestMsgSlashParticipantTrustDeposit_ValidateBasic covers the mandatory
+// reason and evidence digest of a slash.
+func TestMsgSlashParticipantTrustDeposit_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	valid := func() *types.MsgSlashParticipantTrustDeposit {
+		return &types.MsgSlashParticipantTrustDeposit{
+			Corporation:    validAddr,
+			Operator:       validAddr,
+			Id:             1,
+			Amount:         100,
+			Reason:         "forged credentials",
+			EvidenceDigest: "sha384-evidenceDigest",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(m *types.MsgSlashParticipantTrustDeposit)
+		wantErr string
+	}{
+		{"valid baseline", func(m *types.MsgSlashParticipantTrustDeposit) {}, ""},
+		{"id = 0", func(m *types.MsgSlashParticipantTrustDeposit) { m.Id = 0 }, "id must be a valid uint64"},
+		{"amount = 0", func(m *types.MsgSlashParticipantTrustDeposit) { m.Amount = 0 }, "amount must be greater than 0"},
+		{"empty reason", func(m *types.MsgSlashParticipantTrustDeposit) { m.Reason = "" }, "reason is required"},
+		{"reason too long", func(m *types.MsgSlashParticipantTrustDeposit) {
+			m.Reason = strings.Repeat("a", types.MaxSlashReasonLength+1)
+		}, "reason must not exceed"},
+		{"empty evidence digest", func(m *types.MsgSlashParticipantTrustDeposit) { m.EvidenceDigest = "" }, "evidence_digest is required"},
+		{"invalid evidence digest", func(m *types.MsgSlashParticipantTrustDeposit) { m.EvidenceDigest = "not-a-digest" }, "invalid evidence_digest format"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid()
+			tc.mutate(m)
+			err := m.ValidateBasic()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgAppealSlash_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	valid := func() *types.MsgAppealSlash {
+		return &types.MsgAppealSlash{
+			Corporation:    validAddr,
+			Operator:       validAddr,
+			Id:             1,
+			SlashId:        1,
+			Reason:         "evidence is forged",
+			EvidenceDigest: "sha384-appealDigest",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(m *types.MsgAppealSlash)
+		wantErr string
+	}{
+		{"valid baseline", func(m *types.MsgAppealSlash) {}, ""},
+		{"no evidence digest", func(m *types.MsgAppealSlash) { m.EvidenceDigest = "" }, ""},
+		{"empty corporation", func(m *types.MsgAppealSlash) { m.Corporation = "" }, "invalid corporation address"},
+		{"empty operator", func(m *types.MsgAppealSlash) { m.Operator = "" }, "invalid operator address"},
+		{"id = 0", func(m *types.MsgAppealSlash) { m.Id = 0 }, "participant ID cannot be 0"},
+		{"slash id = 0", func(m *types.MsgAppealSlash) { m.SlashId = 0 }, "slash ID cannot be 0"},
+		{"empty reason", func(m *types.MsgAppealSlash) { m.Reason = "" }, "reason is required"},
+		{"invalid evidence digest", func(m *types.MsgAppealSlash) { m.EvidenceDigest = "not-a-digest" }, "invalid evidence_digest format"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid()
+			tc.mutate(m)
+			err := m.ValidateBasic()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgResolveSlashAppeal_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	require.NoError(t, (&types.MsgResolveSlashAppeal{Authority: validAddr, Id: 1, SlashId: 1, Reverse: true}).ValidateBasic())
+	require.ErrorContains(t, (&types.MsgResolveSlashAppeal{Id: 1, SlashId: 1}).ValidateBasic(), "invalid authority address")
+	require.ErrorContains(t, (&types.MsgResolveSlashAppeal{Authority: validAddr, SlashId: 1}).ValidateBasic(), "participant ID cannot be 0")
+	require.ErrorContains(t, (&types.MsgResolveSlashAppeal{Authority: validAddr, Id: 1}).ValidateBasic(), "slash ID cannot be 0")
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/pp/types"
+)
+
+func TestMsgSuspendParticipant_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	valid := func() *types.MsgSuspendParticipant {
+		return &types.MsgSuspendParticipant{
+			Corporation: validAddr,
+			Operator:    validAddr,
+			Id:          1,
+			Reason:      "under investigation",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(m *types.MsgSuspendParticipant)
+		wantErr string
+	}{
+		{"valid baseline", func(m *types.MsgSuspendParticipant) {}, ""},
+		{"empty reason", func(m *types.MsgSuspendParticipant) { m.Reason = "" }, ""},
+		{"empty corporation", func(m *types.MsgSuspendParticipant) { m.Corporation = "" }, "invalid corporation address"},
+		{"empty operator", func(m *types.MsgSuspendParticipant) { m.Operator = "" }, "invalid operator address"},
+		{"id = 0", func(m *types.MsgSuspendParticipant) { m.Id = 0 }, "participant ID cannot be 0"},
+		{"reason too long", func(m *types.MsgSuspendParticipant) {
+			m.Reason = strings.Repeat("a", types.MaxSuspensionReasonLength+1)
+		}, "reason must not exceed"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid()
+			tc.mutate(m)
+			err := m.ValidateBasic()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgUnsuspendParticipant_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("test_address________")).String()
+
+	require.NoError(t, (&types.MsgUnsuspendParticipant{Corporation: validAddr, Operator: validAddr, Id: 1}).ValidateBasic())
+	require.ErrorContains(t, (&types.MsgUnsuspendParticipant{Operator: validAddr, Id: 1}).ValidateBasic(), "invalid corporation address")
+	require.ErrorContains(t, (&types.MsgUnsuspendParticipant{Corporation: validAddr, Id: 1}).ValidateBasic(), "invalid operator address")
+	require.ErrorContains(t, (&types.MsgUnsuspendParticipant{Corporation: validAddr, Operator: validAddr}).ValidateBasic(), "participant ID cannot be 0")
+}
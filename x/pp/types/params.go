@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -9,9 +10,27 @@ import (
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 const (
-	DefaultValidationTermRequestedTimeoutDays = uint64(7) // 7 days
+	DefaultValidationTermRequestedTimeoutDays = uint64(7)  // 7 days
+	DefaultParticipantSessionRetentionDays    = uint64(0)  // keep sessions forever
+	DefaultFeeChangeNoticePeriodDays          = uint64(30) // 30 days
+	DefaultSlashDisputeWindowDays             = uint64(14) // 14 days
+
+	// MaxExpiryNoticeLeadTimes bounds the number of expiry_notice_lead_days,
+	// each of them adding an index entry per participant deadline.
+	MaxExpiryNoticeLeadTimes = 10
+
+	DefaultMaxBatchSize = uint64(100)
+	// MaxBatchSizeParam bounds max_batch_size, a batch runs in a single tx
+	MaxBatchSizeParam = uint64(1000)
+
+	// MaxDaysParam bounds the day-count params so that the derived times
+	// cannot overflow.
+	MaxDaysParam = uint64(36500)
 )
 
+// DefaultExpiryNoticeLeadDays notifies expirations 30, 7 and 1 days ahead
+var DefaultExpiryNoticeLeadDays = []uint64{30, 7, 1}
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -20,9 +39,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	validationTermRequestedTimeoutDays uint64,
+	participantSessionRetentionDays uint64,
+	feeChangeNoticePeriodDays uint64,
+	slashDisputeWindowDays uint64,
+	expiryNoticeLeadDays []uint64,
+	maxBatchSize uint64,
 ) Params {
 	return Params{
 		ValidationTermRequestedTimeoutDays: validationTermRequestedTimeoutDays,
+		ParticipantSessionRetentionDays:    participantSessionRetentionDays,
+		FeeChangeNoticePeriodDays:          feeChangeNoticePeriodDays,
+		SlashDisputeWindowDays:             slashDisputeWindowDays,
+		ExpiryNoticeLeadDays:               expiryNoticeLeadDays,
+		MaxBatchSize:                       maxBatchSize,
 	}
 }
 
@@ -30,6 +59,11 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		DefaultValidationTermRequestedTimeoutDays,
+		DefaultParticipantSessionRetentionDays,
+		DefaultFeeChangeNoticePeriodDays,
+		DefaultSlashDisputeWindowDays,
+		slices.Clone(DefaultExpiryNoticeLeadDays),
+		DefaultMaxBatchSize,
 	)
 }
 
@@ -41,6 +75,31 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.ValidationTermRequestedTimeoutDays,
 			validatePositiveUint64,
 		),
+		paramtypes.NewParamSetPair(
+			[]byte("ParticipantSessionRetentionDays"),
+			&p.ParticipantSessionRetentionDays,
+			validateMaxDays,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("FeeChangeNoticePeriodDays"),
+			&p.FeeChangeNoticePeriodDays,
+			validateMaxDays,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("SlashDisputeWindowDays"),
+			&p.SlashDisputeWindowDays,
+			validateMaxDays,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("ExpiryNoticeLeadDays"),
+			&p.ExpiryNoticeLeadDays,
+			validateExpiryNoticeLeadDays,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxBatchSize"),
+			&p.MaxBatchSize,
+			validateMaxBatchSize,
+		),
 	}
 }
 
@@ -49,6 +108,21 @@ func (p Params) Validate() error {
 	if p.ValidationTermRequestedTimeoutDays == 0 {
 		return fmt.Errorf("validation term requested timeout days must be positive")
 	}
+	if err := validateMaxDays(p.ParticipantSessionRetentionDays); err != nil {
+		return fmt.Errorf("participant session retention days: %w", err)
+	}
+	if err := validateMaxDays(p.FeeChangeNoticePeriodDays); err != nil {
+		return fmt.Errorf("fee change notice period days: %w", err)
+	}
+	if err := validateMaxDays(p.SlashDisputeWindowDays); err != nil {
+		return fmt.Errorf("slash dispute window days: %w", err)
+	}
+	if err := validateExpiryNoticeLeadDays(p.ExpiryNoticeLeadDays); err != nil {
+		return fmt.Errorf("expiry notice lead days: %w", err)
+	}
+	if err := validateMaxBatchSize(p.MaxBatchSize); err != nil {
+		return fmt.Errorf("max batch size: %w", err)
+	}
 	return nil
 }
 
@@ -65,3 +139,56 @@ func validatePositiveUint64(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxDays(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxDaysParam {
+		return fmt.Errorf("value must not exceed %d: %d", MaxDaysParam, v)
+	}
+
+	return nil
+}
+
+func validateExpiryNoticeLeadDays(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) > MaxExpiryNoticeLeadTimes {
+		return fmt.Errorf("must not have more than %d lead times: %d", MaxExpiryNoticeLeadTimes, len(v))
+	}
+
+	seen := make(map[uint64]bool, len(v))
+	for _, days := range v {
+		if days == 0 {
+			return fmt.Errorf("lead time must be positive")
+		}
+		if err := validateMaxDays(days); err != nil {
+			return err
+		}
+		if seen[days] {
+			return fmt.Errorf("duplicate lead time: %d", days)
+		}
+		seen[days] = true
+	}
+
+	return nil
+}
+
+func validateMaxBatchSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 || v > MaxBatchSizeParam {
+		return fmt.Errorf("value must be between 1 and %d: %d", MaxBatchSizeParam, v)
+	}
+
+	return nil
+}
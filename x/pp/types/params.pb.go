@@ -27,6 +27,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	ValidationTermRequestedTimeoutDays uint64 `protobuf:"varint,1,opt,name=validation_term_requested_timeout_days,json=validationTermRequestedTimeoutDays,proto3" json:"validation_term_requested_timeout_days,omitempty"`
+	// participant_session_retention_days is the number of days a participant
+	// session is kept after its last modification before it is pruned.
+	// 0 keeps sessions forever.
+	ParticipantSessionRetentionDays uint64 `protobuf:"varint,2,opt,name=participant_session_retention_days,json=participantSessionRetentionDays,proto3" json:"participant_session_retention_days,omitempty"`
+	// fee_change_notice_period_days is the number of days between the
+	// acceptance of a participant fee change and the time it takes effect.
+	FeeChangeNoticePeriodDays uint64 `protobuf:"varint,3,opt,name=fee_change_notice_period_days,json=feeChangeNoticePeriodDays,proto3" json:"fee_change_notice_period_days,omitempty"`
+	// slash_dispute_window_days is the number of days during which a slash can
+	// be appealed before it becomes final. 0 makes slashes final immediately.
+	SlashDisputeWindowDays uint64 `protobuf:"varint,4,opt,name=slash_dispute_window_days,json=slashDisputeWindowDays,proto3" json:"slash_dispute_window_days,omitempty"`
+	// expiry_notice_lead_days are the number of days before the
+	// effective_until or op_exp of a participant at which a
+	// participant_expiring event is emitted. participant_expired is always
+	// emitted at expiry.
+	ExpiryNoticeLeadDays []uint64 `protobuf:"varint,5,rep,packed,name=expiry_notice_lead_days,json=expiryNoticeLeadDays,proto3" json:"expiry_notice_lead_days,omitempty"`
+	// max_batch_size is the maximum number of items of a batch participant
+	// operation.
+	MaxBatchSize uint64 `protobuf:"varint,6,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +87,41 @@ func (m *Params) GetValidationTermRequestedTimeoutDays() uint64 {
 	return 0
 }
 
+func (m *Params) GetParticipantSessionRetentionDays() uint64 {
+	if m != nil {
+		return m.ParticipantSessionRetentionDays
+	}
+	return 0
+}
+
+func (m *Params) GetFeeChangeNoticePeriodDays() uint64 {
+	if m != nil {
+		return m.FeeChangeNoticePeriodDays
+	}
+	return 0
+}
+
+func (m *Params) GetSlashDisputeWindowDays() uint64 {
+	if m != nil {
+		return m.SlashDisputeWindowDays
+	}
+	return 0
+}
+
+func (m *Params) GetExpiryNoticeLeadDays() []uint64 {
+	if m != nil {
+		return m.ExpiryNoticeLeadDays
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "verana.pp.v1.Params")
 }
@@ -76,22 +129,33 @@ func init() {
 func init() { proto.RegisterFile("verana/pp/v1/params.proto", fileDescriptor_7fd4f13bf1c35b59) }
 
 var fileDescriptor_7fd4f13bf1c35b59 = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0xd4, 0x2f, 0x28, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x48, 0xe9, 0x15, 0x14, 0xe8, 0x95, 0x19, 0x4a,
-	0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x02, 0x29, 0x91, 0xf4, 0xfc, 0xf4,
-	0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0x55, 0x72, 0xb1, 0x05, 0x80, 0x8d, 0x11, 0x0a,
-	0xe2, 0x52, 0x2b, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x8b, 0x2f, 0x49, 0x2d,
-	0xca, 0x8d, 0x2f, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x49, 0x4d, 0x89, 0x2f, 0xc9, 0xcc, 0x4d,
-	0xcd, 0x2f, 0x2d, 0x89, 0x4f, 0x49, 0xac, 0x2c, 0x96, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x52,
-	0x42, 0xa8, 0x0e, 0x49, 0x2d, 0xca, 0x0d, 0x82, 0xa9, 0x0d, 0x81, 0x28, 0x75, 0x49, 0xac, 0x2c,
-	0xb6, 0x92, 0x7e, 0xb1, 0x40, 0x9e, 0xb1, 0xeb, 0xf9, 0x06, 0x2d, 0x21, 0xa8, 0xc3, 0x2b, 0x40,
-	0x4e, 0x87, 0x58, 0xe8, 0xe4, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0x1a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0x8d, 0xba, 0x39,
-	0x89, 0x49, 0xc5, 0xfa, 0xc8, 0x86, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x7d, 0x61,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x86, 0xde, 0x5e, 0x11, 0x19, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcf, 0x6a, 0x14, 0x41,
+	0x10, 0xc6, 0x77, 0xdc, 0x75, 0x0f, 0x4d, 0x10, 0x5c, 0x82, 0x66, 0x23, 0x4e, 0xc2, 0x22, 0xb2,
+	0x08, 0xee, 0x10, 0xc4, 0x83, 0x9e, 0x64, 0xcd, 0x4d, 0x91, 0x30, 0x09, 0x08, 0x5e, 0x9a, 0xda,
+	0x99, 0xca, 0x4c, 0xc3, 0xf4, 0x1f, 0xbb, 0x7b, 0x26, 0x33, 0x79, 0x04, 0x4f, 0x3e, 0x42, 0x1e,
+	0xc1, 0xc7, 0xf0, 0x98, 0xa3, 0x47, 0xd9, 0x3d, 0xe8, 0x63, 0xc8, 0x54, 0x67, 0x71, 0x2f, 0x4d,
+	0x53, 0xf5, 0xab, 0xdf, 0x77, 0xf8, 0xd8, 0xb4, 0x41, 0x0b, 0x0a, 0x12, 0x63, 0x92, 0xe6, 0x24,
+	0x31, 0x60, 0x41, 0xba, 0x85, 0xb1, 0xda, 0xeb, 0xc9, 0x5e, 0x58, 0x2d, 0x8c, 0x59, 0x34, 0x27,
+	0x87, 0x0f, 0x41, 0x0a, 0xa5, 0x13, 0x7a, 0x03, 0x70, 0xb8, 0x5f, 0xe8, 0x42, 0xd3, 0x37, 0xe9,
+	0x7f, 0x61, 0x3a, 0xbb, 0x19, 0xb2, 0xf1, 0x19, 0x79, 0x26, 0x29, 0x7b, 0xde, 0x40, 0x25, 0x72,
+	0xf0, 0x42, 0x2b, 0xee, 0xd1, 0x4a, 0x6e, 0xf1, 0x6b, 0x8d, 0xce, 0x63, 0xce, 0xbd, 0x90, 0xa8,
+	0x6b, 0xcf, 0x73, 0xe8, 0xdc, 0x41, 0x74, 0x1c, 0xcd, 0x47, 0xe9, 0xec, 0x3f, 0x7d, 0x81, 0x56,
+	0xa6, 0x5b, 0xf6, 0x22, 0xa0, 0xa7, 0xd0, 0xb9, 0xc9, 0x07, 0x36, 0x33, 0x60, 0xbd, 0xc8, 0x84,
+	0x01, 0xe5, 0xb9, 0x43, 0xe7, 0x7a, 0xb9, 0x45, 0x8f, 0x8a, 0x62, 0xc8, 0x77, 0x8f, 0x7c, 0x47,
+	0x3b, 0xe4, 0x79, 0x00, 0xd3, 0x2d, 0x47, 0xb2, 0x77, 0xec, 0xe9, 0x25, 0x22, 0xcf, 0x4a, 0x50,
+	0x05, 0x72, 0xa5, 0xbd, 0xc8, 0x90, 0x1b, 0xb4, 0x42, 0xe7, 0xc1, 0x33, 0x24, 0xcf, 0xf4, 0x12,
+	0xf1, 0x3d, 0x31, 0x9f, 0x08, 0x39, 0x23, 0x82, 0x0c, 0x6f, 0xd8, 0xd4, 0x55, 0xe0, 0x4a, 0x9e,
+	0x0b, 0x67, 0x6a, 0x8f, 0xfc, 0x4a, 0xa8, 0x5c, 0x5f, 0x85, 0xeb, 0x11, 0x5d, 0x3f, 0x22, 0xe0,
+	0x34, 0xec, 0x3f, 0xd3, 0x9a, 0x4e, 0x5f, 0xb3, 0xc7, 0xd8, 0x1a, 0x61, 0xbb, 0x6d, 0x70, 0x85,
+	0x70, 0x17, 0x7b, 0xff, 0x78, 0x38, 0x1f, 0xa5, 0xfb, 0x61, 0x1d, 0x32, 0x3f, 0x22, 0x84, 0xc4,
+	0x67, 0xec, 0x81, 0x84, 0x96, 0xaf, 0xc0, 0x67, 0x25, 0x77, 0xe2, 0x1a, 0x0f, 0xc6, 0x14, 0xb3,
+	0x27, 0xa1, 0x5d, 0xf6, 0xc3, 0x73, 0x71, 0x8d, 0x6f, 0x9f, 0xfc, 0xbd, 0x39, 0x8a, 0xbe, 0xfd,
+	0xf9, 0xf1, 0x62, 0x72, 0x57, 0x70, 0xdb, 0x57, 0x1c, 0x7a, 0x59, 0x2e, 0x7f, 0xae, 0xe3, 0xe8,
+	0x76, 0x1d, 0x47, 0xbf, 0xd7, 0x71, 0xf4, 0x7d, 0x13, 0x0f, 0x6e, 0x37, 0xf1, 0xe0, 0xd7, 0x26,
+	0x1e, 0x7c, 0x99, 0x17, 0xc2, 0x97, 0xf5, 0x6a, 0x91, 0x69, 0x99, 0x84, 0xc3, 0x97, 0x15, 0xac,
+	0x5c, 0xb2, 0x2b, 0xf1, 0x9d, 0x41, 0xb7, 0x1a, 0x53, 0xdb, 0xaf, 0xfe, 0x05, 0x00, 0x00, 0xff,
+	0xff, 0x64, 0xed, 0x44, 0x78, 0x41, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -116,6 +180,26 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ValidationTermRequestedTimeoutDays != that1.ValidationTermRequestedTimeoutDays {
 		return false
 	}
+	if this.ParticipantSessionRetentionDays != that1.ParticipantSessionRetentionDays {
+		return false
+	}
+	if this.FeeChangeNoticePeriodDays != that1.FeeChangeNoticePeriodDays {
+		return false
+	}
+	if this.SlashDisputeWindowDays != that1.SlashDisputeWindowDays {
+		return false
+	}
+	if len(this.ExpiryNoticeLeadDays) != len(that1.ExpiryNoticeLeadDays) {
+		return false
+	}
+	for i := range this.ExpiryNoticeLeadDays {
+		if this.ExpiryNoticeLeadDays[i] != that1.ExpiryNoticeLeadDays[i] {
+			return false
+		}
+	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +222,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExpiryNoticeLeadDays) > 0 {
+		dAtA2 := make([]byte, len(m.ExpiryNoticeLeadDays)*10)
+		var j1 int
+		for _, num := range m.ExpiryNoticeLeadDays {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SlashDisputeWindowDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashDisputeWindowDays))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FeeChangeNoticePeriodDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeChangeNoticePeriodDays))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ParticipantSessionRetentionDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParticipantSessionRetentionDays))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ValidationTermRequestedTimeoutDays != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ValidationTermRequestedTimeoutDays))
 		i--
@@ -166,6 +288,25 @@ func (m *Params) Size() (n int) {
 	if m.ValidationTermRequestedTimeoutDays != 0 {
 		n += 1 + sovParams(uint64(m.ValidationTermRequestedTimeoutDays))
 	}
+	if m.ParticipantSessionRetentionDays != 0 {
+		n += 1 + sovParams(uint64(m.ParticipantSessionRetentionDays))
+	}
+	if m.FeeChangeNoticePeriodDays != 0 {
+		n += 1 + sovParams(uint64(m.FeeChangeNoticePeriodDays))
+	}
+	if m.SlashDisputeWindowDays != 0 {
+		n += 1 + sovParams(uint64(m.SlashDisputeWindowDays))
+	}
+	if len(m.ExpiryNoticeLeadDays) > 0 {
+		l = 0
+		for _, e := range m.ExpiryNoticeLeadDays {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchSize))
+	}
 	return n
 }
 
@@ -223,6 +364,158 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantSessionRetentionDays", wireType)
+			}
+			m.ParticipantSessionRetentionDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantSessionRetentionDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeChangeNoticePeriodDays", wireType)
+			}
+			m.FeeChangeNoticePeriodDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeChangeNoticePeriodDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDisputeWindowDays", wireType)
+			}
+			m.SlashDisputeWindowDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashDisputeWindowDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExpiryNoticeLeadDays = append(m.ExpiryNoticeLeadDays, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExpiryNoticeLeadDays) == 0 {
+					m.ExpiryNoticeLeadDays = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExpiryNoticeLeadDays = append(m.ExpiryNoticeLeadDays, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryNoticeLeadDays", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -35,6 +36,88 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeItemKind identifies what a fee estimate item pays for.
+type FeeItemKind int32
+
+const (
+	FeeItemKind_FEE_ITEM_KIND_UNSPECIFIED FeeItemKind = 0
+	// Fee owed to a beneficiary participant of the session.
+	FeeItemKind_FEE_ITEM_KIND_BENEFICIARY_FEE FeeItemKind = 1
+	// Reward owed to the user agent participant.
+	FeeItemKind_FEE_ITEM_KIND_USER_AGENT_REWARD FeeItemKind = 2
+	// Reward owed to the wallet user agent participant.
+	FeeItemKind_FEE_ITEM_KIND_WALLET_USER_AGENT_REWARD FeeItemKind = 3
+	// Validation fees owed to the validator participant.
+	FeeItemKind_FEE_ITEM_KIND_VALIDATION_FEE FeeItemKind = 4
+	// Trust deposit required from the applicant when starting validation.
+	FeeItemKind_FEE_ITEM_KIND_VALIDATION_DEPOSIT FeeItemKind = 5
+)
+
+var FeeItemKind_name = map[int32]string{
+	0: "FEE_ITEM_KIND_UNSPECIFIED",
+	1: "FEE_ITEM_KIND_BENEFICIARY_FEE",
+	2: "FEE_ITEM_KIND_USER_AGENT_REWARD",
+	3: "FEE_ITEM_KIND_WALLET_USER_AGENT_REWARD",
+	4: "FEE_ITEM_KIND_VALIDATION_FEE",
+	5: "FEE_ITEM_KIND_VALIDATION_DEPOSIT",
+}
+
+var FeeItemKind_value = map[string]int32{
+	"FEE_ITEM_KIND_UNSPECIFIED":              0,
+	"FEE_ITEM_KIND_BENEFICIARY_FEE":          1,
+	"FEE_ITEM_KIND_USER_AGENT_REWARD":        2,
+	"FEE_ITEM_KIND_WALLET_USER_AGENT_REWARD": 3,
+	"FEE_ITEM_KIND_VALIDATION_FEE":           4,
+	"FEE_ITEM_KIND_VALIDATION_DEPOSIT":       5,
+}
+
+func (x FeeItemKind) String() string {
+	return proto.EnumName(FeeItemKind_name, int32(x))
+}
+
+func (FeeItemKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{0}
+}
+
+// FeeDestination identifies where the amount of a fee estimate item is sent.
+type FeeDestination int32
+
+const (
+	FeeDestination_FEE_DESTINATION_UNSPECIFIED FeeDestination = 0
+	// Transferred to the recipient corporation account.
+	FeeDestination_FEE_DESTINATION_ACCOUNT FeeDestination = 1
+	// Added to the recipient corporation trust deposit, funded by the payer.
+	FeeDestination_FEE_DESTINATION_TRUST_DEPOSIT FeeDestination = 2
+	// Added to the payer corporation own trust deposit.
+	FeeDestination_FEE_DESTINATION_PAYER_TRUST_DEPOSIT FeeDestination = 3
+	// Held in the module escrow account until the validation completes.
+	FeeDestination_FEE_DESTINATION_ESCROW FeeDestination = 4
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_UNSPECIFIED",
+	1: "FEE_DESTINATION_ACCOUNT",
+	2: "FEE_DESTINATION_TRUST_DEPOSIT",
+	3: "FEE_DESTINATION_PAYER_TRUST_DEPOSIT",
+	4: "FEE_DESTINATION_ESCROW",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_UNSPECIFIED":         0,
+	"FEE_DESTINATION_ACCOUNT":             1,
+	"FEE_DESTINATION_TRUST_DEPOSIT":       2,
+	"FEE_DESTINATION_PAYER_TRUST_DEPOSIT": 3,
+	"FEE_DESTINATION_ESCROW":              4,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{1}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -121,6 +204,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 type QueryListParticipantsRequest struct {
 	ModifiedAfter   *time.Time `protobuf:"bytes,1,opt,name=modified_after,json=modifiedAfter,proto3,stdtime" json:"modified_after,omitempty"`
 	ResponseMaxSize uint32     `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+	// suspended only returns the participants currently suspended
+	Suspended bool `protobuf:"varint,3,opt,name=suspended,proto3" json:"suspended,omitempty"`
 }
 
 func (m *QueryListParticipantsRequest) Reset()         { *m = QueryListParticipantsRequest{} }
@@ -170,6 +255,13 @@ func (m *QueryListParticipantsRequest) GetResponseMaxSize() uint32 {
 	return 0
 }
 
+func (m *QueryListParticipantsRequest) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
 type QueryListParticipantsResponse struct {
 	Participants []Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants"`
 }
@@ -393,6 +485,10 @@ func (m *QueryGetParticipantSessionResponse) GetSession() *ParticipantSession {
 type QueryListParticipantSessionsRequest struct {
 	ModifiedAfter   *time.Time `protobuf:"bytes,1,opt,name=modified_after,json=modifiedAfter,proto3,stdtime" json:"modified_after,omitempty"`
 	ResponseMaxSize uint32     `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+	// optional filters, served from the session indexes when set
+	CorporationId uint64 `protobuf:"varint,3,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	VsOperator    string `protobuf:"bytes,4,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
+	ParticipantId uint64 `protobuf:"varint,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
 }
 
 func (m *QueryListParticipantSessionsRequest) Reset()         { *m = QueryListParticipantSessionsRequest{} }
@@ -442,6 +538,27 @@ func (m *QueryListParticipantSessionsRequest) GetResponseMaxSize() uint32 {
 	return 0
 }
 
+func (m *QueryListParticipantSessionsRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
+}
+
+func (m *QueryListParticipantSessionsRequest) GetVsOperator() string {
+	if m != nil {
+		return m.VsOperator
+	}
+	return ""
+}
+
+func (m *QueryListParticipantSessionsRequest) GetParticipantId() uint64 {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return 0
+}
+
 type QueryListParticipantSessionsResponse struct {
 	Sessions []ParticipantSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
 }
@@ -486,18 +603,155 @@ func (m *QueryListParticipantSessionsResponse) GetSessions() []ParticipantSessio
 	return nil
 }
 
+type QueryListRevokedParticipantsRequest struct {
+	SchemaId    uint64 `protobuf:"varint,1,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	EcosystemId uint64 `protobuf:"varint,2,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+	// revoked_after is the exclusive lower bound of the revocation time.
+	// Together with after_id it forms the cursor of an incremental sync:
+	// participants revoked exactly at revoked_after are returned only if
+	// their id is greater than after_id. When after_id is 0 none of them are
+	// returned.
+	RevokedAfter *time.Time `protobuf:"bytes,3,opt,name=revoked_after,json=revokedAfter,proto3,stdtime" json:"revoked_after,omitempty"`
+	AfterId      uint64     `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// revoked_before is the exclusive upper bound of the revocation time.
+	RevokedBefore   *time.Time `protobuf:"bytes,5,opt,name=revoked_before,json=revokedBefore,proto3,stdtime" json:"revoked_before,omitempty"`
+	ResponseMaxSize uint32     `protobuf:"varint,6,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListRevokedParticipantsRequest) Reset()         { *m = QueryListRevokedParticipantsRequest{} }
+func (m *QueryListRevokedParticipantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListRevokedParticipantsRequest) ProtoMessage()    {}
+func (*QueryListRevokedParticipantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{10}
+}
+func (m *QueryListRevokedParticipantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRevokedParticipantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRevokedParticipantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListRevokedParticipantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRevokedParticipantsRequest.Merge(m, src)
+}
+func (m *QueryListRevokedParticipantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRevokedParticipantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRevokedParticipantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListRevokedParticipantsRequest proto.InternalMessageInfo
+
+func (m *QueryListRevokedParticipantsRequest) GetSchemaId() uint64 {
+	if m != nil {
+		return m.SchemaId
+	}
+	return 0
+}
+
+func (m *QueryListRevokedParticipantsRequest) GetEcosystemId() uint64 {
+	if m != nil {
+		return m.EcosystemId
+	}
+	return 0
+}
+
+func (m *QueryListRevokedParticipantsRequest) GetRevokedAfter() *time.Time {
+	if m != nil {
+		return m.RevokedAfter
+	}
+	return nil
+}
+
+func (m *QueryListRevokedParticipantsRequest) GetAfterId() uint64 {
+	if m != nil {
+		return m.AfterId
+	}
+	return 0
+}
+
+func (m *QueryListRevokedParticipantsRequest) GetRevokedBefore() *time.Time {
+	if m != nil {
+		return m.RevokedBefore
+	}
+	return nil
+}
+
+func (m *QueryListRevokedParticipantsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
+}
+
+type QueryListRevokedParticipantsResponse struct {
+	Participants []Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants"`
+}
+
+func (m *QueryListRevokedParticipantsResponse) Reset()         { *m = QueryListRevokedParticipantsResponse{} }
+func (m *QueryListRevokedParticipantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListRevokedParticipantsResponse) ProtoMessage()    {}
+func (*QueryListRevokedParticipantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{11}
+}
+func (m *QueryListRevokedParticipantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRevokedParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRevokedParticipantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListRevokedParticipantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRevokedParticipantsResponse.Merge(m, src)
+}
+func (m *QueryListRevokedParticipantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRevokedParticipantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRevokedParticipantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListRevokedParticipantsResponse proto.InternalMessageInfo
+
+func (m *QueryListRevokedParticipantsResponse) GetParticipants() []Participant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
 type QueryFindParticipantsWithDIDRequest struct {
 	Did      string     `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 	Role     uint32     `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
 	SchemaId uint64     `protobuf:"varint,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
 	When     *time.Time `protobuf:"bytes,5,opt,name=when,proto3,stdtime" json:"when,omitempty"`
+	// include_did_history also matches participants that had did before a
+	// rotation; with when, only if did was their did at that time.
+	IncludeDidHistory bool `protobuf:"varint,6,opt,name=include_did_history,json=includeDidHistory,proto3" json:"include_did_history,omitempty"`
 }
 
 func (m *QueryFindParticipantsWithDIDRequest) Reset()         { *m = QueryFindParticipantsWithDIDRequest{} }
 func (m *QueryFindParticipantsWithDIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFindParticipantsWithDIDRequest) ProtoMessage()    {}
 func (*QueryFindParticipantsWithDIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_438e2e8e140e775a, []int{10}
+	return fileDescriptor_438e2e8e140e775a, []int{12}
 }
 func (m *QueryFindParticipantsWithDIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,6 +808,13 @@ func (m *QueryFindParticipantsWithDIDRequest) GetWhen() *time.Time {
 	return nil
 }
 
+func (m *QueryFindParticipantsWithDIDRequest) GetIncludeDidHistory() bool {
+	if m != nil {
+		return m.IncludeDidHistory
+	}
+	return false
+}
+
 type QueryFindParticipantsWithDIDResponse struct {
 	Participants []Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants"`
 }
@@ -562,7 +823,7 @@ func (m *QueryFindParticipantsWithDIDResponse) Reset()         { *m = QueryFindP
 func (m *QueryFindParticipantsWithDIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFindParticipantsWithDIDResponse) ProtoMessage()    {}
 func (*QueryFindParticipantsWithDIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_438e2e8e140e775a, []int{11}
+	return fileDescriptor_438e2e8e140e775a, []int{13}
 }
 func (m *QueryFindParticipantsWithDIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +868,7 @@ func (m *QueryFindBeneficiariesRequest) Reset()         { *m = QueryFindBenefici
 func (m *QueryFindBeneficiariesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFindBeneficiariesRequest) ProtoMessage()    {}
 func (*QueryFindBeneficiariesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_438e2e8e140e775a, []int{12}
+	return fileDescriptor_438e2e8e140e775a, []int{14}
 }
 func (m *QueryFindBeneficiariesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +919,7 @@ func (m *QueryFindBeneficiariesResponse) Reset()         { *m = QueryFindBenefic
 func (m *QueryFindBeneficiariesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFindBeneficiariesResponse) ProtoMessage()    {}
 func (*QueryFindBeneficiariesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_438e2e8e140e775a, []int{13}
+	return fileDescriptor_438e2e8e140e775a, []int{15}
 }
 func (m *QueryFindBeneficiariesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,1093 +955,4229 @@ func (m *QueryFindBeneficiariesResponse) GetParticipants() []Participant {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "verana.pp.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "verana.pp.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryListParticipantsRequest)(nil), "verana.pp.v1.QueryListParticipantsRequest")
-	proto.RegisterType((*QueryListParticipantsResponse)(nil), "verana.pp.v1.QueryListParticipantsResponse")
-	proto.RegisterType((*QueryGetParticipantRequest)(nil), "verana.pp.v1.QueryGetParticipantRequest")
-	proto.RegisterType((*QueryGetParticipantResponse)(nil), "verana.pp.v1.QueryGetParticipantResponse")
-	proto.RegisterType((*QueryGetParticipantSessionRequest)(nil), "verana.pp.v1.QueryGetParticipantSessionRequest")
-	proto.RegisterType((*QueryGetParticipantSessionResponse)(nil), "verana.pp.v1.QueryGetParticipantSessionResponse")
-	proto.RegisterType((*QueryListParticipantSessionsRequest)(nil), "verana.pp.v1.QueryListParticipantSessionsRequest")
-	proto.RegisterType((*QueryListParticipantSessionsResponse)(nil), "verana.pp.v1.QueryListParticipantSessionsResponse")
-	proto.RegisterType((*QueryFindParticipantsWithDIDRequest)(nil), "verana.pp.v1.QueryFindParticipantsWithDIDRequest")
-	proto.RegisterType((*QueryFindParticipantsWithDIDResponse)(nil), "verana.pp.v1.QueryFindParticipantsWithDIDResponse")
-	proto.RegisterType((*QueryFindBeneficiariesRequest)(nil), "verana.pp.v1.QueryFindBeneficiariesRequest")
-	proto.RegisterType((*QueryFindBeneficiariesResponse)(nil), "verana.pp.v1.QueryFindBeneficiariesResponse")
+// FeeEstimateItem is a single amount the payer is charged, in the bond denom.
+type FeeEstimateItem struct {
+	Kind        FeeItemKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=verana.pp.v1.FeeItemKind" json:"kind,omitempty"`
+	Destination FeeDestination `protobuf:"varint,2,opt,name=destination,proto3,enum=verana.pp.v1.FeeDestination" json:"destination,omitempty"`
+	// participant credited by this item, 0 when unknown (agent not provided)
+	ParticipantId uint64 `protobuf:"varint,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	CorporationId uint64 `protobuf:"varint,4,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// corporation account credited by this item, empty for escrow or when unknown
+	Account string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Amount  uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/pp/v1/query.proto", fileDescriptor_438e2e8e140e775a) }
-
-var fileDescriptor_438e2e8e140e775a = []byte{
-	// 931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x8e, 0x93, 0x3a, 0x93, 0x36, 0x24, 0x53, 0xa7, 0x71, 0x37, 0xe9, 0xc6, 0xdd,
-	0x22, 0x64, 0xd2, 0xb2, 0x8b, 0x5d, 0x09, 0x10, 0xb7, 0x9a, 0x0a, 0x14, 0x7e, 0xa9, 0xb8, 0x48,
-	0x48, 0x5c, 0x96, 0xb1, 0x77, 0x6c, 0x0f, 0xcd, 0xee, 0x6c, 0x77, 0xc6, 0x6e, 0x52, 0xc4, 0x05,
-	0x09, 0x71, 0xe0, 0x12, 0xa9, 0x07, 0x6e, 0x1c, 0x10, 0x48, 0x1c, 0xe1, 0xbf, 0xc8, 0xb1, 0x12,
-	0x17, 0x4e, 0x05, 0x25, 0x48, 0xfc, 0x1b, 0x68, 0x67, 0x67, 0xa2, 0xdd, 0x78, 0xd7, 0x4e, 0xa5,
-	0x4a, 0x5c, 0xa2, 0xcd, 0xcc, 0xfb, 0xbe, 0xf7, 0x79, 0x6f, 0x66, 0xbe, 0x09, 0xac, 0x8d, 0x49,
-	0x84, 0x03, 0xec, 0x84, 0xa1, 0x33, 0x6e, 0x3a, 0x0f, 0x47, 0x24, 0x3a, 0xb0, 0xc3, 0x88, 0x09,
-	0x86, 0x2e, 0x26, 0x3b, 0x76, 0x18, 0xda, 0xe3, 0xa6, 0xb1, 0x86, 0x7d, 0x1a, 0x30, 0x47, 0xfe,
-	0x4c, 0x02, 0x8c, 0x9d, 0x1e, 0xe3, 0x3e, 0xe3, 0x4e, 0x17, 0x73, 0x92, 0x28, 0x9d, 0x71, 0xb3,
-	0x4b, 0x04, 0x6e, 0x3a, 0x21, 0x1e, 0xd0, 0x00, 0x0b, 0xca, 0x02, 0x15, 0x5b, 0x1d, 0xb0, 0x01,
-	0x93, 0x9f, 0x4e, 0xfc, 0xa5, 0x56, 0xb7, 0x06, 0x8c, 0x0d, 0xf6, 0x88, 0x83, 0x43, 0xea, 0xe0,
-	0x20, 0x60, 0x42, 0x4a, 0xb8, 0xda, 0xdd, 0x56, 0xbb, 0xf2, 0xb7, 0xee, 0xa8, 0xef, 0x08, 0xea,
-	0x13, 0x2e, 0xb0, 0x1f, 0xaa, 0x80, 0xab, 0x19, 0xf6, 0x10, 0x47, 0xd8, 0xd7, 0xda, 0x6c, 0x5b,
-	0xe2, 0x20, 0x24, 0x6a, 0xc7, 0xaa, 0x42, 0xf4, 0x49, 0xcc, 0x7a, 0x4f, 0x86, 0x77, 0xc8, 0xc3,
-	0x11, 0xe1, 0xc2, 0xfa, 0x18, 0x5e, 0xce, 0xac, 0xf2, 0x90, 0x05, 0x9c, 0xa0, 0x37, 0xe1, 0x62,
-	0x92, 0xb6, 0x06, 0xea, 0xa0, 0xb1, 0xdc, 0xaa, 0xda, 0xe9, 0xa1, 0xd8, 0x49, 0x74, 0x7b, 0xe9,
-	0xe8, 0xd9, 0xf6, 0xdc, 0xaf, 0xff, 0xfe, 0xb6, 0x03, 0x3a, 0x2a, 0xdc, 0xfa, 0x01, 0xc0, 0x2d,
-	0x99, 0xf0, 0x43, 0xca, 0xc5, 0x3d, 0x1c, 0x09, 0xda, 0xa3, 0x21, 0x0e, 0x84, 0x2e, 0x88, 0x3e,
-	0x80, 0x2b, 0x3e, 0xf3, 0x68, 0x9f, 0x12, 0xcf, 0xc5, 0x7d, 0x41, 0x22, 0x55, 0xc1, 0xb0, 0x93,
-	0xae, 0x6d, 0xdd, 0xb5, 0xfd, 0xa9, 0xee, 0xba, 0x5d, 0x39, 0x7a, 0xb6, 0x0d, 0x0e, 0xff, 0xda,
-	0x06, 0x9d, 0x4b, 0x5a, 0x7b, 0x27, 0x96, 0xa2, 0x1d, 0xb8, 0x16, 0x29, 0x64, 0xd7, 0xc7, 0xfb,
-	0x2e, 0xa7, 0x8f, 0x49, 0xad, 0x54, 0x07, 0x8d, 0x4b, 0x9d, 0x97, 0xf4, 0xc6, 0x47, 0x78, 0xff,
-	0x3e, 0x7d, 0x4c, 0x2c, 0x0f, 0x5e, 0x2b, 0x00, 0x53, 0x3d, 0xbf, 0x03, 0x2f, 0x86, 0xa9, 0xf5,
-	0x1a, 0xa8, 0xcf, 0x37, 0x96, 0x5b, 0x57, 0x27, 0x3a, 0xd7, 0x11, 0xed, 0x72, 0xdc, 0x7e, 0x27,
-	0x23, 0xb2, 0x6e, 0x41, 0x43, 0x56, 0x79, 0x8f, 0xa4, 0x8b, 0xe8, 0xe6, 0x57, 0x60, 0x89, 0x7a,
-	0xb2, 0xe1, 0x72, 0xa7, 0x44, 0x3d, 0xeb, 0x0b, 0xb8, 0x99, 0x1b, 0xad, 0x88, 0xee, 0xc0, 0xe5,
-	0x54, 0x72, 0x35, 0xa8, 0x99, 0x40, 0x69, 0x8d, 0x75, 0x1b, 0x5e, 0xcf, 0xa9, 0x70, 0x9f, 0x70,
-	0x4e, 0x59, 0x30, 0x89, 0xb5, 0xa4, 0xb0, 0xac, 0x69, 0x22, 0x45, 0xf7, 0x36, 0xbc, 0xc0, 0x93,
-	0x25, 0x45, 0x56, 0x2f, 0x24, 0xd3, 0x52, 0x2d, 0xb0, 0x7e, 0x04, 0xf0, 0x46, 0xde, 0x69, 0xa8,
-	0xc0, 0xff, 0xff, 0xb6, 0x7c, 0x09, 0x5f, 0x9e, 0xce, 0xa7, 0x86, 0xd0, 0x86, 0x15, 0xd5, 0x93,
-	0xbe, 0x30, 0x33, 0xa7, 0xa0, 0x8e, 0xe9, 0x54, 0x67, 0xfd, 0xae, 0x87, 0xf1, 0x2e, 0x0d, 0xbc,
-	0xf4, 0xd5, 0xfc, 0x8c, 0x8a, 0xe1, 0xdd, 0xdd, 0xbb, 0x7a, 0x18, 0xab, 0x70, 0xde, 0x3b, 0x3d,
-	0xa7, 0xf8, 0x13, 0x21, 0x58, 0x8e, 0xd8, 0x9e, 0x6e, 0x42, 0x7e, 0xa3, 0x4d, 0xb8, 0xc4, 0x7b,
-	0x43, 0xe2, 0x63, 0x97, 0x7a, 0xb5, 0x79, 0x79, 0xd5, 0x2a, 0xc9, 0xc2, 0xae, 0x87, 0xde, 0x82,
-	0xe5, 0x47, 0x43, 0x12, 0xd4, 0x16, 0x9e, 0x63, 0x8a, 0x52, 0xf1, 0x7e, 0xb9, 0x52, 0x5e, 0x5d,
-	0xe8, 0x5c, 0xe8, 0xb1, 0x51, 0x20, 0xa2, 0x03, 0xeb, 0x81, 0x9a, 0x4f, 0x21, 0xf2, 0x8b, 0x7c,
-	0x54, 0xdf, 0x03, 0xf5, 0x76, 0xe3, 0x6a, 0x6d, 0x12, 0x90, 0x3e, 0xed, 0x51, 0x1c, 0x51, 0x72,
-	0x7a, 0x4f, 0x5a, 0x70, 0x9d, 0x72, 0x3e, 0x22, 0x91, 0x9b, 0x12, 0xba, 0xa7, 0x6f, 0xed, 0x72,
-	0xb2, 0x99, 0xaa, 0xb3, 0xeb, 0xa1, 0x37, 0xe0, 0xc6, 0x98, 0x44, 0xf1, 0xfd, 0x98, 0x50, 0x95,
-	0xa4, 0x6a, 0x5d, 0x6f, 0x67, 0x74, 0x16, 0x81, 0x66, 0x11, 0xcc, 0x0b, 0x6c, 0xba, 0x75, 0x58,
-	0x81, 0x0b, 0xb2, 0x0e, 0x7a, 0x00, 0x17, 0x13, 0xc3, 0x45, 0x67, 0xee, 0xd6, 0xa4, 0x9f, 0x1b,
-	0xd7, 0xa7, 0x44, 0x24, 0x74, 0xd6, 0xd6, 0x37, 0x7f, 0xfc, 0xf3, 0xa4, 0x74, 0x05, 0x55, 0x9d,
-	0x9c, 0x3f, 0x23, 0xe8, 0x3b, 0x00, 0x57, 0xcf, 0x5a, 0x24, 0xda, 0xc9, 0xc9, 0x5a, 0x60, 0xf0,
-	0xc6, 0xcd, 0x73, 0xc5, 0x2a, 0x16, 0x43, 0xb2, 0x54, 0x11, 0xca, 0xb2, 0xec, 0x51, 0x2e, 0xd0,
-	0xb7, 0x00, 0xae, 0x64, 0x1d, 0x08, 0x35, 0x72, 0x72, 0xe7, 0x3a, 0xad, 0xf1, 0xea, 0x39, 0x22,
-	0x15, 0x83, 0x29, 0x19, 0x6a, 0xe8, 0x4a, 0x96, 0x61, 0x40, 0x84, 0xf3, 0x15, 0xf5, 0xbe, 0x46,
-	0x3f, 0x01, 0xb8, 0x9e, 0xeb, 0x84, 0xc8, 0x99, 0x59, 0x24, 0x6b, 0xb4, 0xc6, 0xeb, 0xe7, 0x17,
-	0x28, 0xb8, 0x57, 0x24, 0x5c, 0x1d, 0x99, 0x13, 0x70, 0xae, 0xf2, 0x8f, 0x04, 0xf2, 0x67, 0x00,
-	0x37, 0x0a, 0xbc, 0x0a, 0x35, 0x67, 0x9f, 0xc8, 0x19, 0xdf, 0x35, 0x5a, 0xcf, 0x23, 0x51, 0xa8,
-	0x96, 0x44, 0xdd, 0x42, 0xc6, 0xe4, 0x59, 0x6a, 0x56, 0xf4, 0x0b, 0x80, 0x1b, 0x05, 0x96, 0x91,
-	0x8b, 0x39, 0xdd, 0x11, 0x73, 0x31, 0x67, 0x38, 0x92, 0x75, 0x43, 0x62, 0x5e, 0x43, 0x9b, 0x59,
-	0xcc, 0x3e, 0x0d, 0x3c, 0xf7, 0x11, 0x15, 0x43, 0x37, 0x36, 0xd6, 0x27, 0x00, 0xae, 0x4d, 0xbc,
-	0x6f, 0x74, 0xb3, 0xa0, 0x5c, 0x9e, 0x25, 0x19, 0xb7, 0xce, 0x17, 0x3c, 0x9d, 0xaa, 0x9b, 0x0e,
-	0x6e, 0xb7, 0x8f, 0x8e, 0x4d, 0xf0, 0xf4, 0xd8, 0x04, 0x7f, 0x1f, 0x9b, 0xe0, 0xf0, 0xc4, 0x9c,
-	0x7b, 0x7a, 0x62, 0xce, 0xfd, 0x79, 0x62, 0xce, 0x7d, 0xde, 0x18, 0x50, 0x31, 0x1c, 0x75, 0xed,
-	0x1e, 0xf3, 0x55, 0x82, 0xd7, 0xf6, 0x70, 0x97, 0xeb, 0x64, 0xfb, 0x71, 0x3a, 0xf9, 0xcf, 0x60,
-	0x77, 0x51, 0x7a, 0xfd, 0xed, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xab, 0x53, 0xdb, 0x19, 0x00,
-	0x0b, 0x00, 0x00,
+func (m *FeeEstimateItem) Reset()         { *m = FeeEstimateItem{} }
+func (m *FeeEstimateItem) String() string { return proto.CompactTextString(m) }
+func (*FeeEstimateItem) ProtoMessage()    {}
+func (*FeeEstimateItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	ListParticipants(ctx context.Context, in *QueryListParticipantsRequest, opts ...grpc.CallOption) (*QueryListParticipantsResponse, error)
-	GetParticipant(ctx context.Context, in *QueryGetParticipantRequest, opts ...grpc.CallOption) (*QueryGetParticipantResponse, error)
-	GetParticipantSession(ctx context.Context, in *QueryGetParticipantSessionRequest, opts ...grpc.CallOption) (*QueryGetParticipantSessionResponse, error)
-	ListParticipantSessions(ctx context.Context, in *QueryListParticipantSessionsRequest, opts ...grpc.CallOption) (*QueryListParticipantSessionsResponse, error)
-	FindParticipantsWithDID(ctx context.Context, in *QueryFindParticipantsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindParticipantsWithDIDResponse, error)
-	FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error)
+func (m *FeeEstimateItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *FeeEstimateItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEstimateItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *FeeEstimateItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEstimateItem.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *FeeEstimateItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEstimateItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEstimateItem.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListParticipants(ctx context.Context, in *QueryListParticipantsRequest, opts ...grpc.CallOption) (*QueryListParticipantsResponse, error) {
-	out := new(QueryListParticipantsResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/ListParticipants", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FeeEstimateItem proto.InternalMessageInfo
+
+func (m *FeeEstimateItem) GetKind() FeeItemKind {
+	if m != nil {
+		return m.Kind
 	}
-	return out, nil
+	return FeeItemKind_FEE_ITEM_KIND_UNSPECIFIED
 }
 
-func (c *queryClient) GetParticipant(ctx context.Context, in *QueryGetParticipantRequest, opts ...grpc.CallOption) (*QueryGetParticipantResponse, error) {
-	out := new(QueryGetParticipantResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/GetParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeeEstimateItem) GetDestination() FeeDestination {
+	if m != nil {
+		return m.Destination
 	}
-	return out, nil
+	return FeeDestination_FEE_DESTINATION_UNSPECIFIED
 }
 
-func (c *queryClient) GetParticipantSession(ctx context.Context, in *QueryGetParticipantSessionRequest, opts ...grpc.CallOption) (*QueryGetParticipantSessionResponse, error) {
-	out := new(QueryGetParticipantSessionResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/GetParticipantSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeeEstimateItem) GetParticipantId() uint64 {
+	if m != nil {
+		return m.ParticipantId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ListParticipantSessions(ctx context.Context, in *QueryListParticipantSessionsRequest, opts ...grpc.CallOption) (*QueryListParticipantSessionsResponse, error) {
-	out := new(QueryListParticipantSessionsResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/ListParticipantSessions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeeEstimateItem) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FindParticipantsWithDID(ctx context.Context, in *QueryFindParticipantsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindParticipantsWithDIDResponse, error) {
-	out := new(QueryFindParticipantsWithDIDResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/FindParticipantsWithDID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeeEstimateItem) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error) {
-	out := new(QueryFindBeneficiariesResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/FindBeneficiaries", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeeEstimateItem) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	ListParticipants(context.Context, *QueryListParticipantsRequest) (*QueryListParticipantsResponse, error)
-	GetParticipant(context.Context, *QueryGetParticipantRequest) (*QueryGetParticipantResponse, error)
-	GetParticipantSession(context.Context, *QueryGetParticipantSessionRequest) (*QueryGetParticipantSessionResponse, error)
-	ListParticipantSessions(context.Context, *QueryListParticipantSessionsRequest) (*QueryListParticipantSessionsResponse, error)
-	FindParticipantsWithDID(context.Context, *QueryFindParticipantsWithDIDRequest) (*QueryFindParticipantsWithDIDResponse, error)
-	FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error)
+// BeneficiaryFeeEstimate is the fee owed to one beneficiary participant.
+type BeneficiaryFeeEstimate struct {
+	ParticipantId uint64          `protobuf:"varint,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Role          ParticipantRole `protobuf:"varint,2,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
+	CorporationId uint64          `protobuf:"varint,3,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// fees in trust units, after the executor discount
+	Fees uint64 `protobuf:"varint,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	// fees converted to the bond denom with the trust unit price
+	FeesInDenom uint64 `protobuf:"varint,5,opt,name=fees_in_denom,json=feesInDenom,proto3" json:"fees_in_denom,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ListParticipants(ctx context.Context, req *QueryListParticipantsRequest) (*QueryListParticipantsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListParticipants not implemented")
+func (m *BeneficiaryFeeEstimate) Reset()         { *m = BeneficiaryFeeEstimate{} }
+func (m *BeneficiaryFeeEstimate) String() string { return proto.CompactTextString(m) }
+func (*BeneficiaryFeeEstimate) ProtoMessage()    {}
+func (*BeneficiaryFeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{17}
 }
-func (*UnimplementedQueryServer) GetParticipant(ctx context.Context, req *QueryGetParticipantRequest) (*QueryGetParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetParticipant not implemented")
+func (m *BeneficiaryFeeEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetParticipantSession(ctx context.Context, req *QueryGetParticipantSessionRequest) (*QueryGetParticipantSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetParticipantSession not implemented")
+func (m *BeneficiaryFeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeneficiaryFeeEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListParticipantSessions(ctx context.Context, req *QueryListParticipantSessionsRequest) (*QueryListParticipantSessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListParticipantSessions not implemented")
+func (m *BeneficiaryFeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeneficiaryFeeEstimate.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FindParticipantsWithDID(ctx context.Context, req *QueryFindParticipantsWithDIDRequest) (*QueryFindParticipantsWithDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindParticipantsWithDID not implemented")
+func (m *BeneficiaryFeeEstimate) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) FindBeneficiaries(ctx context.Context, req *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindBeneficiaries not implemented")
+func (m *BeneficiaryFeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeneficiaryFeeEstimate.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_BeneficiaryFeeEstimate proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *BeneficiaryFeeEstimate) GetParticipantId() uint64 {
+	if m != nil {
+		return m.ParticipantId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ListParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListParticipantsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BeneficiaryFeeEstimate) GetRole() ParticipantRole {
+	if m != nil {
+		return m.Role
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListParticipants(ctx, in)
+	return ParticipantRole_UNSPECIFIED
+}
+
+func (m *BeneficiaryFeeEstimate) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Query/ListParticipants",
+	return 0
+}
+
+func (m *BeneficiaryFeeEstimate) GetFees() uint64 {
+	if m != nil {
+		return m.Fees
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListParticipants(ctx, req.(*QueryListParticipantsRequest))
+	return 0
+}
+
+func (m *BeneficiaryFeeEstimate) GetFeesInDenom() uint64 {
+	if m != nil {
+		return m.FeesInDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetParticipantRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryEstimateSessionFeesRequest struct {
+	IssuerParticipantId   uint64 `protobuf:"varint,1,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`
+	VerifierParticipantId uint64 `protobuf:"varint,2,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`
+	// optional, when set the agent is validated and credited in the items
+	AgentParticipantId uint64 `protobuf:"varint,3,opt,name=agent_participant_id,json=agentParticipantId,proto3" json:"agent_participant_id,omitempty"`
+	// optional, when set the wallet agent is validated and credited in the items
+	WalletAgentParticipantId uint64 `protobuf:"varint,4,opt,name=wallet_agent_participant_id,json=walletAgentParticipantId,proto3" json:"wallet_agent_participant_id,omitempty"`
+}
+
+func (m *QueryEstimateSessionFeesRequest) Reset()         { *m = QueryEstimateSessionFeesRequest{} }
+func (m *QueryEstimateSessionFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSessionFeesRequest) ProtoMessage()    {}
+func (*QueryEstimateSessionFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{18}
+}
+func (m *QueryEstimateSessionFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSessionFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSessionFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetParticipant(ctx, in)
+}
+func (m *QueryEstimateSessionFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSessionFeesRequest.Merge(m, src)
+}
+func (m *QueryEstimateSessionFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSessionFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSessionFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSessionFeesRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateSessionFeesRequest) GetIssuerParticipantId() uint64 {
+	if m != nil {
+		return m.IssuerParticipantId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Query/GetParticipant",
+	return 0
+}
+
+func (m *QueryEstimateSessionFeesRequest) GetVerifierParticipantId() uint64 {
+	if m != nil {
+		return m.VerifierParticipantId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetParticipant(ctx, req.(*QueryGetParticipantRequest))
+	return 0
+}
+
+func (m *QueryEstimateSessionFeesRequest) GetAgentParticipantId() uint64 {
+	if m != nil {
+		return m.AgentParticipantId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetParticipantSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetParticipantSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEstimateSessionFeesRequest) GetWalletAgentParticipantId() uint64 {
+	if m != nil {
+		return m.WalletAgentParticipantId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetParticipantSession(ctx, in)
+	return 0
+}
+
+type QueryEstimateSessionFeesResponse struct {
+	Beneficiaries []BeneficiaryFeeEstimate `protobuf:"bytes,1,rep,name=beneficiaries,proto3" json:"beneficiaries"`
+	Items         []FeeEstimateItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	// sum of the beneficiary fees in trust units, after the executor discount
+	BeneficiaryFees uint64 `protobuf:"varint,3,opt,name=beneficiary_fees,json=beneficiaryFees,proto3" json:"beneficiary_fees,omitempty"`
+	// executor discount applied, scaled 0..10000
+	Discount       uint64 `protobuf:"varint,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	TrustUnitPrice uint64 `protobuf:"varint,5,opt,name=trust_unit_price,json=trustUnitPrice,proto3" json:"trust_unit_price,omitempty"`
+	Denom          string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// payer corporation account (the issuer, or the verifier for verifications)
+	Payer string `protobuf:"bytes,7,opt,name=payer,proto3" json:"payer,omitempty"`
+	// balance the payer must hold for the session to be accepted
+	RequiredPayerBalance uint64 `protobuf:"varint,8,opt,name=required_payer_balance,json=requiredPayerBalance,proto3" json:"required_payer_balance,omitempty"`
+	PayerBalance         uint64 `protobuf:"varint,9,opt,name=payer_balance,json=payerBalance,proto3" json:"payer_balance,omitempty"`
+	SufficientFunds      bool   `protobuf:"varint,10,opt,name=sufficient_funds,json=sufficientFunds,proto3" json:"sufficient_funds,omitempty"`
+}
+
+func (m *QueryEstimateSessionFeesResponse) Reset()         { *m = QueryEstimateSessionFeesResponse{} }
+func (m *QueryEstimateSessionFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSessionFeesResponse) ProtoMessage()    {}
+func (*QueryEstimateSessionFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{19}
+}
+func (m *QueryEstimateSessionFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSessionFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSessionFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Query/GetParticipantSession",
+}
+func (m *QueryEstimateSessionFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSessionFeesResponse.Merge(m, src)
+}
+func (m *QueryEstimateSessionFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSessionFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSessionFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSessionFeesResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateSessionFeesResponse) GetBeneficiaries() []BeneficiaryFeeEstimate {
+	if m != nil {
+		return m.Beneficiaries
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetParticipantSession(ctx, req.(*QueryGetParticipantSessionRequest))
+	return nil
+}
+
+func (m *QueryEstimateSessionFeesResponse) GetItems() []FeeEstimateItem {
+	if m != nil {
+		return m.Items
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListParticipantSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListParticipantSessionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEstimateSessionFeesResponse) GetBeneficiaryFees() uint64 {
+	if m != nil {
+		return m.BeneficiaryFees
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListParticipantSessions(ctx, in)
+	return 0
+}
+
+func (m *QueryEstimateSessionFeesResponse) GetDiscount() uint64 {
+	if m != nil {
+		return m.Discount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Query/ListParticipantSessions",
+	return 0
+}
+
+func (m *QueryEstimateSessionFeesResponse) GetTrustUnitPrice() uint64 {
+	if m != nil {
+		return m.TrustUnitPrice
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListParticipantSessions(ctx, req.(*QueryListParticipantSessionsRequest))
+	return 0
+}
+
+func (m *QueryEstimateSessionFeesResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FindParticipantsWithDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFindParticipantsWithDIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEstimateSessionFeesResponse) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FindParticipantsWithDID(ctx, in)
+	return ""
+}
+
+func (m *QueryEstimateSessionFeesResponse) GetRequiredPayerBalance() uint64 {
+	if m != nil {
+		return m.RequiredPayerBalance
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Query/FindParticipantsWithDID",
+	return 0
+}
+
+func (m *QueryEstimateSessionFeesResponse) GetPayerBalance() uint64 {
+	if m != nil {
+		return m.PayerBalance
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FindParticipantsWithDID(ctx, req.(*QueryFindParticipantsWithDIDRequest))
+	return 0
+}
+
+func (m *QueryEstimateSessionFeesResponse) GetSufficientFunds() bool {
+	if m != nil {
+		return m.SufficientFunds
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_FindBeneficiaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFindBeneficiariesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryEstimateOnboardingFeesRequest struct {
+	ValidatorParticipantId uint64 `protobuf:"varint,1,opt,name=validator_participant_id,json=validatorParticipantId,proto3" json:"validator_participant_id,omitempty"`
+	// role requested by the applicant, checked against the validator role
+	Role ParticipantRole `protobuf:"varint,2,opt,name=role,proto3,enum=verana.pp.v1.ParticipantRole" json:"role,omitempty"`
+	// optional applicant corporation account, used to report its balance
+	Corporation string `protobuf:"bytes,3,opt,name=corporation,proto3" json:"corporation,omitempty"`
+}
+
+func (m *QueryEstimateOnboardingFeesRequest) Reset()         { *m = QueryEstimateOnboardingFeesRequest{} }
+func (m *QueryEstimateOnboardingFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateOnboardingFeesRequest) ProtoMessage()    {}
+func (*QueryEstimateOnboardingFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{20}
+}
+func (m *QueryEstimateOnboardingFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateOnboardingFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateOnboardingFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FindBeneficiaries(ctx, in)
+}
+func (m *QueryEstimateOnboardingFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateOnboardingFeesRequest.Merge(m, src)
+}
+func (m *QueryEstimateOnboardingFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateOnboardingFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateOnboardingFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateOnboardingFeesRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateOnboardingFeesRequest) GetValidatorParticipantId() uint64 {
+	if m != nil {
+		return m.ValidatorParticipantId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Query/FindBeneficiaries",
+	return 0
+}
+
+func (m *QueryEstimateOnboardingFeesRequest) GetRole() ParticipantRole {
+	if m != nil {
+		return m.Role
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FindBeneficiaries(ctx, req.(*QueryFindBeneficiariesRequest))
+	return ParticipantRole_UNSPECIFIED
+}
+
+func (m *QueryEstimateOnboardingFeesRequest) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.pp.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListParticipants",
-			Handler:    _Query_ListParticipants_Handler,
-		},
-		{
-			MethodName: "GetParticipant",
-			Handler:    _Query_GetParticipant_Handler,
-		},
-		{
-			MethodName: "GetParticipantSession",
-			Handler:    _Query_GetParticipantSession_Handler,
-		},
-		{
-			MethodName: "ListParticipantSessions",
-			Handler:    _Query_ListParticipantSessions_Handler,
-		},
-		{
-			MethodName: "FindParticipantsWithDID",
-			Handler:    _Query_FindParticipantsWithDID_Handler,
-		},
-		{
-			MethodName: "FindBeneficiaries",
-			Handler:    _Query_FindBeneficiaries_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/pp/v1/query.proto",
+type QueryEstimateOnboardingFeesResponse struct {
+	Items []FeeEstimateItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// validator participant validation fees in trust units
+	ValidationFees uint64 `protobuf:"varint,2,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	TrustUnitPrice uint64 `protobuf:"varint,3,opt,name=trust_unit_price,json=trustUnitPrice,proto3" json:"trust_unit_price,omitempty"`
+	Denom          string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// balance the applicant must hold for the request to be accepted
+	RequiredPayerBalance uint64 `protobuf:"varint,5,opt,name=required_payer_balance,json=requiredPayerBalance,proto3" json:"required_payer_balance,omitempty"`
+	PayerBalance         uint64 `protobuf:"varint,6,opt,name=payer_balance,json=payerBalance,proto3" json:"payer_balance,omitempty"`
+	SufficientFunds      bool   `protobuf:"varint,7,opt,name=sufficient_funds,json=sufficientFunds,proto3" json:"sufficient_funds,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateOnboardingFeesResponse) Reset()         { *m = QueryEstimateOnboardingFeesResponse{} }
+func (m *QueryEstimateOnboardingFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateOnboardingFeesResponse) ProtoMessage()    {}
+func (*QueryEstimateOnboardingFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{21}
+}
+func (m *QueryEstimateOnboardingFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateOnboardingFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateOnboardingFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEstimateOnboardingFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateOnboardingFeesResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEstimateOnboardingFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateOnboardingFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateOnboardingFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEstimateOnboardingFeesResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateOnboardingFeesResponse) GetItems() []FeeEstimateItem {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEstimateOnboardingFeesResponse) GetValidationFees() uint64 {
+	if m != nil {
+		return m.ValidationFees
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEstimateOnboardingFeesResponse) GetTrustUnitPrice() uint64 {
+	if m != nil {
+		return m.TrustUnitPrice
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListParticipantsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateOnboardingFeesResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListParticipantsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEstimateOnboardingFeesResponse) GetRequiredPayerBalance() uint64 {
+	if m != nil {
+		return m.RequiredPayerBalance
+	}
+	return 0
 }
 
-func (m *QueryListParticipantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ResponseMaxSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ModifiedAfter != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintQuery(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEstimateOnboardingFeesResponse) GetPayerBalance() uint64 {
+	if m != nil {
+		return m.PayerBalance
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListParticipantsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateOnboardingFeesResponse) GetSufficientFunds() bool {
+	if m != nil {
+		return m.SufficientFunds
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryListParticipantsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetParticipantCascadeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryListParticipantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetParticipantCascadeRequest) Reset()         { *m = QueryGetParticipantCascadeRequest{} }
+func (m *QueryGetParticipantCascadeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetParticipantCascadeRequest) ProtoMessage()    {}
+func (*QueryGetParticipantCascadeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{22}
+}
+func (m *QueryGetParticipantCascadeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetParticipantCascadeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetParticipantCascadeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetParticipantRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetParticipantCascadeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetParticipantCascadeRequest.Merge(m, src)
 }
-
-func (m *QueryGetParticipantRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetParticipantCascadeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetParticipantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetParticipantCascadeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetParticipantCascadeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetParticipantCascadeRequest proto.InternalMessageInfo
+
+func (m *QueryGetParticipantCascadeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetParticipantCascadeResponse struct {
+	Cascade ParticipantCascade `protobuf:"bytes,1,opt,name=cascade,proto3" json:"cascade"`
+	// pending_count is the number of descendants still to be visited
+	PendingCount uint64 `protobuf:"varint,2,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
 }
 
-func (m *QueryGetParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Participant.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetParticipantCascadeResponse) Reset()         { *m = QueryGetParticipantCascadeResponse{} }
+func (m *QueryGetParticipantCascadeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetParticipantCascadeResponse) ProtoMessage()    {}
+func (*QueryGetParticipantCascadeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438e2e8e140e775a, []int{23}
+}
+func (m *QueryGetParticipantCascadeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetParticipantCascadeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetParticipantCascadeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetParticipantCascadeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetParticipantCascadeResponse.Merge(m, src)
+}
+func (m *QueryGetParticipantCascadeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetParticipantCascadeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetParticipantCascadeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetParticipantSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetParticipantCascadeResponse proto.InternalMessageInfo
+
+func (m *QueryGetParticipantCascadeResponse) GetCascade() ParticipantCascade {
+	if m != nil {
+		return m.Cascade
 	}
-	return dAtA[:n], nil
+	return ParticipantCascade{}
 }
 
-func (m *QueryGetParticipantSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetParticipantCascadeResponse) GetPendingCount() uint64 {
+	if m != nil {
+		return m.PendingCount
+	}
+	return 0
 }
 
-func (m *QueryGetParticipantSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("verana.pp.v1.FeeItemKind", FeeItemKind_name, FeeItemKind_value)
+	proto.RegisterEnum("verana.pp.v1.FeeDestination", FeeDestination_name, FeeDestination_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "verana.pp.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "verana.pp.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryListParticipantsRequest)(nil), "verana.pp.v1.QueryListParticipantsRequest")
+	proto.RegisterType((*QueryListParticipantsResponse)(nil), "verana.pp.v1.QueryListParticipantsResponse")
+	proto.RegisterType((*QueryGetParticipantRequest)(nil), "verana.pp.v1.QueryGetParticipantRequest")
+	proto.RegisterType((*QueryGetParticipantResponse)(nil), "verana.pp.v1.QueryGetParticipantResponse")
+	proto.RegisterType((*QueryGetParticipantSessionRequest)(nil), "verana.pp.v1.QueryGetParticipantSessionRequest")
+	proto.RegisterType((*QueryGetParticipantSessionResponse)(nil), "verana.pp.v1.QueryGetParticipantSessionResponse")
+	proto.RegisterType((*QueryListParticipantSessionsRequest)(nil), "verana.pp.v1.QueryListParticipantSessionsRequest")
+	proto.RegisterType((*QueryListParticipantSessionsResponse)(nil), "verana.pp.v1.QueryListParticipantSessionsResponse")
+	proto.RegisterType((*QueryListRevokedParticipantsRequest)(nil), "verana.pp.v1.QueryListRevokedParticipantsRequest")
+	proto.RegisterType((*QueryListRevokedParticipantsResponse)(nil), "verana.pp.v1.QueryListRevokedParticipantsResponse")
+	proto.RegisterType((*QueryFindParticipantsWithDIDRequest)(nil), "verana.pp.v1.QueryFindParticipantsWithDIDRequest")
+	proto.RegisterType((*QueryFindParticipantsWithDIDResponse)(nil), "verana.pp.v1.QueryFindParticipantsWithDIDResponse")
+	proto.RegisterType((*QueryFindBeneficiariesRequest)(nil), "verana.pp.v1.QueryFindBeneficiariesRequest")
+	proto.RegisterType((*QueryFindBeneficiariesResponse)(nil), "verana.pp.v1.QueryFindBeneficiariesResponse")
+	proto.RegisterType((*FeeEstimateItem)(nil), "verana.pp.v1.FeeEstimateItem")
+	proto.RegisterType((*BeneficiaryFeeEstimate)(nil), "verana.pp.v1.BeneficiaryFeeEstimate")
+	proto.RegisterType((*QueryEstimateSessionFeesRequest)(nil), "verana.pp.v1.QueryEstimateSessionFeesRequest")
+	proto.RegisterType((*QueryEstimateSessionFeesResponse)(nil), "verana.pp.v1.QueryEstimateSessionFeesResponse")
+	proto.RegisterType((*QueryEstimateOnboardingFeesRequest)(nil), "verana.pp.v1.QueryEstimateOnboardingFeesRequest")
+	proto.RegisterType((*QueryEstimateOnboardingFeesResponse)(nil), "verana.pp.v1.QueryEstimateOnboardingFeesResponse")
+	proto.RegisterType((*QueryGetParticipantCascadeRequest)(nil), "verana.pp.v1.QueryGetParticipantCascadeRequest")
+	proto.RegisterType((*QueryGetParticipantCascadeResponse)(nil), "verana.pp.v1.QueryGetParticipantCascadeResponse")
 }
 
-func (m *QueryGetParticipantSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("verana/pp/v1/query.proto", fileDescriptor_438e2e8e140e775a) }
+
+var fileDescriptor_438e2e8e140e775a = []byte{
+	// 2030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0xf9, 0xeb, 0xd9, 0x96, 0x95, 0x89, 0x63, 0x2b, 0xf2, 0x97, 0x42, 0x67, 0x77,
+	0x1d, 0x67, 0x23, 0xc5, 0xde, 0xa2, 0xdd, 0x2d, 0xd0, 0xa2, 0x92, 0x45, 0x6f, 0xd9, 0x64, 0x6d,
+	0x95, 0x56, 0x1a, 0x6c, 0x2f, 0x2c, 0x25, 0x8e, 0xe5, 0x69, 0x24, 0x92, 0xe1, 0x50, 0x4e, 0xbc,
+	0x8b, 0x05, 0x8a, 0x02, 0x45, 0x0f, 0x7b, 0x59, 0x60, 0xef, 0x2d, 0x5a, 0xb4, 0x68, 0x8f, 0x05,
+	0xfa, 0xf1, 0x07, 0x14, 0x3d, 0xe4, 0xd6, 0xed, 0xf6, 0xd2, 0xd3, 0xb6, 0x4d, 0x0a, 0xb4, 0x7f,
+	0x45, 0x51, 0x70, 0x66, 0x28, 0x93, 0x12, 0x69, 0xcb, 0x68, 0x80, 0x5c, 0x12, 0xcd, 0xfb, 0x98,
+	0xf7, 0x9b, 0xf7, 0x7e, 0x6f, 0x38, 0x33, 0x86, 0xfc, 0x09, 0x76, 0x0d, 0xcb, 0x28, 0x3b, 0x4e,
+	0xf9, 0x64, 0xbb, 0xfc, 0xb8, 0x87, 0xdd, 0xd3, 0x92, 0xe3, 0xda, 0x9e, 0x8d, 0x66, 0xb9, 0xa6,
+	0xe4, 0x38, 0xa5, 0x93, 0xed, 0xc2, 0x15, 0xa3, 0x4b, 0x2c, 0xbb, 0xcc, 0xfe, 0xe5, 0x06, 0x85,
+	0xad, 0x96, 0x4d, 0xbb, 0x36, 0x2d, 0x37, 0x0d, 0x8a, 0xb9, 0x67, 0xf9, 0x64, 0xbb, 0x89, 0x3d,
+	0x63, 0xbb, 0xec, 0x18, 0x6d, 0x62, 0x19, 0x1e, 0xb1, 0x2d, 0x61, 0x7b, 0x9d, 0xdb, 0xea, 0x6c,
+	0x54, 0xe6, 0x03, 0xa1, 0x5a, 0x68, 0xdb, 0x6d, 0x9b, 0xcb, 0xfd, 0x5f, 0x42, 0xba, 0xd2, 0xb6,
+	0xed, 0x76, 0x07, 0x97, 0x0d, 0x87, 0x94, 0x0d, 0xcb, 0xb2, 0x3d, 0x36, 0x5b, 0xe0, 0xb3, 0x2e,
+	0xb4, 0x6c, 0xd4, 0xec, 0x1d, 0x95, 0x3d, 0xd2, 0xc5, 0xd4, 0x33, 0xba, 0x4e, 0x10, 0x2f, 0xb2,
+	0x2c, 0xc7, 0x70, 0x8d, 0x6e, 0xe0, 0x1b, 0x5d, 0xb1, 0x77, 0xea, 0x60, 0xa1, 0x91, 0x17, 0x00,
+	0x7d, 0xdb, 0x5f, 0x46, 0x9d, 0x99, 0x6b, 0xf8, 0x71, 0x0f, 0x53, 0x4f, 0xde, 0x87, 0xab, 0x11,
+	0x29, 0x75, 0x6c, 0x8b, 0x62, 0xf4, 0x15, 0x98, 0xe0, 0xd3, 0xe6, 0xa5, 0xa2, 0xb4, 0x39, 0xb3,
+	0xb3, 0x50, 0x0a, 0xe7, 0xab, 0xc4, 0xad, 0xab, 0xd3, 0xcf, 0xbe, 0x58, 0x1f, 0xfb, 0xf5, 0xbf,
+	0x7f, 0xb3, 0x25, 0x69, 0xc2, 0x5c, 0xfe, 0xbd, 0x04, 0x2b, 0x6c, 0xc2, 0xfb, 0x84, 0x7a, 0x75,
+	0xc3, 0xf5, 0x48, 0x8b, 0x38, 0x86, 0xe5, 0x05, 0x01, 0xd1, 0x3d, 0xc8, 0x76, 0x6d, 0x93, 0x1c,
+	0x11, 0x6c, 0xea, 0xc6, 0x91, 0x87, 0x5d, 0x11, 0xa1, 0x50, 0xe2, 0xab, 0x2e, 0x05, 0xab, 0x2e,
+	0x35, 0x82, 0x55, 0x57, 0xa7, 0x9e, 0x7d, 0xb1, 0x2e, 0x7d, 0xf2, 0xf7, 0x75, 0x49, 0x9b, 0x0b,
+	0x7c, 0x2b, 0xbe, 0x2b, 0xda, 0x82, 0x2b, 0xae, 0x80, 0xac, 0x77, 0x8d, 0xa7, 0x3a, 0x25, 0x1f,
+	0xe0, 0x7c, 0xaa, 0x28, 0x6d, 0xce, 0x69, 0xf3, 0x81, 0xe2, 0x3d, 0xe3, 0xe9, 0x21, 0xf9, 0x00,
+	0xa3, 0x15, 0x98, 0xa6, 0x3d, 0xea, 0x60, 0xcb, 0xc4, 0x66, 0x3e, 0x5d, 0x94, 0x36, 0xa7, 0xb4,
+	0x33, 0x81, 0x6c, 0xc2, 0x6a, 0x02, 0x6c, 0x91, 0x91, 0x5d, 0x98, 0x75, 0x42, 0xf2, 0xbc, 0x54,
+	0x4c, 0x6f, 0xce, 0xec, 0x5c, 0x1f, 0xca, 0x4b, 0x60, 0x51, 0xcd, 0xf8, 0xc9, 0xd1, 0x22, 0x4e,
+	0xf2, 0x9b, 0x50, 0x60, 0x51, 0xde, 0xc5, 0xe1, 0x20, 0x41, 0x6a, 0xb2, 0x90, 0x22, 0x26, 0x4b,
+	0x47, 0x46, 0x4b, 0x11, 0x53, 0xfe, 0x1e, 0x2c, 0xc7, 0x5a, 0x0b, 0x44, 0x15, 0x98, 0x09, 0x4d,
+	0x2e, 0xd2, 0x78, 0x21, 0xa0, 0xb0, 0x8f, 0xfc, 0x16, 0xdc, 0x88, 0x89, 0x70, 0x88, 0x29, 0x25,
+	0xb6, 0x35, 0x0c, 0x6b, 0x5a, 0xc0, 0x92, 0xcf, 0x73, 0x12, 0xe8, 0xbe, 0x0a, 0x93, 0x94, 0x8b,
+	0x04, 0xb2, 0x62, 0x22, 0xb2, 0xc0, 0x35, 0x70, 0x90, 0x7f, 0x95, 0x82, 0x8d, 0xb8, 0x6a, 0x08,
+	0xc3, 0x57, 0xcf, 0xa5, 0xd7, 0x20, 0xdb, 0xb2, 0x5d, 0xc7, 0x76, 0x59, 0xdf, 0xea, 0x84, 0x13,
+	0x2a, 0xa3, 0xcd, 0x85, 0xa4, 0xaa, 0x89, 0xde, 0x81, 0x99, 0x13, 0xaa, 0xdb, 0x0e, 0x76, 0x0d,
+	0xcf, 0x76, 0xf3, 0x19, 0x3f, 0x85, 0xd5, 0xfc, 0xe7, 0xbf, 0xbb, 0xb3, 0x20, 0xf6, 0x88, 0x8a,
+	0x69, 0xba, 0x98, 0xd2, 0x43, 0xcf, 0x25, 0x56, 0x5b, 0x83, 0x13, 0x7a, 0x20, 0x6c, 0xfd, 0x08,
+	0xa1, 0x42, 0xf9, 0x11, 0xc6, 0x79, 0x84, 0x90, 0x54, 0x35, 0xe5, 0xef, 0xc3, 0xcd, 0xf3, 0x13,
+	0x25, 0xaa, 0x51, 0x85, 0x29, 0x91, 0xdc, 0x80, 0xb9, 0x17, 0x96, 0x43, 0xf0, 0xa5, 0xef, 0x27,
+	0xff, 0x29, 0x5c, 0x15, 0x0d, 0x9f, 0xd8, 0x8f, 0xb0, 0x19, 0xd7, 0xe1, 0xcb, 0x30, 0x4d, 0x5b,
+	0xc7, 0xb8, 0x6b, 0xe8, 0x7d, 0x36, 0x4f, 0x71, 0x81, 0x6a, 0xa2, 0x1b, 0x30, 0x8b, 0x5b, 0x36,
+	0x3d, 0xa5, 0x1e, 0xee, 0xfa, 0xfa, 0x14, 0xd3, 0xcf, 0xf4, 0x65, 0xaa, 0x89, 0x54, 0x98, 0x73,
+	0xf9, 0xec, 0xa2, 0xa8, 0xe9, 0x4b, 0x14, 0x75, 0x56, 0xb8, 0xf2, 0x9a, 0x5e, 0x87, 0x29, 0x36,
+	0x85, 0x1f, 0x29, 0xc3, 0x22, 0x4d, 0xb2, 0xb1, 0x6a, 0xfa, 0xdc, 0x09, 0xa2, 0x34, 0xf1, 0x91,
+	0xed, 0x62, 0x96, 0xe0, 0x91, 0xb9, 0x23, 0x7c, 0xab, 0xcc, 0x35, 0x9e, 0x3b, 0x13, 0xb1, 0xdc,
+	0x91, 0x1f, 0x85, 0x4a, 0x16, 0x9b, 0xc5, 0x97, 0xb9, 0xe1, 0xfc, 0x53, 0x12, 0x35, 0xdb, 0x23,
+	0x56, 0x24, 0xcc, 0x43, 0xe2, 0x1d, 0xd7, 0xd4, 0x5a, 0x50, 0xb3, 0x1c, 0xa4, 0xcd, 0x7e, 0x93,
+	0xfb, 0x3f, 0x11, 0x82, 0x8c, 0x6b, 0x77, 0x82, 0x0e, 0x60, 0xbf, 0xa3, 0x95, 0x4d, 0x0f, 0x54,
+	0xf6, 0x6d, 0xc8, 0x3c, 0x39, 0xc6, 0xd6, 0xa5, 0xd2, 0xc8, 0x3c, 0x50, 0x09, 0xae, 0x12, 0xab,
+	0xd5, 0xe9, 0x99, 0x58, 0x37, 0x89, 0xa9, 0x1f, 0x13, 0xea, 0xd9, 0xee, 0x29, 0xcb, 0xdf, 0x94,
+	0x76, 0x45, 0xa8, 0x6a, 0xc4, 0xfc, 0x26, 0x57, 0x7c, 0x2b, 0x33, 0x95, 0xc9, 0x8d, 0x6b, 0x93,
+	0x2d, 0xbb, 0x67, 0x79, 0xee, 0x69, 0x3f, 0xa1, 0x89, 0x4b, 0x7c, 0x99, 0x09, 0xfd, 0x58, 0x12,
+	0x1f, 0x0a, 0x3f, 0x5a, 0x15, 0x5b, 0xf8, 0x88, 0xb4, 0x88, 0xe1, 0x12, 0xdc, 0xa7, 0xff, 0x0e,
+	0x5c, 0x23, 0x94, 0xf6, 0xb0, 0xab, 0x0f, 0x34, 0x30, 0x6f, 0x85, 0xab, 0x5c, 0x59, 0x0f, 0xb7,
+	0x31, 0xfa, 0x32, 0x2c, 0x9d, 0x60, 0xd7, 0xdf, 0x8c, 0x86, 0xbc, 0x78, 0x83, 0x5c, 0x0b, 0xd4,
+	0x11, 0x3f, 0x19, 0xc3, 0x5a, 0x12, 0x98, 0x97, 0xb9, 0xe8, 0x1f, 0xa4, 0x60, 0x7e, 0x0f, 0x63,
+	0x85, 0x7a, 0xa4, 0x6b, 0x78, 0x58, 0xf5, 0x70, 0x17, 0xdd, 0x81, 0xcc, 0x23, 0x62, 0xf1, 0x55,
+	0x65, 0x07, 0x27, 0xdc, 0xc3, 0xcc, 0xe8, 0x1e, 0xb1, 0x4c, 0x8d, 0x99, 0xa1, 0xaf, 0xc3, 0x8c,
+	0x89, 0xa9, 0x27, 0xce, 0x4d, 0x6c, 0x55, 0xd9, 0x9d, 0x95, 0x21, 0xaf, 0xda, 0x99, 0x8d, 0x16,
+	0x76, 0x88, 0xd9, 0x0f, 0xd3, 0x31, 0xfb, 0x61, 0xcc, 0xc6, 0x9c, 0x89, 0xdb, 0x98, 0xf3, 0x30,
+	0x69, 0xb4, 0x18, 0x7f, 0x18, 0x5d, 0xa7, 0xb5, 0x60, 0x88, 0x16, 0x61, 0xc2, 0xe8, 0x32, 0xc5,
+	0x04, 0x73, 0x14, 0x23, 0xf9, 0x2f, 0x12, 0x2c, 0x9e, 0x65, 0xf8, 0x34, 0x94, 0x8d, 0x18, 0x68,
+	0x52, 0x1c, 0xb4, 0xed, 0x50, 0x43, 0x65, 0x77, 0x56, 0x13, 0x2b, 0xa0, 0xd9, 0x1d, 0x2c, 0xfa,
+	0x6d, 0xc4, 0xcf, 0x0c, 0x82, 0xcc, 0x11, 0xc6, 0x54, 0x2c, 0x95, 0xfd, 0x46, 0x32, 0xcc, 0xf9,
+	0xff, 0xeb, 0xc4, 0xd2, 0x4d, 0x6c, 0xd9, 0x5d, 0xf1, 0xf9, 0x98, 0xf1, 0x85, 0xaa, 0x55, 0xf3,
+	0x45, 0xf2, 0x7f, 0x25, 0x58, 0x67, 0xf4, 0x09, 0x96, 0x22, 0x76, 0xfe, 0x3d, 0xfc, 0x4a, 0xd8,
+	0x8c, 0xee, 0xc2, 0x82, 0xd1, 0xc6, 0x96, 0xa7, 0xc7, 0x56, 0x1a, 0x31, 0x5d, 0xd4, 0xe3, 0x6b,
+	0xb0, 0xfc, 0xc4, 0xe8, 0x74, 0xb0, 0xa7, 0xc7, 0x3a, 0xf2, 0x84, 0xe4, 0xb9, 0x49, 0x65, 0xc8,
+	0x5d, 0xfe, 0x3c, 0x0d, 0xc5, 0xe4, 0x04, 0x88, 0x0e, 0xaa, 0xc3, 0x5c, 0x33, 0xdc, 0x5a, 0xa2,
+	0x85, 0x6e, 0x46, 0x0b, 0x18, 0xcf, 0x0d, 0xd1, 0x4d, 0xd1, 0x09, 0xd0, 0x3b, 0x30, 0x4e, 0x3c,
+	0xdc, 0xa5, 0xf9, 0x14, 0x9b, 0x69, 0x75, 0xa8, 0x0b, 0xc2, 0x8d, 0x26, 0xa6, 0xe0, 0x1e, 0xe8,
+	0x16, 0xe4, 0xce, 0xe6, 0x3a, 0xd5, 0x59, 0xd9, 0x79, 0x7a, 0xe6, 0x9b, 0x11, 0x04, 0x14, 0x15,
+	0x60, 0xca, 0x24, 0x94, 0x93, 0x9c, 0x27, 0xa2, 0x3f, 0x46, 0x9b, 0x90, 0xf3, 0xdc, 0x1e, 0xf5,
+	0xf4, 0x9e, 0x45, 0x3c, 0xdd, 0x71, 0x49, 0x0b, 0x0b, 0x82, 0x64, 0x99, 0xfc, 0x81, 0x45, 0xbc,
+	0xba, 0x2f, 0x45, 0x0b, 0x30, 0xce, 0xf9, 0x33, 0xc1, 0xfa, 0x84, 0x0f, 0x7c, 0xa9, 0x63, 0x9c,
+	0x62, 0x37, 0x3f, 0xc9, 0xa5, 0x6c, 0x80, 0xbe, 0x04, 0x8b, 0x2e, 0x7e, 0xdc, 0x23, 0x2e, 0x36,
+	0x75, 0x26, 0xd1, 0x9b, 0x46, 0xc7, 0xb0, 0x5a, 0x38, 0x3f, 0xc5, 0xe6, 0x5e, 0x08, 0xb4, 0x75,
+	0x5f, 0x59, 0xe5, 0x3a, 0xb4, 0x01, 0x73, 0x51, 0xe3, 0x69, 0x66, 0x3c, 0xeb, 0x84, 0x8d, 0x6e,
+	0x41, 0x8e, 0xf6, 0x8e, 0xfc, 0xe5, 0xf9, 0x65, 0x3e, 0xea, 0x59, 0x26, 0xcd, 0x03, 0xfb, 0x3e,
+	0xcc, 0x9f, 0xc9, 0xf7, 0x7c, 0xb1, 0xfc, 0x5b, 0x49, 0x9c, 0x4f, 0x83, 0x2c, 0x1e, 0x58, 0x4d,
+	0xdb, 0x70, 0x4d, 0x62, 0xb5, 0xc3, 0xc4, 0x7e, 0x1b, 0xf2, 0x27, 0x46, 0x87, 0x98, 0xfe, 0x69,
+	0x2b, 0x9e, 0xdb, 0x8b, 0x7d, 0x7d, 0xfd, 0xff, 0x6d, 0xe4, 0x22, 0xcc, 0x84, 0x5a, 0x96, 0x55,
+	0x6c, 0x5a, 0x0b, 0x8b, 0xe4, 0x3f, 0x07, 0x87, 0xab, 0x24, 0xd4, 0x82, 0x8d, 0x7d, 0xee, 0x48,
+	0x97, 0xe6, 0xce, 0x1b, 0x30, 0x2f, 0x56, 0xe4, 0x6f, 0x26, 0x8c, 0x3a, 0xbc, 0x1d, 0xb3, 0x67,
+	0x62, 0xc6, 0x9c, 0x38, 0x76, 0xa4, 0xcf, 0x67, 0x47, 0x26, 0xcc, 0x8e, 0x64, 0x1e, 0x8c, 0x5f,
+	0x86, 0x07, 0x13, 0x23, 0xf2, 0x60, 0x32, 0x9e, 0x07, 0xf1, 0x77, 0x9b, 0x5d, 0x83, 0xb6, 0x0c,
+	0x13, 0x27, 0x5d, 0xb9, 0x3e, 0x96, 0x62, 0x2f, 0x37, 0x7d, 0x2f, 0x51, 0x85, 0x6f, 0xc0, 0x64,
+	0x8b, 0x8b, 0x2e, 0xbc, 0xdc, 0x08, 0x57, 0x51, 0x8a, 0xc0, 0x8d, 0xad, 0x16, 0x5b, 0x7e, 0x79,
+	0x75, 0xde, 0xa2, 0x29, 0xb1, 0x5a, 0x2e, 0xdc, 0xf5, 0x65, 0x5b, 0xff, 0x91, 0x60, 0x26, 0xf4,
+	0x29, 0x45, 0xab, 0x70, 0x7d, 0x4f, 0x51, 0x74, 0xb5, 0xa1, 0xbc, 0xa7, 0xdf, 0x53, 0xf7, 0x6b,
+	0xfa, 0x83, 0xfd, 0xc3, 0xba, 0xb2, 0xab, 0xee, 0xa9, 0x4a, 0x2d, 0x37, 0x86, 0x6e, 0xc0, 0x6a,
+	0x54, 0x5d, 0x55, 0xf6, 0x95, 0x3d, 0x75, 0x57, 0xad, 0x68, 0xef, 0xeb, 0x7b, 0x8a, 0x92, 0x93,
+	0xd0, 0x06, 0xac, 0x0f, 0xcc, 0x70, 0xa8, 0x68, 0x7a, 0xe5, 0x5d, 0x65, 0xbf, 0xa1, 0x6b, 0xca,
+	0xc3, 0x8a, 0x56, 0xcb, 0xa5, 0xd0, 0x16, 0xbc, 0x1e, 0x35, 0x7a, 0x58, 0xb9, 0x7f, 0x5f, 0x69,
+	0xc4, 0xd8, 0xa6, 0x51, 0x11, 0x56, 0xa2, 0xb6, 0xdf, 0xa9, 0xdc, 0x57, 0x6b, 0x95, 0x86, 0x7a,
+	0xb0, 0xcf, 0x42, 0x66, 0xd0, 0x4d, 0x28, 0x26, 0x5a, 0xd4, 0x94, 0xfa, 0xc1, 0xa1, 0xda, 0xc8,
+	0x8d, 0x6f, 0xfd, 0x41, 0x82, 0x6c, 0xf4, 0xfb, 0x8f, 0xd6, 0x61, 0xd9, 0x77, 0xac, 0x29, 0x87,
+	0x0d, 0x75, 0x9f, 0xdb, 0x47, 0xd7, 0xbb, 0x0c, 0x4b, 0x83, 0x06, 0x95, 0xdd, 0xdd, 0x83, 0x07,
+	0xfb, 0x8d, 0x9c, 0x14, 0x24, 0x23, 0xac, 0x6c, 0x68, 0x0f, 0x0e, 0x1b, 0xfd, 0x98, 0x29, 0xf4,
+	0x06, 0x6c, 0x0c, 0x9a, 0xd4, 0x2b, 0xef, 0x2b, 0xda, 0x80, 0x61, 0x1a, 0x15, 0x60, 0x71, 0xd0,
+	0x50, 0x39, 0xdc, 0xd5, 0x0e, 0x1e, 0xe6, 0x32, 0x3b, 0x7f, 0x9c, 0x83, 0x71, 0xc6, 0x18, 0xf4,
+	0x08, 0x26, 0xf8, 0xbb, 0x08, 0x1a, 0x60, 0xc3, 0xf0, 0xb3, 0x4b, 0xe1, 0xc6, 0x39, 0x16, 0x9c,
+	0x63, 0xf2, 0xca, 0x0f, 0xff, 0xfa, 0xaf, 0x4f, 0x53, 0x8b, 0x68, 0xa1, 0x1c, 0xf3, 0xda, 0x83,
+	0x7e, 0x2c, 0x41, 0x6e, 0xf0, 0xad, 0x02, 0x6d, 0xc5, 0xcc, 0x9a, 0xf0, 0x0e, 0x53, 0xb8, 0x3d,
+	0x92, 0xad, 0xc0, 0x52, 0x60, 0x58, 0x16, 0x10, 0x8a, 0x62, 0xe9, 0x10, 0xea, 0xa1, 0x1f, 0x49,
+	0x90, 0x8d, 0x76, 0x0b, 0xda, 0x8c, 0x99, 0x3b, 0xf6, 0xc9, 0xa3, 0x70, 0x6b, 0x04, 0x4b, 0x81,
+	0x61, 0x8d, 0x61, 0xc8, 0xa3, 0xc5, 0x28, 0x86, 0x36, 0xf6, 0xca, 0x1f, 0x12, 0xf3, 0x23, 0xf4,
+	0x73, 0x09, 0xae, 0xc5, 0x3e, 0x49, 0xa0, 0xf2, 0x85, 0x41, 0xa2, 0x2f, 0x1e, 0x85, 0xbb, 0xa3,
+	0x3b, 0x08, 0x70, 0xaf, 0x33, 0x70, 0x45, 0xb4, 0x36, 0x04, 0x4e, 0x17, 0xf7, 0x67, 0x0e, 0xf2,
+	0x17, 0x12, 0x2c, 0x25, 0xdc, 0xd5, 0xd1, 0xf6, 0xc5, 0x15, 0x19, 0x78, 0x00, 0x29, 0xec, 0x5c,
+	0xc6, 0x45, 0x40, 0x95, 0x19, 0xd4, 0x15, 0x54, 0x18, 0xae, 0x65, 0x80, 0x15, 0xfd, 0x52, 0x82,
+	0xa5, 0x84, 0xeb, 0x54, 0x2c, 0xcc, 0xf3, 0x6f, 0x97, 0xb1, 0x30, 0x2f, 0xb8, 0xad, 0xc9, 0x1b,
+	0x0c, 0xe6, 0x2a, 0x5a, 0x8e, 0xc2, 0x3c, 0x22, 0x96, 0xa9, 0x3f, 0x21, 0xde, 0xb1, 0x7f, 0x55,
+	0x44, 0x9f, 0x4a, 0x70, 0x65, 0xe8, 0xee, 0x83, 0x6e, 0x27, 0x84, 0x8b, 0xbb, 0xae, 0x15, 0xde,
+	0x1c, 0xcd, 0xf8, 0x7c, 0x54, 0xd1, 0xf3, 0xdd, 0x4f, 0x45, 0x91, 0x63, 0x6e, 0xf7, 0x89, 0x45,
+	0x4e, 0x7e, 0x4f, 0x49, 0x2c, 0xf2, 0x39, 0x8f, 0x07, 0xf2, 0x2a, 0xc3, 0xb9, 0x84, 0xae, 0x45,
+	0x71, 0x8a, 0x57, 0x0b, 0xf4, 0x33, 0x09, 0xae, 0xc6, 0x9c, 0x79, 0xd1, 0x9d, 0x98, 0x50, 0xc9,
+	0x97, 0x83, 0x42, 0x69, 0x54, 0x73, 0x81, 0xea, 0x36, 0x43, 0xf5, 0x1a, 0xda, 0x88, 0xa2, 0xc2,
+	0xc2, 0x25, 0xa0, 0x1f, 0x3b, 0x9b, 0xa0, 0x67, 0x12, 0x2c, 0xc6, 0x1f, 0x86, 0xd0, 0xdd, 0x73,
+	0xe2, 0xc6, 0x9e, 0xf6, 0x0a, 0xdb, 0x97, 0xf0, 0x10, 0x60, 0x55, 0x06, 0x76, 0x17, 0x55, 0x12,
+	0xc0, 0xda, 0x7d, 0x37, 0x86, 0xb7, 0xfc, 0x61, 0xd2, 0x71, 0xf2, 0x23, 0xf4, 0x93, 0xa1, 0xad,
+	0x49, 0x9c, 0x0a, 0x46, 0xd8, 0x9a, 0xa2, 0x07, 0x96, 0x11, 0xb6, 0xa6, 0x81, 0xb3, 0x4a, 0x52,
+	0xbf, 0x8b, 0x83, 0x08, 0xdb, 0x96, 0xaa, 0xd5, 0x67, 0xcf, 0xd7, 0xa4, 0xcf, 0x9e, 0xaf, 0x49,
+	0xff, 0x78, 0xbe, 0x26, 0x7d, 0xf2, 0x62, 0x6d, 0xec, 0xb3, 0x17, 0x6b, 0x63, 0x7f, 0x7b, 0xb1,
+	0x36, 0xf6, 0xdd, 0xcd, 0x36, 0xf1, 0x8e, 0x7b, 0xcd, 0x52, 0xcb, 0xee, 0x0a, 0xff, 0x3b, 0x1d,
+	0xa3, 0x49, 0x83, 0xb9, 0x9e, 0xfa, 0xb3, 0xb1, 0xbf, 0x32, 0x34, 0x27, 0xd8, 0x4b, 0xcf, 0x5b,
+	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x75, 0xc7, 0xe8, 0x74, 0x19, 0x00, 0x00,
 }
 
-func (m *QueryGetParticipantSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	ListParticipants(ctx context.Context, in *QueryListParticipantsRequest, opts ...grpc.CallOption) (*QueryListParticipantsResponse, error)
+	GetParticipant(ctx context.Context, in *QueryGetParticipantRequest, opts ...grpc.CallOption) (*QueryGetParticipantResponse, error)
+	GetParticipantSession(ctx context.Context, in *QueryGetParticipantSessionRequest, opts ...grpc.CallOption) (*QueryGetParticipantSessionResponse, error)
+	ListParticipantSessions(ctx context.Context, in *QueryListParticipantSessionsRequest, opts ...grpc.CallOption) (*QueryListParticipantSessionsResponse, error)
+	FindParticipantsWithDID(ctx context.Context, in *QueryFindParticipantsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindParticipantsWithDIDResponse, error)
+	FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error)
+	// ListRevokedParticipants returns revoked participants ordered by
+	// revocation time, so that verifiers can sync a revocation feed.
+	ListRevokedParticipants(ctx context.Context, in *QueryListRevokedParticipantsRequest, opts ...grpc.CallOption) (*QueryListRevokedParticipantsResponse, error)
+	// EstimateSessionFees returns the fees a CreateOrUpdateParticipantSession
+	// would charge for the given participants, itemized per beneficiary and
+	// destination, without changing any state.
+	EstimateSessionFees(ctx context.Context, in *QueryEstimateSessionFeesRequest, opts ...grpc.CallOption) (*QueryEstimateSessionFeesResponse, error)
+	// EstimateOnboardingFees returns the fees and trust deposit a
+	// StartParticipantOP (or RenewParticipantOP) would charge to be validated
+	// by the given validator participant, without changing any state.
+	EstimateOnboardingFees(ctx context.Context, in *QueryEstimateOnboardingFeesRequest, opts ...grpc.CallOption) (*QueryEstimateOnboardingFeesResponse, error)
+	// GetParticipantCascade returns a revocation cascade and its progress.
+	GetParticipantCascade(ctx context.Context, in *QueryGetParticipantCascadeRequest, opts ...grpc.CallOption) (*QueryGetParticipantCascadeResponse, error)
 }
 
-func (m *QueryGetParticipantSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Session != nil {
-		{
-			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryListParticipantSessionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListParticipantSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListParticipants(ctx context.Context, in *QueryListParticipantsRequest, opts ...grpc.CallOption) (*QueryListParticipantsResponse, error) {
+	out := new(QueryListParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/ListParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListParticipantSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ResponseMaxSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ModifiedAfter != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintQuery(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetParticipant(ctx context.Context, in *QueryGetParticipantRequest, opts ...grpc.CallOption) (*QueryGetParticipantResponse, error) {
+	out := new(QueryGetParticipantResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/GetParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListParticipantSessionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetParticipantSession(ctx context.Context, in *QueryGetParticipantSessionRequest, opts ...grpc.CallOption) (*QueryGetParticipantSessionResponse, error) {
+	out := new(QueryGetParticipantSessionResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/GetParticipantSession", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListParticipantSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListParticipantSessions(ctx context.Context, in *QueryListParticipantSessionsRequest, opts ...grpc.CallOption) (*QueryListParticipantSessionsResponse, error) {
+	out := new(QueryListParticipantSessionsResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/ListParticipantSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListParticipantSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) FindParticipantsWithDID(ctx context.Context, in *QueryFindParticipantsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindParticipantsWithDIDResponse, error) {
+	out := new(QueryFindParticipantsWithDIDResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/FindParticipantsWithDID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFindParticipantsWithDIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error) {
+	out := new(QueryFindBeneficiariesResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/FindBeneficiaries", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFindParticipantsWithDIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListRevokedParticipants(ctx context.Context, in *QueryListRevokedParticipantsRequest, opts ...grpc.CallOption) (*QueryListRevokedParticipantsResponse, error) {
+	out := new(QueryListRevokedParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/ListRevokedParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFindParticipantsWithDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.When != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.When, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintQuery(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SchemaId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SchemaId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Role != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x10
+func (c *queryClient) EstimateSessionFees(ctx context.Context, in *QueryEstimateSessionFeesRequest, opts ...grpc.CallOption) (*QueryEstimateSessionFeesResponse, error) {
+	out := new(QueryEstimateSessionFeesResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/EstimateSessionFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) EstimateOnboardingFees(ctx context.Context, in *QueryEstimateOnboardingFeesRequest, opts ...grpc.CallOption) (*QueryEstimateOnboardingFeesResponse, error) {
+	out := new(QueryEstimateOnboardingFeesResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/EstimateOnboardingFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFindParticipantsWithDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetParticipantCascade(ctx context.Context, in *QueryGetParticipantCascadeRequest, opts ...grpc.CallOption) (*QueryGetParticipantCascadeResponse, error) {
+	out := new(QueryGetParticipantCascadeResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Query/GetParticipantCascade", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFindParticipantsWithDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	ListParticipants(context.Context, *QueryListParticipantsRequest) (*QueryListParticipantsResponse, error)
+	GetParticipant(context.Context, *QueryGetParticipantRequest) (*QueryGetParticipantResponse, error)
+	GetParticipantSession(context.Context, *QueryGetParticipantSessionRequest) (*QueryGetParticipantSessionResponse, error)
+	ListParticipantSessions(context.Context, *QueryListParticipantSessionsRequest) (*QueryListParticipantSessionsResponse, error)
+	FindParticipantsWithDID(context.Context, *QueryFindParticipantsWithDIDRequest) (*QueryFindParticipantsWithDIDResponse, error)
+	FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error)
+	// ListRevokedParticipants returns revoked participants ordered by
+	// revocation time, so that verifiers can sync a revocation feed.
+	ListRevokedParticipants(context.Context, *QueryListRevokedParticipantsRequest) (*QueryListRevokedParticipantsResponse, error)
+	// EstimateSessionFees returns the fees a CreateOrUpdateParticipantSession
+	// would charge for the given participants, itemized per beneficiary and
+	// destination, without changing any state.
+	EstimateSessionFees(context.Context, *QueryEstimateSessionFeesRequest) (*QueryEstimateSessionFeesResponse, error)
+	// EstimateOnboardingFees returns the fees and trust deposit a
+	// StartParticipantOP (or RenewParticipantOP) would charge to be validated
+	// by the given validator participant, without changing any state.
+	EstimateOnboardingFees(context.Context, *QueryEstimateOnboardingFeesRequest) (*QueryEstimateOnboardingFeesResponse, error)
+	// GetParticipantCascade returns a revocation cascade and its progress.
+	GetParticipantCascade(context.Context, *QueryGetParticipantCascadeRequest) (*QueryGetParticipantCascadeResponse, error)
 }
 
-func (m *QueryFindParticipantsWithDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryFindBeneficiariesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ListParticipants(ctx context.Context, req *QueryListParticipantsRequest) (*QueryListParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParticipants not implemented")
+}
+func (*UnimplementedQueryServer) GetParticipant(ctx context.Context, req *QueryGetParticipantRequest) (*QueryGetParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParticipant not implemented")
+}
+func (*UnimplementedQueryServer) GetParticipantSession(ctx context.Context, req *QueryGetParticipantSessionRequest) (*QueryGetParticipantSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParticipantSession not implemented")
+}
+func (*UnimplementedQueryServer) ListParticipantSessions(ctx context.Context, req *QueryListParticipantSessionsRequest) (*QueryListParticipantSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParticipantSessions not implemented")
+}
+func (*UnimplementedQueryServer) FindParticipantsWithDID(ctx context.Context, req *QueryFindParticipantsWithDIDRequest) (*QueryFindParticipantsWithDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindParticipantsWithDID not implemented")
+}
+func (*UnimplementedQueryServer) FindBeneficiaries(ctx context.Context, req *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBeneficiaries not implemented")
+}
+func (*UnimplementedQueryServer) ListRevokedParticipants(ctx context.Context, req *QueryListRevokedParticipantsRequest) (*QueryListRevokedParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevokedParticipants not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSessionFees(ctx context.Context, req *QueryEstimateSessionFeesRequest) (*QueryEstimateSessionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSessionFees not implemented")
+}
+func (*UnimplementedQueryServer) EstimateOnboardingFees(ctx context.Context, req *QueryEstimateOnboardingFeesRequest) (*QueryEstimateOnboardingFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateOnboardingFees not implemented")
+}
+func (*UnimplementedQueryServer) GetParticipantCascade(ctx context.Context, req *QueryGetParticipantCascadeRequest) (*QueryGetParticipantCascadeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParticipantCascade not implemented")
 }
 
-func (m *QueryFindBeneficiariesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryFindBeneficiariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VerifierParticipantId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierParticipantId))
-		i--
-		dAtA[i] = 0x10
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IssuerParticipantId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.IssuerParticipantId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFindBeneficiariesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListParticipantsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFindBeneficiariesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ListParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/ListParticipants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListParticipants(ctx, req.(*QueryListParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFindBeneficiariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Participants) > 0 {
-		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_GetParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/GetParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParticipant(ctx, req.(*QueryGetParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_GetParticipantSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetParticipantSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GetParticipantSession(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/GetParticipantSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParticipantSession(ctx, req.(*QueryGetParticipantSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListParticipantSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListParticipantSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ListParticipantSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/ListParticipantSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListParticipantSessions(ctx, req.(*QueryListParticipantSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListParticipantsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FindParticipantsWithDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindParticipantsWithDIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ModifiedAfter != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FindParticipantsWithDID(ctx, in)
 	}
-	if m.ResponseMaxSize != 0 {
-		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/FindParticipantsWithDID",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindParticipantsWithDID(ctx, req.(*QueryFindParticipantsWithDIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListParticipantsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FindBeneficiaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindBeneficiariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).FindBeneficiaries(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/FindBeneficiaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindBeneficiaries(ctx, req.(*QueryFindBeneficiariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetParticipantRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListRevokedParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRevokedParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).ListRevokedParticipants(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/ListRevokedParticipants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRevokedParticipants(ctx, req.(*QueryListRevokedParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateSessionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSessionFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Participant.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetParticipantSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSessionFees(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/EstimateSessionFees",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSessionFees(ctx, req.(*QueryEstimateSessionFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetParticipantSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateOnboardingFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateOnboardingFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Session != nil {
-		l = m.Session.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateOnboardingFees(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/EstimateOnboardingFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateOnboardingFees(ctx, req.(*QueryEstimateOnboardingFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListParticipantSessionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetParticipantCascade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetParticipantCascadeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ModifiedAfter != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetParticipantCascade(ctx, in)
 	}
-	if m.ResponseMaxSize != 0 {
-		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Query/GetParticipantCascade",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParticipantCascade(ctx, req.(*QueryGetParticipantCascadeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListParticipantSessionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.pp.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ListParticipants",
+			Handler:    _Query_ListParticipants_Handler,
+		},
+		{
+			MethodName: "GetParticipant",
+			Handler:    _Query_GetParticipant_Handler,
+		},
+		{
+			MethodName: "GetParticipantSession",
+			Handler:    _Query_GetParticipantSession_Handler,
+		},
+		{
+			MethodName: "ListParticipantSessions",
+			Handler:    _Query_ListParticipantSessions_Handler,
+		},
+		{
+			MethodName: "FindParticipantsWithDID",
+			Handler:    _Query_FindParticipantsWithDID_Handler,
+		},
+		{
+			MethodName: "FindBeneficiaries",
+			Handler:    _Query_FindBeneficiaries_Handler,
+		},
+		{
+			MethodName: "ListRevokedParticipants",
+			Handler:    _Query_ListRevokedParticipants_Handler,
+		},
+		{
+			MethodName: "EstimateSessionFees",
+			Handler:    _Query_EstimateSessionFees_Handler,
+		},
+		{
+			MethodName: "EstimateOnboardingFees",
+			Handler:    _Query_EstimateOnboardingFees_Handler,
+		},
+		{
+			MethodName: "GetParticipantCascade",
+			Handler:    _Query_GetParticipantCascade_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/pp/v1/query.proto",
 }
 
-func (m *QueryFindParticipantsWithDIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Role != 0 {
-		n += 1 + sovQuery(uint64(m.Role))
-	}
-	if m.SchemaId != 0 {
-		n += 1 + sovQuery(uint64(m.SchemaId))
-	}
-	if m.When != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFindParticipantsWithDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFindBeneficiariesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListParticipantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListParticipantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListParticipantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IssuerParticipantId != 0 {
-		n += 1 + sovQuery(uint64(m.IssuerParticipantId))
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.VerifierParticipantId != 0 {
-		n += 1 + sovQuery(uint64(m.VerifierParticipantId))
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ModifiedAfter != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFindBeneficiariesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListParticipantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListParticipantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListParticipantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Participant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListParticipantSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListParticipantSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListParticipantSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VsOperator) > 0 {
+		i -= len(m.VsOperator)
+		copy(dAtA[i:], m.VsOperator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VsOperator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ModifiedAfter != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListParticipantSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListParticipantSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListParticipantSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRevokedParticipantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRevokedParticipantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRevokedParticipantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RevokedBefore != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RevokedBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RevokedBefore):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AfterId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RevokedAfter != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RevokedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RevokedAfter):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EcosystemId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EcosystemId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRevokedParticipantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRevokedParticipantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRevokedParticipantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindParticipantsWithDIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindParticipantsWithDIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindParticipantsWithDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeDidHistory {
+		i--
+		if m.IncludeDidHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.When != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.When, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindParticipantsWithDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindParticipantsWithDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindParticipantsWithDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindBeneficiariesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindBeneficiariesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindBeneficiariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifierParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierParticipantId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IssuerParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IssuerParticipantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindBeneficiariesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindBeneficiariesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindBeneficiariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeEstimateItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEstimateItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeEstimateItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Destination != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeneficiaryFeeEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeneficiaryFeeEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeneficiaryFeeEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeesInDenom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeesInDenom))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Fees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSessionFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSessionFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSessionFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WalletAgentParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WalletAgentParticipantId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AgentParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgentParticipantId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VerifierParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierParticipantId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IssuerParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IssuerParticipantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSessionFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSessionFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSessionFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SufficientFunds {
+		i--
+		if m.SufficientFunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PayerBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayerBalance))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RequiredPayerBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequiredPayerBalance))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TrustUnitPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustUnitPrice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Discount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Discount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BeneficiaryFees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeneficiaryFees))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Beneficiaries) > 0 {
+		for iNdEx := len(m.Beneficiaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Beneficiaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateOnboardingFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateOnboardingFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateOnboardingFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorParticipantId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorParticipantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateOnboardingFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateOnboardingFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateOnboardingFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SufficientFunds {
+		i--
+		if m.SufficientFunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PayerBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayerBalance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RequiredPayerBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequiredPayerBalance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TrustUnitPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustUnitPrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantCascadeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantCascadeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantCascadeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantCascadeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantCascadeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantCascadeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Cascade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListParticipantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifiedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListParticipantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetParticipantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetParticipantSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetParticipantSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListParticipantSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifiedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = len(m.VsOperator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.ParticipantId))
+	}
+	return n
+}
+
+func (m *QueryListParticipantSessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListRevokedParticipantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SchemaId != 0 {
+		n += 1 + sovQuery(uint64(m.SchemaId))
+	}
+	if m.EcosystemId != 0 {
+		n += 1 + sovQuery(uint64(m.EcosystemId))
+	}
+	if m.RevokedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RevokedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterId))
+	}
+	if m.RevokedBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RevokedBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListRevokedParticipantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFindParticipantsWithDIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovQuery(uint64(m.SchemaId))
+	}
+	if m.When != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeDidHistory {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFindParticipantsWithDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFindBeneficiariesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IssuerParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.IssuerParticipantId))
+	}
+	if m.VerifierParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.VerifierParticipantId))
+	}
+	return n
+}
+
+func (m *QueryFindBeneficiariesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEstimateItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovQuery(uint64(m.Kind))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovQuery(uint64(m.Destination))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.ParticipantId))
+	}
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *BeneficiaryFeeEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.ParticipantId))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	if m.Fees != 0 {
+		n += 1 + sovQuery(uint64(m.Fees))
+	}
+	if m.FeesInDenom != 0 {
+		n += 1 + sovQuery(uint64(m.FeesInDenom))
+	}
+	return n
+}
+
+func (m *QueryEstimateSessionFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IssuerParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.IssuerParticipantId))
+	}
+	if m.VerifierParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.VerifierParticipantId))
+	}
+	if m.AgentParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.AgentParticipantId))
+	}
+	if m.WalletAgentParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.WalletAgentParticipantId))
+	}
+	return n
+}
+
+func (m *QueryEstimateSessionFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Beneficiaries) > 0 {
+		for _, e := range m.Beneficiaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BeneficiaryFees != 0 {
+		n += 1 + sovQuery(uint64(m.BeneficiaryFees))
+	}
+	if m.Discount != 0 {
+		n += 1 + sovQuery(uint64(m.Discount))
+	}
+	if m.TrustUnitPrice != 0 {
+		n += 1 + sovQuery(uint64(m.TrustUnitPrice))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequiredPayerBalance != 0 {
+		n += 1 + sovQuery(uint64(m.RequiredPayerBalance))
+	}
+	if m.PayerBalance != 0 {
+		n += 1 + sovQuery(uint64(m.PayerBalance))
+	}
+	if m.SufficientFunds {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEstimateOnboardingFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorParticipantId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorParticipantId))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateOnboardingFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if m.ValidationFees != 0 {
+		n += 1 + sovQuery(uint64(m.ValidationFees))
+	}
+	if m.TrustUnitPrice != 0 {
+		n += 1 + sovQuery(uint64(m.TrustUnitPrice))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequiredPayerBalance != 0 {
+		n += 1 + sovQuery(uint64(m.RequiredPayerBalance))
+	}
+	if m.PayerBalance != 0 {
+		n += 1 + sovQuery(uint64(m.PayerBalance))
+	}
+	if m.SufficientFunds {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetParticipantCascadeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetParticipantCascadeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cascade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingCount != 0 {
+		n += 1 + sovQuery(uint64(m.PendingCount))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListParticipantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListParticipantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListParticipantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAfter == nil {
+				m.ModifiedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListParticipantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListParticipantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetParticipantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetParticipantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetParticipantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetParticipantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetParticipantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetParticipantSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetParticipantSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetParticipantSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetParticipantSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetParticipantSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetParticipantSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &ParticipantSession{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListParticipantSessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListParticipantSessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListParticipantSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAfter == nil {
+				m.ModifiedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListParticipantSessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListParticipantSessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListParticipantSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, ParticipantSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRevokedParticipantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRevokedParticipantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRevokedParticipantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
+			}
+			m.EcosystemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EcosystemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevokedAfter == nil {
+				m.RevokedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RevokedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
+			}
+			m.AfterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevokedBefore == nil {
+				m.RevokedBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RevokedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRevokedParticipantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRevokedParticipantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRevokedParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindParticipantsWithDIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.When == nil {
+				m.When = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.When, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDidHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDidHistory = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryFindParticipantsWithDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1799,16 +5196,54 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
+			}
+			m.IssuerParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+			}
+			m.VerifierParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1830,7 +5265,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,15 +5288,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1888,7 +5323,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Participants = append(m.Participants, Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1913,7 +5349,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListParticipantsRequest) Unmarshal(dAtA []byte) error {
+func (m *FeeEstimateItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1936,17 +5372,93 @@ func (m *QueryListParticipantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListParticipantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeEstimateItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListParticipantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeEstimateItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= FeeItemKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= FeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1956,33 +5468,29 @@ func (m *QueryListParticipantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAfter == nil {
-				m.ModifiedAfter = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ResponseMaxSize = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1992,7 +5500,7 @@ func (m *QueryListParticipantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2018,7 +5526,7 @@ func (m *QueryListParticipantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListParticipantsResponse) Unmarshal(dAtA []byte) error {
+func (m *BeneficiaryFeeEstimate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2041,17 +5549,17 @@ func (m *QueryListParticipantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListParticipantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeneficiaryFeeEstimate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeneficiaryFeeEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
 			}
-			var msglen int
+			m.ParticipantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2061,26 +5569,87 @@ func (m *QueryListParticipantsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ParticipantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ParticipantRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
 			}
-			m.Participants = append(m.Participants, Participant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			m.Fees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesInDenom", wireType)
+			}
+			m.FeesInDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeesInDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2102,7 +5671,7 @@ func (m *QueryListParticipantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetParticipantRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSessionFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2125,17 +5694,74 @@ func (m *QueryGetParticipantRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetParticipantRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSessionFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetParticipantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSessionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
 			}
-			m.Id = 0
+			m.IssuerParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+			}
+			m.VerifierParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentParticipantId", wireType)
+			}
+			m.AgentParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentParticipantId", wireType)
+			}
+			m.WalletAgentParticipantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2145,7 +5771,7 @@ func (m *QueryGetParticipantRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.WalletAgentParticipantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2171,7 +5797,7 @@ func (m *QueryGetParticipantRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSessionFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2194,15 +5820,15 @@ func (m *QueryGetParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSessionFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSessionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiaries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2229,63 +5855,105 @@ func (m *QueryGetParticipantResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Participant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Beneficiaries = append(m.Beneficiaries, BeneficiaryFeeEstimate{})
+			if err := m.Beneficiaries[len(m.Beneficiaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetParticipantSessionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Items = append(m.Items, FeeEstimateItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryFees", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BeneficiaryFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeneficiaryFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetParticipantSessionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetParticipantSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			m.Discount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Discount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustUnitPrice", wireType)
+			}
+			m.TrustUnitPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustUnitPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2313,63 +5981,13 @@ func (m *QueryGetParticipantSessionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetParticipantSessionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetParticipantSessionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetParticipantSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2379,83 +5997,48 @@ func (m *QueryGetParticipantSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Session == nil {
-				m.Session = &ParticipantSession{}
-			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListParticipantSessionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPayerBalance", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RequiredPayerBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredPayerBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListParticipantSessionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListParticipantSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerBalance", wireType)
 			}
-			var msglen int
+			m.PayerBalance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2465,33 +6048,16 @@ func (m *QueryListParticipantSessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PayerBalance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ModifiedAfter == nil {
-				m.ModifiedAfter = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SufficientFunds", wireType)
 			}
-			m.ResponseMaxSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2501,11 +6067,12 @@ func (m *QueryListParticipantSessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SufficientFunds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2527,7 +6094,7 @@ func (m *QueryListParticipantSessionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListParticipantSessionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateOnboardingFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2550,17 +6117,55 @@ func (m *QueryListParticipantSessionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListParticipantSessionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateOnboardingFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListParticipantSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateOnboardingFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
+			}
+			m.ValidatorParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ParticipantRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2570,25 +6175,23 @@ func (m *QueryListParticipantSessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, ParticipantSession{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2611,7 +6214,7 @@ func (m *QueryListParticipantSessionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindParticipantsWithDIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateOnboardingFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2634,17 +6237,17 @@ func (m *QueryFindParticipantsWithDIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateOnboardingFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindParticipantsWithDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateOnboardingFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2654,29 +6257,31 @@ func (m *QueryFindParticipantsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, FeeEstimateItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 			}
-			m.Role = 0
+			m.ValidationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2686,16 +6291,16 @@ func (m *QueryFindParticipantsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= uint32(b&0x7F) << shift
+				m.ValidationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustUnitPrice", wireType)
 			}
-			m.SchemaId = 0
+			m.TrustUnitPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2705,16 +6310,16 @@ func (m *QueryFindParticipantsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SchemaId |= uint64(b&0x7F) << shift
+				m.TrustUnitPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2724,83 +6329,29 @@ func (m *QueryFindParticipantsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.When == nil {
-				m.When = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.When, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFindParticipantsWithDIDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindParticipantsWithDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPayerBalance", wireType)
 			}
-			var msglen int
+			m.RequiredPayerBalance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2810,26 +6361,50 @@ func (m *QueryFindParticipantsWithDIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RequiredPayerBalance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerBalance", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.PayerBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayerBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SufficientFunds", wireType)
 			}
-			m.Participants = append(m.Participants, Participant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.SufficientFunds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2851,7 +6426,7 @@ func (m *QueryFindParticipantsWithDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetParticipantCascadeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2874,36 +6449,17 @@ func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetParticipantCascadeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetParticipantCascadeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
-			}
-			m.IssuerParticipantId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuerParticipantId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.VerifierParticipantId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2913,7 +6469,7 @@ func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerifierParticipantId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2939,7 +6495,7 @@ func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetParticipantCascadeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2962,15 +6518,15 @@ func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetParticipantCascadeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetParticipantCascadeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2997,11 +6553,29 @@ func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, Participant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cascade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCount", wireType)
+			}
+			m.PendingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1003,9 +1003,17 @@ func (m *MsgSetParticipantEffectiveUntilResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgSetParticipantEffectiveUntilResponse proto.InternalMessageInfo
 
 type MsgRevokeParticipant struct {
-	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Corporation string           `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string           `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64           `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Reason      RevocationReason `protobuf:"varint,4,opt,name=reason,proto3,enum=verana.pp.v1.RevocationReason" json:"reason,omitempty"`
+	// evidence is an optional free-text justification
+	Evidence string `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// evidence_digest is an optional SRI digest of off-chain evidence
+	EvidenceDigest string `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+	// cascade optionally revokes or suspends the descendants of the
+	// participant, in batches over the following blocks
+	Cascade CascadeMode `protobuf:"varint,7,opt,name=cascade,proto3,enum=verana.pp.v1.CascadeMode" json:"cascade,omitempty"`
 }
 
 func (m *MsgRevokeParticipant) Reset()         { *m = MsgRevokeParticipant{} }
@@ -1062,7 +1070,37 @@ func (m *MsgRevokeParticipant) GetId() uint64 {
 	return 0
 }
 
+func (m *MsgRevokeParticipant) GetReason() RevocationReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
+
+func (m *MsgRevokeParticipant) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *MsgRevokeParticipant) GetEvidenceDigest() string {
+	if m != nil {
+		return m.EvidenceDigest
+	}
+	return ""
+}
+
+func (m *MsgRevokeParticipant) GetCascade() CascadeMode {
+	if m != nil {
+		return m.Cascade
+	}
+	return CascadeMode_CASCADE_MODE_UNSPECIFIED
+}
+
 type MsgRevokeParticipantResponse struct {
+	// cascade_id is the id of the started cascade, 0 if none
+	CascadeId uint64 `protobuf:"varint,1,opt,name=cascade_id,json=cascadeId,proto3" json:"cascade_id,omitempty"`
 }
 
 func (m *MsgRevokeParticipantResponse) Reset()         { *m = MsgRevokeParticipantResponse{} }
@@ -1098,6 +1136,13 @@ func (m *MsgRevokeParticipantResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeParticipantResponse proto.InternalMessageInfo
 
+func (m *MsgRevokeParticipantResponse) GetCascadeId() uint64 {
+	if m != nil {
+		return m.CascadeId
+	}
+	return 0
+}
+
 type MsgCreateOrUpdateParticipantSession struct {
 	// corporation is the group account on whose behalf this message is executed
 	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
@@ -1257,6 +1302,9 @@ type MsgSlashParticipantTrustDeposit struct {
 	Amount   uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	// [MOD-PP-MSG-12-1] reason for the slash (mandatory per spec v4)
 	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// evidence_digest is the SRI digest of the slash evidence. It MUST have
+	// been anchored in x/di before the slash.
+	EvidenceDigest string `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
 }
 
 func (m *MsgSlashParticipantTrustDeposit) Reset()         { *m = MsgSlashParticipantTrustDeposit{} }
@@ -1327,7 +1375,18 @@ func (m *MsgSlashParticipantTrustDeposit) GetReason() string {
 	return ""
 }
 
+func (m *MsgSlashParticipantTrustDeposit) GetEvidenceDigest() string {
+	if m != nil {
+		return m.EvidenceDigest
+	}
+	return ""
+}
+
 type MsgSlashParticipantTrustDepositResponse struct {
+	SlashId uint64 `protobuf:"varint,1,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	// dispute_deadline is the end of the window during which the slash can be
+	// appealed. The slash is final when it equals the slash time.
+	DisputeDeadline time.Time `protobuf:"bytes,2,opt,name=dispute_deadline,json=disputeDeadline,proto3,stdtime" json:"dispute_deadline"`
 }
 
 func (m *MsgSlashParticipantTrustDepositResponse) Reset() {
@@ -1365,6 +1424,20 @@ func (m *MsgSlashParticipantTrustDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSlashParticipantTrustDepositResponse proto.InternalMessageInfo
 
+func (m *MsgSlashParticipantTrustDepositResponse) GetSlashId() uint64 {
+	if m != nil {
+		return m.SlashId
+	}
+	return 0
+}
+
+func (m *MsgSlashParticipantTrustDepositResponse) GetDisputeDeadline() time.Time {
+	if m != nil {
+		return m.DisputeDeadline
+	}
+	return time.Time{}
+}
+
 type MsgRepayParticipantSlashedTrustDeposit struct {
 	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
 	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
@@ -1676,2433 +1749,7459 @@ func (m *MsgSelfCreateParticipantResponse) GetId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "verana.pp.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.pp.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgStartParticipantOP)(nil), "verana.pp.v1.MsgStartParticipantOP")
-	proto.RegisterType((*MsgStartParticipantOPResponse)(nil), "verana.pp.v1.MsgStartParticipantOPResponse")
-	proto.RegisterType((*MsgRenewParticipantOP)(nil), "verana.pp.v1.MsgRenewParticipantOP")
-	proto.RegisterType((*MsgRenewParticipantOPResponse)(nil), "verana.pp.v1.MsgRenewParticipantOPResponse")
-	proto.RegisterType((*MsgSetParticipantOPToValidated)(nil), "verana.pp.v1.MsgSetParticipantOPToValidated")
-	proto.RegisterType((*MsgSetParticipantOPToValidatedResponse)(nil), "verana.pp.v1.MsgSetParticipantOPToValidatedResponse")
-	proto.RegisterType((*MsgCancelParticipantOPLastRequest)(nil), "verana.pp.v1.MsgCancelParticipantOPLastRequest")
-	proto.RegisterType((*MsgCancelParticipantOPLastRequestResponse)(nil), "verana.pp.v1.MsgCancelParticipantOPLastRequestResponse")
-	proto.RegisterType((*MsgCreateRootParticipant)(nil), "verana.pp.v1.MsgCreateRootParticipant")
-	proto.RegisterType((*MsgCreateRootParticipantResponse)(nil), "verana.pp.v1.MsgCreateRootParticipantResponse")
-	proto.RegisterType((*MsgSetParticipantEffectiveUntil)(nil), "verana.pp.v1.MsgSetParticipantEffectiveUntil")
-	proto.RegisterType((*MsgSetParticipantEffectiveUntilResponse)(nil), "verana.pp.v1.MsgSetParticipantEffectiveUntilResponse")
-	proto.RegisterType((*MsgRevokeParticipant)(nil), "verana.pp.v1.MsgRevokeParticipant")
-	proto.RegisterType((*MsgRevokeParticipantResponse)(nil), "verana.pp.v1.MsgRevokeParticipantResponse")
-	proto.RegisterType((*MsgCreateOrUpdateParticipantSession)(nil), "verana.pp.v1.MsgCreateOrUpdateParticipantSession")
-	proto.RegisterType((*MsgCreateOrUpdateParticipantSessionResponse)(nil), "verana.pp.v1.MsgCreateOrUpdateParticipantSessionResponse")
-	proto.RegisterType((*MsgSlashParticipantTrustDeposit)(nil), "verana.pp.v1.MsgSlashParticipantTrustDeposit")
-	proto.RegisterType((*MsgSlashParticipantTrustDepositResponse)(nil), "verana.pp.v1.MsgSlashParticipantTrustDepositResponse")
-	proto.RegisterType((*MsgRepayParticipantSlashedTrustDeposit)(nil), "verana.pp.v1.MsgRepayParticipantSlashedTrustDeposit")
-	proto.RegisterType((*MsgRepayParticipantSlashedTrustDepositResponse)(nil), "verana.pp.v1.MsgRepayParticipantSlashedTrustDepositResponse")
-	proto.RegisterType((*MsgSelfCreateParticipant)(nil), "verana.pp.v1.MsgSelfCreateParticipant")
-	proto.RegisterType((*MsgSelfCreateParticipantResponse)(nil), "verana.pp.v1.MsgSelfCreateParticipantResponse")
+type MsgProposeParticipantFeeChange struct {
+	// corporation is the validator participant corporation (or the ecosystem
+	// controller for participants without validator)
+	Corporation             string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator                string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id                      uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	ValidationFees          uint64 `protobuf:"varint,4,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees            uint64 `protobuf:"varint,5,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees        uint64 `protobuf:"varint,6,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	IssuanceFeeDiscount     uint64 `protobuf:"varint,7,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`
+	VerificationFeeDiscount uint64 `protobuf:"varint,8,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/pp/v1/tx.proto", fileDescriptor_4a8d881b5faa8c86) }
-
-var fileDescriptor_4a8d881b5faa8c86 = []byte{
-	// 1769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x13, 0xc1,
-	0x19, 0xcf, 0x26, 0x26, 0xb1, 0xc7, 0x79, 0x2e, 0x09, 0xd9, 0x38, 0xc1, 0x36, 0x9b, 0x02, 0x26,
-	0x69, 0x6c, 0x62, 0x42, 0x28, 0x11, 0x1c, 0x30, 0x69, 0x2a, 0x28, 0x51, 0xa2, 0x4d, 0x68, 0xab,
-	0x5e, 0x56, 0x1b, 0xef, 0x78, 0xb3, 0xc2, 0xbb, 0xb3, 0xdd, 0x59, 0x1b, 0xd2, 0x53, 0x5b, 0xf5,
-	0x50, 0x71, 0x29, 0xea, 0xa9, 0xbd, 0xf5, 0x58, 0x71, 0x42, 0x55, 0xaf, 0x3d, 0x97, 0x43, 0x55,
-	0xa1, 0xaa, 0x95, 0x2a, 0x55, 0x82, 0x0a, 0x0e, 0xfc, 0x09, 0xbd, 0x56, 0x33, 0xfb, 0xf0, 0xbe,
-	0x6c, 0x6f, 0x4a, 0x90, 0x69, 0xc5, 0x05, 0xb2, 0x3b, 0xdf, 0x6f, 0xbe, 0xc7, 0xcc, 0xf7, 0x9b,
-	0xdf, 0x8e, 0xc1, 0x5c, 0x1b, 0x9a, 0x92, 0x2e, 0x55, 0x0c, 0xa3, 0xd2, 0x5e, 0xaf, 0x58, 0xcf,
-	0xca, 0x86, 0x89, 0x2c, 0xc4, 0x8e, 0xdb, 0xaf, 0xcb, 0x86, 0x51, 0x6e, 0xaf, 0xe7, 0x66, 0x24,
-	0x4d, 0xd5, 0x51, 0x85, 0xfe, 0x6b, 0x1b, 0xe4, 0xf2, 0x75, 0x84, 0x35, 0x84, 0x2b, 0x47, 0x12,
-	0x86, 0x95, 0xf6, 0xfa, 0x11, 0xb4, 0xa4, 0xf5, 0x4a, 0x1d, 0xa9, 0xba, 0x33, 0x3e, 0xef, 0x8c,
-	0x6b, 0x58, 0x21, 0x13, 0x6b, 0x58, 0x71, 0x06, 0x16, 0xec, 0x01, 0x91, 0x3e, 0x55, 0xec, 0x07,
-	0x67, 0x68, 0x56, 0x41, 0x0a, 0xb2, 0xdf, 0x93, 0xbf, 0x5c, 0x4f, 0x0a, 0x42, 0x4a, 0x13, 0x56,
-	0xe8, 0xd3, 0x51, 0xab, 0x51, 0x91, 0x5b, 0xa6, 0x64, 0xa9, 0xc8, 0xf5, 0x54, 0x08, 0x8f, 0x5b,
-	0xaa, 0x06, 0xb1, 0x25, 0x69, 0x86, 0xeb, 0x31, 0x90, 0xa2, 0x21, 0x99, 0x92, 0xe6, 0x7a, 0xe4,
-	0x82, 0xd9, 0x9f, 0x18, 0xd0, 0x19, 0xe1, 0x7f, 0xcf, 0x80, 0xa9, 0x5d, 0xac, 0x3c, 0x36, 0x64,
-	0xc9, 0x82, 0xfb, 0x14, 0xc3, 0x6e, 0x82, 0x8c, 0xd4, 0xb2, 0x8e, 0x91, 0xa9, 0x5a, 0x27, 0x1c,
-	0x53, 0x64, 0x4a, 0x99, 0x1a, 0xf7, 0xd7, 0x3f, 0xac, 0xcd, 0x3a, 0x49, 0xdc, 0x93, 0x65, 0x13,
-	0x62, 0x7c, 0x60, 0x99, 0xaa, 0xae, 0x08, 0x1d, 0x53, 0xf6, 0x16, 0x18, 0xb5, 0xbd, 0x72, 0xc3,
-	0x45, 0xa6, 0x94, 0xad, 0xce, 0x96, 0xfd, 0xd5, 0x2d, 0xdb, 0xb3, 0xd7, 0x32, 0xaf, 0xdf, 0x16,
-	0x86, 0x7e, 0xf7, 0xf1, 0xd5, 0x0a, 0x23, 0x38, 0xe6, 0x5b, 0xe5, 0x9f, 0x7d, 0x7c, 0xb5, 0xd2,
-	0x99, 0xe8, 0xf9, 0xc7, 0x57, 0x2b, 0x8b, 0x4e, 0xc4, 0xcf, 0x48, 0xcc, 0xa1, 0x00, 0xf9, 0x05,
-	0x30, 0x1f, 0x7a, 0x25, 0x40, 0x6c, 0x20, 0x1d, 0x43, 0xfe, 0x97, 0x19, 0x30, 0xb7, 0x8b, 0x95,
-	0x03, 0x4b, 0x32, 0xad, 0x7d, 0xc9, 0xb4, 0xd4, 0xba, 0x6a, 0x48, 0xba, 0xb5, 0xb7, 0xcf, 0x6e,
-	0x81, 0x6c, 0x1d, 0x99, 0x06, 0xb2, 0x8b, 0xda, 0x37, 0x2f, 0xbf, 0x31, 0xbb, 0x01, 0xd2, 0xc8,
-	0x80, 0xa6, 0x64, 0x21, 0x93, 0xe6, 0xd6, 0x0b, 0xe8, 0x59, 0xb2, 0xeb, 0x20, 0x65, 0xa2, 0x26,
-	0xe4, 0x46, 0x8a, 0x4c, 0x69, 0xb2, 0x7a, 0x31, 0x52, 0x0d, 0x37, 0x38, 0x01, 0x35, 0xa1, 0x40,
-	0x4d, 0xd9, 0x6f, 0x01, 0xae, 0x2d, 0x35, 0x55, 0x99, 0xe0, 0x45, 0xa3, 0x63, 0x22, 0xaa, 0x32,
-	0x97, 0x2a, 0x32, 0xa5, 0x94, 0x70, 0xc1, 0x1b, 0xf7, 0xcd, 0xf0, 0x40, 0x66, 0xa7, 0xc1, 0x88,
-	0xac, 0xca, 0xdc, 0x39, 0x12, 0x9d, 0x40, 0xfe, 0x64, 0xbf, 0x0b, 0xa6, 0x1c, 0x5b, 0x15, 0xe9,
-	0x62, 0x03, 0x42, 0xcc, 0x8d, 0xd2, 0x75, 0x59, 0x0a, 0x46, 0xb2, 0x67, 0x10, 0x03, 0xa9, 0xf9,
-	0xf8, 0x81, 0x6e, 0x6d, 0x6e, 0xd4, 0x52, 0xaf, 0xdf, 0x16, 0x18, 0x61, 0xb2, 0x03, 0xdd, 0x81,
-	0x10, 0xb3, 0xdf, 0x01, 0x13, 0x2a, 0xc6, 0x2d, 0x49, 0xaf, 0x43, 0x7b, 0xaa, 0xb1, 0xc4, 0x53,
-	0x8d, 0xbb, 0x40, 0x3a, 0xd1, 0x1e, 0x98, 0x69, 0x43, 0x53, 0x6d, 0xa8, 0x75, 0x5f, 0x5c, 0xe9,
-	0xc4, 0x93, 0x4d, 0xfb, 0xc1, 0x74, 0xc2, 0xdb, 0x20, 0xdb, 0xc6, 0xa2, 0xb7, 0x3c, 0x99, 0x3e,
-	0xcb, 0x03, 0xda, 0x78, 0xcf, 0x5d, 0xa0, 0x3b, 0x60, 0xd1, 0x07, 0x15, 0xc9, 0x06, 0xfc, 0xb1,
-	0xa8, 0x61, 0x45, 0xa4, 0x1d, 0xc2, 0x4d, 0x15, 0x47, 0x4a, 0x19, 0x61, 0xbe, 0x03, 0xb8, 0x47,
-	0x0c, 0x76, 0xb1, 0x72, 0x48, 0x86, 0xd9, 0x5f, 0x31, 0xe0, 0x62, 0x14, 0x8e, 0x0d, 0xa8, 0xcb,
-	0x62, 0x53, 0xd5, 0x54, 0x8b, 0xcb, 0x16, 0x47, 0x4a, 0xd9, 0xea, 0x42, 0xd9, 0x09, 0x84, 0x70,
-	0x48, 0xd9, 0xe1, 0x90, 0xf2, 0x7d, 0xa4, 0xea, 0xb5, 0x9b, 0xa4, 0x17, 0x5e, 0xbe, 0x2b, 0x94,
-	0x14, 0xd5, 0x3a, 0x6e, 0x1d, 0x95, 0xeb, 0x48, 0x73, 0xa8, 0xc2, 0xf9, 0x6f, 0x0d, 0xcb, 0x4f,
-	0x9c, 0x7e, 0x25, 0x00, 0x6c, 0xf7, 0xcd, 0x42, 0x28, 0xa4, 0x03, 0xe2, 0xf3, 0x11, 0x71, 0xc9,
-	0x6e, 0x83, 0x42, 0x34, 0xa6, 0xa7, 0xaa, 0x75, 0x4c, 0x0a, 0xad, 0x98, 0x92, 0x6e, 0x71, 0xe3,
-	0x45, 0xa6, 0x94, 0x16, 0x16, 0x43, 0x73, 0x7c, 0x5f, 0xb5, 0x8e, 0x77, 0x1c, 0x13, 0xf6, 0x37,
-	0x0c, 0xb8, 0x14, 0x9d, 0xa6, 0x01, 0x61, 0x20, 0xbd, 0x89, 0xcf, 0x94, 0xde, 0x52, 0x28, 0xb4,
-	0x1d, 0x08, 0x7d, 0x19, 0xfe, 0x00, 0x70, 0xd1, 0xd0, 0x0c, 0x68, 0xaa, 0x48, 0xe6, 0x26, 0xe9,
-	0x3e, 0x5a, 0x28, 0xdb, 0x54, 0x59, 0x76, 0xa9, 0xb2, 0xbc, 0xed, 0x50, 0x69, 0x2d, 0xf5, 0xeb,
-	0x77, 0x05, 0x46, 0x98, 0x0b, 0x39, 0xd8, 0xa7, 0xe8, 0xad, 0x1b, 0x84, 0x86, 0xbc, 0xf6, 0x25,
-	0x2c, 0x74, 0x29, 0xc4, 0x42, 0x51, 0x5a, 0x79, 0x98, 0x4a, 0x83, 0xe9, 0x2c, 0x59, 0x91, 0x70,
-	0x48, 0x50, 0x97, 0x8e, 0x9a, 0x50, 0xe6, 0x77, 0xc0, 0xc5, 0x58, 0xa4, 0x4b, 0x59, 0xec, 0x65,
-	0x30, 0x19, 0xea, 0x74, 0x86, 0x76, 0xfa, 0x84, 0xe1, 0x6f, 0x70, 0xfe, 0x2f, 0x0c, 0x65, 0x36,
-	0x01, 0xea, 0xf0, 0xe9, 0xa0, 0x99, 0x6d, 0x12, 0x0c, 0xab, 0x32, 0xe5, 0xb5, 0x94, 0x30, 0xac,
-	0x26, 0xa9, 0x5c, 0x34, 0x6c, 0xbe, 0x40, 0x0b, 0x13, 0x1d, 0xf0, 0xb8, 0xfc, 0x8f, 0x29, 0x90,
-	0x27, 0xa5, 0x83, 0xc1, 0xc2, 0x1d, 0xa2, 0xef, 0xd9, 0xdc, 0x04, 0xe5, 0xc1, 0xa7, 0xce, 0xee,
-	0x82, 0x29, 0xd8, 0x68, 0xc0, 0xba, 0xa5, 0xb6, 0xa1, 0xd8, 0xd2, 0x2d, 0xb5, 0x49, 0x89, 0x3a,
-	0x5b, 0xcd, 0x45, 0x76, 0xe1, 0xa1, 0x7b, 0x60, 0xd7, 0xd2, 0x84, 0xcb, 0x5e, 0x90, 0xad, 0x38,
-	0xe9, 0x81, 0x1f, 0x13, 0x2c, 0x7b, 0x35, 0x4a, 0xda, 0xe7, 0xa8, 0xaf, 0x30, 0x21, 0x2f, 0x87,
-	0x09, 0x79, 0x94, 0x9a, 0x05, 0xc9, 0x76, 0x35, 0x8e, 0x6c, 0xc7, 0xa8, 0x61, 0x94, 0x48, 0x57,
-	0xc0, 0x0c, 0x32, 0x44, 0xdc, 0xd2, 0x34, 0xc9, 0x3c, 0x11, 0x65, 0x55, 0x81, 0xd8, 0xa2, 0xcc,
-	0x9c, 0x11, 0xa6, 0x90, 0x71, 0x60, 0xbf, 0xdf, 0xa6, 0xaf, 0xd9, 0x2a, 0x98, 0xf3, 0x7b, 0x17,
-	0x65, 0x15, 0xd7, 0x51, 0x4b, 0xb7, 0x28, 0xfd, 0xa6, 0x84, 0xf3, 0xbe, 0x28, 0xb6, 0x9d, 0x21,
-	0x76, 0x0b, 0x2c, 0x84, 0x83, 0xe9, 0xe0, 0x00, 0xc5, 0xcd, 0x87, 0x82, 0x72, 0xb1, 0x49, 0x5a,
-	0xd3, 0xde, 0x25, 0x7b, 0xfb, 0xde, 0xe6, 0xe0, 0x4b, 0xe0, 0x4a, 0xef, 0xed, 0xe3, 0xed, 0xb4,
-	0x7f, 0x32, 0xe0, 0xd2, 0x2e, 0x56, 0xee, 0x93, 0x90, 0x9b, 0x01, 0xeb, 0x47, 0x12, 0xb6, 0x04,
-	0xf8, 0xa3, 0x16, 0x49, 0x7a, 0xf0, 0x7d, 0xb6, 0x11, 0x29, 0x03, 0x1f, 0x2a, 0x43, 0x27, 0x05,
-	0x2f, 0x76, 0x7e, 0x15, 0x5c, 0xeb, 0x9b, 0x9c, 0x57, 0x8a, 0xbf, 0xa5, 0x01, 0x47, 0xac, 0x4d,
-	0x28, 0x59, 0x50, 0x40, 0xc8, 0x5f, 0xbc, 0x01, 0x54, 0x60, 0x11, 0x64, 0x70, 0xfd, 0x18, 0x6a,
-	0x92, 0xe8, 0x15, 0x22, 0x6d, 0xbf, 0xe8, 0x68, 0x9e, 0x94, 0x5f, 0xf3, 0x74, 0x1a, 0x4a, 0x6c,
-	0x98, 0x48, 0xa3, 0xdd, 0x93, 0xb4, 0x19, 0x27, 0x3c, 0xec, 0x8e, 0x89, 0xb4, 0xb8, 0xd6, 0x1e,
-	0x3d, 0xdb, 0xd6, 0x1e, 0x4b, 0xd6, 0xda, 0xe9, 0xa4, 0xad, 0x9d, 0xe9, 0xd2, 0xda, 0x21, 0x8d,
-	0x04, 0xce, 0x4e, 0x23, 0x65, 0x3f, 0x55, 0x23, 0x8d, 0x7f, 0x91, 0x1a, 0x69, 0xe2, 0xac, 0x34,
-	0xd2, 0xe4, 0x17, 0xa7, 0x91, 0xa6, 0x3e, 0x49, 0x23, 0x6d, 0x46, 0x18, 0xe8, 0x1b, 0x61, 0x06,
-	0x8a, 0x63, 0x0e, 0xbe, 0x0a, 0x8a, 0xdd, 0xc6, 0x3c, 0x21, 0x64, 0xb3, 0x1d, 0xe3, 0xb2, 0x1d,
-	0xff, 0x72, 0x18, 0x14, 0x22, 0x04, 0xfe, 0xed, 0x60, 0x53, 0xfd, 0xbf, 0x09, 0x80, 0x04, 0x05,
-	0x76, 0xea, 0x11, 0x2c, 0x04, 0x7f, 0x0d, 0x5c, 0xed, 0x53, 0x2b, 0x8f, 0xe2, 0xff, 0xcc, 0x80,
-	0x59, 0xaa, 0xbc, 0xda, 0xe8, 0x09, 0x1c, 0x2c, 0xbd, 0x87, 0x0f, 0xb8, 0x6a, 0x24, 0xfb, 0x62,
-	0x44, 0x48, 0x86, 0xa2, 0xe6, 0xf3, 0x60, 0x29, 0xee, 0xbd, 0x97, 0xee, 0xdf, 0x47, 0xc0, 0xb2,
-	0xb7, 0xf7, 0xf6, 0x4c, 0xef, 0xda, 0xc0, 0x35, 0x3c, 0x80, 0x18, 0x93, 0x0c, 0x06, 0x99, 0x7d,
-	0x86, 0x6e, 0x25, 0x47, 0x55, 0xc1, 0x2e, 0x9f, 0xfe, 0xe7, 0xed, 0xc1, 0xe0, 0x77, 0xff, 0x26,
-	0x70, 0x44, 0x53, 0x14, 0x65, 0x0b, 0xc7, 0x39, 0x77, 0x38, 0x88, 0xbb, 0x0e, 0x66, 0x25, 0x05,
-	0xea, 0x56, 0x18, 0x64, 0xcb, 0x48, 0x96, 0x8e, 0x05, 0x11, 0x77, 0xc1, 0xe2, 0x53, 0xa9, 0xd9,
-	0x84, 0x96, 0x18, 0x0b, 0xb4, 0xcf, 0x32, 0xce, 0x36, 0xb9, 0x17, 0x85, 0x5f, 0x00, 0xa3, 0x01,
-	0x4d, 0xe9, 0x3c, 0x6d, 0xdd, 0x8a, 0x2c, 0xf9, 0xe5, 0x58, 0x46, 0xf1, 0xaf, 0x1c, 0x59, 0x32,
-	0xfe, 0x2e, 0x58, 0x4d, 0xb0, 0xac, 0x31, 0xec, 0x42, 0x8b, 0xcd, 0xff, 0xc2, 0x61, 0x97, 0xa6,
-	0x84, 0x8f, 0x7d, 0xb0, 0x43, 0xb3, 0x85, 0xad, 0x6d, 0x68, 0x20, 0xac, 0x7e, 0x01, 0x0d, 0x41,
-	0xaa, 0x26, 0x69, 0x54, 0x21, 0xdb, 0x7b, 0xc0, 0x79, 0x22, 0xef, 0x4d, 0x28, 0x61, 0xa4, 0x3b,
-	0x37, 0x3e, 0xce, 0x53, 0x12, 0xa1, 0x1c, 0x4e, 0x78, 0xdb, 0xe5, 0x8e, 0x1e, 0x95, 0xf0, 0x9a,
-	0xe9, 0xdf, 0x0c, 0x15, 0xd5, 0x02, 0x34, 0xa4, 0x13, 0x7f, 0xb1, 0x09, 0x16, 0xca, 0xff, 0x1b,
-	0xc5, 0x4b, 0xf4, 0xb9, 0xea, 0xe4, 0xe7, 0x26, 0xb6, 0xcd, 0x5f, 0x07, 0xe5, 0x64, 0x89, 0x7b,
-	0xb5, 0xfa, 0x6d, 0x86, 0x4a, 0xe9, 0x03, 0xd8, 0x6c, 0xd8, 0xbb, 0x74, 0xb0, 0x5c, 0x3b, 0xf0,
-	0xeb, 0xc8, 0xb0, 0x34, 0x1f, 0x3d, 0x53, 0x69, 0x3e, 0xf6, 0x09, 0xd2, 0x7c, 0xb5, 0xdb, 0xa5,
-	0x64, 0x9c, 0x98, 0x8e, 0xd1, 0xf1, 0x99, 0x58, 0x1d, 0xff, 0xf9, 0x54, 0xf7, 0xf4, 0x57, 0xd5,
-	0xfd, 0x55, 0x75, 0x87, 0x44, 0x61, 0x0c, 0xc9, 0x3c, 0x4c, 0xa5, 0xb3, 0xd3, 0xe3, 0xbd, 0x2e,
-	0x27, 0x6d, 0x59, 0x1e, 0x0b, 0xee, 0x26, 0xcb, 0xab, 0x7f, 0xca, 0x82, 0x91, 0x5d, 0xac, 0xb0,
-	0x87, 0x60, 0x3c, 0xf0, 0xb3, 0x51, 0x88, 0x51, 0x42, 0xbf, 0xd0, 0xe4, 0x2e, 0xf7, 0x1c, 0xf6,
-	0xbc, 0x35, 0x00, 0x1b, 0xf3, 0xe3, 0xcd, 0x72, 0x04, 0x1c, 0x35, 0xca, 0xad, 0x26, 0x30, 0xf2,
-	0xfb, 0x89, 0xb9, 0x4a, 0x8d, 0xfa, 0x89, 0x1a, 0xc5, 0xf8, 0xe9, 0x7e, 0x89, 0xc9, 0xfe, 0x94,
-	0x01, 0x8b, 0xbd, 0x6e, 0x30, 0xbf, 0x19, 0x0d, 0xba, 0xbb, 0x75, 0x6e, 0xe3, 0x34, 0xd6, 0x5e,
-	0x0c, 0xcf, 0x19, 0x90, 0xef, 0x73, 0xb7, 0x55, 0x89, 0x4c, 0xdc, 0x1b, 0x90, 0xbb, 0x75, 0x4a,
-	0x80, 0x17, 0x0c, 0x02, 0x73, 0xf1, 0x97, 0x4b, 0x57, 0xa2, 0x33, 0xc6, 0xd9, 0xe5, 0xca, 0xc9,
-	0xec, 0x3c, 0x87, 0x3f, 0x67, 0xc0, 0x52, 0xcf, 0x6f, 0xc8, 0xb5, 0x3e, 0x45, 0x0d, 0x9a, 0xe7,
-	0x6e, 0x9e, 0xca, 0xdc, 0x0b, 0xa3, 0x0e, 0x66, 0xa2, 0x5f, 0x5c, 0x7c, 0xcc, 0x56, 0x0a, 0xd9,
-	0xe4, 0x56, 0xfa, 0xdb, 0x78, 0x4e, 0x5e, 0x30, 0xa0, 0xd8, 0xf7, 0x43, 0x67, 0xbd, 0x4b, 0x01,
-	0xbb, 0x43, 0x72, 0xb7, 0x4f, 0x0d, 0x09, 0x96, 0xbf, 0x97, 0xc8, 0x8e, 0x29, 0x7f, 0x0f, 0xf3,
-	0xb8, 0xf2, 0x27, 0x10, 0xae, 0xe4, 0xe0, 0x58, 0x4e, 0xa2, 0x5a, 0x37, 0x62, 0xaa, 0xdd, 0x17,
-	0x95, 0xbb, 0xf3, 0xdf, 0xa0, 0xfc, 0x2d, 0x11, 0x2f, 0x12, 0xaf, 0xc4, 0x6c, 0xb5, 0x18, 0xbb,
-	0x98, 0x96, 0xe8, 0x49, 0xe9, 0xb9, 0x73, 0x3f, 0x21, 0xc7, 0x59, 0xad, 0xf6, 0xfa, 0x7d, 0x9e,
-	0x79, 0xf3, 0x3e, 0xcf, 0xfc, 0xeb, 0x7d, 0x9e, 0x79, 0xf1, 0x21, 0x3f, 0xf4, 0xe6, 0x43, 0x7e,
-	0xe8, 0x1f, 0x1f, 0xf2, 0x43, 0x3f, 0xf4, 0x9f, 0x8b, 0xf6, 0xd4, 0x6b, 0x4d, 0xe9, 0x08, 0x57,
-	0xfc, 0xa7, 0x0e, 0x3d, 0x1d, 0x8f, 0x46, 0xe9, 0x51, 0x76, 0xe3, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x2f, 0x51, 0xf0, 0xd6, 0x61, 0x21, 0x00, 0x00,
+func (m *MsgProposeParticipantFeeChange) Reset()         { *m = MsgProposeParticipantFeeChange{} }
+func (m *MsgProposeParticipantFeeChange) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeParticipantFeeChange) ProtoMessage()    {}
+func (*MsgProposeParticipantFeeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{24}
+}
+func (m *MsgProposeParticipantFeeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeParticipantFeeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeParticipantFeeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeParticipantFeeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeParticipantFeeChange.Merge(m, src)
+}
+func (m *MsgProposeParticipantFeeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeParticipantFeeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeParticipantFeeChange.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgProposeParticipantFeeChange proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	StartParticipantOP(ctx context.Context, in *MsgStartParticipantOP, opts ...grpc.CallOption) (*MsgStartParticipantOPResponse, error)
-	RenewParticipantOP(ctx context.Context, in *MsgRenewParticipantOP, opts ...grpc.CallOption) (*MsgRenewParticipantOPResponse, error)
-	SetParticipantOPToValidated(ctx context.Context, in *MsgSetParticipantOPToValidated, opts ...grpc.CallOption) (*MsgSetParticipantOPToValidatedResponse, error)
-	CancelParticipantOPLastRequest(ctx context.Context, in *MsgCancelParticipantOPLastRequest, opts ...grpc.CallOption) (*MsgCancelParticipantOPLastRequestResponse, error)
-	CreateRootParticipant(ctx context.Context, in *MsgCreateRootParticipant, opts ...grpc.CallOption) (*MsgCreateRootParticipantResponse, error)
-	SetParticipantEffectiveUntil(ctx context.Context, in *MsgSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgSetParticipantEffectiveUntilResponse, error)
-	RevokeParticipant(ctx context.Context, in *MsgRevokeParticipant, opts ...grpc.CallOption) (*MsgRevokeParticipantResponse, error)
-	CreateOrUpdateParticipantSession(ctx context.Context, in *MsgCreateOrUpdateParticipantSession, opts ...grpc.CallOption) (*MsgCreateOrUpdateParticipantSessionResponse, error)
-	SlashParticipantTrustDeposit(ctx context.Context, in *MsgSlashParticipantTrustDeposit, opts ...grpc.CallOption) (*MsgSlashParticipantTrustDepositResponse, error)
-	RepayParticipantSlashedTrustDeposit(ctx context.Context, in *MsgRepayParticipantSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayParticipantSlashedTrustDepositResponse, error)
-	// [MOD-PP-MSG-14] Self Create Participant (OPEN mode)
-	SelfCreateParticipant(ctx context.Context, in *MsgSelfCreateParticipant, opts ...grpc.CallOption) (*MsgSelfCreateParticipantResponse, error)
+func (m *MsgProposeParticipantFeeChange) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgProposeParticipantFeeChange) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgProposeParticipantFeeChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeParticipantFeeChange) GetValidationFees() uint64 {
+	if m != nil {
+		return m.ValidationFees
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) StartParticipantOP(ctx context.Context, in *MsgStartParticipantOP, opts ...grpc.CallOption) (*MsgStartParticipantOPResponse, error) {
-	out := new(MsgStartParticipantOPResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/StartParticipantOP", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeParticipantFeeChange) GetIssuanceFees() uint64 {
+	if m != nil {
+		return m.IssuanceFees
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RenewParticipantOP(ctx context.Context, in *MsgRenewParticipantOP, opts ...grpc.CallOption) (*MsgRenewParticipantOPResponse, error) {
-	out := new(MsgRenewParticipantOPResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/RenewParticipantOP", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeParticipantFeeChange) GetVerificationFees() uint64 {
+	if m != nil {
+		return m.VerificationFees
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SetParticipantOPToValidated(ctx context.Context, in *MsgSetParticipantOPToValidated, opts ...grpc.CallOption) (*MsgSetParticipantOPToValidatedResponse, error) {
-	out := new(MsgSetParticipantOPToValidatedResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SetParticipantOPToValidated", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeParticipantFeeChange) GetIssuanceFeeDiscount() uint64 {
+	if m != nil {
+		return m.IssuanceFeeDiscount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CancelParticipantOPLastRequest(ctx context.Context, in *MsgCancelParticipantOPLastRequest, opts ...grpc.CallOption) (*MsgCancelParticipantOPLastRequestResponse, error) {
-	out := new(MsgCancelParticipantOPLastRequestResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/CancelParticipantOPLastRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeParticipantFeeChange) GetVerificationFeeDiscount() uint64 {
+	if m != nil {
+		return m.VerificationFeeDiscount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateRootParticipant(ctx context.Context, in *MsgCreateRootParticipant, opts ...grpc.CallOption) (*MsgCreateRootParticipantResponse, error) {
-	out := new(MsgCreateRootParticipantResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/CreateRootParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgProposeParticipantFeeChangeResponse struct {
 }
 
-func (c *msgClient) SetParticipantEffectiveUntil(ctx context.Context, in *MsgSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgSetParticipantEffectiveUntilResponse, error) {
-	out := new(MsgSetParticipantEffectiveUntilResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SetParticipantEffectiveUntil", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeParticipantFeeChangeResponse) Reset() {
+	*m = MsgProposeParticipantFeeChangeResponse{}
+}
+func (m *MsgProposeParticipantFeeChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeParticipantFeeChangeResponse) ProtoMessage()    {}
+func (*MsgProposeParticipantFeeChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{25}
+}
+func (m *MsgProposeParticipantFeeChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeParticipantFeeChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeParticipantFeeChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgProposeParticipantFeeChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeParticipantFeeChangeResponse.Merge(m, src)
+}
+func (m *MsgProposeParticipantFeeChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeParticipantFeeChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeParticipantFeeChangeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RevokeParticipant(ctx context.Context, in *MsgRevokeParticipant, opts ...grpc.CallOption) (*MsgRevokeParticipantResponse, error) {
-	out := new(MsgRevokeParticipantResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/RevokeParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProposeParticipantFeeChangeResponse proto.InternalMessageInfo
+
+type MsgAcceptParticipantFeeChange struct {
+	// corporation is the participant corporation
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgAcceptParticipantFeeChange) Reset()         { *m = MsgAcceptParticipantFeeChange{} }
+func (m *MsgAcceptParticipantFeeChange) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptParticipantFeeChange) ProtoMessage()    {}
+func (*MsgAcceptParticipantFeeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{26}
+}
+func (m *MsgAcceptParticipantFeeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptParticipantFeeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptParticipantFeeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAcceptParticipantFeeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptParticipantFeeChange.Merge(m, src)
+}
+func (m *MsgAcceptParticipantFeeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptParticipantFeeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptParticipantFeeChange.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateOrUpdateParticipantSession(ctx context.Context, in *MsgCreateOrUpdateParticipantSession, opts ...grpc.CallOption) (*MsgCreateOrUpdateParticipantSessionResponse, error) {
-	out := new(MsgCreateOrUpdateParticipantSessionResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/CreateOrUpdateParticipantSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptParticipantFeeChange proto.InternalMessageInfo
+
+func (m *MsgAcceptParticipantFeeChange) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SlashParticipantTrustDeposit(ctx context.Context, in *MsgSlashParticipantTrustDeposit, opts ...grpc.CallOption) (*MsgSlashParticipantTrustDepositResponse, error) {
-	out := new(MsgSlashParticipantTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SlashParticipantTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptParticipantFeeChange) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RepayParticipantSlashedTrustDeposit(ctx context.Context, in *MsgRepayParticipantSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayParticipantSlashedTrustDepositResponse, error) {
-	out := new(MsgRepayParticipantSlashedTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/RepayParticipantSlashedTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptParticipantFeeChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SelfCreateParticipant(ctx context.Context, in *MsgSelfCreateParticipant, opts ...grpc.CallOption) (*MsgSelfCreateParticipantResponse, error) {
-	out := new(MsgSelfCreateParticipantResponse)
-	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SelfCreateParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAcceptParticipantFeeChangeResponse struct {
+	Effective time.Time `protobuf:"bytes,1,opt,name=effective,proto3,stdtime" json:"effective"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	StartParticipantOP(context.Context, *MsgStartParticipantOP) (*MsgStartParticipantOPResponse, error)
-	RenewParticipantOP(context.Context, *MsgRenewParticipantOP) (*MsgRenewParticipantOPResponse, error)
-	SetParticipantOPToValidated(context.Context, *MsgSetParticipantOPToValidated) (*MsgSetParticipantOPToValidatedResponse, error)
-	CancelParticipantOPLastRequest(context.Context, *MsgCancelParticipantOPLastRequest) (*MsgCancelParticipantOPLastRequestResponse, error)
-	CreateRootParticipant(context.Context, *MsgCreateRootParticipant) (*MsgCreateRootParticipantResponse, error)
-	SetParticipantEffectiveUntil(context.Context, *MsgSetParticipantEffectiveUntil) (*MsgSetParticipantEffectiveUntilResponse, error)
-	RevokeParticipant(context.Context, *MsgRevokeParticipant) (*MsgRevokeParticipantResponse, error)
-	CreateOrUpdateParticipantSession(context.Context, *MsgCreateOrUpdateParticipantSession) (*MsgCreateOrUpdateParticipantSessionResponse, error)
-	SlashParticipantTrustDeposit(context.Context, *MsgSlashParticipantTrustDeposit) (*MsgSlashParticipantTrustDepositResponse, error)
-	RepayParticipantSlashedTrustDeposit(context.Context, *MsgRepayParticipantSlashedTrustDeposit) (*MsgRepayParticipantSlashedTrustDepositResponse, error)
-	// [MOD-PP-MSG-14] Self Create Participant (OPEN mode)
-	SelfCreateParticipant(context.Context, *MsgSelfCreateParticipant) (*MsgSelfCreateParticipantResponse, error)
+func (m *MsgAcceptParticipantFeeChangeResponse) Reset()         { *m = MsgAcceptParticipantFeeChangeResponse{} }
+func (m *MsgAcceptParticipantFeeChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptParticipantFeeChangeResponse) ProtoMessage()    {}
+func (*MsgAcceptParticipantFeeChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{27}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgAcceptParticipantFeeChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAcceptParticipantFeeChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptParticipantFeeChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) StartParticipantOP(ctx context.Context, req *MsgStartParticipantOP) (*MsgStartParticipantOPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartParticipantOP not implemented")
+func (m *MsgAcceptParticipantFeeChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptParticipantFeeChangeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RenewParticipantOP(ctx context.Context, req *MsgRenewParticipantOP) (*MsgRenewParticipantOPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewParticipantOP not implemented")
+func (m *MsgAcceptParticipantFeeChangeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetParticipantOPToValidated(ctx context.Context, req *MsgSetParticipantOPToValidated) (*MsgSetParticipantOPToValidatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetParticipantOPToValidated not implemented")
+func (m *MsgAcceptParticipantFeeChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptParticipantFeeChangeResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CancelParticipantOPLastRequest(ctx context.Context, req *MsgCancelParticipantOPLastRequest) (*MsgCancelParticipantOPLastRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelParticipantOPLastRequest not implemented")
+
+var xxx_messageInfo_MsgAcceptParticipantFeeChangeResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptParticipantFeeChangeResponse) GetEffective() time.Time {
+	if m != nil {
+		return m.Effective
+	}
+	return time.Time{}
 }
-func (*UnimplementedMsgServer) CreateRootParticipant(ctx context.Context, req *MsgCreateRootParticipant) (*MsgCreateRootParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRootParticipant not implemented")
+
+type MsgAppealSlash struct {
+	// corporation is the slashed participant corporation
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	SlashId     uint64 `protobuf:"varint,4,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	Reason      string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// evidence_digest is the optional SRI digest of the appeal evidence,
+	// anchored in x/di.
+	EvidenceDigest string `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
 }
-func (*UnimplementedMsgServer) SetParticipantEffectiveUntil(ctx context.Context, req *MsgSetParticipantEffectiveUntil) (*MsgSetParticipantEffectiveUntilResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetParticipantEffectiveUntil not implemented")
+
+func (m *MsgAppealSlash) Reset()         { *m = MsgAppealSlash{} }
+func (m *MsgAppealSlash) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlash) ProtoMessage()    {}
+func (*MsgAppealSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{28}
 }
-func (*UnimplementedMsgServer) RevokeParticipant(ctx context.Context, req *MsgRevokeParticipant) (*MsgRevokeParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeParticipant not implemented")
+func (m *MsgAppealSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateOrUpdateParticipantSession(ctx context.Context, req *MsgCreateOrUpdateParticipantSession) (*MsgCreateOrUpdateParticipantSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateParticipantSession not implemented")
+func (m *MsgAppealSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SlashParticipantTrustDeposit(ctx context.Context, req *MsgSlashParticipantTrustDeposit) (*MsgSlashParticipantTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashParticipantTrustDeposit not implemented")
+func (m *MsgAppealSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlash.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RepayParticipantSlashedTrustDeposit(ctx context.Context, req *MsgRepayParticipantSlashedTrustDeposit) (*MsgRepayParticipantSlashedTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepayParticipantSlashedTrustDeposit not implemented")
+func (m *MsgAppealSlash) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SelfCreateParticipant(ctx context.Context, req *MsgSelfCreateParticipant) (*MsgSelfCreateParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelfCreateParticipant not implemented")
+func (m *MsgAppealSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlash.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgAppealSlash proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgAppealSlash) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_StartParticipantOP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartParticipantOP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartParticipantOP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/StartParticipantOP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartParticipantOP(ctx, req.(*MsgStartParticipantOP))
+func (m *MsgAppealSlash) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RenewParticipantOP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRenewParticipantOP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RenewParticipantOP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/RenewParticipantOP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RenewParticipantOP(ctx, req.(*MsgRenewParticipantOP))
+func (m *MsgAppealSlash) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetParticipantOPToValidated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetParticipantOPToValidated)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetParticipantOPToValidated(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/SetParticipantOPToValidated",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetParticipantOPToValidated(ctx, req.(*MsgSetParticipantOPToValidated))
+func (m *MsgAppealSlash) GetSlashId() uint64 {
+	if m != nil {
+		return m.SlashId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CancelParticipantOPLastRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelParticipantOPLastRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelParticipantOPLastRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/CancelParticipantOPLastRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelParticipantOPLastRequest(ctx, req.(*MsgCancelParticipantOPLastRequest))
+func (m *MsgAppealSlash) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateRootParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRootParticipant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRootParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/CreateRootParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRootParticipant(ctx, req.(*MsgCreateRootParticipant))
+func (m *MsgAppealSlash) GetEvidenceDigest() string {
+	if m != nil {
+		return m.EvidenceDigest
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetParticipantEffectiveUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetParticipantEffectiveUntil)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetParticipantEffectiveUntil(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/SetParticipantEffectiveUntil",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetParticipantEffectiveUntil(ctx, req.(*MsgSetParticipantEffectiveUntil))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgAppealSlashResponse struct {
+	// arbiter is the account that resolves the appeal
+	Arbiter string `protobuf:"bytes,1,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
 }
 
-func _Msg_RevokeParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeParticipant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/RevokeParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeParticipant(ctx, req.(*MsgRevokeParticipant))
+func (m *MsgAppealSlashResponse) Reset()         { *m = MsgAppealSlashResponse{} }
+func (m *MsgAppealSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlashResponse) ProtoMessage()    {}
+func (*MsgAppealSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{29}
+}
+func (m *MsgAppealSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAppealSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlashResponse.Merge(m, src)
+}
+func (m *MsgAppealSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlashResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateOrUpdateParticipantSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateOrUpdateParticipantSession)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateOrUpdateParticipantSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/CreateOrUpdateParticipantSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateOrUpdateParticipantSession(ctx, req.(*MsgCreateOrUpdateParticipantSession))
+var xxx_messageInfo_MsgAppealSlashResponse proto.InternalMessageInfo
+
+func (m *MsgAppealSlashResponse) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SlashParticipantTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSlashParticipantTrustDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SlashParticipantTrustDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/SlashParticipantTrustDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SlashParticipantTrustDeposit(ctx, req.(*MsgSlashParticipantTrustDeposit))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgResolveSlashAppeal struct {
+	// authority MUST be the arbiter recorded on the appealed slash
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	SlashId   uint64 `protobuf:"varint,3,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	// reverse restores the slashed amount to the participant deposit, else
+	// the slash is confirmed and the slashed amount burnt.
+	Reverse bool `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
 }
 
-func _Msg_RepayParticipantSlashedTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayParticipantSlashedTrustDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RepayParticipantSlashedTrustDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/RepayParticipantSlashedTrustDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepayParticipantSlashedTrustDeposit(ctx, req.(*MsgRepayParticipantSlashedTrustDeposit))
+func (m *MsgResolveSlashAppeal) Reset()         { *m = MsgResolveSlashAppeal{} }
+func (m *MsgResolveSlashAppeal) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveSlashAppeal) ProtoMessage()    {}
+func (*MsgResolveSlashAppeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{30}
+}
+func (m *MsgResolveSlashAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveSlashAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveSlashAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgResolveSlashAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveSlashAppeal.Merge(m, src)
+}
+func (m *MsgResolveSlashAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveSlashAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveSlashAppeal.DiscardUnknown(m)
 }
 
-func _Msg_SelfCreateParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSelfCreateParticipant)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResolveSlashAppeal proto.InternalMessageInfo
+
+func (m *MsgResolveSlashAppeal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SelfCreateParticipant(ctx, in)
+	return ""
+}
+
+func (m *MsgResolveSlashAppeal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.pp.v1.Msg/SelfCreateParticipant",
+	return 0
+}
+
+func (m *MsgResolveSlashAppeal) GetSlashId() uint64 {
+	if m != nil {
+		return m.SlashId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SelfCreateParticipant(ctx, req.(*MsgSelfCreateParticipant))
+	return 0
+}
+
+func (m *MsgResolveSlashAppeal) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.pp.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "StartParticipantOP",
-			Handler:    _Msg_StartParticipantOP_Handler,
-		},
-		{
-			MethodName: "RenewParticipantOP",
-			Handler:    _Msg_RenewParticipantOP_Handler,
-		},
-		{
-			MethodName: "SetParticipantOPToValidated",
-			Handler:    _Msg_SetParticipantOPToValidated_Handler,
-		},
-		{
-			MethodName: "CancelParticipantOPLastRequest",
-			Handler:    _Msg_CancelParticipantOPLastRequest_Handler,
-		},
-		{
-			MethodName: "CreateRootParticipant",
-			Handler:    _Msg_CreateRootParticipant_Handler,
-		},
-		{
-			MethodName: "SetParticipantEffectiveUntil",
-			Handler:    _Msg_SetParticipantEffectiveUntil_Handler,
-		},
-		{
-			MethodName: "RevokeParticipant",
-			Handler:    _Msg_RevokeParticipant_Handler,
-		},
-		{
-			MethodName: "CreateOrUpdateParticipantSession",
-			Handler:    _Msg_CreateOrUpdateParticipantSession_Handler,
-		},
-		{
-			MethodName: "SlashParticipantTrustDeposit",
-			Handler:    _Msg_SlashParticipantTrustDeposit_Handler,
-		},
-		{
-			MethodName: "RepayParticipantSlashedTrustDeposit",
-			Handler:    _Msg_RepayParticipantSlashedTrustDeposit_Handler,
-		},
-		{
-			MethodName: "SelfCreateParticipant",
-			Handler:    _Msg_SelfCreateParticipant_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/pp/v1/tx.proto",
+type MsgResolveSlashAppealResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveSlashAppealResponse) Reset()         { *m = MsgResolveSlashAppealResponse{} }
+func (m *MsgResolveSlashAppealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveSlashAppealResponse) ProtoMessage()    {}
+func (*MsgResolveSlashAppealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{31}
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveSlashAppealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveSlashAppealResponse.Merge(m, src)
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveSlashAppealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveSlashAppealResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgResolveSlashAppealResponse proto.InternalMessageInfo
+
+type MsgSuspendParticipant struct {
+	// corporation is a validator ancestor corporation or the ecosystem
+	// controller
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSuspendParticipant) Reset()         { *m = MsgSuspendParticipant{} }
+func (m *MsgSuspendParticipant) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendParticipant) ProtoMessage()    {}
+func (*MsgSuspendParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{32}
+}
+func (m *MsgSuspendParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSuspendParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendParticipant.Merge(m, src)
+}
+func (m *MsgSuspendParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendParticipant.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSuspendParticipant proto.InternalMessageInfo
+
+func (m *MsgSuspendParticipant) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSuspendParticipant) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSuspendParticipant) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgStartParticipantOP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSuspendParticipant) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStartParticipantOP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSuspendParticipantResponse struct {
 }
 
-func (m *MsgStartParticipantOP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VsOperatorAuthzMsgTypes) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VsOperatorAuthzMsgTypes[iNdEx])
-			copy(dAtA[i:], m.VsOperatorAuthzMsgTypes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperatorAuthzMsgTypes[iNdEx])))
-			i--
-			dAtA[i] = 0x7a
+func (m *MsgSuspendParticipantResponse) Reset()         { *m = MsgSuspendParticipantResponse{} }
+func (m *MsgSuspendParticipantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendParticipantResponse) ProtoMessage()    {}
+func (*MsgSuspendParticipantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{33}
+}
+func (m *MsgSuspendParticipantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendParticipantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendParticipantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.VsOperatorAuthzPeriod != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VsOperatorAuthzPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintTx(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzFeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VsOperatorAuthzFeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if m.VsOperatorAuthzWithFeegrant {
-		i--
-		if m.VsOperatorAuthzWithFeegrant {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.VsOperatorAuthzSpendLimit) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VsOperatorAuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if len(m.VsOperator) > 0 {
-		i -= len(m.VsOperator)
-		copy(dAtA[i:], m.VsOperator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperator)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.VerificationFees != nil {
-		{
-			size, err := m.VerificationFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.IssuanceFees != nil {
-		{
-			size, err := m.IssuanceFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ValidationFees != nil {
-		{
-			size, err := m.ValidationFees.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ValidatorParticipantId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorParticipantId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgStartParticipantOPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSuspendParticipantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendParticipantResponse.Merge(m, src)
+}
+func (m *MsgSuspendParticipantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendParticipantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendParticipantResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStartParticipantOPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSuspendParticipantResponse proto.InternalMessageInfo
+
+type MsgUnsuspendParticipant struct {
+	// corporation is a validator ancestor corporation or the ecosystem
+	// controller
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgStartParticipantOPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ParticipantId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ParticipantId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgUnsuspendParticipant) Reset()         { *m = MsgUnsuspendParticipant{} }
+func (m *MsgUnsuspendParticipant) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsuspendParticipant) ProtoMessage()    {}
+func (*MsgUnsuspendParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{34}
+}
+func (m *MsgUnsuspendParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsuspendParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsuspendParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnsuspendParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsuspendParticipant.Merge(m, src)
+}
+func (m *MsgUnsuspendParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsuspendParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsuspendParticipant.DiscardUnknown(m)
 }
 
-func (m *MsgRenewParticipantOP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnsuspendParticipant proto.InternalMessageInfo
+
+func (m *MsgUnsuspendParticipant) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRenewParticipantOP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnsuspendParticipant) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *MsgRenewParticipantOP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUnsuspendParticipant) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgRenewParticipantOPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnsuspendParticipant) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRenewParticipantOPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUnsuspendParticipantResponse struct {
 }
 
-func (m *MsgRenewParticipantOPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUnsuspendParticipantResponse) Reset()         { *m = MsgUnsuspendParticipantResponse{} }
+func (m *MsgUnsuspendParticipantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsuspendParticipantResponse) ProtoMessage()    {}
+func (*MsgUnsuspendParticipantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{35}
 }
-
-func (m *MsgSetParticipantOPToValidated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnsuspendParticipantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsuspendParticipantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsuspendParticipantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetParticipantOPToValidated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnsuspendParticipantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsuspendParticipantResponse.Merge(m, src)
 }
-
-func (m *MsgSetParticipantOPToValidated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VerificationFeeDiscount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFeeDiscount))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.IssuanceFeeDiscount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFeeDiscount))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.OpSummaryDigest) > 0 {
-		i -= len(m.OpSummaryDigest)
-		copy(dAtA[i:], m.OpSummaryDigest)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OpSummaryDigest)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.VerificationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.IssuanceFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ValidationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.EffectiveUntil != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err6 != nil {
-			return 0, err6
+func (m *MsgUnsuspendParticipantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsuspendParticipantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsuspendParticipantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnsuspendParticipantResponse proto.InternalMessageInfo
+
+type MsgRotateParticipantDID struct {
+	// corporation is the corporation of the participant
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	NewDid      string `protobuf:"bytes,4,opt,name=new_did,json=newDid,proto3" json:"new_did,omitempty"`
+}
+
+func (m *MsgRotateParticipantDID) Reset()         { *m = MsgRotateParticipantDID{} }
+func (m *MsgRotateParticipantDID) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateParticipantDID) ProtoMessage()    {}
+func (*MsgRotateParticipantDID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{36}
+}
+func (m *MsgRotateParticipantDID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateParticipantDID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateParticipantDID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n6
-		i = encodeVarintTx(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgRotateParticipantDID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateParticipantDID.Merge(m, src)
+}
+func (m *MsgRotateParticipantDID) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateParticipantDID) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateParticipantDID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateParticipantDID proto.InternalMessageInfo
+
+func (m *MsgRotateParticipantDID) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRotateParticipantDID) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetParticipantOPToValidatedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateParticipantDID) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetParticipantOPToValidatedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateParticipantDID) GetNewDid() string {
+	if m != nil {
+		return m.NewDid
+	}
+	return ""
 }
 
-func (m *MsgSetParticipantOPToValidatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRotateParticipantDIDResponse struct {
 }
 
-func (m *MsgCancelParticipantOPLastRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateParticipantDIDResponse) Reset()         { *m = MsgRotateParticipantDIDResponse{} }
+func (m *MsgRotateParticipantDIDResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateParticipantDIDResponse) ProtoMessage()    {}
+func (*MsgRotateParticipantDIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{37}
+}
+func (m *MsgRotateParticipantDIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateParticipantDIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateParticipantDIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRotateParticipantDIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateParticipantDIDResponse.Merge(m, src)
+}
+func (m *MsgRotateParticipantDIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateParticipantDIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateParticipantDIDResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelParticipantOPLastRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRotateParticipantDIDResponse proto.InternalMessageInfo
+
+type MsgBatchRevokeParticipants struct {
+	Corporation string                 `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Items       []MsgRevokeParticipant `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
 }
 
-func (m *MsgCancelParticipantOPLastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+func (m *MsgBatchRevokeParticipants) Reset()         { *m = MsgBatchRevokeParticipants{} }
+func (m *MsgBatchRevokeParticipants) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchRevokeParticipants) ProtoMessage()    {}
+func (*MsgBatchRevokeParticipants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{38}
+}
+func (m *MsgBatchRevokeParticipants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchRevokeParticipants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchRevokeParticipants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgBatchRevokeParticipants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchRevokeParticipants.Merge(m, src)
+}
+func (m *MsgBatchRevokeParticipants) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchRevokeParticipants) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchRevokeParticipants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchRevokeParticipants proto.InternalMessageInfo
+
+func (m *MsgBatchRevokeParticipants) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgBatchRevokeParticipants) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelParticipantOPLastRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchRevokeParticipants) GetItems() []MsgRevokeParticipant {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCancelParticipantOPLastRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchRevokeParticipantsResponse struct {
+	// results are the responses of the items, in order
+	Results []MsgRevokeParticipantResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgCancelParticipantOPLastRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBatchRevokeParticipantsResponse) Reset()         { *m = MsgBatchRevokeParticipantsResponse{} }
+func (m *MsgBatchRevokeParticipantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchRevokeParticipantsResponse) ProtoMessage()    {}
+func (*MsgBatchRevokeParticipantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{39}
+}
+func (m *MsgBatchRevokeParticipantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchRevokeParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchRevokeParticipantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchRevokeParticipantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchRevokeParticipantsResponse.Merge(m, src)
+}
+func (m *MsgBatchRevokeParticipantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchRevokeParticipantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchRevokeParticipantsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRootParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchRevokeParticipantsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchRevokeParticipantsResponse) GetResults() []MsgRevokeParticipantResponse {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateRootParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchSetParticipantEffectiveUntil struct {
+	Corporation string                            `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string                            `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Items       []MsgSetParticipantEffectiveUntil `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
 }
 
-func (m *MsgCreateRootParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VsOperatorAuthzPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VsOperatorAuthzPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintTx(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzFeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VsOperatorAuthzFeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if m.VsOperatorAuthzWithFeegrant {
-		i--
-		if m.VsOperatorAuthzWithFeegrant {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.VsOperatorAuthzSpendLimit) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VsOperatorAuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if len(m.VsOperatorAuthzMsgTypes) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VsOperatorAuthzMsgTypes[iNdEx])
-			copy(dAtA[i:], m.VsOperatorAuthzMsgTypes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperatorAuthzMsgTypes[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if len(m.VsOperator) > 0 {
-		i -= len(m.VsOperator)
-		copy(dAtA[i:], m.VsOperator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperator)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.VerificationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.IssuanceFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.ValidationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.EffectiveUntil != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintTx(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.EffectiveFrom != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
-		if err9 != nil {
-			return 0, err9
+func (m *MsgBatchSetParticipantEffectiveUntil) Reset()         { *m = MsgBatchSetParticipantEffectiveUntil{} }
+func (m *MsgBatchSetParticipantEffectiveUntil) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSetParticipantEffectiveUntil) ProtoMessage()    {}
+func (*MsgBatchSetParticipantEffectiveUntil) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{40}
+}
+func (m *MsgBatchSetParticipantEffectiveUntil) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSetParticipantEffectiveUntil) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSetParticipantEffectiveUntil.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n9
-		i = encodeVarintTx(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SchemaId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SchemaId))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgBatchSetParticipantEffectiveUntil) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSetParticipantEffectiveUntil.Merge(m, src)
+}
+func (m *MsgBatchSetParticipantEffectiveUntil) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSetParticipantEffectiveUntil) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSetParticipantEffectiveUntil.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchSetParticipantEffectiveUntil proto.InternalMessageInfo
+
+func (m *MsgBatchSetParticipantEffectiveUntil) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntil) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateRootParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchSetParticipantEffectiveUntil) GetItems() []MsgSetParticipantEffectiveUntil {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateRootParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchSetParticipantEffectiveUntilResponse struct {
+	// results are the responses of the items, in order
+	Results []MsgSetParticipantEffectiveUntilResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgCreateRootParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) Reset() {
+	*m = MsgBatchSetParticipantEffectiveUntilResponse{}
+}
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgBatchSetParticipantEffectiveUntilResponse) ProtoMessage() {}
+func (*MsgBatchSetParticipantEffectiveUntilResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{41}
+}
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSetParticipantEffectiveUntilResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSetParticipantEffectiveUntilResponse.Merge(m, src)
+}
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSetParticipantEffectiveUntilResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetParticipantEffectiveUntil) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchSetParticipantEffectiveUntilResponse proto.InternalMessageInfo
+
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) GetResults() []MsgSetParticipantEffectiveUntilResponse {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetParticipantEffectiveUntil) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchSelfCreateParticipants struct {
+	Corporation string                     `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Operator    string                     `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Items       []MsgSelfCreateParticipant `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
 }
 
-func (m *MsgSetParticipantEffectiveUntil) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EffectiveUntil != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err10 != nil {
-			return 0, err10
+func (m *MsgBatchSelfCreateParticipants) Reset()         { *m = MsgBatchSelfCreateParticipants{} }
+func (m *MsgBatchSelfCreateParticipants) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSelfCreateParticipants) ProtoMessage()    {}
+func (*MsgBatchSelfCreateParticipants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{42}
+}
+func (m *MsgBatchSelfCreateParticipants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSelfCreateParticipants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSelfCreateParticipants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n10
-		i = encodeVarintTx(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBatchSelfCreateParticipants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSelfCreateParticipants.Merge(m, src)
+}
+func (m *MsgBatchSelfCreateParticipants) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSelfCreateParticipants) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSelfCreateParticipants.DiscardUnknown(m)
 }
 
-func (m *MsgSetParticipantEffectiveUntilResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchSelfCreateParticipants proto.InternalMessageInfo
+
+func (m *MsgBatchSelfCreateParticipants) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetParticipantEffectiveUntilResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchSelfCreateParticipants) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *MsgSetParticipantEffectiveUntilResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRevokeParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchSelfCreateParticipants) GetItems() []MsgSelfCreateParticipant {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRevokeParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchSelfCreateParticipantsResponse struct {
+	// results are the responses of the items, in order
+	Results []MsgSelfCreateParticipantResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgRevokeParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBatchSelfCreateParticipantsResponse) Reset() {
+	*m = MsgBatchSelfCreateParticipantsResponse{}
+}
+func (m *MsgBatchSelfCreateParticipantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSelfCreateParticipantsResponse) ProtoMessage()    {}
+func (*MsgBatchSelfCreateParticipantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d881b5faa8c86, []int{43}
+}
+func (m *MsgBatchSelfCreateParticipantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSelfCreateParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSelfCreateParticipantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBatchSelfCreateParticipantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSelfCreateParticipantsResponse.Merge(m, src)
+}
+func (m *MsgBatchSelfCreateParticipantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSelfCreateParticipantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSelfCreateParticipantsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchSelfCreateParticipantsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchSelfCreateParticipantsResponse) GetResults() []MsgSelfCreateParticipantResponse {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRevokeParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "verana.pp.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.pp.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgStartParticipantOP)(nil), "verana.pp.v1.MsgStartParticipantOP")
+	proto.RegisterType((*MsgStartParticipantOPResponse)(nil), "verana.pp.v1.MsgStartParticipantOPResponse")
+	proto.RegisterType((*MsgRenewParticipantOP)(nil), "verana.pp.v1.MsgRenewParticipantOP")
+	proto.RegisterType((*MsgRenewParticipantOPResponse)(nil), "verana.pp.v1.MsgRenewParticipantOPResponse")
+	proto.RegisterType((*MsgSetParticipantOPToValidated)(nil), "verana.pp.v1.MsgSetParticipantOPToValidated")
+	proto.RegisterType((*MsgSetParticipantOPToValidatedResponse)(nil), "verana.pp.v1.MsgSetParticipantOPToValidatedResponse")
+	proto.RegisterType((*MsgCancelParticipantOPLastRequest)(nil), "verana.pp.v1.MsgCancelParticipantOPLastRequest")
+	proto.RegisterType((*MsgCancelParticipantOPLastRequestResponse)(nil), "verana.pp.v1.MsgCancelParticipantOPLastRequestResponse")
+	proto.RegisterType((*MsgCreateRootParticipant)(nil), "verana.pp.v1.MsgCreateRootParticipant")
+	proto.RegisterType((*MsgCreateRootParticipantResponse)(nil), "verana.pp.v1.MsgCreateRootParticipantResponse")
+	proto.RegisterType((*MsgSetParticipantEffectiveUntil)(nil), "verana.pp.v1.MsgSetParticipantEffectiveUntil")
+	proto.RegisterType((*MsgSetParticipantEffectiveUntilResponse)(nil), "verana.pp.v1.MsgSetParticipantEffectiveUntilResponse")
+	proto.RegisterType((*MsgRevokeParticipant)(nil), "verana.pp.v1.MsgRevokeParticipant")
+	proto.RegisterType((*MsgRevokeParticipantResponse)(nil), "verana.pp.v1.MsgRevokeParticipantResponse")
+	proto.RegisterType((*MsgCreateOrUpdateParticipantSession)(nil), "verana.pp.v1.MsgCreateOrUpdateParticipantSession")
+	proto.RegisterType((*MsgCreateOrUpdateParticipantSessionResponse)(nil), "verana.pp.v1.MsgCreateOrUpdateParticipantSessionResponse")
+	proto.RegisterType((*MsgSlashParticipantTrustDeposit)(nil), "verana.pp.v1.MsgSlashParticipantTrustDeposit")
+	proto.RegisterType((*MsgSlashParticipantTrustDepositResponse)(nil), "verana.pp.v1.MsgSlashParticipantTrustDepositResponse")
+	proto.RegisterType((*MsgRepayParticipantSlashedTrustDeposit)(nil), "verana.pp.v1.MsgRepayParticipantSlashedTrustDeposit")
+	proto.RegisterType((*MsgRepayParticipantSlashedTrustDepositResponse)(nil), "verana.pp.v1.MsgRepayParticipantSlashedTrustDepositResponse")
+	proto.RegisterType((*MsgSelfCreateParticipant)(nil), "verana.pp.v1.MsgSelfCreateParticipant")
+	proto.RegisterType((*MsgSelfCreateParticipantResponse)(nil), "verana.pp.v1.MsgSelfCreateParticipantResponse")
+	proto.RegisterType((*MsgProposeParticipantFeeChange)(nil), "verana.pp.v1.MsgProposeParticipantFeeChange")
+	proto.RegisterType((*MsgProposeParticipantFeeChangeResponse)(nil), "verana.pp.v1.MsgProposeParticipantFeeChangeResponse")
+	proto.RegisterType((*MsgAcceptParticipantFeeChange)(nil), "verana.pp.v1.MsgAcceptParticipantFeeChange")
+	proto.RegisterType((*MsgAcceptParticipantFeeChangeResponse)(nil), "verana.pp.v1.MsgAcceptParticipantFeeChangeResponse")
+	proto.RegisterType((*MsgAppealSlash)(nil), "verana.pp.v1.MsgAppealSlash")
+	proto.RegisterType((*MsgAppealSlashResponse)(nil), "verana.pp.v1.MsgAppealSlashResponse")
+	proto.RegisterType((*MsgResolveSlashAppeal)(nil), "verana.pp.v1.MsgResolveSlashAppeal")
+	proto.RegisterType((*MsgResolveSlashAppealResponse)(nil), "verana.pp.v1.MsgResolveSlashAppealResponse")
+	proto.RegisterType((*MsgSuspendParticipant)(nil), "verana.pp.v1.MsgSuspendParticipant")
+	proto.RegisterType((*MsgSuspendParticipantResponse)(nil), "verana.pp.v1.MsgSuspendParticipantResponse")
+	proto.RegisterType((*MsgUnsuspendParticipant)(nil), "verana.pp.v1.MsgUnsuspendParticipant")
+	proto.RegisterType((*MsgUnsuspendParticipantResponse)(nil), "verana.pp.v1.MsgUnsuspendParticipantResponse")
+	proto.RegisterType((*MsgRotateParticipantDID)(nil), "verana.pp.v1.MsgRotateParticipantDID")
+	proto.RegisterType((*MsgRotateParticipantDIDResponse)(nil), "verana.pp.v1.MsgRotateParticipantDIDResponse")
+	proto.RegisterType((*MsgBatchRevokeParticipants)(nil), "verana.pp.v1.MsgBatchRevokeParticipants")
+	proto.RegisterType((*MsgBatchRevokeParticipantsResponse)(nil), "verana.pp.v1.MsgBatchRevokeParticipantsResponse")
+	proto.RegisterType((*MsgBatchSetParticipantEffectiveUntil)(nil), "verana.pp.v1.MsgBatchSetParticipantEffectiveUntil")
+	proto.RegisterType((*MsgBatchSetParticipantEffectiveUntilResponse)(nil), "verana.pp.v1.MsgBatchSetParticipantEffectiveUntilResponse")
+	proto.RegisterType((*MsgBatchSelfCreateParticipants)(nil), "verana.pp.v1.MsgBatchSelfCreateParticipants")
+	proto.RegisterType((*MsgBatchSelfCreateParticipantsResponse)(nil), "verana.pp.v1.MsgBatchSelfCreateParticipantsResponse")
 }
 
-func (m *MsgRevokeParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("verana/pp/v1/tx.proto", fileDescriptor_4a8d881b5faa8c86) }
+
+var fileDescriptor_4a8d881b5faa8c86 = []byte{
+	// 2553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6f, 0xdc, 0x58,
+	0x15, 0x8f, 0x93, 0xe9, 0x64, 0xe6, 0x24, 0x9d, 0xa4, 0xde, 0xa4, 0x99, 0x38, 0x6d, 0x92, 0x3a,
+	0xdb, 0x6e, 0x36, 0x6d, 0x67, 0x9a, 0xe9, 0xd7, 0x6e, 0xb4, 0x45, 0xea, 0x74, 0x36, 0x28, 0xa5,
+	0x21, 0xc1, 0x69, 0x01, 0xf1, 0x32, 0x72, 0xc6, 0x77, 0x26, 0x56, 0x67, 0x6c, 0xe3, 0xeb, 0x99,
+	0xb4, 0x3c, 0x20, 0x40, 0x20, 0xa1, 0x15, 0x12, 0x15, 0x08, 0x89, 0x45, 0x3c, 0xf0, 0x88, 0xf6,
+	0xa9, 0x20, 0x5e, 0x79, 0xe3, 0xa1, 0xe2, 0x01, 0xad, 0x10, 0x08, 0xa4, 0x95, 0x76, 0x51, 0x2b,
+	0x51, 0x09, 0xfe, 0x00, 0xde, 0x10, 0xf2, 0xf5, 0xc7, 0xd8, 0xbe, 0xd7, 0x1e, 0xa7, 0xed, 0x2a,
+	0x59, 0xb4, 0x2f, 0x6d, 0xc6, 0xf7, 0x9c, 0x7b, 0xcf, 0xfd, 0x9d, 0x7b, 0x7e, 0xf7, 0x9c, 0x63,
+	0xc3, 0x74, 0x0f, 0x99, 0xb2, 0x26, 0x97, 0x0d, 0xa3, 0xdc, 0x5b, 0x2d, 0x5b, 0x0f, 0x4a, 0x86,
+	0xa9, 0x5b, 0x3a, 0x3f, 0xee, 0x3c, 0x2e, 0x19, 0x46, 0xa9, 0xb7, 0x2a, 0x9c, 0x90, 0x3b, 0xaa,
+	0xa6, 0x97, 0xc9, 0xbf, 0x8e, 0x80, 0x30, 0xdf, 0xd0, 0x71, 0x47, 0xc7, 0xe5, 0x5d, 0x19, 0xa3,
+	0x72, 0x6f, 0x75, 0x17, 0x59, 0xf2, 0x6a, 0xb9, 0xa1, 0xab, 0x9a, 0x3b, 0x3e, 0xe3, 0x8e, 0x77,
+	0x70, 0xcb, 0x9e, 0xb8, 0x83, 0x5b, 0xee, 0xc0, 0xac, 0x33, 0x50, 0x27, 0xbf, 0xca, 0xce, 0x0f,
+	0x77, 0x68, 0xaa, 0xa5, 0xb7, 0x74, 0xe7, 0xb9, 0xfd, 0x97, 0xb7, 0x52, 0x4b, 0xd7, 0x5b, 0x6d,
+	0x54, 0x26, 0xbf, 0x76, 0xbb, 0xcd, 0xb2, 0xd2, 0x35, 0x65, 0x4b, 0xd5, 0xbd, 0x95, 0x16, 0xa2,
+	0xe3, 0x96, 0xda, 0x41, 0xd8, 0x92, 0x3b, 0x86, 0xb7, 0x62, 0x68, 0x8b, 0x86, 0x6c, 0xca, 0x1d,
+	0x6f, 0xc5, 0x62, 0x78, 0xf7, 0x0f, 0x0d, 0xe4, 0x8e, 0x88, 0xbf, 0xe5, 0x60, 0x62, 0x13, 0xb7,
+	0xee, 0x19, 0x8a, 0x6c, 0xa1, 0x6d, 0xa2, 0xc3, 0x5f, 0x83, 0xbc, 0xdc, 0xb5, 0xf6, 0x74, 0x53,
+	0xb5, 0x1e, 0x16, 0xb9, 0x45, 0x6e, 0x39, 0x5f, 0x2d, 0xfe, 0xf9, 0x77, 0x17, 0xa7, 0xdc, 0x4d,
+	0xdc, 0x54, 0x14, 0x13, 0x61, 0xbc, 0x63, 0x99, 0xaa, 0xd6, 0x92, 0xfa, 0xa2, 0xfc, 0x75, 0xc8,
+	0x3a, 0xab, 0x16, 0x87, 0x17, 0xb9, 0xe5, 0xb1, 0xca, 0x54, 0x29, 0x88, 0x6e, 0xc9, 0x99, 0xbd,
+	0x9a, 0x7f, 0xf2, 0xf1, 0xc2, 0xd0, 0xaf, 0x9f, 0x3f, 0x5e, 0xe1, 0x24, 0x57, 0x7c, 0xad, 0xf4,
+	0xbd, 0xe7, 0x8f, 0x57, 0xfa, 0x13, 0xbd, 0xf7, 0xfc, 0xf1, 0xca, 0x9c, 0x6b, 0xf1, 0x03, 0xdb,
+	0xe6, 0x88, 0x81, 0xe2, 0x2c, 0xcc, 0x44, 0x1e, 0x49, 0x08, 0x1b, 0xba, 0x86, 0x91, 0xf8, 0xe3,
+	0x3c, 0x4c, 0x6f, 0xe2, 0xd6, 0x8e, 0x25, 0x9b, 0xd6, 0xb6, 0x6c, 0x5a, 0x6a, 0x43, 0x35, 0x64,
+	0xcd, 0xda, 0xda, 0xe6, 0xd7, 0x60, 0xac, 0xa1, 0x9b, 0x86, 0xee, 0x80, 0x3a, 0x70, 0x5f, 0x41,
+	0x61, 0xfe, 0x0a, 0xe4, 0x74, 0x03, 0x99, 0xb2, 0xa5, 0x9b, 0x64, 0x6f, 0x49, 0x8a, 0xbe, 0x24,
+	0xbf, 0x0a, 0x19, 0x53, 0x6f, 0xa3, 0xe2, 0xc8, 0x22, 0xb7, 0x5c, 0xa8, 0x9c, 0xa6, 0xd0, 0xf0,
+	0x8c, 0x93, 0xf4, 0x36, 0x92, 0x88, 0x28, 0xff, 0x16, 0x14, 0x7b, 0x72, 0x5b, 0x55, 0x6c, 0xfd,
+	0xba, 0xd1, 0x17, 0xa9, 0xab, 0x4a, 0x31, 0xb3, 0xc8, 0x2d, 0x67, 0xa4, 0x93, 0xfe, 0x78, 0x60,
+	0x86, 0x0d, 0x85, 0x9f, 0x84, 0x11, 0x45, 0x55, 0x8a, 0xc7, 0x6c, 0xeb, 0x24, 0xfb, 0x4f, 0xfe,
+	0x4b, 0x30, 0xe1, 0xca, 0xaa, 0xba, 0x56, 0x6f, 0x22, 0x84, 0x8b, 0x59, 0xe2, 0x97, 0x53, 0x61,
+	0x4b, 0xb6, 0x0c, 0x5b, 0x40, 0x6e, 0xdf, 0xdb, 0xd0, 0xac, 0x6b, 0x57, 0xaa, 0x99, 0x27, 0x1f,
+	0x2f, 0x70, 0x52, 0xa1, 0xaf, 0xba, 0x8e, 0x10, 0xe6, 0xbf, 0x08, 0xc7, 0x55, 0x8c, 0xbb, 0xb2,
+	0xd6, 0x40, 0xce, 0x54, 0xa3, 0xa9, 0xa7, 0x1a, 0xf7, 0x14, 0xc9, 0x44, 0x5b, 0x70, 0xa2, 0x87,
+	0x4c, 0xb5, 0xa9, 0x36, 0x02, 0x76, 0xe5, 0x52, 0x4f, 0x36, 0x19, 0x54, 0x26, 0x13, 0xbe, 0x0d,
+	0x63, 0x3d, 0x5c, 0xf7, 0xdd, 0x93, 0x1f, 0xe0, 0x1e, 0xe8, 0xe1, 0x2d, 0xcf, 0x41, 0xef, 0xc0,
+	0x5c, 0x40, 0xb5, 0x6e, 0x1f, 0xc0, 0x6f, 0xd5, 0x3b, 0xb8, 0x55, 0x27, 0x11, 0x52, 0x9c, 0x58,
+	0x1c, 0x59, 0xce, 0x4b, 0x33, 0x7d, 0x85, 0x9b, 0xb6, 0xc0, 0x26, 0x6e, 0xdd, 0xb5, 0x87, 0xf9,
+	0x9f, 0x70, 0x70, 0x9a, 0x56, 0xc7, 0x06, 0xd2, 0x94, 0x7a, 0x5b, 0xed, 0xa8, 0x56, 0x71, 0x6c,
+	0x71, 0x64, 0x79, 0xac, 0x32, 0x5b, 0x72, 0x0d, 0xb1, 0x39, 0xa4, 0xe4, 0x72, 0x48, 0xe9, 0x96,
+	0xae, 0x6a, 0xd5, 0xab, 0x76, 0x2c, 0x7c, 0xf0, 0xc9, 0xc2, 0x72, 0x4b, 0xb5, 0xf6, 0xba, 0xbb,
+	0xa5, 0x86, 0xde, 0x71, 0xa9, 0xc2, 0xfd, 0xef, 0x22, 0x56, 0xee, 0xbb, 0xf1, 0x6a, 0x2b, 0x60,
+	0x27, 0x6e, 0x66, 0x23, 0x26, 0xed, 0xd8, 0x6b, 0xde, 0xb1, 0x97, 0xe4, 0x6b, 0xb0, 0x40, 0xdb,
+	0xb4, 0xaf, 0x5a, 0x7b, 0x36, 0xd0, 0x2d, 0x53, 0xd6, 0xac, 0xe2, 0xf8, 0x22, 0xb7, 0x9c, 0x93,
+	0xe6, 0x22, 0x73, 0x7c, 0x4d, 0xb5, 0xf6, 0xd6, 0x5d, 0x11, 0xfe, 0x7d, 0x0e, 0xce, 0xd0, 0xd3,
+	0x34, 0x11, 0x0a, 0x6d, 0xef, 0xf8, 0xa7, 0xb4, 0xbd, 0x53, 0x11, 0xd3, 0xd6, 0x11, 0x0a, 0xec,
+	0xf0, 0xeb, 0x50, 0xa4, 0x4d, 0x33, 0x90, 0xa9, 0xea, 0x4a, 0xb1, 0x40, 0xce, 0xd1, 0x6c, 0xc9,
+	0xa1, 0xca, 0x92, 0x47, 0x95, 0xa5, 0x9a, 0x4b, 0xa5, 0xd5, 0xcc, 0xcf, 0x3f, 0x59, 0xe0, 0xa4,
+	0xe9, 0xc8, 0x02, 0xdb, 0x44, 0x7b, 0xed, 0xb2, 0x4d, 0x43, 0x7e, 0xf8, 0xda, 0x2c, 0x74, 0x26,
+	0xc2, 0x42, 0x34, 0xad, 0xdc, 0xce, 0xe4, 0x60, 0x72, 0xcc, 0xf6, 0x48, 0xd4, 0x24, 0xa4, 0xc9,
+	0xbb, 0x6d, 0xa4, 0x88, 0xeb, 0x70, 0x9a, 0xa9, 0xe9, 0x51, 0x16, 0x7f, 0x16, 0x0a, 0x91, 0x48,
+	0xe7, 0x48, 0xa4, 0x1f, 0x37, 0x82, 0x01, 0x2e, 0xfe, 0x89, 0x23, 0xcc, 0x26, 0x21, 0x0d, 0xed,
+	0x1f, 0x36, 0xb3, 0x15, 0x60, 0x58, 0x55, 0x08, 0xaf, 0x65, 0xa4, 0x61, 0x35, 0x0d, 0x72, 0xb4,
+	0xd9, 0xe2, 0x02, 0x01, 0x86, 0x1e, 0xf0, 0xb9, 0xfc, 0xf7, 0x19, 0x98, 0xb7, 0xa1, 0x43, 0x61,
+	0xe0, 0xee, 0xea, 0x5f, 0x75, 0xb8, 0x09, 0x29, 0x87, 0xbf, 0x75, 0x7e, 0x13, 0x26, 0x50, 0xb3,
+	0x89, 0x1a, 0x96, 0xda, 0x43, 0xf5, 0xae, 0x66, 0xa9, 0x6d, 0x42, 0xd4, 0x63, 0x15, 0x81, 0x3a,
+	0x85, 0x77, 0xbd, 0x0b, 0xbb, 0x9a, 0xb3, 0xb9, 0xec, 0x91, 0x7d, 0x14, 0x0b, 0xbe, 0xf2, 0x3d,
+	0x5b, 0x97, 0x7f, 0x83, 0x26, 0xed, 0x63, 0x64, 0xad, 0x28, 0x21, 0x2f, 0x45, 0x09, 0x39, 0x4b,
+	0xc4, 0xc2, 0x64, 0x7b, 0x9e, 0x45, 0xb6, 0xa3, 0x44, 0x90, 0x26, 0xd2, 0x15, 0x38, 0xa1, 0x1b,
+	0x75, 0xdc, 0xed, 0x74, 0x64, 0xf3, 0x61, 0x5d, 0x51, 0x5b, 0x08, 0x5b, 0x84, 0x99, 0xf3, 0xd2,
+	0x84, 0x6e, 0xec, 0x38, 0xcf, 0x6b, 0xe4, 0x31, 0x5f, 0x81, 0xe9, 0xe0, 0xea, 0x75, 0x45, 0xc5,
+	0x0d, 0xbd, 0xab, 0x59, 0x84, 0x7e, 0x33, 0xd2, 0x6b, 0x01, 0x2b, 0x6a, 0xee, 0x10, 0xbf, 0x06,
+	0xb3, 0x51, 0x63, 0xfa, 0x7a, 0x40, 0xf4, 0x66, 0x22, 0x46, 0x79, 0xba, 0x69, 0x42, 0xd3, 0x39,
+	0x25, 0x5b, 0xdb, 0xfe, 0xe1, 0x10, 0x97, 0xe1, 0x5c, 0xf2, 0xf1, 0xf1, 0x4f, 0xda, 0x47, 0x1c,
+	0x9c, 0xd9, 0xc4, 0xad, 0x5b, 0xb6, 0xc9, 0xed, 0x90, 0xf4, 0x1d, 0x19, 0x5b, 0x12, 0xfa, 0x66,
+	0xd7, 0xde, 0xf4, 0xe1, 0xc7, 0xd9, 0x15, 0x0a, 0x06, 0x31, 0x02, 0x43, 0x7f, 0x0b, 0xbe, 0xed,
+	0xe2, 0x79, 0x78, 0x73, 0xe0, 0xe6, 0x7c, 0x28, 0xfe, 0x92, 0x83, 0xa2, 0x2d, 0x6d, 0x22, 0xd9,
+	0x42, 0x92, 0xae, 0x07, 0xc1, 0x3b, 0x04, 0x04, 0xe6, 0x20, 0x8f, 0x1b, 0x7b, 0xa8, 0x23, 0xd7,
+	0x7d, 0x20, 0x72, 0xce, 0x83, 0x7e, 0xce, 0x93, 0x09, 0xe6, 0x3c, 0xfd, 0x80, 0xaa, 0x37, 0x4d,
+	0xbd, 0x43, 0xa2, 0x27, 0x6d, 0x30, 0x1e, 0xf7, 0x75, 0xd7, 0x4d, 0xbd, 0xc3, 0x0a, 0xed, 0xec,
+	0xab, 0x0d, 0xed, 0xd1, 0x74, 0xa1, 0x9d, 0x4b, 0x1b, 0xda, 0xf9, 0x98, 0xd0, 0x8e, 0xe4, 0x48,
+	0xf0, 0xea, 0x72, 0xa4, 0xb1, 0x97, 0xcd, 0x91, 0xc6, 0x8f, 0x64, 0x8e, 0x74, 0xfc, 0x55, 0xe5,
+	0x48, 0x85, 0x23, 0x97, 0x23, 0x4d, 0xbc, 0x54, 0x8e, 0x74, 0x8d, 0x62, 0xa0, 0xd7, 0xa3, 0x0c,
+	0xc4, 0x62, 0x0e, 0xb1, 0x02, 0x8b, 0x71, 0x63, 0x7e, 0x22, 0xe4, 0xb0, 0x1d, 0xe7, 0xb1, 0x9d,
+	0xf8, 0xc1, 0x30, 0x2c, 0x50, 0x04, 0xfe, 0x6e, 0x38, 0xa8, 0xfe, 0xdf, 0x12, 0x80, 0x14, 0x00,
+	0xbb, 0x78, 0x84, 0x81, 0x10, 0xdf, 0x84, 0x37, 0x06, 0x60, 0xe5, 0x53, 0xfc, 0x7f, 0x87, 0x61,
+	0x8a, 0x64, 0x5e, 0x3d, 0xfd, 0x3e, 0x3a, 0x5c, 0x7a, 0x8f, 0x82, 0x79, 0x0d, 0xb2, 0x26, 0x92,
+	0xb1, 0xae, 0x11, 0x0c, 0x0b, 0x95, 0xf9, 0x70, 0x49, 0x68, 0x9b, 0xec, 0xd0, 0x9a, 0x44, 0xa4,
+	0x24, 0x57, 0x9a, 0x17, 0x20, 0x87, 0x7a, 0xaa, 0x82, 0xb4, 0x06, 0x72, 0x4b, 0x60, 0xff, 0xb7,
+	0xcd, 0xbb, 0xde, 0xdf, 0x5e, 0x56, 0x93, 0x25, 0x22, 0x05, 0xef, 0xb1, 0x9b, 0xd4, 0x5c, 0x86,
+	0xd1, 0x86, 0x8c, 0x1b, 0xb2, 0x82, 0x08, 0x31, 0x17, 0x2a, 0xb3, 0xe1, 0xd5, 0x6f, 0x39, 0x83,
+	0x9b, 0xba, 0x82, 0x24, 0x4f, 0x72, 0xad, 0x42, 0xf9, 0x6b, 0x91, 0x4a, 0x7d, 0x23, 0x38, 0x8b,
+	0x37, 0xe0, 0x14, 0xeb, 0xb9, 0x1f, 0x08, 0xa7, 0x01, 0xdc, 0xe9, 0xfb, 0xd5, 0x40, 0xde, 0x7d,
+	0xb2, 0xa1, 0x88, 0x7f, 0x1d, 0x81, 0x25, 0x3f, 0x98, 0xb6, 0x4c, 0xbf, 0x0f, 0xe2, 0xcd, 0xb3,
+	0x83, 0x30, 0xb6, 0x5d, 0x72, 0x98, 0xee, 0xcc, 0x13, 0x77, 0xba, 0x69, 0x22, 0x8a, 0xe9, 0x65,
+	0xbc, 0xe6, 0x0c, 0x86, 0x1b, 0x19, 0xd7, 0xc0, 0xcd, 0x02, 0x69, 0x2d, 0x27, 0x13, 0x9e, 0xf6,
+	0x86, 0xc3, 0x7a, 0x97, 0x60, 0x4a, 0x6e, 0x21, 0xcd, 0x8a, 0x2a, 0x39, 0x79, 0x31, 0x4f, 0xc6,
+	0xc2, 0x1a, 0x37, 0x60, 0x6e, 0x5f, 0x6e, 0xb7, 0x91, 0x55, 0x67, 0x2a, 0x3a, 0x97, 0x73, 0xd1,
+	0x11, 0xb9, 0x49, 0xab, 0x9f, 0x84, 0x6c, 0x28, 0x49, 0x76, 0x7f, 0xad, 0x5d, 0xa7, 0x4e, 0xc4,
+	0x59, 0x26, 0x45, 0x06, 0x3d, 0x67, 0xbb, 0x4c, 0xbc, 0x01, 0xe7, 0x53, 0xb8, 0x95, 0x41, 0x97,
+	0x04, 0x6c, 0xf1, 0x37, 0x2e, 0x5d, 0xb6, 0x65, 0xbc, 0x17, 0x50, 0xbb, 0x6b, 0x76, 0xb1, 0x55,
+	0x43, 0x86, 0x8e, 0xd5, 0xa3, 0x10, 0xe1, 0x27, 0x21, 0x2b, 0x77, 0x48, 0xca, 0xef, 0x9c, 0x01,
+	0xf7, 0x97, 0xfd, 0xdc, 0x8d, 0x7c, 0x27, 0x7e, 0xbd, 0xc8, 0x4e, 0x1b, 0xbd, 0x69, 0x4a, 0x84,
+	0x28, 0x32, 0x35, 0xf1, 0x67, 0x9c, 0x43, 0x9b, 0x09, 0x98, 0xf9, 0x78, 0xcf, 0x42, 0x0e, 0xdb,
+	0x72, 0xfd, 0x98, 0x1c, 0x25, 0xbf, 0x37, 0x14, 0x7e, 0x0b, 0x26, 0x15, 0x15, 0x1b, 0x5d, 0x0b,
+	0xd5, 0x15, 0x24, 0x2b, 0x6d, 0x55, 0x43, 0x6e, 0x0f, 0x74, 0xd0, 0x25, 0x30, 0x44, 0x2e, 0x81,
+	0x09, 0x57, 0xbb, 0xe6, 0x2a, 0x8b, 0xff, 0xe1, 0x48, 0xed, 0x22, 0x21, 0x43, 0x7e, 0x18, 0x3c,
+	0x02, 0xf6, 0x7a, 0x48, 0xf9, 0x6c, 0xb8, 0x34, 0x55, 0x57, 0xc0, 0xdd, 0x9f, 0xb7, 0xb1, 0x9a,
+	0x78, 0x09, 0x4a, 0xe9, 0x36, 0xee, 0x5f, 0x67, 0xbf, 0xca, 0x93, 0x8a, 0x65, 0x07, 0xb5, 0x9b,
+	0x4e, 0xec, 0x1c, 0xee, 0x95, 0x76, 0xe8, 0x5d, 0xdf, 0x68, 0x05, 0x94, 0x7d, 0xa5, 0x15, 0xd0,
+	0xe8, 0x4b, 0x54, 0x40, 0xe7, 0xe3, 0x7a, 0xbf, 0xac, 0x9a, 0x85, 0x51, 0x2e, 0xe5, 0x99, 0xe5,
+	0xd2, 0xa7, 0x57, 0xdc, 0x4c, 0x7e, 0x5e, 0xdc, 0x7c, 0x5e, 0xdc, 0x44, 0x72, 0x6f, 0x06, 0xc9,
+	0xdc, 0xce, 0xe4, 0xc6, 0x26, 0xc7, 0x93, 0x7a, 0xc0, 0x4e, 0xf5, 0xc3, 0x54, 0x8e, 0xad, 0x7e,
+	0xfe, 0x30, 0x42, 0xba, 0x9f, 0xdb, 0xa6, 0x6e, 0xe8, 0x38, 0xa8, 0xb1, 0x8e, 0xd0, 0xad, 0x3d,
+	0x59, 0x6b, 0xa1, 0x23, 0x40, 0xfd, 0x8c, 0x20, 0xcd, 0xa4, 0xeb, 0x69, 0x1c, 0x4b, 0xdb, 0xd3,
+	0xc8, 0xc6, 0xf0, 0x43, 0x6c, 0x0b, 0x72, 0xf4, 0x05, 0x5b, 0x90, 0xb9, 0xe4, 0x16, 0xe4, 0x55,
+	0xea, 0x70, 0x2c, 0x45, 0x0e, 0x47, 0xc0, 0x55, 0xbe, 0x8f, 0xdc, 0x26, 0x64, 0x82, 0x17, 0xfd,
+	0x7b, 0xec, 0x6f, 0x1c, 0x69, 0x88, 0xdf, 0x6c, 0x34, 0x90, 0x61, 0x1d, 0x4d, 0x7f, 0xa7, 0x68,
+	0x40, 0xf6, 0xcd, 0xef, 0x63, 0x70, 0x1f, 0xce, 0x26, 0x6e, 0xcc, 0x8f, 0x81, 0x2a, 0xe4, 0xfd,
+	0x2b, 0x83, 0x6c, 0x2f, 0x6d, 0x02, 0xd5, 0x57, 0x13, 0x7f, 0x39, 0x0c, 0x05, 0x7b, 0x35, 0xc3,
+	0x40, 0x72, 0x9b, 0xe4, 0x0d, 0x47, 0x20, 0x4e, 0x82, 0xb9, 0x63, 0x26, 0x9c, 0x3b, 0xbe, 0x74,
+	0xe2, 0x7b, 0x81, 0xf2, 0x89, 0x10, 0xf5, 0x49, 0x1f, 0x0b, 0xf1, 0x0e, 0x9c, 0x0c, 0x3f, 0xf1,
+	0xc1, 0xaf, 0xc0, 0xa8, 0x6c, 0xee, 0xaa, 0x16, 0x32, 0x07, 0x22, 0xe4, 0x09, 0x8a, 0x7f, 0xf4,
+	0x5e, 0x4a, 0x61, 0xbd, 0xdd, 0x43, 0x64, 0x3e, 0x67, 0xea, 0x17, 0xfe, 0x88, 0xc0, 0x41, 0x6e,
+	0x98, 0x89, 0xdc, 0x48, 0x18, 0xb9, 0x22, 0x8c, 0x9a, 0xa8, 0x87, 0x4c, 0x8c, 0x08, 0xa6, 0x39,
+	0xc9, 0xfb, 0xe9, 0x1c, 0xd3, 0xf0, 0x07, 0x05, 0x74, 0xea, 0x19, 0x35, 0xd9, 0x7f, 0x21, 0x15,
+	0x1d, 0xf0, 0x23, 0xf4, 0x9f, 0xce, 0x6e, 0x77, 0xba, 0xe4, 0x12, 0x3c, 0x5a, 0x9d, 0x93, 0x93,
+	0xa1, 0xce, 0x89, 0x7f, 0x8c, 0xd2, 0x94, 0x45, 0xd4, 0x76, 0x5c, 0x24, 0xe8, 0x01, 0x1f, 0x89,
+	0x7f, 0x71, 0xce, 0x27, 0x18, 0x1a, 0xfe, 0x2c, 0x61, 0x31, 0x98, 0xc2, 0x59, 0x1b, 0x12, 0xcf,
+	0x90, 0xba, 0x9a, 0x35, 0xe4, 0xe3, 0xf1, 0x6f, 0x07, 0x0f, 0x49, 0xb7, 0xc2, 0xb7, 0x7b, 0x6d,
+	0xa3, 0x76, 0x04, 0xf0, 0x98, 0x81, 0x51, 0x0d, 0xed, 0xd7, 0xfb, 0xef, 0x4a, 0xb2, 0x1a, 0xda,
+	0xaf, 0xa9, 0x4a, 0x0a, 0x40, 0x58, 0x3b, 0x72, 0x01, 0x61, 0x0d, 0xf9, 0x80, 0xfc, 0x70, 0x18,
+	0x84, 0x4d, 0xdc, 0xaa, 0xca, 0x56, 0x63, 0x8f, 0x6a, 0x74, 0xe1, 0x43, 0xc0, 0xe4, 0x0b, 0x70,
+	0x4c, 0xb5, 0x50, 0x07, 0x17, 0x47, 0x48, 0xd2, 0x2a, 0x86, 0xeb, 0x32, 0x56, 0x3b, 0x8e, 0x7c,
+	0x71, 0x32, 0x24, 0x39, 0x6a, 0x6b, 0x6f, 0x51, 0x50, 0x9d, 0x8b, 0x40, 0x15, 0xb3, 0x57, 0xd1,
+	0x00, 0x31, 0x7e, 0xd4, 0x67, 0xdf, 0xdb, 0x36, 0x99, 0xe1, 0x6e, 0xdb, 0xc2, 0x45, 0x8e, 0x58,
+	0xb8, 0x32, 0xd8, 0x42, 0x4f, 0xd9, 0xb5, 0xd4, 0x9b, 0x40, 0xfc, 0xc5, 0x30, 0xbc, 0xee, 0x2d,
+	0x79, 0xc4, 0xba, 0xe7, 0x1b, 0x61, 0x37, 0x5c, 0xa4, 0x36, 0x99, 0x64, 0x6f, 0xd8, 0x23, 0x83,
+	0x0f, 0x6f, 0x10, 0x80, 0x77, 0x9b, 0x4d, 0xa7, 0x51, 0xfe, 0x03, 0x0e, 0x2e, 0xa4, 0x01, 0xc7,
+	0xf7, 0xcc, 0xbd, 0xa8, 0x67, 0xae, 0x1e, 0xc8, 0xe8, 0x38, 0x27, 0xfd, 0x68, 0x98, 0xe4, 0xf7,
+	0xae, 0x1d, 0x8c, 0xca, 0xe0, 0x30, 0xa2, 0xa4, 0x1a, 0x76, 0xcf, 0x39, 0xc6, 0x4e, 0x19, 0x96,
+	0x86, 0xfd, 0x32, 0x38, 0x47, 0x64, 0xec, 0x59, 0x7c, 0x40, 0xf2, 0xe4, 0x04, 0x34, 0x7c, 0x7f,
+	0x7c, 0x39, 0xea, 0x8f, 0x52, 0x3a, 0x2b, 0x63, 0x1c, 0x51, 0xf9, 0x68, 0x0a, 0x46, 0x36, 0x71,
+	0x8b, 0xbf, 0x0b, 0xe3, 0xa1, 0xcf, 0x20, 0x4f, 0x53, 0xd3, 0x06, 0x87, 0x85, 0xb3, 0x89, 0xc3,
+	0xbe, 0xb5, 0x4d, 0xe0, 0x19, 0x1f, 0x23, 0x2e, 0xd1, 0x26, 0x53, 0x42, 0xc2, 0xf9, 0x14, 0x42,
+	0xc1, 0x75, 0x18, 0x9f, 0x06, 0x2d, 0x31, 0x48, 0x24, 0x2a, 0xc4, 0x58, 0x27, 0xfe, 0xa3, 0x1c,
+	0xfe, 0xbb, 0x1c, 0xcc, 0x25, 0x7d, 0x91, 0x73, 0x61, 0x40, 0x70, 0x84, 0xa4, 0x85, 0x2b, 0x07,
+	0x91, 0xf6, 0x6d, 0x78, 0x8f, 0x83, 0xf9, 0x01, 0xdf, 0x6a, 0x94, 0xa9, 0x89, 0x93, 0x15, 0x84,
+	0xeb, 0x07, 0x54, 0xf0, 0x8d, 0xd1, 0x61, 0x9a, 0xfd, 0xb1, 0x04, 0x1d, 0x3c, 0x4c, 0x39, 0xa1,
+	0x94, 0x4e, 0xce, 0x5f, 0xf0, 0xfb, 0x1c, 0x9c, 0x4a, 0x64, 0xf5, 0x83, 0x91, 0xaa, 0xf0, 0x62,
+	0x74, 0xc6, 0x37, 0xe0, 0x04, 0xfd, 0x06, 0x31, 0xc5, 0xb5, 0x2a, 0x1c, 0xe0, 0x62, 0xe3, 0x1f,
+	0x71, 0xb0, 0x38, 0xf0, 0x3d, 0xd7, 0x6a, 0x0c, 0x80, 0xf1, 0x2a, 0xc2, 0xdb, 0x07, 0x56, 0x09,
+	0xc3, 0x9f, 0xf4, 0x8e, 0x85, 0x01, 0x7f, 0x82, 0x38, 0x0b, 0xfe, 0x34, 0x6f, 0x23, 0xde, 0xe7,
+	0x60, 0x29, 0xcd, 0xeb, 0x81, 0x2b, 0x0c, 0xb4, 0x07, 0x6a, 0x09, 0xef, 0xbc, 0x88, 0x56, 0x30,
+	0x24, 0xd8, 0xdd, 0xf8, 0x94, 0xf7, 0x89, 0x70, 0x40, 0x46, 0x27, 0xa4, 0x94, 0xd4, 0x28, 0xa3,
+	0x49, 0x29, 0x41, 0x9a, 0x41, 0x4a, 0x29, 0xda, 0x37, 0xfc, 0xb7, 0x41, 0x48, 0x68, 0xdd, 0xd0,
+	0x1c, 0x1b, 0x2f, 0x2c, 0x5c, 0x3e, 0x80, 0xb0, 0xbf, 0xfe, 0x57, 0x60, 0x2c, 0xd8, 0xf3, 0x38,
+	0x45, 0xcf, 0xd1, 0x1f, 0x15, 0x5e, 0x4f, 0x1a, 0x0d, 0xdf, 0x29, 0x54, 0x65, 0xcf, 0xba, 0x53,
+	0xa2, 0x42, 0xcc, 0x3b, 0x25, 0xae, 0xae, 0x26, 0x77, 0x24, 0x5d, 0x47, 0x32, 0xee, 0x48, 0x4a,
+	0x88, 0x75, 0x47, 0xc6, 0x56, 0x69, 0x7c, 0x1b, 0xa6, 0x98, 0x15, 0x2b, 0xe3, 0x2a, 0x67, 0x88,
+	0x09, 0x17, 0x53, 0x89, 0x05, 0x57, 0x63, 0xd6, 0x83, 0xf4, 0x6a, 0x2c, 0x31, 0xc6, 0x6a, 0x49,
+	0x05, 0x17, 0xdf, 0x85, 0x99, 0xb8, 0x62, 0x6b, 0x99, 0x9a, 0x29, 0x46, 0x52, 0xb8, 0x94, 0x56,
+	0xd2, 0x5f, 0xf6, 0xa7, 0x1c, 0x9c, 0x19, 0x5c, 0x67, 0x54, 0xd8, 0xf3, 0x26, 0x5e, 0x4b, 0x6b,
+	0x07, 0xd7, 0x09, 0xf1, 0x41, 0x52, 0x62, 0x7d, 0x21, 0x6e, 0x6e, 0x96, 0x34, 0x83, 0x0f, 0x52,
+	0xa4, 0xa9, 0xc2, 0xb1, 0xef, 0x3c, 0x7f, 0xbc, 0xc2, 0x55, 0xab, 0x4f, 0x9e, 0xce, 0x73, 0x1f,
+	0x3e, 0x9d, 0xe7, 0xfe, 0xf1, 0x74, 0x9e, 0x7b, 0xf4, 0x6c, 0x7e, 0xe8, 0xc3, 0x67, 0xf3, 0x43,
+	0x7f, 0x7f, 0x36, 0x3f, 0xf4, 0x8d, 0xe0, 0x4b, 0x11, 0x67, 0x81, 0x8b, 0x6d, 0x79, 0x17, 0x97,
+	0x83, 0xc9, 0x32, 0x79, 0x35, 0xb2, 0x9b, 0x25, 0xbd, 0xcf, 0xcb, 0xff, 0x0b, 0x00, 0x00, 0xff,
+	0xff, 0x81, 0x1d, 0x11, 0xf1, 0xc5, 0x34, 0x00, 0x00,
 }
 
-func (m *MsgCreateOrUpdateParticipantSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	StartParticipantOP(ctx context.Context, in *MsgStartParticipantOP, opts ...grpc.CallOption) (*MsgStartParticipantOPResponse, error)
+	RenewParticipantOP(ctx context.Context, in *MsgRenewParticipantOP, opts ...grpc.CallOption) (*MsgRenewParticipantOPResponse, error)
+	SetParticipantOPToValidated(ctx context.Context, in *MsgSetParticipantOPToValidated, opts ...grpc.CallOption) (*MsgSetParticipantOPToValidatedResponse, error)
+	CancelParticipantOPLastRequest(ctx context.Context, in *MsgCancelParticipantOPLastRequest, opts ...grpc.CallOption) (*MsgCancelParticipantOPLastRequestResponse, error)
+	CreateRootParticipant(ctx context.Context, in *MsgCreateRootParticipant, opts ...grpc.CallOption) (*MsgCreateRootParticipantResponse, error)
+	SetParticipantEffectiveUntil(ctx context.Context, in *MsgSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgSetParticipantEffectiveUntilResponse, error)
+	RevokeParticipant(ctx context.Context, in *MsgRevokeParticipant, opts ...grpc.CallOption) (*MsgRevokeParticipantResponse, error)
+	CreateOrUpdateParticipantSession(ctx context.Context, in *MsgCreateOrUpdateParticipantSession, opts ...grpc.CallOption) (*MsgCreateOrUpdateParticipantSessionResponse, error)
+	SlashParticipantTrustDeposit(ctx context.Context, in *MsgSlashParticipantTrustDeposit, opts ...grpc.CallOption) (*MsgSlashParticipantTrustDepositResponse, error)
+	RepayParticipantSlashedTrustDeposit(ctx context.Context, in *MsgRepayParticipantSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayParticipantSlashedTrustDepositResponse, error)
+	// [MOD-PP-MSG-14] Self Create Participant (OPEN mode)
+	SelfCreateParticipant(ctx context.Context, in *MsgSelfCreateParticipant, opts ...grpc.CallOption) (*MsgSelfCreateParticipantResponse, error)
+	// ProposeParticipantFeeChange is run by the validator of a participant to
+	// propose new fees and discounts for it.
+	ProposeParticipantFeeChange(ctx context.Context, in *MsgProposeParticipantFeeChange, opts ...grpc.CallOption) (*MsgProposeParticipantFeeChangeResponse, error)
+	// AcceptParticipantFeeChange is run by the participant to accept the
+	// proposed fee change, which takes effect after the notice period.
+	AcceptParticipantFeeChange(ctx context.Context, in *MsgAcceptParticipantFeeChange, opts ...grpc.CallOption) (*MsgAcceptParticipantFeeChangeResponse, error)
+	// AppealSlash is run by the slashed participant corporation during the
+	// dispute window of a slash to escalate it to its arbiter.
+	AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error)
+	// ResolveSlashAppeal is run by the arbiter of an appealed slash, the
+	// ecosystem corporation group policy or x/gov, to confirm or reverse it.
+	ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error)
+	// SuspendParticipant is run by a validator ancestor or the ecosystem
+	// controller to pause a participant until it is unsuspended.
+	SuspendParticipant(ctx context.Context, in *MsgSuspendParticipant, opts ...grpc.CallOption) (*MsgSuspendParticipantResponse, error)
+	// UnsuspendParticipant lifts the suspension of a participant.
+	UnsuspendParticipant(ctx context.Context, in *MsgUnsuspendParticipant, opts ...grpc.CallOption) (*MsgUnsuspendParticipantResponse, error)
+	// RotateParticipantDID is run by the participant corporation to change the
+	// did of the participant, the previous one being kept in its did history.
+	RotateParticipantDID(ctx context.Context, in *MsgRotateParticipantDID, opts ...grpc.CallOption) (*MsgRotateParticipantDIDResponse, error)
+	// BatchRevokeParticipants, BatchSetParticipantEffectiveUntil and
+	// BatchSelfCreateParticipants apply a list of operations atomically, with a
+	// single operator authorization check; the batch size is capped by the
+	// max_batch_size param.
+	BatchRevokeParticipants(ctx context.Context, in *MsgBatchRevokeParticipants, opts ...grpc.CallOption) (*MsgBatchRevokeParticipantsResponse, error)
+	BatchSetParticipantEffectiveUntil(ctx context.Context, in *MsgBatchSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgBatchSetParticipantEffectiveUntilResponse, error)
+	BatchSelfCreateParticipants(ctx context.Context, in *MsgBatchSelfCreateParticipants, opts ...grpc.CallOption) (*MsgBatchSelfCreateParticipantsResponse, error)
 }
 
-func (m *MsgCreateOrUpdateParticipantSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgCreateOrUpdateParticipantSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Digest) > 0 {
-		i -= len(m.Digest)
-		copy(dAtA[i:], m.Digest)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Digest)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.WalletAgentParticipantId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WalletAgentParticipantId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.AgentParticipantId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AgentParticipantId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.VerifierParticipantId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerifierParticipantId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.IssuerParticipantId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.IssuerParticipantId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateOrUpdateParticipantSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StartParticipantOP(ctx context.Context, in *MsgStartParticipantOP, opts ...grpc.CallOption) (*MsgStartParticipantOPResponse, error) {
+	out := new(MsgStartParticipantOPResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/StartParticipantOP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateOrUpdateParticipantSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RenewParticipantOP(ctx context.Context, in *MsgRenewParticipantOP, opts ...grpc.CallOption) (*MsgRenewParticipantOPResponse, error) {
+	out := new(MsgRenewParticipantOPResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/RenewParticipantOP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateOrUpdateParticipantSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetParticipantOPToValidated(ctx context.Context, in *MsgSetParticipantOPToValidated, opts ...grpc.CallOption) (*MsgSetParticipantOPToValidatedResponse, error) {
+	out := new(MsgSetParticipantOPToValidatedResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SetParticipantOPToValidated", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSlashParticipantTrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelParticipantOPLastRequest(ctx context.Context, in *MsgCancelParticipantOPLastRequest, opts ...grpc.CallOption) (*MsgCancelParticipantOPLastRequestResponse, error) {
+	out := new(MsgCancelParticipantOPLastRequestResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/CancelParticipantOPLastRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSlashParticipantTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateRootParticipant(ctx context.Context, in *MsgCreateRootParticipant, opts ...grpc.CallOption) (*MsgCreateRootParticipantResponse, error) {
+	out := new(MsgCreateRootParticipantResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/CreateRootParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSlashParticipantTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+func (c *msgClient) SetParticipantEffectiveUntil(ctx context.Context, in *MsgSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgSetParticipantEffectiveUntilResponse, error) {
+	out := new(MsgSetParticipantEffectiveUntilResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SetParticipantEffectiveUntil", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) RevokeParticipant(ctx context.Context, in *MsgRevokeParticipant, opts ...grpc.CallOption) (*MsgRevokeParticipantResponse, error) {
+	out := new(MsgRevokeParticipantResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/RevokeParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) CreateOrUpdateParticipantSession(ctx context.Context, in *MsgCreateOrUpdateParticipantSession, opts ...grpc.CallOption) (*MsgCreateOrUpdateParticipantSessionResponse, error) {
+	out := new(MsgCreateOrUpdateParticipantSessionResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/CreateOrUpdateParticipantSession", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSlashParticipantTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SlashParticipantTrustDeposit(ctx context.Context, in *MsgSlashParticipantTrustDeposit, opts ...grpc.CallOption) (*MsgSlashParticipantTrustDepositResponse, error) {
+	out := new(MsgSlashParticipantTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SlashParticipantTrustDeposit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSlashParticipantTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RepayParticipantSlashedTrustDeposit(ctx context.Context, in *MsgRepayParticipantSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayParticipantSlashedTrustDepositResponse, error) {
+	out := new(MsgRepayParticipantSlashedTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/RepayParticipantSlashedTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSlashParticipantTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SelfCreateParticipant(ctx context.Context, in *MsgSelfCreateParticipant, opts ...grpc.CallOption) (*MsgSelfCreateParticipantResponse, error) {
+	out := new(MsgSelfCreateParticipantResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SelfCreateParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProposeParticipantFeeChange(ctx context.Context, in *MsgProposeParticipantFeeChange, opts ...grpc.CallOption) (*MsgProposeParticipantFeeChangeResponse, error) {
+	out := new(MsgProposeParticipantFeeChangeResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/ProposeParticipantFeeChange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptParticipantFeeChange(ctx context.Context, in *MsgAcceptParticipantFeeChange, opts ...grpc.CallOption) (*MsgAcceptParticipantFeeChangeResponse, error) {
+	out := new(MsgAcceptParticipantFeeChangeResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/AcceptParticipantFeeChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
+func (c *msgClient) AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error) {
+	out := new(MsgAppealSlashResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/AppealSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *msgClient) ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error) {
+	out := new(MsgResolveSlashAppealResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/ResolveSlashAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) SuspendParticipant(ctx context.Context, in *MsgSuspendParticipant, opts ...grpc.CallOption) (*MsgSuspendParticipantResponse, error) {
+	out := new(MsgSuspendParticipantResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/SuspendParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UnsuspendParticipant(ctx context.Context, in *MsgUnsuspendParticipant, opts ...grpc.CallOption) (*MsgUnsuspendParticipantResponse, error) {
+	out := new(MsgUnsuspendParticipantResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/UnsuspendParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RotateParticipantDID(ctx context.Context, in *MsgRotateParticipantDID, opts ...grpc.CallOption) (*MsgRotateParticipantDIDResponse, error) {
+	out := new(MsgRotateParticipantDIDResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/RotateParticipantDID", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BatchRevokeParticipants(ctx context.Context, in *MsgBatchRevokeParticipants, opts ...grpc.CallOption) (*MsgBatchRevokeParticipantsResponse, error) {
+	out := new(MsgBatchRevokeParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/BatchRevokeParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) BatchSetParticipantEffectiveUntil(ctx context.Context, in *MsgBatchSetParticipantEffectiveUntil, opts ...grpc.CallOption) (*MsgBatchSetParticipantEffectiveUntilResponse, error) {
+	out := new(MsgBatchSetParticipantEffectiveUntilResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/BatchSetParticipantEffectiveUntil", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSelfCreateParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BatchSelfCreateParticipants(ctx context.Context, in *MsgBatchSelfCreateParticipants, opts ...grpc.CallOption) (*MsgBatchSelfCreateParticipantsResponse, error) {
+	out := new(MsgBatchSelfCreateParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/verana.pp.v1.Msg/BatchSelfCreateParticipants", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSelfCreateParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	StartParticipantOP(context.Context, *MsgStartParticipantOP) (*MsgStartParticipantOPResponse, error)
+	RenewParticipantOP(context.Context, *MsgRenewParticipantOP) (*MsgRenewParticipantOPResponse, error)
+	SetParticipantOPToValidated(context.Context, *MsgSetParticipantOPToValidated) (*MsgSetParticipantOPToValidatedResponse, error)
+	CancelParticipantOPLastRequest(context.Context, *MsgCancelParticipantOPLastRequest) (*MsgCancelParticipantOPLastRequestResponse, error)
+	CreateRootParticipant(context.Context, *MsgCreateRootParticipant) (*MsgCreateRootParticipantResponse, error)
+	SetParticipantEffectiveUntil(context.Context, *MsgSetParticipantEffectiveUntil) (*MsgSetParticipantEffectiveUntilResponse, error)
+	RevokeParticipant(context.Context, *MsgRevokeParticipant) (*MsgRevokeParticipantResponse, error)
+	CreateOrUpdateParticipantSession(context.Context, *MsgCreateOrUpdateParticipantSession) (*MsgCreateOrUpdateParticipantSessionResponse, error)
+	SlashParticipantTrustDeposit(context.Context, *MsgSlashParticipantTrustDeposit) (*MsgSlashParticipantTrustDepositResponse, error)
+	RepayParticipantSlashedTrustDeposit(context.Context, *MsgRepayParticipantSlashedTrustDeposit) (*MsgRepayParticipantSlashedTrustDepositResponse, error)
+	// [MOD-PP-MSG-14] Self Create Participant (OPEN mode)
+	SelfCreateParticipant(context.Context, *MsgSelfCreateParticipant) (*MsgSelfCreateParticipantResponse, error)
+	// ProposeParticipantFeeChange is run by the validator of a participant to
+	// propose new fees and discounts for it.
+	ProposeParticipantFeeChange(context.Context, *MsgProposeParticipantFeeChange) (*MsgProposeParticipantFeeChangeResponse, error)
+	// AcceptParticipantFeeChange is run by the participant to accept the
+	// proposed fee change, which takes effect after the notice period.
+	AcceptParticipantFeeChange(context.Context, *MsgAcceptParticipantFeeChange) (*MsgAcceptParticipantFeeChangeResponse, error)
+	// AppealSlash is run by the slashed participant corporation during the
+	// dispute window of a slash to escalate it to its arbiter.
+	AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error)
+	// ResolveSlashAppeal is run by the arbiter of an appealed slash, the
+	// ecosystem corporation group policy or x/gov, to confirm or reverse it.
+	ResolveSlashAppeal(context.Context, *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error)
+	// SuspendParticipant is run by a validator ancestor or the ecosystem
+	// controller to pause a participant until it is unsuspended.
+	SuspendParticipant(context.Context, *MsgSuspendParticipant) (*MsgSuspendParticipantResponse, error)
+	// UnsuspendParticipant lifts the suspension of a participant.
+	UnsuspendParticipant(context.Context, *MsgUnsuspendParticipant) (*MsgUnsuspendParticipantResponse, error)
+	// RotateParticipantDID is run by the participant corporation to change the
+	// did of the participant, the previous one being kept in its did history.
+	RotateParticipantDID(context.Context, *MsgRotateParticipantDID) (*MsgRotateParticipantDIDResponse, error)
+	// BatchRevokeParticipants, BatchSetParticipantEffectiveUntil and
+	// BatchSelfCreateParticipants apply a list of operations atomically, with a
+	// single operator authorization check; the batch size is capped by the
+	// max_batch_size param.
+	BatchRevokeParticipants(context.Context, *MsgBatchRevokeParticipants) (*MsgBatchRevokeParticipantsResponse, error)
+	BatchSetParticipantEffectiveUntil(context.Context, *MsgBatchSetParticipantEffectiveUntil) (*MsgBatchSetParticipantEffectiveUntilResponse, error)
+	BatchSelfCreateParticipants(context.Context, *MsgBatchSelfCreateParticipants) (*MsgBatchSelfCreateParticipantsResponse, error)
 }
 
-func (m *MsgSelfCreateParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VsOperatorAuthzMsgTypes) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VsOperatorAuthzMsgTypes[iNdEx])
-			copy(dAtA[i:], m.VsOperatorAuthzMsgTypes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperatorAuthzMsgTypes[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-	}
-	if m.VsOperatorAuthzPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VsOperatorAuthzPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod):])
-		if err11 != nil {
-			return 0, err11
-		}
-		i -= n11
-		i = encodeVarintTx(dAtA, i, uint64(n11))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzFeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VsOperatorAuthzFeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if m.VsOperatorAuthzWithFeegrant {
-		i--
-		if m.VsOperatorAuthzWithFeegrant {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.VsOperatorAuthzSpendLimit) > 0 {
-		for iNdEx := len(m.VsOperatorAuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VsOperatorAuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) StartParticipantOP(ctx context.Context, req *MsgStartParticipantOP) (*MsgStartParticipantOPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartParticipantOP not implemented")
+}
+func (*UnimplementedMsgServer) RenewParticipantOP(ctx context.Context, req *MsgRenewParticipantOP) (*MsgRenewParticipantOPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewParticipantOP not implemented")
+}
+func (*UnimplementedMsgServer) SetParticipantOPToValidated(ctx context.Context, req *MsgSetParticipantOPToValidated) (*MsgSetParticipantOPToValidatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetParticipantOPToValidated not implemented")
+}
+func (*UnimplementedMsgServer) CancelParticipantOPLastRequest(ctx context.Context, req *MsgCancelParticipantOPLastRequest) (*MsgCancelParticipantOPLastRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParticipantOPLastRequest not implemented")
+}
+func (*UnimplementedMsgServer) CreateRootParticipant(ctx context.Context, req *MsgCreateRootParticipant) (*MsgCreateRootParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRootParticipant not implemented")
+}
+func (*UnimplementedMsgServer) SetParticipantEffectiveUntil(ctx context.Context, req *MsgSetParticipantEffectiveUntil) (*MsgSetParticipantEffectiveUntilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetParticipantEffectiveUntil not implemented")
+}
+func (*UnimplementedMsgServer) RevokeParticipant(ctx context.Context, req *MsgRevokeParticipant) (*MsgRevokeParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeParticipant not implemented")
+}
+func (*UnimplementedMsgServer) CreateOrUpdateParticipantSession(ctx context.Context, req *MsgCreateOrUpdateParticipantSession) (*MsgCreateOrUpdateParticipantSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateParticipantSession not implemented")
+}
+func (*UnimplementedMsgServer) SlashParticipantTrustDeposit(ctx context.Context, req *MsgSlashParticipantTrustDeposit) (*MsgSlashParticipantTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashParticipantTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) RepayParticipantSlashedTrustDeposit(ctx context.Context, req *MsgRepayParticipantSlashedTrustDeposit) (*MsgRepayParticipantSlashedTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayParticipantSlashedTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) SelfCreateParticipant(ctx context.Context, req *MsgSelfCreateParticipant) (*MsgSelfCreateParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfCreateParticipant not implemented")
+}
+func (*UnimplementedMsgServer) ProposeParticipantFeeChange(ctx context.Context, req *MsgProposeParticipantFeeChange) (*MsgProposeParticipantFeeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeParticipantFeeChange not implemented")
+}
+func (*UnimplementedMsgServer) AcceptParticipantFeeChange(ctx context.Context, req *MsgAcceptParticipantFeeChange) (*MsgAcceptParticipantFeeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptParticipantFeeChange not implemented")
+}
+func (*UnimplementedMsgServer) AppealSlash(ctx context.Context, req *MsgAppealSlash) (*MsgAppealSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealSlash not implemented")
+}
+func (*UnimplementedMsgServer) ResolveSlashAppeal(ctx context.Context, req *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSlashAppeal not implemented")
+}
+func (*UnimplementedMsgServer) SuspendParticipant(ctx context.Context, req *MsgSuspendParticipant) (*MsgSuspendParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendParticipant not implemented")
+}
+func (*UnimplementedMsgServer) UnsuspendParticipant(ctx context.Context, req *MsgUnsuspendParticipant) (*MsgUnsuspendParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendParticipant not implemented")
+}
+func (*UnimplementedMsgServer) RotateParticipantDID(ctx context.Context, req *MsgRotateParticipantDID) (*MsgRotateParticipantDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateParticipantDID not implemented")
+}
+func (*UnimplementedMsgServer) BatchRevokeParticipants(ctx context.Context, req *MsgBatchRevokeParticipants) (*MsgBatchRevokeParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRevokeParticipants not implemented")
+}
+func (*UnimplementedMsgServer) BatchSetParticipantEffectiveUntil(ctx context.Context, req *MsgBatchSetParticipantEffectiveUntil) (*MsgBatchSetParticipantEffectiveUntilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSetParticipantEffectiveUntil not implemented")
+}
+func (*UnimplementedMsgServer) BatchSelfCreateParticipants(ctx context.Context, req *MsgBatchSelfCreateParticipants) (*MsgBatchSelfCreateParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSelfCreateParticipants not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.VsOperator) > 0 {
-		i -= len(m.VsOperator)
-		copy(dAtA[i:], m.VsOperator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperator)))
-		i--
-		dAtA[i] = 0x52
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.ValidationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
-		i--
-		dAtA[i] = 0x48
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/UpdateParams",
 	}
-	if m.VerificationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x40
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if m.EffectiveUntil != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err12 != nil {
-			return 0, err12
-		}
-		i -= n12
-		i = encodeVarintTx(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0x3a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartParticipantOP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartParticipantOP)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EffectiveFrom != nil {
-		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
-		if err13 != nil {
-			return 0, err13
-		}
-		i -= n13
-		i = encodeVarintTx(dAtA, i, uint64(n13))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(MsgServer).StartParticipantOP(ctx, in)
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/StartParticipantOP",
 	}
-	if m.ValidatorParticipantId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorParticipantId))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartParticipantOP(ctx, req.(*MsgStartParticipantOP))
 	}
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewParticipantOP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewParticipantOP)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).RenewParticipantOP(ctx, in)
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/RenewParticipantOP",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewParticipantOP(ctx, req.(*MsgRenewParticipantOP))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSelfCreateParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetParticipantOPToValidated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetParticipantOPToValidated)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSelfCreateParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSelfCreateParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(MsgServer).SetParticipantOPToValidated(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/SetParticipantOPToValidated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetParticipantOPToValidated(ctx, req.(*MsgSetParticipantOPToValidated))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_CancelParticipantOPLastRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParticipantOPLastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParticipantOPLastRequest(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/CancelParticipantOPLastRequest",
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParticipantOPLastRequest(ctx, req.(*MsgCancelParticipantOPLastRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartParticipantOP) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateRootParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRootParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRootParticipant(ctx, in)
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/CreateRootParticipant",
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRootParticipant(ctx, req.(*MsgCreateRootParticipant))
 	}
-	if m.ValidatorParticipantId != 0 {
-		n += 1 + sovTx(uint64(m.ValidatorParticipantId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetParticipantEffectiveUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetParticipantEffectiveUntil)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetParticipantEffectiveUntil(ctx, in)
 	}
-	if m.ValidationFees != nil {
-		l = m.ValidationFees.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/SetParticipantEffectiveUntil",
 	}
-	if m.IssuanceFees != nil {
-		l = m.IssuanceFees.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetParticipantEffectiveUntil(ctx, req.(*MsgSetParticipantEffectiveUntil))
 	}
-	if m.VerificationFees != nil {
-		l = m.VerificationFees.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.VsOperator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeParticipant(ctx, in)
 	}
-	if len(m.VsOperatorAuthzSpendLimit) > 0 {
-		for _, e := range m.VsOperatorAuthzSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/RevokeParticipant",
 	}
-	if m.VsOperatorAuthzWithFeegrant {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeParticipant(ctx, req.(*MsgRevokeParticipant))
 	}
-	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
-		for _, e := range m.VsOperatorAuthzFeeSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateOrUpdateParticipantSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrUpdateParticipantSession)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.VsOperatorAuthzPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod)
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrUpdateParticipantSession(ctx, in)
 	}
-	if len(m.VsOperatorAuthzMsgTypes) > 0 {
-		for _, s := range m.VsOperatorAuthzMsgTypes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/CreateOrUpdateParticipantSession",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrUpdateParticipantSession(ctx, req.(*MsgCreateOrUpdateParticipantSession))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartParticipantOPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SlashParticipantTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashParticipantTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ParticipantId != 0 {
-		n += 1 + sovTx(uint64(m.ParticipantId))
+	if interceptor == nil {
+		return srv.(MsgServer).SlashParticipantTrustDeposit(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/SlashParticipantTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashParticipantTrustDeposit(ctx, req.(*MsgSlashParticipantTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewParticipantOP) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RepayParticipantSlashedTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayParticipantSlashedTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RepayParticipantSlashedTrustDeposit(ctx, in)
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/RepayParticipantSlashedTrustDeposit",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayParticipantSlashedTrustDeposit(ctx, req.(*MsgRepayParticipantSlashedTrustDeposit))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewParticipantOPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SelfCreateParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSelfCreateParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetParticipantOPToValidated) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SelfCreateParticipant(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/SelfCreateParticipant",
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SelfCreateParticipant(ctx, req.(*MsgSelfCreateParticipant))
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeParticipantFeeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeParticipantFeeChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeParticipantFeeChange(ctx, in)
 	}
-	if m.ValidationFees != 0 {
-		n += 1 + sovTx(uint64(m.ValidationFees))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/ProposeParticipantFeeChange",
 	}
-	if m.IssuanceFees != 0 {
-		n += 1 + sovTx(uint64(m.IssuanceFees))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeParticipantFeeChange(ctx, req.(*MsgProposeParticipantFeeChange))
 	}
-	if m.VerificationFees != 0 {
-		n += 1 + sovTx(uint64(m.VerificationFees))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptParticipantFeeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptParticipantFeeChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.OpSummaryDigest)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptParticipantFeeChange(ctx, in)
 	}
-	if m.IssuanceFeeDiscount != 0 {
-		n += 1 + sovTx(uint64(m.IssuanceFeeDiscount))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/AcceptParticipantFeeChange",
 	}
-	if m.VerificationFeeDiscount != 0 {
-		n += 1 + sovTx(uint64(m.VerificationFeeDiscount))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptParticipantFeeChange(ctx, req.(*MsgAcceptParticipantFeeChange))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetParticipantOPToValidatedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AppealSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealSlash)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AppealSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/AppealSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealSlash(ctx, req.(*MsgAppealSlash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelParticipantOPLastRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResolveSlashAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveSlashAppeal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, in)
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/ResolveSlashAppeal",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, req.(*MsgResolveSlashAppeal))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelParticipantOPLastRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SuspendParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/SuspendParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendParticipant(ctx, req.(*MsgSuspendParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateRootParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SchemaId != 0 {
-		n += 1 + sovTx(uint64(m.SchemaId))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UnsuspendParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsuspendParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EffectiveFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UnsuspendParticipant(ctx, in)
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/UnsuspendParticipant",
 	}
-	if m.ValidationFees != 0 {
-		n += 1 + sovTx(uint64(m.ValidationFees))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsuspendParticipant(ctx, req.(*MsgUnsuspendParticipant))
 	}
-	if m.IssuanceFees != 0 {
-		n += 1 + sovTx(uint64(m.IssuanceFees))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateParticipantDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateParticipantDID)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.VerificationFees != 0 {
-		n += 1 + sovTx(uint64(m.VerificationFees))
+	if interceptor == nil {
+		return srv.(MsgServer).RotateParticipantDID(ctx, in)
 	}
-	l = len(m.VsOperator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/RotateParticipantDID",
 	}
-	if len(m.VsOperatorAuthzMsgTypes) > 0 {
-		for _, s := range m.VsOperatorAuthzMsgTypes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateParticipantDID(ctx, req.(*MsgRotateParticipantDID))
 	}
-	if len(m.VsOperatorAuthzSpendLimit) > 0 {
-		for _, e := range m.VsOperatorAuthzSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchRevokeParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchRevokeParticipants)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.VsOperatorAuthzWithFeegrant {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).BatchRevokeParticipants(ctx, in)
 	}
-	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
-		for _, e := range m.VsOperatorAuthzFeeSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/BatchRevokeParticipants",
 	}
-	if m.VsOperatorAuthzPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod)
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchRevokeParticipants(ctx, req.(*MsgBatchRevokeParticipants))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateRootParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BatchSetParticipantEffectiveUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSetParticipantEffectiveUntil)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSetParticipantEffectiveUntil(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/BatchSetParticipantEffectiveUntil",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSetParticipantEffectiveUntil(ctx, req.(*MsgBatchSetParticipantEffectiveUntil))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetParticipantEffectiveUntil) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_BatchSelfCreateParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSelfCreateParticipants)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSelfCreateParticipants(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.pp.v1.Msg/BatchSelfCreateParticipants",
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSelfCreateParticipants(ctx, req.(*MsgBatchSelfCreateParticipants))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetParticipantEffectiveUntilResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.pp.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "StartParticipantOP",
+			Handler:    _Msg_StartParticipantOP_Handler,
+		},
+		{
+			MethodName: "RenewParticipantOP",
+			Handler:    _Msg_RenewParticipantOP_Handler,
+		},
+		{
+			MethodName: "SetParticipantOPToValidated",
+			Handler:    _Msg_SetParticipantOPToValidated_Handler,
+		},
+		{
+			MethodName: "CancelParticipantOPLastRequest",
+			Handler:    _Msg_CancelParticipantOPLastRequest_Handler,
+		},
+		{
+			MethodName: "CreateRootParticipant",
+			Handler:    _Msg_CreateRootParticipant_Handler,
+		},
+		{
+			MethodName: "SetParticipantEffectiveUntil",
+			Handler:    _Msg_SetParticipantEffectiveUntil_Handler,
+		},
+		{
+			MethodName: "RevokeParticipant",
+			Handler:    _Msg_RevokeParticipant_Handler,
+		},
+		{
+			MethodName: "CreateOrUpdateParticipantSession",
+			Handler:    _Msg_CreateOrUpdateParticipantSession_Handler,
+		},
+		{
+			MethodName: "SlashParticipantTrustDeposit",
+			Handler:    _Msg_SlashParticipantTrustDeposit_Handler,
+		},
+		{
+			MethodName: "RepayParticipantSlashedTrustDeposit",
+			Handler:    _Msg_RepayParticipantSlashedTrustDeposit_Handler,
+		},
+		{
+			MethodName: "SelfCreateParticipant",
+			Handler:    _Msg_SelfCreateParticipant_Handler,
+		},
+		{
+			MethodName: "ProposeParticipantFeeChange",
+			Handler:    _Msg_ProposeParticipantFeeChange_Handler,
+		},
+		{
+			MethodName: "AcceptParticipantFeeChange",
+			Handler:    _Msg_AcceptParticipantFeeChange_Handler,
+		},
+		{
+			MethodName: "AppealSlash",
+			Handler:    _Msg_AppealSlash_Handler,
+		},
+		{
+			MethodName: "ResolveSlashAppeal",
+			Handler:    _Msg_ResolveSlashAppeal_Handler,
+		},
+		{
+			MethodName: "SuspendParticipant",
+			Handler:    _Msg_SuspendParticipant_Handler,
+		},
+		{
+			MethodName: "UnsuspendParticipant",
+			Handler:    _Msg_UnsuspendParticipant_Handler,
+		},
+		{
+			MethodName: "RotateParticipantDID",
+			Handler:    _Msg_RotateParticipantDID_Handler,
+		},
+		{
+			MethodName: "BatchRevokeParticipants",
+			Handler:    _Msg_BatchRevokeParticipants_Handler,
+		},
+		{
+			MethodName: "BatchSetParticipantEffectiveUntil",
+			Handler:    _Msg_BatchSetParticipantEffectiveUntil_Handler,
+		},
+		{
+			MethodName: "BatchSelfCreateParticipants",
+			Handler:    _Msg_BatchSelfCreateParticipants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/pp/v1/tx.proto",
 }
 
-func (m *MsgRevokeParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateOrUpdateParticipantSession) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.IssuerParticipantId != 0 {
-		n += 1 + sovTx(uint64(m.IssuerParticipantId))
-	}
-	if m.VerifierParticipantId != 0 {
-		n += 1 + sovTx(uint64(m.VerifierParticipantId))
-	}
-	if m.AgentParticipantId != 0 {
-		n += 1 + sovTx(uint64(m.AgentParticipantId))
-	}
-	if m.WalletAgentParticipantId != 0 {
-		n += 1 + sovTx(uint64(m.WalletAgentParticipantId))
-	}
-	l = len(m.Digest)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateOrUpdateParticipantSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSlashParticipantTrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSlashParticipantTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+func (m *MsgStartParticipantOP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRepayParticipantSlashedTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgStartParticipantOP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelfCreateParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgStartParticipantOP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
-	}
-	if m.ValidatorParticipantId != 0 {
-		n += 1 + sovTx(uint64(m.ValidatorParticipantId))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EffectiveFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VsOperatorAuthzMsgTypes) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VsOperatorAuthzMsgTypes[iNdEx])
+			copy(dAtA[i:], m.VsOperatorAuthzMsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperatorAuthzMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	if m.VerificationFees != 0 {
-		n += 1 + sovTx(uint64(m.VerificationFees))
+	if m.VsOperatorAuthzPeriod != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VsOperatorAuthzPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.ValidationFees != 0 {
-		n += 1 + sovTx(uint64(m.ValidationFees))
+	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzFeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VsOperatorAuthzFeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	l = len(m.VsOperator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.VsOperatorAuthzWithFeegrant {
+		i--
+		if m.VsOperatorAuthzWithFeegrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
 	if len(m.VsOperatorAuthzSpendLimit) > 0 {
-		for _, e := range m.VsOperatorAuthzSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.VsOperatorAuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VsOperatorAuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
-	if m.VsOperatorAuthzWithFeegrant {
-		n += 2
+	if len(m.VsOperator) > 0 {
+		i -= len(m.VsOperator)
+		copy(dAtA[i:], m.VsOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperator)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
-		for _, e := range m.VsOperatorAuthzFeeSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.VerificationFees != nil {
+		{
+			size, err := m.VerificationFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.VsOperatorAuthzPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.VsOperatorAuthzMsgTypes) > 0 {
-		for _, s := range m.VsOperatorAuthzMsgTypes {
-			l = len(s)
-			n += 2 + l + sovTx(uint64(l))
+	if m.IssuanceFees != nil {
+		{
+			size, err := m.IssuanceFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
+	if m.ValidationFees != nil {
+		{
+			size, err := m.ValidationFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ValidatorParticipantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorParticipantId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *MsgSelfCreateParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStartParticipantOPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartParticipantOPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartParticipantOPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParticipantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewParticipantOP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewParticipantOP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewParticipantOP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRenewParticipantOPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRenewParticipantOPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+
+func (m *MsgRenewParticipantOPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetParticipantOPToValidated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParticipantOPToValidated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParticipantOPToValidated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerificationFeeDiscount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFeeDiscount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IssuanceFeeDiscount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFeeDiscount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.OpSummaryDigest) > 0 {
+		i -= len(m.OpSummaryDigest)
+		copy(dAtA[i:], m.OpSummaryDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OpSummaryDigest)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EffectiveUntil != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetParticipantOPToValidatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParticipantOPToValidatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParticipantOPToValidatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParticipantOPLastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParticipantOPLastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParticipantOPLastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParticipantOPLastRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParticipantOPLastRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParticipantOPLastRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRootParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRootParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRootParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VsOperatorAuthzPeriod != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VsOperatorAuthzPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzFeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VsOperatorAuthzFeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.VsOperatorAuthzWithFeegrant {
+		i--
+		if m.VsOperatorAuthzWithFeegrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.VsOperatorAuthzSpendLimit) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VsOperatorAuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VsOperatorAuthzMsgTypes) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VsOperatorAuthzMsgTypes[iNdEx])
+			copy(dAtA[i:], m.VsOperatorAuthzMsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperatorAuthzMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VsOperator) > 0 {
+		i -= len(m.VsOperator)
+		copy(dAtA[i:], m.VsOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EffectiveUntil != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EffectiveFrom != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRootParticipantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRootParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRootParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetParticipantEffectiveUntil) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParticipantEffectiveUntil) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParticipantEffectiveUntil) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveUntil != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetParticipantEffectiveUntilResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParticipantEffectiveUntilResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParticipantEffectiveUntilResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cascade != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cascade))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EvidenceDigest) > 0 {
+		i -= len(m.EvidenceDigest)
+		copy(dAtA[i:], m.EvidenceDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceDigest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeParticipantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CascadeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CascadeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrUpdateParticipantSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrUpdateParticipantSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrUpdateParticipantSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.WalletAgentParticipantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WalletAgentParticipantId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AgentParticipantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AgentParticipantId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VerifierParticipantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerifierParticipantId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IssuerParticipantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuerParticipantId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrUpdateParticipantSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrUpdateParticipantSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrUpdateParticipantSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashParticipantTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashParticipantTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashParticipantTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceDigest) > 0 {
+		i -= len(m.EvidenceDigest)
+		copy(dAtA[i:], m.EvidenceDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceDigest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashParticipantTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashParticipantTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashParticipantTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DisputeDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DisputeDeadline):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if m.SlashId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayParticipantSlashedTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayParticipantSlashedTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayParticipantSlashedTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayParticipantSlashedTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayParticipantSlashedTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayParticipantSlashedTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelfCreateParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelfCreateParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelfCreateParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VsOperatorAuthzMsgTypes) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VsOperatorAuthzMsgTypes[iNdEx])
+			copy(dAtA[i:], m.VsOperatorAuthzMsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperatorAuthzMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.VsOperatorAuthzPeriod != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VsOperatorAuthzPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintTx(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzFeeSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VsOperatorAuthzFeeSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.VsOperatorAuthzWithFeegrant {
+		i--
+		if m.VsOperatorAuthzWithFeegrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.VsOperatorAuthzSpendLimit) > 0 {
+		for iNdEx := len(m.VsOperatorAuthzSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VsOperatorAuthzSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VsOperator) > 0 {
+		i -= len(m.VsOperator)
+		copy(dAtA[i:], m.VsOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VsOperator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EffectiveUntil != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTx(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EffectiveFrom != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTx(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ValidatorParticipantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorParticipantId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelfCreateParticipantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelfCreateParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelfCreateParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeParticipantFeeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeParticipantFeeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeParticipantFeeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerificationFeeDiscount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFeeDiscount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IssuanceFeeDiscount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFeeDiscount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeParticipantFeeChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeParticipantFeeChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeParticipantFeeChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptParticipantFeeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptParticipantFeeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptParticipantFeeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptParticipantFeeChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptParticipantFeeChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptParticipantFeeChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Effective, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Effective):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTx(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceDigest) > 0 {
+		i -= len(m.EvidenceDigest)
+		copy(dAtA[i:], m.EvidenceDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceDigest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SlashId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealSlashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealSlashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveSlashAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveSlashAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveSlashAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveSlashAppealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveSlashAppealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveSlashAppealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendParticipantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsuspendParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsuspendParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsuspendParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsuspendParticipantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsuspendParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsuspendParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateParticipantDID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateParticipantDID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateParticipantDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewDid) > 0 {
+		i -= len(m.NewDid)
+		copy(dAtA[i:], m.NewDid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewDid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateParticipantDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateParticipantDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateParticipantDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchRevokeParticipants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchRevokeParticipants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchRevokeParticipants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchRevokeParticipantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchRevokeParticipantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchRevokeParticipantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntil) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntil) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntil) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchSelfCreateParticipants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchSelfCreateParticipants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchSelfCreateParticipants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchSelfCreateParticipantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchSelfCreateParticipantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchSelfCreateParticipantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStartParticipantOP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	if m.ValidatorParticipantId != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorParticipantId))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValidationFees != nil {
+		l = m.ValidationFees.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IssuanceFees != nil {
+		l = m.IssuanceFees.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VerificationFees != nil {
+		l = m.VerificationFees.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VsOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VsOperatorAuthzSpendLimit) > 0 {
+		for _, e := range m.VsOperatorAuthzSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VsOperatorAuthzWithFeegrant {
+		n += 2
+	}
+	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
+		for _, e := range m.VsOperatorAuthzFeeSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VsOperatorAuthzPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VsOperatorAuthzMsgTypes) > 0 {
+		for _, s := range m.VsOperatorAuthzMsgTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStartParticipantOPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParticipantId != 0 {
+		n += 1 + sovTx(uint64(m.ParticipantId))
+	}
+	return n
+}
+
+func (m *MsgRenewParticipantOP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRenewParticipantOPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetParticipantOPToValidated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTx(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	l = len(m.OpSummaryDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IssuanceFeeDiscount != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFeeDiscount))
+	}
+	if m.VerificationFeeDiscount != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFeeDiscount))
+	}
+	return n
+}
+
+func (m *MsgSetParticipantOPToValidatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelParticipantOPLastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelParticipantOPLastRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRootParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovTx(uint64(m.SchemaId))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTx(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	l = len(m.VsOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VsOperatorAuthzMsgTypes) > 0 {
+		for _, s := range m.VsOperatorAuthzMsgTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VsOperatorAuthzSpendLimit) > 0 {
+		for _, e := range m.VsOperatorAuthzSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VsOperatorAuthzWithFeegrant {
+		n += 2
+	}
+	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
+		for _, e := range m.VsOperatorAuthzFeeSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VsOperatorAuthzPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRootParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgSetParticipantEffectiveUntil) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetParticipantEffectiveUntilResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cascade != 0 {
+		n += 1 + sovTx(uint64(m.Cascade))
+	}
+	return n
+}
+
+func (m *MsgRevokeParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CascadeId != 0 {
+		n += 1 + sovTx(uint64(m.CascadeId))
+	}
+	return n
+}
+
+func (m *MsgCreateOrUpdateParticipantSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IssuerParticipantId != 0 {
+		n += 1 + sovTx(uint64(m.IssuerParticipantId))
+	}
+	if m.VerifierParticipantId != 0 {
+		n += 1 + sovTx(uint64(m.VerifierParticipantId))
+	}
+	if m.AgentParticipantId != 0 {
+		n += 1 + sovTx(uint64(m.AgentParticipantId))
+	}
+	if m.WalletAgentParticipantId != 0 {
+		n += 1 + sovTx(uint64(m.WalletAgentParticipantId))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateOrUpdateParticipantSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSlashParticipantTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSlashParticipantTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashId != 0 {
+		n += 1 + sovTx(uint64(m.SlashId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DisputeDeadline)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRepayParticipantSlashedTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgRepayParticipantSlashedTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelfCreateParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	if m.ValidatorParticipantId != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorParticipantId))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTx(uint64(m.ValidationFees))
+	}
+	l = len(m.VsOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VsOperatorAuthzSpendLimit) > 0 {
+		for _, e := range m.VsOperatorAuthzSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VsOperatorAuthzWithFeegrant {
+		n += 2
+	}
+	if len(m.VsOperatorAuthzFeeSpendLimit) > 0 {
+		for _, e := range m.VsOperatorAuthzFeeSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VsOperatorAuthzPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VsOperatorAuthzPeriod)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VsOperatorAuthzMsgTypes) > 0 {
+		for _, s := range m.VsOperatorAuthzMsgTypes {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSelfCreateParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgProposeParticipantFeeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTx(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	if m.IssuanceFeeDiscount != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFeeDiscount))
+	}
+	if m.VerificationFeeDiscount != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFeeDiscount))
+	}
+	return n
+}
+
+func (m *MsgProposeParticipantFeeChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptParticipantFeeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptParticipantFeeChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Effective)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAppealSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.SlashId != 0 {
+		n += 1 + sovTx(uint64(m.SlashId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveSlashAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.SlashId != 0 {
+		n += 1 + sovTx(uint64(m.SlashId))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveSlashAppealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuspendParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuspendParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnsuspendParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnsuspendParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateParticipantDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.NewDid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateParticipantDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchRevokeParticipants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchRevokeParticipantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntil) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchSelfCreateParticipants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchSelfCreateParticipantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartParticipantOP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartParticipantOP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ParticipantRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
+			}
+			m.ValidatorParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidationFees == nil {
+				m.ValidationFees = &OptionalUInt64{}
+			}
+			if err := m.ValidationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IssuanceFees == nil {
+				m.IssuanceFees = &OptionalUInt64{}
+			}
+			if err := m.IssuanceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerificationFees == nil {
+				m.VerificationFees = &OptionalUInt64{}
+			}
+			if err := m.VerificationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthzSpendLimit = append(m.VsOperatorAuthzSpendLimit, types.Coin{})
+			if err := m.VsOperatorAuthzSpendLimit[len(m.VsOperatorAuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzWithFeegrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VsOperatorAuthzWithFeegrant = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzFeeSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthzFeeSpendLimit = append(m.VsOperatorAuthzFeeSpendLimit, types.Coin{})
+			if err := m.VsOperatorAuthzFeeSpendLimit[len(m.VsOperatorAuthzFeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VsOperatorAuthzPeriod == nil {
+				m.VsOperatorAuthzPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VsOperatorAuthzPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthzMsgTypes = append(m.VsOperatorAuthzMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartParticipantOPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartParticipantOPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartParticipantOPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewParticipantOP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewParticipantOP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewParticipantOP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewParticipantOPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewParticipantOPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewParticipantOPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetParticipantOPToValidated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetParticipantOPToValidated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpSummaryDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpSummaryDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
+			}
+			m.IssuanceFeeDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
+			}
+			m.VerificationFeeDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFeeDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetParticipantOPToValidatedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetParticipantOPToValidatedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetParticipantOPToValidatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParticipantOPLastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParticipantOPLastRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRootParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRootParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthzMsgTypes = append(m.VsOperatorAuthzMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthzSpendLimit = append(m.VsOperatorAuthzSpendLimit, types.Coin{})
+			if err := m.VsOperatorAuthzSpendLimit[len(m.VsOperatorAuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzWithFeegrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VsOperatorAuthzWithFeegrant = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzFeeSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthzFeeSpendLimit = append(m.VsOperatorAuthzFeeSpendLimit, types.Coin{})
+			if err := m.VsOperatorAuthzFeeSpendLimit[len(m.VsOperatorAuthzFeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VsOperatorAuthzPeriod == nil {
+				m.VsOperatorAuthzPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VsOperatorAuthzPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRootParticipantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRootParticipantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRootParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetParticipantEffectiveUntil) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntil: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntil: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetParticipantEffectiveUntilResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntilResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntilResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4125,15 +9224,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4161,13 +9260,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4177,25 +9276,145 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EvidenceDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			m.Cascade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cascade |= CascadeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4217,7 +9436,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeParticipantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4240,12 +9459,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeParticipantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CascadeId", wireType)
+			}
+			m.CascadeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CascadeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4267,7 +9505,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOrUpdateParticipantSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4290,10 +9528,10 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartParticipantOP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartParticipantOP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4361,10 +9599,10 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4374,16 +9612,29 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= ParticipantRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
 			}
-			m.ValidatorParticipantId = 0
+			m.IssuerParticipantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4393,16 +9644,16 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorParticipantId |= uint64(b&0x7F) << shift
+				m.IssuerParticipantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
 			}
-			var stringLen uint64
+			m.VerifierParticipantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4412,29 +9663,16 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VerifierParticipantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentParticipantId", wireType)
 			}
-			var msglen int
+			m.AgentParticipantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4444,33 +9682,35 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AgentParticipantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ValidationFees == nil {
-				m.ValidationFees = &OptionalUInt64{}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentParticipantId", wireType)
 			}
-			if err := m.ValidationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.WalletAgentParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletAgentParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4480,33 +9720,79 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IssuanceFees == nil {
-				m.IssuanceFees = &OptionalUInt64{}
-			}
-			if err := m.IssuanceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrUpdateParticipantSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4516,31 +9802,77 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerificationFees == nil {
-				m.VerificationFees = &OptionalUInt64{}
-			}
-			if err := m.VerificationFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashParticipantTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashParticipantTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashParticipantTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4568,13 +9900,13 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4584,31 +9916,29 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzSpendLimit = append(m.VsOperatorAuthzSpendLimit, types.Coin{})
-			if err := m.VsOperatorAuthzSpendLimit[len(m.VsOperatorAuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzWithFeegrant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4618,17 +9948,16 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.VsOperatorAuthzWithFeegrant = bool(v != 0)
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzFeeSpendLimit", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4638,31 +9967,16 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VsOperatorAuthzFeeSpendLimit = append(m.VsOperatorAuthzFeeSpendLimit, types.Coin{})
-			if err := m.VsOperatorAuthzFeeSpendLimit[len(m.VsOperatorAuthzFeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4672,31 +9986,27 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VsOperatorAuthzPeriod == nil {
-				m.VsOperatorAuthzPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VsOperatorAuthzPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzMsgTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4724,7 +10034,7 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzMsgTypes = append(m.VsOperatorAuthzMsgTypes, string(dAtA[iNdEx:postIndex]))
+			m.EvidenceDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4747,7 +10057,7 @@ func (m *MsgStartParticipantOP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartParticipantOPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSlashParticipantTrustDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4770,17 +10080,17 @@ func (m *MsgStartParticipantOPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartParticipantOPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSlashParticipantTrustDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartParticipantOPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSlashParticipantTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
 			}
-			m.ParticipantId = 0
+			m.SlashId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4790,11 +10100,44 @@ func (m *MsgStartParticipantOPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParticipantId |= uint64(b&0x7F) << shift
+				m.SlashId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DisputeDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4816,7 +10159,7 @@ func (m *MsgStartParticipantOPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewParticipantOP) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayParticipantSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4839,10 +10182,10 @@ func (m *MsgRenewParticipantOP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewParticipantOP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewParticipantOP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4928,6 +10271,25 @@ func (m *MsgRenewParticipantOP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4949,7 +10311,7 @@ func (m *MsgRenewParticipantOP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewParticipantOPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayParticipantSlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4972,10 +10334,10 @@ func (m *MsgRenewParticipantOPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewParticipantOPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewParticipantOPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4999,7 +10361,7 @@ func (m *MsgRenewParticipantOPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
+func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5022,10 +10384,10 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetParticipantOPToValidated: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelfCreateParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetParticipantOPToValidated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelfCreateParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5094,9 +10456,9 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.Id = 0
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5106,14 +10468,65 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Role |= ParticipantRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
+			}
+			m.ValidatorParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5140,18 +10553,18 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
 			}
-			m.ValidationFees = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5161,16 +10574,33 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidationFees |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
 			}
-			m.IssuanceFees = 0
+			m.VerificationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5180,16 +10610,16 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuanceFees |= uint64(b&0x7F) << shift
+				m.VerificationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 			}
-			m.VerificationFees = 0
+			m.ValidationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5199,14 +10629,14 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerificationFees |= uint64(b&0x7F) << shift
+				m.ValidationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpSummaryDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5234,32 +10664,13 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpSummaryDigest = string(dAtA[iNdEx:postIndex])
+			m.VsOperator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
-			}
-			m.IssuanceFeeDiscount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzSpendLimit", wireType)
 			}
-			m.VerificationFeeDiscount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5269,116 +10680,51 @@ func (m *MsgSetParticipantOPToValidated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerificationFeeDiscount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetParticipantOPToValidatedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetParticipantOPToValidatedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetParticipantOPToValidatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelParticipantOPLastRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.VsOperatorAuthzSpendLimit = append(m.VsOperatorAuthzSpendLimit, types.Coin{})
+			if err := m.VsOperatorAuthzSpendLimit[len(m.VsOperatorAuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzWithFeegrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VsOperatorAuthzWithFeegrant = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzFeeSpendLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5388,29 +10734,31 @@ func (m *MsgCancelParticipantOPLastRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corporation = string(dAtA[iNdEx:postIndex])
+			m.VsOperatorAuthzFeeSpendLimit = append(m.VsOperatorAuthzFeeSpendLimit, types.Coin{})
+			if err := m.VsOperatorAuthzFeeSpendLimit[len(m.VsOperatorAuthzFeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5420,29 +10768,33 @@ func (m *MsgCancelParticipantOPLastRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			if m.VsOperatorAuthzPeriod == nil {
+				m.VsOperatorAuthzPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VsOperatorAuthzPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzMsgTypes", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5452,11 +10804,24 @@ func (m *MsgCancelParticipantOPLastRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperatorAuthzMsgTypes = append(m.VsOperatorAuthzMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5478,7 +10843,7 @@ func (m *MsgCancelParticipantOPLastRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelParticipantOPLastRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSelfCreateParticipantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5501,12 +10866,31 @@ func (m *MsgCancelParticipantOPLastRequestResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelfCreateParticipantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelParticipantOPLastRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelfCreateParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5528,7 +10912,7 @@ func (m *MsgCancelParticipantOPLastRequestResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeParticipantFeeChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5551,10 +10935,10 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRootParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeParticipantFeeChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRootParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeParticipantFeeChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5593,126 +10977,7 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
-			}
-			m.SchemaId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SchemaId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EffectiveFrom == nil {
-				m.EffectiveFrom = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
-			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5722,29 +10987,44 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 			}
@@ -5763,7 +11043,7 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
 			}
@@ -5782,7 +11062,7 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 9:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
 			}
@@ -5801,11 +11081,11 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
 			}
-			var stringLen uint64
+			m.IssuanceFeeDiscount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5815,29 +11095,16 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VsOperator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzMsgTypes", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
 			}
-			var stringLen uint64
+			m.VerificationFeeDiscount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5847,29 +11114,116 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VerificationFeeDiscount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeParticipantFeeChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeParticipantFeeChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeParticipantFeeChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzMsgTypes = append(m.VsOperatorAuthzMsgTypes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 12:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptParticipantFeeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptParticipantFeeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptParticipantFeeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5879,51 +11233,29 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzSpendLimit = append(m.VsOperatorAuthzSpendLimit, types.Coin{})
-			if err := m.VsOperatorAuthzSpendLimit[len(m.VsOperatorAuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzWithFeegrant", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VsOperatorAuthzWithFeegrant = bool(v != 0)
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzFeeSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5933,31 +11265,29 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzFeeSpendLimit = append(m.VsOperatorAuthzFeeSpendLimit, types.Coin{})
-			if err := m.VsOperatorAuthzFeeSpendLimit[len(m.VsOperatorAuthzFeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzPeriod", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5967,28 +11297,11 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VsOperatorAuthzPeriod == nil {
-				m.VsOperatorAuthzPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VsOperatorAuthzPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6010,7 +11323,7 @@ func (m *MsgCreateRootParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRootParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptParticipantFeeChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6033,17 +11346,17 @@ func (m *MsgCreateRootParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRootParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptParticipantFeeChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRootParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptParticipantFeeChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6053,11 +11366,25 @@ func (m *MsgCreateRootParticipantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Effective, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6079,7 +11406,7 @@ func (m *MsgCreateRootParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetParticipantEffectiveUntil) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6102,10 +11429,10 @@ func (m *MsgSetParticipantEffectiveUntil) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntil: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntil: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6192,10 +11519,29 @@ func (m *MsgSetParticipantEffectiveUntil) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
+			}
+			m.SlashId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6205,27 +11551,55 @@ func (m *MsgSetParticipantEffectiveUntil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6248,7 +11622,7 @@ func (m *MsgSetParticipantEffectiveUntil) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetParticipantEffectiveUntilResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealSlashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6271,12 +11645,44 @@ func (m *MsgSetParticipantEffectiveUntilResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntilResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealSlashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetParticipantEffectiveUntilResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6298,7 +11704,7 @@ func (m *MsgSetParticipantEffectiveUntilResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveSlashAppeal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6321,15 +11727,15 @@ func (m *MsgRevokeParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveSlashAppeal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveSlashAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6357,13 +11763,13 @@ func (m *MsgRevokeParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corporation = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6373,29 +11779,35 @@ func (m *MsgRevokeParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SlashId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6405,11 +11817,12 @@ func (m *MsgRevokeParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Reverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6431,7 +11844,7 @@ func (m *MsgRevokeParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveSlashAppealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6454,10 +11867,10 @@ func (m *MsgRevokeParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveSlashAppealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveSlashAppealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6481,7 +11894,7 @@ func (m *MsgRevokeParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOrUpdateParticipantSession) Unmarshal(dAtA []byte) error {
+func (m *MsgSuspendParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6504,10 +11917,10 @@ func (m *MsgCreateOrUpdateParticipantSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuspendParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuspendParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6575,99 +11988,10 @@ func (m *MsgCreateOrUpdateParticipantSession) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerParticipantId", wireType)
-			}
-			m.IssuerParticipantId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuerParticipantId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifierParticipantId", wireType)
-			}
-			m.VerifierParticipantId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerifierParticipantId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentParticipantId", wireType)
-			}
-			m.AgentParticipantId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AgentParticipantId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentParticipantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.WalletAgentParticipantId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6677,14 +12001,14 @@ func (m *MsgCreateOrUpdateParticipantSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WalletAgentParticipantId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6712,7 +12036,7 @@ func (m *MsgCreateOrUpdateParticipantSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6735,7 +12059,7 @@ func (m *MsgCreateOrUpdateParticipantSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOrUpdateParticipantSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuspendParticipantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6758,44 +12082,12 @@ func (m *MsgCreateOrUpdateParticipantSessionResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuspendParticipantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOrUpdateParticipantSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuspendParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6817,7 +12109,7 @@ func (m *MsgCreateOrUpdateParticipantSessionResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MsgSlashParticipantTrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgUnsuspendParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6840,10 +12132,10 @@ func (m *MsgSlashParticipantTrustDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashParticipantTrustDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnsuspendParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashParticipantTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnsuspendParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6930,25 +12222,6 @@ func (m *MsgSlashParticipantTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
@@ -7001,7 +12274,7 @@ func (m *MsgSlashParticipantTrustDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSlashParticipantTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnsuspendParticipantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7024,10 +12297,10 @@ func (m *MsgSlashParticipantTrustDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashParticipantTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnsuspendParticipantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashParticipantTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnsuspendParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7051,7 +12324,7 @@ func (m *MsgSlashParticipantTrustDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayParticipantSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateParticipantDID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7074,10 +12347,10 @@ func (m *MsgRepayParticipantSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateParticipantDID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateParticipantDID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7164,10 +12437,10 @@ func (m *MsgRepayParticipantSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDid", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7177,11 +12450,24 @@ func (m *MsgRepayParticipantSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7203,7 +12489,7 @@ func (m *MsgRepayParticipantSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayParticipantSlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateParticipantDIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7226,10 +12512,10 @@ func (m *MsgRepayParticipantSlashedTrustDepositResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateParticipantDIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayParticipantSlashedTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateParticipantDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7253,7 +12539,7 @@ func (m *MsgRepayParticipantSlashedTrustDepositResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchRevokeParticipants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7276,10 +12562,10 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelfCreateParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchRevokeParticipants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelfCreateParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchRevokeParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7347,48 +12633,10 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
-			}
-			m.Role = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Role |= ParticipantRole(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
-			}
-			m.ValidatorParticipantId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorParticipantId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7398,27 +12646,79 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, MsgRevokeParticipant{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchRevokeParticipantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchRevokeParticipantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchRevokeParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7445,18 +12745,66 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveFrom == nil {
-				m.EffectiveFrom = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, MsgRevokeParticipantResponse{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchSetParticipantEffectiveUntil) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntil: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntil: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7466,69 +12814,27 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
-			}
-			m.VerificationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerificationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
-			}
-			m.ValidationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7556,11 +12862,11 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7587,16 +12893,66 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzSpendLimit = append(m.VsOperatorAuthzSpendLimit, types.Coin{})
-			if err := m.VsOperatorAuthzSpendLimit[len(m.VsOperatorAuthzSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, MsgSetParticipantEffectiveUntil{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzWithFeegrant", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchSetParticipantEffectiveUntilResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntilResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchSetParticipantEffectiveUntilResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7606,17 +12962,81 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.VsOperatorAuthzWithFeegrant = bool(v != 0)
-		case 14:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MsgSetParticipantEffectiveUntilResponse{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchSelfCreateParticipants) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchSelfCreateParticipants: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchSelfCreateParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzFeeSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7626,31 +13046,29 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzFeeSpendLimit = append(m.VsOperatorAuthzFeeSpendLimit, types.Coin{})
-			if err := m.VsOperatorAuthzFeeSpendLimit[len(m.VsOperatorAuthzFeeSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7660,33 +13078,29 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VsOperatorAuthzPeriod == nil {
-				m.VsOperatorAuthzPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VsOperatorAuthzPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperatorAuthzMsgTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7696,23 +13110,25 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperatorAuthzMsgTypes = append(m.VsOperatorAuthzMsgTypes, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, MsgSelfCreateParticipant{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7735,7 +13151,7 @@ func (m *MsgSelfCreateParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelfCreateParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchSelfCreateParticipantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7758,17 +13174,17 @@ func (m *MsgSelfCreateParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelfCreateParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchSelfCreateParticipantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelfCreateParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchSelfCreateParticipantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7778,11 +13194,26 @@ func (m *MsgSelfCreateParticipantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MsgSelfCreateParticipantResponse{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19,6 +19,18 @@ const (
 	MsgTriggerResolverTypeURL = "/verana.pp.v1.MsgTriggerResolver"
 )
 
+// MaxRevocationEvidenceLength bounds the free-text evidence stored on a
+// revoked participant.
+const MaxRevocationEvidenceLength = 1024
+
+// MaxSuspensionReasonLength bounds the free-text reasons of a suspension and
+// of its lifting, both stored on the participant.
+const MaxSuspensionReasonLength = 1024
+
+// MaxSlashReasonLength bounds the free-text reasons of a slash and of its
+// appeal, both stored on the participant.
+const MaxSlashReasonLength = 1024
+
 // vsoaPermittedMsgTypes returns the set of msg_types a vs_operator may be granted
 // for the given participant role, or nil if the role cannot be granted VSOA.
 func vsoaPermittedMsgTypes(role ParticipantRole) map[string]bool {
@@ -306,6 +318,20 @@ func (msg *MsgRevokeParticipant) ValidateBasic() error {
 		return fmt.Errorf("participant ID cannot be 0")
 	}
 
+	// Validate revocation reason and evidence (optional)
+	if _, ok := RevocationReason_name[int32(msg.Reason)]; !ok {
+		return fmt.Errorf("invalid revocation reason: %d", msg.Reason)
+	}
+	if len(msg.Evidence) > MaxRevocationEvidenceLength {
+		return fmt.Errorf("evidence must not exceed %d characters", MaxRevocationEvidenceLength)
+	}
+	if msg.EvidenceDigest != "" && !validation.IsValidDigestSRI(msg.EvidenceDigest) {
+		return fmt.Errorf("invalid evidence_digest format")
+	}
+	if _, ok := CascadeMode_name[int32(msg.Cascade)]; !ok {
+		return fmt.Errorf("invalid cascade mode: %d", msg.Cascade)
+	}
+
 	return nil
 }
 
@@ -372,6 +398,16 @@ func (msg *MsgSlashParticipantTrustDeposit) ValidateBasic() error {
 	if msg.Reason == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("reason is required")
 	}
+	if len(msg.Reason) > MaxSlashReasonLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("reason must not exceed %d characters", MaxSlashReasonLength)
+	}
+	// the slash evidence is mandatory and MUST be anchored in x/di
+	if msg.EvidenceDigest == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("evidence_digest is required")
+	}
+	if !validation.IsValidDigestSRI(msg.EvidenceDigest) {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid evidence_digest format")
+	}
 	return nil
 }
 
@@ -451,3 +487,250 @@ func (msg *MsgSelfCreateParticipant) ValidateBasic() error {
 
 	return nil
 }
+
+func (msg *MsgProposeParticipantFeeChange) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// Validate operator address
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	// Validate participant ID
+	if msg.Id == 0 {
+		return fmt.Errorf("participant ID cannot be 0")
+	}
+
+	// Validate discount fields (scaled: 0 = 0.0, 10000 = 1.0, range 0-10000)
+	const maxDiscount = 10000 // 10000 = 100% discount = 1.0
+	if msg.IssuanceFeeDiscount > maxDiscount {
+		return fmt.Errorf("issuance_fee_discount cannot exceed %d (100%% discount)", maxDiscount)
+	}
+	if msg.VerificationFeeDiscount > maxDiscount {
+		return fmt.Errorf("verification_fee_discount cannot exceed %d (100%% discount)", maxDiscount)
+	}
+
+	return nil
+}
+
+func (msg *MsgAcceptParticipantFeeChange) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// Validate operator address
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	// Validate participant ID
+	if msg.Id == 0 {
+		return fmt.Errorf("participant ID cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg *MsgAppealSlash) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// Validate operator address
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	// Validate participant and slash IDs
+	if msg.Id == 0 {
+		return fmt.Errorf("participant ID cannot be 0")
+	}
+	if msg.SlashId == 0 {
+		return fmt.Errorf("slash ID cannot be 0")
+	}
+
+	// Validate appeal reason and evidence
+	if msg.Reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	if len(msg.Reason) > MaxSlashReasonLength {
+		return fmt.Errorf("reason must not exceed %d characters", MaxSlashReasonLength)
+	}
+	if msg.EvidenceDigest != "" && !validation.IsValidDigestSRI(msg.EvidenceDigest) {
+		return fmt.Errorf("invalid evidence_digest format")
+	}
+
+	return nil
+}
+
+func (msg *MsgResolveSlashAppeal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	// Validate participant and slash IDs
+	if msg.Id == 0 {
+		return fmt.Errorf("participant ID cannot be 0")
+	}
+	if msg.SlashId == 0 {
+		return fmt.Errorf("slash ID cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg *MsgSuspendParticipant) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// Validate operator address
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	// Validate participant ID
+	if msg.Id == 0 {
+		return fmt.Errorf("participant ID cannot be 0")
+	}
+
+	if len(msg.Reason) > MaxSuspensionReasonLength {
+		return fmt.Errorf("reason must not exceed %d characters", MaxSuspensionReasonLength)
+	}
+
+	return nil
+}
+
+func (msg *MsgUnsuspendParticipant) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// Validate operator address
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	// Validate participant ID
+	if msg.Id == 0 {
+		return fmt.Errorf("participant ID cannot be 0")
+	}
+
+	if len(msg.Reason) > MaxSuspensionReasonLength {
+		return fmt.Errorf("reason must not exceed %d characters", MaxSuspensionReasonLength)
+	}
+
+	return nil
+}
+
+func (msg *MsgRotateParticipantDID) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(msg.Corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// Validate operator address
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	// Validate participant ID
+	if msg.Id == 0 {
+		return fmt.Errorf("participant ID cannot be 0")
+	}
+
+	// new_did MUST conform to DID Syntax
+	if msg.NewDid == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("new_did is mandatory")
+	}
+	if !validation.IsValidDID(msg.NewDid) {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid DID syntax")
+	}
+
+	return nil
+}
+
+// validateBatch checks the signer addresses of a batch and that it is not
+// empty. The max_batch_size param is enforced by the handler.
+func validateBatch(corporation, operator string, size int) error {
+	if _, err := sdk.AccAddressFromBech32(corporation); err != nil {
+		return fmt.Errorf("invalid corporation address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if size == 0 {
+		return fmt.Errorf("batch must not be empty")
+	}
+	return nil
+}
+
+// validateBatchItemSigners checks that a batch item leaves corporation and
+// operator empty or sets them to the ones of the batch.
+func validateBatchItemSigners(i int, corporation, operator, itemCorporation, itemOperator string) error {
+	if itemCorporation != "" && itemCorporation != corporation {
+		return fmt.Errorf("item %d: corporation must be empty or the batch corporation", i)
+	}
+	if itemOperator != "" && itemOperator != operator {
+		return fmt.Errorf("item %d: operator must be empty or the batch operator", i)
+	}
+	return nil
+}
+
+func (msg *MsgBatchRevokeParticipants) ValidateBasic() error {
+	if err := validateBatch(msg.Corporation, msg.Operator, len(msg.Items)); err != nil {
+		return err
+	}
+	for i := range msg.Items {
+		item := msg.Items[i]
+		if err := validateBatchItemSigners(i, msg.Corporation, msg.Operator, item.Corporation, item.Operator); err != nil {
+			return err
+		}
+		item.Corporation, item.Operator = msg.Corporation, msg.Operator
+		if err := item.ValidateBasic(); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (msg *MsgBatchSetParticipantEffectiveUntil) ValidateBasic() error {
+	if err := validateBatch(msg.Corporation, msg.Operator, len(msg.Items)); err != nil {
+		return err
+	}
+	for i := range msg.Items {
+		item := msg.Items[i]
+		if err := validateBatchItemSigners(i, msg.Corporation, msg.Operator, item.Corporation, item.Operator); err != nil {
+			return err
+		}
+		item.Corporation, item.Operator = msg.Corporation, msg.Operator
+		if err := item.ValidateBasic(); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (msg *MsgBatchSelfCreateParticipants) ValidateBasic() error {
+	if err := validateBatch(msg.Corporation, msg.Operator, len(msg.Items)); err != nil {
+		return err
+	}
+	for i := range msg.Items {
+		item := msg.Items[i]
+		if err := validateBatchItemSigners(i, msg.Corporation, msg.Operator, item.Corporation, item.Operator); err != nil {
+			return err
+		}
+		item.Corporation, item.Operator = msg.Corporation, msg.Operator
+		if err := item.ValidateBasic(); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -101,6 +101,114 @@ func (OnboardingState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1147dec47e58616a, []int{1}
 }
 
+// Reason recorded when a participant is revoked
+type RevocationReason int32
+
+const (
+	RevocationReason_REVOCATION_REASON_UNSPECIFIED        RevocationReason = 0
+	RevocationReason_REVOCATION_REASON_KEY_COMPROMISE     RevocationReason = 1
+	RevocationReason_REVOCATION_REASON_ECOSYSTEM_DECISION RevocationReason = 2
+	RevocationReason_REVOCATION_REASON_VOLUNTARY_EXIT     RevocationReason = 3
+	RevocationReason_REVOCATION_REASON_FRAUD              RevocationReason = 4
+	RevocationReason_REVOCATION_REASON_OTHER              RevocationReason = 5
+)
+
+var RevocationReason_name = map[int32]string{
+	0: "REVOCATION_REASON_UNSPECIFIED",
+	1: "REVOCATION_REASON_KEY_COMPROMISE",
+	2: "REVOCATION_REASON_ECOSYSTEM_DECISION",
+	3: "REVOCATION_REASON_VOLUNTARY_EXIT",
+	4: "REVOCATION_REASON_FRAUD",
+	5: "REVOCATION_REASON_OTHER",
+}
+
+var RevocationReason_value = map[string]int32{
+	"REVOCATION_REASON_UNSPECIFIED":        0,
+	"REVOCATION_REASON_KEY_COMPROMISE":     1,
+	"REVOCATION_REASON_ECOSYSTEM_DECISION": 2,
+	"REVOCATION_REASON_VOLUNTARY_EXIT":     3,
+	"REVOCATION_REASON_FRAUD":              4,
+	"REVOCATION_REASON_OTHER":              5,
+}
+
+func (x RevocationReason) String() string {
+	return proto.EnumName(RevocationReason_name, int32(x))
+}
+
+func (RevocationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{2}
+}
+
+type SlashStatus int32
+
+const (
+	SlashStatus_SLASH_STATUS_UNSPECIFIED SlashStatus = 0
+	// PENDING slashes can be appealed until dispute_deadline
+	SlashStatus_SLASH_STATUS_PENDING SlashStatus = 1
+	// APPEALED slashes wait for the decision of the arbiter
+	SlashStatus_SLASH_STATUS_APPEALED SlashStatus = 2
+	// CONFIRMED slashes are final, the slashed amount was burnt
+	SlashStatus_SLASH_STATUS_CONFIRMED SlashStatus = 3
+	// REVERSED slashes were cancelled by the arbiter, the slashed amount was
+	// restored to the participant deposit
+	SlashStatus_SLASH_STATUS_REVERSED SlashStatus = 4
+)
+
+var SlashStatus_name = map[int32]string{
+	0: "SLASH_STATUS_UNSPECIFIED",
+	1: "SLASH_STATUS_PENDING",
+	2: "SLASH_STATUS_APPEALED",
+	3: "SLASH_STATUS_CONFIRMED",
+	4: "SLASH_STATUS_REVERSED",
+}
+
+var SlashStatus_value = map[string]int32{
+	"SLASH_STATUS_UNSPECIFIED": 0,
+	"SLASH_STATUS_PENDING":     1,
+	"SLASH_STATUS_APPEALED":    2,
+	"SLASH_STATUS_CONFIRMED":   3,
+	"SLASH_STATUS_REVERSED":    4,
+}
+
+func (x SlashStatus) String() string {
+	return proto.EnumName(SlashStatus_name, int32(x))
+}
+
+func (SlashStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{3}
+}
+
+// CascadeMode selects what happens to the descendants of a revoked
+// participant, i.e. the participants it validated, directly or not.
+type CascadeMode int32
+
+const (
+	// descendants are left untouched
+	CascadeMode_CASCADE_MODE_UNSPECIFIED CascadeMode = 0
+	CascadeMode_CASCADE_MODE_REVOKE      CascadeMode = 1
+	CascadeMode_CASCADE_MODE_SUSPEND     CascadeMode = 2
+)
+
+var CascadeMode_name = map[int32]string{
+	0: "CASCADE_MODE_UNSPECIFIED",
+	1: "CASCADE_MODE_REVOKE",
+	2: "CASCADE_MODE_SUSPEND",
+}
+
+var CascadeMode_value = map[string]int32{
+	"CASCADE_MODE_UNSPECIFIED": 0,
+	"CASCADE_MODE_REVOKE":      1,
+	"CASCADE_MODE_SUSPEND":     2,
+}
+
+func (x CascadeMode) String() string {
+	return proto.EnumName(CascadeMode_name, int32(x))
+}
+
+func (CascadeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{4}
+}
+
 type Participant struct {
 	Id                     uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	SchemaId               uint64          `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
@@ -139,6 +247,30 @@ type Participant struct {
 	// corporation_id is the uint64 FK to Corporation.id (resolved from the
 	// signing corporation account via AUTHZ-CHECK-5). Replaces former field 5.
 	CorporationId uint64 `protobuf:"varint,43,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// revocation_reason, revocation_evidence and revocation_evidence_digest
+	// are set together with revoked by RevokeParticipant.
+	RevocationReason         RevocationReason `protobuf:"varint,44,opt,name=revocation_reason,json=revocationReason,proto3,enum=verana.pp.v1.RevocationReason" json:"revocation_reason,omitempty"`
+	RevocationEvidence       string           `protobuf:"bytes,45,opt,name=revocation_evidence,json=revocationEvidence,proto3" json:"revocation_evidence,omitempty"`
+	RevocationEvidenceDigest string           `protobuf:"bytes,46,opt,name=revocation_evidence_digest,json=revocationEvidenceDigest,proto3" json:"revocation_evidence_digest,omitempty"`
+	// pending_fee_change is the fee change proposed by the validator, if any.
+	// Once accepted it replaces the fees below at pending_fee_change.effective.
+	PendingFeeChange *ParticipantFeeChange `protobuf:"bytes,47,opt,name=pending_fee_change,json=pendingFeeChange,proto3" json:"pending_fee_change,omitempty"`
+	// slashes records every slash of the participant trust deposit, with its
+	// evidence and the outcome of its dispute.
+	Slashes []*ParticipantSlash `protobuf:"bytes,48,rep,name=slashes,proto3" json:"slashes,omitempty"`
+	// suspended is set while the participant is suspended. A suspended
+	// participant is not valid until it is unsuspended.
+	Suspended *time.Time `protobuf:"bytes,49,opt,name=suspended,proto3,stdtime" json:"suspended,omitempty"`
+	// suspensions is the suspension history of the participant, oldest first.
+	Suspensions []*ParticipantSuspension `protobuf:"bytes,50,rep,name=suspensions,proto3" json:"suspensions,omitempty"`
+	// did_history are the previous dids of the participant, oldest first.
+	DidHistory []*ParticipantDIDRecord `protobuf:"bytes,51,rep,name=did_history,json=didHistory,proto3" json:"did_history,omitempty"`
+	// released_deposit is the part of the deposit released on revocation that
+	// unbonds until released_deposit_unbonding_until. It stays slashable until
+	// then, and bounds what a slash can hold back from the unbonding trust
+	// deposit of the corporation.
+	ReleasedDeposit               uint64     `protobuf:"varint,52,opt,name=released_deposit,json=releasedDeposit,proto3" json:"released_deposit,omitempty"`
+	ReleasedDepositUnbondingUntil *time.Time `protobuf:"bytes,53,opt,name=released_deposit_unbonding_until,json=releasedDepositUnbondingUntil,proto3,stdtime" json:"released_deposit_unbonding_until,omitempty"`
 }
 
 func (m *Participant) Reset()         { *m = Participant{} }
@@ -384,111 +516,165 @@ func (m *Participant) GetCorporationId() uint64 {
 	return 0
 }
 
-type ParticipantSession struct {
-	Id             string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CorporationId  uint64                      `protobuf:"varint,2,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
-	VsOperator     string                      `protobuf:"bytes,3,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
-	Created        *time.Time                  `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created,omitempty"`
-	Modified       *time.Time                  `protobuf:"bytes,5,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
-	SessionRecords []*ParticipantSessionRecord `protobuf:"bytes,6,rep,name=session_records,json=sessionRecords,proto3" json:"session_records,omitempty"`
+func (m *Participant) GetRevocationReason() RevocationReason {
+	if m != nil {
+		return m.RevocationReason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
 }
 
-func (m *ParticipantSession) Reset()         { *m = ParticipantSession{} }
-func (m *ParticipantSession) String() string { return proto.CompactTextString(m) }
-func (*ParticipantSession) ProtoMessage()    {}
-func (*ParticipantSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1147dec47e58616a, []int{1}
-}
-func (m *ParticipantSession) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Participant) GetRevocationEvidence() string {
+	if m != nil {
+		return m.RevocationEvidence
+	}
+	return ""
 }
-func (m *ParticipantSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ParticipantSession.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Participant) GetRevocationEvidenceDigest() string {
+	if m != nil {
+		return m.RevocationEvidenceDigest
 	}
+	return ""
 }
-func (m *ParticipantSession) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParticipantSession.Merge(m, src)
+
+func (m *Participant) GetPendingFeeChange() *ParticipantFeeChange {
+	if m != nil {
+		return m.PendingFeeChange
+	}
+	return nil
 }
-func (m *ParticipantSession) XXX_Size() int {
-	return m.Size()
+
+func (m *Participant) GetSlashes() []*ParticipantSlash {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
 }
-func (m *ParticipantSession) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParticipantSession.DiscardUnknown(m)
+
+func (m *Participant) GetSuspended() *time.Time {
+	if m != nil {
+		return m.Suspended
+	}
+	return nil
 }
 
-var xxx_messageInfo_ParticipantSession proto.InternalMessageInfo
+func (m *Participant) GetSuspensions() []*ParticipantSuspension {
+	if m != nil {
+		return m.Suspensions
+	}
+	return nil
+}
 
-func (m *ParticipantSession) GetId() string {
+func (m *Participant) GetDidHistory() []*ParticipantDIDRecord {
 	if m != nil {
-		return m.Id
+		return m.DidHistory
 	}
-	return ""
+	return nil
 }
 
-func (m *ParticipantSession) GetCorporationId() uint64 {
+func (m *Participant) GetReleasedDeposit() uint64 {
 	if m != nil {
-		return m.CorporationId
+		return m.ReleasedDeposit
 	}
 	return 0
 }
 
-func (m *ParticipantSession) GetVsOperator() string {
+func (m *Participant) GetReleasedDepositUnbondingUntil() *time.Time {
 	if m != nil {
-		return m.VsOperator
+		return m.ReleasedDepositUnbondingUntil
 	}
-	return ""
+	return nil
 }
 
-func (m *ParticipantSession) GetCreated() *time.Time {
+// ParticipantDIDRecord is a previous did of a participant, which was its did
+// from valid_from included to valid_until excluded.
+type ParticipantDIDRecord struct {
+	Did        string     `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	ValidFrom  *time.Time `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
+	ValidUntil *time.Time `protobuf:"bytes,3,opt,name=valid_until,json=validUntil,proto3,stdtime" json:"valid_until,omitempty"`
+}
+
+func (m *ParticipantDIDRecord) Reset()         { *m = ParticipantDIDRecord{} }
+func (m *ParticipantDIDRecord) String() string { return proto.CompactTextString(m) }
+func (*ParticipantDIDRecord) ProtoMessage()    {}
+func (*ParticipantDIDRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{1}
+}
+func (m *ParticipantDIDRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantDIDRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantDIDRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantDIDRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantDIDRecord.Merge(m, src)
+}
+func (m *ParticipantDIDRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantDIDRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantDIDRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantDIDRecord proto.InternalMessageInfo
+
+func (m *ParticipantDIDRecord) GetDid() string {
 	if m != nil {
-		return m.Created
+		return m.Did
 	}
-	return nil
+	return ""
 }
 
-func (m *ParticipantSession) GetModified() *time.Time {
+func (m *ParticipantDIDRecord) GetValidFrom() *time.Time {
 	if m != nil {
-		return m.Modified
+		return m.ValidFrom
 	}
 	return nil
 }
 
-func (m *ParticipantSession) GetSessionRecords() []*ParticipantSessionRecord {
+func (m *ParticipantDIDRecord) GetValidUntil() *time.Time {
 	if m != nil {
-		return m.SessionRecords
+		return m.ValidUntil
 	}
 	return nil
 }
 
-type ParticipantSessionRecord struct {
-	Id                       uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created                  *time.Time `protobuf:"bytes,2,opt,name=created,proto3,stdtime" json:"created,omitempty"`
-	IssuerParticipantId      uint64     `protobuf:"varint,3,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`
-	VerifierParticipantId    uint64     `protobuf:"varint,4,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`
-	WalletAgentParticipantId uint64     `protobuf:"varint,5,opt,name=wallet_agent_participant_id,json=walletAgentParticipantId,proto3" json:"wallet_agent_participant_id,omitempty"`
-	AgentParticipantId       uint64     `protobuf:"varint,6,opt,name=agent_participant_id,json=agentParticipantId,proto3" json:"agent_participant_id,omitempty"`
+// ParticipantFeeChange is a fee schedule change proposed by the validator of
+// a participant and accepted by the participant.
+type ParticipantFeeChange struct {
+	ValidationFees          uint64     `protobuf:"varint,1,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees            uint64     `protobuf:"varint,2,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees        uint64     `protobuf:"varint,3,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	IssuanceFeeDiscount     uint64     `protobuf:"varint,4,opt,name=issuance_fee_discount,json=issuanceFeeDiscount,proto3" json:"issuance_fee_discount,omitempty"`
+	VerificationFeeDiscount uint64     `protobuf:"varint,5,opt,name=verification_fee_discount,json=verificationFeeDiscount,proto3" json:"verification_fee_discount,omitempty"`
+	ProposerCorporationId   uint64     `protobuf:"varint,6,opt,name=proposer_corporation_id,json=proposerCorporationId,proto3" json:"proposer_corporation_id,omitempty"`
+	Proposed                *time.Time `protobuf:"bytes,7,opt,name=proposed,proto3,stdtime" json:"proposed,omitempty"`
+	// accepted and effective are null until the participant accepts the change
+	Accepted  *time.Time `protobuf:"bytes,8,opt,name=accepted,proto3,stdtime" json:"accepted,omitempty"`
+	Effective *time.Time `protobuf:"bytes,9,opt,name=effective,proto3,stdtime" json:"effective,omitempty"`
 }
 
-func (m *ParticipantSessionRecord) Reset()         { *m = ParticipantSessionRecord{} }
-func (m *ParticipantSessionRecord) String() string { return proto.CompactTextString(m) }
-func (*ParticipantSessionRecord) ProtoMessage()    {}
-func (*ParticipantSessionRecord) Descriptor() ([]byte, []int) {
+func (m *ParticipantFeeChange) Reset()         { *m = ParticipantFeeChange{} }
+func (m *ParticipantFeeChange) String() string { return proto.CompactTextString(m) }
+func (*ParticipantFeeChange) ProtoMessage()    {}
+func (*ParticipantFeeChange) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1147dec47e58616a, []int{2}
 }
-func (m *ParticipantSessionRecord) XXX_Unmarshal(b []byte) error {
+func (m *ParticipantFeeChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParticipantSessionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ParticipantFeeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParticipantSessionRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ParticipantFeeChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -498,77 +684,117 @@ func (m *ParticipantSessionRecord) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ParticipantSessionRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParticipantSessionRecord.Merge(m, src)
+func (m *ParticipantFeeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantFeeChange.Merge(m, src)
 }
-func (m *ParticipantSessionRecord) XXX_Size() int {
+func (m *ParticipantFeeChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParticipantSessionRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParticipantSessionRecord.DiscardUnknown(m)
+func (m *ParticipantFeeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantFeeChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParticipantSessionRecord proto.InternalMessageInfo
+var xxx_messageInfo_ParticipantFeeChange proto.InternalMessageInfo
 
-func (m *ParticipantSessionRecord) GetId() uint64 {
+func (m *ParticipantFeeChange) GetValidationFees() uint64 {
 	if m != nil {
-		return m.Id
+		return m.ValidationFees
 	}
 	return 0
 }
 
-func (m *ParticipantSessionRecord) GetCreated() *time.Time {
+func (m *ParticipantFeeChange) GetIssuanceFees() uint64 {
 	if m != nil {
-		return m.Created
+		return m.IssuanceFees
 	}
-	return nil
+	return 0
 }
 
-func (m *ParticipantSessionRecord) GetIssuerParticipantId() uint64 {
+func (m *ParticipantFeeChange) GetVerificationFees() uint64 {
 	if m != nil {
-		return m.IssuerParticipantId
+		return m.VerificationFees
 	}
 	return 0
 }
 
-func (m *ParticipantSessionRecord) GetVerifierParticipantId() uint64 {
+func (m *ParticipantFeeChange) GetIssuanceFeeDiscount() uint64 {
 	if m != nil {
-		return m.VerifierParticipantId
+		return m.IssuanceFeeDiscount
 	}
 	return 0
 }
 
-func (m *ParticipantSessionRecord) GetWalletAgentParticipantId() uint64 {
+func (m *ParticipantFeeChange) GetVerificationFeeDiscount() uint64 {
 	if m != nil {
-		return m.WalletAgentParticipantId
+		return m.VerificationFeeDiscount
 	}
 	return 0
 }
 
-func (m *ParticipantSessionRecord) GetAgentParticipantId() uint64 {
+func (m *ParticipantFeeChange) GetProposerCorporationId() uint64 {
 	if m != nil {
-		return m.AgentParticipantId
+		return m.ProposerCorporationId
 	}
 	return 0
 }
 
-// OptionalUInt64 is a wrapper for optional uint64 values
-type OptionalUInt64 struct {
-	Value uint64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+func (m *ParticipantFeeChange) GetProposed() *time.Time {
+	if m != nil {
+		return m.Proposed
+	}
+	return nil
 }
 
-func (m *OptionalUInt64) Reset()         { *m = OptionalUInt64{} }
-func (m *OptionalUInt64) String() string { return proto.CompactTextString(m) }
-func (*OptionalUInt64) ProtoMessage()    {}
-func (*OptionalUInt64) Descriptor() ([]byte, []int) {
+func (m *ParticipantFeeChange) GetAccepted() *time.Time {
+	if m != nil {
+		return m.Accepted
+	}
+	return nil
+}
+
+func (m *ParticipantFeeChange) GetEffective() *time.Time {
+	if m != nil {
+		return m.Effective
+	}
+	return nil
+}
+
+type ParticipantSlash struct {
+	Id                   uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount               uint64      `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason               string      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceDigest       string      `protobuf:"bytes,4,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+	SlasherCorporationId uint64      `protobuf:"varint,5,opt,name=slasher_corporation_id,json=slasherCorporationId,proto3" json:"slasher_corporation_id,omitempty"`
+	Created              *time.Time  `protobuf:"bytes,6,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	DisputeDeadline      *time.Time  `protobuf:"bytes,7,opt,name=dispute_deadline,json=disputeDeadline,proto3,stdtime" json:"dispute_deadline,omitempty"`
+	Status               SlashStatus `protobuf:"varint,8,opt,name=status,proto3,enum=verana.pp.v1.SlashStatus" json:"status,omitempty"`
+	// appeal_reason, appeal_evidence_digest, appealed and arbiter are set by
+	// AppealSlash. arbiter is the ecosystem corporation group policy, or the
+	// x/gov authority for ecosystem-level slashes.
+	AppealReason         string     `protobuf:"bytes,9,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	AppealEvidenceDigest string     `protobuf:"bytes,10,opt,name=appeal_evidence_digest,json=appealEvidenceDigest,proto3" json:"appeal_evidence_digest,omitempty"`
+	Appealed             *time.Time `protobuf:"bytes,11,opt,name=appealed,proto3,stdtime" json:"appealed,omitempty"`
+	Arbiter              string     `protobuf:"bytes,12,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	// resolved is set when the slash is confirmed or reversed
+	Resolved *time.Time `protobuf:"bytes,13,opt,name=resolved,proto3,stdtime" json:"resolved,omitempty"`
+	// held_from_unbonding is the part of amount held back from the released
+	// deposit of the participant. It goes back to unbonding if the slash is
+	// reversed.
+	HeldFromUnbonding uint64 `protobuf:"varint,14,opt,name=held_from_unbonding,json=heldFromUnbonding,proto3" json:"held_from_unbonding,omitempty"`
+}
+
+func (m *ParticipantSlash) Reset()         { *m = ParticipantSlash{} }
+func (m *ParticipantSlash) String() string { return proto.CompactTextString(m) }
+func (*ParticipantSlash) ProtoMessage()    {}
+func (*ParticipantSlash) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1147dec47e58616a, []int{3}
 }
-func (m *OptionalUInt64) XXX_Unmarshal(b []byte) error {
+func (m *ParticipantSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OptionalUInt64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ParticipantSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OptionalUInt64.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ParticipantSlash.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -578,751 +804,3676 @@ func (m *OptionalUInt64) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *OptionalUInt64) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OptionalUInt64.Merge(m, src)
+func (m *ParticipantSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantSlash.Merge(m, src)
 }
-func (m *OptionalUInt64) XXX_Size() int {
+func (m *ParticipantSlash) XXX_Size() int {
 	return m.Size()
 }
-func (m *OptionalUInt64) XXX_DiscardUnknown() {
-	xxx_messageInfo_OptionalUInt64.DiscardUnknown(m)
+func (m *ParticipantSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantSlash.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OptionalUInt64 proto.InternalMessageInfo
+var xxx_messageInfo_ParticipantSlash proto.InternalMessageInfo
 
-func (m *OptionalUInt64) GetValue() uint64 {
+func (m *ParticipantSlash) GetId() uint64 {
 	if m != nil {
-		return m.Value
+		return m.Id
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("verana.pp.v1.ParticipantRole", ParticipantRole_name, ParticipantRole_value)
-	proto.RegisterEnum("verana.pp.v1.OnboardingState", OnboardingState_name, OnboardingState_value)
-	proto.RegisterType((*Participant)(nil), "verana.pp.v1.Participant")
-	proto.RegisterType((*ParticipantSession)(nil), "verana.pp.v1.ParticipantSession")
-	proto.RegisterType((*ParticipantSessionRecord)(nil), "verana.pp.v1.ParticipantSessionRecord")
-	proto.RegisterType((*OptionalUInt64)(nil), "verana.pp.v1.OptionalUInt64")
+func (m *ParticipantSlash) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("verana/pp/v1/types.proto", fileDescriptor_1147dec47e58616a) }
+func (m *ParticipantSlash) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
 
-var fileDescriptor_1147dec47e58616a = []byte{
-	// 1216 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xd1, 0x72, 0x13, 0x37,
-	0x17, 0x8e, 0xed, 0x8d, 0xe3, 0xc8, 0x89, 0xbd, 0x11, 0x06, 0x44, 0x00, 0xc7, 0x84, 0x9f, 0x60,
-	0xc2, 0x8f, 0x0d, 0x69, 0x87, 0x52, 0xa6, 0xbd, 0x70, 0x62, 0x43, 0x9d, 0x81, 0x98, 0xae, 0x1d,
-	0x66, 0xda, 0x9b, 0x1d, 0x65, 0x25, 0xdb, 0x6a, 0xd7, 0x2b, 0xcd, 0x6a, 0x6d, 0xa0, 0x77, 0x7d,
-	0x03, 0x1e, 0xa6, 0x33, 0x7d, 0x80, 0xde, 0xf4, 0x92, 0xf6, 0xaa, 0x77, 0xed, 0xc0, 0x8b, 0x74,
-	0x56, 0xda, 0xb5, 0x17, 0x1b, 0x66, 0x4c, 0xef, 0x56, 0xe7, 0x7c, 0xdf, 0xd1, 0xd9, 0x4f, 0xe7,
-	0x1c, 0x09, 0xa0, 0x09, 0xf5, 0xb1, 0x87, 0xeb, 0x42, 0xd4, 0x27, 0xf7, 0xea, 0xc1, 0x2b, 0x41,
-	0x65, 0x4d, 0xf8, 0x3c, 0xe0, 0x70, 0x43, 0x7b, 0x6a, 0x42, 0xd4, 0x26, 0xf7, 0xb6, 0x2f, 0x39,
-	0x5c, 0x8e, 0xb8, 0xb4, 0x95, 0xaf, 0xae, 0x17, 0x1a, 0xb8, 0x5d, 0x1a, 0xf0, 0x01, 0xd7, 0xf6,
-	0xf0, 0x2b, 0xb2, 0xee, 0x0c, 0x38, 0x1f, 0xb8, 0xb4, 0xae, 0x56, 0x67, 0xe3, 0x7e, 0x3d, 0x60,
-	0x23, 0x2a, 0x03, 0x3c, 0x12, 0x1a, 0xb0, 0xfb, 0xeb, 0x26, 0xc8, 0x3f, 0xc3, 0x7e, 0xc0, 0x1c,
-	0x26, 0xb0, 0x17, 0xc0, 0x02, 0x48, 0x33, 0x82, 0x52, 0x95, 0x54, 0xd5, 0xb0, 0xd2, 0x8c, 0xc0,
-	0xcb, 0x60, 0x5d, 0x3a, 0x43, 0x3a, 0xc2, 0x36, 0x23, 0x28, 0xad, 0xcc, 0x39, 0x6d, 0x68, 0x13,
-	0x78, 0x0f, 0x18, 0x3e, 0x77, 0x29, 0xca, 0x54, 0x52, 0xd5, 0xc2, 0xc1, 0xd5, 0x5a, 0x32, 0xd7,
-	0x5a, 0x22, 0xaa, 0xc5, 0x5d, 0x6a, 0x29, 0x28, 0x34, 0x41, 0x86, 0x30, 0x82, 0x8c, 0x4a, 0xaa,
-	0xba, 0x6e, 0x85, 0x9f, 0xf0, 0x21, 0x58, 0x73, 0x7c, 0x8a, 0x03, 0x4a, 0x50, 0xb6, 0x92, 0xaa,
-	0xe6, 0x0f, 0xb6, 0x6b, 0x3a, 0xe9, 0x5a, 0x9c, 0x74, 0xad, 0x17, 0x27, 0x7d, 0x68, 0xbc, 0xfe,
-	0x7b, 0x27, 0x65, 0xc5, 0x04, 0xf8, 0x15, 0xc8, 0x61, 0xf2, 0xc3, 0x58, 0x86, 0xe4, 0xdc, 0x92,
-	0xe4, 0x29, 0x23, 0xdc, 0x59, 0xba, 0x58, 0x0e, 0x29, 0x41, 0x60, 0xd9, 0x9d, 0x23, 0x02, 0x7c,
-	0x00, 0xb2, 0x3e, 0x15, 0x98, 0x11, 0xb4, 0xb1, 0x24, 0x35, 0xc2, 0xc3, 0xc7, 0xa0, 0x40, 0xfb,
-	0x7d, 0xea, 0x04, 0x6c, 0x42, 0xed, 0xbe, 0xcf, 0x47, 0xa8, 0xb0, 0x64, 0x84, 0xcd, 0x29, 0xef,
-	0x91, 0xcf, 0x47, 0xb0, 0x0d, 0x8a, 0xb3, 0x40, 0x63, 0x2f, 0x60, 0x2e, 0x2a, 0x2e, 0x19, 0x69,
-	0x96, 0xc1, 0x69, 0xc8, 0x0b, 0x75, 0x1c, 0x71, 0xc2, 0xfa, 0x8c, 0x12, 0x64, 0x2e, 0xab, 0x63,
-	0xcc, 0x80, 0x37, 0x41, 0x71, 0x82, 0x5d, 0x46, 0x70, 0xc0, 0xb8, 0x67, 0xf7, 0x29, 0x95, 0x68,
-	0x4b, 0x55, 0x4a, 0x61, 0x66, 0x7e, 0x44, 0xa9, 0x84, 0xd7, 0xc1, 0x26, 0x93, 0x72, 0x8c, 0x3d,
-	0x87, 0x6a, 0x18, 0x54, 0xb0, 0x8d, 0xd8, 0xa8, 0x40, 0xb7, 0xc1, 0xd6, 0x84, 0xfa, 0xac, 0xcf,
-	0x9c, 0x44, 0xbc, 0x73, 0x0a, 0x68, 0x26, 0x1d, 0x0a, 0x8c, 0xc0, 0x1a, 0xa1, 0x82, 0x4b, 0x16,
-	0xa0, 0x92, 0x82, 0xc4, 0xcb, 0x30, 0xa9, 0xe8, 0xac, 0xec, 0x18, 0x71, 0x5e, 0x27, 0x15, 0x99,
-	0x9b, 0x11, 0xf0, 0x06, 0x28, 0xe8, 0x93, 0x99, 0xe2, 0x2e, 0x28, 0xdc, 0xa6, 0xb6, 0xc6, 0xb0,
-	0x87, 0x60, 0xcd, 0xa7, 0x13, 0xfe, 0x23, 0x25, 0xe8, 0xe2, 0xb2, 0xc5, 0x12, 0x11, 0xe0, 0x03,
-	0x80, 0x22, 0x25, 0xb8, 0x6f, 0x8b, 0x59, 0x5f, 0x84, 0x3d, 0xb5, 0xad, 0x36, 0xbb, 0x30, 0xf5,
-	0x27, 0xda, 0xa6, 0x1d, 0x32, 0x73, 0x5c, 0xd8, 0x32, 0xc0, 0x01, 0x45, 0x97, 0x3f, 0xd4, 0x65,
-	0x1d, 0xef, 0x8c, 0x63, 0x9f, 0x30, 0x6f, 0xd0, 0x0d, 0x41, 0xd6, 0x1a, 0x17, 0xea, 0x03, 0x7e,
-	0x01, 0xb2, 0x5c, 0xd8, 0xf4, 0xa5, 0x40, 0x57, 0x96, 0x4c, 0x77, 0x95, 0x8b, 0xd6, 0x4b, 0x01,
-	0xbf, 0x05, 0x25, 0x2e, 0x6c, 0x17, 0xcb, 0x40, 0xef, 0x6b, 0x3b, 0x43, 0xec, 0x0d, 0x28, 0xba,
-	0xba, 0x64, 0x98, 0x2d, 0x2e, 0x9e, 0x60, 0x19, 0xa8, 0x2c, 0x8e, 0x14, 0x15, 0xde, 0x55, 0x21,
-	0x67, 0x12, 0xc4, 0x42, 0x97, 0xd5, 0xbf, 0x43, 0x2e, 0x9e, 0xc7, 0xae, 0x58, 0xed, 0x3d, 0x50,
-	0xe4, 0xc2, 0x76, 0xc6, 0xbe, 0x4f, 0xbd, 0x40, 0x97, 0xc0, 0x8e, 0x3e, 0x15, 0x2e, 0x8e, 0xb4,
-	0x55, 0x9d, 0xff, 0xff, 0x01, 0x4c, 0xe0, 0xe2, 0xb8, 0x15, 0x5d, 0x2d, 0x53, 0x68, 0x1c, 0x75,
-	0x1f, 0x6c, 0x85, 0x6a, 0x8e, 0x47, 0x23, 0xec, 0xbf, 0xb2, 0x09, 0x1b, 0x50, 0x19, 0xa0, 0x6b,
-	0x6a, 0x14, 0x15, 0xb9, 0xe8, 0x6a, 0x7b, 0x53, 0x99, 0xe1, 0x01, 0x38, 0x9f, 0xac, 0x55, 0x9b,
-	0x30, 0xe9, 0xf0, 0xb1, 0x17, 0xa0, 0xeb, 0x2a, 0xf8, 0xb9, 0x44, 0xcd, 0x36, 0x23, 0x17, 0x7c,
-	0x08, 0x2e, 0xcd, 0x97, 0xee, 0x8c, 0xf7, 0x3f, 0xc5, 0xbb, 0x38, 0x57, 0xc2, 0x53, 0xee, 0x97,
-	0x20, 0x3f, 0x91, 0x36, 0x17, 0xd4, 0x0f, 0x75, 0x40, 0x37, 0xc2, 0xac, 0x0e, 0xd1, 0x9f, 0xbf,
-	0xdc, 0x29, 0x45, 0x63, 0xbe, 0x41, 0x88, 0x4f, 0xa5, 0xec, 0x06, 0x3e, 0xf3, 0x06, 0x16, 0x98,
-	0xc8, 0x4e, 0x84, 0x0d, 0x2b, 0xd8, 0xe1, 0xbe, 0xe0, 0xbe, 0xde, 0x95, 0x11, 0x74, 0x5b, 0x6b,
-	0x95, 0xb0, 0xb6, 0xc9, 0xb1, 0x91, 0x5b, 0x35, 0xb3, 0xc7, 0x46, 0x6e, 0xcf, 0xbc, 0x79, 0x6c,
-	0xe4, 0x6e, 0x9a, 0xd5, 0x63, 0x23, 0x57, 0x35, 0x6f, 0x1d, 0x1b, 0xb9, 0x5b, 0xe6, 0xfe, 0xb1,
-	0x91, 0xdb, 0x37, 0x6f, 0x5b, 0xf9, 0x04, 0xc5, 0xba, 0x94, 0x48, 0xc8, 0xc6, 0xe3, 0x60, 0xf8,
-	0x93, 0x4d, 0x3d, 0x7c, 0xe6, 0x52, 0x62, 0x5d, 0x5d, 0x74, 0x49, 0x41, 0x3d, 0x62, 0xbb, 0x6c,
-	0xc4, 0x02, 0x6b, 0x67, 0xd1, 0xfd, 0x82, 0x05, 0xc3, 0x50, 0x90, 0x81, 0x1f, 0x5e, 0x08, 0xd7,
-	0x16, 0x01, 0xa1, 0x58, 0xc9, 0x18, 0xe5, 0x8f, 0x6d, 0x21, 0xa8, 0xcf, 0x38, 0xd9, 0xfd, 0x23,
-	0x0d, 0x60, 0xa2, 0x59, 0xba, 0x54, 0x4a, 0xc6, 0xbd, 0xc4, 0x05, 0xb6, 0xae, 0x2e, 0xb0, 0x45,
-	0x71, 0xd2, 0x1f, 0x10, 0x67, 0x5e, 0xfe, 0xcc, 0x27, 0xc8, 0x9f, 0xb8, 0xc0, 0x8c, 0xff, 0x70,
-	0x81, 0x4d, 0x07, 0xef, 0xea, 0x27, 0x0f, 0xde, 0x0e, 0x28, 0x4a, 0xfd, 0xdb, 0xb6, 0x4f, 0x1d,
-	0xee, 0x13, 0x89, 0xb2, 0x95, 0x4c, 0x35, 0x7f, 0xb0, 0xf7, 0xd1, 0xab, 0x38, 0x92, 0xc9, 0x52,
-	0x70, 0xab, 0x20, 0x93, 0x4b, 0xb9, 0xfb, 0x5b, 0x1a, 0xa0, 0x8f, 0x81, 0x17, 0x9e, 0x06, 0x89,
-	0xff, 0x4e, 0x7f, 0xea, 0x7f, 0x47, 0xdd, 0x45, 0x17, 0xc6, 0x61, 0x66, 0xd6, 0x5d, 0x74, 0x6e,
-	0x16, 0xde, 0x07, 0x51, 0xf3, 0x2c, 0xb2, 0x0c, 0xc5, 0x3a, 0x1f, 0xbb, 0xdf, 0xe7, 0x7d, 0x0d,
-	0x2e, 0xbf, 0xc0, 0xae, 0x4b, 0x03, 0x1b, 0x0f, 0xc2, 0x29, 0x31, 0xc7, 0x5d, 0x55, 0x5c, 0xa4,
-	0x21, 0x8d, 0x10, 0xf1, 0x3e, 0xfd, 0x2e, 0x28, 0x7d, 0x90, 0x97, 0xd5, 0xc3, 0x0b, 0x2f, 0x30,
-	0x76, 0xf7, 0x40, 0xa1, 0x23, 0xc2, 0xba, 0xc2, 0xee, 0x69, 0xdb, 0x0b, 0xee, 0x7f, 0x0e, 0x4b,
-	0x60, 0x75, 0x82, 0xdd, 0x31, 0x8d, 0xd4, 0xd3, 0x8b, 0xfd, 0x9f, 0x53, 0xa0, 0x38, 0xf7, 0x4a,
-	0x82, 0x45, 0x90, 0x3f, 0x3d, 0xe9, 0x3e, 0x6b, 0x1d, 0xb5, 0x1f, 0xb5, 0x5b, 0x4d, 0x73, 0x05,
-	0x02, 0x90, 0x6d, 0x77, 0xbb, 0xa7, 0x2d, 0xcb, 0x4c, 0xc1, 0x0d, 0x90, 0x7b, 0xde, 0xb2, 0x42,
-	0x8f, 0x65, 0xa6, 0x21, 0x04, 0x05, 0xed, 0xb1, 0x1f, 0x5b, 0x8d, 0x93, 0x5e, 0xc7, 0x32, 0x33,
-	0xb0, 0x04, 0xcc, 0x18, 0x31, 0xb5, 0x1a, 0x70, 0x13, 0xac, 0xb7, 0x8e, 0x3a, 0xdd, 0xef, 0xba,
-	0xbd, 0xd6, 0x53, 0x73, 0x35, 0x0c, 0xf9, 0x4d, 0xe7, 0x49, 0xb3, 0x65, 0x99, 0xd9, 0x7d, 0x1b,
-	0x14, 0xe7, 0xae, 0x10, 0x58, 0x01, 0x57, 0x3a, 0x27, 0x87, 0x9d, 0x86, 0xd5, 0x6c, 0x9f, 0x3c,
-	0xb6, 0xbb, 0xbd, 0x46, 0xaf, 0x65, 0xbf, 0x9f, 0x53, 0x1e, 0xac, 0x3d, 0x6b, 0x9d, 0x84, 0x6e,
-	0x33, 0x15, 0x06, 0x7f, 0xde, 0x78, 0xd2, 0x6e, 0x36, 0x7a, 0xad, 0xa6, 0x99, 0x86, 0x05, 0x00,
-	0x7a, 0x2d, 0xeb, 0x69, 0xfb, 0x44, 0xad, 0x33, 0x87, 0x87, 0xbf, 0xbf, 0x2d, 0xa7, 0xde, 0xbc,
-	0x2d, 0xa7, 0xfe, 0x79, 0x5b, 0x4e, 0xbd, 0x7e, 0x57, 0x5e, 0x79, 0xf3, 0xae, 0xbc, 0xf2, 0xd7,
-	0xbb, 0xf2, 0xca, 0xf7, 0xd5, 0x01, 0x0b, 0x86, 0xe3, 0xb3, 0x9a, 0xc3, 0x47, 0x75, 0x5d, 0xae,
-	0x77, 0x5c, 0x7c, 0x26, 0xa3, 0xef, 0xfa, 0xcb, 0xf0, 0x35, 0xac, 0x9e, 0xc2, 0x67, 0x59, 0x55,
-	0x50, 0x9f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x73, 0x0f, 0x67, 0xc1, 0x27, 0x0b, 0x00, 0x00,
+func (m *ParticipantSlash) GetEvidenceDigest() string {
+	if m != nil {
+		return m.EvidenceDigest
+	}
+	return ""
 }
 
-func (m *Participant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParticipantSlash) GetSlasherCorporationId() uint64 {
+	if m != nil {
+		return m.SlasherCorporationId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Participant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ParticipantSlash) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return nil
 }
 
-func (m *Participant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CorporationId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd8
+func (m *ParticipantSlash) GetDisputeDeadline() *time.Time {
+	if m != nil {
+		return m.DisputeDeadline
 	}
-	if len(m.VsOperator) > 0 {
-		i -= len(m.VsOperator)
-		copy(dAtA[i:], m.VsOperator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.VsOperator)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xaa
-	}
-	if m.VerificationFeeDiscount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFeeDiscount))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xa0
+	return nil
+}
+
+func (m *ParticipantSlash) GetStatus() SlashStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.IssuanceFeeDiscount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFeeDiscount))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x98
+	return SlashStatus_SLASH_STATUS_UNSPECIFIED
+}
+
+func (m *ParticipantSlash) GetAppealReason() string {
+	if m != nil {
+		return m.AppealReason
 	}
-	if len(m.OpSummaryDigest) > 0 {
-		i -= len(m.OpSummaryDigest)
-		copy(dAtA[i:], m.OpSummaryDigest)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OpSummaryDigest)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x8a
+	return ""
+}
+
+func (m *ParticipantSlash) GetAppealEvidenceDigest() string {
+	if m != nil {
+		return m.AppealEvidenceDigest
 	}
-	if m.OpCurrentDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.OpCurrentDeposit))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x80
+	return ""
+}
+
+func (m *ParticipantSlash) GetAppealed() *time.Time {
+	if m != nil {
+		return m.Appealed
 	}
-	if m.OpCurrentFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.OpCurrentFees))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf8
+	return nil
+}
+
+func (m *ParticipantSlash) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
 	}
-	if m.OpValidatorDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.OpValidatorDeposit))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf0
+	return ""
+}
+
+func (m *ParticipantSlash) GetResolved() *time.Time {
+	if m != nil {
+		return m.Resolved
 	}
-	if m.OpLastStateChange != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.OpLastStateChange, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpLastStateChange):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintTypes(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+	return nil
+}
+
+func (m *ParticipantSlash) GetHeldFromUnbonding() uint64 {
+	if m != nil {
+		return m.HeldFromUnbonding
 	}
-	if m.OpExp != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.OpExp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpExp):])
-		if err2 != nil {
-			return 0, err2
+	return 0
+}
+
+type ParticipantSuspension struct {
+	Suspended              *time.Time `protobuf:"bytes,1,opt,name=suspended,proto3,stdtime" json:"suspended,omitempty"`
+	Reason                 string     `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	SuspenderCorporationId uint64     `protobuf:"varint,3,opt,name=suspender_corporation_id,json=suspenderCorporationId,proto3" json:"suspender_corporation_id,omitempty"`
+	// unsuspended, unsuspend_reason and unsuspender_corporation_id are set
+	// when the suspension is lifted
+	Unsuspended              *time.Time `protobuf:"bytes,4,opt,name=unsuspended,proto3,stdtime" json:"unsuspended,omitempty"`
+	UnsuspendReason          string     `protobuf:"bytes,5,opt,name=unsuspend_reason,json=unsuspendReason,proto3" json:"unsuspend_reason,omitempty"`
+	UnsuspenderCorporationId uint64     `protobuf:"varint,6,opt,name=unsuspender_corporation_id,json=unsuspenderCorporationId,proto3" json:"unsuspender_corporation_id,omitempty"`
+}
+
+func (m *ParticipantSuspension) Reset()         { *m = ParticipantSuspension{} }
+func (m *ParticipantSuspension) String() string { return proto.CompactTextString(m) }
+func (*ParticipantSuspension) ProtoMessage()    {}
+func (*ParticipantSuspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{4}
+}
+func (m *ParticipantSuspension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantSuspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantSuspension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n2
-		i = encodeVarintTypes(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe2
+		return b[:n], nil
 	}
-	if m.OpState != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.OpState))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd8
+}
+func (m *ParticipantSuspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantSuspension.Merge(m, src)
+}
+func (m *ParticipantSuspension) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantSuspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantSuspension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantSuspension proto.InternalMessageInfo
+
+func (m *ParticipantSuspension) GetSuspended() *time.Time {
+	if m != nil {
+		return m.Suspended
 	}
-	if m.ValidatorParticipantId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorParticipantId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd0
+	return nil
+}
+
+func (m *ParticipantSuspension) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Revoked != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Revoked, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintTypes(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+	return ""
+}
+
+func (m *ParticipantSuspension) GetSuspenderCorporationId() uint64 {
+	if m != nil {
+		return m.SuspenderCorporationId
 	}
-	if m.RepaidDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	return 0
+}
+
+func (m *ParticipantSuspension) GetUnsuspended() *time.Time {
+	if m != nil {
+		return m.Unsuspended
 	}
-	if m.SlashedDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+	return nil
+}
+
+func (m *ParticipantSuspension) GetUnsuspendReason() string {
+	if m != nil {
+		return m.UnsuspendReason
 	}
-	if m.Deposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+	return ""
+}
+
+func (m *ParticipantSuspension) GetUnsuspenderCorporationId() uint64 {
+	if m != nil {
+		return m.UnsuspenderCorporationId
 	}
-	if m.VerificationFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return 0
+}
+
+// ParticipantCascade tracks the propagation of a revocation to the
+// descendants of root_participant_id. It is processed in bounded batches by
+// the EndBlocker until pending is empty.
+type ParticipantCascade struct {
+	Id                     uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RootParticipantId      uint64           `protobuf:"varint,2,opt,name=root_participant_id,json=rootParticipantId,proto3" json:"root_participant_id,omitempty"`
+	Mode                   CascadeMode      `protobuf:"varint,3,opt,name=mode,proto3,enum=verana.pp.v1.CascadeMode" json:"mode,omitempty"`
+	Reason                 RevocationReason `protobuf:"varint,4,opt,name=reason,proto3,enum=verana.pp.v1.RevocationReason" json:"reason,omitempty"`
+	InitiatorCorporationId uint64           `protobuf:"varint,5,opt,name=initiator_corporation_id,json=initiatorCorporationId,proto3" json:"initiator_corporation_id,omitempty"`
+	Started                *time.Time       `protobuf:"bytes,6,opt,name=started,proto3,stdtime" json:"started,omitempty"`
+	// completed is set once every descendant has been visited
+	Completed *time.Time `protobuf:"bytes,7,opt,name=completed,proto3,stdtime" json:"completed,omitempty"`
+	// pending are the descendants still to be visited, in visit order
+	Pending []uint64 `protobuf:"varint,8,rep,packed,name=pending,proto3" json:"pending,omitempty"`
+	// visited counts the descendants processed so far, affected those that
+	// were revoked or suspended (the others already were)
+	Visited  uint64 `protobuf:"varint,9,opt,name=visited,proto3" json:"visited,omitempty"`
+	Affected uint64 `protobuf:"varint,10,opt,name=affected,proto3" json:"affected,omitempty"`
+}
+
+func (m *ParticipantCascade) Reset()         { *m = ParticipantCascade{} }
+func (m *ParticipantCascade) String() string { return proto.CompactTextString(m) }
+func (*ParticipantCascade) ProtoMessage()    {}
+func (*ParticipantCascade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{5}
+}
+func (m *ParticipantCascade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantCascade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantCascade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.IssuanceFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFees))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+}
+func (m *ParticipantCascade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantCascade.Merge(m, src)
+}
+func (m *ParticipantCascade) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantCascade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantCascade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantCascade proto.InternalMessageInfo
+
+func (m *ParticipantCascade) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.ValidationFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidationFees))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+	return 0
+}
+
+func (m *ParticipantCascade) GetRootParticipantId() uint64 {
+	if m != nil {
+		return m.RootParticipantId
 	}
-	if m.Modified != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintTypes(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return 0
+}
+
+func (m *ParticipantCascade) GetMode() CascadeMode {
+	if m != nil {
+		return m.Mode
 	}
-	if m.EffectiveUntil != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintTypes(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x7a
+	return CascadeMode_CASCADE_MODE_UNSPECIFIED
+}
+
+func (m *ParticipantCascade) GetReason() RevocationReason {
+	if m != nil {
+		return m.Reason
 	}
-	if m.EffectiveFrom != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintTypes(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x72
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
+
+func (m *ParticipantCascade) GetInitiatorCorporationId() uint64 {
+	if m != nil {
+		return m.InitiatorCorporationId
 	}
-	if m.Repaid != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Repaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintTypes(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Slashed != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Slashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintTypes(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Adjusted != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Adjusted, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Adjusted):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintTypes(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Created != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintTypes(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Role != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SchemaId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SchemaId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ParticipantSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParticipantCascade) GetStarted() *time.Time {
+	if m != nil {
+		return m.Started
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParticipantSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ParticipantCascade) GetCompleted() *time.Time {
+	if m != nil {
+		return m.Completed
+	}
+	return nil
 }
 
-func (m *ParticipantSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionRecords) > 0 {
-		for iNdEx := len(m.SessionRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SessionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.Modified != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
-		if err11 != nil {
-			return 0, err11
-		}
-		i -= n11
-		i = encodeVarintTypes(dAtA, i, uint64(n11))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Created != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
-		if err12 != nil {
-			return 0, err12
-		}
-		i -= n12
-		i = encodeVarintTypes(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VsOperator) > 0 {
-		i -= len(m.VsOperator)
-		copy(dAtA[i:], m.VsOperator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.VsOperator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CorporationId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
-		i--
-		dAtA[i] = 0x10
+func (m *ParticipantCascade) GetPending() []uint64 {
+	if m != nil {
+		return m.Pending
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ParticipantCascade) GetVisited() uint64 {
+	if m != nil {
+		return m.Visited
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ParticipantSessionRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParticipantCascade) GetAffected() uint64 {
+	if m != nil {
+		return m.Affected
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ParticipantSessionRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ParticipantSession struct {
+	Id             string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CorporationId  uint64                      `protobuf:"varint,2,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	VsOperator     string                      `protobuf:"bytes,3,opt,name=vs_operator,json=vsOperator,proto3" json:"vs_operator,omitempty"`
+	Created        *time.Time                  `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	Modified       *time.Time                  `protobuf:"bytes,5,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
+	SessionRecords []*ParticipantSessionRecord `protobuf:"bytes,6,rep,name=session_records,json=sessionRecords,proto3" json:"session_records,omitempty"`
 }
 
-func (m *ParticipantSessionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AgentParticipantId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.AgentParticipantId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.WalletAgentParticipantId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.WalletAgentParticipantId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.VerifierParticipantId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VerifierParticipantId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.IssuerParticipantId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.IssuerParticipantId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Created != nil {
-		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
-		if err13 != nil {
-			return 0, err13
+func (m *ParticipantSession) Reset()         { *m = ParticipantSession{} }
+func (m *ParticipantSession) String() string { return proto.CompactTextString(m) }
+func (*ParticipantSession) ProtoMessage()    {}
+func (*ParticipantSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{6}
+}
+func (m *ParticipantSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n13
-		i = encodeVarintTypes(dAtA, i, uint64(n13))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ParticipantSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantSession.Merge(m, src)
+}
+func (m *ParticipantSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantSession.DiscardUnknown(m)
 }
 
-func (m *OptionalUInt64) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ParticipantSession proto.InternalMessageInfo
+
+func (m *ParticipantSession) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *OptionalUInt64) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ParticipantSession) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
 }
 
-func (m *OptionalUInt64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+func (m *ParticipantSession) GetVsOperator() string {
+	if m != nil {
+		return m.VsOperator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ParticipantSession) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Participant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	if m.SchemaId != 0 {
-		n += 1 + sovTypes(uint64(m.SchemaId))
+
+func (m *ParticipantSession) GetModified() *time.Time {
+	if m != nil {
+		return m.Modified
 	}
-	if m.Role != 0 {
-		n += 1 + sovTypes(uint64(m.Role))
+	return nil
+}
+
+func (m *ParticipantSession) GetSessionRecords() []*ParticipantSessionRecord {
+	if m != nil {
+		return m.SessionRecords
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+type ParticipantSessionRecord struct {
+	Id                       uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created                  *time.Time `protobuf:"bytes,2,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	IssuerParticipantId      uint64     `protobuf:"varint,3,opt,name=issuer_participant_id,json=issuerParticipantId,proto3" json:"issuer_participant_id,omitempty"`
+	VerifierParticipantId    uint64     `protobuf:"varint,4,opt,name=verifier_participant_id,json=verifierParticipantId,proto3" json:"verifier_participant_id,omitempty"`
+	WalletAgentParticipantId uint64     `protobuf:"varint,5,opt,name=wallet_agent_participant_id,json=walletAgentParticipantId,proto3" json:"wallet_agent_participant_id,omitempty"`
+	AgentParticipantId       uint64     `protobuf:"varint,6,opt,name=agent_participant_id,json=agentParticipantId,proto3" json:"agent_participant_id,omitempty"`
+}
+
+func (m *ParticipantSessionRecord) Reset()         { *m = ParticipantSessionRecord{} }
+func (m *ParticipantSessionRecord) String() string { return proto.CompactTextString(m) }
+func (*ParticipantSessionRecord) ProtoMessage()    {}
+func (*ParticipantSessionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{7}
+}
+func (m *ParticipantSessionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantSessionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantSessionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Created != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *ParticipantSessionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantSessionRecord.Merge(m, src)
+}
+func (m *ParticipantSessionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantSessionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantSessionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantSessionRecord proto.InternalMessageInfo
+
+func (m *ParticipantSessionRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Adjusted != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Adjusted)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *ParticipantSessionRecord) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	if m.Slashed != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed)
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *ParticipantSessionRecord) GetIssuerParticipantId() uint64 {
+	if m != nil {
+		return m.IssuerParticipantId
 	}
-	if m.Repaid != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *ParticipantSessionRecord) GetVerifierParticipantId() uint64 {
+	if m != nil {
+		return m.VerifierParticipantId
 	}
-	if m.EffectiveFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *ParticipantSessionRecord) GetWalletAgentParticipantId() uint64 {
+	if m != nil {
+		return m.WalletAgentParticipantId
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *ParticipantSessionRecord) GetAgentParticipantId() uint64 {
+	if m != nil {
+		return m.AgentParticipantId
 	}
-	if m.Modified != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
-		n += 2 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// OptionalUInt64 is a wrapper for optional uint64 values
+type OptionalUInt64 struct {
+	Value uint64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *OptionalUInt64) Reset()         { *m = OptionalUInt64{} }
+func (m *OptionalUInt64) String() string { return proto.CompactTextString(m) }
+func (*OptionalUInt64) ProtoMessage()    {}
+func (*OptionalUInt64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1147dec47e58616a, []int{8}
+}
+func (m *OptionalUInt64) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionalUInt64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionalUInt64.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ValidationFees != 0 {
-		n += 2 + sovTypes(uint64(m.ValidationFees))
+}
+func (m *OptionalUInt64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalUInt64.Merge(m, src)
+}
+func (m *OptionalUInt64) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionalUInt64) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalUInt64.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalUInt64 proto.InternalMessageInfo
+
+func (m *OptionalUInt64) GetValue() uint64 {
+	if m != nil {
+		return m.Value
 	}
-	if m.IssuanceFees != 0 {
-		n += 2 + sovTypes(uint64(m.IssuanceFees))
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("verana.pp.v1.ParticipantRole", ParticipantRole_name, ParticipantRole_value)
+	proto.RegisterEnum("verana.pp.v1.OnboardingState", OnboardingState_name, OnboardingState_value)
+	proto.RegisterEnum("verana.pp.v1.RevocationReason", RevocationReason_name, RevocationReason_value)
+	proto.RegisterEnum("verana.pp.v1.SlashStatus", SlashStatus_name, SlashStatus_value)
+	proto.RegisterEnum("verana.pp.v1.CascadeMode", CascadeMode_name, CascadeMode_value)
+	proto.RegisterType((*Participant)(nil), "verana.pp.v1.Participant")
+	proto.RegisterType((*ParticipantDIDRecord)(nil), "verana.pp.v1.ParticipantDIDRecord")
+	proto.RegisterType((*ParticipantFeeChange)(nil), "verana.pp.v1.ParticipantFeeChange")
+	proto.RegisterType((*ParticipantSlash)(nil), "verana.pp.v1.ParticipantSlash")
+	proto.RegisterType((*ParticipantSuspension)(nil), "verana.pp.v1.ParticipantSuspension")
+	proto.RegisterType((*ParticipantCascade)(nil), "verana.pp.v1.ParticipantCascade")
+	proto.RegisterType((*ParticipantSession)(nil), "verana.pp.v1.ParticipantSession")
+	proto.RegisterType((*ParticipantSessionRecord)(nil), "verana.pp.v1.ParticipantSessionRecord")
+	proto.RegisterType((*OptionalUInt64)(nil), "verana.pp.v1.OptionalUInt64")
+}
+
+func init() { proto.RegisterFile("verana/pp/v1/types.proto", fileDescriptor_1147dec47e58616a) }
+
+var fileDescriptor_1147dec47e58616a = []byte{
+	// 2169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4f, 0x73, 0xdb, 0xba,
+	0x11, 0x8f, 0xfe, 0x58, 0x96, 0x57, 0x89, 0x44, 0xc3, 0xb2, 0x83, 0x38, 0x89, 0xe3, 0x38, 0x79,
+	0x89, 0xe3, 0xbc, 0x48, 0x89, 0x5f, 0x9a, 0xa6, 0x99, 0xb4, 0x1d, 0x59, 0xa2, 0x13, 0x39, 0xb6,
+	0xe4, 0x92, 0xb2, 0xa7, 0xe9, 0xa1, 0x1c, 0x5a, 0x84, 0x65, 0xb6, 0x92, 0xc0, 0x21, 0x28, 0xbd,
+	0xa4, 0xb7, 0x7e, 0x83, 0x77, 0xeb, 0x57, 0xe8, 0xa1, 0xc7, 0x7e, 0x81, 0x4e, 0x2f, 0x3d, 0xbe,
+	0xf6, 0xd0, 0xe9, 0xad, 0x6d, 0x32, 0xfd, 0x08, 0xbd, 0x77, 0x00, 0x90, 0x14, 0x45, 0xd9, 0x6f,
+	0xe8, 0xd7, 0x9b, 0xb0, 0xfb, 0xfb, 0x2d, 0xc0, 0xdd, 0xc5, 0x62, 0x01, 0x01, 0x1e, 0x13, 0xd7,
+	0x1c, 0x9a, 0x55, 0xc7, 0xa9, 0x8e, 0x9f, 0x55, 0xbd, 0x8f, 0x0e, 0x61, 0x15, 0xc7, 0xa5, 0x1e,
+	0x45, 0x57, 0xa5, 0xa6, 0xe2, 0x38, 0x95, 0xf1, 0xb3, 0xd5, 0x1b, 0x5d, 0xca, 0x06, 0x94, 0x19,
+	0x42, 0x57, 0x95, 0x03, 0x09, 0x5c, 0x2d, 0xf7, 0x68, 0x8f, 0x4a, 0x39, 0xff, 0xe5, 0x4b, 0xef,
+	0xf4, 0x28, 0xed, 0xf5, 0x49, 0x55, 0x8c, 0x4e, 0x46, 0xa7, 0x55, 0xcf, 0x1e, 0x10, 0xe6, 0x99,
+	0x03, 0x47, 0x02, 0x36, 0xfe, 0x8d, 0xa0, 0x70, 0x68, 0xba, 0x9e, 0xdd, 0xb5, 0x1d, 0x73, 0xe8,
+	0xa1, 0x22, 0xa4, 0x6d, 0x0b, 0xa7, 0xd6, 0x53, 0x9b, 0x59, 0x2d, 0x6d, 0x5b, 0xe8, 0x26, 0x2c,
+	0xb0, 0xee, 0x19, 0x19, 0x98, 0x86, 0x6d, 0xe1, 0xb4, 0x10, 0xe7, 0xa5, 0xa0, 0x69, 0xa1, 0x67,
+	0x90, 0x75, 0x69, 0x9f, 0xe0, 0xcc, 0x7a, 0x6a, 0xb3, 0xb8, 0x7d, 0xbb, 0x12, 0x5d, 0x6b, 0x25,
+	0x62, 0x55, 0xa3, 0x7d, 0xa2, 0x09, 0x28, 0x52, 0x20, 0x63, 0xd9, 0x16, 0xce, 0xae, 0xa7, 0x36,
+	0x17, 0x34, 0xfe, 0x13, 0xbd, 0x82, 0xf9, 0xae, 0x4b, 0x4c, 0x8f, 0x58, 0x38, 0xb7, 0x9e, 0xda,
+	0x2c, 0x6c, 0xaf, 0x56, 0xe4, 0xa2, 0x2b, 0xc1, 0xa2, 0x2b, 0x9d, 0x60, 0xd1, 0x3b, 0xd9, 0x6f,
+	0xfe, 0x79, 0x27, 0xa5, 0x05, 0x04, 0xf4, 0x1a, 0xf2, 0xa6, 0xf5, 0xab, 0x11, 0xe3, 0xe4, 0x7c,
+	0x42, 0x72, 0xc8, 0xe0, 0x33, 0xb3, 0xbe, 0xc9, 0xce, 0x88, 0x85, 0x21, 0xe9, 0xcc, 0x3e, 0x01,
+	0xbd, 0x84, 0x9c, 0x4b, 0x1c, 0xd3, 0xb6, 0xf0, 0xd5, 0x84, 0x54, 0x1f, 0x8f, 0xde, 0x40, 0x91,
+	0x9c, 0x9e, 0x92, 0xae, 0x67, 0x8f, 0x89, 0x71, 0xea, 0xd2, 0x01, 0x2e, 0x26, 0xb4, 0x70, 0x2d,
+	0xe4, 0xed, 0xba, 0x74, 0x80, 0x9a, 0x50, 0x9a, 0x18, 0x1a, 0x0d, 0x3d, 0xbb, 0x8f, 0x4b, 0x09,
+	0x2d, 0x4d, 0x56, 0x70, 0xc4, 0x79, 0xdc, 0x8f, 0x03, 0x6a, 0xd9, 0xa7, 0x36, 0xb1, 0xb0, 0x92,
+	0xd4, 0x8f, 0x01, 0x03, 0x3d, 0x84, 0xd2, 0xd8, 0xec, 0xdb, 0x96, 0xe9, 0xd9, 0x74, 0x68, 0x9c,
+	0x12, 0xc2, 0xf0, 0xa2, 0xc8, 0x94, 0xe2, 0x44, 0xbc, 0x4b, 0x08, 0x43, 0xf7, 0xe0, 0x9a, 0xcd,
+	0xd8, 0xc8, 0x1c, 0x76, 0x89, 0x84, 0x21, 0x01, 0xbb, 0x1a, 0x08, 0x05, 0xe8, 0x31, 0x2c, 0x8e,
+	0x89, 0x6b, 0x9f, 0xda, 0xdd, 0x88, 0xbd, 0x25, 0x01, 0x54, 0xa2, 0x0a, 0x01, 0xc6, 0x30, 0x6f,
+	0x11, 0x87, 0x32, 0xdb, 0xc3, 0x65, 0x01, 0x09, 0x86, 0x7c, 0x51, 0x7e, 0xac, 0x8c, 0x00, 0xb1,
+	0x2c, 0x17, 0xe5, 0x8b, 0x1b, 0x3e, 0xf0, 0x0b, 0x28, 0xca, 0xc8, 0x84, 0xb8, 0x15, 0x81, 0xbb,
+	0x26, 0xa5, 0x01, 0xec, 0x15, 0xcc, 0xbb, 0x64, 0x4c, 0x7f, 0x4d, 0x2c, 0x7c, 0x3d, 0x69, 0xb2,
+	0xf8, 0x04, 0xf4, 0x12, 0xb0, 0xef, 0x09, 0xea, 0x1a, 0xce, 0x64, 0x5f, 0xf0, 0x3d, 0xb5, 0x2a,
+	0x26, 0x5b, 0x09, 0xf5, 0x91, 0x6d, 0xd3, 0xe4, 0xcc, 0x3c, 0x75, 0x0c, 0xe6, 0x99, 0x1e, 0xc1,
+	0x37, 0xcf, 0xdb, 0x65, 0xed, 0xe1, 0x09, 0x35, 0x5d, 0xcb, 0x1e, 0xf6, 0x74, 0x0e, 0xd2, 0xe6,
+	0xa9, 0x23, 0x7e, 0xa0, 0x1f, 0x42, 0x8e, 0x3a, 0x06, 0xf9, 0xe0, 0xe0, 0x5b, 0x09, 0x97, 0x3b,
+	0x47, 0x1d, 0xf5, 0x83, 0x83, 0x7e, 0x06, 0x65, 0xea, 0x18, 0x7d, 0x93, 0x79, 0x72, 0x5e, 0xa3,
+	0x7b, 0x66, 0x0e, 0x7b, 0x04, 0xdf, 0x4e, 0x68, 0x66, 0x91, 0x3a, 0xfb, 0x26, 0xf3, 0xc4, 0x2a,
+	0xea, 0x82, 0x8a, 0x9e, 0x0a, 0x93, 0x13, 0x17, 0x04, 0x8e, 0x5e, 0x13, 0xdf, 0x8e, 0xa8, 0x73,
+	0x1c, 0xa8, 0x02, 0x6f, 0x3f, 0x80, 0x12, 0x75, 0x8c, 0xee, 0xc8, 0x75, 0xc9, 0xd0, 0x93, 0x29,
+	0x70, 0x47, 0x46, 0x85, 0x3a, 0x75, 0x29, 0x15, 0xf1, 0xff, 0x12, 0x50, 0x04, 0x17, 0xd8, 0x5d,
+	0x97, 0xd9, 0x12, 0x42, 0x03, 0xab, 0x5b, 0xb0, 0xc8, 0xbd, 0x39, 0x1a, 0x0c, 0x4c, 0xf7, 0xa3,
+	0x61, 0xd9, 0x3d, 0xc2, 0x3c, 0x7c, 0x57, 0x94, 0xa2, 0x12, 0x75, 0x74, 0x29, 0x6f, 0x08, 0x31,
+	0xda, 0x86, 0xe5, 0x68, 0xae, 0x1a, 0x96, 0xcd, 0xba, 0x74, 0x34, 0xf4, 0xf0, 0x3d, 0x61, 0x7c,
+	0x29, 0x92, 0xb3, 0x0d, 0x5f, 0x85, 0x5e, 0xc1, 0x8d, 0x78, 0xea, 0x4e, 0x78, 0xf7, 0x05, 0xef,
+	0x7a, 0x2c, 0x85, 0x43, 0xee, 0x8f, 0xa0, 0x30, 0x66, 0x06, 0x75, 0x88, 0xcb, 0xfd, 0x80, 0xbf,
+	0xe0, 0xab, 0xda, 0xc1, 0x7f, 0xfb, 0xe3, 0x93, 0xb2, 0x5f, 0xe6, 0x6b, 0x96, 0xe5, 0x12, 0xc6,
+	0x74, 0xcf, 0xb5, 0x87, 0x3d, 0x0d, 0xc6, 0xac, 0xed, 0x63, 0x79, 0x06, 0x77, 0xa9, 0xeb, 0x50,
+	0x57, 0xce, 0x6a, 0x5b, 0xf8, 0xb1, 0xf4, 0x55, 0x44, 0xda, 0xb4, 0xd0, 0x3b, 0x58, 0xe4, 0x09,
+	0xe9, 0xaf, 0xcd, 0x25, 0x26, 0xa3, 0x43, 0xfc, 0xa5, 0x48, 0xaa, 0xb5, 0xe9, 0xa4, 0xd2, 0x42,
+	0x98, 0x26, 0x50, 0x9a, 0xe2, 0xc6, 0x24, 0xa8, 0x0a, 0x4b, 0x11, 0x63, 0x64, 0x6c, 0x5b, 0x64,
+	0xd8, 0x25, 0xf8, 0x89, 0x70, 0x26, 0x9a, 0xa8, 0x54, 0x5f, 0x83, 0x5e, 0xc3, 0xea, 0x39, 0x84,
+	0x20, 0x08, 0x15, 0xc1, 0xc3, 0xb3, 0x3c, 0x3f, 0x1a, 0x87, 0x80, 0x1c, 0x32, 0xe4, 0x69, 0x2e,
+	0x9c, 0xea, 0xa7, 0x64, 0x55, 0xa4, 0xe4, 0xc6, 0x85, 0xe7, 0xce, 0x2e, 0xf1, 0x33, 0x50, 0x53,
+	0x7c, 0x76, 0x28, 0x41, 0x2f, 0x83, 0xe2, 0xcf, 0xf0, 0xd3, 0xf5, 0xcc, 0x66, 0x21, 0xee, 0x83,
+	0x88, 0x19, 0x9d, 0xe3, 0x82, 0xd2, 0xcf, 0xd0, 0x4f, 0x60, 0x81, 0x8d, 0x18, 0x37, 0x48, 0x2c,
+	0xfc, 0x2c, 0xe1, 0xae, 0x98, 0x50, 0x90, 0x0a, 0x05, 0x39, 0x60, 0x36, 0x1d, 0x32, 0xbc, 0x2d,
+	0x66, 0xbf, 0x77, 0xf1, 0xec, 0x21, 0x56, 0x8b, 0xf2, 0x50, 0x1d, 0x0a, 0x96, 0x6d, 0x19, 0x67,
+	0x36, 0xf3, 0xa8, 0xfb, 0x11, 0x7f, 0x25, 0xcc, 0x5c, 0xec, 0x8b, 0x46, 0xb3, 0xa1, 0x91, 0x2e,
+	0x75, 0x2d, 0x0d, 0x2c, 0xdb, 0x7a, 0x2b, 0x59, 0xe8, 0x11, 0x28, 0x2e, 0xe9, 0x13, 0x93, 0x45,
+	0xca, 0xe4, 0x73, 0x91, 0x3c, 0xa5, 0x40, 0x1e, 0x6c, 0x1e, 0x1b, 0xd6, 0xe3, 0x50, 0x63, 0x34,
+	0x3c, 0xa1, 0x32, 0x2a, 0xf2, 0xfc, 0xf9, 0x41, 0x42, 0x6f, 0xdc, 0x8e, 0x19, 0x3f, 0x0a, 0xec,
+	0x88, 0xe3, 0x68, 0x2f, 0x9b, 0x9f, 0x53, 0x72, 0x7b, 0xd9, 0xfc, 0x03, 0xe5, 0xe1, 0x5e, 0x36,
+	0xff, 0x50, 0xd9, 0xdc, 0xcb, 0xe6, 0x37, 0x95, 0x47, 0x7b, 0xd9, 0xfc, 0x23, 0x65, 0x6b, 0x2f,
+	0x9b, 0xdf, 0x52, 0x1e, 0x6b, 0x85, 0x48, 0x72, 0x6b, 0x37, 0x22, 0x5b, 0xc7, 0x30, 0x47, 0xde,
+	0xd9, 0x6f, 0x0c, 0x32, 0x34, 0x4f, 0xfa, 0xc4, 0xd2, 0x6e, 0xcf, 0xaa, 0x44, 0x20, 0x8c, 0xbe,
+	0x3d, 0xb0, 0x3d, 0xed, 0xce, 0xac, 0xfa, 0x6b, 0xdb, 0x3b, 0xe3, 0x59, 0xd6, 0x73, 0x79, 0xeb,
+	0x72, 0x77, 0x16, 0xc0, 0x33, 0x30, 0x6a, 0x63, 0xed, 0xa2, 0x29, 0x1c, 0xe2, 0xda, 0xd4, 0xda,
+	0xf8, 0x43, 0x0a, 0xca, 0xe7, 0x45, 0x22, 0x68, 0x86, 0x52, 0x93, 0x66, 0xe8, 0xa7, 0x00, 0xa2,
+	0x4c, 0xca, 0xc6, 0x20, 0x9d, 0x34, 0xb9, 0x04, 0x47, 0x34, 0x05, 0x35, 0x28, 0x48, 0x03, 0x32,
+	0x20, 0x99, 0x84, 0x16, 0xe4, 0xac, 0xc2, 0xfb, 0x1b, 0xff, 0xcd, 0x4c, 0x2d, 0x77, 0xb2, 0x65,
+	0xce, 0x39, 0xe7, 0x53, 0xc9, 0xce, 0xf9, 0x74, 0xd2, 0x73, 0x3e, 0x73, 0xc1, 0x39, 0x7f, 0x61,
+	0x35, 0xce, 0x7e, 0xcf, 0x6a, 0x3c, 0xf7, 0xdd, 0xd5, 0xf8, 0x05, 0x5c, 0x77, 0x5c, 0xea, 0x50,
+	0x46, 0x5c, 0x23, 0x56, 0x5b, 0x73, 0x82, 0xb9, 0x1c, 0xa8, 0xeb, 0x53, 0x35, 0xf6, 0x35, 0xe4,
+	0x7d, 0x85, 0x85, 0xe7, 0x93, 0x36, 0x52, 0x01, 0x43, 0xb4, 0xb3, 0xdd, 0x2e, 0x71, 0x2e, 0xd7,
+	0xce, 0xfa, 0x0c, 0x5e, 0x97, 0xc2, 0xb6, 0x0e, 0x2f, 0x24, 0x4d, 0x9d, 0x90, 0xb2, 0xf1, 0xfb,
+	0x39, 0x50, 0xe2, 0x55, 0x6f, 0xe6, 0x3e, 0xb0, 0x02, 0x39, 0x73, 0x20, 0x3c, 0x28, 0x63, 0xea,
+	0x8f, 0xb8, 0xdc, 0x3f, 0x51, 0x32, 0x22, 0x9b, 0xfd, 0x11, 0xcf, 0x99, 0x78, 0xad, 0x97, 0xbd,
+	0x7f, 0x91, 0x4c, 0x57, 0xf8, 0xe7, 0xb0, 0x22, 0x0b, 0xec, 0x8c, 0xc3, 0x65, 0xa8, 0xca, 0xbe,
+	0x76, 0xda, 0xdf, 0xff, 0xcf, 0xe5, 0xe1, 0x1d, 0x28, 0x96, 0xcd, 0x9c, 0x91, 0x47, 0x0c, 0x8b,
+	0x98, 0x56, 0xdf, 0x1e, 0x92, 0xc4, 0x31, 0x2b, 0xf9, 0xcc, 0x86, 0x4f, 0x44, 0xcf, 0x20, 0xc7,
+	0xbb, 0xa5, 0x11, 0x13, 0x81, 0x2b, 0x6e, 0xdf, 0x98, 0x2e, 0xc4, 0xc2, 0x99, 0xba, 0x00, 0x68,
+	0x3e, 0x90, 0xef, 0x12, 0xd3, 0x71, 0x88, 0xd9, 0x0f, 0xce, 0xe2, 0x05, 0xe1, 0x98, 0xab, 0x52,
+	0xe8, 0x9f, 0xb3, 0xcf, 0x61, 0xc5, 0x07, 0xc5, 0xdd, 0x08, 0x02, 0x5d, 0x96, 0xda, 0xd8, 0x71,
+	0xc9, 0x13, 0x49, 0xc8, 0x89, 0x85, 0x0b, 0x89, 0x13, 0xc9, 0x67, 0xa0, 0x6d, 0x98, 0x37, 0xdd,
+	0x13, 0xdb, 0x23, 0xae, 0xb8, 0xdc, 0x7c, 0x57, 0x1b, 0x12, 0x00, 0xf9, 0x8c, 0x2e, 0x61, 0xb4,
+	0x3f, 0x26, 0x16, 0xbe, 0x96, 0x74, 0xc6, 0x80, 0x81, 0x2a, 0xb0, 0x74, 0x46, 0xfa, 0xb2, 0xea,
+	0x4d, 0x0e, 0x15, 0x71, 0x31, 0xca, 0x6a, 0x8b, 0x5c, 0xc5, 0x8b, 0x5b, 0x78, 0x4a, 0x6c, 0xfc,
+	0x3d, 0x0d, 0xcb, 0xe7, 0x1e, 0x91, 0xd3, 0x87, 0x73, 0xea, 0xf2, 0x87, 0xf3, 0x24, 0x8f, 0xd3,
+	0x53, 0x79, 0xfc, 0x12, 0x70, 0x00, 0x9a, 0x49, 0x50, 0x59, 0xb4, 0x56, 0x42, 0xfd, 0x74, 0x8a,
+	0xee, 0x40, 0x61, 0x34, 0x9c, 0xac, 0x29, 0x9b, 0x70, 0x4d, 0x51, 0x12, 0x3f, 0xa6, 0xc3, 0x61,
+	0x90, 0x2d, 0x73, 0xb2, 0x6f, 0x0d, 0xe5, 0x7e, 0xc2, 0xbc, 0x86, 0xd5, 0x09, 0xf3, 0x82, 0xe2,
+	0x85, 0x23, 0x88, 0xa9, 0xc5, 0x6e, 0xfc, 0x29, 0x03, 0x28, 0xe2, 0xd8, 0xba, 0xc9, 0xba, 0xa6,
+	0x45, 0x66, 0xaa, 0x40, 0x05, 0x96, 0x5c, 0x4a, 0xbd, 0xf8, 0x5d, 0x46, 0x96, 0x84, 0x45, 0xae,
+	0x9a, 0xbe, 0xc6, 0x3c, 0x81, 0xec, 0x80, 0x5a, 0xc1, 0x43, 0x41, 0x6c, 0x6f, 0xf8, 0x93, 0x1c,
+	0x50, 0x8b, 0x68, 0x02, 0x86, 0x5e, 0x84, 0x41, 0xc8, 0x26, 0x6a, 0x4f, 0x23, 0x41, 0xb2, 0x87,
+	0xb6, 0x67, 0x8b, 0x83, 0xf8, 0xdc, 0x2a, 0xb2, 0x12, 0xea, 0x67, 0xea, 0x08, 0xf3, 0x4c, 0xf7,
+	0x52, 0x75, 0xc4, 0x27, 0xf0, 0x94, 0xeb, 0xd2, 0x81, 0xd3, 0x27, 0xde, 0x25, 0x8a, 0xfe, 0x84,
+	0xc2, 0xef, 0xb0, 0x7e, 0x77, 0x8a, 0xf3, 0xeb, 0x19, 0x7e, 0x87, 0xf5, 0x87, 0x5c, 0x33, 0xb6,
+	0x99, 0xcd, 0xed, 0x2e, 0xc8, 0xdb, 0xad, 0x3f, 0x44, 0xab, 0x90, 0x37, 0x45, 0xe1, 0xf6, 0xdf,
+	0x2e, 0xb2, 0x5a, 0x38, 0xde, 0xf8, 0x6b, 0x7a, 0x2a, 0x86, 0x3a, 0x61, 0x62, 0x67, 0x4c, 0x62,
+	0xb8, 0x20, 0x62, 0x38, 0x7b, 0x6b, 0x48, 0x9f, 0x77, 0x6b, 0x88, 0xdd, 0x4b, 0x32, 0x97, 0xb8,
+	0x97, 0x44, 0x8a, 0x73, 0xf6, 0x7b, 0xbc, 0xec, 0x84, 0x2f, 0x12, 0x73, 0x97, 0x7e, 0x91, 0x68,
+	0x43, 0x89, 0xc9, 0xcf, 0x36, 0x5c, 0xd1, 0x6a, 0x31, 0x9c, 0x13, 0xfd, 0xf1, 0x83, 0x8b, 0xdb,
+	0x6c, 0x89, 0xf7, 0x7b, 0xe4, 0x22, 0x8b, 0x0e, 0xd9, 0xc6, 0x9f, 0xd3, 0x80, 0x2f, 0x02, 0xcf,
+	0xec, 0x8e, 0xc8, 0x77, 0xa7, 0x2f, 0xfb, 0xdd, 0x7e, 0xa3, 0x43, 0x66, 0xde, 0x09, 0x32, 0x93,
+	0x46, 0x87, 0xc4, 0x1e, 0x09, 0x5e, 0x80, 0xdf, 0xc7, 0xcc, 0xb2, 0x64, 0x7b, 0xb4, 0x1c, 0xa8,
+	0xa7, 0x79, 0x3f, 0x86, 0x9b, 0x5f, 0x9b, 0xfd, 0x3e, 0xf1, 0x0c, 0xb3, 0xc7, 0xaf, 0xcf, 0x31,
+	0xae, 0xdc, 0x31, 0x58, 0x42, 0x6a, 0x1c, 0x31, 0x4d, 0x7f, 0x0a, 0xe5, 0x73, 0x79, 0xb2, 0xc6,
+	0x20, 0x73, 0x86, 0xb1, 0xf1, 0x00, 0x8a, 0x6d, 0x87, 0xe7, 0x95, 0xd9, 0x3f, 0x6a, 0x0e, 0xbd,
+	0x17, 0xcf, 0x51, 0x19, 0xe6, 0xc6, 0x66, 0x7f, 0x44, 0x7c, 0xef, 0xc9, 0xc1, 0xd6, 0x6f, 0x53,
+	0x50, 0x8a, 0x3d, 0x1f, 0xa2, 0x12, 0x14, 0x8e, 0x5a, 0xfa, 0xa1, 0x5a, 0x6f, 0xee, 0x36, 0xd5,
+	0x86, 0x72, 0x05, 0x01, 0xe4, 0x9a, 0xba, 0x7e, 0xa4, 0x6a, 0x4a, 0x0a, 0x5d, 0x85, 0xfc, 0xb1,
+	0xaa, 0x71, 0x8d, 0xa6, 0xa4, 0x11, 0x82, 0xa2, 0xd4, 0x18, 0x6f, 0xb4, 0x5a, 0xab, 0xd3, 0xd6,
+	0x94, 0x0c, 0x2a, 0x83, 0x12, 0x20, 0x42, 0x69, 0x16, 0x5d, 0x83, 0x05, 0xb5, 0xde, 0xd6, 0xdf,
+	0xeb, 0x1d, 0xf5, 0x40, 0x99, 0xe3, 0x26, 0xdf, 0xb6, 0xf7, 0x1b, 0xaa, 0xa6, 0xe4, 0xb6, 0x0c,
+	0x28, 0xc5, 0xde, 0x56, 0xd0, 0x3a, 0xdc, 0x6a, 0xb7, 0x76, 0xda, 0x35, 0xad, 0xd1, 0x6c, 0xbd,
+	0x31, 0xf4, 0x4e, 0xad, 0xa3, 0x1a, 0xd3, 0x6b, 0x2a, 0xc0, 0xfc, 0xa1, 0xda, 0xe2, 0x6a, 0x25,
+	0xc5, 0x8d, 0x1f, 0xd7, 0xf6, 0x9b, 0x8d, 0x5a, 0x47, 0x6d, 0x28, 0x69, 0x54, 0x04, 0xe8, 0xa8,
+	0xda, 0x41, 0xb3, 0x25, 0xc6, 0x99, 0xad, 0xff, 0xa4, 0x40, 0x89, 0x57, 0x32, 0x74, 0x17, 0x6e,
+	0x6b, 0xea, 0x71, 0xbb, 0x5e, 0xeb, 0x34, 0xdb, 0x2d, 0x43, 0x53, 0x6b, 0x7a, 0xbb, 0x15, 0x9b,
+	0xe3, 0x3e, 0xac, 0xcf, 0x42, 0xde, 0xa9, 0xef, 0x8d, 0x7a, 0xfb, 0xe0, 0x50, 0x6b, 0x1f, 0x34,
+	0x75, 0x55, 0x49, 0xa1, 0x4d, 0xb8, 0x3f, 0x8b, 0x0a, 0xbf, 0xd5, 0x68, 0xa8, 0xf5, 0xa6, 0xde,
+	0x6c, 0xb7, 0x94, 0xf4, 0xf9, 0xf6, 0x8e, 0xdb, 0xfb, 0x47, 0xad, 0x4e, 0x4d, 0x7b, 0x6f, 0xa8,
+	0x3f, 0x6f, 0x76, 0x94, 0x0c, 0xba, 0x09, 0xd7, 0x67, 0x51, 0xbb, 0x5a, 0xed, 0xa8, 0xa1, 0x64,
+	0xcf, 0x57, 0xb6, 0x3b, 0x6f, 0x55, 0x4d, 0x99, 0xdb, 0xfa, 0x5d, 0x0a, 0x0a, 0x91, 0xf6, 0x07,
+	0xdd, 0x02, 0xac, 0xef, 0xd7, 0xf4, 0xb7, 0xc2, 0x81, 0x47, 0x7a, 0xec, 0xeb, 0x30, 0x94, 0xa7,
+	0xb4, 0x13, 0x77, 0xde, 0x80, 0xe5, 0x29, 0x4d, 0xed, 0xf0, 0x50, 0xad, 0xed, 0x0b, 0xd7, 0xae,
+	0xc2, 0xca, 0x94, 0xaa, 0xde, 0x6e, 0xed, 0x36, 0xb5, 0x03, 0xee, 0xe6, 0x19, 0x9a, 0xa6, 0x1e,
+	0xab, 0x9a, 0xae, 0x36, 0x94, 0xec, 0xd6, 0x2f, 0xa1, 0x10, 0x39, 0x7b, 0xf8, 0xc2, 0xea, 0x35,
+	0xbd, 0x5e, 0x6b, 0xa8, 0xc6, 0x41, 0xbb, 0x11, 0x0f, 0xed, 0x75, 0x58, 0x9a, 0xd2, 0xf2, 0x0f,
+	0x7e, 0xc7, 0x3d, 0x8d, 0xa1, 0x3c, 0xa5, 0xd0, 0x8f, 0x74, 0xbe, 0x68, 0x25, 0xbd, 0xb3, 0xf3,
+	0x97, 0x4f, 0x6b, 0xa9, 0x6f, 0x3f, 0xad, 0xa5, 0xfe, 0xf5, 0x69, 0x2d, 0xf5, 0xcd, 0xe7, 0xb5,
+	0x2b, 0xdf, 0x7e, 0x5e, 0xbb, 0xf2, 0x8f, 0xcf, 0x6b, 0x57, 0x7e, 0xb1, 0xd9, 0xb3, 0xbd, 0xb3,
+	0xd1, 0x49, 0xa5, 0x4b, 0x07, 0x55, 0x59, 0x90, 0x9e, 0xf4, 0xcd, 0x13, 0xe6, 0xff, 0xae, 0x7e,
+	0xa8, 0x3a, 0x8e, 0xfc, 0x17, 0xe0, 0x24, 0x27, 0x4a, 0xc6, 0x57, 0xff, 0x0b, 0x00, 0x00, 0xff,
+	0xff, 0x86, 0xc9, 0xda, 0xd9, 0x22, 0x18, 0x00, 0x00,
+}
+
+func (m *Participant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.VerificationFees != 0 {
-		n += 2 + sovTypes(uint64(m.VerificationFees))
+	return dAtA[:n], nil
+}
+
+func (m *Participant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Participant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleasedDepositUnbondingUntil != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReleasedDepositUnbondingUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleasedDepositUnbondingUntil):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.Deposit != 0 {
-		n += 2 + sovTypes(uint64(m.Deposit))
+	if m.ReleasedDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReleasedDeposit))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.SlashedDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.SlashedDeposit))
+	if len(m.DidHistory) > 0 {
+		for iNdEx := len(m.DidHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	if m.RepaidDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.RepaidDeposit))
+	if len(m.Suspensions) > 0 {
+		for iNdEx := len(m.Suspensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Suspensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.Revoked != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked)
-		n += 2 + l + sovTypes(uint64(l))
+	if m.Suspended != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Suspended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Suspended):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.ValidatorParticipantId != 0 {
-		n += 2 + sovTypes(uint64(m.ValidatorParticipantId))
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	if m.OpState != 0 {
-		n += 2 + sovTypes(uint64(m.OpState))
+	if m.PendingFeeChange != nil {
+		{
+			size, err := m.PendingFeeChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
 	}
-	if m.OpExp != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpExp)
-		n += 2 + l + sovTypes(uint64(l))
+	if len(m.RevocationEvidenceDigest) > 0 {
+		i -= len(m.RevocationEvidenceDigest)
+		copy(dAtA[i:], m.RevocationEvidenceDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RevocationEvidenceDigest)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
 	}
-	if m.OpLastStateChange != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpLastStateChange)
-		n += 2 + l + sovTypes(uint64(l))
+	if len(m.RevocationEvidence) > 0 {
+		i -= len(m.RevocationEvidence)
+		copy(dAtA[i:], m.RevocationEvidence)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RevocationEvidence)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
 	}
-	if m.OpValidatorDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.OpValidatorDeposit))
+	if m.RevocationReason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevocationReason))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
 	}
-	if m.OpCurrentFees != 0 {
-		n += 2 + sovTypes(uint64(m.OpCurrentFees))
+	if m.CorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
 	}
-	if m.OpCurrentDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.OpCurrentDeposit))
+	if len(m.VsOperator) > 0 {
+		i -= len(m.VsOperator)
+		copy(dAtA[i:], m.VsOperator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VsOperator)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
 	}
-	l = len(m.OpSummaryDigest)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+	if m.VerificationFeeDiscount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFeeDiscount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
 	}
 	if m.IssuanceFeeDiscount != 0 {
-		n += 2 + sovTypes(uint64(m.IssuanceFeeDiscount))
-	}
-	if m.VerificationFeeDiscount != 0 {
-		n += 2 + sovTypes(uint64(m.VerificationFeeDiscount))
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFeeDiscount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
 	}
-	l = len(m.VsOperator)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+	if len(m.OpSummaryDigest) > 0 {
+		i -= len(m.OpSummaryDigest)
+		copy(dAtA[i:], m.OpSummaryDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OpSummaryDigest)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.CorporationId != 0 {
-		n += 2 + sovTypes(uint64(m.CorporationId))
+	if m.OpCurrentDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpCurrentDeposit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
 	}
-	return n
-}
-
-func (m *ParticipantSession) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.OpCurrentFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpCurrentFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.OpValidatorDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpValidatorDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	if m.CorporationId != 0 {
-		n += 1 + sovTypes(uint64(m.CorporationId))
+	if m.OpLastStateChange != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.OpLastStateChange, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpLastStateChange):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTypes(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	l = len(m.VsOperator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.OpExp != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.OpExp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpExp):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	if m.Created != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
-		n += 1 + l + sovTypes(uint64(l))
+	if m.OpState != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpState))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
 	}
-	if m.Modified != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ValidatorParticipantId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorParticipantId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	if len(m.SessionRecords) > 0 {
-		for _, e := range m.SessionRecords {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if m.Revoked != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Revoked, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked):])
+		if err6 != nil {
+			return 0, err6
 		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	return n
-}
-
-func (m *ParticipantSessionRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RepaidDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+	if m.SlashedDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if m.Created != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Deposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.IssuerParticipantId != 0 {
-		n += 1 + sovTypes(uint64(m.IssuerParticipantId))
+	if m.VerificationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.VerifierParticipantId != 0 {
-		n += 1 + sovTypes(uint64(m.VerifierParticipantId))
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.WalletAgentParticipantId != 0 {
-		n += 1 + sovTypes(uint64(m.WalletAgentParticipantId))
+	if m.ValidationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.AgentParticipantId != 0 {
-		n += 1 + sovTypes(uint64(m.AgentParticipantId))
+	if m.Modified != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTypes(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.EffectiveUntil != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTypes(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.EffectiveFrom != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTypes(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Repaid != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Repaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTypes(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Slashed != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Slashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTypes(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Adjusted != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Adjusted, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Adjusted):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintTypes(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Created != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTypes(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantDIDRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantDIDRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantDIDRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidUntil != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ValidUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidUntil):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTypes(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValidFrom != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidFrom):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintTypes(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantFeeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantFeeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantFeeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Effective != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Effective, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Effective):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintTypes(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Accepted != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Accepted, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Accepted):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintTypes(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Proposed != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Proposed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Proposed):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintTypes(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ProposerCorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposerCorporationId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VerificationFeeDiscount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFeeDiscount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IssuanceFeeDiscount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFeeDiscount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeldFromUnbonding != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HeldFromUnbonding))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Resolved != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Resolved, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Resolved):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintTypes(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Appealed != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Appealed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Appealed):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintTypes(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AppealEvidenceDigest) > 0 {
+		i -= len(m.AppealEvidenceDigest)
+		copy(dAtA[i:], m.AppealEvidenceDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppealEvidenceDigest)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AppealReason) > 0 {
+		i -= len(m.AppealReason)
+		copy(dAtA[i:], m.AppealReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppealReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DisputeDeadline != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DisputeDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DisputeDeadline):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintTypes(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Created != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintTypes(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SlasherCorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlasherCorporationId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EvidenceDigest) > 0 {
+		i -= len(m.EvidenceDigest)
+		copy(dAtA[i:], m.EvidenceDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvidenceDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantSuspension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantSuspension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantSuspension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnsuspenderCorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnsuspenderCorporationId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.UnsuspendReason) > 0 {
+		i -= len(m.UnsuspendReason)
+		copy(dAtA[i:], m.UnsuspendReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UnsuspendReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Unsuspended != nil {
+		n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Unsuspended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Unsuspended):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintTypes(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SuspenderCorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SuspenderCorporationId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Suspended != nil {
+		n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Suspended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Suspended):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintTypes(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantCascade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantCascade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantCascade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Affected != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Affected))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Visited != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Visited))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Pending) > 0 {
+		dAtA26 := make([]byte, len(m.Pending)*10)
+		var j25 int
+		for _, num := range m.Pending {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintTypes(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Completed != nil {
+		n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Completed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Completed):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintTypes(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Started != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Started, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Started):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintTypes(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InitiatorCorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InitiatorCorporationId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RootParticipantId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RootParticipantId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionRecords) > 0 {
+		for iNdEx := len(m.SessionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Modified != nil {
+		n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintTypes(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Created != nil {
+		n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintTypes(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VsOperator) > 0 {
+		i -= len(m.VsOperator)
+		copy(dAtA[i:], m.VsOperator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VsOperator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantSessionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantSessionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantSessionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgentParticipantId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AgentParticipantId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WalletAgentParticipantId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WalletAgentParticipantId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VerifierParticipantId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerifierParticipantId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IssuerParticipantId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuerParticipantId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Created != nil {
+		n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintTypes(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptionalUInt64) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalUInt64) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalUInt64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Participant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovTypes(uint64(m.SchemaId))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTypes(uint64(m.Role))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Adjusted != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Adjusted)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Slashed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Repaid != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 2 + sovTypes(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 2 + sovTypes(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 2 + sovTypes(uint64(m.VerificationFees))
+	}
+	if m.Deposit != 0 {
+		n += 2 + sovTypes(uint64(m.Deposit))
+	}
+	if m.SlashedDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.SlashedDeposit))
+	}
+	if m.RepaidDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.RepaidDeposit))
+	}
+	if m.Revoked != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.ValidatorParticipantId != 0 {
+		n += 2 + sovTypes(uint64(m.ValidatorParticipantId))
+	}
+	if m.OpState != 0 {
+		n += 2 + sovTypes(uint64(m.OpState))
+	}
+	if m.OpExp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpExp)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.OpLastStateChange != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OpLastStateChange)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.OpValidatorDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.OpValidatorDeposit))
+	}
+	if m.OpCurrentFees != 0 {
+		n += 2 + sovTypes(uint64(m.OpCurrentFees))
+	}
+	if m.OpCurrentDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.OpCurrentDeposit))
+	}
+	l = len(m.OpSummaryDigest)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.IssuanceFeeDiscount != 0 {
+		n += 2 + sovTypes(uint64(m.IssuanceFeeDiscount))
+	}
+	if m.VerificationFeeDiscount != 0 {
+		n += 2 + sovTypes(uint64(m.VerificationFeeDiscount))
+	}
+	l = len(m.VsOperator)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.CorporationId != 0 {
+		n += 2 + sovTypes(uint64(m.CorporationId))
+	}
+	if m.RevocationReason != 0 {
+		n += 2 + sovTypes(uint64(m.RevocationReason))
+	}
+	l = len(m.RevocationEvidence)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RevocationEvidenceDigest)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.PendingFeeChange != nil {
+		l = m.PendingFeeChange.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Suspended != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Suspended)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.Suspensions) > 0 {
+		for _, e := range m.Suspensions {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DidHistory) > 0 {
+		for _, e := range m.DidHistory {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ReleasedDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.ReleasedDeposit))
+	}
+	if m.ReleasedDepositUnbondingUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleasedDepositUnbondingUntil)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantDIDRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidFrom)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidUntil)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantFeeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidationFees != 0 {
+		n += 1 + sovTypes(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTypes(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTypes(uint64(m.VerificationFees))
+	}
+	if m.IssuanceFeeDiscount != 0 {
+		n += 1 + sovTypes(uint64(m.IssuanceFeeDiscount))
+	}
+	if m.VerificationFeeDiscount != 0 {
+		n += 1 + sovTypes(uint64(m.VerificationFeeDiscount))
+	}
+	if m.ProposerCorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposerCorporationId))
+	}
+	if m.Proposed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Proposed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Accepted != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Accepted)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Effective != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Effective)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvidenceDigest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SlasherCorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.SlasherCorporationId))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DisputeDeadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DisputeDeadline)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.AppealReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AppealEvidenceDigest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Appealed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Appealed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Resolved != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Resolved)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.HeldFromUnbonding != 0 {
+		n += 1 + sovTypes(uint64(m.HeldFromUnbonding))
+	}
+	return n
+}
+
+func (m *ParticipantSuspension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Suspended != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Suspended)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SuspenderCorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.SuspenderCorporationId))
+	}
+	if m.Unsuspended != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Unsuspended)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.UnsuspendReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UnsuspenderCorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.UnsuspenderCorporationId))
+	}
+	return n
+}
+
+func (m *ParticipantCascade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.RootParticipantId != 0 {
+		n += 1 + sovTypes(uint64(m.RootParticipantId))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	if m.InitiatorCorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.InitiatorCorporationId))
+	}
+	if m.Started != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Started)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Completed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Completed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Pending) > 0 {
+		l = 0
+		for _, e := range m.Pending {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.Visited != 0 {
+		n += 1 + sovTypes(uint64(m.Visited))
+	}
+	if m.Affected != 0 {
+		n += 1 + sovTypes(uint64(m.Affected))
+	}
+	return n
+}
+
+func (m *ParticipantSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.CorporationId))
+	}
+	l = len(m.VsOperator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.SessionRecords) > 0 {
+		for _, e := range m.SessionRecords {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipantSessionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IssuerParticipantId != 0 {
+		n += 1 + sovTypes(uint64(m.IssuerParticipantId))
+	}
+	if m.VerifierParticipantId != 0 {
+		n += 1 + sovTypes(uint64(m.VerifierParticipantId))
+	}
+	if m.WalletAgentParticipantId != 0 {
+		n += 1 + sovTypes(uint64(m.WalletAgentParticipantId))
+	}
+	if m.AgentParticipantId != 0 {
+		n += 1 + sovTypes(uint64(m.AgentParticipantId))
+	}
+	return n
+}
+
+func (m *OptionalUInt64) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Participant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Participant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Participant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ParticipantRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjusted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Adjusted == nil {
+				m.Adjusted = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Adjusted, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashed == nil {
+				m.Slashed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Slashed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repaid == nil {
+				m.Repaid = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Repaid, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			m.Deposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+			}
+			m.SlashedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			}
+			m.RepaidDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Revoked == nil {
+				m.Revoked = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Revoked, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
+			}
+			m.ValidatorParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorParticipantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpState", wireType)
+			}
+			m.OpState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpState |= OnboardingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpExp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OpExp == nil {
+				m.OpExp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.OpExp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpLastStateChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OpLastStateChange == nil {
+				m.OpLastStateChange = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.OpLastStateChange, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpValidatorDeposit", wireType)
+			}
+			m.OpValidatorDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpValidatorDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpCurrentFees", wireType)
+			}
+			m.OpCurrentFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpCurrentFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpCurrentDeposit", wireType)
+			}
+			m.OpCurrentDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpCurrentDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpSummaryDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpSummaryDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
+			}
+			m.IssuanceFeeDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
+			}
+			m.VerificationFeeDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFeeDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
+			}
+			m.RevocationReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevocationReason |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationEvidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocationEvidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationEvidenceDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocationEvidenceDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFeeChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingFeeChange == nil {
+				m.PendingFeeChange = &ParticipantFeeChange{}
+			}
+			if err := m.PendingFeeChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, &ParticipantSlash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Suspended == nil {
+				m.Suspended = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Suspended, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suspensions = append(m.Suspensions, &ParticipantSuspension{})
+			if err := m.Suspensions[len(m.Suspensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidHistory = append(m.DidHistory, &ParticipantDIDRecord{})
+			if err := m.DidHistory[len(m.DidHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedDeposit", wireType)
+			}
+			m.ReleasedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedDepositUnbondingUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleasedDepositUnbondingUntil == nil {
+				m.ReleasedDepositUnbondingUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReleasedDepositUnbondingUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantDIDRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantDIDRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantDIDRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidUntil == nil {
+				m.ValidUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ValidUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantFeeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantFeeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantFeeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
+			}
+			m.IssuanceFeeDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
+			}
+			m.VerificationFeeDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFeeDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerCorporationId", wireType)
+			}
+			m.ProposerCorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerCorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposed == nil {
+				m.Proposed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Proposed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Accepted == nil {
+				m.Accepted = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Accepted, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Effective == nil {
+				m.Effective = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Effective, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *OptionalUInt64) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Participant) Unmarshal(dAtA []byte) error {
+func (m *ParticipantSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1345,10 +4496,10 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Participant: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Participant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1372,9 +4523,9 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.SchemaId = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1384,16 +4535,16 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SchemaId |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1403,14 +4554,27 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= ParticipantRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1438,8 +4602,27 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.EvidenceDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlasherCorporationId", wireType)
+			}
+			m.SlasherCorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlasherCorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
@@ -1476,9 +4659,9 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Adjusted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1505,18 +4688,37 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Adjusted == nil {
-				m.Adjusted = new(time.Time)
+			if m.DisputeDeadline == nil {
+				m.DisputeDeadline = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Adjusted, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DisputeDeadline, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1526,33 +4728,29 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Slashed == nil {
-				m.Slashed = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Slashed, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppealReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealEvidenceDigest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1562,31 +4760,27 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repaid == nil {
-				m.Repaid = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Repaid, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppealEvidenceDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appealed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1613,18 +4807,18 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveFrom == nil {
-				m.EffectiveFrom = new(time.Time)
+			if m.Appealed == nil {
+				m.Appealed = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Appealed, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1634,31 +4828,27 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1685,56 +4875,18 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Modified == nil {
-				m.Modified = new(time.Time)
+			if m.Resolved == nil {
+				m.Resolved = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Resolved, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
-			}
-			m.ValidationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
-			}
-			m.IssuanceFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuanceFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 19:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldFromUnbonding", wireType)
 			}
-			m.VerificationFees = 0
+			m.HeldFromUnbonding = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1744,71 +4896,64 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerificationFees |= uint64(b&0x7F) << shift
+				m.HeldFromUnbonding |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Deposit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Deposit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SlashedDeposit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedDeposit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantSuspension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RepaidDeposit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RepaidDeposit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 23:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantSuspension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantSuspension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1835,18 +4980,18 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Revoked == nil {
-				m.Revoked = new(time.Time)
+			if m.Suspended == nil {
+				m.Suspended = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Revoked, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Suspended, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipantId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.ValidatorParticipantId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1856,16 +5001,29 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorParticipantId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 27:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspenderCorporationId", wireType)
 			}
-			m.OpState = 0
+			m.SuspenderCorporationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1875,14 +5033,14 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpState |= OnboardingState(b&0x7F) << shift
+				m.SuspenderCorporationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 28:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpExp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsuspended", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1909,18 +5067,18 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OpExp == nil {
-				m.OpExp = new(time.Time)
+			if m.Unsuspended == nil {
+				m.Unsuspended = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.OpExp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Unsuspended, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpLastStateChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsuspendReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1930,33 +5088,29 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OpLastStateChange == nil {
-				m.OpLastStateChange = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.OpLastStateChange, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UnsuspendReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 30:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpValidatorDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsuspenderCorporationId", wireType)
 			}
-			m.OpValidatorDeposit = 0
+			m.UnsuspenderCorporationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1966,16 +5120,66 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpValidatorDeposit |= uint64(b&0x7F) << shift
+				m.UnsuspenderCorporationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantCascade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantCascade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpCurrentFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.OpCurrentFees = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1985,16 +5189,16 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpCurrentFees |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpCurrentDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootParticipantId", wireType)
 			}
-			m.OpCurrentDeposit = 0
+			m.RootParticipantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2004,16 +5208,16 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpCurrentDeposit |= uint64(b&0x7F) << shift
+				m.RootParticipantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 33:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpSummaryDigest", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var stringLen uint64
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2023,29 +5227,35 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Mode |= CascadeMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.OpSummaryDigest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 35:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFeeDiscount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatorCorporationId", wireType)
 			}
-			m.IssuanceFeeDiscount = 0
+			m.InitiatorCorporationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2055,16 +5265,16 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuanceFeeDiscount |= uint64(b&0x7F) << shift
+				m.InitiatorCorporationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 36:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFeeDiscount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.VerificationFeeDiscount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2074,16 +5284,33 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerificationFeeDiscount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 37:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Started, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VsOperator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2093,29 +5320,109 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VsOperator = string(dAtA[iNdEx:postIndex])
+			if m.Completed == nil {
+				m.Completed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Completed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 43:
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Pending = append(m.Pending, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Pending) == 0 {
+					m.Pending = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Pending = append(m.Pending, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Visited", wireType)
 			}
-			m.CorporationId = 0
+			m.Visited = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2125,7 +5432,26 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CorporationId |= uint64(b&0x7F) << shift
+				m.Visited |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affected", wireType)
+			}
+			m.Affected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Affected |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
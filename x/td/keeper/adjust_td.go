@@ -8,39 +8,38 @@ import (
 	"github.com/verana-labs/verana/x/td/types"
 )
 
-// AdjustTrustDeposit modifies the trust deposit for an account by the specified amount.
+// AdjustTrustDeposit modifies the trust deposit of a corporation by the specified amount.
 // If augend is positive, it increases the trust deposit.
-// If augend is negative, it decreases the trust deposit and increases the claimable amount.
+// If augend is negative, it releases part of the trust deposit: the amount is
+// queued for trust_deposit_unbonding_days, during which it can still be
+// slashed, then becomes claimable. With no unbonding period it is claimable at once.
 //
 // The function follows the specification [MOD-TD-MSG-1] from the Verana blockchain specs.
 //
 // Parameters:
 // - ctx: The SDK context
-// - account: The account address as a Bech32 string
+// - corporationID: The corporation_id the trust deposit is keyed by (funds move from and to its policy_address)
 // - augend: The amount to adjust (positive for increase, negative for decrease)
 // - reason: A human-readable string describing why the adjustment is being made (emitted in events)
 //
 // Returns:
 // - error: If the operation fails
-func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64, reason string) error {
+func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, corporationID uint64, augend int64, reason string) error {
 	// Basic validation
-	if account == "" {
-		return fmt.Errorf("account cannot be empty")
-	}
-	senderAcc, err := sdk.AccAddressFromBech32(account)
-	if err != nil {
-		return fmt.Errorf("invalid account address: %w", err)
-	}
 	if augend == 0 {
 		return fmt.Errorf("augend must be non-zero")
 	}
+	senderAcc, account, err := k.corporationAccount(ctx, corporationID)
+	if err != nil {
+		return err
+	}
 
 	// Get global share value parameter
 	params := k.GetParams(ctx)
 	shareValue := params.TrustDepositShareValue
 
 	// Load existing trust deposit if it exists
-	td, err := k.TrustDeposit.Get(ctx, account)
+	td, err := k.TrustDeposit.Get(ctx, corporationID)
 
 	if err != nil {
 		// If trust deposit doesn't exist and trying to decrease, abort
@@ -52,14 +51,15 @@ func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64
 		augendShare := k.AmountToShare(uint64(augend), shareValue)
 
 		td = types.TrustDeposit{
-			Corporation: account,
-			Deposit:     uint64(augend),
-			Share:       augendShare,
-			Claimable:   0,
+			Corporation:   account,
+			CorporationId: corporationID,
+			Deposit:       uint64(augend),
+			Share:         augendShare,
+			Claimable:     0,
 		}
 
 		// Save new trust deposit BEFORE bank transfer
-		err := k.TrustDeposit.Set(ctx, account, td)
+		err := k.SetTrustDeposit(ctx, td)
 		if err != nil {
 			return fmt.Errorf("failed to save trust deposit: %w", err)
 		}
@@ -78,6 +78,7 @@ func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeAdjustTrustDeposit,
+				sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(corporationID, 10)),
 				sdk.NewAttribute(types.AttributeKeyAccount, account),
 				sdk.NewAttribute(types.AttributeKeyAugend, strconv.FormatInt(augend, 10)),
 				sdk.NewAttribute(types.AttributeKeyAdjustmentType, "increase"),
@@ -136,14 +137,23 @@ func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64
 		// Handle negative adjustment (decrease)
 		absAugend := -augend
 
-		// if augend is negative and td.claimable - augend > td.deposit transaction MUST abort
-		if claimable+absAugend > deposit {
-			return fmt.Errorf("claimable after adjustment would exceed deposit: %d > %d", claimable+absAugend, deposit)
+		// if augend is negative and td.claimable - augend > td.deposit transaction MUST abort.
+		// Amounts still unbonding are already released and count as claimable.
+		unbonding := int64(td.Unbonding)
+		if claimable+unbonding+absAugend > deposit {
+			return fmt.Errorf("claimable after adjustment would exceed deposit: %d > %d", claimable+unbonding+absAugend, deposit)
 		}
 
-		// Since augend is negative, we add absAugend to claimable
-		// This implements "set td.claimable to td.claimable - augend" when augend is negative
-		claimable += absAugend
+		if params.TrustDepositUnbondingDays > 0 {
+			// The released amount stays slashable until it matures
+			if _, err := k.startUnbonding(ctx, &td, uint64(absAugend), params.TrustDepositUnbondingDays, reason); err != nil {
+				return fmt.Errorf("failed to start unbonding: %w", err)
+			}
+		} else {
+			// Since augend is negative, we add absAugend to claimable
+			// This implements "set td.claimable to td.claimable - augend" when augend is negative
+			claimable += absAugend
+		}
 	}
 
 	// Convert back to uint for storage and ensure no negative values
@@ -161,7 +171,7 @@ func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64
 	td.Claimable = uint64(claimable)
 
 	// Save updated trust deposit BEFORE bank transfer
-	err = k.TrustDeposit.Set(ctx, account, td)
+	err = k.SetTrustDeposit(ctx, td)
 	if err != nil {
 		return fmt.Errorf("failed to save trust deposit: %w", err)
 	}
@@ -187,6 +197,7 @@ func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeAdjustTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(corporationID, 10)),
 			sdk.NewAttribute(types.AttributeKeyAccount, account),
 			sdk.NewAttribute(types.AttributeKeyAugend, strconv.FormatInt(augend, 10)),
 			sdk.NewAttribute(types.AttributeKeyAdjustmentType, adjustmentType),
@@ -201,22 +212,23 @@ func (k Keeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64
 	return nil
 }
 
-// AdjustTrustDepositOnBehalf increases the trust deposit of `account` using funds from `funder`.
+// AdjustTrustDepositOnBehalf increases the trust deposit of corporation `corporationID` using funds from `funder`.
 // Unlike AdjustTrustDeposit, this transfers coins directly from the funder to the TD module,
 // bypassing the claimable recycling logic. This is used when a third party (e.g., a fee payer)
-// funds another account's trust deposit increase during CSPS fee distribution.
+// funds another corporation's trust deposit increase during CSPS fee distribution.
 //
 // Only positive amounts are supported (increase only).
-func (k Keeper) AdjustTrustDepositOnBehalf(ctx sdk.Context, account string, funder sdk.AccAddress, amount int64) error {
+func (k Keeper) AdjustTrustDepositOnBehalf(ctx sdk.Context, corporationID uint64, funder sdk.AccAddress, amount int64) error {
 	if amount <= 0 {
 		return fmt.Errorf("amount must be positive, got %d", amount)
 	}
-	if account == "" {
-		return fmt.Errorf("account cannot be empty")
+	_, account, err := k.corporationAccount(ctx, corporationID)
+	if err != nil {
+		return err
 	}
 
-	// Check if account has an existing TD with unrepaid slash
-	td, err := k.TrustDeposit.Get(ctx, account)
+	// Check if the corporation has an existing TD with unrepaid slash
+	td, err := k.TrustDeposit.Get(ctx, corporationID)
 	exists := err == nil
 	if exists && td.SlashedDeposit > 0 && td.RepaidDeposit < td.SlashedDeposit {
 		return fmt.Errorf("trust deposit has been slashed and not repaid")
@@ -228,13 +240,14 @@ func (k Keeper) AdjustTrustDepositOnBehalf(ctx sdk.Context, account string, fund
 
 	augendShare := k.AmountToShare(uint64(amount), shareValue)
 
-	// Load or create trust deposit for account
+	// Load or create trust deposit for the corporation
 	if !exists {
 		td = types.TrustDeposit{
-			Corporation: account,
-			Deposit:     uint64(amount),
-			Share:       augendShare,
-			Claimable:   0,
+			Corporation:   account,
+			CorporationId: corporationID,
+			Deposit:       uint64(amount),
+			Share:         augendShare,
+			Claimable:     0,
 		}
 	} else {
 		td.Deposit += uint64(amount)
@@ -242,7 +255,7 @@ func (k Keeper) AdjustTrustDepositOnBehalf(ctx sdk.Context, account string, fund
 	}
 
 	// Save trust deposit BEFORE bank transfer
-	if err := k.TrustDeposit.Set(ctx, account, td); err != nil {
+	if err := k.SetTrustDeposit(ctx, td); err != nil {
 		return fmt.Errorf("failed to save trust deposit: %w", err)
 	}
 
@@ -259,6 +272,7 @@ func (k Keeper) AdjustTrustDepositOnBehalf(ctx sdk.Context, account string, fund
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeAdjustTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(corporationID, 10)),
 			sdk.NewAttribute(types.AttributeKeyAccount, account),
 			sdk.NewAttribute(types.AttributeKeyAugend, strconv.FormatInt(amount, 10)),
 			sdk.NewAttribute(types.AttributeKeyAdjustmentType, "increase_on_behalf"),
@@ -2,17 +2,35 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 
 	"github.com/verana-labs/verana/x/td/types"
 )
 
-// BeginBlocker processes yield distribution at the beginning of each block
+// BeginBlocker processes yield distribution at the beginning of each block,
+// then checkpoints the resulting share value
 func (k Keeper) BeginBlocker(ctx context.Context) error {
+	if err := k.distributeYield(ctx); err != nil {
+		return err
+	}
+	return k.checkpointShareValue(sdk.UnwrapSDKContext(ctx))
+}
+
+// distributeYield moves the per block yield allowance from the yield
+// intermediate pool to the trust deposit and adjusts the share value.
+//
+// The yield intermediate pool is funded by a protocolpool continuous fund
+// that governance registers with a MsgCreateContinuousFund proposal naming
+// the pool address as recipient. Until the fund exists the distribution is
+// skipped, reported by a yield_distribution_skipped event, and whatever the
+// pool holds is returned to the community pool.
+func (k Keeper) distributeYield(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	params := k.GetParams(sdkCtx)
 
@@ -24,7 +42,8 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	}
 
 	// Skip if yield distribution is not configured
-	if params.YieldIntermediatePool == "" || blocksPerYear == 0 || params.TrustDepositMaxYieldRate.IsZero() {
+	if params.YieldIntermediatePool == "" || blocksPerYear == 0 ||
+		(!params.YieldCurveEnabled() && params.TrustDepositMaxYieldRate.IsZero()) {
 		return nil
 	}
 
@@ -35,6 +54,35 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		yieldIntermediatePoolAddr = authtypes.NewModuleAddress(types.YieldIntermediatePool)
 	}
 
+	// Skip until governance registers the continuous fund of the pool
+	if _, err := k.protocolPool.GetContinuousFund(ctx, yieldIntermediatePoolAddr); err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			k.Logger().Error("failed to get the yield intermediate pool continuous fund", "error", err)
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeYieldDistributionSkipped,
+					sdk.NewAttribute(types.AttributeKeyReason, types.YieldSkipReasonContinuousFundError),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			return nil
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeYieldDistributionSkipped,
+				sdk.NewAttribute(types.AttributeKeyReason, types.YieldSkipReasonNoContinuousFund),
+			),
+		)
+
+		// Nothing is distributed, the whole pool is excess
+		yieldIntermediatePoolBalance := k.bankKeeper.GetBalance(ctx, yieldIntermediatePoolAddr, types.BondDenom)
+		if returned, err := k.returnYieldExcess(sdkCtx, yieldIntermediatePoolBalance.Amount); !returned {
+			return err
+		}
+		return k.YieldFundingFailure.Remove(ctx)
+	}
+
 	// Get trust deposit module address
 	trustDepositAddr := authtypes.NewModuleAddress(types.ModuleName)
 
@@ -49,10 +97,26 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	trustDepositBalanceBefore := k.bankKeeper.GetBalance(ctx, trustDepositAddr, types.BondDenom)
 	trustDepositBalanceDec := math.LegacyNewDecFromInt(trustDepositBalanceBefore.Amount)
 
+	// The yield curve, if enabled, replaces trust_deposit_max_yield_rate. If
+	// the curve cannot be evaluated, e.g. the bonded tokens are unavailable,
+	// the max yield rate applies for this block.
+	yieldRate, depositRatio, err := k.effectiveYieldRate(sdkCtx, params, trustDepositBalanceBefore.Amount)
+	if err != nil {
+		k.Logger().Error("failed to evaluate the yield curve, falling back to the max yield rate", "error", err)
+		yieldRate, depositRatio = params.TrustDepositMaxYieldRate, math.LegacyZeroDec()
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeYieldCurveFallback,
+				sdk.NewAttribute(types.AttributeKeyEffectiveYieldRate, yieldRate.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+
 	// Compute yield allowance
-	// allowance = dust + trust_deposit * trust_deposit_max_yield_rate / blocks_per_year
+	// allowance = dust + trust_deposit * effective_yield_rate / blocks_per_year
 	blocksPerYearDec := math.LegacyNewDec(int64(blocksPerYear))
-	perBlockYieldRate := params.TrustDepositMaxYieldRate.Quo(blocksPerYearDec)
+	perBlockYieldRate := yieldRate.Quo(blocksPerYearDec)
 	perBlockYield := trustDepositBalanceDec.Mul(perBlockYieldRate)
 	allowance := dust.Add(perBlockYield)
 
@@ -69,6 +133,8 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 			sdk.NewAttribute(types.AttributeKeyYIPIncomingBalanceDec, yieldIntermediatePoolBalanceDec.String()),
 			sdk.NewAttribute(types.AttributeKeyAllowance, allowance.String()),
 			sdk.NewAttribute(types.AttributeKeyTrustDepositBalance, trustDepositBalanceBefore.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveYieldRate, yieldRate.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositRatio, depositRatio.String()),
 		),
 	)
 
@@ -78,7 +144,7 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 
 	// Only proceed if there's something to transfer
 	if !transferAmount.IsPositive() {
-		return nil
+		return k.YieldFundingFailure.Remove(ctx)
 	}
 
 	// Emit event for transfer details
@@ -100,8 +166,8 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		types.ModuleName,
 		transferCoins,
 	); err != nil {
-		k.Logger().Error("failed to transfer yield from intermediate pool to trust deposit", "error", err)
-		return err
+		// the allowance is retried from the pool balance next block
+		return k.recordYieldFundingFailure(sdkCtx, types.YieldFundingStageTransfer, transferAmount.Uint64(), err)
 	}
 
 	// Get trust deposit balance after transfer
@@ -129,24 +195,57 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	}
 
 	// Return rest of YIP account to community pool (protocol pool)
-	remainingBalance := yieldIntermediatePoolBalanceDec.Sub(transferAmountDec)
-	if remainingBalance.IsPositive() {
-		remainingCoins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, remainingBalance.TruncateInt()))
-
-		// Send remaining balance back to protocol pool
-		// Note: ProtocolPoolKeeper interface would be needed, but for now we use bank keeper
-		// to send to protocol pool module account
-		protocolPoolAddr := authtypes.NewModuleAddress(protocolpooltypes.ModuleName)
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx,
-			types.YieldIntermediatePool,
-			protocolPoolAddr,
-			remainingCoins,
-		); err != nil {
-			k.Logger().Error("failed to return excess to protocol pool", "error", err)
-			// Don't fail the block if this fails, just log
-		}
+	remainingAmount := yieldIntermediatePoolBalanceDec.Sub(transferAmountDec).TruncateInt()
+	if returned, err := k.returnYieldExcess(sdkCtx, remainingAmount); !returned {
+		return err
+	}
+
+	return k.YieldFundingFailure.Remove(ctx)
+}
+
+// returnYieldExcess returns amount from the yield intermediate pool to the
+// community pool. On failure the excess stays in the pool and is returned
+// with the next one, the failure is recorded and false is returned.
+func (k Keeper) returnYieldExcess(ctx sdk.Context, amount math.Int) (bool, error) {
+	if !amount.IsPositive() {
+		return true, nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, amount))
+	if err := k.protocolPool.FundCommunityPool(
+		ctx,
+		coins,
+		authtypes.NewModuleAddress(types.YieldIntermediatePool),
+	); err != nil {
+		return false, k.recordYieldFundingFailure(ctx, types.YieldFundingStageReturnExcess, amount.Uint64(), err)
 	}
 
-	return nil
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReturnYieldExcess,
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return true, nil
+}
+
+// recordYieldFundingFailure stores the failed yield funding stage until a
+// distribution succeeds and reports it. The failed step is retried by the next
+// block, so the block is not failed.
+func (k Keeper) recordYieldFundingFailure(ctx sdk.Context, stage string, amount uint64, cause error) error {
+	k.Logger().Error("yield funding failed", "stage", stage, "amount", amount, "error", cause)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeYieldFundingFailure,
+			sdk.NewAttribute(types.AttributeKeyStage, stage),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, cause.Error()),
+		),
+	)
+	return k.YieldFundingFailure.Set(ctx, types.YieldFundingFailure{
+		Height: ctx.BlockHeight(),
+		Stage:  stage,
+		Amount: amount,
+		Error:  cause.Error(),
+	})
 }
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/verana-labs/verana/testutil/keeper"
+	"github.com/verana-labs/verana/x/td/keeper"
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// failureStages returns the stages of the yield_funding_failure events of ctx
+func failureStages(ctx sdk.Context) []string {
+	var stages []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeYieldFundingFailure {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyStage {
+				stages = append(stages, attr.Value)
+			}
+		}
+	}
+	return stages
+}
+
+// skipReasons returns the reasons of the yield_distribution_skipped events of ctx
+func skipReasons(ctx sdk.Context) []string {
+	var reasons []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeYieldDistributionSkipped {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyReason {
+				reasons = append(reasons, attr.Value)
+			}
+		}
+	}
+	return reasons
+}
+
+func TestBeginBlockerYieldFunding(t *testing.T) {
+	k, ctx, bank, protocolPool, _ := keepertest.TrustdepositKeeperWithProtocolPool(t)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+
+	bank.SetModuleBalance(types.ModuleName, 1_000_000_000_000)
+	bank.SetModuleBalance(types.YieldIntermediatePool, 1_000_000)
+
+	// Nothing is distributed until the continuous fund exists, the skip is
+	// reported and the pool is returned to the community pool
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Empty(t, failureStages(ctx))
+	require.Equal(t, []string{types.YieldSkipReasonNoContinuousFund}, skipReasons(ctx))
+	require.Equal(t, "1000000000000", bank.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), types.BondDenom).Amount.String())
+	require.Equal(t, "1000000", protocolPool.Funded.AmountOf(types.BondDenom).String())
+	protocolPool.Funded = nil
+
+	// A failing continuous fund lookup is reported as well
+	protocolPool.ContinuousFundErr = errors.New("store unavailable")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Equal(t, []string{types.YieldSkipReasonContinuousFundError}, skipReasons(ctx))
+	require.True(t, protocolPool.Funded.IsZero())
+	protocolPool.ContinuousFundErr = nil
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	yipAddr := authtypes.NewModuleAddress(types.YieldIntermediatePool)
+	protocolPool.ContinuousFunds[yipAddr.String()] = protocolpooltypes.ContinuousFund{Recipient: yipAddr.String()}
+	protocolPool.FundErr = errors.New("community pool unavailable")
+
+	// allowance = 1e12 * 0.15 / 6311520 = 23766.14..., the excess cannot be
+	// returned and stays in the pool
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Equal(t, []string{types.YieldFundingStageReturnExcess}, failureStages(ctx))
+	require.Equal(t, "1000000023766", bank.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), types.BondDenom).Amount.String())
+
+	res, err := k.GetYieldFundingFailure(ctx, &types.QueryGetYieldFundingFailureRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.YieldFundingFailure{
+		Height: 10,
+		Stage:  types.YieldFundingStageReturnExcess,
+		Amount: 976233,
+		Error:  "community pool unavailable",
+	}, res.YieldFundingFailure)
+
+	// Once the community pool is available, the excess is returned
+	protocolPool.FundErr = nil
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Empty(t, failureStages(ctx))
+	require.True(t, protocolPool.Funded.AmountOf(types.BondDenom).IsPositive())
+
+	res, err = k.GetYieldFundingFailure(ctx, &types.QueryGetYieldFundingFailureRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.YieldFundingFailure)
+}
+
+func TestModuleBalanceInvariant(t *testing.T) {
+	k, ctx, bank, _, _ := keepertest.TrustdepositKeeperWithProtocolPool(t)
+	invariant := keeper.ModuleBalanceInvariant(k)
+
+	account := sdk.AccAddress([]byte("invariant_account___")).String()
+	require.NoError(t, k.SetTrustDeposit(ctx, types.TrustDeposit{Corporation: account, CorporationId: 1, Deposit: 500}))
+
+	_, broken := invariant(ctx)
+	require.True(t, broken)
+
+	bank.SetModuleBalance(types.ModuleName, 500)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestBeginBlockerYieldCurve(t *testing.T) {
+	k, ctx, bank, protocolPool, staking := keepertest.TrustdepositKeeperWithProtocolPool(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	yipAddr := authtypes.NewModuleAddress(types.YieldIntermediatePool)
+	protocolPool.ContinuousFunds[yipAddr.String()] = protocolpooltypes.ContinuousFund{Recipient: yipAddr.String()}
+
+	params := k.GetParams(ctx)
+	params.YieldCurveMinRate = math.LegacyMustNewDecFromStr("0.02")
+	params.YieldCurveMaxRate = math.LegacyMustNewDecFromStr("0.2")
+	params.YieldCurveKinkRate = math.LegacyMustNewDecFromStr("0.08")
+	params.YieldCurveTargetRatio = math.LegacyMustNewDecFromStr("0.5")
+	require.NoError(t, k.SetParams(ctx, params))
+
+	bank.SetModuleBalance(types.ModuleName, 1_000_000_000_000)
+	bank.SetModuleBalance(types.YieldIntermediatePool, 1_000_000)
+	staking.BondedTokens = math.NewInt(4_000_000_000_000)
+
+	// a deposit ratio of 0.25 yields 0.14 instead of the 0.15 max yield rate
+	res, err := k.EffectiveYieldRate(ctx, &types.QueryEffectiveYieldRateRequest{})
+	require.NoError(t, err)
+	require.True(t, res.CurveEnabled)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.25"), res.DepositRatio)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.14"), res.Rate)
+	require.Equal(t, math.NewInt(1_000_000_000_000), res.TrustDeposit)
+
+	// allowance = 1e12 * 0.14 / 6311520 = 22181.73...
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Equal(t, "1000000022181", bank.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), types.BondDenom).Amount.String())
+
+	var attrs map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeYieldDistribution {
+			attrs = map[string]string{}
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+		}
+	}
+	require.Equal(t, "0.140000000000000000", attrs[types.AttributeKeyEffectiveYieldRate])
+	require.Equal(t, "0.250000000000000000", attrs[types.AttributeKeyDepositRatio])
+
+	// Without bonded tokens the max yield rate applies for the block:
+	// allowance = 1000000022181 * 0.15 / 6311520 = 23766.14...
+	staking.Err = errors.New("staking store unavailable")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Equal(t, "1000000045947", bank.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), types.BondDenom).Amount.String())
+
+	var fallbacks int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeYieldCurveFallback {
+			fallbacks++
+		}
+	}
+	require.Equal(t, 1, fallbacks)
+	staking.Err = nil
+
+	// Without curve the max yield rate applies
+	params.YieldCurveMaxRate = math.LegacyZeroDec()
+	require.NoError(t, k.SetParams(ctx, params))
+	res, err = k.EffectiveYieldRate(ctx, &types.QueryEffectiveYieldRateRequest{})
+	require.NoError(t, err)
+	require.False(t, res.CurveEnabled)
+	require.Equal(t, params.TrustDepositMaxYieldRate, res.Rate)
+}
@@ -9,10 +9,14 @@ import (
 	"github.com/verana-labs/verana/x/td/types"
 )
 
-func (k Keeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error {
+// BurnEcosystemSlashedTrustDeposit removes amount from the trust deposit of
+// corporationID, slashed by ecosystem ecosystemID. The compensation_pool_share
+// of the amount funds the compensation pool of the ecosystem, the rest is
+// burned.
+func (k Keeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, ecosystemID uint64, corporationID uint64, amount uint64) error {
 	// [MOD-TD-MSG-7-2-1] Basic checks
-	if account == "" {
-		return fmt.Errorf("account cannot be empty")
+	if corporationID == 0 {
+		return fmt.Errorf("corporation id cannot be 0")
 	}
 
 	if amount == 0 {
@@ -20,9 +24,9 @@ func (k Keeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string
 	}
 
 	// Load existing TrustDeposit entry (must exist)
-	td, err := k.TrustDeposit.Get(ctx, account)
+	td, err := k.TrustDeposit.Get(ctx, corporationID)
 	if err != nil {
-		return fmt.Errorf("trust deposit entry not found for account %s: %w", account, err)
+		return fmt.Errorf("trust deposit entry not found for corporation %d: %w", corporationID, err)
 	}
 
 	// amount MUST be lower or equal than td.deposit
@@ -31,14 +35,14 @@ func (k Keeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string
 	}
 
 	// [MOD-TD-MSG-7-3] Execution
-	if err := k.executeBurnEcosystemSlashedTrustDeposit(ctx, account, td, amount); err != nil {
+	if err := k.executeBurnEcosystemSlashedTrustDeposit(ctx, ecosystemID, td, amount); err != nil {
 		return fmt.Errorf("failed to execute burn ecosystem slashed trust deposit: %w", err)
 	}
 
 	return nil
 }
 
-func (k Keeper) executeBurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string, td types.TrustDeposit, amount uint64) error {
+func (k Keeper) executeBurnEcosystemSlashedTrustDeposit(ctx sdk.Context, ecosystemID uint64, td types.TrustDeposit, amount uint64) error {
 	// Get trust deposit share value from params
 	params := k.GetParams(ctx)
 	trustDepositShareValue := params.TrustDepositShareValue
@@ -59,28 +63,47 @@ func (k Keeper) executeBurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account
 		td.Share = math.LegacyZeroDec()
 	}
 
+	pooled := k.compensationPoolPart(ctx, ecosystemID, amount)
+	burned := amount - pooled
+	td.BurnedDeposit += burned
+
+	// Released amounts that have not matured yet are slashable too
+	if err := k.slashUnbonding(ctx, &td); err != nil {
+		return fmt.Errorf("failed to slash unbonding trust deposit: %w", err)
+	}
+
 	// Note: td.SlashedDeposit/LastSlashed/SlashCount are NOT updated here.
 	// Those fields are for network governance slashes (MOD-TD-MSG-5) only.
 	// Ecosystem slashes track slashing at the permission level (perm.slashed_deposit).
 
 	// Save updated trust deposit entry BEFORE burning coins to ensure atomicity —
 	// if Set fails, no coins have been burned yet.
-	if err := k.TrustDeposit.Set(ctx, account, td); err != nil {
+	if err := k.SetTrustDeposit(ctx, td); err != nil {
 		return fmt.Errorf("failed to update trust deposit entry: %w", err)
 	}
 
 	// [MOD-TD-MSG-7-3] Burn amount from TrustDeposit account
-	burnCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(amount)))
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
-		return fmt.Errorf("failed to burn coins from trust deposit module: %w", err)
+	if burned > 0 {
+		burnCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(burned)))
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return fmt.Errorf("failed to burn coins from trust deposit module: %w", err)
+		}
+	}
+	if pooled > 0 {
+		if err := k.fundCompensationPool(ctx, ecosystemID, td.CorporationId, pooled); err != nil {
+			return fmt.Errorf("failed to fund compensation pool: %w", err)
+		}
 	}
 
 	// Emit event for observability
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeBurnEcosystemSlashedTrustDeposit,
-			sdk.NewAttribute(types.AttributeKeyAccount, account),
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(td.CorporationId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccount, td.Corporation),
 			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyBurnedAmount, strconv.FormatUint(burned, 10)),
+			sdk.NewAttribute(types.AttributeKeyPooledAmount, strconv.FormatUint(pooled, 10)),
 			sdk.NewAttribute(types.AttributeKeyNewAmount, strconv.FormatUint(td.Deposit, 10)),
 			sdk.NewAttribute(types.AttributeKeyNewShare, td.Share.String()),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
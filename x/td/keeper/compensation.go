@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// compensationPoolPart returns the part of a slash of amount by ecosystem
+// ecosystemID that funds its compensation pool. Slashes outside of an
+// ecosystem are burned in full.
+func (k Keeper) compensationPoolPart(ctx sdk.Context, ecosystemID uint64, amount uint64) uint64 {
+	if ecosystemID == 0 {
+		return 0
+	}
+	share := k.GetParams(ctx).CompensationPoolShare
+	if share.IsZero() {
+		return 0
+	}
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(amount)).Mul(share).TruncateInt().Uint64()
+}
+
+// getCompensationPool returns the compensation pool of ecosystemID, empty if
+// it was never funded.
+func (k Keeper) getCompensationPool(ctx sdk.Context, ecosystemID uint64) (types.CompensationPool, error) {
+	pool, err := k.CompensationPool.Get(ctx, ecosystemID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CompensationPool{EcosystemId: ecosystemID}, nil
+	}
+	return pool, err
+}
+
+// fundCompensationPool moves amount slashed from corporationID from the trust
+// deposit module account to the compensation pool of ecosystemID.
+func (k Keeper) fundCompensationPool(ctx sdk.Context, ecosystemID uint64, corporationID uint64, amount uint64) error {
+	pool, err := k.getCompensationPool(ctx, ecosystemID)
+	if err != nil {
+		return err
+	}
+	pool.Balance += amount
+	pool.TotalReceived += amount
+	if err := k.CompensationPool.Set(ctx, ecosystemID, pool); err != nil {
+		return fmt.Errorf("failed to update compensation pool: %w", err)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewIntFromUint64(amount)))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.CompensationPoolAccount, coins); err != nil {
+		return fmt.Errorf("failed to transfer to compensation pool: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundCompensationPool,
+			sdk.NewAttribute(types.AttributeKeyEcosystemID, strconv.FormatUint(ecosystemID, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(corporationID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolBalance, strconv.FormatUint(pool.Balance, 10)),
+		),
+	)
+	return nil
+}
+
+// getNextCompensationClaimID gets the next available compensation claim ID
+func (k Keeper) getNextCompensationClaimID(ctx sdk.Context) (uint64, error) {
+	id, err := k.CompensationClaimCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	nextID := id + 1
+	if err := k.CompensationClaimCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set compensation claim counter: %w", err)
+	}
+
+	return nextID, nil
+}
+
+// ecosystemAuthority returns the group policy of the corporation controlling
+// ecosystemID, the account resolving the claims against its pool.
+func (k Keeper) ecosystemAuthority(ctx sdk.Context, ecosystemID uint64) (string, error) {
+	if k.ecosystemKeeper == nil {
+		return "", fmt.Errorf("ecosystem keeper is required for compensation claims")
+	}
+	ec, err := k.ecosystemKeeper.GetEcosystem(ctx, ecosystemID)
+	if err != nil {
+		return "", fmt.Errorf("ecosystem %d not found: %w", ecosystemID, err)
+	}
+	_, policyAddress, err := k.corporationAccount(ctx, ec.CorporationId)
+	if err != nil {
+		return "", fmt.Errorf("ecosystem corporation not found: %w", err)
+	}
+	return policyAddress, nil
+}
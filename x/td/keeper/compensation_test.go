@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/verana-labs/verana/testutil/keeper"
+	"github.com/verana-labs/verana/x/td/keeper"
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func TestCompensationPool(t *testing.T) {
+	k, ctx, coKeeper, ecKeeper, digestKeeper := keepertest.TrustdepositKeeperWithEcosystem(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ecosystemCorp := sdk.AccAddress([]byte("ecosystem_corp______")).String()
+	ecosystemID := ecKeeper.CreateMockEcosystem(coKeeper.Register(ecosystemCorp))
+	slashed := sdk.AccAddress([]byte("slashed_corp________")).String()
+	slashedID := coKeeper.Register(slashed)
+	claimant := sdk.AccAddress([]byte("harmed_party________")).String()
+
+	require.NoError(t, k.SetTrustDeposit(ctx, types.TrustDeposit{
+		Corporation:   slashed,
+		CorporationId: slashedID,
+		Deposit:       1000,
+		Share:         math.LegacyNewDec(1000),
+	}))
+
+	// Half of the slash, rounded down, funds the pool, the rest is burned
+	require.NoError(t, k.BurnEcosystemSlashedTrustDeposit(ctx, ecosystemID, slashedID, 401))
+	td, err := k.TrustDeposit.Get(ctx, slashedID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(599), td.Deposit)
+	require.Equal(t, uint64(201), td.BurnedDeposit)
+
+	pool, err := k.GetCompensationPool(ctx, &types.QueryGetCompensationPoolRequest{EcosystemId: ecosystemID})
+	require.NoError(t, err)
+	require.Equal(t, types.CompensationPool{EcosystemId: ecosystemID, Balance: 200, TotalReceived: 200}, pool.CompensationPool)
+
+	// Slashes outside of an ecosystem are burned in full
+	require.NoError(t, k.BurnEcosystemSlashedTrustDeposit(ctx, 0, slashedID, 99))
+	td, err = k.TrustDeposit.Get(ctx, slashedID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), td.BurnedDeposit)
+
+	// Claims need an existing ecosystem and anchored evidence
+	file := &types.MsgFileCompensationClaim{
+		Claimant:       claimant,
+		EcosystemId:    ecosystemID,
+		Amount:         300,
+		Reason:         "issued credentials were revoked",
+		EvidenceDigest: "sha384-compensationEvidence",
+	}
+	_, err = ms.FileCompensationClaim(ctx, &types.MsgFileCompensationClaim{
+		Claimant: claimant, EcosystemId: 99, Amount: 300, Reason: "r", EvidenceDigest: file.EvidenceDigest,
+	})
+	require.ErrorContains(t, err, "ecosystem 99 not found")
+
+	digestKeeper.Missing = map[string]bool{file.EvidenceDigest: true}
+	_, err = ms.FileCompensationClaim(ctx, file)
+	require.ErrorContains(t, err, "is not anchored")
+	digestKeeper.Missing = nil
+
+	filed, err := ms.FileCompensationClaim(ctx, file)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), filed.Id)
+	rejected, err := ms.FileCompensationClaim(ctx, file)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), rejected.Id)
+
+	// Only the ecosystem corporation resolves claims, within the pool balance
+	_, err = ms.ResolveCompensationClaim(ctx, &types.MsgResolveCompensationClaim{Authority: claimant, Id: filed.Id, Approve: true})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.ResolveCompensationClaim(ctx, &types.MsgResolveCompensationClaim{Authority: ecosystemCorp, Id: filed.Id, Approve: true})
+	require.ErrorIs(t, err, types.ErrInsufficientCompensationPool)
+	_, err = ms.ResolveCompensationClaim(ctx, &types.MsgResolveCompensationClaim{Authority: ecosystemCorp, Id: filed.Id, Approve: true, Amount: 301})
+	require.ErrorContains(t, err, "amount exceeds claimed amount")
+
+	resolved, err := ms.ResolveCompensationClaim(ctx, &types.MsgResolveCompensationClaim{
+		Authority: ecosystemCorp, Id: filed.Id, Approve: true, Amount: 150, Reason: "partially covered",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), resolved.PaidAmount)
+
+	_, err = ms.ResolveCompensationClaim(ctx, &types.MsgResolveCompensationClaim{Authority: ecosystemCorp, Id: rejected.Id})
+	require.NoError(t, err)
+	_, err = ms.ResolveCompensationClaim(ctx, &types.MsgResolveCompensationClaim{Authority: ecosystemCorp, Id: rejected.Id})
+	require.ErrorContains(t, err, "is not pending")
+
+	pool, err = k.GetCompensationPool(ctx, &types.QueryGetCompensationPoolRequest{EcosystemId: ecosystemID})
+	require.NoError(t, err)
+	require.Equal(t, types.CompensationPool{EcosystemId: ecosystemID, Balance: 50, TotalReceived: 200, TotalPaid: 150}, pool.CompensationPool)
+
+	claim, err := k.GetCompensationClaim(ctx, &types.QueryGetCompensationClaimRequest{Id: filed.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PAID, claim.CompensationClaim.Status)
+	require.Equal(t, uint64(150), claim.CompensationClaim.PaidAmount)
+	require.Equal(t, "partially covered", claim.CompensationClaim.ResolutionReason)
+	require.NotNil(t, claim.CompensationClaim.Resolved)
+
+	list, err := k.ListCompensationClaims(ctx, &types.QueryListCompensationClaimsRequest{EcosystemId: ecosystemID})
+	require.NoError(t, err)
+	require.Len(t, list.CompensationClaims, 2)
+	list, err = k.ListCompensationClaims(ctx, &types.QueryListCompensationClaimsRequest{
+		Status: types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_REJECTED,
+	})
+	require.NoError(t, err)
+	require.Len(t, list.CompensationClaims, 1)
+	require.Equal(t, rejected.Id, list.CompensationClaims[0].Id)
+
+	_, err = k.GetCompensationClaim(ctx, &types.QueryGetCompensationClaimRequest{Id: 42})
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	cokeeper "github.com/verana-labs/verana/x/co/keeper"
 	"github.com/verana-labs/verana/x/td/types"
@@ -26,3 +27,11 @@ func (a CoAsTDCorporationKeeper) ResolveCorporationByPolicyAddress(ctx context.C
 	}
 	return types.CorporationView{Id: co.Id, PolicyAddress: co.PolicyAddress}, nil
 }
+
+func (a CoAsTDCorporationKeeper) GetCorporationByID(ctx context.Context, id uint64) (types.CorporationView, error) {
+	co, err := a.k.Corporation.Get(ctx, id)
+	if err != nil {
+		return types.CorporationView{}, fmt.Errorf("corporation %d not found: %w", id, err)
+	}
+	return types.CorporationView{Id: co.Id, PolicyAddress: co.PolicyAddress}, nil
+}
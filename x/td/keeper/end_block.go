@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker moves the matured unbonding trust deposits to claimable.
+// Failures are logged and never halt the chain.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.matureUnbondingTrustDeposits(sdkCtx, sdkCtx.BlockTime(), MaxMaturedUnbondingTrustDepositsPerBlock); err != nil {
+		k.Logger().Error("failed to mature unbonding trust deposits", "error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// RegisterInvariants registers all x/td invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "compensation-pool-balance", CompensationPoolBalanceInvariant(k))
+}
+
+// ModuleBalanceInvariant checks that the trust deposit module account holds
+// at least the sum of the trust deposits.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		total := math.ZeroInt()
+		if err := k.TrustDeposit.Walk(ctx, nil, func(_ uint64, td types.TrustDeposit) (bool, error) {
+			total = total.Add(math.NewIntFromUint64(td.Deposit))
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-balance", fmt.Sprintf("failed to walk trust deposits: %s", err)), true
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), types.BondDenom)
+		broken := balance.Amount.LT(total)
+		return sdk.FormatInvariant(types.ModuleName, "module-balance",
+			fmt.Sprintf("\tsum of trust deposits: %s\n\tmodule account balance: %s\n", total, balance.Amount)), broken
+	}
+}
+
+// CompensationPoolBalanceInvariant checks that the compensation pool module
+// account holds at least the sum of the balances of the compensation pools.
+func CompensationPoolBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		total := math.ZeroInt()
+		if err := k.CompensationPool.Walk(ctx, nil, func(_ uint64, pool types.CompensationPool) (bool, error) {
+			total = total.Add(math.NewIntFromUint64(pool.Balance))
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "compensation-pool-balance", fmt.Sprintf("failed to walk compensation pools: %s", err)), true
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.CompensationPoolAccount), types.BondDenom)
+		broken := balance.Amount.LT(total)
+		return sdk.FormatInvariant(types.ModuleName, "compensation-pool-balance",
+			fmt.Sprintf("\tsum of compensation pools: %s\n\tmodule account balance: %s\n", total, balance.Amount)), broken
+	}
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -24,13 +27,34 @@ type (
 		// should be the x/gov module account.
 		authority string
 		// state
-		TrustDeposit collections.Map[string, types.TrustDeposit]
-		Dust         collections.Item[string] // Accumulated fractional yield (stored as string)
+		TrustDeposit          collections.Map[uint64, types.TrustDeposit]        // keyed by corporation_id
+		TrustDepositByAccount collections.Map[string, uint64]                    // corporation account -> corporation_id
+		Dust                  collections.Item[string]                           // Accumulated fractional yield (stored as string)
+		ShareValueCheckpoint  collections.Map[int64, types.ShareValueCheckpoint] // keyed by unix time of the checkpoint
+		// UnbondingTrustDeposit holds the released deposits not yet claimable, by id
+		UnbondingTrustDeposit        collections.Map[uint64, types.UnbondingTrustDeposit]
+		UnbondingTrustDepositCounter collections.Item[uint64]
+		// UnbondingTrustDepositQueue indexes the unbonding entries by completion time
+		UnbondingTrustDepositQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+		// UnbondingTrustDepositByCorporation indexes the unbonding entries by corporation_id
+		UnbondingTrustDepositByCorporation collections.KeySet[collections.Pair[uint64, uint64]]
+		CompensationPool                   collections.Map[uint64, types.CompensationPool] // keyed by ecosystem_id
+		CompensationClaim                  collections.Map[uint64, types.CompensationClaim]
+		CompensationClaimCounter           collections.Item[uint64]
+		// YieldFundingFailure is the last failed yield funding step, if any
+		YieldFundingFailure collections.Item[types.YieldFundingFailure]
+		// OrphanedTrustDeposit holds the v2 deposits whose account resolved to
+		// no corporation during the v3 migration, keyed by account
+		OrphanedTrustDeposit collections.Map[string, types.TrustDeposit]
 		// external keeper
 		bankKeeper       types.BankKeeper
 		mintKeeper       types.MintKeeper
 		delegationKeeper types.DelegationKeeper
 		coKeeper         types.CorporationKeeper
+		ecosystemKeeper  types.EcosystemKeeper
+		digestKeeper     types.DigestKeeper
+		protocolPool     types.ProtocolPoolKeeper
+		stakingKeeper    types.StakingKeeper
 	}
 )
 
@@ -43,6 +67,10 @@ func NewKeeper(
 	mintKeeper types.MintKeeper,
 	delegationKeeper types.DelegationKeeper,
 	coKeeper types.CorporationKeeper,
+	ecosystemKeeper types.EcosystemKeeper,
+	digestKeeper types.DigestKeeper,
+	protocolPool types.ProtocolPoolKeeper,
+	stakingKeeper types.StakingKeeper,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 
@@ -51,16 +79,33 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:              cdc,
-		storeService:     storeService,
-		authority:        authority,
-		logger:           logger,
-		TrustDeposit:     collections.NewMap(sb, types.TrustDepositKey, "trust_deposit", collections.StringKey, codec.CollValue[types.TrustDeposit](cdc)),
-		Dust:             collections.NewItem(sb, types.DustKey, "dust", collections.StringValue),
-		bankKeeper:       bankKeeper,
-		mintKeeper:       mintKeeper,
-		delegationKeeper: delegationKeeper,
-		coKeeper:         coKeeper,
+		cdc:                          cdc,
+		storeService:                 storeService,
+		authority:                    authority,
+		logger:                       logger,
+		TrustDeposit:                 collections.NewMap(sb, types.TrustDepositKey, "trust_deposit", collections.Uint64Key, codec.CollValue[types.TrustDeposit](cdc)),
+		TrustDepositByAccount:        collections.NewMap(sb, types.TrustDepositByAccountKey, "trust_deposit_by_account", collections.StringKey, collections.Uint64Value),
+		Dust:                         collections.NewItem(sb, types.DustKey, "dust", collections.StringValue),
+		ShareValueCheckpoint:         collections.NewMap(sb, types.ShareValueCheckpointKey, "share_value_checkpoint", collections.Int64Key, codec.CollValue[types.ShareValueCheckpoint](cdc)),
+		UnbondingTrustDeposit:        collections.NewMap(sb, types.UnbondingTrustDepositKey, "unbonding_trust_deposit", collections.Uint64Key, codec.CollValue[types.UnbondingTrustDeposit](cdc)),
+		UnbondingTrustDepositCounter: collections.NewItem(sb, types.UnbondingTrustDepositCounterKey, "unbonding_trust_deposit_counter", collections.Uint64Value),
+		UnbondingTrustDepositQueue: collections.NewKeySet(sb, types.UnbondingTrustDepositQueueKey, "unbonding_trust_deposit_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		UnbondingTrustDepositByCorporation: collections.NewKeySet(sb, types.UnbondingTrustDepositByCorporationKey, "unbonding_trust_deposit_by_corporation",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		CompensationPool:         collections.NewMap(sb, types.CompensationPoolKey, "compensation_pool", collections.Uint64Key, codec.CollValue[types.CompensationPool](cdc)),
+		CompensationClaim:        collections.NewMap(sb, types.CompensationClaimKey, "compensation_claim", collections.Uint64Key, codec.CollValue[types.CompensationClaim](cdc)),
+		CompensationClaimCounter: collections.NewItem(sb, types.CompensationClaimCounterKey, "compensation_claim_counter", collections.Uint64Value),
+		YieldFundingFailure:      collections.NewItem(sb, types.YieldFundingFailureKey, "yield_funding_failure", codec.CollValue[types.YieldFundingFailure](cdc)),
+		OrphanedTrustDeposit:     collections.NewMap(sb, types.OrphanedTrustDepositKey, "orphaned_trust_deposit", collections.StringKey, codec.CollValue[types.TrustDeposit](cdc)),
+		bankKeeper:               bankKeeper,
+		mintKeeper:               mintKeeper,
+		delegationKeeper:         delegationKeeper,
+		coKeeper:                 coKeeper,
+		ecosystemKeeper:          ecosystemKeeper,
+		digestKeeper:             digestKeeper,
+		protocolPool:             protocolPool,
+		stakingKeeper:            stakingKeeper,
 	}
 }
 
@@ -92,10 +137,82 @@ func (k Keeper) GetLogger() interface {
 }
 
 // GetTrustDepositMap returns the TrustDeposit collections.Map for migration purposes.
-func (k Keeper) GetTrustDepositMap() collections.Map[string, types.TrustDeposit] {
+func (k Keeper) GetTrustDepositMap() collections.Map[uint64, types.TrustDeposit] {
 	return k.TrustDeposit
 }
 
+// SetTrustDeposit stores td under its corporation_id, stamped modified at
+// the block time, and indexes it by corporation account.
+func (k Keeper) SetTrustDeposit(ctx context.Context, td types.TrustDeposit) error {
+	if td.CorporationId == 0 {
+		return fmt.Errorf("corporation id cannot be 0")
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	td.Modified = &now
+
+	// Drop the index entry of the previous account
+	previous, err := k.TrustDeposit.Get(ctx, td.CorporationId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && previous.Corporation != "" && previous.Corporation != td.Corporation {
+		if err := k.TrustDepositByAccount.Remove(ctx, previous.Corporation); err != nil {
+			return err
+		}
+	}
+
+	if err := k.TrustDeposit.Set(ctx, td.CorporationId, td); err != nil {
+		return err
+	}
+	if td.Corporation == "" {
+		return nil
+	}
+	return k.TrustDepositByAccount.Set(ctx, td.Corporation, td.CorporationId)
+}
+
+// GetTrustDepositByAccount returns the trust deposit of the corporation whose
+// policy_address is account.
+func (k Keeper) GetTrustDepositByAccount(ctx context.Context, account string) (types.TrustDeposit, error) {
+	id, err := k.TrustDepositByAccount.Get(ctx, account)
+	if err != nil {
+		return types.TrustDeposit{}, err
+	}
+	return k.TrustDeposit.Get(ctx, id)
+}
+
+// ResolveCorporationID returns the corporation_id of the Corporation whose
+// policy_address is account.
+func (k Keeper) ResolveCorporationID(ctx context.Context, account string) (uint64, error) {
+	co, err := k.coKeeper.ResolveCorporationByPolicyAddress(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+	return co.Id, nil
+}
+
+// SetOrphanedTrustDeposit stores a trust deposit whose account resolves to no
+// corporation, keyed by that account.
+func (k Keeper) SetOrphanedTrustDeposit(ctx context.Context, td types.TrustDeposit) error {
+	return k.OrphanedTrustDeposit.Set(ctx, td.Corporation, td)
+}
+
+// corporationAccount returns the policy_address of the Corporation
+// corporationID, the account trust deposit funds flow from and to.
+func (k Keeper) corporationAccount(ctx context.Context, corporationID uint64) (sdk.AccAddress, string, error) {
+	if corporationID == 0 {
+		return nil, "", fmt.Errorf("corporation id cannot be 0")
+	}
+	co, err := k.coKeeper.GetCorporationByID(ctx, corporationID)
+	if err != nil {
+		return nil, "", err
+	}
+	addr, err := sdk.AccAddressFromBech32(co.PolicyAddress)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid corporation address: %w", err)
+	}
+	return addr, co.PolicyAddress, nil
+}
+
 func (k Keeper) GetTrustDepositRate(ctx sdk.Context) math.LegacyDec {
 	params := k.GetParams(ctx)
 	return params.TrustDepositRate
@@ -115,3 +232,8 @@ func (k Keeper) GetTrustDepositShareValue(ctx sdk.Context) math.LegacyDec {
 	params := k.GetParams(ctx)
 	return params.TrustDepositShareValue
 }
+
+func (k Keeper) GetTrustDepositUnbondingDays(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.TrustDepositUnbondingDays
+}
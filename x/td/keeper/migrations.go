@@ -3,6 +3,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v2 "github.com/verana-labs/verana/x/td/migrations/v2"
+	v3 "github.com/verana-labs/verana/x/td/migrations/v3"
+	v4 "github.com/verana-labs/verana/x/td/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +22,17 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration re-keys TrustDeposit from the corporation account to the
+// corporation_id.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// This migration sets the params added since v3, compensation_pool_share to 0
+// and the others to their defaults.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper)
+}
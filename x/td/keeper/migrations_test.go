@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/verana-labs/verana/testutil/keeper"
+	"github.com/verana-labs/verana/x/td/keeper"
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx, _, coKeeper := keepertest.TrustdepositKeeperWithCorp(t)
+	kvStore := k.GetStoreService().OpenKVStore(ctx)
+	valueCodec := codec.CollValue[types.TrustDeposit](k.GetCodec())
+
+	registered := sdk.AccAddress([]byte("migrate_registered_")).String()
+	unregistered := sdk.AccAddress([]byte("migrate_unregistere")).String()
+	coKeeper.Unregistered[unregistered] = true
+
+	// Write account-keyed entries as the v2 store laid them out
+	for _, account := range []string{registered, unregistered} {
+		bz, err := valueCodec.Encode(types.TrustDeposit{
+			Corporation: account,
+			Share:       math.LegacyNewDec(100),
+			Deposit:     100,
+		})
+		require.NoError(t, err)
+		require.NoError(t, kvStore.Set(append(types.LegacyTrustDepositKey.Bytes(), account...), bz))
+	}
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	corpID := coKeeper.Register(registered)
+	td, err := k.TrustDeposit.Get(ctx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, corpID, td.CorporationId)
+	require.Equal(t, registered, td.Corporation)
+	require.Equal(t, uint64(100), td.Deposit)
+
+	byAccount, err := k.GetTrustDepositByAccount(ctx, registered)
+	require.NoError(t, err)
+	require.Equal(t, td, byAccount)
+
+	has, err := kvStore.Has(append(types.LegacyTrustDepositKey.Bytes(), registered...))
+	require.NoError(t, err)
+	require.False(t, has, "migrated entry is removed from the legacy prefix")
+
+	// The unresolvable entry is moved to the orphaned deposits and reported
+	has, err = kvStore.Has(append(types.LegacyTrustDepositKey.Bytes(), unregistered...))
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = k.GetTrustDepositByAccount(ctx, unregistered)
+	require.Error(t, err)
+
+	orphan, err := k.OrphanedTrustDeposit.Get(ctx, unregistered)
+	require.NoError(t, err)
+	require.Equal(t, unregistered, orphan.Corporation)
+	require.Equal(t, uint64(100), orphan.Deposit)
+
+	var orphanEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeOrphanTrustDeposit {
+			orphanEvents = append(orphanEvents, event)
+		}
+	}
+	require.Len(t, orphanEvents, 1)
+	account, ok := orphanEvents[0].GetAttribute(types.AttributeKeyAccount)
+	require.True(t, ok)
+	require.Equal(t, unregistered, account.Value)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx, _, _ := keepertest.TrustdepositKeeperWithCorp(t)
+
+	// v3 params decode the added params as zero values
+	params := k.GetParams(ctx)
+	params.TrustDepositUnbondingDays = 0
+	params.ShareValueCheckpointIntervalSeconds = 0
+	params.ShareValueHistoryRetentionDays = 0
+	params.CompensationPoolShare = math.LegacyDec{}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, types.DefaultTrustDepositUnbondingDays, migrated.TrustDepositUnbondingDays)
+	require.Equal(t, types.DefaultShareValueCheckpointIntervalSeconds, migrated.ShareValueCheckpointIntervalSeconds)
+	require.Equal(t, types.DefaultShareValueHistoryRetentionDays, migrated.ShareValueHistoryRetentionDays)
+	// live chains keep burning ecosystem slashes in full
+	require.Equal(t, math.LegacyZeroDec(), migrated.CompensationPoolShare)
+	require.NoError(t, migrated.Validate())
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// FileCompensationClaim handles the MsgFileCompensationClaim message
+func (ms msgServer) FileCompensationClaim(goCtx context.Context, msg *types.MsgFileCompensationClaim) (*types.MsgFileCompensationClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	// The claim is resolved by the ecosystem corporation, it MUST exist
+	if _, err := ms.Keeper.ecosystemAuthority(ctx, msg.EcosystemId); err != nil {
+		return nil, err
+	}
+
+	// The claim evidence MUST be anchored in x/di
+	if ms.Keeper.digestKeeper == nil {
+		return nil, fmt.Errorf("digest keeper is required for claim evidence")
+	}
+	anchored, err := ms.Keeper.digestKeeper.HasDigest(ctx, msg.EvidenceDigest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check evidence digest: %w", err)
+	}
+	if !anchored {
+		return nil, fmt.Errorf("evidence digest %s is not anchored", msg.EvidenceDigest)
+	}
+
+	id, err := ms.Keeper.getNextCompensationClaimID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next compensation claim id: %w", err)
+	}
+
+	claim := types.CompensationClaim{
+		Id:             id,
+		EcosystemId:    msg.EcosystemId,
+		Claimant:       msg.Claimant,
+		Amount:         msg.Amount,
+		Reason:         msg.Reason,
+		EvidenceDigest: msg.EvidenceDigest,
+		Status:         types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PENDING,
+		Created:        &now,
+	}
+	if err := ms.Keeper.CompensationClaim.Set(ctx, id, claim); err != nil {
+		return nil, fmt.Errorf("failed to store compensation claim: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFileCompensationClaim,
+			sdk.NewAttribute(types.AttributeKeyClaimID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEcosystemID, strconv.FormatUint(msg.EcosystemId, 10)),
+			sdk.NewAttribute(types.AttributeKeyClaimant, msg.Claimant),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyEvidenceDigest, msg.EvidenceDigest),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgFileCompensationClaimResponse{Id: id}, nil
+}
+
+// ResolveCompensationClaim handles the MsgResolveCompensationClaim message
+func (ms msgServer) ResolveCompensationClaim(goCtx context.Context, msg *types.MsgResolveCompensationClaim) (*types.MsgResolveCompensationClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	claim, err := ms.Keeper.CompensationClaim.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("compensation claim %d not found: %w", msg.Id, err)
+	}
+	if claim.Status != types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PENDING {
+		return nil, fmt.Errorf("compensation claim %d is not pending: %s", claim.Id, claim.Status)
+	}
+
+	// Only the group policy of the ecosystem corporation can resolve it
+	authority, err := ms.Keeper.ecosystemAuthority(ctx, claim.EcosystemId)
+	if err != nil {
+		return nil, err
+	}
+	if authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", authority, msg.Authority)
+	}
+
+	claim.Resolved = &now
+	claim.ResolutionReason = msg.Reason
+	if !msg.Approve {
+		claim.Status = types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_REJECTED
+	} else {
+		amount := msg.Amount
+		if amount == 0 {
+			amount = claim.Amount
+		}
+		if amount > claim.Amount {
+			return nil, fmt.Errorf("amount exceeds claimed amount: %d > %d", amount, claim.Amount)
+		}
+		if err := ms.Keeper.payCompensationClaim(ctx, &claim, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.Keeper.CompensationClaim.Set(ctx, claim.Id, claim); err != nil {
+		return nil, fmt.Errorf("failed to update compensation claim: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResolveCompensationClaim,
+			sdk.NewAttribute(types.AttributeKeyClaimID, strconv.FormatUint(claim.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEcosystemID, strconv.FormatUint(claim.EcosystemId, 10)),
+			sdk.NewAttribute(types.AttributeKeyClaimant, claim.Claimant),
+			sdk.NewAttribute(types.AttributeKeyStatus, claim.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyPaidAmount, strconv.FormatUint(claim.PaidAmount, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgResolveCompensationClaimResponse{PaidAmount: claim.PaidAmount}, nil
+}
+
+// payCompensationClaim pays amount of claim from the compensation pool of its
+// ecosystem to the claimant and marks it paid. The caller stores the claim.
+func (k Keeper) payCompensationClaim(ctx sdk.Context, claim *types.CompensationClaim, amount uint64) error {
+	pool, err := k.getCompensationPool(ctx, claim.EcosystemId)
+	if err != nil {
+		return err
+	}
+	if amount > pool.Balance {
+		return errorsmod.Wrapf(types.ErrInsufficientCompensationPool, "%d > %d", amount, pool.Balance)
+	}
+
+	claimant, err := sdk.AccAddressFromBech32(claim.Claimant)
+	if err != nil {
+		return fmt.Errorf("invalid claimant address: %w", err)
+	}
+
+	pool.Balance -= amount
+	pool.TotalPaid += amount
+	if err := k.CompensationPool.Set(ctx, pool.EcosystemId, pool); err != nil {
+		return fmt.Errorf("failed to update compensation pool: %w", err)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewIntFromUint64(amount)))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CompensationPoolAccount, claimant, coins); err != nil {
+		return fmt.Errorf("failed to pay compensation claim: %w", err)
+	}
+
+	claim.Status = types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PAID
+	claim.PaidAmount = amount
+	return nil
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	mathstd "math"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// DepositTrust handles the MsgDepositTrust message
+func (ms msgServer) DepositTrust(goCtx context.Context, msg *types.MsgDepositTrust) (*types.MsgDepositTrustResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// [AUTHZ-CHECK] Verify operator authorization, metering the deposited amount
+	if ms.Keeper.delegationKeeper == nil {
+		return nil, fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.Keeper.delegationKeeper.CheckOperatorAuthorizationWithSpend(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.td.v1.MsgDepositTrust",
+		ctx.BlockTime(),
+		sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewIntFromUint64(msg.Amount))),
+	); err != nil {
+		return nil, fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation.
+	co, err := ms.Keeper.coKeeper.ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount > uint64(mathstd.MaxInt64) {
+		return nil, fmt.Errorf("amount exceeds maximum coin amount: %d", msg.Amount)
+	}
+
+	corporationAddr, err := sdk.AccAddressFromBech32(msg.Corporation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// The corporation funds its own deposit, claimable is left untouched
+	if err := ms.Keeper.AdjustTrustDepositOnBehalf(ctx, co.Id, corporationAddr, int64(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	td, err := ms.Keeper.TrustDeposit.Get(ctx, co.Id)
+	if err != nil {
+		return nil, fmt.Errorf("trust deposit not found for corporation %d: %w", co.Id, err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositTrust,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewAmount, strconv.FormatUint(td.Deposit, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewShare, td.Share.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+
+	return &types.MsgDepositTrustResponse{
+		Deposit: td.Deposit,
+		Share:   td.Share,
+	}, nil
+}
+
+// WithdrawClaimable handles the MsgWithdrawClaimable message
+func (ms msgServer) WithdrawClaimable(goCtx context.Context, msg *types.MsgWithdrawClaimable) (*types.MsgWithdrawClaimableResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// [AUTHZ-CHECK] Verify operator authorization, metering the withdrawn amount
+	if ms.Keeper.delegationKeeper == nil {
+		return nil, fmt.Errorf("delegation keeper is required for operator authorization")
+	}
+	if err := ms.Keeper.delegationKeeper.CheckOperatorAuthorizationWithSpend(
+		ctx,
+		msg.Corporation,
+		msg.Operator,
+		"/verana.td.v1.MsgWithdrawClaimable",
+		ctx.BlockTime(),
+		sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewIntFromUint64(msg.Amount))),
+	); err != nil {
+		return nil, fmt.Errorf("authorization check failed: %w", err)
+	}
+
+	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation.
+	co, err := ms.Keeper.coKeeper.ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
+		return nil, err
+	}
+
+	td, err := ms.Keeper.TrustDeposit.Get(ctx, co.Id)
+	if err != nil {
+		return nil, fmt.Errorf("trust deposit not found for account: %s", msg.Corporation)
+	}
+
+	// Like a reclaim, nothing can leave a deposit with an outstanding slash
+	if td.SlashedDeposit > 0 {
+		return nil, fmt.Errorf("deposit has been slashed and not repaid")
+	}
+
+	sharesReduced, err := ms.Keeper.withdrawClaimable(ctx, &td, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawClaimable,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Corporation),
+			sdk.NewAttribute(types.AttributeKeyClaimedAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeySharesReduced, sharesReduced.String()),
+			sdk.NewAttribute(types.AttributeKeyNewClaimable, strconv.FormatUint(td.Claimable, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+
+	return &types.MsgWithdrawClaimableResponse{
+		Claimable: td.Claimable,
+	}, nil
+}
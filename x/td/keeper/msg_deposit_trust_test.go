@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"errors"
+	mathstd "math"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func TestMsgDepositTrustAndWithdrawClaimable(t *testing.T) {
+	k, ms, ctx, dk := setupMsgServerWithDelegation(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	account := sdk.AccAddress([]byte("deposit_account_____")).String()
+	operator := sdk.AccAddress([]byte("deposit_operator____")).String()
+	corpID := mustCorporationID(t, k, ctx, account)
+
+	// A first deposit creates the trust deposit and meters the amount
+	res, err := ms.DepositTrust(ctx, &types.MsgDepositTrust{Corporation: account, Operator: operator, Amount: 1000})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), res.Deposit)
+	require.Equal(t, math.LegacyNewDec(1000), res.Share)
+	require.Equal(t, []string{"/verana.td.v1.MsgDepositTrust"}, dk.AuthzCheckCalls)
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 1000))}, dk.AuthzSpends)
+
+	// A top-up adds to the deposit without touching claimable
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, -400, "participant ended"))
+	params := k.GetParams(sdkCtx)
+	params.TrustDepositUnbondingDays = 0
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, -300, "participant ended"))
+
+	res, err = ms.DepositTrust(ctx, &types.MsgDepositTrust{Corporation: account, Operator: operator, Amount: 500})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500), res.Deposit)
+	td, err := k.TrustDeposit.Get(ctx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), td.Claimable)
+
+	// Partial withdrawals of the claimable balance
+	dk.Reset()
+	wres, err := ms.WithdrawClaimable(ctx, &types.MsgWithdrawClaimable{Corporation: account, Operator: operator, Amount: 120})
+	require.NoError(t, err)
+	require.Equal(t, uint64(180), wres.Claimable)
+	require.Equal(t, []string{"/verana.td.v1.MsgWithdrawClaimable"}, dk.AuthzCheckCalls)
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 120))}, dk.AuthzSpends)
+
+	td, err = k.TrustDeposit.Get(ctx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(180), td.Claimable)
+	require.Equal(t, math.LegacyNewDec(1380), td.Share)
+
+	_, err = ms.WithdrawClaimable(ctx, &types.MsgWithdrawClaimable{Corporation: account, Operator: operator, Amount: 181})
+	require.ErrorContains(t, err, "amount exceeds claimable")
+
+	wres, err = ms.WithdrawClaimable(ctx, &types.MsgWithdrawClaimable{Corporation: account, Operator: operator, Amount: 180})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), wres.Claimable)
+
+	// A denied authorization, e.g. an exhausted spend limit, aborts
+	dk.ErrToReturn = errors.New("spend limit exceeded")
+	_, err = ms.DepositTrust(ctx, &types.MsgDepositTrust{Corporation: account, Operator: operator, Amount: 1})
+	require.ErrorContains(t, err, "authorization check failed")
+	dk.ErrToReturn = nil
+
+	// Nothing leaves a deposit with an outstanding slash
+	_, err = ms.SlashTrustDeposit(ctx, &types.MsgSlashTrustDeposit{
+		Authority:   k.GetAuthority(),
+		Corporation: account,
+		Deposit:     math.NewInt(10),
+		Reason:      "misbehaviour",
+	})
+	require.NoError(t, err)
+	_, err = ms.WithdrawClaimable(ctx, &types.MsgWithdrawClaimable{Corporation: account, Operator: operator, Amount: 1})
+	require.ErrorContains(t, err, "slashed and not repaid")
+	_, err = ms.DepositTrust(ctx, &types.MsgDepositTrust{Corporation: account, Operator: operator, Amount: 1})
+	require.ErrorContains(t, err, "slashed and not repaid")
+}
+
+func TestAmountToShareExact(t *testing.T) {
+	k, _, _ := setupMsgServer(t)
+
+	// Amounts above MaxInt64 are not truncated or wrapped
+	amount := uint64(mathstd.MaxInt64) + 10
+	share := k.AmountToShare(amount, math.LegacyOneDec())
+	require.Equal(t, math.LegacyNewDecFromInt(math.NewIntFromUint64(amount)), share)
+
+	share = k.AmountToShare(300, math.LegacyMustNewDecFromStr("1.5"))
+	require.Equal(t, math.LegacyNewDec(200), share)
+	require.Equal(t, uint64(300), k.ShareToAmount(share, math.LegacyMustNewDecFromStr("1.5")))
+}
@@ -42,12 +42,13 @@ func (ms msgServer) ReclaimTrustDepositYield(goCtx context.Context, msg *types.M
 	}
 
 	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation.
-	if _, err := ms.Keeper.coKeeper.ResolveCorporationByPolicyAddress(ctx, msg.Corporation); err != nil {
+	co, err := ms.Keeper.coKeeper.ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
 		return nil, err
 	}
 
 	// [MOD-TD-MSG-2-2-1] Load TrustDeposit entry
-	td, err := ms.Keeper.TrustDeposit.Get(ctx, account)
+	td, err := ms.Keeper.TrustDeposit.Get(ctx, co.Id)
 	if err != nil {
 		return nil, fmt.Errorf("trust deposit not found for account: %s", account)
 	}
@@ -67,45 +68,15 @@ func (ms msgServer) ReclaimTrustDepositYield(goCtx context.Context, msg *types.M
 	// and set claimable to 0. No per-call amount parameter.
 	claimed := td.Claimable
 
-	// Get share value
-	params := ms.Keeper.GetParams(ctx)
-
-	// [MOD-TD-MSG-2-3] Reduce shares proportionally to the withdrawn amount
-	sharesToReduce := ms.Keeper.AmountToShare(claimed, params.TrustDepositShareValue)
-	td.Share = td.Share.Sub(sharesToReduce)
-
-	// Drain claimable per spec.
-	td.Claimable = 0
-
-	// Validate corporation address
-	addr, err := sdk.AccAddressFromBech32(account)
+	sharesToReduce, err := ms.Keeper.withdrawClaimable(ctx, &td, claimed)
 	if err != nil {
-		return nil, fmt.Errorf("invalid corporation address: %w", err)
-	}
-
-	// Save updated trust deposit BEFORE bank transfer to ensure atomicity —
-	// if Set fails, no coins have been transferred yet.
-	if err := ms.Keeper.TrustDeposit.Set(ctx, account, td); err != nil {
-		return nil, fmt.Errorf("failed to update trust deposit: %w", err)
-	}
-
-	// [MOD-TD-MSG-2-3] Transfer yield from TrustDeposit module account to corporation
-	if claimed > uint64(mathstd.MaxInt64) {
-		return nil, fmt.Errorf("amount exceeds maximum coin value: %d", claimed)
-	}
-	coins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(claimed)))
-	if err := ms.Keeper.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		types.ModuleName,
-		addr,
-		coins,
-	); err != nil {
-		return nil, fmt.Errorf("failed to transfer yield: %w", err)
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeReclaimTrustDepositYield,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyAccount, account),
 			sdk.NewAttribute(types.AttributeKeyClaimedYield, strconv.FormatUint(claimed, 10)),
 			sdk.NewAttribute(types.AttributeKeySharesReduced, sharesToReduce.String()),
@@ -124,9 +95,10 @@ func (k Keeper) ShareToAmount(share math.LegacyDec, shareValue math.LegacyDec) u
 	return amountDec.TruncateInt().Uint64()
 }
 
-// AmountToShare converts amount to share value using decimal math
+// AmountToShare converts amount to share value using decimal math. amount is
+// converted through math.Int, so amounts above MaxInt64 stay exact.
 func (k Keeper) AmountToShare(amount uint64, shareValue math.LegacyDec) math.LegacyDec {
-	amountDec := math.LegacyNewDec(int64(amount))
+	amountDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(amount))
 	if shareValue.IsZero() {
 		return math.LegacyZeroDec() // Prevent division by zero
 	}
@@ -153,7 +125,7 @@ func (ms msgServer) SlashTrustDeposit(goCtx context.Context, msg *types.MsgSlash
 	}
 
 	// Check if TrustDeposit entry exists for the corporation
-	td, err := ms.Keeper.TrustDeposit.Get(ctx, msg.Corporation)
+	td, err := ms.Keeper.GetTrustDepositByAccount(ctx, msg.Corporation)
 	if err != nil {
 		return nil, fmt.Errorf("trust deposit not found for corporation: %s", msg.Corporation)
 	}
@@ -180,11 +152,16 @@ func (ms msgServer) SlashTrustDeposit(goCtx context.Context, msg *types.MsgSlash
 	td.LastSlashed = &now
 	td.SlashCount++
 
+	// Released amounts that have not matured yet are slashable too
+	if err := ms.Keeper.slashUnbonding(ctx, &td); err != nil {
+		return nil, fmt.Errorf("failed to slash unbonding trust deposit: %w", err)
+	}
+
 	// Save the updated TrustDeposit entry.
 	// Coins remain locked in the module account as slashed deposit;
 	// they are burned later by BurnEcosystemSlashedTrustDeposit (MOD-TD-MSG-7)
 	// or returned to the depositor via RepaySlashedTrustDeposit (MOD-TD-MSG-6).
-	if err := ms.Keeper.TrustDeposit.Set(ctx, msg.Corporation, td); err != nil {
+	if err := ms.Keeper.SetTrustDeposit(ctx, td); err != nil {
 		return nil, fmt.Errorf("failed to save trust deposit: %w", err)
 	}
 
@@ -192,6 +169,7 @@ func (ms msgServer) SlashTrustDeposit(goCtx context.Context, msg *types.MsgSlash
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlashTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(td.CorporationId, 10)),
 			sdk.NewAttribute(types.AttributeKeyAccount, msg.Corporation),
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Deposit.String()),
 			sdk.NewAttribute(types.AttributeKeySlashCount, strconv.FormatUint(td.SlashCount, 10)),
@@ -222,12 +200,13 @@ func (ms msgServer) RepaySlashedTrustDeposit(goCtx context.Context, msg *types.M
 	}
 
 	// [AUTHZ-CHECK-5] Signing corporation account MUST be a registered Corporation.
-	if _, err := ms.Keeper.coKeeper.ResolveCorporationByPolicyAddress(ctx, msg.Corporation); err != nil {
+	co, err := ms.Keeper.coKeeper.ResolveCorporationByPolicyAddress(ctx, msg.Corporation)
+	if err != nil {
 		return nil, err
 	}
 
 	// [MOD-TD-MSG-6-2-1] Load TrustDeposit entry for corporation (must exist)
-	td, err := ms.Keeper.TrustDeposit.Get(ctx, account)
+	td, err := ms.Keeper.TrustDeposit.Get(ctx, co.Id)
 	if err != nil {
 		return nil, fmt.Errorf("trust deposit entry not found for corporation %s: %w", account, err)
 	}
@@ -259,11 +238,13 @@ func (ms msgServer) RepaySlashedTrustDeposit(goCtx context.Context, msg *types.M
 	// and track cumulative repaid_deposit. Preserves historical slash accounting.
 	td.SlashedDeposit -= msg.Deposit
 	td.RepaidDeposit += msg.Deposit
+	// The slashed coins locked at slash time are burned below
+	td.BurnedDeposit += msg.Deposit
 	// td.last_repaid = now
 	td.LastRepaid = &now
 
 	// Save updated trust deposit BEFORE bank transfer to ensure atomicity
-	if err := ms.Keeper.TrustDeposit.Set(ctx, account, td); err != nil {
+	if err := ms.Keeper.SetTrustDeposit(ctx, td); err != nil {
 		return nil, fmt.Errorf("failed to update trust deposit: %w", err)
 	}
 
@@ -293,6 +274,7 @@ func (ms msgServer) RepaySlashedTrustDeposit(goCtx context.Context, msg *types.M
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRepaySlashedTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(co.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyAccount, account),
 			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Deposit, 10)),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
@@ -301,3 +283,52 @@ func (ms msgServer) RepaySlashedTrustDeposit(goCtx context.Context, msg *types.M
 
 	return &types.MsgRepaySlashedTrustDepositResponse{}, nil
 }
+
+// withdrawClaimable moves amount of the claimable balance of td to the
+// corporation account, reducing its share proportionally, and saves td. It
+// returns the reduced share.
+func (k Keeper) withdrawClaimable(ctx sdk.Context, td *types.TrustDeposit, amount uint64) (math.LegacyDec, error) {
+	if amount > td.Claimable {
+		return math.LegacyDec{}, fmt.Errorf("amount exceeds claimable: %d > %d", amount, td.Claimable)
+	}
+
+	// Validate corporation address
+	addr, err := sdk.AccAddressFromBech32(td.Corporation)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid corporation address: %w", err)
+	}
+
+	// Get share value
+	params := k.GetParams(ctx)
+
+	// [MOD-TD-MSG-2-3] Reduce shares proportionally to the withdrawn amount
+	sharesToReduce := k.AmountToShare(amount, params.TrustDepositShareValue)
+	if sharesToReduce.GT(td.Share) {
+		// Rounding can leave the share a few units below amount / share_value
+		sharesToReduce = td.Share
+	}
+	td.Share = td.Share.Sub(sharesToReduce)
+	td.Claimable -= amount
+
+	// Save updated trust deposit BEFORE bank transfer to ensure atomicity —
+	// if Set fails, no coins have been transferred yet.
+	if err := k.SetTrustDeposit(ctx, *td); err != nil {
+		return math.LegacyDec{}, fmt.Errorf("failed to update trust deposit: %w", err)
+	}
+
+	// [MOD-TD-MSG-2-3] Transfer from TrustDeposit module account to corporation
+	if amount > uint64(mathstd.MaxInt64) {
+		return math.LegacyDec{}, fmt.Errorf("amount exceeds maximum coin value: %d", amount)
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(amount)))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		addr,
+		coins,
+	); err != nil {
+		return math.LegacyDec{}, fmt.Errorf("failed to transfer yield: %w", err)
+	}
+
+	return sharesToReduce, nil
+}
@@ -21,6 +21,14 @@ func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, context.Conte
 	return k, keeper.NewMsgServerImpl(k), ctx
 }
 
+// mustCorporationID returns the corporation id the mock corporation keeper
+// registers account under.
+func mustCorporationID(t testing.TB, k keeper.Keeper, ctx context.Context, account string) uint64 {
+	id, err := k.ResolveCorporationID(ctx, account)
+	require.NoError(t, err)
+	return id
+}
+
 func TestMsgServer(t *testing.T) {
 	k, ms, ctx := setupMsgServer(t)
 	require.NotNil(t, ms)
@@ -34,6 +42,7 @@ func TestMsgReclaimTrustDepositYield(t *testing.T) {
 	// Create test account
 	testAddr := sdk.AccAddress([]byte("test_address"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 
 	// Test cases
 	testCases := []struct {
@@ -70,11 +79,12 @@ func TestMsgReclaimTrustDepositYield(t *testing.T) {
 				// Create a trust deposit with no yield
 				td := types.TrustDeposit{
 					Corporation:   testAccString,
+					CorporationId: corpID,
 					Share:     math.LegacyNewDec(1000),
 					Deposit:    1000,
 					Claimable: 0,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgReclaimTrustDepositYield{
@@ -101,11 +111,12 @@ func TestMsgReclaimTrustDepositYield(t *testing.T) {
 				// Create a trust deposit with accrued yield
 				td := types.TrustDeposit{
 					Corporation:   testAccString,
+					CorporationId: corpID,
 					Share:     math.LegacyNewDec(1000),
 					Deposit:    1000,
 					Claimable: 500, // pre-accrued yield available to claim
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgReclaimTrustDepositYield{
@@ -117,7 +128,7 @@ func TestMsgReclaimTrustDepositYield(t *testing.T) {
 				require.Equal(t, uint64(500), resp.ClaimedAmount)
 
 				// Verify trust deposit was updated correctly
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				// Shares reduced by 500/1.5 = 333.33...
 				expectedShare := math.LegacyNewDec(1000).Sub(math.LegacyMustNewDecFromStr("333.333333333333333333"))
@@ -158,11 +169,12 @@ func TestAdjustTrustDeposit(t *testing.T) {
 	// Create test account
 	testAddr := sdk.AccAddress([]byte("test_address"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 
 	// Test cases
 	testCases := []struct {
 		name      string
-		account   string
+		corporationID uint64
 		augend    int64
 		setup     func() // Setup function to prepare the test state
 		expErr    bool
@@ -170,64 +182,67 @@ func TestAdjustTrustDeposit(t *testing.T) {
 		check     func() // Function to check state after execution
 	}{
 		{
-			name:      "Invalid account address",
-			account:   "invalid_address",
-			augend:    100,
-			expErr:    true,
-			expErrMsg: "invalid account address",
+			name:          "Unknown corporation",
+			corporationID: 999,
+			augend:        100,
+			expErr:        true,
+			expErrMsg:     "corporation 999 not found",
 		},
 		{
 			name:      "Zero augend",
-			account:   testAccString,
+			corporationID:   corpID,
 			augend:    0,
 			expErr:    true,
 			expErrMsg: "augend must be non-zero",
 		},
 		{
 			name:      "Decrease non-existent trust deposit",
-			account:   testAccString,
+			corporationID:   corpID,
 			augend:    -100,
 			expErr:    true,
 			expErrMsg: "cannot decrease non-existent trust deposit",
 		},
 		{
 			name:    "Successful decrease",
-			account: testAccString,
+			corporationID: corpID,
 			augend:  -100,
 			setup: func() {
 				// Create a trust deposit
 				td := types.TrustDeposit{
 					Corporation:   testAccString,
+					CorporationId: corpID,
 					Share:     math.LegacyNewDec(1000),
 					Deposit:    1000,
 					Claimable: 200,
 				}
-				err := k.TrustDeposit.Set(ctx, testAccString, td)
+				err := k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr: false,
 			check: func() {
 				// Verify trust deposit was updated correctly
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
-				require.Equal(t, uint64(300), td.Claimable)                                                          // 200 + 100 = 300
+				require.Equal(t, uint64(200), td.Claimable)                                                          // Unchanged until unbonded
+				require.Equal(t, uint64(100), td.Unbonding)
 				require.Equal(t, uint64(1000), td.Deposit)                                                            // Unchanged
 				require.True(t, td.Share.Equal(math.LegacyNewDec(1000)), "expected 1000, got %s", td.Share.String()) // Unchanged
 			},
 		},
 		{
 			name:    "Decrease with claimable exceeding deposit",
-			account: testAccString,
+			corporationID: corpID,
 			augend:  -900,
 			setup: func() {
 				// Create a trust deposit
 				td := types.TrustDeposit{
 					Corporation:   testAccString,
+					CorporationId: corpID,
 					Share:     math.LegacyNewDec(1000),
 					Deposit:    1000,
 					Claimable: 200,
 				}
-				err := k.TrustDeposit.Set(ctx, testAccString, td)
+				err := k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr:    true,
@@ -235,23 +250,24 @@ func TestAdjustTrustDeposit(t *testing.T) {
 		},
 		{
 			name:    "Increase using claimable",
-			account: testAccString,
+			corporationID: corpID,
 			augend:  50,
 			setup: func() {
 				// Create a trust deposit with claimable amount
 				td := types.TrustDeposit{
 					Corporation:   testAccString,
+					CorporationId: corpID,
 					Share:     math.LegacyNewDec(1000),
 					Deposit:    1000,
 					Claimable: 300,
 				}
-				err := k.TrustDeposit.Set(ctx, testAccString, td)
+				err := k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr: false,
 			check: func() {
 				// Verify trust deposit was updated correctly
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(250), td.Claimable)                                                          // 300 - 50 = 250
 				require.Equal(t, uint64(1000), td.Deposit)                                                            // Unchanged
@@ -266,7 +282,7 @@ func TestAdjustTrustDeposit(t *testing.T) {
 				tc.setup()
 			}
 
-			err := k.AdjustTrustDeposit(sdkCtx, tc.account, tc.augend, "test")
+			err := k.AdjustTrustDeposit(sdkCtx, tc.corporationID, tc.augend, "test")
 
 			if tc.expErr {
 				require.Error(t, err)
@@ -411,6 +427,7 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 
 	testAddr := sdk.AccAddress([]byte("slash_target_addr_1"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 
 	testCases := []struct {
 		name      string
@@ -467,10 +484,11 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation: testAccString,
+					CorporationId: corpID,
 					Share:   math.LegacyNewDec(100),
 					Deposit:  100,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgSlashTrustDeposit{
@@ -488,10 +506,11 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation: testAccString,
+					CorporationId: corpID,
 					Share:   math.LegacyNewDec(1000),
 					Deposit:  1000,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgSlashTrustDeposit{
@@ -501,7 +520,7 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(700), td.Deposit)
 				require.Equal(t, uint64(300), td.SlashedDeposit)
@@ -519,12 +538,13 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:        testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(1000),
 					Deposit:         1000,
 					SlashedDeposit: 200,
 					SlashCount:     1,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgSlashTrustDeposit{
@@ -534,7 +554,7 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(900), td.Deposit)
 				require.Equal(t, uint64(300), td.SlashedDeposit) // 200 + 100
@@ -548,10 +568,11 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation: testAccString,
+					CorporationId: corpID,
 					Share:   math.LegacyNewDec(500),
 					Deposit:  500,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgSlashTrustDeposit{
@@ -561,7 +582,7 @@ func TestMsgSlashTrustDeposit(t *testing.T) {
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(0), td.Deposit)
 				require.Equal(t, uint64(500), td.SlashedDeposit)
@@ -600,6 +621,7 @@ func TestMsgRepaySlashedTrustDeposit(t *testing.T) {
 
 	testAddr := sdk.AccAddress([]byte("repay_target_addr_1"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 
 	testCases := []struct {
 		name      string
@@ -626,12 +648,13 @@ func TestMsgRepaySlashedTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:        testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(700),
 					Deposit:         700,
 					SlashedDeposit: 300,
 					RepaidDeposit:  0,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgRepaySlashedTrustDeposit{
@@ -649,13 +672,14 @@ func TestMsgRepaySlashedTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:        testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(700),
 					Deposit:         700,
 					SlashedDeposit: 300,
 					RepaidDeposit:  0,
 					SlashCount:     1,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgRepaySlashedTrustDeposit{
@@ -665,7 +689,7 @@ func TestMsgRepaySlashedTrustDeposit(t *testing.T) {
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(1000), td.Deposit) // 700 + 300
 				// [MOD-TD-MSG-6-3] spec v4 draft 13: slashed_deposit decremented,
@@ -687,13 +711,14 @@ func TestMsgRepaySlashedTrustDeposit(t *testing.T) {
 				// balance (300 remaining after 200 already repaid); repaid_deposit is cumulative.
 				td := types.TrustDeposit{
 					Corporation:    testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(800),
 					Deposit:        800,
 					SlashedDeposit: 300, // outstanding slashed amount
 					RepaidDeposit:  200, // cumulative repaid so far
 					SlashCount:     2,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgRepaySlashedTrustDeposit{
@@ -703,7 +728,7 @@ func TestMsgRepaySlashedTrustDeposit(t *testing.T) {
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(1100), td.Deposit) // 800 + 300
 				// [MOD-TD-MSG-6-3] spec v4 draft 13: 200 prior + 300 now = 500 cumulative repaid.
@@ -718,12 +743,13 @@ func TestMsgRepaySlashedTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:        testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(900),
 					Deposit:         900,
 					SlashedDeposit: 100,
 					RepaidDeposit:  0,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			msg: &types.MsgRepaySlashedTrustDeposit{
@@ -765,6 +791,7 @@ func TestMsgRepaySlashedTrustDepositAuthz(t *testing.T) {
 
 	testAddr := sdk.AccAddress([]byte("authz_repay_addr__1"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 	operatorAddr := sdk.AccAddress([]byte("authz_operator_ad1")).String()
 
 	t.Run("Authorization check fails", func(t *testing.T) {
@@ -772,12 +799,13 @@ func TestMsgRepaySlashedTrustDepositAuthz(t *testing.T) {
 		require.NoError(t, err)
 		td := types.TrustDeposit{
 			Corporation:        testAccString,
+			CorporationId: corpID,
 			Share:          math.LegacyNewDec(700),
 			Deposit:         700,
 			SlashedDeposit: 300,
 			RepaidDeposit:  0,
 		}
-		err = k.TrustDeposit.Set(ctx, testAccString, td)
+		err = k.SetTrustDeposit(ctx, td)
 		require.NoError(t, err)
 
 		dk.ErrToReturn = fmt.Errorf("mock: operator not authorized")
@@ -795,12 +823,13 @@ func TestMsgRepaySlashedTrustDepositAuthz(t *testing.T) {
 		require.NoError(t, err)
 		td := types.TrustDeposit{
 			Corporation:        testAccString,
+			CorporationId: corpID,
 			Share:          math.LegacyNewDec(700),
 			Deposit:         700,
 			SlashedDeposit: 300,
 			RepaidDeposit:  0,
 		}
-		err = k.TrustDeposit.Set(ctx, testAccString, td)
+		err = k.SetTrustDeposit(ctx, td)
 		require.NoError(t, err)
 
 		dk.ErrToReturn = nil
@@ -823,10 +852,11 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 
 	testAddr := sdk.AccAddress([]byte("burn_eco_target_ad1"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 
 	testCases := []struct {
 		name      string
-		account   string
+		corporationID uint64
 		amount    uint64
 		setup     func()
 		expErr    bool
@@ -834,39 +864,40 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 		check     func()
 	}{
 		{
-			name:      "Empty account",
-			account:   "",
-			amount:    100,
-			expErr:    true,
-			expErrMsg: "account cannot be empty",
+			name:          "Zero corporation id",
+			corporationID: 0,
+			amount:        100,
+			expErr:        true,
+			expErrMsg:     "corporation id cannot be 0",
 		},
 		{
 			name:      "Zero amount",
-			account:   testAccString,
+			corporationID:   corpID,
 			amount:    0,
 			expErr:    true,
 			expErrMsg: "deposit must be greater than 0",
 		},
 		{
 			name:      "Trust deposit not found",
-			account:   sdk.AccAddress([]byte("nonexistent_burn_a1")).String(),
+			corporationID: 999,
 			amount:    100,
 			expErr:    true,
 			expErrMsg: "trust deposit entry not found",
 		},
 		{
 			name:    "Amount exceeds deposit",
-			account: testAccString,
+			corporationID: corpID,
 			amount:  200,
 			setup: func() {
 				err := k.SetParams(ctx, defaultTestParams())
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation: testAccString,
+					CorporationId: corpID,
 					Share:   math.LegacyNewDec(100),
 					Deposit:  100,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr:    true,
@@ -874,7 +905,7 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 		},
 		{
 			name:    "Zero share value in params",
-			account: testAccString,
+			corporationID: corpID,
 			amount:  50,
 			setup: func() {
 				params := defaultTestParams()
@@ -883,10 +914,11 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation: testAccString,
+					CorporationId: corpID,
 					Share:   math.LegacyNewDec(100),
 					Deposit:  100,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr:    true,
@@ -894,22 +926,23 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 		},
 		{
 			name:    "Successful burn",
-			account: testAccString,
+			corporationID: corpID,
 			amount:  300,
 			setup: func() {
 				err := k.SetParams(ctx, defaultTestParams())
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation: testAccString,
+					CorporationId: corpID,
 					Share:   math.LegacyNewDec(1000),
 					Deposit:  1000,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(700), td.Deposit)
 				expectedShare := math.LegacyNewDec(700) // 1000 - 300/1.0
@@ -918,22 +951,23 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 		},
 		{
 			name:    "Burn entire deposit",
-			account: testAccString,
+			corporationID: corpID,
 			amount:  500,
 			setup: func() {
 				err := k.SetParams(ctx, defaultTestParams())
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation: testAccString,
+					CorporationId: corpID,
 					Share:   math.LegacyNewDec(500),
 					Deposit:  500,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(0), td.Deposit)
 				require.True(t, td.Share.Equal(math.LegacyZeroDec()) || !td.Share.IsNegative(),
@@ -942,24 +976,25 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 		},
 		{
 			name:    "Does NOT update SlashedDeposit or SlashCount",
-			account: testAccString,
+			corporationID: corpID,
 			amount:  100,
 			setup: func() {
 				err := k.SetParams(ctx, defaultTestParams())
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:        testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(1000),
 					Deposit:         1000,
 					SlashedDeposit: 50,
 					SlashCount:     2,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(50), td.SlashedDeposit, "SlashedDeposit should be unchanged")
 				require.Equal(t, uint64(2), td.SlashCount, "SlashCount should be unchanged")
@@ -972,7 +1007,7 @@ func TestBurnEcosystemSlashedTrustDeposit(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup()
 			}
-			err := k.BurnEcosystemSlashedTrustDeposit(sdkCtx, tc.account, tc.amount)
+			err := k.BurnEcosystemSlashedTrustDeposit(sdkCtx, 0, tc.corporationID, tc.amount)
 			if tc.expErr {
 				require.Error(t, err)
 				if tc.expErrMsg != "" {
@@ -998,11 +1033,12 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 
 	testAddr := sdk.AccAddress([]byte("onbehalf_target_ad1"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 	funder := sdk.AccAddress([]byte("funder_address_0001"))
 
 	testCases := []struct {
 		name      string
-		account   string
+		corporationID uint64
 		funder    sdk.AccAddress
 		amount    int64
 		setup     func()
@@ -1012,7 +1048,7 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 	}{
 		{
 			name:      "Negative amount",
-			account:   testAccString,
+			corporationID:   corpID,
 			funder:    funder,
 			amount:    -100,
 			expErr:    true,
@@ -1020,34 +1056,34 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 		},
 		{
 			name:      "Zero amount",
-			account:   testAccString,
+			corporationID:   corpID,
 			funder:    funder,
 			amount:    0,
 			expErr:    true,
 			expErrMsg: "amount must be positive",
 		},
 		{
-			name:      "Empty account",
-			account:   "",
-			funder:    funder,
-			amount:    100,
-			expErr:    true,
-			expErrMsg: "account cannot be empty",
+			name:          "Zero corporation id",
+			corporationID: 0,
+			funder:        funder,
+			amount:        100,
+			expErr:        true,
+			expErrMsg:     "corporation id cannot be 0",
 		},
 		{
 			name:    "New TD created on behalf",
-			account: testAccString,
+			corporationID: corpID,
 			funder:  funder,
 			amount:  500,
 			setup: func() {
 				err := k.SetParams(ctx, defaultTestParams())
 				require.NoError(t, err)
 				// Ensure no existing TD
-				_ = k.TrustDeposit.Remove(ctx, testAccString)
+				_ = k.TrustDeposit.Remove(ctx, corpID)
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(500), td.Deposit)
 				require.Equal(t, uint64(0), td.Claimable)
@@ -1057,7 +1093,7 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 		},
 		{
 			name:    "Existing TD increased on behalf",
-			account: testAccString,
+			corporationID: corpID,
 			funder:  funder,
 			amount:  200,
 			setup: func() {
@@ -1065,16 +1101,17 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:   testAccString,
+					CorporationId: corpID,
 					Share:     math.LegacyNewDec(1000),
 					Deposit:    1000,
 					Claimable: 100,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(1200), td.Deposit)   // 1000 + 200
 				require.Equal(t, uint64(100), td.Claimable) // unchanged
@@ -1084,7 +1121,7 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 		},
 		{
 			name:    "Slashed and unrepaid TD blocked",
-			account: testAccString,
+			corporationID: corpID,
 			funder:  funder,
 			amount:  100,
 			setup: func() {
@@ -1092,12 +1129,13 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:        testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(700),
 					Deposit:         700,
 					SlashedDeposit: 300,
 					RepaidDeposit:  0,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr:    true,
@@ -1105,7 +1143,7 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 		},
 		{
 			name:    "Slashed but fully repaid TD allowed",
-			account: testAccString,
+			corporationID: corpID,
 			funder:  funder,
 			amount:  100,
 			setup: func() {
@@ -1113,17 +1151,18 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 				require.NoError(t, err)
 				td := types.TrustDeposit{
 					Corporation:        testAccString,
+					CorporationId: corpID,
 					Share:          math.LegacyNewDec(1000),
 					Deposit:         1000,
 					SlashedDeposit: 300,
 					RepaidDeposit:  300,
 				}
-				err = k.TrustDeposit.Set(ctx, testAccString, td)
+				err = k.SetTrustDeposit(ctx, td)
 				require.NoError(t, err)
 			},
 			expErr: false,
 			check: func() {
-				td, err := k.TrustDeposit.Get(ctx, testAccString)
+				td, err := k.TrustDeposit.Get(ctx, corpID)
 				require.NoError(t, err)
 				require.Equal(t, uint64(1100), td.Deposit) // 1000 + 100
 			},
@@ -1135,7 +1174,7 @@ func TestAdjustTrustDepositOnBehalf(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup()
 			}
-			err := k.AdjustTrustDepositOnBehalf(sdkCtx, tc.account, tc.funder, tc.amount)
+			err := k.AdjustTrustDepositOnBehalf(sdkCtx, tc.corporationID, tc.funder, tc.amount)
 			if tc.expErr {
 				require.Error(t, err)
 				if tc.expErrMsg != "" {
@@ -1160,6 +1199,7 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 		k, ms, ctx := setupMsgServer(t)
 		testAddr := sdk.AccAddress([]byte("yield_slash_guard1"))
 		testAccString := testAddr.String()
+		corpID := mustCorporationID(t, k, ctx, testAccString)
 
 		params := defaultTestParams()
 		params.TrustDepositShareValue = math.LegacyMustNewDecFromStr("1.5")
@@ -1168,12 +1208,13 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 
 		td := types.TrustDeposit{
 			Corporation:        testAccString,
+			CorporationId: corpID,
 			Share:          math.LegacyNewDec(1000),
 			Deposit:         1000,
 			SlashedDeposit: 100,
 			RepaidDeposit:  0,
 		}
-		err = k.TrustDeposit.Set(ctx, testAccString, td)
+		err = k.SetTrustDeposit(ctx, td)
 		require.NoError(t, err)
 
 		_, err = ms.ReclaimTrustDepositYield(ctx, &types.MsgReclaimTrustDepositYield{
@@ -1188,6 +1229,7 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 		k, ms, ctx := setupMsgServer(t)
 		testAddr := sdk.AccAddress([]byte("yield_repaid_ok__1"))
 		testAccString := testAddr.String()
+		corpID := mustCorporationID(t, k, ctx, testAccString)
 
 		params := defaultTestParams()
 		params.TrustDepositShareValue = math.LegacyMustNewDecFromStr("1.5")
@@ -1198,13 +1240,14 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 		// and repaid_deposit keeps cumulative history. Reclaim is enabled while slashed_deposit == 0.
 		td := types.TrustDeposit{
 			Corporation:    testAccString,
+			CorporationId: corpID,
 			Share:          math.LegacyNewDec(1000),
 			Deposit:        1000,
 			Claimable:      500, // pre-accrued yield
 			SlashedDeposit: 0,   // fully repaid — decremented to 0
 			RepaidDeposit:  100, // cumulative history preserved
 		}
-		err = k.TrustDeposit.Set(ctx, testAccString, td)
+		err = k.SetTrustDeposit(ctx, td)
 		require.NoError(t, err)
 
 		resp, err := ms.ReclaimTrustDepositYield(ctx, &types.MsgReclaimTrustDepositYield{
@@ -1219,6 +1262,7 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 		k, ms, ctx, dk := setupMsgServerWithDelegation(t)
 		testAddr := sdk.AccAddress([]byte("yield_authz_fail_1"))
 		testAccString := testAddr.String()
+		corpID := mustCorporationID(t, k, ctx, testAccString)
 
 		params := defaultTestParams()
 		params.TrustDepositShareValue = math.LegacyMustNewDecFromStr("1.5")
@@ -1227,10 +1271,11 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 
 		td := types.TrustDeposit{
 			Corporation: testAccString,
+			CorporationId: corpID,
 			Share:   math.LegacyNewDec(1000),
 			Deposit:  1000,
 		}
-		err = k.TrustDeposit.Set(ctx, testAccString, td)
+		err = k.SetTrustDeposit(ctx, td)
 		require.NoError(t, err)
 
 		dk.ErrToReturn = fmt.Errorf("mock: not authorized")
@@ -1246,6 +1291,7 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 		k, ms, ctx, dk := setupMsgServerWithDelegation(t)
 		testAddr := sdk.AccAddress([]byte("yield_authz_pass_1"))
 		testAccString := testAddr.String()
+		corpID := mustCorporationID(t, k, ctx, testAccString)
 
 		params := defaultTestParams()
 		params.TrustDepositShareValue = math.LegacyMustNewDecFromStr("1.5")
@@ -1254,11 +1300,12 @@ func TestMsgReclaimTrustDepositYieldEdgeCases(t *testing.T) {
 
 		td := types.TrustDeposit{
 			Corporation: testAccString,
+			CorporationId: corpID,
 			Share:       math.LegacyNewDec(1000),
 			Deposit:     1000,
 			Claimable:   500, // pre-accrued yield
 		}
-		err = k.TrustDeposit.Set(ctx, testAccString, td)
+		err = k.SetTrustDeposit(ctx, td)
 		require.NoError(t, err)
 
 		dk.ErrToReturn = nil
@@ -1277,21 +1324,23 @@ func TestAdjustTrustDepositSlashedGuard(t *testing.T) {
 
 	testAddr := sdk.AccAddress([]byte("adjust_slash_guard"))
 	testAccString := testAddr.String()
+	corpID := mustCorporationID(t, k, ctx, testAccString)
 
 	err := k.SetParams(ctx, defaultTestParams())
 	require.NoError(t, err)
 
 	td := types.TrustDeposit{
 		Corporation:        testAccString,
+		CorporationId: corpID,
 		Share:          math.LegacyNewDec(700),
 		Deposit:         700,
 		SlashedDeposit: 300,
 		RepaidDeposit:  0,
 	}
-	err = k.TrustDeposit.Set(ctx, testAccString, td)
+	err = k.SetTrustDeposit(ctx, td)
 	require.NoError(t, err)
 
-	err = k.AdjustTrustDeposit(sdkCtx, testAccString, 100, "test")
+	err = k.AdjustTrustDeposit(sdkCtx, corpID, 100, "test")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "slashed and not fully repaid")
 }
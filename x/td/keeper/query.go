@@ -27,7 +27,7 @@ func (k Keeper) GetTrustDeposit(goCtx context.Context, req *types.QueryGetTrustD
 	}
 
 	// [MOD-TD-QRY-1-3] Get trust deposit for corporation
-	trustDeposit, err := k.TrustDeposit.Get(ctx, req.Corporation)
+	trustDeposit, err := k.GetTrustDepositByAccount(ctx, req.Corporation)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, fmt.Sprintf("trust deposit not found for corporation %s", req.Corporation))
@@ -39,3 +39,27 @@ func (k Keeper) GetTrustDeposit(goCtx context.Context, req *types.QueryGetTrustD
 		TrustDeposit: trustDeposit,
 	}, nil
 }
+
+func (k Keeper) GetCorporationTrustDeposit(goCtx context.Context, req *types.QueryGetCorporationTrustDepositRequest) (*types.QueryGetCorporationTrustDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.CorporationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "corporation id cannot be 0")
+	}
+
+	trustDeposit, err := k.TrustDeposit.Get(ctx, req.CorporationId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("trust deposit not found for corporation %d", req.CorporationId))
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get trust deposit: %s", err))
+	}
+
+	return &types.QueryGetCorporationTrustDepositResponse{
+		TrustDeposit: trustDeposit,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func (k Keeper) GetCompensationPool(goCtx context.Context, req *types.QueryGetCompensationPoolRequest) (*types.QueryGetCompensationPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.EcosystemId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ecosystem_id must be greater than 0")
+	}
+
+	// a pool that was never funded is empty
+	pool, err := k.getCompensationPool(ctx, req.EcosystemId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetCompensationPoolResponse{CompensationPool: pool}, nil
+}
+
+func (k Keeper) GetCompensationClaim(goCtx context.Context, req *types.QueryGetCompensationClaimRequest) (*types.QueryGetCompensationClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claim, err := k.CompensationClaim.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "compensation claim not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetCompensationClaimResponse{CompensationClaim: claim}, nil
+}
+
+func (k Keeper) ListCompensationClaims(goCtx context.Context, req *types.QueryListCompensationClaimsRequest) (*types.QueryListCompensationClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claims, pageRes, err := query.CollectionFilteredPaginate(ctx, k.CompensationClaim, req.Pagination,
+		func(_ uint64, claim types.CompensationClaim) (bool, error) {
+			if req.EcosystemId != 0 && claim.EcosystemId != req.EcosystemId {
+				return false, nil
+			}
+			if req.Status != types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_UNSPECIFIED && claim.Status != req.Status {
+				return false, nil
+			}
+			return true, nil
+		},
+		func(_ uint64, claim types.CompensationClaim) (types.CompensationClaim, error) {
+			return claim, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListCompensationClaimsResponse{
+		CompensationClaims: claims,
+		Pagination:         pageRes,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func (k Keeper) EffectiveYieldRate(goCtx context.Context, req *types.QueryEffectiveYieldRateRequest) (*types.QueryEffectiveYieldRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// the yield is distributed on the trust deposit module balance
+	trustDeposit := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), types.BondDenom).Amount
+	rate, ratio, err := k.effectiveYieldRate(ctx, params, trustDeposit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEffectiveYieldRateResponse{
+		Rate:         rate,
+		CurveEnabled: params.YieldCurveEnabled(),
+		DepositRatio: ratio,
+		TrustDeposit: trustDeposit,
+	}, nil
+}
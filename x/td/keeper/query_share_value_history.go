@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// secondsPerYear annualizes the realized APR
+const secondsPerYear = 365 * 24 * 60 * 60
+
+func (k Keeper) ListShareValueCheckpoints(goCtx context.Context, req *types.QueryListShareValueCheckpointsRequest) (*types.QueryListShareValueCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	checkpoints, pageRes, err := query.CollectionPaginate(ctx, k.ShareValueCheckpoint, req.Pagination,
+		func(_ int64, checkpoint types.ShareValueCheckpoint) (types.ShareValueCheckpoint, error) {
+			return checkpoint, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListShareValueCheckpointsResponse{
+		Checkpoints: checkpoints,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) RealizedApr(goCtx context.Context, req *types.QueryRealizedAprRequest) (*types.QueryRealizedAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.To != nil && !req.To.After(req.From) {
+		return nil, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+
+	start, err := k.shareValueCheckpointAt(ctx, req.From)
+	if err != nil {
+		return nil, shareValueHistoryError(err)
+	}
+
+	// Without an end the window runs up to the current share value
+	end := types.ShareValueCheckpoint{
+		Timestamp:   ctx.BlockTime(),
+		BlockHeight: ctx.BlockHeight(),
+		ShareValue:  k.GetTrustDepositShareValue(ctx),
+	}
+	if req.To != nil {
+		end, err = k.shareValueCheckpointAt(ctx, *req.To)
+		if err != nil {
+			return nil, shareValueHistoryError(err)
+		}
+	}
+
+	elapsed := int64(end.Timestamp.Sub(start.Timestamp).Seconds())
+	if elapsed <= 0 {
+		return nil, status.Error(codes.NotFound, "share value history does not cover the window")
+	}
+	if !start.ShareValue.IsPositive() {
+		return nil, status.Error(codes.Internal, "invalid share value checkpoint")
+	}
+
+	// apr = (end / start - 1) * seconds_per_year / elapsed
+	apr := end.ShareValue.Quo(start.ShareValue).Sub(math.LegacyOneDec()).MulInt64(secondsPerYear).QuoInt64(elapsed)
+
+	return &types.QueryRealizedAprResponse{
+		Start: start,
+		End:   end,
+		Apr:   apr,
+	}, nil
+}
+
+// AccruedYield approximates the yield accrued since req.Since by the current
+// share of the trust deposit. Share changes are not tracked, so the current
+// share is counted over the whole window.
+func (k Keeper) AccruedYield(goCtx context.Context, req *types.QueryAccruedYieldRequest) (*types.QueryAccruedYieldResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	td, err := k.TrustDeposit.Get(ctx, req.CorporationId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trust deposit not found for corporation %d", req.CorporationId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	start, err := k.shareValueCheckpointAt(ctx, req.Since)
+	if err != nil {
+		return nil, shareValueHistoryError(err)
+	}
+
+	shareValue := k.GetTrustDepositShareValue(ctx)
+
+	return &types.QueryAccruedYieldResponse{
+		CorporationId: req.CorporationId,
+		Share:         td.Share,
+		Start:         start,
+		ShareValue:    shareValue,
+		AccruedYield:  td.Share.Mul(shareValue.Sub(start.ShareValue)),
+	}, nil
+}
+
+// shareValueHistoryError maps a checkpoint lookup error to a gRPC status
+func shareValueHistoryError(err error) error {
+	if errors.Is(err, collections.ErrNotFound) {
+		return status.Error(codes.NotFound, "no share value checkpoint recorded")
+	}
+	return status.Error(codes.Internal, err.Error())
+}
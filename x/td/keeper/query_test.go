@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/verana-labs/verana/testutil/keeper"
 	"github.com/verana-labs/verana/x/td/types"
@@ -29,12 +32,16 @@ func TestGetTrustDeposit(t *testing.T) {
 
 	// Create a trust deposit
 	trustDeposit := types.TrustDeposit{
-		Corporation: testAddr,
-		Share:       math.LegacyNewDec(100),
-		Deposit:     1000,
-		Claimable:   50,
+		Corporation:   testAddr,
+		CorporationId: 1,
+		Share:         math.LegacyNewDec(100),
+		Deposit:       1000,
+		Claimable:     50,
 	}
-	err = keeper.TrustDeposit.Set(ctx, testAddr, trustDeposit)
+	err = keeper.SetTrustDeposit(ctx, trustDeposit)
+	require.NoError(t, err)
+	// Stamped modified on write
+	trustDeposit, err = keeper.TrustDeposit.Get(ctx, trustDeposit.CorporationId)
 	require.NoError(t, err)
 
 	// Test with existing trust deposit
@@ -52,3 +59,129 @@ func TestGetTrustDeposit(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid corporation address")
 	require.Contains(t, status.Code(err).String(), codes.InvalidArgument.String())
 }
+
+func TestGetCorporationTrustDeposit(t *testing.T) {
+	keeper, ctx := keepertest.TrustdepositKeeper(t)
+
+	_, err := keeper.GetCorporationTrustDeposit(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.GetCorporationTrustDeposit(ctx, &types.QueryGetCorporationTrustDepositRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.GetCorporationTrustDeposit(ctx, &types.QueryGetCorporationTrustDepositRequest{CorporationId: 7})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	trustDeposit := types.TrustDeposit{
+		Corporation:   sdk.AccAddress([]byte("test_address")).String(),
+		CorporationId: 7,
+		Share:         math.LegacyNewDec(100),
+		Deposit:       1000,
+	}
+	require.NoError(t, keeper.SetTrustDeposit(ctx, trustDeposit))
+	trustDeposit, err = keeper.TrustDeposit.Get(ctx, 7)
+	require.NoError(t, err)
+
+	resp, err := keeper.GetCorporationTrustDeposit(ctx, &types.QueryGetCorporationTrustDepositRequest{CorporationId: 7})
+	require.NoError(t, err)
+	require.Equal(t, trustDeposit, resp.TrustDeposit)
+
+	// The by-account lookup resolves to the same entry
+	byAccount, err := keeper.GetTrustDeposit(ctx, &types.QueryGetTrustDepositRequest{Corporation: trustDeposit.Corporation})
+	require.NoError(t, err)
+	require.Equal(t, trustDeposit, byAccount.TrustDeposit)
+
+	// Moving the deposit to another account drops the previous lookup
+	previous := trustDeposit.Corporation
+	trustDeposit.Corporation = sdk.AccAddress([]byte("moved_address")).String()
+	require.NoError(t, keeper.SetTrustDeposit(ctx, trustDeposit))
+	_, err = keeper.GetTrustDeposit(ctx, &types.QueryGetTrustDepositRequest{Corporation: previous})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	byAccount, err = keeper.GetTrustDeposit(ctx, &types.QueryGetTrustDepositRequest{Corporation: trustDeposit.Corporation})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), byAccount.TrustDeposit.CorporationId)
+}
+
+func TestListTrustDeposits(t *testing.T) {
+	keeper, ctx := keepertest.TrustdepositKeeper(t)
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, td := range []types.TrustDeposit{
+		{Deposit: 100},
+		{Deposit: 500, SlashedDeposit: 50},
+		{Deposit: 1000},
+	} {
+		td.CorporationId = uint64(i + 1)
+		td.Corporation = sdk.AccAddress([]byte(fmt.Sprintf("list_td_address_%d", i))).String()
+		td.Share = math.LegacyNewDec(int64(td.Deposit))
+		// Each deposit is modified a day after the previous one
+		require.NoError(t, keeper.SetTrustDeposit(ctx.WithBlockTime(t0.AddDate(0, 0, i)), td))
+	}
+
+	ids := func(res *types.QueryListTrustDepositsResponse) []uint64 {
+		var out []uint64
+		for _, td := range res.TrustDeposits {
+			out = append(out, td.CorporationId)
+		}
+		return out
+	}
+
+	_, err := keeper.ListTrustDeposits(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := keeper.ListTrustDeposits(ctx, &types.QueryListTrustDepositsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, ids(res))
+
+	res, err = keeper.ListTrustDeposits(ctx, &types.QueryListTrustDepositsRequest{HasSlashedBalance: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, ids(res))
+
+	res, err = keeper.ListTrustDeposits(ctx, &types.QueryListTrustDepositsRequest{MinDeposit: 500})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, ids(res))
+
+	res, err = keeper.ListTrustDeposits(ctx, &types.QueryListTrustDepositsRequest{ModifiedAfter: &t0})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, ids(res))
+
+	// Pages follow on through the next key
+	res, err = keeper.ListTrustDeposits(ctx, &types.QueryListTrustDepositsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, ids(res))
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = keeper.ListTrustDeposits(ctx, &types.QueryListTrustDepositsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, ids(res))
+	require.Nil(t, res.Pagination.NextKey)
+}
+
+func TestTrustDepositStats(t *testing.T) {
+	keeper, ctx := keepertest.TrustdepositKeeper(t)
+
+	res, err := keeper.TrustDepositStats(ctx, &types.QueryTrustDepositStatsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.TrustDepositCount)
+	require.True(t, res.TotalShare.IsZero())
+
+	for i, td := range []types.TrustDeposit{
+		{Deposit: 100, Share: math.LegacyNewDec(100), SlashedDeposit: 10, BurnedDeposit: 5},
+		{Deposit: 300, Share: math.LegacyNewDec(250), RepaidDeposit: 20, BurnedDeposit: 20},
+	} {
+		td.CorporationId = uint64(i + 1)
+		td.Corporation = sdk.AccAddress([]byte(fmt.Sprintf("stats_td_address_%d", i))).String()
+		require.NoError(t, keeper.SetTrustDeposit(ctx, td))
+	}
+
+	res, err = keeper.TrustDepositStats(ctx, &types.QueryTrustDepositStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.TrustDepositCount)
+	require.Equal(t, uint64(400), res.TotalDeposit)
+	require.Equal(t, math.LegacyNewDec(350), res.TotalShare)
+	require.Equal(t, keeper.GetTrustDepositShareValue(ctx), res.ShareValue)
+	require.Equal(t, uint64(10), res.TotalSlashedDeposit)
+	require.Equal(t, uint64(20), res.TotalRepaidDeposit)
+	require.Equal(t, uint64(25), res.TotalBurnedDeposit)
+	require.Equal(t, types.BondDenom, res.ModuleBalance.Denom)
+}
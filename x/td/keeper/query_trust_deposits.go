@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func (k Keeper) ListTrustDeposits(goCtx context.Context, req *types.QueryListTrustDepositsRequest) (*types.QueryListTrustDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	trustDeposits, pageRes, err := query.CollectionFilteredPaginate(ctx, k.TrustDeposit, req.Pagination,
+		func(_ uint64, td types.TrustDeposit) (bool, error) {
+			if req.HasSlashedBalance && td.SlashedDeposit == 0 {
+				return false, nil
+			}
+			if td.Deposit < req.MinDeposit {
+				return false, nil
+			}
+			if req.ModifiedAfter != nil && (td.Modified == nil || !td.Modified.After(*req.ModifiedAfter)) {
+				return false, nil
+			}
+			return true, nil
+		},
+		func(_ uint64, td types.TrustDeposit) (types.TrustDeposit, error) {
+			return td, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListTrustDepositsResponse{
+		TrustDeposits: trustDeposits,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (k Keeper) TrustDepositStats(goCtx context.Context, req *types.QueryTrustDepositStatsRequest) (*types.QueryTrustDepositStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := &types.QueryTrustDepositStatsResponse{
+		TotalShare: math.LegacyZeroDec(),
+		ShareValue: k.GetTrustDepositShareValue(ctx),
+	}
+
+	err := k.TrustDeposit.Walk(ctx, nil, func(_ uint64, td types.TrustDeposit) (bool, error) {
+		res.TrustDepositCount++
+		res.TotalDeposit += td.Deposit
+		res.TotalShare = res.TotalShare.Add(td.Share)
+		res.TotalSlashedDeposit += td.SlashedDeposit
+		res.TotalRepaidDeposit += td.RepaidDeposit
+		res.TotalBurnedDeposit += td.BurnedDeposit
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res.ModuleBalance = k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), types.BondDenom)
+
+	return res, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func (k Keeper) ListUnbondingTrustDeposits(goCtx context.Context, req *types.QueryListUnbondingTrustDepositsRequest) (*types.QueryListUnbondingTrustDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unbondings, pageRes, err := query.CollectionFilteredPaginate(ctx, k.UnbondingTrustDepositQueue, req.Pagination,
+		func(key collections.Pair[time.Time, uint64], _ collections.NoValue) (bool, error) {
+			if req.CorporationId == 0 {
+				return true, nil
+			}
+			return k.UnbondingTrustDepositByCorporation.Has(ctx, collections.Join(req.CorporationId, key.K2()))
+		},
+		func(key collections.Pair[time.Time, uint64], _ collections.NoValue) (types.UnbondingTrustDeposit, error) {
+			return k.UnbondingTrustDeposit.Get(ctx, key.K2())
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListUnbondingTrustDepositsResponse{
+		UnbondingTrustDeposits: unbondings,
+		Pagination:             pageRes,
+	}, nil
+}
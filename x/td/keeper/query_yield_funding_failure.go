@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func (k Keeper) GetYieldFundingFailure(ctx context.Context, req *types.QueryGetYieldFundingFailureRequest) (*types.QueryGetYieldFundingFailureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	failure, err := k.YieldFundingFailure.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return &types.QueryGetYieldFundingFailureResponse{}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetYieldFundingFailureResponse{YieldFundingFailure: &failure}, nil
+}
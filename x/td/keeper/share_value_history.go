@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// checkpointShareValue records the current share value if the last
+// checkpoint is at least share_value_checkpoint_interval_seconds old, then
+// prunes the checkpoints older than share_value_history_retention_days.
+func (k Keeper) checkpointShareValue(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.ShareValueCheckpointIntervalSeconds == 0 {
+		return nil
+	}
+
+	now := ctx.BlockTime()
+
+	last, err := k.lastShareValueCheckpoint(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && now.Sub(last.Timestamp) < time.Duration(params.ShareValueCheckpointIntervalSeconds)*time.Second {
+		return nil
+	}
+
+	if err := k.ShareValueCheckpoint.Set(ctx, now.Unix(), types.ShareValueCheckpoint{
+		Timestamp:   now,
+		BlockHeight: ctx.BlockHeight(),
+		ShareValue:  params.TrustDepositShareValue,
+	}); err != nil {
+		return fmt.Errorf("failed to set share value checkpoint: %w", err)
+	}
+
+	cutoff := now.AddDate(0, 0, -int(params.ShareValueHistoryRetentionDays)).Unix()
+	rng := new(collections.Range[int64]).EndExclusive(cutoff)
+	if err := k.ShareValueCheckpoint.Clear(ctx, rng); err != nil {
+		return fmt.Errorf("failed to prune share value checkpoints: %w", err)
+	}
+
+	return nil
+}
+
+// lastShareValueCheckpoint returns the most recent checkpoint, or
+// collections.ErrNotFound if there is none.
+func (k Keeper) lastShareValueCheckpoint(ctx sdk.Context) (types.ShareValueCheckpoint, error) {
+	rng := new(collections.Range[int64]).Descending()
+	return k.firstShareValueCheckpoint(ctx, rng)
+}
+
+// shareValueCheckpointAt returns the closest checkpoint at or before t, or the
+// oldest checkpoint if the history starts after t. It returns
+// collections.ErrNotFound if there is no checkpoint at all.
+func (k Keeper) shareValueCheckpointAt(ctx sdk.Context, t time.Time) (types.ShareValueCheckpoint, error) {
+	checkpoint, err := k.firstShareValueCheckpoint(ctx, new(collections.Range[int64]).EndInclusive(t.Unix()).Descending())
+	if !errors.Is(err, collections.ErrNotFound) {
+		return checkpoint, err
+	}
+	return k.firstShareValueCheckpoint(ctx, new(collections.Range[int64]).StartInclusive(t.Unix()))
+}
+
+func (k Keeper) firstShareValueCheckpoint(ctx sdk.Context, rng *collections.Range[int64]) (types.ShareValueCheckpoint, error) {
+	iter, err := k.ShareValueCheckpoint.Iterate(ctx, rng)
+	if err != nil {
+		return types.ShareValueCheckpoint{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.ShareValueCheckpoint{}, collections.ErrNotFound
+	}
+	return iter.Value()
+}
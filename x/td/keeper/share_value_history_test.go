@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/verana-labs/verana/testutil/keeper"
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func TestShareValueHistory(t *testing.T) {
+	k, ctx := keepertest.TrustdepositKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.ShareValueHistoryRetentionDays = 30
+	require.NoError(t, k.SetParams(ctx, params))
+
+	setShareValue := func(v string) {
+		params := k.GetParams(ctx)
+		params.TrustDepositShareValue = math.LegacyMustNewDecFromStr(v)
+		require.NoError(t, k.SetParams(ctx, params))
+	}
+
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// No history yet
+	_, err := k.RealizedApr(ctx, &types.QueryRealizedAprRequest{From: t0})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// First block records a checkpoint, the next one within the interval does not
+	ctx = ctx.WithBlockTime(t0).WithBlockHeight(1)
+	require.NoError(t, k.BeginBlocker(ctx))
+	ctx = ctx.WithBlockTime(t0.Add(time.Hour)).WithBlockHeight(2)
+	setShareValue("1.01")
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	res, err := k.ListShareValueCheckpoints(ctx, &types.QueryListShareValueCheckpointsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Checkpoints, 1)
+	require.Equal(t, int64(1), res.Checkpoints[0].BlockHeight)
+	require.Equal(t, math.LegacyOneDec(), res.Checkpoints[0].ShareValue)
+
+	// A day later the share value is sampled again
+	t1 := t0.Add(24 * time.Hour)
+	ctx = ctx.WithBlockTime(t1).WithBlockHeight(3)
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	res, err = k.ListShareValueCheckpoints(ctx, &types.QueryListShareValueCheckpointsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Checkpoints, 2)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.01"), res.Checkpoints[1].ShareValue)
+
+	// 1% over a day annualizes to 365%
+	apr, err := k.RealizedApr(ctx, &types.QueryRealizedAprRequest{From: t0, To: &t1})
+	require.NoError(t, err)
+	require.Equal(t, t0, apr.Start.Timestamp)
+	require.Equal(t, t1, apr.End.Timestamp)
+	require.Equal(t, math.LegacyMustNewDecFromStr("3.65"), apr.Apr)
+
+	// A from before the history starts uses the oldest checkpoint, no to uses
+	// the current share value
+	setShareValue("1.02")
+	apr, err = k.RealizedApr(ctx, &types.QueryRealizedAprRequest{From: t0.Add(-time.Hour)})
+	require.NoError(t, err)
+	require.Equal(t, t0, apr.Start.Timestamp)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.02"), apr.End.ShareValue)
+	require.Equal(t, math.LegacyMustNewDecFromStr("7.3"), apr.Apr)
+
+	to := t0.Add(-time.Minute)
+	_, err = k.RealizedApr(ctx, &types.QueryRealizedAprRequest{From: t0, To: &to})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Accrued yield of a corporation since the first checkpoint
+	require.NoError(t, k.SetTrustDeposit(ctx, types.TrustDeposit{
+		Corporation:   "verana1corporation",
+		CorporationId: 7,
+		Share:         math.LegacyNewDec(1000),
+		Deposit:       1000,
+	}))
+	yield, err := k.AccruedYield(ctx, &types.QueryAccruedYieldRequest{CorporationId: 7, Since: t0})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(20), yield.AccruedYield)
+
+	yield, err = k.AccruedYield(ctx, &types.QueryAccruedYieldRequest{CorporationId: 7, Since: t1.Add(time.Hour)})
+	require.NoError(t, err)
+	require.Equal(t, t1, yield.Start.Timestamp)
+	require.Equal(t, math.LegacyNewDec(10), yield.AccruedYield)
+
+	_, err = k.AccruedYield(ctx, &types.QueryAccruedYieldRequest{CorporationId: 8, Since: t0})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Checkpoints older than the retention are pruned
+	t2 := t0.AddDate(0, 0, 30).Add(time.Second)
+	ctx = ctx.WithBlockTime(t2).WithBlockHeight(4)
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	res, err = k.ListShareValueCheckpoints(ctx, &types.QueryListShareValueCheckpointsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Checkpoints, 2)
+	require.Equal(t, t1, res.Checkpoints[0].Timestamp)
+	require.Equal(t, t2, res.Checkpoints[1].Timestamp)
+
+	// Disabled history records nothing
+	params = k.GetParams(ctx)
+	params.ShareValueCheckpointIntervalSeconds = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockTime(t2.AddDate(0, 0, 2)).WithBlockHeight(5)
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	res, err = k.ListShareValueCheckpoints(ctx, &types.QueryListShareValueCheckpointsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Checkpoints, 2)
+}
@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// MaxMaturedUnbondingTrustDepositsPerBlock bounds the number of unbonding
+// entries moved to claimable in a single EndBlocker run.
+const MaxMaturedUnbondingTrustDepositsPerBlock = 100
+
+// startUnbonding queues amount of td for release after unbondingDays and
+// adds it to td.Unbonding. The caller saves td.
+func (k Keeper) startUnbonding(ctx sdk.Context, td *types.TrustDeposit, amount uint64, unbondingDays uint64, reason string) (types.UnbondingTrustDeposit, error) {
+	id, err := k.getNextUnbondingTrustDepositID(ctx)
+	if err != nil {
+		return types.UnbondingTrustDeposit{}, err
+	}
+
+	now := ctx.BlockTime()
+	unbonding := types.UnbondingTrustDeposit{
+		Id:             id,
+		CorporationId:  td.CorporationId,
+		Amount:         amount,
+		Created:        now,
+		CompletionTime: now.AddDate(0, 0, int(unbondingDays)),
+		Reason:         reason,
+	}
+	if err := k.setUnbondingTrustDeposit(ctx, unbonding); err != nil {
+		return types.UnbondingTrustDeposit{}, err
+	}
+
+	td.Unbonding += amount
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbondTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyUnbondingID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(td.CorporationId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, unbonding.CompletionTime.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return unbonding, nil
+}
+
+// getNextUnbondingTrustDepositID gets the next available unbonding ID
+func (k Keeper) getNextUnbondingTrustDepositID(ctx sdk.Context) (uint64, error) {
+	id, err := k.UnbondingTrustDepositCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	nextID := id + 1
+	if err := k.UnbondingTrustDepositCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set unbonding trust deposit counter: %w", err)
+	}
+
+	return nextID, nil
+}
+
+// setUnbondingTrustDeposit stores unbonding and its queue and corporation
+// indexes.
+func (k Keeper) setUnbondingTrustDeposit(ctx sdk.Context, unbonding types.UnbondingTrustDeposit) error {
+	if err := k.UnbondingTrustDeposit.Set(ctx, unbonding.Id, unbonding); err != nil {
+		return err
+	}
+	if err := k.UnbondingTrustDepositQueue.Set(ctx, collections.Join(unbonding.CompletionTime, unbonding.Id)); err != nil {
+		return err
+	}
+	return k.UnbondingTrustDepositByCorporation.Set(ctx, collections.Join(unbonding.CorporationId, unbonding.Id))
+}
+
+// ImportUnbondingTrustDeposit stores an unbonding entry read from genesis.
+func (k Keeper) ImportUnbondingTrustDeposit(ctx sdk.Context, unbonding types.UnbondingTrustDeposit) error {
+	return k.setUnbondingTrustDeposit(ctx, unbonding)
+}
+
+// removeUnbondingTrustDeposit deletes unbonding and its indexes.
+func (k Keeper) removeUnbondingTrustDeposit(ctx sdk.Context, unbonding types.UnbondingTrustDeposit) error {
+	if err := k.UnbondingTrustDeposit.Remove(ctx, unbonding.Id); err != nil {
+		return err
+	}
+	if err := k.UnbondingTrustDepositQueue.Remove(ctx, collections.Join(unbonding.CompletionTime, unbonding.Id)); err != nil {
+		return err
+	}
+	return k.UnbondingTrustDepositByCorporation.Remove(ctx, collections.Join(unbonding.CorporationId, unbonding.Id))
+}
+
+// slashUnbonding keeps td.Unbonding within a deposit reduced by a slash. The
+// slash is taken from the bonded part first; what exceeds it is taken from
+// the unbonding entries, most recent first. The caller saves td.
+func (k Keeper) slashUnbonding(ctx sdk.Context, td *types.TrustDeposit) error {
+	if td.Unbonding == 0 || td.Claimable+td.Unbonding <= td.Deposit {
+		return nil
+	}
+
+	excess := td.Claimable + td.Unbonding - td.Deposit
+	if excess > td.Unbonding {
+		excess = td.Unbonding
+	}
+	return k.takeUnbonding(ctx, td, excess)
+}
+
+// GetUnbondingTrustDeposit returns the amount of the trust deposit of
+// corporationID released but not matured yet, 0 without trust deposit.
+func (k Keeper) GetUnbondingTrustDeposit(ctx sdk.Context, corporationID uint64) (uint64, error) {
+	td, err := k.TrustDeposit.Get(ctx, corporationID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return td.Unbonding, nil
+}
+
+// HoldUnbondingTrustDeposit takes amount back from the unbonding entries of
+// corporationID, most recent first, so that it stays in the bonded deposit
+// until a pending slash is confirmed or reversed.
+func (k Keeper) HoldUnbondingTrustDeposit(ctx sdk.Context, corporationID uint64, amount uint64) error {
+	td, err := k.TrustDeposit.Get(ctx, corporationID)
+	if err != nil {
+		return fmt.Errorf("trust deposit entry not found for corporation %d: %w", corporationID, err)
+	}
+	if amount > td.Unbonding {
+		return fmt.Errorf("amount exceeds unbonding deposit: %d > %d", amount, td.Unbonding)
+	}
+	if err := k.takeUnbonding(ctx, &td, amount); err != nil {
+		return err
+	}
+	return k.SetTrustDeposit(ctx, td)
+}
+
+// takeUnbonding removes amount from the unbonding entries of td, most recent
+// first. The caller saves td.
+func (k Keeper) takeUnbonding(ctx sdk.Context, td *types.TrustDeposit, amount uint64) error {
+	excess := amount
+
+	// Collect first, the index cannot be mutated while it is iterated
+	var ids []uint64
+	rng := collections.NewPrefixedPairRange[uint64, uint64](td.CorporationId).Descending()
+	if err := k.UnbondingTrustDepositByCorporation.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if excess == 0 {
+			break
+		}
+
+		unbonding, err := k.UnbondingTrustDeposit.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		slashed := unbonding.Amount
+		if slashed > excess {
+			slashed = excess
+		}
+		unbonding.Amount -= slashed
+		td.Unbonding -= slashed
+		excess -= slashed
+
+		if unbonding.Amount == 0 {
+			err = k.removeUnbondingTrustDeposit(ctx, unbonding)
+		} else {
+			err = k.UnbondingTrustDeposit.Set(ctx, id, unbonding)
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashUnbondingTrustDeposit,
+				sdk.NewAttribute(types.AttributeKeyUnbondingID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(td.CorporationId, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(slashed, 10)),
+				sdk.NewAttribute(types.AttributeKeyNewAmount, strconv.FormatUint(unbonding.Amount, 10)),
+				sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// matureUnbondingTrustDeposits moves up to limit unbonding entries completed
+// at or before now to the claimable amount of their trust deposit. Each entry
+// is matured in its own cache context: a failure, e.g. an entry that exceeds
+// the unbonding amount of its trust deposit, is logged and reported by a
+// mature_unbonding_trust_deposit_failed event, and the entry leaves the queue
+// so that it does not halt the chain nor block the following entries. The
+// entry itself is kept for inspection.
+func (k Keeper) matureUnbondingTrustDeposits(ctx sdk.Context, now time.Time, limit int) error {
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(now, uint64(math.MaxUint64)))
+
+	// Collect first, the index cannot be mutated while it is iterated
+	var keys []collections.Pair[time.Time, uint64]
+	if err := k.UnbondingTrustDepositQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return len(keys) >= limit, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.UnbondingTrustDepositQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.matureUnbondingTrustDeposit(cacheCtx, key.K2(), now); err != nil {
+			k.Logger().Error("failed to mature unbonding trust deposit", "unbonding_id", key.K2(), "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMatureUnbondingTrustDepositFailed,
+					sdk.NewAttribute(types.AttributeKeyUnbondingID, strconv.FormatUint(key.K2(), 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// matureUnbondingTrustDeposit moves unbonding entry id to the claimable
+// amount of its trust deposit.
+func (k Keeper) matureUnbondingTrustDeposit(ctx sdk.Context, id uint64, now time.Time) error {
+	unbonding, err := k.UnbondingTrustDeposit.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	td, err := k.TrustDeposit.Get(ctx, unbonding.CorporationId)
+	if err != nil {
+		return fmt.Errorf("trust deposit not found for unbonding %d: %w", id, err)
+	}
+	if unbonding.Amount > td.Unbonding {
+		return fmt.Errorf("unbonding %d amount exceeds unbonding trust deposit: %d > %d", id, unbonding.Amount, td.Unbonding)
+	}
+	if err := k.removeUnbondingTrustDeposit(ctx, unbonding); err != nil {
+		return err
+	}
+	td.Unbonding -= unbonding.Amount
+	td.Claimable += unbonding.Amount
+
+	if err := k.SetTrustDeposit(ctx, td); err != nil {
+		return fmt.Errorf("failed to save trust deposit: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMatureUnbondingTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyUnbondingID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCorporationID, strconv.FormatUint(td.CorporationId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(unbonding.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewClaimable, strconv.FormatUint(td.Claimable, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return nil
+}
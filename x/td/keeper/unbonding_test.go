@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func TestUnbondingTrustDeposit(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx = sdkCtx.WithBlockTime(t0)
+
+	params := k.GetParams(sdkCtx)
+	params.TrustDepositUnbondingDays = 10
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	account := sdk.AccAddress([]byte("unbonding_account___")).String()
+	corpID := mustCorporationID(t, k, sdkCtx, account)
+	other := sdk.AccAddress([]byte("unbonding_other_____")).String()
+	otherID := mustCorporationID(t, k, sdkCtx, other)
+
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, 1000, "start"))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, otherID, 500, "start"))
+
+	// A release is queued instead of becoming claimable
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, -300, "participant ended"))
+	sdkCtx = sdkCtx.WithBlockTime(t0.Add(24 * time.Hour))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, -200, "op cancelled"))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, otherID, -100, "participant ended"))
+
+	td, err := k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), td.Deposit)
+	require.Equal(t, uint64(0), td.Claimable)
+	require.Equal(t, uint64(500), td.Unbonding)
+
+	// Unbonding amounts count against the releasable deposit
+	err = k.AdjustTrustDeposit(sdkCtx, corpID, -600, "too much")
+	require.ErrorContains(t, err, "claimable after adjustment would exceed deposit")
+
+	res, err := k.ListUnbondingTrustDeposits(sdkCtx, &types.QueryListUnbondingTrustDepositsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.UnbondingTrustDeposits, 3)
+	require.Equal(t, uint64(300), res.UnbondingTrustDeposits[0].Amount)
+	require.Equal(t, t0.AddDate(0, 0, 10), res.UnbondingTrustDeposits[0].CompletionTime)
+	require.Equal(t, "participant ended", res.UnbondingTrustDeposits[0].Reason)
+
+	res, err = k.ListUnbondingTrustDeposits(sdkCtx, &types.QueryListUnbondingTrustDepositsRequest{CorporationId: corpID})
+	require.NoError(t, err)
+	require.Len(t, res.UnbondingTrustDeposits, 2)
+
+	// Nothing is reclaimable while unbonding
+	_, err = ms.ReclaimTrustDepositYield(sdkCtx, &types.MsgReclaimTrustDepositYield{Corporation: account, Operator: account})
+	require.ErrorContains(t, err, "no claimable yield")
+
+	// A slash beyond the bonded 500 reaches the most recent unbonding entry
+	_, err = ms.SlashTrustDeposit(sdkCtx, &types.MsgSlashTrustDeposit{
+		Authority:   k.GetAuthority(),
+		Corporation: account,
+		Deposit:     math.NewInt(650),
+		Reason:      "misbehaviour",
+	})
+	require.NoError(t, err)
+
+	td, err = k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(350), td.Deposit)
+	require.Equal(t, uint64(350), td.Unbonding)
+
+	res, err = k.ListUnbondingTrustDeposits(sdkCtx, &types.QueryListUnbondingTrustDepositsRequest{CorporationId: corpID})
+	require.NoError(t, err)
+	require.Len(t, res.UnbondingTrustDeposits, 2)
+	require.Equal(t, uint64(300), res.UnbondingTrustDeposits[0].Amount)
+	require.Equal(t, uint64(50), res.UnbondingTrustDeposits[1].Amount)
+
+	// An ecosystem slash of the whole deposit drains the queue
+	require.NoError(t, k.BurnEcosystemSlashedTrustDeposit(sdkCtx, 0, otherID, 500))
+	td, err = k.TrustDeposit.Get(sdkCtx, otherID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), td.Unbonding)
+	res, err = k.ListUnbondingTrustDeposits(sdkCtx, &types.QueryListUnbondingTrustDepositsRequest{CorporationId: otherID})
+	require.NoError(t, err)
+	require.Empty(t, res.UnbondingTrustDeposits)
+
+	// The EndBlocker matures the first entry only once it completes
+	sdkCtx = sdkCtx.WithBlockTime(t0.AddDate(0, 0, 10).Add(-time.Second))
+	require.NoError(t, k.EndBlocker(sdkCtx))
+	td, err = k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), td.Claimable)
+
+	sdkCtx = sdkCtx.WithBlockTime(t0.AddDate(0, 0, 10))
+	require.NoError(t, k.EndBlocker(sdkCtx))
+	td, err = k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), td.Claimable)
+	require.Equal(t, uint64(50), td.Unbonding)
+
+	sdkCtx = sdkCtx.WithBlockTime(t0.AddDate(0, 0, 11))
+	require.NoError(t, k.EndBlocker(sdkCtx))
+	td, err = k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(350), td.Claimable)
+	require.Equal(t, uint64(0), td.Unbonding)
+
+	res, err = k.ListUnbondingTrustDeposits(sdkCtx, &types.QueryListUnbondingTrustDepositsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.UnbondingTrustDeposits)
+
+	// Without an unbonding period a release is claimable at once
+	params.TrustDepositUnbondingDays = 0
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, otherID, 100, "restart"))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, otherID, -100, "participant ended"))
+	td, err = k.TrustDeposit.Get(sdkCtx, otherID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), td.Claimable)
+	require.Equal(t, uint64(0), td.Unbonding)
+}
+
+func TestHoldUnbondingTrustDeposit(t *testing.T) {
+	k, _, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	params := k.GetParams(sdkCtx)
+	params.TrustDepositUnbondingDays = 10
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	account := sdk.AccAddress([]byte("hold_account________")).String()
+	corpID := mustCorporationID(t, k, sdkCtx, account)
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, 1000, "start"))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, -1000, "participant revoked"))
+
+	unbonding, err := k.GetUnbondingTrustDeposit(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), unbonding)
+
+	require.ErrorContains(t, k.HoldUnbondingTrustDeposit(sdkCtx, corpID, 1001), "amount exceeds unbonding deposit")
+
+	// The held amount is bonded again and can be burned by the slash
+	require.NoError(t, k.HoldUnbondingTrustDeposit(sdkCtx, corpID, 400))
+	td, err := k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), td.Deposit)
+	require.Equal(t, uint64(600), td.Unbonding)
+
+	require.NoError(t, k.BurnEcosystemSlashedTrustDeposit(sdkCtx, 0, corpID, 400))
+	td, err = k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(600), td.Deposit)
+	require.Equal(t, uint64(600), td.Unbonding)
+
+	unbonding, err = k.GetUnbondingTrustDeposit(sdkCtx, 99)
+	require.NoError(t, err)
+	require.Zero(t, unbonding)
+}
+
+func TestMatureUnbondingTrustDepositFailure(t *testing.T) {
+	k, _, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx = sdkCtx.WithBlockTime(t0)
+
+	params := k.GetParams(sdkCtx)
+	params.TrustDepositUnbondingDays = 10
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	account := sdk.AccAddress([]byte("unbonding_account___")).String()
+	corpID := mustCorporationID(t, k, sdkCtx, account)
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, 1000, "start"))
+	require.NoError(t, k.AdjustTrustDeposit(sdkCtx, corpID, -300, "participant ended"))
+
+	// An entry completing before it exceeds the unbonding trust deposit
+	bad := types.UnbondingTrustDeposit{
+		Id:             99,
+		CorporationId:  corpID,
+		Amount:         5000,
+		Created:        t0,
+		CompletionTime: t0.AddDate(0, 0, 5),
+	}
+	require.NoError(t, k.ImportUnbondingTrustDeposit(sdkCtx, bad))
+
+	blockCtx := sdkCtx.WithBlockTime(t0.AddDate(0, 0, 10)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(blockCtx))
+
+	// The valid entry matures
+	td, err := k.TrustDeposit.Get(sdkCtx, corpID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), td.Claimable)
+	require.Equal(t, uint64(0), td.Unbonding)
+
+	var failed []string
+	for _, ev := range blockCtx.EventManager().Events() {
+		if ev.Type != types.EventTypeMatureUnbondingTrustDepositFailed {
+			continue
+		}
+		id, ok := ev.GetAttribute(types.AttributeKeyUnbondingID)
+		require.True(t, ok)
+		failed = append(failed, id.Value)
+	}
+	require.Equal(t, []string{"99"}, failed)
+
+	// The failed entry leaves the queue but is kept
+	has, err := k.UnbondingTrustDepositQueue.Has(sdkCtx, collections.Join(bad.CompletionTime, bad.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = k.UnbondingTrustDeposit.Get(sdkCtx, bad.Id)
+	require.NoError(t, err)
+}
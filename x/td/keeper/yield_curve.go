@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// effectiveYieldRate returns the annual yield rate distributed on
+// trustDeposit and the deposit ratio it was evaluated at. Without yield
+// curve the rate is trust_deposit_max_yield_rate and the ratio is zero.
+func (k Keeper) effectiveYieldRate(ctx sdk.Context, params types.Params, trustDeposit math.Int) (math.LegacyDec, math.LegacyDec, error) {
+	if !params.YieldCurveEnabled() {
+		return params.TrustDepositMaxYieldRate, math.LegacyZeroDec(), nil
+	}
+
+	var basis math.Int
+	switch params.YieldCurveRatioBasis {
+	case types.YieldCurveRatioBasisTotalSupply:
+		basis = k.bankKeeper.GetSupply(ctx, types.BondDenom).Amount
+	default:
+		if k.stakingKeeper == nil {
+			return math.LegacyDec{}, math.LegacyDec{}, fmt.Errorf("staking keeper is required for the bonded stake yield curve")
+		}
+		bonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+		if err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, fmt.Errorf("failed to get bonded tokens: %w", err)
+		}
+		basis = bonded
+	}
+
+	// nothing to compare the deposit to: pay the max rate to attract deposits
+	ratio := math.LegacyZeroDec()
+	if basis.IsPositive() {
+		ratio = math.LegacyNewDecFromInt(trustDeposit).QuoInt(basis)
+	}
+	return params.YieldCurveRate(ratio), ratio, nil
+}
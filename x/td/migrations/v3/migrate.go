@@ -0,0 +1,129 @@
+package v3
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// Keeper defines the interface required for migration.
+// This interface allows the migration to work without importing the keeper package,
+// breaking the cyclic dependency.
+type Keeper interface {
+	// GetStoreService returns the store service to access raw store
+	GetStoreService() store.KVStoreService
+	// GetCodec returns the codec for encoding/decoding
+	GetCodec() codec.BinaryCodec
+	// GetLogger returns the logger
+	GetLogger() interface {
+		Info(msg string, keyvals ...interface{})
+	}
+	// ResolveCorporationID resolves a corporation account to its corporation_id
+	ResolveCorporationID(ctx context.Context, account string) (uint64, error)
+	// SetTrustDeposit stores a trust deposit under its corporation_id
+	SetTrustDeposit(ctx context.Context, td types.TrustDeposit) error
+	// SetOrphanedTrustDeposit stores a trust deposit resolving to no corporation
+	SetOrphanedTrustDeposit(ctx context.Context, td types.TrustDeposit) error
+}
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+// The migration re-keys TrustDeposit from the corporation account to the
+// corporation_id.
+//
+// Strategy:
+// 1. Iterate over raw KV store with the legacy trust_deposit prefix
+// 2. For each entry, resolve the corporation account to its corporation_id
+// 3. Write the entry under the corporation_id, indexed by account
+// 4. Delete the legacy entry
+//
+// Entries whose account is not the policy_address of a registered
+// Corporation are moved to the orphaned trust deposit store, keyed by
+// account, and reported by an orphan_trust_deposit event. Their funds stay in
+// the module account.
+//
+// App Hash Safety:
+// - Uses deterministic encoding via valueCodec
+// - Iteration order is deterministic (sorted by key)
+// - Entries are collected before the store is written to
+func MigrateStore(ctx sdk.Context, k Keeper) error {
+	logger := k.GetLogger()
+	logger.Info("Starting migration: re-keying TrustDeposit by corporation_id")
+
+	kvStore := k.GetStoreService().OpenKVStore(ctx)
+	valueCodec := codec.CollValue[types.TrustDeposit](k.GetCodec())
+
+	// The legacy trust_deposit map uses prefix 1 with the account as key
+	prefix := types.LegacyTrustDepositKey.Bytes()
+	endPrefix := make([]byte, len(prefix))
+	copy(endPrefix, prefix)
+	endPrefix[len(endPrefix)-1]++
+
+	type legacyEntry struct {
+		key []byte
+		td  types.TrustDeposit
+	}
+	var entries []legacyEntry
+
+	iterator, err := kvStore.Iterator(prefix, endPrefix)
+	if err != nil {
+		return err
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		td, err := valueCodec.Decode(iterator.Value())
+		if err != nil {
+			logger.Info("Failed to unmarshal trust deposit, skipping", "key", iterator.Key(), "error", err)
+			continue
+		}
+		// The account is the key, the record field is the same value
+		td.Corporation = string(iterator.Key()[len(prefix):])
+		entries = append(entries, legacyEntry{key: append([]byte(nil), iterator.Key()...), td: td})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	migratedCount := 0
+	orphanedCount := 0
+
+	for _, entry := range entries {
+		corporationID, err := k.ResolveCorporationID(ctx, entry.td.Corporation)
+		if err != nil {
+			logger.Info("Trust deposit account is not a registered corporation, orphaned", "account", entry.td.Corporation, "error", err)
+			if err := k.SetOrphanedTrustDeposit(ctx, entry.td); err != nil {
+				return err
+			}
+			if err := kvStore.Delete(entry.key); err != nil {
+				return err
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeOrphanTrustDeposit,
+					sdk.NewAttribute(types.AttributeKeyAccount, entry.td.Corporation),
+					sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(entry.td.Deposit, 10)),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+			orphanedCount++
+			continue
+		}
+
+		entry.td.CorporationId = corporationID
+		if err := k.SetTrustDeposit(ctx, entry.td); err != nil {
+			return err
+		}
+		if err := kvStore.Delete(entry.key); err != nil {
+			return err
+		}
+
+		migratedCount++
+		logger.Info("Migrated trust deposit", "account", entry.td.Corporation, "corporation_id", corporationID)
+	}
+
+	logger.Info("Migration completed", "migrated_count", migratedCount, "orphaned_count", orphanedCount)
+	return nil
+}
@@ -0,0 +1,33 @@
+package v4
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+// Keeper defines the interface required for migration.
+// This interface allows the migration to work without importing the keeper package,
+// breaking the cyclic dependency.
+type Keeper interface {
+	GetParams(ctx context.Context) types.Params
+	SetParams(ctx context.Context, params types.Params) error
+}
+
+// MigrateStore performs in-place store migrations from v3 to v4.
+// v4 adds the trust_deposit_unbonding_days, share_value_checkpoint_interval_seconds,
+// share_value_history_retention_days and compensation_pool_share params, which
+// decode as 0 or unset from v3 params and would leave unbonding and the share
+// value history disabled; they are set to their defaults.
+// compensation_pool_share is set to 0 instead, so that ecosystem slashes keep
+// being burned in full until governance opts in to the compensation pools.
+func MigrateStore(ctx context.Context, k Keeper) error {
+	params := k.GetParams(ctx)
+	params.TrustDepositUnbondingDays = types.DefaultTrustDepositUnbondingDays
+	params.ShareValueCheckpointIntervalSeconds = types.DefaultShareValueCheckpointIntervalSeconds
+	params.ShareValueHistoryRetentionDays = types.DefaultShareValueHistoryRetentionDays
+	params.CompensationPoolShare = math.LegacyZeroDec()
+	return k.SetParams(ctx, params)
+}
@@ -28,6 +28,83 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "ListTrustDeposits",
+					Use:       "list-trust-deposits",
+					Short:     "List trust deposits",
+					Long:      "List trust deposits by ascending corporation id, optionally only those with a slashed balance, of at least min-deposit or modified after a time",
+				},
+				{
+					RpcMethod: "TrustDepositStats",
+					Use:       "stats",
+					Short:     "Shows aggregate trust deposit statistics",
+				},
+				{
+					RpcMethod: "GetCorporationTrustDeposit",
+					Use:       "get-corporation-trust-deposit [corporation-id]",
+					Short:     "Query trust deposit for a corporation id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "corporation_id"},
+					},
+				},
+				{
+					RpcMethod: "ListUnbondingTrustDeposits",
+					Use:       "list-unbonding",
+					Short:     "List the released trust deposits that are not claimable yet, optionally of --corporation-id",
+				},
+				{
+					RpcMethod: "ListShareValueCheckpoints",
+					Use:       "share-value-history",
+					Short:     "List the sampled share value history",
+				},
+				{
+					RpcMethod: "RealizedApr",
+					Use:       "realized-apr [from]",
+					Short:     "Query the realized APR since from (RFC3339), up to --to or the current share value",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "from"},
+					},
+				},
+				{
+					RpcMethod: "EffectiveYieldRate",
+					Use:       "effective-yield-rate",
+					Short:     "Query the annual yield rate currently distributed to trust deposits",
+				},
+				{
+					RpcMethod: "AccruedYield",
+					Use:       "accrued-yield [corporation-id] [since]",
+					Short:     "Query the approximate yield accrued by a corporation's trust deposit since a time (RFC3339)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "corporation_id"},
+						{ProtoField: "since"},
+					},
+				},
+				{
+					RpcMethod: "GetYieldFundingFailure",
+					Use:       "yield-funding-failure",
+					Short:     "Query the last yield funding step that failed, if any",
+				},
+				{
+					RpcMethod: "GetCompensationPool",
+					Use:       "get-compensation-pool [ecosystem-id]",
+					Short:     "Query the compensation pool of an ecosystem",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "ecosystem_id"},
+					},
+				},
+				{
+					RpcMethod: "GetCompensationClaim",
+					Use:       "get-compensation-claim [id]",
+					Short:     "Query a compensation claim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ListCompensationClaims",
+					Use:       "list-compensation-claims",
+					Short:     "List compensation claims, optionally of --ecosystem-id and --status",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -58,6 +135,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "deposit"},
 					},
 				},
+				{
+					RpcMethod: "DepositTrust",
+					Use:       "deposit [corporation] [amount]",
+					Short:     "Deposit into a corporation's trust deposit",
+					Long:      "Voluntarily increase the trust deposit of a corporation with funds from the corporation account, to pre-fund operations or over-collateralize.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "corporation"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "WithdrawClaimable",
+					Use:       "withdraw-claimable [corporation] [amount]",
+					Short:     "Withdraw part of the claimable trust deposit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "corporation"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "FileCompensationClaim",
+					Use:       "file-compensation-claim [ecosystem-id] [amount] [evidence-digest] [reason]",
+					Short:     "Claim compensation from the compensation pool of an ecosystem",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "ecosystem_id"},
+						{ProtoField: "amount"},
+						{ProtoField: "evidence_digest"},
+						{ProtoField: "reason"},
+					},
+				},
+				{
+					RpcMethod: "ResolveCompensationClaim",
+					Skip:      true, // skipped because submitted through a group proposal of the ecosystem corporation
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -27,15 +27,72 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, td := range genState.TrustDeposits {
 		// Create trust deposit entry
 		trustDeposit := types.TrustDeposit{
-			Corporation: td.Corporation,
-			Share:       td.Share,
-			Deposit:     td.Deposit,
-			Claimable:   td.Claimable,
+			Corporation:   td.Corporation,
+			CorporationId: td.CorporationId,
+			Share:         td.Share,
+			Deposit:       td.Deposit,
+			Claimable:     td.Claimable,
+			Unbonding:     td.Unbonding,
 		}
 
 		// Store the trust deposit
-		if err := k.TrustDeposit.Set(ctx, td.Corporation, trustDeposit); err != nil {
-			panic(fmt.Sprintf("failed to set trust deposit for corporation %s: %s", td.Corporation, err))
+		if err := k.SetTrustDeposit(ctx, trustDeposit); err != nil {
+			panic(fmt.Sprintf("failed to set trust deposit for corporation %d: %s", td.CorporationId, err))
+		}
+	}
+
+	// Initialize the share value history
+	for _, checkpoint := range genState.ShareValueCheckpoints {
+		if err := k.ShareValueCheckpoint.Set(ctx, checkpoint.Timestamp.Unix(), checkpoint); err != nil {
+			panic(fmt.Sprintf("failed to set share value checkpoint: %s", err))
+		}
+	}
+
+	// Initialize the unbonding queue, the counter resumes after the highest id
+	var maxUnbondingID uint64
+	for _, unbonding := range genState.UnbondingTrustDeposits {
+		if err := k.ImportUnbondingTrustDeposit(ctx, unbonding); err != nil {
+			panic(fmt.Sprintf("failed to set unbonding trust deposit %d: %s", unbonding.Id, err))
+		}
+		maxUnbondingID = max(maxUnbondingID, unbonding.Id)
+	}
+	if maxUnbondingID > 0 {
+		if err := k.UnbondingTrustDepositCounter.Set(ctx, maxUnbondingID); err != nil {
+			panic(fmt.Sprintf("failed to set unbonding trust deposit counter: %s", err))
+		}
+	}
+
+	// Initialize the compensation pools and claims, the claim counter resumes
+	// after the highest id
+	for _, pool := range genState.CompensationPools {
+		if err := k.CompensationPool.Set(ctx, pool.EcosystemId, pool); err != nil {
+			panic(fmt.Sprintf("failed to set compensation pool of ecosystem %d: %s", pool.EcosystemId, err))
+		}
+	}
+	var maxClaimID uint64
+	for _, claim := range genState.CompensationClaims {
+		if err := k.CompensationClaim.Set(ctx, claim.Id, claim); err != nil {
+			panic(fmt.Sprintf("failed to set compensation claim %d: %s", claim.Id, err))
+		}
+		maxClaimID = max(maxClaimID, claim.Id)
+	}
+	if maxClaimID > 0 {
+		if err := k.CompensationClaimCounter.Set(ctx, maxClaimID); err != nil {
+			panic(fmt.Sprintf("failed to set compensation claim counter: %s", err))
+		}
+	}
+
+	// Initialize the deposits orphaned by the v3 migration
+	for _, td := range genState.OrphanedTrustDeposits {
+		if err := k.SetOrphanedTrustDeposit(ctx, td); err != nil {
+			panic(fmt.Sprintf("failed to set orphaned trust deposit of %s: %s", td.Corporation, err))
+		}
+	}
+
+	// Initialize the last failed yield funding step
+	if genState.YieldFundingFailure != nil {
+		if err := k.YieldFundingFailure.Set(ctx, *genState.YieldFundingFailure); err != nil {
+			panic(fmt.Sprintf("failed to set yield funding failure: %s", err))
 		}
 	}
 }
@@ -49,24 +106,60 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	var trustDeposits []types.TrustDepositRecord
 
 	// Use a callback to gather all trust deposits in deterministic order
-	// The Walk function should iterate over keys in lexicographical order
-	_ = k.TrustDeposit.Walk(ctx, nil, func(key string, value types.TrustDeposit) (bool, error) {
+	// The Walk function iterates over corporation ids in ascending order
+	_ = k.TrustDeposit.Walk(ctx, nil, func(key uint64, value types.TrustDeposit) (bool, error) {
 		trustDeposits = append(trustDeposits, types.TrustDepositRecord{
-			Corporation: value.Corporation,
-			Share:       value.Share,
-			Deposit:     value.Deposit,
-			Claimable:   value.Claimable,
+			Corporation:   value.Corporation,
+			CorporationId: value.CorporationId,
+			Share:         value.Share,
+			Deposit:       value.Deposit,
+			Claimable:     value.Claimable,
+			Unbonding:     value.Unbonding,
 		})
 		return false, nil // Continue iteration
 	})
 
 	genesis.TrustDeposits = trustDeposits
 
+	// Export the share value history, oldest first
+	_ = k.ShareValueCheckpoint.Walk(ctx, nil, func(_ int64, checkpoint types.ShareValueCheckpoint) (bool, error) {
+		genesis.ShareValueCheckpoints = append(genesis.ShareValueCheckpoints, checkpoint)
+		return false, nil
+	})
+
+	// Export the unbonding queue by id
+	_ = k.UnbondingTrustDeposit.Walk(ctx, nil, func(_ uint64, unbonding types.UnbondingTrustDeposit) (bool, error) {
+		genesis.UnbondingTrustDeposits = append(genesis.UnbondingTrustDeposits, unbonding)
+		return false, nil
+	})
+
+	// Export the compensation pools by ecosystem and the claims by id
+	_ = k.CompensationPool.Walk(ctx, nil, func(_ uint64, pool types.CompensationPool) (bool, error) {
+		genesis.CompensationPools = append(genesis.CompensationPools, pool)
+		return false, nil
+	})
+	_ = k.CompensationClaim.Walk(ctx, nil, func(_ uint64, claim types.CompensationClaim) (bool, error) {
+		genesis.CompensationClaims = append(genesis.CompensationClaims, claim)
+		return false, nil
+	})
+
+	// Export the orphaned deposits by account
+	_ = k.OrphanedTrustDeposit.Walk(ctx, nil, func(_ string, td types.TrustDeposit) (bool, error) {
+		genesis.OrphanedTrustDeposits = append(genesis.OrphanedTrustDeposits, td)
+		return false, nil
+	})
+
 	// Export dust
 	dust, err := k.Dust.Get(ctx)
 	if err == nil {
 		genesis.Dust = dust
 	}
 
+	// Export the last failed yield funding step
+	failure, err := k.YieldFundingFailure.Get(ctx)
+	if err == nil {
+		genesis.YieldFundingFailure = &failure
+	}
+
 	return genesis
 }
@@ -2,6 +2,7 @@ package trustdeposit_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,18 +22,38 @@ func TestGenesis(t *testing.T) {
 		Params: types.DefaultParams(),
 		TrustDeposits: []types.TrustDepositRecord{
 			{
-				Corporation: addr1,
-				Share:       math.LegacyNewDec(100),
-				Deposit:     1000,
-				Claimable:   50,
+				Corporation:   addr1,
+				CorporationId: 1,
+				Share:         math.LegacyNewDec(100),
+				Deposit:       1000,
+				Claimable:     50,
+				Unbonding:     200,
 			},
 			{
-				Corporation: addr2,
-				Share:       math.LegacyNewDec(200),
-				Deposit:     2000,
-				Claimable:   100,
+				Corporation:   addr2,
+				CorporationId: 2,
+				Share:         math.LegacyNewDec(200),
+				Deposit:       2000,
+				Claimable:     100,
 			},
 		},
+		ShareValueCheckpoints: []types.ShareValueCheckpoint{
+			{Timestamp: time.Unix(1000, 0).UTC(), BlockHeight: 10, ShareValue: math.LegacyOneDec()},
+			{Timestamp: time.Unix(87400, 0).UTC(), BlockHeight: 20, ShareValue: math.LegacyMustNewDecFromStr("1.001")},
+		},
+		UnbondingTrustDeposits: []types.UnbondingTrustDeposit{
+			{Id: 4, CorporationId: 1, Amount: 150, Created: time.Unix(1000, 0).UTC(), CompletionTime: time.Unix(5000, 0).UTC()},
+			{Id: 7, CorporationId: 1, Amount: 50, Created: time.Unix(2000, 0).UTC(), CompletionTime: time.Unix(3000, 0).UTC()},
+		},
+		OrphanedTrustDeposits: []types.TrustDeposit{
+			{Corporation: addr2, Share: math.LegacyNewDec(10), Deposit: 10},
+		},
+		YieldFundingFailure: &types.YieldFundingFailure{
+			Height: 9,
+			Stage:  types.YieldFundingStageTransfer,
+			Amount: 25,
+			Error:  "insufficient funds",
+		},
 	}
 
 	k, ctx := keepertest.TrustdepositKeeper(t)
@@ -49,12 +70,24 @@ func TestGenesis(t *testing.T) {
 
 	// Verify trust deposits (may need to sort since map iteration is non-deterministic)
 	require.ElementsMatch(t, genesisState.TrustDeposits, got.TrustDeposits)
+	require.Equal(t, genesisState.ShareValueCheckpoints, got.ShareValueCheckpoints)
+	require.Equal(t, genesisState.UnbondingTrustDeposits, got.UnbondingTrustDeposits)
+	require.Equal(t, genesisState.OrphanedTrustDeposits, got.OrphanedTrustDeposits)
+	require.Equal(t, genesisState.YieldFundingFailure, got.YieldFundingFailure)
+
+	// New unbonding ids continue after the imported ones
+	next, err := k.UnbondingTrustDepositCounter.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), next)
 
 	// Verify that trust deposits were correctly stored in the keeper
 	for _, td := range genesisState.TrustDeposits {
-		stored, err := k.TrustDeposit.Get(ctx, td.Corporation)
+		stored, err := k.TrustDeposit.Get(ctx, td.CorporationId)
 		require.NoError(t, err)
 		require.Equal(t, td.Corporation, stored.Corporation)
+		byAccount, err := k.GetTrustDepositByAccount(ctx, td.Corporation)
+		require.NoError(t, err)
+		require.Equal(t, stored, byAccount)
 		require.Equal(t, td.Share, stored.Share)
 		require.Equal(t, td.Deposit, stored.Deposit)
 		require.Equal(t, td.Claimable, stored.Claimable)
@@ -86,22 +119,29 @@ func TestImportExportGenesisWithTrustDeposits(t *testing.T) {
 	addr2 := sdk.AccAddress([]byte("test_address2")).String()
 
 	td1 := types.TrustDeposit{
-		Corporation: addr1,
-		Share:       math.LegacyNewDec(100),
-		Deposit:     1000,
-		Claimable:   50,
+		Corporation:   addr1,
+		CorporationId: 1,
+		Share:         math.LegacyNewDec(100),
+		Deposit:       1000,
+		Claimable:     50,
 	}
 
 	td2 := types.TrustDeposit{
-		Corporation: addr2,
-		Share:       math.LegacyNewDec(200),
-		Deposit:     2000,
-		Claimable:   100,
+		Corporation:   addr2,
+		CorporationId: 2,
+		Share:         math.LegacyNewDec(200),
+		Deposit:       2000,
+		Claimable:     100,
 	}
 
 	// Save trust deposits
-	require.NoError(t, k.TrustDeposit.Set(ctx, addr1, td1))
-	require.NoError(t, k.TrustDeposit.Set(ctx, addr2, td2))
+	require.NoError(t, k.SetTrustDeposit(ctx, td1))
+	require.NoError(t, k.SetTrustDeposit(ctx, td2))
+	// Read back as stored, stamped modified on write
+	td1, err := k.TrustDeposit.Get(ctx, td1.CorporationId)
+	require.NoError(t, err)
+	td2, err = k.TrustDeposit.Get(ctx, td2.CorporationId)
+	require.NoError(t, err)
 
 	// Export genesis
 	exported := trustdeposit.ExportGenesis(ctx, k)
@@ -114,11 +154,11 @@ func TestImportExportGenesisWithTrustDeposits(t *testing.T) {
 	trustdeposit.InitGenesis(newCtx, newK, *exported)
 
 	// Verify the trust deposits were correctly imported
-	storedTd1, err := newK.TrustDeposit.Get(newCtx, addr1)
+	storedTd1, err := newK.TrustDeposit.Get(newCtx, td1.CorporationId)
 	require.NoError(t, err)
 	require.Equal(t, td1, storedTd1)
 
-	storedTd2, err := newK.TrustDeposit.Get(newCtx, addr2)
+	storedTd2, err := newK.TrustDeposit.Get(newCtx, td2.CorporationId)
 	require.NoError(t, err)
 	require.Equal(t, td2, storedTd2)
 }
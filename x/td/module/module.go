@@ -17,6 +17,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	protocolpoolkeeper "github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -129,10 +132,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -152,7 +163,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -162,8 +173,8 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
-	return nil
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -203,8 +214,12 @@ type ModuleInputs struct {
 	AccountKeeper     types.AccountKeeper
 	BankKeeper        types.BankKeeper
 	MintKeeper        mintkeeper.Keeper
+	ProtocolPool      protocolpoolkeeper.Keeper
+	StakingKeeper     *stakingkeeper.Keeper
 	DelegationKeeper  types.DelegationKeeper
 	CorporationKeeper types.CorporationKeeper
+	EcosystemKeeper   types.EcosystemKeeper
+	DigestKeeper      types.DigestKeeper
 }
 
 type ModuleOutputs struct {
@@ -227,6 +242,16 @@ func (a *mintKeeperAdapter) GetBlocksPerYear(ctx context.Context) (uint64, error
 	return params.BlocksPerYear, nil
 }
 
+// protocolPoolKeeperAdapter adapts the Cosmos SDK protocol pool keeper to our
+// ProtocolPoolKeeper interface
+type protocolPoolKeeperAdapter struct {
+	protocolpoolkeeper.Keeper
+}
+
+func (a protocolPoolKeeperAdapter) GetContinuousFund(ctx context.Context, recipient sdk.AccAddress) (protocolpooltypes.ContinuousFund, error) {
+	return a.ContinuousFunds.Get(ctx, recipient)
+}
+
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	// default to governance authority if not provided
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
@@ -246,6 +271,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		mintAdapter,
 		in.DelegationKeeper,
 		in.CorporationKeeper,
+		in.EcosystemKeeper,
+		in.DigestKeeper,
+		protocolPoolKeeperAdapter{Keeper: in.ProtocolPool},
+		in.StakingKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
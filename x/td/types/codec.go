@@ -14,6 +14,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgReclaimTrustDepositYield{}, "verana/x/td/MsgReclaimTrustDepositYield")
 	legacy.RegisterAminoMsg(cdc, &MsgSlashTrustDeposit{}, "verana/x/td/MsgSlashTrustDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgRepaySlashedTrustDeposit{}, "verana/x/td/MsgRepaySlashedTrustDeposit")
+	legacy.RegisterAminoMsg(cdc, &MsgDepositTrust{}, "verana/x/td/MsgDepositTrust")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawClaimable{}, "verana/x/td/MsgWithdrawClaimable")
+	legacy.RegisterAminoMsg(cdc, &MsgFileCompensationClaim{}, "verana/x/td/MsgFileCompensationClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveCompensationClaim{}, "verana/x/td/MsgResolveCompensationClaim")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,6 +26,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgReclaimTrustDepositYield{},
 		&MsgSlashTrustDeposit{},
 		&MsgRepaySlashedTrustDeposit{},
+		&MsgDepositTrust{},
+		&MsgWithdrawClaimable{},
+		&MsgFileCompensationClaim{},
+		&MsgResolveCompensationClaim{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
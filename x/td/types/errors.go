@@ -14,4 +14,6 @@ var (
 	ErrInvalidAmount            = errors.Register(ModuleName, 1103, "invalid amount")
 	ErrTrustDepositNotFound     = errors.Register(ModuleName, 1104, "trust deposit not found")
 	ErrInsufficientTrustDeposit = errors.Register(ModuleName, 1105, "insufficient trust deposit")
+
+	ErrInsufficientCompensationPool = errors.Register(ModuleName, 1106, "insufficient compensation pool balance")
 )
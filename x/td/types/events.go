@@ -1,17 +1,32 @@
 package types
 
 const (
-	EventTypeSlashTrustDeposit                = "slash_trust_deposit"
-	EventTypeRepaySlashedTrustDeposit         = "repay_slashed_trust_deposit"
-	EventTypeReclaimTrustDepositYield         = "reclaim_trust_deposit_yield"
-	EventTypeAdjustTrustDeposit               = "adjust_trust_deposit"
-	EventTypeBurnEcosystemSlashedTrustDeposit = "burn_ecosystem_slashed_trust_deposit"
-	EventTypeYieldDistribution                = "yield_distribution"
-	EventTypeYieldTransfer                    = "yield_transfer"
+	EventTypeSlashTrustDeposit                 = "slash_trust_deposit"
+	EventTypeRepaySlashedTrustDeposit          = "repay_slashed_trust_deposit"
+	EventTypeReclaimTrustDepositYield          = "reclaim_trust_deposit_yield"
+	EventTypeAdjustTrustDeposit                = "adjust_trust_deposit"
+	EventTypeBurnEcosystemSlashedTrustDeposit  = "burn_ecosystem_slashed_trust_deposit"
+	EventTypeYieldDistribution                 = "yield_distribution"
+	EventTypeYieldTransfer                     = "yield_transfer"
+	EventTypeUnbondTrustDeposit                = "unbond_trust_deposit"
+	EventTypeMatureUnbondingTrustDeposit       = "mature_unbonding_trust_deposit"
+	EventTypeMatureUnbondingTrustDepositFailed = "mature_unbonding_trust_deposit_failed"
+	EventTypeSlashUnbondingTrustDeposit        = "slash_unbonding_trust_deposit"
+	EventTypeDepositTrust                      = "deposit_trust"
+	EventTypeWithdrawClaimable                 = "withdraw_claimable"
+	EventTypeFundCompensationPool              = "fund_compensation_pool"
+	EventTypeFileCompensationClaim             = "file_compensation_claim"
+	EventTypeResolveCompensationClaim          = "resolve_compensation_claim"
+	EventTypeYieldFundingFailure               = "yield_funding_failure"
+	EventTypeReturnYieldExcess                 = "return_yield_excess"
+	EventTypeYieldDistributionSkipped          = "yield_distribution_skipped"
+	EventTypeYieldCurveFallback                = "yield_curve_fallback"
+	EventTypeOrphanTrustDeposit                = "orphan_trust_deposit"
 )
 
 const (
 	AttributeKeyAccount               = "account"
+	AttributeKeyCorporationID         = "corporation_id"
 	AttributeKeyAmount                = "amount"
 	AttributeKeySlashCount            = "slash_count"
 	AttributeKeyRepaidBy              = "repaid_by"
@@ -33,4 +48,30 @@ const (
 	AttributeKeyTrustDepositBalance   = "trust_deposit_balance"
 	AttributeKeyTransferAmountDec     = "transfer_amount_dec"
 	AttributeKeyReason                = "reason"
+	AttributeKeyUnbondingID           = "unbonding_id"
+	AttributeKeyCompletionTime        = "completion_time"
+	AttributeKeyPooledAmount          = "pooled_amount"
+	AttributeKeyEcosystemID           = "ecosystem_id"
+	AttributeKeyClaimID               = "claim_id"
+	AttributeKeyClaimant              = "claimant"
+	AttributeKeyEvidenceDigest        = "evidence_digest"
+	AttributeKeyStatus                = "status"
+	AttributeKeyPaidAmount            = "paid_amount"
+	AttributeKeyPoolBalance           = "pool_balance"
+	AttributeKeyStage                 = "stage"
+	AttributeKeyError                 = "error"
+	AttributeKeyEffectiveYieldRate    = "effective_yield_rate"
+	AttributeKeyDepositRatio          = "deposit_ratio"
+)
+
+// Stages of the yield funding reported by yield_funding_failure events
+const (
+	YieldFundingStageTransfer     = "transfer"
+	YieldFundingStageReturnExcess = "return_excess"
+)
+
+// Reasons of the yield_distribution_skipped events
+const (
+	YieldSkipReasonNoContinuousFund    = "no_continuous_fund"
+	YieldSkipReasonContinuousFundError = "continuous_fund_error"
 )
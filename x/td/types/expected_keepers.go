@@ -4,7 +4,11 @@ import (
 	"context"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+
+	ectypes "github.com/verana-labs/verana/x/ec/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
@@ -22,6 +26,7 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -37,10 +42,28 @@ type MintKeeper interface {
 	GetBlocksPerYear(ctx context.Context) (uint64, error)
 }
 
+// StakingKeeper defines the expected interface for the staking module, the
+// bonded stake is a basis of the deposit ratio driving the yield curve.
+type StakingKeeper interface {
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
+}
+
+// ProtocolPoolKeeper defines the expected interface for the protocol pool
+// module. The yield intermediate pool is funded by a continuous fund of the
+// community pool, and returns the excess over the per block yield allowance
+// with FundCommunityPool.
+type ProtocolPoolKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetContinuousFund(ctx context.Context, recipient sdk.AccAddress) (protocolpooltypes.ContinuousFund, error)
+}
+
 // DelegationKeeper defines the expected interface for the Delegation (DE) module.
 // Used to perform [AUTHZ-CHECK] for (authority, operator) pairs.
+// CheckOperatorAuthorizationWithSpend also debits spend from the spend_limit
+// of the authorization, for messages that move the corporation's funds.
 type DelegationKeeper interface {
 	CheckOperatorAuthorization(ctx context.Context, authority string, operator string, msgTypeURL string, now time.Time) error
+	CheckOperatorAuthorizationWithSpend(ctx context.Context, authority string, operator string, msgTypeURL string, now time.Time, spend sdk.Coins) error
 }
 
 // CorporationView is the read shape MOD-TD needs about a Corporation subject
@@ -52,7 +75,23 @@ type CorporationView struct {
 
 // CorporationKeeper backs AUTHZ-CHECK-5 for MOD-TD delegable messages: resolve
 // the signing `corporation` policy_address to its registered Corporation, or
-// abort with ErrCorporationNotRegistered (referencing MOD-CO-MSG-1).
+// abort with ErrCorporationNotRegistered (referencing MOD-CO-MSG-1). Trust
+// deposits are keyed by corporation_id, GetCorporationByID resolves it back
+// to the policy_address account for fund-flows.
 type CorporationKeeper interface {
 	ResolveCorporationByPolicyAddress(ctx context.Context, policyAddress string) (CorporationView, error)
+	GetCorporationByID(ctx context.Context, id uint64) (CorporationView, error)
+}
+
+// EcosystemKeeper defines the expected ecosystem keeper. A compensation pool
+// is resolved by the Ecosystem (ec.CorporationId) to the group policy that
+// resolves its claims.
+type EcosystemKeeper interface {
+	GetEcosystem(ctx context.Context, id uint64) (ectypes.Ecosystem, error)
+}
+
+// DigestKeeper defines the expected interface for the Digest (DI) module.
+// HasDigest backs the evidence check of compensation claims.
+type DigestKeeper interface {
+	HasDigest(ctx context.Context, digest string) (bool, error)
 }
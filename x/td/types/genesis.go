@@ -28,6 +28,7 @@ func (gs GenesisState) Validate() error {
 
 	// Check for duplicate corporations in trust deposits
 	corporationSet := make(map[string]struct{}, len(gs.TrustDeposits))
+	corporationIDSet := make(map[uint64]struct{}, len(gs.TrustDeposits))
 
 	for i, td := range gs.TrustDeposits {
 		if _, err := sdk.AccAddressFromBech32(td.Corporation); err != nil {
@@ -39,10 +40,113 @@ func (gs GenesisState) Validate() error {
 		}
 		corporationSet[td.Corporation] = struct{}{}
 
+		if td.CorporationId == 0 {
+			return fmt.Errorf("corporation id cannot be 0 at index %d", i)
+		}
+		if _, exists := corporationIDSet[td.CorporationId]; exists {
+			return fmt.Errorf("duplicate trust deposit for corporation id: %d", td.CorporationId)
+		}
+		corporationIDSet[td.CorporationId] = struct{}{}
+
 		if td.Deposit < td.Claimable {
 			return fmt.Errorf("claimable amount exceeds deposit for corporation %s: %d > %d",
 				td.Corporation, td.Claimable, td.Deposit)
 		}
+		if td.Deposit-td.Claimable < td.Unbonding {
+			return fmt.Errorf("claimable and unbonding amounts exceed deposit for corporation %s", td.Corporation)
+		}
+	}
+
+	// Unbonding entries must add up to the unbonding amount of their deposit
+	unbondingSum := make(map[uint64]uint64, len(gs.TrustDeposits))
+	unbondingIDSet := make(map[uint64]struct{}, len(gs.UnbondingTrustDeposits))
+	for i, unbonding := range gs.UnbondingTrustDeposits {
+		if unbonding.Id == 0 {
+			return fmt.Errorf("unbonding id cannot be 0 at index %d", i)
+		}
+		if _, exists := unbondingIDSet[unbonding.Id]; exists {
+			return fmt.Errorf("duplicate unbonding trust deposit id: %d", unbonding.Id)
+		}
+		unbondingIDSet[unbonding.Id] = struct{}{}
+
+		if _, exists := corporationIDSet[unbonding.CorporationId]; !exists {
+			return fmt.Errorf("unbonding trust deposit %d references unknown corporation id %d", unbonding.Id, unbonding.CorporationId)
+		}
+		if unbonding.Amount == 0 {
+			return fmt.Errorf("unbonding trust deposit %d amount must be positive", unbonding.Id)
+		}
+		unbondingSum[unbonding.CorporationId] += unbonding.Amount
+	}
+	for _, td := range gs.TrustDeposits {
+		if unbondingSum[td.CorporationId] != td.Unbonding {
+			return fmt.Errorf("unbonding entries of corporation %d add up to %d, expected %d",
+				td.CorporationId, unbondingSum[td.CorporationId], td.Unbonding)
+		}
+	}
+
+	// Checkpoints are keyed by unix time, so two in the same second collide
+	checkpointSet := make(map[int64]struct{}, len(gs.ShareValueCheckpoints))
+	for i, checkpoint := range gs.ShareValueCheckpoints {
+		if !checkpoint.ShareValue.IsPositive() {
+			return fmt.Errorf("share value must be positive for checkpoint at index %d", i)
+		}
+		key := checkpoint.Timestamp.Unix()
+		if _, exists := checkpointSet[key]; exists {
+			return fmt.Errorf("duplicate share value checkpoint at %s", checkpoint.Timestamp)
+		}
+		checkpointSet[key] = struct{}{}
+	}
+
+	// A pool holds what it received and did not pay out
+	poolSet := make(map[uint64]struct{}, len(gs.CompensationPools))
+	for i, pool := range gs.CompensationPools {
+		if pool.EcosystemId == 0 {
+			return fmt.Errorf("ecosystem id cannot be 0 for compensation pool at index %d", i)
+		}
+		if _, exists := poolSet[pool.EcosystemId]; exists {
+			return fmt.Errorf("duplicate compensation pool for ecosystem id: %d", pool.EcosystemId)
+		}
+		poolSet[pool.EcosystemId] = struct{}{}
+
+		if pool.TotalPaid > pool.TotalReceived || pool.Balance != pool.TotalReceived-pool.TotalPaid {
+			return fmt.Errorf("compensation pool of ecosystem %d balance %d does not match received %d minus paid %d",
+				pool.EcosystemId, pool.Balance, pool.TotalReceived, pool.TotalPaid)
+		}
+	}
+
+	claimIDSet := make(map[uint64]struct{}, len(gs.CompensationClaims))
+	for i, claim := range gs.CompensationClaims {
+		if claim.Id == 0 {
+			return fmt.Errorf("compensation claim id cannot be 0 at index %d", i)
+		}
+		if _, exists := claimIDSet[claim.Id]; exists {
+			return fmt.Errorf("duplicate compensation claim id: %d", claim.Id)
+		}
+		claimIDSet[claim.Id] = struct{}{}
+
+		if _, err := sdk.AccAddressFromBech32(claim.Claimant); err != nil {
+			return fmt.Errorf("invalid claimant address for compensation claim %d: %s", claim.Id, err)
+		}
+		if claim.EcosystemId == 0 {
+			return fmt.Errorf("ecosystem id cannot be 0 for compensation claim %d", claim.Id)
+		}
+		if claim.Status == CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_UNSPECIFIED {
+			return fmt.Errorf("compensation claim %d status must be set", claim.Id)
+		}
+		if claim.PaidAmount > claim.Amount {
+			return fmt.Errorf("compensation claim %d paid amount exceeds claimed amount: %d > %d", claim.Id, claim.PaidAmount, claim.Amount)
+		}
+	}
+
+	orphanSet := make(map[string]struct{}, len(gs.OrphanedTrustDeposits))
+	for i, td := range gs.OrphanedTrustDeposits {
+		if _, err := sdk.AccAddressFromBech32(td.Corporation); err != nil {
+			return fmt.Errorf("invalid orphaned trust deposit account at index %d: %s", i, err)
+		}
+		if _, exists := orphanSet[td.Corporation]; exists {
+			return fmt.Errorf("duplicate orphaned trust deposit for account: %s", td.Corporation)
+		}
+		orphanSet[td.Corporation] = struct{}{}
 	}
 
 	return nil
@@ -33,6 +33,16 @@ type GenesisState struct {
 	TrustDeposits []TrustDepositRecord `protobuf:"bytes,2,rep,name=trust_deposits,json=trustDeposits,proto3" json:"trust_deposits"`
 	// dust is the accumulated fractional yield carried forward
 	Dust string `protobuf:"bytes,3,opt,name=dust,proto3" json:"dust,omitempty"`
+	// share_value_checkpoints is the share value history, oldest first
+	ShareValueCheckpoints  []ShareValueCheckpoint  `protobuf:"bytes,4,rep,name=share_value_checkpoints,json=shareValueCheckpoints,proto3" json:"share_value_checkpoints"`
+	UnbondingTrustDeposits []UnbondingTrustDeposit `protobuf:"bytes,5,rep,name=unbonding_trust_deposits,json=unbondingTrustDeposits,proto3" json:"unbonding_trust_deposits"`
+	CompensationPools      []CompensationPool      `protobuf:"bytes,6,rep,name=compensation_pools,json=compensationPools,proto3" json:"compensation_pools"`
+	CompensationClaims     []CompensationClaim     `protobuf:"bytes,7,rep,name=compensation_claims,json=compensationClaims,proto3" json:"compensation_claims"`
+	// orphaned_trust_deposits are the v2 deposits whose account resolved to no
+	// corporation when they were re-keyed by corporation_id
+	OrphanedTrustDeposits []TrustDeposit `protobuf:"bytes,8,rep,name=orphaned_trust_deposits,json=orphanedTrustDeposits,proto3" json:"orphaned_trust_deposits"`
+	// yield_funding_failure is the last failed yield funding step, if any
+	YieldFundingFailure *YieldFundingFailure `protobuf:"bytes,9,opt,name=yield_funding_failure,json=yieldFundingFailure,proto3" json:"yield_funding_failure,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,12 +99,56 @@ func (m *GenesisState) GetDust() string {
 	return ""
 }
 
+func (m *GenesisState) GetShareValueCheckpoints() []ShareValueCheckpoint {
+	if m != nil {
+		return m.ShareValueCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingTrustDeposits() []UnbondingTrustDeposit {
+	if m != nil {
+		return m.UnbondingTrustDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompensationPools() []CompensationPool {
+	if m != nil {
+		return m.CompensationPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompensationClaims() []CompensationClaim {
+	if m != nil {
+		return m.CompensationClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrphanedTrustDeposits() []TrustDeposit {
+	if m != nil {
+		return m.OrphanedTrustDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetYieldFundingFailure() *YieldFundingFailure {
+	if m != nil {
+		return m.YieldFundingFailure
+	}
+	return nil
+}
+
 // TrustDepositRecord defines a trust deposit entry for genesis state
 type TrustDepositRecord struct {
-	Corporation string                      `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
-	Share       cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share" yaml:"share"`
-	Deposit     uint64                      `protobuf:"varint,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	Claimable   uint64                      `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	Corporation   string                      `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	Share         cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share" yaml:"share"`
+	Deposit       uint64                      `protobuf:"varint,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Claimable     uint64                      `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	CorporationId uint64                      `protobuf:"varint,5,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Unbonding     uint64                      `protobuf:"varint,6,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
 }
 
 func (m *TrustDepositRecord) Reset()         { *m = TrustDepositRecord{} }
@@ -151,6 +205,20 @@ func (m *TrustDepositRecord) GetClaimable() uint64 {
 	return 0
 }
 
+func (m *TrustDepositRecord) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
+}
+
+func (m *TrustDepositRecord) GetUnbonding() uint64 {
+	if m != nil {
+		return m.Unbonding
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "verana.td.v1.GenesisState")
 	proto.RegisterType((*TrustDepositRecord)(nil), "verana.td.v1.TrustDepositRecord")
@@ -159,34 +227,48 @@ func init() {
 func init() { proto.RegisterFile("verana/td/v1/genesis.proto", fileDescriptor_daff73aaff90939d) }
 
 var fileDescriptor_daff73aaff90939d = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0x41, 0x8b, 0xd4, 0x30,
-	0x14, 0x6e, 0x76, 0xeb, 0x4a, 0x33, 0xa3, 0x60, 0x98, 0x43, 0x1d, 0xa5, 0x53, 0x7a, 0x2a, 0xc2,
-	0x26, 0xec, 0xee, 0x41, 0xd8, 0x9b, 0x65, 0x41, 0x04, 0x05, 0xe9, 0x7a, 0xf2, 0xb2, 0xa4, 0x4d,
-	0xe8, 0x04, 0xdb, 0xa6, 0x24, 0x99, 0xc1, 0xf9, 0x17, 0xfe, 0x0c, 0xf1, 0xe4, 0xc1, 0x1f, 0xb1,
-	0xc7, 0x45, 0x2f, 0xe2, 0x61, 0x90, 0x99, 0x83, 0x77, 0x7f, 0x81, 0x34, 0xe9, 0xe2, 0x0c, 0x7b,
-	0x09, 0xef, 0xbd, 0xef, 0xfb, 0xde, 0xfb, 0xf2, 0x1e, 0x9c, 0x2e, 0xb9, 0xa2, 0x2d, 0x25, 0x86,
-	0x91, 0xe5, 0x09, 0xa9, 0x78, 0xcb, 0xb5, 0xd0, 0xb8, 0x53, 0xd2, 0x48, 0x34, 0x76, 0x18, 0x36,
-	0x0c, 0x2f, 0x4f, 0xa6, 0x8f, 0x68, 0x23, 0x5a, 0x49, 0xec, 0xeb, 0x08, 0xd3, 0xc7, 0xa5, 0xd4,
-	0x8d, 0xd4, 0x57, 0x36, 0x23, 0x2e, 0x19, 0xa0, 0x49, 0x25, 0x2b, 0xe9, 0xea, 0x7d, 0x74, 0x2b,
-	0xd8, 0x9b, 0xd6, 0x51, 0x45, 0x9b, 0x41, 0x90, 0x7c, 0x01, 0x70, 0xfc, 0xd2, 0x8d, 0xbf, 0x34,
-	0xd4, 0x70, 0xf4, 0x1c, 0x1e, 0x39, 0x42, 0x08, 0x62, 0x90, 0x8e, 0x4e, 0x27, 0x78, 0xd7, 0x0e,
-	0x7e, 0x6b, 0xb1, 0x2c, 0xb8, 0x5e, 0xcf, 0xbc, 0xcf, 0x7f, 0xbe, 0x3e, 0x03, 0xf9, 0x40, 0x47,
-	0x6f, 0xe0, 0x43, 0xa3, 0x16, 0xda, 0x5c, 0x31, 0xde, 0x49, 0x2d, 0x8c, 0x0e, 0x0f, 0xe2, 0xc3,
-	0x74, 0x74, 0x1a, 0xef, 0x37, 0x78, 0xd7, 0x73, 0x2e, 0x1c, 0x25, 0xe7, 0xa5, 0x54, 0x2c, 0xf3,
-	0xfb, 0x66, 0xf9, 0x03, 0xb3, 0x83, 0x68, 0x84, 0xa0, 0xcf, 0x16, 0xda, 0x84, 0x87, 0x31, 0x48,
-	0x83, 0xdc, 0xc6, 0xc9, 0x0f, 0x00, 0xd1, 0x5d, 0x3d, 0x3a, 0x87, 0xa3, 0x52, 0xaa, 0x4e, 0x2a,
-	0x6a, 0x84, 0x6c, 0xad, 0xef, 0x20, 0x0b, 0xbf, 0x7f, 0x3b, 0x9e, 0x0c, 0xbb, 0x79, 0xc1, 0x98,
-	0xe2, 0x5a, 0x5f, 0x1a, 0x25, 0xda, 0x2a, 0xdf, 0x25, 0xa3, 0x57, 0xf0, 0x9e, 0x9e, 0x53, 0xc5,
-	0xc3, 0x03, 0xab, 0x3a, 0xeb, 0xad, 0xfc, 0x5a, 0xcf, 0x9e, 0x38, 0xa5, 0x66, 0x1f, 0xb0, 0x90,
-	0xa4, 0xa1, 0x66, 0x8e, 0x5f, 0xf3, 0x8a, 0x96, 0xab, 0x0b, 0x5e, 0xfe, 0x5d, 0xcf, 0xc6, 0x2b,
-	0xda, 0xd4, 0xe7, 0x89, 0x55, 0x26, 0xb9, 0xeb, 0x80, 0x42, 0x78, 0x7f, 0xf8, 0xba, 0x35, 0xed,
-	0xe7, 0xb7, 0x29, 0x7a, 0x0a, 0x83, 0xb2, 0xa6, 0xa2, 0xa1, 0x45, 0xcd, 0x43, 0xdf, 0x62, 0xff,
-	0x0b, 0x59, 0x76, 0xbd, 0x89, 0xc0, 0xcd, 0x26, 0x02, 0xbf, 0x37, 0x11, 0xf8, 0xb4, 0x8d, 0xbc,
-	0x9b, 0x6d, 0xe4, 0xfd, 0xdc, 0x46, 0xde, 0xfb, 0xb4, 0x12, 0x66, 0xbe, 0x28, 0x70, 0x29, 0x1b,
-	0xe2, 0x96, 0x78, 0x5c, 0xd3, 0x42, 0x0f, 0x31, 0xf9, 0xd8, 0x1f, 0xd4, 0xac, 0x3a, 0xae, 0x8b,
-	0x23, 0x7b, 0xcd, 0xb3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0xf3, 0xad, 0x52, 0x58, 0x02,
-	0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xad, 0xed, 0xa8, 0xf7, 0x47, 0x9a, 0xb7, 0x31, 0x53, 0x50, 0x5a, 0x8a, 0x90,
+	0x2a, 0xa4, 0x25, 0xda, 0x76, 0x40, 0xda, 0x8d, 0x6e, 0x1a, 0x9a, 0x04, 0xd2, 0x94, 0xb2, 0x09,
+	0xb8, 0x04, 0x37, 0xf6, 0x52, 0x6b, 0x49, 0x1c, 0xc5, 0x4e, 0x45, 0xbf, 0x05, 0x47, 0x3e, 0x02,
+	0x47, 0x0e, 0x7c, 0x88, 0xdd, 0x98, 0x38, 0x21, 0x0e, 0x13, 0xda, 0x0e, 0xdc, 0xf9, 0x04, 0x28,
+	0x76, 0x3a, 0x92, 0x6e, 0xbb, 0x54, 0xf6, 0xfb, 0x3c, 0xef, 0xef, 0xb5, 0xdf, 0xbc, 0x35, 0x68,
+	0x8e, 0x68, 0x82, 0x23, 0x6c, 0x4b, 0x62, 0x8f, 0x36, 0x6d, 0x9f, 0x46, 0x54, 0x30, 0x61, 0xc5,
+	0x09, 0x97, 0x1c, 0x2e, 0x68, 0xcd, 0x92, 0xc4, 0x1a, 0x6d, 0x36, 0x97, 0x71, 0xc8, 0x22, 0x6e,
+	0xab, 0x5f, 0x6d, 0x68, 0x3e, 0xf0, 0xb8, 0x08, 0xb9, 0x70, 0xd5, 0xce, 0xd6, 0x9b, 0x5c, 0x5a,
+	0xf5, 0xb9, 0xcf, 0x75, 0x3c, 0x5b, 0x4d, 0x12, 0x4a, 0xd5, 0x62, 0x9c, 0xe0, 0x70, 0x92, 0x80,
+	0x4a, 0x92, 0x1c, 0xc7, 0x34, 0x57, 0x3a, 0xdf, 0x6b, 0x60, 0xe1, 0xa5, 0x3e, 0x58, 0x5f, 0x62,
+	0x49, 0xe1, 0x73, 0x50, 0xd7, 0xa9, 0xc8, 0x68, 0x1b, 0xdd, 0xf9, 0xad, 0x55, 0xab, 0x78, 0x50,
+	0xeb, 0x50, 0x69, 0xbd, 0xc6, 0xd9, 0x45, 0xab, 0xf2, 0xe5, 0xcf, 0xd7, 0x67, 0x86, 0x93, 0xdb,
+	0xe1, 0x6b, 0xb0, 0x24, 0x93, 0x54, 0x48, 0x97, 0xd0, 0x98, 0x0b, 0x26, 0x05, 0x9a, 0x69, 0xcf,
+	0x76, 0xe7, 0xb7, 0xda, 0x65, 0xc0, 0x9b, 0xcc, 0xb3, 0xa7, 0x2d, 0x0e, 0xf5, 0x78, 0x42, 0x7a,
+	0xd5, 0x0c, 0xe6, 0x2c, 0xca, 0x82, 0x22, 0x20, 0x04, 0x55, 0x92, 0x0a, 0x89, 0x66, 0xdb, 0x46,
+	0xb7, 0xe1, 0xa8, 0x35, 0xfc, 0x00, 0xd6, 0xc5, 0x10, 0x27, 0xd4, 0x1d, 0xe1, 0x20, 0xa5, 0xae,
+	0x37, 0xa4, 0xde, 0x69, 0xcc, 0x59, 0x24, 0x05, 0xaa, 0xaa, 0x5a, 0x9d, 0x72, 0xad, 0x7e, 0x66,
+	0x3e, 0xce, 0xbc, 0xbb, 0xd7, 0xd6, 0xbc, 0xda, 0x9a, 0xb8, 0x45, 0x13, 0xd0, 0x03, 0x28, 0x8d,
+	0x06, 0x3c, 0x22, 0x2c, 0xf2, 0xdd, 0xa9, 0xeb, 0xd4, 0x54, 0x89, 0x27, 0xe5, 0x12, 0x47, 0x13,
+	0x77, 0xf1, 0x5e, 0x79, 0x8d, 0xfb, 0xe9, 0x6d, 0xa2, 0x80, 0x7d, 0x00, 0x3d, 0x1e, 0xc6, 0x34,
+	0x12, 0x58, 0x32, 0x1e, 0xb9, 0x31, 0xe7, 0x81, 0x40, 0x75, 0x85, 0x37, 0xcb, 0xf8, 0xdd, 0x82,
+	0xef, 0x90, 0xf3, 0x20, 0x27, 0x2f, 0x7b, 0x53, 0x71, 0x01, 0x8f, 0xc1, 0x4a, 0x09, 0xea, 0x05,
+	0x98, 0x85, 0x02, 0xcd, 0x29, 0x6a, 0xeb, 0x6e, 0xea, 0x6e, 0xe6, 0xcb, 0xb1, 0xa5, 0x63, 0x29,
+	0x41, 0xc0, 0xb7, 0x60, 0x9d, 0x27, 0xf1, 0x10, 0x47, 0x94, 0x4c, 0x37, 0xe4, 0x9e, 0x62, 0x37,
+	0xef, 0xfe, 0xbe, 0x93, 0x5e, 0x4f, 0x00, 0xe5, 0x36, 0x1c, 0x81, 0xb5, 0x31, 0xa3, 0x01, 0x71,
+	0x4f, 0x52, 0xdd, 0xef, 0x13, 0xcc, 0x82, 0x34, 0xa1, 0xa8, 0xa1, 0x06, 0xef, 0x71, 0x99, 0xfb,
+	0x2e, 0xb3, 0xee, 0x6b, 0xe7, 0xbe, 0x36, 0x3a, 0x2b, 0xe3, 0x9b, 0xc1, 0xce, 0xe7, 0x19, 0x00,
+	0x6f, 0x0e, 0x19, 0xdc, 0x01, 0xf3, 0x1e, 0x4f, 0x62, 0x9e, 0xa8, 0xcb, 0xa9, 0xe1, 0x6e, 0xf4,
+	0xd0, 0x8f, 0x6f, 0x1b, 0xab, 0xf9, 0x5f, 0xeb, 0x05, 0x21, 0x09, 0x15, 0xa2, 0x2f, 0x13, 0x16,
+	0xf9, 0x4e, 0xd1, 0x0c, 0x0f, 0x40, 0x4d, 0x8d, 0x0b, 0x9a, 0x51, 0x59, 0xdb, 0xd9, 0xad, 0x7e,
+	0x5d, 0xb4, 0x1e, 0xea, 0x4c, 0x41, 0x4e, 0x2d, 0xc6, 0xed, 0x10, 0xcb, 0xa1, 0xf5, 0x8a, 0xfa,
+	0xd8, 0x1b, 0xef, 0x51, 0xef, 0xef, 0x45, 0x6b, 0x61, 0x8c, 0xc3, 0x60, 0xa7, 0xa3, 0x32, 0x3b,
+	0x8e, 0x26, 0x40, 0x04, 0xe6, 0xf2, 0xfe, 0xa9, 0xc9, 0xae, 0x3a, 0x93, 0x2d, 0x7c, 0x04, 0x1a,
+	0xea, 0x9b, 0xe1, 0x41, 0x40, 0x51, 0x55, 0x69, 0xff, 0x03, 0xf0, 0x29, 0x58, 0x2a, 0x9c, 0xc8,
+	0x65, 0x04, 0xd5, 0x94, 0x65, 0xb1, 0x10, 0x3d, 0x20, 0x19, 0xe4, 0x7a, 0xe8, 0x50, 0x5d, 0x43,
+	0xae, 0x03, 0xbd, 0xde, 0xd9, 0xa5, 0x69, 0x9c, 0x5f, 0x9a, 0xc6, 0xef, 0x4b, 0xd3, 0xf8, 0x74,
+	0x65, 0x56, 0xce, 0xaf, 0xcc, 0xca, 0xcf, 0x2b, 0xb3, 0xf2, 0xbe, 0xeb, 0x33, 0x39, 0x4c, 0x07,
+	0x96, 0xc7, 0x43, 0x5b, 0xb7, 0x7d, 0x23, 0xc0, 0x03, 0x91, 0xaf, 0xed, 0x8f, 0xd9, 0xcb, 0xa1,
+	0x9e, 0x8d, 0x41, 0x5d, 0xbd, 0x1b, 0xdb, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x88, 0x53, 0xbf,
+	0x3d, 0xdc, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -209,6 +291,88 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.YieldFundingFailure != nil {
+		{
+			size, err := m.YieldFundingFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.OrphanedTrustDeposits) > 0 {
+		for iNdEx := len(m.OrphanedTrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrphanedTrustDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CompensationClaims) > 0 {
+		for iNdEx := len(m.CompensationClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompensationClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CompensationPools) > 0 {
+		for iNdEx := len(m.CompensationPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompensationPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UnbondingTrustDeposits) > 0 {
+		for iNdEx := len(m.UnbondingTrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTrustDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ShareValueCheckpoints) > 0 {
+		for iNdEx := len(m.ShareValueCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareValueCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Dust) > 0 {
 		i -= len(m.Dust)
 		copy(dAtA[i:], m.Dust)
@@ -263,6 +427,16 @@ func (m *TrustDepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Unbonding != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Unbonding))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Claimable != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Claimable))
 		i--
@@ -322,6 +496,40 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ShareValueCheckpoints) > 0 {
+		for _, e := range m.ShareValueCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingTrustDeposits) > 0 {
+		for _, e := range m.UnbondingTrustDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompensationPools) > 0 {
+		for _, e := range m.CompensationPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompensationClaims) > 0 {
+		for _, e := range m.CompensationClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrphanedTrustDeposits) > 0 {
+		for _, e := range m.OrphanedTrustDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.YieldFundingFailure != nil {
+		l = m.YieldFundingFailure.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -343,6 +551,12 @@ func (m *TrustDepositRecord) Size() (n int) {
 	if m.Claimable != 0 {
 		n += 1 + sovGenesis(uint64(m.Claimable))
 	}
+	if m.CorporationId != 0 {
+		n += 1 + sovGenesis(uint64(m.CorporationId))
+	}
+	if m.Unbonding != 0 {
+		n += 1 + sovGenesis(uint64(m.Unbonding))
+	}
 	return n
 }
 
@@ -480,6 +694,212 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Dust = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValueCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareValueCheckpoints = append(m.ShareValueCheckpoints, ShareValueCheckpoint{})
+			if err := m.ShareValueCheckpoints[len(m.ShareValueCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTrustDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTrustDeposits = append(m.UnbondingTrustDeposits, UnbondingTrustDeposit{})
+			if err := m.UnbondingTrustDeposits[len(m.UnbondingTrustDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompensationPools = append(m.CompensationPools, CompensationPool{})
+			if err := m.CompensationPools[len(m.CompensationPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompensationClaims = append(m.CompensationClaims, CompensationClaim{})
+			if err := m.CompensationClaims[len(m.CompensationClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedTrustDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedTrustDeposits = append(m.OrphanedTrustDeposits, TrustDeposit{})
+			if err := m.OrphanedTrustDeposits[len(m.OrphanedTrustDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldFundingFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldFundingFailure == nil {
+				m.YieldFundingFailure = &YieldFundingFailure{}
+			}
+			if err := m.YieldFundingFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -634,6 +1054,44 @@ func (m *TrustDepositRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			m.Unbonding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unbonding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
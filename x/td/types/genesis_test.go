@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -20,6 +21,9 @@ func TestGenesisState_Validate(t *testing.T) {
 	invalidShareValue, _ := math.LegacyNewDecFromStr("0.0") // Zero is invalid
 	invalidParams.TrustDepositShareValue = invalidShareValue
 
+	unsetCompensationPoolShare := types.DefaultParams()
+	unsetCompensationPoolShare.CompensationPoolShare = math.LegacyDec{}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -37,12 +41,14 @@ func TestGenesisState_Validate(t *testing.T) {
 				TrustDeposits: []types.TrustDepositRecord{
 					{
 						Corporation: validAddr1,
+						CorporationId: 1,
 						Share:     math.LegacyNewDec(100),
 						Deposit:   1000,
 						Claimable: 500,
 					},
 					{
 						Corporation: validAddr2,
+						CorporationId: 2,
 						Share:     math.LegacyNewDec(200),
 						Deposit:   2000,
 						Claimable: 1000,
@@ -51,6 +57,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid genesis state with unbonding trust deposits",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{
+					{
+						Corporation:   validAddr1,
+						CorporationId: 1,
+						Share:         math.LegacyNewDec(100),
+						Deposit:       1000,
+						Claimable:     500,
+						Unbonding:     300,
+					},
+				},
+				UnbondingTrustDeposits: []types.UnbondingTrustDeposit{
+					{Id: 1, CorporationId: 1, Amount: 100},
+					{Id: 2, CorporationId: 1, Amount: 200},
+				},
+			},
+			valid: true,
+		},
 		{
 			desc: "invalid parameter",
 			genState: &types.GenesisState{
@@ -59,6 +86,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "unset compensation pool share",
+			genState: &types.GenesisState{
+				Params:        unsetCompensationPoolShare,
+				TrustDeposits: []types.TrustDepositRecord{},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid account address",
 			genState: &types.GenesisState{
@@ -81,12 +116,14 @@ func TestGenesisState_Validate(t *testing.T) {
 				TrustDeposits: []types.TrustDepositRecord{
 					{
 						Corporation: validAddr1,
+						CorporationId: 1,
 						Share:     math.LegacyNewDec(100),
 						Deposit:   1000,
 						Claimable: 500,
 					},
 					{
 						Corporation: validAddr1, // Duplicate account
+						CorporationId: 2,
 						Share:     math.LegacyNewDec(200),
 						Deposit:   2000,
 						Claimable: 1000,
@@ -95,6 +132,41 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "zero corporation id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{
+					{
+						Corporation: validAddr1,
+						Share:       math.LegacyNewDec(100),
+						Deposit:     1000,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate corporation id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{
+					{
+						Corporation:   validAddr1,
+						CorporationId: 1,
+						Share:         math.LegacyNewDec(100),
+						Deposit:       1000,
+					},
+					{
+						Corporation:   validAddr2,
+						CorporationId: 1,
+						Share:         math.LegacyNewDec(200),
+						Deposit:       2000,
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "claimable exceeds amount",
 			genState: &types.GenesisState{
@@ -102,6 +174,7 @@ func TestGenesisState_Validate(t *testing.T) {
 				TrustDeposits: []types.TrustDepositRecord{
 					{
 						Corporation: validAddr1,
+						CorporationId: 1,
 						Share:     math.LegacyNewDec(100),
 						Deposit:   1000,
 						Claimable: 1500, // Invalid: claimable > deposit
@@ -110,6 +183,115 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "unbonding entries do not match unbonding amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{
+					{
+						Corporation:   validAddr1,
+						CorporationId: 1,
+						Share:         math.LegacyNewDec(100),
+						Deposit:       1000,
+						Unbonding:     300,
+					},
+				},
+				UnbondingTrustDeposits: []types.UnbondingTrustDeposit{
+					{Id: 1, CorporationId: 1, Amount: 200},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unbonding entry of unknown corporation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				UnbondingTrustDeposits: []types.UnbondingTrustDeposit{
+					{Id: 1, CorporationId: 9, Amount: 200},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate share value checkpoint",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ShareValueCheckpoints: []types.ShareValueCheckpoint{
+					{Timestamp: time.Unix(100, 0).UTC(), ShareValue: math.LegacyOneDec()},
+					{Timestamp: time.Unix(100, 500).UTC(), ShareValue: math.LegacyOneDec()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non positive share value checkpoint",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ShareValueCheckpoints: []types.ShareValueCheckpoint{
+					{Timestamp: time.Unix(100, 0).UTC(), ShareValue: math.LegacyZeroDec()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid compensation pools and claims",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				CompensationPools: []types.CompensationPool{
+					{EcosystemId: 1, Balance: 50, TotalReceived: 150, TotalPaid: 100},
+				},
+				CompensationClaims: []types.CompensationClaim{
+					{Id: 1, EcosystemId: 1, Claimant: validAddr1, Amount: 100, PaidAmount: 100,
+						Status: types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PAID},
+					{Id: 2, EcosystemId: 1, Claimant: validAddr2, Amount: 80,
+						Status: types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PENDING},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "compensation pool balance does not match received minus paid",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				CompensationPools: []types.CompensationPool{
+					{EcosystemId: 1, Balance: 100, TotalReceived: 150, TotalPaid: 100},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "compensation claim paid more than claimed",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				CompensationClaims: []types.CompensationClaim{
+					{Id: 1, EcosystemId: 1, Claimant: validAddr1, Amount: 10, PaidAmount: 20,
+						Status: types.CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PAID},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid orphaned trust deposits",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OrphanedTrustDeposits: []types.TrustDeposit{
+					{Corporation: validAddr1, Share: math.LegacyNewDec(100), Deposit: 100},
+					{Corporation: validAddr2, Share: math.LegacyNewDec(50), Deposit: 50},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate orphaned trust deposit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OrphanedTrustDeposits: []types.TrustDeposit{
+					{Corporation: validAddr1, Share: math.LegacyNewDec(100), Deposit: 100},
+					{Corporation: validAddr1, Share: math.LegacyNewDec(50), Deposit: 50},
+				},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -14,12 +14,33 @@ const (
 
 	RouterKey             = ModuleName
 	YieldIntermediatePool = "yield_intermediate_pool"
+	// CompensationPool holds the slashed funds set aside to compensate the
+	// parties harmed by the participants of an ecosystem
+	CompensationPoolAccount = "td_compensation_pool"
 )
 
 var (
-	ParamsKey       = []byte("p_trustdeposit")
-	TrustDepositKey = collections.NewPrefix(1)
-	DustKey         = collections.NewPrefix(2)
+	ParamsKey = []byte("p_trustdeposit")
+	// LegacyTrustDepositKey holds the trust deposits keyed by corporation
+	// account, moved under TrustDepositKey by the v3 migration.
+	LegacyTrustDepositKey    = collections.NewPrefix(1)
+	DustKey                  = collections.NewPrefix(2)
+	TrustDepositKey          = collections.NewPrefix(3)
+	TrustDepositByAccountKey = collections.NewPrefix(4)
+	ShareValueCheckpointKey  = collections.NewPrefix(5)
+
+	UnbondingTrustDepositKey              = collections.NewPrefix(6)
+	UnbondingTrustDepositCounterKey       = collections.NewPrefix(7)
+	UnbondingTrustDepositQueueKey         = collections.NewPrefix(8)
+	UnbondingTrustDepositByCorporationKey = collections.NewPrefix(9)
+
+	CompensationPoolKey         = collections.NewPrefix(10)
+	CompensationClaimKey        = collections.NewPrefix(11)
+	CompensationClaimCounterKey = collections.NewPrefix(12)
+
+	YieldFundingFailureKey = collections.NewPrefix(13)
+
+	OrphanedTrustDepositKey = collections.NewPrefix(14)
 )
 
 const (
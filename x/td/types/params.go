@@ -24,6 +24,12 @@ const (
 	DefaultUserAgentRewardRate         = "0.2"  // 20%
 	DefaultTrustDepositMaxYieldRate    = "0.15" // 15% annual yield
 	DefaultTrustDepositBlockRewardShare = "0.2"
+	DefaultCompensationPoolShare        = "0.5" // half of an ecosystem slash compensates harmed parties
+	DefaultYieldCurveRatioBasis         = YieldCurveRatioBasisBondedStake
+
+	DefaultShareValueCheckpointIntervalSeconds uint64 = 86400 // daily checkpoints
+	DefaultShareValueHistoryRetentionDays      uint64 = 365
+	DefaultTrustDepositUnbondingDays           uint64 = 21
 )
 
 // ParamKeyTable the param key table for launch module
@@ -41,6 +47,15 @@ func NewParams(
 	trustDepositMaxYieldRate math.LegacyDec,
 	yieldIntermediatePool string,
 	trustDepositBlockRewardShare math.LegacyDec,
+	shareValueCheckpointIntervalSeconds uint64,
+	shareValueHistoryRetentionDays uint64,
+	trustDepositUnbondingDays uint64,
+	compensationPoolShare math.LegacyDec,
+	yieldCurveMinRate math.LegacyDec,
+	yieldCurveMaxRate math.LegacyDec,
+	yieldCurveKinkRate math.LegacyDec,
+	yieldCurveTargetRatio math.LegacyDec,
+	yieldCurveRatioBasis string,
 ) Params {
 	return Params{
 		TrustDepositReclaimBurnRate:  trustDepositReclaimBurnRate,
@@ -51,6 +66,15 @@ func NewParams(
 		TrustDepositMaxYieldRate:     trustDepositMaxYieldRate,
 		YieldIntermediatePool:        yieldIntermediatePool,
 		TrustDepositBlockRewardShare: trustDepositBlockRewardShare,
+		ShareValueCheckpointIntervalSeconds: shareValueCheckpointIntervalSeconds,
+		ShareValueHistoryRetentionDays:      shareValueHistoryRetentionDays,
+		TrustDepositUnbondingDays:           trustDepositUnbondingDays,
+		CompensationPoolShare:               compensationPoolShare,
+		YieldCurveMinRate:                   yieldCurveMinRate,
+		YieldCurveMaxRate:                   yieldCurveMaxRate,
+		YieldCurveKinkRate:                  yieldCurveKinkRate,
+		YieldCurveTargetRatio:               yieldCurveTargetRatio,
+		YieldCurveRatioBasis:                yieldCurveRatioBasis,
 	}
 }
 
@@ -63,6 +87,7 @@ func DefaultParams() Params {
 	UserAgentRewardRate, _ := math.LegacyNewDecFromStr(DefaultUserAgentRewardRate)
 	TrustDepositMaxYieldRate, _ := math.LegacyNewDecFromStr(DefaultTrustDepositMaxYieldRate)
 	TrustDepositBlockRewardShare, _ := math.LegacyNewDecFromStr(DefaultTrustDepositBlockRewardShare)
+	CompensationPoolShare, _ := math.LegacyNewDecFromStr(DefaultCompensationPoolShare)
 
 	// Default yield intermediate pool is the module account address derived from the module account name.
 	defaultYieldIntermediatePool := authtypes.NewModuleAddress(YieldIntermediatePool).String()
@@ -76,6 +101,16 @@ func DefaultParams() Params {
 		TrustDepositMaxYieldRate,
 		defaultYieldIntermediatePool,
 		TrustDepositBlockRewardShare,
+		DefaultShareValueCheckpointIntervalSeconds,
+		DefaultShareValueHistoryRetentionDays,
+		DefaultTrustDepositUnbondingDays,
+		CompensationPoolShare,
+		// the yield curve is disabled by default
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		DefaultYieldCurveRatioBasis,
 	)
 }
 
@@ -122,6 +157,51 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.TrustDepositBlockRewardShare,
 			validateLegacyDec,
 		),
+		paramtypes.NewParamSetPair(
+			[]byte("ShareValueCheckpointIntervalSeconds"),
+			&p.ShareValueCheckpointIntervalSeconds,
+			validateUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("ShareValueHistoryRetentionDays"),
+			&p.ShareValueHistoryRetentionDays,
+			validateUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("TrustDepositUnbondingDays"),
+			&p.TrustDepositUnbondingDays,
+			validateUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("CompensationPoolShare"),
+			&p.CompensationPoolShare,
+			validateLegacyDec,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldCurveMinRate"),
+			&p.YieldCurveMinRate,
+			validateLegacyDec,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldCurveMaxRate"),
+			&p.YieldCurveMaxRate,
+			validateLegacyDec,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldCurveKinkRate"),
+			&p.YieldCurveKinkRate,
+			validateLegacyDec,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldCurveTargetRatio"),
+			&p.YieldCurveTargetRatio,
+			validateLegacyDec,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldCurveRatioBasis"),
+			&p.YieldCurveRatioBasis,
+			validateString,
+		),
 	}
 }
 
@@ -153,6 +233,21 @@ func (p Params) Validate() error {
 	if err := validateLegacyDec(p.TrustDepositBlockRewardShare); err != nil {
 		return err
 	}
+	// A history without retention would be pruned as soon as it is written
+	if p.ShareValueCheckpointIntervalSeconds > 0 && p.ShareValueHistoryRetentionDays == 0 {
+		return fmt.Errorf("share_value_history_retention_days must be positive when share value checkpoints are enabled")
+	}
+	if p.CompensationPoolShare.IsNil() {
+		return fmt.Errorf("compensation_pool_share must be set")
+	}
+	if err := validateLegacyDec(p.CompensationPoolShare); err != nil {
+		return err
+	}
+	if p.YieldCurveEnabled() {
+		if err := p.validateYieldCurve(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -38,6 +38,29 @@ type Params struct {
 	YieldIntermediatePool string `protobuf:"bytes,7,opt,name=yield_intermediate_pool,json=yieldIntermediatePool,proto3" json:"yield_intermediate_pool,omitempty" yaml:"yield_intermediate_pool"`
 	// trust_deposit_block_reward_share is the fraction of block rewards allocated to trust deposit yield (e.g. "0.2" for 20%)
 	TrustDepositBlockRewardShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=trust_deposit_block_reward_share,json=trustDepositBlockRewardShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_block_reward_share" yaml:"trust_deposit_block_reward_share"`
+	// share_value_checkpoint_interval_seconds is the minimum time between two
+	// share value checkpoints. 0 disables the share value history.
+	ShareValueCheckpointIntervalSeconds uint64 `protobuf:"varint,9,opt,name=share_value_checkpoint_interval_seconds,json=shareValueCheckpointIntervalSeconds,proto3" json:"share_value_checkpoint_interval_seconds,omitempty" yaml:"share_value_checkpoint_interval_seconds"`
+	// share_value_history_retention_days is how long share value checkpoints
+	// are kept before being pruned
+	ShareValueHistoryRetentionDays uint64 `protobuf:"varint,10,opt,name=share_value_history_retention_days,json=shareValueHistoryRetentionDays,proto3" json:"share_value_history_retention_days,omitempty" yaml:"share_value_history_retention_days"`
+	// trust_deposit_unbonding_days is how long a released trust deposit stays
+	// slashable before it becomes claimable. 0 makes it claimable at once.
+	TrustDepositUnbondingDays uint64 `protobuf:"varint,11,opt,name=trust_deposit_unbonding_days,json=trustDepositUnbondingDays,proto3" json:"trust_deposit_unbonding_days,omitempty" yaml:"trust_deposit_unbonding_days"`
+	// compensation_pool_share is the fraction of an ecosystem slash moved to
+	// the compensation pool of the ecosystem instead of being burned
+	CompensationPoolShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=compensation_pool_share,json=compensationPoolShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"compensation_pool_share" yaml:"compensation_pool_share"`
+	// The optional yield curve replaces trust_deposit_max_yield_rate when
+	// yield_curve_max_rate is positive. The rate falls from yield_curve_max_rate
+	// when nothing is deposited to yield_curve_kink_rate at a deposit ratio of
+	// yield_curve_target_ratio, then to yield_curve_min_rate at a ratio of 1.
+	// The deposit ratio is the trust deposit over yield_curve_ratio_basis.
+	YieldCurveMinRate     cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=yield_curve_min_rate,json=yieldCurveMinRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_curve_min_rate" yaml:"yield_curve_min_rate"`
+	YieldCurveMaxRate     cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=yield_curve_max_rate,json=yieldCurveMaxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_curve_max_rate" yaml:"yield_curve_max_rate"`
+	YieldCurveKinkRate    cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=yield_curve_kink_rate,json=yieldCurveKinkRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_curve_kink_rate" yaml:"yield_curve_kink_rate"`
+	YieldCurveTargetRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=yield_curve_target_ratio,json=yieldCurveTargetRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_curve_target_ratio" yaml:"yield_curve_target_ratio"`
+	// yield_curve_ratio_basis is bonded_stake or total_supply
+	YieldCurveRatioBasis string `protobuf:"bytes,17,opt,name=yield_curve_ratio_basis,json=yieldCurveRatioBasis,proto3" json:"yield_curve_ratio_basis,omitempty" yaml:"yield_curve_ratio_basis"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +103,34 @@ func (m *Params) GetYieldIntermediatePool() string {
 	return ""
 }
 
+func (m *Params) GetShareValueCheckpointIntervalSeconds() uint64 {
+	if m != nil {
+		return m.ShareValueCheckpointIntervalSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetShareValueHistoryRetentionDays() uint64 {
+	if m != nil {
+		return m.ShareValueHistoryRetentionDays
+	}
+	return 0
+}
+
+func (m *Params) GetTrustDepositUnbondingDays() uint64 {
+	if m != nil {
+		return m.TrustDepositUnbondingDays
+	}
+	return 0
+}
+
+func (m *Params) GetYieldCurveRatioBasis() string {
+	if m != nil {
+		return m.YieldCurveRatioBasis
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "verana.td.v1.Params")
 }
@@ -87,42 +138,62 @@ func init() {
 func init() { proto.RegisterFile("verana/td/v1/params.proto", fileDescriptor_81f1920ecd6d86c2) }
 
 var fileDescriptor_81f1920ecd6d86c2 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x3b, 0xfe, 0xa9, 0xeb, 0xe0, 0x41, 0xa3, 0xae, 0xed, 0x76, 0x37, 0x29, 0x51, 0x74,
-	0x11, 0x4c, 0x58, 0xbc, 0xed, 0x49, 0x4b, 0x41, 0xfc, 0xb3, 0xb2, 0x44, 0x14, 0xdc, 0x4b, 0x78,
-	0x9b, 0x0c, 0x6d, 0xd8, 0x49, 0xa6, 0xcc, 0x4c, 0xba, 0xed, 0xd5, 0xe3, 0x82, 0x20, 0x08, 0x9e,
-	0xfd, 0x08, 0x7e, 0x01, 0xef, 0x7b, 0xdc, 0xa3, 0x78, 0x28, 0xd2, 0x1e, 0xf4, 0xbc, 0x9f, 0x40,
-	0x66, 0x66, 0x85, 0x54, 0x53, 0x37, 0x97, 0x32, 0xf4, 0x79, 0xf3, 0x3c, 0xbf, 0xf7, 0x9d, 0xe4,
-	0xc5, 0xcd, 0x11, 0xe1, 0x90, 0x81, 0x2f, 0x63, 0x7f, 0xb4, 0xe5, 0x0f, 0x81, 0x43, 0x2a, 0xbc,
-	0x21, 0x67, 0x92, 0x59, 0x57, 0x8c, 0xe4, 0xc9, 0xd8, 0x1b, 0x6d, 0xad, 0x5d, 0x83, 0x34, 0xc9,
-	0x98, 0xaf, 0x7f, 0x4d, 0xc1, 0xda, 0x8d, 0x3e, 0xeb, 0x33, 0x7d, 0xf4, 0xd5, 0xc9, 0xfc, 0xeb,
-	0x7e, 0x5d, 0xc1, 0xf5, 0x5d, 0xed, 0x63, 0x7d, 0x44, 0xd8, 0x91, 0x3c, 0x17, 0x32, 0x8c, 0xc9,
-	0x90, 0x89, 0x44, 0x86, 0x9c, 0x44, 0x14, 0x92, 0x34, 0xec, 0xe5, 0x3c, 0x0b, 0x39, 0x48, 0xd2,
-	0x40, 0x6d, 0xb4, 0x79, 0xb9, 0xb3, 0x73, 0x34, 0x75, 0x6a, 0xdf, 0xa7, 0x4e, 0x2b, 0x62, 0x22,
-	0x65, 0x42, 0xc4, 0xfb, 0x5e, 0xc2, 0xfc, 0x14, 0xe4, 0xc0, 0x7b, 0x41, 0xfa, 0x10, 0x4d, 0xba,
-	0x24, 0x3a, 0x99, 0x3a, 0x77, 0x27, 0x90, 0xd2, 0x6d, 0xf7, 0x0c, 0x4f, 0x37, 0x68, 0xe9, 0x8a,
-	0xae, 0x29, 0x08, 0x8c, 0xde, 0xc9, 0x79, 0x16, 0x80, 0x24, 0xd6, 0x3b, 0x84, 0x9b, 0x8b, 0x0e,
-	0x62, 0x00, 0x9c, 0x84, 0x23, 0xa0, 0x39, 0x69, 0x9c, 0xd3, 0x3c, 0x4f, 0xaa, 0xf1, 0xb4, 0xcb,
-	0x78, 0x0a, 0x6e, 0x6e, 0xb0, 0x5a, 0x24, 0x79, 0xa5, 0x94, 0x37, 0x4a, 0xb0, 0x32, 0x6c, 0xfd,
-	0xd5, 0x85, 0x1a, 0xc6, 0x79, 0x1d, 0xfe, 0xa8, 0x5a, 0x78, 0xb3, 0x74, 0x18, 0xba, 0xff, 0xab,
-	0x0b, 0xfd, 0xab, 0xa6, 0xdf, 0x23, 0xbc, 0x71, 0x00, 0x94, 0x12, 0x19, 0xe6, 0x82, 0xf0, 0x10,
-	0xfa, 0x24, 0x53, 0xa3, 0x3b, 0x00, 0x1e, 0x9b, 0xec, 0x0b, 0x3a, 0xfb, 0x79, 0xb5, 0xec, 0x3b,
-	0x26, 0xfb, 0xbf, 0x8e, 0x6e, 0xd0, 0x34, 0xfa, 0x6b, 0x41, 0xf8, 0x63, 0xa5, 0x06, 0x5a, 0xd4,
-	0x3c, 0x13, 0xbc, 0xba, 0x84, 0xe3, 0xa2, 0xe6, 0xe8, 0x56, 0xe3, 0xd8, 0x30, 0x1c, 0xcb, 0x00,
-	0xae, 0xe7, 0x25, 0xd1, 0x87, 0x08, 0xaf, 0x2f, 0x0e, 0x2d, 0x85, 0x71, 0x38, 0x49, 0x08, 0x3d,
-	0x25, 0xa8, 0x6b, 0x82, 0x67, 0xd5, 0x08, 0x6e, 0x97, 0xdd, 0xc2, 0xa2, 0xa1, 0x1b, 0x34, 0x8a,
-	0xf7, 0xb1, 0x03, 0xe3, 0xb7, 0x4a, 0xd3, 0x30, 0x7b, 0xf8, 0x96, 0x29, 0x4c, 0x32, 0x49, 0x78,
-	0x4a, 0xe2, 0x04, 0x24, 0x09, 0x87, 0x8c, 0xd1, 0xc6, 0x25, 0x8d, 0xe1, 0x9e, 0x4c, 0x1d, 0xdb,
-	0x64, 0x2c, 0x29, 0x74, 0x83, 0x9b, 0x5a, 0x79, 0x5a, 0x10, 0x76, 0x19, 0xa3, 0xd6, 0x27, 0x84,
-	0xdb, 0x8b, 0x5c, 0x3d, 0xca, 0xa2, 0xfd, 0x3f, 0x23, 0xd2, 0xef, 0x69, 0x63, 0x45, 0xa7, 0xbc,
-	0xac, 0xd6, 0xec, 0xbd, 0xb2, 0x66, 0xff, 0x35, 0x75, 0x83, 0xf5, 0x62, 0xc3, 0x1d, 0x55, 0x60,
-	0x2e, 0x40, 0x7f, 0x01, 0xdb, 0xad, 0x5f, 0x9f, 0x1d, 0x74, 0xf8, 0xf3, 0xcb, 0x7d, 0xeb, 0x74,
-	0xfb, 0x8c, 0xd5, 0xfe, 0x31, 0x4b, 0xa3, 0xd3, 0x39, 0x9a, 0xd9, 0xe8, 0x78, 0x66, 0xa3, 0x1f,
-	0x33, 0x1b, 0x7d, 0x98, 0xdb, 0xb5, 0xe3, 0xb9, 0x5d, 0xfb, 0x36, 0xb7, 0x6b, 0x7b, 0x9b, 0xfd,
-	0x44, 0x0e, 0xf2, 0x9e, 0x17, 0xb1, 0xd4, 0x37, 0x0f, 0x3e, 0xa0, 0xd0, 0x13, 0x7e, 0xd1, 0x44,
-	0x4e, 0x86, 0x44, 0xf4, 0xea, 0x7a, 0x15, 0x3d, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xbc,
-	0xcf, 0xb9, 0xde, 0x04, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4f, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0xb3, 0x50, 0x02, 0x1d, 0x0a, 0x24, 0x4b, 0xd2, 0xae, 0x9b, 0xd4, 0x1b, 0x4d, 0x11,
+	0x09, 0x48, 0xf5, 0xaa, 0x70, 0xeb, 0x09, 0x36, 0xe1, 0x4f, 0x29, 0x41, 0xd5, 0x94, 0x22, 0xb5,
+	0x97, 0xd5, 0x78, 0x77, 0x64, 0x8f, 0xbc, 0x3b, 0x63, 0xcd, 0xcc, 0xba, 0xde, 0x03, 0x42, 0xe2,
+	0x84, 0x2a, 0x21, 0x21, 0x21, 0x71, 0xe6, 0x23, 0xf0, 0x31, 0x7a, 0xec, 0x11, 0x71, 0xb0, 0x50,
+	0x72, 0x00, 0x71, 0xf4, 0x27, 0x40, 0xfb, 0x8e, 0x9d, 0xec, 0xc6, 0x36, 0xac, 0xd4, 0x4b, 0x64,
+	0xcd, 0xfb, 0xec, 0xf3, 0x7b, 0xde, 0xf9, 0x97, 0x41, 0xad, 0x11, 0x53, 0x54, 0xd0, 0xc0, 0x24,
+	0xc1, 0xe8, 0x76, 0x30, 0xa4, 0x8a, 0x66, 0xba, 0x33, 0x54, 0xd2, 0x48, 0xf7, 0x8a, 0x2d, 0x75,
+	0x4c, 0xd2, 0x19, 0xdd, 0xbe, 0xbe, 0x49, 0x33, 0x2e, 0x64, 0x00, 0x7f, 0xad, 0xe0, 0xfa, 0x56,
+	0x4f, 0xf6, 0x24, 0xfc, 0x0c, 0xca, 0x5f, 0x76, 0x14, 0xff, 0xb3, 0x81, 0xd6, 0xef, 0x83, 0x8f,
+	0xfb, 0xb3, 0x83, 0x7c, 0xa3, 0x72, 0x6d, 0xa2, 0x84, 0x0d, 0xa5, 0xe6, 0x26, 0x52, 0x2c, 0x4e,
+	0x29, 0xcf, 0xa2, 0x6e, 0xae, 0x44, 0xa4, 0xa8, 0x61, 0x9e, 0xb3, 0xe7, 0x1c, 0x5c, 0x0e, 0x8f,
+	0x9f, 0x4d, 0xfc, 0xb5, 0x3f, 0x26, 0xfe, 0x4e, 0x2c, 0x75, 0x26, 0xb5, 0x4e, 0x06, 0x1d, 0x2e,
+	0x83, 0x8c, 0x9a, 0x7e, 0xe7, 0x4b, 0xd6, 0xa3, 0x71, 0x71, 0xc4, 0xe2, 0xe9, 0xc4, 0x7f, 0xb7,
+	0xa0, 0x59, 0x7a, 0x07, 0xff, 0x8f, 0x27, 0x26, 0x3b, 0xa0, 0x38, 0xb2, 0x02, 0x62, 0xeb, 0x61,
+	0xae, 0x04, 0xa1, 0x86, 0xb9, 0xdf, 0x3b, 0xa8, 0x55, 0x77, 0xd0, 0x7d, 0xaa, 0x58, 0x34, 0xa2,
+	0x69, 0xce, 0xbc, 0x97, 0x20, 0xcf, 0x67, 0xcd, 0xf2, 0xec, 0x2d, 0xcb, 0x53, 0x71, 0xc3, 0xe4,
+	0x6a, 0x35, 0xc9, 0x83, 0xb2, 0xf2, 0x4d, 0x59, 0x70, 0x05, 0x72, 0x2f, 0x74, 0x51, 0x4e, 0xc6,
+	0xcb, 0x00, 0xff, 0xa8, 0x19, 0xbc, 0xb5, 0x74, 0x32, 0xa0, 0xff, 0x8d, 0x5a, 0xff, 0x65, 0xd3,
+	0x3f, 0x3a, 0xe8, 0xc6, 0x13, 0x9a, 0xa6, 0xcc, 0x44, 0xb9, 0x66, 0x2a, 0xa2, 0x3d, 0x26, 0xca,
+	0xa9, 0x7b, 0x42, 0x55, 0x62, 0xd9, 0x97, 0x80, 0x7d, 0xaf, 0x19, 0xfb, 0x1d, 0xcb, 0xfe, 0x4f,
+	0x47, 0x4c, 0x5a, 0xb6, 0xfe, 0x50, 0x33, 0xf5, 0x71, 0x59, 0x25, 0x50, 0x84, 0x3c, 0x05, 0xba,
+	0xba, 0x22, 0xc7, 0x2b, 0x90, 0xe3, 0xa8, 0x59, 0x8e, 0x1b, 0x36, 0xc7, 0xaa, 0x00, 0x6f, 0xe7,
+	0x4b, 0xd0, 0x4f, 0x1d, 0xb4, 0x5b, 0x9f, 0xb4, 0x8c, 0x8e, 0xa3, 0x82, 0xb3, 0x74, 0x96, 0x60,
+	0x1d, 0x12, 0x7c, 0xd1, 0x2c, 0xc1, 0xcd, 0x65, 0xab, 0x50, 0x37, 0xc4, 0xc4, 0xab, 0xae, 0xc7,
+	0x31, 0x1d, 0x3f, 0x2a, 0x6b, 0x10, 0xe6, 0x31, 0xba, 0x66, 0x85, 0x5c, 0x18, 0xa6, 0x32, 0x96,
+	0x70, 0x6a, 0x58, 0x34, 0x94, 0x32, 0xf5, 0x5e, 0x85, 0x18, 0x78, 0x3a, 0xf1, 0xdb, 0x96, 0xb1,
+	0x42, 0x88, 0xc9, 0x36, 0x54, 0xee, 0x56, 0x0a, 0xf7, 0xa5, 0x4c, 0xdd, 0x5f, 0x1c, 0xb4, 0x57,
+	0xcf, 0xd5, 0x4d, 0x65, 0x3c, 0x98, 0x4f, 0x11, 0xec, 0x53, 0xef, 0x35, 0xa0, 0x7c, 0xd5, 0xac,
+	0xd9, 0xfd, 0x65, 0xcd, 0x2e, 0x9a, 0x62, 0xb2, 0x5b, 0x6d, 0x38, 0x2c, 0x05, 0x76, 0x01, 0xe0,
+	0x04, 0xb8, 0x3f, 0x38, 0x68, 0xbf, 0x72, 0x4a, 0xa2, 0xb8, 0xcf, 0xe2, 0xc1, 0x50, 0x72, 0x61,
+	0x6c, 0x77, 0x23, 0x9a, 0x46, 0x9a, 0xc5, 0x52, 0x24, 0xda, 0xbb, 0xbc, 0xe7, 0x1c, 0x5c, 0x0a,
+	0x3f, 0x98, 0x4e, 0xfc, 0x8e, 0x85, 0x37, 0xfc, 0x10, 0x93, 0x9b, 0xfa, 0xec, 0xb8, 0x1d, 0x9e,
+	0xe9, 0xee, 0xce, 0x64, 0x0f, 0xac, 0xca, 0x2d, 0x50, 0xcd, 0xb0, 0xcf, 0xb5, 0x91, 0xaa, 0x88,
+	0x14, 0x33, 0x4c, 0x18, 0x2e, 0x45, 0x94, 0xd0, 0x42, 0x7b, 0x08, 0x42, 0xdc, 0x9a, 0x4e, 0xfc,
+	0xf7, 0x16, 0x43, 0x2c, 0xff, 0x06, 0x93, 0xf6, 0x39, 0xff, 0x73, 0x2b, 0x21, 0x73, 0xc5, 0x11,
+	0x2d, 0xb4, 0xdb, 0xbf, 0xb8, 0x0d, 0x73, 0xd1, 0x95, 0x22, 0xe1, 0xa2, 0x67, 0xa1, 0xaf, 0x03,
+	0x74, 0x7f, 0xd5, 0x1e, 0xab, 0xab, 0x31, 0x69, 0x55, 0xa7, 0xfc, 0xe1, 0xbc, 0x08, 0xa4, 0x6f,
+	0xd1, 0xb5, 0x58, 0x66, 0x43, 0x26, 0x34, 0x85, 0x7c, 0xe5, 0xae, 0x99, 0x2d, 0xff, 0x15, 0x58,
+	0xfe, 0x4f, 0x9a, 0x2d, 0xff, 0x6c, 0x1f, 0xae, 0xf0, 0xc2, 0x64, 0xbb, 0x5a, 0x29, 0xb7, 0xa0,
+	0x5d, 0x6e, 0x8d, 0xb6, 0xec, 0xd6, 0x8d, 0x73, 0x35, 0x62, 0x51, 0xc6, 0x67, 0x57, 0xff, 0x1b,
+	0xc0, 0x0e, 0x9b, 0xb1, 0x77, 0xaa, 0x67, 0xa0, 0x6e, 0x84, 0xc9, 0x26, 0x0c, 0x1f, 0x96, 0xa3,
+	0xc7, 0xdc, 0xde, 0xf2, 0x17, 0xa1, 0x74, 0x6c, 0xa1, 0x6f, 0xbe, 0x28, 0x74, 0x66, 0x54, 0x87,
+	0xd2, 0x31, 0x40, 0x47, 0x68, 0xbb, 0xaa, 0x1d, 0x70, 0x31, 0xb0, 0xd4, 0xb7, 0x80, 0x7a, 0xd8,
+	0x8c, 0xba, 0xbb, 0x48, 0x3d, 0x73, 0xc2, 0xc4, 0x3d, 0xc7, 0xde, 0xe3, 0x62, 0x00, 0xdc, 0xef,
+	0x90, 0x57, 0x55, 0x1b, 0xaa, 0x7a, 0x0c, 0xfe, 0x17, 0x70, 0xe9, 0x6d, 0x00, 0xfa, 0xd3, 0x66,
+	0x68, 0x7f, 0x11, 0x5d, 0x35, 0x9b, 0x5f, 0x35, 0x40, 0xff, 0x1a, 0x0a, 0xa4, 0x1c, 0x77, 0x1f,
+	0xcd, 0xaf, 0x31, 0xfb, 0x0d, 0x88, 0xa3, 0x2e, 0xd5, 0x5c, 0x7b, 0x9b, 0xcb, 0xaf, 0xb1, 0x05,
+	0x21, 0x26, 0x5b, 0xe7, 0xde, 0xe0, 0x1a, 0x96, 0xc3, 0x77, 0x76, 0xfe, 0xfe, 0xd5, 0x77, 0x9e,
+	0xfe, 0xf5, 0xdb, 0xfb, 0xee, 0xec, 0xa9, 0x32, 0x2e, 0x1f, 0x2b, 0xf6, 0x85, 0x11, 0x86, 0xcf,
+	0x4e, 0xda, 0xce, 0xf3, 0x93, 0xb6, 0xf3, 0xe7, 0x49, 0xdb, 0xf9, 0xe9, 0xb4, 0xbd, 0xf6, 0xfc,
+	0xb4, 0xbd, 0xf6, 0xfb, 0x69, 0x7b, 0xed, 0xf1, 0x41, 0x8f, 0x9b, 0x7e, 0xde, 0xed, 0xc4, 0x32,
+	0x0b, 0xec, 0x87, 0xb7, 0x52, 0xda, 0xd5, 0x41, 0xd5, 0xc4, 0x14, 0x43, 0xa6, 0xbb, 0xeb, 0xf0,
+	0x6e, 0xf9, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x1f, 0x8b, 0x5c, 0x0b, 0x09, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -168,6 +239,33 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.TrustDepositBlockRewardShare.Equal(that1.TrustDepositBlockRewardShare) {
 		return false
 	}
+	if this.ShareValueCheckpointIntervalSeconds != that1.ShareValueCheckpointIntervalSeconds {
+		return false
+	}
+	if this.ShareValueHistoryRetentionDays != that1.ShareValueHistoryRetentionDays {
+		return false
+	}
+	if this.TrustDepositUnbondingDays != that1.TrustDepositUnbondingDays {
+		return false
+	}
+	if !this.CompensationPoolShare.Equal(that1.CompensationPoolShare) {
+		return false
+	}
+	if !this.YieldCurveMinRate.Equal(that1.YieldCurveMinRate) {
+		return false
+	}
+	if !this.YieldCurveMaxRate.Equal(that1.YieldCurveMaxRate) {
+		return false
+	}
+	if !this.YieldCurveKinkRate.Equal(that1.YieldCurveKinkRate) {
+		return false
+	}
+	if !this.YieldCurveTargetRatio.Equal(that1.YieldCurveTargetRatio) {
+		return false
+	}
+	if this.YieldCurveRatioBasis != that1.YieldCurveRatioBasis {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -190,6 +288,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.YieldCurveRatioBasis) > 0 {
+		i -= len(m.YieldCurveRatioBasis)
+		copy(dAtA[i:], m.YieldCurveRatioBasis)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.YieldCurveRatioBasis)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	{
+		size := m.YieldCurveTargetRatio.Size()
+		i -= size
+		if _, err := m.YieldCurveTargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.YieldCurveKinkRate.Size()
+		i -= size
+		if _, err := m.YieldCurveKinkRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.YieldCurveMaxRate.Size()
+		i -= size
+		if _, err := m.YieldCurveMaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.YieldCurveMinRate.Size()
+		i -= size
+		if _, err := m.YieldCurveMinRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.CompensationPoolShare.Size()
+		i -= size
+		if _, err := m.CompensationPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.TrustDepositUnbondingDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TrustDepositUnbondingDays))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ShareValueHistoryRetentionDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ShareValueHistoryRetentionDays))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ShareValueCheckpointIntervalSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ShareValueCheckpointIntervalSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.TrustDepositBlockRewardShare.Size()
 		i -= size
@@ -305,6 +479,29 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.TrustDepositBlockRewardShare.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.ShareValueCheckpointIntervalSeconds != 0 {
+		n += 1 + sovParams(uint64(m.ShareValueCheckpointIntervalSeconds))
+	}
+	if m.ShareValueHistoryRetentionDays != 0 {
+		n += 1 + sovParams(uint64(m.ShareValueHistoryRetentionDays))
+	}
+	if m.TrustDepositUnbondingDays != 0 {
+		n += 1 + sovParams(uint64(m.TrustDepositUnbondingDays))
+	}
+	l = m.CompensationPoolShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.YieldCurveMinRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.YieldCurveMaxRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.YieldCurveKinkRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.YieldCurveTargetRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.YieldCurveRatioBasis)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -613,6 +810,265 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValueCheckpointIntervalSeconds", wireType)
+			}
+			m.ShareValueCheckpointIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareValueCheckpointIntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValueHistoryRetentionDays", wireType)
+			}
+			m.ShareValueHistoryRetentionDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareValueHistoryRetentionDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositUnbondingDays", wireType)
+			}
+			m.TrustDepositUnbondingDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustDepositUnbondingDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompensationPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldCurveMinRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldCurveMinRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldCurveMaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldCurveMaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldCurveKinkRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldCurveKinkRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldCurveTargetRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldCurveTargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldCurveRatioBasis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YieldCurveRatioBasis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
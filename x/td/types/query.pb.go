@@ -5,25 +5,32 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -204,346 +211,5943 @@ func (m *QueryGetTrustDepositResponse) GetTrustDeposit() TrustDeposit {
 	return TrustDeposit{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "verana.td.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "verana.td.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetTrustDepositRequest)(nil), "verana.td.v1.QueryGetTrustDepositRequest")
-	proto.RegisterType((*QueryGetTrustDepositResponse)(nil), "verana.td.v1.QueryGetTrustDepositResponse")
+// QueryGetCorporationTrustDepositRequest is request type for the GetCorporationTrustDeposit RPC method
+type QueryGetCorporationTrustDepositRequest struct {
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/td/v1/query.proto", fileDescriptor_1e3f9fbb2238b25c) }
-
-var fileDescriptor_1e3f9fbb2238b25c = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0x86, 0x93, 0x8b, 0x16, 0xee, 0xdc, 0x7b, 0xb9, 0x38, 0x56, 0xa8, 0xb1, 0xa4, 0x6d, 0x36,
-	0xd6, 0x82, 0x19, 0x52, 0x17, 0x2e, 0x85, 0xa0, 0xb8, 0x13, 0x0d, 0xae, 0xdc, 0xc8, 0xa4, 0x19,
-	0x62, 0xa0, 0xc9, 0x99, 0x66, 0x26, 0xc1, 0x22, 0x2e, 0xf4, 0x09, 0x14, 0x5f, 0xc2, 0xa5, 0x8f,
-	0xd1, 0x65, 0xc1, 0x8d, 0x2b, 0x91, 0x56, 0xf0, 0x35, 0x24, 0x33, 0x53, 0x48, 0xb4, 0x88, 0x9b,
-	0x30, 0x9c, 0xff, 0x9f, 0x7f, 0xbe, 0x73, 0x72, 0xd0, 0xa0, 0x66, 0x25, 0x2d, 0x28, 0x91, 0x09,
-	0xa9, 0x03, 0xb2, 0xaa, 0x58, 0xb9, 0xf6, 0x79, 0x09, 0x12, 0xf0, 0xb9, 0x56, 0x7c, 0x99, 0xf8,
-	0x75, 0xe0, 0x5c, 0xa3, 0x79, 0x56, 0x00, 0x51, 0x5f, 0x6d, 0x70, 0x66, 0x0b, 0x10, 0x39, 0x08,
-	0x12, 0x53, 0xc1, 0xf4, 0x4d, 0x52, 0x07, 0x31, 0x93, 0x34, 0x20, 0x9c, 0xa6, 0x59, 0x41, 0x65,
-	0x06, 0x85, 0xf1, 0xf6, 0x53, 0x48, 0x41, 0x1d, 0x49, 0x73, 0x32, 0xd5, 0x61, 0x0a, 0x90, 0x2e,
-	0x19, 0xa1, 0x3c, 0x23, 0xb4, 0x28, 0x40, 0xaa, 0x2b, 0xc2, 0xa8, 0x37, 0x3b, 0x68, 0x9c, 0x96,
-	0x34, 0x3f, 0x48, 0x5d, 0x6a, 0xb9, 0xe6, 0xcc, 0x28, 0x5e, 0x1f, 0xe1, 0x67, 0x0d, 0xca, 0x53,
-	0x65, 0x8f, 0xd8, 0xaa, 0x62, 0x42, 0x7a, 0x4f, 0xd0, 0xf5, 0x4e, 0x55, 0x70, 0x28, 0x04, 0xc3,
-	0xf7, 0x51, 0x4f, 0xc7, 0x0e, 0xec, 0xb1, 0x3d, 0x3d, 0x9b, 0xf7, 0xfd, 0x76, 0xcf, 0xbe, 0x76,
-	0x87, 0xa7, 0x9b, 0xef, 0x23, 0xeb, 0xf3, 0xaf, 0x2f, 0x33, 0x3b, 0x32, 0x76, 0xef, 0x01, 0xba,
-	0xa5, 0xf2, 0x1e, 0x33, 0xf9, 0xbc, 0xac, 0x84, 0x7c, 0xc8, 0x38, 0x88, 0x4c, 0x9a, 0xe7, 0xf0,
-	0x18, 0x9d, 0x2d, 0xa0, 0xe4, 0x50, 0xaa, 0x7e, 0x54, 0xf8, 0x69, 0xd4, 0x2e, 0x79, 0x0c, 0x0d,
-	0x8f, 0x07, 0x18, 0xb2, 0x47, 0xe8, 0x42, 0x36, 0xf5, 0x97, 0x89, 0x16, 0x0c, 0xa0, 0xd3, 0x05,
-	0x6c, 0x5f, 0x0d, 0xaf, 0x34, 0x98, 0xd1, 0xb9, 0x6c, 0xd5, 0xe6, 0xef, 0x4e, 0xd0, 0x55, 0xf5,
-	0x0e, 0x4e, 0x50, 0x4f, 0xb7, 0x83, 0xc7, 0xdd, 0x8c, 0xbf, 0xa7, 0xe5, 0x4c, 0xfe, 0xe1, 0xd0,
-	0x7c, 0xde, 0x8d, 0xf7, 0x5f, 0x7f, 0x7e, 0x3a, 0xb9, 0xc4, 0x17, 0x9d, 0xbf, 0x83, 0x3f, 0xda,
-	0xe8, 0xf2, 0x8f, 0x96, 0xf0, 0x9d, 0x23, 0x69, 0xc7, 0xe7, 0xe6, 0xcc, 0xfe, 0xc7, 0x6a, 0x08,
-	0x6e, 0x2b, 0x82, 0x09, 0x1e, 0x91, 0xce, 0x2e, 0xa4, 0x4c, 0x92, 0x37, 0xad, 0x49, 0xbf, 0x0d,
-	0xc3, 0xcd, 0xce, 0xb5, 0xb7, 0x3b, 0xd7, 0xfe, 0xb1, 0x73, 0xed, 0x0f, 0x7b, 0xd7, 0xda, 0xee,
-	0x5d, 0xeb, 0xdb, 0xde, 0xb5, 0x5e, 0x4c, 0xd3, 0x4c, 0xbe, 0xaa, 0x62, 0x7f, 0x01, 0xb9, 0x09,
-	0xb9, 0xbb, 0xa4, 0xb1, 0x38, 0x04, 0xbe, 0x6e, 0x22, 0xd5, 0x6e, 0xc5, 0x3d, 0xb5, 0x5c, 0xf7,
-	0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x42, 0xdb, 0x32, 0xd2, 0x2e, 0x03, 0x00, 0x00,
+func (m *QueryGetCorporationTrustDepositRequest) Reset() {
+	*m = QueryGetCorporationTrustDepositRequest{}
+}
+func (m *QueryGetCorporationTrustDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCorporationTrustDepositRequest) ProtoMessage()    {}
+func (*QueryGetCorporationTrustDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{4}
+}
+func (m *QueryGetCorporationTrustDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCorporationTrustDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCorporationTrustDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCorporationTrustDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCorporationTrustDepositRequest.Merge(m, src)
+}
+func (m *QueryGetCorporationTrustDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCorporationTrustDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCorporationTrustDepositRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetCorporationTrustDepositRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error)
+func (m *QueryGetCorporationTrustDepositRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetCorporationTrustDepositResponse is response type for the GetCorporationTrustDeposit RPC method
+type QueryGetCorporationTrustDepositResponse struct {
+	TrustDeposit TrustDeposit `protobuf:"bytes,1,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetCorporationTrustDepositResponse) Reset() {
+	*m = QueryGetCorporationTrustDepositResponse{}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetCorporationTrustDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCorporationTrustDepositResponse) ProtoMessage()    {}
+func (*QueryGetCorporationTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{5}
+}
+func (m *QueryGetCorporationTrustDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCorporationTrustDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCorporationTrustDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetCorporationTrustDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCorporationTrustDepositResponse.Merge(m, src)
+}
+func (m *QueryGetCorporationTrustDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCorporationTrustDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCorporationTrustDepositResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error) {
-	out := new(QueryGetTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCorporationTrustDepositResponse proto.InternalMessageInfo
+
+func (m *QueryGetCorporationTrustDepositResponse) GetTrustDeposit() TrustDeposit {
+	if m != nil {
+		return m.TrustDeposit
 	}
-	return out, nil
+	return TrustDeposit{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error)
+// QueryListTrustDepositsRequest is request type for the ListTrustDeposits RPC method
+type QueryListTrustDepositsRequest struct {
+	// has_slashed_balance only returns deposits with an outstanding slashed amount
+	HasSlashedBalance bool `protobuf:"varint,1,opt,name=has_slashed_balance,json=hasSlashedBalance,proto3" json:"has_slashed_balance,omitempty"`
+	// min_deposit only returns deposits of at least this amount
+	MinDeposit    uint64             `protobuf:"varint,2,opt,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	ModifiedAfter *time.Time         `protobuf:"bytes,3,opt,name=modified_after,json=modifiedAfter,proto3,stdtime" json:"modified_after,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryListTrustDepositsRequest) Reset()         { *m = QueryListTrustDepositsRequest{} }
+func (m *QueryListTrustDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListTrustDepositsRequest) ProtoMessage()    {}
+func (*QueryListTrustDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryListTrustDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetTrustDeposit(ctx context.Context, req *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDeposit not implemented")
+func (m *QueryListTrustDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListTrustDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryListTrustDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTrustDepositsRequest.Merge(m, src)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryListTrustDepositsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_GetTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTrustDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTrustDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Query/GetTrustDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTrustDeposit(ctx, req.(*QueryGetTrustDepositRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryListTrustDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTrustDepositsRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.td.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetTrustDeposit",
-			Handler:    _Query_GetTrustDeposit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/td/v1/query.proto",
-}
+var xxx_messageInfo_QueryListTrustDepositsRequest proto.InternalMessageInfo
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListTrustDepositsRequest) GetHasSlashedBalance() bool {
+	if m != nil {
+		return m.HasSlashedBalance
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListTrustDepositsRequest) GetMinDeposit() uint64 {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryListTrustDepositsRequest) GetModifiedAfter() *time.Time {
+	if m != nil {
+		return m.ModifiedAfter
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListTrustDepositsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryListTrustDepositsResponse is response type for the ListTrustDeposits RPC method
+type QueryListTrustDepositsResponse struct {
+	TrustDeposits []TrustDeposit      `protobuf:"bytes,1,rep,name=trust_deposits,json=trustDeposits,proto3" json:"trust_deposits"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryListTrustDepositsResponse) Reset()         { *m = QueryListTrustDepositsResponse{} }
+func (m *QueryListTrustDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListTrustDepositsResponse) ProtoMessage()    {}
+func (*QueryListTrustDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{7}
+}
+func (m *QueryListTrustDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListTrustDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListTrustDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetTrustDepositRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListTrustDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTrustDepositsResponse.Merge(m, src)
 }
-
-func (m *QueryGetTrustDepositRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListTrustDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListTrustDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTrustDepositsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTrustDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryListTrustDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryListTrustDepositsResponse) GetTrustDeposits() []TrustDeposit {
+	if m != nil {
+		return m.TrustDeposits
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListTrustDepositsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTrustDepositStatsRequest is request type for the TrustDepositStats RPC method
+type QueryTrustDepositStatsRequest struct {
 }
 
-func (m *QueryGetTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TrustDeposit.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTrustDepositStatsRequest) Reset()         { *m = QueryTrustDepositStatsRequest{} }
+func (m *QueryTrustDepositStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustDepositStatsRequest) ProtoMessage()    {}
+func (*QueryTrustDepositStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{8}
+}
+func (m *QueryTrustDepositStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustDepositStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustDepositStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTrustDepositStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustDepositStatsRequest.Merge(m, src)
+}
+func (m *QueryTrustDepositStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustDepositStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustDepositStatsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTrustDepositStatsRequest proto.InternalMessageInfo
+
+// QueryTrustDepositStatsResponse is response type for the TrustDepositStats RPC method
+type QueryTrustDepositStatsResponse struct {
+	TrustDepositCount uint64                      `protobuf:"varint,1,opt,name=trust_deposit_count,json=trustDepositCount,proto3" json:"trust_deposit_count,omitempty"`
+	TotalDeposit      uint64                      `protobuf:"varint,2,opt,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	TotalShare        cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=total_share,json=totalShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_share"`
+	ShareValue        cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=share_value,json=shareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_value"`
+	// total_slashed_deposit is the outstanding slashed amount, not yet repaid
+	TotalSlashedDeposit uint64 `protobuf:"varint,5,opt,name=total_slashed_deposit,json=totalSlashedDeposit,proto3" json:"total_slashed_deposit,omitempty"`
+	TotalRepaidDeposit  uint64 `protobuf:"varint,6,opt,name=total_repaid_deposit,json=totalRepaidDeposit,proto3" json:"total_repaid_deposit,omitempty"`
+	TotalBurnedDeposit  uint64 `protobuf:"varint,7,opt,name=total_burned_deposit,json=totalBurnedDeposit,proto3" json:"total_burned_deposit,omitempty"`
+	// module_balance is the balance of the trust deposit module account
+	ModuleBalance types.Coin `protobuf:"bytes,8,opt,name=module_balance,json=moduleBalance,proto3" json:"module_balance"`
+}
+
+func (m *QueryTrustDepositStatsResponse) Reset()         { *m = QueryTrustDepositStatsResponse{} }
+func (m *QueryTrustDepositStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustDepositStatsResponse) ProtoMessage()    {}
+func (*QueryTrustDepositStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{9}
+}
+func (m *QueryTrustDepositStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustDepositStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustDepositStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTrustDepositStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustDepositStatsResponse.Merge(m, src)
+}
+func (m *QueryTrustDepositStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustDepositStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustDepositStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrustDepositStatsResponse proto.InternalMessageInfo
+
+func (m *QueryTrustDepositStatsResponse) GetTrustDepositCount() uint64 {
+	if m != nil {
+		return m.TrustDepositCount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTrustDepositStatsResponse) GetTotalDeposit() uint64 {
+	if m != nil {
+		return m.TotalDeposit
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryGetTrustDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTrustDepositStatsResponse) GetTotalSlashedDeposit() uint64 {
+	if m != nil {
+		return m.TotalSlashedDeposit
 	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryTrustDepositStatsResponse) GetTotalRepaidDeposit() uint64 {
+	if m != nil {
+		return m.TotalRepaidDeposit
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTrustDepositStatsResponse) GetTotalBurnedDeposit() uint64 {
+	if m != nil {
+		return m.TotalBurnedDeposit
 	}
-	var l int
-	_ = l
-	l = m.TrustDeposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTrustDepositStatsResponse) GetModuleBalance() types.Coin {
+	if m != nil {
+		return m.ModuleBalance
+	}
+	return types.Coin{}
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// QueryListShareValueCheckpointsRequest is request type for the ListShareValueCheckpoints RPC method
+type QueryListShareValueCheckpointsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListShareValueCheckpointsRequest) Reset()         { *m = QueryListShareValueCheckpointsRequest{} }
+func (m *QueryListShareValueCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListShareValueCheckpointsRequest) ProtoMessage()    {}
+func (*QueryListShareValueCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{10}
+}
+func (m *QueryListShareValueCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListShareValueCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListShareValueCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListShareValueCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListShareValueCheckpointsRequest.Merge(m, src)
+}
+func (m *QueryListShareValueCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListShareValueCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListShareValueCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListShareValueCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QueryListShareValueCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListShareValueCheckpointsResponse is response type for the ListShareValueCheckpoints RPC method
+type QueryListShareValueCheckpointsResponse struct {
+	Checkpoints []ShareValueCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	Pagination  *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListShareValueCheckpointsResponse) Reset() {
+	*m = QueryListShareValueCheckpointsResponse{}
+}
+func (m *QueryListShareValueCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListShareValueCheckpointsResponse) ProtoMessage()    {}
+func (*QueryListShareValueCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{11}
+}
+func (m *QueryListShareValueCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListShareValueCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListShareValueCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListShareValueCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListShareValueCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryListShareValueCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListShareValueCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListShareValueCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListShareValueCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryListShareValueCheckpointsResponse) GetCheckpoints() []ShareValueCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *QueryListShareValueCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRealizedAprRequest is request type for the RealizedApr RPC method
+type QueryRealizedAprRequest struct {
+	// from is the start of the window, the closest checkpoint at or before it
+	// is used, or the oldest checkpoint if the history starts later
+	From time.Time `protobuf:"bytes,1,opt,name=from,proto3,stdtime" json:"from"`
+	// to is the end of the window, the closest checkpoint at or before it is
+	// used. If not set, the current share value is used.
+	To *time.Time `protobuf:"bytes,2,opt,name=to,proto3,stdtime" json:"to,omitempty"`
+}
+
+func (m *QueryRealizedAprRequest) Reset()         { *m = QueryRealizedAprRequest{} }
+func (m *QueryRealizedAprRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRealizedAprRequest) ProtoMessage()    {}
+func (*QueryRealizedAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{12}
+}
+func (m *QueryRealizedAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRealizedAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRealizedAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRealizedAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRealizedAprRequest.Merge(m, src)
+}
+func (m *QueryRealizedAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRealizedAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRealizedAprRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRealizedAprRequest proto.InternalMessageInfo
+
+func (m *QueryRealizedAprRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
+	}
+	return time.Time{}
+}
+
+func (m *QueryRealizedAprRequest) GetTo() *time.Time {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+// QueryRealizedAprResponse is response type for the RealizedApr RPC method
+type QueryRealizedAprResponse struct {
+	Start ShareValueCheckpoint `protobuf:"bytes,1,opt,name=start,proto3" json:"start"`
+	End   ShareValueCheckpoint `protobuf:"bytes,2,opt,name=end,proto3" json:"end"`
+	// apr is the share value growth between start and end, annualized
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+}
+
+func (m *QueryRealizedAprResponse) Reset()         { *m = QueryRealizedAprResponse{} }
+func (m *QueryRealizedAprResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRealizedAprResponse) ProtoMessage()    {}
+func (*QueryRealizedAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{13}
+}
+func (m *QueryRealizedAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRealizedAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRealizedAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRealizedAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRealizedAprResponse.Merge(m, src)
+}
+func (m *QueryRealizedAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRealizedAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRealizedAprResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRealizedAprResponse proto.InternalMessageInfo
+
+func (m *QueryRealizedAprResponse) GetStart() ShareValueCheckpoint {
+	if m != nil {
+		return m.Start
+	}
+	return ShareValueCheckpoint{}
+}
+
+func (m *QueryRealizedAprResponse) GetEnd() ShareValueCheckpoint {
+	if m != nil {
+		return m.End
+	}
+	return ShareValueCheckpoint{}
+}
+
+// QueryAccruedYieldRequest is request type for the AccruedYield RPC method
+type QueryAccruedYieldRequest struct {
+	CorporationId uint64 `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	// since is resolved like QueryRealizedAprRequest.from
+	Since time.Time `protobuf:"bytes,2,opt,name=since,proto3,stdtime" json:"since"`
+}
+
+func (m *QueryAccruedYieldRequest) Reset()         { *m = QueryAccruedYieldRequest{} }
+func (m *QueryAccruedYieldRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedYieldRequest) ProtoMessage()    {}
+func (*QueryAccruedYieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{14}
+}
+func (m *QueryAccruedYieldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedYieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedYieldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedYieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedYieldRequest.Merge(m, src)
+}
+func (m *QueryAccruedYieldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedYieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedYieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedYieldRequest proto.InternalMessageInfo
+
+func (m *QueryAccruedYieldRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
+}
+
+func (m *QueryAccruedYieldRequest) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+// QueryAccruedYieldResponse is response type for the AccruedYield RPC method
+type QueryAccruedYieldResponse struct {
+	CorporationId uint64                      `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Share         cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+	Start         ShareValueCheckpoint        `protobuf:"bytes,3,opt,name=start,proto3" json:"start"`
+	ShareValue    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=share_value,json=shareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_value"`
+	// accrued_yield is share * (share_value - start.share_value). It is an
+	// approximation: the yield earned by the current share, shares added or
+	// removed within the window are counted as if held over the whole window.
+	// It is exact only if the share did not change since start.
+	AccruedYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=accrued_yield,json=accruedYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"accrued_yield"`
+}
+
+func (m *QueryAccruedYieldResponse) Reset()         { *m = QueryAccruedYieldResponse{} }
+func (m *QueryAccruedYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedYieldResponse) ProtoMessage()    {}
+func (*QueryAccruedYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{15}
+}
+func (m *QueryAccruedYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedYieldResponse.Merge(m, src)
+}
+func (m *QueryAccruedYieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedYieldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedYieldResponse proto.InternalMessageInfo
+
+func (m *QueryAccruedYieldResponse) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
+}
+
+func (m *QueryAccruedYieldResponse) GetStart() ShareValueCheckpoint {
+	if m != nil {
+		return m.Start
+	}
+	return ShareValueCheckpoint{}
+}
+
+// QueryGetYieldFundingFailureRequest is request type for the GetYieldFundingFailure RPC method
+type QueryGetYieldFundingFailureRequest struct {
+}
+
+func (m *QueryGetYieldFundingFailureRequest) Reset()         { *m = QueryGetYieldFundingFailureRequest{} }
+func (m *QueryGetYieldFundingFailureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetYieldFundingFailureRequest) ProtoMessage()    {}
+func (*QueryGetYieldFundingFailureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{16}
+}
+func (m *QueryGetYieldFundingFailureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetYieldFundingFailureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetYieldFundingFailureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetYieldFundingFailureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetYieldFundingFailureRequest.Merge(m, src)
+}
+func (m *QueryGetYieldFundingFailureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetYieldFundingFailureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetYieldFundingFailureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetYieldFundingFailureRequest proto.InternalMessageInfo
+
+// QueryGetYieldFundingFailureResponse is response type for the GetYieldFundingFailure RPC method
+type QueryGetYieldFundingFailureResponse struct {
+	// yield_funding_failure is not set if the last yield funding succeeded
+	YieldFundingFailure *YieldFundingFailure `protobuf:"bytes,1,opt,name=yield_funding_failure,json=yieldFundingFailure,proto3" json:"yield_funding_failure,omitempty"`
+}
+
+func (m *QueryGetYieldFundingFailureResponse) Reset()         { *m = QueryGetYieldFundingFailureResponse{} }
+func (m *QueryGetYieldFundingFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetYieldFundingFailureResponse) ProtoMessage()    {}
+func (*QueryGetYieldFundingFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{17}
+}
+func (m *QueryGetYieldFundingFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetYieldFundingFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetYieldFundingFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetYieldFundingFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetYieldFundingFailureResponse.Merge(m, src)
+}
+func (m *QueryGetYieldFundingFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetYieldFundingFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetYieldFundingFailureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetYieldFundingFailureResponse proto.InternalMessageInfo
+
+func (m *QueryGetYieldFundingFailureResponse) GetYieldFundingFailure() *YieldFundingFailure {
+	if m != nil {
+		return m.YieldFundingFailure
+	}
+	return nil
+}
+
+// QueryListUnbondingTrustDepositsRequest is request type for the ListUnbondingTrustDeposits RPC method
+type QueryListUnbondingTrustDepositsRequest struct {
+	// corporation_id only returns the entries of this corporation if set
+	CorporationId uint64             `protobuf:"varint,1,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListUnbondingTrustDepositsRequest) Reset() {
+	*m = QueryListUnbondingTrustDepositsRequest{}
+}
+func (m *QueryListUnbondingTrustDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListUnbondingTrustDepositsRequest) ProtoMessage()    {}
+func (*QueryListUnbondingTrustDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{18}
+}
+func (m *QueryListUnbondingTrustDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListUnbondingTrustDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListUnbondingTrustDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListUnbondingTrustDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListUnbondingTrustDepositsRequest.Merge(m, src)
+}
+func (m *QueryListUnbondingTrustDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListUnbondingTrustDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListUnbondingTrustDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListUnbondingTrustDepositsRequest proto.InternalMessageInfo
+
+func (m *QueryListUnbondingTrustDepositsRequest) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
+}
+
+func (m *QueryListUnbondingTrustDepositsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListUnbondingTrustDepositsResponse is response type for the ListUnbondingTrustDeposits RPC method
+type QueryListUnbondingTrustDepositsResponse struct {
+	UnbondingTrustDeposits []UnbondingTrustDeposit `protobuf:"bytes,1,rep,name=unbonding_trust_deposits,json=unbondingTrustDeposits,proto3" json:"unbonding_trust_deposits"`
+	Pagination             *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListUnbondingTrustDepositsResponse) Reset() {
+	*m = QueryListUnbondingTrustDepositsResponse{}
+}
+func (m *QueryListUnbondingTrustDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListUnbondingTrustDepositsResponse) ProtoMessage()    {}
+func (*QueryListUnbondingTrustDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{19}
+}
+func (m *QueryListUnbondingTrustDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListUnbondingTrustDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListUnbondingTrustDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListUnbondingTrustDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListUnbondingTrustDepositsResponse.Merge(m, src)
+}
+func (m *QueryListUnbondingTrustDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListUnbondingTrustDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListUnbondingTrustDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListUnbondingTrustDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryListUnbondingTrustDepositsResponse) GetUnbondingTrustDeposits() []UnbondingTrustDeposit {
+	if m != nil {
+		return m.UnbondingTrustDeposits
+	}
+	return nil
+}
+
+func (m *QueryListUnbondingTrustDepositsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetCompensationPoolRequest is request type for the GetCompensationPool RPC method
+type QueryGetCompensationPoolRequest struct {
+	EcosystemId uint64 `protobuf:"varint,1,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+}
+
+func (m *QueryGetCompensationPoolRequest) Reset()         { *m = QueryGetCompensationPoolRequest{} }
+func (m *QueryGetCompensationPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCompensationPoolRequest) ProtoMessage()    {}
+func (*QueryGetCompensationPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{20}
+}
+func (m *QueryGetCompensationPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCompensationPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCompensationPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCompensationPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCompensationPoolRequest.Merge(m, src)
+}
+func (m *QueryGetCompensationPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCompensationPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCompensationPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCompensationPoolRequest proto.InternalMessageInfo
+
+func (m *QueryGetCompensationPoolRequest) GetEcosystemId() uint64 {
+	if m != nil {
+		return m.EcosystemId
+	}
+	return 0
+}
+
+// QueryGetCompensationPoolResponse is response type for the GetCompensationPool RPC method
+type QueryGetCompensationPoolResponse struct {
+	CompensationPool CompensationPool `protobuf:"bytes,1,opt,name=compensation_pool,json=compensationPool,proto3" json:"compensation_pool"`
+}
+
+func (m *QueryGetCompensationPoolResponse) Reset()         { *m = QueryGetCompensationPoolResponse{} }
+func (m *QueryGetCompensationPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCompensationPoolResponse) ProtoMessage()    {}
+func (*QueryGetCompensationPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{21}
+}
+func (m *QueryGetCompensationPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCompensationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCompensationPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCompensationPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCompensationPoolResponse.Merge(m, src)
+}
+func (m *QueryGetCompensationPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCompensationPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCompensationPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCompensationPoolResponse proto.InternalMessageInfo
+
+func (m *QueryGetCompensationPoolResponse) GetCompensationPool() CompensationPool {
+	if m != nil {
+		return m.CompensationPool
+	}
+	return CompensationPool{}
+}
+
+// QueryGetCompensationClaimRequest is request type for the GetCompensationClaim RPC method
+type QueryGetCompensationClaimRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetCompensationClaimRequest) Reset()         { *m = QueryGetCompensationClaimRequest{} }
+func (m *QueryGetCompensationClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCompensationClaimRequest) ProtoMessage()    {}
+func (*QueryGetCompensationClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{22}
+}
+func (m *QueryGetCompensationClaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCompensationClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCompensationClaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCompensationClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCompensationClaimRequest.Merge(m, src)
+}
+func (m *QueryGetCompensationClaimRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCompensationClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCompensationClaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCompensationClaimRequest proto.InternalMessageInfo
+
+func (m *QueryGetCompensationClaimRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetCompensationClaimResponse is response type for the GetCompensationClaim RPC method
+type QueryGetCompensationClaimResponse struct {
+	CompensationClaim CompensationClaim `protobuf:"bytes,1,opt,name=compensation_claim,json=compensationClaim,proto3" json:"compensation_claim"`
+}
+
+func (m *QueryGetCompensationClaimResponse) Reset()         { *m = QueryGetCompensationClaimResponse{} }
+func (m *QueryGetCompensationClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCompensationClaimResponse) ProtoMessage()    {}
+func (*QueryGetCompensationClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{23}
+}
+func (m *QueryGetCompensationClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCompensationClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCompensationClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCompensationClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCompensationClaimResponse.Merge(m, src)
+}
+func (m *QueryGetCompensationClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCompensationClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCompensationClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCompensationClaimResponse proto.InternalMessageInfo
+
+func (m *QueryGetCompensationClaimResponse) GetCompensationClaim() CompensationClaim {
+	if m != nil {
+		return m.CompensationClaim
+	}
+	return CompensationClaim{}
+}
+
+// QueryListCompensationClaimsRequest is request type for the ListCompensationClaims RPC method
+type QueryListCompensationClaimsRequest struct {
+	// ecosystem_id only returns the claims against this ecosystem if set
+	EcosystemId uint64 `protobuf:"varint,1,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+	// status only returns the claims in this status if set
+	Status     CompensationClaimStatus `protobuf:"varint,2,opt,name=status,proto3,enum=verana.td.v1.CompensationClaimStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest      `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCompensationClaimsRequest) Reset()         { *m = QueryListCompensationClaimsRequest{} }
+func (m *QueryListCompensationClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListCompensationClaimsRequest) ProtoMessage()    {}
+func (*QueryListCompensationClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{24}
+}
+func (m *QueryListCompensationClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCompensationClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCompensationClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListCompensationClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCompensationClaimsRequest.Merge(m, src)
+}
+func (m *QueryListCompensationClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCompensationClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCompensationClaimsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListCompensationClaimsRequest proto.InternalMessageInfo
+
+func (m *QueryListCompensationClaimsRequest) GetEcosystemId() uint64 {
+	if m != nil {
+		return m.EcosystemId
+	}
+	return 0
+}
+
+func (m *QueryListCompensationClaimsRequest) GetStatus() CompensationClaimStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_UNSPECIFIED
+}
+
+func (m *QueryListCompensationClaimsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListCompensationClaimsResponse is response type for the ListCompensationClaims RPC method
+type QueryListCompensationClaimsResponse struct {
+	CompensationClaims []CompensationClaim `protobuf:"bytes,1,rep,name=compensation_claims,json=compensationClaims,proto3" json:"compensation_claims"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCompensationClaimsResponse) Reset()         { *m = QueryListCompensationClaimsResponse{} }
+func (m *QueryListCompensationClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListCompensationClaimsResponse) ProtoMessage()    {}
+func (*QueryListCompensationClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{25}
+}
+func (m *QueryListCompensationClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCompensationClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCompensationClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListCompensationClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCompensationClaimsResponse.Merge(m, src)
+}
+func (m *QueryListCompensationClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCompensationClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCompensationClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListCompensationClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryListCompensationClaimsResponse) GetCompensationClaims() []CompensationClaim {
+	if m != nil {
+		return m.CompensationClaims
+	}
+	return nil
+}
+
+func (m *QueryListCompensationClaimsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEffectiveYieldRateRequest is request type for the EffectiveYieldRate RPC method
+type QueryEffectiveYieldRateRequest struct {
+}
+
+func (m *QueryEffectiveYieldRateRequest) Reset()         { *m = QueryEffectiveYieldRateRequest{} }
+func (m *QueryEffectiveYieldRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveYieldRateRequest) ProtoMessage()    {}
+func (*QueryEffectiveYieldRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{26}
+}
+func (m *QueryEffectiveYieldRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveYieldRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveYieldRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveYieldRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveYieldRateRequest.Merge(m, src)
+}
+func (m *QueryEffectiveYieldRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveYieldRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveYieldRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveYieldRateRequest proto.InternalMessageInfo
+
+// QueryEffectiveYieldRateResponse is response type for the EffectiveYieldRate RPC method
+type QueryEffectiveYieldRateResponse struct {
+	// rate is the effective annual yield rate
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// curve_enabled is false when rate is trust_deposit_max_yield_rate
+	CurveEnabled bool `protobuf:"varint,2,opt,name=curve_enabled,json=curveEnabled,proto3" json:"curve_enabled,omitempty"`
+	// deposit_ratio is the trust deposit over the yield curve ratio basis, zero
+	// when the curve is disabled
+	DepositRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=deposit_ratio,json=depositRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deposit_ratio"`
+	TrustDeposit cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=trust_deposit,json=trustDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"trust_deposit"`
+}
+
+func (m *QueryEffectiveYieldRateResponse) Reset()         { *m = QueryEffectiveYieldRateResponse{} }
+func (m *QueryEffectiveYieldRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveYieldRateResponse) ProtoMessage()    {}
+func (*QueryEffectiveYieldRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{27}
+}
+func (m *QueryEffectiveYieldRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveYieldRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveYieldRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveYieldRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveYieldRateResponse.Merge(m, src)
+}
+func (m *QueryEffectiveYieldRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveYieldRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveYieldRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveYieldRateResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveYieldRateResponse) GetCurveEnabled() bool {
+	if m != nil {
+		return m.CurveEnabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "verana.td.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "verana.td.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetTrustDepositRequest)(nil), "verana.td.v1.QueryGetTrustDepositRequest")
+	proto.RegisterType((*QueryGetTrustDepositResponse)(nil), "verana.td.v1.QueryGetTrustDepositResponse")
+	proto.RegisterType((*QueryGetCorporationTrustDepositRequest)(nil), "verana.td.v1.QueryGetCorporationTrustDepositRequest")
+	proto.RegisterType((*QueryGetCorporationTrustDepositResponse)(nil), "verana.td.v1.QueryGetCorporationTrustDepositResponse")
+	proto.RegisterType((*QueryListTrustDepositsRequest)(nil), "verana.td.v1.QueryListTrustDepositsRequest")
+	proto.RegisterType((*QueryListTrustDepositsResponse)(nil), "verana.td.v1.QueryListTrustDepositsResponse")
+	proto.RegisterType((*QueryTrustDepositStatsRequest)(nil), "verana.td.v1.QueryTrustDepositStatsRequest")
+	proto.RegisterType((*QueryTrustDepositStatsResponse)(nil), "verana.td.v1.QueryTrustDepositStatsResponse")
+	proto.RegisterType((*QueryListShareValueCheckpointsRequest)(nil), "verana.td.v1.QueryListShareValueCheckpointsRequest")
+	proto.RegisterType((*QueryListShareValueCheckpointsResponse)(nil), "verana.td.v1.QueryListShareValueCheckpointsResponse")
+	proto.RegisterType((*QueryRealizedAprRequest)(nil), "verana.td.v1.QueryRealizedAprRequest")
+	proto.RegisterType((*QueryRealizedAprResponse)(nil), "verana.td.v1.QueryRealizedAprResponse")
+	proto.RegisterType((*QueryAccruedYieldRequest)(nil), "verana.td.v1.QueryAccruedYieldRequest")
+	proto.RegisterType((*QueryAccruedYieldResponse)(nil), "verana.td.v1.QueryAccruedYieldResponse")
+	proto.RegisterType((*QueryGetYieldFundingFailureRequest)(nil), "verana.td.v1.QueryGetYieldFundingFailureRequest")
+	proto.RegisterType((*QueryGetYieldFundingFailureResponse)(nil), "verana.td.v1.QueryGetYieldFundingFailureResponse")
+	proto.RegisterType((*QueryListUnbondingTrustDepositsRequest)(nil), "verana.td.v1.QueryListUnbondingTrustDepositsRequest")
+	proto.RegisterType((*QueryListUnbondingTrustDepositsResponse)(nil), "verana.td.v1.QueryListUnbondingTrustDepositsResponse")
+	proto.RegisterType((*QueryGetCompensationPoolRequest)(nil), "verana.td.v1.QueryGetCompensationPoolRequest")
+	proto.RegisterType((*QueryGetCompensationPoolResponse)(nil), "verana.td.v1.QueryGetCompensationPoolResponse")
+	proto.RegisterType((*QueryGetCompensationClaimRequest)(nil), "verana.td.v1.QueryGetCompensationClaimRequest")
+	proto.RegisterType((*QueryGetCompensationClaimResponse)(nil), "verana.td.v1.QueryGetCompensationClaimResponse")
+	proto.RegisterType((*QueryListCompensationClaimsRequest)(nil), "verana.td.v1.QueryListCompensationClaimsRequest")
+	proto.RegisterType((*QueryListCompensationClaimsResponse)(nil), "verana.td.v1.QueryListCompensationClaimsResponse")
+	proto.RegisterType((*QueryEffectiveYieldRateRequest)(nil), "verana.td.v1.QueryEffectiveYieldRateRequest")
+	proto.RegisterType((*QueryEffectiveYieldRateResponse)(nil), "verana.td.v1.QueryEffectiveYieldRateResponse")
+}
+
+func init() { proto.RegisterFile("verana/td/v1/query.proto", fileDescriptor_1e3f9fbb2238b25c) }
+
+var fileDescriptor_1e3f9fbb2238b25c = []byte{
+	// 1867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xc0, 0xd3, 0xe3, 0x0f, 0x92, 0xe7, 0x8f, 0xc5, 0x65, 0x3b, 0x6b, 0x77, 0xc2, 0x8c, 0xdd,
+	0x5e, 0xc7, 0xbb, 0x4e, 0xa6, 0x3b, 0xf6, 0x66, 0xf9, 0x58, 0x09, 0x50, 0xec, 0xc4, 0x21, 0xb0,
+	0x82, 0xec, 0x38, 0xbb, 0x12, 0x5c, 0x46, 0x35, 0xdd, 0xe5, 0x71, 0x6b, 0x67, 0xba, 0x7a, 0xbb,
+	0xab, 0x2d, 0x4c, 0x88, 0x84, 0x90, 0x38, 0x00, 0x97, 0x45, 0x08, 0x71, 0x40, 0x5c, 0x51, 0x84,
+	0x38, 0x00, 0xe2, 0xba, 0x57, 0x94, 0xcb, 0xa2, 0x15, 0x1c, 0x40, 0x1c, 0x02, 0x24, 0x48, 0xfc,
+	0x1b, 0xa8, 0xab, 0x5e, 0xcf, 0x74, 0x7b, 0x6a, 0xc6, 0xed, 0xc8, 0x97, 0x28, 0x53, 0xef, 0xa3,
+	0x7e, 0xf5, 0xaa, 0xde, 0xab, 0x7a, 0x6d, 0x58, 0x3a, 0x62, 0x11, 0x0d, 0xa8, 0x23, 0x3c, 0xe7,
+	0x68, 0xcb, 0xf9, 0x30, 0x61, 0xd1, 0xb1, 0x1d, 0x46, 0x5c, 0x70, 0x32, 0xad, 0x24, 0xb6, 0xf0,
+	0xec, 0xa3, 0x2d, 0x73, 0x8e, 0x76, 0xfd, 0x80, 0x3b, 0xf2, 0x5f, 0xa5, 0x60, 0x56, 0x5d, 0x1e,
+	0x77, 0x79, 0xec, 0xb4, 0x68, 0xcc, 0x9c, 0xa3, 0xad, 0x16, 0x13, 0x74, 0xcb, 0x71, 0xb9, 0x1f,
+	0xa0, 0x7c, 0x59, 0xc9, 0x9b, 0xf2, 0x97, 0xa3, 0x7e, 0xa0, 0x68, 0x33, 0x6f, 0x2a, 0x27, 0xed,
+	0x39, 0x08, 0x69, 0xdb, 0x0f, 0xa8, 0xf0, 0x79, 0xe6, 0x66, 0xa1, 0xcd, 0xdb, 0x5c, 0xf9, 0x48,
+	0xff, 0x87, 0xa3, 0x57, 0xdb, 0x9c, 0xb7, 0x3b, 0xcc, 0xa1, 0xa1, 0xef, 0xd0, 0x20, 0xe0, 0x42,
+	0x9a, 0x64, 0xfe, 0x6b, 0x28, 0x95, 0xbf, 0x5a, 0xc9, 0x81, 0x23, 0xfc, 0x2e, 0x8b, 0x05, 0xed,
+	0x86, 0x19, 0x5b, 0x61, 0xd9, 0x21, 0x8d, 0x68, 0x37, 0xb3, 0x2d, 0x46, 0x44, 0x1c, 0x87, 0x0c,
+	0x25, 0xd6, 0x02, 0x90, 0x77, 0x53, 0xd6, 0x07, 0x52, 0xbd, 0xc1, 0x3e, 0x4c, 0x58, 0x2c, 0xac,
+	0x6f, 0xc2, 0x7c, 0x61, 0x34, 0x0e, 0x79, 0x10, 0x33, 0xf2, 0x05, 0x98, 0x54, 0x6e, 0x97, 0x8c,
+	0x15, 0xe3, 0xf5, 0xa9, 0xed, 0x05, 0x3b, 0x1f, 0x4f, 0x5b, 0x69, 0xef, 0x5c, 0x7a, 0xfa, 0xac,
+	0x76, 0xe1, 0xc9, 0xff, 0x7e, 0xbf, 0x69, 0x34, 0x50, 0xdd, 0xfa, 0x2a, 0x5c, 0x91, 0xfe, 0xee,
+	0x31, 0xf1, 0x30, 0x4a, 0x62, 0x71, 0x87, 0x85, 0x3c, 0xf6, 0x05, 0x4e, 0x47, 0x56, 0x60, 0xca,
+	0xe5, 0x51, 0xc8, 0x23, 0xb9, 0x60, 0xe9, 0xfc, 0x52, 0x23, 0x3f, 0x64, 0x31, 0xb8, 0xaa, 0x77,
+	0x80, 0x64, 0x77, 0x61, 0x46, 0xa4, 0xe3, 0x4d, 0x4f, 0x09, 0x10, 0xd0, 0x2c, 0x02, 0xe6, 0x4d,
+	0x77, 0xc6, 0x53, 0xcc, 0xc6, 0xb4, 0xc8, 0x8d, 0x59, 0xdf, 0x82, 0x6b, 0xd9, 0x34, 0xbb, 0xfd,
+	0xd9, 0x75, 0xc8, 0xeb, 0x30, 0x9b, 0xe3, 0x6b, 0xfa, 0x9e, 0x9c, 0x71, 0xbc, 0x31, 0x93, 0x1b,
+	0xbd, 0xef, 0x59, 0x21, 0x6c, 0x9c, 0xea, 0xf0, 0x7c, 0x97, 0xf0, 0xa3, 0x0a, 0x7c, 0x4e, 0x4e,
+	0xf9, 0x8e, 0x1f, 0x17, 0x62, 0x95, 0x6d, 0x2e, 0xb1, 0x61, 0xfe, 0x90, 0xc6, 0xcd, 0xb8, 0x43,
+	0xe3, 0x43, 0xe6, 0x35, 0x5b, 0xb4, 0x43, 0x03, 0x97, 0xc9, 0xe9, 0x2e, 0x36, 0xe6, 0x0e, 0x69,
+	0xbc, 0xaf, 0x24, 0x3b, 0x4a, 0x40, 0x6a, 0x30, 0xd5, 0xf5, 0x83, 0x1e, 0x56, 0x45, 0xae, 0x13,
+	0xba, 0x7e, 0x80, 0x8e, 0xc9, 0x37, 0x60, 0xb6, 0xcb, 0x3d, 0xff, 0xc0, 0x67, 0x5e, 0x93, 0x1e,
+	0x08, 0x16, 0x2d, 0x8d, 0x21, 0xba, 0x3a, 0xb2, 0x76, 0x76, 0x64, 0xed, 0x87, 0xd9, 0x91, 0xdd,
+	0xb9, 0xf8, 0xf4, 0x59, 0xcd, 0xf8, 0xe8, 0x5f, 0x35, 0xa3, 0x31, 0x93, 0xd9, 0xde, 0x4e, 0x4d,
+	0xc9, 0x1e, 0x40, 0x3f, 0x5d, 0x96, 0xc6, 0xa5, 0xa3, 0x6b, 0x36, 0x66, 0x5a, 0x9a, 0x5b, 0xb6,
+	0x4a, 0x68, 0xcc, 0x2d, 0xfb, 0x01, 0x6d, 0x33, 0x5c, 0x59, 0x23, 0x67, 0x69, 0xfd, 0xd1, 0x80,
+	0xea, 0xb0, 0x38, 0x60, 0xc4, 0xef, 0xc1, 0x6c, 0x21, 0xe2, 0xe9, 0xb1, 0x1e, 0x2b, 0x15, 0xf2,
+	0x99, 0x7c, 0xc8, 0x63, 0x72, 0xaf, 0xc0, 0x5c, 0x91, 0xcc, 0x1b, 0xa7, 0x32, 0x2b, 0x8a, 0x02,
+	0x74, 0x0d, 0xf7, 0x2e, 0x3f, 0xe5, 0xbe, 0xa0, 0xbd, 0xbd, 0xb3, 0xfe, 0x33, 0x86, 0xab, 0xd2,
+	0x68, 0xe0, 0xaa, 0x6c, 0x98, 0x2f, 0xac, 0xaa, 0xe9, 0xf2, 0x24, 0x10, 0x78, 0x3c, 0xe7, 0xf2,
+	0xe0, 0xbb, 0xa9, 0x80, 0xac, 0xc1, 0x8c, 0xe0, 0x82, 0x76, 0x4e, 0x6c, 0xf0, 0xb4, 0x1c, 0xcc,
+	0xb6, 0xf8, 0x0e, 0x4c, 0x29, 0xa5, 0xf8, 0x90, 0x46, 0x4c, 0xee, 0xef, 0xa5, 0x9d, 0xb5, 0x34,
+	0x16, 0xff, 0x7c, 0x56, 0xbb, 0xa2, 0x56, 0x1a, 0x7b, 0x1f, 0xd8, 0x3e, 0x77, 0xba, 0x54, 0x1c,
+	0xda, 0xef, 0xb0, 0x36, 0x75, 0x8f, 0xef, 0x30, 0xb7, 0x01, 0xd2, 0x6e, 0x3f, 0x35, 0x4b, 0xbd,
+	0x48, 0xfb, 0xe6, 0x11, 0xed, 0x24, 0x4c, 0x6e, 0x6e, 0x59, 0x2f, 0xd2, 0xee, 0xfd, 0xd4, 0x8c,
+	0x6c, 0xc3, 0x22, 0xb2, 0xe0, 0x09, 0xce, 0xc0, 0x27, 0x24, 0xf8, 0xbc, 0x9a, 0x50, 0xc9, 0x32,
+	0xfe, 0x9b, 0xb0, 0xa0, 0x6c, 0x22, 0x16, 0x52, 0xbf, 0x6f, 0x32, 0x29, 0x4d, 0x88, 0x94, 0x35,
+	0xa4, 0x68, 0xc0, 0xa2, 0x95, 0x44, 0x41, 0x6e, 0x92, 0xcf, 0xe4, 0x2c, 0x76, 0xa4, 0x28, 0xb3,
+	0xd8, 0x93, 0x69, 0x90, 0x74, 0x58, 0x2f, 0xa5, 0x2e, 0xca, 0x93, 0xb0, 0x5c, 0x38, 0x09, 0xd9,
+	0x19, 0xd8, 0xe5, 0x7e, 0x90, 0x9d, 0x26, 0x65, 0x86, 0xf9, 0x66, 0x71, 0x58, 0xef, 0x1d, 0xdc,
+	0xfd, 0xde, 0xb2, 0x77, 0x0f, 0x99, 0xfb, 0x41, 0xc8, 0xfd, 0xa0, 0x9f, 0xc8, 0xc5, 0x54, 0x31,
+	0x5e, 0x3a, 0x55, 0x3e, 0x36, 0xb0, 0xec, 0x8d, 0x98, 0x11, 0x0f, 0xd7, 0xd7, 0x61, 0xca, 0xed,
+	0x0f, 0x63, 0xbe, 0x58, 0xc5, 0x7c, 0xd1, 0x79, 0xc0, 0x95, 0xe6, 0x8d, 0xcf, 0x2f, 0x6b, 0x7e,
+	0x6c, 0xc0, 0xab, 0x92, 0xbf, 0xc1, 0x68, 0xc7, 0xff, 0x1e, 0xf3, 0x6e, 0x87, 0x51, 0x16, 0xa3,
+	0x2f, 0xc2, 0xf8, 0x41, 0xc4, 0xbb, 0xbd, 0x62, 0x3a, 0xba, 0x22, 0x5d, 0x90, 0x15, 0x49, 0x5a,
+	0x90, 0x5b, 0x50, 0x11, 0x1c, 0xb1, 0xca, 0x55, 0xb2, 0x8a, 0xe0, 0xd6, 0x27, 0x06, 0x2c, 0x0d,
+	0xb2, 0x60, 0xf4, 0xbe, 0x02, 0x13, 0xb1, 0xa0, 0x51, 0x56, 0xda, 0xcb, 0xc7, 0x4d, 0x99, 0x91,
+	0xb7, 0x61, 0x8c, 0x05, 0x1e, 0x32, 0x95, 0xb7, 0x4e, 0x8d, 0xc8, 0x5b, 0x30, 0x46, 0xc3, 0xe8,
+	0x2c, 0x99, 0x9b, 0xea, 0x5b, 0x8f, 0x71, 0x39, 0xb7, 0x5d, 0x37, 0x4a, 0x98, 0xf7, 0x6d, 0x9f,
+	0x75, 0xbc, 0xb3, 0xdd, 0x81, 0xe4, 0x6d, 0x98, 0x88, 0xfd, 0x34, 0x1d, 0x2a, 0x67, 0xd8, 0x03,
+	0x65, 0x62, 0x7d, 0x52, 0x81, 0x65, 0xcd, 0xfc, 0x18, 0xcf, 0x92, 0x00, 0x5f, 0x82, 0x09, 0x55,
+	0xb6, 0x2a, 0xe5, 0x17, 0xaf, 0x2c, 0xfa, 0x3b, 0x36, 0xf6, 0x72, 0x3b, 0x76, 0x3e, 0x15, 0xef,
+	0x6b, 0x30, 0x43, 0xd5, 0xfa, 0x9b, 0xc7, 0x69, 0x00, 0x64, 0xa5, 0x2b, 0xe9, 0x67, 0x9a, 0xe6,
+	0x22, 0x67, 0xbd, 0x06, 0x56, 0xf6, 0x1e, 0x91, 0x03, 0x7b, 0x49, 0xe0, 0xf9, 0x41, 0x7b, 0x8f,
+	0xfa, 0x9d, 0x24, 0xca, 0x8a, 0x83, 0xf5, 0x7d, 0x58, 0x1b, 0xa9, 0x85, 0xe1, 0x7f, 0x0f, 0x16,
+	0x25, 0x4e, 0xf3, 0x40, 0xc9, 0x9b, 0x07, 0x4a, 0x01, 0x8f, 0xf7, 0x6a, 0x31, 0x58, 0x3a, 0x4f,
+	0xf3, 0xc7, 0x83, 0x83, 0xd6, 0x2f, 0xf3, 0xe5, 0xe8, 0xbd, 0xa0, 0xc5, 0xa5, 0x54, 0xfb, 0x94,
+	0x29, 0x79, 0x00, 0xf6, 0x34, 0x95, 0xe6, 0x65, 0x0a, 0xe5, 0xdf, 0x0d, 0x7c, 0xce, 0x8d, 0x22,
+	0xc3, 0xe0, 0xb8, 0xb0, 0x94, 0x64, 0x1a, 0x4d, 0xed, 0x33, 0x63, 0xad, 0x18, 0x1f, 0xad, 0x3f,
+	0x3c, 0x4d, 0x97, 0x13, 0xed, 0x64, 0xe7, 0x57, 0x42, 0xef, 0x40, 0xad, 0xff, 0x4e, 0xed, 0x86,
+	0x2c, 0x88, 0xe5, 0xf8, 0x03, 0xce, 0x3b, 0x59, 0xac, 0x57, 0x61, 0x9a, 0xb9, 0x3c, 0x3e, 0x8e,
+	0x05, 0xeb, 0xf6, 0x23, 0x3d, 0xd5, 0x1b, 0xbb, 0xef, 0x59, 0x09, 0xac, 0x0c, 0xf7, 0x82, 0x71,
+	0x79, 0x17, 0xe6, 0xdc, 0x9c, 0xac, 0x19, 0x72, 0xde, 0xc1, 0x03, 0x53, 0x2d, 0x06, 0xe4, 0xa4,
+	0x0b, 0x8c, 0xc5, 0x67, 0xdd, 0x13, 0xe3, 0xd6, 0xb6, 0x7e, 0xda, 0xdd, 0x0e, 0xf5, 0xbb, 0x19,
+	0xfd, 0x2c, 0x54, 0x7a, 0xcc, 0x15, 0xdf, 0xb3, 0x8e, 0x61, 0x75, 0x84, 0x0d, 0xb2, 0x3e, 0x04,
+	0x52, 0x60, 0x75, 0x53, 0x29, 0xc2, 0xd6, 0x86, 0xc3, 0x4a, 0x27, 0x48, 0x5b, 0x58, 0xac, 0x14,
+	0x58, 0x7f, 0x31, 0x30, 0x09, 0xd3, 0x53, 0x34, 0x60, 0x17, 0x97, 0x8f, 0x37, 0xf9, 0x32, 0x4c,
+	0xc6, 0x82, 0x8a, 0x24, 0x96, 0x5b, 0x3f, 0xbb, 0xbd, 0x7e, 0x0a, 0xd3, 0xbe, 0x54, 0x6e, 0xa0,
+	0xd1, 0x89, 0xb4, 0x18, 0x7b, 0xe9, 0xb4, 0xf8, 0xb3, 0x81, 0xf5, 0x62, 0xd8, 0x82, 0x30, 0x9c,
+	0xef, 0xc3, 0xfc, 0x60, 0x38, 0xb3, 0x6c, 0x28, 0x19, 0x4f, 0x32, 0x10, 0xcf, 0x73, 0xcc, 0x82,
+	0x15, 0x7c, 0x5c, 0xdf, 0x3d, 0x38, 0x60, 0xae, 0xf0, 0x8f, 0x98, 0xba, 0x6e, 0xa8, 0xe8, 0x55,
+	0xc6, 0x5f, 0x55, 0x30, 0x51, 0x74, 0x2a, 0xbd, 0x2e, 0x79, 0x3c, 0xa2, 0x42, 0x55, 0xc1, 0x92,
+	0x45, 0x5a, 0x1a, 0xa4, 0x2f, 0x71, 0x37, 0x89, 0x8e, 0x58, 0x93, 0x05, 0xb4, 0xd5, 0x61, 0xea,
+	0xa2, 0xbf, 0xd8, 0x98, 0x96, 0x83, 0x77, 0xd5, 0x58, 0x7a, 0x17, 0x64, 0x0f, 0x7b, 0x59, 0xdf,
+	0xce, 0x72, 0xa3, 0x4f, 0xa3, 0x65, 0x23, 0x35, 0x24, 0x0f, 0x4e, 0x36, 0x9c, 0xea, 0x76, 0xba,
+	0x8e, 0x9e, 0x16, 0x07, 0x3d, 0xdd, 0x0f, 0xc4, 0x5f, 0xff, 0x54, 0x07, 0x8c, 0xec, 0xfd, 0x40,
+	0x14, 0x7b, 0xcf, 0xed, 0x27, 0x73, 0x30, 0x21, 0xa3, 0x43, 0x3c, 0x98, 0x54, 0x5f, 0x03, 0xc8,
+	0x4a, 0x71, 0x5f, 0x07, 0x3f, 0x36, 0x98, 0xab, 0x23, 0x34, 0x54, 0x48, 0xad, 0xc5, 0x1f, 0xfe,
+	0xed, 0xbf, 0x3f, 0xaf, 0xbc, 0x42, 0x66, 0x0a, 0x1f, 0x37, 0xc8, 0xcf, 0x0c, 0x78, 0xe5, 0xc4,
+	0x17, 0x01, 0xf2, 0x86, 0xc6, 0x9b, 0xfe, 0xb3, 0x83, 0xb9, 0x59, 0x46, 0x15, 0x09, 0x36, 0x24,
+	0xc1, 0x2a, 0xa9, 0x39, 0x85, 0x4f, 0x29, 0x6d, 0x26, 0x9c, 0x47, 0xb9, 0xcb, 0xe6, 0x31, 0xf9,
+	0x89, 0x01, 0x73, 0x03, 0x2d, 0x27, 0xb9, 0xae, 0x99, 0x6a, 0x58, 0x83, 0x6e, 0xde, 0x28, 0xa7,
+	0x8c, 0x64, 0xa6, 0x24, 0x5b, 0x20, 0xa4, 0x48, 0xd6, 0xf1, 0x63, 0x41, 0x7e, 0x6a, 0xc0, 0xdc,
+	0x40, 0xa7, 0xa8, 0x85, 0x19, 0xd6, 0x71, 0x6a, 0x61, 0x86, 0x36, 0x9f, 0xd6, 0x15, 0x09, 0xb3,
+	0x48, 0xe6, 0x8b, 0x30, 0xb1, 0x9c, 0xf7, 0x63, 0x03, 0xcc, 0xe1, 0x1f, 0x42, 0xc8, 0x2d, 0xfd,
+	0x76, 0x8c, 0xfe, 0x10, 0x63, 0xbe, 0x75, 0x46, 0x2b, 0x04, 0xdd, 0x96, 0xa0, 0x37, 0xc8, 0x66,
+	0x11, 0x34, 0xb7, 0x95, 0x85, 0x7d, 0x6d, 0xfa, 0xde, 0x63, 0xf2, 0x5b, 0x03, 0xcc, 0xe1, 0x37,
+	0xbf, 0x96, 0xff, 0xd4, 0x27, 0x8c, 0x96, 0xff, 0xf4, 0xe7, 0x85, 0x55, 0x93, 0xfc, 0xcb, 0xe4,
+	0xd5, 0x22, 0x7f, 0xef, 0x9d, 0x40, 0x7e, 0x67, 0xc0, 0xbc, 0xe6, 0x1e, 0x26, 0xf5, 0x61, 0xf1,
+	0xd2, 0xde, 0xfa, 0xa6, 0x5d, 0x56, 0x1d, 0xb9, 0x3e, 0x2f, 0xb9, 0x6e, 0x12, 0xfb, 0x64, 0x5c,
+	0xfb, 0xfa, 0xf5, 0xf4, 0xca, 0x77, 0x1e, 0xe5, 0x2f, 0xb7, 0xc7, 0xe4, 0x37, 0x06, 0x2c, 0xe8,
+	0xee, 0x62, 0x52, 0x02, 0x20, 0x7f, 0xd1, 0x9b, 0x4e, 0x69, 0x7d, 0x24, 0xae, 0x4b, 0xe2, 0x0d,
+	0xb2, 0x3e, 0x82, 0x58, 0xde, 0x54, 0xce, 0xa3, 0x14, 0xf4, 0x89, 0x01, 0x97, 0xf5, 0xf7, 0x1c,
+	0xb9, 0x39, 0x64, 0x2b, 0x87, 0xde, 0xf1, 0xe6, 0xd6, 0x19, 0x2c, 0x10, 0xf7, 0x0d, 0x89, 0xbb,
+	0x46, 0x56, 0x4f, 0xc3, 0x8d, 0xc9, 0x1f, 0x0c, 0x58, 0x1e, 0xda, 0xd2, 0x93, 0x37, 0x87, 0xcc,
+	0x3d, 0xea, 0x93, 0x83, 0x79, 0xeb, 0x6c, 0x46, 0xa3, 0x99, 0x65, 0x87, 0x53, 0x97, 0x9d, 0x51,
+	0xfd, 0xd0, 0x8f, 0x05, 0x8f, 0x8e, 0xc9, 0x0f, 0x0c, 0x98, 0xca, 0xb5, 0xce, 0x64, 0x5d, 0x33,
+	0xe1, 0x60, 0x9b, 0x6f, 0x5e, 0x3b, 0x4d, 0x0d, 0x49, 0x2c, 0x49, 0x72, 0x95, 0x98, 0x45, 0x92,
+	0x08, 0x55, 0xeb, 0x34, 0x8c, 0xc8, 0xaf, 0x0d, 0x20, 0x83, 0xd7, 0x3b, 0xd1, 0x15, 0xc2, 0xa1,
+	0x0f, 0x05, 0xb3, 0x5e, 0x52, 0x1b, 0xb9, 0x36, 0x25, 0xd7, 0x6b, 0xc4, 0x2a, 0x72, 0xb1, 0xcc,
+	0xa2, 0x2e, 0x1b, 0xa5, 0xba, 0x7c, 0x26, 0xfc, 0xc2, 0x80, 0xe9, 0x7c, 0x3b, 0x4c, 0x74, 0x8b,
+	0xd7, 0xf4, 0xeb, 0xe6, 0xc6, 0xa9, 0x7a, 0x48, 0x73, 0x4b, 0xd2, 0xd8, 0xe4, 0x46, 0x91, 0x06,
+	0x3b, 0x49, 0xc5, 0xa2, 0x2d, 0x8f, 0x97, 0xf5, 0x1d, 0xa3, 0x36, 0x33, 0x46, 0xb6, 0xa0, 0xda,
+	0xcc, 0x18, 0xdd, 0x8e, 0x5a, 0xd7, 0x25, 0xf5, 0x3a, 0x59, 0x2b, 0x52, 0xab, 0xc8, 0x61, 0x8b,
+	0x5a, 0xc7, 0x16, 0x75, 0x67, 0xe7, 0xe9, 0xf3, 0xaa, 0xf1, 0xe9, 0xf3, 0xaa, 0xf1, 0xef, 0xe7,
+	0x55, 0xe3, 0xa3, 0x17, 0xd5, 0x0b, 0x9f, 0xbe, 0xa8, 0x5e, 0xf8, 0xc7, 0x8b, 0xea, 0x85, 0xef,
+	0xbc, 0xde, 0xf6, 0xc5, 0x61, 0xd2, 0xb2, 0x5d, 0xde, 0x45, 0x47, 0xf5, 0x0e, 0x6d, 0xc5, 0x99,
+	0xd3, 0xef, 0xa6, 0x6e, 0xe5, 0x5f, 0x50, 0x5a, 0x93, 0xf2, 0x0b, 0xc6, 0x9b, 0xff, 0x0f, 0x00,
+	0x00, 0xff, 0xff, 0x58, 0x54, 0x35, 0xd4, 0x70, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetTrustDeposit looks a trust deposit up by corporation account
+	// (policy_address). Kept for compatibility, deposits are keyed by
+	// corporation_id.
+	GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error)
+	// ListTrustDeposits lists trust deposits by ascending corporation_id.
+	ListTrustDeposits(ctx context.Context, in *QueryListTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListTrustDepositsResponse, error)
+	// TrustDepositStats returns aggregate figures over all trust deposits.
+	TrustDepositStats(ctx context.Context, in *QueryTrustDepositStatsRequest, opts ...grpc.CallOption) (*QueryTrustDepositStatsResponse, error)
+	// GetCorporationTrustDeposit looks a trust deposit up by corporation_id.
+	GetCorporationTrustDeposit(ctx context.Context, in *QueryGetCorporationTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetCorporationTrustDepositResponse, error)
+	// ListUnbondingTrustDeposits returns the released trust deposits that are
+	// not claimable yet, by ascending completion time.
+	ListUnbondingTrustDeposits(ctx context.Context, in *QueryListUnbondingTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListUnbondingTrustDepositsResponse, error)
+	// GetCompensationPool returns the compensation pool of an ecosystem.
+	GetCompensationPool(ctx context.Context, in *QueryGetCompensationPoolRequest, opts ...grpc.CallOption) (*QueryGetCompensationPoolResponse, error)
+	// GetCompensationClaim returns a compensation claim.
+	GetCompensationClaim(ctx context.Context, in *QueryGetCompensationClaimRequest, opts ...grpc.CallOption) (*QueryGetCompensationClaimResponse, error)
+	// ListCompensationClaims returns the compensation claims by ascending id.
+	ListCompensationClaims(ctx context.Context, in *QueryListCompensationClaimsRequest, opts ...grpc.CallOption) (*QueryListCompensationClaimsResponse, error)
+	// ListShareValueCheckpoints returns the sampled share value history.
+	ListShareValueCheckpoints(ctx context.Context, in *QueryListShareValueCheckpointsRequest, opts ...grpc.CallOption) (*QueryListShareValueCheckpointsResponse, error)
+	// RealizedApr returns the annualized yield realized by the share value
+	// over a window.
+	RealizedApr(ctx context.Context, in *QueryRealizedAprRequest, opts ...grpc.CallOption) (*QueryRealizedAprResponse, error)
+	// EffectiveYieldRate returns the annual yield rate the current block
+	// distributes, from the yield curve if enabled.
+	EffectiveYieldRate(ctx context.Context, in *QueryEffectiveYieldRateRequest, opts ...grpc.CallOption) (*QueryEffectiveYieldRateResponse, error)
+	// AccruedYield returns an approximation of the yield accrued by a
+	// corporation's trust deposit since a given time: the current share is
+	// assumed to have been held over the whole window.
+	AccruedYield(ctx context.Context, in *QueryAccruedYieldRequest, opts ...grpc.CallOption) (*QueryAccruedYieldResponse, error)
+	// GetYieldFundingFailure returns the last yield funding step that failed,
+	// if the yield distribution has not succeeded since.
+	GetYieldFundingFailure(ctx context.Context, in *QueryGetYieldFundingFailureRequest, opts ...grpc.CallOption) (*QueryGetYieldFundingFailureResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error) {
+	out := new(QueryGetTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTrustDeposits(ctx context.Context, in *QueryListTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListTrustDepositsResponse, error) {
+	out := new(QueryListTrustDepositsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/ListTrustDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrustDepositStats(ctx context.Context, in *QueryTrustDepositStatsRequest, opts ...grpc.CallOption) (*QueryTrustDepositStatsResponse, error) {
+	out := new(QueryTrustDepositStatsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/TrustDepositStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCorporationTrustDeposit(ctx context.Context, in *QueryGetCorporationTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetCorporationTrustDepositResponse, error) {
+	out := new(QueryGetCorporationTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetCorporationTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListUnbondingTrustDeposits(ctx context.Context, in *QueryListUnbondingTrustDepositsRequest, opts ...grpc.CallOption) (*QueryListUnbondingTrustDepositsResponse, error) {
+	out := new(QueryListUnbondingTrustDepositsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/ListUnbondingTrustDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCompensationPool(ctx context.Context, in *QueryGetCompensationPoolRequest, opts ...grpc.CallOption) (*QueryGetCompensationPoolResponse, error) {
+	out := new(QueryGetCompensationPoolResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetCompensationPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCompensationClaim(ctx context.Context, in *QueryGetCompensationClaimRequest, opts ...grpc.CallOption) (*QueryGetCompensationClaimResponse, error) {
+	out := new(QueryGetCompensationClaimResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetCompensationClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListCompensationClaims(ctx context.Context, in *QueryListCompensationClaimsRequest, opts ...grpc.CallOption) (*QueryListCompensationClaimsResponse, error) {
+	out := new(QueryListCompensationClaimsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/ListCompensationClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListShareValueCheckpoints(ctx context.Context, in *QueryListShareValueCheckpointsRequest, opts ...grpc.CallOption) (*QueryListShareValueCheckpointsResponse, error) {
+	out := new(QueryListShareValueCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/ListShareValueCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RealizedApr(ctx context.Context, in *QueryRealizedAprRequest, opts ...grpc.CallOption) (*QueryRealizedAprResponse, error) {
+	out := new(QueryRealizedAprResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/RealizedApr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveYieldRate(ctx context.Context, in *QueryEffectiveYieldRateRequest, opts ...grpc.CallOption) (*QueryEffectiveYieldRateResponse, error) {
+	out := new(QueryEffectiveYieldRateResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/EffectiveYieldRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccruedYield(ctx context.Context, in *QueryAccruedYieldRequest, opts ...grpc.CallOption) (*QueryAccruedYieldResponse, error) {
+	out := new(QueryAccruedYieldResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/AccruedYield", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetYieldFundingFailure(ctx context.Context, in *QueryGetYieldFundingFailureRequest, opts ...grpc.CallOption) (*QueryGetYieldFundingFailureResponse, error) {
+	out := new(QueryGetYieldFundingFailureResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetYieldFundingFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetTrustDeposit looks a trust deposit up by corporation account
+	// (policy_address). Kept for compatibility, deposits are keyed by
+	// corporation_id.
+	GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error)
+	// ListTrustDeposits lists trust deposits by ascending corporation_id.
+	ListTrustDeposits(context.Context, *QueryListTrustDepositsRequest) (*QueryListTrustDepositsResponse, error)
+	// TrustDepositStats returns aggregate figures over all trust deposits.
+	TrustDepositStats(context.Context, *QueryTrustDepositStatsRequest) (*QueryTrustDepositStatsResponse, error)
+	// GetCorporationTrustDeposit looks a trust deposit up by corporation_id.
+	GetCorporationTrustDeposit(context.Context, *QueryGetCorporationTrustDepositRequest) (*QueryGetCorporationTrustDepositResponse, error)
+	// ListUnbondingTrustDeposits returns the released trust deposits that are
+	// not claimable yet, by ascending completion time.
+	ListUnbondingTrustDeposits(context.Context, *QueryListUnbondingTrustDepositsRequest) (*QueryListUnbondingTrustDepositsResponse, error)
+	// GetCompensationPool returns the compensation pool of an ecosystem.
+	GetCompensationPool(context.Context, *QueryGetCompensationPoolRequest) (*QueryGetCompensationPoolResponse, error)
+	// GetCompensationClaim returns a compensation claim.
+	GetCompensationClaim(context.Context, *QueryGetCompensationClaimRequest) (*QueryGetCompensationClaimResponse, error)
+	// ListCompensationClaims returns the compensation claims by ascending id.
+	ListCompensationClaims(context.Context, *QueryListCompensationClaimsRequest) (*QueryListCompensationClaimsResponse, error)
+	// ListShareValueCheckpoints returns the sampled share value history.
+	ListShareValueCheckpoints(context.Context, *QueryListShareValueCheckpointsRequest) (*QueryListShareValueCheckpointsResponse, error)
+	// RealizedApr returns the annualized yield realized by the share value
+	// over a window.
+	RealizedApr(context.Context, *QueryRealizedAprRequest) (*QueryRealizedAprResponse, error)
+	// EffectiveYieldRate returns the annual yield rate the current block
+	// distributes, from the yield curve if enabled.
+	EffectiveYieldRate(context.Context, *QueryEffectiveYieldRateRequest) (*QueryEffectiveYieldRateResponse, error)
+	// AccruedYield returns an approximation of the yield accrued by a
+	// corporation's trust deposit since a given time: the current share is
+	// assumed to have been held over the whole window.
+	AccruedYield(context.Context, *QueryAccruedYieldRequest) (*QueryAccruedYieldResponse, error)
+	// GetYieldFundingFailure returns the last yield funding step that failed,
+	// if the yield distribution has not succeeded since.
+	GetYieldFundingFailure(context.Context, *QueryGetYieldFundingFailureRequest) (*QueryGetYieldFundingFailureResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetTrustDeposit(ctx context.Context, req *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDeposit not implemented")
+}
+func (*UnimplementedQueryServer) ListTrustDeposits(ctx context.Context, req *QueryListTrustDepositsRequest) (*QueryListTrustDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrustDeposits not implemented")
+}
+func (*UnimplementedQueryServer) TrustDepositStats(ctx context.Context, req *QueryTrustDepositStatsRequest) (*QueryTrustDepositStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustDepositStats not implemented")
+}
+func (*UnimplementedQueryServer) GetCorporationTrustDeposit(ctx context.Context, req *QueryGetCorporationTrustDepositRequest) (*QueryGetCorporationTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorporationTrustDeposit not implemented")
+}
+func (*UnimplementedQueryServer) ListUnbondingTrustDeposits(ctx context.Context, req *QueryListUnbondingTrustDepositsRequest) (*QueryListUnbondingTrustDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnbondingTrustDeposits not implemented")
+}
+func (*UnimplementedQueryServer) GetCompensationPool(ctx context.Context, req *QueryGetCompensationPoolRequest) (*QueryGetCompensationPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompensationPool not implemented")
+}
+func (*UnimplementedQueryServer) GetCompensationClaim(ctx context.Context, req *QueryGetCompensationClaimRequest) (*QueryGetCompensationClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompensationClaim not implemented")
+}
+func (*UnimplementedQueryServer) ListCompensationClaims(ctx context.Context, req *QueryListCompensationClaimsRequest) (*QueryListCompensationClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompensationClaims not implemented")
+}
+func (*UnimplementedQueryServer) ListShareValueCheckpoints(ctx context.Context, req *QueryListShareValueCheckpointsRequest) (*QueryListShareValueCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShareValueCheckpoints not implemented")
+}
+func (*UnimplementedQueryServer) RealizedApr(ctx context.Context, req *QueryRealizedAprRequest) (*QueryRealizedAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealizedApr not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveYieldRate(ctx context.Context, req *QueryEffectiveYieldRateRequest) (*QueryEffectiveYieldRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveYieldRate not implemented")
+}
+func (*UnimplementedQueryServer) AccruedYield(ctx context.Context, req *QueryAccruedYieldRequest) (*QueryAccruedYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedYield not implemented")
+}
+func (*UnimplementedQueryServer) GetYieldFundingFailure(ctx context.Context, req *QueryGetYieldFundingFailureRequest) (*QueryGetYieldFundingFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetYieldFundingFailure not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTrustDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTrustDeposit(ctx, req.(*QueryGetTrustDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTrustDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTrustDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTrustDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/ListTrustDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTrustDeposits(ctx, req.(*QueryListTrustDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrustDepositStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrustDepositStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrustDepositStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/TrustDepositStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrustDepositStats(ctx, req.(*QueryTrustDepositStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCorporationTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCorporationTrustDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCorporationTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetCorporationTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCorporationTrustDeposit(ctx, req.(*QueryGetCorporationTrustDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListUnbondingTrustDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListUnbondingTrustDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListUnbondingTrustDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/ListUnbondingTrustDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListUnbondingTrustDeposits(ctx, req.(*QueryListUnbondingTrustDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCompensationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCompensationPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCompensationPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetCompensationPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCompensationPool(ctx, req.(*QueryGetCompensationPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCompensationClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCompensationClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCompensationClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetCompensationClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCompensationClaim(ctx, req.(*QueryGetCompensationClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListCompensationClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCompensationClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListCompensationClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/ListCompensationClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCompensationClaims(ctx, req.(*QueryListCompensationClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListShareValueCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListShareValueCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListShareValueCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/ListShareValueCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListShareValueCheckpoints(ctx, req.(*QueryListShareValueCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RealizedApr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRealizedAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RealizedApr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/RealizedApr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RealizedApr(ctx, req.(*QueryRealizedAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveYieldRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveYieldRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveYieldRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/EffectiveYieldRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveYieldRate(ctx, req.(*QueryEffectiveYieldRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccruedYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedYieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/AccruedYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedYield(ctx, req.(*QueryAccruedYieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetYieldFundingFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetYieldFundingFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetYieldFundingFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetYieldFundingFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetYieldFundingFailure(ctx, req.(*QueryGetYieldFundingFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.td.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetTrustDeposit",
+			Handler:    _Query_GetTrustDeposit_Handler,
+		},
+		{
+			MethodName: "ListTrustDeposits",
+			Handler:    _Query_ListTrustDeposits_Handler,
+		},
+		{
+			MethodName: "TrustDepositStats",
+			Handler:    _Query_TrustDepositStats_Handler,
+		},
+		{
+			MethodName: "GetCorporationTrustDeposit",
+			Handler:    _Query_GetCorporationTrustDeposit_Handler,
+		},
+		{
+			MethodName: "ListUnbondingTrustDeposits",
+			Handler:    _Query_ListUnbondingTrustDeposits_Handler,
+		},
+		{
+			MethodName: "GetCompensationPool",
+			Handler:    _Query_GetCompensationPool_Handler,
+		},
+		{
+			MethodName: "GetCompensationClaim",
+			Handler:    _Query_GetCompensationClaim_Handler,
+		},
+		{
+			MethodName: "ListCompensationClaims",
+			Handler:    _Query_ListCompensationClaims_Handler,
+		},
+		{
+			MethodName: "ListShareValueCheckpoints",
+			Handler:    _Query_ListShareValueCheckpoints_Handler,
+		},
+		{
+			MethodName: "RealizedApr",
+			Handler:    _Query_RealizedApr_Handler,
+		},
+		{
+			MethodName: "EffectiveYieldRate",
+			Handler:    _Query_EffectiveYieldRate_Handler,
+		},
+		{
+			MethodName: "AccruedYield",
+			Handler:    _Query_AccruedYield_Handler,
+		},
+		{
+			MethodName: "GetYieldFundingFailure",
+			Handler:    _Query_GetYieldFundingFailure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/td/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTrustDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrustDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCorporationTrustDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCorporationTrustDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCorporationTrustDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCorporationTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCorporationTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCorporationTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrustDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTrustDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTrustDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTrustDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ModifiedAfter != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinDeposit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasSlashedBalance {
+		i--
+		if m.HasSlashedBalance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTrustDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTrustDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTrustDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TrustDeposits) > 0 {
+		for iNdEx := len(m.TrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrustDepositStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustDepositStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustDepositStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrustDepositStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustDepositStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustDepositStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ModuleBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.TotalBurnedDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalBurnedDeposit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalRepaidDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalRepaidDeposit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalSlashedDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSlashedDeposit))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ShareValue.Size()
+		i -= size
+		if _, err := m.ShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalShare.Size()
+		i -= size
+		if _, err := m.TotalShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TotalDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalDeposit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TrustDepositCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustDepositCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListShareValueCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListShareValueCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListShareValueCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListShareValueCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListShareValueCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListShareValueCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRealizedAprRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRealizedAprRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRealizedAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.To, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.To):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.From, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.From):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRealizedAprResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRealizedAprResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRealizedAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedYieldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedYieldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedYieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AccruedYield.Size()
+		i -= size
+		if _, err := m.AccruedYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ShareValue.Size()
+		i -= size
+		if _, err := m.ShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetYieldFundingFailureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetYieldFundingFailureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetYieldFundingFailureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetYieldFundingFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetYieldFundingFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetYieldFundingFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.YieldFundingFailure != nil {
+		{
+			size, err := m.YieldFundingFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListUnbondingTrustDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListUnbondingTrustDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListUnbondingTrustDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListUnbondingTrustDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListUnbondingTrustDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListUnbondingTrustDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingTrustDeposits) > 0 {
+		for iNdEx := len(m.UnbondingTrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTrustDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCompensationPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCompensationPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCompensationPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EcosystemId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EcosystemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCompensationPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCompensationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCompensationPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CompensationPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCompensationClaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCompensationClaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCompensationClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCompensationClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCompensationClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCompensationClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CompensationClaim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCompensationClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCompensationClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCompensationClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EcosystemId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EcosystemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCompensationClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCompensationClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCompensationClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CompensationClaims) > 0 {
+		for iNdEx := len(m.CompensationClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompensationClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveYieldRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveYieldRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveYieldRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveYieldRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveYieldRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveYieldRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TrustDeposit.Size()
+		i -= size
+		if _, err := m.TrustDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DepositRatio.Size()
+		i -= size
+		if _, err := m.DepositRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurveEnabled {
+		i--
+		if m.CurveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTrustDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrustDeposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCorporationTrustDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	return n
+}
+
+func (m *QueryGetCorporationTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrustDeposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListTrustDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasSlashedBalance {
+		n += 2
+	}
+	if m.MinDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.MinDeposit))
+	}
+	if m.ModifiedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListTrustDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrustDeposits) > 0 {
+		for _, e := range m.TrustDeposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrustDepositStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTrustDepositStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrustDepositCount != 0 {
+		n += 1 + sovQuery(uint64(m.TrustDepositCount))
+	}
+	if m.TotalDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.TotalDeposit))
+	}
+	l = m.TotalShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ShareValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TotalSlashedDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSlashedDeposit))
+	}
+	if m.TotalRepaidDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.TotalRepaidDeposit))
+	}
+	if m.TotalBurnedDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.TotalBurnedDeposit))
+	}
+	l = m.ModuleBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListShareValueCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListShareValueCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRealizedAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.From)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.To != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRealizedAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Start.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccruedYieldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccruedYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Start.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ShareValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccruedYield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetYieldFundingFailureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetYieldFundingFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.YieldFundingFailure != nil {
+		l = m.YieldFundingFailure.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListUnbondingTrustDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorporationId != 0 {
+		n += 1 + sovQuery(uint64(m.CorporationId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListUnbondingTrustDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingTrustDeposits) > 0 {
+		for _, e := range m.UnbondingTrustDeposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCompensationPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EcosystemId != 0 {
+		n += 1 + sovQuery(uint64(m.EcosystemId))
+	}
+	return n
+}
+
+func (m *QueryGetCompensationPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CompensationPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCompensationClaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetCompensationClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CompensationClaim.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListCompensationClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EcosystemId != 0 {
+		n += 1 + sovQuery(uint64(m.EcosystemId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCompensationClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CompensationClaims) > 0 {
+		for _, e := range m.CompensationClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveYieldRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveYieldRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurveEnabled {
+		n += 2
+	}
+	l = m.DepositRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TrustDeposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTrustDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTrustDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCorporationTrustDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCorporationTrustDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCorporationTrustDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCorporationTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCorporationTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCorporationTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTrustDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTrustDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTrustDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasSlashedBalance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasSlashedBalance = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			m.MinDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAfter == nil {
+				m.ModifiedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTrustDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTrustDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTrustDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustDeposits = append(m.TrustDeposits, TrustDeposit{})
+			if err := m.TrustDeposits[len(m.TrustDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrustDepositStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustDepositStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustDepositStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrustDepositStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustDepositStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustDepositStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositCount", wireType)
+			}
+			m.TrustDepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustDepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			m.TotalDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSlashedDeposit", wireType)
+			}
+			m.TotalSlashedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSlashedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRepaidDeposit", wireType)
+			}
+			m.TotalRepaidDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRepaidDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedDeposit", wireType)
+			}
+			m.TotalBurnedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBurnedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListShareValueCheckpointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListShareValueCheckpointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListShareValueCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListShareValueCheckpointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListShareValueCheckpointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListShareValueCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, ShareValueCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRealizedAprRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRealizedAprRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRealizedAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRealizedAprResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRealizedAprResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRealizedAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedYieldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedYieldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedYieldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedYieldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedYieldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetYieldFundingFailureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetYieldFundingFailureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetYieldFundingFailureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetYieldFundingFailureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetYieldFundingFailureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetYieldFundingFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldFundingFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldFundingFailure == nil {
+				m.YieldFundingFailure = &YieldFundingFailure{}
+			}
+			if err := m.YieldFundingFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListUnbondingTrustDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListUnbondingTrustDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListUnbondingTrustDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListUnbondingTrustDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListUnbondingTrustDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListUnbondingTrustDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTrustDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTrustDeposits = append(m.UnbondingTrustDeposits, UnbondingTrustDeposit{})
+			if err := m.UnbondingTrustDeposits[len(m.UnbondingTrustDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCompensationPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCompensationPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCompensationPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
+			}
+			m.EcosystemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EcosystemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCompensationPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCompensationPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCompensationPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompensationPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCompensationClaimRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCompensationClaimRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCompensationClaimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCompensationClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -566,12 +6170,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCompensationClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCompensationClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompensationClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -593,7 +6230,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListCompensationClaimsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,15 +6253,53 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCompensationClaimsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCompensationClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
+			}
+			m.EcosystemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EcosystemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CompensationClaimStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -651,7 +6326,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -676,7 +6354,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListCompensationClaimsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -699,17 +6377,17 @@ func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTrustDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCompensationClaimsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTrustDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCompensationClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationClaims", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -719,23 +6397,61 @@ func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corporation = string(dAtA[iNdEx:postIndex])
+			m.CompensationClaims = append(m.CompensationClaims, CompensationClaim{})
+			if err := m.CompensationClaims[len(m.CompensationClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -758,7 +6474,7 @@ func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveYieldRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -781,17 +6497,155 @@ func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveYieldRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveYieldRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveYieldRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveYieldRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveYieldRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CurveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -801,15 +6655,16 @@ func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
@@ -433,661 +433,2535 @@ func (m *MsgRepaySlashedTrustDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRepaySlashedTrustDepositResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "verana.td.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.td.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgReclaimTrustDepositYield)(nil), "verana.td.v1.MsgReclaimTrustDepositYield")
-	proto.RegisterType((*MsgReclaimTrustDepositYieldResponse)(nil), "verana.td.v1.MsgReclaimTrustDepositYieldResponse")
-	proto.RegisterType((*MsgSlashTrustDeposit)(nil), "verana.td.v1.MsgSlashTrustDeposit")
-	proto.RegisterType((*MsgSlashTrustDepositResponse)(nil), "verana.td.v1.MsgSlashTrustDepositResponse")
-	proto.RegisterType((*MsgRepaySlashedTrustDeposit)(nil), "verana.td.v1.MsgRepaySlashedTrustDeposit")
-	proto.RegisterType((*MsgRepaySlashedTrustDepositResponse)(nil), "verana.td.v1.MsgRepaySlashedTrustDepositResponse")
+// MsgDepositTrust defines the message for voluntarily increasing a trust
+// deposit, to pre-fund future operations or over-collateralize. The amount is
+// sent from the corporation account.
+type MsgDepositTrust struct {
+	// corporation is the group address that owns the trust deposit.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run this Msg.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// amount is the amount to deposit (in base denom).
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/td/v1/tx.proto", fileDescriptor_ae13ffc8589bdf17) }
-
-var fileDescriptor_ae13ffc8589bdf17 = []byte{
-	// 643 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0xd2, 0xa5, 0xc2, 0x80, 0x0a, 0x9b, 0x22, 0xcb, 0x22, 0x0b, 0x59, 0x43, 0xc4, 0x26,
-	0xec, 0x06, 0x30, 0x12, 0x7b, 0xa3, 0xf1, 0x22, 0xb1, 0x89, 0x59, 0xf0, 0xa0, 0x17, 0x32, 0x74,
-	0x27, 0xdb, 0x8d, 0xdd, 0x9d, 0xcd, 0xcc, 0x94, 0xc0, 0xcd, 0x78, 0xf4, 0xc4, 0x9f, 0xe1, 0x91,
-	0x18, 0x6f, 0xfe, 0x03, 0x1c, 0x89, 0x27, 0xe3, 0xa1, 0x31, 0xad, 0x49, 0xff, 0x0d, 0xb3, 0x3b,
-	0xdb, 0xa5, 0xfb, 0xa3, 0x05, 0x3d, 0x78, 0x69, 0x3a, 0xef, 0x7d, 0xdf, 0x9b, 0xf7, 0xbd, 0x7e,
-	0x6f, 0x0a, 0x16, 0x4e, 0x10, 0x81, 0x1e, 0x34, 0x98, 0x65, 0x9c, 0x6c, 0x19, 0xec, 0x54, 0xf7,
-	0x09, 0x66, 0x58, 0x9a, 0xe5, 0x61, 0x9d, 0x59, 0xfa, 0xc9, 0x96, 0x32, 0x0f, 0x5d, 0xc7, 0xc3,
-	0x46, 0xf8, 0xc9, 0x01, 0xca, 0x62, 0x03, 0x53, 0x17, 0x53, 0xc3, 0xa5, 0x76, 0x40, 0x74, 0xa9,
-	0x1d, 0x25, 0x96, 0x78, 0xe2, 0x28, 0x3c, 0x19, 0xfc, 0x10, 0xa5, 0xca, 0x36, 0xb6, 0x31, 0x8f,
-	0x07, 0xdf, 0x06, 0x84, 0x44, 0x07, 0x3e, 0x24, 0xd0, 0x8d, 0x08, 0xda, 0x17, 0x01, 0xdc, 0xaf,
-	0x53, 0xfb, 0x8d, 0x6f, 0x41, 0x86, 0x5e, 0x87, 0x19, 0xe9, 0x19, 0x98, 0x86, 0x6d, 0xd6, 0xc4,
-	0xc4, 0x61, 0x67, 0xb2, 0xb0, 0x26, 0x6c, 0x4c, 0xd7, 0xe4, 0xef, 0x5f, 0x37, 0xcb, 0xd1, 0x4d,
-	0x7b, 0x96, 0x45, 0x10, 0xa5, 0x07, 0x8c, 0x38, 0x9e, 0x6d, 0x5e, 0x43, 0xa5, 0x5d, 0x50, 0xe2,
-	0xb5, 0xe5, 0x89, 0x35, 0x61, 0x63, 0x66, 0xbb, 0xac, 0x0f, 0x4b, 0xd4, 0x79, 0xf5, 0xda, 0xf4,
-	0x65, 0x67, 0xb5, 0xf0, 0xb9, 0x7f, 0x51, 0x11, 0xcc, 0x08, 0x5e, 0xd5, 0x3f, 0xf6, 0x2f, 0x2a,
-	0xd7, 0x85, 0x3e, 0xf5, 0x2f, 0x2a, 0xcb, 0x51, 0xcb, 0xa7, 0x41, 0xd3, 0xa9, 0x06, 0xb5, 0x25,
-	0xb0, 0x98, 0x0a, 0x99, 0x88, 0xfa, 0xd8, 0xa3, 0x48, 0xeb, 0x08, 0x60, 0xb9, 0x4e, 0x6d, 0x13,
-	0x35, 0x5a, 0xd0, 0x71, 0x0f, 0x49, 0x9b, 0xb2, 0x17, 0xc8, 0xc7, 0xd4, 0x61, 0x6f, 0x1d, 0xd4,
-	0xb2, 0xa4, 0x2a, 0x98, 0x69, 0x60, 0xe2, 0x63, 0x02, 0x99, 0x83, 0xbd, 0x1b, 0xd5, 0x0d, 0x83,
-	0xa5, 0xa7, 0x60, 0x0a, 0xfb, 0x88, 0x40, 0x86, 0x49, 0xa8, 0x70, 0x1c, 0x31, 0x46, 0x56, 0x9f,
-	0x07, 0xe2, 0xe2, 0x63, 0xa0, 0xed, 0x71, 0x4a, 0xdb, 0xa8, 0x66, 0xf7, 0xc5, 0xa9, 0xe2, 0x9c,
-	0xb8, 0x2f, 0x4e, 0x89, 0x73, 0x93, 0x66, 0x09, 0xba, 0xb8, 0xed, 0x31, 0xed, 0x15, 0x78, 0x34,
-	0x86, 0x32, 0x98, 0x83, 0xb4, 0x0e, 0xee, 0x85, 0x08, 0x64, 0x1d, 0x71, 0x62, 0x28, 0x55, 0x34,
-	0xef, 0x46, 0xd1, 0x3d, 0x5e, 0xed, 0x7c, 0x02, 0x94, 0xeb, 0xd4, 0x3e, 0x68, 0x41, 0xda, 0x1c,
-	0x2e, 0xf6, 0xcf, 0x1e, 0x48, 0xcd, 0x77, 0xe2, 0x6f, 0xe6, 0xbb, 0x0b, 0xee, 0x58, 0xfc, 0x7a,
-	0xb9, 0x18, 0xf2, 0x56, 0x02, 0xab, 0xfc, 0xec, 0xac, 0x2e, 0x70, 0x2e, 0xb5, 0xde, 0xeb, 0x0e,
-	0x36, 0x5c, 0xc8, 0x9a, 0xfa, 0x4b, 0x8f, 0x99, 0x03, 0xb4, 0xf4, 0x00, 0x94, 0x08, 0x82, 0x14,
-	0x7b, 0xb2, 0x18, 0xf0, 0xcc, 0xe8, 0x54, 0xdd, 0xc9, 0xfa, 0x6a, 0x2d, 0x35, 0xfb, 0x8c, 0x72,
-	0x4d, 0x05, 0x0f, 0xf3, 0xe2, 0xb1, 0xc3, 0x7e, 0x0f, 0x1c, 0xe6, 0xc3, 0xb3, 0x10, 0x85, 0xac,
-	0xc4, 0xe4, 0xfe, 0xbb, 0xc3, 0x24, 0x39, 0x39, 0x37, 0x31, 0x1e, 0xcc, 0xad, 0xbc, 0x97, 0x2f,
-	0x43, 0x5b, 0x8f, 0x7c, 0x96, 0x9f, 0x1e, 0x4c, 0x63, 0xfb, 0x5b, 0x11, 0x14, 0xeb, 0xd4, 0x96,
-	0x0e, 0xc1, 0x6c, 0xe2, 0x0d, 0x59, 0x49, 0xee, 0x7e, 0x6a, 0x5d, 0x95, 0xf5, 0xb1, 0xe9, 0xd8,
-	0xc5, 0xa7, 0x40, 0x1e, 0xb9, 0xc9, 0x4f, 0x32, 0x25, 0x46, 0x41, 0x95, 0xad, 0x5b, 0x43, 0xe3,
-	0x9b, 0x1b, 0x60, 0x3e, 0xbb, 0x14, 0x5a, 0xa6, 0x4e, 0x06, 0xa3, 0x54, 0x6e, 0xc6, 0x24, 0xe5,
-	0x8d, 0xb0, 0x51, 0x9e, 0xbc, 0x7c, 0x68, 0xae, 0xbc, 0xf1, 0x3f, 0x9b, 0x32, 0xf9, 0x21, 0x78,
-	0x80, 0x6b, 0xb5, 0xcb, 0xae, 0x2a, 0x5c, 0x75, 0x55, 0xe1, 0x57, 0x57, 0x15, 0xce, 0x7b, 0x6a,
-	0xe1, 0xaa, 0xa7, 0x16, 0x7e, 0xf4, 0xd4, 0xc2, 0xbb, 0x0d, 0xdb, 0x61, 0xcd, 0xf6, 0xb1, 0xde,
-	0xc0, 0xae, 0xc1, 0xab, 0x6f, 0xb6, 0xe0, 0x31, 0x35, 0x86, 0xed, 0xc3, 0xce, 0x7c, 0x44, 0x8f,
-	0x4b, 0xe1, 0x1f, 0xc9, 0xce, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x67, 0xc2, 0x72, 0xe7,
-	0x06, 0x00, 0x00,
+func (m *MsgDepositTrust) Reset()         { *m = MsgDepositTrust{} }
+func (m *MsgDepositTrust) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositTrust) ProtoMessage()    {}
+func (*MsgDepositTrust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{8}
+}
+func (m *MsgDepositTrust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositTrust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositTrust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositTrust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositTrust.Merge(m, src)
+}
+func (m *MsgDepositTrust) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositTrust) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositTrust.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgDepositTrust proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgDepositTrust) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	ReclaimTrustDepositYield(ctx context.Context, in *MsgReclaimTrustDepositYield, opts ...grpc.CallOption) (*MsgReclaimTrustDepositYieldResponse, error)
-	// SlashTrustDeposit defines a governance operation to slash a corporation's trust deposit
-	SlashTrustDeposit(ctx context.Context, in *MsgSlashTrustDeposit, opts ...grpc.CallOption) (*MsgSlashTrustDepositResponse, error)
-	RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepaySlashedTrustDepositResponse, error)
+func (m *MsgDepositTrust) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDepositTrust) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgDepositTrustResponse struct {
+	Deposit uint64                      `protobuf:"varint,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Share   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositTrustResponse) Reset()         { *m = MsgDepositTrustResponse{} }
+func (m *MsgDepositTrustResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositTrustResponse) ProtoMessage()    {}
+func (*MsgDepositTrustResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{9}
+}
+func (m *MsgDepositTrustResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositTrustResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositTrustResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDepositTrustResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositTrustResponse.Merge(m, src)
+}
+func (m *MsgDepositTrustResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositTrustResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositTrustResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ReclaimTrustDepositYield(ctx context.Context, in *MsgReclaimTrustDepositYield, opts ...grpc.CallOption) (*MsgReclaimTrustDepositYieldResponse, error) {
-	out := new(MsgReclaimTrustDepositYieldResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/ReclaimTrustDepositYield", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositTrustResponse proto.InternalMessageInfo
+
+func (m *MsgDepositTrustResponse) GetDeposit() uint64 {
+	if m != nil {
+		return m.Deposit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SlashTrustDeposit(ctx context.Context, in *MsgSlashTrustDeposit, opts ...grpc.CallOption) (*MsgSlashTrustDepositResponse, error) {
-	out := new(MsgSlashTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/SlashTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgWithdrawClaimable defines the message for withdrawing an explicit
+// amount of the claimable balance to the corporation account.
+type MsgWithdrawClaimable struct {
+	// corporation is the group address that owns the trust deposit.
+	Corporation string `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	// operator is the account authorized by the corporation to run this Msg.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// amount is the amount to withdraw, at most the claimable balance.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgWithdrawClaimable) Reset()         { *m = MsgWithdrawClaimable{} }
+func (m *MsgWithdrawClaimable) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawClaimable) ProtoMessage()    {}
+func (*MsgWithdrawClaimable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{10}
+}
+func (m *MsgWithdrawClaimable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawClaimable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawClaimable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgWithdrawClaimable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawClaimable.Merge(m, src)
+}
+func (m *MsgWithdrawClaimable) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawClaimable) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawClaimable.DiscardUnknown(m)
 }
 
-func (c *msgClient) RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepaySlashedTrustDepositResponse, error) {
-	out := new(MsgRepaySlashedTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/RepaySlashedTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawClaimable proto.InternalMessageInfo
+
+func (m *MsgWithdrawClaimable) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	ReclaimTrustDepositYield(context.Context, *MsgReclaimTrustDepositYield) (*MsgReclaimTrustDepositYieldResponse, error)
-	// SlashTrustDeposit defines a governance operation to slash a corporation's trust deposit
-	SlashTrustDeposit(context.Context, *MsgSlashTrustDeposit) (*MsgSlashTrustDepositResponse, error)
-	RepaySlashedTrustDeposit(context.Context, *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error)
+func (m *MsgWithdrawClaimable) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgWithdrawClaimable) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+type MsgWithdrawClaimableResponse struct {
+	Claimable uint64 `protobuf:"varint,1,opt,name=claimable,proto3" json:"claimable,omitempty"`
 }
-func (*UnimplementedMsgServer) ReclaimTrustDepositYield(ctx context.Context, req *MsgReclaimTrustDepositYield) (*MsgReclaimTrustDepositYieldResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReclaimTrustDepositYield not implemented")
+
+func (m *MsgWithdrawClaimableResponse) Reset()         { *m = MsgWithdrawClaimableResponse{} }
+func (m *MsgWithdrawClaimableResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawClaimableResponse) ProtoMessage()    {}
+func (*MsgWithdrawClaimableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{11}
 }
-func (*UnimplementedMsgServer) SlashTrustDeposit(ctx context.Context, req *MsgSlashTrustDeposit) (*MsgSlashTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashTrustDeposit not implemented")
+func (m *MsgWithdrawClaimableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RepaySlashedTrustDeposit(ctx context.Context, req *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepaySlashedTrustDeposit not implemented")
+func (m *MsgWithdrawClaimableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawClaimableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgWithdrawClaimableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawClaimableResponse.Merge(m, src)
+}
+func (m *MsgWithdrawClaimableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawClaimableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawClaimableResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgWithdrawClaimableResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawClaimableResponse) GetClaimable() uint64 {
+	if m != nil {
+		return m.Claimable
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ReclaimTrustDepositYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReclaimTrustDepositYield)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReclaimTrustDepositYield(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Msg/ReclaimTrustDepositYield",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReclaimTrustDepositYield(ctx, req.(*MsgReclaimTrustDepositYield))
+// MsgFileCompensationClaim defines the message for a party harmed by the
+// participants of an ecosystem to claim compensation from its pool.
+type MsgFileCompensationClaim struct {
+	// claimant is the account filing the claim and receiving the payout.
+	Claimant    string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	EcosystemId uint64 `protobuf:"varint,2,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+	// amount is the requested compensation (in base denom).
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// evidence_digest MUST be anchored in x/di.
+	EvidenceDigest string `protobuf:"bytes,5,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+}
+
+func (m *MsgFileCompensationClaim) Reset()         { *m = MsgFileCompensationClaim{} }
+func (m *MsgFileCompensationClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgFileCompensationClaim) ProtoMessage()    {}
+func (*MsgFileCompensationClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{12}
+}
+func (m *MsgFileCompensationClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFileCompensationClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFileCompensationClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFileCompensationClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFileCompensationClaim.Merge(m, src)
+}
+func (m *MsgFileCompensationClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFileCompensationClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFileCompensationClaim.DiscardUnknown(m)
 }
 
-func _Msg_SlashTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSlashTrustDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFileCompensationClaim proto.InternalMessageInfo
+
+func (m *MsgFileCompensationClaim) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SlashTrustDeposit(ctx, in)
+	return ""
+}
+
+func (m *MsgFileCompensationClaim) GetEcosystemId() uint64 {
+	if m != nil {
+		return m.EcosystemId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Msg/SlashTrustDeposit",
+	return 0
+}
+
+func (m *MsgFileCompensationClaim) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SlashTrustDeposit(ctx, req.(*MsgSlashTrustDeposit))
+	return 0
+}
+
+func (m *MsgFileCompensationClaim) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RepaySlashedTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepaySlashedTrustDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RepaySlashedTrustDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Msg/RepaySlashedTrustDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepaySlashedTrustDeposit(ctx, req.(*MsgRepaySlashedTrustDeposit))
+func (m *MsgFileCompensationClaim) GetEvidenceDigest() string {
+	if m != nil {
+		return m.EvidenceDigest
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.td.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "ReclaimTrustDepositYield",
-			Handler:    _Msg_ReclaimTrustDepositYield_Handler,
-		},
-		{
-			MethodName: "SlashTrustDeposit",
-			Handler:    _Msg_SlashTrustDeposit_Handler,
-		},
-		{
-			MethodName: "RepaySlashedTrustDeposit",
-			Handler:    _Msg_RepaySlashedTrustDeposit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/td/v1/tx.proto",
+type MsgFileCompensationClaimResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgFileCompensationClaimResponse) Reset()         { *m = MsgFileCompensationClaimResponse{} }
+func (m *MsgFileCompensationClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFileCompensationClaimResponse) ProtoMessage()    {}
+func (*MsgFileCompensationClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{13}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFileCompensationClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgFileCompensationClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFileCompensationClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgFileCompensationClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFileCompensationClaimResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFileCompensationClaimResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgFileCompensationClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFileCompensationClaimResponse.DiscardUnknown(m)
 }
 
-func (m *MsgReclaimTrustDepositYield) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFileCompensationClaimResponse proto.InternalMessageInfo
+
+func (m *MsgFileCompensationClaimResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgReclaimTrustDepositYield) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgResolveCompensationClaim defines the message for the ecosystem
+// corporation group policy to approve or reject a compensation claim.
+type MsgResolveCompensationClaim struct {
+	// authority MUST be the group policy of the ecosystem corporation
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// approve pays the claim from the pool, else the claim is rejected
+	Approve bool `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	// amount is the approved payout, at most the claimed amount. 0 pays the
+	// claimed amount.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgResolveCompensationClaim) Reset()         { *m = MsgResolveCompensationClaim{} }
+func (m *MsgResolveCompensationClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveCompensationClaim) ProtoMessage()    {}
+func (*MsgResolveCompensationClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{14}
+}
+func (m *MsgResolveCompensationClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgReclaimTrustDepositYield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgResolveCompensationClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveCompensationClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgResolveCompensationClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveCompensationClaim.Merge(m, src)
+}
+func (m *MsgResolveCompensationClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveCompensationClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveCompensationClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveCompensationClaim proto.InternalMessageInfo
+
+func (m *MsgResolveCompensationClaim) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgReclaimTrustDepositYieldResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveCompensationClaim) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgReclaimTrustDepositYieldResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResolveCompensationClaim) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
 }
 
-func (m *MsgReclaimTrustDepositYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClaimedAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ClaimedAmount))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgResolveCompensationClaim) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSlashTrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveCompensationClaim) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSlashTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgResolveCompensationClaimResponse struct {
+	PaidAmount uint64 `protobuf:"varint,1,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
 }
 
-func (m *MsgSlashTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgResolveCompensationClaimResponse) Reset()         { *m = MsgResolveCompensationClaimResponse{} }
+func (m *MsgResolveCompensationClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveCompensationClaimResponse) ProtoMessage()    {}
+func (*MsgResolveCompensationClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{15}
+}
+func (m *MsgResolveCompensationClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveCompensationClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveCompensationClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSlashTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgResolveCompensationClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveCompensationClaimResponse.Merge(m, src)
+}
+func (m *MsgResolveCompensationClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveCompensationClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveCompensationClaimResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSlashTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgResolveCompensationClaimResponse proto.InternalMessageInfo
+
+func (m *MsgResolveCompensationClaimResponse) GetPaidAmount() uint64 {
+	if m != nil {
+		return m.PaidAmount
+	}
+	return 0
 }
 
-func (m *MsgSlashTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "verana.td.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.td.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgReclaimTrustDepositYield)(nil), "verana.td.v1.MsgReclaimTrustDepositYield")
+	proto.RegisterType((*MsgReclaimTrustDepositYieldResponse)(nil), "verana.td.v1.MsgReclaimTrustDepositYieldResponse")
+	proto.RegisterType((*MsgSlashTrustDeposit)(nil), "verana.td.v1.MsgSlashTrustDeposit")
+	proto.RegisterType((*MsgSlashTrustDepositResponse)(nil), "verana.td.v1.MsgSlashTrustDepositResponse")
+	proto.RegisterType((*MsgRepaySlashedTrustDeposit)(nil), "verana.td.v1.MsgRepaySlashedTrustDeposit")
+	proto.RegisterType((*MsgRepaySlashedTrustDepositResponse)(nil), "verana.td.v1.MsgRepaySlashedTrustDepositResponse")
+	proto.RegisterType((*MsgDepositTrust)(nil), "verana.td.v1.MsgDepositTrust")
+	proto.RegisterType((*MsgDepositTrustResponse)(nil), "verana.td.v1.MsgDepositTrustResponse")
+	proto.RegisterType((*MsgWithdrawClaimable)(nil), "verana.td.v1.MsgWithdrawClaimable")
+	proto.RegisterType((*MsgWithdrawClaimableResponse)(nil), "verana.td.v1.MsgWithdrawClaimableResponse")
+	proto.RegisterType((*MsgFileCompensationClaim)(nil), "verana.td.v1.MsgFileCompensationClaim")
+	proto.RegisterType((*MsgFileCompensationClaimResponse)(nil), "verana.td.v1.MsgFileCompensationClaimResponse")
+	proto.RegisterType((*MsgResolveCompensationClaim)(nil), "verana.td.v1.MsgResolveCompensationClaim")
+	proto.RegisterType((*MsgResolveCompensationClaimResponse)(nil), "verana.td.v1.MsgResolveCompensationClaimResponse")
 }
 
-func (m *MsgRepaySlashedTrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("verana/td/v1/tx.proto", fileDescriptor_ae13ffc8589bdf17) }
+
+var fileDescriptor_ae13ffc8589bdf17 = []byte{
+	// 998 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0xbb, 0x4b, 0x32, 0x09, 0x69, 0x63, 0x25, 0xad, 0xbb, 0x69, 0x37, 0xc1, 0x25,
+	0x34, 0x44, 0x8a, 0xad, 0xa4, 0xa8, 0x55, 0x57, 0x5c, 0x9a, 0x46, 0x95, 0x5a, 0x75, 0x25, 0xe4,
+	0x16, 0x21, 0xb8, 0x44, 0x13, 0x7b, 0xe4, 0xb5, 0x58, 0x7b, 0x2c, 0xcf, 0x64, 0xc9, 0xde, 0x10,
+	0xe2, 0xc4, 0xa9, 0x3f, 0x83, 0x63, 0x84, 0xf8, 0x11, 0x15, 0xa7, 0x0a, 0x71, 0x40, 0x1c, 0x22,
+	0x48, 0x90, 0x72, 0xe0, 0x4f, 0x20, 0xcf, 0x8c, 0x1d, 0xdb, 0x33, 0xde, 0x86, 0x1e, 0x80, 0x4b,
+	0x94, 0x79, 0xef, 0x7b, 0x6f, 0xde, 0xf7, 0xcd, 0x9b, 0x37, 0x5e, 0xb0, 0x3c, 0x42, 0x09, 0x8c,
+	0xa0, 0x4d, 0x3d, 0x7b, 0xb4, 0x6d, 0xd3, 0x23, 0x2b, 0x4e, 0x30, 0xc5, 0xfa, 0x3c, 0x37, 0x5b,
+	0xd4, 0xb3, 0x46, 0xdb, 0x9d, 0x45, 0x18, 0x06, 0x11, 0xb6, 0xd9, 0x5f, 0x0e, 0xe8, 0x5c, 0x77,
+	0x31, 0x09, 0x31, 0xb1, 0x43, 0xe2, 0xa7, 0x81, 0x21, 0xf1, 0x85, 0xe3, 0x06, 0x77, 0xec, 0xb3,
+	0x95, 0xcd, 0x17, 0xc2, 0xb5, 0xe4, 0x63, 0x1f, 0x73, 0x7b, 0xfa, 0x5f, 0x16, 0x50, 0xaa, 0x20,
+	0x86, 0x09, 0x0c, 0x45, 0x80, 0xf9, 0x83, 0x06, 0xae, 0xf4, 0x89, 0xff, 0x69, 0xec, 0x41, 0x8a,
+	0x3e, 0x61, 0x1e, 0xfd, 0x1e, 0x98, 0x85, 0x87, 0x74, 0x80, 0x93, 0x80, 0x8e, 0x0d, 0x6d, 0x4d,
+	0xdb, 0x98, 0xdd, 0x35, 0x7e, 0xfe, 0x71, 0x6b, 0x49, 0xec, 0xf4, 0xd0, 0xf3, 0x12, 0x44, 0xc8,
+	0x73, 0x9a, 0x04, 0x91, 0xef, 0x5c, 0x40, 0xf5, 0xfb, 0xa0, 0xcd, 0x73, 0x1b, 0x8d, 0x35, 0x6d,
+	0x63, 0x6e, 0x67, 0xc9, 0x2a, 0x52, 0xb4, 0x78, 0xf6, 0xdd, 0xd9, 0x57, 0x27, 0xab, 0x53, 0xdf,
+	0x9f, 0x1f, 0x6f, 0x6a, 0x8e, 0x80, 0xf7, 0xac, 0x6f, 0xce, 0x8f, 0x37, 0x2f, 0x12, 0x7d, 0x77,
+	0x7e, 0xbc, 0xb9, 0x22, 0x4a, 0x3e, 0x4a, 0x8b, 0xae, 0x14, 0x68, 0xde, 0x00, 0xd7, 0x2b, 0x26,
+	0x07, 0x91, 0x18, 0x47, 0x04, 0x99, 0x27, 0x1a, 0x58, 0xe9, 0x13, 0xdf, 0x41, 0xee, 0x10, 0x06,
+	0xe1, 0x8b, 0xe4, 0x90, 0xd0, 0x3d, 0x14, 0x63, 0x12, 0xd0, 0xcf, 0x03, 0x34, 0xf4, 0xf4, 0x1e,
+	0x98, 0x73, 0x71, 0x12, 0xe3, 0x04, 0xd2, 0x00, 0x47, 0x6f, 0x64, 0x57, 0x04, 0xeb, 0x1f, 0x81,
+	0x19, 0x1c, 0xa3, 0x04, 0x52, 0x9c, 0x30, 0x86, 0x93, 0x02, 0x73, 0x64, 0xef, 0x41, 0x4a, 0x2e,
+	0x5f, 0xa6, 0xdc, 0xee, 0x54, 0xb8, 0xd5, 0x15, 0xfb, 0xb4, 0x39, 0x33, 0x7d, 0xb5, 0xf9, 0xb4,
+	0x39, 0xd3, 0xbc, 0xda, 0x72, 0xda, 0x30, 0xc4, 0x87, 0x11, 0x35, 0x9f, 0x81, 0xdb, 0x13, 0x42,
+	0x32, 0x1d, 0xf4, 0x75, 0xb0, 0xc0, 0x10, 0xc8, 0xdb, 0xe7, 0x81, 0x8c, 0x6a, 0xd3, 0x79, 0x57,
+	0x58, 0x1f, 0xf2, 0x6c, 0x2f, 0x1b, 0x60, 0xa9, 0x4f, 0xfc, 0xe7, 0x43, 0x48, 0x06, 0xc5, 0x64,
+	0x6f, 0xdd, 0x03, 0x15, 0x7d, 0x1b, 0xff, 0x44, 0xdf, 0xfb, 0xe0, 0x1d, 0x8f, 0x6f, 0x6f, 0x4c,
+	0xb3, 0xb8, 0x5b, 0x69, 0xab, 0xfc, 0x76, 0xb2, 0xba, 0xcc, 0x63, 0x89, 0xf7, 0xa5, 0x15, 0x60,
+	0x3b, 0x84, 0x74, 0x60, 0x3d, 0x89, 0xa8, 0x93, 0xa1, 0xf5, 0x6b, 0xa0, 0x9d, 0x20, 0x48, 0x70,
+	0x64, 0x34, 0xd3, 0x38, 0x47, 0xac, 0x7a, 0x77, 0xe5, 0xbe, 0x5a, 0xab, 0x68, 0x2f, 0x31, 0x37,
+	0xbb, 0xe0, 0xa6, 0xca, 0x9e, 0x77, 0xd8, 0x9f, 0x59, 0x87, 0xc5, 0x70, 0xcc, 0x50, 0xc8, 0x2b,
+	0x29, 0xf7, 0xaf, 0x77, 0x98, 0x6e, 0x94, 0x75, 0x6b, 0xe6, 0xc2, 0x5c, 0xaa, 0xf7, 0xd4, 0x34,
+	0xcc, 0x75, 0xd1, 0x67, 0x6a, 0x77, 0xae, 0xc6, 0x4f, 0x7c, 0x7e, 0x08, 0x33, 0x83, 0xfc, 0x07,
+	0x0a, 0x5c, 0x03, 0xe2, 0x7a, 0x08, 0x01, 0xc4, 0xaa, 0xb7, 0x25, 0xf1, 0xaf, 0xce, 0x95, 0x62,
+	0xe1, 0x66, 0xc4, 0xe6, 0x4a, 0xd1, 0x94, 0xdf, 0xa7, 0x82, 0xc6, 0x5a, 0x49, 0x63, 0xfd, 0x01,
+	0x68, 0x91, 0x01, 0x4c, 0x90, 0x28, 0xf7, 0xb6, 0xe8, 0xd9, 0x15, 0xb9, 0x67, 0x9f, 0x21, 0x1f,
+	0xba, 0xe3, 0x3d, 0xe4, 0x3a, 0x3c, 0xc2, 0xfc, 0x45, 0x63, 0xb7, 0xef, 0xb3, 0x80, 0x0e, 0xbc,
+	0x04, 0x7e, 0xf5, 0x28, 0xbd, 0x9a, 0xf0, 0x60, 0x88, 0xfe, 0x47, 0x0a, 0xee, 0x48, 0x0a, 0x56,
+	0x6f, 0x90, 0x54, 0xbd, 0xf9, 0x31, 0xbb, 0x41, 0x92, 0x3d, 0xd7, 0xf2, 0x26, 0x98, 0x75, 0x33,
+	0xa3, 0x50, 0xf3, 0xc2, 0x60, 0x7e, 0xdb, 0x00, 0x46, 0x9f, 0xf8, 0x8f, 0x83, 0x21, 0x7a, 0x84,
+	0xc3, 0x18, 0x45, 0x84, 0xf1, 0x62, 0x69, 0x52, 0x72, 0x1c, 0x29, 0x06, 0xda, 0x44, 0x72, 0x19,
+	0x52, 0x7f, 0x0f, 0xcc, 0x23, 0x17, 0x93, 0x31, 0xa1, 0x28, 0xdc, 0x0f, 0x3c, 0x26, 0x4b, 0xd3,
+	0x99, 0xcb, 0x6d, 0x4f, 0xbc, 0x3a, 0xfe, 0x75, 0xa3, 0x45, 0xbf, 0x03, 0xae, 0xa0, 0x51, 0xe0,
+	0xa1, 0xc8, 0x45, 0xfb, 0x5e, 0xe0, 0x23, 0x42, 0x8d, 0x16, 0x03, 0x2c, 0x64, 0xe6, 0x3d, 0x66,
+	0xed, 0xdd, 0x63, 0x02, 0x66, 0xa5, 0xa4, 0x02, 0xbe, 0x5f, 0x11, 0x50, 0xc9, 0xd4, 0xdc, 0x01,
+	0x6b, 0x75, 0xbe, 0x5c, 0xc8, 0x05, 0xd0, 0x08, 0x3c, 0xa1, 0x60, 0x23, 0xf0, 0xcc, 0xbf, 0xb2,
+	0xd1, 0x44, 0xf0, 0x70, 0xa4, 0x50, 0xef, 0x6d, 0x87, 0x3a, 0xdf, 0xa7, 0x91, 0xed, 0x93, 0x5e,
+	0x06, 0x18, 0xc7, 0x09, 0x1e, 0x21, 0xa6, 0xd6, 0x8c, 0x93, 0x2d, 0x0b, 0x32, 0x36, 0x6b, 0x64,
+	0x6c, 0x95, 0x26, 0x74, 0x4f, 0x9e, 0xd0, 0xf2, 0x84, 0x52, 0xb3, 0x31, 0x1f, 0x8b, 0x09, 0xa5,
+	0x76, 0xe7, 0x22, 0xad, 0x82, 0xb9, 0x18, 0x06, 0x95, 0x67, 0x10, 0xa4, 0x26, 0xfe, 0x06, 0xee,
+	0xfc, 0xd1, 0x06, 0xd3, 0x7d, 0xe2, 0xeb, 0x2f, 0xc0, 0x7c, 0xe9, 0x33, 0xe8, 0x56, 0xf9, 0xf3,
+	0xa5, 0xf2, 0xc5, 0xd1, 0x59, 0x9f, 0xe8, 0xce, 0xb7, 0x3f, 0x02, 0x46, 0xed, 0xc7, 0xc8, 0x87,
+	0x52, 0x8a, 0x3a, 0x68, 0x67, 0xfb, 0xd2, 0xd0, 0x7c, 0x67, 0x17, 0x2c, 0xca, 0xef, 0xba, 0x29,
+	0xe5, 0x91, 0x30, 0x9d, 0xcd, 0x37, 0x63, 0xca, 0xf4, 0x6a, 0x5e, 0x42, 0x15, 0x3d, 0x35, 0x54,
+	0x49, 0x6f, 0xf2, 0xcb, 0x93, 0x1e, 0x57, 0xe9, 0xd5, 0x91, 0x8f, 0xab, 0xe8, 0x56, 0x1c, 0x97,
+	0x72, 0xce, 0xbb, 0x60, 0x51, 0x1e, 0xc7, 0xb2, 0x68, 0x12, 0x46, 0x21, 0x5a, 0xfd, 0x00, 0xc4,
+	0x60, 0x59, 0x3d, 0xde, 0x3e, 0x90, 0x92, 0x28, 0x71, 0x1d, 0xeb, 0x72, 0xb8, 0xf2, 0x29, 0xd5,
+	0x0c, 0x05, 0xd5, 0x29, 0xa9, 0xa1, 0xca, 0x53, 0x9a, 0x7c, 0xfb, 0x3a, 0xad, 0xaf, 0xd3, 0x2f,
+	0xfd, 0xdd, 0xdd, 0x57, 0xa7, 0x5d, 0xed, 0xf5, 0x69, 0x57, 0xfb, 0xfd, 0xb4, 0xab, 0xbd, 0x3c,
+	0xeb, 0x4e, 0xbd, 0x3e, 0xeb, 0x4e, 0xfd, 0x7a, 0xd6, 0x9d, 0xfa, 0x62, 0xc3, 0x0f, 0xe8, 0xe0,
+	0xf0, 0xc0, 0x72, 0x71, 0x68, 0xf3, 0xec, 0x5b, 0x43, 0x78, 0x40, 0xec, 0xe2, 0x14, 0xa0, 0xe3,
+	0x18, 0x91, 0x83, 0x36, 0xfb, 0xc5, 0x72, 0xf7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x11,
+	0x0e, 0x15, 0x50, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ReclaimTrustDepositYield(ctx context.Context, in *MsgReclaimTrustDepositYield, opts ...grpc.CallOption) (*MsgReclaimTrustDepositYieldResponse, error)
+	// SlashTrustDeposit defines a governance operation to slash a corporation's trust deposit
+	SlashTrustDeposit(ctx context.Context, in *MsgSlashTrustDeposit, opts ...grpc.CallOption) (*MsgSlashTrustDepositResponse, error)
+	RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepaySlashedTrustDepositResponse, error)
+	// DepositTrust voluntarily increases a corporation's trust deposit
+	DepositTrust(ctx context.Context, in *MsgDepositTrust, opts ...grpc.CallOption) (*MsgDepositTrustResponse, error)
+	// WithdrawClaimable withdraws part of a corporation's claimable balance
+	WithdrawClaimable(ctx context.Context, in *MsgWithdrawClaimable, opts ...grpc.CallOption) (*MsgWithdrawClaimableResponse, error)
+	// FileCompensationClaim files a claim against the compensation pool of an ecosystem
+	FileCompensationClaim(ctx context.Context, in *MsgFileCompensationClaim, opts ...grpc.CallOption) (*MsgFileCompensationClaimResponse, error)
+	// ResolveCompensationClaim approves and pays, or rejects, a compensation claim
+	ResolveCompensationClaim(ctx context.Context, in *MsgResolveCompensationClaim, opts ...grpc.CallOption) (*MsgResolveCompensationClaimResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRepaySlashedTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReclaimTrustDepositYield(ctx context.Context, in *MsgReclaimTrustDepositYield, opts ...grpc.CallOption) (*MsgReclaimTrustDepositYieldResponse, error) {
+	out := new(MsgReclaimTrustDepositYieldResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/ReclaimTrustDepositYield", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepaySlashedTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Deposit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Deposit))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SlashTrustDeposit(ctx context.Context, in *MsgSlashTrustDeposit, opts ...grpc.CallOption) (*MsgSlashTrustDepositResponse, error) {
+	out := new(MsgSlashTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/SlashTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRepaySlashedTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepaySlashedTrustDepositResponse, error) {
+	out := new(MsgRepaySlashedTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/RepaySlashedTrustDeposit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRepaySlashedTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DepositTrust(ctx context.Context, in *MsgDepositTrust, opts ...grpc.CallOption) (*MsgDepositTrustResponse, error) {
+	out := new(MsgDepositTrustResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/DepositTrust", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepaySlashedTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) WithdrawClaimable(ctx context.Context, in *MsgWithdrawClaimable, opts ...grpc.CallOption) (*MsgWithdrawClaimableResponse, error) {
+	out := new(MsgWithdrawClaimableResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/WithdrawClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) FileCompensationClaim(ctx context.Context, in *MsgFileCompensationClaim, opts ...grpc.CallOption) (*MsgFileCompensationClaimResponse, error) {
+	out := new(MsgFileCompensationClaimResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/FileCompensationClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) ResolveCompensationClaim(ctx context.Context, in *MsgResolveCompensationClaim, opts ...grpc.CallOption) (*MsgResolveCompensationClaimResponse, error) {
+	out := new(MsgResolveCompensationClaimResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/ResolveCompensationClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ReclaimTrustDepositYield(context.Context, *MsgReclaimTrustDepositYield) (*MsgReclaimTrustDepositYieldResponse, error)
+	// SlashTrustDeposit defines a governance operation to slash a corporation's trust deposit
+	SlashTrustDeposit(context.Context, *MsgSlashTrustDeposit) (*MsgSlashTrustDepositResponse, error)
+	RepaySlashedTrustDeposit(context.Context, *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error)
+	// DepositTrust voluntarily increases a corporation's trust deposit
+	DepositTrust(context.Context, *MsgDepositTrust) (*MsgDepositTrustResponse, error)
+	// WithdrawClaimable withdraws part of a corporation's claimable balance
+	WithdrawClaimable(context.Context, *MsgWithdrawClaimable) (*MsgWithdrawClaimableResponse, error)
+	// FileCompensationClaim files a claim against the compensation pool of an ecosystem
+	FileCompensationClaim(context.Context, *MsgFileCompensationClaim) (*MsgFileCompensationClaimResponse, error)
+	// ResolveCompensationClaim approves and pays, or rejects, a compensation claim
+	ResolveCompensationClaim(context.Context, *MsgResolveCompensationClaim) (*MsgResolveCompensationClaimResponse, error)
 }
 
-func (m *MsgReclaimTrustDepositYield) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimTrustDepositYield(ctx context.Context, req *MsgReclaimTrustDepositYield) (*MsgReclaimTrustDepositYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimTrustDepositYield not implemented")
+}
+func (*UnimplementedMsgServer) SlashTrustDeposit(ctx context.Context, req *MsgSlashTrustDeposit) (*MsgSlashTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) RepaySlashedTrustDeposit(ctx context.Context, req *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepaySlashedTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) DepositTrust(ctx context.Context, req *MsgDepositTrust) (*MsgDepositTrustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositTrust not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawClaimable(ctx context.Context, req *MsgWithdrawClaimable) (*MsgWithdrawClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawClaimable not implemented")
+}
+func (*UnimplementedMsgServer) FileCompensationClaim(ctx context.Context, req *MsgFileCompensationClaim) (*MsgFileCompensationClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileCompensationClaim not implemented")
+}
+func (*UnimplementedMsgServer) ResolveCompensationClaim(ctx context.Context, req *MsgResolveCompensationClaim) (*MsgResolveCompensationClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCompensationClaim not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/UpdateParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReclaimTrustDepositYieldResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReclaimTrustDepositYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimTrustDepositYield)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ClaimedAmount != 0 {
-		n += 1 + sovTx(uint64(m.ClaimedAmount))
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimTrustDepositYield(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/ReclaimTrustDepositYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimTrustDepositYield(ctx, req.(*MsgReclaimTrustDepositYield))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSlashTrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SlashTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SlashTrustDeposit(ctx, in)
 	}
-	l = len(m.Corporation)
-	if l > 0 {
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/SlashTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashTrustDeposit(ctx, req.(*MsgSlashTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepaySlashedTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepaySlashedTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepaySlashedTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/RepaySlashedTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepaySlashedTrustDeposit(ctx, req.(*MsgRepaySlashedTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositTrust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositTrust)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositTrust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/DepositTrust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositTrust(ctx, req.(*MsgDepositTrust))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawClaimable)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/WithdrawClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawClaimable(ctx, req.(*MsgWithdrawClaimable))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FileCompensationClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFileCompensationClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FileCompensationClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/FileCompensationClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FileCompensationClaim(ctx, req.(*MsgFileCompensationClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveCompensationClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveCompensationClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveCompensationClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/ResolveCompensationClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveCompensationClaim(ctx, req.(*MsgResolveCompensationClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.td.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ReclaimTrustDepositYield",
+			Handler:    _Msg_ReclaimTrustDepositYield_Handler,
+		},
+		{
+			MethodName: "SlashTrustDeposit",
+			Handler:    _Msg_SlashTrustDeposit_Handler,
+		},
+		{
+			MethodName: "RepaySlashedTrustDeposit",
+			Handler:    _Msg_RepaySlashedTrustDeposit_Handler,
+		},
+		{
+			MethodName: "DepositTrust",
+			Handler:    _Msg_DepositTrust_Handler,
+		},
+		{
+			MethodName: "WithdrawClaimable",
+			Handler:    _Msg_WithdrawClaimable_Handler,
+		},
+		{
+			MethodName: "FileCompensationClaim",
+			Handler:    _Msg_FileCompensationClaim_Handler,
+		},
+		{
+			MethodName: "ResolveCompensationClaim",
+			Handler:    _Msg_ResolveCompensationClaim_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/td/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTrustDepositYield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTrustDepositYield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTrustDepositYield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTrustDepositYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTrustDepositYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTrustDepositYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimedAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimedAmount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepaySlashedTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepaySlashedTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepaySlashedTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepaySlashedTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepaySlashedTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepaySlashedTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositTrust) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositTrust) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositTrust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositTrustResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositTrustResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositTrustResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Deposit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawClaimable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawClaimable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawClaimable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimable != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Claimable))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFileCompensationClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFileCompensationClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFileCompensationClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceDigest) > 0 {
+		i -= len(m.EvidenceDigest)
+		copy(dAtA[i:], m.EvidenceDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceDigest)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EcosystemId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EcosystemId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFileCompensationClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFileCompensationClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFileCompensationClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveCompensationClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveCompensationClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveCompensationClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveCompensationClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveCompensationClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveCompensationClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaidAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PaidAmount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReclaimTrustDepositYield) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReclaimTrustDepositYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimedAmount != 0 {
+		n += 1 + sovTx(uint64(m.ClaimedAmount))
+	}
+	return n
+}
+
+func (m *MsgSlashTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSlashTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepaySlashedTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deposit != 0 {
+		n += 1 + sovTx(uint64(m.Deposit))
+	}
+	return n
+}
+
+func (m *MsgRepaySlashedTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositTrust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgDepositTrustResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != 0 {
+		n += 1 + sovTx(uint64(m.Deposit))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawClaimable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgWithdrawClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimable != 0 {
+		n += 1 + sovTx(uint64(m.Claimable))
+	}
+	return n
+}
+
+func (m *MsgFileCompensationClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EcosystemId != 0 {
+		n += 1 + sovTx(uint64(m.EcosystemId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFileCompensationClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgResolveCompensationClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Approve {
+		n += 2
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Reason)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResolveCompensationClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaidAmount != 0 {
+		n += 1 + sovTx(uint64(m.PaidAmount))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimTrustDepositYield) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimTrustDepositYield: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimTrustDepositYield: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimTrustDepositYieldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimTrustDepositYieldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimTrustDepositYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			m.ClaimedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSlashTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRepaySlashedTrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Deposit != 0 {
-		n += 1 + sovTx(uint64(m.Deposit))
+func (m *MsgRepaySlashedTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepaySlashedTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepaySlashedTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			m.Deposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRepaySlashedTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRepaySlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepaySlashedTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepaySlashedTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositTrust) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,15 +2984,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositTrust: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositTrust: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,13 +3020,45 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Corporation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1162,25 +3068,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1202,7 +3094,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositTrustResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,12 +3117,65 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositTrustResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositTrustResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			m.Deposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1252,7 +3197,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReclaimTrustDepositYield) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClaimable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1275,10 +3220,10 @@ func (m *MsgReclaimTrustDepositYield) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReclaimTrustDepositYield: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClaimable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReclaimTrustDepositYield: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClaimable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1345,6 +3290,25 @@ func (m *MsgReclaimTrustDepositYield) Unmarshal(dAtA []byte) error {
 			}
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1366,7 +3330,7 @@ func (m *MsgReclaimTrustDepositYield) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReclaimTrustDepositYieldResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClaimableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1389,17 +3353,17 @@ func (m *MsgReclaimTrustDepositYieldResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReclaimTrustDepositYieldResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClaimableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReclaimTrustDepositYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
 			}
-			m.ClaimedAmount = 0
+			m.Claimable = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1409,7 +3373,7 @@ func (m *MsgReclaimTrustDepositYieldResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClaimedAmount |= uint64(b&0x7F) << shift
+				m.Claimable |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1435,7 +3399,7 @@ func (m *MsgReclaimTrustDepositYieldResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgFileCompensationClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1458,15 +3422,15 @@ func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashTrustDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFileCompensationClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFileCompensationClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1494,13 +3458,13 @@ func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
 			}
-			var stringLen uint64
+			m.EcosystemId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1510,27 +3474,33 @@ func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EcosystemId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Corporation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1558,13 +3528,11 @@ func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1592,7 +3560,7 @@ func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.EvidenceDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1615,7 +3583,7 @@ func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSlashTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFileCompensationClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1638,12 +3606,31 @@ func (m *MsgSlashTrustDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFileCompensationClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFileCompensationClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1665,7 +3652,7 @@ func (m *MsgSlashTrustDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepaySlashedTrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveCompensationClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1688,15 +3675,15 @@ func (m *MsgRepaySlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepaySlashedTrustDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveCompensationClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepaySlashedTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveCompensationClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1724,13 +3711,13 @@ func (m *MsgRepaySlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corporation = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1740,29 +3727,36 @@ func (m *MsgRepaySlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Approve = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Deposit = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1772,11 +3766,43 @@ func (m *MsgRepaySlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deposit |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1798,7 +3824,7 @@ func (m *MsgRepaySlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepaySlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveCompensationClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,12 +3847,31 @@ func (m *MsgRepaySlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepaySlashedTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveCompensationClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepaySlashedTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveCompensationClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
+			}
+			m.PaidAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1,11 +1,19 @@
 package types
 
 import (
+	"math"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/verana-labs/verana/util/validation"
 )
 
+// MaxCompensationReasonLength bounds the free-text reasons of a compensation
+// claim and of its resolution, both stored on the claim.
+const MaxCompensationReasonLength = 1024
+
 // ValidateBasic implements sdk.Msg
 // [MOD-TD-MSG-2-1] Spec v4 draft 13: parameters are corporation + operator only.
 func (msg *MsgReclaimTrustDepositYield) ValidateBasic() error {
@@ -60,3 +68,92 @@ func (msg *MsgRepaySlashedTrustDeposit) ValidateBasic() error {
 
 	return nil
 }
+
+func (msg *MsgDepositTrust) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Corporation)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid corporation address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than 0")
+	}
+	if msg.Amount > math.MaxInt64 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount exceeds maximum coin amount")
+	}
+
+	return nil
+}
+
+func (msg *MsgWithdrawClaimable) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Corporation)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid corporation address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than 0")
+	}
+	if msg.Amount > math.MaxInt64 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount exceeds maximum coin amount")
+	}
+
+	return nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgFileCompensationClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimant); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimant address (%s)", err)
+	}
+	if msg.EcosystemId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ecosystem_id must be greater than 0")
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than 0")
+	}
+	if msg.Amount > math.MaxInt64 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount exceeds maximum coin amount")
+	}
+	if msg.Reason == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason is required")
+	}
+	if len(msg.Reason) > MaxCompensationReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason must not exceed %d characters", MaxCompensationReasonLength)
+	}
+	// the evidence is mandatory and MUST be anchored in x/di
+	if msg.EvidenceDigest == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "evidence_digest is required")
+	}
+	if !validation.IsValidDigestSRI(msg.EvidenceDigest) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid evidence_digest format")
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgResolveCompensationClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if msg.Id == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "id must be greater than 0")
+	}
+	if !msg.Approve && msg.Amount != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must not be set when rejecting a claim")
+	}
+	if len(msg.Reason) > MaxCompensationReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason must not exceed %d characters", MaxCompensationReasonLength)
+	}
+	return nil
+}
@@ -31,7 +31,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// TrustDeposit represents a corporation's trust deposit
+type CompensationClaimStatus int32
+
+const (
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_UNSPECIFIED CompensationClaimStatus = 0
+	// PENDING claims wait for the ecosystem corporation group policy
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PENDING CompensationClaimStatus = 1
+	// PAID claims were approved and paid_amount sent to the claimant
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_PAID     CompensationClaimStatus = 2
+	CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_REJECTED CompensationClaimStatus = 3
+)
+
+var CompensationClaimStatus_name = map[int32]string{
+	0: "COMPENSATION_CLAIM_STATUS_UNSPECIFIED",
+	1: "COMPENSATION_CLAIM_STATUS_PENDING",
+	2: "COMPENSATION_CLAIM_STATUS_PAID",
+	3: "COMPENSATION_CLAIM_STATUS_REJECTED",
+}
+
+var CompensationClaimStatus_value = map[string]int32{
+	"COMPENSATION_CLAIM_STATUS_UNSPECIFIED": 0,
+	"COMPENSATION_CLAIM_STATUS_PENDING":     1,
+	"COMPENSATION_CLAIM_STATUS_PAID":        2,
+	"COMPENSATION_CLAIM_STATUS_REJECTED":    3,
+}
+
+func (x CompensationClaimStatus) String() string {
+	return proto.EnumName(CompensationClaimStatus_name, int32(x))
+}
+
+func (CompensationClaimStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{0}
+}
+
+// TrustDeposit represents a corporation's trust deposit, keyed by
+// corporation_id. corporation is the policy_address of the Corporation,
+// kept for the by-account lookup and fund-flows.
 type TrustDeposit struct {
 	Corporation    string                      `protobuf:"bytes,1,opt,name=corporation,proto3" json:"corporation,omitempty"`
 	Share          cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share" yaml:"trust_deposit_share_value"`
@@ -42,6 +77,15 @@ type TrustDeposit struct {
 	LastSlashed    *time.Time                  `protobuf:"bytes,7,opt,name=last_slashed,json=lastSlashed,proto3,stdtime" json:"last_slashed,omitempty"`
 	LastRepaid     *time.Time                  `protobuf:"bytes,8,opt,name=last_repaid,json=lastRepaid,proto3,stdtime" json:"last_repaid,omitempty"`
 	SlashCount     uint64                      `protobuf:"varint,9,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	CorporationId  uint64                      `protobuf:"varint,10,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Modified       *time.Time                  `protobuf:"bytes,11,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
+	// burned_deposit is the cumulative amount burned from this deposit, by
+	// ecosystem slashes and when a governance slash is repaid
+	BurnedDeposit uint64 `protobuf:"varint,12,opt,name=burned_deposit,json=burnedDeposit,proto3" json:"burned_deposit,omitempty"`
+	// unbonding is the part of deposit released but not yet claimable, the
+	// sum of the UnbondingTrustDeposit entries of the corporation. It is
+	// still slashable.
+	Unbonding uint64 `protobuf:"varint,13,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
 }
 
 func (m *TrustDeposit) Reset()         { *m = TrustDeposit{} }
@@ -133,25 +177,57 @@ func (m *TrustDeposit) GetSlashCount() uint64 {
 	return 0
 }
 
-type SlashTrustDepositProposal struct {
-	Title       string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
-	Description string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	Corporation string                `protobuf:"bytes,3,opt,name=corporation,proto3" json:"corporation,omitempty" yaml:"corporation"`
-	Deposit     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit" yaml:"deposit"`
+func (m *TrustDeposit) GetCorporationId() uint64 {
+	if m != nil {
+		return m.CorporationId
+	}
+	return 0
 }
 
-func (m *SlashTrustDepositProposal) Reset()         { *m = SlashTrustDepositProposal{} }
-func (m *SlashTrustDepositProposal) String() string { return proto.CompactTextString(m) }
-func (*SlashTrustDepositProposal) ProtoMessage()    {}
-func (*SlashTrustDepositProposal) Descriptor() ([]byte, []int) {
+func (m *TrustDeposit) GetModified() *time.Time {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+func (m *TrustDeposit) GetBurnedDeposit() uint64 {
+	if m != nil {
+		return m.BurnedDeposit
+	}
+	return 0
+}
+
+func (m *TrustDeposit) GetUnbonding() uint64 {
+	if m != nil {
+		return m.Unbonding
+	}
+	return 0
+}
+
+// UnbondingTrustDeposit is an amount released from a trust deposit that is
+// moved to claimable by the EndBlocker at completion_time.
+type UnbondingTrustDeposit struct {
+	Id             uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CorporationId  uint64    `protobuf:"varint,2,opt,name=corporation_id,json=corporationId,proto3" json:"corporation_id,omitempty"`
+	Amount         uint64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Created        time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	Reason         string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *UnbondingTrustDeposit) Reset()         { *m = UnbondingTrustDeposit{} }
+func (m *UnbondingTrustDeposit) String() string { return proto.CompactTextString(m) }
+func (*UnbondingTrustDeposit) ProtoMessage()    {}
+func (*UnbondingTrustDeposit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ffd0c7b737037a10, []int{1}
 }
-func (m *SlashTrustDepositProposal) XXX_Unmarshal(b []byte) error {
+func (m *UnbondingTrustDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SlashTrustDepositProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnbondingTrustDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SlashTrustDepositProposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnbondingTrustDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -161,304 +237,2062 @@ func (m *SlashTrustDepositProposal) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *SlashTrustDepositProposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SlashTrustDepositProposal.Merge(m, src)
+func (m *UnbondingTrustDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingTrustDeposit.Merge(m, src)
 }
-func (m *SlashTrustDepositProposal) XXX_Size() int {
+func (m *UnbondingTrustDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *SlashTrustDepositProposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_SlashTrustDepositProposal.DiscardUnknown(m)
+func (m *UnbondingTrustDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingTrustDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SlashTrustDepositProposal proto.InternalMessageInfo
+var xxx_messageInfo_UnbondingTrustDeposit proto.InternalMessageInfo
 
-func (m *SlashTrustDepositProposal) GetTitle() string {
+func (m *UnbondingTrustDeposit) GetId() uint64 {
 	if m != nil {
-		return m.Title
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *SlashTrustDepositProposal) GetDescription() string {
+func (m *UnbondingTrustDeposit) GetCorporationId() uint64 {
 	if m != nil {
-		return m.Description
+		return m.CorporationId
 	}
-	return ""
+	return 0
 }
 
-func (m *SlashTrustDepositProposal) GetCorporation() string {
+func (m *UnbondingTrustDeposit) GetAmount() uint64 {
 	if m != nil {
-		return m.Corporation
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*TrustDeposit)(nil), "verana.td.v1.TrustDeposit")
-	proto.RegisterType((*SlashTrustDepositProposal)(nil), "verana.td.v1.SlashTrustDepositProposal")
+func (m *UnbondingTrustDeposit) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
 }
 
-func init() { proto.RegisterFile("verana/td/v1/types.proto", fileDescriptor_ffd0c7b737037a10) }
-
-var fileDescriptor_ffd0c7b737037a10 = []byte{
-	// 571 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8f, 0xdb, 0xb4, 0xfd, 0x32, 0xc9, 0x17, 0xc0, 0x2a, 0xe0, 0x06, 0x64, 0x47, 0x96, 0x80,
-	0x6c, 0xea, 0x51, 0x61, 0x83, 0xba, 0x6b, 0x5a, 0x09, 0x55, 0x62, 0x81, 0xdc, 0xae, 0x90, 0x90,
-	0x35, 0xb1, 0x07, 0x67, 0x84, 0xc7, 0x63, 0xcd, 0x4c, 0x22, 0xb2, 0xe5, 0x04, 0x3d, 0x0c, 0x87,
-	0xe8, 0xb2, 0x62, 0x85, 0xba, 0x30, 0x28, 0xb9, 0x41, 0x4e, 0x80, 0x3c, 0x33, 0x26, 0x2e, 0x6c,
-	0xd8, 0x44, 0xf3, 0x7e, 0x7f, 0x5e, 0x9e, 0xf5, 0x7b, 0x0f, 0x38, 0x73, 0xcc, 0x51, 0x8e, 0xa0,
-	0x4c, 0xe0, 0xfc, 0x08, 0xca, 0x45, 0x81, 0x45, 0x50, 0x70, 0x26, 0x99, 0xdd, 0xd3, 0x4c, 0x20,
-	0x93, 0x60, 0x7e, 0x34, 0x78, 0x80, 0x28, 0xc9, 0x19, 0x54, 0xbf, 0x5a, 0x30, 0x78, 0x1c, 0x33,
-	0x41, 0x99, 0x80, 0x54, 0xa4, 0x95, 0x97, 0x8a, 0xd4, 0x10, 0x07, 0x9a, 0x88, 0x54, 0x05, 0x75,
-	0x61, 0xa8, 0xfd, 0x94, 0xa5, 0x4c, 0xe3, 0xd5, 0xcb, 0xa0, 0x5e, 0xca, 0x58, 0x9a, 0x61, 0xa8,
-	0xaa, 0xc9, 0xec, 0x23, 0x94, 0x84, 0x62, 0x21, 0x11, 0x2d, 0xea, 0x8e, 0x77, 0xa6, 0x2c, 0x10,
-	0x47, 0xd4, 0x74, 0xf4, 0x6f, 0xb7, 0x41, 0xef, 0x92, 0xcf, 0x84, 0x3c, 0xc3, 0x05, 0x13, 0x44,
-	0xda, 0xc7, 0xa0, 0x1b, 0x33, 0x5e, 0x30, 0x8e, 0x24, 0x61, 0xb9, 0x63, 0x0d, 0xad, 0x51, 0x67,
-	0xec, 0x7c, 0xfb, 0x7a, 0xb8, 0x6f, 0x26, 0x39, 0x49, 0x12, 0x8e, 0x85, 0xb8, 0x90, 0x9c, 0xe4,
-	0x69, 0xd8, 0x14, 0xdb, 0x1f, 0xc0, 0x8e, 0x98, 0x22, 0x8e, 0x9d, 0x2d, 0xe5, 0x7a, 0x73, 0x5d,
-	0x7a, 0xad, 0xdb, 0xd2, 0x7b, 0xa2, 0x9d, 0x22, 0xf9, 0x14, 0x10, 0x06, 0x29, 0x92, 0xd3, 0xe0,
-	0x2d, 0x4e, 0x51, 0xbc, 0x38, 0xc3, 0xf1, 0xba, 0xf4, 0x86, 0x0b, 0x44, 0xb3, 0x63, 0x5f, 0x56,
-	0x53, 0x44, 0x89, 0x1e, 0x23, 0x52, 0x7d, 0xa2, 0x39, 0xca, 0x66, 0xd8, 0x0f, 0x75, 0x57, 0xdb,
-	0x01, 0x7b, 0x86, 0x76, 0xb6, 0x87, 0xd6, 0xa8, 0x1d, 0xd6, 0xa5, 0xfd, 0x14, 0x74, 0xe2, 0x0c,
-	0x11, 0x8a, 0x26, 0x19, 0x76, 0xda, 0x8a, 0xdb, 0x00, 0xf6, 0x0b, 0x70, 0x4f, 0x64, 0x48, 0x4c,
-	0x71, 0x52, 0xb7, 0x77, 0x76, 0x94, 0xa6, 0x6f, 0xe0, 0xfa, 0xdb, 0x9f, 0x81, 0x3e, 0xc7, 0x05,
-	0x22, 0x1b, 0xdd, 0xae, 0xd2, 0xfd, 0xaf, 0xd1, 0x5a, 0x76, 0x0a, 0x7a, 0x19, 0x12, 0x32, 0x32,
-	0x6e, 0x67, 0x6f, 0x68, 0x8d, 0xba, 0x2f, 0x07, 0x81, 0x8e, 0x21, 0xa8, 0x63, 0x08, 0x2e, 0xeb,
-	0x18, 0xc6, 0xed, 0xab, 0x1f, 0x9e, 0x15, 0x76, 0x2b, 0xd7, 0x85, 0x36, 0xd9, 0x27, 0x40, 0x95,
-	0x91, 0x6e, 0xed, 0xfc, 0xf7, 0x8f, 0x3d, 0x40, 0x65, 0x0a, 0x95, 0xc7, 0xf6, 0x40, 0x57, 0x8d,
-	0x10, 0xc5, 0x6c, 0x96, 0x4b, 0xa7, 0xa3, 0x66, 0x05, 0x0a, 0x3a, 0xad, 0x10, 0xff, 0xcb, 0x16,
-	0x38, 0x50, 0xff, 0xd7, 0x4c, 0xf8, 0x1d, 0x67, 0x05, 0x13, 0x28, 0xb3, 0x9f, 0x83, 0x1d, 0x49,
-	0x64, 0x86, 0x4d, 0xc6, 0xf7, 0xd7, 0xa5, 0xd7, 0x33, 0x51, 0x54, 0xb0, 0x1f, 0x6a, 0xda, 0x7e,
-	0x0d, 0xba, 0x09, 0x16, 0x31, 0x27, 0x85, 0xda, 0x08, 0x9d, 0xed, 0xa3, 0x75, 0xe9, 0xd9, 0x5a,
-	0xdd, 0x20, 0xfd, 0xb0, 0x29, 0xad, 0x9c, 0xcd, 0x5d, 0xda, 0xfe, 0xd3, 0xd9, 0x20, 0xfd, 0xbb,
-	0x9b, 0x74, 0xbe, 0x89, 0xba, 0xad, 0x5c, 0xd0, 0xec, 0xd2, 0xc3, 0xbf, 0x77, 0xe9, 0x3c, 0x97,
-	0xeb, 0xd2, 0xeb, 0xd7, 0xc3, 0x28, 0x97, 0xff, 0x7b, 0x37, 0xc6, 0xe3, 0xeb, 0xa5, 0x6b, 0xdd,
-	0x2c, 0x5d, 0xeb, 0xe7, 0xd2, 0xb5, 0xae, 0x56, 0x6e, 0xeb, 0x66, 0xe5, 0xb6, 0xbe, 0xaf, 0xdc,
-	0xd6, 0xfb, 0x51, 0x4a, 0xe4, 0x74, 0x36, 0x09, 0x62, 0x46, 0xa1, 0xbe, 0x90, 0xc3, 0x0c, 0x4d,
-	0x84, 0x79, 0xc3, 0xcf, 0xd5, 0xbd, 0xa8, 0x93, 0x9e, 0xec, 0xaa, 0x3c, 0x5e, 0xfd, 0x0a, 0x00,
-	0x00, 0xff, 0xff, 0xd5, 0xc5, 0x79, 0xba, 0xef, 0x03, 0x00, 0x00,
+func (m *UnbondingTrustDeposit) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
 }
 
-func (m *TrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UnbondingTrustDeposit) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ShareValueCheckpoint is a sample of the trust deposit share value, taken
+// by the BeginBlocker at most every share_value_checkpoint_interval_seconds.
+type ShareValueCheckpoint struct {
+	Timestamp   time.Time                   `protobuf:"bytes,1,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	BlockHeight int64                       `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ShareValue  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=share_value,json=shareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_value"`
 }
 
-func (m *TrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SlashCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SlashCount))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.LastRepaid != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastRepaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintTypes(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.LastSlashed != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSlashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintTypes(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.RepaidDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.SlashedDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Claimable != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Claimable))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Deposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Share.Size()
-		i -= size
-		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ShareValueCheckpoint) Reset()         { *m = ShareValueCheckpoint{} }
+func (m *ShareValueCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ShareValueCheckpoint) ProtoMessage()    {}
+func (*ShareValueCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{2}
+}
+func (m *ShareValueCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareValueCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareValueCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ShareValueCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareValueCheckpoint.Merge(m, src)
+}
+func (m *ShareValueCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareValueCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareValueCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareValueCheckpoint proto.InternalMessageInfo
+
+func (m *ShareValueCheckpoint) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *SlashTrustDepositProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ShareValueCheckpoint) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SlashTrustDepositProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CompensationPool holds the share of the slashes of an ecosystem's
+// participants set aside to compensate the parties they harmed. The funds are
+// held by the compensation pool module account.
+type CompensationPool struct {
+	EcosystemId   uint64 `protobuf:"varint,1,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+	Balance       uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	TotalReceived uint64 `protobuf:"varint,3,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
+	TotalPaid     uint64 `protobuf:"varint,4,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`
 }
 
-func (m *SlashTrustDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *CompensationPool) Reset()         { *m = CompensationPool{} }
+func (m *CompensationPool) String() string { return proto.CompactTextString(m) }
+func (*CompensationPool) ProtoMessage()    {}
+func (*CompensationPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{3}
+}
+func (m *CompensationPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompensationPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompensationPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Corporation) > 0 {
-		i -= len(m.Corporation)
-		copy(dAtA[i:], m.Corporation)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Corporation)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CompensationPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompensationPool.Merge(m, src)
+}
+func (m *CompensationPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompensationPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompensationPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompensationPool proto.InternalMessageInfo
+
+func (m *CompensationPool) GetEcosystemId() uint64 {
+	if m != nil {
+		return m.EcosystemId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CompensationPool) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *TrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CompensationPool) GetTotalReceived() uint64 {
+	if m != nil {
+		return m.TotalReceived
 	}
-	var l int
-	_ = l
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *CompensationPool) GetTotalPaid() uint64 {
+	if m != nil {
+		return m.TotalPaid
 	}
-	l = m.Share.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Deposit != 0 {
-		n += 1 + sovTypes(uint64(m.Deposit))
+	return 0
+}
+
+// CompensationClaim is a claim of a harmed party against the compensation
+// pool of an ecosystem.
+type CompensationClaim struct {
+	Id             uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EcosystemId    uint64                  `protobuf:"varint,2,opt,name=ecosystem_id,json=ecosystemId,proto3" json:"ecosystem_id,omitempty"`
+	Claimant       string                  `protobuf:"bytes,3,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Amount         uint64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason         string                  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceDigest string                  `protobuf:"bytes,6,opt,name=evidence_digest,json=evidenceDigest,proto3" json:"evidence_digest,omitempty"`
+	Status         CompensationClaimStatus `protobuf:"varint,7,opt,name=status,proto3,enum=verana.td.v1.CompensationClaimStatus" json:"status,omitempty"`
+	Created        *time.Time              `protobuf:"bytes,8,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	// resolved, resolution_reason and paid_amount are set when the claim is
+	// approved or rejected
+	Resolved         *time.Time `protobuf:"bytes,9,opt,name=resolved,proto3,stdtime" json:"resolved,omitempty"`
+	ResolutionReason string     `protobuf:"bytes,10,opt,name=resolution_reason,json=resolutionReason,proto3" json:"resolution_reason,omitempty"`
+	PaidAmount       uint64     `protobuf:"varint,11,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+}
+
+func (m *CompensationClaim) Reset()         { *m = CompensationClaim{} }
+func (m *CompensationClaim) String() string { return proto.CompactTextString(m) }
+func (*CompensationClaim) ProtoMessage()    {}
+func (*CompensationClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{4}
+}
+func (m *CompensationClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompensationClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompensationClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Claimable != 0 {
-		n += 1 + sovTypes(uint64(m.Claimable))
+}
+func (m *CompensationClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompensationClaim.Merge(m, src)
+}
+func (m *CompensationClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompensationClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompensationClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompensationClaim proto.InternalMessageInfo
+
+func (m *CompensationClaim) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.SlashedDeposit != 0 {
-		n += 1 + sovTypes(uint64(m.SlashedDeposit))
+	return 0
+}
+
+func (m *CompensationClaim) GetEcosystemId() uint64 {
+	if m != nil {
+		return m.EcosystemId
 	}
-	if m.RepaidDeposit != 0 {
-		n += 1 + sovTypes(uint64(m.RepaidDeposit))
+	return 0
+}
+
+func (m *CompensationClaim) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	if m.LastSlashed != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed)
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *CompensationClaim) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.LastRepaid != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *CompensationClaim) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CompensationClaim) GetEvidenceDigest() string {
+	if m != nil {
+		return m.EvidenceDigest
+	}
+	return ""
+}
+
+func (m *CompensationClaim) GetStatus() CompensationClaimStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CompensationClaimStatus_COMPENSATION_CLAIM_STATUS_UNSPECIFIED
+}
+
+func (m *CompensationClaim) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *CompensationClaim) GetResolved() *time.Time {
+	if m != nil {
+		return m.Resolved
+	}
+	return nil
+}
+
+func (m *CompensationClaim) GetResolutionReason() string {
+	if m != nil {
+		return m.ResolutionReason
+	}
+	return ""
+}
+
+func (m *CompensationClaim) GetPaidAmount() uint64 {
+	if m != nil {
+		return m.PaidAmount
+	}
+	return 0
+}
+
+// YieldFundingFailure records the last yield funding step that failed, it is
+// cleared by the next successful distribution.
+type YieldFundingFailure struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// stage is the failed step: transfer or return_excess
+	Stage  string `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *YieldFundingFailure) Reset()         { *m = YieldFundingFailure{} }
+func (m *YieldFundingFailure) String() string { return proto.CompactTextString(m) }
+func (*YieldFundingFailure) ProtoMessage()    {}
+func (*YieldFundingFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{5}
+}
+func (m *YieldFundingFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldFundingFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldFundingFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldFundingFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldFundingFailure.Merge(m, src)
+}
+func (m *YieldFundingFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldFundingFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldFundingFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldFundingFailure proto.InternalMessageInfo
+
+func (m *YieldFundingFailure) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *YieldFundingFailure) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *YieldFundingFailure) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *YieldFundingFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SlashTrustDepositProposal struct {
+	Title       string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Corporation string                `protobuf:"bytes,3,opt,name=corporation,proto3" json:"corporation,omitempty" yaml:"corporation"`
+	Deposit     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit" yaml:"deposit"`
+}
+
+func (m *SlashTrustDepositProposal) Reset()         { *m = SlashTrustDepositProposal{} }
+func (m *SlashTrustDepositProposal) String() string { return proto.CompactTextString(m) }
+func (*SlashTrustDepositProposal) ProtoMessage()    {}
+func (*SlashTrustDepositProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{6}
+}
+func (m *SlashTrustDepositProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashTrustDepositProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashTrustDepositProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashTrustDepositProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashTrustDepositProposal.Merge(m, src)
+}
+func (m *SlashTrustDepositProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashTrustDepositProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashTrustDepositProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashTrustDepositProposal proto.InternalMessageInfo
+
+func (m *SlashTrustDepositProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SlashTrustDepositProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SlashTrustDepositProposal) GetCorporation() string {
+	if m != nil {
+		return m.Corporation
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("verana.td.v1.CompensationClaimStatus", CompensationClaimStatus_name, CompensationClaimStatus_value)
+	proto.RegisterType((*TrustDeposit)(nil), "verana.td.v1.TrustDeposit")
+	proto.RegisterType((*UnbondingTrustDeposit)(nil), "verana.td.v1.UnbondingTrustDeposit")
+	proto.RegisterType((*ShareValueCheckpoint)(nil), "verana.td.v1.ShareValueCheckpoint")
+	proto.RegisterType((*CompensationPool)(nil), "verana.td.v1.CompensationPool")
+	proto.RegisterType((*CompensationClaim)(nil), "verana.td.v1.CompensationClaim")
+	proto.RegisterType((*YieldFundingFailure)(nil), "verana.td.v1.YieldFundingFailure")
+	proto.RegisterType((*SlashTrustDepositProposal)(nil), "verana.td.v1.SlashTrustDepositProposal")
+}
+
+func init() { proto.RegisterFile("verana/td/v1/types.proto", fileDescriptor_ffd0c7b737037a10) }
+
+var fileDescriptor_ffd0c7b737037a10 = []byte{
+	// 1159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xcf, 0x39, 0x71, 0x1a, 0xaf, 0x5d, 0xd7, 0x5d, 0xd2, 0xf6, 0x5a, 0xc0, 0x4e, 0x0f, 0xa5,
+	0x04, 0x50, 0x7d, 0x6a, 0xe1, 0x01, 0x55, 0x05, 0xc9, 0xff, 0x5a, 0x8c, 0xda, 0xd4, 0x3a, 0xbb,
+	0x48, 0x20, 0xa1, 0xd3, 0xfa, 0x6e, 0x7b, 0x5e, 0xe5, 0xee, 0xf6, 0xb8, 0x5d, 0x5b, 0xe4, 0x15,
+	0xf1, 0x8a, 0xd4, 0x17, 0xbe, 0x09, 0x12, 0x1f, 0x80, 0x97, 0x3e, 0x56, 0x3c, 0x21, 0x84, 0x0c,
+	0x4a, 0xbe, 0x41, 0x3e, 0x01, 0xda, 0x3f, 0x67, 0x5f, 0x9a, 0x26, 0xf2, 0x8b, 0x75, 0xf3, 0x9b,
+	0x99, 0xdd, 0x99, 0x9d, 0xdf, 0xcc, 0x18, 0x98, 0x33, 0x9c, 0xa2, 0x18, 0xd9, 0xdc, 0xb7, 0x67,
+	0xf7, 0x6c, 0x7e, 0x98, 0x60, 0xd6, 0x4c, 0x52, 0xca, 0x29, 0xac, 0x28, 0x4d, 0x93, 0xfb, 0xcd,
+	0xd9, 0xbd, 0x5b, 0x57, 0x51, 0x44, 0x62, 0x6a, 0xcb, 0x5f, 0x65, 0x70, 0xeb, 0x86, 0x47, 0x59,
+	0x44, 0x99, 0x1d, 0xb1, 0x40, 0xf8, 0x46, 0x2c, 0xd0, 0x8a, 0x9b, 0x4a, 0xe1, 0x4a, 0xc9, 0x56,
+	0x82, 0x56, 0x6d, 0x07, 0x34, 0xa0, 0x0a, 0x17, 0x5f, 0x1a, 0x6d, 0x04, 0x94, 0x06, 0x21, 0xb6,
+	0xa5, 0x34, 0x9e, 0xbe, 0xb0, 0x39, 0x89, 0x30, 0xe3, 0x28, 0x4a, 0xb2, 0x13, 0x4f, 0x45, 0x99,
+	0xa0, 0x14, 0x45, 0xfa, 0x44, 0xeb, 0xe7, 0x22, 0xa8, 0x8c, 0xd2, 0x29, 0xe3, 0x5d, 0x9c, 0x50,
+	0x46, 0x38, 0x7c, 0x00, 0xca, 0x1e, 0x4d, 0x13, 0x9a, 0x22, 0x4e, 0x68, 0x6c, 0x1a, 0x3b, 0xc6,
+	0x5e, 0xa9, 0x6d, 0xfe, 0xf9, 0xdb, 0xdd, 0x6d, 0x1d, 0x49, 0xcb, 0xf7, 0x53, 0xcc, 0xd8, 0x90,
+	0xa7, 0x24, 0x0e, 0x9c, 0xbc, 0x31, 0xfc, 0x1e, 0x14, 0xd9, 0x04, 0xa5, 0xd8, 0x2c, 0x48, 0xaf,
+	0xc7, 0xaf, 0xe6, 0x8d, 0xb5, 0xbf, 0xe7, 0x8d, 0x77, 0x95, 0x27, 0xf3, 0x0f, 0x9a, 0x84, 0xda,
+	0x11, 0xe2, 0x93, 0xe6, 0x13, 0x1c, 0x20, 0xef, 0xb0, 0x8b, 0xbd, 0x93, 0x79, 0x63, 0xe7, 0x10,
+	0x45, 0xe1, 0x03, 0x8b, 0x8b, 0x28, 0x5c, 0x5f, 0x85, 0xe1, 0xca, 0x73, 0xdc, 0x19, 0x0a, 0xa7,
+	0xd8, 0x72, 0xd4, 0xa9, 0xd0, 0x04, 0x97, 0xb4, 0xda, 0x5c, 0xdf, 0x31, 0xf6, 0x36, 0x9c, 0x4c,
+	0x84, 0xef, 0x81, 0x92, 0x17, 0x22, 0x12, 0xa1, 0x71, 0x88, 0xcd, 0x0d, 0xa9, 0x5b, 0x02, 0xf0,
+	0x43, 0x70, 0x85, 0x85, 0x88, 0x4d, 0xb0, 0x9f, 0x1d, 0x6f, 0x16, 0xa5, 0x4d, 0x55, 0xc3, 0x59,
+	0xee, 0xbb, 0xa0, 0x9a, 0xe2, 0x04, 0x91, 0xa5, 0xdd, 0xa6, 0xb4, 0xbb, 0xac, 0xd0, 0xcc, 0xac,
+	0x03, 0x2a, 0x21, 0x62, 0xdc, 0xd5, 0xde, 0xe6, 0xa5, 0x1d, 0x63, 0xaf, 0x7c, 0xff, 0x56, 0x53,
+	0x95, 0xa1, 0x99, 0x95, 0xa1, 0x39, 0xca, 0xca, 0xd0, 0xde, 0x78, 0xf9, 0x6f, 0xc3, 0x70, 0xca,
+	0xc2, 0x6b, 0xa8, 0x9c, 0x60, 0x0b, 0x48, 0xd1, 0x55, 0x47, 0x9b, 0x5b, 0x2b, 0x9e, 0x01, 0x84,
+	0x93, 0x23, 0x7d, 0x60, 0x03, 0x94, 0x65, 0x08, 0xae, 0x47, 0xa7, 0x31, 0x37, 0x4b, 0x32, 0x56,
+	0x20, 0xa1, 0x8e, 0x40, 0x44, 0x3e, 0xb9, 0xf2, 0xb8, 0xc4, 0x37, 0x81, 0xca, 0x27, 0x87, 0xf6,
+	0x7d, 0xf8, 0x10, 0x6c, 0x45, 0xd4, 0x27, 0x2f, 0x08, 0xf6, 0xcd, 0xf2, 0x8a, 0x71, 0x2c, 0x3c,
+	0xc4, 0x25, 0xe3, 0x69, 0x1a, 0xe7, 0x1e, 0xb7, 0xa2, 0x2e, 0x51, 0x68, 0x77, 0x59, 0xa2, 0x69,
+	0x3c, 0xa6, 0xb1, 0x4f, 0xe2, 0xc0, 0xbc, 0xac, 0x4a, 0xb4, 0x00, 0xac, 0x5f, 0x0a, 0xe0, 0xda,
+	0xf3, 0x4c, 0x3a, 0xc5, 0xc7, 0x2a, 0x28, 0x10, 0x5f, 0xd2, 0x70, 0xc3, 0x29, 0x10, 0xff, 0x2d,
+	0x39, 0x15, 0xde, 0x96, 0xd3, 0x75, 0xb0, 0x89, 0x22, 0xf9, 0x2c, 0x8a, 0x2a, 0x5a, 0x82, 0x5f,
+	0x82, 0x4b, 0x5e, 0x8a, 0x11, 0xc7, 0xbe, 0xe4, 0xc9, 0xc5, 0xa9, 0x6e, 0x09, 0x02, 0xcb, 0x74,
+	0x33, 0x27, 0xf8, 0x14, 0x5c, 0xf1, 0x68, 0x94, 0x84, 0x58, 0xde, 0x2e, 0x1a, 0x4d, 0x72, 0x69,
+	0xd5, 0x73, 0xaa, 0x4b, 0x67, 0xa1, 0x16, 0x61, 0xa6, 0x18, 0x31, 0x1a, 0x4b, 0xa6, 0x95, 0x1c,
+	0x2d, 0x59, 0x7f, 0x18, 0x60, 0x7b, 0x28, 0x48, 0xff, 0x8d, 0xe8, 0x80, 0xce, 0x04, 0x7b, 0x07,
+	0x09, 0x25, 0x31, 0x87, 0x6d, 0x50, 0x5a, 0x74, 0xb7, 0x7c, 0x95, 0x55, 0x6f, 0x5e, 0xba, 0xc1,
+	0xdb, 0xa0, 0x32, 0x0e, 0xa9, 0x77, 0xe0, 0x4e, 0x30, 0x09, 0x26, 0x5c, 0x3e, 0xe0, 0xba, 0x53,
+	0x96, 0xd8, 0x57, 0x12, 0x82, 0x5d, 0x50, 0xce, 0x75, 0xa0, 0x7c, 0xc3, 0x52, 0xfb, 0x83, 0x15,
+	0xfa, 0xd9, 0x01, 0x6c, 0x11, 0xb6, 0xf5, 0xab, 0x01, 0x6a, 0x1d, 0x1a, 0x25, 0x38, 0x66, 0xb2,
+	0x2e, 0x03, 0x4a, 0x43, 0x71, 0x3b, 0xf6, 0x28, 0x3b, 0x64, 0x1c, 0x47, 0xee, 0xa2, 0xb4, 0xe5,
+	0x05, 0xd6, 0xf7, 0x45, 0xa3, 0x8f, 0x51, 0x88, 0x62, 0x0f, 0xeb, 0xe2, 0x66, 0xa2, 0xa8, 0x3e,
+	0xa7, 0x1c, 0x85, 0x6e, 0x8a, 0x3d, 0x4c, 0x66, 0xd8, 0xd7, 0xe5, 0xbd, 0x2c, 0x51, 0x47, 0x83,
+	0xf0, 0x7d, 0x00, 0x94, 0x99, 0xec, 0x2d, 0x3d, 0x10, 0x24, 0x32, 0x40, 0xc4, 0xb7, 0xfe, 0x59,
+	0x07, 0x57, 0xf3, 0x71, 0x75, 0xc4, 0xa8, 0x38, 0xc3, 0xb4, 0x37, 0x03, 0x2d, 0x9c, 0x0d, 0xf4,
+	0x33, 0xb0, 0xa5, 0xc6, 0x8c, 0xe6, 0xd9, 0x45, 0x93, 0x72, 0x61, 0x99, 0xe3, 0xe6, 0xc6, 0x29,
+	0x6e, 0x2e, 0xc9, 0x50, 0xcc, 0x93, 0x41, 0xcc, 0x2f, 0x3c, 0x23, 0x3e, 0x8e, 0x3d, 0xec, 0xfa,
+	0x24, 0xc0, 0x8c, 0x6b, 0xb6, 0x54, 0x33, 0xb8, 0x2b, 0x51, 0xf8, 0x05, 0xd8, 0x64, 0x1c, 0xf1,
+	0x29, 0x93, 0x23, 0xa9, 0x7a, 0x7f, 0xb7, 0x99, 0x5f, 0x42, 0xcd, 0x33, 0x29, 0x0f, 0xa5, 0xb1,
+	0xa3, 0x9d, 0xe0, 0x83, 0x65, 0x6f, 0xac, 0x3a, 0x8e, 0x16, 0x7d, 0xf1, 0x10, 0x6c, 0xa5, 0x98,
+	0xd1, 0x50, 0x94, 0xa4, 0xb4, 0xea, 0x0c, 0xc9, 0x3c, 0xe0, 0x27, 0xe0, 0xaa, 0xfc, 0x9e, 0xca,
+	0xae, 0xd2, 0x8f, 0x00, 0x64, 0x8e, 0xb5, 0xa5, 0xc2, 0x51, 0xcf, 0xd1, 0x00, 0x65, 0x39, 0xa3,
+	0xf5, 0x1b, 0x96, 0xd5, 0xd8, 0x13, 0x50, 0x4b, 0x22, 0xd6, 0x0f, 0xe0, 0x9d, 0x6f, 0x09, 0x0e,
+	0xfd, 0x47, 0x53, 0x39, 0x4e, 0x1e, 0x21, 0x12, 0x4e, 0x53, 0xd9, 0x6b, 0x9a, 0xf0, 0x86, 0x24,
+	0xbc, 0x96, 0xe0, 0x36, 0x28, 0x32, 0x8e, 0x02, 0xbd, 0xb5, 0x1c, 0x25, 0x9c, 0x3b, 0x40, 0xb6,
+	0x41, 0x11, 0xa7, 0x29, 0x4d, 0x65, 0xed, 0x4a, 0x8e, 0x12, 0xac, 0x9f, 0x0a, 0xe0, 0xa6, 0x9c,
+	0xec, 0xf9, 0xd9, 0x35, 0x48, 0x69, 0x42, 0x19, 0x0a, 0xe1, 0x1d, 0x50, 0xe4, 0x84, 0x87, 0x58,
+	0x6f, 0xd3, 0xda, 0xc9, 0xbc, 0x51, 0xd1, 0x4b, 0x4f, 0xc0, 0x96, 0xa3, 0xd4, 0xf0, 0x73, 0x50,
+	0xf6, 0x31, 0xf3, 0x52, 0x92, 0xc8, 0xdd, 0xab, 0xb6, 0xe8, 0xf5, 0x93, 0x79, 0x03, 0x2a, 0xeb,
+	0x9c, 0xd2, 0x72, 0xf2, 0xa6, 0xc2, 0x33, 0xbf, 0xb5, 0xd7, 0xdf, 0xf4, 0xcc, 0x29, 0xad, 0xd3,
+	0x3b, 0xbb, 0xbf, 0x5c, 0xaa, 0x32, 0xa3, 0xb6, 0xad, 0xbb, 0xfc, 0xda, 0xd9, 0x2e, 0xef, 0xc7,
+	0xfc, 0x64, 0xde, 0xa8, 0x66, 0xc1, 0x48, 0x2f, 0x6b, 0xb1, 0x85, 0x3f, 0xfe, 0xdd, 0x00, 0x37,
+	0xce, 0xe1, 0x18, 0xfc, 0x08, 0xec, 0x76, 0x9e, 0x3d, 0x1d, 0xf4, 0xf6, 0x87, 0xad, 0x51, 0xff,
+	0xd9, 0xbe, 0xdb, 0x79, 0xd2, 0xea, 0x3f, 0x75, 0x87, 0xa3, 0xd6, 0xe8, 0xf9, 0xd0, 0x7d, 0xbe,
+	0x3f, 0x1c, 0xf4, 0x3a, 0xfd, 0x47, 0xfd, 0x5e, 0xb7, 0xb6, 0x06, 0x77, 0xc1, 0xed, 0xf3, 0x4d,
+	0x07, 0xbd, 0xfd, 0x6e, 0x7f, 0xff, 0x71, 0xcd, 0x80, 0x16, 0xa8, 0x5f, 0x60, 0xd6, 0xea, 0x77,
+	0x6b, 0x05, 0x78, 0x07, 0x58, 0xe7, 0xdb, 0x38, 0xbd, 0xaf, 0x7b, 0x9d, 0x51, 0xaf, 0x5b, 0x5b,
+	0x6f, 0xb7, 0x5f, 0x1d, 0xd5, 0x8d, 0xd7, 0x47, 0x75, 0xe3, 0xbf, 0xa3, 0xba, 0xf1, 0xf2, 0xb8,
+	0xbe, 0xf6, 0xfa, 0xb8, 0xbe, 0xf6, 0xd7, 0x71, 0x7d, 0xed, 0xbb, 0xbd, 0x80, 0xf0, 0xc9, 0x74,
+	0xdc, 0xf4, 0x68, 0x64, 0xab, 0x66, 0xba, 0x1b, 0xa2, 0x31, 0xd3, 0xdf, 0xf6, 0x8f, 0xe2, 0x3f,
+	0x95, 0xfc, 0xdb, 0x37, 0xde, 0x94, 0x3c, 0xff, 0xf4, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6,
+	0xbe, 0x30, 0x65, 0x13, 0x0a, 0x00, 0x00,
+}
+
+func (m *TrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unbonding != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Unbonding))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BurnedDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BurnedDeposit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Modified != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x50
 	}
 	if m.SlashCount != 0 {
-		n += 1 + sovTypes(uint64(m.SlashCount))
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastRepaid != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastRepaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LastSlashed != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSlashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RepaidDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SlashedDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Claimable != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Claimable))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CorporationId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CorporationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareValueCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareValueCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareValueCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareValue.Size()
+		i -= size
+		if _, err := m.ShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CompensationPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompensationPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompensationPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPaid != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalPaid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalReceived != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalReceived))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Balance != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EcosystemId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EcosystemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompensationClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompensationClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompensationClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaidAmount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PaidAmount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ResolutionReason) > 0 {
+		i -= len(m.ResolutionReason)
+		copy(dAtA[i:], m.ResolutionReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ResolutionReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Resolved != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Resolved, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Resolved):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTypes(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Created != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTypes(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EvidenceDigest) > 0 {
+		i -= len(m.EvidenceDigest)
+		copy(dAtA[i:], m.EvidenceDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvidenceDigest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EcosystemId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EcosystemId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldFundingFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldFundingFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldFundingFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Stage) > 0 {
+		i -= len(m.Stage)
+		copy(dAtA[i:], m.Stage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Stage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashTrustDepositProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashTrustDepositProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashTrustDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Corporation) > 0 {
+		i -= len(m.Corporation)
+		copy(dAtA[i:], m.Corporation)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Corporation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Deposit != 0 {
+		n += 1 + sovTypes(uint64(m.Deposit))
+	}
+	if m.Claimable != 0 {
+		n += 1 + sovTypes(uint64(m.Claimable))
+	}
+	if m.SlashedDeposit != 0 {
+		n += 1 + sovTypes(uint64(m.SlashedDeposit))
+	}
+	if m.RepaidDeposit != 0 {
+		n += 1 + sovTypes(uint64(m.RepaidDeposit))
+	}
+	if m.LastSlashed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastRepaid != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SlashCount != 0 {
+		n += 1 + sovTypes(uint64(m.SlashCount))
+	}
+	if m.CorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.CorporationId))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BurnedDeposit != 0 {
+		n += 1 + sovTypes(uint64(m.BurnedDeposit))
+	}
+	if m.Unbonding != 0 {
+		n += 1 + sovTypes(uint64(m.Unbonding))
+	}
+	return n
+}
+
+func (m *UnbondingTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.CorporationId != 0 {
+		n += 1 + sovTypes(uint64(m.CorporationId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ShareValueCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	l = m.ShareValue.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *CompensationPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EcosystemId != 0 {
+		n += 1 + sovTypes(uint64(m.EcosystemId))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovTypes(uint64(m.Balance))
+	}
+	if m.TotalReceived != 0 {
+		n += 1 + sovTypes(uint64(m.TotalReceived))
+	}
+	if m.TotalPaid != 0 {
+		n += 1 + sovTypes(uint64(m.TotalPaid))
+	}
+	return n
+}
+
+func (m *CompensationClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.EcosystemId != 0 {
+		n += 1 + sovTypes(uint64(m.EcosystemId))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvidenceDigest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Resolved != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Resolved)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ResolutionReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PaidAmount != 0 {
+		n += 1 + sovTypes(uint64(m.PaidAmount))
+	}
+	return n
+}
+
+func (m *YieldFundingFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashTrustDepositProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Corporation)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corporation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			m.Deposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			m.Claimable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimable |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+			}
+			m.SlashedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			}
+			m.RepaidDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSlashed == nil {
+				m.LastSlashed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSlashed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRepaid == nil {
+				m.LastRepaid = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastRepaid, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+			}
+			m.SlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedDeposit", wireType)
+			}
+			m.BurnedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			m.Unbonding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unbonding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorporationId", wireType)
+			}
+			m.CorporationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorporationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareValueCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareValueCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareValueCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *SlashTrustDepositProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Corporation)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return nil
 }
+func (m *CompensationPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompensationPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompensationPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
+			}
+			m.EcosystemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EcosystemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReceived", wireType)
+			}
+			m.TotalReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalReceived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaid", wireType)
+			}
+			m.TotalPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *CompensationClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -477,19 +2311,57 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TrustDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompensationClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompensationClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemId", wireType)
+			}
+			m.EcosystemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EcosystemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corporation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -517,11 +2389,30 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corporation = string(dAtA[iNdEx:postIndex])
+			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -549,15 +2440,13 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigest", wireType)
 			}
-			m.Deposit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -567,16 +2456,29 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deposit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Claimable = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -586,16 +2488,16 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Claimable |= uint64(b&0x7F) << shift
+				m.Status |= CompensationClaimStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			m.SlashedDeposit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -605,16 +2507,33 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.RepaidDeposit = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -624,16 +2543,33 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resolved == nil {
+				m.Resolved = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Resolved, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -643,33 +2579,117 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastSlashed == nil {
-				m.LastSlashed = new(time.Time)
+			m.ResolutionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSlashed, dAtA[iNdEx:postIndex]); err != nil {
+			m.PaidAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldFundingFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldFundingFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldFundingFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -679,33 +2699,29 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastRepaid == nil {
-				m.LastRepaid = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastRepaid, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.SlashCount = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -715,11 +2731,43 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashCount |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Bases of the deposit ratio driving the yield curve
+const (
+	YieldCurveRatioBasisBondedStake = "bonded_stake"
+	YieldCurveRatioBasisTotalSupply = "total_supply"
+)
+
+// YieldCurveEnabled reports whether the yield curve replaces
+// trust_deposit_max_yield_rate. The curve params are unset on chains
+// upgraded from before the curve, which leaves it disabled.
+func (p Params) YieldCurveEnabled() bool {
+	return !p.YieldCurveMaxRate.IsNil() && p.YieldCurveMaxRate.IsPositive()
+}
+
+// YieldCurveRate returns the annual yield rate of the curve at deposit ratio
+// ratio: it falls linearly from the max rate at 0 to the kink rate at the
+// target ratio, then linearly to the min rate at 1 and stays there.
+func (p Params) YieldCurveRate(ratio math.LegacyDec) math.LegacyDec {
+	if ratio.IsNegative() {
+		ratio = math.LegacyZeroDec()
+	}
+
+	if ratio.LTE(p.YieldCurveTargetRatio) {
+		progress := ratio.Quo(p.YieldCurveTargetRatio)
+		return p.YieldCurveMaxRate.Sub(p.YieldCurveMaxRate.Sub(p.YieldCurveKinkRate).Mul(progress))
+	}
+
+	progress := math.LegacyMinDec(
+		ratio.Sub(p.YieldCurveTargetRatio).Quo(math.LegacyOneDec().Sub(p.YieldCurveTargetRatio)),
+		math.LegacyOneDec(),
+	)
+	return p.YieldCurveKinkRate.Sub(p.YieldCurveKinkRate.Sub(p.YieldCurveMinRate).Mul(progress))
+}
+
+// validateYieldCurve checks that the rates of an enabled curve decrease from
+// max to min through the kink at a target ratio strictly between 0 and 1.
+func (p Params) validateYieldCurve() error {
+	for _, param := range []struct {
+		name  string
+		value math.LegacyDec
+	}{
+		{"yield_curve_min_rate", p.YieldCurveMinRate},
+		{"yield_curve_max_rate", p.YieldCurveMaxRate},
+		{"yield_curve_kink_rate", p.YieldCurveKinkRate},
+		{"yield_curve_target_ratio", p.YieldCurveTargetRatio},
+	} {
+		if param.value.IsNil() {
+			return fmt.Errorf("%s must be set when the yield curve is enabled", param.name)
+		}
+		if err := validateLegacyDec(param.value); err != nil {
+			return fmt.Errorf("invalid %s: %w", param.name, err)
+		}
+	}
+	if p.YieldCurveMinRate.GT(p.YieldCurveKinkRate) || p.YieldCurveKinkRate.GT(p.YieldCurveMaxRate) {
+		return fmt.Errorf("yield curve rates must satisfy min <= kink <= max: %s, %s, %s",
+			p.YieldCurveMinRate, p.YieldCurveKinkRate, p.YieldCurveMaxRate)
+	}
+	if !p.YieldCurveTargetRatio.IsPositive() || p.YieldCurveTargetRatio.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("yield_curve_target_ratio must be between 0 and 1 exclusive: %s", p.YieldCurveTargetRatio)
+	}
+	switch p.YieldCurveRatioBasis {
+	case YieldCurveRatioBasisBondedStake, YieldCurveRatioBasisTotalSupply:
+	default:
+		return fmt.Errorf("invalid yield_curve_ratio_basis %q, expected %s or %s",
+			p.YieldCurveRatioBasis, YieldCurveRatioBasisBondedStake, YieldCurveRatioBasisTotalSupply)
+	}
+	return nil
+}
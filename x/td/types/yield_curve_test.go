@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana/x/td/types"
+)
+
+func yieldCurveParams() types.Params {
+	params := types.DefaultParams()
+	params.YieldCurveMinRate = math.LegacyMustNewDecFromStr("0.02")
+	params.YieldCurveMaxRate = math.LegacyMustNewDecFromStr("0.2")
+	params.YieldCurveKinkRate = math.LegacyMustNewDecFromStr("0.08")
+	params.YieldCurveTargetRatio = math.LegacyMustNewDecFromStr("0.5")
+	return params
+}
+
+func TestYieldCurveRate(t *testing.T) {
+	params := yieldCurveParams()
+	require.True(t, params.YieldCurveEnabled())
+	require.False(t, types.DefaultParams().YieldCurveEnabled())
+
+	for ratio, rate := range map[string]string{
+		"0":    "0.2",
+		"0.25": "0.14",
+		"0.5":  "0.08",
+		"0.75": "0.05",
+		"1":    "0.02",
+		"2":    "0.02",
+	} {
+		require.Equal(t, math.LegacyMustNewDecFromStr(rate), params.YieldCurveRate(math.LegacyMustNewDecFromStr(ratio)), "ratio %s", ratio)
+	}
+}
+
+func TestYieldCurveValidate(t *testing.T) {
+	require.NoError(t, yieldCurveParams().Validate())
+
+	tests := map[string]func(p *types.Params){
+		"kink above max": func(p *types.Params) { p.YieldCurveKinkRate = math.LegacyMustNewDecFromStr("0.3") },
+		"min above kink": func(p *types.Params) { p.YieldCurveMinRate = math.LegacyMustNewDecFromStr("0.1") },
+		"zero target":    func(p *types.Params) { p.YieldCurveTargetRatio = math.LegacyZeroDec() },
+		"target of one":  func(p *types.Params) { p.YieldCurveTargetRatio = math.LegacyOneDec() },
+		"unset min rate": func(p *types.Params) { p.YieldCurveMinRate = math.LegacyDec{} },
+		"unknown basis":  func(p *types.Params) { p.YieldCurveRatioBasis = "circulating" },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := yieldCurveParams()
+			mutate(&params)
+			require.Error(t, params.Validate())
+		})
+	}
+}